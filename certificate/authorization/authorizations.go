@@ -49,6 +49,9 @@ type Group []*Authorization
 type Authorization struct {
 	Token Token
 	Claim *Claim
+	// Expires is the time after which the authorization can't be claimed, zero
+	// means never.
+	Expires time.Time
 }
 
 // Token is a userID and a random byte array, when serialized, can be used like
@@ -128,6 +131,10 @@ func (group *Group) Unmarshal(data []byte) error {
 		res := &Authorization{}
 		*group = append(*group, res)
 
+		if auth.Expires != 0 {
+			res.Expires = time.Unix(auth.Expires, 0)
+		}
+
 		if auth.Token != nil {
 			var tokendata [tokenDataLength]byte
 			copy(tokendata[:], auth.Token.Data)
@@ -175,9 +182,15 @@ func (group Group) Marshal() ([]byte, error) {
 			}
 		}
 
+		var expires int64
+		if !auth.Expires.IsZero() {
+			expires = auth.Expires.Unix()
+		}
+
 		msg.Authorizations = append(msg.Authorizations, &certificatepb.Authorization{
-			Token: token,
-			Claim: claim,
+			Token:   token,
+			Claim:   claim,
+			Expires: expires,
 		})
 	}
 
@@ -203,6 +216,12 @@ func (group Group) GroupByClaimed() (claimed, open Group) {
 	return claimed, open
 }
 
+// Expired returns whether the authorization can't be claimed anymore at now,
+// because it has expired.
+func (a Authorization) Expired(now time.Time) bool {
+	return !a.Expires.IsZero() && now.After(a.Expires)
+}
+
 // String implements the stringer interface and prevents authorization data
 // from completely leaking into logs and errors.
 func (a Authorization) String() string {
@@ -29,6 +29,8 @@ var (
 	ErrInvalidClaim = errs.Class("invalid authorization claim")
 	// ErrAlreadyClaimed is used when a valid claim is attempted with a token that's been used already.
 	ErrAlreadyClaimed = errs.Class("authorization already claimed")
+	// ErrExpired is used when a claim is attempted with a token that has expired.
+	ErrExpired = errs.Class("authorization expired")
 	// ErrNotFound is used when there is no matching authorization in the DB for a given userID and token.
 	ErrNotFound = errs.Class("authorization not found")
 	// ErrDBInternal is used when an internal error occurs involving the authorization database.
@@ -100,6 +102,14 @@ func (authDB *DB) Close() error {
 
 // Create creates a new authorization and adds it to the authorization database.
 func (authDB *DB) Create(ctx context.Context, userID string, count int) (_ Group, err error) {
+	defer mon.Task()(&ctx, userID, count)(&err)
+	return authDB.CreateExpiring(ctx, userID, count, time.Time{})
+}
+
+// CreateExpiring creates new authorizations, which can't be claimed after expires, and
+// adds them to the authorization database. A zero expires means the authorizations
+// never expire.
+func (authDB *DB) CreateExpiring(ctx context.Context, userID string, count int, expires time.Time) (_ Group, err error) {
 	defer mon.Task()(&ctx, userID, count)(&err)
 	if len(userID) == 0 {
 		return nil, ErrEmptyUserID
@@ -114,6 +124,7 @@ func (authDB *DB) Create(ctx context.Context, userID string, count int) (_ Group
 		if err != nil {
 			return nil, ErrDBInternal.Wrap(err)
 		}
+		auth.Expires = expires
 		newAuths = append(newAuths, auth)
 	}
 
@@ -218,6 +229,9 @@ func (authDB *DB) Claim(ctx context.Context, opts *ClaimOpts) (err error) {
 			if auth.Claim != nil {
 				return ErrAlreadyClaimed.New("%s", auth.String())
 			}
+			if auth.Expired(now) {
+				return ErrExpired.New("%s", auth.String())
+			}
 
 			auths[i] = &Authorization{
 				Token: auth.Token,
@@ -227,6 +241,7 @@ func (authDB *DB) Claim(ctx context.Context, opts *ClaimOpts) (err error) {
 					Identity:         ident,
 					SignedChainBytes: opts.ChainBytes,
 				},
+				Expires: auth.Expires,
 			}
 			if err := authDB.put(ctx, token.UserID, auths); err != nil {
 				return err
@@ -268,6 +283,57 @@ func (authDB *DB) Unclaim(ctx context.Context, authToken string) (err error) {
 	return errs.New("token not found in authorizations DB")
 }
 
+// Revoke removes an unclaimed authorization, so it can't be claimed anymore.
+func (authDB *DB) Revoke(ctx context.Context, authToken string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	token, err := ParseToken(authToken)
+	if err != nil {
+		return err
+	}
+
+	auths, err := authDB.Get(ctx, token.UserID)
+	if err != nil {
+		return err
+	}
+
+	for i, auth := range auths {
+		if auth.Token.Equal(token) {
+			if auth.Claim != nil {
+				return ErrAlreadyClaimed.New("%s", auth.String())
+			}
+			auths = append(auths[:i], auths[i+1:]...)
+			mon.Meter("authorization_revoke").Mark(1)
+			return authDB.put(ctx, token.UserID, auths)
+		}
+	}
+	tokenFmt := Authorization{
+		Token: *token,
+	}
+	return ErrNotFound.New("%s", tokenFmt.String())
+}
+
+// RevokeUnclaimed removes all unclaimed authorizations of the user and returns
+// how many were removed.
+func (authDB *DB) RevokeUnclaimed(ctx context.Context, userID string) (revoked int, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	auths, err := authDB.Get(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	claimed, open := auths.GroupByClaimed()
+	if len(open) == 0 {
+		return 0, nil
+	}
+	if err := authDB.put(ctx, userID, claimed); err != nil {
+		return 0, err
+	}
+
+	mon.Meter("authorization_revoke").Mark(len(open))
+	return len(open), nil
+}
+
 func (authDB *DB) add(ctx context.Context, userID string, newAuths Group) (err error) {
 	defer mon.Task()(&ctx, userID)(&err)
 
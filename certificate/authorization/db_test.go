@@ -387,6 +387,87 @@ func TestAuthorizationDB_Claim_Invalid(t *testing.T) {
 	})
 }
 
+func TestAuthorizationDB_Claim_Expired(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	authDB := newTestAuthDB(t, ctx)
+	defer ctx.Check(authDB.Close)
+
+	userID := "user@mail.test"
+
+	expires := time.Now().Add(-time.Minute).Truncate(time.Second)
+	auths, err := authDB.CreateExpiring(ctx, userID, 1, expires)
+	require.NoError(t, err)
+	require.Len(t, auths, 1)
+
+	stored, err := authDB.Get(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, stored, 1)
+	require.True(t, expires.Equal(stored[0].Expires))
+
+	ident, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+
+	peer := &rpcpeer.Peer{
+		Addr: &net.TCPAddr{
+			IP:   net.ParseIP("1.2.3.4"),
+			Port: 5,
+		},
+		State: tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{ident.Leaf, ident.CA},
+		},
+	}
+
+	err = authDB.Claim(ctx, &ClaimOpts{
+		Req: &certificatepb.SigningRequest{
+			AuthToken: auths[0].Token.String(),
+			Timestamp: time.Now().Unix(),
+		},
+		Peer:       peer,
+		ChainBytes: [][]byte{ident.CA.Raw},
+	})
+	require.True(t, ErrExpired.Has(err), err)
+}
+
+func TestAuthorizationDB_Revoke(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	authDB := newTestAuthDB(t, ctx)
+	defer ctx.Check(authDB.Close)
+
+	userID := "user@mail.test"
+
+	ident, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+
+	auths, err := authDB.Create(ctx, userID, 3)
+	require.NoError(t, err)
+	auths[0].Claim = &Claim{
+		Timestamp: time.Now().Unix(),
+		Identity:  &identity.PeerIdentity{CA: ident.CA, Leaf: ident.Leaf},
+	}
+	require.NoError(t, authDB.put(ctx, userID, auths))
+
+	err = authDB.Revoke(ctx, auths[0].Token.String())
+	require.True(t, ErrAlreadyClaimed.Has(err), err)
+
+	require.NoError(t, authDB.Revoke(ctx, auths[1].Token.String()))
+	err = authDB.Revoke(ctx, auths[1].Token.String())
+	require.True(t, ErrNotFound.Has(err), err)
+
+	revoked, err := authDB.RevokeUnclaimed(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, 1, revoked)
+
+	remaining, err := authDB.Get(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, remaining, 1)
+	require.Equal(t, auths[0].Token, remaining[0].Token)
+	require.NotNil(t, remaining[0].Claim)
+}
+
 func TestAuthorizationDB_Emails(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
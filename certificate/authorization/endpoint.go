@@ -5,11 +5,14 @@ package authorization
 
 import (
 	"context"
+	"crypto/subtle"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"path"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -43,6 +46,7 @@ func NewEndpoint(log *zap.Logger, service *Service, listener net.Listener) *Endp
 	}
 
 	mux.HandleFunc("/v1/authorizations/", endpoint.handleAuthorization)
+	mux.HandleFunc("/v1/management/authorizations/", endpoint.handleManagement)
 
 	return endpoint
 }
@@ -115,3 +119,165 @@ func (endpoint *Endpoint) handleAuthorization(writer http.ResponseWriter, httpRe
 		return
 	}
 }
+
+// IssueRequest is the request of the management api to issue authorizations.
+type IssueRequest struct {
+	// Count is the number of the issued authorizations, one when zero.
+	Count int `json:"count"`
+	// Expiration is the duration after which the authorizations expire, e.g. "72h".
+	// The configured expiration is used, when it's empty.
+	Expiration string `json:"expiration,omitempty"`
+}
+
+// AuthorizationInfo describes an authorization in the responses of the management api.
+type AuthorizationInfo struct {
+	Token   string     `json:"token"`
+	Claimed bool       `json:"claimed"`
+	Expires *time.Time `json:"expires,omitempty"`
+}
+
+// RevokeResponse is the response of the management api to revoke authorizations.
+type RevokeResponse struct {
+	Revoked int `json:"revoked"`
+}
+
+// handleManagement handles the management api, which issues, lists and revokes the
+// authorizations of a user ID:
+//
+//	POST   /v1/management/authorizations/<user ID>          issues authorizations
+//	GET    /v1/management/authorizations/<user ID>          lists authorizations
+//	DELETE /v1/management/authorizations/<user ID>[?token=] revokes unclaimed authorizations
+//
+// The requests must have the configured api key in the Authorization header.
+func (endpoint *Endpoint) handleManagement(writer http.ResponseWriter, httpReq *http.Request) {
+	var err error
+	ctx := httpReq.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	apiKey := endpoint.service.config.APIKey
+	if apiKey == "" {
+		msg := "management api is disabled"
+		err = ErrEndpoint.New("%s", msg)
+		http.Error(writer, msg, http.StatusForbidden)
+		return
+	}
+	if subtle.ConstantTimeCompare([]byte(httpReq.Header.Get("Authorization")), []byte(apiKey)) != 1 {
+		msg := "invalid api key"
+		err = ErrEndpoint.New("%s", msg)
+		http.Error(writer, msg, http.StatusUnauthorized)
+		return
+	}
+
+	userID := path.Base(httpReq.URL.Path)
+	if userID == "authorizations" || userID == "" {
+		msg := "missing user ID"
+		err = ErrEndpoint.New("%s", msg)
+		http.Error(writer, msg, http.StatusUnprocessableEntity)
+		return
+	}
+
+	switch httpReq.Method {
+	case http.MethodPost:
+		var request IssueRequest
+		if err = json.NewDecoder(http.MaxBytesReader(writer, httpReq.Body, 4096)).Decode(&request); err != nil {
+			err = ErrEndpoint.Wrap(err)
+			http.Error(writer, "invalid request: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if request.Count == 0 {
+			request.Count = 1
+		}
+		if request.Count < 0 {
+			err = ErrEndpoint.New("invalid count: %d", request.Count)
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var expiration time.Duration
+		if request.Expiration != "" {
+			expiration, err = time.ParseDuration(request.Expiration)
+			if err != nil || expiration <= 0 {
+				err = ErrEndpoint.New("invalid expiration: %q", request.Expiration)
+				http.Error(writer, err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+
+		var group Group
+		group, err = endpoint.service.Create(ctx, userID, request.Count, expiration)
+		if err != nil {
+			endpoint.serviceError(writer, err)
+			return
+		}
+		endpoint.log.Info("issued authorizations", zap.String("user ID", userID), zap.Int("count", len(group)))
+		err = endpoint.writeJSON(writer, http.StatusCreated, authorizationInfos(group))
+	case http.MethodGet:
+		var group Group
+		group, err = endpoint.service.List(ctx, userID)
+		if err != nil {
+			endpoint.serviceError(writer, err)
+			return
+		}
+		err = endpoint.writeJSON(writer, http.StatusOK, authorizationInfos(group))
+	case http.MethodDelete:
+		var revoked int
+		revoked, err = endpoint.service.Revoke(ctx, userID, httpReq.URL.Query().Get("token"))
+		if err != nil {
+			endpoint.serviceError(writer, err)
+			return
+		}
+		endpoint.log.Info("revoked authorizations", zap.String("user ID", userID), zap.Int("count", revoked))
+		err = endpoint.writeJSON(writer, http.StatusOK, RevokeResponse{Revoked: revoked})
+	default:
+		msg := fmt.Sprintf("unsupported HTTP method: %s", httpReq.Method)
+		err = ErrEndpoint.New("%s", msg)
+		http.Error(writer, msg, http.StatusMethodNotAllowed)
+	}
+}
+
+// serviceError writes the response for the error of the service.
+func (endpoint *Endpoint) serviceError(writer http.ResponseWriter, err error) {
+	switch {
+	case ErrQuotaExceeded.Has(err):
+		http.Error(writer, err.Error(), http.StatusTooManyRequests)
+	case ErrNotFound.Has(err):
+		http.Error(writer, err.Error(), http.StatusNotFound)
+	case ErrAlreadyClaimed.Has(err):
+		http.Error(writer, err.Error(), http.StatusConflict)
+	case ErrInvalidToken.Has(err):
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+	default:
+		msg := "error managing authorizations"
+		endpoint.log.Error(msg, zap.Error(err))
+		http.Error(writer, msg, http.StatusInternalServerError)
+	}
+}
+
+// writeJSON writes the value as the json response with the status.
+func (endpoint *Endpoint) writeJSON(writer http.ResponseWriter, status int, value interface{}) error {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	if err := json.NewEncoder(writer).Encode(value); err != nil {
+		err = ErrEndpoint.Wrap(err)
+		endpoint.log.Error("error writing response", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// authorizationInfos converts the group to its descriptions in the responses.
+func authorizationInfos(group Group) []AuthorizationInfo {
+	infos := make([]AuthorizationInfo, 0, len(group))
+	for _, authorization := range group {
+		info := AuthorizationInfo{
+			Token:   authorization.Token.String(),
+			Claimed: authorization.Claim != nil,
+		}
+		if !authorization.Expires.IsZero() {
+			expires := authorization.Expires.UTC()
+			info.Expires = &expires
+		}
+		infos = append(infos, info)
+	}
+	return infos
+}
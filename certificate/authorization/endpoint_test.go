@@ -4,10 +4,13 @@
 package authorization
 
 import (
+	"bytes"
+	"encoding/json"
 	"io"
 	"net"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
@@ -28,7 +31,7 @@ func TestEndpoint_Run_httpSuccess(t *testing.T) {
 	authDB := newTestAuthDB(t, ctx)
 	defer ctx.Check(authDB.Close)
 
-	service := NewService(log, authDB)
+	service := NewService(log, authDB, ServiceConfig{})
 	endpoint := NewEndpoint(log, service, listener)
 	require.NotNil(t, endpoint)
 
@@ -74,7 +77,7 @@ func TestEndpoint_Run_httpErrors(t *testing.T) {
 	authDB := newTestAuthDB(t, ctx)
 	defer ctx.Check(authDB.Close)
 
-	service := NewService(log, authDB)
+	service := NewService(log, authDB, ServiceConfig{})
 	endpoint := NewEndpoint(log, service, listener)
 	require.NotNil(t, endpoint)
 
@@ -137,3 +140,80 @@ func TestEndpoint_Run_httpErrors(t *testing.T) {
 		require.Equal(t, testCase.statusCode, res.StatusCode)
 	}
 }
+
+func TestEndpoint_Management(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	log := zaptest.NewLogger(t)
+	authDB := newTestAuthDB(t, ctx)
+	defer ctx.Check(authDB.Close)
+
+	const apiKey = "secret"
+	service := NewService(log, authDB, ServiceConfig{Quota: 2, APIKey: apiKey})
+	endpoint := NewEndpoint(log, service, listener)
+
+	ctx.Go(func() error {
+		return errs2.IgnoreCanceled(endpoint.Run(ctx))
+	})
+	defer ctx.Check(endpoint.Close)
+
+	url := "http://" + listener.Addr().String() + "/v1/management/authorizations/user@mail.test"
+
+	do := func(method, url, key string, body interface{}, response interface{}) int {
+		var reqBody io.Reader
+		if body != nil {
+			data, err := json.Marshal(body)
+			require.NoError(t, err)
+			reqBody = bytes.NewReader(data)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", key)
+
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer func() { require.NoError(t, res.Body.Close()) }()
+
+		if response != nil && res.StatusCode < 300 {
+			require.NoError(t, json.NewDecoder(res.Body).Decode(response))
+		}
+		return res.StatusCode
+	}
+
+	require.Equal(t, http.StatusUnauthorized, do(http.MethodGet, url, "wrong", nil, nil))
+	require.Equal(t, http.StatusBadRequest, do(http.MethodPost, url, apiKey, IssueRequest{Count: 1, Expiration: "-1h"}, nil))
+
+	var issued []AuthorizationInfo
+	require.Equal(t, http.StatusCreated, do(http.MethodPost, url, apiKey, IssueRequest{Count: 2, Expiration: "72h"}, &issued))
+	require.Len(t, issued, 2)
+	for _, info := range issued {
+		require.False(t, info.Claimed)
+		require.NotNil(t, info.Expires)
+		require.WithinDuration(t, time.Now().Add(72*time.Hour), *info.Expires, time.Minute)
+
+		token, err := ParseToken(info.Token)
+		require.NoError(t, err)
+		require.Equal(t, "user@mail.test", token.UserID)
+	}
+
+	require.Equal(t, http.StatusTooManyRequests, do(http.MethodPost, url, apiKey, IssueRequest{}, nil))
+
+	var listed []AuthorizationInfo
+	require.Equal(t, http.StatusOK, do(http.MethodGet, url, apiKey, nil, &listed))
+	require.ElementsMatch(t, issued, listed)
+
+	var revoked RevokeResponse
+	require.Equal(t, http.StatusOK, do(http.MethodDelete, url+"?token="+issued[0].Token, apiKey, nil, &revoked))
+	require.Equal(t, 1, revoked.Revoked)
+	require.Equal(t, http.StatusNotFound, do(http.MethodDelete, url+"?token="+issued[0].Token, apiKey, nil, nil))
+
+	require.Equal(t, http.StatusOK, do(http.MethodDelete, url, apiKey, nil, &revoked))
+	require.Equal(t, 1, revoked.Revoked)
+
+	require.Equal(t, http.StatusOK, do(http.MethodGet, url, apiKey, nil, &listed))
+	require.Empty(t, listed)
+}
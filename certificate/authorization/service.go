@@ -6,25 +6,44 @@ package authorization
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 )
 
-// ErrService is the default error class for the authorization service.
-var ErrService = errs.Class("authorization service")
+var (
+	// ErrService is the default error class for the authorization service.
+	ErrService = errs.Class("authorization service")
+	// ErrQuotaExceeded is used when issuing authorizations would exceed the quota of the user.
+	ErrQuotaExceeded = errs.Class("authorization quota exceeded")
+)
+
+// ServiceConfig is the authorization service config.
+type ServiceConfig struct {
+	Quota      int           `default:"0" help:"maximum number of claimed and unexpired unclaimed authorizations per user ID, zero means unlimited"`
+	Expiration time.Duration `default:"0s" help:"expiration of the issued authorizations, when none is requested, zero means never"`
+	APIKey     string        `default:"" help:"key required by the authorization management api, which is disabled when empty"`
+}
 
 // Service is the authorization service.
 type Service struct {
-	log *zap.Logger
-	db  *DB
+	log    *zap.Logger
+	db     *DB
+	config ServiceConfig
+
+	// mu serializes the issuance and revocation of authorizations, so the quotas
+	// can't be exceeded by concurrent requests.
+	mu sync.Mutex
 }
 
 // NewService creates a new authorization service.
-func NewService(log *zap.Logger, db *DB) *Service {
+func NewService(log *zap.Logger, db *DB, config ServiceConfig) *Service {
 	return &Service{
-		log: log,
-		db:  db,
+		log:    log,
+		db:     db,
+		config: config,
 	}
 }
 
@@ -38,6 +57,9 @@ func (service *Service) GetOrCreate(ctx context.Context, userID string) (_ *Toke
 		return nil, err
 	}
 
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
 	existingGroup, err := service.db.Get(ctx, userID)
 	if err != nil && !ErrNotFound.Has(err) {
 		msg := "error getting authorizations"
@@ -46,17 +68,15 @@ func (service *Service) GetOrCreate(ctx context.Context, userID string) (_ *Toke
 		return nil, err
 	}
 
+	now := time.Now()
 	for _, authorization := range existingGroup {
-		if authorization.Claim == nil {
+		if authorization.Claim == nil && !authorization.Expired(now) {
 			return &authorization.Token, nil
 		}
 	}
 
-	createdGroup, err := service.db.Create(ctx, userID, 1)
+	createdGroup, err := service.create(ctx, userID, existingGroup, 1, service.config.Expiration)
 	if err != nil {
-		msg := "error creating authorization"
-		err = ErrService.Wrap(err)
-		service.log.Error(msg, zap.Error(err))
 		return nil, err
 	}
 
@@ -72,3 +92,109 @@ func (service *Service) GetOrCreate(ctx context.Context, userID string) (_ *Toke
 	authorization := createdGroup[0]
 	return &authorization.Token, nil
 }
+
+// Create issues count new authorizations for the user ID, which expire after the
+// expiration. A zero expiration uses the configured one.
+func (service *Service) Create(ctx context.Context, userID string, count int, expiration time.Duration) (_ Group, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if userID == "" {
+		return nil, ErrService.New("missing user ID")
+	}
+	if count < 1 {
+		return nil, ErrService.Wrap(ErrCount)
+	}
+	if expiration < 0 {
+		return nil, ErrService.New("negative expiration: %s", expiration)
+	}
+	if expiration == 0 {
+		expiration = service.config.Expiration
+	}
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	existingGroup, err := service.db.Get(ctx, userID)
+	if err != nil && !ErrNotFound.Has(err) {
+		msg := "error getting authorizations"
+		err = ErrService.Wrap(err)
+		service.log.Error(msg, zap.Error(err))
+		return nil, err
+	}
+
+	return service.create(ctx, userID, existingGroup, count, expiration)
+}
+
+// create issues count new authorizations for the user ID, when it doesn't exceed the
+// quota of the user with the existing authorizations. It must be called with mu held.
+func (service *Service) create(ctx context.Context, userID string, existingGroup Group, count int, expiration time.Duration) (_ Group, err error) {
+	now := time.Now()
+
+	if service.config.Quota > 0 {
+		active := 0
+		for _, authorization := range existingGroup {
+			if authorization.Claim != nil || !authorization.Expired(now) {
+				active++
+			}
+		}
+		if active+count > service.config.Quota {
+			return nil, ErrQuotaExceeded.New("user has %d of %d authorizations", active, service.config.Quota)
+		}
+	}
+
+	// the expiration is stored with second precision.
+	var expires time.Time
+	if expiration > 0 {
+		expires = now.Add(expiration).Truncate(time.Second)
+	}
+
+	createdGroup, err := service.db.CreateExpiring(ctx, userID, count, expires)
+	if err != nil {
+		msg := "error creating authorization"
+		err = ErrService.Wrap(err)
+		service.log.Error(msg, zap.Error(err))
+		return nil, err
+	}
+	return createdGroup, nil
+}
+
+// List returns the authorizations of the user ID.
+func (service *Service) List(ctx context.Context, userID string) (_ Group, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	group, err := service.db.Get(ctx, userID)
+	if err != nil && !ErrNotFound.Has(err) {
+		return nil, ErrService.Wrap(err)
+	}
+	return group, nil
+}
+
+// Revoke revokes the unclaimed authorization of the user ID with the token, or all
+// unclaimed authorizations of the user ID, when the token is empty. It returns
+// how many authorizations were revoked.
+func (service *Service) Revoke(ctx context.Context, userID, authToken string) (revoked int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	if authToken == "" {
+		revoked, err = service.db.RevokeUnclaimed(ctx, userID)
+		if ErrNotFound.Has(err) {
+			return 0, nil
+		}
+		return revoked, ErrService.Wrap(err)
+	}
+
+	token, err := ParseToken(authToken)
+	if err != nil {
+		return 0, ErrService.Wrap(err)
+	}
+	if token.UserID != userID {
+		return 0, ErrInvalidToken.New("token isn't issued to the user ID")
+	}
+	if err := service.db.Revoke(ctx, authToken); err != nil {
+		return 0, ErrService.Wrap(err)
+	}
+	return 1, nil
+}
@@ -5,6 +5,7 @@ package authorization
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/zeebo/errs"
@@ -20,7 +21,7 @@ func TestService_GetOrCreate(t *testing.T) {
 	authorizationDB := newTestAuthDB(t, ctx)
 	defer ctx.Check(authorizationDB.Close)
 
-	service := NewService(zaptest.NewLogger(t), authorizationDB)
+	service := NewService(zaptest.NewLogger(t), authorizationDB, ServiceConfig{})
 	require.NotNil(t, service)
 
 	{ // new user, no existing authorization tokens (create)
@@ -61,7 +62,7 @@ func TestService_GetOrCreate_error(t *testing.T) {
 	authorizationDB := newTestAuthDB(t, ctx)
 	defer ctx.Check(authorizationDB.Close)
 
-	service := NewService(zaptest.NewLogger(t), authorizationDB)
+	service := NewService(zaptest.NewLogger(t), authorizationDB, ServiceConfig{})
 	require.NotNil(t, service)
 
 	{ // empty user ID
@@ -70,3 +71,61 @@ func TestService_GetOrCreate_error(t *testing.T) {
 		require.Nil(t, token)
 	}
 }
+
+func TestService_Create(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	authorizationDB := newTestAuthDB(t, ctx)
+	defer ctx.Check(authorizationDB.Close)
+
+	service := NewService(zaptest.NewLogger(t), authorizationDB, ServiceConfig{
+		Quota:      3,
+		Expiration: time.Hour,
+	})
+
+	userID := "user@mail.test"
+
+	group, err := service.Create(ctx, userID, 2, 0)
+	require.NoError(t, err)
+	require.Len(t, group, 2)
+	for _, authorization := range group {
+		require.WithinDuration(t, time.Now().Add(time.Hour), authorization.Expires, time.Minute)
+	}
+
+	_, err = service.Create(ctx, userID, 2, 0)
+	require.True(t, ErrQuotaExceeded.Has(err), err)
+
+	// an unclaimed authorization is returned instead of issuing a new one.
+	token, err := service.GetOrCreate(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, group[0].Token, *token)
+
+	group, err = service.Create(ctx, userID, 1, 24*time.Hour)
+	require.NoError(t, err)
+	require.Len(t, group, 1)
+	require.WithinDuration(t, time.Now().Add(24*time.Hour), group[0].Expires, time.Minute)
+
+	_, err = service.GetOrCreate(ctx, "other@mail.test")
+	require.NoError(t, err)
+
+	// revoked authorizations don't count towards the quota.
+	revoked, err := service.Revoke(ctx, userID, group[0].Token.String())
+	require.NoError(t, err)
+	require.Equal(t, 1, revoked)
+
+	_, err = service.Revoke(ctx, "other@mail.test", group[0].Token.String())
+	require.True(t, ErrInvalidToken.Has(err), err)
+
+	revoked, err = service.Revoke(ctx, userID, "")
+	require.NoError(t, err)
+	require.Equal(t, 2, revoked)
+
+	group, err = service.Create(ctx, userID, 3, 0)
+	require.NoError(t, err)
+	require.Len(t, group, 3)
+
+	list, err := service.List(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+}
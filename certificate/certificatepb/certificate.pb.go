@@ -146,6 +146,7 @@ func (m *AuthorizationGroup) GetAuthorizations() []*Authorization {
 type Authorization struct {
 	Token                *Token   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	Claim                *Claim   `protobuf:"bytes,2,opt,name=claim,proto3" json:"claim,omitempty"`
+	Expires              int64    `protobuf:"varint,3,opt,name=expires,proto3" json:"expires,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -189,6 +190,13 @@ func (m *Authorization) GetClaim() *Claim {
 	return nil
 }
 
+func (m *Authorization) GetExpires() int64 {
+	if m != nil {
+		return m.Expires
+	}
+	return 0
+}
+
 type Token struct {
 	UserId               []byte   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
@@ -309,28 +317,29 @@ func init() {
 func init() { proto.RegisterFile("certificate.proto", fileDescriptor_c0d34c34dd33be4b) }
 
 var fileDescriptor_c0d34c34dd33be4b = []byte{
-	// 363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xbf, 0x4f, 0xf3, 0x30,
-	0x10, 0x55, 0xbe, 0x26, 0xfd, 0xe8, 0x35, 0x14, 0x30, 0x45, 0x44, 0x15, 0x48, 0x25, 0x0b, 0x11,
-	0x42, 0xa9, 0xd4, 0xb2, 0x31, 0xd1, 0x0c, 0x88, 0x81, 0x01, 0x03, 0x0b, 0x4b, 0xe4, 0xc6, 0xa6,
-	0x35, 0x50, 0x3b, 0xc4, 0xce, 0x50, 0x56, 0xfe, 0x71, 0x64, 0x1b, 0xf5, 0xd7, 0xc0, 0x76, 0xf7,
-	0xde, 0xdd, 0x93, 0xdf, 0x3d, 0xc3, 0x41, 0xc1, 0x2a, 0xcd, 0x5f, 0x79, 0x41, 0x34, 0x4b, 0xcb,
-	0x4a, 0x6a, 0x89, 0x7c, 0x21, 0x29, 0x8b, 0xef, 0xa1, 0xf3, 0xc8, 0xa7, 0x82, 0x8b, 0x29, 0x66,
-	0x9f, 0x35, 0x53, 0x1a, 0x9d, 0x02, 0x90, 0x5a, 0xcf, 0x72, 0x2d, 0xdf, 0x99, 0x88, 0xbc, 0xbe,
-	0x97, 0xb4, 0x70, 0xcb, 0x20, 0x4f, 0x06, 0x40, 0x27, 0xd0, 0xd2, 0x7c, 0xce, 0x94, 0x26, 0xf3,
-	0x32, 0xfa, 0xd7, 0xf7, 0x92, 0x06, 0x5e, 0x01, 0xf1, 0x39, 0xec, 0x2d, 0xe5, 0x54, 0x29, 0x85,
-	0x62, 0xa8, 0x0b, 0x41, 0x31, 0x23, 0xdc, 0x48, 0x35, 0x92, 0x10, 0xbb, 0x26, 0x7e, 0x00, 0x74,
-	0x53, 0xeb, 0x99, 0xac, 0xf8, 0x17, 0xd1, 0x5c, 0x8a, 0xdb, 0x4a, 0xd6, 0x25, 0xba, 0x86, 0x0e,
-	0x59, 0x47, 0x95, 0x5d, 0x6a, 0x0f, 0x0f, 0x53, 0xf3, 0xd8, 0x74, 0x63, 0x03, 0x6f, 0x8d, 0xc6,
-	0xcf, 0xb0, 0xbb, 0x31, 0x80, 0xce, 0x20, 0x58, 0x99, 0x68, 0x0f, 0xdb, 0x4e, 0xc4, 0xda, 0xc0,
-	0x8e, 0x31, 0x23, 0xc5, 0x07, 0xe1, 0x73, 0xeb, 0x64, 0x39, 0x92, 0x19, 0x08, 0x3b, 0x26, 0xbe,
-	0x82, 0xc0, 0x39, 0x3f, 0x86, 0xff, 0xb5, 0x62, 0x55, 0xce, 0xa9, 0x15, 0x0c, 0x71, 0xd3, 0xb4,
-	0x77, 0x14, 0x21, 0xf0, 0x29, 0xd1, 0xc4, 0x6a, 0x84, 0xd8, 0xd6, 0xf1, 0xb7, 0x07, 0x81, 0x95,
-	0x31, 0x2c, 0xa1, 0xb4, 0xfa, 0xdd, 0xb1, 0xf5, 0xdf, 0x47, 0x44, 0x3d, 0xd8, 0xe1, 0x94, 0x09,
-	0xcd, 0xf5, 0x22, 0x6a, 0xd8, 0xad, 0x65, 0x8f, 0x2e, 0x01, 0x29, 0x3e, 0x15, 0x8c, 0xe6, 0xf6,
-	0x8e, 0xf9, 0x64, 0xa1, 0x99, 0x8a, 0x7c, 0x7b, 0xda, 0x7d, 0xc7, 0x64, 0x86, 0x18, 0x1b, 0x7c,
-	0x98, 0x41, 0x98, 0xad, 0x82, 0x57, 0x68, 0x04, 0xbe, 0x89, 0x07, 0x75, 0x9d, 0xcf, 0xcd, 0xe4,
-	0x7b, 0x47, 0x5b, 0xa8, 0x0b, 0x70, 0x7c, 0xf1, 0x92, 0x28, 0x2d, 0xab, 0xb7, 0x94, 0xcb, 0x81,
-	0x2d, 0x06, 0x6b, 0x9f, 0x69, 0xbd, 0x2e, 0x27, 0x93, 0xa6, 0xfd, 0x5b, 0xa3, 0x9f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xc3, 0xd7, 0xdc, 0xe6, 0x70, 0x02, 0x00, 0x00,
+	// 378 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xb1, 0x6e, 0xdb, 0x30,
+	0x10, 0x85, 0x6c, 0xc9, 0xae, 0xcf, 0xaa, 0xdb, 0xb2, 0x2e, 0x2a, 0x18, 0x2d, 0xe0, 0x6a, 0xa9,
+	0x50, 0x14, 0x32, 0x60, 0x77, 0xeb, 0x54, 0x6b, 0x28, 0x3a, 0x74, 0x08, 0x93, 0x29, 0x8b, 0x40,
+	0x8b, 0x8c, 0xcd, 0x24, 0x26, 0x15, 0x92, 0x02, 0xe2, 0xac, 0xf9, 0xf1, 0x80, 0x64, 0x62, 0xcb,
+	0x1e, 0xb2, 0xdd, 0xbd, 0x77, 0x77, 0xe4, 0xbd, 0x7b, 0xf0, 0xa1, 0x62, 0xca, 0xf0, 0x2b, 0x5e,
+	0x11, 0xc3, 0xf2, 0x5a, 0x49, 0x23, 0x51, 0x28, 0x24, 0x65, 0xe9, 0x7f, 0x18, 0x9d, 0xf3, 0xb5,
+	0xe0, 0x62, 0x8d, 0xd9, 0x5d, 0xc3, 0xb4, 0x41, 0x5f, 0x01, 0x48, 0x63, 0x36, 0xa5, 0x91, 0x37,
+	0x4c, 0x24, 0xc1, 0x34, 0xc8, 0x06, 0x78, 0x60, 0x91, 0x0b, 0x0b, 0xa0, 0x2f, 0x30, 0x30, 0x7c,
+	0xcb, 0xb4, 0x21, 0xdb, 0x3a, 0xe9, 0x4c, 0x83, 0xac, 0x8b, 0x0f, 0x40, 0xfa, 0x1d, 0xde, 0xed,
+	0xc7, 0xe9, 0x5a, 0x0a, 0xcd, 0xd0, 0x18, 0xa2, 0x6a, 0x43, 0xb8, 0x1d, 0xd5, 0xcd, 0x62, 0xec,
+	0x93, 0xf4, 0x0c, 0xd0, 0x9f, 0xc6, 0x6c, 0xa4, 0xe2, 0x0f, 0xc4, 0x70, 0x29, 0xfe, 0x2a, 0xd9,
+	0xd4, 0xe8, 0x37, 0x8c, 0x48, 0x1b, 0xd5, 0xae, 0x69, 0x38, 0xff, 0x98, 0xdb, 0xcf, 0xe6, 0x47,
+	0x1d, 0xf8, 0xa4, 0x34, 0x95, 0xf0, 0xf6, 0xa8, 0x00, 0x7d, 0x83, 0xe8, 0xb0, 0xc4, 0x70, 0x3e,
+	0xf4, 0x43, 0xdc, 0x1a, 0xd8, 0x33, 0xb6, 0xa4, 0xba, 0x25, 0x7c, 0x9b, 0x74, 0xda, 0x25, 0x85,
+	0x85, 0xb0, 0x67, 0x50, 0x02, 0x7d, 0x76, 0x5f, 0x73, 0xc5, 0x74, 0xd2, 0x75, 0xeb, 0xbe, 0xa4,
+	0xe9, 0x2f, 0x88, 0xbc, 0x26, 0x9f, 0xa1, 0xdf, 0x68, 0xa6, 0x4a, 0x4e, 0xdd, 0x53, 0x31, 0xee,
+	0xd9, 0xf4, 0x1f, 0x45, 0x08, 0x42, 0x4a, 0x0c, 0x71, 0xd3, 0x63, 0xec, 0xe2, 0xf4, 0x31, 0x80,
+	0xc8, 0x3d, 0x60, 0x59, 0x42, 0xa9, 0x7a, 0xee, 0x71, 0xf1, 0xeb, 0xf2, 0xa2, 0x09, 0xbc, 0xe1,
+	0x94, 0x09, 0xc3, 0xcd, 0xce, 0x7d, 0x26, 0xc6, 0xfb, 0x1c, 0xfd, 0x04, 0xa4, 0xf9, 0x5a, 0x30,
+	0x5a, 0x3a, 0x85, 0xcb, 0xd5, 0xce, 0x30, 0x9d, 0x84, 0x4e, 0xf4, 0xf7, 0x9e, 0x29, 0x2c, 0xb1,
+	0xb4, 0xf8, 0xbc, 0x80, 0xb8, 0x38, 0x58, 0x42, 0xa3, 0x05, 0x84, 0xf6, 0x70, 0x68, 0xec, 0x15,
+	0x38, 0xf6, 0xc4, 0xe4, 0xd3, 0x09, 0xea, 0x4f, 0xbb, 0xfc, 0x71, 0x99, 0x69, 0x23, 0xd5, 0x75,
+	0xce, 0xe5, 0xcc, 0x05, 0xb3, 0x96, 0xcd, 0xda, 0x71, 0xbd, 0x5a, 0xf5, 0x9c, 0xeb, 0x16, 0x4f,
+	0x03, 0x00, 0x80, 0x60, 0x8a, 0x1c, 0x8a, 0x02, 0x00, 0x00,
 }
@@ -37,6 +37,8 @@ type Config struct {
 	AuthorizationDB   authorization.DBConfig
 	AuthorizationAddr string `default:"127.0.0.1:9000" help:"address for authorization http proxy to listen on"`
 
+	AuthorizationService authorization.ServiceConfig
+
 	MinDifficulty uint `default:"36" help:"minimum difficulty of the requester's identity required to claim an authorization"`
 }
 
@@ -95,7 +97,7 @@ func New(log *zap.Logger, ident *identity.FullIdentity, ca *identity.FullCertifi
 		return nil, errs.Combine(err, peer.Close())
 	}
 
-	authorizationService := authorization.NewService(log, authorizationDB)
+	authorizationService := authorization.NewService(log, authorizationDB, config.AuthorizationService)
 	peer.Authorization.Endpoint = authorization.NewEndpoint(log.Named("authorization"), authorizationService, peer.Authorization.Listener)
 
 	return peer, nil
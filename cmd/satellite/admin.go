@@ -28,8 +28,10 @@ func cmdAdminRun(cmd *cobra.Command, args []string) (err error) {
 	}
 
 	db, err := satellitedb.Open(ctx, log.Named("db"), runCfg.Database, satellitedb.Options{
-		ApplicationName:   "satellite-admin",
-		APIKeysLRUOptions: runCfg.APIKeysLRUOptions(),
+		ApplicationName:    "satellite-admin",
+		APIKeysLRUOptions:  runCfg.APIKeysLRUOptions(),
+		SlowQueryThreshold: runCfg.DatabaseOptions.SlowQueryThreshold,
+		ChangeSinkURL:      runCfg.DatabaseOptions.ChangeSinkURL,
 	})
 	if err != nil {
 		return errs.New("Error starting master database on satellite api: %+v", err)
@@ -27,6 +27,7 @@ func cmdAPIRun(cmd *cobra.Command, args []string) (err error) {
 	log := zap.L()
 
 	runCfg.Debug.Address = *process.DebugAddrFlag
+	runCfg.Reload.ConfigFile = reloadConfigFile()
 
 	identity, err := runCfg.Identity.Load()
 	if err != nil {
@@ -38,6 +39,8 @@ func cmdAPIRun(cmd *cobra.Command, args []string) (err error) {
 		ApplicationName:      "satellite-api",
 		APIKeysLRUOptions:    runCfg.APIKeysLRUOptions(),
 		RevocationLRUOptions: runCfg.RevocationLRUOptions(),
+		SlowQueryThreshold:   runCfg.DatabaseOptions.SlowQueryThreshold,
+		ChangeSinkURL:        runCfg.DatabaseOptions.ChangeSinkURL,
 	})
 	if err != nil {
 		return errs.New("Error starting master database on satellite api: %+v", err)
@@ -127,6 +130,12 @@ func cmdAPIRun(cmd *cobra.Command, args []string) (err error) {
 		err = errs.Combine(err, rollupsWriteCache.CloseAndFlush(context2.WithoutCancellation(ctx)))
 	}()
 
+	if runCfg.Orders.RollupsSpillDir != "" {
+		if err := rollupsWriteCache.OpenSpill(ctx, runCfg.Orders.RollupsSpillDir); err != nil {
+			return errs.New("Error recovering rollups spill files: %w", err)
+		}
+	}
+
 	peer, err := satellite.NewAPI(log, identity, db, metabaseDB, revocationDB, accountingCache, rollupsWriteCache, &runCfg.Config, version.Build, process.AtomicLevel(cmd))
 	if err != nil {
 		return err
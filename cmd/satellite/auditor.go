@@ -29,7 +29,11 @@ func cmdAuditorRun(cmd *cobra.Command, args []string) (err error) {
 		return errs.New("Failed to load identity: %+v", err)
 	}
 
-	db, err := satellitedb.Open(ctx, log.Named("db"), runCfg.Database, satellitedb.Options{ApplicationName: "satellite-auditor"})
+	db, err := satellitedb.Open(ctx, log.Named("db"), runCfg.Database, satellitedb.Options{
+		ApplicationName:    "satellite-auditor",
+		SlowQueryThreshold: runCfg.DatabaseOptions.SlowQueryThreshold,
+		ChangeSinkURL:      runCfg.DatabaseOptions.ChangeSinkURL,
+	})
 	if err != nil {
 		return errs.New("Error starting master database: %+v", err)
 	}
@@ -69,6 +73,7 @@ func cmdAuditorRun(cmd *cobra.Command, args []string) (err error) {
 		db.NodeEvents(),
 		db.Reputation(),
 		db.Containment(),
+		db.NodeQuarantines(),
 		version.Build,
 		&runCfg.Config,
 		process.AtomicLevel(cmd),
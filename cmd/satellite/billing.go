@@ -15,6 +15,7 @@ import (
 	"storj.io/private/process"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/payments/stripe"
+	"storj.io/storj/satellite/payments/tax"
 	"storj.io/storj/satellite/satellitedb"
 )
 
@@ -63,7 +64,7 @@ func setupPayments(log *zap.Logger, db satellite.DB) (*stripe.Service, error) {
 		return nil, err
 	}
 
-	return stripe.NewService(
+	service, err := stripe.NewService(
 		log.Named("payments.stripe:service"),
 		stripeClient,
 		pc.StripeCoinPayments,
@@ -77,6 +78,19 @@ func setupPayments(log *zap.Logger, db satellite.DB) (*stripe.Service, error) {
 		priceOverrides,
 		pc.PackagePlans.Packages,
 		pc.BonusRate)
+	if err != nil {
+		return nil, err
+	}
+
+	if pc.Tax.Enabled {
+		taxes, err := tax.NewEUVAT(pc.Tax)
+		if err != nil {
+			return nil, err
+		}
+		service.SetTaxes(taxes, db.Console().BillingAddresses())
+	}
+
+	return service, nil
 }
 
 // parseYearMonth parses year and month from the provided string and returns a corresponding time.Time for the first day
@@ -20,21 +20,6 @@ import (
 )
 
 func generateInvoicesCSV(ctx context.Context, period compensation.Period, out io.Writer) (err error) {
-	periodInfo := compensation.PeriodInfo{
-		Period: period,
-		Rates: &compensation.Rates{
-			AtRestGBHours: generateInvoicesCfg.Compensation.Rates.AtRestGBHours,
-			GetTB:         generateInvoicesCfg.Compensation.Rates.GetTB,
-			PutTB:         generateInvoicesCfg.Compensation.Rates.PutTB,
-			GetRepairTB:   generateInvoicesCfg.Compensation.Rates.GetRepairTB,
-			PutRepairTB:   generateInvoicesCfg.Compensation.Rates.PutRepairTB,
-			GetAuditTB:    generateInvoicesCfg.Compensation.Rates.GetAuditTB,
-		},
-		SurgePercent:     generateInvoicesCfg.SurgePercent,
-		DisposePercent:   generateInvoicesCfg.Compensation.DisposePercent,
-		WithheldPercents: generateInvoicesCfg.Compensation.WithheldPercents,
-	}
-
 	db, err := satellitedb.Open(ctx, zap.L().Named("db"), generateInvoicesCfg.Database, satellitedb.Options{ApplicationName: "satellite-compensation"})
 	if err != nil {
 		return errs.New("error connecting to master database on satellite: %+v", err)
@@ -46,6 +31,30 @@ func generateInvoicesCSV(ctx context.Context, period compensation.Period, out io
 		return errs.New("Error checking version for satellitedb: %+v", err)
 	}
 
+	// the rates of the schedule in effect for the period take precedence over the
+	// configured ones, so the invoices of past periods can be generated again with
+	// corrected rates.
+	schedules, err := db.Compensation().ListRateSchedules(ctx)
+	if err != nil {
+		return err
+	}
+	rates := generateInvoicesCfg.Compensation.Rates.Rates()
+	if schedule, ok := compensation.ScheduleForPeriod(schedules, period); ok {
+		zap.L().Info("Using rate schedule.",
+			zap.Stringer("Period", schedule.Period), zap.Int("Version", schedule.Version))
+		rates = schedule.Rates
+	} else {
+		zap.L().Info("No rate schedule in effect, using configured rates.")
+	}
+
+	periodInfo := compensation.PeriodInfo{
+		Period:           period,
+		Rates:            &rates,
+		SurgePercent:     generateInvoicesCfg.SurgePercent,
+		DisposePercent:   generateInvoicesCfg.Compensation.DisposePercent,
+		WithheldPercents: generateInvoicesCfg.Compensation.WithheldPercents,
+	}
+
 	periodUsage, err := db.StoragenodeAccounting().QueryStorageNodePeriodUsage(ctx, period)
 	if err != nil {
 		return err
@@ -196,3 +205,58 @@ func recordOneOffPayments(ctx context.Context, paymentsCSV string) (int, error)
 
 	return len(payments), nil
 }
+
+func addRateSchedule(ctx context.Context, period compensation.Period) (_ compensation.RateSchedule, err error) {
+	db, err := satellitedb.Open(ctx, zap.L().Named("db"), addRateScheduleCfg.Database, satellitedb.Options{ApplicationName: "satellite-compensation"})
+	if err != nil {
+		return compensation.RateSchedule{}, errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() { err = errs.Combine(err, db.Close()) }()
+
+	if err := db.CheckVersion(ctx); err != nil {
+		zap.L().Fatal("Failed satellite database version check.", zap.Error(err))
+		return compensation.RateSchedule{}, errs.New("Error checking version for satellitedb: %+v", err)
+	}
+
+	return db.Compensation().CreateRateSchedule(ctx, period, addRateScheduleCfg.Rates.Rates(), addRateScheduleCfg.Note)
+}
+
+func listRateSchedules(ctx context.Context, out io.Writer) (err error) {
+	db, err := satellitedb.Open(ctx, zap.L().Named("db"), listRateSchedulesCfg.Database, satellitedb.Options{ApplicationName: "satellite-compensation"})
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() { err = errs.Combine(err, db.Close()) }()
+
+	if err := db.CheckVersion(ctx); err != nil {
+		zap.L().Fatal("Failed satellite database version check.", zap.Error(err))
+		return errs.New("Error checking version for satellitedb: %+v", err)
+	}
+
+	schedules, err := db.Compensation().ListRateSchedules(ctx)
+	if err != nil {
+		return err
+	}
+
+	return compensation.WriteRateSchedules(out, schedules)
+}
+
+func listHeldReleases(ctx context.Context, period compensation.Period, out io.Writer) (err error) {
+	db, err := satellitedb.Open(ctx, zap.L().Named("db"), listHeldReleasesCfg.Database, satellitedb.Options{ApplicationName: "satellite-compensation"})
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() { err = errs.Combine(err, db.Close()) }()
+
+	if err := db.CheckVersion(ctx); err != nil {
+		zap.L().Fatal("Failed satellite database version check.", zap.Error(err))
+		return errs.New("Error checking version for satellitedb: %+v", err)
+	}
+
+	releases, err := db.Compensation().ListHeldReleases(ctx, period)
+	if err != nil {
+		return err
+	}
+
+	return compensation.WriteHeldReleases(out, releases)
+}
@@ -21,8 +21,13 @@ func cmdGCBloomFilterRun(cmd *cobra.Command, args []string) (err error) {
 	log := zap.L()
 
 	runCfg.Debug.Address = *process.DebugAddrFlag
+	runCfg.Reload.ConfigFile = reloadConfigFile()
 
-	db, err := satellitedb.Open(ctx, log.Named("db"), runCfg.Database, satellitedb.Options{ApplicationName: "satellite-gc-bloomfilter"})
+	db, err := satellitedb.Open(ctx, log.Named("db"), runCfg.Database, satellitedb.Options{
+		ApplicationName:    "satellite-gc-bloomfilter",
+		SlowQueryThreshold: runCfg.DatabaseOptions.SlowQueryThreshold,
+		ChangeSinkURL:      runCfg.DatabaseOptions.ChangeSinkURL,
+	})
 	if err != nil {
 		return errs.New("Error starting master database on satellite GC: %+v", err)
 	}
@@ -28,7 +28,11 @@ func cmdGCRun(cmd *cobra.Command, args []string) (err error) {
 		return errs.New("Failed to load identity: %+v", err)
 	}
 
-	db, err := satellitedb.Open(ctx, log.Named("db"), runCfg.Database, satellitedb.Options{ApplicationName: "satellite-gc"})
+	db, err := satellitedb.Open(ctx, log.Named("db"), runCfg.Database, satellitedb.Options{
+		ApplicationName:    "satellite-gc",
+		SlowQueryThreshold: runCfg.DatabaseOptions.SlowQueryThreshold,
+		ChangeSinkURL:      runCfg.DatabaseOptions.ChangeSinkURL,
+	})
 	if err != nil {
 		return errs.New("Error starting master database on satellite GC: %+v", err)
 	}
@@ -58,7 +58,9 @@ type Satellite struct {
 			Expiration time.Duration `help:"macaroon revocation cache expiration" default:"5m"`
 			Capacity   int           `help:"macaroon revocation cache capacity" default:"10000"`
 		}
-		MigrationUnsafe string `help:"comma separated migration types to run during every startup (none: no migration, snapshot: creating db from latest test snapshot (for testing only), testdata: create testuser in addition to a migration, full: do the normal migration (equals to 'satellite run migration'" default:"none" hidden:"true"`
+		SlowQueryThreshold time.Duration `help:"duration after which satellite database queries are logged as slow, zero disables the logging" default:"0s"`
+		ChangeSinkURL      string        `help:"url of the webhook, which receives the change events of the satellite database tables, empty disables it" default:""`
+		MigrationUnsafe    string        `help:"comma separated migration types to run during every startup (none: no migration, snapshot: creating db from latest test snapshot (for testing only), testdata: create testuser in addition to a migration, full: do the normal migration (equals to 'satellite run migration'" default:"none" hidden:"true"`
 	}
 
 	satellite.Config
@@ -198,6 +200,27 @@ var (
 		Args:  cobra.ExactArgs(1),
 		RunE:  cmdRecordOneOffPayments,
 	}
+	addRateScheduleCmd = &cobra.Command{
+		Use:   "add-rate-schedule [period]",
+		Short: "Add storage node payment rate schedule",
+		Long:  "Add the storage node payment rates in effect from a pay period. Period is a UTC date formatted like YYYY-MM. Adding a schedule for a period, which already has one, records a correction of its rates.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdAddRateSchedule,
+	}
+	listRateSchedulesCmd = &cobra.Command{
+		Use:   "list-rate-schedules",
+		Short: "List storage node payment rate schedules",
+		Long:  "List all versions of the storage node payment rate schedules in CSV form",
+		Args:  cobra.NoArgs,
+		RunE:  cmdListRateSchedules,
+	}
+	listHeldReleasesCmd = &cobra.Command{
+		Use:   "list-held-releases [period]",
+		Short: "List storage node held amount releases",
+		Long:  "List the scheduled releases of the held amounts of the storage nodes leaving withholding in a pay period in CSV form. Period is a UTC date formatted like YYYY-MM.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdListHeldReleases,
+	}
 	billingCmd = &cobra.Command{
 		Use:   "billing",
 		Short: "Customer billing commands",
@@ -272,6 +295,13 @@ var (
 		Long:  "Cleanup Graceful Exit data which is lingering in the transfer queue DB table on nodes which has finished the exit.",
 		RunE:  cmdConsistencyGECleanup,
 	}
+	consistencyRepairRollupsCmd = &cobra.Command{
+		Use:   "repair-rollups",
+		Short: "Repair interrupted rollups",
+		Long: "Recompute the periods of the rollup and the rollup archive runs, which were interrupted by a crash. " +
+			"Every interrupted run is repaired exactly once.",
+		RunE: cmdConsistencyRepairRollups,
+	}
 	restoreTrashCmd = &cobra.Command{
 		Use:   "restore-trash [node-id-1 node-id-2 node-id-3 ...]",
 		Short: "Restore trash",
@@ -327,6 +357,19 @@ var (
 	recordOneOffPaymentsCfg struct {
 		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
 	}
+	addRateScheduleCfg struct {
+		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
+		Rates    compensation.RatesConfig
+		Note     string `help:"reason for the rate schedule or the correction" default:""`
+	}
+	listRateSchedulesCfg struct {
+		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
+		Output   string `help:"destination of report output" default:""`
+	}
+	listHeldReleasesCfg struct {
+		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
+		Output   string `help:"destination of report output" default:""`
+	}
 	partnerAttribtionCfg struct {
 		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
 		Output   string `help:"destination of report output" default:""`
@@ -338,6 +381,10 @@ var (
 	}
 	reportsVerifyGracefulExitReceiptCfg struct {
 	}
+	migrationDryRunCfg struct {
+		Enabled bool
+		Format  string
+	}
 	consistencyGECleanupCfg struct {
 		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
 		Before   string `help:"select only exited nodes before this UTC date formatted like YYYY-MM. Date cannot be newer than the current time (required)"`
@@ -380,6 +427,9 @@ func init() {
 	compensationCmd.AddCommand(generateInvoicesCmd)
 	compensationCmd.AddCommand(recordPeriodCmd)
 	compensationCmd.AddCommand(recordOneOffPaymentsCmd)
+	compensationCmd.AddCommand(addRateScheduleCmd)
+	compensationCmd.AddCommand(listRateSchedulesCmd)
+	compensationCmd.AddCommand(listHeldReleasesCmd)
 	billingCmd.AddCommand(applyFreeTierCouponsCmd)
 	billingCmd.AddCommand(createCustomerBalanceInvoiceItems)
 	billingCmd.AddCommand(prepareCustomerInvoiceRecordsCmd)
@@ -390,6 +440,9 @@ func init() {
 	billingCmd.AddCommand(payCustomerInvoicesCmd)
 	billingCmd.AddCommand(stripeCustomerCmd)
 	consistencyCmd.AddCommand(consistencyGECleanupCmd)
+	consistencyCmd.AddCommand(consistencyRepairRollupsCmd)
+	runMigrationCmd.Flags().BoolVar(&migrationDryRunCfg.Enabled, "dry-run", false, "print the pending migration steps and the schema drift instead of migrating; fails when the schema drifted")
+	runMigrationCmd.Flags().StringVar(&migrationDryRunCfg.Format, "dry-run-format", "text", "output format of the dry run (text, json)")
 	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(runMigrationCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(runAPICmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
@@ -409,6 +462,9 @@ func init() {
 	process.Bind(generateInvoicesCmd, &generateInvoicesCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(recordPeriodCmd, &recordPeriodCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(recordOneOffPaymentsCmd, &recordOneOffPaymentsCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(addRateScheduleCmd, &addRateScheduleCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(listRateSchedulesCmd, &listRateSchedulesCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(listHeldReleasesCmd, &listHeldReleasesCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(reportsGracefulExitCmd, &reportsGracefulExitCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(reportsVerifyGEReceiptCmd, &reportsVerifyGracefulExitReceiptCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(partnerAttributionCmd, &partnerAttribtionCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
@@ -422,6 +478,7 @@ func init() {
 	process.Bind(payCustomerInvoicesCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(stripeCustomerCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(consistencyGECleanupCmd, &consistencyGECleanupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(consistencyRepairRollupsCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(fixLastNetsCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 
 	if err := consistencyGECleanupCmd.MarkFlagRequired("before"); err != nil {
@@ -429,6 +486,14 @@ func init() {
 	}
 }
 
+// reloadConfigFile returns the configuration file, which is reread on SIGHUP.
+func reloadConfigFile() string {
+	if runCfg.Reload.ConfigFile != "" {
+		return runCfg.Reload.ConfigFile
+	}
+	return filepath.Join(confDir, process.DefaultCfgFilename)
+}
+
 func cmdRun(cmd *cobra.Command, args []string) (err error) {
 	// inert constructors only ====
 
@@ -447,6 +512,8 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		ApplicationName:     "satellite-core",
 		SaveRollupBatchSize: runCfg.Tally.SaveRollupBatchSize,
 		ReadRollupBatchSize: runCfg.Tally.ReadRollupBatchSize,
+		SlowQueryThreshold:  runCfg.DatabaseOptions.SlowQueryThreshold,
+		ChangeSinkURL:       runCfg.DatabaseOptions.ChangeSinkURL,
 	})
 	if err != nil {
 		return errs.New("Error starting master database on satellite: %+v", err)
@@ -522,6 +589,10 @@ func cmdMigrationRun(cmd *cobra.Command, args []string) (err error) {
 	ctx, _ := process.Ctx(cmd)
 	log := zap.L()
 
+	if migrationDryRunCfg.Enabled {
+		return cmdMigrationDryRun(ctx, log, os.Stdout)
+	}
+
 	db, err := satellitedb.Open(ctx, log.Named("migration"), runCfg.Database, satellitedb.Options{ApplicationName: "satellite-migration"})
 	if err != nil {
 		return errs.New("Error creating new master database connection for satellitedb migration: %+v", err)
@@ -716,6 +787,43 @@ func cmdRecordOneOffPayments(cmd *cobra.Command, args []string) (err error) {
 	return nil
 }
 
+func cmdAddRateSchedule(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	period, err := compensation.PeriodFromString(args[0])
+	if err != nil {
+		return err
+	}
+
+	schedule, err := addRateSchedule(ctx, period)
+	if err != nil {
+		return err
+	}
+	fmt.Println("rate schedule", schedule.Period, "version", schedule.Version, "recorded")
+	return nil
+}
+
+func cmdListRateSchedules(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	return runWithOutput(listRateSchedulesCfg.Output, func(out io.Writer) error {
+		return listRateSchedules(ctx, out)
+	})
+}
+
+func cmdListHeldReleases(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	period, err := compensation.PeriodFromString(args[0])
+	if err != nil {
+		return err
+	}
+
+	return runWithOutput(listHeldReleasesCfg.Output, func(out io.Writer) error {
+		return listHeldReleases(ctx, period, out)
+	})
+}
+
 func cmdValueAttribution(cmd *cobra.Command, args []string) (err error) {
 	ctx, _ := process.Ctx(cmd)
 	log := zap.L().Named("satellite-cli")
@@ -0,0 +1,168 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/private/migrate"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+// migrationDryRun is the report of the migration dry run.
+type migrationDryRun struct {
+	Databases []migrationDryRunDatabase `json:"databases"`
+}
+
+// migrationDryRunDatabase is the report of a single database.
+type migrationDryRunDatabase struct {
+	Database     string                     `json:"database"`
+	Pending      []migrationDryRunStep      `json:"pending"`
+	DriftChecked bool                       `json:"driftChecked"`
+	Drift        []migrate.SchemaDifference `json:"drift"`
+}
+
+// migrationDryRunStep is a pending migration step. SQL is empty for steps, which run code.
+type migrationDryRunStep struct {
+	Version     int      `json:"version"`
+	Description string   `json:"description"`
+	SQL         []string `json:"sql,omitempty"`
+	Code        bool     `json:"code,omitempty"`
+}
+
+// driftDetected returns the number of differences found in all databases.
+func (report migrationDryRun) driftDetected() (count int) {
+	for _, database := range report.Databases {
+		count += len(database.Drift)
+	}
+	return count
+}
+
+func cmdMigrationDryRun(ctx context.Context, log *zap.Logger, w io.Writer) (err error) {
+	format := migrationDryRunCfg.Format
+	if format != "text" && format != "json" {
+		return errs.New("unknown dry run format %q", format)
+	}
+
+	db, err := satellitedb.Open(ctx, log.Named("migration"), runCfg.Database, satellitedb.Options{ApplicationName: "satellite-migration"})
+	if err != nil {
+		return errs.New("Error creating new master database connection for satellitedb migration: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	reports, err := db.DryRunMigration(ctx)
+	if err != nil {
+		return errs.New("Error checking satellitedb migration: %+v", err)
+	}
+
+	var report migrationDryRun
+	for _, r := range reports {
+		report.Databases = append(report.Databases, newMigrationDryRunDatabase(r))
+	}
+
+	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), runCfg.Metainfo.DatabaseURL,
+		runCfg.Config.Metainfo.Metabase("satellite-migration"))
+	if err != nil {
+		return errs.New("Error creating metabase connection: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, metabaseDB.Close())
+	}()
+
+	pending, err := metabaseDB.PostgresMigration().PendingSteps(ctx)
+	if err != nil {
+		return errs.New("Error checking metabase migration: %+v", err)
+	}
+	report.Databases = append(report.Databases, newMigrationDryRunDatabase(satellite.MigrationReport{
+		Database: "metabase",
+		Pending:  pending,
+	}))
+
+	switch format {
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(report)
+	default:
+		err = writeMigrationDryRun(w, report)
+	}
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	if count := report.driftDetected(); count > 0 {
+		return errs.New("schema drift detected: %d differences", count)
+	}
+	return nil
+}
+
+func newMigrationDryRunDatabase(report satellite.MigrationReport) migrationDryRunDatabase {
+	database := migrationDryRunDatabase{
+		Database:     report.Database,
+		Pending:      []migrationDryRunStep{},
+		DriftChecked: report.DriftChecked,
+		Drift:        report.Drift,
+	}
+	if database.Drift == nil {
+		database.Drift = []migrate.SchemaDifference{}
+	}
+
+	for _, step := range report.Pending {
+		statements, ok := step.Statements()
+		database.Pending = append(database.Pending, migrationDryRunStep{
+			Version:     step.Version,
+			Description: step.Description,
+			SQL:         statements,
+			Code:        !ok,
+		})
+	}
+	return database
+}
+
+// writeMigrationDryRun writes the report as commented SQL script.
+func writeMigrationDryRun(w io.Writer, report migrationDryRun) error {
+	var b strings.Builder
+	for _, database := range report.Databases {
+		fmt.Fprintf(&b, "-- %s: %d pending migration steps\n", database.Database, len(database.Pending))
+
+		for _, step := range database.Pending {
+			fmt.Fprintf(&b, "\n-- v%d: %s\n", step.Version, step.Description)
+			if step.Code {
+				b.WriteString("-- the step runs code, its statements are not known in advance\n")
+				continue
+			}
+			for _, statement := range step.SQL {
+				b.WriteString(strings.TrimSuffix(strings.TrimSpace(statement), ";"))
+				b.WriteString(";\n")
+			}
+		}
+
+		switch {
+		case !database.DriftChecked:
+			fmt.Fprintf(&b, "\n-- %s: schema drift not checked\n", database.Database)
+		case len(database.Drift) == 0:
+			fmt.Fprintf(&b, "\n-- %s: no schema drift\n", database.Database)
+		default:
+			fmt.Fprintf(&b, "\n-- %s: schema drift detected\n", database.Database)
+			for _, diff := range database.Drift {
+				fmt.Fprintf(&b, "--   %s\n", diff)
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/private/tagsql"
+	"storj.io/storj/private/migrate"
+	"storj.io/storj/satellite"
+)
+
+func TestWriteMigrationDryRun(t *testing.T) {
+	report := migrationDryRun{
+		Databases: []migrationDryRunDatabase{
+			newMigrationDryRunDatabase(satellite.MigrationReport{
+				Database: "satellitedb",
+				Pending: []*migrate.Step{
+					{
+						Version:     2,
+						Description: "add column",
+						Action:      migrate.SQL{`ALTER TABLE users ADD COLUMN name text;`},
+					},
+					{
+						Version:     3,
+						Description: "fill column",
+						Action: migrate.Func(func(ctx context.Context, log *zap.Logger, db tagsql.DB, tx tagsql.Tx) error {
+							return nil
+						}),
+					},
+				},
+			}),
+			newMigrationDryRunDatabase(satellite.MigrationReport{
+				Database:     "satellitedb:repairqueue",
+				DriftChecked: true,
+				Drift: []migrate.SchemaDifference{
+					{Kind: migrate.DriftUnexpectedColumn, Table: "repair_queue", Name: "extra"},
+				},
+			}),
+		},
+	}
+	require.Equal(t, 1, report.driftDetected())
+
+	var b strings.Builder
+	require.NoError(t, writeMigrationDryRun(&b, report))
+	require.Equal(t, `-- satellitedb: 2 pending migration steps
+
+-- v2: add column
+ALTER TABLE users ADD COLUMN name text;
+
+-- v3: fill column
+-- the step runs code, its statements are not known in advance
+
+-- satellitedb: schema drift not checked
+
+-- satellitedb:repairqueue: 0 pending migration steps
+
+-- satellitedb:repairqueue: schema drift detected
+--   unexpected_column repair_queue extra
+
+`, b.String())
+}
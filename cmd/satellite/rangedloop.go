@@ -19,8 +19,13 @@ func cmdRangedLoopRun(cmd *cobra.Command, args []string) (err error) {
 	log := zap.L()
 
 	runCfg.Debug.Address = *process.DebugAddrFlag
+	runCfg.Reload.ConfigFile = reloadConfigFile()
 
-	db, err := satellitedb.Open(ctx, log.Named("db"), runCfg.Database, satellitedb.Options{ApplicationName: "satellite-rangedloop"})
+	db, err := satellitedb.Open(ctx, log.Named("db"), runCfg.Database, satellitedb.Options{
+		ApplicationName:    "satellite-rangedloop",
+		SlowQueryThreshold: runCfg.DatabaseOptions.SlowQueryThreshold,
+		ChangeSinkURL:      runCfg.DatabaseOptions.ChangeSinkURL,
+	})
 	if err != nil {
 		return errs.New("Error starting master database on satellite rangedloop: %+v", err)
 	}
@@ -0,0 +1,51 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/private/process"
+	"storj.io/storj/satellite/accounting/rollup"
+	"storj.io/storj/satellite/accounting/rolluparchive"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+func cmdConsistencyRepairRollups(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	db, err := satellitedb.Open(ctx, log.Named("db"), runCfg.Database, satellitedb.Options{
+		ApplicationName:     "satellite-repair-rollups",
+		SaveRollupBatchSize: runCfg.Tally.SaveRollupBatchSize,
+		ReadRollupBatchSize: runCfg.Tally.ReadRollupBatchSize,
+	})
+	if err != nil {
+		return errs.New("error opening master database: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	// keep the same order expiration as the core uses for the rollup.
+	orderExpirationPlusDay := runCfg.Orders.Expiration + runCfg.Rollup.Interval
+	rollupService := rollup.New(log.Named("rollup"), db.StoragenodeAccounting(), runCfg.Rollup, orderExpirationPlusDay)
+
+	repaired, err := rollupService.Repair(ctx)
+	if err != nil {
+		return err
+	}
+	log.Info("repaired rollup runs", zap.Int("runs", repaired))
+
+	archiveChore := rolluparchive.New(log.Named("rollup-archive"), db.StoragenodeAccounting(), db.ProjectAccounting(), runCfg.RollupArchive)
+	repaired, err = archiveChore.Repair(ctx, runCfg.RollupArchive.BatchSize)
+	if err != nil {
+		return err
+	}
+	log.Info("repaired rollup archive runs", zap.Int("runs", repaired))
+
+	return nil
+}
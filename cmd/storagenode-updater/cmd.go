@@ -60,8 +60,9 @@ var (
 	}
 
 	runCfg struct {
-		Identity identity.Config
-		Version  checker.Config
+		Identity    identity.Config
+		Version     checker.Config
+		HealthCheck HealthCheckConfig
 
 		BinaryLocation string `help:"the storage node executable binary location" default:"storagenode"`
 		ServiceName    string `help:"storage node OS service name" default:"storagenode"`
@@ -0,0 +1,173 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/private/version"
+	"storj.io/storj/private/version/checker"
+)
+
+// healthCheckErr is the error class of the failed health checks.
+var healthCheckErr = errs.Class("health check")
+
+// dialTimeout is the timeout of the connections made by the health checks.
+const dialTimeout = 10 * time.Second
+
+// HealthCheckConfig contains the configuration of the health checks after an update.
+type HealthCheckConfig struct {
+	Enabled          bool          `help:"check the health of the storage node after an update and roll back to the previous binary, when the checks fail" default:"true"`
+	ServerAddress    string        `help:"address of the storage node server, which must accept connections after an update" default:"127.0.0.1:28967"`
+	DashboardAddress string        `help:"address of the storage node dashboard, which must respond after an update" default:"127.0.0.1:14002"`
+	Window           time.Duration `help:"how long the updated storage node has to pass the health checks" default:"5m0s"`
+	Interval         time.Duration `help:"how often the health checks are retried within the window" default:"10s"`
+}
+
+// dashboard contains the fields of the storage node dashboard used by the health checks.
+type dashboard struct {
+	Version    version.SemVer `json:"version"`
+	Satellites []struct {
+		URL          string     `json:"url"`
+		Disqualified *time.Time `json:"disqualified"`
+	} `json:"satellites"`
+}
+
+// waitHealthy waits until the storage node running the expected version passes the
+// health checks. It returns the error of the last check, when the checks don't pass
+// within the window.
+func waitHealthy(ctx context.Context, config HealthCheckConfig, expected version.SemVer) error {
+	deadline := time.Now().Add(config.Window)
+	for {
+		err := checkHealth(ctx, config, expected)
+		if err == nil {
+			return nil
+		}
+		if !time.Now().Add(config.Interval).Before(deadline) {
+			return err
+		}
+
+		zap.L().Debug("Health check failed, retrying.", zap.Error(err))
+		if !sync2.Sleep(ctx, config.Interval) {
+			return ctx.Err()
+		}
+	}
+}
+
+// checkHealth checks that the storage node serves, that its dashboard responds with the
+// expected version and that the satellites are contactable.
+func checkHealth(ctx context.Context, config HealthCheckConfig, expected version.SemVer) error {
+	dialer := net.Dialer{Timeout: dialTimeout}
+
+	conn, err := dialer.DialContext(ctx, "tcp", config.ServerAddress)
+	if err != nil {
+		return healthCheckErr.New("storage node is not serving: %v", err)
+	}
+	_ = conn.Close()
+
+	data, err := getDashboard(ctx, config.DashboardAddress)
+	if err != nil {
+		return healthCheckErr.New("dashboard is not responding: %v", err)
+	}
+	if data.Version.Compare(expected) != 0 {
+		return healthCheckErr.New("dashboard reports version %s instead of %s", data.Version.String(), expected.String())
+	}
+
+	// a single contactable satellite is enough, so an outage of a satellite doesn't
+	// roll back the update.
+	var contactErrs errs.Group
+	for _, satellite := range data.Satellites {
+		if satellite.Disqualified != nil {
+			continue
+		}
+		nodeURL, err := storj.ParseNodeURL(satellite.URL)
+		if err != nil {
+			contactErrs.Add(err)
+			continue
+		}
+		conn, err := dialer.DialContext(ctx, "tcp", nodeURL.Address)
+		if err != nil {
+			contactErrs.Add(err)
+			continue
+		}
+		_ = conn.Close()
+		return nil
+	}
+	if err := contactErrs.Err(); err != nil {
+		return healthCheckErr.New("satellites are not contactable: %v", err)
+	}
+	return nil
+}
+
+// getDashboard returns the dashboard of the storage node.
+func getDashboard(ctx context.Context, address string) (_ *dashboard, err error) {
+	httpClient := http.Client{Timeout: dialTimeout}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+address+"/api/sno/", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, resp.Body.Close()) }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errs.New("non-success http status code: %d", resp.StatusCode)
+	}
+
+	var data dashboard
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// rollback restores the backup binary of the service, which replaced the binary failing
+// the health checks, and reports the rollback to the version control server. The failed
+// binary is kept, so the failed version isn't installed again.
+func rollback(ctx context.Context, serviceName, binaryLocation, backupPath string, failed, restored version.SemVer, reason error) error {
+	failedPath := failedBinaryPath(binaryLocation, failed)
+
+	zap.L().Warn("Rolling back the update.",
+		zap.String("Service", serviceName),
+		zap.String("Failed Version", failed.String()),
+		zap.String("Restored Version", restored.String()),
+		zap.Error(reason),
+	)
+
+	if err := restartService(ctx, serviceName, binaryLocation, backupPath, failedPath); err != nil {
+		return errs.Wrap(err)
+	}
+
+	report := checker.RollbackReport{
+		NodeID:   nodeID,
+		Failed:   failed.String(),
+		Restored: restored.String(),
+		Reason:   reason.Error(),
+	}
+	if err := checker.New(runCfg.Version.ClientConfig).ReportRollback(ctx, serviceName, report); err != nil {
+		zap.L().Warn("Unable to report the rollback.", zap.String("Service", serviceName), zap.Error(err))
+	}
+
+	zap.L().Info("Service rolled back successfully.", zap.String("Service", serviceName))
+	return nil
+}
+
+// failedBinaryPath returns the path of the binary of the version, which failed the
+// health checks.
+func failedBinaryPath(binaryLocation string, failed version.SemVer) string {
+	return prependExtension(binaryLocation, "failed."+failed.String())
+}
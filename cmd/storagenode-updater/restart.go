@@ -14,6 +14,10 @@ import (
 	"github.com/zeebo/errs"
 )
 
+// restartsService is whether restartService restarts the service. It only replaces
+// the binary here, so there is no restarted service to health check.
+const restartsService = false
+
 func cmdRestart(cmd *cobra.Command, args []string) error {
 	return nil
 }
@@ -17,6 +17,10 @@ import (
 	"github.com/zeebo/errs"
 )
 
+// restartsService is whether restartService restarts the service, so the restarted
+// service can be health checked.
+const restartsService = true
+
 func cmdRestart(cmd *cobra.Command, args []string) error {
 	return nil
 }
@@ -21,6 +21,10 @@ import (
 	"storj.io/private/process"
 )
 
+// restartsService is whether restartService restarts the service, so the restarted
+// service can be health checked.
+const restartsService = true
+
 var unrecoverableErr = errs.Class("unable to recover binary from backup")
 
 func cmdRestart(cmd *cobra.Command, args []string) (err error) {
@@ -34,6 +34,19 @@ func update(ctx context.Context, serviceName, binaryLocation string, ver version
 		return nil
 	}
 
+	newSemVer, err := newVersion.SemVer()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	if _, err := os.Stat(failedBinaryPath(binaryLocation, newSemVer)); err == nil {
+		zap.L().Info("Version failed the health checks before, skipping update.",
+			zap.String("Service", serviceName),
+			zap.String("Version", newSemVer.String()),
+		)
+		return nil
+	}
+
 	newVersionPath := prependExtension(binaryLocation, newVersion.Version)
 
 	if err = downloadBinary(ctx, parseDownloadURL(newVersion.URL), newVersionPath); err != nil {
@@ -45,11 +58,6 @@ func update(ctx context.Context, serviceName, binaryLocation string, ver version
 		return errs.Combine(errs.Wrap(err), os.Remove(newVersionPath))
 	}
 
-	newSemVer, err := newVersion.SemVer()
-	if err != nil {
-		return errs.Combine(err, os.Remove(newVersionPath))
-	}
-
 	if newSemVer.Compare(downloadedVersion) != 0 {
 		err := errs.New("invalid version downloaded: wants %s got %s", newVersion.Version, downloadedVersion)
 		return errs.Combine(err, os.Remove(newVersionPath))
@@ -70,5 +78,17 @@ func update(ctx context.Context, serviceName, binaryLocation string, ver version
 	}
 
 	zap.L().Info("Service restarted successfully.", zap.String("Service", serviceName))
+
+	if !restartsService || serviceName == updaterServiceName || !runCfg.HealthCheck.Enabled {
+		return nil
+	}
+
+	zap.L().Info("Checking the health of the service.", zap.String("Service", serviceName))
+
+	if err := waitHealthy(ctx, runCfg.HealthCheck, newSemVer); err != nil {
+		return rollback(ctx, serviceName, binaryLocation, backupPath, newSemVer, currentVersion, err)
+	}
+
+	zap.L().Info("Service passed the health checks.", zap.String("Service", serviceName))
 	return nil
 }
@@ -0,0 +1,110 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/private/cfgstruct"
+	"storj.io/private/process"
+	"storj.io/private/version"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/diagnostics"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+type diagnosticsCfg struct {
+	storagenode.Config
+
+	Output string `help:"path of the diagnostics bundle, a file in the current directory when empty" default:""`
+}
+
+func newDiagnosticsCmd(f *Factory) *cobra.Command {
+	var cfg diagnosticsCfg
+
+	cmd := &cobra.Command{
+		Use:   "diagnostics",
+		Short: "Collect the diagnostics bundle of the storage node",
+		Long: `Collect the diagnostics bundle of the storage node.
+
+The bundle is a zip archive with the configuration, with the secrets redacted, the recent
+logs, the results of the database integrity checks, the disk usage and the reputation of
+the node, which can be shared when asking for support.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmdDiagnostics(cmd, &cfg)
+		},
+		Example: `
+#=> collect the bundle to a file in the current directory
+$ storagenode diagnostics --config-dir '<path/to/config-dir>' --identity-dir '<path/to/identity-dir>'
+
+#=> collect the bundle to the file
+$ storagenode diagnostics --output '<path/to/bundle.zip>' --config-dir '<path/to/config-dir>' --identity-dir '<path/to/identity-dir>'
+`,
+		Args:        cobra.ExactArgs(0),
+		Annotations: map[string]string{"type": "helper"},
+	}
+
+	process.Bind(cmd, &cfg, f.Defaults, cfgstruct.ConfDir(f.ConfDir), cfgstruct.IdentityDir(f.IdentityDir))
+
+	return cmd
+}
+
+func cmdDiagnostics(cmd *cobra.Command, cfg *diagnosticsCfg) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	identity, err := cfg.Identity.Load()
+	if err != nil {
+		return errs.New("failed to load identity: %v", err)
+	}
+
+	db, err := storagenodedb.OpenExisting(ctx, log.Named("db"), cfg.DatabaseConfig())
+	if err != nil {
+		return errs.New("error starting master database on storage node: %v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	service := diagnostics.NewService(log.Named("diagnostics"), cfg.Diagnostics, identity.ID, version.Build, db)
+	service.SetSettings(diagnostics.Settings(cmd.Flags()))
+	service.SetLogPath(logOutputPath(cmd))
+
+	output := cfg.Output
+	if output == "" {
+		output = fmt.Sprintf("storagenode-diagnostics-%s.zip", time.Now().UTC().Format("20060102-150405"))
+	}
+
+	file, err := os.Create(output)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, file.Close())
+	}()
+
+	if err := service.Write(ctx, file); err != nil {
+		return err
+	}
+
+	fmt.Println("Diagnostics bundle written to", output)
+	return nil
+}
+
+// logOutputPath returns the log file of the node, or an empty string when the log is not
+// written to a file.
+func logOutputPath(cmd *cobra.Command) string {
+	flag := cmd.Flag("log.output")
+	if flag == nil {
+		return ""
+	}
+	return diagnostics.LogPath(flag.Value.String())
+}
@@ -0,0 +1,157 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/sync2"
+	"storj.io/private/cfgstruct"
+	"storj.io/private/process"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/blobstore/packstore"
+	"storj.io/storj/storagenode/blobstore/s3store"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagemigration"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+// migrateCheckpointsDir is the directory in the storage directory, where the progress of
+// the migrations is saved.
+const migrateCheckpointsDir = "storage-migration-checkpoints"
+
+// migrateStorageTarget is the blob store, to which the pieces are migrated.
+type migrateStorageTarget struct {
+	Path      string `help:"the storage directory to copy the pieces to, defaults to the storage directory of the node" default:""`
+	S3        s3store.Config
+	Packfiles packstore.Config
+}
+
+type migrateStorageCfg struct {
+	storagenode.Config
+
+	Target  migrateStorageTarget
+	Migrate storagemigration.Config
+
+	Cutover     bool          `help:"stop the running node from accepting uploads before copying, so that the target has all the pieces after this pass" default:"false"`
+	CutoverWait time.Duration `help:"how long to wait for the running node to stop accepting uploads, longer than monitor.verify-dir-readable-interval" default:"2m"`
+}
+
+func newMigrateStorageCmd(f *Factory) *cobra.Command {
+	var cfg migrateStorageCfg
+	cmd := &cobra.Command{
+		Use:   "migrate-storage",
+		Short: "Copy the pieces to another storage directory or blob store, e.g. to pack files or to an object storage. The node may keep running until the cutover.",
+		Long: "Copy the pieces to another storage directory or blob store. The node may keep running, " +
+			"and the pieces uploaded meanwhile are copied by the next run, which skips the already copied pieces. " +
+			"An interrupted run resumes where it stopped.\n\n" +
+			"Run it with --cutover to make the running node stop accepting uploads and copy the remaining pieces. " +
+			"Then restart the node with the target storage configuration.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmdMigrateStorage(cmd, &cfg)
+		},
+		Annotations: map[string]string{"type": "helper"},
+		Args:        cobra.ExactArgs(0),
+	}
+	process.Bind(cmd, &cfg, f.Defaults, cfgstruct.ConfDir(f.ConfDir), cfgstruct.IdentityDir(f.IdentityDir))
+
+	return cmd
+}
+
+func cmdMigrateStorage(cmd *cobra.Command, cfg *migrateStorageCfg) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	identity, err := cfg.Identity.Load()
+	if err != nil {
+		return errs.New("Error loading identity: %v", err)
+	}
+
+	fromConfig := cfg.DatabaseConfig()
+	toConfig := fromConfig
+	toConfig.AdditionalPieces = nil
+	if cfg.Target.Path != "" {
+		toConfig.Pieces = cfg.Target.Path
+	}
+	toConfig.S3 = cfg.Target.S3
+	toConfig.Packfiles = cfg.Target.Packfiles
+	// the write-ahead log belongs to the running node, which places its pieces into the storage
+	// directory within the placement interval, well before the end of the cutover wait.
+	fromConfig.PieceWAL.Enabled = false
+	toConfig.PieceWAL.Enabled = false
+
+	fromBackend, toBackend := storagemigration.Backend(fromConfig), storagemigration.Backend(toConfig)
+	if fromBackend == toBackend {
+		return errs.New("the target storage %q is the same as the storage of the node", toBackend)
+	}
+
+	from, err := storagenodedb.OpenPieces(log.Named("from"), fromConfig, false)
+	if err != nil {
+		return errs.New("Error opening the storage of the node: %v", err)
+	}
+	defer func() { err = errs.Combine(err, from.Close()) }()
+
+	to, err := storagenodedb.OpenPieces(log.Named("to"), toConfig, true)
+	if err != nil {
+		return errs.New("Error opening the target storage: %v", err)
+	}
+	defer func() { err = errs.Combine(err, to.Close()) }()
+
+	// the target may not have been used by the node yet.
+	if err := to.CreateVerificationFile(ctx, identity.ID); err != nil {
+		return errs.New("Error creating verification file in the target storage: %v", err)
+	}
+
+	// the checkpoints are kept per target, so that a migration to another target starts over.
+	targetHash := sha256.Sum256([]byte(toBackend))
+	checkpoints, err := pieces.OpenWalkCheckpoints(filepath.Join(cfg.Storage.Path, migrateCheckpointsDir, hex.EncodeToString(targetHash[:8])))
+	if err != nil {
+		return errs.New("Error opening the migration checkpoints: %v", err)
+	}
+
+	if cfg.Cutover {
+		if err := storagemigration.MarkCutover(cfg.Storage.Path, fromBackend); err != nil {
+			return errs.New("Error starting the cutover: %v", err)
+		}
+		fmt.Printf("Waiting %s for the running node to stop accepting uploads...\n", cfg.CutoverWait)
+		if !sync2.Sleep(ctx, cfg.CutoverWait) {
+			return ctx.Err()
+		}
+	}
+
+	migrator := storagemigration.NewMigrator(log.Named("migrate"), from, to, checkpoints, cfg.Migrate)
+	stats, err := migrator.Migrate(ctx)
+	fmt.Printf("Copied %d pieces (%s), %d pieces were already copied.\n", stats.Copied, memory.Size(stats.CopiedBytes), stats.Existing)
+	if stats.Skipped > 0 {
+		fmt.Printf("Skipped %d pieces with the old storage format, which can't be migrated.\n", stats.Skipped)
+	}
+	if err != nil {
+		return errs.New("Error copying pieces: %v. Run the command again to resume.", err)
+	}
+
+	if cfg.Cutover && stats.Skipped > 0 {
+		// the pieces would be lost after switching to the target.
+		return errs.Combine(
+			errs.New("The migration can't be completed, because of the pieces with the old storage format. The node accepts uploads again."),
+			storagemigration.UnmarkCutover(cfg.Storage.Path))
+	}
+
+	if cfg.Cutover {
+		fmt.Printf("All the pieces were copied to %q. Restart the node with the target storage configuration.\n", toBackend)
+		fmt.Println("The node doesn't accept uploads until it's restarted. To cancel the migration instead, remove " +
+			filepath.Join(cfg.Storage.Path, storagemigration.CutoverMarker) + ".")
+	} else {
+		fmt.Println("Run the command again with --cutover to copy the remaining pieces and switch the node to the target storage.")
+	}
+	return nil
+}
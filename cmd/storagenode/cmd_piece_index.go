@@ -0,0 +1,128 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/private/cfgstruct"
+	"storj.io/private/process"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+type pieceIndexCfg struct {
+	storagenode.Config
+
+	Repair bool `help:"fix the differences between the piece index and the stored pieces" default:"false"`
+}
+
+func newPieceIndexCmd(f *Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "piece-index",
+		Short:       "Manage the index of the stored pieces",
+		Annotations: map[string]string{"type": "helper"},
+	}
+
+	var rebuildCfg pieceIndexCfg
+	rebuildCmd := &cobra.Command{
+		Use:   "rebuild",
+		Short: "Rebuild the piece index by walking all the stored pieces. The node must be stopped.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmdPieceIndexRebuild(cmd, &rebuildCfg)
+		},
+		Args: cobra.ExactArgs(0),
+	}
+	process.Bind(rebuildCmd, &rebuildCfg, f.Defaults, cfgstruct.ConfDir(f.ConfDir), cfgstruct.IdentityDir(f.IdentityDir))
+
+	var checkCfg pieceIndexCfg
+	checkCmd := &cobra.Command{
+		Use:   "check",
+		Short: "Check whether the piece index matches the stored pieces. The node must be stopped.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmdPieceIndexCheck(cmd, &checkCfg)
+		},
+		Args: cobra.ExactArgs(0),
+	}
+	process.Bind(checkCmd, &checkCfg, f.Defaults, cfgstruct.ConfDir(f.ConfDir), cfgstruct.IdentityDir(f.IdentityDir))
+
+	cmd.AddCommand(rebuildCmd, checkCmd)
+
+	return cmd
+}
+
+func cmdPieceIndexRebuild(cmd *cobra.Command, cfg *pieceIndexCfg) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	db, err := storagenodedb.OpenExisting(ctx, zap.L().Named("db"), cfg.DatabaseConfig())
+	if err != nil {
+		return errs.New("Error starting master database on storage node: %v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	index, err := pieces.OpenPieceIndex(zap.L().Named("pieceindex"), cfg.PieceIndexPath())
+	if err != nil {
+		return errs.New("Error opening the piece index, is the node stopped? %v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, index.Close())
+	}()
+
+	if err := index.Rebuild(ctx, db.Pieces()); err != nil {
+		return errs.New("Error rebuilding the piece index: %v", err)
+	}
+
+	fmt.Println("The piece index was rebuilt.")
+	return nil
+}
+
+func cmdPieceIndexCheck(cmd *cobra.Command, cfg *pieceIndexCfg) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	db, err := storagenodedb.OpenExisting(ctx, zap.L().Named("db"), cfg.DatabaseConfig())
+	if err != nil {
+		return errs.New("Error starting master database on storage node: %v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	index, err := pieces.OpenPieceIndex(zap.L().Named("pieceindex"), cfg.PieceIndexPath())
+	if err != nil {
+		return errs.New("Error opening the piece index, is the node stopped? %v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, index.Close())
+	}()
+
+	if !index.Ready() {
+		fmt.Println("The piece index is not built, run the rebuild command first.")
+		return nil
+	}
+
+	check, err := index.Check(ctx, db.Pieces(), cfg.Repair)
+	if err != nil {
+		return errs.New("Error checking the piece index: %v", err)
+	}
+
+	fmt.Printf("Pieces missing from the index: %d\n", check.Missing)
+	fmt.Printf("Pieces in the index but not stored: %d\n", check.Stale)
+	fmt.Printf("Pieces with a different size or mtime: %d\n", check.Mismatched)
+	switch {
+	case check.Consistent():
+		fmt.Println("The piece index is consistent.")
+	case cfg.Repair:
+		fmt.Println("The piece index was repaired.")
+	default:
+		fmt.Println("The piece index is inconsistent, run the check with --repair to fix it.")
+	}
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	"storj.io/private/version"
 	"storj.io/storj/private/revocation"
 	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/diagnostics"
 	"storj.io/storj/storagenode/storagenodedb"
 )
 
@@ -81,6 +82,9 @@ func cmdRun(cmd *cobra.Command, cfg *runCfg) (err error) {
 		return err
 	}
 
+	peer.Diagnostics.SetSettings(diagnostics.Settings(cmd.Flags()))
+	peer.Diagnostics.SetLogPath(logOutputPath(cmd))
+
 	// okay, start doing stuff ====
 
 	_, err = peer.Version.Service.CheckVersion(ctx)
@@ -0,0 +1,129 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/private/cfgstruct"
+	"storj.io/private/process"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/blobstore/multistore"
+)
+
+type storageDirsMoveCfg struct {
+	storagenode.Config
+
+	From string `help:"the storage directory to move the pieces from" default:""`
+	To   string `help:"the storage directory to move the pieces to" default:""`
+}
+
+func newStorageDirsCmd(f *Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "storage-dirs",
+		Short:       "Manage the storage directories",
+		Annotations: map[string]string{"type": "helper"},
+	}
+
+	var statusCfg storagenode.Config
+	statusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the space used and free in each storage directory",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmdStorageDirsStatus(cmd, &statusCfg)
+		},
+		Args: cobra.ExactArgs(0),
+	}
+	process.Bind(statusCmd, &statusCfg, f.Defaults, cfgstruct.ConfDir(f.ConfDir), cfgstruct.IdentityDir(f.IdentityDir))
+
+	var moveCfg storageDirsMoveCfg
+	moveCmd := &cobra.Command{
+		Use:   "move",
+		Short: "Move all the pieces from one storage directory to another, e.g. before removing a disk. The node must be stopped.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmdStorageDirsMove(cmd, &moveCfg)
+		},
+		Args: cobra.ExactArgs(0),
+	}
+	process.Bind(moveCmd, &moveCfg, f.Defaults, cfgstruct.ConfDir(f.ConfDir), cfgstruct.IdentityDir(f.IdentityDir))
+
+	cmd.AddCommand(statusCmd, moveCmd)
+
+	return cmd
+}
+
+func cmdStorageDirsStatus(cmd *cobra.Command, cfg *storagenode.Config) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "Directory\tUsed\tTrash\tFree\tWritable")
+
+	for _, path := range append([]string{cfg.Storage.Path}, cfg.Storage.AdditionalPaths...) {
+		blobs, err := filestore.NewAt(zap.L().Named("filestore"), path, cfg.Filestore)
+		if err != nil {
+			return errs.New("Error opening storage directory %q: %v", path, err)
+		}
+
+		used, usedErr := blobs.SpaceUsedForBlobs(ctx)
+		trash, trashErr := blobs.SpaceUsedForTrash(ctx)
+		free, freeErr := blobs.FreeSpace(ctx)
+		writable := blobs.CheckWritability(ctx) == nil
+		if err := errs.Combine(usedErr, trashErr, freeErr, blobs.Close()); err != nil {
+			return errs.New("Error checking storage directory %q: %v", path, err)
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\n", path, memory.Size(used), memory.Size(trash), memory.Size(free), writable)
+	}
+	return w.Flush()
+}
+
+func cmdStorageDirsMove(cmd *cobra.Command, cfg *storageDirsMoveCfg) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	if cfg.From == "" || cfg.To == "" {
+		return errs.New("both --from and --to must be set")
+	}
+
+	identity, err := cfg.Identity.Load()
+	if err != nil {
+		return errs.New("Error loading identity: %v", err)
+	}
+
+	log := zap.L().Named("filestore")
+	fromDir, err := filestore.OpenDir(log, cfg.From)
+	if err != nil {
+		return errs.New("Error opening storage directory %q: %v", cfg.From, err)
+	}
+	from := filestore.New(log, fromDir, cfg.Filestore)
+	defer func() { err = errs.Combine(err, from.Close()) }()
+
+	to, err := filestore.NewAt(log, cfg.To, cfg.Filestore)
+	if err != nil {
+		return errs.New("Error opening storage directory %q: %v", cfg.To, err)
+	}
+	defer func() { err = errs.Combine(err, to.Close()) }()
+
+	// the target directory may not have been used by the node yet.
+	if err := to.CreateVerificationFile(ctx, identity.ID); err != nil {
+		return errs.New("Error creating verification file in %q: %v", cfg.To, err)
+	}
+
+	stats, err := multistore.MoveBlobs(ctx, zap.L().Named("move"), from, to)
+	fmt.Printf("Moved %d pieces (%s).\n", stats.Moved, memory.Size(stats.MovedBytes))
+	if stats.Skipped > 0 {
+		fmt.Printf("Skipped %d pieces with the old storage format, keep %q configured as a storage directory.\n", stats.Skipped, cfg.From)
+	}
+	if err != nil {
+		return errs.New("Error moving pieces: %v", err)
+	}
+	return nil
+}
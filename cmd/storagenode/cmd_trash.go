@@ -0,0 +1,288 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/private/cfgstruct"
+	"storj.io/private/process"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+// trashDayLayout is the format of the days in the trash listing.
+const trashDayLayout = "2006-01-02"
+
+type trashListCfg struct {
+	storagenode.Config
+
+	Satellite string `help:"only list the trash of this satellite" default:""`
+}
+
+type trashRestoreCfg struct {
+	storagenode.Config
+
+	Satellite    string   `help:"only restore the trash of this satellite" default:""`
+	Day          string   `help:"restore the pieces trashed on this day (UTC), e.g. 2023-06-01" default:""`
+	TrashedAfter string   `help:"restore the pieces trashed after this time, e.g. 2023-06-01T12:00:00Z" default:""`
+	PieceIDs     []string `help:"restore the pieces with these ids" default:""`
+	All          bool     `help:"restore all the pieces in the trash" default:"false"`
+	DryRun       bool     `help:"only show what would be restored" default:"false"`
+}
+
+func newTrashCmd(f *Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "trash",
+		Short:       "Inspect and restore the trash",
+		Annotations: map[string]string{"type": "helper"},
+	}
+
+	var listCfg trashListCfg
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the number and size of the pieces in the trash per satellite and day",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmdTrashList(cmd, &listCfg)
+		},
+		Args: cobra.ExactArgs(0),
+	}
+	process.Bind(listCmd, &listCfg, f.Defaults, cfgstruct.ConfDir(f.ConfDir), cfgstruct.IdentityDir(f.IdentityDir))
+
+	var restoreCfg trashRestoreCfg
+	restoreCmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore the selected pieces from the trash. The node must be stopped.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmdTrashRestore(cmd, &restoreCfg)
+		},
+		Args: cobra.ExactArgs(0),
+	}
+	process.Bind(restoreCmd, &restoreCfg, f.Defaults, cfgstruct.ConfDir(f.ConfDir), cfgstruct.IdentityDir(f.IdentityDir))
+
+	cmd.AddCommand(listCmd, restoreCmd)
+
+	return cmd
+}
+
+// trashDay is the summary of the pieces of a satellite trashed on a day.
+type trashDay struct {
+	Day    string
+	Pieces int64
+	Size   int64
+}
+
+func cmdTrashList(cmd *cobra.Command, cfg *trashListCfg) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	db, err := storagenodedb.OpenExisting(ctx, zap.L().Named("db"), cfg.DatabaseConfig())
+	if err != nil {
+		return errs.New("Error starting master database on storage node: %v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	store := pieces.NewStore(zap.L().Named("pieces"), nil, nil, db.Pieces(), db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), cfg.Pieces)
+
+	satellites, err := trashSatellites(ctx, db, cfg.Satellite)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "Satellite\tDay\tPieces\tSize")
+
+	for _, satelliteID := range satellites {
+		days := map[string]*trashDay{}
+		err := store.WalkTrash(ctx, satelliteID, func(piece pieces.TrashedPiece) error {
+			day := piece.TrashedAt.UTC().Format(trashDayLayout)
+			if days[day] == nil {
+				days[day] = &trashDay{Day: day}
+			}
+			days[day].Pieces++
+			days[day].Size += piece.Size
+			return nil
+		})
+		if err != nil {
+			return errs.New("Error walking the trash of %s: %v", satelliteID, err)
+		}
+
+		sorted := make([]*trashDay, 0, len(days))
+		for _, day := range days {
+			sorted = append(sorted, day)
+		}
+		sort.Slice(sorted, func(i, k int) bool { return sorted[i].Day < sorted[k].Day })
+		for _, day := range sorted {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", satelliteID, day.Day, day.Pieces, memory.Size(day.Size))
+		}
+	}
+	return w.Flush()
+}
+
+func cmdTrashRestore(cmd *cobra.Command, cfg *trashRestoreCfg) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	selected, err := cfg.selection()
+	if err != nil {
+		return err
+	}
+
+	db, err := storagenodedb.OpenExisting(ctx, zap.L().Named("db"), cfg.DatabaseConfig())
+	if err != nil {
+		return errs.New("Error starting master database on storage node: %v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	// the changes of the space used are journaled, so that the node picks them up on startup.
+	cache := pieces.NewBlobsUsageCache(zap.L().Named("blobscache"), db.Pieces())
+	journal, err := pieces.OpenUsedSpaceJournal(cfg.UsedSpaceJournalPath())
+	if err != nil {
+		return errs.New("Error opening the used space journal: %v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, journal.Close())
+	}()
+	cache.SetJournal(journal)
+
+	store := pieces.NewStore(zap.L().Named("pieces"), nil, nil, cache, db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), cfg.Pieces)
+	if cfg.Pieces.EnablePieceIndex {
+		index, err := pieces.OpenPieceIndex(zap.L().Named("pieceindex"), cfg.PieceIndexPath())
+		if err != nil {
+			return errs.New("Error opening the piece index, is the node stopped? %v", err)
+		}
+		defer func() {
+			err = errs.Combine(err, index.Close())
+		}()
+		store.SetIndex(index)
+	}
+
+	satellites, err := trashSatellites(ctx, db, cfg.Satellite)
+	if err != nil {
+		return err
+	}
+
+	for _, satelliteID := range satellites {
+		var pieceIDs []storj.PieceID
+		var size int64
+		err := store.WalkTrash(ctx, satelliteID, func(piece pieces.TrashedPiece) error {
+			if selected(piece) {
+				pieceIDs = append(pieceIDs, piece.PieceID)
+				size += piece.Size
+			}
+			return nil
+		})
+		if err != nil {
+			return errs.New("Error walking the trash of %s: %v", satelliteID, err)
+		}
+		if len(pieceIDs) == 0 {
+			continue
+		}
+
+		if cfg.DryRun {
+			fmt.Printf("Would restore %d pieces (%s) of %s.\n", len(pieceIDs), memory.Size(size), satelliteID)
+			continue
+		}
+
+		restored, err := store.RestoreTrashedPieces(ctx, satelliteID, pieceIDs)
+		fmt.Printf("Restored %d pieces of %s.\n", len(restored), satelliteID)
+		if err != nil {
+			return errs.New("Error restoring the trash of %s: %v", satelliteID, err)
+		}
+	}
+	return nil
+}
+
+// selection returns the function which selects the trashed pieces to restore.
+func (cfg *trashRestoreCfg) selection() (func(pieces.TrashedPiece) bool, error) {
+	var filters []func(pieces.TrashedPiece) bool
+
+	if cfg.Day != "" {
+		day, err := time.Parse(trashDayLayout, cfg.Day)
+		if err != nil {
+			return nil, errs.New("Invalid day %q: %v", cfg.Day, err)
+		}
+		filters = append(filters, func(piece pieces.TrashedPiece) bool {
+			return !piece.TrashedAt.Before(day) && piece.TrashedAt.Before(day.AddDate(0, 0, 1))
+		})
+	}
+
+	if cfg.TrashedAfter != "" {
+		after, err := time.Parse(time.RFC3339, cfg.TrashedAfter)
+		if err != nil {
+			return nil, errs.New("Invalid time %q: %v", cfg.TrashedAfter, err)
+		}
+		filters = append(filters, func(piece pieces.TrashedPiece) bool {
+			return piece.TrashedAt.After(after)
+		})
+	}
+
+	if len(cfg.PieceIDs) > 0 {
+		pieceIDs := map[storj.PieceID]struct{}{}
+		for _, s := range cfg.PieceIDs {
+			pieceID, err := storj.PieceIDFromString(s)
+			if err != nil {
+				return nil, errs.New("Invalid piece id %q: %v", s, err)
+			}
+			pieceIDs[pieceID] = struct{}{}
+		}
+		filters = append(filters, func(piece pieces.TrashedPiece) bool {
+			_, ok := pieceIDs[piece.PieceID]
+			return ok
+		})
+	}
+
+	if len(filters) == 0 && !cfg.All {
+		return nil, errs.New("select the pieces to restore with --day, --trashed-after or --piece-ids, or use --all")
+	}
+
+	return func(piece pieces.TrashedPiece) bool {
+		for _, filter := range filters {
+			if !filter(piece) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+// trashSatellites returns the satellites whose trash is listed or restored.
+func trashSatellites(ctx context.Context, db *storagenodedb.DB, satellite string) ([]storj.NodeID, error) {
+	if satellite != "" {
+		satelliteID, err := storj.NodeIDFromString(satellite)
+		if err != nil {
+			return nil, errs.New("Invalid satellite id %q: %v", satellite, err)
+		}
+		return []storj.NodeID{satelliteID}, nil
+	}
+
+	namespaces, err := db.Pieces().ListNamespaces(ctx)
+	if err != nil {
+		return nil, errs.New("Error listing the satellites: %v", err)
+	}
+	var satellites []storj.NodeID
+	for _, namespace := range namespaces {
+		satelliteID, err := storj.NodeIDFromBytes(namespace)
+		if err != nil {
+			continue
+		}
+		satellites = append(satellites, satelliteID)
+	}
+	sort.Slice(satellites, func(i, k int) bool { return satellites[i].Less(satellites[k]) })
+	return satellites, nil
+}
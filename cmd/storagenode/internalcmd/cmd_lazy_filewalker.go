@@ -10,15 +10,20 @@ import (
 	"io"
 	"net/url"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 
 	"storj.io/private/process"
+	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/pieces/lazyfilewalker"
 	"storj.io/storj/storagenode/storagenodedb"
 )
 
+// progressInterval is how often the lazyfilewalker subprocesses report their progress.
+var progressInterval = 30 * time.Second
+
 // FilewalkerCfg is the config structure for the lazyfilewalker commands.
 type FilewalkerCfg struct {
 	lazyfilewalker.Config
@@ -92,6 +97,40 @@ func (r *RunOptions) SetIn(reader io.Reader) {
 	r.stdin = reader
 }
 
+// reportProgress periodically reports the progress of the subprocess to the main process,
+// until the returned stop func is called, which reports the final progress.
+func (r *RunOptions) reportProgress(progress *pieces.WalkProgress) (stop func()) {
+	report := func() {
+		piecesProcessed, bytesProcessed := progress.Get()
+		lazyfilewalker.ReportProgress(r.Logger, piecesProcessed, bytesProcessed)
+	}
+
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(progressInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				report()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-stopped
+		report()
+	}
+}
+
 // DefaultRunOpts returns the default RunOptions.
 func DefaultRunOpts(ctx context.Context, logger *zap.Logger, config *FilewalkerCfg) *RunOptions {
 	return &RunOptions{
@@ -113,6 +152,8 @@ func (config *FilewalkerCfg) DatabaseConfig() storagenodedb.Config {
 		Pieces:    config.Pieces,
 		Filestore: config.Filestore,
 		Driver:    config.Driver,
+
+		AdditionalPieces: config.AdditionalPieces,
 	}
 }
 
@@ -155,3 +196,43 @@ func NewGCFilewalkerCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewTrashFilewalkerCmd creates a new cobra command for running the trash cleanup filewalker.
+func NewTrashFilewalkerCmd() *cobra.Command {
+	var cfg FilewalkerCfg
+
+	cmd := &cobra.Command{
+		Use:   lazyfilewalker.TrashFilewalkerCmdName,
+		Short: "An internal subcommand used to run the trash cleanup filewalker as a separate subprocess with lower IO priority",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, _ := process.Ctx(cmd)
+			return NewTrashLazyFilewalkerWithConfig(ctx, zap.L(), &cfg).Run()
+		},
+		Hidden: true,
+		Args:   cobra.ExactArgs(0),
+	}
+
+	process.Bind(cmd, &cfg)
+
+	return cmd
+}
+
+// NewReconcileFilewalkerCmd creates a new cobra command for running the space used cache reconciliation filewalker.
+func NewReconcileFilewalkerCmd() *cobra.Command {
+	var cfg FilewalkerCfg
+
+	cmd := &cobra.Command{
+		Use:   lazyfilewalker.ReconcileFilewalkerCmdName,
+		Short: "An internal subcommand used to run the space used cache reconciliation filewalker as a separate subprocess with lower IO priority",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, _ := process.Ctx(cmd)
+			return NewReconcileLazyFilewalkerWithConfig(ctx, zap.L(), &cfg).Run()
+		},
+		Hidden: true,
+		Args:   cobra.ExactArgs(0),
+	}
+
+	process.Bind(cmd, &cfg)
+
+	return cmd
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"io"
+	"path/filepath"
 	"runtime"
 
 	"github.com/zeebo/errs"
@@ -93,7 +94,19 @@ func (g *GCLazyFileWalker) Run() (err error) {
 	log.Info("gc-filewalker started", zap.Time("createdBefore", req.CreatedBefore), zap.Int("bloomFilterSize", len(req.BloomFilter)))
 
 	filewalker := pieces.NewFileWalker(log, db.Pieces(), db.V0PieceInfo())
+
+	checkpoints, err := pieces.OpenWalkCheckpoints(filepath.Join(g.Config.Storage, pieces.WalkCheckpointsDir))
+	if err != nil {
+		return err
+	}
+	filewalker.SetCheckpoints(checkpoints)
+
+	var progress pieces.WalkProgress
+	filewalker.SetProgress(&progress)
+	stopProgress := g.reportProgress(&progress)
+
 	pieceIDs, piecesCount, piecesSkippedCount, err := filewalker.WalkSatellitePiecesToTrash(g.Ctx, req.SatelliteID, req.CreatedBefore, filter)
+	stopProgress()
 	if err != nil {
 		return err
 	}
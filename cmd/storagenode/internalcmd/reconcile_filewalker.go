@@ -0,0 +1,153 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package internalcmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"runtime"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/storagenode/iopriority"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/pieces/lazyfilewalker"
+	"storj.io/storj/storagenode/pieces/lazyfilewalker/execwrapper"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+// ReconcileLazyFileWalker is an execwrapper.Command for the reconcile-filewalker.
+type ReconcileLazyFileWalker struct {
+	*RunOptions
+}
+
+var _ execwrapper.Command = (*ReconcileLazyFileWalker)(nil)
+
+// NewReconcileLazyFilewalker creates a new ReconcileLazyFileWalker instance.
+func NewReconcileLazyFilewalker(ctx context.Context, logger *zap.Logger, config lazyfilewalker.Config) *ReconcileLazyFileWalker {
+	return NewReconcileLazyFilewalkerWithConfig(ctx, logger, &FilewalkerCfg{config})
+}
+
+// NewReconcileLazyFilewalkerWithConfig creates a new ReconcileLazyFileWalker instance with the given config.
+func NewReconcileLazyFilewalkerWithConfig(ctx context.Context, logger *zap.Logger, config *FilewalkerCfg) *ReconcileLazyFileWalker {
+	return &ReconcileLazyFileWalker{
+		RunOptions: DefaultRunOpts(ctx, logger, config),
+	}
+}
+
+// Run runs the ReconcileLazyFileWalker.
+func (r *ReconcileLazyFileWalker) Run() (err error) {
+	if r.Config.LowerIOPriority {
+		if runtime.GOOS == "linux" {
+			// Pin the current goroutine to the current OS thread, so we can set the IO priority
+			// for the current thread.
+			// This is necessary because Go does use CLONE_IO when creating new threads,
+			// so they do not share a single IO context.
+			runtime.LockOSThread()
+			defer runtime.UnlockOSThread()
+		}
+
+		err = iopriority.SetLowIOPriority()
+		if err != nil {
+			return err
+		}
+	}
+
+	log := r.Logger
+
+	// Decode the data struct received from the main process
+	var req lazyfilewalker.ReconcileRequest
+	if err = json.NewDecoder(r.stdin).Decode(&req); err != nil {
+		return errs.New("Error decoding data from stdin: %v", err)
+	}
+
+	for _, satelliteID := range req.SatelliteIDs {
+		if satelliteID.IsZero() {
+			return errs.New("SatelliteID is required")
+		}
+	}
+
+	// We still need the DB in this case because we still have to deal with v0 pieces.
+	// Once we drop support for v0 pieces, we can remove this.
+	db, err := storagenodedb.OpenExisting(r.Ctx, log.Named("db"), r.Config.DatabaseConfig())
+	if err != nil {
+		return errs.New("Error starting master database on storage node: %v", err)
+	}
+	log.Info("Database started")
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	log.Info("reconcile-filewalker started", zap.Int("satellites", len(req.SatelliteIDs)))
+
+	filewalker := pieces.NewFileWalker(log, db.Pieces(), db.V0PieceInfo())
+
+	checkpoints, err := pieces.OpenWalkCheckpoints(filepath.Join(r.Config.Storage, pieces.WalkCheckpointsDir))
+	if err != nil {
+		return err
+	}
+	filewalker.SetCheckpoints(checkpoints)
+	filewalker.SetConfig(pieces.FileWalkerConfig{
+		Concurrency:     r.Config.Concurrency,
+		PiecesPerSecond: r.Config.PiecesPerSecond,
+	})
+
+	var progress pieces.WalkProgress
+	filewalker.SetProgress(&progress)
+	stopProgress := r.reportProgress(&progress)
+	defer stopProgress()
+
+	var resp lazyfilewalker.ReconcileResponse
+	for _, satelliteID := range req.SatelliteIDs {
+		total, contentSize, err := filewalker.WalkAndComputeSpaceUsedBySatellite(r.Ctx, satelliteID)
+		if err != nil {
+			return err
+		}
+		resp.Satellites = append(resp.Satellites, lazyfilewalker.SatelliteSpaceUsed{
+			SatelliteID:       satelliteID,
+			PiecesTotal:       total,
+			PiecesContentSize: contentSize,
+		})
+	}
+
+	resp.TrashTotal, err = db.Pieces().SpaceUsedForTrash(r.Ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Info("reconcile-filewalker completed", zap.Int64("trashTotal", resp.TrashTotal))
+
+	// encode the response struct and write it to stdout
+	return json.NewEncoder(r.stdout).Encode(resp)
+}
+
+// Start starts the ReconcileLazyFileWalker, assuming it behaves like the Start method on exec.Cmd.
+// This is a no-op and only exists to satisfy the execwrapper.Command interface.
+// Wait must be called to actually run the command.
+func (r *ReconcileLazyFileWalker) Start() error {
+	return nil
+}
+
+// Wait waits for the ReconcileLazyFileWalker to finish, assuming it behaves like the Wait method on exec.Cmd.
+func (r *ReconcileLazyFileWalker) Wait() error {
+	return r.Run()
+}
+
+// SetIn sets the stdin of the ReconcileLazyFileWalker.
+func (r *ReconcileLazyFileWalker) SetIn(reader io.Reader) {
+	r.RunOptions.SetIn(reader)
+}
+
+// SetOut sets the stdout of the ReconcileLazyFileWalker.
+func (r *ReconcileLazyFileWalker) SetOut(writer io.Writer) {
+	r.RunOptions.SetOut(writer)
+}
+
+// SetErr sets the stderr of the ReconcileLazyFileWalker.
+func (r *ReconcileLazyFileWalker) SetErr(writer io.Writer) {
+	r.RunOptions.SetErr(writer)
+}
@@ -0,0 +1,146 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package internalcmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"runtime"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/iopriority"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/pieces/lazyfilewalker"
+	"storj.io/storj/storagenode/pieces/lazyfilewalker/execwrapper"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+// TrashLazyFileWalker is an execwrapper.Command for the trash-filewalker.
+type TrashLazyFileWalker struct {
+	*RunOptions
+}
+
+var _ execwrapper.Command = (*TrashLazyFileWalker)(nil)
+
+// NewTrashLazyFilewalker creates a new TrashLazyFileWalker instance.
+func NewTrashLazyFilewalker(ctx context.Context, logger *zap.Logger, config lazyfilewalker.Config) *TrashLazyFileWalker {
+	return NewTrashLazyFilewalkerWithConfig(ctx, logger, &FilewalkerCfg{config})
+}
+
+// NewTrashLazyFilewalkerWithConfig creates a new TrashLazyFileWalker instance with the given config.
+func NewTrashLazyFilewalkerWithConfig(ctx context.Context, logger *zap.Logger, config *FilewalkerCfg) *TrashLazyFileWalker {
+	return &TrashLazyFileWalker{
+		RunOptions: DefaultRunOpts(ctx, logger, config),
+	}
+}
+
+// Run runs the TrashLazyFileWalker.
+func (t *TrashLazyFileWalker) Run() (err error) {
+	if t.Config.LowerIOPriority {
+		if runtime.GOOS == "linux" {
+			// Pin the current goroutine to the current OS thread, so we can set the IO priority
+			// for the current thread.
+			// This is necessary because Go does use CLONE_IO when creating new threads,
+			// so they do not share a single IO context.
+			runtime.LockOSThread()
+			defer runtime.UnlockOSThread()
+		}
+
+		err = iopriority.SetLowIOPriority()
+		if err != nil {
+			return err
+		}
+	}
+
+	log := t.Logger
+
+	// Decode the data struct received from the main process
+	var req lazyfilewalker.TrashRequest
+	if err = json.NewDecoder(t.stdin).Decode(&req); err != nil {
+		return errs.New("Error decoding data from stdin: %v", err)
+	}
+
+	// Validate the request data
+	switch {
+	case req.SatelliteID.IsZero():
+		return errs.New("SatelliteID is required")
+	case req.TrashedBefore.IsZero():
+		return errs.New("TrashedBefore is required")
+	}
+
+	db, err := storagenodedb.OpenExisting(t.Ctx, log.Named("db"), t.Config.DatabaseConfig())
+	if err != nil {
+		return errs.New("Error starting master database on storage node: %v", err)
+	}
+	log.Info("Database started")
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	log.Info("trash-filewalker started", zap.Time("trashedBefore", req.TrashedBefore))
+
+	bytesDeleted, deletedKeys, err := t.emptyTrash(db.Pieces(), req)
+	if err != nil {
+		return err
+	}
+
+	resp := lazyfilewalker.TrashResponse{
+		BytesDeleted:    bytesDeleted,
+		DeletedPieceIDs: make([]storj.PieceID, 0, len(deletedKeys)),
+	}
+	for _, key := range deletedKeys {
+		pieceID, err := storj.PieceIDFromBytes(key)
+		if err != nil {
+			return err
+		}
+		resp.DeletedPieceIDs = append(resp.DeletedPieceIDs, pieceID)
+	}
+
+	log.Info("trash-filewalker completed", zap.Int64("bytesDeleted", bytesDeleted), zap.Int("numKeysDeleted", len(deletedKeys)))
+
+	// encode the response struct and write it to stdout
+	return json.NewEncoder(t.stdout).Encode(resp)
+}
+
+// emptyTrash empties the trash of the satellite and periodically reports the number of the
+// pieces deleted so far.
+func (t *TrashLazyFileWalker) emptyTrash(blobs blobstore.Blobs, req lazyfilewalker.TrashRequest) (int64, [][]byte, error) {
+	var progress pieces.WalkProgress
+	stopProgress := t.reportProgress(&progress)
+	defer stopProgress()
+
+	return blobstore.EmptyTrash(t.Ctx, blobs, req.SatelliteID.Bytes(), req.TrashedBefore, progress.Add)
+}
+
+// Start starts the TrashLazyFileWalker, assuming it behaves like the Start method on exec.Cmd.
+// This is a no-op and only exists to satisfy the execwrapper.Command interface.
+// Wait must be called to actually run the command.
+func (t *TrashLazyFileWalker) Start() error {
+	return nil
+}
+
+// Wait waits for the TrashLazyFileWalker to finish, assuming it behaves like the Wait method on exec.Cmd.
+func (t *TrashLazyFileWalker) Wait() error {
+	return t.Run()
+}
+
+// SetIn sets the stdin of the TrashLazyFileWalker.
+func (t *TrashLazyFileWalker) SetIn(reader io.Reader) {
+	t.RunOptions.SetIn(reader)
+}
+
+// SetOut sets the stdout of the TrashLazyFileWalker.
+func (t *TrashLazyFileWalker) SetOut(writer io.Writer) {
+	t.RunOptions.SetOut(writer)
+}
+
+// SetErr sets the stderr of the TrashLazyFileWalker.
+func (t *TrashLazyFileWalker) SetErr(writer io.Writer) {
+	t.RunOptions.SetErr(writer)
+}
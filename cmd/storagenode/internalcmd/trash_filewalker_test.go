@@ -0,0 +1,89 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package internalcmd
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest"
+	"go.uber.org/zap/zaptest/observer"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/pieces/lazyfilewalker"
+)
+
+// pausedTrashBlobs pauses emptying the trash after the first deleted blob, until resume returns.
+type pausedTrashBlobs struct {
+	blobstore.Blobs
+	resume func()
+}
+
+func (blobs *pausedTrashBlobs) EmptyTrashWithProgress(ctx context.Context, namespace []byte, trashedBefore time.Time, deleted func(blobs, bytes int64)) (int64, [][]byte, error) {
+	var once sync.Once
+	return blobstore.EmptyTrash(ctx, blobs.Blobs, namespace, trashedBefore, func(n, bytes int64) {
+		deleted(n, bytes)
+		once.Do(blobs.resume)
+	})
+}
+
+func TestTrashLazyFileWalkerProgress(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	defer func(interval time.Duration) { progressInterval = interval }(progressInterval)
+	progressInterval = time.Millisecond
+
+	store, err := filestore.NewAt(zaptest.NewLogger(t), ctx.Dir("store"), filestore.DefaultConfig)
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	satelliteID := testrand.NodeID()
+	for i := 0; i < 3; i++ {
+		ref := blobstore.BlobRef{Namespace: satelliteID.Bytes(), Key: testrand.PieceID().Bytes()}
+		writer, err := store.Create(ctx, ref, -1)
+		require.NoError(t, err)
+		_, err = writer.Write(testrand.Bytes(memory.KiB))
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+		require.NoError(t, store.Trash(ctx, ref))
+	}
+
+	core, logs := observer.New(zapcore.InfoLevel)
+	walker := NewTrashLazyFilewalkerWithConfig(ctx, zap.New(core), &FilewalkerCfg{})
+
+	// the progress is reported while the trash is emptied, not only when it's done.
+	intermediate := func() bool {
+		for _, entry := range logs.FilterMessage("lazyfilewalker progress").All() {
+			if entry.ContextMap()["piecesProcessed"].(int64) == 1 {
+				return true
+			}
+		}
+		return false
+	}
+	blobs := &pausedTrashBlobs{Blobs: store, resume: func() {
+		require.Eventually(t, intermediate, 10*time.Second, time.Millisecond)
+	}}
+
+	bytesDeleted, keys, err := walker.emptyTrash(blobs, lazyfilewalker.TrashRequest{
+		SatelliteID:   satelliteID,
+		TrashedBefore: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Len(t, keys, 3)
+
+	final := logs.FilterMessage("lazyfilewalker progress").All()
+	require.NotEmpty(t, final)
+	require.EqualValues(t, 3, final[len(final)-1].ContextMap()["piecesProcessed"])
+	require.EqualValues(t, bytesDeleted, final[len(final)-1].ContextMap()["bytesProcessed"])
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"io"
+	"path/filepath"
 	"runtime"
 
 	"github.com/zeebo/errs"
@@ -81,7 +82,23 @@ func (u *UsedSpaceLazyFileWalker) Run() (err error) {
 	log.Info("used-space-filewalker started")
 
 	filewalker := pieces.NewFileWalker(log, db.Pieces(), db.V0PieceInfo())
+
+	checkpoints, err := pieces.OpenWalkCheckpoints(filepath.Join(u.Config.Storage, pieces.WalkCheckpointsDir))
+	if err != nil {
+		return err
+	}
+	filewalker.SetCheckpoints(checkpoints)
+	filewalker.SetConfig(pieces.FileWalkerConfig{
+		Concurrency:     u.Config.Concurrency,
+		PiecesPerSecond: u.Config.PiecesPerSecond,
+	})
+
+	var progress pieces.WalkProgress
+	filewalker.SetProgress(&progress)
+	stopProgress := u.reportProgress(&progress)
+
 	total, contentSize, err := filewalker.WalkAndComputeSpaceUsedBySatellite(u.Ctx, req.SatelliteID)
+	stopProgress()
 	if err != nil {
 		return err
 	}
@@ -37,5 +37,13 @@ func main() {
 }
 
 func isFilewalkerCommand() bool {
-	return len(os.Args) > 1 && (os.Args[1] == lazyfilewalker.UsedSpaceFilewalkerCmdName || os.Args[1] == lazyfilewalker.GCFilewalkerCmdName)
+	if len(os.Args) < 2 {
+		return false
+	}
+	for _, name := range lazyfilewalker.CmdNames {
+		if os.Args[1] == name {
+			return true
+		}
+	}
+	return false
 }
@@ -54,14 +54,21 @@ func newRootCmd(setDefaults bool) (*cobra.Command, *Factory) {
 		newSetupCmd(factory),
 		newDashboardCmd(factory),
 		newDiagCmd(factory),
+		newDiagnosticsCmd(factory),
 		newRunCmd(factory),
 		newNodeInfoCmd(factory),
 		newIssueAPIKeyCmd(factory),
 		newGracefulExitInitCmd(factory),
 		newGracefulExitStatusCmd(factory),
+		newPieceIndexCmd(factory),
+		newStorageDirsCmd(factory),
+		newMigrateStorageCmd(factory),
+		newTrashCmd(factory),
 		// internal hidden commands
 		internalcmd.NewUsedSpaceFilewalkerCmd(),
 		internalcmd.NewGCFilewalkerCmd(),
+		internalcmd.NewTrashFilewalkerCmd(),
+		internalcmd.NewReconcileFilewalkerCmd(),
 	)
 
 	return cmd, factory
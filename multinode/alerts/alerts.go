@@ -0,0 +1,66 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+)
+
+// DB exposes needed by MND alerts history functionality.
+//
+// architecture: Database
+type DB interface {
+	// Insert stores a new active alert.
+	Insert(ctx context.Context, alert Alert) error
+	// Resolve marks the alert as resolved.
+	Resolve(ctx context.Context, id uuid.UUID, resolvedAt time.Time) error
+	// ListActive returns all the alerts, which aren't resolved.
+	ListActive(ctx context.Context) ([]Alert, error)
+	// ListPaged returns paginated alerts history from the newest alert.
+	ListPaged(ctx context.Context, cursor Cursor) (Page, error)
+}
+
+// Rule is the name of an alert rule.
+type Rule string
+
+const (
+	// RuleOffline fires, when a node is unreachable or doesn't contact the satellites.
+	RuleOffline Rule = "offline"
+	// RuleAuditScore fires, when the audit score of a node on a satellite is too low.
+	RuleAuditScore Rule = "audit-score"
+	// RuleDiskUsage fires, when a node uses too much of its allocated disk space.
+	RuleDiskUsage Rule = "disk-usage"
+)
+
+// Alert is fired by a rule for a node, until the rule doesn't match the node anymore.
+type Alert struct {
+	ID     uuid.UUID    `json:"id"`
+	Rule   Rule         `json:"rule"`
+	NodeID storj.NodeID `json:"nodeId"`
+	// SatelliteID is zero for the rules, which don't depend on a satellite.
+	SatelliteID storj.NodeID `json:"satelliteId"`
+	Message     string       `json:"message"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	ResolvedAt  *time.Time   `json:"resolvedAt"`
+}
+
+// Cursor holds alerts cursor entity which is used to create listed page.
+type Cursor struct {
+	Limit int64
+	Page  int64
+}
+
+// Page holds alerts page entity which is used to show listed page of alerts history.
+type Page struct {
+	Alerts      []Alert `json:"alerts"`
+	Offset      int64   `json:"offset"`
+	Limit       int64   `json:"limit"`
+	CurrentPage int64   `json:"currentPage"`
+	PageCount   int64   `json:"pageCount"`
+	TotalCount  int64   `json:"totalCount"`
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/multinode"
+	"storj.io/storj/multinode/alerts"
+	"storj.io/storj/multinode/multinodedb/multinodedbtest"
+)
+
+func TestAlertsDB(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		alertsDB := db.Alerts()
+
+		nodeID := testrand.NodeID()
+		offline := alerts.Alert{
+			ID:      testrand.UUID(),
+			Rule:    alerts.RuleOffline,
+			NodeID:  nodeID,
+			Message: "node is offline",
+		}
+		audit := alerts.Alert{
+			ID:          testrand.UUID(),
+			Rule:        alerts.RuleAuditScore,
+			NodeID:      nodeID,
+			SatelliteID: testrand.NodeID(),
+			Message:     "audit score is low",
+		}
+		require.NoError(t, alertsDB.Insert(ctx, offline))
+		require.NoError(t, alertsDB.Insert(ctx, audit))
+
+		active, err := alertsDB.ListActive(ctx)
+		require.NoError(t, err)
+		require.Len(t, active, 2)
+		for _, alert := range active {
+			require.Equal(t, nodeID, alert.NodeID)
+			require.Nil(t, alert.ResolvedAt)
+			switch alert.ID {
+			case offline.ID:
+				require.Equal(t, alerts.RuleOffline, alert.Rule)
+				require.Equal(t, storj.NodeID{}, alert.SatelliteID)
+			case audit.ID:
+				require.Equal(t, audit.SatelliteID, alert.SatelliteID)
+				require.Equal(t, audit.Message, alert.Message)
+			default:
+				t.Fatalf("unexpected alert %s", alert.ID)
+			}
+		}
+
+		resolvedAt := time.Now()
+		require.NoError(t, alertsDB.Resolve(ctx, offline.ID, resolvedAt))
+
+		active, err = alertsDB.ListActive(ctx)
+		require.NoError(t, err)
+		require.Len(t, active, 1)
+		require.Equal(t, audit.ID, active[0].ID)
+
+		page, err := alertsDB.ListPaged(ctx, alerts.Cursor{Limit: 1, Page: 2})
+		require.NoError(t, err)
+		require.EqualValues(t, 2, page.TotalCount)
+		require.EqualValues(t, 2, page.PageCount)
+		require.EqualValues(t, 1, page.Offset)
+		require.Len(t, page.Alerts, 1)
+
+		page, err = alertsDB.ListPaged(ctx, alerts.Cursor{Limit: 10, Page: 1})
+		require.NoError(t, err)
+		require.Len(t, page.Alerts, 2)
+		for _, alert := range page.Alerts {
+			if alert.ID == offline.ID {
+				require.NotNil(t, alert.ResolvedAt)
+				require.WithinDuration(t, resolvedAt, *alert.ResolvedAt, time.Second)
+			}
+		}
+	})
+}
@@ -0,0 +1,183 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/mail"
+	"net/smtp"
+	"net/url"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/private/post"
+)
+
+// ErrNotify is an error class for alert notification errors.
+var ErrNotify = errs.Class("alert notification")
+
+// Notification is sent, when an alert is fired or resolved.
+type Notification struct {
+	Alert    Alert `json:"alert"`
+	Resolved bool  `json:"resolved"`
+}
+
+// subject returns the short description of the notification.
+func (notification Notification) subject() string {
+	if notification.Resolved {
+		return "Resolved: " + notification.Alert.Message
+	}
+	return "Alert: " + notification.Alert.Message
+}
+
+// notifier sends the notifications through a channel.
+type notifier interface {
+	Name() string
+	Notify(ctx context.Context, notification Notification) error
+}
+
+// newNotifiers creates the notifiers, which are configured.
+func newNotifiers(config Config) (notifiers []notifier, err error) {
+	if config.WebhookURL != "" {
+		webhook, err := newWebhookNotifier(config.WebhookURL)
+		if err != nil {
+			return nil, err
+		}
+		notifiers = append(notifiers, webhook)
+	}
+	if config.SMTPServerAddress != "" && config.EmailTo != "" {
+		email, err := newEmailNotifier(config)
+		if err != nil {
+			return nil, err
+		}
+		notifiers = append(notifiers, email)
+	}
+	return notifiers, nil
+}
+
+// webhookNotifier posts the notifications as json to an url.
+type webhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+func newWebhookNotifier(webhookURL string) (*webhookNotifier, error) {
+	parsed, err := url.Parse(webhookURL)
+	if err != nil {
+		return nil, ErrNotify.New("webhook url %q couldn't be parsed: %v", webhookURL, err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nil, ErrNotify.New("webhook url %q must use http or https", webhookURL)
+	}
+
+	return &webhookNotifier{
+		url:    webhookURL,
+		client: &http.Client{},
+	}, nil
+}
+
+// Name implements notifier.
+func (webhook *webhookNotifier) Name() string { return "webhook" }
+
+// Notify implements notifier.
+func (webhook *webhookNotifier) Notify(ctx context.Context, notification Notification) (err error) {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return ErrNotify.Wrap(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.url, bytes.NewReader(body))
+	if err != nil {
+		return ErrNotify.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := webhook.client.Do(req)
+	if err != nil {
+		return ErrNotify.Wrap(err)
+	}
+	defer func() { err = ErrNotify.Wrap(errs.Combine(err, resp.Body.Close())) }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return ErrNotify.New("webhook responded with %s", resp.Status)
+	}
+	return nil
+}
+
+// emailNotifier sends the notifications by email.
+type emailNotifier struct {
+	sender *post.SMTPSender
+	to     []post.Address
+}
+
+func newEmailNotifier(config Config) (*emailNotifier, error) {
+	host, _, err := net.SplitHostPort(config.SMTPServerAddress)
+	if err != nil {
+		return nil, ErrNotify.New("smtp server address %q couldn't be parsed: %v", config.SMTPServerAddress, err)
+	}
+
+	to, err := mail.ParseAddressList(config.EmailTo)
+	if err != nil {
+		return nil, ErrNotify.New("email recipients %q couldn't be parsed: %v", config.EmailTo, err)
+	}
+
+	fromAddress := config.EmailFrom
+	if fromAddress == "" {
+		fromAddress = to[0].Address
+	}
+	from, err := mail.ParseAddress(fromAddress)
+	if err != nil {
+		return nil, ErrNotify.New("email sender %q couldn't be parsed: %v", fromAddress, err)
+	}
+
+	email := &emailNotifier{
+		sender: &post.SMTPSender{
+			ServerAddress: config.SMTPServerAddress,
+			From:          *from,
+		},
+	}
+	if config.SMTPLogin != "" {
+		email.sender.Auth = smtp.PlainAuth("", config.SMTPLogin, config.SMTPPassword, host)
+	}
+	for _, address := range to {
+		email.to = append(email.to, *address)
+	}
+	return email, nil
+}
+
+// Name implements notifier.
+func (email *emailNotifier) Name() string { return "email" }
+
+// Notify implements notifier.
+func (email *emailNotifier) Notify(ctx context.Context, notification Notification) error {
+	var body strings.Builder
+	body.WriteString(notification.Alert.Message)
+	body.WriteString("\n\nRule: ")
+	body.WriteString(string(notification.Alert.Rule))
+	body.WriteString("\nNode ID: ")
+	body.WriteString(notification.Alert.NodeID.String())
+	if !notification.Alert.SatelliteID.IsZero() {
+		body.WriteString("\nSatellite ID: ")
+		body.WriteString(notification.Alert.SatelliteID.String())
+	}
+	body.WriteString("\nFired at: ")
+	body.WriteString(notification.Alert.CreatedAt.UTC().String())
+	if notification.Alert.ResolvedAt != nil {
+		body.WriteString("\nResolved at: ")
+		body.WriteString(notification.Alert.ResolvedAt.UTC().String())
+	}
+
+	return ErrNotify.Wrap(email.sender.SendEmail(ctx, &post.Message{
+		From:      email.sender.From,
+		To:        email.to,
+		Subject:   "[Multinode Dashboard] " + notification.subject(),
+		Date:      notification.Alert.CreatedAt,
+		PlainText: body.String(),
+	}))
+}
@@ -0,0 +1,362 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package alerts implements the alert rules, which are evaluated against the state of
+// the nodes, e.g. when a node is offline, and their notifications and history.
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/multinode/nodes"
+	"storj.io/storj/private/multinodepb"
+)
+
+var (
+	mon = monkit.Package()
+	// Error is an error class for alerts service error.
+	Error = errs.Class("alerts")
+)
+
+// MaxAlertsOnPage defines maximum limit on alerts history page.
+const MaxAlertsOnPage = 100
+
+// Config contains the alert rules and the notification channels.
+type Config struct {
+	Interval      time.Duration `help:"how often the alert rules are evaluated, 0 to disable alerts" default:"5m"`
+	OfflineAfter  time.Duration `help:"alert when a node is unreachable or doesn't contact the satellites for longer than this, 0 to disable the rule" default:"30m"`
+	MinAuditScore float64       `help:"alert when the audit score of a node on a satellite drops below this, 0 to disable the rule" default:"0.98"`
+	MaxDiskUsage  float64       `help:"alert when a node uses more than this percentage of its allocated disk space, 0 to disable the rule" default:"95"`
+
+	WebhookURL        string        `help:"url, to which the alerts are posted as json, empty to disable" default:""`
+	SMTPServerAddress string        `help:"smtp server address, through which the alerts are emailed, empty to disable" default:""`
+	SMTPLogin         string        `help:"smtp server login" default:""`
+	SMTPPassword      string        `help:"smtp server password" default:""`
+	EmailFrom         string        `help:"sender of the alert emails, defaults to the first recipient" default:""`
+	EmailTo           string        `help:"comma separated list of recipients of the alert emails" default:""`
+	NotifyTimeout     time.Duration `help:"timeout of sending a notification" default:"30s"`
+}
+
+// alertKey identifies the alerts of a rule for a node, which are active at the same time.
+type alertKey struct {
+	rule        Rule
+	nodeID      storj.NodeID
+	satelliteID storj.NodeID
+}
+
+// nodeState is the state of a node, against which the rules are evaluated.
+type nodeState struct {
+	lastContact time.Time
+	// diskUsage is the percentage of the allocated disk space, which is used.
+	diskUsage   float64
+	used        int64
+	allocated   int64
+	auditScores map[storj.NodeID]float64
+}
+
+// Service evaluates the alert rules against the state of the nodes and notifies about the
+// fired and resolved alerts.
+//
+// architecture: Chore
+type Service struct {
+	log       *zap.Logger
+	dialer    rpc.Dialer
+	nodes     nodes.DB
+	db        DB
+	config    Config
+	notifiers []notifier
+
+	Loop *sync2.Cycle
+
+	startedAt time.Time
+	// lastReachable is when the nodes were reachable the last time.
+	lastReachable map[storj.NodeID]time.Time
+}
+
+// NewService creates new instance of alerts Service.
+func NewService(log *zap.Logger, dialer rpc.Dialer, nodes nodes.DB, db DB, config Config) (*Service, error) {
+	notifiers, err := newNotifiers(config)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &Service{
+		log:       log,
+		dialer:    dialer,
+		nodes:     nodes,
+		db:        db,
+		config:    config,
+		notifiers: notifiers,
+
+		Loop: sync2.NewCycle(config.Interval),
+
+		startedAt:     time.Now(),
+		lastReachable: make(map[storj.NodeID]time.Time),
+	}, nil
+}
+
+// Run evaluates the alert rules periodically.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := service.Evaluate(ctx); err != nil {
+			service.log.Error("failed to evaluate alert rules", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the service.
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// Active returns the alerts, which aren't resolved.
+func (service *Service) Active(ctx context.Context) (_ []Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	active, err := service.db.ListActive(ctx)
+	return active, Error.Wrap(err)
+}
+
+// ListPaginated returns paginated alerts history.
+func (service *Service) ListPaginated(ctx context.Context, cursor Cursor) (_ Page, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if cursor.Limit > MaxAlertsOnPage {
+		cursor.Limit = MaxAlertsOnPage
+	}
+	if cursor.Limit < 1 {
+		cursor.Limit = 1
+	}
+	if cursor.Page < 1 {
+		return Page{}, Error.New("page can not be less than 1")
+	}
+
+	page, err := service.db.ListPaged(ctx, cursor)
+	return page, Error.Wrap(err)
+}
+
+// Evaluate evaluates the alert rules against the current state of the nodes. The alerts,
+// which the rules fire, are stored and notified about, and the active alerts, which the
+// rules don't fire anymore, are resolved.
+func (service *Service) Evaluate(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodeList, err := service.nodes.List(ctx)
+	if err != nil && !nodes.ErrNoNode.Has(err) {
+		return Error.Wrap(err)
+	}
+
+	now := time.Now()
+	firing := make(map[alertKey]string)
+	// evaluated are the nodes, for which all the rules were evaluated. Only the offline rule
+	// is evaluated for the unreachable nodes, so that their active alerts aren't resolved.
+	evaluated := make(map[storj.NodeID]bool)
+	listed := make(map[storj.NodeID]bool)
+
+	for _, node := range nodeList {
+		listed[node.ID] = true
+		name := node.Name
+		if name == "" {
+			name = node.ID.String()
+		}
+
+		state, err := service.collect(ctx, node)
+		if err != nil {
+			if !nodes.ErrNodeNotReachable.Has(err) {
+				service.log.Warn("failed to collect node state", zap.Stringer("Node ID", node.ID), zap.Error(err))
+				continue
+			}
+
+			lastReachable, ok := service.lastReachable[node.ID]
+			if !ok {
+				lastReachable = service.startedAt
+			}
+			if service.config.OfflineAfter > 0 && now.Sub(lastReachable) > service.config.OfflineAfter {
+				firing[alertKey{rule: RuleOffline, nodeID: node.ID}] = fmt.Sprintf("node %s is unreachable since %s", name, lastReachable.UTC().Format(time.RFC3339))
+			}
+			continue
+		}
+
+		service.lastReachable[node.ID] = now
+		evaluated[node.ID] = true
+
+		for key, message := range service.evaluateRules(now, node.ID, name, state) {
+			firing[key] = message
+		}
+	}
+
+	active, err := service.db.ListActive(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var group errs.Group
+	for _, alert := range active {
+		key := alertKey{rule: alert.Rule, nodeID: alert.NodeID, satelliteID: alert.SatelliteID}
+		if _, ok := firing[key]; ok {
+			delete(firing, key)
+			continue
+		}
+
+		// the alerts of an unreachable node are kept, until it's reachable again.
+		if listed[alert.NodeID] && !evaluated[alert.NodeID] {
+			continue
+		}
+
+		resolvedAt := now
+		if err := service.db.Resolve(ctx, alert.ID, resolvedAt); err != nil {
+			group.Add(err)
+			continue
+		}
+		alert.ResolvedAt = &resolvedAt
+		service.notify(ctx, Notification{Alert: alert, Resolved: true})
+	}
+
+	for key, message := range firing {
+		id, err := uuid.New()
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		alert := Alert{
+			ID:          id,
+			Rule:        key.rule,
+			NodeID:      key.nodeID,
+			SatelliteID: key.satelliteID,
+			Message:     message,
+			CreatedAt:   now,
+		}
+		if err := service.db.Insert(ctx, alert); err != nil {
+			group.Add(err)
+			continue
+		}
+		service.notify(ctx, Notification{Alert: alert})
+	}
+
+	return Error.Wrap(group.Err())
+}
+
+// evaluateRules returns the alerts, which the rules fire for a reachable node.
+func (service *Service) evaluateRules(now time.Time, nodeID storj.NodeID, name string, state nodeState) map[alertKey]string {
+	firing := make(map[alertKey]string)
+
+	if service.config.OfflineAfter > 0 && !state.lastContact.IsZero() && now.Sub(state.lastContact) > service.config.OfflineAfter {
+		firing[alertKey{rule: RuleOffline, nodeID: nodeID}] = fmt.Sprintf("node %s hasn't contacted the satellites since %s", name, state.lastContact.UTC().Format(time.RFC3339))
+	}
+
+	if service.config.MaxDiskUsage > 0 && state.diskUsage > service.config.MaxDiskUsage {
+		firing[alertKey{rule: RuleDiskUsage, nodeID: nodeID}] = fmt.Sprintf("node %s uses %.1f%% of its allocated disk space (%s of %s)",
+			name, state.diskUsage, memory.Size(state.used), memory.Size(state.allocated))
+	}
+
+	if service.config.MinAuditScore > 0 {
+		for satelliteID, score := range state.auditScores {
+			if score < service.config.MinAuditScore {
+				firing[alertKey{rule: RuleAuditScore, nodeID: nodeID, satelliteID: satelliteID}] = fmt.Sprintf("audit score of node %s on satellite %s dropped to %.2f%%",
+					name, satelliteID, score*100)
+			}
+		}
+	}
+
+	return firing
+}
+
+// collect dials the node and retrieves its state.
+func (service *Service) collect(ctx context.Context, node nodes.Node) (_ nodeState, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := service.dialer.DialNodeURL(ctx, storj.NodeURL{
+		ID:      node.ID,
+		Address: node.PublicAddress,
+	})
+	if err != nil {
+		return nodeState{}, nodes.ErrNodeNotReachable.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, conn.Close())
+	}()
+
+	nodeClient := multinodepb.NewDRPCNodeClient(conn)
+	storageClient := multinodepb.NewDRPCStorageClient(conn)
+	header := &multinodepb.RequestHeader{
+		ApiKey: node.APISecret[:],
+	}
+
+	lastContact, err := nodeClient.LastContact(ctx, &multinodepb.LastContactRequest{Header: header})
+	if err != nil {
+		return nodeState{}, err
+	}
+
+	diskSpace, err := storageClient.DiskSpace(ctx, &multinodepb.DiskSpaceRequest{Header: header})
+	if err != nil {
+		return nodeState{}, err
+	}
+
+	state := nodeState{
+		lastContact: lastContact.LastContact,
+		used:        diskSpace.GetUsedPieces() + diskSpace.GetUsedTrash(),
+		allocated:   diskSpace.GetAllocated(),
+		auditScores: make(map[storj.NodeID]float64),
+	}
+	if state.allocated > 0 {
+		state.diskUsage = float64(state.used) / float64(state.allocated) * 100
+	}
+
+	trusted, err := nodeClient.TrustedSatellites(ctx, &multinodepb.TrustedSatellitesRequest{Header: header})
+	if err != nil {
+		return nodeState{}, err
+	}
+	for _, satellite := range trusted.TrustedSatellites {
+		reputation, err := nodeClient.Reputation(ctx, &multinodepb.ReputationRequest{
+			Header:      header,
+			SatelliteId: satellite.NodeId,
+		})
+		if err != nil {
+			if rpcstatus.Code(err) == rpcstatus.NotFound {
+				continue
+			}
+			return nodeState{}, err
+		}
+		// the disqualified nodes aren't audited anymore.
+		if reputation.DisqualifiedAt != nil {
+			continue
+		}
+		state.auditScores[satellite.NodeId] = reputation.Audit.Score
+	}
+
+	return state, nil
+}
+
+// notify sends the notification through all the notifiers.
+func (service *Service) notify(ctx context.Context, notification Notification) {
+	for _, notifier := range service.notifiers {
+		func() {
+			ctx, cancel := context.WithTimeout(ctx, service.config.NotifyTimeout)
+			defer cancel()
+
+			if err := notifier.Notify(ctx, notification); err != nil {
+				service.log.Warn("failed to send alert notification",
+					zap.String("Notifier", notifier.Name()),
+					zap.String("Rule", string(notification.Alert.Rule)),
+					zap.Stringer("Node ID", notification.Alert.NodeID),
+					zap.Error(err))
+			}
+		}()
+	}
+}
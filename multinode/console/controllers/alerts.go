@@ -0,0 +1,102 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/multinode/alerts"
+)
+
+var (
+	// ErrAlerts is an error type for alerts web api controller.
+	ErrAlerts = errs.Class("alerts web api controller")
+)
+
+// Alerts is an alerts web api controller.
+type Alerts struct {
+	log     *zap.Logger
+	service *alerts.Service
+}
+
+// NewAlerts is a constructor of alerts controller.
+func NewAlerts(log *zap.Logger, service *alerts.Service) *Alerts {
+	return &Alerts{
+		log:     log,
+		service: service,
+	}
+}
+
+// List handles retrieval of the alerts history.
+func (controller *Alerts) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	limit, page, err := parsePagination(r, alerts.MaxAlertsOnPage)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrAlerts.Wrap(err))
+		return
+	}
+
+	history, err := controller.service.ListPaginated(ctx, alerts.Cursor{
+		Limit: limit,
+		Page:  page,
+	})
+	if err != nil {
+		controller.log.Error("alerts history internal error", zap.Error(ErrAlerts.Wrap(err)))
+		controller.serveError(w, http.StatusInternalServerError, ErrAlerts.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(history); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(ErrAlerts.Wrap(err)))
+		return
+	}
+}
+
+// Active handles retrieval of the alerts, which aren't resolved.
+func (controller *Alerts) Active(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	active, err := controller.service.Active(ctx)
+	if err != nil {
+		controller.log.Error("active alerts internal error", zap.Error(ErrAlerts.Wrap(err)))
+		controller.serveError(w, http.StatusInternalServerError, ErrAlerts.Wrap(err))
+		return
+	}
+
+	if len(active) == 0 {
+		active = make([]alerts.Alert, 0)
+	}
+	if err = json.NewEncoder(w).Encode(active); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(ErrAlerts.Wrap(err)))
+		return
+	}
+}
+
+// serveError set http statuses and send json error.
+func (controller *Alerts) serveError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(err))
+	}
+}
@@ -6,9 +6,13 @@ package controllers
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
 
 	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+
+	"storj.io/storj/multinode/nodes"
 )
 
 var (
@@ -43,3 +47,48 @@ func (handler *NotFound) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		handler.log.Error("failed to write json error response", zap.Error(err))
 	}
 }
+
+// parsePagination parses the limit and page query parameters. The first page is returned,
+// if the page is missing.
+func parsePagination(r *http.Request, defaultLimit int64) (limit, page int64, err error) {
+	limit, page = defaultLimit, 1
+	if limitParam := r.URL.Query().Get("limit"); limitParam != "" {
+		limit, err = strconv.ParseInt(limitParam, 10, 64)
+		if err != nil {
+			return 0, 0, errs.New("invalid limit: %w", err)
+		}
+	}
+	if pageParam := r.URL.Query().Get("page"); pageParam != "" {
+		page, err = strconv.ParseInt(pageParam, 10, 64)
+		if err != nil {
+			return 0, 0, errs.New("invalid page: %w", err)
+		}
+	}
+	return limit, page, nil
+}
+
+// SelectLabels limits the nodes, which the API aggregates, to the nodes with the labels of
+// the labels query parameter, e.g. ?labels=location:berlin,hardware:rpi4.
+func SelectLabels(log *zap.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			selector, err := nodes.ParseSelector(r.URL.Query().Get("labels"))
+			if err != nil {
+				w.Header().Add("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+
+				var response struct {
+					Error string `json:"error"`
+				}
+				response.Error = err.Error()
+
+				if err := json.NewEncoder(w).Encode(response); err != nil {
+					log.Error("failed to write json error response", zap.Error(err))
+				}
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(nodes.WithSelector(r.Context(), selector)))
+		})
+	}
+}
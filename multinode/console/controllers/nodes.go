@@ -121,6 +121,90 @@ func (controller *Nodes) UpdateName(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// SetLabel handles setting the label of the node.
+func (controller *Nodes) SetLabel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	segmentParams := mux.Vars(r)
+
+	id, err := storj.NodeIDFromString(segmentParams["id"])
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrNodes.Wrap(err))
+		return
+	}
+
+	var payload struct {
+		Value string `json:"value"`
+	}
+
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrNodes.Wrap(err))
+		return
+	}
+
+	err = controller.service.SetLabel(ctx, id, segmentParams["name"], payload.Value)
+	if err != nil {
+		switch {
+		case nodes.ErrInvalidLabel.Has(err):
+			controller.serveError(w, http.StatusBadRequest, ErrNodes.Wrap(err))
+		case nodes.ErrNoNode.Has(err):
+			controller.serveError(w, http.StatusNotFound, ErrNodes.Wrap(err))
+		default:
+			controller.log.Error("set node label internal error", zap.Error(err))
+			controller.serveError(w, http.StatusInternalServerError, ErrNodes.Wrap(err))
+		}
+		return
+	}
+}
+
+// RemoveLabel handles removing the label of the node.
+func (controller *Nodes) RemoveLabel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	segmentParams := mux.Vars(r)
+
+	id, err := storj.NodeIDFromString(segmentParams["id"])
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrNodes.Wrap(err))
+		return
+	}
+
+	if err = controller.service.RemoveLabel(ctx, id, segmentParams["name"]); err != nil {
+		controller.log.Error("remove node label internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrNodes.Wrap(err))
+		return
+	}
+}
+
+// Groups handles retrieval of the groups of nodes by label.
+func (controller *Nodes) Groups(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	groups, err := controller.service.Groups(ctx)
+	if err != nil {
+		controller.log.Error("list node groups internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrNodes.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(groups); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
 // Get handles retrieving node by id.
 func (controller *Nodes) Get(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
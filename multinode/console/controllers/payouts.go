@@ -226,6 +226,49 @@ func (controller *Payouts) SummarySatellite(w http.ResponseWriter, r *http.Reque
 	}
 }
 
+// History handles retrieval of payout history of all nodes from specific satellite, aggregated by period.
+func (controller *Payouts) History(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+	segmentParams := mux.Vars(r)
+
+	id, ok := segmentParams["id"]
+	if !ok {
+		controller.serveError(w, http.StatusBadRequest, ErrPayouts.New("couldn't receive route variable satellite id"))
+		return
+	}
+
+	satelliteID, err := storj.NodeIDFromString(id)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrPayouts.Wrap(err))
+		return
+	}
+
+	limit, page, err := parsePagination(r, payouts.MaxPeriodsOnPage)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrPayouts.Wrap(err))
+		return
+	}
+
+	history, err := controller.service.History(ctx, satelliteID, payouts.HistoryCursor{
+		Limit: limit,
+		Page:  page,
+	})
+	if err != nil {
+		controller.log.Error("payout history internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrPayouts.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(history); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
 // Paystub returns all summed paystubs.
 func (controller *Payouts) Paystub(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -76,6 +76,48 @@ func (controller *Reputation) Stats(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// Trend handles retrieval of reputation scores and online trend of all nodes for particular satellite.
+func (controller *Reputation) Trend(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+	segments := mux.Vars(r)
+
+	satelliteIDEnc, ok := segments["satelliteID"]
+	if !ok {
+		controller.serveError(w, http.StatusBadRequest, ErrReputation.New("could not retrieve satellite id segment"))
+		return
+	}
+	satelliteID, err := storj.NodeIDFromString(satelliteIDEnc)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrReputation.Wrap(err))
+		return
+	}
+
+	limit, page, err := parsePagination(r, reputation.MaxWindowsOnPage)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrReputation.Wrap(err))
+		return
+	}
+
+	trend, err := controller.service.Trend(ctx, satelliteID, reputation.TrendCursor{
+		Limit: limit,
+		Page:  page,
+	})
+	if err != nil {
+		controller.log.Error("reputation trend internal error", zap.Error(ErrReputation.Wrap(err)))
+		controller.serveError(w, http.StatusInternalServerError, ErrReputation.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(trend); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(ErrReputation.Wrap(err)))
+		return
+	}
+}
+
 // serveError set http statuses and send json error.
 func (controller *Reputation) serveError(w http.ResponseWriter, status int, err error) {
 	w.WriteHeader(status)
@@ -0,0 +1,159 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/multinode/nodes"
+	"storj.io/storj/multinode/settings"
+)
+
+var (
+	// ErrSettings is an error type for settings web api controller.
+	ErrSettings = errs.Class("settings web api controller")
+)
+
+// Settings is a node settings web api controller.
+type Settings struct {
+	log     *zap.Logger
+	service *settings.Service
+}
+
+// NewSettings is a constructor of settings controller.
+func NewSettings(log *zap.Logger, service *settings.Service) *Settings {
+	return &Settings{
+		log:     log,
+		service: service,
+	}
+}
+
+// Get handles retrieval of the settings of a node.
+func (controller *Settings) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	nodeID, err := storj.NodeIDFromString(mux.Vars(r)["nodeID"])
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrSettings.Wrap(err))
+		return
+	}
+
+	nodeSettings, err := controller.service.Get(ctx, nodeID)
+	if err != nil {
+		controller.serveServiceError(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(nodeSettings); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(ErrSettings.Wrap(err)))
+		return
+	}
+}
+
+// Update handles changing the settings of a node.
+func (controller *Settings) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	nodeID, err := storj.NodeIDFromString(mux.Vars(r)["nodeID"])
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrSettings.Wrap(err))
+		return
+	}
+
+	var update settings.Update
+	if err = json.NewDecoder(r.Body).Decode(&update); err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrSettings.Wrap(err))
+		return
+	}
+
+	nodeSettings, err := controller.service.Update(ctx, nodeID, update)
+	if err != nil {
+		controller.serveServiceError(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(nodeSettings); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(ErrSettings.Wrap(err)))
+		return
+	}
+}
+
+// History handles retrieval of the history of the changes of the settings of a node.
+func (controller *Settings) History(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	nodeID, err := storj.NodeIDFromString(mux.Vars(r)["nodeID"])
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrSettings.Wrap(err))
+		return
+	}
+
+	limit, page, err := parsePagination(r, settings.MaxChangesOnPage)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrSettings.Wrap(err))
+		return
+	}
+
+	history, err := controller.service.History(ctx, nodeID, settings.Cursor{
+		Limit: limit,
+		Page:  page,
+	})
+	if err != nil {
+		controller.serveServiceError(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(history); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(ErrSettings.Wrap(err)))
+		return
+	}
+}
+
+// serveServiceError maps the settings service errors to http statuses.
+func (controller *Settings) serveServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case nodes.ErrNoNode.Has(err), nodes.ErrNodeNotReachable.Has(err):
+		controller.serveError(w, http.StatusNotFound, ErrSettings.Wrap(err))
+	case settings.ErrUpdatesNotAllowed.Has(err):
+		controller.serveError(w, http.StatusForbidden, ErrSettings.Wrap(err))
+	case settings.ErrInvalidSettings.Has(err):
+		controller.serveError(w, http.StatusBadRequest, ErrSettings.Wrap(err))
+	default:
+		controller.log.Error("settings internal error", zap.Error(ErrSettings.Wrap(err)))
+		controller.serveError(w, http.StatusInternalServerError, ErrSettings.Wrap(err))
+	}
+}
+
+// serveError set http statuses and send json error.
+func (controller *Settings) serveError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(err))
+	}
+}
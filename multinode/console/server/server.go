@@ -17,12 +17,14 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/common/errs2"
+	"storj.io/storj/multinode/alerts"
 	"storj.io/storj/multinode/bandwidth"
 	"storj.io/storj/multinode/console/controllers"
 	"storj.io/storj/multinode/nodes"
 	"storj.io/storj/multinode/operators"
 	"storj.io/storj/multinode/payouts"
 	"storj.io/storj/multinode/reputation"
+	"storj.io/storj/multinode/settings"
 	"storj.io/storj/multinode/storage"
 	"storj.io/storj/private/web"
 )
@@ -46,6 +48,8 @@ type Services struct {
 	Storage    *storage.Service
 	Bandwidth  *bandwidth.Service
 	Reputation *reputation.Service
+	Alerts     *alerts.Service
+	Settings   *settings.Service
 }
 
 // Server represents Multinode Dashboard http server.
@@ -63,6 +67,8 @@ type Server struct {
 	bandwidth  *bandwidth.Service
 	storage    *storage.Service
 	reputation *reputation.Service
+	alerts     *alerts.Service
+	settings   *settings.Service
 }
 
 // NewServer returns new instance of Multinode Dashboard http server.
@@ -77,12 +83,15 @@ func NewServer(log *zap.Logger, listener net.Listener, assets fs.FS, services Se
 		storage:    services.Storage,
 		bandwidth:  services.Bandwidth,
 		reputation: services.Reputation,
+		alerts:     services.Alerts,
+		settings:   services.Settings,
 	}
 
 	router := mux.NewRouter()
 
 	apiRouter := router.PathPrefix("/api/v0").Subrouter()
 	apiRouter.NotFoundHandler = controllers.NewNotFound(server.log)
+	apiRouter.Use(controllers.SelectLabels(server.log))
 
 	nodesController := controllers.NewNodes(server.log, server.nodes)
 	nodesRouter := apiRouter.PathPrefix("/nodes").Subrouter()
@@ -90,9 +99,12 @@ func NewServer(log *zap.Logger, listener net.Listener, assets fs.FS, services Se
 	nodesRouter.HandleFunc("/infos", nodesController.ListInfos).Methods(http.MethodGet)
 	nodesRouter.HandleFunc("/infos/{satelliteID}", nodesController.ListInfosSatellite).Methods(http.MethodGet)
 	nodesRouter.HandleFunc("/trusted-satellites", nodesController.TrustedSatellites).Methods(http.MethodGet)
+	nodesRouter.HandleFunc("/groups", nodesController.Groups).Methods(http.MethodGet)
 	nodesRouter.HandleFunc("/{id}", nodesController.Get).Methods(http.MethodGet)
 	nodesRouter.HandleFunc("/{id}", nodesController.UpdateName).Methods(http.MethodPatch)
 	nodesRouter.HandleFunc("/{id}", nodesController.Delete).Methods(http.MethodDelete)
+	nodesRouter.HandleFunc("/{id}/labels/{name}", nodesController.SetLabel).Methods(http.MethodPut)
+	nodesRouter.HandleFunc("/{id}/labels/{name}", nodesController.RemoveLabel).Methods(http.MethodDelete)
 
 	operatorsController := controllers.NewOperators(server.log, server.operators)
 	operatorsRouter := apiRouter.PathPrefix("/operators").Subrouter()
@@ -118,6 +130,7 @@ func NewServer(log *zap.Logger, listener net.Listener, assets fs.FS, services Se
 	payoutsRouter.HandleFunc("/satellites/{id}/summaries", payoutsController.SummarySatellite).Methods(http.MethodGet)
 	payoutsRouter.HandleFunc("/satellites/{id}/summaries/{period}", payoutsController.SummarySatellitePeriod).Methods(http.MethodGet)
 	payoutsRouter.HandleFunc("/satellites/{id}/paystubs/{nodeID}", payoutsController.PaystubSatellite).Methods(http.MethodGet)
+	payoutsRouter.HandleFunc("/satellites/{id}/history", payoutsController.History).Methods(http.MethodGet)
 	payoutsRouter.HandleFunc("/satellites/{id}/paystubs/{period}/{nodeID}", payoutsController.PaystubSatellitePeriod).Methods(http.MethodGet)
 
 	storageController := controllers.NewStorage(server.log, server.storage)
@@ -132,6 +145,18 @@ func NewServer(log *zap.Logger, listener net.Listener, assets fs.FS, services Se
 	reputationController := controllers.NewReputation(server.log, server.reputation)
 	reputationRouter := apiRouter.PathPrefix("/reputation").Subrouter()
 	reputationRouter.HandleFunc("/satellites/{satelliteID}", reputationController.Stats)
+	reputationRouter.HandleFunc("/satellites/{satelliteID}/trend", reputationController.Trend).Methods(http.MethodGet)
+
+	settingsController := controllers.NewSettings(server.log, server.settings)
+	settingsRouter := apiRouter.PathPrefix("/settings").Subrouter()
+	settingsRouter.HandleFunc("/{nodeID}", settingsController.Get).Methods(http.MethodGet)
+	settingsRouter.HandleFunc("/{nodeID}", settingsController.Update).Methods(http.MethodPatch)
+	settingsRouter.HandleFunc("/{nodeID}/history", settingsController.History).Methods(http.MethodGet)
+
+	alertsController := controllers.NewAlerts(server.log, server.alerts)
+	alertsRouter := apiRouter.PathPrefix("/alerts").Subrouter()
+	alertsRouter.HandleFunc("", alertsController.List).Methods(http.MethodGet)
+	alertsRouter.HandleFunc("/active", alertsController.Active).Methods(http.MethodGet)
 
 	staticServer := http.FileServer(http.FS(server.assets))
 	router.PathPrefix("/static").Handler(web.CacheHandler(staticServer))
@@ -0,0 +1,143 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multinodedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/multinode/alerts"
+	"storj.io/storj/multinode/multinodedb/dbx"
+)
+
+// ErrAlertsDB indicates about internal AlertsDB error.
+var ErrAlertsDB = errs.Class("AlertsDB")
+
+// ensures that alertsdb implements alerts.DB.
+var _ alerts.DB = (*alertsdb)(nil)
+
+// alertsdb stores the alerts history.
+//
+// architecture: Database
+type alertsdb struct {
+	methods dbx.Methods
+}
+
+// Insert stores a new active alert.
+func (db *alertsdb) Insert(ctx context.Context, alert alerts.Alert) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.methods.CreateNoReturn_Alert(ctx,
+		dbx.Alert_Id(alert.ID.Bytes()),
+		dbx.Alert_Rule(string(alert.Rule)),
+		dbx.Alert_NodeId(alert.NodeID.Bytes()),
+		dbx.Alert_SatelliteId(alert.SatelliteID.Bytes()),
+		dbx.Alert_Message(alert.Message),
+		dbx.Alert_Create_Fields{},
+	)
+
+	return ErrAlertsDB.Wrap(err)
+}
+
+// Resolve marks the alert as resolved.
+func (db *alertsdb) Resolve(ctx context.Context, id uuid.UUID, resolvedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.methods.UpdateNoReturn_Alert_By_Id(ctx, dbx.Alert_Id(id.Bytes()), dbx.Alert_Update_Fields{
+		ResolvedAt: dbx.Alert_ResolvedAt(resolvedAt.UTC()),
+	})
+
+	return ErrAlertsDB.Wrap(err)
+}
+
+// ListActive returns all the alerts, which aren't resolved.
+func (db *alertsdb) ListActive(ctx context.Context) (_ []alerts.Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxAlerts, err := db.methods.All_Alert_By_ResolvedAt_Is_Null(ctx)
+	if err != nil {
+		return nil, ErrAlertsDB.Wrap(err)
+	}
+
+	active := make([]alerts.Alert, 0, len(dbxAlerts))
+	for _, dbxAlert := range dbxAlerts {
+		alert, err := fromDBXAlert(dbxAlert)
+		if err != nil {
+			return nil, ErrAlertsDB.Wrap(err)
+		}
+		active = append(active, alert)
+	}
+
+	return active, nil
+}
+
+// ListPaged returns paginated alerts history from the newest alert.
+func (db *alertsdb) ListPaged(ctx context.Context, cursor alerts.Cursor) (page alerts.Page, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	page = alerts.Page{
+		Alerts:      []alerts.Alert{},
+		CurrentPage: cursor.Page,
+		Limit:       cursor.Limit,
+		Offset:      (cursor.Page - 1) * cursor.Limit,
+	}
+
+	page.TotalCount, err = db.methods.Count_Alert(ctx)
+	if err != nil {
+		return alerts.Page{}, ErrAlertsDB.Wrap(err)
+	}
+	page.PageCount = page.TotalCount / cursor.Limit
+	if page.TotalCount%cursor.Limit != 0 {
+		page.PageCount++
+	}
+
+	dbxAlerts, err := db.methods.Limited_Alert_OrderBy_Desc_CreatedAt(ctx, int(page.Limit), page.Offset)
+	if err != nil {
+		return alerts.Page{}, ErrAlertsDB.Wrap(err)
+	}
+	for _, dbxAlert := range dbxAlerts {
+		alert, err := fromDBXAlert(dbxAlert)
+		if err != nil {
+			return alerts.Page{}, ErrAlertsDB.Wrap(err)
+		}
+		page.Alerts = append(page.Alerts, alert)
+	}
+
+	return page, nil
+}
+
+// fromDBXAlert converts dbx.Alert to alerts.Alert.
+func fromDBXAlert(dbxAlert *dbx.Alert) (_ alerts.Alert, err error) {
+	id, err := uuid.FromBytes(dbxAlert.Id)
+	if err != nil {
+		return alerts.Alert{}, err
+	}
+
+	nodeID, err := storj.NodeIDFromBytes(dbxAlert.NodeId)
+	if err != nil {
+		return alerts.Alert{}, err
+	}
+
+	var satelliteID storj.NodeID
+	if len(dbxAlert.SatelliteId) > 0 {
+		satelliteID, err = storj.NodeIDFromBytes(dbxAlert.SatelliteId)
+		if err != nil {
+			return alerts.Alert{}, err
+		}
+	}
+
+	return alerts.Alert{
+		ID:          id,
+		Rule:        alerts.Rule(dbxAlert.Rule),
+		NodeID:      nodeID,
+		SatelliteID: satelliteID,
+		Message:     dbxAlert.Message,
+		CreatedAt:   dbxAlert.CreatedAt,
+		ResolvedAt:  dbxAlert.ResolvedAt,
+	}, nil
+}
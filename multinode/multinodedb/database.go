@@ -15,8 +15,10 @@ import (
 	"storj.io/private/dbutil/pgutil"
 	"storj.io/private/tagsql"
 	"storj.io/storj/multinode"
+	"storj.io/storj/multinode/alerts"
 	"storj.io/storj/multinode/multinodedb/dbx"
 	"storj.io/storj/multinode/nodes"
+	"storj.io/storj/multinode/settings"
 	"storj.io/storj/private/migrate"
 )
 
@@ -94,6 +96,20 @@ func (db *DB) Nodes() nodes.DB {
 	}
 }
 
+// Alerts returns alerts database.
+func (db *DB) Alerts() alerts.DB {
+	return &alertsdb{
+		methods: db,
+	}
+}
+
+// Settings returns node settings database.
+func (db *DB) Settings() settings.DB {
+	return &settingsdb{
+		methods: db,
+	}
+}
+
 // MigrateToLatest migrates db to the latest version.
 func (db DB) MigrateToLatest(ctx context.Context) error {
 	var migration *migrate.Migration
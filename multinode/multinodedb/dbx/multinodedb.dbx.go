@@ -275,13 +275,40 @@ func newpgx(db *DB) *pgxDB {
 }
 
 func (obj *pgxDB) Schema() string {
-	return `CREATE TABLE nodes (
+	return `CREATE TABLE alerts (
+	id bytea NOT NULL,
+	rule text NOT NULL,
+	node_id bytea NOT NULL,
+	satellite_id bytea NOT NULL,
+	message text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	resolved_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE nodes (
 	id bytea NOT NULL,
 	name text NOT NULL,
 	public_address text NOT NULL,
 	api_secret bytea NOT NULL,
 	PRIMARY KEY ( id )
-);`
+);
+CREATE TABLE node_labels (
+	node_id bytea NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+	name text NOT NULL,
+	value text NOT NULL,
+	PRIMARY KEY ( node_id, name )
+);
+CREATE TABLE node_setting_changes (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+	name text NOT NULL,
+	old_value text NOT NULL,
+	new_value text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE INDEX node_labels_name_value_index ON node_labels ( name, value );
+CREATE INDEX node_setting_changes_node_id_created_at_index ON node_setting_changes ( node_id, created_at );`
 }
 
 func (obj *pgxDB) wrapTx(tx tagsql.Tx) txMethods {
@@ -345,13 +372,40 @@ func newsqlite3(db *DB) *sqlite3DB {
 }
 
 func (obj *sqlite3DB) Schema() string {
-	return `CREATE TABLE nodes (
+	return `CREATE TABLE alerts (
+	id BLOB NOT NULL,
+	rule TEXT NOT NULL,
+	node_id BLOB NOT NULL,
+	satellite_id BLOB NOT NULL,
+	message TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	resolved_at TIMESTAMP,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE nodes (
 	id BLOB NOT NULL,
 	name TEXT NOT NULL,
 	public_address TEXT NOT NULL,
 	api_secret BLOB NOT NULL,
 	PRIMARY KEY ( id )
-);`
+);
+CREATE TABLE node_labels (
+	node_id BLOB NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	value TEXT NOT NULL,
+	PRIMARY KEY ( node_id, name )
+);
+CREATE TABLE node_setting_changes (
+	id BLOB NOT NULL,
+	node_id BLOB NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	old_value TEXT NOT NULL,
+	new_value TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE INDEX node_labels_name_value_index ON node_labels ( name, value );
+CREATE INDEX node_setting_changes_node_id_created_at_index ON node_setting_changes ( node_id, created_at );`
 }
 
 func (obj *sqlite3DB) wrapTx(tx tagsql.Tx) txMethods {
@@ -414,6 +468,172 @@ nextval:
 	fmt.Fprint(f, "]")
 }
 
+type Alert struct {
+	Id          []byte
+	Rule        string
+	NodeId      []byte
+	SatelliteId []byte
+	Message     string
+	CreatedAt   time.Time
+	ResolvedAt  *time.Time
+}
+
+func (Alert) _Table() string { return "alerts" }
+
+type Alert_Create_Fields struct {
+	ResolvedAt Alert_ResolvedAt_Field
+}
+
+type Alert_Update_Fields struct {
+	ResolvedAt Alert_ResolvedAt_Field
+}
+
+type Alert_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Alert_Id(v []byte) Alert_Id_Field {
+	return Alert_Id_Field{_set: true, _value: v}
+}
+
+func (f Alert_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Alert_Id_Field) _Column() string { return "id" }
+
+type Alert_Rule_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Alert_Rule(v string) Alert_Rule_Field {
+	return Alert_Rule_Field{_set: true, _value: v}
+}
+
+func (f Alert_Rule_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Alert_Rule_Field) _Column() string { return "rule" }
+
+type Alert_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Alert_NodeId(v []byte) Alert_NodeId_Field {
+	return Alert_NodeId_Field{_set: true, _value: v}
+}
+
+func (f Alert_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Alert_NodeId_Field) _Column() string { return "node_id" }
+
+type Alert_SatelliteId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Alert_SatelliteId(v []byte) Alert_SatelliteId_Field {
+	return Alert_SatelliteId_Field{_set: true, _value: v}
+}
+
+func (f Alert_SatelliteId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Alert_SatelliteId_Field) _Column() string { return "satellite_id" }
+
+type Alert_Message_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Alert_Message(v string) Alert_Message_Field {
+	return Alert_Message_Field{_set: true, _value: v}
+}
+
+func (f Alert_Message_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Alert_Message_Field) _Column() string { return "message" }
+
+type Alert_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Alert_CreatedAt(v time.Time) Alert_CreatedAt_Field {
+	return Alert_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f Alert_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Alert_CreatedAt_Field) _Column() string { return "created_at" }
+
+type Alert_ResolvedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Alert_ResolvedAt(v time.Time) Alert_ResolvedAt_Field {
+	return Alert_ResolvedAt_Field{_set: true, _value: &v}
+}
+
+func Alert_ResolvedAt_Raw(v *time.Time) Alert_ResolvedAt_Field {
+	if v == nil {
+		return Alert_ResolvedAt_Null()
+	}
+	return Alert_ResolvedAt(*v)
+}
+
+func Alert_ResolvedAt_Null() Alert_ResolvedAt_Field {
+	return Alert_ResolvedAt_Field{_set: true, _null: true}
+}
+
+func (f Alert_ResolvedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Alert_ResolvedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Alert_ResolvedAt_Field) _Column() string { return "resolved_at" }
+
 type Node struct {
 	Id            []byte
 	Name          string
@@ -503,6 +723,202 @@ func (f Node_ApiSecret_Field) value() interface{} {
 
 func (Node_ApiSecret_Field) _Column() string { return "api_secret" }
 
+type NodeLabel struct {
+	NodeId []byte
+	Name   string
+	Value  string
+}
+
+func (NodeLabel) _Table() string { return "node_labels" }
+
+type NodeLabel_Update_Fields struct {
+}
+
+type NodeLabel_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeLabel_NodeId(v []byte) NodeLabel_NodeId_Field {
+	return NodeLabel_NodeId_Field{_set: true, _value: v}
+}
+
+func (f NodeLabel_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeLabel_NodeId_Field) _Column() string { return "node_id" }
+
+type NodeLabel_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeLabel_Name(v string) NodeLabel_Name_Field {
+	return NodeLabel_Name_Field{_set: true, _value: v}
+}
+
+func (f NodeLabel_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeLabel_Name_Field) _Column() string { return "name" }
+
+type NodeLabel_Value_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeLabel_Value(v string) NodeLabel_Value_Field {
+	return NodeLabel_Value_Field{_set: true, _value: v}
+}
+
+func (f NodeLabel_Value_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeLabel_Value_Field) _Column() string { return "value" }
+
+type NodeSettingChange struct {
+	Id        []byte
+	NodeId    []byte
+	Name      string
+	OldValue  string
+	NewValue  string
+	CreatedAt time.Time
+}
+
+func (NodeSettingChange) _Table() string { return "node_setting_changes" }
+
+type NodeSettingChange_Update_Fields struct {
+}
+
+type NodeSettingChange_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeSettingChange_Id(v []byte) NodeSettingChange_Id_Field {
+	return NodeSettingChange_Id_Field{_set: true, _value: v}
+}
+
+func (f NodeSettingChange_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeSettingChange_Id_Field) _Column() string { return "id" }
+
+type NodeSettingChange_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeSettingChange_NodeId(v []byte) NodeSettingChange_NodeId_Field {
+	return NodeSettingChange_NodeId_Field{_set: true, _value: v}
+}
+
+func (f NodeSettingChange_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeSettingChange_NodeId_Field) _Column() string { return "node_id" }
+
+type NodeSettingChange_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeSettingChange_Name(v string) NodeSettingChange_Name_Field {
+	return NodeSettingChange_Name_Field{_set: true, _value: v}
+}
+
+func (f NodeSettingChange_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeSettingChange_Name_Field) _Column() string { return "name" }
+
+type NodeSettingChange_OldValue_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeSettingChange_OldValue(v string) NodeSettingChange_OldValue_Field {
+	return NodeSettingChange_OldValue_Field{_set: true, _value: v}
+}
+
+func (f NodeSettingChange_OldValue_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeSettingChange_OldValue_Field) _Column() string { return "old_value" }
+
+type NodeSettingChange_NewValue_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeSettingChange_NewValue(v string) NodeSettingChange_NewValue_Field {
+	return NodeSettingChange_NewValue_Field{_set: true, _value: v}
+}
+
+func (f NodeSettingChange_NewValue_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeSettingChange_NewValue_Field) _Column() string { return "new_value" }
+
+type NodeSettingChange_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeSettingChange_CreatedAt(v time.Time) NodeSettingChange_CreatedAt_Field {
+	return NodeSettingChange_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f NodeSettingChange_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeSettingChange_CreatedAt_Field) _Column() string { return "created_at" }
+
 func toUTC(t time.Time) time.Time {
 	return t.UTC()
 }
@@ -923,6 +1339,41 @@ func (h *__sqlbundle_Hole) Render() string {
 // end runtime support for building sql statements
 //
 
+func (obj *pgxImpl) CreateNoReturn_Alert(ctx context.Context,
+	alert_id Alert_Id_Field,
+	alert_rule Alert_Rule_Field,
+	alert_node_id Alert_NodeId_Field,
+	alert_satellite_id Alert_SatelliteId_Field,
+	alert_message Alert_Message_Field,
+	optional Alert_Create_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := alert_id.value()
+	__rule_val := alert_rule.value()
+	__node_id_val := alert_node_id.value()
+	__satellite_id_val := alert_satellite_id.value()
+	__message_val := alert_message.value()
+	__created_at_val := __now
+	__resolved_at_val := optional.ResolvedAt.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO alerts ( id, rule, node_id, satellite_id, message, created_at, resolved_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? )")
+
+	var __values []interface{}
+	__values = append(__values, __id_val, __rule_val, __node_id_val, __satellite_id_val, __message_val, __created_at_val, __resolved_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
 func (obj *pgxImpl) Create_Node(ctx context.Context,
 	node_id Node_Id_Field,
 	node_name Node_Name_Field,
@@ -952,6 +1403,65 @@ func (obj *pgxImpl) Create_Node(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) ReplaceNoReturn_NodeLabel(ctx context.Context,
+	node_label_node_id NodeLabel_NodeId_Field,
+	node_label_name NodeLabel_Name_Field,
+	node_label_value NodeLabel_Value_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	__node_id_val := node_label_node_id.value()
+	__name_val := node_label_name.value()
+	__value_val := node_label_value.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO node_labels ( node_id, name, value ) VALUES ( ?, ?, ? ) ON CONFLICT ( node_id, name ) DO UPDATE SET node_id = EXCLUDED.node_id, name = EXCLUDED.name, value = EXCLUDED.value")
+
+	var __values []interface{}
+	__values = append(__values, __node_id_val, __name_val, __value_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
+func (obj *pgxImpl) CreateNoReturn_NodeSettingChange(ctx context.Context,
+	node_setting_change_id NodeSettingChange_Id_Field,
+	node_setting_change_node_id NodeSettingChange_NodeId_Field,
+	node_setting_change_name NodeSettingChange_Name_Field,
+	node_setting_change_old_value NodeSettingChange_OldValue_Field,
+	node_setting_change_new_value NodeSettingChange_NewValue_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := node_setting_change_id.value()
+	__node_id_val := node_setting_change_node_id.value()
+	__name_val := node_setting_change_name.value()
+	__old_value_val := node_setting_change_old_value.value()
+	__new_value_val := node_setting_change_new_value.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO node_setting_changes ( id, node_id, name, old_value, new_value, created_at ) VALUES ( ?, ?, ?, ?, ?, ? )")
+
+	var __values []interface{}
+	__values = append(__values, __id_val, __node_id_val, __name_val, __old_value_val, __new_value_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
 func (obj *pgxImpl) Get_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field) (
 	node *Node, err error) {
@@ -994,6 +1504,93 @@ func (obj *pgxImpl) Count_Node(ctx context.Context) (
 
 }
 
+func (obj *pgxImpl) All_Alert_By_ResolvedAt_Is_Null(ctx context.Context) (
+	rows []*Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT alerts.id, alerts.rule, alerts.node_id, alerts.satellite_id, alerts.message, alerts.created_at, alerts.resolved_at FROM alerts WHERE alerts.resolved_at is NULL")
+
+	var __values []interface{}
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		alert := &Alert{}
+		err = __rows.Scan(&alert.Id, &alert.Rule, &alert.NodeId, &alert.SatelliteId, &alert.Message, &alert.CreatedAt, &alert.ResolvedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, alert)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *pgxImpl) Count_Alert(ctx context.Context) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT COUNT(*) FROM alerts")
+
+	var __values []interface{}
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&count)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *pgxImpl) Limited_Alert_OrderBy_Desc_CreatedAt(ctx context.Context,
+	limit int, offset int64) (
+	rows []*Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT alerts.id, alerts.rule, alerts.node_id, alerts.satellite_id, alerts.message, alerts.created_at, alerts.resolved_at FROM alerts ORDER BY alerts.created_at DESC LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		alert := &Alert{}
+		err = __rows.Scan(&alert.Id, &alert.Rule, &alert.NodeId, &alert.SatelliteId, &alert.Message, &alert.CreatedAt, &alert.ResolvedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, alert)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
 func (obj *pgxImpl) All_Node(ctx context.Context) (
 	rows []*Node, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -1061,6 +1658,168 @@ func (obj *pgxImpl) Limited_Node(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) All_NodeLabel(ctx context.Context) (
+	rows []*NodeLabel, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_labels.node_id, node_labels.name, node_labels.value FROM node_labels")
+
+	var __values []interface{}
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		node_label := &NodeLabel{}
+		err = __rows.Scan(&node_label.NodeId, &node_label.Name, &node_label.Value)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, node_label)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *pgxImpl) All_NodeLabel_By_NodeId(ctx context.Context,
+	node_label_node_id NodeLabel_NodeId_Field) (
+	rows []*NodeLabel, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_labels.node_id, node_labels.name, node_labels.value FROM node_labels WHERE node_labels.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_label_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		node_label := &NodeLabel{}
+		err = __rows.Scan(&node_label.NodeId, &node_label.Name, &node_label.Value)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, node_label)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *pgxImpl) Count_NodeSettingChange_By_NodeId(ctx context.Context,
+	node_setting_change_node_id NodeSettingChange_NodeId_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT COUNT(*) FROM node_setting_changes WHERE node_setting_changes.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_setting_change_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&count)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *pgxImpl) Limited_NodeSettingChange_By_NodeId_OrderBy_Desc_CreatedAt(ctx context.Context,
+	node_setting_change_node_id NodeSettingChange_NodeId_Field,
+	limit int, offset int64) (
+	rows []*NodeSettingChange, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_setting_changes.id, node_setting_changes.node_id, node_setting_changes.name, node_setting_changes.old_value, node_setting_changes.new_value, node_setting_changes.created_at FROM node_setting_changes WHERE node_setting_changes.node_id = ? ORDER BY node_setting_changes.created_at DESC LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, node_setting_change_node_id.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		node_setting_change := &NodeSettingChange{}
+		err = __rows.Scan(&node_setting_change.Id, &node_setting_change.NodeId, &node_setting_change.Name, &node_setting_change.OldValue, &node_setting_change.NewValue, &node_setting_change.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, node_setting_change)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *pgxImpl) UpdateNoReturn_Alert_By_Id(ctx context.Context,
+	alert_id Alert_Id_Field,
+	update Alert_Update_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE alerts SET "), __sets, __sqlbundle_Literal(" WHERE alerts.id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.ResolvedAt._set {
+		__values = append(__values, update.ResolvedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("resolved_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
+	}
+
+	__args = append(__args, alert_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+}
+
 func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field,
 	update Node_Update_Fields) (
@@ -1166,8 +1925,36 @@ func (obj *pgxImpl) Delete_Node_By_Id(ctx context.Context,
 
 }
 
-func (impl pgxImpl) isConstraintError(err error) (
-	constraint string, ok bool) {
+func (obj *pgxImpl) Delete_NodeLabel_By_NodeId_And_Name(ctx context.Context,
+	node_label_node_id NodeLabel_NodeId_Field,
+	node_label_name NodeLabel_Name_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM node_labels WHERE node_labels.node_id = ? AND node_labels.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_label_node_id.value(), node_label_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (impl pgxImpl) isConstraintError(err error) (
+	constraint string, ok bool) {
 	if e, ok := err.(*pgconn.PgError); ok {
 		if e.Code[:2] == "23" {
 			return e.ConstraintName, true
@@ -1180,6 +1967,26 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 	var __res sql.Result
 	var __count int64
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_setting_changes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_labels;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
 	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM nodes;")
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -1189,73 +1996,419 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
-	count += __count
-
-	return count, nil
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM alerts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+
+	return count, nil
+
+}
+
+func (obj *sqlite3Impl) CreateNoReturn_Alert(ctx context.Context,
+	alert_id Alert_Id_Field,
+	alert_rule Alert_Rule_Field,
+	alert_node_id Alert_NodeId_Field,
+	alert_satellite_id Alert_SatelliteId_Field,
+	alert_message Alert_Message_Field,
+	optional Alert_Create_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := alert_id.value()
+	__rule_val := alert_rule.value()
+	__node_id_val := alert_node_id.value()
+	__satellite_id_val := alert_satellite_id.value()
+	__message_val := alert_message.value()
+	__created_at_val := __now
+	__resolved_at_val := optional.ResolvedAt.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO alerts ( id, rule, node_id, satellite_id, message, created_at, resolved_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? )")
+
+	var __values []interface{}
+	__values = append(__values, __id_val, __rule_val, __node_id_val, __satellite_id_val, __message_val, __created_at_val, __resolved_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
+func (obj *sqlite3Impl) Create_Node(ctx context.Context,
+	node_id Node_Id_Field,
+	node_name Node_Name_Field,
+	node_public_address Node_PublicAddress_Field,
+	node_api_secret Node_ApiSecret_Field) (
+	node *Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+	__id_val := node_id.value()
+	__name_val := node_name.value()
+	__public_address_val := node_public_address.value()
+	__api_secret_val := node_api_secret.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, name, public_address, api_secret ) VALUES ( ?, ?, ?, ? )")
+
+	var __values []interface{}
+	__values = append(__values, __id_val, __name_val, __public_address_val, __api_secret_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastNode(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) ReplaceNoReturn_NodeLabel(ctx context.Context,
+	node_label_node_id NodeLabel_NodeId_Field,
+	node_label_name NodeLabel_Name_Field,
+	node_label_value NodeLabel_Value_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	__node_id_val := node_label_node_id.value()
+	__name_val := node_label_name.value()
+	__value_val := node_label_value.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT OR REPLACE INTO node_labels ( node_id, name, value ) VALUES ( ?, ?, ? )")
+
+	var __values []interface{}
+	__values = append(__values, __node_id_val, __name_val, __value_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
+func (obj *sqlite3Impl) CreateNoReturn_NodeSettingChange(ctx context.Context,
+	node_setting_change_id NodeSettingChange_Id_Field,
+	node_setting_change_node_id NodeSettingChange_NodeId_Field,
+	node_setting_change_name NodeSettingChange_Name_Field,
+	node_setting_change_old_value NodeSettingChange_OldValue_Field,
+	node_setting_change_new_value NodeSettingChange_NewValue_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := node_setting_change_id.value()
+	__node_id_val := node_setting_change_node_id.value()
+	__name_val := node_setting_change_name.value()
+	__old_value_val := node_setting_change_old_value.value()
+	__new_value_val := node_setting_change_new_value.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO node_setting_changes ( id, node_id, name, old_value, new_value, created_at ) VALUES ( ?, ?, ?, ?, ?, ? )")
+
+	var __values []interface{}
+	__values = append(__values, __id_val, __node_id_val, __name_val, __old_value_val, __new_value_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
+func (obj *sqlite3Impl) Get_Node_By_Id(ctx context.Context,
+	node_id Node_Id_Field) (
+	node *Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.name, nodes.public_address, nodes.api_secret FROM nodes WHERE nodes.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node = &Node{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Name, &node.PublicAddress, &node.ApiSecret)
+	if err != nil {
+		return (*Node)(nil), obj.makeErr(err)
+	}
+	return node, nil
+
+}
+
+func (obj *sqlite3Impl) Count_Node(ctx context.Context) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT COUNT(*) FROM nodes")
+
+	var __values []interface{}
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&count)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *sqlite3Impl) All_Alert_By_ResolvedAt_Is_Null(ctx context.Context) (
+	rows []*Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT alerts.id, alerts.rule, alerts.node_id, alerts.satellite_id, alerts.message, alerts.created_at, alerts.resolved_at FROM alerts WHERE alerts.resolved_at is NULL")
+
+	var __values []interface{}
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		alert := &Alert{}
+		err = __rows.Scan(&alert.Id, &alert.Rule, &alert.NodeId, &alert.SatelliteId, &alert.Message, &alert.CreatedAt, &alert.ResolvedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, alert)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Count_Alert(ctx context.Context) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT COUNT(*) FROM alerts")
+
+	var __values []interface{}
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&count)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *sqlite3Impl) Limited_Alert_OrderBy_Desc_CreatedAt(ctx context.Context,
+	limit int, offset int64) (
+	rows []*Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT alerts.id, alerts.rule, alerts.node_id, alerts.satellite_id, alerts.message, alerts.created_at, alerts.resolved_at FROM alerts ORDER BY alerts.created_at DESC LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		alert := &Alert{}
+		err = __rows.Scan(&alert.Id, &alert.Rule, &alert.NodeId, &alert.SatelliteId, &alert.Message, &alert.CreatedAt, &alert.ResolvedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, alert)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) All_Node(ctx context.Context) (
+	rows []*Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.name, nodes.public_address, nodes.api_secret FROM nodes")
+
+	var __values []interface{}
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		node := &Node{}
+		err = __rows.Scan(&node.Id, &node.Name, &node.PublicAddress, &node.ApiSecret)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, node)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Limited_Node(ctx context.Context,
+	limit int, offset int64) (
+	rows []*Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.name, nodes.public_address, nodes.api_secret FROM nodes LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		node := &Node{}
+		err = __rows.Scan(&node.Id, &node.Name, &node.PublicAddress, &node.ApiSecret)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, node)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
-func (obj *sqlite3Impl) Create_Node(ctx context.Context,
-	node_id Node_Id_Field,
-	node_name Node_Name_Field,
-	node_public_address Node_PublicAddress_Field,
-	node_api_secret Node_ApiSecret_Field) (
-	node *Node, err error) {
+func (obj *sqlite3Impl) All_NodeLabel(ctx context.Context) (
+	rows []*NodeLabel, err error) {
 	defer mon.Task()(&ctx)(&err)
-	__id_val := node_id.value()
-	__name_val := node_name.value()
-	__public_address_val := node_public_address.value()
-	__api_secret_val := node_api_secret.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, name, public_address, api_secret ) VALUES ( ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_labels.node_id, node_labels.name, node_labels.value FROM node_labels")
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __name_val, __public_address_val, __api_secret_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	__pk, err := __res.LastInsertId()
-	if err != nil {
+	defer __rows.Close()
+
+	for __rows.Next() {
+		node_label := &NodeLabel{}
+		err = __rows.Scan(&node_label.NodeId, &node_label.Name, &node_label.Value)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, node_label)
+	}
+	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return obj.getLastNode(ctx, __pk)
+	return rows, nil
 
 }
 
-func (obj *sqlite3Impl) Get_Node_By_Id(ctx context.Context,
-	node_id Node_Id_Field) (
-	node *Node, err error) {
+func (obj *sqlite3Impl) All_NodeLabel_By_NodeId(ctx context.Context,
+	node_label_node_id NodeLabel_NodeId_Field) (
+	rows []*NodeLabel, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.name, nodes.public_address, nodes.api_secret FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_labels.node_id, node_labels.name, node_labels.value FROM node_labels WHERE node_labels.node_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, node_id.value())
+	__values = append(__values, node_label_node_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	node = &Node{}
-	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Name, &node.PublicAddress, &node.ApiSecret)
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 	if err != nil {
-		return (*Node)(nil), obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
-	return node, nil
+	defer __rows.Close()
+
+	for __rows.Next() {
+		node_label := &NodeLabel{}
+		err = __rows.Scan(&node_label.NodeId, &node_label.Name, &node_label.Value)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, node_label)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
-func (obj *sqlite3Impl) Count_Node(ctx context.Context) (
+func (obj *sqlite3Impl) Count_NodeSettingChange_By_NodeId(ctx context.Context,
+	node_setting_change_node_id NodeSettingChange_NodeId_Field) (
 	count int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT COUNT(*) FROM nodes")
+	var __embed_stmt = __sqlbundle_Literal("SELECT COUNT(*) FROM node_setting_changes WHERE node_setting_changes.node_id = ?")
 
 	var __values []interface{}
+	__values = append(__values, node_setting_change_node_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -1269,13 +2422,18 @@ func (obj *sqlite3Impl) Count_Node(ctx context.Context) (
 
 }
 
-func (obj *sqlite3Impl) All_Node(ctx context.Context) (
-	rows []*Node, err error) {
+func (obj *sqlite3Impl) Limited_NodeSettingChange_By_NodeId_OrderBy_Desc_CreatedAt(ctx context.Context,
+	node_setting_change_node_id NodeSettingChange_NodeId_Field,
+	limit int, offset int64) (
+	rows []*NodeSettingChange, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.name, nodes.public_address, nodes.api_secret FROM nodes")
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_setting_changes.id, node_setting_changes.node_id, node_setting_changes.name, node_setting_changes.old_value, node_setting_changes.new_value, node_setting_changes.created_at FROM node_setting_changes WHERE node_setting_changes.node_id = ? ORDER BY node_setting_changes.created_at DESC LIMIT ? OFFSET ?")
 
 	var __values []interface{}
+	__values = append(__values, node_setting_change_node_id.value())
+
+	__values = append(__values, limit, offset)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -1287,12 +2445,12 @@ func (obj *sqlite3Impl) All_Node(ctx context.Context) (
 	defer __rows.Close()
 
 	for __rows.Next() {
-		node := &Node{}
-		err = __rows.Scan(&node.Id, &node.Name, &node.PublicAddress, &node.ApiSecret)
+		node_setting_change := &NodeSettingChange{}
+		err = __rows.Scan(&node_setting_change.Id, &node_setting_change.NodeId, &node_setting_change.Name, &node_setting_change.OldValue, &node_setting_change.NewValue, &node_setting_change.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
-		rows = append(rows, node)
+		rows = append(rows, node_setting_change)
 	}
 	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
@@ -1301,39 +2459,41 @@ func (obj *sqlite3Impl) All_Node(ctx context.Context) (
 
 }
 
-func (obj *sqlite3Impl) Limited_Node(ctx context.Context,
-	limit int, offset int64) (
-	rows []*Node, err error) {
+func (obj *sqlite3Impl) UpdateNoReturn_Alert_By_Id(ctx context.Context,
+	alert_id Alert_Id_Field,
+	update Alert_Update_Fields) (
+	err error) {
 	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.name, nodes.public_address, nodes.api_secret FROM nodes LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE alerts SET "), __sets, __sqlbundle_Literal(" WHERE alerts.id = ?")}}
 
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
+	var __args []interface{}
 
-	__values = append(__values, limit, offset)
+	if update.ResolvedAt._set {
+		__values = append(__values, update.ResolvedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("resolved_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
+	}
+
+	__args = append(__args, alert_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	defer __rows.Close()
-
-	for __rows.Next() {
-		node := &Node{}
-		err = __rows.Scan(&node.Id, &node.Name, &node.PublicAddress, &node.ApiSecret)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, node)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
+		return obj.makeErr(err)
 	}
-	return rows, nil
-
+	return nil
 }
 
 func (obj *sqlite3Impl) Update_Node_By_Id(ctx context.Context,
@@ -1470,6 +2630,34 @@ func (obj *sqlite3Impl) getLastNode(ctx context.Context,
 
 }
 
+func (obj *sqlite3Impl) Delete_NodeLabel_By_NodeId_And_Name(ctx context.Context,
+	node_label_node_id NodeLabel_NodeId_Field,
+	node_label_name NodeLabel_Name_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM node_labels WHERE node_labels.node_id = ? AND node_labels.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_label_node_id.value(), node_label_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (impl sqlite3Impl) isConstraintError(err error) (
 	constraint string, ok bool) {
 	if e, ok := err.(sqlite3.Error); ok {
@@ -1489,11 +2677,41 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 	defer mon.Task()(&ctx)(&err)
 	var __res sql.Result
 	var __count int64
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_setting_changes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_labels;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
 	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM nodes;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM alerts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -1546,6 +2764,15 @@ func (rx *Rx) Rollback() (err error) {
 	return err
 }
 
+func (rx *Rx) All_Alert_By_ResolvedAt_Is_Null(ctx context.Context) (
+	rows []*Alert, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_Alert_By_ResolvedAt_Is_Null(ctx)
+}
+
 func (rx *Rx) All_Node(ctx context.Context) (
 	rows []*Node, err error) {
 	var tx *Tx
@@ -1555,6 +2782,34 @@ func (rx *Rx) All_Node(ctx context.Context) (
 	return tx.All_Node(ctx)
 }
 
+func (rx *Rx) All_NodeLabel(ctx context.Context) (
+	rows []*NodeLabel, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_NodeLabel(ctx)
+}
+
+func (rx *Rx) All_NodeLabel_By_NodeId(ctx context.Context,
+	node_label_node_id NodeLabel_NodeId_Field) (
+	rows []*NodeLabel, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_NodeLabel_By_NodeId(ctx, node_label_node_id)
+}
+
+func (rx *Rx) Count_Alert(ctx context.Context) (
+	count int64, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Count_Alert(ctx)
+}
+
 func (rx *Rx) Count_Node(ctx context.Context) (
 	count int64, err error) {
 	var tx *Tx
@@ -1564,6 +2819,47 @@ func (rx *Rx) Count_Node(ctx context.Context) (
 	return tx.Count_Node(ctx)
 }
 
+func (rx *Rx) Count_NodeSettingChange_By_NodeId(ctx context.Context,
+	node_setting_change_node_id NodeSettingChange_NodeId_Field) (
+	count int64, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Count_NodeSettingChange_By_NodeId(ctx, node_setting_change_node_id)
+}
+
+func (rx *Rx) CreateNoReturn_Alert(ctx context.Context,
+	alert_id Alert_Id_Field,
+	alert_rule Alert_Rule_Field,
+	alert_node_id Alert_NodeId_Field,
+	alert_satellite_id Alert_SatelliteId_Field,
+	alert_message Alert_Message_Field,
+	optional Alert_Create_Fields) (
+	err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.CreateNoReturn_Alert(ctx, alert_id, alert_rule, alert_node_id, alert_satellite_id, alert_message, optional)
+
+}
+
+func (rx *Rx) CreateNoReturn_NodeSettingChange(ctx context.Context,
+	node_setting_change_id NodeSettingChange_Id_Field,
+	node_setting_change_node_id NodeSettingChange_NodeId_Field,
+	node_setting_change_name NodeSettingChange_Name_Field,
+	node_setting_change_old_value NodeSettingChange_OldValue_Field,
+	node_setting_change_new_value NodeSettingChange_NewValue_Field) (
+	err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.CreateNoReturn_NodeSettingChange(ctx, node_setting_change_id, node_setting_change_node_id, node_setting_change_name, node_setting_change_old_value, node_setting_change_new_value)
+
+}
+
 func (rx *Rx) Create_Node(ctx context.Context,
 	node_id Node_Id_Field,
 	node_name Node_Name_Field,
@@ -1578,6 +2874,17 @@ func (rx *Rx) Create_Node(ctx context.Context,
 
 }
 
+func (rx *Rx) Delete_NodeLabel_By_NodeId_And_Name(ctx context.Context,
+	node_label_node_id NodeLabel_NodeId_Field,
+	node_label_name NodeLabel_Name_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_NodeLabel_By_NodeId_And_Name(ctx, node_label_node_id, node_label_name)
+}
+
 func (rx *Rx) Delete_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field) (
 	deleted bool, err error) {
@@ -1598,6 +2905,16 @@ func (rx *Rx) Get_Node_By_Id(ctx context.Context,
 	return tx.Get_Node_By_Id(ctx, node_id)
 }
 
+func (rx *Rx) Limited_Alert_OrderBy_Desc_CreatedAt(ctx context.Context,
+	limit int, offset int64) (
+	rows []*Alert, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Limited_Alert_OrderBy_Desc_CreatedAt(ctx, limit, offset)
+}
+
 func (rx *Rx) Limited_Node(ctx context.Context,
 	limit int, offset int64) (
 	rows []*Node, err error) {
@@ -1608,6 +2925,41 @@ func (rx *Rx) Limited_Node(ctx context.Context,
 	return tx.Limited_Node(ctx, limit, offset)
 }
 
+func (rx *Rx) Limited_NodeSettingChange_By_NodeId_OrderBy_Desc_CreatedAt(ctx context.Context,
+	node_setting_change_node_id NodeSettingChange_NodeId_Field,
+	limit int, offset int64) (
+	rows []*NodeSettingChange, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Limited_NodeSettingChange_By_NodeId_OrderBy_Desc_CreatedAt(ctx, node_setting_change_node_id, limit, offset)
+}
+
+func (rx *Rx) ReplaceNoReturn_NodeLabel(ctx context.Context,
+	node_label_node_id NodeLabel_NodeId_Field,
+	node_label_name NodeLabel_Name_Field,
+	node_label_value NodeLabel_Value_Field) (
+	err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.ReplaceNoReturn_NodeLabel(ctx, node_label_node_id, node_label_name, node_label_value)
+
+}
+
+func (rx *Rx) UpdateNoReturn_Alert_By_Id(ctx context.Context,
+	alert_id Alert_Id_Field,
+	update Alert_Update_Fields) (
+	err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.UpdateNoReturn_Alert_By_Id(ctx, alert_id, update)
+}
+
 func (rx *Rx) UpdateNoReturn_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field,
 	update Node_Update_Fields) (
@@ -1631,12 +2983,46 @@ func (rx *Rx) Update_Node_By_Id(ctx context.Context,
 }
 
 type Methods interface {
+	All_Alert_By_ResolvedAt_Is_Null(ctx context.Context) (
+		rows []*Alert, err error)
+
 	All_Node(ctx context.Context) (
 		rows []*Node, err error)
 
+	All_NodeLabel(ctx context.Context) (
+		rows []*NodeLabel, err error)
+
+	All_NodeLabel_By_NodeId(ctx context.Context,
+		node_label_node_id NodeLabel_NodeId_Field) (
+		rows []*NodeLabel, err error)
+
+	Count_Alert(ctx context.Context) (
+		count int64, err error)
+
 	Count_Node(ctx context.Context) (
 		count int64, err error)
 
+	Count_NodeSettingChange_By_NodeId(ctx context.Context,
+		node_setting_change_node_id NodeSettingChange_NodeId_Field) (
+		count int64, err error)
+
+	CreateNoReturn_Alert(ctx context.Context,
+		alert_id Alert_Id_Field,
+		alert_rule Alert_Rule_Field,
+		alert_node_id Alert_NodeId_Field,
+		alert_satellite_id Alert_SatelliteId_Field,
+		alert_message Alert_Message_Field,
+		optional Alert_Create_Fields) (
+		err error)
+
+	CreateNoReturn_NodeSettingChange(ctx context.Context,
+		node_setting_change_id NodeSettingChange_Id_Field,
+		node_setting_change_node_id NodeSettingChange_NodeId_Field,
+		node_setting_change_name NodeSettingChange_Name_Field,
+		node_setting_change_old_value NodeSettingChange_OldValue_Field,
+		node_setting_change_new_value NodeSettingChange_NewValue_Field) (
+		err error)
+
 	Create_Node(ctx context.Context,
 		node_id Node_Id_Field,
 		node_name Node_Name_Field,
@@ -1644,6 +3030,11 @@ type Methods interface {
 		node_api_secret Node_ApiSecret_Field) (
 		node *Node, err error)
 
+	Delete_NodeLabel_By_NodeId_And_Name(ctx context.Context,
+		node_label_node_id NodeLabel_NodeId_Field,
+		node_label_name NodeLabel_Name_Field) (
+		deleted bool, err error)
+
 	Delete_Node_By_Id(ctx context.Context,
 		node_id Node_Id_Field) (
 		deleted bool, err error)
@@ -1652,10 +3043,30 @@ type Methods interface {
 		node_id Node_Id_Field) (
 		node *Node, err error)
 
+	Limited_Alert_OrderBy_Desc_CreatedAt(ctx context.Context,
+		limit int, offset int64) (
+		rows []*Alert, err error)
+
 	Limited_Node(ctx context.Context,
 		limit int, offset int64) (
 		rows []*Node, err error)
 
+	Limited_NodeSettingChange_By_NodeId_OrderBy_Desc_CreatedAt(ctx context.Context,
+		node_setting_change_node_id NodeSettingChange_NodeId_Field,
+		limit int, offset int64) (
+		rows []*NodeSettingChange, err error)
+
+	ReplaceNoReturn_NodeLabel(ctx context.Context,
+		node_label_node_id NodeLabel_NodeId_Field,
+		node_label_name NodeLabel_Name_Field,
+		node_label_value NodeLabel_Value_Field) (
+		err error)
+
+	UpdateNoReturn_Alert_By_Id(ctx context.Context,
+		alert_id Alert_Id_Field,
+		update Alert_Update_Fields) (
+		err error)
+
 	UpdateNoReturn_Node_By_Id(ctx context.Context,
 		node_id Node_Id_Field,
 		update Node_Update_Fields) (
@@ -1,5 +1,15 @@
 -- AUTOGENERATED BY storj.io/dbx
 -- DO NOT EDIT
+CREATE TABLE alerts (
+	id bytea NOT NULL,
+	rule text NOT NULL,
+	node_id bytea NOT NULL,
+	satellite_id bytea NOT NULL,
+	message text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	resolved_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	name text NOT NULL,
@@ -7,3 +17,20 @@ CREATE TABLE nodes (
 	api_secret bytea NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_labels (
+	node_id bytea NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+	name text NOT NULL,
+	value text NOT NULL,
+	PRIMARY KEY ( node_id, name )
+);
+CREATE TABLE node_setting_changes (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+	name text NOT NULL,
+	old_value text NOT NULL,
+	new_value text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE INDEX node_labels_name_value_index ON node_labels ( name, value );
+CREATE INDEX node_setting_changes_node_id_created_at_index ON node_setting_changes ( node_id, created_at );
@@ -1,5 +1,15 @@
 -- AUTOGENERATED BY storj.io/dbx
 -- DO NOT EDIT
+CREATE TABLE alerts (
+	id BLOB NOT NULL,
+	rule TEXT NOT NULL,
+	node_id BLOB NOT NULL,
+	satellite_id BLOB NOT NULL,
+	message TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	resolved_at TIMESTAMP,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE nodes (
 	id BLOB NOT NULL,
 	name TEXT NOT NULL,
@@ -7,3 +17,20 @@ CREATE TABLE nodes (
 	api_secret BLOB NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_labels (
+	node_id BLOB NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	value TEXT NOT NULL,
+	PRIMARY KEY ( node_id, name )
+);
+CREATE TABLE node_setting_changes (
+	id BLOB NOT NULL,
+	node_id BLOB NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	old_value TEXT NOT NULL,
+	new_value TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE INDEX node_labels_name_value_index ON node_labels ( name, value );
+CREATE INDEX node_setting_changes_node_id_created_at_index ON node_setting_changes ( node_id, created_at );
@@ -26,6 +26,54 @@ func (db *DB) SQLite3Migration() *migrate.Migration {
 					); `,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add node labels",
+				Version:     1,
+				Action: migrate.SQL{
+					`CREATE TABLE node_labels (
+						node_id BLOB NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+						name TEXT NOT NULL,
+						value TEXT NOT NULL,
+						PRIMARY KEY ( node_id, name )
+					);`,
+					`CREATE INDEX node_labels_name_value_index ON node_labels ( name, value );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add alerts",
+				Version:     2,
+				Action: migrate.SQL{
+					`CREATE TABLE alerts (
+						id BLOB NOT NULL,
+						rule TEXT NOT NULL,
+						node_id BLOB NOT NULL,
+						satellite_id BLOB NOT NULL,
+						message TEXT NOT NULL,
+						created_at TIMESTAMP NOT NULL,
+						resolved_at TIMESTAMP,
+						PRIMARY KEY ( id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add node setting changes",
+				Version:     3,
+				Action: migrate.SQL{
+					`CREATE TABLE node_setting_changes (
+						id BLOB NOT NULL,
+						node_id BLOB NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+						name TEXT NOT NULL,
+						old_value TEXT NOT NULL,
+						new_value TEXT NOT NULL,
+						created_at TIMESTAMP NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX node_setting_changes_node_id_created_at_index ON node_setting_changes ( node_id, created_at );`,
+				},
+			},
 		},
 	}
 }
@@ -49,6 +97,54 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					);`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add node labels",
+				Version:     1,
+				Action: migrate.SQL{
+					`CREATE TABLE node_labels (
+						node_id bytea NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+						name text NOT NULL,
+						value text NOT NULL,
+						PRIMARY KEY ( node_id, name )
+					);`,
+					`CREATE INDEX node_labels_name_value_index ON node_labels ( name, value );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add alerts",
+				Version:     2,
+				Action: migrate.SQL{
+					`CREATE TABLE alerts (
+						id bytea NOT NULL,
+						rule text NOT NULL,
+						node_id bytea NOT NULL,
+						satellite_id bytea NOT NULL,
+						message text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						resolved_at timestamp with time zone,
+						PRIMARY KEY ( id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add node setting changes",
+				Version:     3,
+				Action: migrate.SQL{
+					`CREATE TABLE node_setting_changes (
+						id bytea NOT NULL,
+						node_id bytea NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+						name text NOT NULL,
+						old_value text NOT NULL,
+						new_value text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX node_setting_changes_node_id_created_at_index ON node_setting_changes ( node_id, created_at );`,
+				},
+			},
 		},
 	}
 }
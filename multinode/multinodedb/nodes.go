@@ -7,6 +7,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"sort"
 
 	"github.com/zeebo/errs"
 
@@ -30,7 +31,7 @@ type nodesdb struct {
 	methods dbx.Methods
 }
 
-// List returns all connected nodes.
+// List returns all connected nodes, which match the selector of the context.
 func (n *nodesdb) List(ctx context.Context) (allNodes []nodes.Node, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -38,23 +39,33 @@ func (n *nodesdb) List(ctx context.Context) (allNodes []nodes.Node, err error) {
 	if err != nil {
 		return []nodes.Node{}, ErrNodesDB.Wrap(err)
 	}
-	if len(dbxNodes) == 0 {
-		return []nodes.Node{}, nodes.ErrNoNode.New("no nodes")
+
+	labels, err := n.labels(ctx)
+	if err != nil {
+		return []nodes.Node{}, ErrNodesDB.Wrap(err)
 	}
 
+	selector := nodes.SelectorFromContext(ctx)
 	for _, dbxNode := range dbxNodes {
 		node, err := fromDBXNode(ctx, dbxNode)
 		if err != nil {
 			return []nodes.Node{}, ErrNodesDB.Wrap(err)
 		}
+		node.Labels = labels[node.ID]
 
+		if !selector.Matches(node.Labels) {
+			continue
+		}
 		allNodes = append(allNodes, node)
 	}
+	if len(allNodes) == 0 {
+		return []nodes.Node{}, nodes.ErrNoNode.New("no nodes")
+	}
 
 	return allNodes, ErrNodesDB.Wrap(err)
 }
 
-// ListPaged returns paginated nodes list.
+// ListPaged returns paginated nodes list, which match the selector of the context.
 func (n *nodesdb) ListPaged(ctx context.Context, cursor nodes.Cursor) (page nodes.Page, err error) {
 	defer mon.Task()(&ctx)(&err)
 	page = nodes.Page{
@@ -62,6 +73,11 @@ func (n *nodesdb) ListPaged(ctx context.Context, cursor nodes.Cursor) (page node
 		Limit:       cursor.Limit,
 		Offset:      (cursor.Page - 1) * cursor.Limit,
 	}
+
+	if len(nodes.SelectorFromContext(ctx)) > 0 {
+		return n.listPagedSelected(ctx, page)
+	}
+
 	totalCount, err := n.methods.Count_Node(ctx)
 	if err != nil {
 		return nodes.Page{}, ErrNodesDB.Wrap(err)
@@ -75,16 +91,44 @@ func (n *nodesdb) ListPaged(ctx context.Context, cursor nodes.Cursor) (page node
 	if err != nil {
 		return nodes.Page{}, ErrNodesDB.Wrap(err)
 	}
+
+	labels, err := n.labels(ctx)
+	if err != nil {
+		return nodes.Page{}, ErrNodesDB.Wrap(err)
+	}
+
 	for _, dbxNode := range dbxNodes {
 		node, err := fromDBXNode(ctx, dbxNode)
 		if err != nil {
 			return nodes.Page{}, ErrNodesDB.Wrap(err)
 		}
+		node.Labels = labels[node.ID]
 		page.Nodes = append(page.Nodes, node)
 	}
 	return page, nil
 }
 
+// listPagedSelected fills the page with the nodes, which match the selector of the context.
+// The labels are filtered in memory, because a dashboard has only a few nodes.
+func (n *nodesdb) listPagedSelected(ctx context.Context, page nodes.Page) (_ nodes.Page, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	selected, err := n.List(ctx)
+	if err != nil && !nodes.ErrNoNode.Has(err) {
+		return nodes.Page{}, err
+	}
+
+	page.TotalCount = int64(len(selected))
+	page.PageCount = page.TotalCount / page.Limit
+	if page.TotalCount%page.Limit != 0 {
+		page.PageCount++
+	}
+	for i := page.Offset; i < page.TotalCount && i < page.Offset+page.Limit; i++ {
+		page.Nodes = append(page.Nodes, selected[i])
+	}
+	return page, nil
+}
+
 // Get return node from NodesDB by its id.
 func (n *nodesdb) Get(ctx context.Context, id storj.NodeID) (_ nodes.Node, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -98,8 +142,22 @@ func (n *nodesdb) Get(ctx context.Context, id storj.NodeID) (_ nodes.Node, err e
 	}
 
 	node, err := fromDBXNode(ctx, dbxNode)
+	if err != nil {
+		return nodes.Node{}, ErrNodesDB.Wrap(err)
+	}
 
-	return node, ErrNodesDB.Wrap(err)
+	dbxLabels, err := n.methods.All_NodeLabel_By_NodeId(ctx, dbx.NodeLabel_NodeId(id.Bytes()))
+	if err != nil {
+		return nodes.Node{}, ErrNodesDB.Wrap(err)
+	}
+	for _, dbxLabel := range dbxLabels {
+		if node.Labels == nil {
+			node.Labels = make(nodes.Labels)
+		}
+		node.Labels[dbxLabel.Name] = dbxLabel.Value
+	}
+
+	return node, nil
 }
 
 // Add creates new node in NodesDB.
@@ -137,6 +195,84 @@ func (n *nodesdb) UpdateName(ctx context.Context, id storj.NodeID, name string)
 	return ErrNodesDB.Wrap(err)
 }
 
+// SetLabel sets the value of the label of the specified node.
+func (n *nodesdb) SetLabel(ctx context.Context, id storj.NodeID, name, value string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = n.methods.ReplaceNoReturn_NodeLabel(ctx,
+		dbx.NodeLabel_NodeId(id.Bytes()),
+		dbx.NodeLabel_Name(name),
+		dbx.NodeLabel_Value(value),
+	)
+
+	return ErrNodesDB.Wrap(err)
+}
+
+// RemoveLabel removes the label of the specified node.
+func (n *nodesdb) RemoveLabel(ctx context.Context, id storj.NodeID, name string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = n.methods.Delete_NodeLabel_By_NodeId_And_Name(ctx,
+		dbx.NodeLabel_NodeId(id.Bytes()),
+		dbx.NodeLabel_Name(name),
+	)
+
+	return ErrNodesDB.Wrap(err)
+}
+
+// Groups returns the number of nodes by label, sorted by label name and value.
+func (n *nodesdb) Groups(ctx context.Context) (_ []nodes.Group, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxLabels, err := n.methods.All_NodeLabel(ctx)
+	if err != nil {
+		return nil, ErrNodesDB.Wrap(err)
+	}
+
+	counts := make(map[nodes.Group]int)
+	for _, dbxLabel := range dbxLabels {
+		counts[nodes.Group{Name: dbxLabel.Name, Value: dbxLabel.Value}]++
+	}
+
+	groups := make([]nodes.Group, 0, len(counts))
+	for group, count := range counts {
+		group.NodeCount = count
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, k int) bool {
+		if groups[i].Name != groups[k].Name {
+			return groups[i].Name < groups[k].Name
+		}
+		return groups[i].Value < groups[k].Value
+	})
+
+	return groups, nil
+}
+
+// labels returns the labels of all the nodes.
+func (n *nodesdb) labels(ctx context.Context) (_ map[storj.NodeID]nodes.Labels, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxLabels, err := n.methods.All_NodeLabel(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := make(map[storj.NodeID]nodes.Labels)
+	for _, dbxLabel := range dbxLabels {
+		id, err := storj.NodeIDFromBytes(dbxLabel.NodeId)
+		if err != nil {
+			return nil, err
+		}
+		if labels[id] == nil {
+			labels[id] = make(nodes.Labels)
+		}
+		labels[id][dbxLabel.Name] = dbxLabel.Value
+	}
+
+	return labels, nil
+}
+
 // fromDBXNode converts dbx.Node to console.Node.
 func fromDBXNode(ctx context.Context, node *dbx.Node) (_ nodes.Node, err error) {
 	defer mon.Task()(&ctx)(&err)
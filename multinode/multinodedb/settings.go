@@ -0,0 +1,101 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multinodedb
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/multinode/multinodedb/dbx"
+	"storj.io/storj/multinode/settings"
+)
+
+// ErrSettingsDB indicates about internal SettingsDB error.
+var ErrSettingsDB = errs.Class("SettingsDB")
+
+// ensures that settingsdb implements settings.DB.
+var _ settings.DB = (*settingsdb)(nil)
+
+// settingsdb stores the history of the changes of the settings of the nodes.
+//
+// architecture: Database
+type settingsdb struct {
+	methods dbx.Methods
+}
+
+// Insert stores a change of a setting of a node.
+func (db *settingsdb) Insert(ctx context.Context, change settings.Change) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.methods.CreateNoReturn_NodeSettingChange(ctx,
+		dbx.NodeSettingChange_Id(change.ID.Bytes()),
+		dbx.NodeSettingChange_NodeId(change.NodeID.Bytes()),
+		dbx.NodeSettingChange_Name(change.Name),
+		dbx.NodeSettingChange_OldValue(change.OldValue),
+		dbx.NodeSettingChange_NewValue(change.NewValue),
+	)
+
+	return ErrSettingsDB.Wrap(err)
+}
+
+// ListPaged returns paginated changes of the settings of a node from the newest change.
+func (db *settingsdb) ListPaged(ctx context.Context, nodeID storj.NodeID, cursor settings.Cursor) (page settings.Page, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	page = settings.Page{
+		Changes:     []settings.Change{},
+		CurrentPage: cursor.Page,
+		Limit:       cursor.Limit,
+		Offset:      (cursor.Page - 1) * cursor.Limit,
+	}
+
+	page.TotalCount, err = db.methods.Count_NodeSettingChange_By_NodeId(ctx, dbx.NodeSettingChange_NodeId(nodeID.Bytes()))
+	if err != nil {
+		return settings.Page{}, ErrSettingsDB.Wrap(err)
+	}
+	page.PageCount = page.TotalCount / cursor.Limit
+	if page.TotalCount%cursor.Limit != 0 {
+		page.PageCount++
+	}
+
+	dbxChanges, err := db.methods.Limited_NodeSettingChange_By_NodeId_OrderBy_Desc_CreatedAt(ctx,
+		dbx.NodeSettingChange_NodeId(nodeID.Bytes()), int(page.Limit), page.Offset)
+	if err != nil {
+		return settings.Page{}, ErrSettingsDB.Wrap(err)
+	}
+	for _, dbxChange := range dbxChanges {
+		change, err := fromDBXNodeSettingChange(dbxChange)
+		if err != nil {
+			return settings.Page{}, ErrSettingsDB.Wrap(err)
+		}
+		page.Changes = append(page.Changes, change)
+	}
+
+	return page, nil
+}
+
+// fromDBXNodeSettingChange converts dbx.NodeSettingChange to settings.Change.
+func fromDBXNodeSettingChange(dbxChange *dbx.NodeSettingChange) (_ settings.Change, err error) {
+	id, err := uuid.FromBytes(dbxChange.Id)
+	if err != nil {
+		return settings.Change{}, err
+	}
+
+	nodeID, err := storj.NodeIDFromBytes(dbxChange.NodeId)
+	if err != nil {
+		return settings.Change{}, err
+	}
+
+	return settings.Change{
+		ID:        id,
+		NodeID:    nodeID,
+		Name:      dbxChange.Name,
+		OldValue:  dbxChange.OldValue,
+		NewValue:  dbxChange.NewValue,
+		CreatedAt: dbxChange.CreatedAt,
+	}, nil
+}
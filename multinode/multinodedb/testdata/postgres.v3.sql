@@ -0,0 +1,46 @@
+-- AUTOGENERATED BY storj.io/dbx
+-- DO NOT EDIT
+CREATE TABLE alerts (
+	id bytea NOT NULL,
+	rule text NOT NULL,
+	node_id bytea NOT NULL,
+	satellite_id bytea NOT NULL,
+	message text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	resolved_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE nodes (
+	id bytea NOT NULL,
+	name text NOT NULL,
+	public_address text NOT NULL,
+	api_secret bytea NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_labels (
+	node_id bytea NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+	name text NOT NULL,
+	value text NOT NULL,
+	PRIMARY KEY ( node_id, name )
+);
+CREATE TABLE node_setting_changes (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+	name text NOT NULL,
+	old_value text NOT NULL,
+	new_value text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE INDEX node_labels_name_value_index ON node_labels ( name, value );
+CREATE INDEX node_setting_changes_node_id_created_at_index ON node_setting_changes ( node_id, created_at );
+
+-- MAIN DATA --
+
+INSERT INTO nodes (id, name, public_address, api_secret) VALUES (E'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', 'node_name', '127.0.0.1:13000', E'\\153\\313\\233\\074\\327\\177\\136\\070\\346\\001');
+INSERT INTO node_labels (node_id, name, value) VALUES (E'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', 'location', 'berlin');
+INSERT INTO alerts (id, rule, node_id, satellite_id, message, created_at, resolved_at) VALUES (E'\\014+\\240\\304\\345\\306J_\\237^j~\\033\\034-.', 'offline', E'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', E'', 'node is offline', '2023-05-01 10:00:00+00', NULL);
+
+-- NEW DATA --
+
+INSERT INTO node_setting_changes (id, node_id, name, old_value, new_value, created_at) VALUES (E'\\x5d1b7f0c9a2e4f6b8c3d2e1f0a9b8c7d', E'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', 'wallet', '0x0000000000000000000000000000000000000000', '0x0123456789012345678901234567890123456789', '2023-05-02 10:00:00+00');
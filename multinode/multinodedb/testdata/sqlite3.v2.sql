@@ -0,0 +1,35 @@
+-- AUTOGENERATED BY storj.io/dbx
+-- DO NOT EDIT
+CREATE TABLE alerts (
+	id BLOB NOT NULL,
+	rule TEXT NOT NULL,
+	node_id BLOB NOT NULL,
+	satellite_id BLOB NOT NULL,
+	message TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	resolved_at TIMESTAMP,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE nodes (
+	id BLOB NOT NULL,
+	name TEXT NOT NULL,
+	public_address TEXT NOT NULL,
+	api_secret BLOB NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_labels (
+	node_id BLOB NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	value TEXT NOT NULL,
+	PRIMARY KEY ( node_id, name )
+);
+CREATE INDEX node_labels_name_value_index ON node_labels ( name, value );
+
+-- MAIN DATA --
+
+INSERT INTO nodes (id, name, public_address, api_secret) VALUES (X'2b3a5863a41f25408a8f5348839d7a1361dbd886d75786bb139a8ca0bdf41000', 'node_name', '127.0.0.1:13000', X'62180593328b8ff3c9f97565fdfd305d');
+INSERT INTO node_labels (node_id, name, value) VALUES (X'2b3a5863a41f25408a8f5348839d7a1361dbd886d75786bb139a8ca0bdf41000', 'location', 'berlin');
+
+-- NEW DATA --
+
+INSERT INTO alerts (id, rule, node_id, satellite_id, message, created_at, resolved_at) VALUES (X'0c2ba0c4e5c64a5f9f5e6a7e1b1c2d3e', 'offline', X'2b3a5863a41f25408a8f5348839d7a1361dbd886d75786bb139a8ca0bdf41000', X'', 'node is offline', '2023-05-01 10:00:00+00:00', NULL);
@@ -0,0 +1,46 @@
+-- AUTOGENERATED BY storj.io/dbx
+-- DO NOT EDIT
+CREATE TABLE alerts (
+	id BLOB NOT NULL,
+	rule TEXT NOT NULL,
+	node_id BLOB NOT NULL,
+	satellite_id BLOB NOT NULL,
+	message TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	resolved_at TIMESTAMP,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE nodes (
+	id BLOB NOT NULL,
+	name TEXT NOT NULL,
+	public_address TEXT NOT NULL,
+	api_secret BLOB NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_labels (
+	node_id BLOB NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	value TEXT NOT NULL,
+	PRIMARY KEY ( node_id, name )
+);
+CREATE TABLE node_setting_changes (
+	id BLOB NOT NULL,
+	node_id BLOB NOT NULL REFERENCES nodes( id ) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	old_value TEXT NOT NULL,
+	new_value TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE INDEX node_labels_name_value_index ON node_labels ( name, value );
+CREATE INDEX node_setting_changes_node_id_created_at_index ON node_setting_changes ( node_id, created_at );
+
+-- MAIN DATA --
+
+INSERT INTO nodes (id, name, public_address, api_secret) VALUES (X'2b3a5863a41f25408a8f5348839d7a1361dbd886d75786bb139a8ca0bdf41000', 'node_name', '127.0.0.1:13000', X'62180593328b8ff3c9f97565fdfd305d');
+INSERT INTO node_labels (node_id, name, value) VALUES (X'2b3a5863a41f25408a8f5348839d7a1361dbd886d75786bb139a8ca0bdf41000', 'location', 'berlin');
+INSERT INTO alerts (id, rule, node_id, satellite_id, message, created_at, resolved_at) VALUES (X'0c2ba0c4e5c64a5f9f5e6a7e1b1c2d3e', 'offline', X'2b3a5863a41f25408a8f5348839d7a1361dbd886d75786bb139a8ca0bdf41000', X'', 'node is offline', '2023-05-01 10:00:00+00:00', NULL);
+
+-- NEW DATA --
+
+INSERT INTO node_setting_changes (id, node_id, name, old_value, new_value, created_at) VALUES (X'5d1b7f0c9a2e4f6b8c3d2e1f0a9b8c7d', X'2b3a5863a41f25408a8f5348839d7a1361dbd886d75786bb139a8ca0bdf41000', 'wallet', '0x0000000000000000000000000000000000000000', '0x0123456789012345678901234567890123456789', '2023-05-02 10:00:00+00:00');
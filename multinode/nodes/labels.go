@@ -0,0 +1,104 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodes
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrInvalidLabel is an error type that indicates about an invalid label name or value.
+var ErrInvalidLabel = errs.Class("invalid node label")
+
+// maxLabelLength is the maximum length of a label name or value.
+const maxLabelLength = 64
+
+// Labels are the names and values of the labels of a node, e.g. location or hardware class,
+// which organize the nodes into groups.
+type Labels map[string]string
+
+// Group contains the number of nodes with the same label.
+type Group struct {
+	Name      string `json:"name"`
+	Value     string `json:"value"`
+	NodeCount int    `json:"nodeCount"`
+}
+
+// ValidateLabel checks whether the label name and value can be used in a selector.
+func ValidateLabel(name, value string) error {
+	for _, s := range []string{name, value} {
+		switch {
+		case s == "":
+			return ErrInvalidLabel.New("name and value can't be empty")
+		case len(s) > maxLabelLength:
+			return ErrInvalidLabel.New("%q is longer than %d characters", s, maxLabelLength)
+		case strings.ContainsAny(s, ":,"):
+			return ErrInvalidLabel.New("%q contains ':' or ','", s)
+		}
+	}
+	return nil
+}
+
+// Selector selects the nodes, which have all of its labels. An empty selector selects all
+// the nodes.
+type Selector Labels
+
+// ParseSelector parses a comma separated list of name:value labels.
+func ParseSelector(value string) (Selector, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	selector := make(Selector)
+	for _, entry := range strings.Split(value, ",") {
+		name, labelValue, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, ErrInvalidLabel.New("%q: expected <name>:<value>", entry)
+		}
+		if err := ValidateLabel(name, labelValue); err != nil {
+			return nil, err
+		}
+		selector[name] = labelValue
+	}
+	return selector, nil
+}
+
+// String returns the selector in the format of ParseSelector, sorted by name.
+func (selector Selector) String() string {
+	entries := make([]string, 0, len(selector))
+	for name, value := range selector {
+		entries = append(entries, name+":"+value)
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
+}
+
+// Matches returns true, when the labels contain all the labels of the selector.
+func (selector Selector) Matches(labels Labels) bool {
+	for name, value := range selector {
+		if labels[name] != value {
+			return false
+		}
+	}
+	return true
+}
+
+type selectorKey struct{}
+
+// WithSelector returns a context, within which NodesDB lists only the nodes matching the selector.
+func WithSelector(ctx context.Context, selector Selector) context.Context {
+	if len(selector) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, selectorKey{}, selector)
+}
+
+// SelectorFromContext returns the selector of the context, or an empty selector.
+func SelectorFromContext(ctx context.Context) Selector {
+	selector, _ := ctx.Value(selectorKey{}).(Selector)
+	return selector
+}
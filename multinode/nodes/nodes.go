@@ -19,9 +19,9 @@ import (
 type DB interface {
 	// Get return node from NodesDB by its id.
 	Get(ctx context.Context, id storj.NodeID) (Node, error)
-	// List returns all connected nodes.
+	// List returns all connected nodes, which match the selector of the context.
 	List(ctx context.Context) ([]Node, error)
-	// ListPaged returns paginated nodes list.
+	// ListPaged returns paginated nodes list, which match the selector of the context.
 	// TODO: rename to ListPaginated, because pagination is to divide up copy into pages,
 	// because paging doesn't necessarily mean pagination in computing.
 	ListPaged(ctx context.Context, cursor Cursor) (page Page, err error)
@@ -31,6 +31,12 @@ type DB interface {
 	Remove(ctx context.Context, id storj.NodeID) error
 	// UpdateName will update name of the specified node in database.
 	UpdateName(ctx context.Context, id storj.NodeID, name string) error
+	// SetLabel sets the value of the label of the specified node.
+	SetLabel(ctx context.Context, id storj.NodeID, name, value string) error
+	// RemoveLabel removes the label of the specified node.
+	RemoveLabel(ctx context.Context, id storj.NodeID, name string) error
+	// Groups returns the number of nodes by label.
+	Groups(ctx context.Context) ([]Group, error)
 }
 
 var (
@@ -45,6 +51,7 @@ type Node struct {
 	APISecret     multinodeauth.Secret `json:"apiSecret"`
 	PublicAddress string               `json:"publicAddress"`
 	Name          string               `json:"name"`
+	Labels        Labels               `json:"labels"`
 }
 
 // Status represents node online status.
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/multinode"
@@ -124,3 +125,83 @@ func TestNodesDB(t *testing.T) {
 		})
 	})
 }
+
+func TestNodesDBLabels(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		nodesRepository := db.Nodes()
+
+		var nodeIDs []storj.NodeID
+		for i := 0; i < 3; i++ {
+			node := nodes.Node{
+				ID:            testrand.NodeID(),
+				APISecret:     multinodeauth.Secret{uint8(i)},
+				PublicAddress: fmt.Sprintf("%d", i),
+			}
+			require.NoError(t, nodesRepository.Add(ctx, node))
+			nodeIDs = append(nodeIDs, node.ID)
+		}
+
+		require.NoError(t, nodesRepository.SetLabel(ctx, nodeIDs[0], "location", "berlin"))
+		require.NoError(t, nodesRepository.SetLabel(ctx, nodeIDs[0], "hardware", "rpi4"))
+		require.NoError(t, nodesRepository.SetLabel(ctx, nodeIDs[1], "location", "paris"))
+		require.NoError(t, nodesRepository.SetLabel(ctx, nodeIDs[1], "location", "berlin"))
+		require.NoError(t, nodesRepository.SetLabel(ctx, nodeIDs[2], "location", "paris"))
+
+		node, err := nodesRepository.Get(ctx, nodeIDs[0])
+		require.NoError(t, err)
+		require.Equal(t, nodes.Labels{"location": "berlin", "hardware": "rpi4"}, node.Labels)
+
+		groups, err := nodesRepository.Groups(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []nodes.Group{
+			{Name: "hardware", Value: "rpi4", NodeCount: 1},
+			{Name: "location", Value: "berlin", NodeCount: 2},
+			{Name: "location", Value: "paris", NodeCount: 1},
+		}, groups)
+
+		selectedCtx := nodes.WithSelector(ctx, nodes.Selector{"location": "berlin"})
+		selected, err := nodesRepository.List(selectedCtx)
+		require.NoError(t, err)
+		require.Len(t, selected, 2)
+		require.Equal(t, nodeIDs[0], selected[0].ID)
+		require.Equal(t, nodeIDs[1], selected[1].ID)
+
+		page, err := nodesRepository.ListPaged(selectedCtx, nodes.Cursor{Limit: 1, Page: 2})
+		require.NoError(t, err)
+		require.EqualValues(t, 2, page.TotalCount)
+		require.EqualValues(t, 2, page.PageCount)
+		require.Len(t, page.Nodes, 1)
+		require.Equal(t, nodeIDs[1], page.Nodes[0].ID)
+
+		_, err = nodesRepository.List(nodes.WithSelector(ctx, nodes.Selector{"location": "tokyo"}))
+		require.True(t, nodes.ErrNoNode.Has(err))
+
+		require.NoError(t, nodesRepository.RemoveLabel(ctx, nodeIDs[0], "hardware"))
+		require.NoError(t, nodesRepository.Remove(ctx, nodeIDs[1]))
+
+		groups, err = nodesRepository.Groups(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []nodes.Group{
+			{Name: "location", Value: "berlin", NodeCount: 1},
+			{Name: "location", Value: "paris", NodeCount: 1},
+		}, groups)
+	})
+}
+
+func TestParseSelector(t *testing.T) {
+	selector, err := nodes.ParseSelector("location:berlin,hardware:rpi4")
+	require.NoError(t, err)
+	require.Equal(t, nodes.Selector{"location": "berlin", "hardware": "rpi4"}, selector)
+	require.Equal(t, "hardware:rpi4,location:berlin", selector.String())
+	require.True(t, selector.Matches(nodes.Labels{"location": "berlin", "hardware": "rpi4", "isp": "x"}))
+	require.False(t, selector.Matches(nodes.Labels{"location": "berlin"}))
+
+	selector, err = nodes.ParseSelector("")
+	require.NoError(t, err)
+	require.True(t, selector.Matches(nil))
+
+	for _, invalid := range []string{"location", "location:", ":berlin", "location:berlin,"} {
+		_, err := nodes.ParseSelector(invalid)
+		require.True(t, nodes.ErrInvalidLabel.Has(err), invalid)
+	}
+}
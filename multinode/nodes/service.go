@@ -116,6 +116,38 @@ func (service *Service) Remove(ctx context.Context, id storj.NodeID) (err error)
 	return Error.Wrap(service.nodes.Remove(ctx, id))
 }
 
+// SetLabel sets the label of the node, which adds the node to the group of the label.
+func (service *Service) SetLabel(ctx context.Context, id storj.NodeID, name, value string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := ValidateLabel(name, value); err != nil {
+		return err
+	}
+	if _, err := service.nodes.Get(ctx, id); err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(service.nodes.SetLabel(ctx, id, name, value))
+}
+
+// RemoveLabel removes the label of the node.
+func (service *Service) RemoveLabel(ctx context.Context, id storj.NodeID, name string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return Error.Wrap(service.nodes.RemoveLabel(ctx, id, name))
+}
+
+// Groups returns the groups of nodes by label.
+func (service *Service) Groups(ctx context.Context) (_ []Group, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	groups, err := service.nodes.Groups(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return groups, nil
+}
+
 // ListInfos queries node basic info from all nodes via rpc.
 func (service *Service) ListInfos(ctx context.Context) (_ []NodeInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
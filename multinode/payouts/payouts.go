@@ -85,3 +85,42 @@ type Paystub struct {
 	Distributed    int64   `json:"distributed"`
 	Disposed       int64   `json:"disposed"`
 }
+
+// Add sums the paystubs.
+func (paystub *Paystub) Add(other Paystub) {
+	paystub.UsageAtRest += other.UsageAtRest
+	paystub.UsageGet += other.UsageGet
+	paystub.UsageGetRepair += other.UsageGetRepair
+	paystub.UsageGetAudit += other.UsageGetAudit
+	paystub.CompAtRest += other.CompAtRest
+	paystub.CompGet += other.CompGet
+	paystub.CompGetRepair += other.CompGetRepair
+	paystub.CompGetAudit += other.CompGetAudit
+	paystub.Held += other.Held
+	paystub.Paid += other.Paid
+	paystub.Distributed += other.Distributed
+	paystub.Disposed += other.Disposed
+}
+
+// PeriodHistory contains paystubs of all nodes from satellite for specific period.
+type PeriodHistory struct {
+	Period    string  `json:"period"`
+	NodeCount int     `json:"nodeCount"`
+	Paystub   Paystub `json:"paystub"`
+}
+
+// HistoryCursor holds cursor entity which is used to create listed page of payout history.
+type HistoryCursor struct {
+	Limit int64
+	Page  int64
+}
+
+// HistoryPage holds payout history page entity, which is used to show listed page of periods.
+type HistoryPage struct {
+	Periods     []PeriodHistory `json:"periods"`
+	Offset      int64           `json:"offset"`
+	Limit       int64           `json:"limit"`
+	CurrentPage int64           `json:"currentPage"`
+	PageCount   int64           `json:"pageCount"`
+	TotalCount  int64           `json:"totalCount"`
+}
@@ -0,0 +1,41 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/multinode/payouts"
+)
+
+func TestPaystubAdd(t *testing.T) {
+	paystub := payouts.Paystub{
+		UsageAtRest: 1.5,
+		UsageGet:    2,
+		CompAtRest:  3,
+		Held:        4,
+		Paid:        5,
+	}
+	paystub.Add(payouts.Paystub{
+		UsageAtRest:    0.5,
+		UsageGet:       1,
+		UsageGetRepair: 1,
+		CompAtRest:     1,
+		Held:           1,
+		Paid:           1,
+		Distributed:    7,
+	})
+
+	require.Equal(t, payouts.Paystub{
+		UsageAtRest:    2,
+		UsageGet:       3,
+		UsageGetRepair: 1,
+		CompAtRest:     4,
+		Held:           5,
+		Paid:           6,
+		Distributed:    7,
+	}, paystub)
+}
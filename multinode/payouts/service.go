@@ -5,11 +5,14 @@ package payouts
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/lrucache"
 	"storj.io/common/rpc"
 	"storj.io/common/storj"
 	"storj.io/drpc"
@@ -23,6 +26,17 @@ var (
 	Error = errs.Class("payouts")
 )
 
+// MaxPeriodsOnPage defines maximum limit on payout history page.
+const MaxPeriodsOnPage = 24
+
+// historyCacheCapacity is how many satellites and periods of payout history are cached.
+const historyCacheCapacity = 1000
+
+// Config contains configurable values for payouts service.
+type Config struct {
+	HistoryCacheExpiration time.Duration `help:"how long the aggregated payout history of the nodes is cached, 0 to disable caching" default:"10m"`
+}
+
 // Service exposes all payouts related logic.
 //
 // architecture: Service
@@ -30,15 +44,170 @@ type Service struct {
 	log    *zap.Logger
 	dialer rpc.Dialer
 	nodes  nodes.DB
+
+	periodsCache *lrucache.ExpiringLRUOf[historyPeriods]
+	historyCache *lrucache.ExpiringLRUOf[PeriodHistory]
 }
 
 // NewService creates new instance of Service.
-func NewService(log *zap.Logger, dialer rpc.Dialer, nodes nodes.DB) *Service {
+func NewService(log *zap.Logger, dialer rpc.Dialer, nodes nodes.DB, config Config) *Service {
+	capacity := 0
+	if config.HistoryCacheExpiration > 0 {
+		capacity = historyCacheCapacity
+	}
+
 	return &Service{
 		log:    log,
 		dialer: dialer,
 		nodes:  nodes,
+		periodsCache: lrucache.NewOf[historyPeriods](lrucache.Options{
+			Expiration: config.HistoryCacheExpiration,
+			Capacity:   capacity,
+			Name:       "multinode-payouts-periods",
+		}),
+		historyCache: lrucache.NewOf[PeriodHistory](lrucache.Options{
+			Expiration: config.HistoryCacheExpiration,
+			Capacity:   capacity,
+			Name:       "multinode-payouts-history",
+		}),
+	}
+}
+
+// historyPeriods contains the nodes, which have paystubs from satellite, by period.
+type historyPeriods struct {
+	// periods are sorted from the newest.
+	periods []string
+	nodes   map[string][]nodes.Node
+}
+
+// History returns paginated payout history of all nodes for satellite, aggregated by period
+// from the newest one.
+func (service *Service) History(ctx context.Context, satelliteID storj.NodeID, cursor HistoryCursor) (_ HistoryPage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if cursor.Limit > MaxPeriodsOnPage {
+		cursor.Limit = MaxPeriodsOnPage
+	}
+	if cursor.Limit < 1 {
+		cursor.Limit = 1
+	}
+	if cursor.Page < 1 {
+		return HistoryPage{}, Error.New("page can not be less than 1")
+	}
+
+	// the nodes of the history depend on the label selector.
+	cacheKey := satelliteID.String() + "/" + nodes.SelectorFromContext(ctx).String()
+
+	periods, err := service.periodsCache.Get(ctx, cacheKey, func() (historyPeriods, error) {
+		return service.historyPeriods(ctx, satelliteID)
+	})
+	if err != nil {
+		return HistoryPage{}, Error.Wrap(err)
+	}
+
+	page := HistoryPage{
+		Periods:     []PeriodHistory{},
+		Offset:      (cursor.Page - 1) * cursor.Limit,
+		Limit:       cursor.Limit,
+		CurrentPage: cursor.Page,
+		TotalCount:  int64(len(periods.periods)),
+	}
+	page.PageCount = (page.TotalCount + page.Limit - 1) / page.Limit
+
+	for i := page.Offset; i < page.TotalCount && i < page.Offset+page.Limit; i++ {
+		period := periods.periods[i]
+		history, err := service.historyCache.Get(ctx, cacheKey+"/"+period, func() (PeriodHistory, error) {
+			return service.periodHistory(ctx, satelliteID, period, periods.nodes[period])
+		})
+		if err != nil {
+			return HistoryPage{}, Error.Wrap(err)
+		}
+		page.Periods = append(page.Periods, history)
+	}
+
+	return page, nil
+}
+
+// historyPeriods retrieves the periods, for which the nodes have paystubs from satellite.
+func (service *Service) historyPeriods(ctx context.Context, satelliteID storj.NodeID) (_ historyPeriods, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	listNodes, err := service.nodes.List(ctx)
+	if err != nil {
+		return historyPeriods{}, err
+	}
+
+	periods := historyPeriods{
+		nodes: make(map[string][]nodes.Node),
+	}
+	for _, node := range listNodes {
+		history, err := service.nodeHeldAmountHistory(ctx, node)
+		if err != nil {
+			if nodes.ErrNodeNotReachable.Has(err) {
+				continue
+			}
+			return historyPeriods{}, err
+		}
+
+		for _, satelliteHistory := range history {
+			if satelliteHistory.SatelliteID != satelliteID {
+				continue
+			}
+			for _, heldAmount := range satelliteHistory.HeldAmounts {
+				if _, ok := periods.nodes[heldAmount.Period]; !ok {
+					periods.periods = append(periods.periods, heldAmount.Period)
+				}
+				periods.nodes[heldAmount.Period] = append(periods.nodes[heldAmount.Period], node)
+			}
+		}
+	}
+
+	// periods are formatted as YYYY-MM.
+	sort.Sort(sort.Reverse(sort.StringSlice(periods.periods)))
+
+	return periods, nil
+}
+
+// nodeHeldAmountHistory dials node and retrieves its held amount history.
+func (service *Service) nodeHeldAmountHistory(ctx context.Context, node nodes.Node) (_ []HeldAmountHistory, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := service.dialer.DialNodeURL(ctx, storj.NodeURL{
+		ID:      node.ID,
+		Address: node.PublicAddress,
+	})
+	if err != nil {
+		return nil, nodes.ErrNodeNotReachable.Wrap(err)
+	}
+
+	defer func() {
+		err = errs.Combine(err, conn.Close())
+	}()
+
+	return service.heldAmountHistory(ctx, node, conn)
+}
+
+// periodHistory sums the paystubs of the nodes from satellite for period.
+func (service *Service) periodHistory(ctx context.Context, satelliteID storj.NodeID, period string, periodNodes []nodes.Node) (_ PeriodHistory, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	history := PeriodHistory{
+		Period: period,
+	}
+	for _, node := range periodNodes {
+		paystub, err := service.paystubSatellitePeriod(ctx, node, period, satelliteID)
+		if err != nil {
+			if nodes.ErrNodeNotReachable.Has(err) {
+				continue
+			}
+			return PeriodHistory{}, err
+		}
+
+		history.NodeCount++
+		history.Paystub.Add(paystub)
 	}
+
+	return history, nil
 }
 
 // Earned retrieves all nodes earned amount for all time.
@@ -583,6 +752,13 @@ func (service *Service) PaystubSatellitePeriod(ctx context.Context, period strin
 		return Paystub{}, Error.Wrap(err)
 	}
 
+	return service.paystubSatellitePeriod(ctx, node, period, satelliteID)
+}
+
+// paystubSatellitePeriod dials node and retrieves its paystub from satellite for period.
+func (service *Service) paystubSatellitePeriod(ctx context.Context, node nodes.Node, period string, satelliteID storj.NodeID) (_ Paystub, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	conn, err := service.dialer.DialNodeURL(ctx, storj.NodeURL{
 		ID:      node.ID,
 		Address: node.PublicAddress,
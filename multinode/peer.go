@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 
 	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
@@ -18,12 +19,14 @@ import (
 	"storj.io/common/peertls/tlsopts"
 	"storj.io/common/rpc"
 	"storj.io/private/debug"
+	"storj.io/storj/multinode/alerts"
 	"storj.io/storj/multinode/bandwidth"
 	"storj.io/storj/multinode/console/server"
 	"storj.io/storj/multinode/nodes"
 	"storj.io/storj/multinode/operators"
 	"storj.io/storj/multinode/payouts"
 	"storj.io/storj/multinode/reputation"
+	"storj.io/storj/multinode/settings"
 	"storj.io/storj/multinode/storage"
 	"storj.io/storj/private/lifecycle"
 	multinodeweb "storj.io/storj/web/multinode"
@@ -39,6 +42,10 @@ var (
 type DB interface {
 	// Nodes returns nodes database.
 	Nodes() nodes.DB
+	// Alerts returns alerts database.
+	Alerts() alerts.DB
+	// Settings returns node settings database.
+	Settings() settings.DB
 
 	// MigrateToLatest initializes the database.
 	MigrateToLatest(ctx context.Context) error
@@ -52,7 +59,10 @@ type Config struct {
 	Identity identity.Config
 	Debug    debug.Config
 
-	Console server.Config
+	Console    server.Config
+	Payouts    payouts.Config
+	Reputation reputation.Config
+	Alerts     alerts.Config
 }
 
 // Peer is the a Multinode Dashboard application itself.
@@ -94,13 +104,24 @@ type Peer struct {
 		Service *reputation.Service
 	}
 
+	// exposes and changes the settings of the nodes.
+	Settings struct {
+		Service *settings.Service
+	}
+
+	// evaluates the alert rules and notifies about the alerts.
+	Alerts struct {
+		Service *alerts.Service
+	}
+
 	// Web server with web UI.
 	Console struct {
 		Listener net.Listener
 		Endpoint *server.Server
 	}
 
-	Servers *lifecycle.Group
+	Servers  *lifecycle.Group
+	Services *lifecycle.Group
 }
 
 // New creates a new instance of Multinode Dashboard application.
@@ -110,6 +131,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config, db DB) (_
 		Identity: full,
 		DB:       db,
 		Servers:  lifecycle.NewGroup(log.Named("servers")),
+		Services: lifecycle.NewGroup(log.Named("services")),
 	}
 
 	tlsConfig := tlsopts.Config{
@@ -153,6 +175,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config, db DB) (_
 			peer.Log.Named("payouts:service"),
 			peer.Dialer,
 			peer.DB.Nodes(),
+			config.Payouts,
 		)
 	}
 
@@ -169,9 +192,40 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config, db DB) (_
 			peer.Log.Named("reputation:service"),
 			peer.Dialer,
 			peer.DB.Nodes(),
+			config.Reputation,
+		)
+	}
+
+	{ // settings setup
+		peer.Settings.Service = settings.NewService(
+			peer.Log.Named("settings:service"),
+			peer.Dialer,
+			peer.DB.Nodes(),
+			peer.DB.Settings(),
 		)
 	}
 
+	{ // alerts setup
+		peer.Alerts.Service, err = alerts.NewService(
+			peer.Log.Named("alerts:service"),
+			peer.Dialer,
+			peer.DB.Nodes(),
+			peer.DB.Alerts(),
+			config.Alerts,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if config.Alerts.Interval > 0 {
+			peer.Services.Add(lifecycle.Item{
+				Name:  "alerts:service",
+				Run:   peer.Alerts.Service.Run,
+				Close: peer.Alerts.Service.Close,
+			})
+		}
+	}
+
 	{ // console setup
 		peer.Console.Listener, err = net.Listen("tcp", config.Console.Address)
 		if err != nil {
@@ -199,6 +253,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config, db DB) (_
 				Storage:    peer.Storage.Service,
 				Bandwidth:  peer.Bandwidth.Service,
 				Reputation: peer.Reputation.Service,
+				Alerts:     peer.Alerts.Service,
+				Settings:   peer.Settings.Service,
 			},
 		)
 		if err != nil {
@@ -222,11 +278,15 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 	group, ctx := errgroup.WithContext(ctx)
 
 	peer.Servers.Run(ctx, group)
+	peer.Services.Run(ctx, group)
 
 	return group.Wait()
 }
 
 // Close closes all the resources.
 func (peer *Peer) Close() error {
-	return peer.Servers.Close()
+	return errs.Combine(
+		peer.Servers.Close(),
+		peer.Services.Close(),
+	)
 }
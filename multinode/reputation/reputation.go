@@ -43,3 +43,34 @@ type Stats struct {
 	UpdatedAt            time.Time    `json:"updatedAt"`
 	JoinedAt             time.Time    `json:"joinedAt"`
 }
+
+// TrendWindow contains audit counts of all nodes for particular time frame.
+type TrendWindow struct {
+	WindowStart time.Time `json:"windowStart"`
+	NodeCount   int       `json:"nodeCount"`
+	TotalCount  int64     `json:"totalCount"`
+	OnlineCount int64     `json:"onlineCount"`
+	// OnlineRatio is the ratio of the audits, for which the nodes were online.
+	OnlineRatio float64 `json:"onlineRatio"`
+}
+
+// TrendCursor holds cursor entity which is used to create listed page of reputation trend.
+type TrendCursor struct {
+	Limit int64
+	Page  int64
+}
+
+// TrendPage holds reputation trend page entity, which contains average scores of the nodes
+// and listed page of audit windows.
+type TrendPage struct {
+	NodeCount       int           `json:"nodeCount"`
+	AuditScore      float64       `json:"auditScore"`
+	SuspensionScore float64       `json:"suspensionScore"`
+	OnlineScore     float64       `json:"onlineScore"`
+	Windows         []TrendWindow `json:"windows"`
+	Offset          int64         `json:"offset"`
+	Limit           int64         `json:"limit"`
+	CurrentPage     int64         `json:"currentPage"`
+	PageCount       int64         `json:"pageCount"`
+	TotalCount      int64         `json:"totalCount"`
+}
@@ -5,11 +5,14 @@ package reputation
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/lrucache"
 	"storj.io/common/rpc"
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
@@ -25,6 +28,17 @@ var (
 	ErrorNoStats = errs.Class("reputation stats not found")
 )
 
+// MaxWindowsOnPage defines maximum limit on reputation trend page.
+const MaxWindowsOnPage = 100
+
+// trendCacheCapacity is how many satellites reputation stats are cached for trends.
+const trendCacheCapacity = 100
+
+// Config contains configurable values for reputation service.
+type Config struct {
+	TrendCacheExpiration time.Duration `help:"how long the reputation stats of the nodes are cached for the aggregated trends, 0 to disable caching" default:"5m"`
+}
+
 // Service exposes all reputation related logic.
 //
 // architecture: Service
@@ -32,17 +46,109 @@ type Service struct {
 	log    *zap.Logger
 	dialer rpc.Dialer
 	nodes  nodes.DB
+
+	statsCache *lrucache.ExpiringLRUOf[[]Stats]
 }
 
 // NewService creates new instance of reputation Service.
-func NewService(log *zap.Logger, dialer rpc.Dialer, nodes nodes.DB) *Service {
+func NewService(log *zap.Logger, dialer rpc.Dialer, nodes nodes.DB, config Config) *Service {
+	capacity := 0
+	if config.TrendCacheExpiration > 0 {
+		capacity = trendCacheCapacity
+	}
+
 	return &Service{
 		log:    log,
 		dialer: dialer,
 		nodes:  nodes,
+		statsCache: lrucache.NewOf[[]Stats](lrucache.Options{
+			Expiration: config.TrendCacheExpiration,
+			Capacity:   capacity,
+			Name:       "multinode-reputation-stats",
+		}),
 	}
 }
 
+// Trend returns reputation scores of all nodes for satellite and paginated online counts
+// aggregated by audit window from the newest one.
+func (service *Service) Trend(ctx context.Context, satelliteID storj.NodeID, cursor TrendCursor) (_ TrendPage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if cursor.Limit > MaxWindowsOnPage {
+		cursor.Limit = MaxWindowsOnPage
+	}
+	if cursor.Limit < 1 {
+		cursor.Limit = 1
+	}
+	if cursor.Page < 1 {
+		return TrendPage{}, Error.New("page can not be less than 1")
+	}
+
+	// the nodes of the trend depend on the label selector.
+	cacheKey := satelliteID.String() + "/" + nodes.SelectorFromContext(ctx).String()
+
+	statsList, err := service.statsCache.Get(ctx, cacheKey, func() ([]Stats, error) {
+		return service.Stats(ctx, satelliteID)
+	})
+	if err != nil {
+		return TrendPage{}, Error.Wrap(err)
+	}
+
+	windows := make(map[time.Time]*TrendWindow)
+	page := TrendPage{
+		Windows:     []TrendWindow{},
+		NodeCount:   len(statsList),
+		Offset:      (cursor.Page - 1) * cursor.Limit,
+		Limit:       cursor.Limit,
+		CurrentPage: cursor.Page,
+	}
+	for _, stats := range statsList {
+		page.AuditScore += stats.Audit.Score
+		page.SuspensionScore += stats.Audit.SuspensionScore
+		page.OnlineScore += stats.OnlineScore
+
+		for _, auditWindow := range stats.Audit.History {
+			window, ok := windows[auditWindow.WindowStart]
+			if !ok {
+				window = &TrendWindow{WindowStart: auditWindow.WindowStart}
+				windows[auditWindow.WindowStart] = window
+			}
+			window.NodeCount++
+			window.TotalCount += int64(auditWindow.TotalCount)
+			window.OnlineCount += int64(auditWindow.OnlineCount)
+		}
+	}
+	if len(statsList) > 0 {
+		page.AuditScore /= float64(len(statsList))
+		page.SuspensionScore /= float64(len(statsList))
+		page.OnlineScore /= float64(len(statsList))
+	}
+
+	sorted := make([]TrendWindow, 0, len(windows))
+	for _, window := range windows {
+		window.OnlineRatio = 1
+		if window.TotalCount > 0 {
+			window.OnlineRatio = float64(window.OnlineCount) / float64(window.TotalCount)
+		}
+		sorted = append(sorted, *window)
+	}
+	sort.Slice(sorted, func(i, k int) bool {
+		return sorted[i].WindowStart.After(sorted[k].WindowStart)
+	})
+
+	page.TotalCount = int64(len(sorted))
+	page.PageCount = (page.TotalCount + page.Limit - 1) / page.Limit
+	if page.Offset < page.TotalCount {
+		end := page.Offset + page.Limit
+		if end > page.TotalCount {
+			end = page.TotalCount
+		}
+		page.Windows = append(page.Windows, sorted[page.Offset:end]...)
+	}
+
+	return page, nil
+}
+
 // Stats retrieves node reputation stats list for satellite.
 func (service *Service) Stats(ctx context.Context, satelliteID storj.NodeID) (_ []Stats, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -0,0 +1,229 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package settings implements viewing and changing the settings of the nodes, e.g. the
+// allocated disk space, and keeps the history of the changes.
+package settings
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/multinode/nodes"
+	"storj.io/storj/private/multinodepb"
+)
+
+var (
+	mon = monkit.Package()
+	// Error is an error class for settings service error.
+	Error = errs.Class("settings")
+	// ErrUpdatesNotAllowed is an error class, which indicates that the node doesn't allow
+	// changing its settings.
+	ErrUpdatesNotAllowed = errs.Class("settings updates not allowed")
+	// ErrInvalidSettings is an error class, which indicates that the node rejected the settings.
+	ErrInvalidSettings = errs.Class("invalid settings")
+)
+
+// MaxChangesOnPage defines maximum limit on settings changes page.
+const MaxChangesOnPage = 100
+
+// Service exposes the settings of the nodes and their history.
+//
+// architecture: Service
+type Service struct {
+	log    *zap.Logger
+	dialer rpc.Dialer
+	nodes  nodes.DB
+	db     DB
+}
+
+// NewService creates new instance of settings Service.
+func NewService(log *zap.Logger, dialer rpc.Dialer, nodes nodes.DB, db DB) *Service {
+	return &Service{
+		log:    log,
+		dialer: dialer,
+		nodes:  nodes,
+		db:     db,
+	}
+}
+
+// Get returns the settings of the node.
+func (service *Service) Get(ctx context.Context, nodeID storj.NodeID) (_ Settings, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	node, err := service.nodes.Get(ctx, nodeID)
+	if err != nil {
+		return Settings{}, Error.Wrap(err)
+	}
+
+	conn, err := service.dial(ctx, node)
+	if err != nil {
+		return Settings{}, err
+	}
+	defer func() {
+		err = errs.Combine(err, conn.Close())
+	}()
+
+	return service.get(ctx, multinodepb.NewDRPCSettingsClient(conn), node)
+}
+
+// Update changes the settings of the node and records the changes.
+func (service *Service) Update(ctx context.Context, nodeID storj.NodeID, update Update) (_ Settings, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	node, err := service.nodes.Get(ctx, nodeID)
+	if err != nil {
+		return Settings{}, Error.Wrap(err)
+	}
+
+	conn, err := service.dial(ctx, node)
+	if err != nil {
+		return Settings{}, err
+	}
+	defer func() {
+		err = errs.Combine(err, conn.Close())
+	}()
+
+	client := multinodepb.NewDRPCSettingsClient(conn)
+
+	old, err := service.get(ctx, client, node)
+	if err != nil {
+		return Settings{}, err
+	}
+	if !old.UpdatesAllowed {
+		return Settings{}, ErrUpdatesNotAllowed.New("node %s doesn't allow changing its settings", node.ID)
+	}
+
+	request := &multinodepb.UpdateSettingsRequest{
+		Header: &multinodepb.RequestHeader{
+			ApiKey: node.APISecret[:],
+		},
+		Settings: &multinodepb.NodeSettings{},
+	}
+	if update.AllocatedDiskSpace != nil {
+		if *update.AllocatedDiskSpace <= 0 {
+			return Settings{}, ErrInvalidSettings.New("allocated disk space must be positive")
+		}
+		request.Settings.AllocatedDiskSpace = *update.AllocatedDiskSpace
+	}
+	if update.Wallet != nil {
+		if *update.Wallet == "" {
+			return Settings{}, ErrInvalidSettings.New("wallet can't be empty")
+		}
+		request.Settings.Wallet = *update.Wallet
+	}
+	if update.BandwidthLimits != nil {
+		request.Settings.BandwidthLimits = &multinodepb.BandwidthLimits{
+			Ingress: update.BandwidthLimits.Ingress,
+			Egress:  update.BandwidthLimits.Egress,
+		}
+	}
+
+	response, err := client.UpdateSettings(ctx, request)
+	if err != nil {
+		switch rpcstatus.Code(err) {
+		case rpcstatus.PermissionDenied:
+			return Settings{}, ErrUpdatesNotAllowed.Wrap(err)
+		case rpcstatus.InvalidArgument:
+			return Settings{}, ErrInvalidSettings.Wrap(err)
+		default:
+			return Settings{}, Error.Wrap(err)
+		}
+	}
+
+	updated := fromPB(response.GetSettings())
+	updated.RestartRequired = response.GetRestartRequired()
+	updated.UpdatesAllowed = true
+
+	changes := []Change{
+		{Name: NameAllocatedDiskSpace, OldValue: strconv.FormatInt(old.AllocatedDiskSpace, 10), NewValue: strconv.FormatInt(updated.AllocatedDiskSpace, 10)},
+		{Name: NameWallet, OldValue: old.Wallet, NewValue: updated.Wallet},
+		{Name: NameIngress, OldValue: strconv.FormatInt(old.Ingress, 10), NewValue: strconv.FormatInt(updated.Ingress, 10)},
+		{Name: NameEgress, OldValue: strconv.FormatInt(old.Egress, 10), NewValue: strconv.FormatInt(updated.Egress, 10)},
+	}
+	for _, change := range changes {
+		if change.OldValue == change.NewValue {
+			continue
+		}
+
+		change.ID, err = uuid.New()
+		if err != nil {
+			return Settings{}, Error.Wrap(err)
+		}
+		change.NodeID = node.ID
+		if err := service.db.Insert(ctx, change); err != nil {
+			return Settings{}, Error.Wrap(err)
+		}
+	}
+
+	return updated, nil
+}
+
+// History returns paginated history of the changes of the settings of the node.
+func (service *Service) History(ctx context.Context, nodeID storj.NodeID, cursor Cursor) (_ Page, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if cursor.Limit > MaxChangesOnPage {
+		cursor.Limit = MaxChangesOnPage
+	}
+	if cursor.Limit < 1 {
+		cursor.Limit = 1
+	}
+	if cursor.Page < 1 {
+		return Page{}, Error.New("page can not be less than 1")
+	}
+
+	if _, err := service.nodes.Get(ctx, nodeID); err != nil {
+		return Page{}, Error.Wrap(err)
+	}
+
+	page, err := service.db.ListPaged(ctx, nodeID, cursor)
+	return page, Error.Wrap(err)
+}
+
+// dial dials the node.
+func (service *Service) dial(ctx context.Context, node nodes.Node) (*rpc.Conn, error) {
+	conn, err := service.dialer.DialNodeURL(ctx, storj.NodeURL{
+		ID:      node.ID,
+		Address: node.PublicAddress,
+	})
+	if err != nil {
+		return nil, nodes.ErrNodeNotReachable.Wrap(err)
+	}
+	return conn, nil
+}
+
+// get retrieves the settings of the node.
+func (service *Service) get(ctx context.Context, client multinodepb.DRPCSettingsClient, node nodes.Node) (_ Settings, err error) {
+	response, err := client.Settings(ctx, &multinodepb.SettingsRequest{
+		Header: &multinodepb.RequestHeader{
+			ApiKey: node.APISecret[:],
+		},
+	})
+	if err != nil {
+		return Settings{}, Error.Wrap(err)
+	}
+
+	settings := fromPB(response.GetSettings())
+	settings.RestartRequired = response.GetRestartRequired()
+	settings.UpdatesAllowed = response.GetUpdatesAllowed()
+	return settings, nil
+}
+
+// fromPB converts multinodepb.NodeSettings to Settings.
+func fromPB(settings *multinodepb.NodeSettings) Settings {
+	return Settings{
+		AllocatedDiskSpace: settings.GetAllocatedDiskSpace(),
+		Wallet:             settings.GetWallet(),
+		Ingress:            settings.GetBandwidthLimits().GetIngress(),
+		Egress:             settings.GetBandwidthLimits().GetEgress(),
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package settings
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+)
+
+// DB exposes needed by MND NodeSettingsDB functionality.
+//
+// architecture: Database
+type DB interface {
+	// Insert stores a change of a setting of a node.
+	Insert(ctx context.Context, change Change) error
+	// ListPaged returns paginated changes of the settings of a node from the newest change.
+	ListPaged(ctx context.Context, nodeID storj.NodeID, cursor Cursor) (Page, error)
+}
+
+// Names of the settings, which are recorded in the change history.
+const (
+	NameAllocatedDiskSpace = "allocatedDiskSpace"
+	NameWallet             = "wallet"
+	NameIngress            = "ingress"
+	NameEgress             = "egress"
+)
+
+// Settings are the settings of a node, which can be changed remotely.
+type Settings struct {
+	AllocatedDiskSpace int64  `json:"allocatedDiskSpace"`
+	Wallet             string `json:"wallet"`
+	// Ingress and Egress are the bandwidth limits in bytes per second, 0 means unlimited.
+	Ingress int64 `json:"ingress"`
+	Egress  int64 `json:"egress"`
+	// RestartRequired is true, when the node has to be restarted to apply the allocated
+	// disk space or the wallet.
+	RestartRequired bool `json:"restartRequired"`
+	// UpdatesAllowed is false, when the node doesn't allow changing its settings.
+	UpdatesAllowed bool `json:"updatesAllowed"`
+}
+
+// Update contains the settings to change. Nil settings aren't changed.
+type Update struct {
+	AllocatedDiskSpace *int64           `json:"allocatedDiskSpace"`
+	Wallet             *string          `json:"wallet"`
+	BandwidthLimits    *BandwidthLimits `json:"bandwidthLimits"`
+}
+
+// BandwidthLimits are the bandwidth limits of a node in bytes per second, 0 means unlimited.
+type BandwidthLimits struct {
+	Ingress int64 `json:"ingress"`
+	Egress  int64 `json:"egress"`
+}
+
+// Change is a change of a setting of a node.
+type Change struct {
+	ID        uuid.UUID    `json:"id"`
+	NodeID    storj.NodeID `json:"nodeId"`
+	Name      string       `json:"name"`
+	OldValue  string       `json:"oldValue"`
+	NewValue  string       `json:"newValue"`
+	CreatedAt time.Time    `json:"createdAt"`
+}
+
+// Cursor holds settings changes cursor entity which is used to create listed page.
+type Cursor struct {
+	Limit int64
+	Page  int64
+}
+
+// Page holds settings changes page entity which is used to show listed page of changes.
+type Page struct {
+	Changes     []Change `json:"changes"`
+	Offset      int64    `json:"offset"`
+	Limit       int64    `json:"limit"`
+	CurrentPage int64    `json:"currentPage"`
+	PageCount   int64    `json:"pageCount"`
+	TotalCount  int64    `json:"totalCount"`
+}
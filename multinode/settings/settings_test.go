@@ -0,0 +1,56 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package settings_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/multinode"
+	"storj.io/storj/multinode/multinodedb/multinodedbtest"
+	"storj.io/storj/multinode/nodes"
+	"storj.io/storj/multinode/settings"
+)
+
+func TestSettingsDB(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		settingsDB := db.Settings()
+
+		nodeID := testrand.NodeID()
+		require.NoError(t, db.Nodes().Add(ctx, nodes.Node{ID: nodeID, PublicAddress: "127.0.0.1:13000"}))
+
+		changes := []settings.Change{
+			{ID: testrand.UUID(), NodeID: nodeID, Name: settings.NameWallet, OldValue: "0x1", NewValue: "0x2"},
+			{ID: testrand.UUID(), NodeID: nodeID, Name: settings.NameIngress, OldValue: "0", NewValue: "1000000"},
+			{ID: testrand.UUID(), NodeID: nodeID, Name: settings.NameEgress, OldValue: "0", NewValue: "2000000"},
+		}
+		for _, change := range changes {
+			require.NoError(t, settingsDB.Insert(ctx, change))
+		}
+
+		page, err := settingsDB.ListPaged(ctx, nodeID, settings.Cursor{Limit: 2, Page: 1})
+		require.NoError(t, err)
+		require.EqualValues(t, 3, page.TotalCount)
+		require.EqualValues(t, 2, page.PageCount)
+		require.Len(t, page.Changes, 2)
+
+		page, err = settingsDB.ListPaged(ctx, nodeID, settings.Cursor{Limit: 2, Page: 2})
+		require.NoError(t, err)
+		require.Len(t, page.Changes, 1)
+
+		page, err = settingsDB.ListPaged(ctx, testrand.NodeID(), settings.Cursor{Limit: 2, Page: 1})
+		require.NoError(t, err)
+		require.Zero(t, page.TotalCount)
+		require.Empty(t, page.Changes)
+
+		// the history is removed with the node.
+		require.NoError(t, db.Nodes().Remove(ctx, nodeID))
+		page, err = settingsDB.ListPaged(ctx, nodeID, settings.Cursor{Limit: 2, Page: 1})
+		require.NoError(t, err)
+		require.Zero(t, page.TotalCount)
+	})
+}
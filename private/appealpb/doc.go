@@ -0,0 +1,7 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package appealpb contains protobuf definitions for the appeals of the suspended or disqualified storage nodes.
+package appealpb
+
+//go:generate go run gen.go
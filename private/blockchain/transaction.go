@@ -0,0 +1,97 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package blockchain
+
+import (
+	"encoding/hex"
+	"strings"
+)
+
+// Chain is the network on which a transaction was executed.
+type Chain string
+
+const (
+	// ChainEthereum is the Ethereum mainnet.
+	ChainEthereum Chain = "ethereum"
+	// ChainZkSync is the zkSync layer 2 network.
+	ChainZkSync Chain = "zksync"
+	// ChainPolygon is the Polygon network.
+	ChainPolygon Chain = "polygon"
+)
+
+// IsLayer2 returns whether the chain is a layer 2 network.
+func (chain Chain) IsLayer2() bool {
+	return chain == ChainZkSync || chain == ChainPolygon
+}
+
+// receiptPrefixes maps the prefixes of the payout receipts to the chain.
+// The zkwithdraw receipts are the withdrawals from zkSync to Ethereum, which
+// are initiated on zkSync.
+var receiptPrefixes = map[string]Chain{
+	"eth":        ChainEthereum,
+	"zksync":     ChainZkSync,
+	"zkwithdraw": ChainZkSync,
+	"polygon":    ChainPolygon,
+}
+
+// Transaction references a payment transaction on a chain.
+type Transaction struct {
+	Chain  Chain `json:"chain"`
+	Hash   Hash  `json:"hash"`
+	Layer2 bool  `json:"layer2"`
+}
+
+// ParseReceipt parses the transaction from a payout receipt in the form of
+// "<chain>:<hash>", e.g. "eth:0xdea1082dbea119c822dfe804264f5b880d4208ef51e8c5a8995eff10a5094de8".
+func ParseReceipt(receipt string) (Transaction, error) {
+	prefix, hash, ok := strings.Cut(strings.TrimSpace(receipt), ":")
+	if !ok {
+		return Transaction{}, Error.New("invalid receipt %q", receipt)
+	}
+
+	chain, ok := receiptPrefixes[prefix]
+	if !ok {
+		return Transaction{}, Error.New("unknown chain in receipt %q", receipt)
+	}
+
+	hash = strings.TrimPrefix(strings.TrimPrefix(hash, "0x"), "0X")
+	decoded, err := hex.DecodeString(hash)
+	if err != nil {
+		return Transaction{}, Error.Wrap(err)
+	}
+	if len(decoded) != HashLength {
+		return Transaction{}, Error.New("invalid hash length: %d instead of %d", len(decoded), HashLength)
+	}
+
+	tx := Transaction{
+		Chain:  chain,
+		Layer2: chain.IsLayer2(),
+	}
+	copy(tx.Hash[:], decoded)
+	return tx, nil
+}
+
+// Receipt returns the canonical payout receipt of the transaction.
+func (tx Transaction) Receipt() string {
+	prefix := string(tx.Chain)
+	if tx.Chain == ChainEthereum {
+		prefix = "eth"
+	}
+	return prefix + ":" + tx.Hash.Hex()
+}
+
+// ExplorerURL returns the link to the transaction in the block explorer of the
+// chain, or an empty string when the chain is unknown.
+func (tx Transaction) ExplorerURL() string {
+	switch tx.Chain {
+	case ChainEthereum:
+		return "https://etherscan.io/tx/" + tx.Hash.Hex()
+	case ChainZkSync:
+		return "https://zkscan.io/explorer/transactions/" + tx.Hash.Hex()
+	case ChainPolygon:
+		return "https://polygonscan.com/tx/" + tx.Hash.Hex()
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package blockchain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/private/blockchain"
+)
+
+func TestParseReceipt(t *testing.T) {
+	const hash = "0xdea1082dbea119c822dfe804264f5b880d4208ef51e8c5a8995eff10a5094de8"
+
+	for _, tt := range []struct {
+		receipt string
+		chain   blockchain.Chain
+		layer2  bool
+		url     string
+	}{
+		{"eth:" + hash, blockchain.ChainEthereum, false, "https://etherscan.io/tx/" + hash},
+		{"eth:" + hash[2:], blockchain.ChainEthereum, false, "https://etherscan.io/tx/" + hash},
+		{"zksync:" + hash, blockchain.ChainZkSync, true, "https://zkscan.io/explorer/transactions/" + hash},
+		{"zkwithdraw:" + hash, blockchain.ChainZkSync, true, "https://zkscan.io/explorer/transactions/" + hash},
+		{"polygon:" + hash, blockchain.ChainPolygon, true, "https://polygonscan.com/tx/" + hash},
+	} {
+		tx, err := blockchain.ParseReceipt(tt.receipt)
+		require.NoError(t, err, tt.receipt)
+		require.Equal(t, tt.chain, tx.Chain, tt.receipt)
+		require.Equal(t, hash, tx.Hash.Hex(), tt.receipt)
+		require.Equal(t, tt.layer2, tx.Layer2, tt.receipt)
+		require.Equal(t, tt.url, tx.ExplorerURL(), tt.receipt)
+
+		reparsed, err := blockchain.ParseReceipt(tx.Receipt())
+		require.NoError(t, err, tt.receipt)
+		require.Equal(t, tx, reparsed, tt.receipt)
+	}
+
+	for _, receipt := range []string{
+		"",
+		hash,
+		"bitcoin:" + hash,
+		"eth:0x1234",
+		"eth:not-a-hash",
+	} {
+		_, err := blockchain.ParseReceipt(receipt)
+		require.Error(t, err, receipt)
+	}
+}
@@ -0,0 +1,11 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package capabilitiespb contains protobuf definitions for the advertisement of the storage node capabilities.
+package capabilitiespb
+
+// SettlementExtensionKey is the key of the drpc metadata, which flags the settlement of the
+// orders expired during a downtime of the satellite with the token of the SettlementExtension.
+const SettlementExtensionKey = "settlement-extension"
+
+//go:generate go run gen.go
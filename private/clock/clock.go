@@ -0,0 +1,86 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package clock implements the source of the current time for the chores and
+// loops, which can be replaced by a simulated clock.
+package clock
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"storj.io/private/debug"
+)
+
+// Clock returns the current time.
+type Clock interface {
+	Now() time.Time
+}
+
+// Real is the clock of the system.
+type Real struct{}
+
+// Now returns the current time of the system.
+func (Real) Now() time.Time { return time.Now() }
+
+// Simulated is a clock, which is only advanced explicitly. It allows tests to
+// move the time forward deterministically, instead of sleeping or changing the
+// timestamps in the database.
+type Simulated struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewSimulated creates a simulated clock starting at now.
+func NewSimulated(now time.Time) *Simulated {
+	return &Simulated{now: now}
+}
+
+// Now returns the current time of the simulated clock.
+func (clock *Simulated) Now() time.Time {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	return clock.now
+}
+
+// Set sets the current time of the simulated clock.
+func (clock *Simulated) Set(now time.Time) {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	clock.now = now
+}
+
+// Advance moves the simulated clock forward by duration and returns the new time.
+func (clock *Simulated) Advance(duration time.Duration) time.Time {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	clock.now = clock.now.Add(duration)
+	return clock.now
+}
+
+// Buttons returns a button group of the debug control panel, which advances the
+// simulated clock.
+func Buttons(name string, clock *Simulated) *debug.ButtonGroup {
+	advance := func(label string, duration time.Duration) *debug.Button {
+		return &debug.Button{
+			Name: label,
+			Call: func(w io.Writer) error {
+				now := clock.Advance(duration)
+				_, _ = fmt.Fprintln(w, "Current time", now.Format(time.RFC3339))
+				return nil
+			},
+		}
+	}
+
+	return &debug.ButtonGroup{
+		Name: name,
+		Buttons: []*debug.Button{
+			advance("Advance 1 hour", time.Hour),
+			advance("Advance 1 day", 24*time.Hour),
+			advance("Advance 7 days", 7*24*time.Hour),
+			advance("Advance 30 days", 30*24*time.Hour),
+		},
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package clock_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/private/clock"
+)
+
+func TestSimulated(t *testing.T) {
+	start := time.Date(2023, 1, 31, 12, 0, 0, 0, time.UTC)
+	simulated := clock.NewSimulated(start)
+	require.Equal(t, start, simulated.Now())
+	require.Equal(t, start, simulated.Now(), "the simulated time must not pass by itself")
+
+	require.Equal(t, start.Add(time.Hour), simulated.Advance(time.Hour))
+	require.Equal(t, start.Add(time.Hour), simulated.Now())
+
+	simulated.Set(start)
+	require.Equal(t, start, simulated.Now())
+
+	buttons := clock.Buttons("Simulated Clock", simulated)
+	require.NotEmpty(t, buttons.Buttons)
+
+	var progress bytes.Buffer
+	require.NoError(t, buttons.Buttons[1].Call(&progress))
+	require.Equal(t, start.Add(24*time.Hour), simulated.Now())
+	require.Contains(t, progress.String(), "2023-02-01T12:00:00Z")
+}
+
+func TestReal(t *testing.T) {
+	before := time.Now()
+	now := clock.Real{}.Now()
+	require.False(t, now.Before(before))
+}
@@ -0,0 +1,7 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package containmentpb contains protobuf definitions for querying the audit containment of a storage node.
+package containmentpb
+
+//go:generate go run gen.go
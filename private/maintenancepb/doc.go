@@ -0,0 +1,7 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package maintenancepb contains protobuf definitions for the maintenance windows of the storage nodes.
+package maintenancepb
+
+//go:generate go run gen.go
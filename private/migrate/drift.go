@@ -0,0 +1,190 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package migrate
+
+import (
+	"sort"
+	"strings"
+
+	"storj.io/private/dbutil/dbschema"
+)
+
+// DriftKind is the kind of a difference between the expected and the actual schema.
+type DriftKind string
+
+const (
+	// DriftMissingTable is a table, which is expected, but doesn't exist.
+	DriftMissingTable = DriftKind("missing_table")
+	// DriftUnexpectedTable is a table, which exists, but isn't expected.
+	DriftUnexpectedTable = DriftKind("unexpected_table")
+	// DriftMissingColumn is a column, which is expected, but doesn't exist.
+	DriftMissingColumn = DriftKind("missing_column")
+	// DriftUnexpectedColumn is a column, which exists, but isn't expected.
+	DriftUnexpectedColumn = DriftKind("unexpected_column")
+	// DriftChangedColumn is a column, which differs from the expected one.
+	DriftChangedColumn = DriftKind("changed_column")
+	// DriftChangedTable is a table, which primary key, unique or check constraints differ
+	// from the expected ones.
+	DriftChangedTable = DriftKind("changed_table")
+	// DriftMissingIndex is an index, which is expected, but doesn't exist.
+	DriftMissingIndex = DriftKind("missing_index")
+	// DriftUnexpectedIndex is an index, which exists, but isn't expected.
+	DriftUnexpectedIndex = DriftKind("unexpected_index")
+	// DriftChangedIndex is an index, which differs from the expected one.
+	DriftChangedIndex = DriftKind("changed_index")
+	// DriftMissingSequence is a sequence, which is expected, but doesn't exist.
+	DriftMissingSequence = DriftKind("missing_sequence")
+	// DriftUnexpectedSequence is a sequence, which exists, but isn't expected.
+	DriftUnexpectedSequence = DriftKind("unexpected_sequence")
+)
+
+// SchemaDifference is a single difference between the expected and the actual schema.
+type SchemaDifference struct {
+	Kind DriftKind `json:"kind"`
+	// Table is the table of the difference, it's empty for sequences.
+	Table string `json:"table,omitempty"`
+	// Name is the name of the column, the index or the sequence.
+	Name     string `json:"name,omitempty"`
+	Expected string `json:"expected,omitempty"`
+	Actual   string `json:"actual,omitempty"`
+}
+
+// DiffSchemas returns the differences between the expected and the actual schema.
+func DiffSchemas(expected, actual *dbschema.Schema) []SchemaDifference {
+	var diffs []SchemaDifference
+
+	expectedTables := make(map[string]*dbschema.Table)
+	for _, table := range expected.Tables {
+		expectedTables[table.Name] = table
+	}
+	actualTables := make(map[string]*dbschema.Table)
+	for _, table := range actual.Tables {
+		actualTables[table.Name] = table
+	}
+
+	for _, name := range sortedKeys(expectedTables, actualTables) {
+		expectedTable, isExpected := expectedTables[name]
+		actualTable, isActual := actualTables[name]
+		switch {
+		case !isActual:
+			diffs = append(diffs, SchemaDifference{Kind: DriftMissingTable, Table: name, Expected: expectedTable.String()})
+		case !isExpected:
+			diffs = append(diffs, SchemaDifference{Kind: DriftUnexpectedTable, Table: name, Actual: actualTable.String()})
+		default:
+			diffs = append(diffs, diffTables(expectedTable, actualTable)...)
+		}
+	}
+
+	expectedIndexes := make(map[string]*dbschema.Index)
+	for _, index := range expected.Indexes {
+		expectedIndexes[index.Name] = index
+	}
+	actualIndexes := make(map[string]*dbschema.Index)
+	for _, index := range actual.Indexes {
+		actualIndexes[index.Name] = index
+	}
+
+	for _, name := range sortedKeys(expectedIndexes, actualIndexes) {
+		expectedIndex, isExpected := expectedIndexes[name]
+		actualIndex, isActual := actualIndexes[name]
+		switch {
+		case !isActual:
+			diffs = append(diffs, SchemaDifference{Kind: DriftMissingIndex, Table: expectedIndex.Table, Name: name, Expected: expectedIndex.String()})
+		case !isExpected:
+			diffs = append(diffs, SchemaDifference{Kind: DriftUnexpectedIndex, Table: actualIndex.Table, Name: name, Actual: actualIndex.String()})
+		case expectedIndex.String() != actualIndex.String():
+			diffs = append(diffs, SchemaDifference{Kind: DriftChangedIndex, Table: actualIndex.Table, Name: name, Expected: expectedIndex.String(), Actual: actualIndex.String()})
+		}
+	}
+
+	expectedSequences := make(map[string]bool)
+	for _, sequence := range expected.Sequences {
+		expectedSequences[sequence] = true
+	}
+	actualSequences := make(map[string]bool)
+	for _, sequence := range actual.Sequences {
+		actualSequences[sequence] = true
+	}
+
+	for _, name := range sortedKeys(expectedSequences, actualSequences) {
+		switch {
+		case !actualSequences[name]:
+			diffs = append(diffs, SchemaDifference{Kind: DriftMissingSequence, Name: name})
+		case !expectedSequences[name]:
+			diffs = append(diffs, SchemaDifference{Kind: DriftUnexpectedSequence, Name: name})
+		}
+	}
+
+	return diffs
+}
+
+// diffTables returns the differences between the columns and the constraints of the tables.
+func diffTables(expected, actual *dbschema.Table) []SchemaDifference {
+	var diffs []SchemaDifference
+
+	expectedColumns := make(map[string]*dbschema.Column)
+	for _, column := range expected.Columns {
+		expectedColumns[column.Name] = column
+	}
+	actualColumns := make(map[string]*dbschema.Column)
+	for _, column := range actual.Columns {
+		actualColumns[column.Name] = column
+	}
+
+	for _, name := range sortedKeys(expectedColumns, actualColumns) {
+		expectedColumn, isExpected := expectedColumns[name]
+		actualColumn, isActual := actualColumns[name]
+		switch {
+		case !isActual:
+			diffs = append(diffs, SchemaDifference{Kind: DriftMissingColumn, Table: expected.Name, Name: name, Expected: expectedColumn.String()})
+		case !isExpected:
+			diffs = append(diffs, SchemaDifference{Kind: DriftUnexpectedColumn, Table: actual.Name, Name: name, Actual: actualColumn.String()})
+		case expectedColumn.String() != actualColumn.String():
+			diffs = append(diffs, SchemaDifference{Kind: DriftChangedColumn, Table: actual.Name, Name: name, Expected: expectedColumn.String(), Actual: actualColumn.String()})
+		}
+	}
+
+	if constraints(expected) != constraints(actual) {
+		diffs = append(diffs, SchemaDifference{Kind: DriftChangedTable, Table: actual.Name, Expected: constraints(expected), Actual: constraints(actual)})
+	}
+
+	return diffs
+}
+
+// constraints returns the description of the table without the columns.
+func constraints(table *dbschema.Table) string {
+	withoutColumns := *table
+	withoutColumns.Columns = nil
+	return withoutColumns.String()
+}
+
+// sortedKeys returns the sorted union of the keys of the maps.
+func sortedKeys[T any](a, b map[string]T) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// String returns a single line description of the difference.
+func (diff SchemaDifference) String() string {
+	var b strings.Builder
+	b.WriteString(string(diff.Kind))
+	if diff.Table != "" {
+		b.WriteString(" ")
+		b.WriteString(diff.Table)
+	}
+	if diff.Name != "" {
+		b.WriteString(" ")
+		b.WriteString(diff.Name)
+	}
+	return b.String()
+}
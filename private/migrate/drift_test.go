@@ -0,0 +1,69 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package migrate_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/private/dbutil/dbschema"
+	"storj.io/storj/private/migrate"
+)
+
+func TestDiffSchemas(t *testing.T) {
+	schema := func(nameType string) *dbschema.Schema {
+		return &dbschema.Schema{
+			Tables: []*dbschema.Table{
+				{
+					Name: "users",
+					Columns: []*dbschema.Column{
+						{Name: "id", Type: "bytea"},
+						{Name: "name", Type: nameType},
+					},
+					PrimaryKey: []string{"id"},
+				},
+				{
+					Name: "projects",
+					Columns: []*dbschema.Column{
+						{Name: "id", Type: "bytea"},
+					},
+					PrimaryKey: []string{"id"},
+				},
+			},
+			Indexes: []*dbschema.Index{
+				{Name: "users_name_index", Table: "users", Columns: []string{"name"}},
+			},
+		}
+	}
+
+	require.Empty(t, migrate.DiffSchemas(schema("text"), schema("text")))
+
+	expected, actual := schema("text"), schema("text")
+	actual.Tables[0].Columns[1].Type = "bytea"
+	actual.Tables[0].Columns = append(actual.Tables[0].Columns, &dbschema.Column{Name: "email", Type: "text"})
+	actual.Tables[0].PrimaryKey = []string{"id", "name"}
+	actual.Tables = append(actual.Tables[:1], &dbschema.Table{Name: "accounts"})
+	actual.Indexes = nil
+	actual.Sequences = []string{"node_id_seq"}
+
+	diffs := migrate.DiffSchemas(expected, actual)
+
+	var kinds []string
+	for _, diff := range diffs {
+		kinds = append(kinds, diff.String())
+	}
+	require.Equal(t, []string{
+		"unexpected_table accounts",
+		"missing_table projects",
+		"unexpected_column users email",
+		"changed_column users name",
+		"changed_table users",
+		"missing_index users users_name_index",
+		"unexpected_sequence node_id_seq",
+	}, kinds)
+
+	require.Contains(t, diffs[3].Expected, "Type: text")
+	require.Contains(t, diffs[3].Actual, "Type: bytea")
+}
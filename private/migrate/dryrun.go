@@ -0,0 +1,95 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package migrate
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/private/dbutil/txutil"
+	"storj.io/private/tagsql"
+)
+
+// PendingSteps returns the steps, which Run would execute, without changing the database.
+//
+// Steps, which create their database, are always pending when the database doesn't exist yet.
+func (migration *Migration) PendingSteps(ctx context.Context) (_ []*Step, err error) {
+	if err := migration.ValidateSteps(); err != nil {
+		return nil, err
+	}
+
+	versions := make(map[tagsql.DB]int)
+
+	var pending []*Step
+	for _, step := range migration.Steps {
+		db := *step.DB
+		if db == nil {
+			if step.CreateDB == nil {
+				return nil, Error.New("step.DB is nil for step %d", step.Version)
+			}
+			pending = append(pending, step)
+			continue
+		}
+
+		version, ok := versions[db]
+		if !ok {
+			version, err = migration.peekLatestVersion(ctx, db)
+			if err != nil {
+				return nil, err
+			}
+			versions[db] = version
+		}
+
+		if step.Version > version {
+			pending = append(pending, step)
+		}
+	}
+
+	return pending, nil
+}
+
+// peekLatestVersion finds the latest version in migration.Table like CurrentVersion,
+// but it rolls back the creation of the version table.
+func (migration *Migration) peekLatestVersion(ctx context.Context, db tagsql.DB) (version int, err error) {
+	if err := migration.ValidTableName(); err != nil {
+		return -1, Error.Wrap(err)
+	}
+
+	rollback := errs.Class("only used to tell WithTx to do a rollback")
+
+	err = txutil.WithTx(ctx, db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		_, err := tx.Exec(ctx, rebind(db, `CREATE TABLE IF NOT EXISTS `+migration.Table+` (version int, commited_at text)`)) //nolint:misspell
+		if err != nil {
+			return err
+		}
+
+		var latest *int64
+		/* #nosec G202 */ // Table name is white listed by the ValidTableName method
+		// executed at the beginning of the function
+		err = tx.QueryRow(ctx, rebind(db, `SELECT MAX(version) FROM `+migration.Table)).Scan(&latest)
+		if err != nil {
+			return err
+		}
+
+		version = -1
+		if latest != nil {
+			version = int(*latest)
+		}
+		return rollback.New("")
+	})
+	if rollback.Has(err) {
+		err = nil
+	}
+	return version, Error.Wrap(err)
+}
+
+// Statements returns the SQL statements of the step. The statements of actions, which
+// aren't SQL, e.g. Func, can't be known and ok is false.
+func (step *Step) Statements() (statements []string, ok bool) {
+	if sql, ok := step.Action.(SQL); ok {
+		return sql, true
+	}
+	return nil, false
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package migrate_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/private/tagsql"
+	"storj.io/storj/private/migrate"
+)
+
+func TestPendingSteps(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db, err := tagsql.Open(ctx, "sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, db.Close()) }()
+
+	m := migrate.Migration{
+		Table: "versions",
+		Steps: []*migrate.Step{
+			{
+				DB:          &db,
+				Description: "create users",
+				Version:     1,
+				Action:      migrate.SQL{`CREATE TABLE users (id int)`},
+			},
+			{
+				DB:          &db,
+				Description: "add names",
+				Version:     2,
+				Action:      migrate.SQL{`ALTER TABLE users ADD COLUMN name text`},
+			},
+			{
+				DB:          &db,
+				Description: "custom",
+				Version:     3,
+				Action:      migrate.Func(func(ctx context.Context, log *zap.Logger, db tagsql.DB, tx tagsql.Tx) error { return nil }),
+			},
+		},
+	}
+
+	pending, err := m.PendingSteps(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 3)
+
+	// the version table isn't created by a dry run.
+	var count int
+	err = db.QueryRowContext(ctx, `SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'versions'`).Scan(&count)
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	require.NoError(t, m.TargetVersion(1).Run(ctx, zaptest.NewLogger(t)))
+
+	pending, err = m.PendingSteps(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	require.Equal(t, 2, pending[0].Version)
+	require.Equal(t, 3, pending[1].Version)
+
+	statements, ok := pending[0].Statements()
+	require.True(t, ok)
+	require.Equal(t, []string{`ALTER TABLE users ADD COLUMN name text`}, statements)
+
+	_, ok = pending[1].Statements()
+	require.False(t, ok)
+
+	require.NoError(t, m.Run(ctx, zaptest.NewLogger(t)))
+
+	pending, err = m.PendingSteps(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
@@ -4113,6 +4113,290 @@ func (m *SatellitePeriodPaystubResponse) GetPaystub() *Paystub {
 	return nil
 }
 
+type BandwidthLimits struct {
+	Ingress              int64    `protobuf:"varint,1,opt,name=ingress,proto3" json:"ingress,omitempty"`
+	Egress               int64    `protobuf:"varint,2,opt,name=egress,proto3" json:"egress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BandwidthLimits) Reset()         { *m = BandwidthLimits{} }
+func (m *BandwidthLimits) String() string { return proto.CompactTextString(m) }
+func (*BandwidthLimits) ProtoMessage()    {}
+func (*BandwidthLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{89}
+}
+func (m *BandwidthLimits) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BandwidthLimits.Unmarshal(m, b)
+}
+func (m *BandwidthLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BandwidthLimits.Marshal(b, m, deterministic)
+}
+func (m *BandwidthLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BandwidthLimits.Merge(m, src)
+}
+func (m *BandwidthLimits) XXX_Size() int {
+	return xxx_messageInfo_BandwidthLimits.Size(m)
+}
+func (m *BandwidthLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_BandwidthLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BandwidthLimits proto.InternalMessageInfo
+
+func (m *BandwidthLimits) GetIngress() int64 {
+	if m != nil {
+		return m.Ingress
+	}
+	return 0
+}
+
+func (m *BandwidthLimits) GetEgress() int64 {
+	if m != nil {
+		return m.Egress
+	}
+	return 0
+}
+
+type NodeSettings struct {
+	AllocatedDiskSpace   int64            `protobuf:"varint,1,opt,name=allocated_disk_space,json=allocatedDiskSpace,proto3" json:"allocated_disk_space,omitempty"`
+	Wallet               string           `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	BandwidthLimits      *BandwidthLimits `protobuf:"bytes,3,opt,name=bandwidth_limits,json=bandwidthLimits,proto3" json:"bandwidth_limits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *NodeSettings) Reset()         { *m = NodeSettings{} }
+func (m *NodeSettings) String() string { return proto.CompactTextString(m) }
+func (*NodeSettings) ProtoMessage()    {}
+func (*NodeSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{90}
+}
+func (m *NodeSettings) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeSettings.Unmarshal(m, b)
+}
+func (m *NodeSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeSettings.Marshal(b, m, deterministic)
+}
+func (m *NodeSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeSettings.Merge(m, src)
+}
+func (m *NodeSettings) XXX_Size() int {
+	return xxx_messageInfo_NodeSettings.Size(m)
+}
+func (m *NodeSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeSettings proto.InternalMessageInfo
+
+func (m *NodeSettings) GetAllocatedDiskSpace() int64 {
+	if m != nil {
+		return m.AllocatedDiskSpace
+	}
+	return 0
+}
+
+func (m *NodeSettings) GetWallet() string {
+	if m != nil {
+		return m.Wallet
+	}
+	return ""
+}
+
+func (m *NodeSettings) GetBandwidthLimits() *BandwidthLimits {
+	if m != nil {
+		return m.BandwidthLimits
+	}
+	return nil
+}
+
+type SettingsRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SettingsRequest) Reset()         { *m = SettingsRequest{} }
+func (m *SettingsRequest) String() string { return proto.CompactTextString(m) }
+func (*SettingsRequest) ProtoMessage()    {}
+func (*SettingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{91}
+}
+func (m *SettingsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SettingsRequest.Unmarshal(m, b)
+}
+func (m *SettingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SettingsRequest.Marshal(b, m, deterministic)
+}
+func (m *SettingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettingsRequest.Merge(m, src)
+}
+func (m *SettingsRequest) XXX_Size() int {
+	return xxx_messageInfo_SettingsRequest.Size(m)
+}
+func (m *SettingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettingsRequest proto.InternalMessageInfo
+
+func (m *SettingsRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type SettingsResponse struct {
+	Settings             *NodeSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	RestartRequired      bool          `protobuf:"varint,2,opt,name=restart_required,json=restartRequired,proto3" json:"restart_required,omitempty"`
+	UpdatesAllowed       bool          `protobuf:"varint,3,opt,name=updates_allowed,json=updatesAllowed,proto3" json:"updates_allowed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SettingsResponse) Reset()         { *m = SettingsResponse{} }
+func (m *SettingsResponse) String() string { return proto.CompactTextString(m) }
+func (*SettingsResponse) ProtoMessage()    {}
+func (*SettingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{92}
+}
+func (m *SettingsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SettingsResponse.Unmarshal(m, b)
+}
+func (m *SettingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SettingsResponse.Marshal(b, m, deterministic)
+}
+func (m *SettingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettingsResponse.Merge(m, src)
+}
+func (m *SettingsResponse) XXX_Size() int {
+	return xxx_messageInfo_SettingsResponse.Size(m)
+}
+func (m *SettingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettingsResponse proto.InternalMessageInfo
+
+func (m *SettingsResponse) GetSettings() *NodeSettings {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+func (m *SettingsResponse) GetRestartRequired() bool {
+	if m != nil {
+		return m.RestartRequired
+	}
+	return false
+}
+
+func (m *SettingsResponse) GetUpdatesAllowed() bool {
+	if m != nil {
+		return m.UpdatesAllowed
+	}
+	return false
+}
+
+type UpdateSettingsRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Settings             *NodeSettings  `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *UpdateSettingsRequest) Reset()         { *m = UpdateSettingsRequest{} }
+func (m *UpdateSettingsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateSettingsRequest) ProtoMessage()    {}
+func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{93}
+}
+func (m *UpdateSettingsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateSettingsRequest.Unmarshal(m, b)
+}
+func (m *UpdateSettingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateSettingsRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateSettingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSettingsRequest.Merge(m, src)
+}
+func (m *UpdateSettingsRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateSettingsRequest.Size(m)
+}
+func (m *UpdateSettingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSettingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateSettingsRequest proto.InternalMessageInfo
+
+func (m *UpdateSettingsRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *UpdateSettingsRequest) GetSettings() *NodeSettings {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+type UpdateSettingsResponse struct {
+	Settings             *NodeSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	RestartRequired      bool          `protobuf:"varint,2,opt,name=restart_required,json=restartRequired,proto3" json:"restart_required,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *UpdateSettingsResponse) Reset()         { *m = UpdateSettingsResponse{} }
+func (m *UpdateSettingsResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateSettingsResponse) ProtoMessage()    {}
+func (*UpdateSettingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{94}
+}
+func (m *UpdateSettingsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateSettingsResponse.Unmarshal(m, b)
+}
+func (m *UpdateSettingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateSettingsResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateSettingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSettingsResponse.Merge(m, src)
+}
+func (m *UpdateSettingsResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateSettingsResponse.Size(m)
+}
+func (m *UpdateSettingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSettingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateSettingsResponse proto.InternalMessageInfo
+
+func (m *UpdateSettingsResponse) GetSettings() *NodeSettings {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+func (m *UpdateSettingsResponse) GetRestartRequired() bool {
+	if m != nil {
+		return m.RestartRequired
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*RequestHeader)(nil), "multinode.RequestHeader")
 	proto.RegisterType((*DiskSpaceRequest)(nil), "multinode.DiskSpaceRequest")
@@ -4208,191 +4492,209 @@ func init() {
 	proto.RegisterType((*PeriodPaystubResponse)(nil), "multinode.PeriodPaystubResponse")
 	proto.RegisterType((*SatellitePeriodPaystubRequest)(nil), "multinode.SatellitePeriodPaystubRequest")
 	proto.RegisterType((*SatellitePeriodPaystubResponse)(nil), "multinode.SatellitePeriodPaystubResponse")
+	proto.RegisterType((*BandwidthLimits)(nil), "multinode.BandwidthLimits")
+	proto.RegisterType((*NodeSettings)(nil), "multinode.NodeSettings")
+	proto.RegisterType((*SettingsRequest)(nil), "multinode.SettingsRequest")
+	proto.RegisterType((*SettingsResponse)(nil), "multinode.SettingsResponse")
+	proto.RegisterType((*UpdateSettingsRequest)(nil), "multinode.UpdateSettingsRequest")
+	proto.RegisterType((*UpdateSettingsResponse)(nil), "multinode.UpdateSettingsResponse")
 }
 
 func init() { proto.RegisterFile("multinode.proto", fileDescriptor_9a45fd79b06f3a1b) }
 
 var fileDescriptor_9a45fd79b06f3a1b = []byte{
-	// 2886 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcb, 0x6f, 0x1c, 0xc7,
-	0xd1, 0xff, 0x86, 0x8f, 0x5d, 0x6e, 0xed, 0xf2, 0xd5, 0xe2, 0x63, 0x39, 0xe2, 0x4b, 0x43, 0x7d,
-	0x12, 0x15, 0x4b, 0x94, 0x4d, 0x1b, 0x4e, 0xec, 0xd8, 0x88, 0x97, 0x92, 0x6c, 0xd2, 0x96, 0x2c,
-	0x66, 0x28, 0x39, 0x86, 0x15, 0x78, 0x3d, 0xe4, 0x34, 0xc9, 0xb1, 0x67, 0x67, 0xd6, 0x33, 0xbd,
-	0x64, 0x08, 0x04, 0x46, 0x0e, 0x89, 0x73, 0x0a, 0x90, 0xb3, 0x11, 0xe4, 0x9a, 0x5b, 0x0e, 0xb9,
-	0xe4, 0x98, 0x5b, 0x60, 0x20, 0xff, 0x41, 0x0e, 0x0e, 0x90, 0x5b, 0x2e, 0xb9, 0xe4, 0x96, 0x53,
-	0xd0, 0x8f, 0x79, 0xf4, 0xbc, 0x96, 0x9c, 0x95, 0x41, 0xdf, 0xa6, 0xab, 0xab, 0x7e, 0x5d, 0x55,
-	0xdd, 0x5d, 0xd3, 0x55, 0xdd, 0x30, 0xd9, 0xe9, 0xd9, 0xc4, 0x72, 0x5c, 0x13, 0x6f, 0x74, 0x3d,
-	0x97, 0xb8, 0xa8, 0x16, 0x12, 0x54, 0x38, 0x72, 0x8f, 0x5c, 0x4e, 0x56, 0x57, 0x8e, 0x5c, 0xf7,
-	0xc8, 0xc6, 0x77, 0x59, 0x6b, 0xbf, 0x77, 0x78, 0x97, 0x58, 0x1d, 0xec, 0x13, 0xa3, 0xd3, 0xe5,
-	0x0c, 0xda, 0x3a, 0x8c, 0xeb, 0xf8, 0xf3, 0x1e, 0xf6, 0xc9, 0x36, 0x36, 0x4c, 0xec, 0xa1, 0x79,
-	0xa8, 0x1a, 0x5d, 0xab, 0xfd, 0x19, 0x3e, 0x6b, 0x2a, 0xab, 0xca, 0x7a, 0x43, 0xaf, 0x18, 0x5d,
-	0xeb, 0x3d, 0x7c, 0xa6, 0xdd, 0x87, 0xa9, 0xfb, 0x96, 0xff, 0xd9, 0x5e, 0xd7, 0x38, 0xc0, 0x42,
-	0x04, 0xbd, 0x08, 0x95, 0x63, 0x26, 0xc6, 0x78, 0xeb, 0x9b, 0xcd, 0x8d, 0x48, 0x2f, 0x09, 0x56,
-	0x17, 0x7c, 0xda, 0x5f, 0x14, 0x98, 0x8e, 0xc1, 0xf8, 0x5d, 0xd7, 0xf1, 0x31, 0x5a, 0x84, 0x9a,
-	0x61, 0xdb, 0xee, 0x81, 0x41, 0xb0, 0xc9, 0xa0, 0x86, 0xf5, 0x88, 0x80, 0x56, 0xa0, 0xde, 0xf3,
-	0xb1, 0xd9, 0xee, 0x5a, 0xf8, 0x00, 0xfb, 0xcd, 0x21, 0xd6, 0x0f, 0x94, 0xb4, 0xcb, 0x28, 0x68,
-	0x09, 0x58, 0xab, 0x4d, 0x3c, 0xc3, 0x3f, 0x6e, 0x0e, 0x73, 0x79, 0x4a, 0x79, 0x42, 0x09, 0x08,
-	0xc1, 0xc8, 0xa1, 0x87, 0x71, 0x73, 0x84, 0x75, 0xb0, 0x6f, 0x36, 0xe2, 0x89, 0x61, 0xd9, 0xc6,
-	0xbe, 0x8d, 0x9b, 0xa3, 0x62, 0xc4, 0x80, 0x80, 0x54, 0x18, 0x73, 0x4f, 0xb0, 0x47, 0x21, 0x9a,
-	0x15, 0xd6, 0x19, 0xb6, 0xb5, 0x3f, 0x2a, 0xd0, 0xd8, 0x23, 0xae, 0x67, 0x1c, 0xe1, 0xa7, 0xbe,
-	0x71, 0x84, 0x91, 0x06, 0xe3, 0x06, 0x69, 0x7b, 0xd8, 0x27, 0x6d, 0xe2, 0x12, 0xc3, 0x66, 0x06,
-	0x28, 0x7a, 0xdd, 0x20, 0x3a, 0xf6, 0xc9, 0x13, 0x4a, 0x42, 0xef, 0xc1, 0x84, 0xe5, 0x10, 0xec,
-	0x9d, 0x18, 0x76, 0xdb, 0x27, 0x86, 0x47, 0x98, 0x15, 0xf5, 0x4d, 0x75, 0x83, 0x4f, 0xd0, 0x46,
-	0x30, 0x41, 0x1b, 0x4f, 0x82, 0x09, 0xda, 0x1a, 0xfb, 0xfa, 0x9b, 0x95, 0xff, 0xfb, 0xed, 0x3f,
-	0x56, 0x14, 0x7d, 0x3c, 0x90, 0xdd, 0xa3, 0xa2, 0xe8, 0x0e, 0x5c, 0x91, 0x06, 0x6c, 0xef, 0x9f,
-	0x11, 0xec, 0x33, 0xbb, 0x15, 0x7d, 0x2a, 0x36, 0xec, 0x16, 0xa5, 0x6b, 0x7f, 0x56, 0xe0, 0x4a,
-	0x5c, 0xe1, 0xd2, 0x93, 0x87, 0x7e, 0x40, 0x1d, 0xe9, 0x76, 0x2e, 0xa4, 0x3b, 0x93, 0x40, 0xaf,
-	0xc0, 0x10, 0x71, 0x99, 0x86, 0xe7, 0x95, 0x1b, 0x22, 0xae, 0xf6, 0x7b, 0x05, 0x66, 0x64, 0xcd,
-	0xc5, 0x7a, 0x79, 0x03, 0xc6, 0x7d, 0x4e, 0x6f, 0xf7, 0x68, 0x47, 0x53, 0x59, 0x1d, 0x5e, 0xaf,
-	0x6f, 0xce, 0xc7, 0x2c, 0x90, 0xe4, 0x1a, 0x7e, 0x7c, 0xc2, 0x9a, 0x50, 0xf5, 0x7b, 0x9d, 0x8e,
-	0xe1, 0x9d, 0x31, 0x4b, 0x14, 0x3d, 0x68, 0xa2, 0x0d, 0xb8, 0x62, 0x9c, 0xe0, 0x08, 0x57, 0xf2,
-	0xec, 0xb4, 0xe8, 0x62, 0x20, 0xdc, 0xb5, 0xff, 0x51, 0x60, 0x31, 0x3e, 0xd0, 0x9e, 0x41, 0xb0,
-	0x6d, 0x5b, 0x64, 0x00, 0x1f, 0xbf, 0x04, 0x0d, 0x3f, 0x40, 0x69, 0x5b, 0x26, 0xd3, 0xb0, 0xb1,
-	0x35, 0x41, 0xfd, 0xf2, 0xf7, 0x6f, 0x56, 0x2a, 0xef, 0xbb, 0x26, 0xde, 0xb9, 0xaf, 0xd7, 0x43,
-	0x9e, 0x1d, 0x33, 0x9c, 0x96, 0xe1, 0x92, 0xd3, 0x32, 0x72, 0xc1, 0x69, 0xf9, 0x83, 0x02, 0x4b,
-	0x39, 0x56, 0x7f, 0xc7, 0xe6, 0x67, 0x17, 0x16, 0xb7, 0x0c, 0xc7, 0x3c, 0xb5, 0x4c, 0x72, 0xfc,
-	0xc8, 0x75, 0xc8, 0xf1, 0x1e, 0x07, 0x2a, 0x1f, 0xbf, 0x5e, 0x86, 0xa5, 0x1c, 0x44, 0x61, 0x3a,
-	0x82, 0x11, 0x16, 0x36, 0x78, 0x14, 0x63, 0xdf, 0xda, 0xaf, 0x15, 0x58, 0x0d, 0xa5, 0x84, 0xc0,
-	0xa5, 0x2c, 0x15, 0xed, 0x4d, 0xb8, 0x56, 0xa0, 0x88, 0x30, 0x21, 0xe6, 0x7f, 0x6e, 0x45, 0xd0,
-	0xd4, 0xde, 0x83, 0xf9, 0xa4, 0x78, 0x79, 0x57, 0xbe, 0x02, 0xcd, 0x34, 0x58, 0x5f, 0x15, 0x7e,
-	0xa9, 0xc0, 0xd2, 0x83, 0x23, 0x0f, 0xfb, 0xfe, 0xa5, 0x3a, 0xf2, 0x75, 0x58, 0xce, 0xd3, 0xa2,
-	0xaf, 0x09, 0xdb, 0x30, 0x23, 0xc9, 0x96, 0x77, 0xe1, 0x4b, 0x30, 0x9b, 0x40, 0xea, 0x3b, 0xf8,
-	0xaf, 0x14, 0x58, 0xde, 0x71, 0x2e, 0xdf, 0x81, 0x3f, 0x84, 0x95, 0x5c, 0x35, 0xfa, 0x1a, 0xb1,
-	0x03, 0xb3, 0xb2, 0x70, 0x79, 0x17, 0x6e, 0xc2, 0x5c, 0x12, 0xaa, 0xef, 0xf0, 0x3f, 0x87, 0xd9,
-	0xfb, 0x86, 0x65, 0x5f, 0x92, 0xe7, 0xf6, 0x60, 0x2e, 0x39, 0xba, 0xd0, 0xf8, 0x35, 0x68, 0xf0,
-	0xb0, 0xe8, 0xb9, 0xb6, 0xdd, 0xeb, 0x8a, 0xa8, 0x3b, 0x17, 0x53, 0x82, 0x87, 0x5b, 0xd6, 0xab,
-	0xd7, 0x7b, 0x51, 0x43, 0x7b, 0x0b, 0x1a, 0x0c, 0xb4, 0xbc, 0x23, 0xdf, 0x85, 0x71, 0x81, 0x30,
-	0xb8, 0x36, 0x7f, 0x53, 0xa0, 0x1e, 0xeb, 0x44, 0xb7, 0xa0, 0x82, 0xd9, 0x1c, 0x09, 0x6d, 0xa6,
-	0x63, 0x20, 0x7c, 0x03, 0xe8, 0x82, 0x01, 0xdd, 0x86, 0xaa, 0xc5, 0xe7, 0x53, 0x1c, 0x53, 0x50,
-	0x8c, 0x57, 0xcc, 0xb4, 0x1e, 0xb0, 0xa0, 0x39, 0xa8, 0x98, 0xd8, 0xc6, 0x04, 0x8b, 0x53, 0xa3,
-	0x68, 0x65, 0x9c, 0xd7, 0x46, 0x4a, 0x9f, 0xd7, 0xb4, 0x87, 0x50, 0x79, 0x10, 0x0e, 0xe7, 0xe1,
-	0xae, 0x61, 0x79, 0x62, 0x45, 0x89, 0x16, 0x9a, 0x81, 0x51, 0xa3, 0x67, 0x5a, 0x44, 0x9c, 0x6d,
-	0x79, 0x83, 0x52, 0xf9, 0xdf, 0x93, 0xeb, 0xc6, 0x1b, 0xda, 0xf7, 0xa1, 0xba, 0xe3, 0xc8, 0x70,
-	0xa6, 0x04, 0x67, 0x46, 0x82, 0x43, 0x71, 0xc1, 0x2d, 0x98, 0xf8, 0x00, 0x7b, 0xbe, 0xe5, 0x3a,
-	0xe5, 0x27, 0xf9, 0x05, 0x98, 0x0c, 0x31, 0xa2, 0x6d, 0x72, 0xc2, 0x49, 0x0c, 0xa5, 0xa6, 0x07,
-	0x4d, 0xed, 0x6d, 0x40, 0x0f, 0x0d, 0x9f, 0xdc, 0x73, 0x1d, 0x62, 0x1c, 0x90, 0xf2, 0x83, 0x7e,
-	0x0c, 0x57, 0x24, 0x1c, 0x31, 0xf0, 0x3b, 0xd0, 0xb0, 0x0d, 0x9f, 0xb4, 0x0f, 0x38, 0x5d, 0xc0,
-	0x9d, 0x6f, 0x86, 0xea, 0x76, 0x04, 0xa8, 0xfd, 0x0c, 0xa6, 0x75, 0xdc, 0xed, 0x11, 0x83, 0x0c,
-	0xe2, 0x9b, 0x32, 0x5b, 0xf9, 0x2b, 0x05, 0xea, 0x2d, 0x3a, 0xd7, 0x3f, 0xb1, 0x1c, 0xd3, 0x3d,
-	0xa5, 0x26, 0x9d, 0xb2, 0x2f, 0xb1, 0xe8, 0x2e, 0x64, 0x12, 0x97, 0xe4, 0x29, 0xc2, 0x35, 0x68,
-	0xb8, 0x8e, 0x6d, 0x39, 0xb8, 0x7d, 0xe0, 0xf6, 0x1c, 0xbe, 0xae, 0x46, 0xf5, 0x3a, 0xa7, 0xdd,
-	0xa3, 0x24, 0x9a, 0x55, 0xf1, 0xec, 0x81, 0x73, 0x0c, 0x33, 0x0e, 0x60, 0x24, 0xc6, 0xa0, 0xfd,
-	0xb7, 0x0a, 0x28, 0xee, 0x97, 0xf0, 0x6c, 0x57, 0xe1, 0x30, 0x42, 0xbb, 0xeb, 0x92, 0x63, 0x92,
-	0xec, 0x1b, 0x8f, 0x19, 0xaf, 0x2e, 0x64, 0xd0, 0x6b, 0xf1, 0x95, 0x5e, 0xdf, 0x5c, 0x2b, 0x16,
-	0x66, 0xbe, 0x09, 0xb6, 0xc3, 0x23, 0x98, 0x34, 0x2d, 0xff, 0xf3, 0x9e, 0x61, 0x5b, 0x87, 0x16,
-	0x36, 0xdb, 0x06, 0x39, 0xe7, 0x89, 0x57, 0x61, 0xfe, 0x99, 0x88, 0x0b, 0xb7, 0x08, 0xf5, 0xb5,
-	0xdf, 0xf3, 0xbb, 0xd8, 0x31, 0x39, 0xd6, 0xc8, 0x05, 0xb0, 0xea, 0xa1, 0x64, 0x8b, 0xa0, 0x0f,
-	0x60, 0xc6, 0x3d, 0x3c, 0x64, 0xce, 0x96, 0x00, 0x47, 0x2f, 0x00, 0x88, 0x04, 0xc2, 0x5e, 0x0c,
-	0xf7, 0x19, 0xcc, 0x07, 0xb8, 0x3d, 0xc7, 0xc4, 0x5e, 0xdb, 0xc3, 0x27, 0x16, 0x3e, 0xa5, 0xd0,
-	0x95, 0x0b, 0x40, 0x07, 0xca, 0x3d, 0xa5, 0x18, 0x3a, 0x83, 0x68, 0x11, 0xd4, 0x82, 0xda, 0x09,
-	0x26, 0x84, 0x6b, 0x5a, 0xbb, 0x00, 0xdc, 0x18, 0x17, 0x6b, 0x11, 0x74, 0x0f, 0xa0, 0xd7, 0x35,
-	0x0d, 0x81, 0x51, 0xbd, 0xc0, 0x52, 0xad, 0x09, 0x39, 0xae, 0xc7, 0xa7, 0xae, 0xe5, 0x70, 0x8c,
-	0xb1, 0x0b, 0x60, 0x8c, 0x71, 0xb1, 0x16, 0x51, 0x97, 0xa1, 0xc2, 0x17, 0x19, 0x8d, 0x7b, 0xfe,
-	0x81, 0xeb, 0x61, 0x91, 0x81, 0xf3, 0x86, 0xfa, 0xa7, 0x21, 0x18, 0x6d, 0x05, 0x01, 0x35, 0xdd,
-	0x8f, 0x6e, 0xc1, 0x14, 0x9f, 0x37, 0x1a, 0xb4, 0xda, 0x9c, 0x81, 0xe7, 0x1d, 0x93, 0x11, 0x7d,
-	0x8f, 0xb1, 0x66, 0xec, 0x99, 0xe1, 0xf8, 0x9e, 0x41, 0x6b, 0x30, 0xee, 0xf7, 0x0e, 0x0e, 0xb0,
-	0xef, 0x0b, 0x16, 0x5e, 0x73, 0x68, 0x08, 0x22, 0x67, 0xa2, 0xd1, 0xde, 0xee, 0x1e, 0x1b, 0x6c,
-	0x85, 0x28, 0x3a, 0x6f, 0xd0, 0xc4, 0x61, 0x1f, 0x13, 0x83, 0xcd, 0xad, 0xa2, 0xb3, 0x6f, 0x0a,
-	0xd7, 0x73, 0x3e, 0x73, 0xdc, 0x53, 0xa7, 0xcd, 0x25, 0xaa, 0xac, 0xb3, 0x21, 0x88, 0x2d, 0x26,
-	0x78, 0x0d, 0x82, 0x76, 0x9b, 0x01, 0x8c, 0xf1, 0xf2, 0x83, 0xa0, 0x6d, 0x51, 0x9c, 0x17, 0xa1,
-	0x7a, 0x6c, 0xd1, 0x1c, 0xeb, 0xac, 0x59, 0x4b, 0xfd, 0x85, 0x63, 0x01, 0x48, 0x0f, 0xd8, 0xb4,
-	0x87, 0xd0, 0x7c, 0xe2, 0xf5, 0x7c, 0x82, 0xcd, 0xf0, 0x98, 0xe1, 0x97, 0x8f, 0xe0, 0x7f, 0x55,
-	0x60, 0x21, 0x03, 0x4e, 0x44, 0x94, 0x67, 0x80, 0x08, 0xef, 0x6c, 0x87, 0xc1, 0xd1, 0x17, 0xc7,
-	0x85, 0xdb, 0x31, 0xec, 0x5c, 0x84, 0x0d, 0x1a, 0x5b, 0x9f, 0xea, 0x0f, 0xf5, 0x69, 0x92, 0x64,
-	0x51, 0x1f, 0x42, 0x55, 0xf4, 0xa2, 0x9b, 0x50, 0xa5, 0x38, 0x6d, 0xf1, 0xbf, 0x4c, 0xc7, 0xe6,
-	0x0a, 0xed, 0xde, 0x31, 0xe9, 0x2f, 0xcd, 0x30, 0xcd, 0xf0, 0x0c, 0x51, 0xd3, 0x83, 0xa6, 0x76,
-	0x0f, 0x26, 0x1f, 0x77, 0xb1, 0x67, 0x10, 0xd7, 0x2b, 0xef, 0x0d, 0x0b, 0xa6, 0x22, 0x10, 0xe1,
-	0x83, 0x19, 0x18, 0xc5, 0x1d, 0xc3, 0xb2, 0xc5, 0x3f, 0x94, 0x37, 0xe8, 0x0f, 0xfe, 0xd4, 0xb0,
-	0x6d, 0x4c, 0x84, 0x1e, 0xa2, 0x85, 0x6e, 0xc2, 0x24, 0xff, 0x6a, 0x1f, 0x62, 0x83, 0xf4, 0x3c,
-	0x96, 0x03, 0x0f, 0xaf, 0xd7, 0xf4, 0x09, 0x4e, 0x7e, 0x5b, 0x50, 0xb5, 0x2f, 0x15, 0x58, 0x79,
-	0xe0, 0x13, 0xab, 0x43, 0xb7, 0xdb, 0xae, 0x71, 0xe6, 0xf6, 0xc8, 0xe5, 0x1c, 0x5a, 0x7f, 0x0c,
-	0xab, 0xf9, 0x7a, 0x08, 0x1f, 0xdc, 0x01, 0x84, 0x03, 0x9e, 0x36, 0x36, 0x3c, 0xc7, 0x72, 0x8e,
-	0x7c, 0x71, 0xb4, 0x99, 0x0e, 0x7b, 0x1e, 0x88, 0x0e, 0xed, 0x5d, 0x98, 0x4b, 0x40, 0x96, 0x9f,
-	0x92, 0x6d, 0x98, 0x4f, 0x61, 0x95, 0xd3, 0x6a, 0x0b, 0x26, 0x06, 0xce, 0x49, 0x76, 0x60, 0x32,
-	0x99, 0x8c, 0xbc, 0x0a, 0xf5, 0x2e, 0xd3, 0xab, 0x6d, 0x39, 0x87, 0xae, 0x40, 0x9a, 0x8d, 0x21,
-	0x71, 0xad, 0x77, 0x9c, 0x43, 0x57, 0x87, 0x6e, 0xf8, 0xad, 0x7d, 0x02, 0x33, 0x02, 0x6a, 0x17,
-	0x7b, 0x96, 0x6b, 0x96, 0x9f, 0xf4, 0x39, 0xa8, 0x74, 0x19, 0x44, 0xb0, 0x16, 0x79, 0x4b, 0x7b,
-	0x0c, 0xb3, 0x89, 0x11, 0x06, 0x54, 0xf9, 0x0b, 0x98, 0xbf, 0xd4, 0xcc, 0x54, 0x87, 0x66, 0x6e,
-	0x4a, 0x5a, 0xd6, 0xa6, 0xdf, 0x29, 0xb0, 0x94, 0x04, 0x1d, 0x74, 0x42, 0x4a, 0x54, 0x0a, 0xa3,
-	0x39, 0x1c, 0x96, 0xe6, 0xf0, 0x43, 0x58, 0xce, 0xd3, 0x6e, 0x40, 0xc3, 0x5b, 0x30, 0x4e, 0xb7,
-	0x06, 0x2e, 0x6f, 0xa7, 0x76, 0x03, 0x26, 0x02, 0x88, 0x28, 0x58, 0x46, 0x95, 0xf6, 0x61, 0x9d,
-	0x37, 0x58, 0x3c, 0x60, 0x7c, 0x83, 0x2f, 0x1b, 0xed, 0x13, 0x98, 0x4f, 0x61, 0x89, 0xc1, 0x1f,
-	0xc0, 0x14, 0x66, 0x5d, 0xd1, 0xcf, 0x4a, 0xfc, 0xab, 0xd4, 0x78, 0x56, 0x9a, 0x90, 0x9e, 0xc4,
-	0x32, 0x41, 0xfb, 0x08, 0x26, 0x13, 0x3c, 0xd9, 0x66, 0x95, 0x59, 0xc1, 0xdb, 0x30, 0xf3, 0xd4,
-	0x31, 0x2d, 0x9f, 0x78, 0xd6, 0x7e, 0x8f, 0x0c, 0xe2, 0xfb, 0x3b, 0x30, 0x9b, 0x40, 0x2a, 0x9c,
-	0x82, 0x2f, 0x60, 0x7e, 0xd7, 0x38, 0xf3, 0x49, 0x6f, 0xff, 0x72, 0xb6, 0xee, 0x36, 0x34, 0xd3,
-	0xe3, 0x0b, 0x8d, 0x6f, 0x43, 0xb5, 0xcb, 0xfb, 0x84, 0x06, 0x48, 0x5e, 0xbd, 0xb4, 0x47, 0x0f,
-	0x58, 0x68, 0x18, 0x0f, 0x68, 0xa5, 0x9d, 0xf7, 0x23, 0x98, 0x0c, 0x31, 0x4a, 0x29, 0xf1, 0x09,
-	0xcc, 0x08, 0xda, 0xb7, 0x15, 0xbc, 0x1f, 0xc0, 0x6c, 0x62, 0x84, 0x52, 0x8a, 0xd2, 0xf0, 0x96,
-	0x74, 0xfc, 0x77, 0x28, 0xbc, 0xbd, 0x0f, 0xcb, 0x79, 0xda, 0x95, 0x32, 0xf7, 0x15, 0x80, 0x28,
-	0xdc, 0xd1, 0x83, 0xfb, 0x31, 0xb6, 0xc3, 0x8a, 0x3f, 0xfd, 0xa6, 0x34, 0x56, 0x97, 0xe1, 0x05,
-	0x18, 0xf6, 0xad, 0xfd, 0x66, 0x18, 0xaa, 0x02, 0x0a, 0x69, 0x30, 0xce, 0x6b, 0x63, 0xe2, 0x22,
-	0x2f, 0xb8, 0x33, 0x64, 0xc4, 0x16, 0xbb, 0xc1, 0x43, 0x57, 0xa1, 0xc6, 0x79, 0x8e, 0x70, 0x50,
-	0x18, 0x1a, 0x63, 0x84, 0x77, 0x30, 0x41, 0xeb, 0x30, 0x15, 0x76, 0xb6, 0x45, 0x4d, 0x89, 0xa7,
-	0x23, 0x13, 0x01, 0x8f, 0xce, 0x6b, 0x4b, 0x37, 0x60, 0x32, 0xe2, 0xe4, 0xb9, 0x37, 0x4f, 0x4a,
-	0xc6, 0x03, 0x46, 0x9e, 0x1c, 0xad, 0x42, 0xe3, 0xc0, 0xed, 0x74, 0x43, 0x8d, 0xf8, 0xa5, 0x28,
-	0x50, 0x9a, 0x50, 0x68, 0x01, 0xc6, 0x18, 0x07, 0xd5, 0x87, 0xdf, 0x8a, 0x56, 0x69, 0x9b, 0xaa,
-	0x73, 0x03, 0x26, 0x83, 0xae, 0x40, 0x9b, 0x2a, 0x1f, 0x44, 0x70, 0x08, 0x65, 0xae, 0xc3, 0x44,
-	0xc8, 0xc7, 0x75, 0x19, 0xe3, 0x09, 0x92, 0x60, 0xe3, 0xaa, 0x04, 0x1e, 0xad, 0x65, 0x78, 0x14,
-	0x22, 0x8f, 0xa2, 0x55, 0xa8, 0xc7, 0x62, 0x53, 0xb3, 0xce, 0xba, 0xe2, 0x24, 0xa4, 0xc2, 0x98,
-	0x69, 0xf9, 0x5d, 0xd7, 0xc7, 0x66, 0xb3, 0xc1, 0x5d, 0x18, 0xb4, 0x69, 0x8a, 0xb3, 0x8d, 0x6d,
-	0xb3, 0xd5, 0xa1, 0x49, 0xd9, 0x36, 0xcf, 0x7b, 0xca, 0x6f, 0xf6, 0xaf, 0x87, 0x60, 0x21, 0x03,
-	0x4e, 0xac, 0xaf, 0xdd, 0x28, 0x01, 0xe3, 0xff, 0x8a, 0x57, 0x63, 0x80, 0xb9, 0x62, 0x19, 0x3d,
-	0x01, 0x8c, 0xfa, 0x06, 0x40, 0xd4, 0x1b, 0x5b, 0xf9, 0x4a, 0x7c, 0xe5, 0x53, 0xba, 0xd1, 0x09,
-	0x2b, 0x40, 0xc3, 0xba, 0x68, 0xa9, 0x5f, 0x29, 0x30, 0x9d, 0x02, 0x4f, 0x6d, 0x39, 0xa5, 0xff,
-	0x96, 0xd3, 0xa1, 0x41, 0xa7, 0xa7, 0xcd, 0x71, 0x69, 0xbe, 0x44, 0xad, 0xbb, 0x7b, 0x41, 0xeb,
-	0xf4, 0xfa, 0x71, 0xf8, 0xed, 0x6b, 0x8f, 0xe1, 0x6a, 0xe2, 0x30, 0xce, 0x6e, 0xb3, 0xcb, 0xcf,
-	0xcd, 0x23, 0x58, 0xcc, 0x06, 0x2c, 0x77, 0xc4, 0x7f, 0x0c, 0x57, 0x5b, 0xb6, 0x1d, 0xe5, 0x98,
-	0x03, 0x9f, 0xf7, 0x3f, 0x80, 0xc5, 0x6c, 0xc0, 0x01, 0x0f, 0x5f, 0x1d, 0xb8, 0x26, 0xe1, 0xf2,
-	0xa0, 0x37, 0xa8, 0xba, 0xb9, 0x3f, 0x93, 0x9f, 0x82, 0x56, 0x34, 0xdc, 0x73, 0x48, 0x0b, 0x02,
-	0xe8, 0x81, 0x4d, 0x28, 0x99, 0x16, 0xa4, 0xc6, 0x7f, 0x1e, 0x69, 0x81, 0xfc, 0x4b, 0xba, 0x04,
-	0xd3, 0x0a, 0xd3, 0x82, 0x1c, 0xed, 0x06, 0x34, 0xfc, 0x11, 0x2c, 0xf0, 0xd3, 0xef, 0x2e, 0xf6,
-	0x9e, 0xc3, 0x71, 0xfd, 0x00, 0xd4, 0x2c, 0xb8, 0xe7, 0x7b, 0x62, 0x8f, 0x2f, 0xc0, 0x41, 0xcf,
-	0x86, 0x25, 0x0f, 0xb7, 0xe9, 0xf1, 0x4b, 0x9f, 0x2b, 0xd9, 0x74, 0x0e, 0x6c, 0x46, 0xd1, 0xb9,
-	0x52, 0x1e, 0xa1, 0xf4, 0xb9, 0x32, 0xb1, 0x02, 0x2f, 0xc1, 0xf3, 0x45, 0xe7, 0xca, 0x3c, 0xed,
-	0xca, 0x98, 0xbb, 0xf9, 0x8b, 0x21, 0xa8, 0x8a, 0x77, 0x31, 0xe8, 0x6d, 0xa8, 0x85, 0xef, 0xe4,
-	0xd0, 0xd5, 0x98, 0x54, 0xf2, 0x11, 0x9e, 0xba, 0x98, 0xdd, 0x29, 0x34, 0xd8, 0x86, 0x51, 0xfe,
-	0xaa, 0x66, 0x39, 0xef, 0xf1, 0x8d, 0x80, 0x59, 0xc9, 0xed, 0x17, 0x48, 0x07, 0x30, 0x21, 0x3f,
-	0xf7, 0x41, 0x37, 0x73, 0x44, 0x92, 0x3b, 0x5a, 0x5d, 0xef, 0xcf, 0xc8, 0x07, 0xd9, 0xfc, 0x67,
-	0x05, 0x6a, 0xe1, 0xab, 0x10, 0x64, 0x40, 0x23, 0xfe, 0xc8, 0x46, 0x1a, 0xb0, 0xe8, 0x61, 0x8f,
-	0x34, 0x60, 0xf1, 0x7b, 0x9d, 0x13, 0x58, 0xc8, 0x7d, 0x11, 0x83, 0x5e, 0xc8, 0x82, 0xc9, 0x29,
-	0x4e, 0xa9, 0xb7, 0xcf, 0xc7, 0x1c, 0x16, 0xbd, 0xa7, 0x92, 0x4c, 0x48, 0x2b, 0x40, 0x08, 0x46,
-	0x59, 0x2b, 0xe4, 0x11, 0xe0, 0x1d, 0x98, 0xcb, 0x7e, 0x9d, 0x82, 0xd6, 0x53, 0x37, 0xe7, 0x79,
-	0xe6, 0xdc, 0x3a, 0x07, 0xa7, 0x18, 0x4e, 0x87, 0x71, 0x89, 0x03, 0xad, 0xe4, 0xc9, 0x06, 0xe0,
-	0xab, 0xf9, 0x0c, 0x02, 0xb3, 0x0b, 0xf3, 0x39, 0xef, 0x43, 0xd0, 0xad, 0xf4, 0x8d, 0x7e, 0x9e,
-	0x11, 0xdf, 0x3b, 0x0f, 0xab, 0x18, 0xf1, 0x29, 0x4c, 0xc8, 0x2c, 0x68, 0x35, 0x57, 0x3a, 0xc0,
-	0xbf, 0x56, 0xc0, 0x11, 0xc1, 0xca, 0xcf, 0x35, 0x24, 0xd8, 0xcc, 0x77, 0x24, 0x12, 0x6c, 0xce,
-	0x5b, 0x8f, 0xd7, 0x61, 0x94, 0xf5, 0xa0, 0xf9, 0x24, 0x6f, 0x00, 0xd2, 0x4c, 0x77, 0x88, 0x4d,
-	0xf6, 0xe5, 0x30, 0x8c, 0xd0, 0x38, 0x87, 0xde, 0x82, 0xaa, 0xb8, 0xce, 0x47, 0x0b, 0x31, 0x6e,
-	0xf9, 0x99, 0x80, 0xaa, 0x66, 0x75, 0x09, 0x35, 0x1e, 0x42, 0x3d, 0x76, 0x37, 0x8f, 0x96, 0x62,
-	0xac, 0xe9, 0xbb, 0x7f, 0x75, 0x39, 0xaf, 0x5b, 0xa0, 0xed, 0x00, 0x44, 0xb7, 0xc0, 0x68, 0x31,
-	0xe7, 0x72, 0x98, 0x63, 0x2d, 0x15, 0x5e, 0x1d, 0xa3, 0x8f, 0x61, 0x3a, 0x75, 0x5f, 0x84, 0xd6,
-	0x8a, 0x6f, 0x93, 0x38, 0xf0, 0xf5, 0xf3, 0x5c, 0x39, 0xa1, 0x7b, 0x30, 0x16, 0x5c, 0xe2, 0xa0,
-	0xb8, 0x83, 0x12, 0xd7, 0x43, 0xea, 0xd5, 0xcc, 0x3e, 0x31, 0x11, 0xff, 0xaa, 0xb1, 0x92, 0x80,
-	0xdb, 0x23, 0x3e, 0x9d, 0x8b, 0x60, 0xdd, 0xc5, 0xe7, 0x22, 0xb1, 0xe0, 0xd4, 0xac, 0xae, 0x68,
-	0x1b, 0x4a, 0x95, 0x78, 0x69, 0x1b, 0x66, 0xdd, 0x02, 0x48, 0xdb, 0x30, 0xbb, 0x88, 0xff, 0x0c,
-	0xa6, 0x52, 0xfb, 0x4f, 0x4b, 0x4b, 0xa5, 0x56, 0xf0, 0x5a, 0x21, 0x4f, 0x14, 0xa6, 0xb2, 0xcb,
-	0xce, 0x52, 0x98, 0x2a, 0xac, 0x9b, 0x4b, 0x61, 0xaa, 0x4f, 0x0d, 0xfb, 0x4d, 0xa8, 0xf0, 0x43,
-	0x1e, 0x6a, 0xa6, 0xce, 0x7d, 0x01, 0xdc, 0x42, 0x46, 0x8f, 0x10, 0xff, 0x30, 0x5d, 0xb1, 0xbd,
-	0x56, 0x70, 0x7e, 0x14, 0x80, 0x5a, 0x11, 0x8b, 0x40, 0xf6, 0xa1, 0x99, 0x77, 0x39, 0x86, 0xe2,
-	0x11, 0xac, 0xcf, 0x4d, 0x9e, 0xfa, 0xc2, 0xb9, 0x78, 0x63, 0xe6, 0xc8, 0x3c, 0xb2, 0x39, 0x99,
-	0x57, 0x6b, 0xb2, 0x39, 0x39, 0x37, 0x66, 0x3a, 0x8c, 0x4b, 0x45, 0x63, 0x69, 0x1d, 0x66, 0x15,
-	0xa6, 0xa5, 0x75, 0x98, 0x5d, 0x6f, 0x7e, 0x06, 0x53, 0xc9, 0x12, 0x9e, 0xb4, 0x0e, 0x73, 0xca,
-	0xce, 0xd2, 0x3a, 0xcc, 0x2d, 0x0d, 0xbf, 0x15, 0x15, 0xe6, 0x16, 0x32, 0xce, 0x67, 0x19, 0x5b,
-	0x2f, 0x79, 0xce, 0xd3, 0x61, 0x5c, 0xaa, 0xa3, 0x4a, 0x26, 0x67, 0xd5, 0x70, 0x25, 0x93, 0xb3,
-	0x4b, 0xb0, 0x1d, 0x98, 0xcb, 0xae, 0x5a, 0x4a, 0xbb, 0xa3, 0xb0, 0xec, 0x2a, 0xed, 0x8e, 0x3e,
-	0x25, 0xd0, 0x8f, 0xb3, 0x2a, 0x42, 0x6b, 0xc5, 0x85, 0x9c, 0x74, 0xc0, 0xcc, 0xad, 0xf6, 0x6c,
-	0xfe, 0xbb, 0x06, 0x15, 0xb1, 0xce, 0x8e, 0x60, 0x26, 0xab, 0xde, 0x81, 0x6e, 0xc4, 0x5f, 0x25,
-	0xe4, 0x57, 0x58, 0xd4, 0x9b, 0x7d, 0xf9, 0x84, 0x4d, 0x67, 0xa0, 0xe6, 0x57, 0x24, 0xd0, 0xed,
-	0x3c, 0x98, 0xac, 0x4c, 0x5c, 0xbd, 0x73, 0x4e, 0xee, 0x58, 0xe0, 0x4c, 0x94, 0x0b, 0xe4, 0xc0,
-	0x99, 0x5d, 0xcb, 0x90, 0x03, 0x67, 0x5e, 0xbd, 0x81, 0x06, 0xce, 0xcc, 0xc4, 0x5c, 0x0e, 0x9c,
-	0x45, 0x95, 0x05, 0x39, 0x70, 0x16, 0x67, 0xf9, 0x03, 0x06, 0x4e, 0x03, 0x50, 0x3a, 0x3b, 0x47,
-	0xd7, 0x53, 0x02, 0x19, 0xb5, 0x00, 0xf5, 0xff, 0xfb, 0x70, 0x5d, 0x66, 0x04, 0x3d, 0x82, 0x99,
-	0xac, 0xb2, 0xa2, 0xb4, 0x8c, 0x0b, 0x0a, 0x99, 0xd2, 0x32, 0x2e, 0xac, 0x4f, 0x7e, 0x4b, 0x01,
-	0x35, 0x59, 0x4d, 0xc8, 0x5e, 0x9f, 0x89, 0x28, 0xb8, 0x56, 0xc8, 0xf3, 0x5c, 0x03, 0x6a, 0x3c,
-	0xa3, 0x96, 0x03, 0x6a, 0x46, 0x25, 0x40, 0x0e, 0xa8, 0x99, 0xc9, 0x78, 0x7a, 0xd7, 0x04, 0xe0,
-	0x05, 0xbb, 0x26, 0x31, 0xca, 0xad, 0x73, 0x70, 0xf2, 0xe1, 0xb6, 0xae, 0x7f, 0xa4, 0xd1, 0x08,
-	0xf8, 0xe9, 0x86, 0xe5, 0xde, 0x65, 0x1f, 0x77, 0xbb, 0x9e, 0x75, 0x62, 0x10, 0x7c, 0x37, 0x84,
-	0xe8, 0xee, 0xef, 0x57, 0xd8, 0x2b, 0xb7, 0x97, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0xde, 0x43,
-	0x56, 0xb1, 0xd2, 0x37, 0x00, 0x00,
+	// 3085 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcb, 0x53, 0x24, 0xc7,
+	0xd1, 0xff, 0x9a, 0x59, 0xe6, 0x91, 0x33, 0x30, 0x50, 0xcb, 0x63, 0xe8, 0x65, 0x79, 0x34, 0xfb,
+	0xed, 0xc2, 0xa7, 0x5d, 0x56, 0x42, 0x0a, 0x7d, 0x96, 0x2c, 0x85, 0x35, 0xb0, 0x48, 0x20, 0xb1,
+	0x5a, 0xdc, 0xec, 0xca, 0x0a, 0xc9, 0xa1, 0x56, 0x43, 0x17, 0xd0, 0xda, 0x9e, 0xe9, 0x51, 0x77,
+	0x0d, 0x88, 0x08, 0x87, 0xc2, 0x07, 0x5b, 0x3e, 0x39, 0xc2, 0x67, 0xd9, 0x61, 0xdf, 0xec, 0x9b,
+	0x0f, 0xbe, 0xf8, 0xe8, 0x9b, 0x43, 0x11, 0xfe, 0x0f, 0x7c, 0x90, 0x23, 0x7c, 0xf3, 0xc5, 0x17,
+	0xdf, 0x7c, 0x72, 0xd4, 0xa3, 0xdf, 0x0f, 0xa0, 0x67, 0x65, 0x74, 0xeb, 0xca, 0xca, 0xfc, 0x55,
+	0x66, 0x56, 0x55, 0x76, 0x55, 0x66, 0x41, 0xb3, 0xd3, 0xb7, 0x88, 0xd9, 0xb5, 0x0d, 0xbc, 0xda,
+	0x73, 0x6c, 0x62, 0xa3, 0x9a, 0x4f, 0x90, 0xe1, 0xc8, 0x3e, 0xb2, 0x39, 0x59, 0x9e, 0x3f, 0xb2,
+	0xed, 0x23, 0x0b, 0xdf, 0x67, 0xad, 0xfd, 0xfe, 0xe1, 0x7d, 0x62, 0x76, 0xb0, 0x4b, 0xf4, 0x4e,
+	0x8f, 0x33, 0x28, 0xcb, 0x30, 0xa2, 0xe2, 0x4f, 0xfb, 0xd8, 0x25, 0x5b, 0x58, 0x37, 0xb0, 0x83,
+	0xa6, 0xa1, 0xa2, 0xf7, 0x4c, 0xed, 0x29, 0x3e, 0x6b, 0x49, 0x0b, 0xd2, 0x72, 0x43, 0x2d, 0xeb,
+	0x3d, 0xf3, 0x1d, 0x7c, 0xa6, 0x3c, 0x80, 0xb1, 0x07, 0xa6, 0xfb, 0x74, 0xaf, 0xa7, 0x1f, 0x60,
+	0x21, 0x82, 0x9e, 0x87, 0xf2, 0x31, 0x13, 0x63, 0xbc, 0xf5, 0xb5, 0xd6, 0x6a, 0xa0, 0x57, 0x04,
+	0x56, 0x15, 0x7c, 0xca, 0x9f, 0x24, 0x18, 0x0f, 0xc1, 0xb8, 0x3d, 0xbb, 0xeb, 0x62, 0x34, 0x0b,
+	0x35, 0xdd, 0xb2, 0xec, 0x03, 0x9d, 0x60, 0x83, 0x41, 0x95, 0xd4, 0x80, 0x80, 0xe6, 0xa1, 0xde,
+	0x77, 0xb1, 0xa1, 0xf5, 0x4c, 0x7c, 0x80, 0xdd, 0xd6, 0x10, 0xeb, 0x07, 0x4a, 0xda, 0x65, 0x14,
+	0x74, 0x13, 0x58, 0x4b, 0x23, 0x8e, 0xee, 0x1e, 0xb7, 0x4a, 0x5c, 0x9e, 0x52, 0x1e, 0x53, 0x02,
+	0x42, 0x70, 0xed, 0xd0, 0xc1, 0xb8, 0x75, 0x8d, 0x75, 0xb0, 0x6f, 0x36, 0xe2, 0x89, 0x6e, 0x5a,
+	0xfa, 0xbe, 0x85, 0x5b, 0xc3, 0x62, 0x44, 0x8f, 0x80, 0x64, 0xa8, 0xda, 0x27, 0xd8, 0xa1, 0x10,
+	0xad, 0x32, 0xeb, 0xf4, 0xdb, 0xca, 0xef, 0x25, 0x68, 0xec, 0x11, 0xdb, 0xd1, 0x8f, 0xf0, 0x13,
+	0x57, 0x3f, 0xc2, 0x48, 0x81, 0x11, 0x9d, 0x68, 0x0e, 0x76, 0x89, 0x46, 0x6c, 0xa2, 0x5b, 0xcc,
+	0x00, 0x49, 0xad, 0xeb, 0x44, 0xc5, 0x2e, 0x79, 0x4c, 0x49, 0xe8, 0x1d, 0x18, 0x35, 0xbb, 0x04,
+	0x3b, 0x27, 0xba, 0xa5, 0xb9, 0x44, 0x77, 0x08, 0xb3, 0xa2, 0xbe, 0x26, 0xaf, 0xf2, 0x09, 0x5a,
+	0xf5, 0x26, 0x68, 0xf5, 0xb1, 0x37, 0x41, 0xeb, 0xd5, 0xaf, 0xbe, 0x9e, 0xff, 0x9f, 0x5f, 0xfc,
+	0x6d, 0x5e, 0x52, 0x47, 0x3c, 0xd9, 0x3d, 0x2a, 0x8a, 0xee, 0xc1, 0xf5, 0xc8, 0x80, 0xda, 0xfe,
+	0x19, 0xc1, 0x2e, 0xb3, 0x5b, 0x52, 0xc7, 0x42, 0xc3, 0xae, 0x53, 0xba, 0xf2, 0x47, 0x09, 0xae,
+	0x87, 0x15, 0x2e, 0x3c, 0x79, 0xe8, 0x3b, 0xd4, 0x91, 0x76, 0xe7, 0x52, 0xba, 0x33, 0x09, 0xf4,
+	0x12, 0x0c, 0x11, 0xbb, 0x55, 0xba, 0x84, 0xdc, 0x10, 0xb1, 0x95, 0x5f, 0x4b, 0x30, 0x11, 0xd5,
+	0x5c, 0xac, 0x97, 0xd7, 0x60, 0xc4, 0xe5, 0x74, 0xad, 0x4f, 0x3b, 0x5a, 0xd2, 0x42, 0x69, 0xb9,
+	0xbe, 0x36, 0x1d, 0xb2, 0x20, 0x22, 0xd7, 0x70, 0xc3, 0x13, 0xd6, 0x82, 0x8a, 0xdb, 0xef, 0x74,
+	0x74, 0xe7, 0x8c, 0x59, 0x22, 0xa9, 0x5e, 0x13, 0xad, 0xc2, 0x75, 0xfd, 0x04, 0x07, 0xb8, 0x11,
+	0xcf, 0x8e, 0x8b, 0x2e, 0x06, 0xc2, 0x5d, 0xfb, 0x2f, 0x09, 0x66, 0xc3, 0x03, 0xed, 0xe9, 0x04,
+	0x5b, 0x96, 0x49, 0x06, 0xf0, 0xf1, 0x0b, 0xd0, 0x70, 0x3d, 0x14, 0xcd, 0x34, 0x98, 0x86, 0x8d,
+	0xf5, 0x51, 0xea, 0x97, 0xbf, 0x7e, 0x3d, 0x5f, 0x7e, 0xd7, 0x36, 0xf0, 0xf6, 0x03, 0xb5, 0xee,
+	0xf3, 0x6c, 0x1b, 0xfe, 0xb4, 0x94, 0x0a, 0x4e, 0xcb, 0xb5, 0x4b, 0x4e, 0xcb, 0xef, 0x24, 0xb8,
+	0x99, 0x61, 0xf5, 0xb7, 0x6c, 0x7e, 0x76, 0x61, 0x76, 0x5d, 0xef, 0x1a, 0xa7, 0xa6, 0x41, 0x8e,
+	0x1f, 0xda, 0x5d, 0x72, 0xbc, 0xc7, 0x81, 0x8a, 0xc7, 0xaf, 0x17, 0xe1, 0x66, 0x06, 0xa2, 0x30,
+	0x1d, 0xc1, 0x35, 0x16, 0x36, 0x78, 0x14, 0x63, 0xdf, 0xca, 0xcf, 0x24, 0x58, 0xf0, 0xa5, 0x84,
+	0xc0, 0x95, 0x2c, 0x15, 0xe5, 0x75, 0x58, 0xcc, 0x51, 0x44, 0x98, 0x10, 0xf2, 0x3f, 0xb7, 0xc2,
+	0x6b, 0x2a, 0xef, 0xc0, 0x74, 0x5c, 0xbc, 0xb8, 0x2b, 0x5f, 0x82, 0x56, 0x12, 0xec, 0x5c, 0x15,
+	0x7e, 0x22, 0xc1, 0xcd, 0xcd, 0x23, 0x07, 0xbb, 0xee, 0x95, 0x3a, 0xf2, 0x55, 0x98, 0xcb, 0xd2,
+	0xe2, 0x5c, 0x13, 0xb6, 0x60, 0x22, 0x22, 0x5b, 0xdc, 0x85, 0x2f, 0xc0, 0x64, 0x0c, 0xe9, 0xdc,
+	0xc1, 0x7f, 0x2a, 0xc1, 0xdc, 0x76, 0xf7, 0xea, 0x1d, 0xf8, 0x5d, 0x98, 0xcf, 0x54, 0xe3, 0x5c,
+	0x23, 0xb6, 0x61, 0x32, 0x2a, 0x5c, 0xdc, 0x85, 0x6b, 0x30, 0x15, 0x87, 0x3a, 0x77, 0xf8, 0x1f,
+	0xc1, 0xe4, 0x03, 0xdd, 0xb4, 0xae, 0xc8, 0x73, 0x7b, 0x30, 0x15, 0x1f, 0x5d, 0x68, 0xfc, 0x0a,
+	0x34, 0x78, 0x58, 0x74, 0x6c, 0xcb, 0xea, 0xf7, 0x44, 0xd4, 0x9d, 0x0a, 0x29, 0xc1, 0xc3, 0x2d,
+	0xeb, 0x55, 0xeb, 0xfd, 0xa0, 0xa1, 0xbc, 0x01, 0x0d, 0x06, 0x5a, 0xdc, 0x91, 0x6f, 0xc3, 0x88,
+	0x40, 0x18, 0x5c, 0x9b, 0xbf, 0x48, 0x50, 0x0f, 0x75, 0xa2, 0x15, 0x28, 0x63, 0x36, 0x47, 0x42,
+	0x9b, 0xf1, 0x10, 0x08, 0xdf, 0x00, 0xaa, 0x60, 0x40, 0x77, 0xa1, 0x62, 0xf2, 0xf9, 0x14, 0xc7,
+	0x14, 0x14, 0xe2, 0x15, 0x33, 0xad, 0x7a, 0x2c, 0x68, 0x0a, 0xca, 0x06, 0xb6, 0x30, 0xc1, 0xe2,
+	0xd4, 0x28, 0x5a, 0x29, 0xe7, 0xb5, 0x6b, 0x85, 0xcf, 0x6b, 0xca, 0x0e, 0x94, 0x37, 0xfd, 0xe1,
+	0x1c, 0xdc, 0xd3, 0x4d, 0x47, 0xac, 0x28, 0xd1, 0x42, 0x13, 0x30, 0xac, 0xf7, 0x0d, 0x93, 0x88,
+	0xb3, 0x2d, 0x6f, 0x50, 0x2a, 0xff, 0x7b, 0x72, 0xdd, 0x78, 0x43, 0xf9, 0x7f, 0xa8, 0x6c, 0x77,
+	0xa3, 0x70, 0x46, 0x04, 0xce, 0x08, 0x04, 0x87, 0xc2, 0x82, 0xeb, 0x30, 0xfa, 0x1e, 0x76, 0x5c,
+	0xd3, 0xee, 0x16, 0x9f, 0xe4, 0xe7, 0xa0, 0xe9, 0x63, 0x04, 0xdb, 0xe4, 0x84, 0x93, 0x18, 0x4a,
+	0x4d, 0xf5, 0x9a, 0xca, 0x9b, 0x80, 0x76, 0x74, 0x97, 0x6c, 0xd8, 0x5d, 0xa2, 0x1f, 0x90, 0xe2,
+	0x83, 0x7e, 0x04, 0xd7, 0x23, 0x38, 0x62, 0xe0, 0xb7, 0xa0, 0x61, 0xe9, 0x2e, 0xd1, 0x0e, 0x38,
+	0xbd, 0x25, 0x5d, 0x62, 0x86, 0xea, 0x56, 0x00, 0xa8, 0x7c, 0x06, 0xe3, 0x2a, 0xee, 0xf5, 0x89,
+	0x4e, 0x06, 0xf1, 0x4d, 0x91, 0xad, 0xfc, 0xa5, 0x04, 0xf5, 0x36, 0x9d, 0xeb, 0x1f, 0x98, 0x5d,
+	0xc3, 0x3e, 0xa5, 0x26, 0x9d, 0xb2, 0x2f, 0xb1, 0xe8, 0x2e, 0x65, 0x12, 0x97, 0xe4, 0x57, 0x84,
+	0x45, 0x68, 0xd8, 0x5d, 0xcb, 0xec, 0x62, 0xed, 0xc0, 0xee, 0x77, 0xf9, 0xba, 0x1a, 0x56, 0xeb,
+	0x9c, 0xb6, 0x41, 0x49, 0xf4, 0x56, 0xc5, 0x6f, 0x0f, 0x9c, 0xa3, 0xc4, 0x38, 0x80, 0x91, 0x18,
+	0x83, 0xf2, 0xef, 0x0a, 0xa0, 0xb0, 0x5f, 0xfc, 0xb3, 0x5d, 0x99, 0xc3, 0x08, 0xed, 0x6e, 0x45,
+	0x1c, 0x13, 0x67, 0x5f, 0x7d, 0xc4, 0x78, 0x55, 0x21, 0x83, 0x5e, 0x09, 0xaf, 0xf4, 0xfa, 0xda,
+	0x52, 0xbe, 0x30, 0xf3, 0x8d, 0xb7, 0x1d, 0x1e, 0x42, 0xd3, 0x30, 0xdd, 0x4f, 0xfb, 0xba, 0x65,
+	0x1e, 0x9a, 0xd8, 0xd0, 0x74, 0x72, 0xc1, 0x13, 0xaf, 0xc4, 0xfc, 0x33, 0x1a, 0x16, 0x6e, 0x13,
+	0xea, 0x6b, 0xb7, 0xef, 0xf6, 0x70, 0xd7, 0xe0, 0x58, 0xd7, 0x2e, 0x81, 0x55, 0xf7, 0x25, 0xdb,
+	0x04, 0xbd, 0x07, 0x13, 0xf6, 0xe1, 0x21, 0x73, 0x76, 0x04, 0x70, 0xf8, 0x12, 0x80, 0x48, 0x20,
+	0xec, 0x85, 0x70, 0x3f, 0x84, 0x69, 0x0f, 0xb7, 0xdf, 0x35, 0xb0, 0xa3, 0x39, 0xf8, 0xc4, 0xc4,
+	0xa7, 0x14, 0xba, 0x7c, 0x09, 0x68, 0x4f, 0xb9, 0x27, 0x14, 0x43, 0x65, 0x10, 0x6d, 0x82, 0xda,
+	0x50, 0x3b, 0xc1, 0x84, 0x70, 0x4d, 0x6b, 0x97, 0x80, 0xab, 0x72, 0xb1, 0x36, 0x41, 0x1b, 0x00,
+	0xfd, 0x9e, 0xa1, 0x0b, 0x8c, 0xca, 0x25, 0x96, 0x6a, 0x4d, 0xc8, 0x71, 0x3d, 0x3e, 0xb1, 0xcd,
+	0x2e, 0xc7, 0xa8, 0x5e, 0x02, 0xa3, 0xca, 0xc5, 0xda, 0x44, 0x9e, 0x83, 0x32, 0x5f, 0x64, 0x34,
+	0xee, 0xb9, 0x07, 0xb6, 0x83, 0xc5, 0x0d, 0x9c, 0x37, 0xe4, 0x3f, 0x0c, 0xc1, 0x70, 0xdb, 0x0b,
+	0xa8, 0xc9, 0x7e, 0xb4, 0x02, 0x63, 0x7c, 0xde, 0x68, 0xd0, 0xd2, 0x38, 0x03, 0xbf, 0x77, 0x34,
+	0x03, 0xfa, 0x1e, 0x63, 0x4d, 0xd9, 0x33, 0xa5, 0xf0, 0x9e, 0x41, 0x4b, 0x30, 0xe2, 0xf6, 0x0f,
+	0x0e, 0xb0, 0xeb, 0x0a, 0x16, 0x9e, 0x73, 0x68, 0x08, 0x22, 0x67, 0xa2, 0xd1, 0xde, 0xea, 0x1d,
+	0xeb, 0x6c, 0x85, 0x48, 0x2a, 0x6f, 0xd0, 0x8b, 0xc3, 0x3e, 0x26, 0x3a, 0x9b, 0x5b, 0x49, 0x65,
+	0xdf, 0x14, 0xae, 0xdf, 0x7d, 0xda, 0xb5, 0x4f, 0xbb, 0x1a, 0x97, 0xa8, 0xb0, 0xce, 0x86, 0x20,
+	0xb6, 0x99, 0xe0, 0x22, 0x78, 0x6d, 0x8d, 0x01, 0x54, 0x19, 0x4f, 0x5d, 0xd0, 0xd6, 0x29, 0xce,
+	0xf3, 0x50, 0x39, 0x36, 0xe9, 0x1d, 0xeb, 0xac, 0x55, 0x4b, 0xfc, 0x85, 0x43, 0x01, 0x48, 0xf5,
+	0xd8, 0x94, 0x1d, 0x68, 0x3d, 0x76, 0xfa, 0x2e, 0xc1, 0x86, 0x7f, 0xcc, 0x70, 0x8b, 0x47, 0xf0,
+	0x3f, 0x4b, 0x30, 0x93, 0x02, 0x27, 0x22, 0xca, 0x87, 0x80, 0x08, 0xef, 0xd4, 0xfc, 0xe0, 0xe8,
+	0x8a, 0xe3, 0xc2, 0xdd, 0x10, 0x76, 0x26, 0xc2, 0x2a, 0x8d, 0xad, 0x4f, 0xd4, 0x1d, 0x75, 0x9c,
+	0xc4, 0x59, 0xe4, 0x1d, 0xa8, 0x88, 0x5e, 0x74, 0x07, 0x2a, 0x14, 0x47, 0x13, 0xff, 0xcb, 0x64,
+	0x6c, 0x2e, 0xd3, 0xee, 0x6d, 0x83, 0xfe, 0xd2, 0x74, 0xc3, 0xf0, 0xcf, 0x10, 0x35, 0xd5, 0x6b,
+	0x2a, 0x1b, 0xd0, 0x7c, 0xd4, 0xc3, 0x8e, 0x4e, 0x6c, 0xa7, 0xb8, 0x37, 0x4c, 0x18, 0x0b, 0x40,
+	0x84, 0x0f, 0x26, 0x60, 0x18, 0x77, 0x74, 0xd3, 0x12, 0xff, 0x50, 0xde, 0xa0, 0x3f, 0xf8, 0x53,
+	0xdd, 0xb2, 0x30, 0x11, 0x7a, 0x88, 0x16, 0xba, 0x03, 0x4d, 0xfe, 0xa5, 0x1d, 0x62, 0x9d, 0xf4,
+	0x1d, 0x76, 0x07, 0x2e, 0x2d, 0xd7, 0xd4, 0x51, 0x4e, 0x7e, 0x53, 0x50, 0x95, 0x2f, 0x24, 0x98,
+	0xdf, 0x74, 0x89, 0xd9, 0xa1, 0xdb, 0x6d, 0x57, 0x3f, 0xb3, 0xfb, 0xe4, 0x6a, 0x0e, 0xad, 0xdf,
+	0x87, 0x85, 0x6c, 0x3d, 0x84, 0x0f, 0xee, 0x01, 0xc2, 0x1e, 0x8f, 0x86, 0x75, 0xa7, 0x6b, 0x76,
+	0x8f, 0x5c, 0x71, 0xb4, 0x19, 0xf7, 0x7b, 0x36, 0x45, 0x87, 0xf2, 0x36, 0x4c, 0xc5, 0x20, 0x8b,
+	0x4f, 0xc9, 0x16, 0x4c, 0x27, 0xb0, 0x8a, 0x69, 0xb5, 0x0e, 0xa3, 0x03, 0xdf, 0x49, 0xb6, 0xa1,
+	0x19, 0xbf, 0x8c, 0xbc, 0x0c, 0xf5, 0x1e, 0xd3, 0x4b, 0x33, 0xbb, 0x87, 0xb6, 0x40, 0x9a, 0x0c,
+	0x21, 0x71, 0xad, 0xb7, 0xbb, 0x87, 0xb6, 0x0a, 0x3d, 0xff, 0x5b, 0xf9, 0x18, 0x26, 0x04, 0xd4,
+	0x2e, 0x76, 0x4c, 0xdb, 0x28, 0x3e, 0xe9, 0x53, 0x50, 0xee, 0x31, 0x08, 0x6f, 0x2d, 0xf2, 0x96,
+	0xf2, 0x08, 0x26, 0x63, 0x23, 0x0c, 0xa8, 0xf2, 0xe7, 0x30, 0x7d, 0xa5, 0x37, 0x53, 0x15, 0x5a,
+	0x99, 0x57, 0xd2, 0xa2, 0x36, 0xfd, 0x8a, 0xa6, 0xcc, 0x62, 0xa0, 0x83, 0x4e, 0x48, 0x81, 0x4c,
+	0x61, 0x30, 0x87, 0xa5, 0xc8, 0x1c, 0xbe, 0x0f, 0x73, 0x59, 0xda, 0x0d, 0x68, 0x78, 0x1b, 0x46,
+	0xe8, 0xd6, 0xc0, 0xc5, 0xed, 0x54, 0x6e, 0xc3, 0xa8, 0x07, 0x11, 0x04, 0xcb, 0x20, 0xd3, 0x5e,
+	0x52, 0x79, 0x83, 0xc5, 0x03, 0xc6, 0x37, 0xf8, 0xb2, 0x51, 0x3e, 0x86, 0xe9, 0x04, 0x96, 0x18,
+	0x7c, 0x13, 0xc6, 0x30, 0xeb, 0x0a, 0x7e, 0x56, 0xe2, 0x5f, 0x25, 0x87, 0x6f, 0xa5, 0x31, 0xe9,
+	0x26, 0x8e, 0x12, 0x94, 0x0f, 0xa0, 0x19, 0xe3, 0x49, 0x37, 0xab, 0xc8, 0x0a, 0xde, 0x82, 0x89,
+	0x27, 0x5d, 0xc3, 0x74, 0x89, 0x63, 0xee, 0xf7, 0xc9, 0x20, 0xbe, 0xbf, 0x07, 0x93, 0x31, 0xa4,
+	0xdc, 0x29, 0xf8, 0x1c, 0xa6, 0x77, 0xf5, 0x33, 0x97, 0xf4, 0xf7, 0xaf, 0x66, 0xeb, 0x6e, 0x41,
+	0x2b, 0x39, 0xbe, 0xd0, 0xf8, 0x2e, 0x54, 0x7a, 0xbc, 0xaf, 0x25, 0x25, 0x12, 0x03, 0x42, 0x4a,
+	0xf5, 0x58, 0x68, 0x18, 0xf7, 0x68, 0x85, 0x9d, 0xf7, 0x3d, 0x68, 0xfa, 0x18, 0x85, 0x94, 0xf8,
+	0x18, 0x26, 0x04, 0xed, 0x9b, 0x0a, 0xde, 0x9b, 0x30, 0x19, 0x1b, 0xa1, 0x90, 0xa2, 0x34, 0xbc,
+	0xc5, 0x1d, 0xff, 0x2d, 0x0a, 0x6f, 0xef, 0xc2, 0x5c, 0x96, 0x76, 0x85, 0xcc, 0x7d, 0x09, 0x20,
+	0x08, 0x77, 0xf4, 0xe0, 0x7e, 0x8c, 0x2d, 0x3f, 0xe3, 0x4f, 0xbf, 0x29, 0xad, 0xa7, 0x0b, 0xa5,
+	0x4b, 0x2a, 0xfb, 0x56, 0x7e, 0x5e, 0x82, 0x8a, 0x80, 0xa2, 0x35, 0x43, 0x9e, 0x1b, 0x13, 0x85,
+	0x3c, 0xaf, 0x66, 0xc8, 0x88, 0x6d, 0x56, 0xc1, 0x43, 0x37, 0xa0, 0xc6, 0x79, 0x8e, 0xb0, 0x97,
+	0x18, 0xaa, 0x32, 0xc2, 0x5b, 0x98, 0xa0, 0x65, 0x18, 0xf3, 0x3b, 0x35, 0x91, 0x53, 0xe2, 0xd7,
+	0x91, 0x51, 0x8f, 0x47, 0x65, 0x54, 0x74, 0x1b, 0x9a, 0x01, 0x27, 0xbf, 0x7b, 0xf3, 0x4b, 0xc9,
+	0x88, 0xc7, 0xc8, 0x2f, 0x47, 0x0b, 0xd0, 0x38, 0xb0, 0x3b, 0x3d, 0x5f, 0x23, 0x5e, 0x14, 0x05,
+	0x4a, 0x13, 0x0a, 0xcd, 0x40, 0x95, 0x71, 0x50, 0x7d, 0x78, 0x55, 0xb4, 0x42, 0xdb, 0x54, 0x9d,
+	0xdb, 0xd0, 0xf4, 0xba, 0x3c, 0x6d, 0x2a, 0x7c, 0x10, 0xc1, 0x21, 0x94, 0xb9, 0x05, 0xa3, 0x3e,
+	0x1f, 0xd7, 0xa5, 0xca, 0x2f, 0x48, 0x82, 0x8d, 0xab, 0xe2, 0x79, 0xb4, 0x96, 0xe2, 0x51, 0x08,
+	0x3c, 0x8a, 0x16, 0xa0, 0x1e, 0x8a, 0x4d, 0xad, 0x3a, 0xeb, 0x0a, 0x93, 0x68, 0x21, 0xd7, 0x30,
+	0xdd, 0x9e, 0xed, 0x62, 0xa3, 0xd5, 0xe0, 0x2e, 0xf4, 0xda, 0xf4, 0x8a, 0xb3, 0x85, 0x2d, 0xa3,
+	0xdd, 0xa1, 0x97, 0xb2, 0x2d, 0x7e, 0xef, 0x29, 0xbe, 0xd9, 0xbf, 0x1a, 0x82, 0x99, 0x14, 0x38,
+	0xb1, 0xbe, 0x76, 0x83, 0x0b, 0x18, 0xff, 0x57, 0xbc, 0x1c, 0x02, 0xcc, 0x14, 0x4b, 0xe9, 0xf1,
+	0x60, 0xe4, 0xd7, 0x00, 0x82, 0xde, 0xd0, 0xca, 0x97, 0xc2, 0x2b, 0x9f, 0xd2, 0xf5, 0x8e, 0x9f,
+	0x01, 0x2a, 0xa9, 0xa2, 0x25, 0x7f, 0x29, 0xc1, 0x78, 0x02, 0x3c, 0xb1, 0xe5, 0xa4, 0xf3, 0xb7,
+	0x9c, 0x0a, 0x0d, 0x3a, 0x3d, 0x1a, 0xc7, 0xa5, 0xf7, 0x25, 0x6a, 0xdd, 0xfd, 0x4b, 0x5a, 0xa7,
+	0xd6, 0x8f, 0xfd, 0x6f, 0x57, 0x79, 0x04, 0x37, 0x62, 0x87, 0x71, 0x56, 0xcd, 0x2e, 0x3e, 0x37,
+	0x0f, 0x61, 0x36, 0x1d, 0xb0, 0xd8, 0x11, 0xff, 0x11, 0xdc, 0x68, 0x5b, 0x56, 0x70, 0xc7, 0x1c,
+	0xf8, 0xbc, 0xff, 0x1e, 0xcc, 0xa6, 0x03, 0x0e, 0x78, 0xf8, 0xea, 0xc0, 0x62, 0x04, 0x97, 0x07,
+	0xbd, 0x41, 0xd5, 0xcd, 0xfc, 0x99, 0xfc, 0x10, 0x94, 0xbc, 0xe1, 0x9e, 0xc1, 0xb5, 0xc0, 0x83,
+	0x1e, 0xd8, 0x84, 0x82, 0xd7, 0x82, 0xc4, 0xf8, 0xcf, 0xe2, 0x5a, 0x10, 0xfd, 0x25, 0x5d, 0x81,
+	0x69, 0xb9, 0xd7, 0x82, 0x0c, 0xed, 0x06, 0x34, 0xfc, 0x21, 0xcc, 0xf0, 0xd3, 0xef, 0x2e, 0x76,
+	0x9e, 0xc1, 0x71, 0xfd, 0x00, 0xe4, 0x34, 0xb8, 0x67, 0x7b, 0x62, 0x0f, 0x2f, 0xc0, 0x41, 0xcf,
+	0x86, 0x05, 0x0f, 0xb7, 0xc9, 0xf1, 0x0b, 0x9f, 0x2b, 0xd9, 0x74, 0x0e, 0x6c, 0x46, 0xde, 0xb9,
+	0x32, 0x3a, 0x42, 0xe1, 0x73, 0x65, 0x6c, 0x05, 0x5e, 0x81, 0xe7, 0xf3, 0xce, 0x95, 0x59, 0xda,
+	0x15, 0x32, 0x77, 0x03, 0x9a, 0xfe, 0x8b, 0x88, 0x1d, 0xb3, 0x63, 0x12, 0x97, 0xa6, 0x22, 0xbd,
+	0x72, 0xa6, 0x28, 0x42, 0x87, 0x4a, 0x97, 0x38, 0xa8, 0x73, 0x96, 0xbc, 0x02, 0xa8, 0xf2, 0x1b,
+	0x09, 0x1a, 0xd4, 0x88, 0x3d, 0x4c, 0x08, 0xfd, 0x5d, 0xa1, 0xe7, 0x61, 0xc2, 0x7f, 0x4b, 0xa7,
+	0x19, 0xa6, 0xfb, 0x54, 0x73, 0xe9, 0xe3, 0x3b, 0x81, 0x87, 0xfc, 0x3e, 0xff, 0x59, 0x5e, 0x66,
+	0xda, 0x71, 0x13, 0xc6, 0xf6, 0x3d, 0xfd, 0x34, 0x8b, 0x29, 0xe8, 0x97, 0x60, 0x02, 0xb3, 0x62,
+	0x26, 0xa8, 0xcd, 0xfd, 0x28, 0x81, 0x9a, 0xe9, 0x29, 0x57, 0x7c, 0xcb, 0xff, 0x52, 0x82, 0xb1,
+	0x00, 0x45, 0xb8, 0xfb, 0x45, 0xa8, 0xba, 0x82, 0x26, 0x80, 0xc2, 0x4f, 0x8e, 0xc2, 0x5e, 0x51,
+	0x7d, 0x46, 0x9a, 0xff, 0x77, 0x30, 0xab, 0xb7, 0x69, 0x0e, 0xfe, 0xb4, 0x6f, 0x3a, 0x98, 0x2f,
+	0x8a, 0xaa, 0xda, 0x14, 0x74, 0x55, 0x90, 0x69, 0xde, 0x95, 0x97, 0x2e, 0x5c, 0x8d, 0xba, 0xed,
+	0x14, 0xf3, 0x15, 0x51, 0x55, 0x47, 0x05, 0xb9, 0xcd, 0xa9, 0xca, 0xe7, 0x30, 0xf9, 0x84, 0x51,
+	0x06, 0x36, 0x34, 0x62, 0xd3, 0xd0, 0x05, 0x6d, 0x52, 0x3e, 0x83, 0xa9, 0xf8, 0xf8, 0xff, 0x1d,
+	0x17, 0xad, 0xfd, 0x78, 0x08, 0x2a, 0xe2, 0x6d, 0x17, 0x7a, 0x13, 0x6a, 0xc1, 0xa2, 0xba, 0x11,
+	0x1a, 0x26, 0xfe, 0x90, 0x54, 0x9e, 0x4d, 0xef, 0x14, 0x3a, 0x6f, 0xc1, 0x30, 0x7f, 0x19, 0x36,
+	0x97, 0xf5, 0x80, 0x4c, 0xc0, 0xcc, 0x67, 0xf6, 0x0b, 0xa4, 0x03, 0x18, 0x8d, 0x3e, 0x59, 0x43,
+	0x77, 0x32, 0x44, 0xe2, 0x7f, 0x25, 0x79, 0xf9, 0x7c, 0x46, 0x3e, 0xc8, 0xda, 0xdf, 0xcb, 0x50,
+	0xf3, 0x37, 0x01, 0xd2, 0xa1, 0x11, 0x7e, 0x28, 0x16, 0x19, 0x30, 0xef, 0x71, 0x9a, 0xbc, 0x7c,
+	0x3e, 0xa3, 0xb0, 0xea, 0x04, 0x66, 0x32, 0x5f, 0x75, 0xa1, 0xe7, 0xd2, 0x60, 0x32, 0x12, 0xac,
+	0xf2, 0xdd, 0x8b, 0x31, 0xfb, 0x85, 0x9b, 0xb1, 0x38, 0x13, 0x52, 0x72, 0x10, 0xbc, 0x51, 0x96,
+	0x72, 0x79, 0x04, 0x78, 0x07, 0xa6, 0xd2, 0x5f, 0x58, 0xa1, 0xe5, 0xc4, 0xeb, 0x8f, 0x2c, 0x73,
+	0x56, 0x2e, 0xc0, 0x29, 0x86, 0x53, 0x61, 0x24, 0xc2, 0x81, 0xe6, 0xb3, 0x64, 0x3d, 0xf0, 0x85,
+	0x6c, 0x06, 0x81, 0xd9, 0x83, 0xe9, 0x8c, 0x37, 0x4e, 0x68, 0x25, 0xf9, 0x2a, 0x25, 0xcb, 0x88,
+	0xff, 0xbb, 0x08, 0xab, 0x18, 0xf1, 0x09, 0x8c, 0x46, 0x59, 0xd0, 0x42, 0xa6, 0xb4, 0x87, 0xbf,
+	0x98, 0xc3, 0x11, 0xc0, 0x46, 0x9f, 0x1c, 0x45, 0x60, 0x53, 0xdf, 0x42, 0xc9, 0x8b, 0x39, 0x1c,
+	0x02, 0xf6, 0x55, 0x18, 0x66, 0x3d, 0x68, 0x3a, 0xce, 0xeb, 0x81, 0xb4, 0x92, 0x1d, 0x62, 0x93,
+	0x7d, 0x51, 0x82, 0x6b, 0x34, 0x5a, 0xa1, 0x37, 0xa0, 0x22, 0x9e, 0xa4, 0xa0, 0x99, 0x10, 0x77,
+	0xf4, 0xa9, 0x8b, 0x2c, 0xa7, 0x75, 0x09, 0x35, 0x76, 0xa0, 0x1e, 0x7a, 0x5f, 0x82, 0x6e, 0x86,
+	0x58, 0x93, 0xef, 0x57, 0xe4, 0xb9, 0xac, 0x6e, 0x81, 0xb6, 0x0d, 0x10, 0xbc, 0x64, 0x40, 0xb3,
+	0x19, 0x0f, 0x1c, 0x38, 0xd6, 0xcd, 0xdc, 0xe7, 0x0f, 0xe8, 0x23, 0x18, 0x4f, 0xd4, 0x3c, 0xd1,
+	0x52, 0x7e, 0x45, 0x94, 0x03, 0xdf, 0xba, 0x48, 0xd9, 0x14, 0x6d, 0x40, 0xd5, 0x2b, 0x44, 0xa2,
+	0xb0, 0x83, 0x62, 0x25, 0x4e, 0xf9, 0x46, 0x6a, 0x9f, 0x98, 0x88, 0x7f, 0xd4, 0x58, 0x5a, 0xcb,
+	0xee, 0x13, 0x97, 0xce, 0x85, 0xb7, 0xee, 0xc2, 0x73, 0x11, 0x5b, 0x70, 0x72, 0x5a, 0x57, 0xb0,
+	0x0d, 0x23, 0xd5, 0xa4, 0xc8, 0x36, 0x4c, 0xab, 0x64, 0xc9, 0x0b, 0xd9, 0x0c, 0x41, 0x98, 0x4a,
+	0xec, 0x3f, 0x25, 0x29, 0x95, 0x58, 0xc1, 0x4b, 0xb9, 0x3c, 0x41, 0x98, 0x4a, 0x2f, 0x9d, 0x44,
+	0xc2, 0x54, 0x6e, 0xed, 0x47, 0x5e, 0xb9, 0x00, 0xa7, 0x18, 0xee, 0x75, 0x28, 0xf3, 0x8b, 0x0a,
+	0x6a, 0x25, 0xee, 0x2e, 0x1e, 0xdc, 0x4c, 0x4a, 0x8f, 0x10, 0x7f, 0x3f, 0x59, 0x75, 0x58, 0xcc,
+	0xb9, 0x03, 0x09, 0x40, 0x25, 0x8f, 0x45, 0x20, 0xbb, 0xd0, 0xca, 0x2a, 0xf0, 0xa2, 0x70, 0x04,
+	0x3b, 0xa7, 0x1a, 0x2d, 0x3f, 0x77, 0x21, 0xde, 0x90, 0x39, 0x51, 0x9e, 0xa8, 0x39, 0xa9, 0xe5,
+	0x61, 0x59, 0xc9, 0x63, 0x09, 0xd6, 0x61, 0xa4, 0xf0, 0x11, 0x59, 0x87, 0x69, 0xc5, 0x15, 0x79,
+	0x21, 0x9b, 0x21, 0x58, 0x87, 0xf1, 0x34, 0x74, 0x64, 0x1d, 0x66, 0x94, 0x4e, 0xe4, 0xa5, 0x5c,
+	0x1e, 0x01, 0xfe, 0x46, 0x90, 0x5c, 0x9e, 0x49, 0xf2, 0xa7, 0x6d, 0xbd, 0xf8, 0x5d, 0x45, 0x85,
+	0x91, 0x48, 0x2d, 0x20, 0x62, 0x72, 0x5a, 0x1d, 0x42, 0x5e, 0xc8, 0x66, 0x08, 0x76, 0x47, 0x7a,
+	0xe6, 0x3d, 0xb2, 0x3b, 0x72, 0x4b, 0x07, 0xf2, 0xca, 0x05, 0x38, 0x83, 0x80, 0x99, 0xcc, 0x6a,
+	0x2e, 0xe5, 0x27, 0x23, 0x93, 0x01, 0x33, 0x33, 0x63, 0xb9, 0xf6, 0xcf, 0x1a, 0x94, 0xc5, 0x3a,
+	0x3b, 0x82, 0x89, 0xb4, 0x9c, 0x1d, 0xba, 0x1d, 0x7e, 0x59, 0x93, 0x9d, 0x25, 0x94, 0xef, 0x9c,
+	0xcb, 0x27, 0x6c, 0x3a, 0x03, 0x39, 0x3b, 0xab, 0x86, 0xee, 0x66, 0xc1, 0xa4, 0x65, 0x93, 0xe4,
+	0x7b, 0x17, 0xe4, 0x0e, 0x05, 0xce, 0x58, 0xca, 0x2b, 0x1a, 0x38, 0xd3, 0xf3, 0x71, 0xf2, 0x52,
+	0x2e, 0x4f, 0x28, 0x70, 0xa6, 0x26, 0x97, 0xa2, 0x81, 0x33, 0x2f, 0x3b, 0x26, 0xaf, 0x5c, 0x80,
+	0xf3, 0xd9, 0x04, 0x4e, 0x1d, 0x50, 0x32, 0xc3, 0x84, 0x6e, 0x25, 0x04, 0x52, 0xf2, 0x59, 0xf2,
+	0xff, 0x9e, 0xc3, 0x75, 0x95, 0x11, 0xf4, 0x08, 0x26, 0xd2, 0x52, 0xe3, 0x91, 0x65, 0x9c, 0x93,
+	0x8c, 0x97, 0xef, 0x9c, 0xcb, 0xf7, 0xcd, 0x06, 0xd4, 0x78, 0x46, 0x2c, 0x7d, 0x7d, 0xc6, 0xa2,
+	0xe0, 0x52, 0x2e, 0xcf, 0x33, 0x0d, 0xa8, 0xe1, 0xac, 0x50, 0x34, 0xa0, 0xa6, 0x64, 0xb3, 0xe4,
+	0x85, 0x6c, 0x86, 0xcc, 0x5d, 0xe3, 0x81, 0xe7, 0xec, 0x9a, 0xd8, 0x28, 0x2b, 0x17, 0xe0, 0x14,
+	0x01, 0xef, 0xb7, 0x12, 0x54, 0xfd, 0x44, 0xd2, 0x46, 0xe8, 0x3b, 0x72, 0x86, 0x8b, 0xe6, 0x38,
+	0xe4, 0x1b, 0xa9, 0x7d, 0xc1, 0x55, 0x22, 0x9a, 0x99, 0x88, 0x5c, 0x25, 0x52, 0x93, 0x26, 0xf2,
+	0x62, 0x0e, 0x07, 0x87, 0x5d, 0xbf, 0xf5, 0x81, 0x42, 0x43, 0xf5, 0x27, 0xab, 0xa6, 0x7d, 0x9f,
+	0x7d, 0xdc, 0xef, 0x39, 0xe6, 0x89, 0x4e, 0xf0, 0x7d, 0x5f, 0xb4, 0xb7, 0xbf, 0x5f, 0x66, 0x4f,
+	0x4a, 0x5f, 0xfc, 0xcf, 0x00, 0x74, 0x6d, 0x26, 0x55, 0x3f, 0x3b, 0x00, 0x00,
 }
@@ -1973,3 +1973,118 @@ func (x *drpcPayout_SatellitePeriodPaystubStream) SendAndClose(m *SatellitePerio
 	}
 	return x.CloseSend()
 }
+
+type DRPCSettingsClient interface {
+	DRPCConn() drpc.Conn
+
+	Settings(ctx context.Context, in *SettingsRequest) (*SettingsResponse, error)
+	UpdateSettings(ctx context.Context, in *UpdateSettingsRequest) (*UpdateSettingsResponse, error)
+}
+
+type drpcSettingsClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCSettingsClient(cc drpc.Conn) DRPCSettingsClient {
+	return &drpcSettingsClient{cc}
+}
+
+func (c *drpcSettingsClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcSettingsClient) Settings(ctx context.Context, in *SettingsRequest) (*SettingsResponse, error) {
+	out := new(SettingsResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Settings/Settings", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcSettingsClient) UpdateSettings(ctx context.Context, in *UpdateSettingsRequest) (*UpdateSettingsResponse, error) {
+	out := new(UpdateSettingsResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Settings/UpdateSettings", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCSettingsServer interface {
+	Settings(context.Context, *SettingsRequest) (*SettingsResponse, error)
+	UpdateSettings(context.Context, *UpdateSettingsRequest) (*UpdateSettingsResponse, error)
+}
+
+type DRPCSettingsUnimplementedServer struct{}
+
+func (s *DRPCSettingsUnimplementedServer) Settings(context.Context, *SettingsRequest) (*SettingsResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCSettingsUnimplementedServer) UpdateSettings(context.Context, *UpdateSettingsRequest) (*UpdateSettingsResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCSettingsDescription struct{}
+
+func (DRPCSettingsDescription) NumMethods() int { return 2 }
+
+func (DRPCSettingsDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/multinode.Settings/Settings", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCSettingsServer).
+					Settings(
+						ctx,
+						in1.(*SettingsRequest),
+					)
+			}, DRPCSettingsServer.Settings, true
+	case 1:
+		return "/multinode.Settings/UpdateSettings", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCSettingsServer).
+					UpdateSettings(
+						ctx,
+						in1.(*UpdateSettingsRequest),
+					)
+			}, DRPCSettingsServer.UpdateSettings, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterSettings(mux drpc.Mux, impl DRPCSettingsServer) error {
+	return mux.Register(impl, DRPCSettingsDescription{})
+}
+
+type DRPCSettings_SettingsStream interface {
+	drpc.Stream
+	SendAndClose(*SettingsResponse) error
+}
+
+type drpcSettings_SettingsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcSettings_SettingsStream) SendAndClose(m *SettingsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCSettings_UpdateSettingsStream interface {
+	drpc.Stream
+	SendAndClose(*UpdateSettingsResponse) error
+}
+
+type drpcSettings_UpdateSettingsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcSettings_UpdateSettingsStream) SendAndClose(m *UpdateSettingsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
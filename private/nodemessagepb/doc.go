@@ -0,0 +1,7 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package nodemessagepb contains protobuf definitions for the messages of the satellite operators to the storage node operators.
+package nodemessagepb
+
+//go:generate go run gen.go
@@ -0,0 +1,66 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"storj.io/common/lrucache"
+)
+
+// Local is a limiter, which keeps the token buckets in memory, so the limits are enforced
+// by every process on its own.
+type Local struct {
+	buckets *lrucache.ExpiringLRUOf[*localBucket]
+}
+
+// localBucket is the token bucket of a key.
+type localBucket struct {
+	limit   Limit
+	limiter *rate.Limiter
+}
+
+var _ Limiter = (*Local)(nil)
+
+// NewLocal returns a local limiter, which tracks up to capacity keys for the expiration.
+func NewLocal(capacity int, expiration time.Duration) *Local {
+	return &Local{
+		buckets: lrucache.NewOf[*localBucket](lrucache.Options{
+			Capacity:   capacity,
+			Expiration: expiration,
+			Name:       "ratelimit-local",
+		}),
+	}
+}
+
+// Allow reports whether an event for the key may happen now within the limit.
+func (local *Local) Allow(ctx context.Context, key string, limit Limit) (bool, error) {
+	bucket, err := local.buckets.Get(ctx, key, func() (*localBucket, error) {
+		return newLocalBucket(limit), nil
+	})
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+
+	// the limit of the key has changed, e.g. the limits of a project were updated.
+	if bucket.limit != limit {
+		bucket = newLocalBucket(limit)
+		local.buckets.Add(ctx, key, bucket)
+	}
+
+	return bucket.limiter.Allow(), nil
+}
+
+// Close releases the resources of the limiter.
+func (local *Local) Close() error { return nil }
+
+func newLocalBucket(limit Limit) *localBucket {
+	return &localBucket{
+		limit:   limit,
+		limiter: rate.NewLimiter(rate.Limit(limit.Rate), limit.Burst),
+	}
+}
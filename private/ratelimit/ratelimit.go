@@ -0,0 +1,66 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package ratelimit implements token bucket rate limiters, which can be shared by the
+// replicas of a service.
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for the rate limiters.
+	Error = errs.Class("ratelimit")
+)
+
+// Limit is the limit of a token bucket.
+type Limit struct {
+	// Rate is the number of events allowed per second.
+	Rate float64
+	// Burst is the maximum number of events allowed at once.
+	Burst int
+}
+
+// Limiter decides whether the events are allowed.
+type Limiter interface {
+	// Allow reports whether an event for the key may happen now within the limit.
+	Allow(ctx context.Context, key string, limit Limit) (bool, error)
+	// Close releases the resources of the limiter.
+	Close() error
+}
+
+// Config configures the rate limiter.
+type Config struct {
+	Address         string        `help:"redis url of the rate limiter shared by the replicas, e.g. redis://127.0.0.1:6379?db=2, when empty every process enforces the limits on its own" default:""`
+	LocalCapacity   int           `help:"number of keys tracked by the local rate limiter" default:"10000" testDefault:"100"`
+	LocalExpiration time.Duration `help:"how long the keys are tracked by the local rate limiter" default:"10m" testDefault:"1m"`
+}
+
+// Shared returns whether the limits are shared by the replicas.
+func (config Config) Shared() bool {
+	return config.Address != ""
+}
+
+// New returns the limiter configured by config. The shared limiter falls back to the local
+// one, when Redis isn't available.
+func New(log *zap.Logger, config Config) (Limiter, error) {
+	local := NewLocal(config.LocalCapacity, config.LocalExpiration)
+	if !config.Shared() {
+		return local, nil
+	}
+
+	opts, err := redis.ParseURL(config.Address)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return NewRedis(log, redis.NewClient(opts), local), nil
+}
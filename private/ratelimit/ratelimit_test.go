@@ -0,0 +1,101 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/ratelimit"
+	"storj.io/storj/private/testredis"
+)
+
+func TestLocal(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	limiter := ratelimit.NewLocal(10, time.Hour)
+	defer ctx.Check(limiter.Close)
+
+	testBurst(ctx, t, limiter)
+
+	// a changed limit starts a new bucket.
+	allowed, err := limiter.Allow(ctx, "key", ratelimit.Limit{Rate: 0.001, Burst: 4})
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
+
+func TestShared(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	server, err := testredis.Mini(ctx)
+	require.NoError(t, err)
+	defer ctx.Check(server.Close)
+
+	config := ratelimit.Config{
+		Address:         "redis://" + server.Addr(),
+		LocalCapacity:   10,
+		LocalExpiration: time.Hour,
+	}
+
+	// two replicas share the limits.
+	first, err := ratelimit.New(zaptest.NewLogger(t), config)
+	require.NoError(t, err)
+	defer ctx.Check(first.Close)
+
+	second, err := ratelimit.New(zaptest.NewLogger(t), config)
+	require.NoError(t, err)
+	defer ctx.Check(second.Close)
+
+	testBurst(ctx, t, first)
+
+	limit := ratelimit.Limit{Rate: 0.001, Burst: 2}
+	for _, limiter := range []ratelimit.Limiter{first, second} {
+		allowed, err := limiter.Allow(ctx, "shared", limit)
+		require.NoError(t, err)
+		require.True(t, allowed)
+	}
+	for _, limiter := range []ratelimit.Limiter{first, second} {
+		allowed, err := limiter.Allow(ctx, "shared", limit)
+		require.NoError(t, err)
+		require.False(t, allowed)
+	}
+}
+
+func TestSharedFallback(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	server, err := testredis.Mini(ctx)
+	require.NoError(t, err)
+
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	limiter := ratelimit.NewRedis(zaptest.NewLogger(t), client, ratelimit.NewLocal(10, time.Hour))
+	defer ctx.Check(limiter.Close)
+
+	// redis isn't available, the local limiter enforces the limits.
+	require.NoError(t, server.Close())
+	testBurst(ctx, t, limiter)
+}
+
+func testBurst(ctx *testcontext.Context, t *testing.T, limiter ratelimit.Limiter) {
+	limit := ratelimit.Limit{Rate: 0.001, Burst: 3}
+	for i := 0; i < limit.Burst; i++ {
+		allowed, err := limiter.Allow(ctx, "key", limit)
+		require.NoError(t, err)
+		require.True(t, allowed)
+	}
+
+	allowed, err := limiter.Allow(ctx, "key", limit)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	// other keys have their own limits.
+	allowed, err = limiter.Allow(ctx, "other", limit)
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+)
+
+// tokenBucket takes a token from the bucket KEYS[1] with the rate ARGV[1] and the burst
+// ARGV[2], it returns 1 when the token was taken. The time of the Redis server is used, so
+// the clocks of the replicas don't need to be in sync. The bucket expires after ARGV[3]
+// milliseconds, when it's full again.
+var tokenBucket = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+
+local time = redis.call("TIME")
+local now = tonumber(time[1]) + tonumber(time[2]) / 1000000
+
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "updated")
+local tokens = tonumber(bucket[1])
+local updated = tonumber(bucket[2])
+if tokens == nil or updated == nil then
+	tokens = burst
+	updated = now
+end
+
+tokens = math.min(burst, tokens + math.max(0, now - updated) * rate)
+
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "updated", tostring(now))
+redis.call("PEXPIRE", KEYS[1], ARGV[3])
+
+return allowed
+`)
+
+// Redis is a limiter, which keeps the token buckets in Redis, so the limits are shared by
+// all the processes using the same Redis. When Redis fails, the fallback limiter is used.
+type Redis struct {
+	log      *zap.Logger
+	client   *redis.Client
+	fallback Limiter
+}
+
+var _ Limiter = (*Redis)(nil)
+
+// NewRedis returns a limiter, which keeps the token buckets in Redis.
+func NewRedis(log *zap.Logger, client *redis.Client, fallback Limiter) *Redis {
+	return &Redis{
+		log:      log,
+		client:   client,
+		fallback: fallback,
+	}
+}
+
+// Allow reports whether an event for the key may happen now within the limit.
+func (limiter *Redis) Allow(ctx context.Context, key string, limit Limit) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if math.IsInf(limit.Rate, 1) {
+		return true, nil
+	}
+
+	allowed, err := tokenBucket.Run(ctx, limiter.client, []string{"ratelimit:" + key},
+		limit.Rate, limit.Burst, expiration(limit).Milliseconds()).Int()
+	if err != nil {
+		mon.Event("ratelimit_redis_fallback")
+		limiter.log.Debug("shared rate limiter failed, using the local one", zap.Error(err))
+		return limiter.fallback.Allow(ctx, key, limit)
+	}
+	return allowed == 1, nil
+}
+
+// Close closes the connection to Redis and the fallback limiter.
+func (limiter *Redis) Close() error {
+	return errs.Combine(limiter.client.Close(), limiter.fallback.Close())
+}
+
+// expiration returns the duration after which an unused bucket is full again.
+func expiration(limit Limit) time.Duration {
+	if limit.Rate <= 0 {
+		return 24 * time.Hour
+	}
+	return time.Duration(math.Ceil(float64(limit.Burst)/limit.Rate*float64(time.Second))) + time.Second
+}
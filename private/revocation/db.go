@@ -4,6 +4,7 @@
 package revocation
 
 import (
+	"bytes"
 	"context"
 	"crypto/x509"
 	"crypto/x509/pkix"
@@ -24,13 +25,25 @@ var (
 	Error = errs.Class("revocation")
 )
 
+// caKeyPrefix prefixes the keys of the CA certificates, which signed the revocations.
+var caKeyPrefix = []byte("ca/")
+
 // DB stores the most recently seen revocation for each nodeID
 // (i.e. nodeID [CA certificate's public key hash] is the key, values is
 // the most recently seen revocation).
+//
+// The CA certificate, which signed the revocation, is stored next to it, so the
+// revocation can be verified by the peers it's propagated to.
 type DB struct {
 	store kvstore.Store
 }
 
+// Revoked is a revocation with the CA certificate, which signed it.
+type Revoked struct {
+	CA         *x509.Certificate
+	Revocation []byte
+}
+
 // Get attempts to retrieve the most recent revocation for the given cert chain
 // (the  key used in the underlying database is the nodeID of the certificate chain).
 func (db *DB) Get(ctx context.Context, chain []*x509.Certificate) (_ *extensions.Revocation, err error) {
@@ -66,13 +79,21 @@ func (db *DB) Get(ctx context.Context, chain []*x509.Certificate) (_ *extensions
 func (db *DB) Put(ctx context.Context, chain []*x509.Certificate, revExt pkix.Extension) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	return db.Add(ctx, chain[peertls.CAIndex], revExt.Value)
+}
+
+// Add stores the revocation signed by the CA IF the timestamp is newer than the
+// current value, like Put. It's used for the revocations, which were propagated
+// from other peers.
+func (db *DB) Add(ctx context.Context, ca *x509.Certificate, revocation []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	if db.store == nil {
 		return extensions.ErrRevocationDB.New("not supported")
 	}
 
-	ca := chain[peertls.CAIndex]
 	var rev extensions.Revocation
-	if err := rev.Unmarshal(revExt.Value); err != nil {
+	if err := rev.Unmarshal(revocation); err != nil {
 		return err
 	}
 
@@ -83,7 +104,7 @@ func (db *DB) Put(ctx context.Context, chain []*x509.Certificate, revExt pkix.Ex
 		return err
 	}
 
-	lastRev, err := db.Get(ctx, chain)
+	lastRev, err := db.Get(ctx, []*x509.Certificate{peertls.CAIndex: ca})
 	if err != nil {
 		return err
 	} else if lastRev != nil && lastRev.Timestamp >= rev.Timestamp {
@@ -94,7 +115,10 @@ func (db *DB) Put(ctx context.Context, chain []*x509.Certificate, revExt pkix.Ex
 	if err != nil {
 		return extensions.ErrRevocationDB.Wrap(err)
 	}
-	if err := db.store.Put(ctx, nodeID.Bytes(), revExt.Value); err != nil {
+	if err := db.store.Put(ctx, caKey(nodeID.Bytes()), ca.Raw); err != nil {
+		return extensions.ErrRevocationDB.Wrap(err)
+	}
+	if err := db.store.Put(ctx, nodeID.Bytes(), revocation); err != nil {
 		return extensions.ErrRevocationDB.Wrap(err)
 	}
 	return nil
@@ -109,6 +133,10 @@ func (db *DB) List(ctx context.Context) (revs []*extensions.Revocation, err erro
 	}
 
 	err = db.store.Range(ctx, func(ctx context.Context, key kvstore.Key, value kvstore.Value) error {
+		if bytes.HasPrefix(key, caKeyPrefix) {
+			return nil
+		}
+
 		rev := new(extensions.Revocation)
 		if err := rev.Unmarshal(value); err != nil {
 			return extensions.ErrRevocationDB.Wrap(err)
@@ -120,6 +148,54 @@ func (db *DB) List(ctx context.Context) (revs []*extensions.Revocation, err erro
 	return revs, extensions.ErrRevocationDB.Wrap(err)
 }
 
+// ListRevoked lists the revocations in the store, which have the CA certificate
+// that signed them. The revocations stored before the CA certificates were are
+// skipped.
+func (db *DB) ListRevoked(ctx context.Context) (revoked []Revoked, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if db.store == nil {
+		return nil, nil
+	}
+
+	revocations := map[string][]byte{}
+	cas := map[string][]byte{}
+	err = db.store.Range(ctx, func(ctx context.Context, key kvstore.Key, value kvstore.Value) error {
+		if bytes.HasPrefix(key, caKeyPrefix) {
+			cas[string(key[len(caKeyPrefix):])] = append([]byte{}, value...)
+		} else {
+			revocations[string(key)] = append([]byte{}, value...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, extensions.ErrRevocationDB.Wrap(err)
+	}
+
+	for key, revocation := range revocations {
+		caBytes, ok := cas[key]
+		if !ok {
+			continue
+		}
+
+		ca, err := x509.ParseCertificate(caBytes)
+		if err != nil {
+			return nil, extensions.ErrRevocationDB.Wrap(err)
+		}
+
+		revoked = append(revoked, Revoked{
+			CA:         ca,
+			Revocation: revocation,
+		})
+	}
+	return revoked, nil
+}
+
+// caKey returns the key of the CA certificate, which signed the revocation with the key.
+func caKey(key kvstore.Key) kvstore.Key {
+	return append(append(kvstore.Key{}, caKeyPrefix...), key...)
+}
+
 // TestGetStore returns the internal store for testing.
 func (db *DB) TestGetStore() kvstore.Store {
 	return db.store
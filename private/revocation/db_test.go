@@ -19,6 +19,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/storj/private/kvstore"
+	"storj.io/storj/private/revocation"
 	"storj.io/storj/private/testrevocation"
 )
 
@@ -196,3 +197,51 @@ func TestRevocationDB_List(t *testing.T) {
 		}
 	})
 }
+
+func TestRevocationDB_ListRevoked(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	testrevocation.RunDBs(t, func(t *testing.T, revDB extensions.RevocationDB, db kvstore.Store) {
+		keys, chain, err := testpeertls.NewCertChain(2, storj.LatestIDVersion().Number)
+		require.NoError(t, err)
+
+		ext, err := extensions.NewRevocationExt(keys[peertls.CAIndex], chain[peertls.LeafIndex])
+		require.NoError(t, err)
+		require.NoError(t, revDB.Put(ctx, chain, ext))
+
+		// a revocation stored without its CA certificate isn't listed.
+		_, legacyChain, err := testpeertls.NewCertChain(2, storj.LatestIDVersion().Number)
+		require.NoError(t, err)
+		legacyID, err := identity.NodeIDFromCert(legacyChain[peertls.CAIndex])
+		require.NoError(t, err)
+		require.NoError(t, db.Put(ctx, legacyID.Bytes(), ext.Value))
+
+		revs, err := revDB.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, revs, 2)
+
+		revoked, err := revDB.(*revocation.DB).ListRevoked(ctx)
+		require.NoError(t, err)
+		require.Len(t, revoked, 1)
+		require.Equal(t, chain[peertls.CAIndex].Raw, revoked[0].CA.Raw)
+		require.Equal(t, ext.Value, revoked[0].Revocation)
+
+		// the listed revocations can be added to another database.
+		other, err := revocation.OpenDB(ctx, "bolt://"+ctx.File("other.db"))
+		require.NoError(t, err)
+		defer ctx.Check(other.Close)
+
+		require.NoError(t, other.Add(ctx, revoked[0].CA, revoked[0].Revocation))
+		err = other.Add(ctx, revoked[0].CA, revoked[0].Revocation)
+		require.ErrorIs(t, err, extensions.ErrRevocationTimestamp)
+
+		// the revocation must be signed by the CA.
+		err = other.Add(ctx, legacyChain[peertls.CAIndex], revoked[0].Revocation)
+		require.Error(t, err)
+
+		rev, err := other.Get(ctx, chain)
+		require.NoError(t, err)
+		require.NotNil(t, rev)
+	})
+}
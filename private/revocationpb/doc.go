@@ -0,0 +1,7 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package revocationpb contains protobuf definitions for the propagation of identity revocations.
+package revocationpb
+
+//go:generate go run gen.go
@@ -5,6 +5,7 @@ package server
 
 import (
 	"fmt"
+	"net"
 	"os"
 	"strconv"
 	"strings"
@@ -12,15 +13,36 @@ import (
 	"syscall"
 
 	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
 )
 
 const tcpFastOpen = 0x17
 const tcpFastOpenSysctlPath = "/proc/sys/net/ipv4/tcp_fastopen"
 
+// tcpiOptSynData is the TCP_INFO option, which is set when the SYN of the connection carried data.
+const tcpiOptSynData = 0x20
+
 func setTCPFastOpen(fd uintptr, queue int) error {
 	return syscall.SetsockoptInt(int(fd), syscall.IPPROTO_TCP, tcpFastOpen, queue)
 }
 
+// usedFastOpen returns whether the client sent data with the SYN of the connection.
+func usedFastOpen(conn *net.TCPConn) bool {
+	raw, err := conn.SyscallConn()
+	if err != nil {
+		return false
+	}
+
+	var info *unix.TCPInfo
+	controlErr := raw.Control(func(fd uintptr) {
+		info, err = unix.GetsockoptTCPInfo(int(fd), unix.IPPROTO_TCP, unix.TCP_INFO)
+	})
+	if controlErr != nil || err != nil {
+		return false
+	}
+	return info.Options&tcpiOptSynData != 0
+}
+
 var tryInitFastOpenOnce sync.Once
 
 func tryInitFastOpen(log *zap.Logger) {
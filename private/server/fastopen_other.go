@@ -7,9 +7,13 @@
 package server
 
 import (
+	"net"
+
 	"go.uber.org/zap"
 )
 
 func setTCPFastOpen(fd uintptr, queue int) error { return nil }
 
 func tryInitFastOpen(*zap.Logger) {}
+
+func usedFastOpen(*net.TCPConn) bool { return false }
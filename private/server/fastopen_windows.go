@@ -4,6 +4,7 @@
 package server
 
 import (
+	"net"
 	"syscall"
 
 	"go.uber.org/zap"
@@ -21,3 +22,6 @@ func tryInitFastOpen(*zap.Logger) {
 	// netsh int tcp set global fastopenfallback=disabled
 	// ?
 }
+
+// usedFastOpen isn't known on windows.
+func usedFastOpen(*net.TCPConn) bool { return false }
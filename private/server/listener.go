@@ -4,7 +4,9 @@
 package server
 
 import (
+	"crypto/tls"
 	"net"
+	"sync"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -19,13 +21,14 @@ import (
 const defaultUserTimeout = 60 * time.Second
 
 // wrapListener wraps the provided net.Listener in one that sets timeouts
-// and monitors if the returned connections are closed or leaked.
-func wrapListener(lis net.Listener) net.Listener {
+// and monitors if the returned connections are closed or leaked. The accepted
+// function is called for every accepted connection, when it's not nil.
+func wrapListener(lis net.Listener, accepted func(transport string, fastOpen bool)) net.Listener {
 	if lis, ok := lis.(*net.TCPListener); ok {
-		return newTCPUserTimeoutListener(lis)
+		return newTCPUserTimeoutListener(lis, accepted)
 	}
 	if lis, ok := lis.(*quic.Listener); ok {
-		return newQUICTrackedListener(lis)
+		return newQUICTrackedListener(lis, accepted)
 	}
 	return lis
 }
@@ -33,12 +36,13 @@ func wrapListener(lis net.Listener) net.Listener {
 // tcpUserTimeoutListener wraps a tcp listener so that it sets the TCP_USER_TIMEOUT
 // value for each socket it returns.
 type tcpUserTimeoutListener struct {
-	lis *net.TCPListener
+	lis      *net.TCPListener
+	accepted func(transport string, fastOpen bool)
 }
 
 // newTCPUserTimeoutListener wraps the tcp listener in a userTimeoutListener.
-func newTCPUserTimeoutListener(lis *net.TCPListener) *tcpUserTimeoutListener {
-	return &tcpUserTimeoutListener{lis: lis}
+func newTCPUserTimeoutListener(lis *net.TCPListener, accepted func(transport string, fastOpen bool)) *tcpUserTimeoutListener {
+	return &tcpUserTimeoutListener{lis: lis, accepted: accepted}
 }
 
 // Accept waits for and returns the next connection to the listener.
@@ -48,6 +52,13 @@ func (lis *tcpUserTimeoutListener) Accept() (net.Conn, error) {
 		return nil, err
 	}
 	mon.Event("incoming_connection", monkit.NewSeriesTag("kind", "tcp"))
+	if lis.accepted != nil {
+		fastOpen := usedFastOpen(conn)
+		if fastOpen {
+			mon.Event("incoming_connection_fast_open")
+		}
+		lis.accepted(TransportTCP, fastOpen)
+	}
 
 	if err := netutil.SetUserTimeout(conn, defaultUserTimeout); err != nil {
 		return nil, errs.Combine(err, conn.Close())
@@ -67,11 +78,12 @@ func (lis *tcpUserTimeoutListener) Addr() net.Addr {
 }
 
 type quicTrackedListener struct {
-	lis *quic.Listener
+	lis      *quic.Listener
+	accepted func(transport string, fastOpen bool)
 }
 
-func newQUICTrackedListener(lis *quic.Listener) *quicTrackedListener {
-	return &quicTrackedListener{lis: lis}
+func newQUICTrackedListener(lis *quic.Listener, accepted func(transport string, fastOpen bool)) *quicTrackedListener {
+	return &quicTrackedListener{lis: lis, accepted: accepted}
 }
 
 func (lis *quicTrackedListener) Accept() (net.Conn, error) {
@@ -80,6 +92,9 @@ func (lis *quicTrackedListener) Accept() (net.Conn, error) {
 		return nil, err
 	}
 	mon.Event("incoming_connection", monkit.NewSeriesTag("kind", "quic"))
+	if lis.accepted != nil {
+		lis.accepted(TransportQUIC, false)
+	}
 
 	connectorConn, ok := conn.(rpc.ConnectorConn)
 	if !ok {
@@ -99,3 +114,53 @@ func (lis *quicTrackedListener) Close() error {
 func (lis *quicTrackedListener) Addr() net.Addr {
 	return lis.lis.Addr()
 }
+
+// handshakeListener wraps a tls listener, so that the duration of the handshake of
+// every connection is reported to the observer.
+type handshakeListener struct {
+	net.Listener
+	transport string
+	observer  ConnectionObserver
+}
+
+// Accept waits for and returns the next connection to the listener.
+func (lis *handshakeListener) Accept() (net.Conn, error) {
+	conn, err := lis.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	tlsConn, ok := conn.(*tls.Conn)
+	if !ok {
+		return conn, nil
+	}
+	return &handshakeConn{Conn: tlsConn, transport: lis.transport, observer: lis.observer}, nil
+}
+
+// handshakeConn runs the tls handshake on the first read or write, and reports its
+// duration. The handshake would run there anyway.
+type handshakeConn struct {
+	*tls.Conn
+	transport string
+	observer  ConnectionObserver
+	once      sync.Once
+}
+
+func (conn *handshakeConn) handshake() {
+	conn.once.Do(func() {
+		start := time.Now()
+		err := conn.Conn.Handshake()
+		conn.observer.Handshake(conn.transport, time.Since(start), err)
+	})
+}
+
+// Read reads data from the connection.
+func (conn *handshakeConn) Read(p []byte) (int, error) {
+	conn.handshake()
+	return conn.Conn.Read(p)
+}
+
+// Write writes data to the connection.
+func (conn *handshakeConn) Write(p []byte) (int, error) {
+	conn.handshake()
+	return conn.Conn.Write(p)
+}
@@ -86,12 +86,37 @@ type Server struct {
 	// http fallback for the public endpoint
 	publicHTTP http.HandlerFunc
 
+	observer ConnectionObserver
+
 	mu   sync.Mutex
 	wg   sync.WaitGroup
 	once sync.Once
 	done chan struct{}
 }
 
+const (
+	// TransportTCP is the name of the tcp transport.
+	TransportTCP = "tcp"
+	// TransportQUIC is the name of the quic transport.
+	TransportQUIC = "quic"
+)
+
+// ConnectionObserver is notified about the public connections of the server.
+type ConnectionObserver interface {
+	// Accepted is called for every accepted connection. fastOpen is whether the
+	// client sent data with the SYN of a tcp connection.
+	Accepted(transport string, fastOpen bool)
+	// Handshake is called when the tls handshake of a tcp connection finished.
+	Handshake(transport string, duration time.Duration, err error)
+}
+
+// RequestObserver is notified about the requests handled by the public endpoints.
+type RequestObserver interface {
+	// Handled is called for every handled request with the error returned by
+	// the endpoint.
+	Handled(rpc string, err error)
+}
+
 // New creates a Server out of an Identity, a net.Listener,
 // and interceptors.
 func New(log *zap.Logger, tlsOptions *tlsopts.Options, config Config) (_ *Server, err error) {
@@ -134,7 +159,7 @@ func New(log *zap.Logger, tlsOptions *tlsopts.Options, config Config) (_ *Server
 				return nil, err
 			}
 			addr = publicTCPListener.Addr().String()
-			server.publicTCPListener = wrapListener(publicTCPListener)
+			server.publicTCPListener = wrapListener(publicTCPListener, server.accepted)
 		}
 
 		if !config.DisableQUIC {
@@ -181,7 +206,7 @@ func New(log *zap.Logger, tlsOptions *tlsopts.Options, config Config) (_ *Server
 	if err != nil {
 		return nil, errs.Combine(err, server.Close())
 	}
-	server.privateTCPListener = wrapListener(privateTCPListener)
+	server.privateTCPListener = wrapListener(privateTCPListener, nil)
 
 	return server, nil
 }
@@ -260,6 +285,26 @@ func (p *Server) Close() error {
 	return nil
 }
 
+// SetConnectionObserver sets the observer of the public connections. It must be
+// called before Run.
+func (p *Server) SetConnectionObserver(observer ConnectionObserver) {
+	p.observer = observer
+}
+
+// SetRequestObserver sets the observer of the requests handled by the public
+// endpoints. It must be called before Run.
+func (p *Server) SetRequestObserver(observer RequestObserver) {
+	p.publicEndpointsReplaySafe.observer = observer
+	p.publicEndpointsAll.observer = observer
+}
+
+// accepted notifies the observer about an accepted public connection.
+func (p *Server) accepted(transport string, fastOpen bool) {
+	if p.observer != nil {
+		p.observer.Accepted(transport, fastOpen)
+	}
+}
+
 // AddHTTPFallback adds http fallback to the drpc endpoint.
 func (p *Server) AddHTTPFallback(httpHandler http.HandlerFunc) {
 	p.publicHTTP = httpHandler
@@ -307,7 +352,7 @@ func (p *Server) Run(ctx context.Context) (err error) {
 		// We should be able to remove UDP-specific protocols from the Server
 		// struct and keep them localized to (*Server).Run, akin to TLS vs
 		// Noise drpcmigrate.ListenMuxed listeners over TCP.
-		p.publicQUICListener = wrapListener(publicQUICListener)
+		p.publicQUICListener = wrapListener(publicQUICListener, p.accepted)
 	}
 
 	// We need a new context chain because we require this context to be
@@ -332,6 +377,13 @@ func (p *Server) Run(ctx context.Context) (err error) {
 		}
 
 		publicTLSDRPCListener = tls.NewListener(tlsMux, p.tlsOptions.ServerTLSConfig())
+		if p.observer != nil {
+			publicTLSDRPCListener = &handshakeListener{
+				Listener:  publicTLSDRPCListener,
+				transport: TransportTCP,
+				observer:  p.observer,
+			}
+		}
 		publicNoiseDRPCListener = noiseconn.NewListenerWithOptions(
 			publicLMux.Route(noise.Header),
 			p.noiseConf,
@@ -410,25 +462,40 @@ func (p *Server) Run(ctx context.Context) (err error) {
 }
 
 type endpointCollection struct {
-	mux  *drpcmux.Mux
-	drpc *drpcserver.Server
+	mux      *drpcmux.Mux
+	drpc     *drpcserver.Server
+	observer RequestObserver
 }
 
 func newEndpointCollection() *endpointCollection {
-	mux := drpcmux.New()
-	return &endpointCollection{
-		mux: mux,
-		drpc: drpcserver.NewWithOptions(
-			experiment.NewHandler(
-				rpctracing.NewHandler(
-					mux,
-					jaeger.RemoteTraceHandler),
-			),
-			drpcserver.Options{
-				Manager: rpc.NewDefaultManagerOptions(),
-			},
+	collection := &endpointCollection{
+		mux: drpcmux.New(),
+	}
+	collection.drpc = drpcserver.NewWithOptions(
+		experiment.NewHandler(
+			rpctracing.NewHandler(
+				observedHandler{collection},
+				jaeger.RemoteTraceHandler),
 		),
+		drpcserver.Options{
+			Manager: rpc.NewDefaultManagerOptions(),
+		},
+	)
+	return collection
+}
+
+// observedHandler notifies the observer of the collection about the handled requests.
+type observedHandler struct {
+	collection *endpointCollection
+}
+
+// HandleRPC implements drpc.Handler.
+func (handler observedHandler) HandleRPC(stream drpc.Stream, rpc string) error {
+	err := handler.collection.mux.HandleRPC(stream, rpc)
+	if observer := handler.collection.observer; observer != nil {
+		observer.Handled(rpc, err)
 	}
+	return err
 }
 
 // isErrorAddressAlreadyInUse checks whether the error is corresponding to
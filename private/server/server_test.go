@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/zeebo/errs"
@@ -77,6 +78,76 @@ func TestServer(t *testing.T) {
 	))
 }
 
+type connectionObserver struct {
+	accepted   chan string
+	handshakes chan error
+}
+
+func (observer *connectionObserver) Accepted(transport string, fastOpen bool) {
+	observer.accepted <- transport
+}
+
+func (observer *connectionObserver) Handshake(transport string, duration time.Duration, err error) {
+	observer.handshakes <- err
+}
+
+func TestServer_ConnectionObserver(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+	identity := testidentity.MustPregeneratedIdentity(0, storj.LatestIDVersion())
+	clientIdentity := testidentity.MustPregeneratedIdentity(1, storj.LatestIDVersion())
+
+	tlsOptions, err := tlsopts.NewOptions(identity, tlsopts.Config{
+		PeerIDVersions: "latest",
+	}, nil)
+	require.NoError(t, err)
+	clientTLSOptions, err := tlsopts.NewOptions(clientIdentity, tlsopts.Config{
+		PeerIDVersions: "latest",
+	}, nil)
+	require.NoError(t, err)
+
+	instance, err := server.New(log.Named("server"), tlsOptions, server.Config{
+		Address:        "127.0.0.1:0",
+		PrivateAddress: "127.0.0.1:0",
+		DisableQUIC:    true,
+	})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, instance.Close())
+	}()
+
+	observer := &connectionObserver{
+		accepted:   make(chan string, 1),
+		handshakes: make(chan error, 1),
+	}
+	instance.SetConnectionObserver(observer)
+
+	serverCtx, serverCancel := context.WithCancel(ctx)
+	defer serverCancel()
+
+	require.Empty(t, sync2.Concurrently(
+		func() error {
+			err = instance.Run(serverCtx)
+			return errs2.IgnoreCanceled(err)
+		},
+		func() (err error) {
+			defer serverCancel()
+
+			connector := rpc.NewDefaultTCPConnector(nil)
+			conn, err := connector.DialContext(ctx, clientTLSOptions.ClientTLSConfig(identity.ID), instance.Addr().String())
+			if err != nil {
+				return errs.Wrap(err)
+			}
+			defer func() { err = errs.Combine(err, conn.Close()) }()
+
+			if transport := <-observer.accepted; transport != server.TransportTCP {
+				return errs.New("unexpected transport %q", transport)
+			}
+			return errs.Wrap(<-observer.handshakes)
+		},
+	))
+}
+
 func TestHybridConnector_Basic(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount:   1,
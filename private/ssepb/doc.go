@@ -0,0 +1,7 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package ssepb contains protobuf definitions for the server-side encryption of the bucket metadata.
+package ssepb
+
+//go:generate go run gen.go
@@ -0,0 +1,232 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package testplanet
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/storagenode/blobstore/testblobs"
+)
+
+// StorageNodeFaults injects faults into a storage node, so the edge cases of repair,
+// audit and reputation can be tested deterministically. It requires Config.EnableFaults.
+//
+// The other peers reach the node through a proxy, which simulates the link faults.
+type StorageNodeFaults struct {
+	proxy   *linkProxy
+	corrupt *testblobs.CorruptDB
+}
+
+// SetOffline makes the node unreachable for the other peers, as if it were offline, and
+// drops the open connections to it. The node keeps running, so it's back online once
+// offline is reset.
+func (faults *StorageNodeFaults) SetOffline(offline bool) {
+	faults.proxy.SetOffline(offline)
+}
+
+// SetLinkLatency delays every chunk of data sent to or from the node by latency, to
+// simulate a slow link. A zero latency disables the delay.
+func (faults *StorageNodeFaults) SetLinkLatency(latency time.Duration) {
+	faults.proxy.SetLatency(latency)
+}
+
+// SetCorruptPieces makes the node serve the pieces with corrupted content.
+func (faults *StorageNodeFaults) SetCorruptPieces(corrupt bool) {
+	faults.corrupt.SetCorrupt(corrupt)
+}
+
+// FailQueries returns a fault for satellite.TestingDB.SetQueryFault, which fails the
+// queries containing any of substrs, e.g. a table name, with err.
+func FailQueries(err error, substrs ...string) func(ctx context.Context, query string) error {
+	return func(ctx context.Context, query string) error {
+		for _, substr := range substrs {
+			if strings.Contains(query, substr) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// linkProxy forwards the tcp connections to a peer and injects the link faults.
+type linkProxy struct {
+	log      *zap.Logger
+	listener net.Listener
+	target   string
+
+	mu      sync.Mutex
+	offline bool
+	latency time.Duration
+	conns   map[net.Conn]struct{}
+	closed  bool
+
+	wg sync.WaitGroup
+}
+
+// newLinkProxy creates a proxy, which accepts the connections on listener.
+func newLinkProxy(log *zap.Logger, listener net.Listener) *linkProxy {
+	return &linkProxy{
+		log:      log,
+		listener: listener,
+		conns:    map[net.Conn]struct{}{},
+	}
+}
+
+// Addr returns the address of the proxy.
+func (proxy *linkProxy) Addr() string {
+	return proxy.listener.Addr().String()
+}
+
+// Start starts forwarding the connections to target.
+func (proxy *linkProxy) Start(target string) {
+	proxy.target = target
+
+	proxy.wg.Add(1)
+	go func() {
+		defer proxy.wg.Done()
+		for {
+			conn, err := proxy.listener.Accept()
+			if err != nil {
+				return
+			}
+
+			proxy.wg.Add(1)
+			go func() {
+				defer proxy.wg.Done()
+				proxy.forward(conn)
+			}()
+		}
+	}()
+}
+
+// SetOffline sets whether the connections are refused. Going offline drops the open
+// connections.
+func (proxy *linkProxy) SetOffline(offline bool) {
+	proxy.mu.Lock()
+	defer proxy.mu.Unlock()
+
+	proxy.offline = offline
+	if offline {
+		proxy.closeConns()
+	}
+}
+
+// SetLatency sets the delay of every forwarded chunk of data.
+func (proxy *linkProxy) SetLatency(latency time.Duration) {
+	proxy.mu.Lock()
+	defer proxy.mu.Unlock()
+	proxy.latency = latency
+}
+
+// forward forwards the client connection to the target.
+func (proxy *linkProxy) forward(client net.Conn) {
+	defer func() { _ = client.Close() }()
+
+	proxy.mu.Lock()
+	offline := proxy.offline || proxy.closed
+	proxy.mu.Unlock()
+	if offline {
+		return
+	}
+
+	server, err := net.Dial("tcp", proxy.target)
+	if err != nil {
+		proxy.log.Debug("failed to dial the target", zap.String("target", proxy.target), zap.Error(err))
+		return
+	}
+	defer func() { _ = server.Close() }()
+
+	if !proxy.track(client, server) {
+		return
+	}
+	defer proxy.untrack(client, server)
+
+	done := make(chan struct{}, 2)
+	go func() { proxy.pipe(server, client); done <- struct{}{} }()
+	go func() { proxy.pipe(client, server); done <- struct{}{} }()
+
+	// closing both connections stops the other direction.
+	<-done
+	_ = client.Close()
+	_ = server.Close()
+	<-done
+}
+
+// pipe copies the data from src to dst with the latency of the link.
+func (proxy *linkProxy) pipe(dst, src net.Conn) {
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			proxy.mu.Lock()
+			latency := proxy.latency
+			proxy.mu.Unlock()
+			if latency > 0 {
+				time.Sleep(latency)
+			}
+
+			if _, err := dst.Write(buf[:n]); err != nil {
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// track registers the connections to be dropped, when the proxy goes offline. It returns
+// false, when the proxy is already offline.
+func (proxy *linkProxy) track(conns ...net.Conn) bool {
+	proxy.mu.Lock()
+	defer proxy.mu.Unlock()
+
+	if proxy.offline || proxy.closed {
+		return false
+	}
+	for _, conn := range conns {
+		proxy.conns[conn] = struct{}{}
+	}
+	return true
+}
+
+// untrack unregisters the connections.
+func (proxy *linkProxy) untrack(conns ...net.Conn) {
+	proxy.mu.Lock()
+	defer proxy.mu.Unlock()
+
+	for _, conn := range conns {
+		delete(proxy.conns, conn)
+	}
+}
+
+// closeConns closes the open connections. It must be called with mu held.
+func (proxy *linkProxy) closeConns() {
+	for conn := range proxy.conns {
+		_ = conn.Close()
+		delete(proxy.conns, conn)
+	}
+}
+
+// Close stops the proxy and drops the open connections.
+func (proxy *linkProxy) Close() error {
+	proxy.mu.Lock()
+	proxy.closed = true
+	proxy.closeConns()
+	proxy.mu.Unlock()
+
+	err := proxy.listener.Close()
+	proxy.wg.Wait()
+	if errs.Is(err, net.ErrClosed) {
+		return nil
+	}
+	return err
+}
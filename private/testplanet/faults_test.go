@@ -0,0 +1,88 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package testplanet_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/buckets"
+)
+
+func TestStorageNodeFaults(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		EnableFaults: true,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.ReconfigureRS(2, 3, 4, 4),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+
+		data := testrand.Bytes(10 * memory.KiB)
+		require.NoError(t, uplink.Upload(ctx, satellite, "testbucket", "test/path", data))
+
+		// the corrupted pieces fail the hash verification, so the other pieces are used.
+		planet.StorageNodes[0].Faults.SetCorruptPieces(true)
+		downloaded, err := uplink.Download(ctx, satellite, "testbucket", "test/path")
+		require.NoError(t, err)
+		require.Equal(t, data, downloaded)
+		planet.StorageNodes[0].Faults.SetCorruptPieces(false)
+
+		planet.StorageNodes[1].Faults.SetLinkLatency(10 * time.Millisecond)
+		downloaded, err = uplink.Download(ctx, satellite, "testbucket", "test/path")
+		require.NoError(t, err)
+		require.Equal(t, data, downloaded)
+		planet.StorageNodes[1].Faults.SetLinkLatency(0)
+
+		for _, node := range planet.StorageNodes[:3] {
+			node.Faults.SetOffline(true)
+		}
+		_, err = uplink.Download(ctx, satellite, "testbucket", "test/path")
+		require.Error(t, err)
+
+		for _, node := range planet.StorageNodes[:3] {
+			node.Faults.SetOffline(false)
+		}
+		downloaded, err = uplink.Download(ctx, satellite, "testbucket", "test/path")
+		require.NoError(t, err)
+		require.Equal(t, data, downloaded)
+	})
+}
+
+func TestSatelliteQueryFaults(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		injected := errors.New("injected")
+		satellite.DB.Testing().SetQueryFault(testplanet.FailQueries(injected, "bucket_metainfos"))
+
+		_, err := satellite.DB.Buckets().CreateBucket(ctx, buckets.Bucket{
+			ID:        testrand.UUID(),
+			Name:      "testbucket",
+			ProjectID: projectID,
+		})
+		require.ErrorIs(t, err, injected)
+
+		satellite.DB.Testing().SetQueryFault(nil)
+
+		_, err = satellite.DB.Buckets().CreateBucket(ctx, buckets.Bucket{
+			ID:        testrand.UUID(),
+			Name:      "testbucket",
+			ProjectID: projectID,
+		})
+		require.NoError(t, err)
+	})
+}
@@ -65,6 +65,9 @@ type Config struct {
 	NonParallel bool
 	Timeout     time.Duration
 
+	// EnableFaults sets up StorageNode.Faults for injecting faults into the storage nodes.
+	EnableFaults bool
+
 	applicationName string
 }
 
@@ -193,12 +196,7 @@ func (planet *Planet) createPeers(ctx context.Context, satelliteDatabases satell
 		return errs.Wrap(err)
 	}
 
-	whitelistedSatellites := make(storj.NodeURLs, 0, len(planet.Satellites))
-	for _, satellite := range planet.Satellites {
-		whitelistedSatellites = append(whitelistedSatellites, satellite.NodeURL())
-	}
-
-	planet.StorageNodes, err = planet.newStorageNodes(ctx, planet.config.StorageNodeCount, whitelistedSatellites)
+	planet.StorageNodes, err = planet.newStorageNodes(ctx, planet.config.StorageNodeCount, planet.Satellites)
 	if err != nil {
 		return errs.Wrap(err)
 	}
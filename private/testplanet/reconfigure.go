@@ -25,9 +25,10 @@ type Reconfigure struct {
 	Satellite           func(log *zap.Logger, index int, config *satellite.Config)
 	Uplink              func(log *zap.Logger, index int, config *UplinkConfig)
 
-	StorageNodeDB func(index int, db storagenode.DB, log *zap.Logger) (storagenode.DB, error)
-	StorageNode   func(index int, config *storagenode.Config)
-	UniqueIPCount int
+	StorageNodeDB    func(index int, db storagenode.DB, log *zap.Logger) (storagenode.DB, error)
+	StorageNode      func(index int, config *storagenode.Config)
+	StorageNodeTrust func(index int, trust *StorageNodeTrust)
+	UniqueIPCount    int
 
 	VersionControl func(config *versioncontrol.Config)
 
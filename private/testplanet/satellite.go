@@ -137,14 +137,15 @@ type Satellite struct {
 	}
 
 	Audit struct {
-		VerifyQueue          audit.VerifyQueue
-		ReverifyQueue        audit.ReverifyQueue
-		Worker               *audit.Worker
-		ReverifyWorker       *audit.ReverifyWorker
-		Verifier             *audit.Verifier
-		Reverifier           *audit.Reverifier
-		Reporter             audit.Reporter
-		ContainmentSyncChore *audit.ContainmentSyncChore
+		VerifyQueue                audit.VerifyQueue
+		ReverifyQueue              audit.ReverifyQueue
+		Worker                     *audit.Worker
+		ReverifyWorker             *audit.ReverifyWorker
+		Verifier                   *audit.Verifier
+		Reverifier                 *audit.Reverifier
+		Reporter                   audit.Reporter
+		ContainmentSyncChore       *audit.ContainmentSyncChore
+		ContainmentExpirationChore *audit.ContainmentExpirationChore
 	}
 
 	Reputation struct {
@@ -157,7 +158,8 @@ type Satellite struct {
 	}
 
 	ExpiredDeletion struct {
-		Chore *expireddeletion.Chore
+		Chore      *expireddeletion.Chore
+		QueueChore *expireddeletion.QueueChore
 	}
 
 	ZombieDeletion struct {
@@ -637,11 +639,13 @@ func createNewSystem(name string, log *zap.Logger, config satellite.Config, peer
 	system.Audit.Reverifier = auditorPeer.Audit.Reverifier
 	system.Audit.Reporter = auditorPeer.Audit.Reporter
 	system.Audit.ContainmentSyncChore = peer.Audit.ContainmentSyncChore
+	system.Audit.ContainmentExpirationChore = peer.Audit.ContainmentExpirationChore
 
 	system.GarbageCollection.Sender = gcPeer.GarbageCollection.Sender
 	system.GarbageCollection.BloomFilters = gcBFPeer.GarbageCollection.Service
 
 	system.ExpiredDeletion.Chore = peer.ExpiredDeletion.Chore
+	system.ExpiredDeletion.QueueChore = peer.ExpiredDeletion.QueueChore
 	system.ZombieDeletion.Chore = peer.ZombieDeletion.Chore
 
 	system.Accounting.Tally = peer.Accounting.Tally
@@ -679,6 +683,11 @@ func (planet *Planet) newAPI(ctx context.Context, index int, identity *identity.
 
 	rollupsWriteCache := orders.NewRollupsWriteCache(log.Named("orders-write-cache"), db.Orders(), config.Orders.FlushBatchSize)
 	planet.databases = append(planet.databases, rollupsWriteCacheCloser{rollupsWriteCache})
+	if config.Orders.RollupsSpillDir != "" {
+		if err := rollupsWriteCache.OpenSpill(ctx, config.Orders.RollupsSpillDir); err != nil {
+			return nil, errs.Wrap(err)
+		}
+	}
 
 	return satellite.NewAPI(log, identity, db, metabaseDB, revocationDB, liveAccounting, rollupsWriteCache, &config, versionInfo, nil)
 }
@@ -704,7 +713,7 @@ func (planet *Planet) newRepairer(ctx context.Context, index int, identity *iden
 	}
 	planet.databases = append(planet.databases, revocationDB)
 
-	return satellite.NewRepairer(log, identity, metabaseDB, revocationDB, db.RepairQueue(), db.Buckets(), db.OverlayCache(), db.NodeEvents(), db.Reputation(), db.Containment(), versionInfo, &config, nil)
+	return satellite.NewRepairer(log, identity, metabaseDB, revocationDB, db.RepairQueue(), db.Buckets(), db.OverlayCache(), db.NodeEvents(), db.Reputation(), db.Containment(), db.NodeQuarantines(), versionInfo, &config, nil)
 }
 
 func (planet *Planet) newAuditor(ctx context.Context, index int, identity *identity.FullIdentity, db satellite.DB, metabaseDB *metabase.DB, config satellite.Config, versionInfo version.Info) (_ *satellite.Auditor, err error) {
@@ -719,7 +728,7 @@ func (planet *Planet) newAuditor(ctx context.Context, index int, identity *ident
 	}
 	planet.databases = append(planet.databases, revocationDB)
 
-	return satellite.NewAuditor(log, identity, metabaseDB, revocationDB, db.VerifyQueue(), db.ReverifyQueue(), db.OverlayCache(), db.NodeEvents(), db.Reputation(), db.Containment(), versionInfo, &config, nil)
+	return satellite.NewAuditor(log, identity, metabaseDB, revocationDB, db.VerifyQueue(), db.ReverifyQueue(), db.OverlayCache(), db.NodeEvents(), db.Reputation(), db.Containment(), db.NodeQuarantines(), versionInfo, &config, nil)
 }
 
 type rollupsWriteCacheCloser struct {
@@ -6,6 +6,7 @@ package testplanet
 import (
 	"context"
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
 	"runtime/pprof"
@@ -27,6 +28,7 @@ import (
 	"storj.io/storj/storagenode/apikeys"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/blobstore/testblobs"
 	"storj.io/storj/storagenode/collector"
 	"storj.io/storj/storagenode/console/consoleserver"
 	"storj.io/storj/storagenode/contact"
@@ -49,7 +51,11 @@ type StorageNode struct {
 	Config storagenode.Config
 	*storagenode.Peer
 
-	apiKey apikeys.APIKey
+	// Faults injects faults into the node, when Config.EnableFaults is set.
+	Faults *StorageNodeFaults
+
+	trustListPath string
+	apiKey        apikeys.APIKey
 }
 
 // Label returns name for debugger.
@@ -69,18 +75,9 @@ func (system *StorageNode) APIKey() string {
 }
 
 // newStorageNodes initializes storage nodes.
-func (planet *Planet) newStorageNodes(ctx context.Context, count int, whitelistedSatellites storj.NodeURLs) (_ []*StorageNode, err error) {
+func (planet *Planet) newStorageNodes(ctx context.Context, count int, satellites []*Satellite) (_ []*StorageNode, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var sources []trust.Source
-	for _, u := range whitelistedSatellites {
-		source, err := trust.NewStaticURLSource(u.String())
-		if err != nil {
-			return nil, errs.Wrap(err)
-		}
-		sources = append(sources, source)
-	}
-
 	var xs []*StorageNode
 	for i := 0; i < count; i++ {
 		index := i
@@ -90,7 +87,7 @@ func (planet *Planet) newStorageNodes(ctx context.Context, count int, whiteliste
 		var system *StorageNode
 		var err error
 		pprof.Do(ctx, pprof.Labels("peer", prefix), func(ctx context.Context) {
-			system, err = planet.newStorageNode(ctx, prefix, index, count, log, sources)
+			system, err = planet.newStorageNode(ctx, prefix, index, count, log, satellites)
 		})
 		if err != nil {
 			return nil, errs.Wrap(err)
@@ -103,7 +100,7 @@ func (planet *Planet) newStorageNodes(ctx context.Context, count int, whiteliste
 	return xs, nil
 }
 
-func (planet *Planet) newStorageNode(ctx context.Context, prefix string, index, count int, log *zap.Logger, sources []trust.Source) (_ *StorageNode, err error) {
+func (planet *Planet) newStorageNode(ctx context.Context, prefix string, index, count int, log *zap.Logger, satellites []*Satellite) (_ *StorageNode, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	storageDir := filepath.Join(planet.directory, prefix)
@@ -111,6 +108,19 @@ func (planet *Planet) newStorageNode(ctx context.Context, prefix string, index,
 		return nil, errs.Wrap(err)
 	}
 
+	nodeTrust := StorageNodeTrust{
+		Satellites: append([]*Satellite(nil), satellites...),
+	}
+	if planet.config.Reconfigure.StorageNodeTrust != nil {
+		planet.config.Reconfigure.StorageNodeTrust(index, &nodeTrust)
+	}
+
+	// the trust list is kept in a file, so it can be changed with SetTrustedSatellites.
+	trustListPath := filepath.Join(storageDir, "trust-list.txt")
+	if err := writeTrustList(trustListPath, nodeTrust.Satellites); err != nil {
+		return nil, err
+	}
+
 	identity, err := planet.NewIdentity()
 	if err != nil {
 		return nil, errs.Wrap(err)
@@ -187,9 +197,10 @@ func (planet *Planet) newStorageNode(ctx context.Context, prefix string, index,
 				VerifyDirWritableTimeout:  10 * time.Second,
 			},
 			Trust: trust.Config{
-				Sources:         sources,
+				Sources:         trust.Sources{trust.NewFileSource(trustListPath)},
 				CachePath:       filepath.Join(storageDir, "trust-cache.json"),
 				RefreshInterval: defaultInterval,
+				Quotas:          trust.Quotas{Limits: nodeTrust.Quotas},
 			},
 			MaxUsedSerialsSize: memory.MiB,
 		},
@@ -227,6 +238,25 @@ func (planet *Planet) newStorageNode(ctx context.Context, prefix string, index,
 		}
 	}
 
+	var faults *StorageNodeFaults
+	if planet.config.EnableFaults {
+		// the proxy listens on the same host as the node, to keep the node's last_net.
+		host, _, err := net.SplitHostPort(config.Server.Address)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		listener, err := net.Listen("tcp", net.JoinHostPort(host, "0"))
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		faults = &StorageNodeFaults{proxy: newLinkProxy(log.Named("proxy"), listener)}
+		planet.databases = append(planet.databases, faults.proxy)
+
+		if config.Contact.ExternalAddress == "" {
+			config.Contact.ExternalAddress = faults.proxy.Addr()
+		}
+	}
+
 	verisonInfo := planet.NewVersionInfo()
 
 	dbconfig := config.DatabaseConfig()
@@ -241,6 +271,11 @@ func (planet *Planet) newStorageNode(ctx context.Context, prefix string, index,
 		return nil, errs.Wrap(err)
 	}
 
+	if faults != nil {
+		faults.corrupt = testblobs.NewCorruptDB(log.Named("corrupt"), db)
+		db = faults.corrupt
+	}
+
 	if planet.config.Reconfigure.StorageNodeDB != nil {
 		db, err = planet.config.Reconfigure.StorageNodeDB(index, db, planet.log)
 		if err != nil {
@@ -262,6 +297,10 @@ func (planet *Planet) newStorageNode(ctx context.Context, prefix string, index,
 	// Mark the peer's PieceDeleter as in testing mode, so it is easy to wait on the deleter
 	peer.Storage2.PieceDeleter.SetupTest()
 
+	if faults != nil {
+		faults.proxy.Start(peer.Addr())
+	}
+
 	err = db.MigrateToLatest(ctx)
 	if err != nil {
 		return nil, errs.Wrap(err)
@@ -279,6 +318,9 @@ func (planet *Planet) newStorageNode(ctx context.Context, prefix string, index,
 		Name:   prefix,
 		Config: config,
 		Peer:   peer,
-		apiKey: apiKey,
+		Faults: faults,
+
+		trustListPath: trustListPath,
+		apiKey:        apiKey,
 	}, nil
 }
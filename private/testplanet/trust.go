@@ -0,0 +1,69 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package testplanet
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/overlay"
+)
+
+// StorageNodeTrust is the trust configuration of a storage node.
+type StorageNodeTrust struct {
+	// Satellites are the trusted satellites. All the satellites are trusted by default.
+	Satellites []*Satellite
+	// Quotas are the allocated space limits of the satellites.
+	Quotas map[storj.NodeID]memory.Size
+}
+
+// writeTrustList writes the urls of the satellites to the trust list file of the
+// storage node.
+func writeTrustList(path string, satellites []*Satellite) error {
+	var list strings.Builder
+	for _, satellite := range satellites {
+		list.WriteString(satellite.NodeURL().String())
+		list.WriteString("\n")
+	}
+	return errs.Wrap(os.WriteFile(path, []byte(list.String()), 0600))
+}
+
+// SetTrustedSatellites replaces the satellites trusted by the storage node and reloads
+// its trust pool.
+func (system *StorageNode) SetTrustedSatellites(ctx context.Context, satellites ...*Satellite) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := writeTrustList(system.trustListPath, satellites); err != nil {
+		return err
+	}
+	return system.Storage2.Trust.Refresh(ctx)
+}
+
+// TrustedSatellites returns the IDs of the satellites trusted by the storage node.
+func (system *StorageNode) TrustedSatellites(ctx context.Context) storj.NodeIDList {
+	satellites := storj.NodeIDList(system.Storage2.Trust.GetSatellites(ctx))
+	sort.Sort(satellites)
+	return satellites
+}
+
+// SpaceUsedBySatellite returns the content size of the pieces stored for the satellite.
+func (system *StorageNode) SpaceUsedBySatellite(ctx context.Context, satelliteID storj.NodeID) (int64, error) {
+	_, contentSize, err := system.Storage2.BlobsCache.SpaceUsedBySatellite(ctx, satelliteID)
+	return contentSize, err
+}
+
+// KnowsNode returns whether the storage node has checked in with the satellite.
+func (system *Satellite) KnowsNode(ctx context.Context, nodeID storj.NodeID) (bool, error) {
+	_, err := system.Overlay.Service.Get(ctx, nodeID)
+	if overlay.ErrNodeNotFound.Has(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
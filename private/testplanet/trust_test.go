@@ -0,0 +1,72 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package testplanet_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+)
+
+func TestStorageNodeTrust(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 2, StorageNodeCount: 2, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNodeTrust: func(index int, trust *testplanet.StorageNodeTrust) {
+				if index == 1 {
+					trust.Satellites = trust.Satellites[:1]
+				}
+				trust.Quotas = map[storj.NodeID]memory.Size{
+					trust.Satellites[0].ID(): memory.GB,
+				}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat0, sat1 := planet.Satellites[0], planet.Satellites[1]
+		node0, node1 := planet.StorageNodes[0], planet.StorageNodes[1]
+
+		require.ElementsMatch(t, storj.NodeIDList{sat0.ID(), sat1.ID()}, node0.TrustedSatellites(ctx))
+		require.Equal(t, storj.NodeIDList{sat0.ID()}, node1.TrustedSatellites(ctx))
+
+		quota, ok := node1.Storage2.Trust.Quota(sat0.ID())
+		require.True(t, ok)
+		require.Equal(t, memory.GB, quota)
+
+		for _, node := range planet.StorageNodes {
+			require.NoError(t, node.Contact.Service.PingSatellites(ctx, time.Second))
+		}
+
+		known, err := sat1.KnowsNode(ctx, node0.ID())
+		require.NoError(t, err)
+		require.True(t, known)
+
+		known, err = sat1.KnowsNode(ctx, node1.ID())
+		require.NoError(t, err)
+		require.False(t, known)
+
+		// trusting the second satellite makes the node check in with it.
+		require.NoError(t, node1.SetTrustedSatellites(ctx, sat0, sat1))
+		require.ElementsMatch(t, storj.NodeIDList{sat0.ID(), sat1.ID()}, node1.TrustedSatellites(ctx))
+		require.NoError(t, node1.Contact.Service.PingSatellites(ctx, time.Second))
+
+		known, err = sat1.KnowsNode(ctx, node1.ID())
+		require.NoError(t, err)
+		require.True(t, known)
+
+		// the untrusted satellite can't use the node anymore.
+		require.NoError(t, node0.SetTrustedSatellites(ctx, sat1))
+		require.Equal(t, storj.NodeIDList{sat1.ID()}, node0.TrustedSatellites(ctx))
+		require.Error(t, node0.Storage2.Trust.VerifySatelliteID(ctx, sat0.ID()))
+
+		used, err := node0.SpaceUsedBySatellite(ctx, sat0.ID())
+		require.NoError(t, err)
+		require.Zero(t, used)
+	})
+}
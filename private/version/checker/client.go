@@ -18,6 +18,7 @@ import (
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
+	"storj.io/common/storj"
 	"storj.io/private/version"
 )
 
@@ -126,6 +127,50 @@ func (client *Client) Process(ctx context.Context, processName string) (process
 	return process, nil
 }
 
+// RollbackReport describes an update of a process, which was rolled back, because the
+// updated process failed the health checks.
+type RollbackReport struct {
+	NodeID storj.NodeID `json:"nodeId"`
+	// Failed is the version, which failed the health checks.
+	Failed string `json:"failed"`
+	// Restored is the version, which the process was rolled back to.
+	Restored string `json:"restored"`
+	Reason   string `json:"reason"`
+}
+
+// ReportRollback reports the rollback of the named process to the version control server.
+func (client *Client) ReportRollback(ctx context.Context, processName string, report RollbackReport) (err error) {
+	defer mon.Task()(&ctx, processName)(&err)
+
+	body, err := json.Marshal(report)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	httpClient := http.Client{
+		Timeout: client.config.RequestTimeout,
+	}
+
+	url := strings.TrimSuffix(client.config.ServerAddress, "/") + "/processes/" + processName + "/rollbacks"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return Error.New("non-success http status code: %d; body: %s\n", resp.StatusCode, respBody)
+	}
+	return nil
+}
+
 // kebabToPascal converts `alpha-beta` to `AlphaBeta`.
 func kebabToPascal(str string) string {
 	return strings.ReplaceAll(cases.Title(language.Und, cases.NoLower).String(str), "-", "")
@@ -5,6 +5,7 @@ package web
 
 import (
 	"context"
+	"math"
 	"net"
 	"net/http"
 	"strings"
@@ -14,6 +15,8 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/time/rate"
+
+	"storj.io/storj/private/ratelimit"
 )
 
 const (
@@ -35,6 +38,10 @@ type RateLimiter struct {
 	mu      sync.Mutex
 	limits  map[string]*userLimit
 	keyFunc func(*http.Request) (string, error)
+
+	// shared enforces the limits instead of limits, when set.
+	shared       ratelimit.Limiter
+	sharedPrefix string
 }
 
 // userLimit is the per-key limiter.
@@ -57,6 +64,15 @@ func NewRateLimiter(config RateLimiterConfig, log *zap.Logger, keyFunc func(*htt
 	}
 }
 
+// WithShared makes the rate limiter enforce the limits through shared, e.g. to share them
+// between the replicas of the service. The keys are prefixed with prefix to be unique
+// in shared. A nil shared keeps the limits in the rate limiter.
+func (rl *RateLimiter) WithShared(shared ratelimit.Limiter, prefix string) *RateLimiter {
+	rl.shared = shared
+	rl.sharedPrefix = prefix
+	return rl
+}
+
 // Run occasionally cleans old rate-limiting data, until context cancel.
 func (rl *RateLimiter) Run(ctx context.Context) {
 	cleanupTicker := time.NewTicker(rl.config.Duration)
@@ -90,8 +106,12 @@ func (rl *RateLimiter) Limit(next http.Handler) http.Handler {
 			ServeCustomJSONError(rl.log, w, http.StatusInternalServerError, err, internalServerErrMsg)
 			return
 		}
-		limit := rl.getUserLimit(key)
-		if !limit.Allow() {
+		allowed, err := rl.allow(r.Context(), key)
+		if err != nil {
+			ServeCustomJSONError(rl.log, w, http.StatusInternalServerError, err, internalServerErrMsg)
+			return
+		}
+		if !allowed {
 			ServeJSONError(rl.log, w, http.StatusTooManyRequests, errs.New(rateLimitErrMsg))
 			return
 		}
@@ -99,6 +119,19 @@ func (rl *RateLimiter) Limit(next http.Handler) http.Handler {
 	})
 }
 
+// allow reports whether a request for the key is allowed.
+func (rl *RateLimiter) allow(ctx context.Context, key string) (bool, error) {
+	if rl.shared == nil {
+		return rl.getUserLimit(key).Allow(), nil
+	}
+
+	limit := ratelimit.Limit{Rate: math.Inf(1), Burst: rl.config.Burst}
+	if rl.config.Duration != 0 {
+		limit.Rate = float64(time.Second) / float64(rl.config.Duration)
+	}
+	return rl.shared.Allow(ctx, rl.sharedPrefix+key, limit)
+}
+
 // GetRequestIP gets the original IP address of the request by handling the request headers.
 func GetRequestIP(r *http.Request) (ip string, err error) {
 	realIP := r.Header.Get("X-REAL-IP")
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/assert"
@@ -16,6 +17,7 @@ import (
 
 	"storj.io/common/testcontext"
 	"storj.io/private/cfgstruct"
+	"storj.io/storj/private/ratelimit"
 	"storj.io/storj/private/web"
 )
 
@@ -51,6 +53,32 @@ func TestNewIPRateLimiter(t *testing.T) {
 	testWithAddress(ctx, t, "192.168.1.1:5000", rateLimiter.Burst(), handler)
 }
 
+func TestSharedRateLimiter(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	config := web.RateLimiterConfig{}
+	cfgstruct.Bind(&pflag.FlagSet{}, &config, cfgstruct.UseDevDefaults())
+
+	// two replicas share the limits.
+	shared := ratelimit.NewLocal(10, time.Hour)
+	first := web.NewIPRateLimiter(config, zaptest.NewLogger(t)).WithShared(shared, "ip:")
+	second := web.NewIPRateLimiter(config, zaptest.NewLogger(t)).WithShared(shared, "ip:")
+
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	testWithAddress(ctx, t, "192.168.1.1:5000", config.Burst, first.Limit(ok))
+
+	// the other replica enforces the same limit.
+	req, err := http.NewRequestWithContext(ctx, "GET", "", nil)
+	require.NoError(t, err)
+	req.RemoteAddr = "192.168.1.1:5000"
+	rr := httptest.NewRecorder()
+	second.Limit(ok).ServeHTTP(rr, req)
+	require.Equal(t, http.StatusTooManyRequests, rr.Code)
+}
+
 func testWithAddress(ctx context.Context, t *testing.T, remoteAddress string, burst int, handler http.Handler) {
 	// create HTTP request
 	req, err := http.NewRequestWithContext(ctx, "GET", "", nil)
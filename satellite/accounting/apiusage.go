@@ -0,0 +1,154 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+)
+
+// ErrAPIUsageRollups is the error class of the API usage rollups.
+var ErrAPIUsageRollups = errs.Class("api usage rollups")
+
+// The operations of the metainfo requests counted in the API usage rollups.
+const (
+	// APIOperationList counts the listings of the buckets, the objects and the segments.
+	APIOperationList = "list"
+	// APIOperationDownload counts the downloads of the objects and the segments.
+	APIOperationDownload = "download"
+	// APIOperationUpload counts the beginnings of the uploads of the objects and the segments.
+	APIOperationUpload = "upload"
+	// APIOperationDelete counts the deletions of the objects and the buckets.
+	APIOperationDelete = "delete"
+)
+
+// APIUsageRollupsConfig contains the configurable values of the API usage rollups.
+type APIUsageRollupsConfig struct {
+	Enabled       bool          `help:"whether the metainfo requests are counted per project and operation into hourly rollups" default:"false"`
+	FlushInterval time.Duration `help:"how often the counted requests are written to the database" default:"1m" testDefault:"$TESTINTERVAL"`
+	MaxPending    int           `help:"maximum number of the counted rollups kept in memory between the flushes. the requests of the new rollups above the limit are dropped" default:"10000"`
+}
+
+type apiUsageRollupKey struct {
+	projectID     uuid.UUID
+	operation     string
+	intervalStart time.Time
+}
+
+// APIUsageRollups counts the metainfo requests per project and operation in
+// memory and periodically adds them to the hourly rollups.
+//
+// architecture: Chore
+type APIUsageRollups struct {
+	log    *zap.Logger
+	config APIUsageRollupsConfig
+	db     ProjectAccounting
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+
+	mu      sync.Mutex
+	pending map[apiUsageRollupKey]int64
+}
+
+// NewAPIUsageRollups creates a new counter of the API usage rollups.
+func NewAPIUsageRollups(log *zap.Logger, config APIUsageRollupsConfig, db ProjectAccounting) *APIUsageRollups {
+	return &APIUsageRollups{
+		log:    log,
+		config: config,
+		db:     db,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.FlushInterval),
+
+		pending: make(map[apiUsageRollupKey]int64),
+	}
+}
+
+// Run starts the periodic flushes of the counted requests.
+func (rollups *APIUsageRollups) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return rollups.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := rollups.Flush(ctx); err != nil {
+			rollups.log.Error("failed to flush the api usage rollups", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the periodic flushes and flushes the remaining requests.
+func (rollups *APIUsageRollups) Close() error {
+	rollups.Loop.Close()
+	return rollups.Flush(context.Background())
+}
+
+// SetNow allows tests to have the counter act as if the current time is whatever they want.
+func (rollups *APIUsageRollups) SetNow(nowFn func() time.Time) {
+	rollups.nowFn = nowFn
+}
+
+// Add counts a request of the operation made by the project.
+func (rollups *APIUsageRollups) Add(projectID uuid.UUID, operation string) {
+	intervalStart := rollups.nowFn().UTC().Truncate(time.Hour)
+	key := apiUsageRollupKey{
+		projectID:     projectID,
+		operation:     operation,
+		intervalStart: intervalStart,
+	}
+
+	rollups.mu.Lock()
+	defer rollups.mu.Unlock()
+
+	if _, ok := rollups.pending[key]; !ok && len(rollups.pending) >= rollups.config.MaxPending {
+		mon.Counter("api_usage_rollups_dropped").Inc(1)
+		return
+	}
+	rollups.pending[key]++
+}
+
+// Flush adds the counted requests to the hourly rollups. The requests are kept
+// for the next flush, when the rollups couldn't be updated.
+func (rollups *APIUsageRollups) Flush(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rollups.mu.Lock()
+	pending := rollups.pending
+	rollups.pending = make(map[apiUsageRollupKey]int64)
+	rollups.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	batch := make([]APIUsageRollup, 0, len(pending))
+	for key, count := range pending {
+		batch = append(batch, APIUsageRollup{
+			ProjectID:     key.projectID,
+			IntervalStart: key.intervalStart,
+			Operation:     key.operation,
+			Count:         count,
+		})
+	}
+
+	err = rollups.db.UpdateAPIUsageRollups(ctx, batch)
+	if err == nil {
+		return nil
+	}
+
+	// restore the requests and add the requests counted in the meantime on top of them.
+	rollups.mu.Lock()
+	defer rollups.mu.Unlock()
+	for key, count := range pending {
+		rollups.pending[key] += count
+	}
+	return ErrAPIUsageRollups.Wrap(err)
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/accounting"
+)
+
+type fakeAPIUsageDB struct {
+	accounting.ProjectAccounting
+
+	failed  bool
+	rollups []accounting.APIUsageRollup
+}
+
+func (db *fakeAPIUsageDB) UpdateAPIUsageRollups(ctx context.Context, rollups []accounting.APIUsageRollup) error {
+	if db.failed {
+		return errors.New("database unavailable")
+	}
+	db.rollups = append(db.rollups, rollups...)
+	return nil
+}
+
+func (db *fakeAPIUsageDB) flushed() []accounting.APIUsageRollup {
+	rollups := db.rollups
+	db.rollups = nil
+	sort.Slice(rollups, func(i, k int) bool {
+		if !rollups[i].IntervalStart.Equal(rollups[k].IntervalStart) {
+			return rollups[i].IntervalStart.Before(rollups[k].IntervalStart)
+		}
+		return rollups[i].Operation < rollups[k].Operation
+	})
+	return rollups
+}
+
+func TestAPIUsageRollups(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	db := &fakeAPIUsageDB{}
+	rollups := accounting.NewAPIUsageRollups(zaptest.NewLogger(t), accounting.APIUsageRollupsConfig{
+		FlushInterval: time.Minute,
+		MaxPending:    4,
+	}, db)
+
+	now := time.Date(2023, time.June, 1, 13, 25, 0, 0, time.UTC)
+	hour := time.Date(2023, time.June, 1, 13, 0, 0, 0, time.UTC)
+	rollups.SetNow(func() time.Time { return now })
+
+	projectID := testrand.UUID()
+
+	// the requests are counted per operation and hour.
+	rollups.Add(projectID, accounting.APIOperationList)
+	rollups.Add(projectID, accounting.APIOperationList)
+	rollups.Add(projectID, accounting.APIOperationDownload)
+	now = now.Add(time.Hour)
+	rollups.Add(projectID, accounting.APIOperationList)
+
+	require.NoError(t, rollups.Flush(ctx))
+	require.Equal(t, []accounting.APIUsageRollup{
+		{ProjectID: projectID, IntervalStart: hour, Operation: accounting.APIOperationDownload, Count: 1},
+		{ProjectID: projectID, IntervalStart: hour, Operation: accounting.APIOperationList, Count: 2},
+		{ProjectID: projectID, IntervalStart: hour.Add(time.Hour), Operation: accounting.APIOperationList, Count: 1},
+	}, db.flushed())
+
+	// nothing is written without new requests.
+	require.NoError(t, rollups.Flush(ctx))
+	require.Empty(t, db.flushed())
+
+	// the requests are kept when the flush fails.
+	db.failed = true
+	rollups.Add(projectID, accounting.APIOperationUpload)
+	require.Error(t, rollups.Flush(ctx))
+
+	db.failed = false
+	rollups.Add(projectID, accounting.APIOperationUpload)
+	require.NoError(t, rollups.Close())
+	require.Equal(t, []accounting.APIUsageRollup{
+		{ProjectID: projectID, IntervalStart: hour.Add(time.Hour), Operation: accounting.APIOperationUpload, Count: 2},
+	}, db.flushed())
+
+	// the requests of the new rollups above the limit are dropped.
+	for i := 0; i < 5; i++ {
+		rollups.Add(testrand.UUID(), accounting.APIOperationDelete)
+	}
+	require.NoError(t, rollups.Flush(ctx))
+	require.Len(t, db.flushed(), 4)
+}
@@ -75,6 +75,11 @@ type ProjectUsage struct {
 	Egress       int64   `json:"egress"`
 	SegmentCount float64 `json:"segmentCount"`
 	ObjectCount  float64 `json:"objectCount"`
+	// RepairEgress and AuditEgress are the download traffic of the repair and
+	// the audits of the project's segments, which isn't charged. They are
+	// only set by GetProjectTotalByPartner.
+	RepairEgress int64 `json:"repairEgress"`
+	AuditEgress  int64 `json:"auditEgress"`
 
 	Since  time.Time `json:"since"`
 	Before time.Time `json:"before"`
@@ -94,6 +99,9 @@ type ProjectLimits struct {
 
 	RateLimit  *int
 	BurstLimit *int
+
+	// PaidTier is whether the owner of the project is in the paid tier.
+	PaidTier bool
 }
 
 // ProjectDailyUsage holds project daily usage.
@@ -109,6 +117,30 @@ type ProjectUsageByDay struct {
 	Value int64     `json:"value"`
 }
 
+// ProjectDailyBandwidth holds the project's egress rolled up for a day.
+type ProjectDailyBandwidth struct {
+	IntervalDay time.Time `json:"intervalDay"`
+	Allocated   int64     `json:"allocated"`
+	Settled     int64     `json:"settled"`
+	Dead        int64     `json:"dead"`
+}
+
+// APIUsageRollup is the number of the metainfo requests of an operation made by
+// a project within an hour.
+type APIUsageRollup struct {
+	ProjectID     uuid.UUID `json:"projectID"`
+	IntervalStart time.Time `json:"intervalStart"`
+	Operation     string    `json:"operation"`
+	Count         int64     `json:"count"`
+}
+
+// ProjectDailyBandwidthCorrection holds the project's daily bandwidth rollup
+// before and after it was recomputed from the bucket bandwidth rollups.
+type ProjectDailyBandwidthCorrection struct {
+	Before ProjectDailyBandwidth `json:"before"`
+	After  ProjectDailyBandwidth `json:"after"`
+}
+
 // BucketUsage consist of total bucket usage for period.
 type BucketUsage struct {
 	ProjectID  uuid.UUID
@@ -200,6 +232,14 @@ type StoragenodeAccounting interface {
 	GetRollupsSince(ctx context.Context, since time.Time) ([]StoragenodeBandwidthRollup, error)
 	// GetArchivedRollupsSince retrieves all archived bandwidth rollup records since a given time. A hard limit batch size is used for results.
 	GetArchivedRollupsSince(ctx context.Context, since time.Time) ([]StoragenodeBandwidthRollup, error)
+	// InsertRollupRun records the start of a rollup run.
+	InsertRollupRun(ctx context.Context, run RollupRun) error
+	// UpdateRollupRunStatus updates the progress of a rollup run.
+	UpdateRollupRunStatus(ctx context.Context, id uuid.UUID, status RollupRunStatus, finishedAt *time.Time) error
+	// ListIncompleteRollupRuns returns the runs of the chore, which were neither completed nor repaired, the oldest first.
+	ListIncompleteRollupRuns(ctx context.Context, chore RollupRunChore) ([]RollupRun, error)
+	// ClaimRollupRunRepair marks an incomplete rollup run as being repaired. It returns false when the run was already claimed.
+	ClaimRollupRunRepair(ctx context.Context, id uuid.UUID, claimedAt time.Time) (bool, error)
 }
 
 // ProjectAccounting stores information about bandwidth and storage usage for projects.
@@ -222,6 +262,14 @@ type ProjectAccounting interface {
 	DeleteProjectBandwidthBefore(ctx context.Context, before time.Time) error
 	// GetProjectDailyUsageByDateRange returns daily allocated, settled bandwidth and storage usage for the specified date range.
 	GetProjectDailyUsageByDateRange(ctx context.Context, projectID uuid.UUID, from, to time.Time, crdbInterval time.Duration) (*ProjectDailyUsage, error)
+	// RecomputeProjectDailyBandwidth recomputes the project's daily bandwidth rollups of the days in the period
+	// from the bucket bandwidth rollups and returns them before and after the correction. The rollups are
+	// only written when dryRun is false.
+	RecomputeProjectDailyBandwidth(ctx context.Context, projectID uuid.UUID, since, before time.Time, dryRun bool) ([]ProjectDailyBandwidthCorrection, error)
+	// UpdateAPIUsageRollups adds the request counts of the rollups to the hourly API usage rollups.
+	UpdateAPIUsageRollups(ctx context.Context, rollups []APIUsageRollup) error
+	// GetAPIUsageRollups returns the hourly API usage rollups of the project for the hours from since, inclusive, to before, exclusive.
+	GetAPIUsageRollups(ctx context.Context, projectID uuid.UUID, since, before time.Time) ([]APIUsageRollup, error)
 
 	// UpdateProjectUsageLimit updates project usage limit.
 	UpdateProjectUsageLimit(ctx context.Context, projectID uuid.UUID, limit memory.Size) error
@@ -306,6 +354,10 @@ type Cache interface {
 	// AddProjectStorageUsageUpToLimit increases storage usage up to the limit.
 	// If the limit is exceeded, the usage is not increased and accounting.ErrProjectLimitExceeded is returned.
 	AddProjectStorageUsageUpToLimit(ctx context.Context, projectID uuid.UUID, increment int64, spaceLimit int64) error
+	// AddProjectCopyMoveUsageUpToLimit counts a server-side copy or move of an object of the
+	// size in the project's daily usage. If either the daily count or the daily size limit is
+	// exceeded, the usage is not increased and accounting.ErrProjectLimitExceeded is returned.
+	AddProjectCopyMoveUsageUpToLimit(ctx context.Context, projectID uuid.UUID, size int64, countLimit int64, sizeLimit int64, now time.Time) error
 	// GetAllProjectTotals return the total projects' storage and segments used space.
 	GetAllProjectTotals(ctx context.Context) (map[uuid.UUID]Usage, error)
 	// Close the client, releasing any open resources. Once it's called any other
@@ -154,6 +154,47 @@ func TestGetAllProjectTotals(t *testing.T) {
 	}
 }
 
+func TestAddProjectCopyMoveUsageUpToLimit(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	redis, err := testredis.Start(ctx)
+	require.NoError(t, err)
+	defer ctx.Check(redis.Close)
+
+	cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), live.Config{
+		StorageBackend: "redis://" + redis.Addr() + "?db=0",
+	})
+	require.NoError(t, err)
+	defer ctx.Check(cache.Close)
+
+	projectID := testrand.UUID()
+	now := time.Date(2023, time.June, 10, 12, 0, 0, 0, time.UTC)
+
+	const countLimit, sizeLimit = 3, 100
+
+	require.NoError(t, cache.AddProjectCopyMoveUsageUpToLimit(ctx, projectID, 60, countLimit, sizeLimit, now))
+
+	// the size limit is exceeded and nothing is counted.
+	err = cache.AddProjectCopyMoveUsageUpToLimit(ctx, projectID, 50, countLimit, sizeLimit, now)
+	require.True(t, accounting.ErrProjectLimitExceeded.Has(err), err)
+
+	require.NoError(t, cache.AddProjectCopyMoveUsageUpToLimit(ctx, projectID, 40, countLimit, sizeLimit, now))
+	require.NoError(t, cache.AddProjectCopyMoveUsageUpToLimit(ctx, projectID, 0, countLimit, sizeLimit, now))
+
+	// the count limit is exceeded.
+	err = cache.AddProjectCopyMoveUsageUpToLimit(ctx, projectID, 0, countLimit, sizeLimit, now)
+	require.True(t, accounting.ErrProjectLimitExceeded.Has(err), err)
+
+	// the limits are per day.
+	require.NoError(t, cache.AddProjectCopyMoveUsageUpToLimit(ctx, projectID, 100, countLimit, sizeLimit, now.Add(24*time.Hour)))
+
+	// the copy and move usage isn't reported as the project totals.
+	require.NoError(t, cache.AddProjectStorageUsage(ctx, projectID, 10))
+	totals, err := cache.GetAllProjectTotals(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[uuid.UUID]accounting.Usage{projectID: {Storage: 10}}, totals)
+}
+
 func TestLiveAccountingCache_ProjectBandwidthUsage_expiration(t *testing.T) {
 	tests := []struct {
 		backend string
@@ -208,6 +208,46 @@ func (cache *redisLiveAccounting) AddProjectStorageUsageUpToLimit(ctx context.Co
 	return nil
 }
 
+// AddProjectCopyMoveUsageUpToLimit counts a server-side copy or move of an object of the
+// size in the project's daily usage. If either the daily count or the daily size limit is
+// exceeded, the usage is not increased and accounting.ErrProjectLimitExceeded is returned.
+func (cache *redisLiveAccounting) AddProjectCopyMoveUsageUpToLimit(ctx context.Context, projectID uuid.UUID, size int64, countLimit int64, sizeLimit int64, now time.Time) (err error) {
+	defer mon.Task()(&ctx, projectID, size)(&err)
+
+	// The following script increments the daily count and size keys and rolls
+	// both back, when either exceeds its limit. The keys expire after the day,
+	// the expiration is set when they are created.
+	script := redis.NewScript(`local count = redis.call("incrby", KEYS[1], 1)
+	local size = redis.call("incrby", KEYS[2], ARGV[1])
+	for _, key in ipairs(KEYS) do
+		if redis.call("ttl", key) == -1 then
+			redis.call("expire", key, ARGV[4])
+		end
+	end
+	if count > tonumber(ARGV[2]) or size > tonumber(ARGV[3]) then
+		redis.call("decrby", KEYS[1], 1)
+		redis.call("decrby", KEYS[2], ARGV[1])
+		return 0
+	end
+	return 1
+	`)
+
+	keys := []string{
+		createCopyMoveProjectIDKey(projectID, now, "count"),
+		createCopyMoveProjectIDKey(projectID, now, "size"),
+	}
+	allowed, err := script.Run(ctx, cache.client, keys, size, countLimit, sizeLimit, int(copyMoveUsageTTL.Seconds())).Int()
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("Redis eval failed: %w", err)
+	}
+
+	if allowed == 0 {
+		return accounting.ErrProjectLimitExceeded.New("Copy or move of %d bytes exceeds project daily limit of %d operations or %d bytes", size, countLimit, sizeLimit)
+	}
+
+	return nil
+}
+
 // GetAllProjectTotals iterates through the live accounting DB and returns a map of project IDs and totals, amount of segments.
 //
 // TODO (https://storjlabs.atlassian.net/browse/IN-173): see if it possible to
@@ -225,6 +265,11 @@ func (cache *redisLiveAccounting) GetAllProjectTotals(ctx context.Context) (_ ma
 			continue
 		}
 
+		// skip copy and move keys
+		if strings.Contains(key, copyMoveKeyInfix) {
+			continue
+		}
+
 		if strings.HasSuffix(key, "segment") {
 			projectID, err := uuid.FromBytes([]byte(strings.TrimSuffix(key, ":segment")))
 			if err != nil {
@@ -325,3 +370,16 @@ func createBandwidthProjectIDKey(projectID uuid.UUID, now time.Time) string {
 func createSegmentProjectIDKey(projectID uuid.UUID) string {
 	return string(projectID[:]) + ":segment"
 }
+
+// copyMoveKeyInfix separates the project and the day from the counter in the copy and move keys.
+const copyMoveKeyInfix = ":copymove-"
+
+// copyMoveUsageTTL is how long the daily copy and move usage is kept.
+const copyMoveUsageTTL = 48 * time.Hour
+
+// createCopyMoveProjectIDKey creates the key of the daily copy and move counter of the project.
+// The current UTC day is combined with projectID to create a prefix.
+func createCopyMoveProjectIDKey(projectID uuid.UUID, now time.Time, counter string) string {
+	_, month, day := now.UTC().Date()
+	return string(projectID[:]) + string(byte(month)) + string(byte(day)) + copyMoveKeyInfix + counter
+}
@@ -72,27 +72,7 @@ func (usage *Service) ExceedsBandwidthUsage(ctx context.Context, projectID uuid.
 	})
 	group.Go(func() error {
 		var err error
-
-		// Get the current bandwidth usage from cache.
-		bandwidthUsage, err = usage.liveAccounting.GetProjectBandwidthUsage(ctx, projectID, usage.nowFn())
-		if err != nil {
-			// Verify If the cache key was not found
-			if ErrKeyNotFound.Has(err) {
-
-				// Get current bandwidth value from database.
-				now := usage.nowFn()
-				bandwidthUsage, err = usage.GetProjectBandwidth(ctx, projectID, now.Year(), now.Month(), now.Day())
-				if err != nil {
-					return err
-				}
-
-				// Create cache key with database value.
-				_, err = usage.liveAccounting.InsertProjectBandwidthUsage(ctx, projectID, bandwidthUsage, usage.bandwidthCacheTTL, usage.nowFn())
-				if err != nil {
-					return err
-				}
-			}
-		}
+		bandwidthUsage, err = usage.getBandwidthUsage(ctx, projectID)
 		return err
 	})
 
@@ -109,6 +89,32 @@ func (usage *Service) ExceedsBandwidthUsage(ctx context.Context, projectID uuid.
 	return false, limit, nil
 }
 
+// getBandwidthUsage returns the current bandwidth usage from the cache. When
+// the cache doesn't have it, the usage is read from the database and cached.
+func (usage *Service) getBandwidthUsage(ctx context.Context, projectID uuid.UUID) (bandwidthUsage int64, err error) {
+	// Get the current bandwidth usage from cache.
+	bandwidthUsage, err = usage.liveAccounting.GetProjectBandwidthUsage(ctx, projectID, usage.nowFn())
+	if err != nil {
+		// Verify If the cache key was not found
+		if ErrKeyNotFound.Has(err) {
+
+			// Get current bandwidth value from database.
+			now := usage.nowFn()
+			bandwidthUsage, err = usage.GetProjectBandwidth(ctx, projectID, now.Year(), now.Month(), now.Day())
+			if err != nil {
+				return 0, err
+			}
+
+			// Create cache key with database value.
+			_, err = usage.liveAccounting.InsertProjectBandwidthUsage(ctx, projectID, bandwidthUsage, usage.bandwidthCacheTTL, usage.nowFn())
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+	return bandwidthUsage, err
+}
+
 // UploadLimit contains upload limit characteristics.
 type UploadLimit struct {
 	ExceedsStorage  bool
@@ -164,6 +170,55 @@ func (usage *Service) ExceedsUploadLimits(
 	return limit, nil
 }
 
+// ProjectUsageAndLimits contains the current usage and the limits of a project.
+type ProjectUsageAndLimits struct {
+	StorageUsage   int64
+	StorageLimit   int64
+	SegmentUsage   int64
+	SegmentLimit   int64
+	BandwidthUsage int64
+	BandwidthLimit int64
+}
+
+// GetProjectUsageAndLimits returns the current storage, segment and bandwidth
+// usage of the project together with its limits.
+func (usage *Service) GetProjectUsageAndLimits(ctx context.Context, projectID uuid.UUID) (result ProjectUsageAndLimits, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	var group errgroup.Group
+
+	group.Go(func() error {
+		limits, err := usage.projectLimitCache.GetLimits(ctx, projectID)
+		if err != nil {
+			return err
+		}
+		result.StorageLimit = *limits.Usage
+		result.SegmentLimit = *limits.Segments
+		result.BandwidthLimit = *limits.Bandwidth
+		return nil
+	})
+	group.Go(func() error {
+		var err error
+		result.StorageUsage, err = usage.GetProjectStorageTotals(ctx, projectID)
+		return err
+	})
+	group.Go(func() error {
+		var err error
+		result.SegmentUsage, err = usage.GetProjectSegmentTotals(ctx, projectID)
+		return err
+	})
+	group.Go(func() error {
+		var err error
+		result.BandwidthUsage, err = usage.getBandwidthUsage(ctx, projectID)
+		return err
+	})
+
+	if err := group.Wait(); err != nil {
+		return ProjectUsageAndLimits{}, ErrProjectUsage.Wrap(err)
+	}
+	return result, nil
+}
+
 // AddProjectUsageUpToLimit increases segment and storage usage up to the projects limit.
 // If the limit is exceeded, neither usage is increased and accounting.ErrProjectLimitExceeded is returned.
 func (usage *Service) AddProjectUsageUpToLimit(ctx context.Context, projectID uuid.UUID, storage int64, segments int64) (err error) {
@@ -191,6 +246,14 @@ func (usage *Service) AddProjectUsageUpToLimit(ctx context.Context, projectID uu
 	return err
 }
 
+// AddProjectCopyMoveUsageUpToLimit counts a server-side copy or move of an object of the size
+// in the project's usage of the current day. If either limit is exceeded, the usage is not
+// increased and accounting.ErrProjectLimitExceeded is returned.
+func (usage *Service) AddProjectCopyMoveUsageUpToLimit(ctx context.Context, projectID uuid.UUID, size int64, countLimit int64, sizeLimit int64) (err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+	return usage.liveAccounting.AddProjectCopyMoveUsageUpToLimit(ctx, projectID, size, countLimit, sizeLimit, usage.nowFn())
+}
+
 // GetProjectStorageTotals returns total amount of storage used by project.
 //
 // It can return one of the following errors returned by
@@ -12,6 +12,7 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/accounting"
 )
 
@@ -32,6 +33,8 @@ type Service struct {
 	deleteTallies          bool
 	deleteTalliesBatchSize int
 	OrderExpiration        time.Duration
+
+	nowFn func() time.Time
 }
 
 // New creates a new rollup service.
@@ -43,6 +46,8 @@ func New(logger *zap.Logger, sdb accounting.StoragenodeAccounting, config Config
 		deleteTallies:          config.DeleteTallies,
 		deleteTalliesBatchSize: config.DeleteTalliesBatchSize,
 		OrderExpiration:        orderExpiration,
+
+		nowFn: time.Now,
 	}
 }
 
@@ -67,6 +72,13 @@ func (r *Service) Close() error {
 // Rollup aggregates storage and bandwidth amounts for the time interval.
 func (r *Service) Rollup(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
+
+	// recompute the periods of the runs, which were interrupted, before
+	// rolling up anything new.
+	if _, err := r.Repair(ctx); err != nil {
+		return Error.Wrap(err)
+	}
+
 	// only Rollup new things - get LastRollup
 	lastRollup, err := r.sdb.LastTimestamp(ctx, accounting.LastRollup)
 	if err != nil {
@@ -77,13 +89,22 @@ func (r *Service) Rollup(ctx context.Context) (err error) {
 		lastRollup = lastRollup.Add(-r.OrderExpiration)
 	}
 
+	return r.rollupSince(ctx, lastRollup, nil)
+}
+
+// rollupSince rolls up the tallies and the bandwidth since the time. When
+// repairing an interrupted run, it's passed as the repaired run, otherwise
+// a new run is recorded.
+func (r *Service) rollupSince(ctx context.Context, since time.Time, repaired *accounting.RollupRun) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	rollupStats := make(accounting.RollupStats)
-	latestTally, err := r.RollupStorage(ctx, lastRollup, rollupStats)
+	latestTally, err := r.RollupStorage(ctx, since, rollupStats)
 	if err != nil {
 		return Error.Wrap(err)
 	}
 
-	err = r.RollupBW(ctx, lastRollup, rollupStats)
+	err = r.RollupBW(ctx, since, rollupStats)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -93,24 +114,125 @@ func (r *Service) Rollup(ctx context.Context) (err error) {
 	delete(rollupStats, latestTally)
 	if len(rollupStats) == 0 {
 		r.logger.Info("RollupStats is empty")
+		if repaired != nil {
+			return r.finishRun(ctx, repaired.ID, accounting.RollupRunRepaired)
+		}
 		return nil
 	}
 
+	var runID uuid.UUID
+	if repaired != nil {
+		runID = repaired.ID
+	} else {
+		runID, err = uuid.New()
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		err = r.sdb.InsertRollupRun(ctx, accounting.RollupRun{
+			ID:          runID,
+			Chore:       accounting.RollupRunChoreRollup,
+			Status:      accounting.RollupRunStarted,
+			PeriodStart: since,
+			PeriodEnd:   latestTally,
+			StartedAt:   r.nowFn(),
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
 	err = r.sdb.SaveRollup(ctx, latestTally, rollupStats)
 	if err != nil {
 		return Error.Wrap(err)
 	}
 
-	if r.deleteTallies {
-		// Delete already rolled up tallies
-		latestTally = latestTally.Add(-r.OrderExpiration)
-		err = r.sdb.DeleteTalliesBefore(ctx, latestTally, r.deleteTalliesBatchSize)
+	if repaired == nil {
+		err = r.sdb.UpdateRollupRunStatus(ctx, runID, accounting.RollupRunSaved, nil)
 		if err != nil {
 			return Error.Wrap(err)
 		}
 	}
 
-	return nil
+	if err := r.deleteTalliesBefore(ctx, latestTally); err != nil {
+		return Error.Wrap(err)
+	}
+
+	if repaired != nil {
+		return r.finishRun(ctx, runID, accounting.RollupRunRepaired)
+	}
+	return r.finishRun(ctx, runID, accounting.RollupRunCompleted)
+}
+
+// Repair recomputes the periods of the rollup runs, which were interrupted.
+// Every interrupted run is repaired exactly once, even when the repair runs
+// concurrently.
+func (r *Service) Repair(ctx context.Context) (repaired int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	runs, err := r.sdb.ListIncompleteRollupRuns(ctx, accounting.RollupRunChoreRollup)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	mon.IntVal("rollup_incomplete_runs").Observe(int64(len(runs)))
+
+	for _, run := range runs {
+		run := run
+		if run.RepairClaimedAt != nil {
+			r.logger.Warn("repair of the rollup run was already started",
+				zap.Stringer("Run", run.ID), zap.Time("Claimed At", *run.RepairClaimedAt))
+			continue
+		}
+
+		claimed, err := r.sdb.ClaimRollupRunRepair(ctx, run.ID, r.nowFn())
+		if err != nil {
+			return repaired, Error.Wrap(err)
+		}
+		if !claimed {
+			continue
+		}
+
+		r.logger.Warn("repairing interrupted rollup run",
+			zap.Stringer("Run", run.ID),
+			zap.String("Status", string(run.Status)),
+			zap.Time("Period Start", run.PeriodStart),
+			zap.Time("Period End", run.PeriodEnd))
+
+		switch run.Status {
+		case accounting.RollupRunSaved:
+			// the rollups were saved and the last rollup timestamp was updated,
+			// recomputing them now would miss the already deleted tallies.
+			if err := r.deleteTalliesBefore(ctx, run.PeriodEnd); err != nil {
+				return repaired, Error.Wrap(err)
+			}
+			if err := r.finishRun(ctx, run.ID, accounting.RollupRunRepaired); err != nil {
+				return repaired, Error.Wrap(err)
+			}
+		default:
+			// the rollups might be only partially saved, however saving them
+			// overwrites the days, so the period is recomputed from the tallies.
+			if err := r.rollupSince(ctx, run.PeriodStart, &run); err != nil {
+				return repaired, Error.Wrap(err)
+			}
+		}
+		repaired++
+	}
+
+	return repaired, nil
+}
+
+// deleteTalliesBefore deletes the rolled up tallies, when configured.
+func (r *Service) deleteTalliesBefore(ctx context.Context, latestTally time.Time) (err error) {
+	if !r.deleteTallies {
+		return nil
+	}
+	// Delete already rolled up tallies
+	return r.sdb.DeleteTalliesBefore(ctx, latestTally.Add(-r.OrderExpiration), r.deleteTalliesBatchSize)
+}
+
+// finishRun marks the rollup run as finished.
+func (r *Service) finishRun(ctx context.Context, id uuid.UUID, status accounting.RollupRunStatus) error {
+	finishedAt := r.nowFn()
+	return Error.Wrap(r.sdb.UpdateRollupRunStatus(ctx, id, status, &finishedAt))
 }
 
 // RollupStorage rolls up storage tally, modifies rollupStats map.
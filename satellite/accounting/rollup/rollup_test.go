@@ -17,6 +17,7 @@ import (
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/accounting/rollup"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
@@ -104,6 +105,80 @@ func TestRollupNoDeletes(t *testing.T) {
 	})
 }
 
+func TestRollupRepair(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		const (
+			days         = 3
+			atRestAmount = 10
+			putAmount    = 30
+		)
+
+		var (
+			ordersDB       = db.Orders()
+			snAccountingDB = db.StoragenodeAccounting()
+			storageNodes   = createNodes(ctx, t, db)
+		)
+
+		rollupService := rollup.New(testplanet.NewLogger(t), snAccountingDB, rollup.Config{Interval: 120 * time.Second}, time.Hour)
+
+		initialTime := time.Now().UTC().AddDate(0, 0, -days)
+		bwAmount := make([]float64, len(storageNodes))
+		bwTotals := make(map[storj.NodeID][]int64)
+		for i, storageNodeID := range storageNodes {
+			bwAmount[i] = float64(atRestAmount)
+			bwTotals[storageNodeID] = []int64{putAmount, 0, 0, 0, 0}
+		}
+		for i := 0; i < days+1; i++ {
+			currentTime := initialTime.AddDate(0, 0, i)
+			require.NoError(t, snAccountingDB.SaveTallies(ctx, currentTime, storageNodes, bwAmount))
+			require.NoError(t, saveBWPhase3(ctx, ordersDB, bwTotals, currentTime))
+		}
+
+		// simulate a crash after the run started, but before the rollups were saved.
+		interrupted := accounting.RollupRun{
+			ID:        testrand.UUID(),
+			Chore:     accounting.RollupRunChoreRollup,
+			Status:    accounting.RollupRunStarted,
+			PeriodEnd: initialTime.AddDate(0, 0, days),
+			StartedAt: time.Now(),
+		}
+		require.NoError(t, snAccountingDB.InsertRollupRun(ctx, interrupted))
+
+		runs, err := snAccountingDB.ListIncompleteRollupRuns(ctx, accounting.RollupRunChoreRollup)
+		require.NoError(t, err)
+		require.Len(t, runs, 1)
+		require.Equal(t, interrupted.ID, runs[0].ID)
+
+		repaired, err := rollupService.Repair(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, repaired)
+
+		// the run is repaired only once.
+		claimed, err := snAccountingDB.ClaimRollupRunRepair(ctx, interrupted.ID, time.Now())
+		require.NoError(t, err)
+		require.False(t, claimed)
+
+		repaired, err = rollupService.Repair(ctx)
+		require.NoError(t, err)
+		require.Zero(t, repaired)
+
+		// the regular rollup recomputes the same days without double counting them.
+		require.NoError(t, rollupService.Rollup(ctx))
+
+		runs, err = snAccountingDB.ListIncompleteRollupRuns(ctx, accounting.RollupRunChoreRollup)
+		require.NoError(t, err)
+		require.Empty(t, runs)
+
+		rows, err := snAccountingDB.QueryPaymentInfo(ctx, initialTime.Add(-24*time.Hour), initialTime.AddDate(0, 0, days+1))
+		require.NoError(t, err)
+		require.Len(t, rows, len(storageNodes))
+		for _, row := range rows {
+			require.Equal(t, int64(days*putAmount), row.PutTotal)
+			require.Equal(t, float64(days*atRestAmount), row.AtRestTotal)
+		}
+	})
+}
+
 func createNodes(ctx *testcontext.Context, t *testing.T, db satellite.DB) []storj.NodeID {
 	storageNodes := []storj.NodeID{}
 	for i := 0; i < 10; i++ {
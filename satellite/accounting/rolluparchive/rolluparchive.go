@@ -12,6 +12,8 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/clock"
 	"storj.io/storj/satellite/accounting"
 )
 
@@ -39,6 +41,7 @@ type Chore struct {
 	batchSize         int
 	nodeAccounting    accounting.StoragenodeAccounting
 	projectAccounting accounting.ProjectAccounting
+	clock             clock.Clock
 }
 
 // New creates a new rollup archiver chore.
@@ -50,9 +53,15 @@ func New(log *zap.Logger, sdb accounting.StoragenodeAccounting, pdb accounting.P
 		batchSize:         config.BatchSize,
 		nodeAccounting:    sdb,
 		projectAccounting: pdb,
+		clock:             clock.Real{},
 	}
 }
 
+// SetClock sets the clock, which determines the cutoff of the archived rollups.
+func (chore *Chore) SetClock(clock clock.Clock) {
+	chore.clock = clock
+}
+
 // Run starts the archiver chore.
 func (chore *Chore) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -60,7 +69,7 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 		return Error.New("archive age can't be less than 0")
 	}
 	return chore.Loop.Run(ctx, func(ctx context.Context) error {
-		cutoff := time.Now().UTC().Add(-chore.archiveAge)
+		cutoff := chore.clock.Now().UTC().Add(-chore.archiveAge)
 		err := chore.ArchiveRollups(ctx, cutoff, chore.batchSize)
 		if err != nil {
 			chore.log.Error("error archiving SN and bucket bandwidth rollups", zap.Error(err))
@@ -78,6 +87,81 @@ func (chore *Chore) Close() error {
 // ArchiveRollups will remove old rollups from active rollup tables.
 func (chore *Chore) ArchiveRollups(ctx context.Context, cutoff time.Time, batchSize int) (err error) {
 	defer mon.Task()(&ctx)(&err)
+
+	// finish the archiving of the runs, which were interrupted, before
+	// starting a new one.
+	if _, err := chore.Repair(ctx, batchSize); err != nil {
+		return Error.Wrap(err)
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	err = chore.nodeAccounting.InsertRollupRun(ctx, accounting.RollupRun{
+		ID:        id,
+		Chore:     accounting.RollupRunChoreArchive,
+		Status:    accounting.RollupRunStarted,
+		PeriodEnd: cutoff,
+		StartedAt: chore.clock.Now(),
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if err := chore.archiveRollups(ctx, cutoff, batchSize); err != nil {
+		return err
+	}
+
+	return chore.finishRun(ctx, id, accounting.RollupRunCompleted)
+}
+
+// Repair archives the rollups of the archiving runs, which were interrupted.
+// Every interrupted run is repaired exactly once, even when the repair runs
+// concurrently.
+func (chore *Chore) Repair(ctx context.Context, batchSize int) (repaired int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	runs, err := chore.nodeAccounting.ListIncompleteRollupRuns(ctx, accounting.RollupRunChoreArchive)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	mon.IntVal("rollup_archive_incomplete_runs").Observe(int64(len(runs)))
+
+	for _, run := range runs {
+		if run.RepairClaimedAt != nil {
+			chore.log.Warn("repair of the rollup archive run was already started",
+				zap.Stringer("Run", run.ID), zap.Time("Claimed At", *run.RepairClaimedAt))
+			continue
+		}
+
+		claimed, err := chore.nodeAccounting.ClaimRollupRunRepair(ctx, run.ID, chore.clock.Now())
+		if err != nil {
+			return repaired, Error.Wrap(err)
+		}
+		if !claimed {
+			continue
+		}
+
+		chore.log.Warn("repairing interrupted rollup archive run",
+			zap.Stringer("Run", run.ID), zap.Time("Cutoff", run.PeriodEnd))
+
+		// archiving moves the rollups, so repeating it with the same cutoff
+		// moves only the rollups, which weren't moved before the crash.
+		if err := chore.archiveRollups(ctx, run.PeriodEnd, batchSize); err != nil {
+			return repaired, err
+		}
+		if err := chore.finishRun(ctx, run.ID, accounting.RollupRunRepaired); err != nil {
+			return repaired, err
+		}
+		repaired++
+	}
+
+	return repaired, nil
+}
+
+func (chore *Chore) archiveRollups(ctx context.Context, cutoff time.Time, batchSize int) (err error) {
+	defer mon.Task()(&ctx)(&err)
 	nodeRollupsArchived, err := chore.nodeAccounting.ArchiveRollupsBefore(ctx, cutoff, batchSize)
 	if err != nil {
 		chore.log.Error("archiving bandwidth rollups", zap.Int("node rollups archived", nodeRollupsArchived), zap.Error(err))
@@ -90,3 +174,9 @@ func (chore *Chore) ArchiveRollups(ctx context.Context, cutoff time.Time, batchS
 	}
 	return nil
 }
+
+// finishRun marks the archiving run as finished.
+func (chore *Chore) finishRun(ctx context.Context, id uuid.UUID, status accounting.RollupRunStatus) error {
+	finishedAt := chore.clock.Now()
+	return Error.Wrap(chore.nodeAccounting.UpdateRollupRunStatus(ctx, id, status, &finishedAt))
+}
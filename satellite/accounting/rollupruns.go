@@ -0,0 +1,48 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// RollupRunChore is the chore, which recorded a rollup run.
+type RollupRunChore string
+
+const (
+	// RollupRunChoreRollup is the rollup chore.
+	RollupRunChoreRollup RollupRunChore = "rollup"
+	// RollupRunChoreArchive is the rollup archive chore.
+	RollupRunChoreArchive RollupRunChore = "rollup-archive"
+)
+
+// RollupRunStatus is the progress of a rollup run.
+type RollupRunStatus string
+
+const (
+	// RollupRunStarted means the run started, but the rollups might be only partially saved.
+	RollupRunStarted RollupRunStatus = "started"
+	// RollupRunSaved means the rollups were saved, but the cleanup after them didn't finish.
+	RollupRunSaved RollupRunStatus = "saved"
+	// RollupRunCompleted means the run finished successfully.
+	RollupRunCompleted RollupRunStatus = "completed"
+	// RollupRunRepaired means the run was interrupted and its period was recomputed.
+	RollupRunRepaired RollupRunStatus = "repaired"
+)
+
+// RollupRun is the idempotency marker of a single run of the rollup chores.
+// A run, which is neither completed nor repaired, was interrupted by a crash.
+type RollupRun struct {
+	ID          uuid.UUID
+	Chore       RollupRunChore
+	Status      RollupRunStatus
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	StartedAt   time.Time
+
+	FinishedAt      *time.Time
+	RepairClaimedAt *time.Time
+}
@@ -22,10 +22,14 @@ import (
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/admin"
 	"storj.io/storj/satellite/analytics"
+	"storj.io/storj/satellite/appeals"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/restkeys"
+	"storj.io/storj/satellite/featureflags"
+	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/nodemessages"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/stripe"
 )
@@ -75,6 +79,10 @@ type Admin struct {
 	FreezeAccounts struct {
 		Service *console.AccountFreezeService
 	}
+
+	Mail struct {
+		Service *mailservice.Service
+	}
 }
 
 // NewAdmin creates a new satellite admin peer.
@@ -92,6 +100,7 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 
 	{
 		peer.Buckets.Service = buckets.NewService(db.Buckets(), metabaseDB)
+		peer.Buckets.Service.SetMetadataHistory(peer.Log.Named("buckets:history"), db.BucketMetadataHistory())
 	}
 
 	{ // setup rest keys
@@ -135,6 +144,16 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 		})
 	}
 
+	var notifier *console.Notifier
+	if notificationsConfig := config.Console.Config.Notifications; notificationsConfig.Enabled {
+		notifier = console.NewNotifier(
+			peer.Log.Named("console:notifications"),
+			peer.DB.Console().Notifications(),
+			peer.DB.Console().Projects(),
+			notificationsConfig,
+		)
+	}
+
 	{ // setup payments
 		pc := config.Payments
 
@@ -190,6 +209,20 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 			db.Console().Projects(),
 			analytics.NewService(peer.Log.Named("analytics:service"), config.Analytics, config.Console.SatelliteName),
 		)
+		peer.FreezeAccounts.Service.SetNotifier(notifier)
+	}
+
+	if config.Mail.TemplatePath != "" { // setup mailservice for template management
+		var err error
+		peer.Mail.Service, err = setupMailService(peer.Log, peer.DB, *config)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		peer.Services.Add(lifecycle.Item{
+			Name:  "mail:service",
+			Close: peer.Mail.Service.Close,
+		})
 	}
 
 	{ // setup admin endpoint
@@ -202,7 +235,36 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 		adminConfig := config.Admin
 		adminConfig.AuthorizationToken = config.Console.AuthToken
 
-		peer.Admin.Server = admin.NewServer(log.Named("admin"), peer.Admin.Listener, peer.DB, peer.Buckets.Service, peer.REST.Keys, peer.FreezeAccounts.Service, peer.Payments.Accounts, config.Console, adminConfig)
+		peer.Admin.Server = admin.NewServer(log.Named("admin"), peer.Admin.Listener, peer.DB, peer.Buckets.Service, peer.REST.Keys, peer.FreezeAccounts.Service, peer.Payments.Accounts, peer.Mail.Service, config.Console, adminConfig)
+		if config.Appeals.Enabled {
+			peer.Admin.Server.SetAppeals(appeals.NewService(
+				peer.Log.Named("appeals:service"),
+				peer.DB.NodeAppeals(),
+				peer.DB.OverlayCache(),
+				peer.DB.Reputation(),
+				config.Reputation,
+				config.Appeals,
+			))
+		}
+		if notifier != nil {
+			peer.Admin.Server.SetNotifier(notifier)
+		}
+		if config.NodeMessages.Enabled {
+			peer.Admin.Server.SetNodeMessages(nodemessages.NewService(
+				peer.Log.Named("nodemessages:service"),
+				peer.DB.NodeMessages(),
+				config.NodeMessages,
+			))
+		}
+		featureFlags, err := featureflags.NewService(
+			peer.Log.Named("featureflags"),
+			peer.DB.FeatureFlags(),
+			config.FeatureFlags,
+		)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Admin.Server.SetFeatureFlags(featureFlags)
 		peer.Servers.Add(lifecycle.Item{
 			Name:  "admin",
 			Run:   peer.Admin.Server.Run,
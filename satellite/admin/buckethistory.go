@@ -0,0 +1,166 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/storj/satellite/buckets"
+)
+
+// bucketMetadataState is the JSON representation of a recorded state of the bucket metadata.
+type bucketMetadataState struct {
+	Version             int64     `json:"version"`
+	BucketID            string    `json:"bucketId"`
+	EncryptionCipher    int       `json:"encryptionCipher"`
+	EncryptionBlockSize int32     `json:"encryptionBlockSize"`
+	Versioning          string    `json:"versioning"`
+	ObjectLockEnabled   bool      `json:"objectLockEnabled"`
+	Deleted             bool      `json:"deleted"`
+	ChangedAt           time.Time `json:"changedAt"`
+}
+
+func toBucketMetadataState(state buckets.MetadataState) bucketMetadataState {
+	return bucketMetadataState{
+		Version:             state.Version,
+		BucketID:            state.BucketID.String(),
+		EncryptionCipher:    int(state.DefaultEncryptionParameters.CipherSuite),
+		EncryptionBlockSize: state.DefaultEncryptionParameters.BlockSize,
+		Versioning:          state.Versioning.String(),
+		ObjectLockEnabled:   state.ObjectLockEnabled,
+		Deleted:             state.Deleted,
+		ChangedAt:           state.ChangedAt,
+	}
+}
+
+func (server *Server) getBucketHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	var output interface{}
+	if value := r.URL.Query().Get("at"); value != "" {
+		at, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			sendJSONError(w, "invalid at",
+				"at must be a RFC 3339 time", http.StatusBadRequest)
+			return
+		}
+
+		state, err := server.buckets.GetMetadataStateAt(ctx, project.UUID, string(bucket), at)
+		if err != nil {
+			if buckets.ErrMetadataStateNotFound.Has(err) {
+				sendJSONError(w, "no bucket metadata recorded at the time",
+					err.Error(), http.StatusNotFound)
+				return
+			}
+			sendJSONError(w, "failed to get bucket metadata",
+				err.Error(), http.StatusInternalServerError)
+			return
+		}
+		output = toBucketMetadataState(state)
+	} else {
+		limit := 100
+		if value := r.URL.Query().Get("limit"); value != "" {
+			limit, err = strconv.Atoi(value)
+			if err != nil || limit <= 0 {
+				sendJSONError(w, "invalid limit",
+					"limit must be a positive number", http.StatusBadRequest)
+				return
+			}
+		}
+
+		states, err := server.buckets.GetMetadataHistory(ctx, project.UUID, string(bucket), limit)
+		if err != nil {
+			sendJSONError(w, "failed to get bucket metadata history",
+				err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		history := make([]bucketMetadataState, 0, len(states))
+		for _, state := range states {
+			history = append(history, toBucketMetadataState(state))
+		}
+		output = history
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) setBucketVersioning(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	versioning, err := buckets.ParseVersioning(r.URL.Query().Get("state"))
+	if err != nil {
+		sendJSONError(w, "invalid versioning state",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	state, err := server.buckets.SetVersioning(ctx, project.UUID, string(bucket), versioning)
+	server.sendBucketMetadataState(w, state, err)
+}
+
+func (server *Server) enableBucketObjectLock(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	state, err := server.buckets.EnableObjectLock(ctx, project.UUID, string(bucket))
+	server.sendBucketMetadataState(w, state, err)
+}
+
+// sendBucketMetadataState writes the changed state of the bucket metadata or the error of
+// the change.
+func (server *Server) sendBucketMetadataState(w http.ResponseWriter, state buckets.MetadataState, err error) {
+	if err != nil {
+		switch {
+		case buckets.ErrBucketNotFound.Has(err):
+			sendJSONError(w, "bucket does not exist",
+				"", http.StatusNotFound)
+		case buckets.ErrInvalidTransition.Has(err):
+			sendJSONError(w, "invalid bucket state transition",
+				err.Error(), http.StatusConflict)
+		default:
+			sendJSONError(w, "failed to change bucket state",
+				err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	data, err := json.Marshal(toBucketMetadataState(state))
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
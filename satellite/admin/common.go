@@ -4,6 +4,7 @@
 package admin
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"net/http"
 
@@ -35,3 +36,10 @@ func sendJSONData(w http.ResponseWriter, statusCode int, data []byte) {
 	w.WriteHeader(statusCode)
 	_, _ = w.Write(data) // any error here entitles a client side disconnect or similar, which we do not care about.
 }
+
+func sendCSV(w http.ResponseWriter, filename string, rows [][]string) {
+	w.Header().Set("Content-Type", "text/csv; charset=UTF-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+	w.WriteHeader(http.StatusOK)
+	_ = csv.NewWriter(w).WriteAll(rows) // see sendJSONData.
+}
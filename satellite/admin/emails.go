@@ -0,0 +1,163 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/storj/satellite/mailservice"
+)
+
+func (server *Server) listEmailTemplates(w http.ResponseWriter, r *http.Request) {
+	if server.mail == nil {
+		sendJSONError(w, "mail service is not configured",
+			"", http.StatusNotFound)
+		return
+	}
+
+	templates, locales := server.mail.TemplateNames()
+
+	data, err := json.Marshal(struct {
+		Templates []string `json:"templates"`
+		Locales   []string `json:"locales"`
+	}{
+		Templates: templates,
+		Locales:   locales,
+	})
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) reloadEmailTemplates(w http.ResponseWriter, r *http.Request) {
+	if server.mail == nil {
+		sendJSONError(w, "mail service is not configured",
+			"", http.StatusNotFound)
+		return
+	}
+
+	if err := server.mail.Reload(); err != nil {
+		sendJSONError(w, "failed to reload templates",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (server *Server) previewEmailTemplate(w http.ResponseWriter, r *http.Request) {
+	if server.mail == nil {
+		sendJSONError(w, "mail service is not configured",
+			"", http.StatusNotFound)
+		return
+	}
+
+	vars := mux.Vars(r)
+	name, ok := vars["name"]
+	if !ok {
+		sendJSONError(w, "template name missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	// every query parameter apart from locale is passed to the template
+	// so that placeholders can be filled with sample values.
+	query := r.URL.Query()
+	locale := query.Get("locale")
+	data := map[string]string{}
+	for key := range query {
+		if key != "locale" {
+			data[key] = query.Get(key)
+		}
+	}
+
+	content, err := server.mail.Preview(name, locale, data)
+	if err != nil {
+		sendJSONError(w, "failed to render template",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(content))
+}
+
+func (server *Server) listEmailDeliveries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	query := r.URL.Query()
+	filter := mailservice.DeliveryFilter{
+		MessageID: query.Get("message-id"),
+		Recipient: query.Get("email"),
+	}
+	for _, value := range query["status"] {
+		status, ok := mailservice.ParseDeliveryStatus(value)
+		if !ok {
+			sendJSONError(w, "invalid status",
+				value, http.StatusBadRequest)
+			return
+		}
+		filter.Statuses = append(filter.Statuses, status)
+	}
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			sendJSONError(w, "invalid limit",
+				value, http.StatusBadRequest)
+			return
+		}
+		filter.Limit = limit
+	}
+
+	deliveries, err := server.db.EmailDeliveries().List(ctx, filter)
+	if err != nil {
+		sendJSONError(w, "failed to list deliveries",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	type delivery struct {
+		MessageID string    `json:"messageId"`
+		Recipient string    `json:"recipient"`
+		Template  string    `json:"template"`
+		Subject   string    `json:"subject"`
+		Status    string    `json:"status"`
+		Reason    string    `json:"reason,omitempty"`
+		CreatedAt time.Time `json:"createdAt"`
+		UpdatedAt time.Time `json:"updatedAt"`
+	}
+
+	output := make([]delivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		output = append(output, delivery{
+			MessageID: d.MessageID,
+			Recipient: d.Recipient,
+			Template:  d.Template,
+			Subject:   d.Subject,
+			Status:    d.Status.String(),
+			Reason:    d.Reason,
+			CreatedAt: d.CreatedAt,
+			UpdatedAt: d.UpdatedAt,
+		})
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
@@ -0,0 +1,186 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/featureflags"
+)
+
+// featureFlag is the JSON representation of a feature flag.
+type featureFlag struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Enabled     bool      `json:"enabled"`
+	Percentage  int       `json:"percentage"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+func toFeatureFlag(flag featureflags.Flag) featureFlag {
+	return featureFlag{
+		Name:        flag.Name,
+		Description: flag.Description,
+		Enabled:     flag.Enabled,
+		Percentage:  flag.Percentage,
+		CreatedAt:   flag.CreatedAt,
+		UpdatedAt:   flag.UpdatedAt,
+	}
+}
+
+func (server *Server) listFeatureFlags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if server.featureFlags == nil {
+		sendJSONError(w, "feature flags are not configured",
+			"", http.StatusNotFound)
+		return
+	}
+
+	flags, err := server.featureFlags.List(ctx)
+	if err != nil {
+		sendJSONError(w, "failed to list feature flags",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := make([]featureFlag, 0, len(flags))
+	for _, flag := range flags {
+		output = append(output, toFeatureFlag(flag))
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) getFeatureFlag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if server.featureFlags == nil {
+		sendJSONError(w, "feature flags are not configured",
+			"", http.StatusNotFound)
+		return
+	}
+
+	flag, err := server.featureFlags.Get(ctx, mux.Vars(r)["name"])
+	if err != nil {
+		if featureflags.ErrNotFound.Has(err) {
+			sendJSONError(w, "feature flag not found",
+				"", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "failed to get feature flag",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := struct {
+		featureFlag
+		// EnabledFor is set, when the flag is checked for a project or user.
+		EnabledFor *bool `json:"enabledFor,omitempty"`
+	}{
+		featureFlag: toFeatureFlag(flag),
+	}
+
+	if value := r.URL.Query().Get("id"); value != "" {
+		id, err := uuid.FromString(value)
+		if err != nil {
+			sendJSONError(w, "invalid id",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+		enabled := flag.Enabled && featureflags.InCohort(flag.Name, id, flag.Percentage)
+		output.EnabledFor = &enabled
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) setFeatureFlag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if server.featureFlags == nil {
+		sendJSONError(w, "feature flags are not configured",
+			"", http.StatusNotFound)
+		return
+	}
+
+	var input struct {
+		Description string `json:"description"`
+		Enabled     bool   `json:"enabled"`
+		Percentage  int    `json:"percentage"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		sendJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	flag, err := server.featureFlags.Set(ctx, featureflags.SetFlag{
+		Name:        mux.Vars(r)["name"],
+		Description: input.Description,
+		Enabled:     input.Enabled,
+		Percentage:  input.Percentage,
+	})
+	if err != nil {
+		if featureflags.ErrInvalid.Has(err) {
+			sendJSONError(w, "invalid feature flag",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+		sendJSONError(w, "failed to set feature flag",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(toFeatureFlag(flag))
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) deleteFeatureFlag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if server.featureFlags == nil {
+		sendJSONError(w, "feature flags are not configured",
+			"", http.StatusNotFound)
+		return
+	}
+
+	err := server.featureFlags.Delete(ctx, mux.Vars(r)["name"])
+	if err != nil {
+		if featureflags.ErrNotFound.Has(err) {
+			sendJSONError(w, "feature flag not found",
+				"", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "failed to delete feature flag",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/appeals"
+)
+
+// nodeAppeal is the JSON representation of an appeal.
+type nodeAppeal struct {
+	ID         string                     `json:"id"`
+	NodeID     string                     `json:"nodeId"`
+	Kind       string                     `json:"kind"`
+	Status     string                     `json:"status"`
+	Message    string                     `json:"message"`
+	Reputation appeals.ReputationSnapshot `json:"reputation"`
+	ReviewNote string                     `json:"reviewNote,omitempty"`
+	ReviewedBy string                     `json:"reviewedBy,omitempty"`
+	ReviewedAt *time.Time                 `json:"reviewedAt,omitempty"`
+	CreatedAt  time.Time                  `json:"createdAt"`
+}
+
+func toNodeAppeal(appeal appeals.Appeal) nodeAppeal {
+	return nodeAppeal{
+		ID:         appeal.ID.String(),
+		NodeID:     appeal.NodeID.String(),
+		Kind:       appeal.Kind.String(),
+		Status:     appeal.Status.String(),
+		Message:    appeal.Message,
+		Reputation: appeal.Reputation,
+		ReviewNote: appeal.ReviewNote,
+		ReviewedBy: appeal.ReviewedBy,
+		ReviewedAt: appeal.ReviewedAt,
+		CreatedAt:  appeal.CreatedAt,
+	}
+}
+
+func (server *Server) listNodeAppeals(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if server.appeals == nil {
+		sendJSONError(w, "node appeals are not configured",
+			"", http.StatusNotFound)
+		return
+	}
+
+	status := appeals.StatusPending
+	if value := r.URL.Query().Get("status"); value != "" {
+		var err error
+		status, err = appeals.ParseStatus(value)
+		if err != nil {
+			sendJSONError(w, "invalid status",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	limit := 100
+	if value := r.URL.Query().Get("limit"); value != "" {
+		var err error
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			sendJSONError(w, "invalid limit",
+				"limit must be a positive number", http.StatusBadRequest)
+			return
+		}
+	}
+
+	list, err := server.appeals.ListByStatus(ctx, status, limit)
+	if err != nil {
+		sendJSONError(w, "failed to list appeals",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := make([]nodeAppeal, 0, len(list))
+	for _, appeal := range list {
+		output = append(output, toNodeAppeal(appeal))
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) getNodeAppeal(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if server.appeals == nil {
+		sendJSONError(w, "node appeals are not configured",
+			"", http.StatusNotFound)
+		return
+	}
+
+	id, ok := appealIDFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	appeal, events, err := server.appeals.Get(ctx, id)
+	if err != nil {
+		if appeals.ErrNotFound.Has(err) {
+			sendJSONError(w, "appeal not found",
+				"", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "failed to get appeal",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	type event struct {
+		Action    string    `json:"action"`
+		Actor     string    `json:"actor"`
+		Note      string    `json:"note,omitempty"`
+		CreatedAt time.Time `json:"createdAt"`
+	}
+
+	output := struct {
+		nodeAppeal
+		Events []event `json:"events"`
+	}{
+		nodeAppeal: toNodeAppeal(appeal),
+		Events:     make([]event, 0, len(events)),
+	}
+	for _, e := range events {
+		output.Events = append(output.Events, event{
+			Action:    string(e.Action),
+			Actor:     e.Actor,
+			Note:      e.Note,
+			CreatedAt: e.CreatedAt,
+		})
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) approveNodeAppeal(w http.ResponseWriter, r *http.Request) {
+	server.reviewNodeAppeal(w, r, server.appeals.Approve)
+}
+
+func (server *Server) rejectNodeAppeal(w http.ResponseWriter, r *http.Request) {
+	server.reviewNodeAppeal(w, r, server.appeals.Reject)
+}
+
+// reviewNodeAppeal approves or rejects the appeal with the decision.
+func (server *Server) reviewNodeAppeal(w http.ResponseWriter, r *http.Request, decide func(ctx context.Context, id uuid.UUID, reviewer, note string) (appeals.Appeal, error)) {
+	ctx := r.Context()
+
+	if server.appeals == nil {
+		sendJSONError(w, "node appeals are not configured",
+			"", http.StatusNotFound)
+		return
+	}
+
+	id, ok := appealIDFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var input struct {
+		Reviewer string `json:"reviewer"`
+		Note     string `json:"note"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		sendJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	appeal, err := decide(ctx, id, input.Reviewer, input.Note)
+	if err != nil {
+		switch {
+		case appeals.ErrNotFound.Has(err):
+			sendJSONError(w, "appeal not found",
+				"", http.StatusNotFound)
+		case appeals.ErrInvalid.Has(err):
+			sendJSONError(w, "invalid review",
+				err.Error(), http.StatusBadRequest)
+		case appeals.ErrReviewed.Has(err):
+			sendJSONError(w, "appeal already reviewed",
+				err.Error(), http.StatusConflict)
+		default:
+			sendJSONError(w, "failed to review appeal",
+				err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	data, err := json.Marshal(toNodeAppeal(appeal))
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func appealIDFromRequest(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	idString, ok := mux.Vars(r)["id"]
+	if !ok {
+		sendJSONError(w, "appeal id missing",
+			"", http.StatusBadRequest)
+		return uuid.UUID{}, false
+	}
+
+	id, err := uuid.FromString(idString)
+	if err != nil {
+		sendJSONError(w, "invalid appeal id",
+			err.Error(), http.StatusBadRequest)
+		return uuid.UUID{}, false
+	}
+	return id, true
+}
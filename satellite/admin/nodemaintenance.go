@@ -0,0 +1,86 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+)
+
+func (server *Server) getNodeMaintenance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	nodeIDString, ok := vars["nodeid"]
+	if !ok {
+		sendJSONError(w, "node-id missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	nodeID, err := storj.NodeIDFromString(nodeIDString)
+	if err != nil {
+		sendJSONError(w, "invalid node-id",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	now := server.nowFn().UTC()
+	since := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if value := r.URL.Query().Get("since"); value != "" {
+		since, err = time.Parse(time.RFC3339, value)
+		if err != nil {
+			sendJSONError(w, "invalid since",
+				"since must be a RFC 3339 time", http.StatusBadRequest)
+			return
+		}
+	}
+
+	windows, err := server.db.OverlayCache().GetMaintenanceWindows(ctx, nodeID, since)
+	if err != nil {
+		sendJSONError(w, "failed to get maintenance windows",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	type window struct {
+		ID            string    `json:"id"`
+		StartsAt      time.Time `json:"startsAt"`
+		EndsAt        time.Time `json:"endsAt"`
+		Reason        string    `json:"reason"`
+		OfflineAudits int64     `json:"offlineAudits"`
+		CreatedAt     time.Time `json:"createdAt"`
+	}
+	output := struct {
+		NodeID  string   `json:"nodeId"`
+		Windows []window `json:"windows"`
+	}{
+		NodeID:  nodeID.String(),
+		Windows: make([]window, 0, len(windows)),
+	}
+	for _, maintenance := range windows {
+		output.Windows = append(output.Windows, window{
+			ID:            maintenance.ID.String(),
+			StartsAt:      maintenance.StartsAt,
+			EndsAt:        maintenance.EndsAt,
+			Reason:        maintenance.Reason,
+			OfflineAudits: maintenance.OfflineAudits,
+			CreatedAt:     maintenance.CreatedAt,
+		})
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
@@ -0,0 +1,253 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/nodemessages"
+)
+
+// nodeMessage is the JSON representation of a message to the storage node operators.
+type nodeMessage struct {
+	ID              string     `json:"id"`
+	Kind            string     `json:"kind"`
+	Title           string     `json:"title"`
+	Body            string     `json:"body"`
+	Recipients      []string   `json:"recipients,omitempty"`
+	ExpiresAt       *time.Time `json:"expiresAt,omitempty"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	Acknowledgments int        `json:"acknowledgments"`
+}
+
+func toNodeMessage(message nodemessages.Message) nodeMessage {
+	output := nodeMessage{
+		ID:              message.ID.String(),
+		Kind:            string(message.Kind),
+		Title:           message.Title,
+		Body:            message.Body,
+		ExpiresAt:       message.ExpiresAt,
+		CreatedAt:       message.CreatedAt,
+		Acknowledgments: message.Acknowledgments,
+	}
+	for _, nodeID := range message.Recipients {
+		output.Recipients = append(output.Recipients, nodeID.String())
+	}
+	return output
+}
+
+func (server *Server) listNodeMessages(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if server.nodeMessages == nil {
+		sendJSONError(w, "node messages are not configured",
+			"", http.StatusNotFound)
+		return
+	}
+
+	limit := 100
+	if value := r.URL.Query().Get("limit"); value != "" {
+		var err error
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			sendJSONError(w, "invalid limit",
+				"limit must be a positive number", http.StatusBadRequest)
+			return
+		}
+	}
+
+	list, err := server.nodeMessages.List(ctx, limit)
+	if err != nil {
+		sendJSONError(w, "failed to list node messages",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := make([]nodeMessage, 0, len(list))
+	for _, message := range list {
+		output = append(output, toNodeMessage(message))
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) publishNodeMessage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if server.nodeMessages == nil {
+		sendJSONError(w, "node messages are not configured",
+			"", http.StatusNotFound)
+		return
+	}
+
+	var input struct {
+		Kind       string     `json:"kind"`
+		Title      string     `json:"title"`
+		Body       string     `json:"body"`
+		Recipients []string   `json:"recipients"`
+		ExpiresAt  *time.Time `json:"expiresAt"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		sendJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	kind, err := nodemessages.ParseKind(input.Kind)
+	if err != nil {
+		sendJSONError(w, "invalid kind",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	recipients := make([]storj.NodeID, 0, len(input.Recipients))
+	for _, value := range input.Recipients {
+		nodeID, err := storj.NodeIDFromString(value)
+		if err != nil {
+			sendJSONError(w, "invalid recipient",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+		recipients = append(recipients, nodeID)
+	}
+
+	message, err := server.nodeMessages.Publish(ctx, nodemessages.NewMessage{
+		Kind:       kind,
+		Title:      input.Title,
+		Body:       input.Body,
+		Recipients: recipients,
+		ExpiresAt:  input.ExpiresAt,
+	})
+	if err != nil {
+		if nodemessages.ErrInvalid.Has(err) {
+			sendJSONError(w, "invalid message",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+		sendJSONError(w, "failed to publish node message",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(toNodeMessage(message))
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) getNodeMessage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if server.nodeMessages == nil {
+		sendJSONError(w, "node messages are not configured",
+			"", http.StatusNotFound)
+		return
+	}
+
+	id, ok := nodeMessageIDFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	message, acknowledgments, err := server.nodeMessages.Get(ctx, id)
+	if err != nil {
+		if nodemessages.ErrNotFound.Has(err) {
+			sendJSONError(w, "node message not found",
+				"", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "failed to get node message",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	type acknowledgment struct {
+		NodeID         string    `json:"nodeId"`
+		AcknowledgedAt time.Time `json:"acknowledgedAt"`
+	}
+
+	output := struct {
+		nodeMessage
+		AcknowledgedBy []acknowledgment `json:"acknowledgedBy"`
+	}{
+		nodeMessage:    toNodeMessage(message),
+		AcknowledgedBy: make([]acknowledgment, 0, len(acknowledgments)),
+	}
+	for _, a := range acknowledgments {
+		output.AcknowledgedBy = append(output.AcknowledgedBy, acknowledgment{
+			NodeID:         a.NodeID.String(),
+			AcknowledgedAt: a.AcknowledgedAt,
+		})
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) deleteNodeMessage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if server.nodeMessages == nil {
+		sendJSONError(w, "node messages are not configured",
+			"", http.StatusNotFound)
+		return
+	}
+
+	id, ok := nodeMessageIDFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := server.nodeMessages.Delete(ctx, id); err != nil {
+		if nodemessages.ErrNotFound.Has(err) {
+			sendJSONError(w, "node message not found",
+				"", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "failed to delete node message",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func nodeMessageIDFromRequest(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	idString, ok := mux.Vars(r)["id"]
+	if !ok {
+		sendJSONError(w, "node message id missing",
+			"", http.StatusBadRequest)
+		return uuid.UUID{}, false
+	}
+
+	id, err := uuid.FromString(idString)
+	if err != nil {
+		sendJSONError(w, "invalid node message id",
+			err.Error(), http.StatusBadRequest)
+		return uuid.UUID{}, false
+	}
+	return id, true
+}
@@ -0,0 +1,73 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/overlay"
+)
+
+func (server *Server) getNodeStorageEstimate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	nodeIDString, ok := vars["nodeid"]
+	if !ok {
+		sendJSONError(w, "node-id missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	nodeID, err := storj.NodeIDFromString(nodeIDString)
+	if err != nil {
+		sendJSONError(w, "invalid node-id",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	estimate, err := server.db.OverlayCache().GetStorageEstimate(ctx, nodeID)
+	if err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			sendJSONError(w, "storage estimate not found",
+				"", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "failed to get storage estimate",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := struct {
+		NodeID       string    `json:"nodeId"`
+		PieceCount   int64     `json:"pieceCount"`
+		StoredBytes  int64     `json:"storedBytes"`
+		SettledBytes int64     `json:"settledBytes"`
+		TotalBytes   int64     `json:"totalBytes"`
+		EstimatedAt  time.Time `json:"estimatedAt"`
+		UpdatedAt    time.Time `json:"updatedAt"`
+	}{
+		NodeID:       estimate.NodeID.String(),
+		PieceCount:   estimate.PieceCount,
+		StoredBytes:  estimate.StoredBytes,
+		SettledBytes: estimate.SettledBytes,
+		TotalBytes:   estimate.Total(),
+		EstimatedAt:  estimate.EstimatedAt,
+		UpdatedAt:    estimate.UpdatedAt,
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
@@ -0,0 +1,57 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"storj.io/storj/satellite/console"
+)
+
+func (server *Server) announceNotification(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if server.notifier == nil {
+		sendJSONError(w, "notifications are not enabled",
+			"", http.StatusNotFound)
+		return
+	}
+
+	var input struct {
+		Title   string `json:"title"`
+		Message string `json:"message"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		sendJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	input.Title = strings.TrimSpace(input.Title)
+	if input.Title == "" {
+		sendJSONError(w, "title is required",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	count, err := server.notifier.Announce(ctx, console.NotificationMaintenance, input.Title, input.Message)
+	if err != nil {
+		sendJSONError(w, "failed to announce notification",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(struct {
+		Notified int64 `json:"notified"`
+	}{count})
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -19,11 +20,15 @@ import (
 	"storj.io/common/macaroon"
 	"storj.io/common/memory"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/payments/stripe"
 )
 
+// usagePeriodLayout is the layout of the billing periods in the API.
+const usagePeriodLayout = "2006-01"
+
 func (server *Server) checkProjectUsage(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -47,6 +52,145 @@ func (server *Server) checkProjectUsage(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+func (server *Server) recomputeProjectUsage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	projectUUIDString, ok := vars["project"]
+	if !ok {
+		sendJSONError(w, "project-uuid missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	projectUUID, err := uuid.FromString(projectUUIDString)
+	if err != nil {
+		sendJSONError(w, "invalid project-uuid",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	query := r.URL.Query()
+	period, err := time.Parse(usagePeriodLayout, query.Get("period"))
+	if err != nil {
+		sendJSONError(w, "invalid period",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+	since, before := period, period.AddDate(0, 1, 0)
+
+	var dryRun bool
+	if value := query.Get("dry-run"); value != "" {
+		dryRun, err = strconv.ParseBool(value)
+		if err != nil {
+			sendJSONError(w, "invalid dry-run",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	_, err = server.db.Console().Projects().Get(ctx, projectUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		sendJSONError(w, "project with specified uuid does not exist",
+			"", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "unable to fetch project details",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	corrections, err := server.db.ProjectAccounting().RecomputeProjectDailyBandwidth(ctx, projectUUID, since, before, dryRun)
+	if err != nil {
+		sendJSONError(w, "unable to recompute project bandwidth rollups",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	usage, err := server.db.ProjectAccounting().GetProjectTotal(ctx, projectUUID, since, before)
+	if err != nil {
+		sendJSONError(w, "unable to compute project usage",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	type projectUsage struct {
+		Storage  float64 `json:"storage"`
+		Egress   int64   `json:"egress"`
+		Segments float64 `json:"segments"`
+	}
+	type bandwidthTotals struct {
+		Before int64 `json:"before"`
+		After  int64 `json:"after"`
+		Delta  int64 `json:"delta"`
+	}
+
+	var output struct {
+		Period string       `json:"period"`
+		DryRun bool         `json:"dryRun"`
+		Usage  projectUsage `json:"usage"`
+		// Invoiced and Delta are only set when the usage of the period has
+		// already been recorded for invoicing.
+		Invoiced *projectUsage `json:"invoiced"`
+		Delta    *projectUsage `json:"delta"`
+
+		SettledBandwidth bandwidthTotals                              `json:"settledBandwidth"`
+		Corrections      []accounting.ProjectDailyBandwidthCorrection `json:"corrections"`
+	}
+	output.Period = period.Format(usagePeriodLayout)
+	output.DryRun = dryRun
+	output.Usage = projectUsage{
+		Storage:  usage.Storage,
+		Egress:   usage.Egress,
+		Segments: usage.SegmentCount,
+	}
+
+	output.Corrections = []accounting.ProjectDailyBandwidthCorrection{}
+	for _, correction := range corrections {
+		output.SettledBandwidth.Before += correction.Before.Settled
+		output.SettledBandwidth.After += correction.After.Settled
+		if correction.Before != correction.After {
+			output.Corrections = append(output.Corrections, correction)
+		}
+	}
+	output.SettledBandwidth.Delta = output.SettledBandwidth.After - output.SettledBandwidth.Before
+
+	err = server.db.StripeCoinPayments().ProjectRecords().Check(ctx, projectUUID, since, before)
+	switch {
+	case errors.Is(err, stripe.ErrProjectRecordExists):
+		record, err := server.db.StripeCoinPayments().ProjectRecords().Get(ctx, projectUUID, since, before)
+		if err != nil {
+			sendJSONError(w, "unable to get project records",
+				err.Error(), http.StatusInternalServerError)
+			return
+		}
+		output.Invoiced = &projectUsage{
+			Storage:  record.Storage,
+			Egress:   record.Egress,
+			Segments: record.Segments,
+		}
+		output.Delta = &projectUsage{
+			Storage:  output.Usage.Storage - record.Storage,
+			Egress:   output.Usage.Egress - record.Egress,
+			Segments: output.Usage.Segments - record.Segments,
+		}
+	case err != nil:
+		sendJSONError(w, "unable to get project records",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
 func (server *Server) getProject(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -306,6 +450,95 @@ func (server *Server) putProjectLimit(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (server *Server) getPlacementEntitlements(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	projectUUIDString, ok := vars["project"]
+	if !ok {
+		sendJSONError(w, "project-uuid missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	projectUUID, err := uuid.FromString(projectUUIDString)
+	if err != nil {
+		sendJSONError(w, "invalid project-uuid",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	entitlements, err := server.db.Console().Projects().GetPlacementEntitlements(ctx, projectUUID)
+	if err != nil {
+		sendJSONError(w, "failed to get placement entitlements",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(entitlements)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) addPlacementEntitlement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	projectUUIDString, ok := vars["project"]
+	if !ok {
+		sendJSONError(w, "project-uuid missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	projectUUID, err := uuid.FromString(projectUUIDString)
+	if err != nil {
+		sendJSONError(w, "invalid project-uuid",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	placement, err := parsePlacementConstraint(r.URL.Query().Get("region"))
+	if err != nil {
+		sendJSONError(w, err.Error(), "available: EU, EEA, US, DE", http.StatusBadRequest)
+		return
+	}
+
+	var isDefault bool
+	if value := r.URL.Query().Get("default"); value != "" {
+		isDefault, err = strconv.ParseBool(value)
+		if err != nil {
+			sendJSONError(w, "invalid default parameter",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	_, err = server.db.Console().Projects().Get(ctx, projectUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		sendJSONError(w, "project with specified uuid does not exist",
+			"", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "failed to get project",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = server.db.Console().Projects().EntitlePlacement(ctx, projectUUID, placement, isDefault)
+	if err != nil {
+		sendJSONError(w, "failed to add placement entitlement",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
 func (server *Server) addProject(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
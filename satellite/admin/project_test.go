@@ -18,6 +18,7 @@ import (
 
 	"storj.io/common/macaroon"
 	"storj.io/common/memory"
+	"storj.io/common/pb"
 	"storj.io/common/testcontext"
 	"storj.io/common/uuid"
 	"storj.io/storj/private/testplanet"
@@ -561,6 +562,112 @@ func TestProjectCheckUsage_lastMonthUnappliedInvoice(t *testing.T) {
 	})
 }
 
+func TestProjectRecomputeUsage(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		period := time.Date(2030, time.September, 1, 0, 0, 0, 0, time.UTC)
+		oneMonthAhead := period.AddDate(0, 1, 0)
+
+		for _, intervalStart := range []time.Time{period, period.Add(time.Hour)} {
+			err := sat.DB.ProjectAccounting().CreateStorageTally(ctx, accounting.BucketStorageTally{
+				BucketName:        "test",
+				ProjectID:         projectID,
+				IntervalStart:     intervalStart,
+				ObjectCount:       1,
+				TotalSegmentCount: 2,
+				TotalBytes:        640000,
+				MetadataSize:      2,
+			})
+			require.NoError(t, err)
+		}
+
+		sat.API.Payments.StripeService.SetNow(func() time.Time {
+			return oneMonthAhead
+		})
+		err := sat.API.Payments.StripeService.PrepareInvoiceProjectRecords(ctx, period)
+		require.NoError(t, err)
+
+		// the settlement is recorded after the invoice project record was prepared
+		// and the daily rollups of the period are lost.
+		day := period.AddDate(0, 0, 1)
+		err = sat.DB.Orders().UpdateBucketBandwidthSettle(ctx, projectID, []byte("test"), pb.PieceAction_GET, 1000, 0, day)
+		require.NoError(t, err)
+		err = sat.DB.ProjectAccounting().DeleteProjectBandwidthBefore(ctx, oneMonthAhead)
+		require.NoError(t, err)
+
+		type projectUsage struct {
+			Storage  float64 `json:"storage"`
+			Egress   int64   `json:"egress"`
+			Segments float64 `json:"segments"`
+		}
+		type result struct {
+			Period           string        `json:"period"`
+			DryRun           bool          `json:"dryRun"`
+			Usage            projectUsage  `json:"usage"`
+			Invoiced         *projectUsage `json:"invoiced"`
+			Delta            *projectUsage `json:"delta"`
+			SettledBandwidth struct {
+				Before int64 `json:"before"`
+				After  int64 `json:"after"`
+				Delta  int64 `json:"delta"`
+			} `json:"settledBandwidth"`
+			Corrections []accounting.ProjectDailyBandwidthCorrection `json:"corrections"`
+		}
+
+		recompute := func(query string) (output result) {
+			link := fmt.Sprintf("http://%s/api/projects/%s/usage/recompute?%s", address, projectID, query)
+			body := assertReq(ctx, t, link, http.MethodPost, "", http.StatusOK, "", sat.Config.Console.AuthToken)
+			require.NoError(t, json.Unmarshal(body, &output))
+			return output
+		}
+
+		output := recompute("period=2030-09&dry-run=true")
+		require.Equal(t, "2030-09", output.Period)
+		require.True(t, output.DryRun)
+		require.NotNil(t, output.Invoiced)
+		require.NotNil(t, output.Delta)
+		require.EqualValues(t, 1000, output.Usage.Egress)
+		require.EqualValues(t, 1000, output.Delta.Egress)
+		require.Zero(t, output.Delta.Storage)
+		require.EqualValues(t, 1000, output.SettledBandwidth.Delta)
+		require.Len(t, output.Corrections, 1)
+		require.True(t, output.Corrections[0].After.IntervalDay.Equal(day))
+		require.EqualValues(t, 1000, output.Corrections[0].After.Settled)
+
+		_, settled, _, err := sat.DB.ProjectAccounting().GetProjectDailyBandwidth(ctx, projectID, day.Year(), day.Month(), day.Day())
+		require.NoError(t, err)
+		require.Zero(t, settled)
+
+		output = recompute("period=2030-09")
+		require.False(t, output.DryRun)
+		require.Len(t, output.Corrections, 1)
+
+		_, settled, _, err = sat.DB.ProjectAccounting().GetProjectDailyBandwidth(ctx, projectID, day.Year(), day.Month(), day.Day())
+		require.NoError(t, err)
+		require.EqualValues(t, 1000, settled)
+
+		// the rollups are corrected, hence nothing is left to be corrected.
+		output = recompute("period=2030-09")
+		require.Empty(t, output.Corrections)
+		require.Zero(t, output.SettledBandwidth.Delta)
+
+		link := fmt.Sprintf("http://%s/api/projects/%s/usage/recompute?period=september", address, projectID)
+		assertReq(ctx, t, link, http.MethodPost, "", http.StatusBadRequest, "", sat.Config.Console.AuthToken)
+	})
+}
+
 // TestProjectDelete_withUsageCurrentMonth first tries to delete an actively used project of a paid tier user, which
 // should fail and afterwards converts the user to free tier and tries the deletion again. That deletion should succeed.
 func TestProjectDelete_withUsageCurrentMonth(t *testing.T) {
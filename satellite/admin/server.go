@@ -21,12 +21,20 @@ import (
 	"storj.io/common/errs2"
 	"storj.io/storj/satellite/accounting"
 	adminui "storj.io/storj/satellite/admin/ui"
+	"storj.io/storj/satellite/appeals"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb"
 	"storj.io/storj/satellite/console/restkeys"
+	"storj.io/storj/satellite/featureflags"
+	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/nodemessages"
 	"storj.io/storj/satellite/oidc"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/overlay/slareports"
 	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/billing"
+	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripe"
 )
 
@@ -64,6 +72,16 @@ type DB interface {
 	OIDC() oidc.DB
 	// StripeCoinPayments returns database for satellite stripe coin payments
 	StripeCoinPayments() stripe.DB
+	// EmailDeliveries returns database for the delivery status of sent emails.
+	EmailDeliveries() mailservice.Deliveries
+	// NodeSLAReports returns database for the monthly uptime SLA reports of the nodes.
+	NodeSLAReports() slareports.DB
+	// OverlayCache returns database for the storage nodes.
+	OverlayCache() overlay.DB
+	// Billing returns database for the billing transactions.
+	Billing() billing.TransactionsDB
+	// Wallets returns database for the storjscan wallets of the users.
+	Wallets() storjscan.WalletsDB
 }
 
 // Server provides endpoints for administrative tasks.
@@ -78,6 +96,11 @@ type Server struct {
 	buckets        *buckets.Service
 	restKeys       *restkeys.Service
 	freezeAccounts *console.AccountFreezeService
+	mail           *mailservice.Service
+	appeals        *appeals.Service
+	nodeMessages   *nodemessages.Service
+	featureFlags   *featureflags.Service
+	notifier       *console.Notifier
 
 	nowFn func() time.Time
 
@@ -86,7 +109,7 @@ type Server struct {
 }
 
 // NewServer returns a new administration Server.
-func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.Service, restKeys *restkeys.Service, freezeAccounts *console.AccountFreezeService, accounts payments.Accounts, console consoleweb.Config, config Config) *Server {
+func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.Service, restKeys *restkeys.Service, freezeAccounts *console.AccountFreezeService, accounts payments.Accounts, mail *mailservice.Service, console consoleweb.Config, config Config) *Server {
 	server := &Server{
 		log: log,
 
@@ -97,6 +120,7 @@ func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.S
 		buckets:        buckets,
 		restKeys:       restKeys,
 		freezeAccounts: freezeAccounts,
+		mail:           mail,
 
 		nowFn: time.Now,
 
@@ -117,6 +141,7 @@ func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.S
 	fullAccessAPI.HandleFunc("/users/{useremail}", server.updateUser).Methods("PUT")
 	fullAccessAPI.HandleFunc("/users/{useremail}", server.deleteUser).Methods("DELETE")
 	fullAccessAPI.HandleFunc("/users/{useremail}/mfa", server.disableUserMFA).Methods("DELETE")
+	fullAccessAPI.HandleFunc("/users/{useremail}/merge", server.mergeUser).Methods("POST")
 	fullAccessAPI.HandleFunc("/oauth/clients", server.createOAuthClient).Methods("POST")
 	fullAccessAPI.HandleFunc("/oauth/clients/{id}", server.updateOAuthClient).Methods("PUT")
 	fullAccessAPI.HandleFunc("/oauth/clients/{id}", server.deleteOAuthClient).Methods("DELETE")
@@ -130,10 +155,38 @@ func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.S
 	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}", server.getBucketInfo).Methods("GET")
 	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", server.createGeofenceForBucket).Methods("POST")
 	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", server.deleteGeofenceForBucket).Methods("DELETE")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/history", server.getBucketHistory).Methods("GET")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/versioning", server.setBucketVersioning).Methods("PUT")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/object-lock", server.enableBucketObjectLock).Methods("POST")
 	fullAccessAPI.HandleFunc("/projects/{project}/usage", server.checkProjectUsage).Methods("GET")
+	fullAccessAPI.HandleFunc("/projects/{project}/usage/recompute", server.recomputeProjectUsage).Methods("POST")
+	fullAccessAPI.HandleFunc("/projects/{project}/placements", server.getPlacementEntitlements).Methods("GET")
+	fullAccessAPI.HandleFunc("/projects/{project}/placements", server.addPlacementEntitlement).Methods("POST")
 	fullAccessAPI.HandleFunc("/apikeys/{apikey}", server.deleteAPIKey).Methods("DELETE")
 	fullAccessAPI.HandleFunc("/restkeys/{useremail}", server.addRESTKey).Methods("POST")
 	fullAccessAPI.HandleFunc("/restkeys/{apikey}/revoke", server.revokeRESTKey).Methods("PUT")
+	fullAccessAPI.HandleFunc("/emails/templates", server.listEmailTemplates).Methods("GET")
+	fullAccessAPI.HandleFunc("/emails/templates/reload", server.reloadEmailTemplates).Methods("POST")
+	fullAccessAPI.HandleFunc("/emails/templates/{name}/preview", server.previewEmailTemplate).Methods("GET")
+	fullAccessAPI.HandleFunc("/emails/deliveries", server.listEmailDeliveries).Methods("GET")
+	fullAccessAPI.HandleFunc("/sla-reports", server.listSLAReportPeriods).Methods("GET")
+	fullAccessAPI.HandleFunc("/sla-reports/{period}/nodes", server.getNodeSLAReports).Methods("GET")
+	fullAccessAPI.HandleFunc("/sla-reports/{period}/wallets", server.getWalletSLAReports).Methods("GET")
+	fullAccessAPI.HandleFunc("/nodes/{nodeid}/storage-estimate", server.getNodeStorageEstimate).Methods("GET")
+	fullAccessAPI.HandleFunc("/nodes/{nodeid}/maintenance", server.getNodeMaintenance).Methods("GET")
+	fullAccessAPI.HandleFunc("/nodes/appeals", server.listNodeAppeals).Methods("GET")
+	fullAccessAPI.HandleFunc("/nodes/appeals/{id}", server.getNodeAppeal).Methods("GET")
+	fullAccessAPI.HandleFunc("/nodes/appeals/{id}/approve", server.approveNodeAppeal).Methods("POST")
+	fullAccessAPI.HandleFunc("/nodes/appeals/{id}/reject", server.rejectNodeAppeal).Methods("POST")
+	fullAccessAPI.HandleFunc("/nodes/messages", server.listNodeMessages).Methods("GET")
+	fullAccessAPI.HandleFunc("/nodes/messages", server.publishNodeMessage).Methods("POST")
+	fullAccessAPI.HandleFunc("/nodes/messages/{id}", server.getNodeMessage).Methods("GET")
+	fullAccessAPI.HandleFunc("/nodes/messages/{id}", server.deleteNodeMessage).Methods("DELETE")
+	fullAccessAPI.HandleFunc("/feature-flags", server.listFeatureFlags).Methods("GET")
+	fullAccessAPI.HandleFunc("/feature-flags/{name}", server.getFeatureFlag).Methods("GET")
+	fullAccessAPI.HandleFunc("/feature-flags/{name}", server.setFeatureFlag).Methods("PUT")
+	fullAccessAPI.HandleFunc("/feature-flags/{name}", server.deleteFeatureFlag).Methods("DELETE")
+	fullAccessAPI.HandleFunc("/notifications/announce", server.announceNotification).Methods("POST")
 
 	// limit update access required
 	limitUpdateAPI := api.NewRoute().Subrouter()
@@ -185,6 +238,26 @@ func (server *Server) SetNow(nowFn func() time.Time) {
 	server.nowFn = nowFn
 }
 
+// SetAppeals sets the service, which reviews the appeals of the suspended or disqualified nodes.
+func (server *Server) SetAppeals(appeals *appeals.Service) {
+	server.appeals = appeals
+}
+
+// SetNodeMessages sets the service, which publishes the messages to the storage node operators.
+func (server *Server) SetNodeMessages(nodeMessages *nodemessages.Service) {
+	server.nodeMessages = nodeMessages
+}
+
+// SetFeatureFlags sets the service, which manages the feature flags.
+func (server *Server) SetFeatureFlags(featureFlags *featureflags.Service) {
+	server.featureFlags = featureFlags
+}
+
+// SetNotifier sets the notifier, which announces the in-app notifications to the users.
+func (server *Server) SetNotifier(notifier *console.Notifier) {
+	server.notifier = notifier
+}
+
 // Close closes server and underlying listener.
 func (server *Server) Close() error {
 	return Error.Wrap(server.server.Close())
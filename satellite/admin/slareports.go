@@ -0,0 +1,183 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/storj/satellite/overlay/slareports"
+)
+
+// slaPeriodLayout is the layout of the periods of the SLA reports in the API.
+const slaPeriodLayout = "2006-01"
+
+func (server *Server) listSLAReportPeriods(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	periods, err := server.db.NodeSLAReports().ListPeriods(ctx)
+	if err != nil {
+		sendJSONError(w, "failed to list SLA report periods",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := make([]string, 0, len(periods))
+	for _, period := range periods {
+		output = append(output, period.Format(slaPeriodLayout))
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) getNodeSLAReports(w http.ResponseWriter, r *http.Request) {
+	reports, ok := server.slaReports(w, r)
+	if !ok {
+		return
+	}
+
+	if r.URL.Query().Get("format") == "csv" {
+		rows := [][]string{{"period", "node_id", "wallet", "windows", "online_score", "compliant"}}
+		for _, report := range reports {
+			rows = append(rows, []string{
+				report.Period.Format(slaPeriodLayout),
+				report.NodeID.String(),
+				report.Wallet,
+				strconv.Itoa(report.Windows),
+				strconv.FormatFloat(report.OnlineScore, 'f', 6, 64),
+				strconv.FormatBool(report.Compliant),
+			})
+		}
+		sendCSV(w, "sla-nodes-"+mux.Vars(r)["period"]+".csv", rows)
+		return
+	}
+
+	type nodeReport struct {
+		NodeID      string    `json:"nodeId"`
+		Wallet      string    `json:"wallet"`
+		Windows     int       `json:"windows"`
+		OnlineScore float64   `json:"onlineScore"`
+		Compliant   bool      `json:"compliant"`
+		CreatedAt   time.Time `json:"createdAt"`
+	}
+
+	output := make([]nodeReport, 0, len(reports))
+	for _, report := range reports {
+		output = append(output, nodeReport{
+			NodeID:      report.NodeID.String(),
+			Wallet:      report.Wallet,
+			Windows:     report.Windows,
+			OnlineScore: report.OnlineScore,
+			Compliant:   report.Compliant,
+			CreatedAt:   report.CreatedAt,
+		})
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) getWalletSLAReports(w http.ResponseWriter, r *http.Request) {
+	reports, ok := server.slaReports(w, r)
+	if !ok {
+		return
+	}
+	wallets := slareports.WalletReports(reports)
+
+	if r.URL.Query().Get("format") == "csv" {
+		rows := [][]string{{"period", "wallet", "nodes", "compliant_nodes", "online_score", "compliant"}}
+		for _, wallet := range wallets {
+			rows = append(rows, []string{
+				wallet.Period.Format(slaPeriodLayout),
+				wallet.Wallet,
+				strconv.Itoa(wallet.Nodes),
+				strconv.Itoa(wallet.CompliantNodes),
+				strconv.FormatFloat(wallet.OnlineScore, 'f', 6, 64),
+				strconv.FormatBool(wallet.Compliant),
+			})
+		}
+		sendCSV(w, "sla-wallets-"+mux.Vars(r)["period"]+".csv", rows)
+		return
+	}
+
+	type walletReport struct {
+		Wallet         string  `json:"wallet"`
+		Nodes          int     `json:"nodes"`
+		CompliantNodes int     `json:"compliantNodes"`
+		OnlineScore    float64 `json:"onlineScore"`
+		Compliant      bool    `json:"compliant"`
+	}
+
+	output := make([]walletReport, 0, len(wallets))
+	for _, wallet := range wallets {
+		output = append(output, walletReport{
+			Wallet:         wallet.Wallet,
+			Nodes:          wallet.Nodes,
+			CompliantNodes: wallet.CompliantNodes,
+			OnlineScore:    wallet.OnlineScore,
+			Compliant:      wallet.Compliant,
+		})
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+// slaReports returns the node reports of the period of the request. It sends the
+// error response and returns false, when the reports can't be returned.
+func (server *Server) slaReports(w http.ResponseWriter, r *http.Request) ([]slareports.NodeReport, bool) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	periodString, ok := vars["period"]
+	if !ok {
+		sendJSONError(w, "period missing",
+			"", http.StatusBadRequest)
+		return nil, false
+	}
+
+	period, err := time.Parse(slaPeriodLayout, periodString)
+	if err != nil {
+		sendJSONError(w, "invalid period, expected YYYY-MM",
+			err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+
+	reports, err := server.db.NodeSLAReports().GetReports(ctx, period)
+	if err != nil {
+		sendJSONError(w, "failed to get SLA reports",
+			err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	if len(reports) == 0 {
+		sendJSONError(w, "SLA report not found",
+			"", http.StatusNotFound)
+		return nil, false
+	}
+
+	return reports, true
+}
@@ -8,17 +8,22 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
+	"storj.io/common/currency"
 	"storj.io/common/memory"
 	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/payments/billing"
 )
 
 func TestUserGet(t *testing.T) {
@@ -403,3 +408,111 @@ func TestUserDelete(t *testing.T) {
 		require.Contains(t, string(body), "does not exist")
 	})
 }
+
+func TestUserMerge(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      2,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		authToken := sat.Config.Console.AuthToken
+
+		target := planet.Uplinks[0].Projects[0].Owner
+		source := planet.Uplinks[1].Projects[0].Owner
+		sourceProject := planet.Uplinks[1].Projects[0]
+
+		_, err := sat.DB.Billing().Insert(ctx, billing.Transaction{
+			UserID:      source.ID,
+			Amount:      currency.AmountFromBaseUnits(1000, currency.USDollars),
+			Description: "deposit",
+			Source:      billing.StorjScanSource,
+			Status:      billing.TransactionStatusCompleted,
+			Type:        billing.TransactionTypeCredit,
+			Metadata:    []byte(`{}`),
+			Timestamp:   time.Now(),
+		})
+		require.NoError(t, err)
+
+		// a failed merge debited the source, but didn't credit the target.
+		_, err = sat.API.Payments.Accounts.Balances().ApplyCredit(ctx, source.ID, 500, "bonus")
+		require.NoError(t, err)
+		_, err = sat.API.Payments.Accounts.Balances().ApplyCredit(ctx, source.ID, -500, fmt.Sprintf("Moved to account %s", target.ID))
+		require.NoError(t, err)
+
+		type report struct {
+			DryRun   bool `json:"dryRun"`
+			Projects []struct {
+				ID uuid.UUID `json:"id"`
+			} `json:"projects"`
+			MovedMemberships    []uuid.UUID `json:"movedMemberships"`
+			BillingTransactions int         `json:"billingTransactions"`
+			StripeCredits       int64       `json:"stripeCredits"`
+			Conflicts           []string    `json:"conflicts"`
+		}
+
+		link := fmt.Sprintf("http://%s/api/users/%s/merge?into=%s", address, source.Email, url.QueryEscape(target.Email))
+
+		// the dry run only reports the changes.
+		var output report
+		body := assertReq(ctx, t, link+"&dry-run=true", http.MethodPost, "", http.StatusOK, "", authToken)
+		require.NoError(t, json.Unmarshal(body, &output))
+		require.True(t, output.DryRun)
+		require.Len(t, output.Projects, 1)
+		require.Equal(t, sourceProject.ID, output.Projects[0].ID)
+		require.Equal(t, []uuid.UUID{sourceProject.ID}, output.MovedMemberships)
+		require.Equal(t, 1, output.BillingTransactions)
+		require.EqualValues(t, 500, output.StripeCredits)
+		require.Empty(t, output.Conflicts)
+
+		project, err := sat.DB.Console().Projects().Get(ctx, sourceProject.ID)
+		require.NoError(t, err)
+		require.Equal(t, source.ID, project.OwnerID)
+
+		body = assertReq(ctx, t, link, http.MethodPost, "", http.StatusOK, "", authToken)
+		require.NoError(t, json.Unmarshal(body, &output))
+		require.False(t, output.DryRun)
+
+		project, err = sat.DB.Console().Projects().Get(ctx, sourceProject.ID)
+		require.NoError(t, err)
+		require.Equal(t, target.ID, project.OwnerID)
+
+		memberships, err := sat.DB.Console().ProjectMembers().GetByMemberID(ctx, target.ID)
+		require.NoError(t, err)
+		require.Len(t, memberships, 2)
+
+		transactions, err := sat.DB.Billing().List(ctx, target.ID)
+		require.NoError(t, err)
+		require.Len(t, transactions, 1)
+		balance, err := sat.DB.Billing().GetBalance(ctx, target.ID)
+		require.NoError(t, err)
+		require.Equal(t, currency.AmountFromBaseUnits(1000, currency.USDollars).AsDecimal().String(), balance.AsDecimal().String())
+
+		// the retried merge credits the target without debiting the source again.
+		sourceTransactions, err := sat.API.Payments.Accounts.Balances().ListTransactions(ctx, source.ID)
+		require.NoError(t, err)
+		require.Len(t, sourceTransactions, 2)
+		targetTransactions, err := sat.API.Payments.Accounts.Balances().ListTransactions(ctx, target.ID)
+		require.NoError(t, err)
+		require.Len(t, targetTransactions, 1)
+		require.EqualValues(t, 500, targetTransactions[0].Amount)
+
+		deactivated, err := sat.DB.Console().Users().Get(ctx, source.ID)
+		require.NoError(t, err)
+		require.Equal(t, console.Deleted, deactivated.Status)
+
+		// the source can't be found by its email any longer.
+		body = assertReq(ctx, t, link, http.MethodPost, "", http.StatusNotFound, "", authToken)
+		require.Contains(t, string(body), "does not exist")
+
+		// merging a user into itself is rejected.
+		link = fmt.Sprintf("http://%s/api/users/%s/merge?into=%s", address, target.Email, url.QueryEscape(target.Email))
+		assertReq(ctx, t, link, http.MethodPost, "", http.StatusBadRequest, "", authToken)
+	})
+}
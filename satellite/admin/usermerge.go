@@ -0,0 +1,422 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/shopspring/decimal"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/private/blockchain"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/payments/billing"
+)
+
+// userMergeReport describes what merging the source user into the target user
+// moves, and what prevents the merge.
+type userMergeReport struct {
+	DryRun bool             `json:"dryRun"`
+	Source userMergeAccount `json:"source"`
+	Target userMergeAccount `json:"target"`
+
+	Projects           []userMergeProject `json:"projects"`
+	MovedMemberships   []uuid.UUID        `json:"movedMemberships"`
+	DroppedMemberships []uuid.UUID        `json:"droppedMemberships"`
+	ProjectLimit       int                `json:"projectLimit"`
+
+	BillingTransactions int                 `json:"billingTransactions"`
+	BillingBalance      decimal.Decimal     `json:"billingBalance"`
+	StripeCredits       int64               `json:"stripeCredits"`
+	Wallet              *blockchain.Address `json:"wallet"`
+
+	UserAgent string `json:"userAgent"`
+
+	Conflicts []string `json:"conflicts"`
+}
+
+type userMergeAccount struct {
+	ID    uuid.UUID `json:"id"`
+	Email string    `json:"email"`
+}
+
+type userMergeProject struct {
+	ID      uuid.UUID `json:"id"`
+	Name    string    `json:"name"`
+	APIKeys uint64    `json:"apiKeys"`
+}
+
+func (server *Server) mergeUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	userEmail, ok := vars["useremail"]
+	if !ok {
+		sendJSONError(w, "user-email missing", "", http.StatusBadRequest)
+		return
+	}
+
+	query := r.URL.Query()
+	targetEmail := query.Get("into")
+	if targetEmail == "" {
+		sendJSONError(w, "target user-email missing", "", http.StatusBadRequest)
+		return
+	}
+
+	var dryRun bool
+	if value := query.Get("dry-run"); value != "" {
+		var err error
+		dryRun, err = strconv.ParseBool(value)
+		if err != nil {
+			sendJSONError(w, "invalid dry-run",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	source, ok := server.getUserForMerge(ctx, w, userEmail)
+	if !ok {
+		return
+	}
+	target, ok := server.getUserForMerge(ctx, w, targetEmail)
+	if !ok {
+		return
+	}
+	if source.ID == target.ID {
+		sendJSONError(w, "cannot merge a user into itself", "", http.StatusBadRequest)
+		return
+	}
+
+	report, err := server.planUserMerge(ctx, source, target)
+	if err != nil {
+		sendJSONError(w, "unable to plan user merge",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	report.DryRun = dryRun
+
+	if !dryRun {
+		if len(report.Conflicts) > 0 {
+			sendJSONError(w, "users cannot be merged",
+				strings.Join(report.Conflicts, "; "), http.StatusConflict)
+			return
+		}
+		if err := server.applyUserMerge(ctx, source, target, report); err != nil {
+			sendJSONError(w, "unable to merge users",
+				err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+// getUserForMerge returns the user with the email, or sends an error response.
+func (server *Server) getUserForMerge(ctx context.Context, w http.ResponseWriter, email string) (*console.User, bool) {
+	user, err := server.db.Console().Users().GetByEmail(ctx, email)
+	if errors.Is(err, sql.ErrNoRows) {
+		sendJSONError(w, fmt.Sprintf("user with email %q does not exist", email),
+			"", http.StatusNotFound)
+		return nil, false
+	}
+	if err != nil {
+		sendJSONError(w, "failed to get user details",
+			err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	return user, true
+}
+
+// planUserMerge reports what merging source into target would move. It only
+// reads, so the merge is planned again when it's retried after a failure, and
+// the already moved parts are skipped.
+func (server *Server) planUserMerge(ctx context.Context, source, target *console.User) (_ *userMergeReport, err error) {
+	report := &userMergeReport{
+		Source:             userMergeAccount{ID: source.ID, Email: source.Email},
+		Target:             userMergeAccount{ID: target.ID, Email: target.Email},
+		Projects:           []userMergeProject{},
+		MovedMemberships:   []uuid.UUID{},
+		DroppedMemberships: []uuid.UUID{},
+		ProjectLimit:       target.ProjectLimit,
+		Conflicts:          []string{},
+	}
+
+	if target.Status != console.Active {
+		report.Conflicts = append(report.Conflicts, "target user is not active")
+	}
+
+	// the projects are moved along with their api keys and attribution.
+	sourceProjects, err := server.db.Console().Projects().GetOwn(ctx, source.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, project := range sourceProjects {
+		keys, err := server.db.Console().APIKeys().GetPagedByProjectID(ctx, project.ID, console.APIKeyCursor{Limit: 1, Page: 1})
+		if err != nil {
+			return nil, err
+		}
+		report.Projects = append(report.Projects, userMergeProject{
+			ID:      project.ID,
+			Name:    project.Name,
+			APIKeys: keys.TotalCount,
+		})
+	}
+
+	// the project limit of the target is raised to fit the moved projects.
+	targetProjects, err := server.db.Console().Projects().GetOwn(ctx, target.ID)
+	if err != nil {
+		return nil, err
+	}
+	if owned := len(targetProjects) + len(sourceProjects); owned > report.ProjectLimit {
+		report.ProjectLimit = owned
+	}
+
+	// the memberships of the target take precedence over the ones of the source.
+	targetMemberships, err := server.db.Console().ProjectMembers().GetByMemberID(ctx, target.ID)
+	if err != nil {
+		return nil, err
+	}
+	isTargetMember := make(map[uuid.UUID]bool, len(targetMemberships))
+	for _, member := range targetMemberships {
+		isTargetMember[member.ProjectID] = true
+	}
+	sourceMemberships, err := server.db.Console().ProjectMembers().GetByMemberID(ctx, source.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range sourceMemberships {
+		if isTargetMember[member.ProjectID] {
+			report.DroppedMemberships = append(report.DroppedMemberships, member.ProjectID)
+		} else {
+			report.MovedMemberships = append(report.MovedMemberships, member.ProjectID)
+		}
+	}
+
+	// the billing transactions and balance of the source are moved, but the
+	// target keeps its stripe customer, so the source must not owe anything.
+	transactions, err := server.db.Billing().List(ctx, source.ID)
+	if err != nil {
+		return nil, err
+	}
+	report.BillingTransactions = len(transactions)
+
+	balance, err := server.db.Billing().GetBalance(ctx, source.ID)
+	if err != nil {
+		return nil, err
+	}
+	report.BillingBalance = balance.AsDecimal()
+
+	invoices, err := server.payments.Invoices().List(ctx, source.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, invoice := range invoices {
+		if invoice.Status == "draft" || invoice.Status == "open" {
+			report.Conflicts = append(report.Conflicts, "source user has unpaid/pending invoices")
+			break
+		}
+	}
+
+	hasItems, err := server.payments.Invoices().CheckPendingItems(ctx, source.ID)
+	if err != nil {
+		return nil, err
+	}
+	if hasItems {
+		report.Conflicts = append(report.Conflicts, "source user has pending invoice items")
+	}
+
+	stripeBalance, err := server.payments.Balances().Get(ctx, source.ID)
+	if err != nil {
+		return nil, err
+	}
+	report.StripeCredits = stripeBalance.Credits.IntPart()
+	if report.StripeCredits < 0 {
+		report.Conflicts = append(report.Conflicts, "source user has a negative stripe balance")
+	}
+
+	// a failed merge may have debited the source without crediting the target.
+	debited, credited, err := server.getStripeCreditsMove(ctx, source.ID, target.ID)
+	if err != nil {
+		return nil, err
+	}
+	if debited > 0 && !credited {
+		report.StripeCredits = debited
+	}
+
+	// the deposits to a wallet are credited to its user, so only one wallet can be kept.
+	sourceWallet, err := server.db.Wallets().GetWallet(ctx, source.ID)
+	switch {
+	case errors.Is(err, billing.ErrNoWallet):
+	case err != nil:
+		return nil, err
+	default:
+		report.Wallet = &sourceWallet
+
+		_, err := server.db.Wallets().GetWallet(ctx, target.ID)
+		switch {
+		case errors.Is(err, billing.ErrNoWallet):
+		case err != nil:
+			return nil, err
+		default:
+			report.Conflicts = append(report.Conflicts, "both users have a storjscan wallet")
+		}
+	}
+
+	// the target keeps the partner it signed up with, unless it has none.
+	if len(target.UserAgent) == 0 {
+		report.UserAgent = string(source.UserAgent)
+	} else {
+		report.UserAgent = string(target.UserAgent)
+	}
+
+	return report, nil
+}
+
+// applyUserMerge moves everything in the report from source to target and
+// deactivates source.
+func (server *Server) applyUserMerge(ctx context.Context, source, target *console.User, report *userMergeReport) (err error) {
+	if err := server.db.Billing().Reassign(ctx, source.ID, target.ID); err != nil {
+		return err
+	}
+	if report.Wallet != nil {
+		if err := server.db.Wallets().Reassign(ctx, source.ID, target.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := server.moveStripeCredits(ctx, source.ID, target.ID, report.StripeCredits); err != nil {
+		return err
+	}
+
+	if report.ProjectLimit != target.ProjectLimit {
+		err := server.db.Console().Users().Update(ctx, target.ID, console.UpdateUserRequest{
+			ProjectLimit: &report.ProjectLimit,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if report.UserAgent != string(target.UserAgent) {
+		if err := server.db.Console().Users().UpdateUserAgent(ctx, target.ID, []byte(report.UserAgent)); err != nil {
+			return err
+		}
+	}
+
+	for _, project := range report.Projects {
+		if err := server.db.Console().Projects().UpdateOwner(ctx, project.ID, target.ID); err != nil {
+			return err
+		}
+	}
+	for _, projectID := range report.MovedMemberships {
+		if _, err := server.db.Console().ProjectMembers().Insert(ctx, target.ID, projectID); err != nil {
+			return err
+		}
+		if err := server.db.Console().ProjectMembers().Delete(ctx, source.ID, projectID); err != nil {
+			return err
+		}
+	}
+	for _, projectID := range report.DroppedMemberships {
+		if err := server.db.Console().ProjectMembers().Delete(ctx, source.ID, projectID); err != nil {
+			return err
+		}
+	}
+
+	emptyName := ""
+	emptyNamePtr := &emptyName
+	deactivatedEmail := fmt.Sprintf("deactivated+%s@storj.io", source.ID.String())
+	status := console.Deleted
+
+	err = server.db.Console().Users().Update(ctx, source.ID, console.UpdateUserRequest{
+		FullName:  &emptyName,
+		ShortName: &emptyNamePtr,
+		Email:     &deactivatedEmail,
+		Status:    &status,
+	})
+	if err != nil {
+		return err
+	}
+
+	return server.payments.CreditCards().RemoveAll(ctx, source.ID)
+}
+
+// moveStripeCredits moves the stripe credits from source to target. The source is debited
+// first, and the balance transactions of the move record its progress, so a retry after
+// a failure credits the target with what was debited instead of starting over.
+func (server *Server) moveStripeCredits(ctx context.Context, sourceID, targetID uuid.UUID, credits int64) error {
+	debited, credited, err := server.getStripeCreditsMove(ctx, sourceID, targetID)
+	if err != nil {
+		return err
+	}
+
+	if debited == 0 {
+		if credits <= 0 {
+			return nil
+		}
+		_, err := server.payments.Balances().ApplyCredit(ctx, sourceID, -credits, stripeCreditsMovedTo(targetID))
+		if err != nil {
+			return err
+		}
+		debited = credits
+	}
+
+	if credited {
+		return nil
+	}
+	_, err = server.payments.Balances().ApplyCredit(ctx, targetID, debited, stripeCreditsMovedFrom(sourceID))
+	return err
+}
+
+// getStripeCreditsMove returns how many stripe credits were debited from source for the merge
+// into target, and whether they were credited to target.
+func (server *Server) getStripeCreditsMove(ctx context.Context, sourceID, targetID uuid.UUID) (debited int64, credited bool, err error) {
+	sourceTransactions, err := server.payments.Balances().ListTransactions(ctx, sourceID)
+	if err != nil {
+		return 0, false, err
+	}
+	for _, transaction := range sourceTransactions {
+		if transaction.Description == stripeCreditsMovedTo(targetID) {
+			debited = -transaction.Amount
+			break
+		}
+	}
+	if debited == 0 {
+		return 0, false, nil
+	}
+
+	targetTransactions, err := server.payments.Balances().ListTransactions(ctx, targetID)
+	if err != nil {
+		return 0, false, err
+	}
+	for _, transaction := range targetTransactions {
+		if transaction.Description == stripeCreditsMovedFrom(sourceID) {
+			return debited, true, nil
+		}
+	}
+	return debited, false, nil
+}
+
+// stripeCreditsMovedTo is the description of the debit of the merged user.
+func stripeCreditsMovedTo(targetID uuid.UUID) string {
+	return fmt.Sprintf("Moved to account %s", targetID)
+}
+
+// stripeCreditsMovedFrom is the description of the credit of the user merged into.
+func stripeCreditsMovedFrom(sourceID uuid.UUID) string {
+	return fmt.Sprintf("Moved from account %s", sourceID)
+}
@@ -0,0 +1,146 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// HTTPSinkConfig is a configuration struct for the generic HTTP sink.
+type HTTPSinkConfig struct {
+	URL            string        `help:"endpoint which receives analytics events as a JSON array" default:""`
+	Authorization  string        `help:"value of the Authorization header sent with each request" default:""`
+	DefaultTimeout time.Duration `help:"the default timeout for the http client" default:"10s"`
+}
+
+// PostHogConfig is a configuration struct for the PostHog sink.
+type PostHogConfig struct {
+	APIKey         string        `help:"posthog project api key" default:""`
+	Host           string        `help:"posthog instance address" default:"https://app.posthog.com"`
+	DefaultTimeout time.Duration `help:"the default timeout for the posthog http client" default:"10s"`
+}
+
+// HTTPSender posts batches of events as JSON to a configured endpoint.
+type HTTPSender struct {
+	config     HTTPSinkConfig
+	httpClient *http.Client
+}
+
+// NewHTTPSender creates a new sender for a generic HTTP endpoint.
+func NewHTTPSender(config HTTPSinkConfig) *HTTPSender {
+	return &HTTPSender{
+		config: config,
+		httpClient: &http.Client{
+			Timeout: config.DefaultTimeout,
+		},
+	}
+}
+
+// SendBatch implements BatchSender.
+func (sender *HTTPSender) SendBatch(ctx context.Context, events []Event) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	headers := http.Header{}
+	if sender.config.Authorization != "" {
+		headers.Set("Authorization", sender.config.Authorization)
+	}
+	return postJSON(ctx, sender.httpClient, sender.config.URL, headers, events)
+}
+
+// PostHogSender sends batches of events to the PostHog batch API.
+type PostHogSender struct {
+	config     PostHogConfig
+	httpClient *http.Client
+}
+
+// NewPostHogSender creates a new sender for PostHog.
+func NewPostHogSender(config PostHogConfig) *PostHogSender {
+	return &PostHogSender{
+		config: config,
+		httpClient: &http.Client{
+			Timeout: config.DefaultTimeout,
+		},
+	}
+}
+
+type postHogEvent struct {
+	Event      string                 `json:"event"`
+	DistinctID string                 `json:"distinct_id"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+	Timestamp  time.Time              `json:"timestamp"`
+}
+
+// SendBatch implements BatchSender.
+func (sender *PostHogSender) SendBatch(ctx context.Context, events []Event) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	batch := make([]postHogEvent, 0, len(events))
+	for _, event := range events {
+		distinctID := event.UserID
+		if distinctID == "" {
+			distinctID = event.AnonymousID
+		}
+
+		converted := postHogEvent{
+			Event:      event.Name,
+			DistinctID: distinctID,
+			Properties: event.Properties,
+			Timestamp:  event.Timestamp,
+		}
+		switch event.Type {
+		case EventTypeIdentify:
+			converted.Event = "$identify"
+			converted.Properties = map[string]interface{}{"$set": event.Properties}
+		case EventTypePage:
+			converted.Event = "$pageview"
+		}
+		batch = append(batch, converted)
+	}
+
+	body := map[string]interface{}{
+		"api_key": sender.config.APIKey,
+		"batch":   batch,
+	}
+	url := strings.TrimSuffix(sender.config.Host, "/") + "/batch/"
+	return postJSON(ctx, sender.httpClient, url, nil, body)
+}
+
+// postJSON sends data encoded as JSON to url and checks for a successful status code.
+func postJSON(ctx context.Context, client *http.Client, url string, headers http.Header, data interface{}) (err error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return Error.New("json marshal failed: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return Error.New("new request failed: %w", err)
+	}
+	for key, values := range headers {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return Error.New("send request failed: %w", err)
+	}
+	defer func() {
+		err = errs.Combine(err, resp.Body.Close())
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return Error.New("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 	segment "gopkg.in/segmentio/analytics-go.v3"
 
 	"storj.io/common/uuid"
@@ -95,6 +96,10 @@ var (
 type Config struct {
 	SegmentWriteKey string `help:"segment write key" default:""`
 	Enabled         bool   `help:"enable analytics reporting" default:"false"`
+	Sink            string `help:"where analytics events are sent (segment, posthog, http, log)" default:"segment"`
+	Batch           BatchConfig
+	PostHog         PostHogConfig
+	HTTP            HTTPSinkConfig
 	HubSpot         HubSpotConfig
 }
 
@@ -125,7 +130,7 @@ type Service struct {
 	satelliteName string
 	clientEvents  map[string]bool
 
-	segment segment.Client
+	sink    Sink
 	hubspot *HubSpotEvents
 }
 
@@ -139,7 +144,12 @@ func NewService(log *zap.Logger, config Config, satelliteName string) *Service {
 		hubspot:       NewHubSpotEvents(log.Named("hubspotclient"), config.HubSpot, satelliteName),
 	}
 	if config.Enabled {
-		service.segment = segment.New(config.SegmentWriteKey)
+		sink, err := NewSink(log.Named("sink"), config)
+		if err != nil {
+			log.Error("invalid analytics sink, falling back to logging events", zap.Error(err))
+			sink = &logSink{log: log.Named("sink")}
+		}
+		service.sink = sink
 	}
 	for _, name := range []string{eventGatewayCredentialsCreated, eventPassphraseCreated, eventExternalLinkClicked,
 		eventPathSelected, eventLinkShared, eventObjectUploaded, eventAPIKeyGenerated, eventUpgradeBannerClicked,
@@ -164,15 +174,22 @@ func (service *Service) Run(ctx context.Context) error {
 	if !service.config.Enabled {
 		return nil
 	}
-	return service.hubspot.Run(ctx)
+	group, ctx := errgroup.WithContext(ctx)
+	group.Go(func() error {
+		return service.sink.Run(ctx)
+	})
+	group.Go(func() error {
+		return service.hubspot.Run(ctx)
+	})
+	return group.Wait()
 }
 
-// Close closes the Segment client.
+// Close closes the analytics sink.
 func (service *Service) Close() error {
 	if !service.config.Enabled {
 		return nil
 	}
-	return service.segment.Close()
+	return service.sink.Close()
 }
 
 // UserType is a type for distinguishing personal vs. professional users.
@@ -204,7 +221,7 @@ type TrackCreateUserFields struct {
 }
 
 func (service *Service) enqueueMessage(message segment.Message) {
-	err := service.segment.Enqueue(message)
+	err := service.sink.Enqueue(message)
 	if err != nil {
 		service.log.Error("Error enqueueing message", zap.Error(err))
 	}
@@ -0,0 +1,290 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package analytics
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	segment "gopkg.in/segmentio/analytics-go.v3"
+
+	"storj.io/common/sync2"
+)
+
+// Sink names accepted by Config.Sink.
+const (
+	SinkSegment = "segment"
+	SinkPostHog = "posthog"
+	SinkHTTP    = "http"
+	SinkLog     = "log"
+)
+
+// Sink is a destination for analytics messages.
+type Sink interface {
+	// Run runs any background work needed to deliver messages.
+	Run(ctx context.Context) error
+	// Enqueue queues the message for delivery.
+	Enqueue(message segment.Message) error
+	// Close flushes pending messages and releases resources.
+	Close() error
+}
+
+// BatchConfig contains configuration for sinks which deliver events in batches.
+type BatchConfig struct {
+	Size         int           `help:"maximum number of events sent in a single batch" default:"100"`
+	Interval     time.Duration `help:"how often queued events are flushed" default:"5s"`
+	QueueSize    int           `help:"the number of events that can be queued before dropping" default:"1000"`
+	Retries      int           `help:"how many times sending a batch is retried before the batch is dropped" default:"3"`
+	RetryBackoff time.Duration `help:"delay before the first retry, doubled on each further retry" default:"1s"`
+}
+
+// NewSink creates the sink selected by config.
+func NewSink(log *zap.Logger, config Config) (Sink, error) {
+	switch strings.ToLower(config.Sink) {
+	case "", SinkSegment:
+		return &segmentSink{client: segment.New(config.SegmentWriteKey)}, nil
+	case SinkPostHog:
+		return newBatchSink(log, config.Batch, NewPostHogSender(config.PostHog)), nil
+	case SinkHTTP:
+		return newBatchSink(log, config.Batch, NewHTTPSender(config.HTTP)), nil
+	case SinkLog:
+		return &logSink{log: log}, nil
+	default:
+		return nil, Error.New("unknown sink %q", config.Sink)
+	}
+}
+
+// segmentSink sends messages to Segment.
+// The segment client does its own batching and retrying.
+type segmentSink struct {
+	client segment.Client
+}
+
+// Run implements Sink.
+func (sink *segmentSink) Run(ctx context.Context) error { return nil }
+
+// Enqueue implements Sink.
+func (sink *segmentSink) Enqueue(message segment.Message) error {
+	return sink.client.Enqueue(message)
+}
+
+// Close implements Sink.
+func (sink *segmentSink) Close() error { return sink.client.Close() }
+
+// logSink only logs messages, it is useful for operators without an analytics provider.
+type logSink struct {
+	log *zap.Logger
+}
+
+// Run implements Sink.
+func (sink *logSink) Run(ctx context.Context) error { return nil }
+
+// Enqueue implements Sink.
+func (sink *logSink) Enqueue(message segment.Message) error {
+	event, err := EventFromMessage(message)
+	if err != nil {
+		return err
+	}
+	sink.log.Info("analytics event",
+		zap.String("type", event.Type),
+		zap.String("name", event.Name),
+		zap.String("user", event.UserID),
+		zap.Any("properties", event.Properties))
+	return nil
+}
+
+// Close implements Sink.
+func (sink *logSink) Close() error { return nil }
+
+// Event is a provider agnostic representation of an analytics message.
+type Event struct {
+	Type        string                 `json:"type"`
+	Name        string                 `json:"name,omitempty"`
+	UserID      string                 `json:"userId,omitempty"`
+	AnonymousID string                 `json:"anonymousId,omitempty"`
+	Properties  map[string]interface{} `json:"properties,omitempty"`
+	Timestamp   time.Time              `json:"timestamp"`
+}
+
+// Event types.
+const (
+	EventTypeTrack    = "track"
+	EventTypeIdentify = "identify"
+	EventTypePage     = "page"
+)
+
+// EventFromMessage converts a segment message into an Event.
+func EventFromMessage(message segment.Message) (Event, error) {
+	now := time.Now()
+	timestampOr := func(t time.Time) time.Time {
+		if t.IsZero() {
+			return now
+		}
+		return t
+	}
+
+	switch m := message.(type) {
+	case segment.Track:
+		return Event{
+			Type:        EventTypeTrack,
+			Name:        m.Event,
+			UserID:      m.UserId,
+			AnonymousID: m.AnonymousId,
+			Properties:  m.Properties,
+			Timestamp:   timestampOr(m.Timestamp),
+		}, nil
+	case segment.Identify:
+		return Event{
+			Type:        EventTypeIdentify,
+			UserID:      m.UserId,
+			AnonymousID: m.AnonymousId,
+			Properties:  m.Traits,
+			Timestamp:   timestampOr(m.Timestamp),
+		}, nil
+	case segment.Page:
+		return Event{
+			Type:        EventTypePage,
+			Name:        m.Name,
+			UserID:      m.UserId,
+			AnonymousID: m.AnonymousId,
+			Properties:  m.Properties,
+			Timestamp:   timestampOr(m.Timestamp),
+		}, nil
+	default:
+		return Event{}, Error.New("unsupported message type %T", message)
+	}
+}
+
+// BatchSender sends a batch of events to an analytics provider.
+type BatchSender interface {
+	SendBatch(ctx context.Context, events []Event) error
+}
+
+// batchSink queues events and delivers them in batches, retrying failed batches.
+type batchSink struct {
+	log    *zap.Logger
+	config BatchConfig
+	sender BatchSender
+
+	wake chan struct{}
+
+	mu    sync.Mutex
+	queue []Event
+}
+
+func newBatchSink(log *zap.Logger, config BatchConfig, sender BatchSender) *batchSink {
+	if config.Size <= 0 {
+		config.Size = 100
+	}
+	if config.QueueSize <= 0 {
+		config.QueueSize = 1000
+	}
+	if config.Interval <= 0 {
+		config.Interval = 5 * time.Second
+	}
+	return &batchSink{
+		log:    log,
+		config: config,
+		sender: sender,
+		wake:   make(chan struct{}, 1),
+	}
+}
+
+// Run periodically flushes queued events.
+// A flush is started early when a full batch is queued.
+func (sink *batchSink) Run(ctx context.Context) error {
+	ticker := time.NewTicker(sink.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		case <-sink.wake:
+		}
+		sink.flush(ctx)
+	}
+}
+
+// Enqueue implements Sink.
+func (sink *batchSink) Enqueue(message segment.Message) error {
+	event, err := EventFromMessage(message)
+	if err != nil {
+		return err
+	}
+
+	sink.mu.Lock()
+	if len(sink.queue) >= sink.config.QueueSize {
+		sink.mu.Unlock()
+		mon.Counter("analytics_events_dropped").Inc(1)
+		return Error.New("event queue is full")
+	}
+	sink.queue = append(sink.queue, event)
+	full := len(sink.queue) >= sink.config.Size
+	sink.mu.Unlock()
+
+	if full {
+		select {
+		case sink.wake <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// Close sends the remaining events.
+func (sink *batchSink) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	sink.flush(ctx)
+	return nil
+}
+
+// flush sends all queued events in batches of at most config.Size.
+func (sink *batchSink) flush(ctx context.Context) {
+	for {
+		sink.mu.Lock()
+		n := len(sink.queue)
+		if n > sink.config.Size {
+			n = sink.config.Size
+		}
+		batch := sink.queue[:n:n]
+		sink.queue = sink.queue[n:]
+		sink.mu.Unlock()
+
+		if len(batch) == 0 {
+			return
+		}
+
+		if err := sink.send(ctx, batch); err != nil {
+			mon.Counter("analytics_events_dropped").Inc(int64(len(batch)))
+			sink.log.Error("sending analytics batch failed", zap.Int("events", len(batch)), zap.Error(err))
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// send sends a single batch, retrying with exponential backoff.
+func (sink *batchSink) send(ctx context.Context, batch []Event) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	backoff := sink.config.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		err = sink.sender.SendBatch(ctx, batch)
+		if err == nil || attempt >= sink.config.Retries {
+			return err
+		}
+		sink.log.Debug("retrying analytics batch", zap.Int("attempt", attempt+1), zap.Error(err))
+		if !sync2.Sleep(ctx, backoff) {
+			return ctx.Err()
+		}
+		backoff *= 2
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package analytics_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	segment "gopkg.in/segmentio/analytics-go.v3"
+
+	"storj.io/storj/satellite/analytics"
+)
+
+func TestHTTPSinkBatchingAndRetry(t *testing.T) {
+	var mu sync.Mutex
+	var requests int
+	var batches [][]analytics.Event
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var batch []analytics.Event
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&batch))
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		batches = append(batches, batch)
+	}))
+	defer server.Close()
+
+	sink, err := analytics.NewSink(zaptest.NewLogger(t), analytics.Config{
+		Sink: analytics.SinkHTTP,
+		Batch: analytics.BatchConfig{
+			Size:         2,
+			Interval:     time.Hour,
+			QueueSize:    10,
+			Retries:      1,
+			RetryBackoff: time.Millisecond,
+		},
+		HTTP: analytics.HTTPSinkConfig{
+			URL:            server.URL,
+			Authorization:  "Bearer secret",
+			DefaultTimeout: time.Second,
+		},
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, sink.Enqueue(segment.Track{
+			UserId: "user",
+			Event:  "Signed In",
+		}))
+	}
+	require.NoError(t, sink.Enqueue(segment.Identify{UserId: "user"}))
+	require.NoError(t, sink.Close())
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	// one failed request, followed by three successful ones.
+	require.Equal(t, 4, requests)
+	require.Len(t, batches, 3)
+
+	var total int
+	for _, batch := range batches {
+		require.LessOrEqual(t, len(batch), 2)
+		total += len(batch)
+	}
+	require.Equal(t, 6, total)
+	require.Equal(t, analytics.EventTypeTrack, batches[0][0].Type)
+	require.Equal(t, "Signed In", batches[0][0].Name)
+	require.Equal(t, analytics.EventTypeIdentify, batches[2][1].Type)
+}
+
+func TestNewSinkUnknown(t *testing.T) {
+	_, err := analytics.NewSink(zaptest.NewLogger(t), analytics.Config{Sink: "carrier-pigeon"})
+	require.Error(t, err)
+}
@@ -24,26 +24,42 @@ import (
 	"storj.io/common/storj"
 	"storj.io/private/debug"
 	"storj.io/private/version"
+	"storj.io/storj/private/appealpb"
+	"storj.io/storj/private/capabilitiespb"
+	"storj.io/storj/private/clock"
+	"storj.io/storj/private/containmentpb"
 	"storj.io/storj/private/lifecycle"
+	"storj.io/storj/private/maintenancepb"
+	"storj.io/storj/private/nodemessagepb"
+	"storj.io/storj/private/ratelimit"
+	"storj.io/storj/private/revocationpb"
 	"storj.io/storj/private/server"
+	"storj.io/storj/private/ssepb"
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/abtesting"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/analytics"
+	"storj.io/storj/satellite/appeals"
+	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/authservice"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb"
 	"storj.io/storj/satellite/console/restkeys"
 	"storj.io/storj/satellite/console/userinfo"
 	"storj.io/storj/satellite/contact"
+	"storj.io/storj/satellite/eventing"
+	"storj.io/storj/satellite/featureflags"
 	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/inspector"
 	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/kms"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/metainfo/piecedeletion"
+	"storj.io/storj/satellite/nodemessages"
 	"storj.io/storj/satellite/nodestats"
 	"storj.io/storj/satellite/oidc"
 	"storj.io/storj/satellite/orders"
@@ -51,8 +67,13 @@ import (
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripe"
+	"storj.io/storj/satellite/payments/tax"
+	"storj.io/storj/satellite/quarantine"
+	"storj.io/storj/satellite/reload"
 	"storj.io/storj/satellite/reputation"
 	"storj.io/storj/satellite/snopayouts"
+	"storj.io/storj/satellite/statuspage"
+	"storj.io/storj/satellite/tracing"
 )
 
 // API is the satellite API process.
@@ -66,6 +87,8 @@ type API struct {
 	Servers  *lifecycle.Group
 	Services *lifecycle.Group
 
+	Clock clock.Clock
+
 	Dialer          rpc.Dialer
 	Server          *server.Server
 	ExternalAddress string
@@ -81,16 +104,20 @@ type API struct {
 	}
 
 	Contact struct {
-		Service  *contact.Service
-		Endpoint *contact.Endpoint
+		Service              *contact.Service
+		Endpoint             *contact.Endpoint
+		RevocationEndpoint   *contact.RevocationEndpoint
+		CapabilitiesEndpoint *contact.CapabilitiesEndpoint
 	}
 
 	Overlay struct {
-		DB      overlay.DB
-		Service *overlay.Service
+		DB                  overlay.DB
+		Service             *overlay.Service
+		MaintenanceEndpoint *overlay.MaintenanceEndpoint
 	}
 
 	Reputation struct {
+		DB      reputation.DB
 		Service *reputation.Service
 	}
 
@@ -99,6 +126,7 @@ type API struct {
 		Endpoint *orders.Endpoint
 		Service  *orders.Service
 		Chore    *orders.Chore
+		Outages  *orders.OutageTracker
 	}
 
 	Metainfo struct {
@@ -107,6 +135,19 @@ type API struct {
 		Endpoint      *metainfo.Endpoint
 	}
 
+	KMS struct {
+		Service  *kms.Service
+		Endpoint *metainfo.SSEEndpoint
+	}
+
+	Eventing struct {
+		Service *eventing.Service
+	}
+
+	Tracing struct {
+		Service *tracing.Service
+	}
+
 	Userinfo struct {
 		Endpoint *userinfo.Endpoint
 	}
@@ -123,6 +164,14 @@ type API struct {
 		Cache accounting.Cache
 	}
 
+	RateLimiter struct {
+		Limiter ratelimit.Limiter
+	}
+
+	Reload struct {
+		Registry *reload.Registry
+	}
+
 	ProjectLimits struct {
 		Cache *accounting.ProjectLimitCache
 	}
@@ -151,12 +200,41 @@ type API struct {
 		Service    *console.Service
 		Endpoint   *consoleweb.Server
 		AuthTokens *consoleauth.Service
+
+		GatewayCredentialUsage *console.GatewayCredentialUsage
 	}
 
 	NodeStats struct {
 		Endpoint *nodestats.Endpoint
 	}
 
+	Appeals struct {
+		Service  *appeals.Service
+		Endpoint *appeals.Endpoint
+	}
+
+	NodeMessages struct {
+		Service  *nodemessages.Service
+		Endpoint *nodemessages.Endpoint
+	}
+
+	StatusPage struct {
+		Requests *statuspage.RequestStats
+		Service  *statuspage.Service
+	}
+
+	UserAgentRollups struct {
+		Service *attribution.UserAgentRollups
+	}
+
+	APIUsageRollups struct {
+		Service *accounting.APIUsageRollups
+	}
+
+	FeatureFlags struct {
+		Service *featureflags.Service
+	}
+
 	OIDC struct {
 		Service *oidc.Service
 	}
@@ -201,6 +279,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 
 	{ // setup buckets service
 		peer.Buckets.Service = buckets.NewService(db.Buckets(), metabaseDB)
+		peer.Buckets.Service.SetMetadataHistory(peer.Log.Named("buckets:history"), db.BucketMetadataHistory())
 	}
 
 	{ // setup debug
@@ -222,6 +301,8 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 	}
 
+	peer.Clock = newClock(config.SimulateClock, peer.Debug.Server.Panel)
+
 	var err error
 
 	{
@@ -275,7 +356,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 	}
 
 	{ // setup mailservice
-		peer.Mail.Service, err = setupMailService(peer.Log, *config)
+		peer.Mail.Service, err = setupMailService(peer.Log, peer.DB, *config)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
@@ -310,7 +391,9 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			})
 			reputationDB = cachingDB
 		}
+		peer.Reputation.DB = reputationDB
 		peer.Reputation.Service = reputation.NewService(peer.Log.Named("reputation"), peer.Overlay.Service, reputationDB, config.Reputation)
+		peer.Reputation.Service.SetClock(peer.Clock)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "reputation",
 			Close: peer.Reputation.Service.Close,
@@ -339,6 +422,18 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			return nil, errs.Combine(err, peer.Close())
 		}
 
+		peer.Contact.CapabilitiesEndpoint = contact.NewCapabilitiesEndpoint(peer.Log.Named("contact:capabilities"), peer.Overlay.Service, config.Reputation.MaintenanceWindows)
+		if err := capabilitiespb.DRPCRegisterNodeCapabilities(peer.Server.DRPC(), peer.Contact.CapabilitiesEndpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		if source, ok := revocationDB.(contact.RevocationSource); ok {
+			peer.Contact.RevocationEndpoint = contact.NewRevocationEndpoint(peer.Log.Named("contact:revocations"), source, config.Contact.RevocationsCacheDuration)
+			if err := revocationpb.DRPCRegisterIdentityRevocations(peer.Server.DRPC(), peer.Contact.RevocationEndpoint); err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+		}
+
 		peer.Services.Add(lifecycle.Item{
 			Name:  "contact:service",
 			Close: peer.Contact.Service.Close,
@@ -349,6 +444,25 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		peer.LiveAccounting.Cache = liveAccounting
 	}
 
+	{ // setup configuration reload
+		peer.Reload.Registry = reload.NewRegistry(peer.Log.Named("reload"), config.Reload)
+		peer.Services.Add(lifecycle.Item{
+			Name: "reload",
+			Run:  peer.Reload.Registry.Run,
+		})
+	}
+
+	{ // setup rate limiter
+		peer.RateLimiter.Limiter, err = ratelimit.New(peer.Log.Named("ratelimiter"), config.RateLimiter)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Services.Add(lifecycle.Item{
+			Name:  "ratelimiter",
+			Close: peer.RateLimiter.Limiter.Close,
+		})
+	}
+
 	{ // setup project limits
 		peer.ProjectLimits.Cache = accounting.NewProjectLimitCache(peer.DB.ProjectAccounting(),
 			config.Console.Config.UsageLimits.Storage.Free,
@@ -408,6 +522,24 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		if err := pb.DRPCRegisterOrders(peer.Server.DRPC(), peer.Orders.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+
+		if config.Orders.SettlementExtension.Enabled {
+			peer.Orders.Outages = orders.NewOutageTracker(peer.Log.Named("orders:outages"), peer.DB.Outages(), config.Orders.SettlementExtension, config.Orders.Expiration)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "orders:outages",
+				Run:   peer.Orders.Outages.Run,
+				Close: peer.Orders.Outages.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Orders Outages", peer.Orders.Outages.Loop))
+
+			peer.Orders.Endpoint.SetOutages(peer.Orders.Outages)
+			peer.Contact.CapabilitiesEndpoint.SetOutages(peer.Orders.Outages)
+		}
+
+		if config.Quarantine.Enabled {
+			peer.Orders.Endpoint.SetCorruptions(quarantine.NewRecorder(peer.Log.Named("quarantine:recorder"), peer.DB.NodeQuarantines()))
+		}
 	}
 
 	{ // setup analytics service
@@ -428,6 +560,61 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 	}
 
+	{ // setup bucket events.
+		if config.Eventing.Enabled {
+			peer.Eventing.Service = eventing.NewService(peer.Log.Named("eventing"), peer.DB.Console().BucketNotifications(), config.Eventing)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "eventing",
+				Run:   peer.Eventing.Service.Run,
+				Close: peer.Eventing.Service.Close,
+			})
+		}
+	}
+
+	{ // setup request tracing
+		if config.RequestTracing.Enabled {
+			peer.Tracing.Service, err = tracing.NewService(peer.Log.Named("tracing"), config.RequestTracing)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			peer.Services.Add(lifecycle.Item{
+				Name:  "tracing:exporter",
+				Run:   peer.Tracing.Service.Exporter().Run,
+				Close: peer.Tracing.Service.Exporter().Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Tracing Exporter", peer.Tracing.Service.Exporter().Loop))
+
+			sampler := peer.Tracing.Service.Sampler()
+			peer.Reload.Registry.Register("request-tracing.sample-rate", reload.Float64(
+				sampler.DefaultRate,
+				sampler.SetDefaultRate,
+				tracing.ValidateSampleRate,
+			))
+			peer.Reload.Registry.Register("request-tracing.project-sample-rates", reload.Value[tracing.ProjectSampleRates]{
+				Parse:  tracing.ParseProjectSampleRates,
+				Format: func(rates tracing.ProjectSampleRates) string { return rates.String() },
+				Load:   sampler.ProjectRates,
+				Store:  sampler.SetProjectRates,
+			})
+		}
+	}
+
+	{ // setup feature flags
+		peer.FeatureFlags.Service, err = featureflags.NewService(
+			peer.Log.Named("featureflags"),
+			peer.DB.FeatureFlags(),
+			config.FeatureFlags,
+		)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Services.Add(lifecycle.Item{
+			Name: "featureflags",
+			Run:  peer.FeatureFlags.Service.Run,
+		})
+	}
+
 	{ // setup metainfo
 		peer.Metainfo.Metabase = metabaseDB
 
@@ -462,11 +649,20 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.DB.Console().Projects(),
 			signing.SignerFromFullIdentity(peer.Identity),
 			peer.DB.Revocation(),
+			peer.RateLimiter.Limiter,
+			peer.Eventing.Service,
+			peer.Tracing.Service,
 			config.Metainfo,
 		)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		peer.Metainfo.Endpoint.SetFeatureFlags(peer.FeatureFlags.Service)
+		peer.Reload.Registry.Register("metainfo.rate-limiter.rate", reload.Float64(
+			peer.Metainfo.Endpoint.DefaultRateLimit,
+			peer.Metainfo.Endpoint.SetDefaultRateLimit,
+			metainfo.ValidateRateLimit,
+		))
 
 		if err := pb.DRPCRegisterMetainfo(peer.Server.DRPC(), peer.Metainfo.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -474,10 +670,66 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 
 		peer.Services.Add(lifecycle.Item{
 			Name:  "metainfo:endpoint",
+			Run:   peer.Metainfo.Endpoint.Run,
 			Close: peer.Metainfo.Endpoint.Close,
 		})
 	}
 
+	if config.UserAgentRollups.Enabled { // setup user agent rollups
+		peer.UserAgentRollups.Service = attribution.NewUserAgentRollups(
+			peer.Log.Named("attribution:useragent-rollups"),
+			config.UserAgentRollups,
+			peer.DB.Attribution(),
+		)
+		peer.Metainfo.Endpoint.SetUserAgentRollups(peer.UserAgentRollups.Service)
+
+		peer.Services.Add(lifecycle.Item{
+			Name:  "attribution:useragent-rollups",
+			Run:   peer.UserAgentRollups.Service.Run,
+			Close: peer.UserAgentRollups.Service.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("User Agent Rollups", peer.UserAgentRollups.Service.Loop))
+	}
+
+	if config.APIUsageRollups.Enabled { // setup api usage rollups
+		peer.APIUsageRollups.Service = accounting.NewAPIUsageRollups(
+			peer.Log.Named("accounting:api-usage-rollups"),
+			config.APIUsageRollups,
+			peer.DB.ProjectAccounting(),
+		)
+		peer.Metainfo.Endpoint.SetAPIUsageRollups(peer.APIUsageRollups.Service)
+
+		peer.Services.Add(lifecycle.Item{
+			Name:  "accounting:api-usage-rollups",
+			Run:   peer.APIUsageRollups.Service.Run,
+			Close: peer.APIUsageRollups.Service.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("API Usage Rollups", peer.APIUsageRollups.Service.Loop))
+	}
+
+	{ // setup server-side encryption.
+		if config.KMS.Enabled {
+			masterKey, err := kms.OpenLocalMasterKey(config.KMS.MasterKeyID, config.KMS.MasterKeyPath)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+
+			peer.KMS.Service = kms.NewService(
+				peer.Log.Named("kms"),
+				peer.DB.BucketEncryptionKeys(),
+				peer.DB.ProjectRootKeys(),
+				masterKey,
+			)
+			peer.KMS.Endpoint = metainfo.NewSSEEndpoint(peer.Metainfo.Endpoint, peer.KMS.Service)
+
+			if err := ssepb.DRPCRegisterServerSideEncryption(peer.Server.DRPC(), peer.KMS.Endpoint); err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+		}
+	}
+
 	{ // setup userinfo.
 		if config.Userinfo.Enabled {
 
@@ -586,6 +838,27 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		peer.REST.Keys = restkeys.NewService(peer.DB.OIDC().OAuthTokens(), config.RESTKeys)
 	}
 
+	if config.StatusPage.Enabled { // setup status page
+		peer.StatusPage.Requests = &statuspage.RequestStats{}
+		peer.Server.SetRequestObserver(peer.StatusPage.Requests)
+
+		peer.StatusPage.Service = statuspage.NewService(
+			peer.Log.Named("statuspage"),
+			config.StatusPage,
+			peer.StatusPage.Requests,
+			peer.DB.RepairQueue(),
+			peer.Reputation.DB,
+			peer.Overlay.Service.UploadSelectionCache,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "statuspage",
+			Run:   peer.StatusPage.Service.Run,
+			Close: peer.StatusPage.Service.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Status Page", peer.StatusPage.Service.Loop))
+	}
+
 	{ // setup console
 		consoleConfig := config.Console
 		peer.Console.Listener, err = net.Listen("tcp", consoleConfig.Address)
@@ -598,6 +871,9 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 
 		peer.Console.AuthTokens = consoleauth.NewService(config.ConsoleAuth, &consoleauth.Hmac{Secret: []byte(consoleConfig.AuthTokenSecret)})
 
+		// buckets created through the console are subject to the same limit as the ones created through metainfo.
+		consoleConfig.Config.DefaultMaxBuckets = config.Metainfo.ProjectLimits.MaxBuckets
+
 		externalAddress := consoleConfig.ExternalAddress
 		if externalAddress == "" {
 			externalAddress = "http://" + peer.Console.Listener.Addr().String()
@@ -610,6 +886,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.DB.ProjectAccounting(),
 			peer.Accounting.ProjectUsage,
 			peer.Buckets.Service,
+			peer.DB.Attribution(),
 			peer.Payments.Accounts,
 			peer.Payments.DepositWallets,
 			peer.DB.Billing(),
@@ -623,8 +900,62 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			return nil, errs.Combine(err, peer.Close())
 		}
 
+		if taxConfig := config.Payments.Tax; taxConfig.Enabled {
+			taxes, err := tax.NewEUVAT(taxConfig)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			peer.Console.Service.SetTaxEngine(taxes)
+		}
+
+		if gatewayCredentials := consoleConfig.Config.GatewayCredentials; gatewayCredentials.Enabled {
+			peer.Console.Service.SetGatewayCredentials(
+				authservice.NewClient(consoleConfig.GatewayCredentialsRequestURL, gatewayCredentials.RequestTimeout),
+				peer.DB.Revocation(),
+			)
+
+			peer.Console.GatewayCredentialUsage = console.NewGatewayCredentialUsage(
+				peer.Log.Named("console:gateway-credential-usage"),
+				peer.DB.Console().GatewayCredentials(),
+				gatewayCredentials,
+			)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "console:gateway-credential-usage",
+				Run:   peer.Console.GatewayCredentialUsage.Run,
+				Close: peer.Console.GatewayCredentialUsage.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Console Gateway Credential Usage", peer.Console.GatewayCredentialUsage.Loop))
+			peer.Metainfo.Endpoint.SetGatewayCredentialUsage(peer.Console.GatewayCredentialUsage)
+		}
+
+		if consoleConfig.Config.ServerSideAccess.Enabled {
+			if peer.KMS.Service == nil {
+				return nil, errs.Combine(errs.New("server-side access grants require the kms to be enabled"), peer.Close())
+			}
+			peer.Console.Service.SetProjectRootKeys(peer.KMS.Service, peer.URL().String())
+		}
+
 		accountFreezeService := console.NewAccountFreezeService(db.Console().AccountFreezeEvents(), db.Console().Users(), db.Console().Projects(), peer.Analytics.Service)
 
+		if notificationsConfig := consoleConfig.Config.Notifications; notificationsConfig.Enabled {
+			notifier := console.NewNotifier(
+				peer.Log.Named("console:notifications"),
+				db.Console().Notifications(),
+				db.Console().Projects(),
+				notificationsConfig,
+			)
+			peer.Console.Service.SetNotifier(notifier)
+			accountFreezeService.SetNotifier(notifier)
+			peer.Metainfo.Endpoint.SetNotifier(notifier)
+		}
+
+		// the console keeps its own limits, unless they're shared by the replicas.
+		var consoleLimiter ratelimit.Limiter
+		if config.RateLimiter.Shared() {
+			consoleLimiter = peer.RateLimiter.Limiter
+		}
+
 		peer.Console.Endpoint = consoleweb.NewServer(
 			peer.Log.Named("console:endpoint"),
 			consoleConfig,
@@ -638,6 +969,9 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			config.Payments.StripeCoinPayments.StripePublicKey,
 			peer.URL(),
 			config.Payments.PackagePlans,
+			consoleLimiter,
+			peer.StatusPage.Service,
+			peer.Payments.StripeService,
 		)
 
 		peer.Servers.Add(lifecycle.Item{
@@ -653,11 +987,58 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.Overlay.DB,
 			peer.Reputation.Service,
 			peer.DB.StoragenodeAccounting(),
+			peer.DB.Containment(),
 			config.Payments,
 		)
 		if err := pb.DRPCRegisterNodeStats(peer.Server.DRPC(), peer.NodeStats.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		if err := containmentpb.DRPCRegisterNodeContainment(peer.Server.DRPC(), peer.NodeStats.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+	}
+
+	if config.Appeals.Enabled { // setup node appeals endpoint
+		peer.Appeals.Service = appeals.NewService(
+			peer.Log.Named("appeals:service"),
+			peer.DB.NodeAppeals(),
+			peer.Overlay.DB,
+			peer.Reputation.DB,
+			config.Reputation,
+			config.Appeals,
+		)
+		peer.Appeals.Endpoint = appeals.NewEndpoint(
+			peer.Log.Named("appeals:endpoint"),
+			peer.Appeals.Service,
+		)
+		if err := appealpb.DRPCRegisterNodeAppeals(peer.Server.DRPC(), peer.Appeals.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+	}
+
+	if config.Overlay.Maintenance.Enabled { // setup node maintenance endpoint
+		peer.Overlay.MaintenanceEndpoint = overlay.NewMaintenanceEndpoint(
+			peer.Log.Named("overlay:maintenance-endpoint"),
+			peer.Overlay.Service,
+		)
+		if err := maintenancepb.DRPCRegisterNodeMaintenance(peer.Server.DRPC(), peer.Overlay.MaintenanceEndpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+	}
+
+	if config.NodeMessages.Enabled { // setup node messages endpoint
+		peer.NodeMessages.Service = nodemessages.NewService(
+			peer.Log.Named("nodemessages:service"),
+			peer.DB.NodeMessages(),
+			config.NodeMessages,
+		)
+		peer.NodeMessages.Endpoint = nodemessages.NewEndpoint(
+			peer.Log.Named("nodemessages:endpoint"),
+			peer.NodeMessages.Service,
+		)
+		if err := nodemessagepb.DRPCRegisterNodeMessages(peer.Server.DRPC(), peer.NodeMessages.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 	}
 
 	{ // setup SnoPayout endpoint
@@ -0,0 +1,182 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package appeals
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/overlay"
+)
+
+var (
+	// Error is the default error class for the appeals.
+	Error = errs.Class("appeals")
+	// ErrNotFound is returned when the appeal doesn't exist.
+	ErrNotFound = errs.Class("appeal not found")
+	// ErrInvalid is returned when the appeal is malformed.
+	ErrInvalid = errs.Class("invalid appeal")
+	// ErrNotAppealable is returned when the node can't appeal its status, e.g. because it's
+	// neither suspended nor disqualified or its previous appeal is still pending.
+	ErrNotAppealable = errs.Class("not appealable")
+	// ErrReviewed is returned when the appeal has been already approved or rejected.
+	ErrReviewed = errs.Class("appeal already reviewed")
+
+	mon = monkit.Package()
+)
+
+// Config contains the configuration of the appeals.
+type Config struct {
+	Enabled           bool          `help:"whether the suspended or disqualified storage nodes can appeal their status" default:"true"`
+	MaxMessageLength  int           `help:"the longest explanation of an appeal" default:"4096"`
+	RejectionCooldown time.Duration `help:"how long a node has to wait after a rejected appeal before appealing again" default:"720h"`
+}
+
+// Kind is the appealed status of the node.
+type Kind int
+
+const (
+	// KindSuspension is an appeal of the unknown audit or the offline suspension.
+	KindSuspension Kind = 0
+	// KindDisqualification is an appeal of the disqualification.
+	KindDisqualification Kind = 1
+)
+
+// String returns a string representation of the kind.
+func (kind Kind) String() string {
+	switch kind {
+	case KindSuspension:
+		return "suspension"
+	case KindDisqualification:
+		return "disqualification"
+	default:
+		return "unknown"
+	}
+}
+
+// Status is the state of the review of an appeal.
+type Status int
+
+const (
+	// StatusPending is an appeal waiting for the review.
+	StatusPending Status = 0
+	// StatusApproved is an approved appeal, which reinstated the node.
+	StatusApproved Status = 1
+	// StatusRejected is a rejected appeal.
+	StatusRejected Status = 2
+)
+
+// String returns a string representation of the status.
+func (status Status) String() string {
+	switch status {
+	case StatusPending:
+		return "pending"
+	case StatusApproved:
+		return "approved"
+	case StatusRejected:
+		return "rejected"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseStatus parses the string representation of a status.
+func ParseStatus(s string) (Status, error) {
+	for _, status := range []Status{StatusPending, StatusApproved, StatusRejected} {
+		if status.String() == s {
+			return status, nil
+		}
+	}
+	return 0, ErrInvalid.New("unknown status %q", s)
+}
+
+// Action is an action taken on an appeal.
+type Action string
+
+const (
+	// ActionFiled is logged when the node files the appeal.
+	ActionFiled Action = "filed"
+	// ActionApproved is logged when the reviewer approves the appeal.
+	ActionApproved Action = "approved"
+	// ActionRejected is logged when the reviewer rejects the appeal.
+	ActionRejected Action = "rejected"
+	// ActionReinstated is logged when the node is reinstated after the approval.
+	ActionReinstated Action = "reinstated"
+)
+
+// ReputationSnapshot is the reputation of the node when it filed the appeal.
+type ReputationSnapshot struct {
+	AuditSuccessCount      int64                           `json:"auditSuccessCount"`
+	TotalAuditCount        int64                           `json:"totalAuditCount"`
+	AuditScore             float64                         `json:"auditScore"`
+	UnknownAuditScore      float64                         `json:"unknownAuditScore"`
+	OnlineScore            float64                         `json:"onlineScore"`
+	VettedAt               *time.Time                      `json:"vettedAt"`
+	Disqualified           *time.Time                      `json:"disqualified"`
+	DisqualificationReason *overlay.DisqualificationReason `json:"disqualificationReason"`
+	UnknownAuditSuspended  *time.Time                      `json:"unknownAuditSuspended"`
+	OfflineSuspended       *time.Time                      `json:"offlineSuspended"`
+	UnderReview            *time.Time                      `json:"underReview"`
+}
+
+// Appeal is a request of a node operator to reinstate the suspended or disqualified node.
+type Appeal struct {
+	ID         uuid.UUID
+	NodeID     storj.NodeID
+	Kind       Kind
+	Status     Status
+	Message    string
+	Reputation ReputationSnapshot
+
+	ReviewNote string
+	ReviewedBy string
+	ReviewedAt *time.Time
+	CreatedAt  time.Time
+}
+
+// Event is an action taken on an appeal.
+type Event struct {
+	ID        uuid.UUID
+	AppealID  uuid.UUID
+	NodeID    storj.NodeID
+	Action    Action
+	Actor     string
+	Note      string
+	CreatedAt time.Time
+}
+
+// Review is the decision about an appeal.
+type Review struct {
+	Status     Status
+	Note       string
+	ReviewedBy string
+	ReviewedAt time.Time
+}
+
+// DB stores the appeals and the actions taken on them.
+//
+// architecture: Database
+type DB interface {
+	// Insert inserts a new appeal.
+	Insert(ctx context.Context, appeal Appeal) error
+	// Get returns the appeal.
+	Get(ctx context.Context, id uuid.UUID) (Appeal, error)
+	// ListByNode returns the appeals of the node, the newest first.
+	ListByNode(ctx context.Context, nodeID storj.NodeID) ([]Appeal, error)
+	// ListByStatus returns at most limit appeals with the status, the oldest first.
+	ListByStatus(ctx context.Context, status Status, limit int) ([]Appeal, error)
+	// Review records the decision about a pending appeal. It returns ErrReviewed, when
+	// the appeal isn't pending anymore.
+	Review(ctx context.Context, id uuid.UUID, review Review) error
+
+	// InsertEvent logs an action taken on an appeal.
+	InsertEvent(ctx context.Context, event Event) error
+	// ListEvents returns the actions taken on an appeal, the oldest first.
+	ListEvents(ctx context.Context, appealID uuid.UUID) ([]Event, error)
+}
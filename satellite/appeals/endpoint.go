@@ -0,0 +1,95 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package appeals
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/identity"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/private/appealpb"
+)
+
+// Endpoint receives the appeals of the suspended or disqualified storage nodes. The nodes
+// are authenticated by their identity.
+//
+// architecture: Endpoint
+type Endpoint struct {
+	appealpb.DRPCNodeAppealsUnimplementedServer
+
+	log     *zap.Logger
+	service *Service
+}
+
+// NewEndpoint returns a new node appeals endpoint.
+func NewEndpoint(log *zap.Logger, service *Service) *Endpoint {
+	return &Endpoint{
+		log:     log,
+		service: service,
+	}
+}
+
+// FileAppeal files an appeal of the calling node.
+func (endpoint *Endpoint) FileAppeal(ctx context.Context, req *appealpb.FileAppealRequest) (_ *appealpb.FileAppealResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+
+	appeal, err := endpoint.service.File(ctx, peer.ID, req.GetMessage())
+	if err != nil {
+		switch {
+		case ErrInvalid.Has(err):
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		case ErrNotAppealable.Has(err):
+			return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, err.Error())
+		}
+		endpoint.log.Error("failed to file appeal", zap.Stringer("Node ID", peer.ID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "failed to file appeal")
+	}
+
+	return &appealpb.FileAppealResponse{
+		Appeal: toProto(appeal),
+	}, nil
+}
+
+// ListAppeals returns the appeals of the calling node, the newest first.
+func (endpoint *Endpoint) ListAppeals(ctx context.Context, req *appealpb.ListAppealsRequest) (_ *appealpb.ListAppealsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+
+	appeals, err := endpoint.service.ListByNode(ctx, peer.ID)
+	if err != nil {
+		endpoint.log.Error("failed to list appeals", zap.Stringer("Node ID", peer.ID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "failed to list appeals")
+	}
+
+	resp := &appealpb.ListAppealsResponse{
+		Appeals: make([]*appealpb.Appeal, 0, len(appeals)),
+	}
+	for _, appeal := range appeals {
+		resp.Appeals = append(resp.Appeals, toProto(appeal))
+	}
+	return resp, nil
+}
+
+func toProto(appeal Appeal) *appealpb.Appeal {
+	return &appealpb.Appeal{
+		Id:         appeal.ID.Bytes(),
+		Kind:       appeal.Kind.String(),
+		Status:     appeal.Status.String(),
+		Message:    appeal.Message,
+		ReviewNote: appeal.ReviewNote,
+		CreatedAt:  appeal.CreatedAt,
+		ReviewedAt: appeal.ReviewedAt,
+	}
+}
@@ -0,0 +1,330 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package appeals
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/reputation"
+)
+
+// Service files the appeals of the storage nodes and reinstates the nodes, when the
+// operators of the satellite approve them. Every action is logged as an Event.
+//
+// architecture: Service
+type Service struct {
+	log              *zap.Logger
+	db               DB
+	overlay          overlay.DB
+	reputation       reputation.DB
+	reputationConfig reputation.Config
+	config           Config
+
+	nowFn func() time.Time
+}
+
+// NewService creates a new appeals service.
+func NewService(log *zap.Logger, db DB, overlay overlay.DB, reputation reputation.DB, reputationConfig reputation.Config, config Config) *Service {
+	return &Service{
+		log:              log,
+		db:               db,
+		overlay:          overlay,
+		reputation:       reputation,
+		reputationConfig: reputationConfig,
+		config:           config,
+
+		nowFn: time.Now,
+	}
+}
+
+// TestSetNow allows tests to have the service act as if the current time is
+// whatever they want.
+func (service *Service) TestSetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
+// File files an appeal of the suspended or disqualified node. The reputation of the
+// node is recorded with the appeal for the review.
+func (service *Service) File(ctx context.Context, nodeID storj.NodeID, message string) (_ Appeal, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if message == "" {
+		return Appeal{}, ErrInvalid.New("missing message")
+	}
+	if !utf8.ValidString(message) {
+		return Appeal{}, ErrInvalid.New("message is not valid utf-8")
+	}
+	if len(message) > service.config.MaxMessageLength {
+		return Appeal{}, ErrInvalid.New("message is longer than %d bytes", service.config.MaxMessageLength)
+	}
+
+	node, err := service.overlay.Get(ctx, nodeID)
+	if err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			return Appeal{}, ErrNotAppealable.New("unknown node")
+		}
+		return Appeal{}, Error.Wrap(err)
+	}
+
+	var kind Kind
+	switch {
+	case node.ExitStatus.ExitFinishedAt != nil:
+		return Appeal{}, ErrNotAppealable.New("node has exited")
+	case node.Disqualified != nil:
+		kind = KindDisqualification
+	case node.UnknownAuditSuspended != nil || node.OfflineSuspended != nil:
+		kind = KindSuspension
+	default:
+		return Appeal{}, ErrNotAppealable.New("node is neither suspended nor disqualified")
+	}
+
+	now := service.nowFn()
+
+	previous, err := service.db.ListByNode(ctx, nodeID)
+	if err != nil {
+		return Appeal{}, Error.Wrap(err)
+	}
+	for _, appeal := range previous {
+		switch {
+		case appeal.Status == StatusPending:
+			return Appeal{}, ErrNotAppealable.New("appeal %s is pending", appeal.ID)
+		case appeal.Status == StatusRejected && appeal.ReviewedAt != nil &&
+			now.Before(appeal.ReviewedAt.Add(service.config.RejectionCooldown)):
+			return Appeal{}, ErrNotAppealable.New("appeal %s was rejected, appeal again after %s",
+				appeal.ID, appeal.ReviewedAt.Add(service.config.RejectionCooldown).Format(time.RFC3339))
+		}
+	}
+
+	snapshot, err := service.snapshot(ctx, nodeID)
+	if err != nil {
+		return Appeal{}, Error.Wrap(err)
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return Appeal{}, Error.Wrap(err)
+	}
+	appeal := Appeal{
+		ID:         id,
+		NodeID:     nodeID,
+		Kind:       kind,
+		Status:     StatusPending,
+		Message:    message,
+		Reputation: snapshot,
+		CreatedAt:  now,
+	}
+	if err := service.db.Insert(ctx, appeal); err != nil {
+		return Appeal{}, Error.Wrap(err)
+	}
+	if err := service.logAction(ctx, appeal, ActionFiled, nodeID.String(), message); err != nil {
+		return Appeal{}, err
+	}
+
+	mon.Event("appeal_filed")
+	service.log.Info("node filed an appeal",
+		zap.Stringer("Appeal ID", appeal.ID),
+		zap.Stringer("Node ID", nodeID),
+		zap.Stringer("Kind", kind))
+
+	return appeal, nil
+}
+
+// Get returns the appeal and the actions taken on it.
+func (service *Service) Get(ctx context.Context, id uuid.UUID) (_ Appeal, _ []Event, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	appeal, err := service.db.Get(ctx, id)
+	if err != nil {
+		return Appeal{}, nil, err
+	}
+	events, err := service.db.ListEvents(ctx, id)
+	if err != nil {
+		return Appeal{}, nil, Error.Wrap(err)
+	}
+	return appeal, events, nil
+}
+
+// ListByNode returns the appeals of the node, the newest first.
+func (service *Service) ListByNode(ctx context.Context, nodeID storj.NodeID) (_ []Appeal, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	appeals, err := service.db.ListByNode(ctx, nodeID)
+	return appeals, Error.Wrap(err)
+}
+
+// ListByStatus returns at most limit appeals with the status, the oldest first.
+func (service *Service) ListByStatus(ctx context.Context, status Status, limit int) (_ []Appeal, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	appeals, err := service.db.ListByStatus(ctx, status, limit)
+	return appeals, Error.Wrap(err)
+}
+
+// Approve approves the pending appeal and reinstates the node. The disqualification and
+// the suspensions of the node are cleared and its scores are reset to the initial values.
+func (service *Service) Approve(ctx context.Context, id uuid.UUID, reviewer, note string) (_ Appeal, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	appeal, err := service.pending(ctx, id, reviewer)
+	if err != nil {
+		return Appeal{}, err
+	}
+
+	// the node is reinstated before the appeal is marked as approved, so that a failed
+	// reinstatement can be approved again.
+	if err := service.reputation.ReinstateNode(ctx, appeal.NodeID, service.reputationConfig); err != nil {
+		return Appeal{}, Error.Wrap(err)
+	}
+	if _, err := service.overlay.ReinstateNode(ctx, appeal.NodeID); err != nil {
+		return Appeal{}, Error.Wrap(err)
+	}
+
+	appeal, err = service.review(ctx, appeal, StatusApproved, reviewer, note)
+	if err != nil {
+		return Appeal{}, err
+	}
+	if err := service.logAction(ctx, appeal, ActionApproved, reviewer, note); err != nil {
+		return Appeal{}, err
+	}
+	if err := service.logAction(ctx, appeal, ActionReinstated, reviewer, ""); err != nil {
+		return Appeal{}, err
+	}
+
+	mon.Event("appeal_approved")
+	service.log.Info("appeal approved, node reinstated",
+		zap.Stringer("Appeal ID", appeal.ID),
+		zap.Stringer("Node ID", appeal.NodeID),
+		zap.Stringer("Kind", appeal.Kind),
+		zap.String("Reviewer", reviewer))
+
+	return appeal, nil
+}
+
+// Reject rejects the pending appeal. The node can't appeal again until the rejection
+// cooldown passes.
+func (service *Service) Reject(ctx context.Context, id uuid.UUID, reviewer, note string) (_ Appeal, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	appeal, err := service.pending(ctx, id, reviewer)
+	if err != nil {
+		return Appeal{}, err
+	}
+
+	appeal, err = service.review(ctx, appeal, StatusRejected, reviewer, note)
+	if err != nil {
+		return Appeal{}, err
+	}
+	if err := service.logAction(ctx, appeal, ActionRejected, reviewer, note); err != nil {
+		return Appeal{}, err
+	}
+
+	mon.Event("appeal_rejected")
+	service.log.Info("appeal rejected",
+		zap.Stringer("Appeal ID", appeal.ID),
+		zap.Stringer("Node ID", appeal.NodeID),
+		zap.Stringer("Kind", appeal.Kind),
+		zap.String("Reviewer", reviewer))
+
+	return appeal, nil
+}
+
+// pending returns the appeal, when it's still pending.
+func (service *Service) pending(ctx context.Context, id uuid.UUID, reviewer string) (Appeal, error) {
+	if reviewer == "" {
+		return Appeal{}, ErrInvalid.New("missing reviewer")
+	}
+
+	appeal, err := service.db.Get(ctx, id)
+	if err != nil {
+		return Appeal{}, err
+	}
+	if appeal.Status != StatusPending {
+		return Appeal{}, ErrReviewed.New("appeal %s is %s", id, appeal.Status)
+	}
+	return appeal, nil
+}
+
+// review records the decision about the appeal.
+func (service *Service) review(ctx context.Context, appeal Appeal, status Status, reviewer, note string) (Appeal, error) {
+	now := service.nowFn()
+	err := service.db.Review(ctx, appeal.ID, Review{
+		Status:     status,
+		Note:       note,
+		ReviewedBy: reviewer,
+		ReviewedAt: now,
+	})
+	if err != nil {
+		if ErrReviewed.Has(err) {
+			return Appeal{}, err
+		}
+		return Appeal{}, Error.Wrap(err)
+	}
+
+	appeal.Status = status
+	appeal.ReviewNote = note
+	appeal.ReviewedBy = reviewer
+	appeal.ReviewedAt = &now
+	return appeal, nil
+}
+
+// logAction logs an action taken on the appeal.
+func (service *Service) logAction(ctx context.Context, appeal Appeal, action Action, actor, note string) error {
+	id, err := uuid.New()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(service.db.InsertEvent(ctx, Event{
+		ID:        id,
+		AppealID:  appeal.ID,
+		NodeID:    appeal.NodeID,
+		Action:    action,
+		Actor:     actor,
+		Note:      note,
+		CreatedAt: service.nowFn(),
+	}))
+}
+
+// snapshot returns the current reputation of the node.
+func (service *Service) snapshot(ctx context.Context, nodeID storj.NodeID) (ReputationSnapshot, error) {
+	info, err := service.reputation.Get(ctx, nodeID)
+	if err != nil {
+		if reputation.ErrNodeNotFound.Has(err) {
+			return ReputationSnapshot{AuditScore: 1, UnknownAuditScore: 1, OnlineScore: 1}, nil
+		}
+		return ReputationSnapshot{}, err
+	}
+
+	snapshot := ReputationSnapshot{
+		AuditSuccessCount:     info.AuditSuccessCount,
+		TotalAuditCount:       info.TotalAuditCount,
+		AuditScore:            score(info.AuditReputationAlpha, info.AuditReputationBeta),
+		UnknownAuditScore:     score(info.UnknownAuditReputationAlpha, info.UnknownAuditReputationBeta),
+		OnlineScore:           info.OnlineScore,
+		VettedAt:              info.VettedAt,
+		Disqualified:          info.Disqualified,
+		UnknownAuditSuspended: info.UnknownAuditSuspended,
+		OfflineSuspended:      info.OfflineSuspended,
+		UnderReview:           info.UnderReview,
+	}
+	if info.Disqualified != nil {
+		reason := info.DisqualificationReason
+		snapshot.DisqualificationReason = &reason
+	}
+	return snapshot, nil
+}
+
+// score returns the reputation score from the alpha and beta values.
+func score(alpha, beta float64) float64 {
+	if alpha+beta == 0 {
+		return 1
+	}
+	return alpha / (alpha + beta)
+}
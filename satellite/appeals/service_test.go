@@ -0,0 +1,280 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package appeals_test
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/appeals"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/reputation"
+)
+
+func TestService(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	disqualifiedAt := now.Add(-24 * time.Hour)
+
+	nodeID := testrand.NodeID()
+	overlayDB := &overlayDB{nodes: map[storj.NodeID]*overlay.NodeDossier{
+		nodeID: {Disqualified: &disqualifiedAt},
+	}}
+	reputationDB := &reputationDB{infos: map[storj.NodeID]*reputation.Info{
+		nodeID: {
+			AuditSuccessCount:      90,
+			TotalAuditCount:        100,
+			AuditReputationAlpha:   3,
+			AuditReputationBeta:    1,
+			OnlineScore:            0.5,
+			Disqualified:           &disqualifiedAt,
+			DisqualificationReason: overlay.DisqualificationReasonAuditFailure,
+		},
+	}}
+
+	config := appeals.Config{
+		MaxMessageLength:  16,
+		RejectionCooldown: 24 * time.Hour,
+	}
+	service := appeals.NewService(zaptest.NewLogger(t), newAppealsDB(), overlayDB, reputationDB, reputation.Config{}, config)
+	service.TestSetNow(func() time.Time { return now })
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := service.File(ctx, nodeID, "")
+		require.True(t, appeals.ErrInvalid.Has(err))
+
+		_, err = service.File(ctx, nodeID, "this message is too long")
+		require.True(t, appeals.ErrInvalid.Has(err))
+	})
+
+	t.Run("not appealable", func(t *testing.T) {
+		_, err := service.File(ctx, testrand.NodeID(), "please")
+		require.True(t, appeals.ErrNotAppealable.Has(err))
+
+		healthyID := testrand.NodeID()
+		overlayDB.nodes[healthyID] = &overlay.NodeDossier{}
+		_, err = service.File(ctx, healthyID, "please")
+		require.True(t, appeals.ErrNotAppealable.Has(err))
+	})
+
+	var rejected appeals.Appeal
+	t.Run("reject", func(t *testing.T) {
+		appeal, err := service.File(ctx, nodeID, "disk failed")
+		require.NoError(t, err)
+		require.Equal(t, appeals.KindDisqualification, appeal.Kind)
+		require.Equal(t, appeals.StatusPending, appeal.Status)
+		require.Equal(t, 0.75, appeal.Reputation.AuditScore)
+		require.Equal(t, int64(100), appeal.Reputation.TotalAuditCount)
+		require.NotNil(t, appeal.Reputation.DisqualificationReason)
+		require.Equal(t, overlay.DisqualificationReasonAuditFailure, *appeal.Reputation.DisqualificationReason)
+
+		_, err = service.File(ctx, nodeID, "disk failed")
+		require.True(t, appeals.ErrNotAppealable.Has(err), "an appeal is pending")
+
+		pending, err := service.ListByStatus(ctx, appeals.StatusPending, 10)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		require.Equal(t, appeal.ID, pending[0].ID)
+
+		_, err = service.Reject(ctx, appeal.ID, "", "no")
+		require.True(t, appeals.ErrInvalid.Has(err), "missing reviewer")
+
+		rejected, err = service.Reject(ctx, appeal.ID, "operator", "too many failures")
+		require.NoError(t, err)
+		require.Equal(t, appeals.StatusRejected, rejected.Status)
+
+		_, err = service.Approve(ctx, appeal.ID, "operator", "")
+		require.True(t, appeals.ErrReviewed.Has(err))
+
+		_, err = service.File(ctx, nodeID, "please")
+		require.True(t, appeals.ErrNotAppealable.Has(err), "the rejection cooldown didn't pass")
+
+		require.NotNil(t, overlayDB.nodes[nodeID].Disqualified)
+	})
+
+	t.Run("approve", func(t *testing.T) {
+		now = now.Add(config.RejectionCooldown)
+
+		appeal, err := service.File(ctx, nodeID, "please")
+		require.NoError(t, err)
+
+		approved, err := service.Approve(ctx, appeal.ID, "operator", "ok")
+		require.NoError(t, err)
+		require.Equal(t, appeals.StatusApproved, approved.Status)
+		require.Equal(t, "operator", approved.ReviewedBy)
+
+		require.Nil(t, overlayDB.nodes[nodeID].Disqualified)
+		require.Nil(t, reputationDB.infos[nodeID].Disqualified)
+
+		_, events, err := service.Get(ctx, appeal.ID)
+		require.NoError(t, err)
+		var actions []appeals.Action
+		for _, event := range events {
+			actions = append(actions, event.Action)
+		}
+		require.Equal(t, []appeals.Action{appeals.ActionFiled, appeals.ActionApproved, appeals.ActionReinstated}, actions)
+
+		list, err := service.ListByNode(ctx, nodeID)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, appeal.ID, list[0].ID)
+		require.Equal(t, rejected.ID, list[1].ID)
+
+		_, err = service.File(ctx, nodeID, "please")
+		require.True(t, appeals.ErrNotAppealable.Has(err), "the node was reinstated")
+	})
+
+	t.Run("unknown appeal", func(t *testing.T) {
+		_, _, err := service.Get(ctx, testrand.UUID())
+		require.True(t, appeals.ErrNotFound.Has(err))
+
+		_, err = service.Approve(ctx, testrand.UUID(), "operator", "")
+		require.True(t, appeals.ErrNotFound.Has(err))
+	})
+}
+
+type overlayDB struct {
+	overlay.DB
+	nodes map[storj.NodeID]*overlay.NodeDossier
+}
+
+func (db *overlayDB) Get(ctx context.Context, nodeID storj.NodeID) (*overlay.NodeDossier, error) {
+	node, ok := db.nodes[nodeID]
+	if !ok {
+		return nil, overlay.ErrNodeNotFound.New("%v", nodeID)
+	}
+	return node, nil
+}
+
+func (db *overlayDB) ReinstateNode(ctx context.Context, nodeID storj.NodeID) (string, error) {
+	node, ok := db.nodes[nodeID]
+	if !ok {
+		return "", overlay.ErrNodeNotFound.New("%v", nodeID)
+	}
+	node.Disqualified = nil
+	node.UnknownAuditSuspended = nil
+	node.OfflineSuspended = nil
+	return "", nil
+}
+
+type reputationDB struct {
+	reputation.DB
+	infos map[storj.NodeID]*reputation.Info
+}
+
+func (db *reputationDB) Get(ctx context.Context, nodeID storj.NodeID) (*reputation.Info, error) {
+	info, ok := db.infos[nodeID]
+	if !ok {
+		return nil, reputation.ErrNodeNotFound.New("%v", nodeID)
+	}
+	return info, nil
+}
+
+func (db *reputationDB) ReinstateNode(ctx context.Context, nodeID storj.NodeID, config reputation.Config) error {
+	db.infos[nodeID] = &reputation.Info{OnlineScore: 1}
+	return nil
+}
+
+type appealsDB struct {
+	mu      sync.Mutex
+	appeals map[uuid.UUID]appeals.Appeal
+	events  []appeals.Event
+}
+
+func newAppealsDB() *appealsDB {
+	return &appealsDB{appeals: map[uuid.UUID]appeals.Appeal{}}
+}
+
+func (db *appealsDB) Insert(ctx context.Context, appeal appeals.Appeal) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.appeals[appeal.ID] = appeal
+	return nil
+}
+
+func (db *appealsDB) Get(ctx context.Context, id uuid.UUID) (appeals.Appeal, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	appeal, ok := db.appeals[id]
+	if !ok {
+		return appeals.Appeal{}, appeals.ErrNotFound.New("%s", id)
+	}
+	return appeal, nil
+}
+
+func (db *appealsDB) ListByNode(ctx context.Context, nodeID storj.NodeID) ([]appeals.Appeal, error) {
+	list := db.list(func(appeal appeals.Appeal) bool { return appeal.NodeID == nodeID })
+	sort.Slice(list, func(i, k int) bool { return list[i].CreatedAt.After(list[k].CreatedAt) })
+	return list, nil
+}
+
+func (db *appealsDB) ListByStatus(ctx context.Context, status appeals.Status, limit int) ([]appeals.Appeal, error) {
+	list := db.list(func(appeal appeals.Appeal) bool { return appeal.Status == status })
+	sort.Slice(list, func(i, k int) bool { return list[i].CreatedAt.Before(list[k].CreatedAt) })
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
+
+func (db *appealsDB) list(match func(appeals.Appeal) bool) []appeals.Appeal {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	var list []appeals.Appeal
+	for _, appeal := range db.appeals {
+		if match(appeal) {
+			list = append(list, appeal)
+		}
+	}
+	return list
+}
+
+func (db *appealsDB) Review(ctx context.Context, id uuid.UUID, review appeals.Review) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	appeal, ok := db.appeals[id]
+	if !ok {
+		return appeals.ErrNotFound.New("%s", id)
+	}
+	if appeal.Status != appeals.StatusPending {
+		return appeals.ErrReviewed.New("%s", id)
+	}
+	reviewedAt := review.ReviewedAt
+	appeal.Status = review.Status
+	appeal.ReviewNote = review.Note
+	appeal.ReviewedBy = review.ReviewedBy
+	appeal.ReviewedAt = &reviewedAt
+	db.appeals[id] = appeal
+	return nil
+}
+
+func (db *appealsDB) InsertEvent(ctx context.Context, event appeals.Event) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.events = append(db.events, event)
+	return nil
+}
+
+func (db *appealsDB) ListEvents(ctx context.Context, appealID uuid.UUID) ([]appeals.Event, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	var list []appeals.Event
+	for _, event := range db.events {
+		if event.AppealID == appealID {
+			list = append(list, event)
+		}
+	}
+	return list, nil
+}
@@ -36,6 +36,20 @@ type BucketUsage struct {
 	Hours        int
 }
 
+// UserAgentRollup is the traffic of a single user agent product and version
+// to a bucket within a day.
+type UserAgentRollup struct {
+	ProjectID     uuid.UUID `json:"projectID"`
+	BucketName    string    `json:"bucketName"`
+	Product       string    `json:"product"`
+	Version       string    `json:"version"`
+	IntervalDay   time.Time `json:"intervalDay"`
+	UploadCount   int64     `json:"uploadCount"`
+	UploadBytes   int64     `json:"uploadBytes"`
+	DownloadCount int64     `json:"downloadCount"`
+	DownloadBytes int64     `json:"downloadBytes"`
+}
+
 // DB implements the database for value attribution table.
 //
 // architecture: Database
@@ -48,4 +62,8 @@ type DB interface {
 	QueryAttribution(ctx context.Context, userAgent []byte, start time.Time, end time.Time) ([]*BucketUsage, error)
 	// QueryAllAttribution queries all partner bucket usage data.
 	QueryAllAttribution(ctx context.Context, start time.Time, end time.Time) ([]*BucketUsage, error)
+	// UpdateUserAgentRollups adds the traffic of the rollups to the daily user agent rollups.
+	UpdateUserAgentRollups(ctx context.Context, rollups []UserAgentRollup) error
+	// GetUserAgentRollups returns the daily user agent rollups of the project for the days from since to before, inclusive.
+	GetUserAgentRollups(ctx context.Context, projectID uuid.UUID, since, before time.Time) ([]UserAgentRollup, error)
 }
@@ -94,6 +94,40 @@ func TestDB(t *testing.T) {
 	})
 }
 
+func TestUserAgentRollupsDB(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		attributionDB := db.Attribution()
+		project1, project2 := testrand.UUID(), testrand.UUID()
+		day := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+		rollups := []attribution.UserAgentRollup{
+			{ProjectID: project1, BucketName: "alpha", Product: "uplink", Version: "v1.76.0", IntervalDay: day, UploadCount: 1, UploadBytes: 100},
+			{ProjectID: project1, BucketName: "alpha", Product: "rclone", Version: "v1.62.2", IntervalDay: day, DownloadCount: 2, DownloadBytes: 200},
+			{ProjectID: project1, BucketName: "beta", Product: "uplink", Version: "v1.76.0", IntervalDay: day.AddDate(0, 0, 1), UploadCount: 3, UploadBytes: 300},
+			{ProjectID: project2, BucketName: "alpha", Product: "uplink", Version: "v1.76.0", IntervalDay: day, UploadCount: 4, UploadBytes: 400},
+		}
+		require.NoError(t, attributionDB.UpdateUserAgentRollups(ctx, rollups))
+
+		// the traffic of the existing rollups is added up.
+		require.NoError(t, attributionDB.UpdateUserAgentRollups(ctx, []attribution.UserAgentRollup{
+			{ProjectID: project1, BucketName: "alpha", Product: "uplink", Version: "v1.76.0", IntervalDay: day, UploadCount: 1, UploadBytes: 50, DownloadCount: 1, DownloadBytes: 10},
+		}))
+
+		got, err := attributionDB.GetUserAgentRollups(ctx, project1, day, day.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		require.Equal(t, []attribution.UserAgentRollup{
+			{ProjectID: project1, BucketName: "alpha", Product: "rclone", Version: "v1.62.2", IntervalDay: day, DownloadCount: 2, DownloadBytes: 200},
+			{ProjectID: project1, BucketName: "alpha", Product: "uplink", Version: "v1.76.0", IntervalDay: day, UploadCount: 2, UploadBytes: 150, DownloadCount: 1, DownloadBytes: 10},
+			{ProjectID: project1, BucketName: "beta", Product: "uplink", Version: "v1.76.0", IntervalDay: day.AddDate(0, 0, 1), UploadCount: 3, UploadBytes: 300},
+		}, got)
+
+		got, err = attributionDB.GetUserAgentRollups(ctx, project1, day.AddDate(0, 0, 1), day.AddDate(0, 0, 2))
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		require.Equal(t, "beta", got[0].BucketName)
+	})
+}
+
 func TestQueryAttribution(t *testing.T) {
 	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
 		now := time.Now()
@@ -0,0 +1,230 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package attribution
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/common/useragent"
+	"storj.io/common/uuid"
+)
+
+var (
+	mon = monkit.Package()
+
+	// ErrUserAgentRollups is the error class of the user agent rollups.
+	ErrUserAgentRollups = errs.Class("user agent rollups")
+)
+
+const (
+	// unknownProduct is recorded for the user agents, which couldn't be parsed.
+	unknownProduct = "unknown"
+
+	maxProductLength = 64
+	maxVersionLength = 32
+)
+
+// UserAgentRollupsConfig contains the configurable values of the user agent rollups.
+type UserAgentRollupsConfig struct {
+	Enabled       bool          `help:"whether the traffic is aggregated per user agent product and version into daily rollups" default:"false"`
+	FlushInterval time.Duration `help:"how often the aggregated traffic is written to the database" default:"1m" testDefault:"$TESTINTERVAL"`
+	MaxEntries    int           `help:"maximum number of the user agent entries, e.g. the application and the libraries it uses, recorded per request" default:"3"`
+	MaxPending    int           `help:"maximum number of the aggregated rollups kept in memory between the flushes. the traffic of the new rollups above the limit is dropped" default:"10000"`
+}
+
+type userAgentRollupKey struct {
+	projectID   uuid.UUID
+	bucketName  string
+	product     string
+	version     string
+	intervalDay time.Time
+}
+
+// UserAgentRollups aggregates the traffic per project, bucket and user agent
+// product and version in memory and periodically adds it to the daily rollups.
+//
+// architecture: Chore
+type UserAgentRollups struct {
+	log    *zap.Logger
+	config UserAgentRollupsConfig
+	db     DB
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+
+	mu      sync.Mutex
+	pending map[userAgentRollupKey]*UserAgentRollup
+}
+
+// NewUserAgentRollups creates a new aggregator of the user agent rollups.
+func NewUserAgentRollups(log *zap.Logger, config UserAgentRollupsConfig, db DB) *UserAgentRollups {
+	return &UserAgentRollups{
+		log:    log,
+		config: config,
+		db:     db,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.FlushInterval),
+
+		pending: make(map[userAgentRollupKey]*UserAgentRollup),
+	}
+}
+
+// Run starts the periodic flushes of the aggregated traffic.
+func (rollups *UserAgentRollups) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return rollups.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := rollups.Flush(ctx); err != nil {
+			rollups.log.Error("failed to flush the user agent rollups", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the periodic flushes and flushes the remaining traffic.
+func (rollups *UserAgentRollups) Close() error {
+	rollups.Loop.Close()
+	return rollups.Flush(context.Background())
+}
+
+// SetNow allows tests to have the aggregator act as if the current time is whatever they want.
+func (rollups *UserAgentRollups) SetNow(nowFn func() time.Time) {
+	rollups.nowFn = nowFn
+}
+
+// AddUpload records an upload of the given size to the bucket.
+func (rollups *UserAgentRollups) AddUpload(projectID uuid.UUID, bucketName, userAgent []byte, size int64) {
+	rollups.add(projectID, bucketName, userAgent, func(rollup *UserAgentRollup) {
+		rollup.UploadCount++
+		rollup.UploadBytes += size
+	})
+}
+
+// AddDownload records a download of the given size from the bucket.
+func (rollups *UserAgentRollups) AddDownload(projectID uuid.UUID, bucketName, userAgent []byte, size int64) {
+	rollups.add(projectID, bucketName, userAgent, func(rollup *UserAgentRollup) {
+		rollup.DownloadCount++
+		rollup.DownloadBytes += size
+	})
+}
+
+func (rollups *UserAgentRollups) add(projectID uuid.UUID, bucketName, userAgent []byte, update func(rollup *UserAgentRollup)) {
+	now := rollups.nowFn().UTC()
+	intervalDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	rollups.mu.Lock()
+	defer rollups.mu.Unlock()
+
+	for _, entry := range rollups.entries(userAgent) {
+		key := userAgentRollupKey{
+			projectID:   projectID,
+			bucketName:  string(bucketName),
+			product:     entry.Product,
+			version:     entry.Version,
+			intervalDay: intervalDay,
+		}
+
+		rollup, ok := rollups.pending[key]
+		if !ok {
+			if len(rollups.pending) >= rollups.config.MaxPending {
+				mon.Counter("user_agent_rollups_dropped").Inc(1)
+				continue
+			}
+			rollup = &UserAgentRollup{
+				ProjectID:   key.projectID,
+				BucketName:  key.bucketName,
+				Product:     key.product,
+				Version:     key.version,
+				IntervalDay: key.intervalDay,
+			}
+			rollups.pending[key] = rollup
+		}
+		update(rollup)
+	}
+}
+
+// entries returns the products and versions of the user agent, which are recorded.
+// The products are lowercased, so that the rollups of a product are not split by
+// the spelling of its name.
+func (rollups *UserAgentRollups) entries(userAgent []byte) []useragent.Entry {
+	entries, err := useragent.ParseEntries(userAgent)
+	if err != nil || len(entries) == 0 {
+		return []useragent.Entry{{Product: unknownProduct}}
+	}
+
+	recorded := make([]useragent.Entry, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Product == "" {
+			continue
+		}
+		if len(recorded) >= rollups.config.MaxEntries {
+			break
+		}
+		recorded = append(recorded, useragent.Entry{
+			Product: truncate(strings.ToLower(entry.Product), maxProductLength),
+			Version: truncate(entry.Version, maxVersionLength),
+		})
+	}
+	if len(recorded) == 0 {
+		return []useragent.Entry{{Product: unknownProduct}}
+	}
+	return recorded
+}
+
+// Flush adds the aggregated traffic to the daily rollups. The traffic is kept
+// for the next flush, when the rollups couldn't be updated.
+func (rollups *UserAgentRollups) Flush(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rollups.mu.Lock()
+	pending := rollups.pending
+	rollups.pending = make(map[userAgentRollupKey]*UserAgentRollup)
+	rollups.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	batch := make([]UserAgentRollup, 0, len(pending))
+	for _, rollup := range pending {
+		batch = append(batch, *rollup)
+	}
+
+	err = rollups.db.UpdateUserAgentRollups(ctx, batch)
+	if err == nil {
+		return nil
+	}
+
+	// restore the traffic and add the traffic recorded in the meantime on top of it.
+	rollups.mu.Lock()
+	defer rollups.mu.Unlock()
+	for key, rollup := range pending {
+		current, ok := rollups.pending[key]
+		if !ok {
+			rollups.pending[key] = rollup
+			continue
+		}
+		current.UploadCount += rollup.UploadCount
+		current.UploadBytes += rollup.UploadBytes
+		current.DownloadCount += rollup.DownloadCount
+		current.DownloadBytes += rollup.DownloadBytes
+	}
+	return ErrUserAgentRollups.Wrap(err)
+}
+
+func truncate(s string, length int) string {
+	if len(s) > length {
+		return s[:length]
+	}
+	return s
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package attribution_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/attribution"
+)
+
+type fakeRollupsDB struct {
+	attribution.DB
+
+	failed  bool
+	rollups []attribution.UserAgentRollup
+}
+
+func (db *fakeRollupsDB) UpdateUserAgentRollups(ctx context.Context, rollups []attribution.UserAgentRollup) error {
+	if db.failed {
+		return errors.New("database unavailable")
+	}
+	db.rollups = append(db.rollups, rollups...)
+	return nil
+}
+
+func (db *fakeRollupsDB) GetUserAgentRollups(ctx context.Context, projectID uuid.UUID, since, before time.Time) ([]attribution.UserAgentRollup, error) {
+	return db.rollups, nil
+}
+
+func (db *fakeRollupsDB) flushed() []attribution.UserAgentRollup {
+	rollups := db.rollups
+	db.rollups = nil
+	sort.Slice(rollups, func(i, k int) bool {
+		if rollups[i].BucketName != rollups[k].BucketName {
+			return rollups[i].BucketName < rollups[k].BucketName
+		}
+		return rollups[i].Product < rollups[k].Product
+	})
+	return rollups
+}
+
+func TestUserAgentRollups(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	db := &fakeRollupsDB{}
+	rollups := attribution.NewUserAgentRollups(zaptest.NewLogger(t), attribution.UserAgentRollupsConfig{
+		FlushInterval: time.Minute,
+		MaxEntries:    2,
+		MaxPending:    4,
+	}, db)
+
+	now := time.Date(2023, time.June, 1, 13, 0, 0, 0, time.UTC)
+	day := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)
+	rollups.SetNow(func() time.Time { return now })
+
+	projectID := testrand.UUID()
+	bucket := []byte("bucket")
+
+	// every entry of the user agent is counted up to the limit, the products are lowercased.
+	rollups.AddUpload(projectID, bucket, []byte("Rclone/v1.62.2 uplink/v1.76.0 gateway/v1.0.0"), 100)
+	rollups.AddDownload(projectID, bucket, []byte("rclone/v1.62.2 uplink/v1.76.0"), 50)
+	rollups.AddDownload(projectID, []byte("other"), []byte("not a valid user agent ("), 10)
+
+	require.NoError(t, rollups.Flush(ctx))
+	require.Equal(t, []attribution.UserAgentRollup{
+		{ProjectID: projectID, BucketName: "bucket", Product: "rclone", Version: "v1.62.2", IntervalDay: day, UploadCount: 1, UploadBytes: 100, DownloadCount: 1, DownloadBytes: 50},
+		{ProjectID: projectID, BucketName: "bucket", Product: "uplink", Version: "v1.76.0", IntervalDay: day, UploadCount: 1, UploadBytes: 100, DownloadCount: 1, DownloadBytes: 50},
+		{ProjectID: projectID, BucketName: "other", Product: "unknown", IntervalDay: day, DownloadCount: 1, DownloadBytes: 10},
+	}, db.flushed())
+
+	// nothing is written without new traffic.
+	require.NoError(t, rollups.Flush(ctx))
+	require.Empty(t, db.flushed())
+
+	// the traffic is kept when the flush fails.
+	db.failed = true
+	rollups.AddUpload(projectID, bucket, []byte("uplink/v1.76.0"), 100)
+	require.Error(t, rollups.Flush(ctx))
+
+	db.failed = false
+	rollups.AddUpload(projectID, bucket, []byte("uplink/v1.76.0"), 100)
+	require.NoError(t, rollups.Close())
+	require.Equal(t, []attribution.UserAgentRollup{
+		{ProjectID: projectID, BucketName: "bucket", Product: "uplink", Version: "v1.76.0", IntervalDay: day, UploadCount: 2, UploadBytes: 200},
+	}, db.flushed())
+
+	// the traffic of the new rollups above the limit is dropped.
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		rollups.AddUpload(projectID, []byte(name), []byte("uplink/v1.76.0"), 1)
+	}
+	require.NoError(t, rollups.Flush(ctx))
+	require.Len(t, db.flushed(), 4)
+}
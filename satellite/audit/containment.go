@@ -5,6 +5,7 @@ package audit
 
 import (
 	"context"
+	"time"
 
 	"github.com/zeebo/errs"
 
@@ -30,4 +31,19 @@ type Containment interface {
 	Insert(ctx context.Context, job *PieceLocator) error
 	Delete(ctx context.Context, job *PieceLocator) (wasDeleted, nodeStillContained bool, err error)
 	GetAllContainedNodes(ctx context.Context) ([]pb.NodeID, error)
+	GetStatus(ctx context.Context, nodeID pb.NodeID) (ContainmentStatus, error)
+}
+
+// ContainmentStatus describes the pending reverifications of a node.
+type ContainmentStatus struct {
+	// PendingCount is the number of pieces awaiting a reverification.
+	PendingCount int64
+	// OldestPendingAt is the time the oldest reverification was queued,
+	// nil when the node isn't contained.
+	OldestPendingAt *time.Time
+}
+
+// Contained returns whether the node has pending reverifications.
+func (status ContainmentStatus) Contained() bool {
+	return status.PendingCount > 0
 }
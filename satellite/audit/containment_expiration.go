@@ -0,0 +1,169 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/overlay"
+)
+
+const (
+	// ExpiredContainmentFailure counts the expired pending reverifications as failed audits.
+	ExpiredContainmentFailure = "failure"
+	// ExpiredContainmentDiscard removes the expired pending reverifications without changing
+	// the reputation of the nodes.
+	ExpiredContainmentDiscard = "discard"
+)
+
+// ContainmentExpirationChore removes the pending reverifications, which stayed in the
+// reverification queue for longer than the configured age, and releases the nodes from
+// the containment. Normally the reverifications are decided after MaxReverifyCount
+// attempts, but the entries, which are never attempted or decided, would otherwise keep
+// the nodes contained indefinitely.
+type ContainmentExpirationChore struct {
+	log      *zap.Logger
+	queue    ReverifyQueue
+	overlay  *overlay.Service
+	reporter Reporter
+
+	maxAge        time.Duration
+	retryInterval time.Duration
+	countFailure  bool
+	batchSize     int
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewContainmentExpirationChore creates a new ContainmentExpirationChore.
+func NewContainmentExpirationChore(log *zap.Logger, queue ReverifyQueue, overlay *overlay.Service, reporter Reporter, config Config) (*ContainmentExpirationChore, error) {
+	var countFailure bool
+	switch config.ContainmentExpirationOutcome {
+	case ExpiredContainmentFailure:
+		countFailure = true
+	case ExpiredContainmentDiscard:
+	default:
+		return nil, Error.New("invalid containment expiration outcome %q, expected %q or %q",
+			config.ContainmentExpirationOutcome, ExpiredContainmentFailure, ExpiredContainmentDiscard)
+	}
+	if config.ContainmentExpirationBatchSize <= 0 {
+		return nil, Error.New("containment expiration batch size must be positive")
+	}
+
+	return &ContainmentExpirationChore{
+		log:      log,
+		queue:    queue,
+		overlay:  overlay,
+		reporter: reporter,
+
+		maxAge:        config.ContainmentExpirationAge,
+		retryInterval: config.ReverificationRetryInterval,
+		countFailure:  countFailure,
+		batchSize:     config.ContainmentExpirationBatchSize,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.ContainmentExpirationInterval),
+	}, nil
+}
+
+// Run runs the containment expiration chore.
+func (chore *ContainmentExpirationChore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if chore.maxAge <= 0 {
+		chore.log.Info("containment expiration is disabled")
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, chore.ExpireContainment)
+}
+
+// ExpireContainment decides and removes the expired pending reverifications.
+func (chore *ContainmentExpirationChore) ExpireContainment(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := chore.nowFn()
+	// the jobs, which a worker may be reverifying right now, are left to the worker.
+	insertedBefore := now.Add(-chore.maxAge)
+	attemptedBefore := now.Add(-chore.retryInterval)
+
+	var expired, failed int64
+	for {
+		jobs, err := chore.queue.GetExpired(ctx, insertedBefore, attemptedBefore, chore.batchSize)
+		if err != nil {
+			chore.log.Error("failed to get expired pending reverifications", zap.Error(err))
+			break
+		}
+
+		var batchFailed int
+		for _, job := range jobs {
+			if err := chore.expire(ctx, job); err != nil {
+				chore.log.Error("failed to expire pending reverification",
+					zap.Stringer("Node ID", job.Locator.NodeID),
+					zap.Stringer("Stream ID", job.Locator.StreamID),
+					zap.Uint64("Position", job.Locator.Position.Encode()),
+					zap.Error(err))
+				batchFailed++
+				continue
+			}
+			expired++
+		}
+		failed += int64(batchFailed)
+
+		// the failed jobs would be returned again, so they are retried on the next run.
+		if len(jobs) < chore.batchSize || batchFailed > 0 {
+			break
+		}
+	}
+
+	mon.IntVal("containment_expired_entries").Observe(expired)  //mon:locked
+	mon.IntVal("containment_expiration_errors").Observe(failed) //mon:locked
+	if expired > 0 || failed > 0 {
+		chore.log.Info("expired pending reverifications",
+			zap.Int64("expired", expired),
+			zap.Int64("failed", failed),
+			zap.Bool("counted as audit failures", chore.countFailure))
+	}
+	return nil
+}
+
+// expire records the final decision of the pending reverification, which removes it
+// from the queue and releases the node when it has no other pending reverifications.
+func (chore *ContainmentExpirationChore) expire(ctx context.Context, job *ReverificationJob) error {
+	outcome := OutcomeNotNecessary
+	var reputation overlay.ReputationStatus
+	if chore.countFailure {
+		node, err := chore.overlay.Get(ctx, job.Locator.NodeID)
+		switch {
+		case err == nil:
+			outcome = OutcomeFailure
+			reputation = node.Reputation.Status
+		case overlay.ErrNodeNotFound.Has(err):
+			// there is no reputation to apply the failure to.
+		default:
+			return err
+		}
+	}
+
+	if err := chore.reporter.RecordReverificationResult(ctx, job, outcome, reputation); err != nil {
+		return err
+	}
+
+	if outcome == OutcomeFailure {
+		mon.Meter("containment_expired_failures").Mark(1) //mon:locked
+	} else {
+		mon.Meter("containment_expired_discards").Mark(1) //mon:locked
+	}
+	return nil
+}
+
+// TestingSetNow sets the function, which returns the current time.
+func (chore *ContainmentExpirationChore) TestingSetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
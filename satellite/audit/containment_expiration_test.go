@@ -0,0 +1,80 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/audit"
+)
+
+func TestContainmentExpirationChore(t *testing.T) {
+	for _, outcome := range []string{audit.ExpiredContainmentDiscard, audit.ExpiredContainmentFailure} {
+		outcome := outcome
+		t.Run(outcome, func(t *testing.T) {
+			testplanet.Run(t, testplanet.Config{
+				SatelliteCount:   1,
+				StorageNodeCount: 2,
+				Reconfigure: testplanet.Reconfigure{
+					Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+						config.Audit.ContainmentExpirationAge = 24 * time.Hour
+						config.Audit.ContainmentExpirationOutcome = outcome
+					},
+				},
+			}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+				satellite := planet.Satellites[0]
+				reverifyQueue := satellite.Audit.ReverifyQueue
+				cache := satellite.Overlay.DB
+				chore := satellite.Audit.ContainmentExpirationChore
+				chore.Loop.Pause()
+
+				node1 := planet.StorageNodes[0].ID()
+				node2 := planet.StorageNodes[1].ID()
+
+				for _, nodeID := range []storj.NodeID{node1, node2} {
+					err := satellite.Audit.Reporter.ReportReverificationNeeded(ctx, &audit.PieceLocator{
+						StreamID: testrand.UUID(),
+						NodeID:   nodeID,
+					})
+					require.NoError(t, err)
+				}
+				requireInReverifyQueue(ctx, t, reverifyQueue, node1, node2)
+				requireContainedStatus(ctx, t, cache, node1, true, node2, true)
+
+				before, err := satellite.Reputation.Service.Get(ctx, node1)
+				require.NoError(t, err)
+
+				// the entries are not old enough yet
+				chore.Loop.TriggerWait()
+				requireInReverifyQueue(ctx, t, reverifyQueue, node1, node2)
+
+				chore.TestingSetNow(func() time.Time {
+					return time.Now().Add(25 * time.Hour)
+				})
+				chore.Loop.TriggerWait()
+
+				requireInReverifyQueue(ctx, t, reverifyQueue)
+				requireContainedStatus(ctx, t, cache, node1, false, node2, false)
+
+				after, err := satellite.Reputation.Service.Get(ctx, node1)
+				require.NoError(t, err)
+				if outcome == audit.ExpiredContainmentFailure {
+					require.Equal(t, before.TotalAuditCount+1, after.TotalAuditCount)
+					require.Equal(t, before.AuditSuccessCount, after.AuditSuccessCount)
+				} else {
+					require.Equal(t, before.TotalAuditCount, after.TotalAuditCount)
+				}
+			})
+		})
+	}
+}
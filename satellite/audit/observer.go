@@ -11,10 +11,12 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/rangedloop"
 	"storj.io/storj/satellite/metabase/segmentloop"
+	"storj.io/storj/satellite/quarantine"
 )
 
 // Observer populates reservoirs and the audit queue.
@@ -26,8 +28,14 @@ type Observer struct {
 	config   Config
 	seedRand *rand.Rand
 
+	// quarantines lists the quarantined nodes, whose segments are audited first.
+	quarantines quarantine.DB
+
 	// The follow fields are reset on each segment loop cycle.
 	Reservoirs map[metabase.NodeAlias]*Reservoir
+
+	quarantined        map[storj.NodeID]struct{}
+	quarantinedAliases map[metabase.NodeAlias]struct{}
 }
 
 var _ rangedloop.Observer = (*Observer)(nil)
@@ -46,11 +54,31 @@ func NewObserver(log *zap.Logger, queue VerifyQueue, config Config) *Observer {
 	}
 }
 
+// SetQuarantines sets the quarantines of the nodes. The segments of the quarantined
+// nodes are sampled to the full reservoirs and they are audited first.
+func (obs *Observer) SetQuarantines(quarantines quarantine.DB) {
+	obs.quarantines = quarantines
+}
+
 // Start prepares the observer for audit segment collection.
 func (obs *Observer) Start(ctx context.Context, startTime time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	obs.Reservoirs = make(map[metabase.NodeAlias]*Reservoir)
+	obs.quarantined = make(map[storj.NodeID]struct{})
+	obs.quarantinedAliases = make(map[metabase.NodeAlias]struct{})
+
+	if obs.quarantines != nil {
+		active, err := obs.quarantines.ListActive(ctx)
+		if err != nil {
+			// the quarantined nodes are audited as the other nodes until the next cycle.
+			obs.log.Error("failed to list the quarantined nodes", zap.Error(err))
+			return nil
+		}
+		for _, quarantine := range active {
+			obs.quarantined[quarantine.NodeID] = struct{}{}
+		}
+	}
 	return nil
 }
 
@@ -64,7 +92,7 @@ func (obs *Observer) Fork(ctx context.Context) (_ rangedloop.Partial, err error)
 	// for two or more RNGs. To prevent that, the observer itself uses an RNG
 	// to seed the per-collector RNGs.
 	rnd := rand.New(rand.NewSource(obs.seedRand.Int63()))
-	return newObserverFork(obs.config.Slots, rnd), nil
+	return newObserverFork(obs.config.Slots, rnd, obs.quarantined), nil
 }
 
 // Join merges the audit reservoir collector into the per-node reservoirs.
@@ -76,6 +104,10 @@ func (obs *Observer) Join(ctx context.Context, partial rangedloop.Partial) (err
 		return errs.New("expected partial type %T but got %T", fork, partial)
 	}
 
+	for nodeAlias := range fork.quarantinedAliases {
+		obs.quarantinedAliases[nodeAlias] = struct{}{}
+	}
+
 	for nodeAlias, reservoir := range fork.reservoirs {
 		existing, ok := obs.Reservoirs[nodeAlias]
 		if !ok {
@@ -101,6 +133,26 @@ func (obs *Observer) Finish(ctx context.Context) (err error) {
 	var newQueue []Segment
 	queueSegments := make(map[SegmentKey]struct{})
 
+	enqueue := func(segment segmentloop.Segment) {
+		segmentKey := SegmentKey{
+			StreamID: segment.StreamID,
+			Position: segment.Position.Encode(),
+		}
+		if _, ok := queueSegments[segmentKey]; !ok {
+			newQueue = append(newQueue, NewSegment(segment))
+			queueSegments[segmentKey] = struct{}{}
+		}
+	}
+
+	// Add the segments of the quarantined nodes first, so they're audited intensively.
+	for nodeAlias := range obs.quarantinedAliases {
+		if res, ok := obs.Reservoirs[nodeAlias]; ok {
+			for _, segment := range res.Segments() {
+				enqueue(segment)
+			}
+		}
+	}
+
 	// Add reservoir segments to queue in pseudorandom order.
 	for i := 0; i < obs.config.Slots; i++ {
 		for _, res := range obs.Reservoirs {
@@ -109,15 +161,7 @@ func (obs *Observer) Finish(ctx context.Context) (err error) {
 			if len(segments) <= i {
 				continue
 			}
-			segment := segments[i]
-			segmentKey := SegmentKey{
-				StreamID: segment.StreamID,
-				Position: segment.Position.Encode(),
-			}
-			if _, ok := queueSegments[segmentKey]; !ok {
-				newQueue = append(newQueue, NewSegment(segment))
-				queueSegments[segmentKey] = struct{}{}
-			}
+			enqueue(segments[i])
 		}
 	}
 
@@ -129,13 +173,18 @@ type observerFork struct {
 	reservoirs map[metabase.NodeAlias]*Reservoir
 	slotCount  int
 	rand       *rand.Rand
+
+	quarantined        map[storj.NodeID]struct{}
+	quarantinedAliases map[metabase.NodeAlias]struct{}
 }
 
-func newObserverFork(reservoirSlots int, r *rand.Rand) *observerFork {
+func newObserverFork(reservoirSlots int, r *rand.Rand, quarantined map[storj.NodeID]struct{}) *observerFork {
 	return &observerFork{
-		reservoirs: make(map[metabase.NodeAlias]*Reservoir),
-		slotCount:  reservoirSlots,
-		rand:       r,
+		reservoirs:         make(map[metabase.NodeAlias]*Reservoir),
+		slotCount:          reservoirSlots,
+		rand:               r,
+		quarantined:        quarantined,
+		quarantinedAliases: make(map[metabase.NodeAlias]struct{}),
 	}
 }
 
@@ -149,10 +198,15 @@ func (fork *observerFork) Process(ctx context.Context, segments []segmentloop.Se
 			continue
 		}
 
-		for _, piece := range segment.AliasPieces {
+		for i, piece := range segment.AliasPieces {
 			res, ok := fork.reservoirs[piece.Alias]
 			if !ok {
-				res = NewReservoir(fork.slotCount)
+				slotCount := fork.slotCount
+				if fork.isQuarantined(segment, i) {
+					slotCount = maxReservoirSize
+					fork.quarantinedAliases[piece.Alias] = struct{}{}
+				}
+				res = NewReservoir(slotCount)
 				fork.reservoirs[piece.Alias] = res
 			}
 			res.Sample(fork.rand, segment)
@@ -160,3 +214,12 @@ func (fork *observerFork) Process(ctx context.Context, segments []segmentloop.Se
 	}
 	return nil
 }
+
+// isQuarantined returns whether the node storing the i-th piece of the segment is quarantined.
+func (fork *observerFork) isQuarantined(segment segmentloop.Segment, i int) bool {
+	if len(fork.quarantined) == 0 || i >= len(segment.Pieces) {
+		return false
+	}
+	_, ok := fork.quarantined[segment.Pieces[i].StorageNode]
+	return ok
+}
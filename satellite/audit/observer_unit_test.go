@@ -0,0 +1,89 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/segmentloop"
+	"storj.io/storj/satellite/quarantine"
+)
+
+func TestObserverQuarantinedNodesFirst(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	quarantinedID := testrand.NodeID()
+	queue := &verifyQueue{}
+	observer := audit.NewObserver(zaptest.NewLogger(t), queue, audit.Config{Slots: 1, VerificationPushBatchSize: 100})
+	observer.SetQuarantines(&quarantines{active: []quarantine.Quarantine{{NodeID: quarantinedID}}})
+
+	newSegment := func(alias metabase.NodeAlias, nodeID storj.NodeID) segmentloop.Segment {
+		return segmentloop.Segment{
+			StreamID:      testrand.UUID(),
+			EncryptedSize: 1024,
+			AliasPieces:   metabase.AliasPieces{{Number: 0, Alias: alias}},
+			Pieces:        metabase.Pieces{{Number: 0, StorageNode: nodeID}},
+		}
+	}
+
+	quarantinedSegments := map[uuid.UUID]struct{}{}
+	var segments []segmentloop.Segment
+	for i := 0; i < 5; i++ {
+		segment := newSegment(1, quarantinedID)
+		quarantinedSegments[segment.StreamID] = struct{}{}
+		segments = append(segments, segment)
+	}
+	for i := 0; i < 10; i++ {
+		segments = append(segments, newSegment(metabase.NodeAlias(2+i), testrand.NodeID()))
+	}
+
+	require.NoError(t, observer.Start(ctx, time.Now()))
+	fork, err := observer.Fork(ctx)
+	require.NoError(t, err)
+	require.NoError(t, fork.Process(ctx, segments))
+	require.NoError(t, observer.Join(ctx, fork))
+	require.NoError(t, observer.Finish(ctx))
+
+	// the quarantined node uses all reservoir slots and its segments are queued first.
+	require.Len(t, queue.segments, 13)
+	for _, segment := range queue.segments[:3] {
+		require.Contains(t, quarantinedSegments, segment.StreamID)
+	}
+	for _, segment := range queue.segments[3:] {
+		require.NotContains(t, quarantinedSegments, segment.StreamID)
+	}
+}
+
+type verifyQueue struct {
+	segments []audit.Segment
+}
+
+func (queue *verifyQueue) Push(ctx context.Context, segments []audit.Segment, maxBatchSize int) error {
+	queue.segments = append(queue.segments, segments...)
+	return nil
+}
+
+func (queue *verifyQueue) Next(ctx context.Context) (audit.Segment, error) {
+	panic("not implemented")
+}
+
+type quarantines struct {
+	quarantine.DB
+	active []quarantine.Quarantine
+}
+
+func (db *quarantines) ListActive(ctx context.Context) ([]quarantine.Quarantine, error) {
+	return db.active, nil
+}
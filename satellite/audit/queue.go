@@ -35,6 +35,8 @@ type ReverifyQueue interface {
 	Remove(ctx context.Context, piece *PieceLocator) (wasDeleted bool, err error)
 	GetByNodeID(ctx context.Context, nodeID storj.NodeID) (audit *ReverificationJob, err error)
 	GetAllContainedNodes(ctx context.Context) ([]storj.NodeID, error)
+	GetStatusByNodeID(ctx context.Context, nodeID storj.NodeID) (ContainmentStatus, error)
+	GetExpired(ctx context.Context, insertedBefore, attemptedBefore time.Time, limit int) ([]*ReverificationJob, error)
 }
 
 // ByStreamIDAndPosition allows sorting of a slice of segments by stream ID and position.
@@ -9,7 +9,9 @@ import (
 
 	"go.uber.org/zap"
 
+	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/satellite/quarantine"
 )
 
 // ReverifyWorker processes reverifications (retrying piece audits against nodes that timed out
@@ -20,6 +22,8 @@ type ReverifyWorker struct {
 	reverifier *Reverifier
 	reporter   Reporter
 
+	corruptions *quarantine.Recorder
+
 	Loop          *sync2.Cycle
 	concurrency   int
 	retryInterval time.Duration
@@ -105,6 +109,14 @@ func (worker *ReverifyWorker) work(ctx context.Context, logger *zap.Logger, job
 	if err != nil {
 		logger.Error("finished with audit, but failed to remove entry from queue", zap.Error(err))
 	}
+	if outcome == OutcomeFailure {
+		worker.corruptions.Record(ctx, quarantine.SourceAudit, storj.NodeIDList{job.Locator.NodeID})
+	}
+}
+
+// SetCorruptions sets the recorder of the failed audits, which may quarantine the nodes.
+func (worker *ReverifyWorker) SetCorruptions(corruptions *quarantine.Recorder) {
+	worker.corruptions = corruptions
 }
 
 // Close halts the worker.
@@ -13,6 +13,7 @@ import (
 	"storj.io/common/memory"
 	"storj.io/common/sync2"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/quarantine"
 )
 
 // Error is the default audit errs class.
@@ -36,6 +37,11 @@ type Config struct {
 	ReverificationRetryInterval time.Duration `help:"how long a single reverification job can take before it may be taken over by another worker" releaseDefault:"6h" devDefault:"10m"`
 
 	ContainmentSyncChoreInterval time.Duration `help:"how often to run the containment-sync chore" releaseDefault:"2h" devDefault:"2m" testDefault:"$TESTINTERVAL"`
+
+	ContainmentExpirationInterval  time.Duration `help:"how often to run the containment expiration chore" releaseDefault:"1h" devDefault:"1m" testDefault:"$TESTINTERVAL"`
+	ContainmentExpirationAge       time.Duration `help:"how long a pending reverification can stay in the queue before it's expired and the node is released from containment, 0 disables the expiration" default:"168h"`
+	ContainmentExpirationOutcome   string        `help:"how the expired pending reverifications are decided: failure counts them as failed audits, discard removes them without changing the reputation" default:"discard"`
+	ContainmentExpirationBatchSize int           `help:"number of the expired pending reverifications to process at once" default:"1000"`
 }
 
 // Worker contains information for populating audit queue and processing audits.
@@ -45,6 +51,7 @@ type Worker struct {
 	verifier      *Verifier
 	reverifyQueue ReverifyQueue
 	reporter      Reporter
+	corruptions   *quarantine.Recorder
 	Loop          *sync2.Cycle
 	concurrency   int
 }
@@ -63,6 +70,11 @@ func NewWorker(log *zap.Logger, queue VerifyQueue, verifier *Verifier, reverifyQ
 	}
 }
 
+// SetCorruptions sets the recorder of the failed audits, which may quarantine the nodes.
+func (worker *Worker) SetCorruptions(corruptions *quarantine.Recorder) {
+	worker.corruptions = corruptions
+}
+
 // Run runs audit service 2.0.
 func (worker *Worker) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -148,6 +160,7 @@ func (worker *Worker) work(ctx context.Context, segment Segment) (err error) {
 	}
 
 	worker.reporter.RecordAudits(ctx, report)
+	worker.corruptions.Record(ctx, quarantine.SourceAudit, report.Fails)
 
 	return errlist.Err()
 }
@@ -22,6 +22,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/private/debug"
 	"storj.io/private/version"
+	"storj.io/storj/private/clock"
 	"storj.io/storj/private/lifecycle"
 	version_checker "storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/audit"
@@ -30,6 +31,7 @@ import (
 	"storj.io/storj/satellite/nodeevents"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/quarantine"
 	"storj.io/storj/satellite/reputation"
 )
 
@@ -43,6 +45,8 @@ type Auditor struct {
 	Servers  *lifecycle.Group
 	Services *lifecycle.Group
 
+	Clock clock.Clock
+
 	Dialer rpc.Dialer
 
 	Version struct {
@@ -83,6 +87,7 @@ func NewAuditor(log *zap.Logger, full *identity.FullIdentity,
 	nodeEvents nodeevents.DB,
 	reputationdb reputation.DB,
 	containmentDB audit.Containment,
+	quarantineDB quarantine.DB,
 	versionInfo version.Info, config *Config, atomicLogLevel *zap.AtomicLevel,
 ) (*Auditor, error) {
 	peer := &Auditor{
@@ -112,6 +117,8 @@ func NewAuditor(log *zap.Logger, full *identity.FullIdentity,
 		})
 	}
 
+	peer.Clock = newClock(config.SimulateClock, peer.Debug.Server.Panel)
+
 	{ // setup version control
 		peer.Log.Info("Version info",
 			zap.Stringer("Version", versionInfo.Version.Version),
@@ -166,6 +173,7 @@ func NewAuditor(log *zap.Logger, full *identity.FullIdentity,
 			reputationdb,
 			config.Reputation,
 		)
+		peer.Reputation.SetClock(peer.Clock)
 
 		peer.Services.Add(lifecycle.Item{
 			Name:  "reputation",
@@ -229,6 +237,9 @@ func NewAuditor(log *zap.Logger, full *identity.FullIdentity,
 			reverifyQueue,
 			peer.Audit.Reporter,
 			config.Audit)
+		if config.Quarantine.Enabled {
+			peer.Audit.Worker.SetCorruptions(quarantine.NewRecorder(log.Named("quarantine:recorder"), quarantineDB))
+		}
 		peer.Services.Add(lifecycle.Item{
 			Name:  "audit:verify-worker",
 			Run:   peer.Audit.Worker.Run,
@@ -242,6 +253,9 @@ func NewAuditor(log *zap.Logger, full *identity.FullIdentity,
 			peer.Audit.Reverifier,
 			peer.Audit.Reporter,
 			config.Audit)
+		if config.Quarantine.Enabled {
+			peer.Audit.ReverifyWorker.SetCorruptions(quarantine.NewRecorder(log.Named("quarantine:recorder"), quarantineDB))
+		}
 		peer.Services.Add(lifecycle.Item{
 			Name:  "audit:reverify-worker",
 			Run:   peer.Audit.ReverifyWorker.Run,
@@ -0,0 +1,106 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+)
+
+var (
+	// ErrMetadataStateNotFound is returned when there is no recorded metadata state of the bucket.
+	ErrMetadataStateNotFound = errs.Class("bucket metadata state not found")
+
+	// ErrMetadataStateConflict is returned when the version of the bucket metadata state is
+	// already recorded by a concurrent change.
+	ErrMetadataStateConflict = errs.Class("bucket metadata state conflict")
+
+	// ErrInvalidTransition is returned when the versioning or the object lock state of the
+	// bucket can't be changed to the requested one.
+	ErrInvalidTransition = errs.Class("invalid bucket state transition")
+)
+
+// Versioning is the versioning state of a bucket.
+type Versioning int
+
+const (
+	// Unversioned is the state of the buckets, which never had the versioning enabled.
+	Unversioned Versioning = 0
+	// VersioningEnabled is the state of the buckets, which keep the previous versions of the objects.
+	VersioningEnabled Versioning = 1
+	// VersioningSuspended is the state of the buckets, which had the versioning enabled, but
+	// don't keep the previous versions of the new objects anymore.
+	VersioningSuspended Versioning = 2
+)
+
+// String returns the name of the versioning state.
+func (versioning Versioning) String() string {
+	switch versioning {
+	case Unversioned:
+		return "unversioned"
+	case VersioningEnabled:
+		return "enabled"
+	case VersioningSuspended:
+		return "suspended"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseVersioning parses the name of the versioning state.
+func ParseVersioning(name string) (Versioning, error) {
+	switch name {
+	case "unversioned":
+		return Unversioned, nil
+	case "enabled":
+		return VersioningEnabled, nil
+	case "suspended":
+		return VersioningSuspended, nil
+	default:
+		return Unversioned, ErrBucket.New("unknown versioning state %q", name)
+	}
+}
+
+// MetadataState is a state of the bucket metadata, which is recorded in the bucket metadata
+// history whenever it changes. The history is kept after the bucket is deleted, so it's
+// identified by the project and the bucket name, and the buckets recreated with the same
+// name continue the history of the deleted ones.
+//
+// There is no nonce policy in the state: the nonces are chosen by the uplinks, and the
+// satellite neither sees nor enforces them.
+type MetadataState struct {
+	ProjectID  uuid.UUID
+	BucketName string
+	// Version is the number of the state in the history of the bucket name, starting with 1.
+	Version  int64
+	BucketID uuid.UUID
+
+	DefaultEncryptionParameters storj.EncryptionParameters
+	Versioning                  Versioning
+	ObjectLockEnabled           bool
+	// Deleted is set for the state recorded when the bucket was deleted.
+	Deleted bool
+
+	ChangedAt time.Time
+}
+
+// MetadataHistory is the interface for the database of the bucket metadata history.
+//
+// architecture: Database
+type MetadataHistory interface {
+	// Insert records the state with its version. It returns ErrMetadataStateConflict, when
+	// the version of the bucket name is already recorded.
+	Insert(ctx context.Context, state MetadataState) (MetadataState, error)
+	// Latest returns the current state of the bucket name.
+	Latest(ctx context.Context, projectID uuid.UUID, bucketName string) (MetadataState, error)
+	// At returns the state of the bucket name at the time.
+	At(ctx context.Context, projectID uuid.UUID, bucketName string, at time.Time) (MetadataState, error)
+	// List returns the states of the bucket name, the newest first.
+	List(ctx context.Context, projectID uuid.UUID, bucketName string, limit int) ([]MetadataState, error)
+}
@@ -5,9 +5,12 @@ package buckets
 
 import (
 	"context"
+	"time"
 
 	"github.com/zeebo/errs"
+	"go.uber.org/zap"
 
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 )
 
@@ -28,6 +31,73 @@ func NewService(bucketsDB DB, metabase *metabase.DB) *Service {
 type Service struct {
 	DB
 	metabase *metabase.DB
+
+	log     *zap.Logger
+	history MetadataHistory
+}
+
+// SetMetadataHistory sets the database, which records the changes of the bucket metadata.
+func (buckets *Service) SetMetadataHistory(log *zap.Logger, history MetadataHistory) {
+	buckets.log = log
+	buckets.history = history
+}
+
+// CreateBucket overrides the default CreateBucket behaviour by recording the initial metadata
+// state of the bucket in the metadata history. The bucket is created, even when the state
+// can't be recorded.
+func (buckets *Service) CreateBucket(ctx context.Context, bucket Bucket) (Bucket, error) {
+	created, err := buckets.DB.CreateBucket(ctx, bucket)
+	if err != nil || buckets.history == nil {
+		return created, err
+	}
+
+	_, err = buckets.changeMetadataState(ctx, created.ProjectID, created.Name, func(latest MetadataState, found bool) (MetadataState, bool, error) {
+		return MetadataState{
+			ProjectID:                   created.ProjectID,
+			BucketName:                  created.Name,
+			BucketID:                    created.ID,
+			DefaultEncryptionParameters: created.DefaultEncryptionParameters,
+			Versioning:                  Unversioned,
+		}, true, nil
+	})
+	if err != nil {
+		buckets.log.Error("failed to record bucket metadata state",
+			zap.Stringer("Project ID", created.ProjectID), zap.String("Bucket", created.Name), zap.Error(err))
+	}
+	return created, nil
+}
+
+// DeleteBucket overrides the default DeleteBucket behaviour by recording the deletion in the
+// metadata history. The bucket is deleted, even when the deletion can't be recorded.
+func (buckets *Service) DeleteBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) error {
+	if buckets.history == nil {
+		return buckets.DB.DeleteBucket(ctx, bucketName, projectID)
+	}
+
+	// the bucket is read before it's deleted, because the buckets, which had no recorded
+	// state yet, are recorded with their deletion.
+	bucket, bucketErr := buckets.GetBucket(ctx, bucketName, projectID)
+
+	err := buckets.DB.DeleteBucket(ctx, bucketName, projectID)
+	if err != nil {
+		return err
+	}
+
+	_, err = buckets.changeMetadataState(ctx, projectID, string(bucketName), func(latest MetadataState, found bool) (MetadataState, bool, error) {
+		if !found || latest.Deleted {
+			if bucketErr != nil {
+				return MetadataState{}, false, bucketErr
+			}
+			latest = bucketMetadataState(bucket)
+		}
+		latest.Deleted = true
+		return latest, true, nil
+	})
+	if err != nil {
+		buckets.log.Error("failed to record bucket metadata state",
+			zap.Stringer("Project ID", projectID), zap.ByteString("Bucket", bucketName), zap.Error(err))
+	}
+	return nil
 }
 
 // UpdateBucket overrides the default UpdateBucket behaviour by adding a check against MetabaseDB to ensure the bucket
@@ -53,5 +123,178 @@ func (buckets *Service) UpdateBucket(ctx context.Context, bucket Bucket) (Bucket
 		}
 	}
 
-	return buckets.DB.UpdateBucket(ctx, bucket)
+	updated, err := buckets.DB.UpdateBucket(ctx, bucket)
+	if err != nil || buckets.history == nil || current.DefaultEncryptionParameters == updated.DefaultEncryptionParameters {
+		return updated, err
+	}
+
+	_, err = buckets.changeMetadataState(ctx, updated.ProjectID, updated.Name, func(latest MetadataState, found bool) (MetadataState, bool, error) {
+		state := latest
+		if !found || latest.Deleted {
+			state = bucketMetadataState(updated)
+		} else if latest.DefaultEncryptionParameters == updated.DefaultEncryptionParameters {
+			return latest, false, nil
+		}
+		state.DefaultEncryptionParameters = updated.DefaultEncryptionParameters
+		return state, true, nil
+	})
+	if err != nil {
+		buckets.log.Error("failed to record bucket metadata state",
+			zap.Stringer("Project ID", updated.ProjectID), zap.String("Bucket", updated.Name), zap.Error(err))
+	}
+	return updated, nil
+}
+
+// GetMetadataState returns the current metadata state of the bucket. The buckets created
+// before the metadata history was recorded are unversioned.
+func (buckets *Service) GetMetadataState(ctx context.Context, projectID uuid.UUID, bucketName string) (MetadataState, error) {
+	if buckets.history == nil {
+		return MetadataState{}, ErrBucket.New("bucket metadata history is not enabled")
+	}
+
+	latest, err := buckets.history.Latest(ctx, projectID, bucketName)
+	if err != nil && !ErrMetadataStateNotFound.Has(err) {
+		return MetadataState{}, ErrBucket.Wrap(err)
+	}
+	return buckets.currentMetadataState(ctx, projectID, bucketName, latest, err == nil)
+}
+
+// currentMetadataState returns the latest recorded state, or the state of the bucket, when
+// it has no recorded state since it was created.
+func (buckets *Service) currentMetadataState(ctx context.Context, projectID uuid.UUID, bucketName string, latest MetadataState, found bool) (MetadataState, error) {
+	if found && !latest.Deleted {
+		return latest, nil
+	}
+
+	bucket, err := buckets.GetBucket(ctx, []byte(bucketName), projectID)
+	if err != nil {
+		return MetadataState{}, err
+	}
+	state := bucketMetadataState(bucket)
+	state.Version = latest.Version
+	return state, nil
+}
+
+// bucketMetadataState returns the initial metadata state of the bucket.
+func bucketMetadataState(bucket Bucket) MetadataState {
+	return MetadataState{
+		ProjectID:                   bucket.ProjectID,
+		BucketName:                  bucket.Name,
+		BucketID:                    bucket.ID,
+		DefaultEncryptionParameters: bucket.DefaultEncryptionParameters,
+		Versioning:                  Unversioned,
+	}
+}
+
+// GetMetadataStateAt returns the metadata state of the bucket at the time.
+func (buckets *Service) GetMetadataStateAt(ctx context.Context, projectID uuid.UUID, bucketName string, at time.Time) (MetadataState, error) {
+	if buckets.history == nil {
+		return MetadataState{}, ErrBucket.New("bucket metadata history is not enabled")
+	}
+
+	state, err := buckets.history.At(ctx, projectID, bucketName, at)
+	if err != nil {
+		if ErrMetadataStateNotFound.Has(err) {
+			return MetadataState{}, err
+		}
+		return MetadataState{}, ErrBucket.Wrap(err)
+	}
+	return state, nil
+}
+
+// GetMetadataHistory returns the recorded metadata states of the bucket, the newest first.
+func (buckets *Service) GetMetadataHistory(ctx context.Context, projectID uuid.UUID, bucketName string, limit int) ([]MetadataState, error) {
+	if buckets.history == nil {
+		return nil, ErrBucket.New("bucket metadata history is not enabled")
+	}
+
+	states, err := buckets.history.List(ctx, projectID, bucketName, limit)
+	return states, ErrBucket.Wrap(err)
+}
+
+// SetVersioning changes the versioning state of the bucket. A bucket can't become unversioned
+// once the versioning was enabled, and the versioning of a bucket with the object lock
+// can't be suspended.
+func (buckets *Service) SetVersioning(ctx context.Context, projectID uuid.UUID, bucketName string, versioning Versioning) (MetadataState, error) {
+	if buckets.history == nil {
+		return MetadataState{}, ErrBucket.New("bucket metadata history is not enabled")
+	}
+
+	return buckets.changeMetadataState(ctx, projectID, bucketName, func(latest MetadataState, found bool) (MetadataState, bool, error) {
+		state, err := buckets.currentMetadataState(ctx, projectID, bucketName, latest, found)
+		if err != nil {
+			return MetadataState{}, false, err
+		}
+
+		switch {
+		case state.Versioning == versioning:
+			return state, false, nil
+		case versioning != VersioningEnabled && versioning != VersioningSuspended:
+			return MetadataState{}, false, ErrInvalidTransition.New("versioning can't be changed to %s", versioning)
+		case versioning == VersioningSuspended && state.ObjectLockEnabled:
+			return MetadataState{}, false, ErrInvalidTransition.New("versioning of a bucket with the object lock can't be suspended")
+		}
+
+		state.Versioning = versioning
+		return state, true, nil
+	})
+}
+
+// EnableObjectLock enables the object lock of the bucket. The versioning of the bucket must be
+// enabled, and the object lock can't be disabled afterwards.
+func (buckets *Service) EnableObjectLock(ctx context.Context, projectID uuid.UUID, bucketName string) (MetadataState, error) {
+	if buckets.history == nil {
+		return MetadataState{}, ErrBucket.New("bucket metadata history is not enabled")
+	}
+
+	return buckets.changeMetadataState(ctx, projectID, bucketName, func(latest MetadataState, found bool) (MetadataState, bool, error) {
+		state, err := buckets.currentMetadataState(ctx, projectID, bucketName, latest, found)
+		if err != nil {
+			return MetadataState{}, false, err
+		}
+
+		switch {
+		case state.ObjectLockEnabled:
+			return state, false, nil
+		case state.Versioning != VersioningEnabled:
+			return MetadataState{}, false, ErrInvalidTransition.New("object lock requires the versioning to be enabled")
+		}
+
+		state.ObjectLockEnabled = true
+		return state, true, nil
+	})
+}
+
+// maxMetadataChangeAttempts is how many times a change of the bucket metadata is applied,
+// when the concurrent changes record their versions first.
+const maxMetadataChangeAttempts = 5
+
+// changeMetadataState applies the change to the latest recorded state of the bucket name and
+// records the result as the next version, when the change returns that it changed the state.
+// The change is applied again to the new latest state, when a concurrent change recorded the
+// next version first, so the transitions are always checked against the recorded state.
+func (buckets *Service) changeMetadataState(ctx context.Context, projectID uuid.UUID, bucketName string, change func(latest MetadataState, found bool) (MetadataState, bool, error)) (MetadataState, error) {
+	for attempt := 1; ; attempt++ {
+		latest, err := buckets.history.Latest(ctx, projectID, bucketName)
+		if err != nil && !ErrMetadataStateNotFound.Has(err) {
+			return MetadataState{}, ErrBucket.Wrap(err)
+		}
+
+		state, changed, err := change(latest, err == nil)
+		if err != nil || !changed {
+			return state, err
+		}
+
+		state.Version = latest.Version + 1
+		state.ChangedAt = time.Now()
+		recorded, err := buckets.history.Insert(ctx, state)
+		switch {
+		case err == nil:
+			return recorded, nil
+		case ErrMetadataStateConflict.Has(err) && attempt < maxMetadataChangeAttempts:
+			continue
+		default:
+			return MetadataState{}, ErrBucket.Wrap(err)
+		}
+	}
 }
@@ -12,6 +12,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/buckets"
 )
 
 const TestBucket = "testbucket"
@@ -247,3 +248,77 @@ func TestBucketPlacement_PendingObject(t *testing.T) {
 		},
 	)
 }
+
+func TestBucketMetadataHistory(t *testing.T) {
+	testplanet.Run(t,
+		testplanet.Config{
+			SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+			satellite := planet.Satellites[0]
+			service := satellite.API.Buckets.Service
+			uplink := planet.Uplinks[0]
+			projectID := uplink.Projects[0].ID
+
+			err := uplink.CreateBucket(ctx, satellite, TestBucket)
+			require.NoError(t, err)
+
+			state, err := service.GetMetadataState(ctx, projectID, TestBucket)
+			require.NoError(t, err)
+			require.Equal(t, buckets.Unversioned, state.Versioning)
+			require.EqualValues(t, 1, state.Version)
+
+			// the object lock requires the versioning.
+			_, err = service.EnableObjectLock(ctx, projectID, TestBucket)
+			require.True(t, buckets.ErrInvalidTransition.Has(err))
+
+			state, err = service.SetVersioning(ctx, projectID, TestBucket, buckets.VersioningEnabled)
+			require.NoError(t, err)
+			require.Equal(t, buckets.VersioningEnabled, state.Versioning)
+
+			// the bucket can't become unversioned again.
+			_, err = service.SetVersioning(ctx, projectID, TestBucket, buckets.Unversioned)
+			require.True(t, buckets.ErrInvalidTransition.Has(err))
+
+			state, err = service.EnableObjectLock(ctx, projectID, TestBucket)
+			require.NoError(t, err)
+			require.True(t, state.ObjectLockEnabled)
+			enabledAt := state.ChangedAt
+
+			// the versioning of a locked bucket can't be suspended.
+			_, err = service.SetVersioning(ctx, projectID, TestBucket, buckets.VersioningSuspended)
+			require.True(t, buckets.ErrInvalidTransition.Has(err))
+
+			// the unchanged states aren't recorded.
+			state, err = service.SetVersioning(ctx, projectID, TestBucket, buckets.VersioningEnabled)
+			require.NoError(t, err)
+			require.EqualValues(t, 3, state.Version)
+
+			err = uplink.DeleteBucket(ctx, satellite, TestBucket)
+			require.NoError(t, err)
+
+			history, err := service.GetMetadataHistory(ctx, projectID, TestBucket, 10)
+			require.NoError(t, err)
+			require.Len(t, history, 4)
+			require.True(t, history[0].Deleted)
+			require.True(t, history[0].ObjectLockEnabled)
+
+			// the history is kept for the audits of the deleted buckets.
+			past, err := service.GetMetadataStateAt(ctx, projectID, TestBucket, enabledAt)
+			require.NoError(t, err)
+			require.Equal(t, buckets.VersioningEnabled, past.Versioning)
+			require.True(t, past.ObjectLockEnabled)
+			require.False(t, past.Deleted)
+
+			// the recreated bucket starts unversioned.
+			err = uplink.CreateBucket(ctx, satellite, TestBucket)
+			require.NoError(t, err)
+
+			state, err = service.GetMetadataState(ctx, projectID, TestBucket)
+			require.NoError(t, err)
+			require.Equal(t, buckets.Unversioned, state.Versioning)
+			require.False(t, state.ObjectLockEnabled)
+			require.EqualValues(t, 5, state.Version)
+		},
+	)
+}
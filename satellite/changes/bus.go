@@ -0,0 +1,200 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package changes implements an in-process bus of the change events of the satellite
+// database tables, e.g. to invalidate caches without polling the database.
+package changes
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+)
+
+var mon = monkit.Package()
+
+// Table is a table of the satellite database, which emits change events.
+type Table string
+
+const (
+	// TableNodes is the table of the storage nodes. The key is the node ID.
+	TableNodes = Table("nodes")
+	// TableReputations is the table of the reputations of the storage nodes. The key is
+	// the node ID.
+	TableReputations = Table("reputations")
+	// TableProjects is the table of the projects. The key is the project ID.
+	TableProjects = Table("projects")
+	// TableBucketMetainfos is the table of the buckets. The key is the project ID and the
+	// bucket name joined by a slash.
+	TableBucketMetainfos = Table("bucket_metainfos")
+)
+
+// Op is the kind of the change.
+type Op string
+
+const (
+	// OpInsert is a row, which was inserted.
+	OpInsert = Op("insert")
+	// OpUpdate is a row, which was updated or upserted.
+	OpUpdate = Op("update")
+	// OpDelete is a row, which was deleted.
+	OpDelete = Op("delete")
+)
+
+// Event is a change of a row of a table.
+type Event struct {
+	Table Table     `json:"table"`
+	Op    Op        `json:"op"`
+	Key   string    `json:"key"`
+	Time  time.Time `json:"time"`
+}
+
+// Subscriber is called for every event of the subscribed table. It's called synchronously
+// by the code, which changed the row, so it must not block.
+//
+// The events of changes made in a transaction may be published before the commit.
+type Subscriber func(ctx context.Context, event Event)
+
+// sinkQueueSize is the number of events waiting for the sink, after which the events
+// are dropped.
+const sinkQueueSize = 10000
+
+// sinkBatchSize is the maximum number of events sent to the sink at once.
+const sinkBatchSize = 100
+
+// sinkTimeout is the timeout of sending a batch to the sink.
+const sinkTimeout = 10 * time.Second
+
+// Bus delivers the change events to the subscribers and to the optional sink.
+//
+// architecture: Service
+type Bus struct {
+	log *zap.Logger
+
+	mu          sync.RWMutex
+	nextID      int
+	subscribers map[Table]map[int]Subscriber
+	closed      bool
+
+	sink  Sink
+	queue chan Event
+	done  chan struct{}
+}
+
+// NewBus returns a new bus. When sink isn't nil, the events are sent to it asynchronously.
+func NewBus(log *zap.Logger, sink Sink) *Bus {
+	bus := &Bus{
+		log:         log,
+		subscribers: make(map[Table]map[int]Subscriber),
+		sink:        sink,
+	}
+	if sink != nil {
+		bus.queue = make(chan Event, sinkQueueSize)
+		bus.done = make(chan struct{})
+		go bus.deliver()
+	}
+	return bus
+}
+
+// Subscribe calls subscriber for the events of the table until unsubscribe is called.
+func (bus *Bus) Subscribe(table Table, subscriber Subscriber) (unsubscribe func()) {
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+
+	id := bus.nextID
+	bus.nextID++
+
+	if bus.subscribers[table] == nil {
+		bus.subscribers[table] = make(map[int]Subscriber)
+	}
+	bus.subscribers[table][id] = subscriber
+
+	return func() {
+		bus.mu.Lock()
+		defer bus.mu.Unlock()
+		delete(bus.subscribers[table], id)
+	}
+}
+
+// Publish delivers the event to the subscribers and queues it for the sink. A nil bus
+// drops the events.
+func (bus *Bus) Publish(ctx context.Context, event Event) {
+	if bus == nil {
+		return
+	}
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+
+	bus.mu.RLock()
+	subscribers := make([]Subscriber, 0, len(bus.subscribers[event.Table]))
+	for _, subscriber := range bus.subscribers[event.Table] {
+		subscribers = append(subscribers, subscriber)
+	}
+	if bus.queue != nil && !bus.closed {
+		select {
+		case bus.queue <- event:
+		default:
+			mon.Event("changes_sink_event_dropped")
+		}
+	}
+	bus.mu.RUnlock()
+
+	mon.Counter("changes_event", monkit.NewSeriesTag("table", string(event.Table))).Inc(1)
+	for _, subscriber := range subscribers {
+		subscriber(ctx, event)
+	}
+}
+
+// deliver sends the queued events in batches to the sink until the bus is closed.
+func (bus *Bus) deliver() {
+	defer close(bus.done)
+
+	batch := make([]Event, 0, sinkBatchSize)
+	for event := range bus.queue {
+		batch = append(batch[:0], event)
+	fill:
+		for len(batch) < sinkBatchSize {
+			select {
+			case event, ok := <-bus.queue:
+				if !ok {
+					break fill
+				}
+				batch = append(batch, event)
+			default:
+				break fill
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), sinkTimeout)
+		err := bus.sink.Send(ctx, batch)
+		cancel()
+		if err != nil {
+			mon.Counter("changes_sink_events_failed").Inc(int64(len(batch)))
+			bus.log.Warn("failed to send change events to the sink", zap.Int("Count", len(batch)), zap.Error(err))
+		}
+	}
+}
+
+// Close stops publishing the events and waits until the queued events are sent to
+// the sink.
+func (bus *Bus) Close() error {
+	bus.mu.Lock()
+	if bus.closed {
+		bus.mu.Unlock()
+		return nil
+	}
+	bus.closed = true
+	if bus.queue != nil {
+		close(bus.queue)
+	}
+	bus.mu.Unlock()
+
+	if bus.done != nil {
+		<-bus.done
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package changes_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/changes"
+)
+
+func TestBusSubscribe(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	bus := changes.NewBus(zaptest.NewLogger(t), nil)
+	defer ctx.Check(bus.Close)
+
+	var received []changes.Event
+	unsubscribe := bus.Subscribe(changes.TableNodes, func(ctx context.Context, event changes.Event) {
+		received = append(received, event)
+	})
+
+	bus.Publish(ctx, changes.Event{Table: changes.TableNodes, Op: changes.OpUpdate, Key: "a"})
+	bus.Publish(ctx, changes.Event{Table: changes.TableProjects, Op: changes.OpInsert, Key: "b"})
+
+	require.Len(t, received, 1)
+	require.Equal(t, changes.OpUpdate, received[0].Op)
+	require.Equal(t, "a", received[0].Key)
+	require.False(t, received[0].Time.IsZero())
+
+	unsubscribe()
+	bus.Publish(ctx, changes.Event{Table: changes.TableNodes, Op: changes.OpDelete, Key: "a"})
+	require.Len(t, received, 1)
+
+	// a nil bus drops the events.
+	var nilBus *changes.Bus
+	nilBus.Publish(ctx, changes.Event{Table: changes.TableNodes, Op: changes.OpUpdate, Key: "a"})
+}
+
+func TestBusWebhookSink(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var mu sync.Mutex
+	var received []changes.Event
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var events []changes.Event
+		if err := json.NewDecoder(r.Body).Decode(&events); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		received = append(received, events...)
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	bus := changes.NewBus(zaptest.NewLogger(t), changes.NewWebhookSink(server.URL))
+
+	keys := []string{"a", "b", "c"}
+	for _, key := range keys {
+		bus.Publish(ctx, changes.Event{Table: changes.TableBucketMetainfos, Op: changes.OpInsert, Key: key})
+	}
+
+	// closing waits for the queued events to be sent.
+	require.NoError(t, bus.Close())
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, received, len(keys))
+	for i, event := range received {
+		require.Equal(t, changes.TableBucketMetainfos, event.Table)
+		require.Equal(t, keys[i], event.Key)
+	}
+}
+
+func TestWebhookSinkError(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	err := changes.NewWebhookSink(server.URL).Send(ctx, []changes.Event{{Table: changes.TableNodes}})
+	require.Error(t, err)
+	require.True(t, changes.Error.Has(err))
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package changes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/zeebo/errs"
+)
+
+// Error is the default error class for the changes package.
+var Error = errs.Class("changes")
+
+// Sink receives the change events outside of the process.
+type Sink interface {
+	// Send sends a batch of events.
+	Send(ctx context.Context, events []Event) error
+}
+
+// WebhookSink posts the events as a JSON array to an url.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+var _ Sink = (*WebhookSink)(nil)
+
+// NewWebhookSink returns a sink, which posts the events to url.
+func NewWebhookSink(url string) *WebhookSink {
+	return &WebhookSink{
+		url:    url,
+		client: http.DefaultClient,
+	}
+}
+
+// Send posts the events.
+func (sink *WebhookSink) Send(ctx context.Context, events []Event) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	body, err := json.Marshal(events)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sink.url, bytes.NewReader(body))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := sink.client.Do(req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return Error.New("sink responded with %s", resp.Status)
+	}
+	return nil
+}
@@ -10,18 +10,34 @@ import (
 
 // Config contains configuration for the calculations this package performs.
 type Config struct {
-	Rates struct {
-		AtRestGBHours Rate `user:"true" help:"rate for data at rest per GB/hour" default:"0.00000205"`
-		GetTB         Rate `user:"true" help:"rate for egress bandwidth per TB" default:"20.00"`
-		PutTB         Rate `user:"true" help:"rate for ingress bandwidth per TB" default:"0"`
-		GetRepairTB   Rate `user:"true" help:"rate for repair egress bandwidth per TB" default:"10.00"`
-		PutRepairTB   Rate `user:"true" help:"rate for repair ingress bandwidth per TB" default:"0"`
-		GetAuditTB    Rate `user:"true" help:"rate for audit egress bandwidth per TB" default:"10.00"`
-	}
+	Rates            RatesConfig
 	WithheldPercents Percents `user:"true" help:"comma separated monthly withheld percentage rates" default:"75,75,75,50,50,50,25,25,25,0,0,0,0,0,0"`
 	DisposePercent   int      `user:"true" help:"percent of held amount disposed to node after leaving withheld" default:"50"`
 }
 
+// RatesConfig contains the configured payment rates, which are used for the periods
+// without a rate schedule in effect.
+type RatesConfig struct {
+	AtRestGBHours Rate `user:"true" help:"rate for data at rest per GB/hour" default:"0.00000205"`
+	GetTB         Rate `user:"true" help:"rate for egress bandwidth per TB" default:"20.00"`
+	PutTB         Rate `user:"true" help:"rate for ingress bandwidth per TB" default:"0"`
+	GetRepairTB   Rate `user:"true" help:"rate for repair egress bandwidth per TB" default:"10.00"`
+	PutRepairTB   Rate `user:"true" help:"rate for repair ingress bandwidth per TB" default:"0"`
+	GetAuditTB    Rate `user:"true" help:"rate for audit egress bandwidth per TB" default:"10.00"`
+}
+
+// Rates returns the configured payment rates.
+func (config RatesConfig) Rates() Rates {
+	return Rates{
+		AtRestGBHours: config.AtRestGBHours,
+		GetTB:         config.GetTB,
+		PutTB:         config.PutTB,
+		GetRepairTB:   config.GetRepairTB,
+		PutRepairTB:   config.PutRepairTB,
+		GetAuditTB:    config.GetAuditTB,
+	}
+}
+
 // Percents is used to hold a list of percentages, typically for the withheld schedule.
 type Percents []int
 
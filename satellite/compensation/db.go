@@ -34,4 +34,19 @@ type DB interface {
 
 	// RecordPayments records one off individual payments.
 	RecordPayments(ctx context.Context, payments []Payment) error
+
+	// CreateRateSchedule records the rates in effect from the period as the next
+	// version of the schedule of the period and returns the recorded schedule.
+	CreateRateSchedule(ctx context.Context, period Period, rates Rates, note string) (RateSchedule, error)
+
+	// ListRateSchedules returns all versions of the rate schedules ordered by the
+	// period and the version.
+	ListRateSchedules(ctx context.Context) ([]RateSchedule, error)
+
+	// ScheduleHeldReleases records the releases of the held amounts. The amount of an
+	// already scheduled release of the node for the period is replaced.
+	ScheduleHeldReleases(ctx context.Context, releases []HeldRelease) error
+
+	// ListHeldReleases returns the held releases of the period ordered by the node ID.
+	ListHeldReleases(ctx context.Context, period Period) ([]HeldRelease, error)
 }
@@ -0,0 +1,149 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package heldrelease schedules the releases of the held amounts of the nodes leaving
+// withholding.
+package heldrelease
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/private/clock"
+	"storj.io/storj/satellite/compensation"
+	"storj.io/storj/satellite/overlay"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for held releases.
+	Error = errs.Class("held release")
+)
+
+// Config contains configurable values for the held release chore.
+type Config struct {
+	Enabled  bool          `help:"whether the releases of the held amounts are scheduled" default:"false"`
+	Interval time.Duration `help:"how often the releases of the held amounts are scheduled" releaseDefault:"24h" devDefault:"1h" testDefault:"$TESTINTERVAL"`
+	Periods  int           `help:"number of upcoming periods, after the current one, the releases are scheduled for" default:"1"`
+}
+
+// Chore schedules the releases of the held amounts of the nodes, which leave
+// withholding in the current or one of the upcoming periods.
+//
+// architecture: Chore
+type Chore struct {
+	log          *zap.Logger
+	overlay      overlay.DB
+	db           compensation.DB
+	config       Config
+	compensation compensation.Config
+	clock        clock.Clock
+
+	Loop *sync2.Cycle
+}
+
+// NewChore creates a new held release chore.
+func NewChore(log *zap.Logger, overlay overlay.DB, db compensation.DB, config Config, compensationConfig compensation.Config) *Chore {
+	return &Chore{
+		log:          log,
+		overlay:      overlay,
+		db:           db,
+		config:       config,
+		compensation: compensationConfig,
+		clock:        clock.Real{},
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// SetClock sets the clock, which decides the current period.
+func (chore *Chore) SetClock(clock clock.Clock) {
+	chore.clock = clock
+}
+
+// Run runs the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		period := compensation.PeriodFromTime(chore.clock.Now())
+
+		count, err := chore.Schedule(ctx, period)
+		if err != nil {
+			chore.log.Error("failed to schedule held releases", zap.Stringer("period", period), zap.Error(err))
+			return nil
+		}
+		chore.log.Info("held releases scheduled", zap.Stringer("period", period), zap.Int("releases", count))
+		return nil
+	})
+}
+
+// Schedule schedules the releases of the nodes leaving withholding from the period up to
+// the configured number of upcoming periods. The amounts of the already scheduled
+// releases of those periods are updated. It returns the number of scheduled releases.
+func (chore *Chore) Schedule(ctx context.Context, period compensation.Period) (count int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	first := period.StartDate()
+	last := first.AddDate(0, chore.config.Periods, 0)
+
+	var releases []compensation.HeldRelease
+	err = chore.overlay.IterateAllNodeDossiers(ctx, func(ctx context.Context, node *overlay.NodeDossier) error {
+		// disqualified nodes don't get the held amount back and the held amount of
+		// the exited nodes is disposed with the graceful exit.
+		if node.Disqualified != nil || node.ExitStatus.ExitFinishedAt != nil {
+			return nil
+		}
+
+		releasePeriod := compensation.ReleasePeriod(chore.compensation.WithheldPercents, node.CreatedAt)
+		if releasePeriod.StartDate().Before(first) || releasePeriod.StartDate().After(last) {
+			return nil
+		}
+
+		releases = append(releases, compensation.HeldRelease{
+			NodeID: node.Id,
+			Period: releasePeriod,
+		})
+		return nil
+	})
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	scheduled := releases[:0]
+	for _, release := range releases {
+		totals, err := chore.db.QueryTotalAmounts(ctx, release.NodeID)
+		if err != nil {
+			return 0, Error.Wrap(err)
+		}
+
+		release.Amount, err = compensation.ReleaseAmount(totals, chore.compensation.DisposePercent)
+		if err != nil {
+			return 0, Error.Wrap(err)
+		}
+		if release.Amount.Value() <= 0 {
+			continue
+		}
+		scheduled = append(scheduled, release)
+	}
+
+	if err := chore.db.ScheduleHeldReleases(ctx, scheduled); err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	mon.IntVal("held_releases_scheduled").Observe(int64(len(scheduled)))
+
+	return len(scheduled), nil
+}
+
+// Close closes the chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package heldrelease_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/currency"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/compensation"
+	"storj.io/storj/satellite/compensation/heldrelease"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+	"storj.io/storj/satellite/snopayouts"
+)
+
+func TestSchedule(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		compensationConfig := compensation.Config{
+			WithheldPercents: compensation.Percents{75, 50, 25},
+			DisposePercent:   50,
+		}
+
+		addNode := func(held int64) storj.NodeID {
+			nodeID := testrand.NodeID()
+			require.NoError(t, db.OverlayCache().UpdateCheckIn(ctx, overlay.NodeCheckInInfo{
+				NodeID:   nodeID,
+				IsUp:     true,
+				Address:  &pb.NodeAddress{Address: "1.2.3.4"},
+				Version:  &pb.NodeVersion{Version: "v1.0.0"},
+				Operator: &pb.NodeOperator{Email: "operator@mail.test", Wallet: "0x0123456789012345678901234567890123456789"},
+			}, time.Now(), overlay.NodeSelectionConfig{}))
+
+			require.NoError(t, db.SNOPayouts().TestCreatePaystub(ctx, snopayouts.Paystub{
+				Period: "2020-01",
+				NodeID: nodeID,
+				Held:   held,
+			}))
+			return nodeID
+		}
+
+		released := addNode(40)
+		withoutHeld := addNode(0)
+		disqualified := addNode(40)
+		_, err := db.OverlayCache().DisqualifyNode(ctx, disqualified, time.Now(), overlay.DisqualificationReasonAuditFailure)
+		require.NoError(t, err)
+
+		node, err := db.OverlayCache().Get(ctx, released)
+		require.NoError(t, err)
+		period := compensation.ReleasePeriod(compensationConfig.WithheldPercents, node.CreatedAt)
+
+		chore := heldrelease.NewChore(zaptest.NewLogger(t), db.OverlayCache(), db.Compensation(), heldrelease.Config{
+			Periods: 1,
+		}, compensationConfig)
+
+		// the nodes leave withholding after the scheduled periods.
+		count, err := chore.Schedule(ctx, compensation.PeriodFromTime(period.StartDate().AddDate(0, -2, 0)))
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		count, err = chore.Schedule(ctx, compensation.PeriodFromTime(period.StartDate().AddDate(0, -1, 0)))
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		// scheduling again doesn't duplicate the release.
+		count, err = chore.Schedule(ctx, period)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		releases, err := db.Compensation().ListHeldReleases(ctx, period)
+		require.NoError(t, err)
+		require.Len(t, releases, 1)
+		require.Equal(t, released, releases[0].NodeID)
+		require.Equal(t, period, releases[0].Period)
+		require.Equal(t, currency.NewMicroUnit(20), releases[0].Amount)
+
+		service := snopayouts.NewService(zaptest.NewLogger(t), db.SNOPayouts())
+
+		upcoming, err := service.GetUpcomingHeldReleases(ctx, released, period.StartDate())
+		require.NoError(t, err)
+		require.Len(t, upcoming, 1)
+		require.Equal(t, period.String(), upcoming[0].Period)
+		require.Equal(t, int64(20), upcoming[0].Amount)
+
+		upcoming, err = service.GetUpcomingHeldReleases(ctx, released, period.EndDateExclusive())
+		require.NoError(t, err)
+		require.Empty(t, upcoming)
+
+		upcoming, err = service.GetUpcomingHeldReleases(ctx, withoutHeld, period.StartDate())
+		require.NoError(t, err)
+		require.Empty(t, upcoming)
+	})
+}
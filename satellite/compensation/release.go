@@ -0,0 +1,67 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package compensation
+
+import (
+	"io"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"storj.io/common/storj"
+	"storj.io/common/strictcsv"
+	"storj.io/storj/private/currency"
+)
+
+// HeldRelease is the disbursement of the held amount to a node, when the node leaves
+// withholding.
+type HeldRelease struct {
+	NodeID    storj.NodeID       // The node the held amount is released to
+	Period    Period             // The period the node leaves withholding in
+	Amount    currency.MicroUnit // The amount released from the held amount
+	CreatedAt time.Time          // When the release was scheduled
+}
+
+// ReleasePeriod returns the first period for which a node created at createdAt is
+// out of withholding, which is the period the held amount is released in.
+func ReleasePeriod(withheldPercents []int, createdAt time.Time) Period {
+	// NodeWithheldPercent considers a node out of withholding when the end date of
+	// the period is not before the end of the withholding.
+	withholdingEnd := createdAt.AddDate(0, len(withheldPercents), 0)
+	return PeriodFromTime(withholdingEnd.Add(-time.Nanosecond))
+}
+
+// ReleaseAmount returns the amount released to a node leaving withholding, which is
+// the dispose percent of the total held amount minus what has already been disposed.
+func ReleaseAmount(totals TotalAmounts, disposePercent int) (currency.MicroUnit, error) {
+	released := PercentOf(totals.TotalHeld.Decimal(), decimal.NewFromInt(int64(disposePercent))).
+		Sub(totals.TotalDisposed.Decimal())
+	if released.Sign() < 0 {
+		return currency.MicroUnit{}, nil
+	}
+	amount, err := currency.MicroUnitFromDecimal(released)
+	return amount, Error.Wrap(err)
+}
+
+// heldReleaseRow is the CSV form of a HeldRelease.
+type heldReleaseRow struct {
+	Period    Period             `csv:"period"`
+	NodeID    NodeID             `csv:"node-id"`
+	Amount    currency.MicroUnit `csv:"amount"`
+	CreatedAt string             `csv:"created-at"`
+}
+
+// WriteHeldReleases writes the releases in CSV form.
+func WriteHeldReleases(w io.Writer, releases []HeldRelease) error {
+	rows := make([]heldReleaseRow, 0, len(releases))
+	for _, release := range releases {
+		rows = append(rows, heldReleaseRow{
+			Period:    release.Period,
+			NodeID:    NodeID(release.NodeID),
+			Amount:    release.Amount,
+			CreatedAt: release.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return strictcsv.Write(w, rows)
+}
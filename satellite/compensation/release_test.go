@@ -0,0 +1,73 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package compensation_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/storj/private/currency"
+	"storj.io/storj/satellite/compensation"
+)
+
+func TestReleasePeriod(t *testing.T) {
+	withheldPercents := []int{75, 75, 75, 50, 50, 50, 25, 25, 25, 0, 0, 0, 0, 0, 0}
+
+	for _, tt := range []struct {
+		createdAt time.Time
+		period    compensation.Period
+	}{
+		{createdAt: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), period: compensation.Period{Year: 2020, Month: 3}},
+		{createdAt: time.Date(2019, 1, 15, 0, 0, 0, 0, time.UTC), period: compensation.Period{Year: 2020, Month: 4}},
+		{createdAt: time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC), period: compensation.Period{Year: 2021, Month: 3}},
+	} {
+		period := compensation.ReleasePeriod(withheldPercents, tt.createdAt)
+		require.Equal(t, tt.period, period, tt.createdAt)
+
+		// the node is out of withholding in the release period, but not before.
+		_, inWithholding := compensation.NodeWithheldPercent(withheldPercents, tt.createdAt, period.EndDateExclusive())
+		require.False(t, inWithholding, tt.createdAt)
+		_, inWithholding = compensation.NodeWithheldPercent(withheldPercents, tt.createdAt, period.StartDate())
+		require.True(t, inWithholding, tt.createdAt)
+	}
+}
+
+func TestReleaseAmount(t *testing.T) {
+	amount, err := compensation.ReleaseAmount(compensation.TotalAmounts{
+		TotalHeld: currency.NewMicroUnit(40),
+	}, 50)
+	require.NoError(t, err)
+	require.Equal(t, currency.NewMicroUnit(20), amount)
+
+	amount, err = compensation.ReleaseAmount(compensation.TotalAmounts{
+		TotalHeld:     currency.NewMicroUnit(40),
+		TotalDisposed: currency.NewMicroUnit(15),
+	}, 50)
+	require.NoError(t, err)
+	require.Equal(t, currency.NewMicroUnit(5), amount)
+
+	// more has been disposed than the dispose percent.
+	amount, err = compensation.ReleaseAmount(compensation.TotalAmounts{
+		TotalHeld:     currency.NewMicroUnit(40),
+		TotalDisposed: currency.NewMicroUnit(30),
+	}, 50)
+	require.NoError(t, err)
+	require.Equal(t, currency.NewMicroUnit(0), amount)
+}
+
+func TestWriteHeldReleases(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, compensation.WriteHeldReleases(&buf, []compensation.HeldRelease{{
+		NodeID:    storj.NodeID{1},
+		Period:    compensation.Period{Year: 2023, Month: 6},
+		Amount:    currency.NewMicroUnit(1250000),
+		CreatedAt: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+	}}))
+	require.Contains(t, buf.String(), "period,node-id,amount,created-at\n")
+	require.Contains(t, buf.String(), "2023-06,"+storj.NodeID{1}.String()+",1250000,2023-05-01T00:00:00Z\n")
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package compensation
+
+import (
+	"io"
+	"time"
+
+	"storj.io/common/strictcsv"
+)
+
+// RateSchedule is a version of the payment rates. The rates are in effect from the
+// period until the period of the next schedule. A schedule with a higher version for
+// the same period is a correction, which replaces the previous versions, including
+// for the invoices of past periods generated again.
+type RateSchedule struct {
+	Period    Period    // The first period the rates are in effect for
+	Version   int       // The version of the rates of the period
+	Rates     Rates     // The payment rates
+	Note      string    // The reason for the schedule or the correction
+	CreatedAt time.Time // When the schedule was created
+}
+
+// ScheduleForPeriod returns the schedule in effect for the period, which is the latest
+// version of the schedule with the latest period not after the period. It returns
+// false, when no schedule is in effect for the period.
+func ScheduleForPeriod(schedules []RateSchedule, period Period) (RateSchedule, bool) {
+	var found RateSchedule
+	var ok bool
+	for _, schedule := range schedules {
+		if schedule.Period.StartDate().After(period.StartDate()) {
+			continue
+		}
+		if !ok || schedule.Period.StartDate().After(found.Period.StartDate()) ||
+			(schedule.Period == found.Period && schedule.Version > found.Version) {
+			found, ok = schedule, true
+		}
+	}
+	return found, ok
+}
+
+// rateScheduleRow is the CSV form of a RateSchedule.
+type rateScheduleRow struct {
+	Period        Period `csv:"period"`
+	Version       int64  `csv:"version"`
+	AtRestGBHours string `csv:"at-rest-gb-hours"`
+	GetTB         string `csv:"get-tb"`
+	PutTB         string `csv:"put-tb"`
+	GetRepairTB   string `csv:"get-repair-tb"`
+	PutRepairTB   string `csv:"put-repair-tb"`
+	GetAuditTB    string `csv:"get-audit-tb"`
+	Note          string `csv:"note"`
+	CreatedAt     string `csv:"created-at"`
+}
+
+// WriteRateSchedules writes the schedules in CSV form.
+func WriteRateSchedules(w io.Writer, schedules []RateSchedule) error {
+	rows := make([]rateScheduleRow, 0, len(schedules))
+	for _, schedule := range schedules {
+		rows = append(rows, rateScheduleRow{
+			Period:        schedule.Period,
+			Version:       int64(schedule.Version),
+			AtRestGBHours: schedule.Rates.AtRestGBHours.String(),
+			GetTB:         schedule.Rates.GetTB.String(),
+			PutTB:         schedule.Rates.PutTB.String(),
+			GetRepairTB:   schedule.Rates.GetRepairTB.String(),
+			PutRepairTB:   schedule.Rates.PutRepairTB.String(),
+			GetAuditTB:    schedule.Rates.GetAuditTB.String(),
+			Note:          schedule.Note,
+			CreatedAt:     schedule.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return strictcsv.Write(w, rows)
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package compensation
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleForPeriod(t *testing.T) {
+	schedule := func(period string, version int, getTB string) RateSchedule {
+		p, err := PeriodFromString(period)
+		require.NoError(t, err)
+		return RateSchedule{
+			Period:  p,
+			Version: version,
+			Rates:   Rates{GetTB: RequireRateFromString(getTB)},
+		}
+	}
+
+	schedules := []RateSchedule{
+		schedule("2023-01", 1, "20"),
+		schedule("2023-04", 1, "15"),
+		// correction of the rates of 2023-04.
+		schedule("2023-04", 2, "16"),
+		schedule("2023-07", 1, "10"),
+	}
+
+	for _, tt := range []struct {
+		period  string
+		ok      bool
+		getTB   string
+		version int
+	}{
+		{period: "2022-12", ok: false},
+		{period: "2023-01", ok: true, getTB: "20", version: 1},
+		{period: "2023-03", ok: true, getTB: "20", version: 1},
+		{period: "2023-04", ok: true, getTB: "16", version: 2},
+		{period: "2023-06", ok: true, getTB: "16", version: 2},
+		{period: "2023-07", ok: true, getTB: "10", version: 1},
+		{period: "2024-01", ok: true, getTB: "10", version: 1},
+	} {
+		period, err := PeriodFromString(tt.period)
+		require.NoError(t, err)
+
+		found, ok := ScheduleForPeriod(schedules, period)
+		require.Equal(t, tt.ok, ok, tt.period)
+		if !ok {
+			continue
+		}
+		assert.Equal(t, tt.getTB, found.Rates.GetTB.String(), tt.period)
+		assert.Equal(t, tt.version, found.Version, tt.period)
+	}
+}
+
+func TestWriteRateSchedules(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteRateSchedules(&buf, []RateSchedule{{
+		Period:  Period{Year: 2023, Month: time.May},
+		Version: 2,
+		Rates: Rates{
+			AtRestGBHours: RequireRateFromString("0.00000205"),
+			GetTB:         RequireRateFromString("20"),
+			PutTB:         RequireRateFromString("0"),
+			GetRepairTB:   RequireRateFromString("10"),
+			PutRepairTB:   RequireRateFromString("0"),
+			GetAuditTB:    RequireRateFromString("10"),
+		},
+		Note:      "corrected egress rate",
+		CreatedAt: time.Date(2023, time.June, 2, 10, 0, 0, 0, time.UTC),
+	}}))
+
+	assert.Equal(t, ""+
+		"period,version,at-rest-gb-hours,get-tb,put-tb,get-repair-tb,put-repair-tb,get-audit-tb,note,created-at\n"+
+		"2023-05,2,0.00000205,20,0,10,0,10,corrected egress rate,2023-06-02T10:00:00Z\n",
+		buf.String())
+}
@@ -64,6 +64,7 @@ type AccountFreezeService struct {
 	usersDB        Users
 	projectsDB     Projects
 	tracker        analytics.FreezeTracker
+	notifier       *Notifier
 }
 
 // NewAccountFreezeService creates a new account freeze service.
@@ -174,6 +175,8 @@ func (s *AccountFreezeService) FreezeUser(ctx context.Context, userID uuid.UUID)
 	}
 
 	s.tracker.TrackAccountFrozen(userID, user.Email)
+	s.notifier.Notify(ctx, userID, NotificationAccountFrozen, "Your account is frozen",
+		"Your account was frozen because of unpaid invoices, and the usage limits of your projects were removed. Pay the invoices to unfreeze it.")
 	return nil
 }
 
@@ -213,6 +216,8 @@ func (s *AccountFreezeService) UnfreezeUser(ctx context.Context, userID uuid.UUI
 	}
 
 	s.tracker.TrackAccountUnfrozen(userID, user.Email)
+	s.notifier.Notify(ctx, userID, NotificationAccountUnfrozen, "Your account is unfrozen",
+		"Your account was unfrozen, and the usage limits of your projects were restored.")
 	return nil
 }
 
@@ -234,6 +239,8 @@ func (s *AccountFreezeService) WarnUser(ctx context.Context, userID uuid.UUID) (
 	}
 
 	s.tracker.TrackAccountFreezeWarning(userID, user.Email)
+	s.notifier.Notify(ctx, userID, NotificationAccountWarned, "Your payment is overdue",
+		"Your account has unpaid invoices. Pay them to prevent your account from being frozen.")
 	return nil
 }
 
@@ -272,6 +279,11 @@ func (s *AccountFreezeService) GetAll(ctx context.Context, userID uuid.UUID) (fr
 	return freeze, warning, nil
 }
 
+// SetNotifier sets the notifier, which notifies the users about the changes of their freeze status.
+func (s *AccountFreezeService) SetNotifier(notifier *Notifier) {
+	s.notifier = notifier
+}
+
 // TestChangeFreezeTracker changes the freeze tracker service for tests.
 func (s *AccountFreezeService) TestChangeFreezeTracker(t analytics.FreezeTracker) {
 	s.tracker = t
@@ -0,0 +1,101 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package authservice implements a client of the auth service of the gateways, which issues
+// the S3 compatible credentials for the access grants.
+package authservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/console"
+)
+
+var (
+	// Error is the default error class of the auth service client.
+	Error = errs.Class("auth service")
+
+	mon = monkit.Package()
+)
+
+// maxErrorLength is the longest error message read from the responses of the auth service.
+const maxErrorLength = 1024
+
+var _ console.AuthService = (*Client)(nil)
+
+// Client is a client of the HTTP API of the auth service.
+type Client struct {
+	address string
+	http    http.Client
+}
+
+// NewClient creates a new client of the auth service at the address.
+func NewClient(address string, timeout time.Duration) *Client {
+	return &Client{
+		address: strings.TrimSuffix(address, "/"),
+		http:    http.Client{Timeout: timeout},
+	}
+}
+
+// Register registers the access grant and returns the credentials of the gateways. The
+// access grant isn't public, so it can't be used for the linksharing.
+func (client *Client) Register(ctx context.Context, accessGrant string) (_ console.AuthServiceCredentials, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	body, err := json.Marshal(struct {
+		AccessGrant string `json:"access_grant"`
+		Public      bool   `json:"public"`
+	}{
+		AccessGrant: accessGrant,
+	})
+	if err != nil {
+		return console.AuthServiceCredentials{}, Error.Wrap(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, client.address+"/v1/access", bytes.NewReader(body))
+	if err != nil {
+		return console.AuthServiceCredentials{}, Error.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.http.Do(req)
+	if err != nil {
+		return console.AuthServiceCredentials{}, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode != http.StatusOK {
+		message, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorLength))
+		if err != nil {
+			return console.AuthServiceCredentials{}, Error.Wrap(err)
+		}
+		return console.AuthServiceCredentials{}, Error.New("%s: %s", resp.Status, bytes.TrimSpace(message))
+	}
+
+	var data struct {
+		AccessKeyID string `json:"access_key_id"`
+		SecretKey   string `json:"secret_key"`
+		Endpoint    string `json:"endpoint"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return console.AuthServiceCredentials{}, Error.Wrap(err)
+	}
+	if data.AccessKeyID == "" || data.SecretKey == "" {
+		return console.AuthServiceCredentials{}, Error.New("missing credentials in the response")
+	}
+
+	return console.AuthServiceCredentials{
+		AccessKeyID: data.AccessKeyID,
+		SecretKey:   data.SecretKey,
+		Endpoint:    data.Endpoint,
+	}, nil
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package authservice_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/console/authservice"
+)
+
+func TestClientRegister(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/access" {
+			http.NotFound(w, r)
+			return
+		}
+
+		var request struct {
+			AccessGrant string `json:"access_grant"`
+			Public      bool   `json:"public"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.Public {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		if request.AccessGrant != "grant" {
+			http.Error(w, "invalid access grant", http.StatusUnauthorized)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"access_key_id": "access",
+			"secret_key":    "secret",
+			"endpoint":      "https://gateway.example.test",
+		})
+	}))
+	defer server.Close()
+
+	client := authservice.NewClient(server.URL+"/", time.Second)
+
+	credentials, err := client.Register(ctx, "grant")
+	require.NoError(t, err)
+	require.Equal(t, "access", credentials.AccessKeyID)
+	require.Equal(t, "secret", credentials.SecretKey)
+	require.Equal(t, "https://gateway.example.test", credentials.Endpoint)
+
+	_, err = client.Register(ctx, "other")
+	require.Error(t, err)
+	require.True(t, authservice.Error.Has(err))
+	require.Contains(t, err.Error(), "invalid access grant")
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+// ErrBillingAddressNotFound is returned when the user hasn't set a billing address.
+var ErrBillingAddressNotFound = errs.Class("billing address not found")
+
+// BillingAddresses exposes methods to manage the billing addresses table in database.
+//
+// architecture: Database
+type BillingAddresses interface {
+	// Get returns the billing address of the user.
+	Get(ctx context.Context, userID uuid.UUID) (*BillingAddress, error)
+	// Upsert creates or replaces the billing address of the user.
+	Upsert(ctx context.Context, userID uuid.UUID, address BillingAddress) error
+}
+
+// BillingAddress is the billing address and the tax ID of a user, which determine the taxes
+// added to the invoices.
+type BillingAddress struct {
+	Name       string `json:"name"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2"`
+	City       string `json:"city"`
+	PostalCode string `json:"postalCode"`
+	State      string `json:"state"`
+	// Country is the ISO 3166-1 alpha-2 code of the country.
+	Country string `json:"country"`
+	// TaxID is the tax ID of a business, or empty for a consumer.
+	TaxID     string    `json:"taxID"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
@@ -35,4 +35,8 @@ const (
 	Coupon BillingHistoryItemType = 3
 	// DepositBonus is an entity that adds some funds to Accounts balance after deposit with storj coins.
 	DepositBonus BillingHistoryItemType = 4
+	// Refund is a refund of a credit card charge billing item.
+	Refund BillingHistoryItemType = 5
+	// Chargeback is a chargeback, or its reversal, of a credit card charge billing item.
+	Chargeback BillingHistoryItemType = 6
 )
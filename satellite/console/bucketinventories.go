@@ -0,0 +1,115 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+// ErrBucketInventoryNotFound is returned when the bucket has no inventory configured.
+var ErrBucketInventoryNotFound = errs.Class("bucket inventory not found")
+
+// BucketInventories exposes methods to manage the bucket inventories table in database.
+//
+// architecture: Database
+type BucketInventories interface {
+	// Get returns the inventory configuration of the bucket.
+	Get(ctx context.Context, projectID uuid.UUID, bucketName string) (*BucketInventory, error)
+	// GetAll returns the inventory configurations of all the buckets.
+	GetAll(ctx context.Context) ([]BucketInventory, error)
+	// Upsert creates the inventory configuration of the bucket or replaces the existing one.
+	Upsert(ctx context.Context, inventory BucketInventory) error
+	// Delete removes the inventory configuration of the bucket.
+	Delete(ctx context.Context, projectID uuid.UUID, bucketName string) error
+	// SetDelivered updates the time the last report of the bucket was delivered.
+	SetDelivered(ctx context.Context, projectID uuid.UUID, bucketName string, deliveredAt time.Time) error
+}
+
+// InventoryFormat is the file format of the inventory reports.
+type InventoryFormat string
+
+const (
+	// InventoryFormatCSV writes the reports as comma-separated values.
+	InventoryFormatCSV InventoryFormat = "csv"
+)
+
+// InventoryFrequency defines how often the inventory reports are delivered.
+type InventoryFrequency string
+
+const (
+	// InventoryDaily delivers a report every day.
+	InventoryDaily InventoryFrequency = "daily"
+	// InventoryWeekly delivers a report every week.
+	InventoryWeekly InventoryFrequency = "weekly"
+)
+
+// Interval returns the time between two reports.
+func (frequency InventoryFrequency) Interval() time.Duration {
+	switch frequency {
+	case InventoryWeekly:
+		return 7 * 24 * time.Hour
+	default:
+		return 24 * time.Hour
+	}
+}
+
+// BucketInventory is the configuration of the scheduled inventory reports of a bucket.
+type BucketInventory struct {
+	ProjectID  uuid.UUID `json:"projectID"`
+	BucketName string    `json:"bucketName"`
+
+	DestinationBucket string             `json:"destinationBucket"`
+	DestinationPrefix string             `json:"destinationPrefix"`
+	Format            InventoryFormat    `json:"format"`
+	Frequency         InventoryFrequency `json:"frequency"`
+	// DestinationAccess is the serialized access grant which is used to upload the reports.
+	DestinationAccess string `json:"-"`
+
+	LastDeliveredAt *time.Time `json:"lastDeliveredAt"`
+	CreatedAt       time.Time  `json:"createdAt"`
+}
+
+// Due returns whether the next report of the bucket should be delivered.
+func (inventory *BucketInventory) Due(now time.Time) bool {
+	if inventory.LastDeliveredAt == nil {
+		return true
+	}
+	return !now.Before(inventory.LastDeliveredAt.Add(inventory.Frequency.Interval()))
+}
+
+// BucketInventoryRequest holds the data needed to configure the inventory reports of a bucket.
+type BucketInventoryRequest struct {
+	DestinationBucket string             `json:"destinationBucket"`
+	DestinationPrefix string             `json:"destinationPrefix"`
+	Format            InventoryFormat    `json:"format"`
+	Frequency         InventoryFrequency `json:"frequency"`
+	// DestinationAccess is an access grant which allows to upload into the destination bucket.
+	DestinationAccess string `json:"destinationAccess"`
+}
+
+// Validate checks the values of the request.
+func (request *BucketInventoryRequest) Validate() error {
+	if err := ValidateBucketName(request.DestinationBucket); err != nil {
+		return err
+	}
+	switch request.Format {
+	case InventoryFormatCSV:
+	default:
+		return errs.New("unsupported inventory format %q", request.Format)
+	}
+	switch request.Frequency {
+	case InventoryDaily, InventoryWeekly:
+	default:
+		return errs.New("unsupported inventory frequency %q", request.Frequency)
+	}
+	if request.DestinationAccess == "" {
+		return errs.New("destination access grant is required")
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/console"
+)
+
+func TestBucketInventoryDue(t *testing.T) {
+	now := time.Now()
+	inventory := console.BucketInventory{Frequency: console.InventoryDaily}
+	require.True(t, inventory.Due(now))
+
+	lastDeliveredAt := now.Add(-23 * time.Hour)
+	inventory.LastDeliveredAt = &lastDeliveredAt
+	require.False(t, inventory.Due(now))
+	require.True(t, inventory.Due(now.Add(time.Hour)))
+
+	inventory.Frequency = console.InventoryWeekly
+	require.False(t, inventory.Due(now.Add(time.Hour)))
+	require.True(t, inventory.Due(lastDeliveredAt.Add(7*24*time.Hour)))
+}
+
+func TestBucketInventoryRequestValidate(t *testing.T) {
+	valid := console.BucketInventoryRequest{
+		DestinationBucket: "reports",
+		Format:            console.InventoryFormatCSV,
+		Frequency:         console.InventoryWeekly,
+		DestinationAccess: "access",
+	}
+	require.NoError(t, valid.Validate())
+
+	invalid := []func(request *console.BucketInventoryRequest){
+		func(request *console.BucketInventoryRequest) { request.DestinationBucket = "" },
+		func(request *console.BucketInventoryRequest) { request.Format = "parquet" },
+		func(request *console.BucketInventoryRequest) { request.Frequency = "hourly" },
+		func(request *console.BucketInventoryRequest) { request.DestinationAccess = "" },
+	}
+	for _, modify := range invalid {
+		request := valid
+		modify(&request)
+		require.Error(t, request.Validate())
+	}
+}
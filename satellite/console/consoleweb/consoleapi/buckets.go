@@ -6,13 +6,16 @@ package consoleapi
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/private/web"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/eventing"
 )
 
 var (
@@ -42,24 +45,9 @@ func (b *Buckets) AllBucketNames(w http.ResponseWriter, r *http.Request) {
 
 	w.Header().Set("Content-Type", "application/json")
 
-	projectIDString := r.URL.Query().Get("projectID")
-	publicIDString := r.URL.Query().Get("publicID")
-
-	var projectID uuid.UUID
-	if projectIDString != "" {
-		projectID, err = uuid.FromString(projectIDString)
-		if err != nil {
-			b.serveJSONError(w, http.StatusBadRequest, err)
-			return
-		}
-	} else if publicIDString != "" {
-		projectID, err = uuid.FromString(publicIDString)
-		if err != nil {
-			b.serveJSONError(w, http.StatusBadRequest, err)
-			return
-		}
-	} else {
-		b.serveJSONError(w, http.StatusBadRequest, errs.New("Project ID was not provided."))
+	projectID, err := projectIDFromQuery(r)
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
 		return
 	}
 
@@ -80,6 +68,281 @@ func (b *Buckets) AllBucketNames(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// PlacementEntitlements returns the placements that can be selected for new buckets of a specific project.
+func (b *Buckets) PlacementEntitlements(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, err := projectIDFromQuery(r)
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	entitlements, err := b.service.GetPlacementEntitlements(ctx, projectID)
+	if err != nil {
+		if console.ErrUnauthorized.Has(err) {
+			b.serveJSONError(w, http.StatusUnauthorized, err)
+			return
+		}
+
+		b.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if entitlements == nil {
+		entitlements = []console.PlacementEntitlement{}
+	}
+
+	err = json.NewEncoder(w).Encode(entitlements)
+	if err != nil {
+		b.log.Error("failed to write json placement entitlements response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
+// CreateBucket creates a bucket with the selected placement.
+func (b *Buckets) CreateBucket(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	var request struct {
+		ProjectID uuid.UUID                  `json:"projectID"`
+		Name      string                     `json:"name"`
+		Placement *storj.PlacementConstraint `json:"placement"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if request.ProjectID.IsZero() {
+		b.serveJSONError(w, http.StatusBadRequest, errs.New("Project ID was not provided."))
+		return
+	}
+
+	bucket, err := b.service.CreateBucket(ctx, request.ProjectID, request.Name, request.Placement)
+	if err != nil {
+		switch {
+		case console.ErrUnauthorized.Has(err):
+			b.serveJSONError(w, http.StatusUnauthorized, err)
+		case console.ErrValidation.Has(err):
+			b.serveJSONError(w, http.StatusBadRequest, err)
+		case console.ErrBucketExists.Has(err):
+			b.serveJSONError(w, http.StatusConflict, err)
+		case console.ErrUsage.Has(err):
+			b.serveJSONError(w, http.StatusForbidden, err)
+		default:
+			b.serveJSONError(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	var response struct {
+		Name      string                    `json:"name"`
+		Placement storj.PlacementConstraint `json:"placement"`
+		CreatedAt time.Time                 `json:"createdAt"`
+	}
+	response.Name = bucket.Name
+	response.Placement = bucket.Placement
+	response.CreatedAt = bucket.Created
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		b.log.Error("failed to write json create bucket response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
+// GetBucketInventory returns the inventory configuration of the bucket.
+func (b *Buckets) GetBucketInventory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, err := bucketFromQuery(r)
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	inventory, err := b.service.GetBucketInventory(ctx, projectID, bucketName)
+	if err != nil {
+		b.serveBucketConfigError(w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(inventory)
+	if err != nil {
+		b.log.Error("failed to write json bucket inventory response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
+// SetBucketInventory configures the scheduled inventory reports of the bucket.
+func (b *Buckets) SetBucketInventory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, err := bucketFromQuery(r)
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var request console.BucketInventoryRequest
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	inventory, err := b.service.SetBucketInventory(ctx, projectID, bucketName, request)
+	if err != nil {
+		b.serveBucketConfigError(w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(inventory)
+	if err != nil {
+		b.log.Error("failed to write json bucket inventory response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
+// DeleteBucketInventory stops the scheduled inventory reports of the bucket.
+func (b *Buckets) DeleteBucketInventory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, bucketName, err := bucketFromQuery(r)
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = b.service.DeleteBucketInventory(ctx, projectID, bucketName)
+	if err != nil {
+		b.serveBucketConfigError(w, err)
+		return
+	}
+}
+
+// GetBucketNotifications returns the event notification rules of the bucket.
+func (b *Buckets) GetBucketNotifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, err := bucketFromQuery(r)
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	config, err := b.service.GetBucketNotifications(ctx, projectID, bucketName)
+	if err != nil {
+		b.serveBucketConfigError(w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(config)
+	if err != nil {
+		b.log.Error("failed to write json bucket notifications response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
+// SetBucketNotifications replaces the event notification rules of the bucket.
+func (b *Buckets) SetBucketNotifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, bucketName, err := bucketFromQuery(r)
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var config eventing.BucketConfig
+	if err = json.NewDecoder(r.Body).Decode(&config); err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = b.service.SetBucketNotifications(ctx, projectID, bucketName, config)
+	if err != nil {
+		b.serveBucketConfigError(w, err)
+		return
+	}
+}
+
+// DeleteBucketNotifications stops the event notifications of the bucket.
+func (b *Buckets) DeleteBucketNotifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, bucketName, err := bucketFromQuery(r)
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = b.service.DeleteBucketNotifications(ctx, projectID, bucketName)
+	if err != nil {
+		b.serveBucketConfigError(w, err)
+		return
+	}
+}
+
+// serveBucketConfigError writes the error of a request, which configures a bucket.
+func (b *Buckets) serveBucketConfigError(w http.ResponseWriter, err error) {
+	switch {
+	case console.ErrUnauthorized.Has(err):
+		b.serveJSONError(w, http.StatusUnauthorized, err)
+	case console.ErrValidation.Has(err):
+		b.serveJSONError(w, http.StatusBadRequest, err)
+	case console.ErrBucketInventoryNotFound.Has(err):
+		b.serveJSONError(w, http.StatusNotFound, err)
+	default:
+		b.serveJSONError(w, http.StatusInternalServerError, err)
+	}
+}
+
+// bucketFromQuery returns the project ID and the bucket name provided as query parameters.
+func bucketFromQuery(r *http.Request) (uuid.UUID, string, error) {
+	projectID, err := projectIDFromQuery(r)
+	if err != nil {
+		return uuid.UUID{}, "", err
+	}
+	bucketName := r.URL.Query().Get("bucket")
+	if bucketName == "" {
+		return uuid.UUID{}, "", errs.New("Bucket name was not provided.")
+	}
+	return projectID, bucketName, nil
+}
+
+// projectIDFromQuery returns the project ID provided either as projectID or publicID query parameter.
+func projectIDFromQuery(r *http.Request) (uuid.UUID, error) {
+	if projectIDString := r.URL.Query().Get("projectID"); projectIDString != "" {
+		return uuid.FromString(projectIDString)
+	}
+	if publicIDString := r.URL.Query().Get("publicID"); publicIDString != "" {
+		return uuid.FromString(publicIDString)
+	}
+	return uuid.UUID{}, errs.New("Project ID was not provided.")
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (b *Buckets) serveJSONError(w http.ResponseWriter, status int, err error) {
 	web.ServeJSONError(b.log, w, status, err)
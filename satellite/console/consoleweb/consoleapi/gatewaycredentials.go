@@ -0,0 +1,142 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/private/web"
+	"storj.io/storj/satellite/console"
+)
+
+var (
+	// ErrGatewayCredentialsAPI - console gateway credentials api error type.
+	ErrGatewayCredentialsAPI = errs.Class("console gateway credentials")
+)
+
+// GatewayCredentials is an api controller that exposes the gateway credentials of the projects.
+type GatewayCredentials struct {
+	log     *zap.Logger
+	service *console.Service
+}
+
+// NewGatewayCredentials is a constructor for api gateway credentials controller.
+func NewGatewayCredentials(log *zap.Logger, service *console.Service) *GatewayCredentials {
+	return &GatewayCredentials{
+		log:     log,
+		service: service,
+	}
+}
+
+// List returns the active gateway credentials of the project.
+func (c *GatewayCredentials) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, err := projectIDFromQuery(r)
+	if err != nil {
+		c.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	credentials, err := c.service.ListGatewayCredentials(ctx, projectID)
+	if err != nil {
+		c.serveError(w, err)
+		return
+	}
+	if credentials == nil {
+		credentials = []console.GatewayCredential{}
+	}
+
+	err = json.NewEncoder(w).Encode(credentials)
+	if err != nil {
+		c.log.Error("failed to write json gateway credentials response", zap.Error(ErrGatewayCredentialsAPI.Wrap(err)))
+	}
+}
+
+// Create creates a gateway credential for an access grant of the project.
+// The secret key is returned only in this response.
+func (c *GatewayCredentials) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, err := projectIDFromQuery(r)
+	if err != nil {
+		c.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var request console.CreateGatewayCredentialRequest
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		c.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := c.service.CreateGatewayCredential(ctx, projectID, request)
+	if err != nil {
+		c.serveError(w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		c.log.Error("failed to write json gateway credential response", zap.Error(ErrGatewayCredentialsAPI.Wrap(err)))
+	}
+}
+
+// Revoke revokes the gateway credential and its access grant.
+func (c *GatewayCredentials) Revoke(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := projectIDFromQuery(r)
+	if err != nil {
+		c.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		c.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = c.service.RevokeGatewayCredential(ctx, projectID, id)
+	if err != nil {
+		c.serveError(w, err)
+		return
+	}
+}
+
+// serveError writes the error of a gateway credentials request.
+func (c *GatewayCredentials) serveError(w http.ResponseWriter, err error) {
+	switch {
+	case console.ErrUnauthorized.Has(err):
+		c.serveJSONError(w, http.StatusUnauthorized, err)
+	case console.ErrValidation.Has(err):
+		c.serveJSONError(w, http.StatusBadRequest, err)
+	case console.ErrGatewayCredentialNotFound.Has(err), console.ErrGatewayCredentialsDisabled.Has(err):
+		c.serveJSONError(w, http.StatusNotFound, err)
+	default:
+		c.serveJSONError(w, http.StatusInternalServerError, err)
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (c *GatewayCredentials) serveJSONError(w http.ResponseWriter, status int, err error) {
+	web.ServeJSONError(c.log, w, status, err)
+}
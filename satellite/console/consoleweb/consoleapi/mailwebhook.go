@@ -0,0 +1,115 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/private/web"
+	"storj.io/storj/satellite/mailservice"
+)
+
+// ErrMailWebhookAPI - console mail webhook api error type.
+var ErrMailWebhookAPI = errs.Class("consoleapi mail webhook")
+
+// maxWebhookBodySize is the maximum size of a delivery report.
+const maxWebhookBodySize = 1 << 20
+
+// MailWebhook is an api controller that receives delivery reports from the email provider.
+type MailWebhook struct {
+	log     *zap.Logger
+	service *mailservice.Service
+	secret  string
+}
+
+// NewMailWebhook is a constructor for mail webhook controller.
+func NewMailWebhook(log *zap.Logger, service *mailservice.Service, secret string) *MailWebhook {
+	return &MailWebhook{
+		log:     log,
+		service: service,
+		secret:  secret,
+	}
+}
+
+type deliveryEvent struct {
+	MessageID string `json:"messageId"`
+	Recipient string `json:"recipient"`
+	Email     string `json:"email"`
+	Event     string `json:"event"`
+	Reason    string `json:"reason"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// ReportDeliveries updates the delivery status of emails from a list of provider events.
+func (m *MailWebhook) ReportDeliveries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get("X-Webhook-Secret")), []byte(m.secret)) != 1 {
+		m.serveJSONError(w, http.StatusUnauthorized, ErrMailWebhookAPI.New("invalid webhook secret"))
+		return
+	}
+
+	var events []deliveryEvent
+	err = json.NewDecoder(http.MaxBytesReader(w, r.Body, maxWebhookBodySize)).Decode(&events)
+	if err != nil {
+		m.serveJSONError(w, http.StatusBadRequest, ErrMailWebhookAPI.Wrap(err))
+		return
+	}
+
+	for _, event := range events {
+		status, ok := parseDeliveryEvent(event.Event)
+		if !ok || event.MessageID == "" {
+			m.log.Debug("ignoring mail delivery event", zap.String("event", event.Event), zap.String("message", event.MessageID))
+			continue
+		}
+
+		recipient := event.Recipient
+		if recipient == "" {
+			recipient = event.Email
+		}
+
+		at := time.Now()
+		if event.Timestamp > 0 {
+			at = time.Unix(event.Timestamp, 0)
+		}
+
+		err = m.service.UpdateDeliveryStatus(ctx, event.MessageID, recipient, status, event.Reason, at)
+		if err != nil {
+			m.serveJSONError(w, http.StatusInternalServerError, ErrMailWebhookAPI.Wrap(err))
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseDeliveryEvent maps the event names used by common email providers to a delivery status.
+func parseDeliveryEvent(event string) (mailservice.DeliveryStatus, bool) {
+	event = strings.ToLower(event)
+	switch event {
+	case "delivered", "delivery":
+		return mailservice.DeliverySent, true
+	case "dropped", "rejected":
+		return mailservice.DeliveryFailed, true
+	case "bounce", "hard_bounce":
+		return mailservice.DeliveryBounced, true
+	case "complaint", "spamreport", "spam_complaint":
+		return mailservice.DeliveryComplained, true
+	}
+	return mailservice.ParseDeliveryStatus(event)
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (m *MailWebhook) serveJSONError(w http.ResponseWriter, status int, err error) {
+	web.ServeJSONError(m.log, w, status, err)
+}
@@ -0,0 +1,189 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/private/web"
+	"storj.io/storj/satellite/console"
+)
+
+var (
+	// ErrNotificationsAPI - console notifications api error type.
+	ErrNotificationsAPI = errs.Class("console notifications")
+)
+
+// Notifications is an api controller that exposes the in-app notifications of the user.
+type Notifications struct {
+	log     *zap.Logger
+	service *console.Service
+}
+
+// NewNotifications is a constructor for api notifications controller.
+func NewNotifications(log *zap.Logger, service *console.Service) *Notifications {
+	return &Notifications{
+		log:     log,
+		service: service,
+	}
+}
+
+// List returns the recent notifications of the user and the number of the unread ones.
+// With unread=true only the unread notifications are returned.
+func (c *Notifications) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	var unreadOnly bool
+	if value := r.URL.Query().Get("unread"); value != "" {
+		unreadOnly, err = strconv.ParseBool(value)
+		if err != nil {
+			c.serveJSONError(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	list, err := c.service.ListNotifications(ctx, unreadOnly)
+	if err != nil {
+		c.serveError(w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(list)
+	if err != nil {
+		c.log.Error("failed to write json notifications response", zap.Error(ErrNotificationsAPI.Wrap(err)))
+	}
+}
+
+// MarkRead marks the notification as read.
+func (c *Notifications) MarkRead(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		c.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = c.service.MarkNotificationRead(ctx, id)
+	if err != nil {
+		c.serveError(w, err)
+		return
+	}
+}
+
+// MarkAllRead marks all the notifications of the user as read.
+func (c *Notifications) MarkAllRead(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	err = c.service.MarkAllNotificationsRead(ctx)
+	if err != nil {
+		c.serveError(w, err)
+		return
+	}
+}
+
+// Stream sends the new notifications of the user as server-sent events, until the client
+// disconnects. The notifications created after the time in the since query parameter
+// (RFC 3339) are sent first, so the client doesn't miss the ones created while it was
+// reconnecting.
+func (c *Notifications) Stream(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	since := time.Now()
+	if value := r.URL.Query().Get("since"); value != "" {
+		since, err = time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			c.serveJSONError(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		c.serveJSONError(w, http.StatusInternalServerError, ErrNotificationsAPI.New("streaming is not supported"))
+		return
+	}
+
+	subscription, err := c.service.SubscribeNotifications(ctx)
+	if err != nil {
+		c.serveError(w, err)
+		return
+	}
+	defer subscription.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		var notifications []console.Notification
+		notifications, err = subscription.Next(ctx, since)
+		if err != nil {
+			if ctx.Err() == nil {
+				c.log.Error("failed to stream notifications", zap.Error(ErrNotificationsAPI.Wrap(err)))
+			}
+			return
+		}
+
+		if len(notifications) == 0 {
+			// keeps the idle connection open through the proxies.
+			_, err = fmt.Fprint(w, ": ping\n\n")
+		}
+		for _, notification := range notifications {
+			var data []byte
+			data, err = json.Marshal(notification)
+			if err != nil {
+				c.log.Error("failed to encode notification", zap.Error(ErrNotificationsAPI.Wrap(err)))
+				return
+			}
+			_, err = fmt.Fprintf(w, "id: %s\nevent: notification\ndata: %s\n\n", notification.ID, data)
+			if err != nil {
+				break
+			}
+			since = notification.CreatedAt
+		}
+		if err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// serveError writes the error of a notifications request.
+func (c *Notifications) serveError(w http.ResponseWriter, err error) {
+	switch {
+	case console.ErrUnauthorized.Has(err):
+		c.serveJSONError(w, http.StatusUnauthorized, err)
+	case console.ErrNotificationNotFound.Has(err), console.ErrNotificationsDisabled.Has(err):
+		c.serveJSONError(w, http.StatusNotFound, err)
+	default:
+		c.serveJSONError(w, http.StatusInternalServerError, err)
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (c *Notifications) serveJSONError(w http.ResponseWriter, status int, err error) {
+	web.ServeJSONError(c.log, w, status, err)
+}
@@ -567,6 +567,64 @@ func (p *Payments) PackageAvailable(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GetBillingAddress returns the billing address and the tax ID of the user.
+func (p *Payments) GetBillingAddress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	address, err := p.service.Payments().GetBillingAddress(ctx)
+	if err != nil {
+		switch {
+		case console.ErrUnauthorized.Has(err):
+			p.serveJSONError(w, http.StatusUnauthorized, err)
+		case console.ErrBillingAddressNotFound.Has(err):
+			p.serveJSONError(w, http.StatusNotFound, err)
+		default:
+			p.serveJSONError(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(address); err != nil {
+		p.log.Error("failed to encode billing address", zap.Error(ErrPaymentsAPI.Wrap(err)))
+	}
+}
+
+// SetBillingAddress sets the billing address and the tax ID of the user.
+func (p *Payments) SetBillingAddress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	var address console.BillingAddress
+	if err = json.NewDecoder(r.Body).Decode(&address); err != nil {
+		p.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	stored, err := p.service.Payments().SetBillingAddress(ctx, address)
+	if err != nil {
+		switch {
+		case console.ErrUnauthorized.Has(err):
+			p.serveJSONError(w, http.StatusUnauthorized, err)
+		case console.ErrValidation.Has(err):
+			p.serveJSONError(w, http.StatusBadRequest, err)
+		default:
+			p.serveJSONError(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(stored); err != nil {
+		p.log.Error("failed to encode billing address", zap.Error(ErrPaymentsAPI.Wrap(err)))
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (p *Payments) serveJSONError(w http.ResponseWriter, status int, err error) {
 	web.ServeJSONError(p.log, w, status, err)
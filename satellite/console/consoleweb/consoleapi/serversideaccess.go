@@ -0,0 +1,158 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/private/web"
+	"storj.io/storj/satellite/console"
+)
+
+var (
+	// ErrServerSideAccessAPI - console server-side access api error type.
+	ErrServerSideAccessAPI = errs.Class("console server-side access")
+)
+
+// ServerSideAccess is an api controller that exposes the access grants created by the satellite.
+type ServerSideAccess struct {
+	log     *zap.Logger
+	service *console.Service
+}
+
+// NewServerSideAccess is a constructor for api server-side access controller.
+func NewServerSideAccess(log *zap.Logger, service *console.Service) *ServerSideAccess {
+	return &ServerSideAccess{
+		log:     log,
+		service: service,
+	}
+}
+
+// Get returns whether the project keeps its root key on the satellite.
+func (c *ServerSideAccess) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, err := projectIDFromQuery(r)
+	if err != nil {
+		c.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	enabled, err := c.service.GetServerSideAccess(ctx, projectID)
+	if err != nil {
+		c.serveError(w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(struct {
+		Enabled bool `json:"enabled"`
+	}{Enabled: enabled})
+	if err != nil {
+		c.log.Error("failed to write json server-side access response", zap.Error(ErrServerSideAccessAPI.Wrap(err)))
+	}
+}
+
+// Enable stores the root key of the project derived from the passphrase.
+func (c *ServerSideAccess) Enable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := projectIDFromQuery(r)
+	if err != nil {
+		c.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var request console.EnableServerSideAccessRequest
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		c.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = c.service.EnableServerSideAccess(ctx, projectID, request)
+	if err != nil {
+		c.serveError(w, err)
+		return
+	}
+}
+
+// Disable removes the root key of the project.
+func (c *ServerSideAccess) Disable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := projectIDFromQuery(r)
+	if err != nil {
+		c.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = c.service.DisableServerSideAccess(ctx, projectID)
+	if err != nil {
+		c.serveError(w, err)
+		return
+	}
+}
+
+// CreateAccessGrant creates a restricted access grant of the project.
+// The access grant is returned only in this response.
+func (c *ServerSideAccess) CreateAccessGrant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, err := projectIDFromQuery(r)
+	if err != nil {
+		c.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var request console.CreateServerSideAccessGrantRequest
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		c.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := c.service.CreateServerSideAccessGrant(ctx, projectID, request)
+	if err != nil {
+		c.serveError(w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		c.log.Error("failed to write json server-side access grant response", zap.Error(ErrServerSideAccessAPI.Wrap(err)))
+	}
+}
+
+// serveError writes the error of a server-side access request.
+func (c *ServerSideAccess) serveError(w http.ResponseWriter, err error) {
+	switch {
+	case console.ErrUnauthorized.Has(err):
+		c.serveJSONError(w, http.StatusUnauthorized, err)
+	case console.ErrValidation.Has(err):
+		c.serveJSONError(w, http.StatusBadRequest, err)
+	case console.ErrServerSideAccessDisabled.Has(err):
+		c.serveJSONError(w, http.StatusNotFound, err)
+	default:
+		c.serveJSONError(w, http.StatusInternalServerError, err)
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (c *ServerSideAccess) serveJSONError(w http.ResponseWriter, status int, err error) {
+	web.ServeJSONError(c.log, w, status, err)
+}
@@ -0,0 +1,62 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/private/web"
+	"storj.io/storj/satellite/payments/stripe"
+)
+
+// ErrStripeWebhookAPI - console stripe webhook api error type.
+var ErrStripeWebhookAPI = errs.Class("consoleapi stripe webhook")
+
+// StripeWebhook is an api controller that receives the refunds and the chargebacks from Stripe.
+type StripeWebhook struct {
+	log     *zap.Logger
+	service *stripe.Service
+}
+
+// NewStripeWebhook is a constructor for stripe webhook controller.
+func NewStripeWebhook(log *zap.Logger, service *stripe.Service) *StripeWebhook {
+	return &StripeWebhook{
+		log:     log,
+		service: service,
+	}
+}
+
+// HandleEvent records the refunds and the chargebacks reported by the signed Stripe event.
+func (s *StripeWebhook) HandleEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBodySize))
+	if err != nil {
+		s.serveJSONError(w, http.StatusBadRequest, ErrStripeWebhookAPI.Wrap(err))
+		return
+	}
+
+	err = s.service.HandleWebhook(ctx, payload, r.Header.Get("Stripe-Signature"))
+	if err != nil {
+		if stripe.ErrWebhookSignature.Has(err) {
+			s.serveJSONError(w, http.StatusBadRequest, ErrStripeWebhookAPI.Wrap(err))
+			return
+		}
+		s.serveJSONError(w, http.StatusInternalServerError, ErrStripeWebhookAPI.Wrap(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (s *StripeWebhook) serveJSONError(w http.ResponseWriter, status int, err error) {
+	web.ServeJSONError(s.log, w, status, err)
+}
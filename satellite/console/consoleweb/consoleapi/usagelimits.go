@@ -154,6 +154,106 @@ func (ul *UsageLimits) DailyUsage(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// UserAgentRollups returns the daily traffic of the project per bucket and user agent.
+func (ul *UsageLimits) UserAgentRollups(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var ok bool
+	var idParam string
+
+	if idParam, ok = mux.Vars(r)["id"]; !ok {
+		ul.serveJSONError(w, http.StatusBadRequest, errs.New("missing project id route param"))
+		return
+	}
+	projectID, err := uuid.FromString(idParam)
+	if err != nil {
+		ul.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return
+	}
+
+	sinceStamp, err := strconv.ParseInt(r.URL.Query().Get("from"), 10, 64)
+	if err != nil {
+		ul.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+	beforeStamp, err := strconv.ParseInt(r.URL.Query().Get("to"), 10, 64)
+	if err != nil {
+		ul.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	since := time.Unix(sinceStamp, 0)
+	before := time.Unix(beforeStamp, 0)
+
+	rollups, err := ul.service.GetUserAgentRollups(ctx, projectID, since, before)
+	if err != nil {
+		if console.ErrUnauthorized.Has(err) {
+			ul.serveJSONError(w, http.StatusUnauthorized, err)
+			return
+		}
+
+		ul.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(rollups)
+	if err != nil {
+		ul.log.Error("error encoding user agent rollups", zap.Error(ErrUsageLimitsAPI.Wrap(err)))
+	}
+}
+
+// APIUsageRollups returns the hourly counts of the metainfo requests of the project per operation.
+func (ul *UsageLimits) APIUsageRollups(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var ok bool
+	var idParam string
+
+	if idParam, ok = mux.Vars(r)["id"]; !ok {
+		ul.serveJSONError(w, http.StatusBadRequest, errs.New("missing project id route param"))
+		return
+	}
+	projectID, err := uuid.FromString(idParam)
+	if err != nil {
+		ul.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return
+	}
+
+	sinceStamp, err := strconv.ParseInt(r.URL.Query().Get("from"), 10, 64)
+	if err != nil {
+		ul.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+	beforeStamp, err := strconv.ParseInt(r.URL.Query().Get("to"), 10, 64)
+	if err != nil {
+		ul.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	since := time.Unix(sinceStamp, 0)
+	before := time.Unix(beforeStamp, 0)
+
+	rollups, err := ul.service.GetAPIUsageRollups(ctx, projectID, since, before)
+	if err != nil {
+		if console.ErrUnauthorized.Has(err) {
+			ul.serveJSONError(w, http.StatusUnauthorized, err)
+			return
+		}
+
+		ul.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(rollups)
+	if err != nil {
+		ul.log.Error("error encoding api usage rollups", zap.Error(ErrUsageLimitsAPI.Wrap(err)))
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (ul *UsageLimits) serveJSONError(w http.ResponseWriter, status int, err error) {
 	web.ServeJSONError(ul.log, w, status, err)
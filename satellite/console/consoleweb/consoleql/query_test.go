@@ -89,6 +89,7 @@ func TestGraphqlQuery(t *testing.T) {
 			db.ProjectAccounting(),
 			projectUsage,
 			sat.API.Buckets.Service,
+			db.Attribution(),
 			paymentsService.Accounts(),
 			// TODO: do we need a payment deposit wallet here?
 			nil,
@@ -109,7 +110,7 @@ func TestGraphqlQuery(t *testing.T) {
 		)
 		require.NoError(t, err)
 
-		mailService, err := mailservice.New(log, &discardSender{}, "testdata")
+		mailService, err := mailservice.New(log, &discardSender{}, "testdata", mailservice.Branding{}, nil)
 		require.NoError(t, err)
 		defer ctx.Check(mailService.Close)
 
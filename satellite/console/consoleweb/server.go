@@ -33,6 +33,7 @@ import (
 
 	"storj.io/common/errs2"
 	"storj.io/common/storj"
+	"storj.io/storj/private/ratelimit"
 	"storj.io/storj/private/web"
 	"storj.io/storj/satellite/abtesting"
 	"storj.io/storj/satellite/analytics"
@@ -43,6 +44,8 @@ import (
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/oidc"
 	"storj.io/storj/satellite/payments/paymentsconfig"
+	"storj.io/storj/satellite/payments/stripe"
+	"storj.io/storj/satellite/statuspage"
 )
 
 const (
@@ -69,6 +72,8 @@ type Config struct {
 	AuthToken       string `help:"auth token needed for access to registration token creation endpoint" default:"" testDefault:"very-secret-token"`
 	AuthTokenSecret string `help:"secret used to sign auth tokens" releaseDefault:"" devDefault:"my-suppa-secret-key"`
 
+	MailWebhookSecret string `help:"shared secret which email providers must send in the X-Webhook-Secret header when reporting delivery status, the webhook is disabled when empty" default:""`
+
 	ContactInfoURL                  string     `help:"url link to contacts page" default:"https://forum.storj.io"`
 	FrameAncestors                  string     `help:"allow domains to embed the satellite in a frame, space separated" default:"tardigrade.io storj.io"`
 	LetUsKnowURL                    string     `help:"url link to let us know page" default:"https://storjlabs.atlassian.net/servicedesk/customer/portals"`
@@ -195,7 +200,7 @@ func (a *apiAuth) RemoveAuthCookie(w http.ResponseWriter) {
 }
 
 // NewServer creates new instance of console server.
-func NewServer(logger *zap.Logger, config Config, service *console.Service, oidcService *oidc.Service, mailService *mailservice.Service, analytics *analytics.Service, abTesting *abtesting.Service, accountFreezeService *console.AccountFreezeService, listener net.Listener, stripePublicKey string, nodeURL storj.NodeURL, packagePlans paymentsconfig.PackagePlans) *Server {
+func NewServer(logger *zap.Logger, config Config, service *console.Service, oidcService *oidc.Service, mailService *mailservice.Service, analytics *analytics.Service, abTesting *abtesting.Service, accountFreezeService *console.AccountFreezeService, listener net.Listener, stripePublicKey string, nodeURL storj.NodeURL, packagePlans paymentsconfig.PackagePlans, sharedLimiter ratelimit.Limiter, statusPage *statuspage.Service, stripeService *stripe.Service) *Server {
 	server := Server{
 		log:               logger,
 		config:            config,
@@ -205,8 +210,8 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 		analytics:         analytics,
 		abTesting:         abTesting,
 		stripePublicKey:   stripePublicKey,
-		ipRateLimiter:     web.NewIPRateLimiter(config.RateLimit, logger),
-		userIDRateLimiter: NewUserIDRateLimiter(config.RateLimit, logger),
+		ipRateLimiter:     web.NewIPRateLimiter(config.RateLimit, logger).WithShared(sharedLimiter, "console:ip:"),
+		userIDRateLimiter: NewUserIDRateLimiter(config.RateLimit, logger).WithShared(sharedLimiter, "console:user:"),
 		nodeURL:           nodeURL,
 		packagePlans:      packagePlans,
 	}
@@ -267,6 +272,14 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 		"/api/v0/projects/{id}/daily-usage",
 		server.withAuth(http.HandlerFunc(usageLimitsController.DailyUsage)),
 	).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/projects/{id}/user-agents",
+		server.withAuth(http.HandlerFunc(usageLimitsController.UserAgentRollups)),
+	).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/projects/{id}/api-usage",
+		server.withAuth(http.HandlerFunc(usageLimitsController.APIUsageRollups)),
+	).Methods(http.MethodGet)
 
 	authController := consoleapi.NewAuth(logger, service, accountFreezeService, mailService, server.cookieAuth, server.analytics, config.SatelliteName, server.config.ExternalAddress, config.LetUsKnowURL, config.TermsAndConditionsURL, config.ContactInfoURL, config.GeneralRequestURL)
 	authRouter := router.PathPrefix("/api/v0/auth").Subrouter()
@@ -312,6 +325,8 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	paymentsRouter.HandleFunc("/wallet", paymentController.ClaimWallet).Methods(http.MethodPost)
 	paymentsRouter.HandleFunc("/wallet/payments", paymentController.WalletPayments).Methods(http.MethodGet)
 	paymentsRouter.HandleFunc("/billing-history", paymentController.BillingHistory).Methods(http.MethodGet)
+	paymentsRouter.HandleFunc("/billing-address", paymentController.GetBillingAddress).Methods(http.MethodGet)
+	paymentsRouter.HandleFunc("/billing-address", paymentController.SetBillingAddress).Methods(http.MethodPut)
 	paymentsRouter.Handle("/coupon/apply", server.userIDRateLimiter.Limit(http.HandlerFunc(paymentController.ApplyCouponCode))).Methods(http.MethodPatch)
 	paymentsRouter.HandleFunc("/coupon", paymentController.GetCoupon).Methods(http.MethodGet)
 	paymentsRouter.HandleFunc("/pricing", paymentController.GetProjectUsagePriceModel).Methods(http.MethodGet)
@@ -324,18 +339,63 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	bucketsRouter := router.PathPrefix("/api/v0/buckets").Subrouter()
 	bucketsRouter.Use(server.withAuth)
 	bucketsRouter.HandleFunc("/bucket-names", bucketsController.AllBucketNames).Methods(http.MethodGet)
+	bucketsRouter.HandleFunc("/placements", bucketsController.PlacementEntitlements).Methods(http.MethodGet)
+	bucketsRouter.HandleFunc("/create", bucketsController.CreateBucket).Methods(http.MethodPost)
+	bucketsRouter.HandleFunc("/inventory", bucketsController.GetBucketInventory).Methods(http.MethodGet)
+	bucketsRouter.HandleFunc("/inventory", bucketsController.SetBucketInventory).Methods(http.MethodPut)
+	bucketsRouter.HandleFunc("/inventory", bucketsController.DeleteBucketInventory).Methods(http.MethodDelete)
+	bucketsRouter.HandleFunc("/notifications", bucketsController.GetBucketNotifications).Methods(http.MethodGet)
+	bucketsRouter.HandleFunc("/notifications", bucketsController.SetBucketNotifications).Methods(http.MethodPut)
+	bucketsRouter.HandleFunc("/notifications", bucketsController.DeleteBucketNotifications).Methods(http.MethodDelete)
 
 	apiKeysController := consoleapi.NewAPIKeys(logger, service)
 	apiKeysRouter := router.PathPrefix("/api/v0/api-keys").Subrouter()
 	apiKeysRouter.Use(server.withAuth)
 	apiKeysRouter.HandleFunc("/delete-by-name", apiKeysController.DeleteByNameAndProjectID).Methods(http.MethodDelete)
 
+	gatewayCredentialsController := consoleapi.NewGatewayCredentials(logger, service)
+	gatewayCredentialsRouter := router.PathPrefix("/api/v0/gateway-credentials").Subrouter()
+	gatewayCredentialsRouter.Use(server.withAuth)
+	gatewayCredentialsRouter.HandleFunc("", gatewayCredentialsController.List).Methods(http.MethodGet)
+	gatewayCredentialsRouter.HandleFunc("", gatewayCredentialsController.Create).Methods(http.MethodPost)
+	gatewayCredentialsRouter.HandleFunc("/{id}", gatewayCredentialsController.Revoke).Methods(http.MethodDelete)
+
+	notificationsController := consoleapi.NewNotifications(logger, service)
+	notificationsRouter := router.PathPrefix("/api/v0/notifications").Subrouter()
+	notificationsRouter.Use(server.withAuth)
+	notificationsRouter.HandleFunc("", notificationsController.List).Methods(http.MethodGet)
+	notificationsRouter.HandleFunc("/read-all", notificationsController.MarkAllRead).Methods(http.MethodPost)
+	notificationsRouter.HandleFunc("/stream", notificationsController.Stream).Methods(http.MethodGet)
+	notificationsRouter.HandleFunc("/{id}/read", notificationsController.MarkRead).Methods(http.MethodPatch)
+
+	serverSideAccessController := consoleapi.NewServerSideAccess(logger, service)
+	serverSideAccessRouter := router.PathPrefix("/api/v0/server-side-access").Subrouter()
+	serverSideAccessRouter.Use(server.withAuth)
+	serverSideAccessRouter.HandleFunc("", serverSideAccessController.Get).Methods(http.MethodGet)
+	serverSideAccessRouter.HandleFunc("", serverSideAccessController.Enable).Methods(http.MethodPut)
+	serverSideAccessRouter.HandleFunc("", serverSideAccessController.Disable).Methods(http.MethodDelete)
+	serverSideAccessRouter.HandleFunc("/access-grants", serverSideAccessController.CreateAccessGrant).Methods(http.MethodPost)
+
 	analyticsController := consoleapi.NewAnalytics(logger, service, server.analytics)
 	analyticsRouter := router.PathPrefix("/api/v0/analytics").Subrouter()
 	analyticsRouter.Use(server.withAuth)
 	analyticsRouter.HandleFunc("/event", analyticsController.EventTriggered).Methods(http.MethodPost)
 	analyticsRouter.HandleFunc("/page", analyticsController.PageEventTriggered).Methods(http.MethodPost)
 
+	if statusPage != nil {
+		router.Handle("/api/v0/status", server.ipRateLimiter.Limit(statusPage)).Methods(http.MethodGet)
+	}
+
+	if server.config.MailWebhookSecret != "" {
+		mailWebhookController := consoleapi.NewMailWebhook(logger, mailService, server.config.MailWebhookSecret)
+		router.HandleFunc("/api/v0/mail/webhook", mailWebhookController.ReportDeliveries).Methods(http.MethodPost)
+	}
+
+	if stripeService != nil && stripeService.WebhookEnabled() {
+		stripeWebhookController := consoleapi.NewStripeWebhook(logger, stripeService)
+		router.HandleFunc("/api/v0/payments/stripe/webhook", stripeWebhookController.HandleEvent).Methods(http.MethodPost)
+	}
+
 	if server.config.StaticDir != "" {
 		oidc := oidc.NewEndpoint(
 			server.nodeURL, server.config.ExternalAddress,
@@ -887,6 +947,13 @@ func (w *responseWriterStatusCode) WriteHeader(code int) {
 	w.ResponseWriter.WriteHeader(code)
 }
 
+// Flush implements http.Flusher, which the streamed responses need.
+func (w *responseWriterStatusCode) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
 // newTraceRequestMiddleware returns middleware for tracing each request to a
 // registered endpoint through Monkit.
 //
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/eventing"
 )
 
 // DB contains access to different satellite databases.
@@ -31,6 +32,16 @@ type DB interface {
 	WebappSessions() consoleauth.WebappSessions
 	// AccountFreezeEvents is a getter for AccountFreezeEvents repository.
 	AccountFreezeEvents() AccountFreezeEvents
+	// BucketInventories is a getter for BucketInventories repository.
+	BucketInventories() BucketInventories
+	// GatewayCredentials is a getter for GatewayCredentials repository.
+	GatewayCredentials() GatewayCredentials
+	// BucketNotifications is a getter for the repository of the bucket event configurations.
+	BucketNotifications() eventing.DB
+	// BillingAddresses is a getter for BillingAddresses repository.
+	BillingAddresses() BillingAddresses
+	// Notifications is a getter for Notifications repository.
+	Notifications() Notifications
 
 	// WithTx is a method for executing transactions with retrying as necessary.
 	WithTx(ctx context.Context, fn func(ctx context.Context, tx DBTx) error) error
@@ -0,0 +1,177 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+)
+
+var (
+	// ErrGatewayCredentialNotFound is returned when the gateway credential doesn't exist or
+	// it's already revoked.
+	ErrGatewayCredentialNotFound = errs.Class("gateway credential not found")
+	// ErrGatewayCredentialsDisabled is returned when the gateway credentials can't be managed
+	// in the satellite UI.
+	ErrGatewayCredentialsDisabled = errs.Class("gateway credentials disabled")
+)
+
+// GatewayCredentials exposes methods to manage the gateway credentials table in database.
+//
+// architecture: Database
+type GatewayCredentials interface {
+	// Create stores a new gateway credential.
+	Create(ctx context.Context, credential GatewayCredential) error
+	// Get returns the gateway credential, which isn't revoked.
+	Get(ctx context.Context, id uuid.UUID) (*GatewayCredential, error)
+	// ListActive returns the gateway credentials of the project, which aren't revoked, the newest first.
+	ListActive(ctx context.Context, projectID uuid.UUID) ([]GatewayCredential, error)
+	// Revoke marks the gateway credential as revoked.
+	Revoke(ctx context.Context, id uuid.UUID, revokedAt time.Time) error
+	// UpdateLastUsed updates the time the gateway credentials with the macaroon tails were last used.
+	UpdateLastUsed(ctx context.Context, tails [][]byte, usedAt time.Time) error
+}
+
+// GatewayCredentialsConfig contains the configuration of the gateway credentials, which
+// the users create in the satellite UI.
+type GatewayCredentialsConfig struct {
+	Enabled        bool          `help:"whether the users can create and revoke the gateway credentials in the satellite UI" default:"false"`
+	MaxPerProject  int           `help:"the maximum number of active gateway credentials of a project" default:"100"`
+	RequestTimeout time.Duration `help:"timeout of the requests to the auth service" default:"10s"`
+	UsageInterval  time.Duration `help:"how often the last use of the gateway credentials is recorded" default:"5m"`
+}
+
+// GatewayCredential is an S3 compatible credential pair, which the auth service issued for an
+// access grant of a project. The secret key is returned only when it's created.
+type GatewayCredential struct {
+	ID          uuid.UUID  `json:"id"`
+	ProjectID   uuid.UUID  `json:"projectID"`
+	APIKeyID    uuid.UUID  `json:"apiKeyID"`
+	Name        string     `json:"name"`
+	AccessKeyID string     `json:"accessKeyID"`
+	Endpoint    string     `json:"endpoint"`
+	Tail        []byte     `json:"-"`
+	CreatedBy   uuid.UUID  `json:"createdBy"`
+	LastUsedAt  *time.Time `json:"lastUsedAt"`
+	RevokedAt   *time.Time `json:"-"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+// CreateGatewayCredentialRequest holds the data needed to create a gateway credential.
+type CreateGatewayCredentialRequest struct {
+	Name string `json:"name"`
+	// AccessGrant is the serialized access grant of the project, which the credential is bound to.
+	AccessGrant string `json:"accessGrant"`
+}
+
+// CreateGatewayCredentialResponse holds the created gateway credential with its secret key.
+type CreateGatewayCredentialResponse struct {
+	Credential GatewayCredential `json:"credential"`
+	SecretKey  string            `json:"secretKey"`
+}
+
+// AuthServiceCredentials are the credentials issued by the auth service for an access grant.
+type AuthServiceCredentials struct {
+	AccessKeyID string
+	SecretKey   string
+	Endpoint    string
+}
+
+// AuthService registers the access grants with the auth service of the gateways.
+type AuthService interface {
+	// Register registers the access grant and returns the credentials of the gateways.
+	Register(ctx context.Context, accessGrant string) (AuthServiceCredentials, error)
+}
+
+// maxObservedTails is the maximum number of the macaroon tails, whose use is recorded at once.
+const maxObservedTails = 10000
+
+// GatewayCredentialUsage records approximately when the access grants of the gateway
+// credentials were last used. The tails of the used macaroons are collected in memory and
+// periodically written to the database.
+//
+// architecture: Chore
+type GatewayCredentialUsage struct {
+	log *zap.Logger
+	db  GatewayCredentials
+
+	mu    sync.Mutex
+	tails map[string]struct{}
+
+	Loop *sync2.Cycle
+}
+
+// NewGatewayCredentialUsage creates a new recorder of the use of the gateway credentials.
+func NewGatewayCredentialUsage(log *zap.Logger, db GatewayCredentials, config GatewayCredentialsConfig) *GatewayCredentialUsage {
+	return &GatewayCredentialUsage{
+		log:   log,
+		db:    db,
+		tails: map[string]struct{}{},
+		Loop:  sync2.NewCycle(config.UsageInterval),
+	}
+}
+
+// Observe records the use of the macaroon with the tail.
+func (usage *GatewayCredentialUsage) Observe(tail []byte) {
+	if usage == nil {
+		return
+	}
+
+	usage.mu.Lock()
+	defer usage.mu.Unlock()
+	if len(usage.tails) >= maxObservedTails {
+		return
+	}
+	usage.tails[string(tail)] = struct{}{}
+}
+
+// Run periodically writes the observed uses to the database.
+func (usage *GatewayCredentialUsage) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return usage.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := usage.Flush(ctx, time.Now()); err != nil {
+			usage.log.Error("failed to record the use of the gateway credentials", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Flush writes the uses observed since the previous flush to the database.
+func (usage *GatewayCredentialUsage) Flush(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	usage.mu.Lock()
+	observed := usage.tails
+	usage.tails = map[string]struct{}{}
+	usage.mu.Unlock()
+
+	if len(observed) == 0 {
+		return nil
+	}
+
+	tails := make([][]byte, 0, len(observed))
+	for tail := range observed {
+		tails = append(tails, []byte(tail))
+	}
+	// sort for a deterministic order of the updated rows.
+	sort.Slice(tails, func(i, k int) bool { return bytes.Compare(tails[i], tails[k]) < 0 })
+
+	return Error.Wrap(usage.db.UpdateLastUsed(ctx, tails, now))
+}
+
+// Close stops the recorder.
+func (usage *GatewayCredentialUsage) Close() error {
+	usage.Loop.Close()
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/console"
+)
+
+func TestGatewayCredentialUsage(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	db := &gatewayCredentialsDB{}
+	usage := console.NewGatewayCredentialUsage(zaptest.NewLogger(t), db, console.GatewayCredentialsConfig{UsageInterval: time.Hour})
+	defer ctx.Check(usage.Close)
+
+	now := time.Now()
+	require.NoError(t, usage.Flush(ctx, now))
+	require.Empty(t, db.updates, "nothing was used")
+
+	usage.Observe([]byte("b"))
+	usage.Observe([]byte("a"))
+	usage.Observe([]byte("b"))
+	require.NoError(t, usage.Flush(ctx, now))
+	require.Equal(t, [][][]byte{{[]byte("a"), []byte("b")}}, db.updates)
+
+	require.NoError(t, usage.Flush(ctx, now))
+	require.Len(t, db.updates, 1, "the uses are flushed only once")
+
+	var disabled *console.GatewayCredentialUsage
+	disabled.Observe([]byte("a"))
+}
+
+type gatewayCredentialsDB struct {
+	console.GatewayCredentials
+	updates [][][]byte
+}
+
+func (db *gatewayCredentialsDB) UpdateLastUsed(ctx context.Context, tails [][]byte, usedAt time.Time) error {
+	db.updates = append(db.updates, tails)
+	return nil
+}
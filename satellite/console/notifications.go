@@ -0,0 +1,317 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+)
+
+var (
+	// ErrNotificationNotFound is returned when the notification doesn't exist.
+	ErrNotificationNotFound = errs.Class("notification not found")
+	// ErrNotificationsDisabled is returned when the in-app notifications are disabled.
+	ErrNotificationsDisabled = errs.Class("notifications disabled")
+)
+
+// NotificationKind is the kind of a notification, which the UI uses to choose its icon
+// and its action.
+type NotificationKind string
+
+const (
+	// NotificationAccountFrozen is sent when the account is frozen.
+	NotificationAccountFrozen NotificationKind = "account_frozen"
+	// NotificationAccountWarned is sent when the account is warned before it's frozen.
+	NotificationAccountWarned NotificationKind = "account_warned"
+	// NotificationAccountUnfrozen is sent when the account is unfrozen.
+	NotificationAccountUnfrozen NotificationKind = "account_unfrozen"
+	// NotificationLimitExceeded is sent to the owner of a project, which exceeded one of its limits.
+	NotificationLimitExceeded NotificationKind = "limit_exceeded"
+	// NotificationProjectMemberAdded is sent to the users added to a project.
+	NotificationProjectMemberAdded NotificationKind = "project_member_added"
+	// NotificationMaintenance is an announcement of the satellite operator, e.g. a planned maintenance.
+	NotificationMaintenance NotificationKind = "maintenance"
+)
+
+// Notifications exposes methods to manage the in-app notifications of the users in database.
+//
+// architecture: Database
+type Notifications interface {
+	// Insert stores a new notification of the user.
+	Insert(ctx context.Context, notification Notification) error
+	// InsertForActiveUsers stores the notification for all the active users, and returns the
+	// number of the notified users. The user ID of the notification is ignored.
+	InsertForActiveUsers(ctx context.Context, notification Notification) (int64, error)
+	// List returns the notifications of the user, the newest first.
+	List(ctx context.Context, userID uuid.UUID, limit int, unreadOnly bool) ([]Notification, error)
+	// ListAfter returns the notifications of the user created after the time, the oldest first.
+	ListAfter(ctx context.Context, userID uuid.UUID, after time.Time, limit int) ([]Notification, error)
+	// CountUnread returns the number of the unread notifications of the user.
+	CountUnread(ctx context.Context, userID uuid.UUID) (int, error)
+	// MarkRead marks the notification of the user as read.
+	MarkRead(ctx context.Context, userID, id uuid.UUID, readAt time.Time) error
+	// MarkAllRead marks all the notifications of the user as read.
+	MarkAllRead(ctx context.Context, userID uuid.UUID, readAt time.Time) error
+}
+
+// NotificationsConfig contains the configuration of the in-app notifications.
+type NotificationsConfig struct {
+	Enabled                   bool          `help:"whether the in-app notifications are created and shown in the satellite UI" default:"false"`
+	ListLimit                 int           `help:"the maximum number of the notifications returned at once" default:"50"`
+	StreamPollInterval        time.Duration `help:"how often the notification streams check for the notifications created by the other satellite processes" default:"30s" testDefault:"1s"`
+	LimitNotificationInterval time.Duration `help:"the minimum time between two notifications about the same exceeded project limit" default:"24h"`
+}
+
+// Notification is an in-app notification of a user.
+type Notification struct {
+	ID        uuid.UUID        `json:"id"`
+	UserID    uuid.UUID        `json:"-"`
+	Kind      NotificationKind `json:"kind"`
+	Title     string           `json:"title"`
+	Message   string           `json:"message"`
+	ReadAt    *time.Time       `json:"readAt"`
+	CreatedAt time.Time        `json:"createdAt"`
+}
+
+// NotificationList holds the recent notifications of a user and the number of the unread ones.
+type NotificationList struct {
+	Notifications []Notification `json:"notifications"`
+	Unread        int            `json:"unread"`
+}
+
+// NotificationSubscription waits for the new notifications of a user.
+type NotificationSubscription struct {
+	db          Notifications
+	userID      uuid.UUID
+	limit       int
+	wake        <-chan struct{}
+	unsubscribe func()
+	ticker      *time.Ticker
+}
+
+// Next returns the notifications created after the time, the oldest first. When there are
+// none, it waits until a notification is created, or until the stream poll interval
+// passes, and returns an empty list in that case.
+func (subscription *NotificationSubscription) Next(ctx context.Context, after time.Time) (_ []Notification, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	notifications, err := subscription.db.ListAfter(ctx, subscription.userID, after, subscription.limit)
+	if err != nil || len(notifications) > 0 {
+		return notifications, Error.Wrap(err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-subscription.ticker.C:
+	case <-subscription.wake:
+	}
+
+	notifications, err = subscription.db.ListAfter(ctx, subscription.userID, after, subscription.limit)
+	return notifications, Error.Wrap(err)
+}
+
+// Close stops the subscription.
+func (subscription *NotificationSubscription) Close() {
+	subscription.ticker.Stop()
+	subscription.unsubscribe()
+}
+
+// maxTrackedLimitNotifications is the maximum number of the exceeded project limits, whose
+// last notification is remembered.
+const maxTrackedLimitNotifications = 10000
+
+type limitNotificationKey struct {
+	projectID uuid.UUID
+	limit     string
+}
+
+// Notifier creates the in-app notifications and wakes up the notification streams of the
+// users. The streams only get woken up by the notifications created in the same process,
+// the other ones are picked up when the streams poll the database.
+//
+// A nil Notifier doesn't notify anyone.
+type Notifier struct {
+	log      *zap.Logger
+	db       Notifications
+	projects Projects
+	config   NotificationsConfig
+
+	mu             sync.Mutex
+	subscribers    map[uuid.UUID]map[chan struct{}]struct{}
+	limitsNotified map[limitNotificationKey]time.Time
+}
+
+// NewNotifier creates a new notifier.
+func NewNotifier(log *zap.Logger, db Notifications, projects Projects, config NotificationsConfig) *Notifier {
+	return &Notifier{
+		log:            log,
+		db:             db,
+		projects:       projects,
+		config:         config,
+		subscribers:    map[uuid.UUID]map[chan struct{}]struct{}{},
+		limitsNotified: map[limitNotificationKey]time.Time{},
+	}
+}
+
+// Config returns the configuration of the notifications.
+func (notifier *Notifier) Config() NotificationsConfig {
+	return notifier.config
+}
+
+// Notify creates a notification of the user. The notifications are best effort, so a
+// failure is only logged.
+func (notifier *Notifier) Notify(ctx context.Context, userID uuid.UUID, kind NotificationKind, title, message string) {
+	if notifier == nil {
+		return
+	}
+
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.New()
+	if err != nil {
+		notifier.log.Error("failed to create notification", zap.Stringer("User ID", userID), zap.Error(err))
+		return
+	}
+
+	err = notifier.db.Insert(ctx, Notification{
+		ID:        id,
+		UserID:    userID,
+		Kind:      kind,
+		Title:     title,
+		Message:   message,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		notifier.log.Error("failed to create notification", zap.Stringer("User ID", userID), zap.Error(err))
+		return
+	}
+
+	notifier.wake(userID)
+}
+
+// Announce creates the notification for all the active users, and returns the number of
+// the notified users.
+func (notifier *Notifier) Announce(ctx context.Context, kind NotificationKind, title, message string) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.New()
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	count, err := notifier.db.InsertForActiveUsers(ctx, Notification{
+		ID:        id,
+		Kind:      kind,
+		Title:     title,
+		Message:   message,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	notifier.wakeAll()
+	return count, nil
+}
+
+// NotifyLimitExceeded notifies the owner of the project, which exceeded the limit. The owner
+// is notified about the same limit at most once per the limit notification interval.
+func (notifier *Notifier) NotifyLimitExceeded(ctx context.Context, projectID uuid.UUID, limit string) {
+	if notifier == nil {
+		return
+	}
+
+	now := time.Now()
+	key := limitNotificationKey{projectID: projectID, limit: limit}
+
+	notifier.mu.Lock()
+	if last, ok := notifier.limitsNotified[key]; ok && now.Sub(last) < notifier.config.LimitNotificationInterval {
+		notifier.mu.Unlock()
+		return
+	}
+	if len(notifier.limitsNotified) >= maxTrackedLimitNotifications {
+		for tracked, last := range notifier.limitsNotified {
+			if now.Sub(last) >= notifier.config.LimitNotificationInterval {
+				delete(notifier.limitsNotified, tracked)
+			}
+		}
+	}
+	notifier.limitsNotified[key] = now
+	notifier.mu.Unlock()
+
+	project, err := notifier.projects.Get(ctx, projectID)
+	if err != nil {
+		notifier.log.Error("failed to get project of exceeded limit", zap.Stringer("Project ID", projectID), zap.Error(err))
+		return
+	}
+
+	notifier.Notify(ctx, project.OwnerID, NotificationLimitExceeded,
+		"Project "+limit+" limit exceeded",
+		"Project "+project.Name+" reached its "+limit+" limit. Requests exceeding the limit are rejected until the usage drops or the limit is increased.")
+}
+
+// Subscribe returns a channel, which is signaled when a notification of the user is created.
+// The unsubscribe function must be called when the channel is no longer used.
+func (notifier *Notifier) Subscribe(userID uuid.UUID) (_ <-chan struct{}, unsubscribe func()) {
+	wake := make(chan struct{}, 1)
+
+	notifier.mu.Lock()
+	defer notifier.mu.Unlock()
+
+	subscribers, ok := notifier.subscribers[userID]
+	if !ok {
+		subscribers = map[chan struct{}]struct{}{}
+		notifier.subscribers[userID] = subscribers
+	}
+	subscribers[wake] = struct{}{}
+
+	return wake, func() {
+		notifier.mu.Lock()
+		defer notifier.mu.Unlock()
+
+		delete(subscribers, wake)
+		if len(subscribers) == 0 {
+			delete(notifier.subscribers, userID)
+		}
+	}
+}
+
+// wake signals the subscribers of the user.
+func (notifier *Notifier) wake(userID uuid.UUID) {
+	notifier.mu.Lock()
+	defer notifier.mu.Unlock()
+
+	for wake := range notifier.subscribers[userID] {
+		wakeSubscriber(wake)
+	}
+}
+
+// wakeAll signals all the subscribers.
+func (notifier *Notifier) wakeAll() {
+	notifier.mu.Lock()
+	defer notifier.mu.Unlock()
+
+	for _, subscribers := range notifier.subscribers {
+		for wake := range subscribers {
+			wakeSubscriber(wake)
+		}
+	}
+}
+
+// wakeSubscriber signals the channel without blocking, the pending signal is enough to wake up
+// the subscriber.
+func wakeSubscriber(wake chan struct{}) {
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+)
+
+// ErrPlacementNotEntitled is returned when a project requests a placement it is not entitled to.
+var ErrPlacementNotEntitled = errs.Class("placement not entitled")
+
+// PlacementEntitlement describes a placement that a project may select for new buckets.
+type PlacementEntitlement struct {
+	Placement storj.PlacementConstraint `json:"placement"`
+	Default   bool                      `json:"default"`
+	CreatedAt time.Time                 `json:"createdAt"`
+}
+
+// SelectBucketPlacement returns the placement a new bucket should use.
+//
+// When requested is nil the project's default entitlement is used, falling back to
+// storj.EveryCountry. storj.EveryCountry is always allowed, any other placement must
+// be among the project's entitlements.
+func SelectBucketPlacement(entitlements []PlacementEntitlement, requested *storj.PlacementConstraint) (storj.PlacementConstraint, error) {
+	if requested == nil {
+		for _, entitlement := range entitlements {
+			if entitlement.Default {
+				return entitlement.Placement, nil
+			}
+		}
+		return storj.EveryCountry, nil
+	}
+
+	if *requested == storj.EveryCountry {
+		return storj.EveryCountry, nil
+	}
+
+	for _, entitlement := range entitlements {
+		if entitlement.Placement == *requested {
+			return *requested, nil
+		}
+	}
+
+	return storj.EveryCountry, ErrPlacementNotEntitled.New("project is not entitled to placement %d", *requested)
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestSelectBucketPlacement(t *testing.T) {
+	placement := func(p storj.PlacementConstraint) *storj.PlacementConstraint { return &p }
+
+	// projects without entitlements can only use the default placement.
+	selected, err := console.SelectBucketPlacement(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, storj.EveryCountry, selected)
+
+	_, err = console.SelectBucketPlacement(nil, placement(storj.EU))
+	require.True(t, console.ErrPlacementNotEntitled.Has(err))
+
+	entitlements := []console.PlacementEntitlement{
+		{Placement: storj.EU, Default: true},
+		{Placement: storj.US},
+	}
+
+	selected, err = console.SelectBucketPlacement(entitlements, nil)
+	require.NoError(t, err)
+	require.Equal(t, storj.EU, selected)
+
+	selected, err = console.SelectBucketPlacement(entitlements, placement(storj.US))
+	require.NoError(t, err)
+	require.Equal(t, storj.US, selected)
+
+	selected, err = console.SelectBucketPlacement(entitlements, placement(storj.EveryCountry))
+	require.NoError(t, err)
+	require.Equal(t, storj.EveryCountry, selected)
+
+	_, err = console.SelectBucketPlacement(entitlements, placement(storj.DE))
+	require.True(t, console.ErrPlacementNotEntitled.Has(err))
+}
+
+func TestPlacementEntitlements(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		projects := db.Console().Projects()
+
+		project, err := projects.Insert(ctx, &console.Project{Name: "entitled"})
+		require.NoError(t, err)
+
+		entitlements, err := projects.GetPlacementEntitlements(ctx, project.ID)
+		require.NoError(t, err)
+		require.Empty(t, entitlements)
+
+		require.NoError(t, projects.EntitlePlacement(ctx, project.ID, storj.EU, true))
+		require.NoError(t, projects.EntitlePlacement(ctx, project.ID, storj.US, false))
+
+		entitlements, err = projects.GetPlacementEntitlements(ctx, project.ID)
+		require.NoError(t, err)
+		require.Len(t, entitlements, 2)
+		require.Equal(t, storj.EU, entitlements[0].Placement)
+		require.True(t, entitlements[0].Default)
+		require.Equal(t, storj.US, entitlements[1].Placement)
+		require.False(t, entitlements[1].Default)
+
+		// making another placement the default one resets the previous default.
+		require.NoError(t, projects.EntitlePlacement(ctx, project.ID, storj.US, true))
+
+		entitlements, err = projects.GetPlacementEntitlements(ctx, project.ID)
+		require.NoError(t, err)
+		require.Len(t, entitlements, 2)
+		require.False(t, entitlements[0].Default)
+		require.True(t, entitlements[1].Default)
+
+		selected, err := console.SelectBucketPlacement(entitlements, nil)
+		require.NoError(t, err)
+		require.Equal(t, storj.US, selected)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"storj.io/common/memory"
+	"storj.io/common/storj"
 	"storj.io/common/uuid"
 )
 
@@ -51,9 +52,17 @@ type Projects interface {
 	GetMaxBuckets(ctx context.Context, id uuid.UUID) (*int, error)
 	// UpdateBucketLimit is a method for updating projects bucket limit.
 	UpdateBucketLimit(ctx context.Context, id uuid.UUID, newLimit int) error
+	// UpdateOwner is a method for transferring the project to another owner.
+	UpdateOwner(ctx context.Context, id uuid.UUID, ownerID uuid.UUID) error
 
 	// UpdateUsageLimits is a method for updating project's usage limits.
 	UpdateUsageLimits(ctx context.Context, id uuid.UUID, limits UsageLimits) error
+
+	// GetPlacementEntitlements returns the placements the project may select for new buckets.
+	GetPlacementEntitlements(ctx context.Context, id uuid.UUID) ([]PlacementEntitlement, error)
+	// EntitlePlacement allows the project to select the placement for new buckets.
+	// When isDefault is set, the placement is used for buckets created without an explicit placement.
+	EntitlePlacement(ctx context.Context, id uuid.UUID, placement storj.PlacementConstraint, isDefault bool) error
 }
 
 // UsageLimitsConfig is a configuration struct for default per-project usage limits.
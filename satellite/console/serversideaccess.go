@@ -0,0 +1,105 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/grant"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+)
+
+// ErrServerSideAccessDisabled is returned when the satellite doesn't create the access grants
+// of the project, because it's not configured or the project didn't opt in.
+var ErrServerSideAccessDisabled = errs.Class("server-side access grants disabled")
+
+// rootKeyDerivationThreads is the number of threads used to derive the root key from the
+// passphrase. It must match the uplink, so that the derived key is the same.
+const rootKeyDerivationThreads = 8
+
+// ServerSideAccessConfig contains the configuration of the access grants, which the satellite
+// creates from the root keys of the projects that opted in.
+type ServerSideAccessConfig struct {
+	Enabled bool `help:"whether the projects can keep their root key on the satellite, so that the access grants can be created in the satellite UI without uplink" default:"false"`
+}
+
+// ProjectRootKeys keeps the root encryption keys of the projects, which opted into the
+// access grants created by the satellite.
+type ProjectRootKeys interface {
+	// StoreProjectRootKey stores the root key of the project.
+	StoreProjectRootKey(ctx context.Context, projectID, createdBy uuid.UUID, rootKey *storj.Key) error
+	// ProjectRootKey returns the root key of the project.
+	ProjectRootKey(ctx context.Context, projectID uuid.UUID) (*storj.Key, error)
+	// DeleteProjectRootKey removes the root key of the project.
+	DeleteProjectRootKey(ctx context.Context, projectID uuid.UUID) error
+}
+
+// EnableServerSideAccessRequest holds the data needed to opt a project into the access grants
+// created by the satellite.
+type EnableServerSideAccessRequest struct {
+	// Passphrase is the encryption passphrase of the project. Only the root key derived from
+	// it is stored.
+	Passphrase string `json:"passphrase"`
+}
+
+// CreateServerSideAccessGrantRequest holds the data needed to create an access grant on the satellite.
+type CreateServerSideAccessGrantRequest struct {
+	Name          string `json:"name"`
+	AllowDownload bool   `json:"allowDownload"`
+	AllowUpload   bool   `json:"allowUpload"`
+	AllowList     bool   `json:"allowList"`
+	AllowDelete   bool   `json:"allowDelete"`
+	// Prefixes restrict the access grant to the buckets or the object key prefixes within
+	// them, given as "bucket" or "bucket/prefix". The access grant isn't restricted when
+	// there are none.
+	Prefixes  []string   `json:"prefixes"`
+	NotBefore *time.Time `json:"notBefore"`
+	NotAfter  *time.Time `json:"notAfter"`
+}
+
+// CreateServerSideAccessGrantResponse holds the created access grant.
+type CreateServerSideAccessGrantResponse struct {
+	APIKeyID    uuid.UUID `json:"apiKeyID"`
+	Name        string    `json:"name"`
+	AccessGrant string    `json:"accessGrant"`
+}
+
+// permission returns the permission of the requested access grant.
+func (request CreateServerSideAccessGrantRequest) permission() grant.Permission {
+	permission := grant.Permission{
+		AllowDownload: request.AllowDownload,
+		AllowUpload:   request.AllowUpload,
+		AllowList:     request.AllowList,
+		AllowDelete:   request.AllowDelete,
+	}
+	if request.NotBefore != nil {
+		permission.NotBefore = *request.NotBefore
+	}
+	if request.NotAfter != nil {
+		permission.NotAfter = *request.NotAfter
+	}
+	return permission
+}
+
+// sharePrefixes returns the prefixes the requested access grant is restricted to.
+func (request CreateServerSideAccessGrantRequest) sharePrefixes() ([]grant.SharePrefix, error) {
+	prefixes := make([]grant.SharePrefix, 0, len(request.Prefixes))
+	for _, path := range request.Prefixes {
+		parts := strings.SplitN(path, "/", 2)
+		if parts[0] == "" {
+			return nil, ErrValidation.New("prefix %q has no bucket", path)
+		}
+		prefix := grant.SharePrefix{Bucket: parts[0]}
+		if len(parts) > 1 {
+			prefix.Prefix = parts[1]
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	return prefixes, nil
+}
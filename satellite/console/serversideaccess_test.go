@@ -0,0 +1,125 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/encryption"
+	"storj.io/common/grant"
+	"storj.io/common/paths"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+)
+
+func TestServerSideAccessGrants(t *testing.T) {
+	masterKeyPath := filepath.Join(t.TempDir(), "master.key")
+	require.NoError(t, os.WriteFile(masterKeyPath, []byte(hex.EncodeToString(testrand.BytesInt(32))), 0600))
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 2,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.KMS.Enabled = true
+				config.KMS.MasterKeyPath = masterKeyPath
+				config.Console.ServerSideAccess.Enabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+
+		project, err := sat.API.DB.Console().Projects().Get(ctx, planet.Uplinks[0].Projects[0].ID)
+		require.NoError(t, err)
+		ownerCtx, err := sat.UserContext(ctx, project.OwnerID)
+		require.NoError(t, err)
+		otherCtx, err := sat.UserContext(ctx, planet.Uplinks[1].Projects[0].Owner.ID)
+		require.NoError(t, err)
+
+		enabled, err := service.GetServerSideAccess(ownerCtx, project.ID)
+		require.NoError(t, err)
+		require.False(t, enabled)
+
+		request := console.CreateServerSideAccessGrantRequest{
+			Name:          "web access",
+			AllowDownload: true,
+			AllowList:     true,
+			Prefixes:      []string{"bucket/prefix/"},
+		}
+		_, err = service.CreateServerSideAccessGrant(ownerCtx, project.ID, request)
+		require.True(t, console.ErrServerSideAccessDisabled.Has(err))
+
+		// only the owner can opt the project in.
+		err = service.EnableServerSideAccess(otherCtx, project.ID, console.EnableServerSideAccessRequest{Passphrase: "passphrase"})
+		require.True(t, console.ErrUnauthorized.Has(err))
+
+		err = service.EnableServerSideAccess(ownerCtx, project.ID, console.EnableServerSideAccessRequest{})
+		require.True(t, console.ErrValidation.Has(err))
+
+		require.NoError(t, service.EnableServerSideAccess(ownerCtx, project.ID, console.EnableServerSideAccessRequest{Passphrase: "passphrase"}))
+
+		enabled, err = service.GetServerSideAccess(ownerCtx, project.ID)
+		require.NoError(t, err)
+		require.True(t, enabled)
+
+		_, err = service.CreateServerSideAccessGrant(ownerCtx, project.ID, console.CreateServerSideAccessGrantRequest{Name: "no permissions"})
+		require.True(t, console.ErrValidation.Has(err))
+
+		_, err = service.CreateServerSideAccessGrant(ownerCtx, project.ID, console.CreateServerSideAccessGrantRequest{
+			Name: "no bucket", AllowList: true, Prefixes: []string{"/prefix"},
+		})
+		require.True(t, console.ErrValidation.Has(err))
+
+		notAfter := time.Now().Add(time.Hour)
+		request.NotAfter = &notAfter
+		response, err := service.CreateServerSideAccessGrant(ownerCtx, project.ID, request)
+		require.NoError(t, err)
+		require.Equal(t, request.Name, response.Name)
+
+		keyInfo, err := sat.API.DB.Console().APIKeys().Get(ctx, response.APIKeyID)
+		require.NoError(t, err)
+		require.Equal(t, project.ID, keyInfo.ProjectID)
+
+		_, err = service.CreateServerSideAccessGrant(ownerCtx, project.ID, request)
+		require.True(t, console.ErrValidation.Has(err), "the name of the api key must be unique")
+
+		access, err := grant.ParseAccess(response.AccessGrant)
+		require.NoError(t, err)
+		require.Equal(t, sat.NodeURL().String(), access.SatelliteAddress)
+		require.Nil(t, access.EncAccess.Store.GetDefaultKey(), "the root key must not be shared")
+
+		// the keys of the shared prefix match the ones derived from the passphrase by uplink.
+		salt, err := sat.API.DB.Console().Projects().GetSalt(ctx, project.ID)
+		require.NoError(t, err)
+		rootKey, err := encryption.DeriveRootKey([]byte("passphrase"), salt, "", 8)
+		require.NoError(t, err)
+
+		objectKey := paths.NewUnencrypted("prefix/object")
+		expected, err := encryption.DerivePathKey("bucket", objectKey, grant.NewEncryptionAccessWithDefaultKey(rootKey).Store)
+		require.NoError(t, err)
+		derived, err := encryption.DerivePathKey("bucket", objectKey, access.EncAccess.Store)
+		require.NoError(t, err)
+		require.Equal(t, expected, derived)
+
+		require.NoError(t, service.DisableServerSideAccess(ownerCtx, project.ID))
+
+		enabled, err = service.GetServerSideAccess(ownerCtx, project.ID)
+		require.NoError(t, err)
+		require.False(t, enabled)
+
+		request.Name = "after disabling"
+		_, err = service.CreateServerSideAccessGrant(ownerCtx, project.ID, request)
+		require.True(t, console.ErrServerSideAccessDisabled.Has(err))
+	})
+}
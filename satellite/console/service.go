@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/mail"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -23,8 +24,11 @@ import (
 	"golang.org/x/crypto/bcrypt"
 
 	"storj.io/common/currency"
+	"storj.io/common/encryption"
+	"storj.io/common/grant"
 	"storj.io/common/macaroon"
 	"storj.io/common/memory"
+	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/private/cfgstruct"
 	"storj.io/storj/private/api"
@@ -32,11 +36,16 @@ import (
 	"storj.io/storj/private/post"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/analytics"
+	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/eventing"
+	"storj.io/storj/satellite/kms"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/billing"
+	"storj.io/storj/satellite/payments/tax"
+	"storj.io/storj/satellite/revocation"
 	"storj.io/storj/satellite/satellitedb/dbx"
 )
 
@@ -126,6 +135,9 @@ var (
 	// ErrPurchaseDesc is error that occurs when something is wrong with Purchase description.
 	ErrPurchaseDesc = errs.Class("purchase description")
 
+	// ErrBucketExists occurs when a bucket with the same name already exists in the project.
+	ErrBucketExists = errs.Class("bucket already exists")
+
 	// ErrAlreadyHasPackage is error that occurs when a user tries to update package, but already has one.
 	ErrAlreadyHasPackage = errs.Class("user already has package")
 )
@@ -140,6 +152,7 @@ type Service struct {
 	projectAccounting          accounting.ProjectAccounting
 	projectUsage               *accounting.Service
 	buckets                    buckets.DB
+	attributions               attribution.DB
 	accounts                   payments.Accounts
 	depositWallets             payments.DepositWallets
 	billing                    billing.TransactionsDB
@@ -148,6 +161,13 @@ type Service struct {
 	analytics                  *analytics.Service
 	tokens                     *consoleauth.Service
 	mailService                *mailservice.Service
+	authService                AuthService
+	revocations                revocation.DB
+	rootKeys                   ProjectRootKeys
+	taxes                      tax.Engine
+	notifier                   *Notifier
+
+	satelliteNodeURL string
 
 	satelliteAddress string
 
@@ -174,9 +194,13 @@ type Config struct {
 	AsOfSystemTimeDuration      time.Duration `help:"default duration for AS OF SYSTEM TIME" devDefault:"-5m" releaseDefault:"-5m" testDefault:"0"`
 	LoginAttemptsWithoutPenalty int           `help:"number of times user can try to login without penalty" default:"3"`
 	FailedLoginPenalty          float64       `help:"incremental duration of penalty for failed login attempts in minutes" default:"2.0"`
+	DefaultMaxBuckets           int           `internal:"true"`
 	UsageLimits                 UsageLimitsConfig
 	Captcha                     CaptchaConfig
 	Session                     SessionConfig
+	GatewayCredentials          GatewayCredentialsConfig
+	ServerSideAccess            ServerSideAccessConfig
+	Notifications               NotificationsConfig
 }
 
 // CaptchaConfig contains configurations for login/registration captcha system.
@@ -212,7 +236,7 @@ type Payments struct {
 }
 
 // NewService returns new instance of Service.
-func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting accounting.ProjectAccounting, projectUsage *accounting.Service, buckets buckets.DB, accounts payments.Accounts, depositWallets payments.DepositWallets, billing billing.TransactionsDB, analytics *analytics.Service, tokens *consoleauth.Service, mailService *mailservice.Service, satelliteAddress string, config Config) (*Service, error) {
+func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting accounting.ProjectAccounting, projectUsage *accounting.Service, buckets buckets.DB, attributions attribution.DB, accounts payments.Accounts, depositWallets payments.DepositWallets, billing billing.TransactionsDB, analytics *analytics.Service, tokens *consoleauth.Service, mailService *mailservice.Service, satelliteAddress string, config Config) (*Service, error) {
 	if store == nil {
 		return nil, errs.New("store can't be nil")
 	}
@@ -248,6 +272,7 @@ func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting
 		projectAccounting:          projectAccounting,
 		projectUsage:               projectUsage,
 		buckets:                    buckets,
+		attributions:               attributions,
 		accounts:                   accounts,
 		depositWallets:             depositWallets,
 		billing:                    billing,
@@ -282,7 +307,7 @@ func (s *Service) auditLog(ctx context.Context, operation string, userID *uuid.U
 	if email != "" {
 		fields = append(fields, zap.String("email", email))
 	}
-	fields = append(fields, fields...)
+	fields = append(fields, extra...)
 	s.auditLogger.Info("console activity", fields...)
 }
 
@@ -533,6 +558,27 @@ func (payment Payments) BillingHistory(ctx context.Context) (billingHistory []*B
 		)
 	}
 
+	adjustments, err := payment.service.accounts.ChargeAdjustments(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	for _, adjustment := range adjustments {
+		itemType := Refund
+		if adjustment.Type == string(billing.TransactionTypeChargeback) {
+			itemType = Chargeback
+		}
+
+		billingHistory = append(billingHistory, &BillingHistoryItem{
+			ID:          strconv.FormatInt(adjustment.ID, 10),
+			Description: adjustment.Description,
+			Amount:      adjustment.Amount,
+			Status:      adjustment.Status,
+			Start:       adjustment.CreatedAt,
+			Type:        itemType,
+		})
+	}
+
 	sort.SliceStable(billingHistory,
 		func(i, j int) bool {
 			return billingHistory[i].Start.After(billingHistory[j].Start)
@@ -1980,6 +2026,12 @@ func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, em
 
 	s.analytics.TrackProjectMemberAddition(user.ID, user.Email)
 
+	for _, member := range users {
+		s.notifier.Notify(ctx, member.ID, NotificationProjectMemberAdded,
+			"Added to project "+isMember.project.Name,
+			user.FullName+" added you to the project "+isMember.project.Name+".")
+	}
+
 	return users, nil
 }
 
@@ -2082,7 +2134,14 @@ func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name st
 		return nil, nil, Error.Wrap(err)
 	}
 
-	_, err = s.store.APIKeys().GetByNameAndProjectID(ctx, name, isMember.project.ID)
+	return s.createAPIKey(ctx, user, isMember.project.ID, name)
+}
+
+// createAPIKey creates a new api key of the project, which the user is a member of.
+func (s *Service) createAPIKey(ctx context.Context, user *User, projectID uuid.UUID, name string) (_ *APIKeyInfo, _ *macaroon.APIKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = s.store.APIKeys().GetByNameAndProjectID(ctx, name, projectID)
 	if err == nil {
 		return nil, nil, ErrValidation.New(apiKeyWithNameExistsErrMsg)
 	}
@@ -2099,7 +2158,7 @@ func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name st
 
 	apikey := APIKeyInfo{
 		Name:      name,
-		ProjectID: isMember.project.ID,
+		ProjectID: projectID,
 		Secret:    secret,
 		UserAgent: user.UserAgent,
 	}
@@ -2509,115 +2568,102 @@ func (s *Service) GetAllBucketNames(ctx context.Context, projectID uuid.UUID) (_
 	return list, nil
 }
 
-// GenGetBucketUsageRollups retrieves summed usage rollups for every bucket of particular project for a given period for generated api.
-func (s *Service) GenGetBucketUsageRollups(ctx context.Context, reqProjectID uuid.UUID, since, before time.Time) (rollups []accounting.BucketUsageRollup, httpError api.HTTPError) {
-	var err error
+// GetPlacementEntitlements returns the placements the project may select for new buckets.
+func (s *Service) GetPlacementEntitlements(ctx context.Context, projectID uuid.UUID) (_ []PlacementEntitlement, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	user, err := s.getUserAndAuditLog(ctx, "get bucket usage rollups", zap.String("projectID", reqProjectID.String()))
+	user, err := s.getUserAndAuditLog(ctx, "get placement entitlements", zap.String("projectID", projectID.String()))
 	if err != nil {
-		return nil, api.HTTPError{
-			Status: http.StatusUnauthorized,
-			Err:    Error.Wrap(err),
-		}
+		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, reqProjectID)
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
 	if err != nil {
-		return nil, api.HTTPError{
-			Status: http.StatusUnauthorized,
-			Err:    Error.Wrap(err),
-		}
+		return nil, Error.Wrap(err)
 	}
 
-	projectID := isMember.project.ID
-
-	rollups, err = s.projectAccounting.GetBucketUsageRollups(ctx, projectID, since, before)
+	entitlements, err := s.store.Projects().GetPlacementEntitlements(ctx, isMember.project.ID)
 	if err != nil {
-		return nil, api.HTTPError{
-			Status: http.StatusInternalServerError,
-			Err:    Error.Wrap(err),
-		}
-	}
-
-	// if project ID from request is public ID, replace rollup's project ID with public ID
-	if reqProjectID != projectID {
-		for i := range rollups {
-			rollups[i].ProjectID = reqProjectID
-		}
+		return nil, Error.Wrap(err)
 	}
 
-	return rollups, httpError
+	return entitlements, nil
 }
 
-// GenGetSingleBucketUsageRollup retrieves usage rollup for single bucket of particular project for a given period for generated api.
-func (s *Service) GenGetSingleBucketUsageRollup(ctx context.Context, reqProjectID uuid.UUID, bucket string, since, before time.Time) (rollup *accounting.BucketUsageRollup, httpError api.HTTPError) {
-	var err error
+// CreateBucket creates a new bucket in the project.
+// When placement is nil, the project's default placement is used.
+func (s *Service) CreateBucket(ctx context.Context, projectID uuid.UUID, name string, placement *storj.PlacementConstraint) (_ buckets.Bucket, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	user, err := s.getUserAndAuditLog(ctx, "get single bucket usage rollup", zap.String("projectID", reqProjectID.String()))
+	user, err := s.getUserAndAuditLog(ctx, "create bucket", zap.String("projectID", projectID.String()), zap.String("bucket", name))
 	if err != nil {
-		return nil, api.HTTPError{
-			Status: http.StatusUnauthorized,
-			Err:    Error.Wrap(err),
-		}
+		return buckets.Bucket{}, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, reqProjectID)
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
 	if err != nil {
-		return nil, api.HTTPError{
-			Status: http.StatusUnauthorized,
-			Err:    Error.Wrap(err),
-		}
+		return buckets.Bucket{}, Error.Wrap(err)
 	}
+	project := isMember.project
 
-	projectID := isMember.project.ID
+	if err := ValidateBucketName(name); err != nil {
+		return buckets.Bucket{}, ErrValidation.Wrap(err)
+	}
 
-	rollup, err = s.projectAccounting.GetSingleBucketUsageRollup(ctx, projectID, bucket, since, before)
+	entitlements, err := s.store.Projects().GetPlacementEntitlements(ctx, project.ID)
 	if err != nil {
-		return nil, api.HTTPError{
-			Status: http.StatusInternalServerError,
-			Err:    Error.Wrap(err),
-		}
+		return buckets.Bucket{}, Error.Wrap(err)
 	}
 
-	// make sure to replace rollup project ID with reqProjectID in case it is the public ID
-	rollup.ProjectID = reqProjectID
-
-	return rollup, httpError
-}
+	bucketPlacement, err := SelectBucketPlacement(entitlements, placement)
+	if err != nil {
+		return buckets.Bucket{}, ErrValidation.Wrap(err)
+	}
 
-// GetDailyProjectUsage returns daily usage by project ID.
-// ID here may be project.ID or project.PublicID.
-func (s *Service) GetDailyProjectUsage(ctx context.Context, projectID uuid.UUID, from, to time.Time) (_ *accounting.ProjectDailyUsage, err error) {
-	defer mon.Task()(&ctx)(&err)
+	exists, err := s.buckets.HasBucket(ctx, []byte(name), project.ID)
+	if err != nil {
+		return buckets.Bucket{}, Error.Wrap(err)
+	}
+	if exists {
+		return buckets.Bucket{}, ErrBucketExists.New("bucket %q already exists", name)
+	}
 
-	user, err := s.getUserAndAuditLog(ctx, "get daily usage by project ID")
+	maxBuckets := s.config.DefaultMaxBuckets
+	if project.MaxBuckets != nil {
+		maxBuckets = *project.MaxBuckets
+	}
+	bucketCount, err := s.buckets.CountBuckets(ctx, project.ID)
 	if err != nil {
-		return nil, Error.Wrap(err)
+		return buckets.Bucket{}, Error.Wrap(err)
+	}
+	if maxBuckets > 0 && bucketCount >= maxBuckets {
+		return buckets.Bucket{}, ErrUsage.New("number of allocated buckets (%d) exceeded", maxBuckets)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	bucketID, err := uuid.New()
 	if err != nil {
-		return nil, Error.Wrap(err)
+		return buckets.Bucket{}, Error.Wrap(err)
 	}
 
-	usage, err := s.projectAccounting.GetProjectDailyUsageByDateRange(ctx, isMember.project.ID, from, to, s.config.AsOfSystemTimeDuration)
+	bucket, err := s.buckets.CreateBucket(ctx, buckets.Bucket{
+		ID:        bucketID,
+		Name:      name,
+		ProjectID: project.ID,
+		UserAgent: project.UserAgent,
+		Placement: bucketPlacement,
+	})
 	if err != nil {
-		return nil, Error.Wrap(err)
+		return buckets.Bucket{}, Error.Wrap(err)
 	}
 
-	return usage, nil
+	return bucket, nil
 }
 
-// GetProjectUsageLimits returns project limits and current usage.
-//
-// Among others,it can return one of the following errors returned by
-// storj.io/storj/satellite/accounting.Service, wrapped Error.
-func (s *Service) GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID) (_ *ProjectUsageLimits, err error) {
+// GetBucketInventory returns the inventory configuration of the bucket.
+func (s *Service) GetBucketInventory(ctx context.Context, projectID uuid.UUID, bucketName string) (_ *BucketInventory, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	user, err := s.getUserAndAuditLog(ctx, "get project usage limits", zap.String("projectID", projectID.String()))
+	user, err := s.getUserAndAuditLog(ctx, "get bucket inventory", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -2627,184 +2673,984 @@ func (s *Service) GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID
 		return nil, Error.Wrap(err)
 	}
 
-	prUsageLimits, err := s.getProjectUsageLimits(ctx, isMember.project.ID)
-	if err != nil {
-		return nil, Error.Wrap(err)
-	}
-
-	prObjectsSegments, err := s.projectAccounting.GetProjectObjectsSegments(ctx, isMember.project.ID)
+	inventory, err := s.store.BucketInventories().Get(ctx, isMember.project.ID, bucketName)
 	if err != nil {
+		if ErrBucketInventoryNotFound.Has(err) {
+			return nil, err
+		}
 		return nil, Error.Wrap(err)
 	}
 
-	return &ProjectUsageLimits{
-		StorageLimit:   prUsageLimits.StorageLimit,
-		BandwidthLimit: prUsageLimits.BandwidthLimit,
-		StorageUsed:    prUsageLimits.StorageUsed,
-		BandwidthUsed:  prUsageLimits.BandwidthUsed,
-		ObjectCount:    prObjectsSegments.ObjectCount,
-		SegmentCount:   prObjectsSegments.SegmentCount,
-		SegmentLimit:   prUsageLimits.SegmentLimit,
-		SegmentUsed:    prUsageLimits.SegmentUsed,
-	}, nil
+	return inventory, nil
 }
 
-// GetTotalUsageLimits returns total limits and current usage for all the projects.
-func (s *Service) GetTotalUsageLimits(ctx context.Context) (_ *ProjectUsageLimits, err error) {
+// SetBucketInventory configures the scheduled inventory reports of the bucket.
+// The reports are uploaded with the access grant from the request, which must
+// belong to the same project.
+func (s *Service) SetBucketInventory(ctx context.Context, projectID uuid.UUID, bucketName string, request BucketInventoryRequest) (_ *BucketInventory, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	user, err := s.getUserAndAuditLog(ctx, "get total usage and limits for all the projects")
+	user, err := s.getUserAndAuditLog(ctx, "set bucket inventory", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
 
-	projects, err := s.store.Projects().GetOwn(ctx, user.ID)
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
+	project := isMember.project
+
+	if err := request.Validate(); err != nil {
+		return nil, ErrValidation.Wrap(err)
+	}
+	if request.DestinationBucket == bucketName {
+		return nil, ErrValidation.New("destination bucket must differ from the listed bucket")
+	}
 
-	var totalStorageLimit int64
-	var totalBandwidthLimit int64
-	var totalStorageUsed int64
-	var totalBandwidthUsed int64
+	access, err := grant.ParseAccess(request.DestinationAccess)
+	if err != nil {
+		return nil, ErrValidation.Wrap(err)
+	}
+	keyInfo, err := s.store.APIKeys().GetByHead(ctx, access.APIKey.Head())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrValidation.New("unknown destination access grant")
+		}
+		return nil, Error.Wrap(err)
+	}
+	if keyInfo.ProjectID != project.ID {
+		return nil, ErrValidation.New("destination access grant belongs to another project")
+	}
 
-	for _, pr := range projects {
-		prUsageLimits, err := s.getProjectUsageLimits(ctx, pr.ID)
+	for _, name := range []string{bucketName, request.DestinationBucket} {
+		exists, err := s.buckets.HasBucket(ctx, []byte(name), project.ID)
 		if err != nil {
 			return nil, Error.Wrap(err)
 		}
+		if !exists {
+			return nil, ErrValidation.New("bucket %q does not exist", name)
+		}
+	}
 
-		totalStorageLimit += prUsageLimits.StorageLimit
-		totalBandwidthLimit += prUsageLimits.BandwidthLimit
-		totalStorageUsed += prUsageLimits.StorageUsed
-		totalBandwidthUsed += prUsageLimits.BandwidthUsed
+	inventory := BucketInventory{
+		ProjectID:         project.ID,
+		BucketName:        bucketName,
+		DestinationBucket: request.DestinationBucket,
+		DestinationPrefix: request.DestinationPrefix,
+		Format:            request.Format,
+		Frequency:         request.Frequency,
+		DestinationAccess: request.DestinationAccess,
+		CreatedAt:         time.Now(),
+	}
+	if err := s.store.BucketInventories().Upsert(ctx, inventory); err != nil {
+		return nil, Error.Wrap(err)
 	}
 
-	return &ProjectUsageLimits{
-		StorageLimit:   totalStorageLimit,
-		BandwidthLimit: totalBandwidthLimit,
-		StorageUsed:    totalStorageUsed,
-		BandwidthUsed:  totalBandwidthUsed,
-	}, nil
+	return &inventory, nil
 }
 
-func (s *Service) getProjectUsageLimits(ctx context.Context, projectID uuid.UUID) (_ *ProjectUsageLimits, err error) {
+// DeleteBucketInventory stops the scheduled inventory reports of the bucket.
+func (s *Service) DeleteBucketInventory(ctx context.Context, projectID uuid.UUID, bucketName string) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	storageLimit, err := s.projectUsage.GetProjectStorageLimit(ctx, projectID)
-	if err != nil {
-		return nil, err
-	}
-	bandwidthLimit, err := s.projectUsage.GetProjectBandwidthLimit(ctx, projectID)
+	user, err := s.getUserAndAuditLog(ctx, "delete bucket inventory", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
 	if err != nil {
-		return nil, err
+		return Error.Wrap(err)
 	}
-	segmentLimit, err := s.projectUsage.GetProjectSegmentLimit(ctx, projectID)
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
 	if err != nil {
-		return nil, err
+		return Error.Wrap(err)
 	}
 
-	storageUsed, err := s.projectUsage.GetProjectStorageTotals(ctx, projectID)
+	return Error.Wrap(s.store.BucketInventories().Delete(ctx, isMember.project.ID, bucketName))
+}
+
+// GetBucketNotifications returns the event notification rules of the bucket.
+// The secrets of the targets aren't returned.
+func (s *Service) GetBucketNotifications(ctx context.Context, projectID uuid.UUID, bucketName string) (_ eventing.BucketConfig, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get bucket notifications", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
 	if err != nil {
-		return nil, err
+		return eventing.BucketConfig{}, Error.Wrap(err)
 	}
-	bandwidthUsed, err := s.projectUsage.GetProjectBandwidthTotals(ctx, projectID)
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
 	if err != nil {
-		return nil, err
+		return eventing.BucketConfig{}, Error.Wrap(err)
 	}
-	segmentUsed, err := s.projectUsage.GetProjectSegmentTotals(ctx, projectID)
+
+	config, err := s.store.BucketNotifications().Get(ctx, isMember.project.ID, bucketName)
 	if err != nil {
-		return nil, err
+		return eventing.BucketConfig{}, Error.Wrap(err)
 	}
 
-	return &ProjectUsageLimits{
-		StorageLimit:   storageLimit.Int64(),
-		BandwidthLimit: bandwidthLimit.Int64(),
-		StorageUsed:    storageUsed,
-		BandwidthUsed:  bandwidthUsed,
-		SegmentLimit:   segmentLimit.Int64(),
-		SegmentUsed:    segmentUsed,
-	}, nil
+	for i := range config.Rules {
+		config.Rules[i].Target.SecretAccessKey = ""
+	}
+	return config, nil
 }
 
-// TokenAuth returns an authenticated context by session token.
-func (s *Service) TokenAuth(ctx context.Context, token consoleauth.Token, authTime time.Time) (_ context.Context, err error) {
+// SetBucketNotifications replaces the event notification rules of the bucket.
+func (s *Service) SetBucketNotifications(ctx context.Context, projectID uuid.UUID, bucketName string, config eventing.BucketConfig) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	valid, err := s.tokens.ValidateToken(token)
+	user, err := s.getUserAndAuditLog(ctx, "set bucket notifications", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
 	if err != nil {
-		return nil, Error.Wrap(err)
-	}
-	if !valid {
-		return nil, Error.New("incorrect signature")
+		return Error.Wrap(err)
 	}
 
-	sessionID, err := uuid.FromBytes(token.Payload)
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
 	if err != nil {
-		return nil, Error.Wrap(err)
+		return Error.Wrap(err)
 	}
+	project := isMember.project
 
-	session, err := s.store.WebappSessions().GetBySessionID(ctx, sessionID)
-	if err != nil {
-		return nil, Error.Wrap(err)
+	if err := config.Validate(); err != nil {
+		return ErrValidation.Wrap(err)
 	}
 
-	ctx, err = s.authorize(ctx, session.UserID, session.ExpiresAt, authTime)
+	exists, err := s.buckets.HasBucket(ctx, []byte(bucketName), project.ID)
 	if err != nil {
-		err := errs.Combine(err, s.store.WebappSessions().DeleteBySessionID(ctx, sessionID))
-		if err != nil {
-			return nil, Error.Wrap(err)
-		}
-		return nil, err
+		return Error.Wrap(err)
+	}
+	if !exists {
+		return ErrValidation.New("bucket %q does not exist", bucketName)
 	}
 
-	return ctx, nil
+	return Error.Wrap(s.store.BucketNotifications().Set(ctx, project.ID, bucketName, config))
 }
 
-// KeyAuth returns an authenticated context by api key.
-func (s *Service) KeyAuth(ctx context.Context, apikey string, authTime time.Time) (_ context.Context, err error) {
+// DeleteBucketNotifications stops the event notifications of the bucket.
+func (s *Service) DeleteBucketNotifications(ctx context.Context, projectID uuid.UUID, bucketName string) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	ctx = consoleauth.WithAPIKey(ctx, []byte(apikey))
-
-	userID, exp, err := s.restKeys.GetUserAndExpirationFromKey(ctx, apikey)
+	user, err := s.getUserAndAuditLog(ctx, "delete bucket notifications", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
 	if err != nil {
-		return nil, err
+		return Error.Wrap(err)
 	}
 
-	ctx, err = s.authorize(ctx, userID, exp, authTime)
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
 	if err != nil {
-		return nil, err
+		return Error.Wrap(err)
 	}
 
-	return ctx, nil
+	return Error.Wrap(s.store.BucketNotifications().Delete(ctx, isMember.project.ID, bucketName))
 }
 
-// checkProjectCanBeDeleted ensures that all data, api-keys and buckets are deleted and usage has been accounted.
-// no error means the project status is clean.
-func (s *Service) checkProjectCanBeDeleted(ctx context.Context, user *User, projectID uuid.UUID) (err error) {
+// SetTaxEngine sets the tax engine, which validates the tax IDs of the billing addresses.
+func (s *Service) SetTaxEngine(taxes tax.Engine) {
+	s.taxes = taxes
+}
+
+// SetNotifier sets the notifier, which creates the in-app notifications of the users.
+func (s *Service) SetNotifier(notifier *Notifier) {
+	s.notifier = notifier
+}
+
+// ListNotifications returns the recent notifications of the user and the number of the unread ones.
+func (s *Service) ListNotifications(ctx context.Context, unreadOnly bool) (_ *NotificationList, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	buckets, err := s.buckets.CountBuckets(ctx, projectID)
+	user, err := GetUser(ctx)
 	if err != nil {
-		return err
+		return nil, Error.Wrap(err)
 	}
-	if buckets > 0 {
-		return ErrUsage.New("some buckets still exist")
+	if s.notifier == nil {
+		return nil, ErrNotificationsDisabled.New("")
 	}
 
-	keys, err := s.store.APIKeys().GetPagedByProjectID(ctx, projectID, APIKeyCursor{Limit: 1, Page: 1})
+	notifications, err := s.store.Notifications().List(ctx, user.ID, s.notifier.Config().ListLimit, unreadOnly)
 	if err != nil {
-		return err
+		return nil, Error.Wrap(err)
 	}
-	if keys.TotalCount > 0 {
-		return ErrUsage.New("some api keys still exist")
+	unread, err := s.store.Notifications().CountUnread(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
 	}
 
-	if user.PaidTier {
-		err = s.Payments().checkProjectUsageStatus(ctx, projectID)
-		if err != nil {
-			return ErrUsage.Wrap(err)
+	if notifications == nil {
+		notifications = []Notification{}
+	}
+	return &NotificationList{
+		Notifications: notifications,
+		Unread:        unread,
+	}, nil
+}
+
+// MarkNotificationRead marks the notification of the user as read.
+func (s *Service) MarkNotificationRead(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := GetUser(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if s.notifier == nil {
+		return ErrNotificationsDisabled.New("")
+	}
+
+	err = s.store.Notifications().MarkRead(ctx, user.ID, id, time.Now())
+	if err != nil {
+		if ErrNotificationNotFound.Has(err) {
+			return err
+		}
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
+// MarkAllNotificationsRead marks all the notifications of the user as read.
+func (s *Service) MarkAllNotificationsRead(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := GetUser(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if s.notifier == nil {
+		return ErrNotificationsDisabled.New("")
+	}
+
+	return Error.Wrap(s.store.Notifications().MarkAllRead(ctx, user.ID, time.Now()))
+}
+
+// SubscribeNotifications returns the subscription to the new notifications of the user.
+// The subscription must be closed when it's no longer used.
+func (s *Service) SubscribeNotifications(ctx context.Context) (_ *NotificationSubscription, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := GetUser(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if s.notifier == nil {
+		return nil, ErrNotificationsDisabled.New("")
+	}
+
+	config := s.notifier.Config()
+	wake, unsubscribe := s.notifier.Subscribe(user.ID)
+	return &NotificationSubscription{
+		db:          s.store.Notifications(),
+		userID:      user.ID,
+		limit:       config.ListLimit,
+		wake:        wake,
+		unsubscribe: unsubscribe,
+		ticker:      time.NewTicker(config.StreamPollInterval),
+	}, nil
+}
+
+// SetGatewayCredentials sets the auth service, which issues the gateway credentials, and the
+// revocations, which revoke the access grants of the revoked credentials.
+func (s *Service) SetGatewayCredentials(authService AuthService, revocations revocation.DB) {
+	s.authService = authService
+	s.revocations = revocations
+}
+
+// CreateGatewayCredential registers the access grant with the auth service and returns the
+// gateway credential bound to it. The access grant must belong to the project.
+func (s *Service) CreateGatewayCredential(ctx context.Context, projectID uuid.UUID, request CreateGatewayCredentialRequest) (_ *CreateGatewayCredentialResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "create gateway credential", zap.String("projectID", projectID.String()), zap.String("name", request.Name))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if err := s.checkGatewayCredentialsEnabled(); err != nil {
+		return nil, err
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	project := isMember.project
+
+	if request.Name == "" {
+		return nil, ErrValidation.New("name is required")
+	}
+
+	access, err := grant.ParseAccess(request.AccessGrant)
+	if err != nil {
+		return nil, ErrValidation.Wrap(err)
+	}
+	keyInfo, err := s.store.APIKeys().GetByHead(ctx, access.APIKey.Head())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrValidation.New("unknown access grant")
+		}
+		return nil, Error.Wrap(err)
+	}
+	if keyInfo.ProjectID != project.ID {
+		return nil, ErrValidation.New("access grant belongs to another project")
+	}
+	mac, err := macaroon.ParseMacaroon(access.APIKey.SerializeRaw())
+	if err != nil {
+		return nil, ErrValidation.Wrap(err)
+	}
+	if !mac.Validate(keyInfo.Secret) {
+		return nil, ErrValidation.New("invalid access grant")
+	}
+
+	active, err := s.store.GatewayCredentials().ListActive(ctx, project.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if len(active) >= s.config.GatewayCredentials.MaxPerProject {
+		return nil, ErrValidation.New("the project has reached the limit of %d gateway credentials", s.config.GatewayCredentials.MaxPerProject)
+	}
+
+	issued, err := s.authService.Register(ctx, request.AccessGrant)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	credential := GatewayCredential{
+		ID:          id,
+		ProjectID:   project.ID,
+		APIKeyID:    keyInfo.ID,
+		Name:        request.Name,
+		AccessKeyID: issued.AccessKeyID,
+		Endpoint:    issued.Endpoint,
+		Tail:        access.APIKey.Tail(),
+		CreatedBy:   user.ID,
+		CreatedAt:   time.Now(),
+	}
+	if err := s.store.GatewayCredentials().Create(ctx, credential); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &CreateGatewayCredentialResponse{
+		Credential: credential,
+		SecretKey:  issued.SecretKey,
+	}, nil
+}
+
+// ListGatewayCredentials returns the gateway credentials of the project, which aren't revoked.
+func (s *Service) ListGatewayCredentials(ctx context.Context, projectID uuid.UUID) (_ []GatewayCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "list gateway credentials", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if err := s.checkGatewayCredentialsEnabled(); err != nil {
+		return nil, err
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	credentials, err := s.store.GatewayCredentials().ListActive(ctx, isMember.project.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return credentials, nil
+}
+
+// RevokeGatewayCredential revokes the gateway credential. The access grant of the credential
+// is revoked too, so the gateways stop accepting it.
+func (s *Service) RevokeGatewayCredential(ctx context.Context, projectID, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "revoke gateway credential", zap.String("projectID", projectID.String()), zap.String("credentialID", id.String()))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if err := s.checkGatewayCredentialsEnabled(); err != nil {
+		return err
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	credential, err := s.store.GatewayCredentials().Get(ctx, id)
+	if err != nil {
+		if ErrGatewayCredentialNotFound.Has(err) {
+			return err
+		}
+		return Error.Wrap(err)
+	}
+	if credential.ProjectID != isMember.project.ID {
+		return ErrGatewayCredentialNotFound.New("%s", id)
+	}
+
+	if err := s.revocations.Revoke(ctx, credential.Tail, credential.APIKeyID[:]); err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(s.store.GatewayCredentials().Revoke(ctx, id, time.Now()))
+}
+
+// checkGatewayCredentialsEnabled returns an error, when the gateway credentials can't be managed.
+func (s *Service) checkGatewayCredentialsEnabled() error {
+	if !s.config.GatewayCredentials.Enabled || s.authService == nil || s.revocations == nil {
+		return ErrGatewayCredentialsDisabled.New("gateway credentials are not enabled")
+	}
+	return nil
+}
+
+// SetProjectRootKeys sets the keeper of the root keys of the projects and the node URL of the
+// satellite, which the access grants created from the root keys are bound to.
+func (s *Service) SetProjectRootKeys(rootKeys ProjectRootKeys, satelliteNodeURL string) {
+	s.rootKeys = rootKeys
+	s.satelliteNodeURL = satelliteNodeURL
+}
+
+// GetServerSideAccess returns whether the project keeps its root key on the satellite.
+func (s *Service) GetServerSideAccess(ctx context.Context, projectID uuid.UUID) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get server-side access", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+
+	if err := s.checkServerSideAccessEnabled(); err != nil {
+		return false, err
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+
+	_, err = s.rootKeys.ProjectRootKey(ctx, isMember.project.ID)
+	if err != nil {
+		if kms.ErrNoRootKey.Has(err) {
+			return false, nil
+		}
+		return false, Error.Wrap(err)
+	}
+	return true, nil
+}
+
+// EnableServerSideAccess derives the root key of the project from its passphrase and stores it,
+// so that the access grants of the project can be created by the satellite. Only the owner
+// of the project can opt it in.
+func (s *Service) EnableServerSideAccess(ctx context.Context, projectID uuid.UUID, request EnableServerSideAccessRequest) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "enable server-side access", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if err := s.checkServerSideAccessEnabled(); err != nil {
+		return err
+	}
+
+	_, project, err := s.isProjectOwner(ctx, user.ID, projectID)
+	if err != nil {
+		return err
+	}
+
+	if request.Passphrase == "" {
+		return ErrValidation.New("passphrase is required")
+	}
+
+	salt, err := s.store.Projects().GetSalt(ctx, project.ID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	rootKey, err := encryption.DeriveRootKey([]byte(request.Passphrase), salt, "", rootKeyDerivationThreads)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(s.rootKeys.StoreProjectRootKey(ctx, project.ID, user.ID, rootKey))
+}
+
+// DisableServerSideAccess removes the root key of the project. The access grants created
+// before keep working until their api keys are deleted.
+func (s *Service) DisableServerSideAccess(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "disable server-side access", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if err := s.checkServerSideAccessEnabled(); err != nil {
+		return err
+	}
+
+	_, project, err := s.isProjectOwner(ctx, user.ID, projectID)
+	if err != nil {
+		return err
+	}
+
+	return Error.Wrap(s.rootKeys.DeleteProjectRootKey(ctx, project.ID))
+}
+
+// CreateServerSideAccessGrant creates a new api key of the project and returns an access grant
+// restricted to the requested permissions and prefixes, whose encryption keys are derived from
+// the root key of the project kept on the satellite.
+func (s *Service) CreateServerSideAccessGrant(ctx context.Context, projectID uuid.UUID, request CreateServerSideAccessGrantRequest) (_ *CreateServerSideAccessGrantResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	fields := []zap.Field{
+		zap.String("projectID", projectID.String()),
+		zap.String("name", request.Name),
+		zap.Bool("allowDownload", request.AllowDownload),
+		zap.Bool("allowUpload", request.AllowUpload),
+		zap.Bool("allowList", request.AllowList),
+		zap.Bool("allowDelete", request.AllowDelete),
+		zap.Strings("prefixes", request.Prefixes),
+	}
+	if request.NotBefore != nil {
+		fields = append(fields, zap.Time("notBefore", *request.NotBefore))
+	}
+	if request.NotAfter != nil {
+		fields = append(fields, zap.Time("notAfter", *request.NotAfter))
+	}
+
+	user, err := s.getUserAndAuditLog(ctx, "create server-side access grant", fields...)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if err := s.checkServerSideAccessEnabled(); err != nil {
+		return nil, err
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	project := isMember.project
+
+	if request.Name == "" {
+		return nil, ErrValidation.New("name is required")
+	}
+	permission := request.permission()
+	if permission == (grant.Permission{}) {
+		return nil, ErrValidation.New("at least one permission is required")
+	}
+	if request.NotBefore != nil && request.NotAfter != nil && request.NotAfter.Before(*request.NotBefore) {
+		return nil, ErrValidation.New("notAfter must not be before notBefore")
+	}
+	prefixes, err := request.sharePrefixes()
+	if err != nil {
+		return nil, err
+	}
+
+	rootKey, err := s.rootKeys.ProjectRootKey(ctx, project.ID)
+	if err != nil {
+		if kms.ErrNoRootKey.Has(err) {
+			return nil, ErrServerSideAccessDisabled.New("the project doesn't keep its root key on the satellite")
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	info, key, err := s.createAPIKey(ctx, user, project.ID, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	encAccess := grant.NewEncryptionAccessWithDefaultKey(rootKey)
+	encAccess.SetDefaultPathCipher(storj.EncAESGCM)
+	encAccess.LimitTo(key)
+
+	access, err := (&grant.Access{
+		SatelliteAddress: s.satelliteNodeURL,
+		APIKey:           key,
+		EncAccess:        encAccess,
+	}).Restrict(permission, prefixes...)
+	if err != nil {
+		return nil, errs.Combine(ErrValidation.Wrap(err), s.store.APIKeys().Delete(ctx, info.ID))
+	}
+
+	serialized, err := access.Serialize()
+	if err != nil {
+		return nil, errs.Combine(Error.Wrap(err), s.store.APIKeys().Delete(ctx, info.ID))
+	}
+
+	s.auditLog(ctx, "server-side access grant created", &user.ID, user.Email,
+		zap.String("projectID", project.ID.String()),
+		zap.String("apiKeyID", info.ID.String()))
+
+	return &CreateServerSideAccessGrantResponse{
+		APIKeyID:    info.ID,
+		Name:        info.Name,
+		AccessGrant: serialized,
+	}, nil
+}
+
+// checkServerSideAccessEnabled returns an error, when the satellite doesn't create the access grants.
+func (s *Service) checkServerSideAccessEnabled() error {
+	if !s.config.ServerSideAccess.Enabled || s.rootKeys == nil {
+		return ErrServerSideAccessDisabled.New("server-side access grants are not enabled")
+	}
+	return nil
+}
+
+// GenGetBucketUsageRollups retrieves summed usage rollups for every bucket of particular project for a given period for generated api.
+func (s *Service) GenGetBucketUsageRollups(ctx context.Context, reqProjectID uuid.UUID, since, before time.Time) (rollups []accounting.BucketUsageRollup, httpError api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get bucket usage rollups", zap.String("projectID", reqProjectID.String()))
+	if err != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusUnauthorized,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, reqProjectID)
+	if err != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusUnauthorized,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	projectID := isMember.project.ID
+
+	rollups, err = s.projectAccounting.GetBucketUsageRollups(ctx, projectID, since, before)
+	if err != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusInternalServerError,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	// if project ID from request is public ID, replace rollup's project ID with public ID
+	if reqProjectID != projectID {
+		for i := range rollups {
+			rollups[i].ProjectID = reqProjectID
+		}
+	}
+
+	return rollups, httpError
+}
+
+// GenGetSingleBucketUsageRollup retrieves usage rollup for single bucket of particular project for a given period for generated api.
+func (s *Service) GenGetSingleBucketUsageRollup(ctx context.Context, reqProjectID uuid.UUID, bucket string, since, before time.Time) (rollup *accounting.BucketUsageRollup, httpError api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get single bucket usage rollup", zap.String("projectID", reqProjectID.String()))
+	if err != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusUnauthorized,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, reqProjectID)
+	if err != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusUnauthorized,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	projectID := isMember.project.ID
+
+	rollup, err = s.projectAccounting.GetSingleBucketUsageRollup(ctx, projectID, bucket, since, before)
+	if err != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusInternalServerError,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	// make sure to replace rollup project ID with reqProjectID in case it is the public ID
+	rollup.ProjectID = reqProjectID
+
+	return rollup, httpError
+}
+
+// GetDailyProjectUsage returns daily usage by project ID.
+// ID here may be project.ID or project.PublicID.
+func (s *Service) GetDailyProjectUsage(ctx context.Context, projectID uuid.UUID, from, to time.Time) (_ *accounting.ProjectDailyUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get daily usage by project ID")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	usage, err := s.projectAccounting.GetProjectDailyUsageByDateRange(ctx, isMember.project.ID, from, to, s.config.AsOfSystemTimeDuration)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return usage, nil
+}
+
+// GetUserAgentRollups returns the daily traffic of the project per bucket and
+// user agent product and version for the days from since to before, inclusive.
+// ID here may be project.ID or project.PublicID.
+func (s *Service) GetUserAgentRollups(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ []attribution.UserAgentRollup, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get user agent rollups", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	rollups, err := s.attributions.GetUserAgentRollups(ctx, isMember.project.ID, since, before)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// make sure to replace the project ID with projectID in case it is the public ID.
+	for i := range rollups {
+		rollups[i].ProjectID = projectID
+	}
+
+	return rollups, nil
+}
+
+// GetAPIUsageRollups returns the hourly counts of the metainfo requests of the
+// project per operation for the hours from since, inclusive, to before, exclusive.
+// ID here may be project.ID or project.PublicID.
+func (s *Service) GetAPIUsageRollups(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ []accounting.APIUsageRollup, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get api usage rollups", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	rollups, err := s.projectAccounting.GetAPIUsageRollups(ctx, isMember.project.ID, since, before)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// make sure to replace the project ID with projectID in case it is the public ID.
+	for i := range rollups {
+		rollups[i].ProjectID = projectID
+	}
+
+	return rollups, nil
+}
+
+// GetProjectUsageLimits returns project limits and current usage.
+//
+// Among others,it can return one of the following errors returned by
+// storj.io/storj/satellite/accounting.Service, wrapped Error.
+func (s *Service) GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID) (_ *ProjectUsageLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get project usage limits", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	prUsageLimits, err := s.getProjectUsageLimits(ctx, isMember.project.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	prObjectsSegments, err := s.projectAccounting.GetProjectObjectsSegments(ctx, isMember.project.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &ProjectUsageLimits{
+		StorageLimit:   prUsageLimits.StorageLimit,
+		BandwidthLimit: prUsageLimits.BandwidthLimit,
+		StorageUsed:    prUsageLimits.StorageUsed,
+		BandwidthUsed:  prUsageLimits.BandwidthUsed,
+		ObjectCount:    prObjectsSegments.ObjectCount,
+		SegmentCount:   prObjectsSegments.SegmentCount,
+		SegmentLimit:   prUsageLimits.SegmentLimit,
+		SegmentUsed:    prUsageLimits.SegmentUsed,
+	}, nil
+}
+
+// GetTotalUsageLimits returns total limits and current usage for all the projects.
+func (s *Service) GetTotalUsageLimits(ctx context.Context) (_ *ProjectUsageLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get total usage and limits for all the projects")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	projects, err := s.store.Projects().GetOwn(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var totalStorageLimit int64
+	var totalBandwidthLimit int64
+	var totalStorageUsed int64
+	var totalBandwidthUsed int64
+
+	for _, pr := range projects {
+		prUsageLimits, err := s.getProjectUsageLimits(ctx, pr.ID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		totalStorageLimit += prUsageLimits.StorageLimit
+		totalBandwidthLimit += prUsageLimits.BandwidthLimit
+		totalStorageUsed += prUsageLimits.StorageUsed
+		totalBandwidthUsed += prUsageLimits.BandwidthUsed
+	}
+
+	return &ProjectUsageLimits{
+		StorageLimit:   totalStorageLimit,
+		BandwidthLimit: totalBandwidthLimit,
+		StorageUsed:    totalStorageUsed,
+		BandwidthUsed:  totalBandwidthUsed,
+	}, nil
+}
+
+func (s *Service) getProjectUsageLimits(ctx context.Context, projectID uuid.UUID) (_ *ProjectUsageLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	storageLimit, err := s.projectUsage.GetProjectStorageLimit(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	bandwidthLimit, err := s.projectUsage.GetProjectBandwidthLimit(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	segmentLimit, err := s.projectUsage.GetProjectSegmentLimit(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	storageUsed, err := s.projectUsage.GetProjectStorageTotals(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	bandwidthUsed, err := s.projectUsage.GetProjectBandwidthTotals(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	segmentUsed, err := s.projectUsage.GetProjectSegmentTotals(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProjectUsageLimits{
+		StorageLimit:   storageLimit.Int64(),
+		BandwidthLimit: bandwidthLimit.Int64(),
+		StorageUsed:    storageUsed,
+		BandwidthUsed:  bandwidthUsed,
+		SegmentLimit:   segmentLimit.Int64(),
+		SegmentUsed:    segmentUsed,
+	}, nil
+}
+
+// TokenAuth returns an authenticated context by session token.
+func (s *Service) TokenAuth(ctx context.Context, token consoleauth.Token, authTime time.Time) (_ context.Context, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	valid, err := s.tokens.ValidateToken(token)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if !valid {
+		return nil, Error.New("incorrect signature")
+	}
+
+	sessionID, err := uuid.FromBytes(token.Payload)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	session, err := s.store.WebappSessions().GetBySessionID(ctx, sessionID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	ctx, err = s.authorize(ctx, session.UserID, session.ExpiresAt, authTime)
+	if err != nil {
+		err := errs.Combine(err, s.store.WebappSessions().DeleteBySessionID(ctx, sessionID))
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		return nil, err
+	}
+
+	return ctx, nil
+}
+
+// KeyAuth returns an authenticated context by api key.
+func (s *Service) KeyAuth(ctx context.Context, apikey string, authTime time.Time) (_ context.Context, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ctx = consoleauth.WithAPIKey(ctx, []byte(apikey))
+
+	userID, exp, err := s.restKeys.GetUserAndExpirationFromKey(ctx, apikey)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = s.authorize(ctx, userID, exp, authTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return ctx, nil
+}
+
+// checkProjectCanBeDeleted ensures that all data, api-keys and buckets are deleted and usage has been accounted.
+// no error means the project status is clean.
+func (s *Service) checkProjectCanBeDeleted(ctx context.Context, user *User, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	buckets, err := s.buckets.CountBuckets(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if buckets > 0 {
+		return ErrUsage.New("some buckets still exist")
+	}
+
+	keys, err := s.store.APIKeys().GetPagedByProjectID(ctx, projectID, APIKeyCursor{Limit: 1, Page: 1})
+	if err != nil {
+		return err
+	}
+	if keys.TotalCount > 0 {
+		return ErrUsage.New("some api keys still exist")
+	}
+
+	if user.PaidTier {
+		err = s.Payments().checkProjectUsageStatus(ctx, projectID)
+		if err != nil {
+			return ErrUsage.Wrap(err)
 		}
 	}
 
@@ -3203,6 +4049,76 @@ func (payment Payments) GetProjectUsagePriceModel(partner string) (_ *payments.P
 	return &model
 }
 
+// maxBillingAddressFieldLength is the maximum length of the fields of a billing address.
+const maxBillingAddressFieldLength = 256
+
+// GetBillingAddress returns the billing address of the user, or ErrBillingAddressNotFound
+// if it's not set yet.
+func (payment Payments) GetBillingAddress(ctx context.Context) (_ *BillingAddress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := GetUser(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	address, err := payment.service.store.BillingAddresses().Get(ctx, user.ID)
+	if err != nil {
+		if ErrBillingAddressNotFound.Has(err) {
+			return nil, err
+		}
+		return nil, Error.Wrap(err)
+	}
+	return address, nil
+}
+
+// SetBillingAddress validates and stores the billing address of the user. The tax ID is
+// stored in its canonical form.
+func (payment Payments) SetBillingAddress(ctx context.Context, address BillingAddress) (_ *BillingAddress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := payment.service.getUserAndAuditLog(ctx, "set billing address")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	address = BillingAddress{
+		Name:       strings.TrimSpace(address.Name),
+		Line1:      strings.TrimSpace(address.Line1),
+		Line2:      strings.TrimSpace(address.Line2),
+		City:       strings.TrimSpace(address.City),
+		PostalCode: strings.TrimSpace(address.PostalCode),
+		State:      strings.TrimSpace(address.State),
+		Country:    strings.ToUpper(strings.TrimSpace(address.Country)),
+		TaxID:      strings.ToUpper(strings.TrimSpace(address.TaxID)),
+		UpdatedAt:  time.Now(),
+	}
+
+	if address.Name == "" || address.Line1 == "" || address.City == "" {
+		return nil, ErrValidation.New("name, address line 1 and city are required")
+	}
+	for _, field := range []string{address.Name, address.Line1, address.Line2, address.City, address.PostalCode, address.State, address.TaxID} {
+		if len(field) > maxBillingAddressFieldLength {
+			return nil, ErrValidation.New("billing address fields can't be longer than %d characters", maxBillingAddressFieldLength)
+		}
+	}
+	if len(address.Country) != 2 || strings.Trim(address.Country, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+		return nil, ErrValidation.New("country must be an ISO 3166-1 alpha-2 code")
+	}
+
+	if payment.service.taxes != nil {
+		address.TaxID, err = payment.service.taxes.NormalizeTaxID(address.Country, address.TaxID)
+		if err != nil {
+			return nil, ErrValidation.Wrap(err)
+		}
+	}
+
+	if err := payment.service.store.BillingAddresses().Upsert(ctx, user.ID, address); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &address, nil
+}
+
 func findMembershipByProjectID(memberships []ProjectMember, projectID uuid.UUID) (ProjectMember, bool) {
 	for _, membership := range memberships {
 		if membership.ProjectID == projectID {
@@ -34,6 +34,7 @@ import (
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/storjscan/blockchaintest"
 	"storj.io/storj/satellite/payments/stripe"
+	"storj.io/storj/satellite/payments/tax"
 )
 
 func TestService(t *testing.T) {
@@ -1580,6 +1581,64 @@ func TestPaymentsWalletPayments(t *testing.T) {
 	})
 }
 
+func TestPaymentsBillingAddress(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+		p := service.Payments()
+
+		engine, err := tax.NewEUVAT(tax.Config{})
+		require.NoError(t, err)
+		service.SetTaxEngine(engine)
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Test User",
+			Email:    "test@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		userCtx, err := sat.UserContext(ctx, user.ID)
+		require.NoError(t, err)
+
+		_, err = p.GetBillingAddress(userCtx)
+		require.True(t, console.ErrBillingAddressNotFound.Has(err))
+
+		address := console.BillingAddress{
+			Name:       " Storj Test ",
+			Line1:      "Main Street 1",
+			City:       "Berlin",
+			PostalCode: "10115",
+			Country:    "de",
+			TaxID:      "de 123 456 789",
+		}
+
+		for _, invalid := range []func(*console.BillingAddress){
+			func(a *console.BillingAddress) { a.Name = "" },
+			func(a *console.BillingAddress) { a.Country = "Germany" },
+			func(a *console.BillingAddress) { a.TaxID = "DE123" },
+			func(a *console.BillingAddress) { a.Line2 = string(make([]byte, 257)) },
+		} {
+			request := address
+			invalid(&request)
+			_, err = p.SetBillingAddress(userCtx, request)
+			require.True(t, console.ErrValidation.Has(err), err)
+		}
+
+		stored, err := p.SetBillingAddress(userCtx, address)
+		require.NoError(t, err)
+		require.Equal(t, "Storj Test", stored.Name)
+		require.Equal(t, "DE", stored.Country)
+		require.Equal(t, "DE123456789", stored.TaxID)
+
+		got, err := p.GetBillingAddress(userCtx)
+		require.NoError(t, err)
+		require.Equal(t, stored.TaxID, got.TaxID)
+		require.Equal(t, stored.Line1, got.Line1)
+	})
+}
+
 func TestPaymentsPurchase(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
@@ -1849,3 +1908,83 @@ func TestServiceGenMethods(t *testing.T) {
 		}
 	})
 }
+
+func TestNotifications(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.Notifications.Enabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+
+		owner, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Project Owner",
+			Email:    "owner@mail.test",
+		}, 1)
+		require.NoError(t, err)
+		member, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Project Member",
+			Email:    "member@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, owner.ID, "notified")
+		require.NoError(t, err)
+
+		ownerCtx, err := sat.UserContext(ctx, owner.ID)
+		require.NoError(t, err)
+		memberCtx, err := sat.UserContext(ctx, member.ID)
+		require.NoError(t, err)
+
+		subscription, err := service.SubscribeNotifications(memberCtx)
+		require.NoError(t, err)
+		defer subscription.Close()
+
+		since := time.Now().Add(-time.Minute)
+		_, err = service.AddProjectMembers(ownerCtx, project.ID, []string{member.Email})
+		require.NoError(t, err)
+
+		streamed, err := subscription.Next(memberCtx, since)
+		require.NoError(t, err)
+		require.Len(t, streamed, 1)
+		require.Equal(t, console.NotificationProjectMemberAdded, streamed[0].Kind)
+
+		list, err := service.ListNotifications(memberCtx, false)
+		require.NoError(t, err)
+		require.Len(t, list.Notifications, 1)
+		require.Equal(t, 1, list.Unread)
+
+		// the notifications of the other users can't be marked as read.
+		err = service.MarkNotificationRead(ownerCtx, streamed[0].ID)
+		require.True(t, console.ErrNotificationNotFound.Has(err))
+
+		require.NoError(t, service.MarkNotificationRead(memberCtx, streamed[0].ID))
+		list, err = service.ListNotifications(memberCtx, true)
+		require.NoError(t, err)
+		require.Empty(t, list.Notifications)
+		require.Zero(t, list.Unread)
+
+		// the owner is notified about an exceeded limit once per interval.
+		notifier := console.NewNotifier(sat.Log, sat.DB.Console().Notifications(), sat.DB.Console().Projects(), sat.Config.Console.Notifications)
+		notifier.NotifyLimitExceeded(ctx, project.ID, "storage")
+		notifier.NotifyLimitExceeded(ctx, project.ID, "storage")
+		notifier.NotifyLimitExceeded(ctx, project.ID, "bandwidth")
+
+		list, err = service.ListNotifications(ownerCtx, true)
+		require.NoError(t, err)
+		require.Len(t, list.Notifications, 2)
+		for _, notification := range list.Notifications {
+			require.Equal(t, console.NotificationLimitExceeded, notification.Kind)
+		}
+
+		require.NoError(t, service.MarkAllNotificationsRead(ownerCtx))
+		list, err = service.ListNotifications(ownerCtx, false)
+		require.NoError(t, err)
+		require.Len(t, list.Notifications, 2)
+		require.Zero(t, list.Unread)
+	})
+}
@@ -4,6 +4,9 @@
 package console
 
 import (
+	"net"
+	"strings"
+
 	"github.com/zeebo/errs"
 )
 
@@ -43,3 +46,31 @@ func ValidateFullName(name string) error {
 
 	return nil
 }
+
+// ValidateBucketName validates the name of a bucket created through the console.
+// It returns an plain error (not wrapped in a errs.Class) if name is invalid.
+func ValidateBucketName(name string) error {
+	if len(name) < 3 || len(name) > 63 {
+		return errs.New("bucket name must be at least 3 and no more than 63 characters long")
+	}
+
+	for _, label := range strings.Split(name, ".") {
+		if label == "" {
+			return errs.New("bucket label cannot be empty")
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return errs.New("bucket label cannot start or end with a hyphen")
+		}
+		for _, r := range label {
+			if !('a' <= r && r <= 'z') && !('0' <= r && r <= '9') && r != '-' {
+				return errs.New("bucket name must contain only lowercase letters, numbers or hyphens")
+			}
+		}
+	}
+
+	if net.ParseIP(name) != nil {
+		return errs.New("bucket name cannot be formatted as an IP address")
+	}
+
+	return nil
+}
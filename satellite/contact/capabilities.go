@@ -0,0 +1,122 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/identity"
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/private/capabilitiespb"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/reputation"
+)
+
+// CapabilitiesEndpoint receives the capabilities advertised by the storage nodes after
+// checking in, which are used for the node selection and the order limits.
+//
+// architecture: Endpoint
+type CapabilitiesEndpoint struct {
+	capabilitiespb.DRPCNodeCapabilitiesUnimplementedServer
+
+	log         *zap.Logger
+	overlay     *overlay.Service
+	maintenance reputation.MaintenanceWindows
+	outages     *orders.OutageTracker
+}
+
+// NewCapabilitiesEndpoint returns a new node capabilities endpoint. The maintenance windows
+// are announced to the nodes in the responses.
+func NewCapabilitiesEndpoint(log *zap.Logger, overlay *overlay.Service, maintenance reputation.MaintenanceWindows) *CapabilitiesEndpoint {
+	return &CapabilitiesEndpoint{
+		log:         log,
+		overlay:     overlay,
+		maintenance: maintenance,
+	}
+}
+
+// SetOutages enables announcing the recent downtimes of the satellite, which extend the
+// settlement of the orders, to the nodes.
+func (endpoint *CapabilitiesEndpoint) SetOutages(outages *orders.OutageTracker) {
+	endpoint.outages = outages
+}
+
+// Advertise replaces the capabilities of the calling node and returns the current and
+// the upcoming maintenance windows and the recent downtimes of the satellite.
+func (endpoint *CapabilitiesEndpoint) Advertise(ctx context.Context, req *capabilitiespb.AdvertiseRequest) (_ *capabilitiespb.AdvertiseResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peerID, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+	nodeID := peerID.ID
+
+	capabilities := req.GetCapabilities()
+	if capabilities == nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "missing capabilities")
+	}
+	if capabilities.MaxPieceSize < 0 {
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "invalid max piece size: %d", capabilities.MaxPieceSize)
+	}
+
+	hashAlgorithms := make([]pb.PieceHashAlgorithm, 0, len(capabilities.HashAlgorithms))
+	for _, algorithm := range capabilities.HashAlgorithms {
+		if _, ok := pb.PieceHashAlgorithm_name[algorithm]; !ok {
+			return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "unknown hash algorithm: %d", algorithm)
+		}
+		hashAlgorithms = append(hashAlgorithms, pb.PieceHashAlgorithm(algorithm))
+	}
+
+	// only the nodes, which have checked in, can advertise their capabilities.
+	if _, err := endpoint.overlay.Get(ctx, nodeID); err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, "node has not checked in")
+		}
+		endpoint.log.Error("failed to get node", zap.Stringer("Node ID", nodeID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "failed to get node")
+	}
+
+	err = endpoint.overlay.UpdateNodeCapabilities(ctx, nodeID, overlay.NodeCapabilities{
+		HashAlgorithms: hashAlgorithms,
+		TCPFastOpen:    capabilities.TcpFastOpen,
+		Noise:          capabilities.Noise,
+		MaxPieceSize:   capabilities.MaxPieceSize,
+	})
+	if err != nil {
+		endpoint.log.Error("failed to update node capabilities", zap.Stringer("Node ID", nodeID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "failed to update node capabilities")
+	}
+
+	var windows []*capabilitiespb.MaintenanceWindow
+	for _, window := range endpoint.maintenance.Upcoming(time.Now()) {
+		windows = append(windows, &capabilitiespb.MaintenanceWindow{
+			Start: window.Start,
+			End:   window.End,
+		})
+	}
+
+	var extensions []*capabilitiespb.SettlementExtension
+	for _, outage := range endpoint.outages.Outages() {
+		extensions = append(extensions, &capabilitiespb.SettlementExtension{
+			Token: outage.Token.Bytes(),
+			Start: outage.Start,
+			End:   outage.End,
+		})
+	}
+
+	response := &capabilitiespb.AdvertiseResponse{
+		MaintenanceWindows:   windows,
+		SettlementExtensions: extensions,
+	}
+	if endpoint.outages != nil {
+		response.MaxSettlementExtensionSeconds = int64(endpoint.outages.MaximumExtension() / time.Second)
+	}
+	return response, nil
+}
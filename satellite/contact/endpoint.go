@@ -66,6 +66,11 @@ func (endpoint *Endpoint) CheckIn(ctx context.Context, req *pb.CheckInRequest) (
 		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, errCheckInRateLimit.New("node rate limited by id").Error())
 	}
 
+	if err := endpoint.checkNewNodeIDDifficulty(ctx, nodeID); err != nil {
+		endpoint.log.Info("node id difficulty too low", zap.String("node address", req.Address), zap.Stringer("Node ID", nodeID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, errCheckInIdentity.Wrap(err).Error())
+	}
+
 	err = endpoint.service.peerIDs.Set(ctx, nodeID, peerID)
 	if err != nil {
 		endpoint.log.Info("failed to add peer identity entry for ID", zap.String("node address", req.Address), zap.Stringer("Node ID", nodeID), zap.Error(err))
@@ -133,6 +138,10 @@ func (endpoint *Endpoint) CheckIn(ctx context.Context, req *pb.CheckInRequest) (
 	endpoint.emitEvenkitEvent(ctx, req, pingNodeSuccess, pingNodeSuccessQUIC, nodeInfo)
 
 	err = endpoint.service.overlay.UpdateCheckIn(ctx, nodeInfo, time.Now().UTC())
+	if overlay.ErrLowDifficulty.Has(err) {
+		endpoint.log.Info("node id difficulty too low", zap.String("node address", req.Address), zap.Stringer("Node ID", nodeID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, errCheckInIdentity.Wrap(err).Error())
+	}
 	if err != nil {
 		endpoint.log.Info("failed to update check in", zap.String("node address", req.Address), zap.Stringer("Node ID", nodeID), zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, Error.Wrap(err).Error())
@@ -146,6 +155,23 @@ func (endpoint *Endpoint) CheckIn(ctx context.Context, req *pb.CheckInRequest) (
 	}, nil
 }
 
+// checkNewNodeIDDifficulty rejects the new nodes, which don't have the node id difficulty
+// required for joining. The known nodes remain allowed with any difficulty.
+func (endpoint *Endpoint) checkNewNodeIDDifficulty(ctx context.Context, nodeID storj.NodeID) error {
+	err := endpoint.service.overlay.CheckNewNodeIDDifficulty(nodeID, time.Now())
+	if !overlay.ErrLowDifficulty.Has(err) {
+		return err
+	}
+
+	// the difficulty is checked again when updating the check-in, hence only the nodes,
+	// which are certainly new, are rejected here.
+	_, getErr := endpoint.service.overlay.Get(ctx, nodeID)
+	if overlay.ErrNodeNotFound.Has(getErr) {
+		return err
+	}
+	return nil
+}
+
 func (endpoint *Endpoint) emitEvenkitEvent(ctx context.Context, req *pb.CheckInRequest, pingNodeTCPSuccess bool, pingNodeQUICSuccess bool, nodeInfo overlay.NodeCheckInInfo) {
 	var sourceAddr string
 	transport, found := drpcctx.Transport(ctx)
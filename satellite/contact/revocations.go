@@ -0,0 +1,76 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/private/revocation"
+	"storj.io/storj/private/revocationpb"
+)
+
+// RevocationSource lists the identity revocations known to the satellite.
+type RevocationSource interface {
+	ListRevoked(ctx context.Context) ([]revocation.Revoked, error)
+}
+
+// RevocationEndpoint propagates the identity revocations known to the satellite to
+// the storage nodes, which fetch them periodically.
+//
+// architecture: Endpoint
+type RevocationEndpoint struct {
+	revocationpb.DRPCIdentityRevocationsUnimplementedServer
+
+	log           *zap.Logger
+	source        RevocationSource
+	cacheDuration time.Duration
+
+	mu       sync.Mutex
+	cached   *revocationpb.ListResponse
+	cachedAt time.Time
+}
+
+// NewRevocationEndpoint returns a new identity revocation endpoint, which caches the
+// revocations for the cache duration.
+func NewRevocationEndpoint(log *zap.Logger, source RevocationSource, cacheDuration time.Duration) *RevocationEndpoint {
+	return &RevocationEndpoint{
+		log:           log,
+		source:        source,
+		cacheDuration: cacheDuration,
+	}
+}
+
+// List returns the identity revocations with the CA certificates, which signed them.
+func (endpoint *RevocationEndpoint) List(ctx context.Context, req *revocationpb.ListRequest) (_ *revocationpb.ListResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.mu.Lock()
+	defer endpoint.mu.Unlock()
+
+	if endpoint.cached != nil && time.Since(endpoint.cachedAt) < endpoint.cacheDuration {
+		return endpoint.cached, nil
+	}
+
+	revoked, err := endpoint.source.ListRevoked(ctx)
+	if err != nil {
+		endpoint.log.Error("failed to list identity revocations", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "failed to list identity revocations")
+	}
+
+	response := &revocationpb.ListResponse{}
+	for _, r := range revoked {
+		response.Revocations = append(response.Revocations, &revocationpb.Revocation{
+			CaCertificate: r.CA.Raw,
+			Revocation:    r.Revocation,
+		})
+	}
+
+	endpoint.cached, endpoint.cachedAt = response, time.Now()
+	return response, nil
+}
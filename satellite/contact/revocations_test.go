@@ -0,0 +1,76 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact_test
+
+import (
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/peertls"
+	"storj.io/common/peertls/extensions"
+	"storj.io/common/peertls/testpeertls"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/revocation"
+	"storj.io/storj/private/revocationpb"
+	"storj.io/storj/satellite/contact"
+)
+
+func TestRevocationEndpoint(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	satelliteDB, err := revocation.OpenDB(ctx, "bolt://"+ctx.File("satellite.db"))
+	require.NoError(t, err)
+	defer ctx.Check(satelliteDB.Close)
+
+	revoke := func() []*x509.Certificate {
+		keys, chain, err := testpeertls.NewCertChain(2, storj.LatestIDVersion().Number)
+		require.NoError(t, err)
+		ext, err := extensions.NewRevocationExt(keys[peertls.CAIndex], chain[peertls.LeafIndex])
+		require.NoError(t, err)
+		require.NoError(t, satelliteDB.Put(ctx, chain, ext))
+		return chain
+	}
+
+	first := revoke()
+
+	cached := contact.NewRevocationEndpoint(zaptest.NewLogger(t), satelliteDB, time.Hour)
+	uncached := contact.NewRevocationEndpoint(zaptest.NewLogger(t), satelliteDB, 0)
+
+	resp, err := cached.List(ctx, &revocationpb.ListRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Revocations, 1)
+
+	second := revoke()
+
+	// the cached revocations are returned until the cache expires.
+	resp, err = cached.List(ctx, &revocationpb.ListRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Revocations, 1)
+
+	resp, err = uncached.List(ctx, &revocationpb.ListRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Revocations, 2)
+
+	// the propagated revocations can be verified and stored by the nodes.
+	nodeDB, err := revocation.OpenDB(ctx, "bolt://"+ctx.File("node.db"))
+	require.NoError(t, err)
+	defer ctx.Check(nodeDB.Close)
+
+	for _, r := range resp.Revocations {
+		ca, err := x509.ParseCertificate(r.CaCertificate)
+		require.NoError(t, err)
+		require.NoError(t, nodeDB.Add(ctx, ca, r.Revocation))
+	}
+
+	for _, chain := range [][]*x509.Certificate{first, second} {
+		rev, err := nodeDB.Get(ctx, chain)
+		require.NoError(t, err)
+		require.NotNil(t, rev)
+	}
+}
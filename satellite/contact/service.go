@@ -29,6 +29,8 @@ type Config struct {
 	RateLimitInterval  time.Duration `help:"the amount of time that should happen between contact attempts usually" releaseDefault:"10m0s" devDefault:"1ns"`
 	RateLimitBurst     int           `help:"the maximum burst size for the contact rate limit token bucket" releaseDefault:"2" devDefault:"1000"`
 	RateLimitCacheSize int           `help:"the number of nodes or addresses to keep token buckets for" default:"1000"`
+
+	RevocationsCacheDuration time.Duration `help:"how long the identity revocations propagated to the storage nodes are cached" default:"1m0s" testDefault:"0s"`
 }
 
 // Service is the contact service between storage nodes and satellites.
@@ -21,6 +21,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/private/debug"
 	"storj.io/private/version"
+	"storj.io/storj/private/clock"
 	"storj.io/storj/private/lifecycle"
 	version_checker "storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/accounting"
@@ -30,6 +31,7 @@ import (
 	"storj.io/storj/satellite/accounting/tally"
 	"storj.io/storj/satellite/analytics"
 	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/compensation/heldrelease"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/dbcleanup"
@@ -39,15 +41,19 @@ import (
 	"storj.io/storj/satellite/metabase/segmentloop"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
+	"storj.io/storj/satellite/metainfo/piecedeletion"
 	"storj.io/storj/satellite/nodeevents"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/overlay/offlinenodes"
+	"storj.io/storj/satellite/overlay/slareports"
+	"storj.io/storj/satellite/overlay/storageestimates"
 	"storj.io/storj/satellite/overlay/straynodes"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/accountfreeze"
 	"storj.io/storj/satellite/payments/billing"
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripe"
+	"storj.io/storj/satellite/quarantine"
 	"storj.io/storj/satellite/reputation"
 )
 
@@ -63,6 +69,8 @@ type Core struct {
 	Servers  *lifecycle.Group
 	Services *lifecycle.Group
 
+	Clock clock.Clock
+
 	Dialer rpc.Dialer
 
 	Version struct {
@@ -86,6 +94,12 @@ type Core struct {
 		Service           *overlay.Service
 		OfflineNodeEmails *offlinenodes.Chore
 		DQStrayNodes      *straynodes.Chore
+		StorageEstimates  *storageestimates.Chore
+		SLAReports        *slareports.Chore
+	}
+
+	Compensation struct {
+		HeldReleases *heldrelease.Chore
 	}
 
 	NodeEvents struct {
@@ -94,6 +108,10 @@ type Core struct {
 		Chore    *nodeevents.Chore
 	}
 
+	Quarantine struct {
+		Chore *quarantine.Chore
+	}
+
 	Metainfo struct {
 		Metabase    *metabase.DB
 		SegmentLoop *segmentloop.Service
@@ -104,13 +122,16 @@ type Core struct {
 	}
 
 	Audit struct {
-		VerifyQueue          audit.VerifyQueue
-		ReverifyQueue        audit.ReverifyQueue
-		ContainmentSyncChore *audit.ContainmentSyncChore
+		VerifyQueue                audit.VerifyQueue
+		ReverifyQueue              audit.ReverifyQueue
+		ContainmentSyncChore       *audit.ContainmentSyncChore
+		ContainmentExpirationChore *audit.ContainmentExpirationChore
 	}
 
 	ExpiredDeletion struct {
-		Chore *expireddeletion.Chore
+		Chore         *expireddeletion.Chore
+		PieceDeletion *piecedeletion.Service
+		QueueChore    *expireddeletion.QueueChore
 	}
 
 	ZombieDeletion struct {
@@ -175,6 +196,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 	}
 
+	peer.Clock = newClock(config.SimulateClock, peer.Debug.Server.Panel)
+
 	var err error
 
 	{ // setup version control
@@ -205,7 +228,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 	}
 
 	{ // setup mailservice
-		peer.Mail.Service, err = setupMailService(peer.Log, *config)
+		peer.Mail.Service, err = setupMailService(peer.Log, peer.DB, *config)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
@@ -273,6 +296,44 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.Debug.Server.Panel.Add(
 				debug.Cycle("Overlay DQ Stray Nodes", peer.Overlay.DQStrayNodes.Loop))
 		}
+
+		if config.StorageEstimates.Enabled {
+			peer.Overlay.StorageEstimates = storageestimates.NewChore(peer.Log.Named("overlay:storage-estimates"), peer.Overlay.DB, config.StorageEstimates)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "overlay:storage-estimates",
+				Run:   peer.Overlay.StorageEstimates.Run,
+				Close: peer.Overlay.StorageEstimates.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Overlay Storage Estimates", peer.Overlay.StorageEstimates.Loop))
+		}
+
+		if config.SLAReports.Enabled {
+			peer.Overlay.SLAReports = slareports.NewChore(peer.Log.Named("overlay:sla-reports"), peer.DB.NodeSLAReports(), config.SLAReports)
+			peer.Overlay.SLAReports.SetClock(peer.Clock)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "overlay:sla-reports",
+				Run:   peer.Overlay.SLAReports.Run,
+				Close: peer.Overlay.SLAReports.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Overlay SLA Reports", peer.Overlay.SLAReports.Loop))
+		}
+	}
+
+	{ // setup held releases
+		if config.HeldReleases.Enabled {
+			peer.Compensation.HeldReleases = heldrelease.NewChore(peer.Log.Named("compensation:held-releases"),
+				peer.Overlay.DB, peer.DB.Compensation(), config.HeldReleases, config.Compensation)
+			peer.Compensation.HeldReleases.SetClock(peer.Clock)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "compensation:held-releases",
+				Run:   peer.Compensation.HeldReleases.Run,
+				Close: peer.Compensation.HeldReleases.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Compensation Held Releases", peer.Compensation.HeldReleases.Loop))
+		}
 	}
 
 	{ // setup node events
@@ -298,6 +359,19 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		}
 	}
 
+	{ // setup quarantine
+		if config.Quarantine.Enabled {
+			peer.Quarantine.Chore = quarantine.NewChore(peer.Log.Named("quarantine"), peer.DB.NodeQuarantines(), peer.Overlay.DB, peer.DB.NodeEvents(), config.Quarantine)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "quarantine",
+				Run:   peer.Quarantine.Chore.Run,
+				Close: peer.Quarantine.Chore.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Quarantine", peer.Quarantine.Chore.Loop))
+		}
+	}
+
 	{ // setup live accounting
 		peer.LiveAccounting.Cache = liveAccounting
 	}
@@ -332,6 +406,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			reputationDB,
 			config.Reputation,
 		)
+		peer.Reputation.Service.SetClock(peer.Clock)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "reputation",
 			Close: peer.Reputation.Service.Close,
@@ -355,6 +430,30 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Audit Containment Sync Chore", peer.Audit.ContainmentSyncChore.Loop))
+
+		reporter := audit.NewReporter(
+			peer.Log.Named("audit:reporter"),
+			peer.Reputation.Service,
+			peer.Overlay.Service,
+			db.Containment(),
+			config.MaxRetriesStatDB,
+			int32(config.MaxReverifyCount))
+
+		peer.Audit.ContainmentExpirationChore, err = audit.NewContainmentExpirationChore(peer.Log.Named("audit:containment-expiration-chore"),
+			peer.Audit.ReverifyQueue,
+			peer.Overlay.Service,
+			reporter,
+			config,
+		)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Services.Add(lifecycle.Item{
+			Name: "audit:containment-expiration-chore",
+			Run:  peer.Audit.ContainmentExpirationChore.Run,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Audit Containment Expiration Chore", peer.Audit.ContainmentExpirationChore.Loop))
 	}
 
 	{ // setup expired segment cleanup
@@ -370,6 +469,37 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Expired Segments Chore", peer.ExpiredDeletion.Chore.Loop))
+
+		if config.ExpiredDeletion.QueuePieceDeletions {
+			peer.ExpiredDeletion.PieceDeletion, err = piecedeletion.NewService(
+				peer.Log.Named("core-expired-deletion:piecedeletion"),
+				peer.Dialer,
+				peer.Overlay.Service.DownloadSelectionCache,
+				config.Metainfo.PieceDeletion,
+			)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			peer.Services.Add(lifecycle.Item{
+				Name:  "expireddeletion:piecedeletion",
+				Run:   peer.ExpiredDeletion.PieceDeletion.Run,
+				Close: peer.ExpiredDeletion.PieceDeletion.Close,
+			})
+
+			peer.ExpiredDeletion.QueueChore = expireddeletion.NewQueueChore(
+				peer.Log.Named("core-expired-deletion:queue"),
+				config.ExpiredDeletion,
+				peer.Metainfo.Metabase,
+				peer.ExpiredDeletion.PieceDeletion,
+			)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "expireddeletion:queue-chore",
+				Run:   peer.ExpiredDeletion.QueueChore.Run,
+				Close: peer.ExpiredDeletion.QueueChore.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Expired Pieces Queue Chore", peer.ExpiredDeletion.QueueChore.Loop))
+		}
 	}
 
 	{ // setup zombie objects cleanup
@@ -419,6 +549,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 
 		if config.RollupArchive.Enabled {
 			peer.Accounting.RollupArchiveChore = rolluparchive.New(peer.Log.Named("accounting:rollup-archive"), peer.DB.StoragenodeAccounting(), peer.DB.ProjectAccounting(), config.RollupArchive)
+			peer.Accounting.RollupArchiveChore.SetClock(peer.Clock)
 			peer.Services.Add(lifecycle.Item{
 				Name:  "accounting:rollup-archive",
 				Run:   peer.Accounting.RollupArchiveChore.Run,
@@ -517,6 +648,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			config.Payments.BillingConfig.DisableLoop,
 			config.Payments.BonusRate,
 		)
+		peer.Payments.BillingChore.SetClock(peer.Clock)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "billing:chore",
 			Run:   peer.Payments.BillingChore.Run,
@@ -527,12 +659,21 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 	{ // setup account freeze
 		if config.AccountFreeze.Enabled {
 			analyticService := analytics.NewService(peer.Log.Named("analytics:service"), config.Analytics, config.Console.SatelliteName)
+			freezeService := console.NewAccountFreezeService(db.Console().AccountFreezeEvents(), db.Console().Users(), db.Console().Projects(), analyticService)
+			if notificationsConfig := config.Console.Config.Notifications; notificationsConfig.Enabled {
+				freezeService.SetNotifier(console.NewNotifier(
+					peer.Log.Named("console:notifications"),
+					db.Console().Notifications(),
+					db.Console().Projects(),
+					notificationsConfig,
+				))
+			}
 			peer.Payments.AccountFreeze = accountfreeze.NewChore(
 				peer.Log.Named("payments.accountfreeze:chore"),
 				peer.DB.StripeCoinPayments(),
 				peer.Payments.Accounts,
 				peer.DB.Console().Users(),
-				console.NewAccountFreezeService(db.Console().AccountFreezeEvents(), db.Console().Users(), db.Console().Projects(), analyticService),
+				freezeService,
 				analyticService,
 				config.AccountFreeze,
 			)
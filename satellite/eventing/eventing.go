@@ -0,0 +1,100 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package eventing publishes the object events of the buckets to the targets
+// configured by the users, e.g. webhooks, Kafka topics or SNS topics.
+//
+// The events are published by the metainfo endpoint when objects are committed,
+// copied, moved or deleted. They are delivered asynchronously with retries, so
+// a target which is down doesn't slow down the uploads.
+package eventing
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+var (
+	// Error is the default error class for the eventing package.
+	Error = errs.Class("eventing")
+
+	mon = monkit.Package()
+)
+
+// EventName is the kind of the event, named the same way as the S3 event notifications.
+type EventName string
+
+const (
+	// ObjectCreatedPut is published when an object is uploaded.
+	ObjectCreatedPut = EventName("s3:ObjectCreated:Put")
+	// ObjectCreatedCopy is published when an object is copied or moved to a new key.
+	ObjectCreatedCopy = EventName("s3:ObjectCreated:Copy")
+	// ObjectRemovedDelete is published when an object is deleted or moved away from its key.
+	ObjectRemovedDelete = EventName("s3:ObjectRemoved:Delete")
+)
+
+// Event is a change of an object of a bucket.
+type Event struct {
+	Name      EventName
+	Time      time.Time
+	ProjectID uuid.UUID
+	Bucket    string
+	// ObjectKey is the key of the object as stored by the satellite, i.e. usually encrypted.
+	ObjectKey string
+	Version   int64
+	Size      int64
+}
+
+// Message returns the event encoded the same way as the records of the S3 event
+// notifications. The object key is URL-encoded, because it's usually encrypted.
+func (event Event) Message() ([]byte, error) {
+	type object struct {
+		Key       string `json:"key"`
+		Size      int64  `json:"size,omitempty"`
+		VersionID string `json:"versionId"`
+	}
+	type bucket struct {
+		Name string `json:"name"`
+	}
+	type s3 struct {
+		Bucket bucket `json:"bucket"`
+		Object object `json:"object"`
+	}
+	type record struct {
+		EventVersion string    `json:"eventVersion"`
+		EventSource  string    `json:"eventSource"`
+		EventTime    time.Time `json:"eventTime"`
+		EventName    string    `json:"eventName"`
+		ProjectID    string    `json:"projectId"`
+		S3           s3        `json:"s3"`
+	}
+
+	message, err := json.Marshal(struct {
+		Records []record `json:"Records"`
+	}{
+		Records: []record{{
+			EventVersion: "2.1",
+			EventSource:  "storj:s3",
+			EventTime:    event.Time.UTC(),
+			EventName:    strings.TrimPrefix(string(event.Name), "s3:"),
+			ProjectID:    event.ProjectID.String(),
+			S3: s3{
+				Bucket: bucket{Name: event.Bucket},
+				Object: object{
+					Key:       url.QueryEscape(event.ObjectKey),
+					Size:      event.Size,
+					VersionID: strconv.FormatInt(event.Version, 10),
+				},
+			},
+		}},
+	})
+	return message, Error.Wrap(err)
+}
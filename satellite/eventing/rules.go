@@ -0,0 +1,153 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package eventing
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+// ErrValidation is returned for an invalid bucket configuration.
+var ErrValidation = errs.Class("eventing validation")
+
+// maxRules is the maximum number of the rules of a bucket.
+const maxRules = 10
+
+// DB stores the configurations of the buckets.
+//
+// architecture: Database
+type DB interface {
+	// Get returns the configuration of the bucket. The configuration is empty when
+	// the bucket has none.
+	Get(ctx context.Context, projectID uuid.UUID, bucketName string) (BucketConfig, error)
+	// Set replaces the configuration of the bucket.
+	Set(ctx context.Context, projectID uuid.UUID, bucketName string, config BucketConfig) error
+	// Delete removes the configuration of the bucket.
+	Delete(ctx context.Context, projectID uuid.UUID, bucketName string) error
+}
+
+// TargetType is the kind of the target of the events.
+type TargetType string
+
+const (
+	// TargetWebhook posts the events to an HTTP endpoint.
+	TargetWebhook = TargetType("webhook")
+	// TargetKafka produces the events to a Kafka topic through a Kafka REST proxy.
+	TargetKafka = TargetType("kafka")
+	// TargetSNS publishes the events to an AWS SNS compatible topic.
+	TargetSNS = TargetType("sns")
+)
+
+// Target is where the events are delivered.
+type Target struct {
+	Type TargetType `json:"type"`
+	// URL is the webhook, the Kafka REST proxy or the SNS endpoint.
+	URL string `json:"url"`
+
+	// Topic is the name of the Kafka topic.
+	Topic string `json:"topic,omitempty"`
+
+	// TopicARN, Region and the credentials are used by the SNS target.
+	TopicARN        string `json:"topicArn,omitempty"`
+	Region          string `json:"region,omitempty"`
+	AccessKeyID     string `json:"accessKeyId,omitempty"`
+	SecretAccessKey string `json:"secretAccessKey,omitempty"`
+}
+
+// Rule selects the events delivered to a target.
+type Rule struct {
+	ID string `json:"id"`
+	// Events are the names of the matched events. The name may end with "*",
+	// e.g. "s3:ObjectCreated:*" matches all the created events.
+	Events []EventName `json:"events"`
+	// Prefix and Suffix filter the object keys, which are usually encrypted.
+	Prefix string `json:"prefix,omitempty"`
+	Suffix string `json:"suffix,omitempty"`
+
+	Target Target `json:"target"`
+}
+
+// BucketConfig is the configuration of the events of a bucket.
+type BucketConfig struct {
+	Rules []Rule `json:"rules"`
+}
+
+// Matches returns whether the event is selected by the rule.
+func (rule *Rule) Matches(event Event) bool {
+	if !strings.HasPrefix(event.ObjectKey, rule.Prefix) || !strings.HasSuffix(event.ObjectKey, rule.Suffix) {
+		return false
+	}
+	for _, name := range rule.Events {
+		if name == event.Name {
+			return true
+		}
+		if pattern := string(name); strings.HasSuffix(pattern, "*") && strings.HasPrefix(string(event.Name), strings.TrimSuffix(pattern, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the rules of the bucket.
+func (config *BucketConfig) Validate() error {
+	if len(config.Rules) > maxRules {
+		return ErrValidation.New("at most %d rules are allowed", maxRules)
+	}
+
+	ids := map[string]bool{}
+	for _, rule := range config.Rules {
+		if rule.ID == "" {
+			return ErrValidation.New("rule ID is required")
+		}
+		if ids[rule.ID] {
+			return ErrValidation.New("duplicate rule ID %q", rule.ID)
+		}
+		ids[rule.ID] = true
+
+		if len(rule.Events) == 0 {
+			return ErrValidation.New("rule %q has no events", rule.ID)
+		}
+		for _, name := range rule.Events {
+			if !strings.HasPrefix(string(name), "s3:Object") {
+				return ErrValidation.New("rule %q has unsupported event %q", rule.ID, name)
+			}
+		}
+
+		if err := rule.Target.Validate(); err != nil {
+			return ErrValidation.New("rule %q: %v", rule.ID, err)
+		}
+	}
+	return nil
+}
+
+// Validate checks the target has the values needed by its type.
+func (target *Target) Validate() error {
+	parsed, err := url.Parse(target.URL)
+	if err != nil {
+		return err
+	}
+	if parsed.Scheme != "https" && parsed.Scheme != "http" {
+		return errs.New("target URL must use http or https")
+	}
+
+	switch target.Type {
+	case TargetWebhook:
+	case TargetKafka:
+		if target.Topic == "" {
+			return errs.New("kafka target requires topic")
+		}
+	case TargetSNS:
+		if target.TopicARN == "" || target.Region == "" || target.AccessKeyID == "" || target.SecretAccessKey == "" {
+			return errs.New("sns target requires topic ARN, region and credentials")
+		}
+	default:
+		return errs.New("unsupported target type %q", target.Type)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package eventing_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/eventing"
+)
+
+func TestRuleMatches(t *testing.T) {
+	rule := eventing.Rule{
+		ID:     "images",
+		Events: []eventing.EventName{"s3:ObjectCreated:*"},
+		Prefix: "images/",
+		Suffix: ".png",
+	}
+
+	require.True(t, rule.Matches(eventing.Event{Name: eventing.ObjectCreatedPut, ObjectKey: "images/a.png"}))
+	require.True(t, rule.Matches(eventing.Event{Name: eventing.ObjectCreatedCopy, ObjectKey: "images/b.png"}))
+	require.False(t, rule.Matches(eventing.Event{Name: eventing.ObjectRemovedDelete, ObjectKey: "images/a.png"}))
+	require.False(t, rule.Matches(eventing.Event{Name: eventing.ObjectCreatedPut, ObjectKey: "docs/a.png"}))
+	require.False(t, rule.Matches(eventing.Event{Name: eventing.ObjectCreatedPut, ObjectKey: "images/a.jpg"}))
+
+	rule = eventing.Rule{ID: "deletes", Events: []eventing.EventName{eventing.ObjectRemovedDelete}}
+	require.True(t, rule.Matches(eventing.Event{Name: eventing.ObjectRemovedDelete, ObjectKey: "any"}))
+	require.False(t, rule.Matches(eventing.Event{Name: eventing.ObjectCreatedPut, ObjectKey: "any"}))
+}
+
+func TestBucketConfigValidate(t *testing.T) {
+	webhook := eventing.Rule{
+		ID:     "webhook",
+		Events: []eventing.EventName{"s3:ObjectCreated:*"},
+		Target: eventing.Target{Type: eventing.TargetWebhook, URL: "https://example.test/hook"},
+	}
+	kafka := eventing.Rule{
+		ID:     "kafka",
+		Events: []eventing.EventName{eventing.ObjectRemovedDelete},
+		Target: eventing.Target{Type: eventing.TargetKafka, URL: "http://kafka-rest.test:8082", Topic: "events"},
+	}
+	sns := eventing.Rule{
+		ID:     "sns",
+		Events: []eventing.EventName{eventing.ObjectCreatedPut},
+		Target: eventing.Target{
+			Type: eventing.TargetSNS, URL: "https://sns.us-east-1.example.test/",
+			TopicARN: "arn:aws:sns:us-east-1:123456789012:events", Region: "us-east-1",
+			AccessKeyID: "access", SecretAccessKey: "secret",
+		},
+	}
+
+	config := eventing.BucketConfig{Rules: []eventing.Rule{webhook, kafka, sns}}
+	require.NoError(t, config.Validate())
+
+	invalid := []func(rule *eventing.Rule){
+		func(rule *eventing.Rule) { rule.ID = "" },
+		func(rule *eventing.Rule) { rule.ID = "kafka" },
+		func(rule *eventing.Rule) { rule.Events = nil },
+		func(rule *eventing.Rule) { rule.Events = []eventing.EventName{"s3:BucketCreated"} },
+		func(rule *eventing.Rule) { rule.Target.URL = "ftp://example.test" },
+		func(rule *eventing.Rule) { rule.Target.Type = "queue" },
+		func(rule *eventing.Rule) { rule.Target.Type = eventing.TargetKafka },
+		func(rule *eventing.Rule) { rule.Target.Type = eventing.TargetSNS },
+	}
+	for _, modify := range invalid {
+		rule := webhook
+		modify(&rule)
+		config := eventing.BucketConfig{Rules: []eventing.Rule{rule, kafka}}
+		require.Error(t, config.Validate())
+		require.True(t, eventing.ErrValidation.Has(config.Validate()))
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package eventing
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/lrucache"
+	"storj.io/common/sync2"
+)
+
+// Config contains configurable values for the bucket events.
+type Config struct {
+	Enabled bool `help:"whether to publish the object events to the targets configured for the buckets" default:"false"`
+
+	Workers     int           `help:"the number of events delivered concurrently" default:"4"`
+	QueueSize   int           `help:"the number of events waiting for the delivery, after which the events are dropped" default:"10000"`
+	MaxAttempts int           `help:"the number of attempts to deliver an event to a target" default:"5"`
+	RetryDelay  time.Duration `help:"the delay before the first retry, which doubles with each attempt" default:"1s" testDefault:"10ms"`
+	Timeout     time.Duration `help:"the timeout of a single delivery attempt" default:"10s"`
+
+	CacheCapacity   int           `help:"the number of cached bucket configurations" default:"10000"`
+	CacheExpiration time.Duration `help:"how long the bucket configurations are cached, i.e. how long it takes until a change is applied" default:"1m" testDefault:"1ms"`
+}
+
+// Service delivers the published events to the targets of the matching rules.
+//
+// architecture: Service
+type Service struct {
+	log    *zap.Logger
+	config Config
+	db     DB
+	client *http.Client
+
+	configs *lrucache.ExpiringLRUOf[BucketConfig]
+	queue   chan Event
+}
+
+// NewService creates a new service delivering the bucket events.
+func NewService(log *zap.Logger, db DB, config Config) *Service {
+	return &Service{
+		log:    log,
+		config: config,
+		db:     db,
+		client: &http.Client{Timeout: config.Timeout},
+		configs: lrucache.NewOf[BucketConfig](lrucache.Options{
+			Capacity:   config.CacheCapacity,
+			Expiration: config.CacheExpiration,
+			Name:       "eventing-bucket-configs",
+		}),
+		queue: make(chan Event, config.QueueSize),
+	}
+}
+
+// Publish queues the event for the delivery. It doesn't block, so the event is
+// dropped when the queue is full. A nil service drops the events.
+func (service *Service) Publish(ctx context.Context, event Event) {
+	if service == nil {
+		return
+	}
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+
+	select {
+	case service.queue <- event:
+	default:
+		mon.Event("eventing_event_dropped")
+	}
+}
+
+// Run delivers the queued events until the context is canceled.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	group, ctx := errgroup.WithContext(ctx)
+	for i := 0; i < service.config.Workers; i++ {
+		group.Go(func() error {
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case event := <-service.queue:
+					service.process(ctx, event)
+				}
+			}
+		})
+	}
+	return group.Wait()
+}
+
+// Close closes resources. The events, which weren't delivered yet, are lost.
+func (service *Service) Close() error { return nil }
+
+// process delivers the event to the targets of the rules of its bucket.
+func (service *Service) process(ctx context.Context, event Event) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	config, err := service.configs.Get(ctx, event.ProjectID.String()+"/"+event.Bucket, func() (BucketConfig, error) {
+		return service.db.Get(ctx, event.ProjectID, event.Bucket)
+	})
+	if err != nil {
+		service.log.Warn("unable to get the bucket configuration",
+			zap.Stringer("Project ID", event.ProjectID),
+			zap.String("Bucket", event.Bucket),
+			zap.Error(err))
+		return
+	}
+
+	for _, rule := range config.Rules {
+		if !rule.Matches(event) {
+			continue
+		}
+		if err := service.deliver(ctx, rule.Target, event); err != nil {
+			mon.Event("eventing_delivery_failed")
+			service.log.Warn("unable to deliver the event",
+				zap.Stringer("Project ID", event.ProjectID),
+				zap.String("Bucket", event.Bucket),
+				zap.String("Rule", rule.ID),
+				zap.String("Target", string(rule.Target.Type)),
+				zap.Error(err))
+			continue
+		}
+		mon.Event("eventing_delivered")
+	}
+}
+
+// deliver sends the event to the target, retrying with an exponential backoff.
+func (service *Service) deliver(ctx context.Context, target Target, event Event) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	delay := service.config.RetryDelay
+	for attempt := 1; ; attempt++ {
+		err = Send(ctx, service.client, target, event)
+		if err == nil || attempt >= service.config.MaxAttempts {
+			return err
+		}
+		if !sync2.Sleep(ctx, delay) {
+			return ctx.Err()
+		}
+		delay *= 2
+	}
+}
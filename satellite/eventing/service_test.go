@@ -0,0 +1,92 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package eventing_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/eventing"
+)
+
+type staticDB struct {
+	config eventing.BucketConfig
+}
+
+func (db *staticDB) Get(ctx context.Context, projectID uuid.UUID, bucketName string) (eventing.BucketConfig, error) {
+	if bucketName != "bucket" {
+		return eventing.BucketConfig{}, nil
+	}
+	return db.config, nil
+}
+
+func (db *staticDB) Set(ctx context.Context, projectID uuid.UUID, bucketName string, config eventing.BucketConfig) error {
+	db.config = config
+	return nil
+}
+
+func (db *staticDB) Delete(ctx context.Context, projectID uuid.UUID, bucketName string) error {
+	db.config = eventing.BucketConfig{}
+	return nil
+}
+
+func TestServiceRetries(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var attempts int32
+	delivered := make(chan struct{}, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		delivered <- struct{}{}
+	}))
+	defer server.Close()
+
+	db := &staticDB{config: eventing.BucketConfig{Rules: []eventing.Rule{{
+		ID:     "created",
+		Events: []eventing.EventName{"s3:ObjectCreated:*"},
+		Target: eventing.Target{Type: eventing.TargetWebhook, URL: server.URL},
+	}}}}
+
+	service := eventing.NewService(zaptest.NewLogger(t), db, eventing.Config{
+		Workers:       1,
+		QueueSize:     10,
+		MaxAttempts:   5,
+		RetryDelay:    time.Millisecond,
+		Timeout:       time.Second,
+		CacheCapacity: 10,
+	})
+
+	runCtx, cancel := context.WithCancel(ctx)
+	ctx.Go(func() error { return service.Run(runCtx) })
+	defer cancel()
+
+	projectID := testrand.UUID()
+	// neither the other bucket nor the deletion match the rule.
+	service.Publish(ctx, eventing.Event{Name: eventing.ObjectCreatedPut, ProjectID: projectID, Bucket: "other", ObjectKey: "key"})
+	service.Publish(ctx, eventing.Event{Name: eventing.ObjectRemovedDelete, ProjectID: projectID, Bucket: "bucket", ObjectKey: "key"})
+	service.Publish(ctx, eventing.Event{Name: eventing.ObjectCreatedPut, ProjectID: projectID, Bucket: "bucket", ObjectKey: "key"})
+
+	select {
+	case <-delivered:
+	case <-time.After(10 * time.Second):
+		t.Fatal("event wasn't delivered")
+	}
+	require.EqualValues(t, 3, atomic.LoadInt32(&attempts))
+
+	var nilService *eventing.Service
+	nilService.Publish(ctx, eventing.Event{Name: eventing.ObjectCreatedPut})
+}
@@ -0,0 +1,158 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package eventing
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// Send delivers the event to the target.
+func Send(ctx context.Context, client *http.Client, target Target, event Event) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	message, err := event.Message()
+	if err != nil {
+		return err
+	}
+
+	var req *http.Request
+	switch target.Type {
+	case TargetWebhook:
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, target.URL, bytes.NewReader(message))
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+	case TargetKafka:
+		req, err = newKafkaRequest(ctx, target, event, message)
+	case TargetSNS:
+		req, err = newSNSRequest(ctx, target, message, event.Time)
+	default:
+		return Error.New("unsupported target type %q", target.Type)
+	}
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return Error.New("%s target responded with %s", target.Type, resp.Status)
+	}
+	return nil
+}
+
+// newKafkaRequest produces the message to the topic using the v2 API of the Kafka REST proxy.
+// The records are keyed by the bucket, so the events of a bucket stay in order.
+func newKafkaRequest(ctx context.Context, target Target, event Event, message []byte) (*http.Request, error) {
+	type record struct {
+		Key   string          `json:"key"`
+		Value json.RawMessage `json:"value"`
+	}
+	body, err := json.Marshal(struct {
+		Records []record `json:"records"`
+	}{
+		Records: []record{{Key: event.Bucket, Value: message}},
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		strings.TrimSuffix(target.URL, "/")+"/topics/"+url.PathEscape(target.Topic), bytes.NewReader(body))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/vnd.kafka.json.v2+json")
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+	return req, nil
+}
+
+// newSNSRequest publishes the message to the topic with the query API of SNS,
+// signed with the AWS signature version 4.
+func newSNSRequest(ctx context.Context, target Target, message []byte, now time.Time) (*http.Request, error) {
+	body := url.Values{
+		"Action":   {"Publish"},
+		"Version":  {"2010-03-31"},
+		"TopicArn": {target.TopicARN},
+		"Message":  {string(message)},
+	}.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.URL, strings.NewReader(body))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	signV4(req, []byte(body), "sns", target.Region, target.AccessKeyID, target.SecretAccessKey, now)
+	return req, nil
+}
+
+// signV4 adds the AWS signature version 4 to the request, which has no query.
+func signV4(req *http.Request, body []byte, service, region, accessKeyID, secretAccessKey string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	const signedHeaders = "content-type;host;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		req.URL.RawQuery,
+		"content-type:" + req.Header.Get("Content-Type") + "\n" +
+			"host:" + req.URL.Host + "\n" +
+			"x-amz-date:" + amzDate + "\n",
+		signedHeaders,
+		hexSHA256(body),
+	}, "\n")
+
+	scope := date + "/" + region + "/" + service + "/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hexSHA256([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+accessKeyID+"/"+scope+
+		", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
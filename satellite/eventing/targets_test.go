@@ -0,0 +1,130 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package eventing_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/eventing"
+)
+
+func TestSend(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	event := eventing.Event{
+		Name:      eventing.ObjectCreatedPut,
+		Time:      time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC),
+		ProjectID: testrand.UUID(),
+		Bucket:    "bucket",
+		ObjectKey: "a/b",
+		Version:   1,
+		Size:      100,
+	}
+	message, err := event.Message()
+	require.NoError(t, err)
+
+	var records struct {
+		Records []struct {
+			EventName string `json:"eventName"`
+			S3        struct {
+				Bucket struct {
+					Name string `json:"name"`
+				} `json:"bucket"`
+				Object struct {
+					Key  string `json:"key"`
+					Size int64  `json:"size"`
+				} `json:"object"`
+			} `json:"s3"`
+		} `json:"Records"`
+	}
+	require.NoError(t, json.Unmarshal(message, &records))
+	require.Len(t, records.Records, 1)
+	require.Equal(t, "ObjectCreated:Put", records.Records[0].EventName)
+	require.Equal(t, "bucket", records.Records[0].S3.Bucket.Name)
+	require.Equal(t, "a%2Fb", records.Records[0].S3.Object.Key)
+	require.EqualValues(t, 100, records.Records[0].S3.Object.Size)
+
+	requests := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		requests <- r
+		bodies <- body
+	}))
+	defer server.Close()
+
+	t.Run("webhook", func(t *testing.T) {
+		err := eventing.Send(ctx, server.Client(), eventing.Target{Type: eventing.TargetWebhook, URL: server.URL + "/hook"}, event)
+		require.NoError(t, err)
+
+		r, body := <-requests, <-bodies
+		require.Equal(t, "/hook", r.URL.Path)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.JSONEq(t, string(message), string(body))
+	})
+
+	t.Run("kafka", func(t *testing.T) {
+		err := eventing.Send(ctx, server.Client(), eventing.Target{Type: eventing.TargetKafka, URL: server.URL, Topic: "events"}, event)
+		require.NoError(t, err)
+
+		r, body := <-requests, <-bodies
+		require.Equal(t, "/topics/events", r.URL.Path)
+		require.Equal(t, "application/vnd.kafka.json.v2+json", r.Header.Get("Content-Type"))
+
+		var produce struct {
+			Records []struct {
+				Key   string          `json:"key"`
+				Value json.RawMessage `json:"value"`
+			} `json:"records"`
+		}
+		require.NoError(t, json.Unmarshal(body, &produce))
+		require.Len(t, produce.Records, 1)
+		require.Equal(t, "bucket", produce.Records[0].Key)
+		require.JSONEq(t, string(message), string(produce.Records[0].Value))
+	})
+
+	t.Run("sns", func(t *testing.T) {
+		err := eventing.Send(ctx, server.Client(), eventing.Target{
+			Type: eventing.TargetSNS, URL: server.URL,
+			TopicARN: "arn:aws:sns:us-east-1:123456789012:events", Region: "us-east-1",
+			AccessKeyID: "access", SecretAccessKey: "secret",
+		}, event)
+		require.NoError(t, err)
+
+		r, body := <-requests, <-bodies
+		require.Equal(t, "20230601T120000Z", r.Header.Get("X-Amz-Date"))
+		authorization := r.Header.Get("Authorization")
+		require.True(t, strings.HasPrefix(authorization,
+			"AWS4-HMAC-SHA256 Credential=access/20230601/us-east-1/sns/aws4_request, SignedHeaders=content-type;host;x-amz-date, Signature="),
+			authorization)
+
+		form, err := url.ParseQuery(string(body))
+		require.NoError(t, err)
+		require.Equal(t, "Publish", form.Get("Action"))
+		require.Equal(t, "arn:aws:sns:us-east-1:123456789012:events", form.Get("TopicArn"))
+		require.JSONEq(t, string(message), form.Get("Message"))
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer failing.Close()
+
+		err := eventing.Send(ctx, failing.Client(), eventing.Target{Type: eventing.TargetWebhook, URL: failing.URL}, event)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,73 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package featureflags implements the feature flags, which roll out behaviors of the
+// satellite gradually without a restart, e.g. a new node selection or a billing change.
+// A flag enables its behavior for a percentage of the projects or the users, which are
+// selected by a hash of the flag name and their id, so that increasing the percentage
+// only adds projects or users to the cohort.
+package featureflags
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+)
+
+var (
+	// Error is the default error class for the feature flags.
+	Error = errs.Class("feature flags")
+	// ErrNotFound is returned when the flag doesn't exist.
+	ErrNotFound = errs.Class("feature flag not found")
+	// ErrInvalid is returned when the flag is malformed.
+	ErrInvalid = errs.Class("invalid feature flag")
+
+	mon = monkit.Package()
+)
+
+// Names of the flags, which the satellite checks.
+const (
+	// ListingQuery enables the new query for the non-recursive listings per project.
+	ListingQuery = "listing-query"
+)
+
+// namePattern is the format of the flag names.
+var namePattern = regexp.MustCompile(`^[a-z0-9]+([.-][a-z0-9]+)*$`)
+
+// maxNameLength is the longest name of a flag.
+const maxNameLength = 64
+
+// Config contains the configuration of the feature flags.
+type Config struct {
+	CacheExpiration time.Duration `help:"how long the feature flags are cached before they are reread from the database" default:"1m" testDefault:"$TESTINTERVAL"`
+}
+
+// Flag is a feature flag.
+type Flag struct {
+	Name        string
+	Description string
+	// Enabled switches the behavior on for the percentage of the projects or users.
+	Enabled bool
+	// Percentage is the part of the projects or users from 0 to 100, for which
+	// the behavior is enabled.
+	Percentage int
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// DB stores the feature flags.
+//
+// architecture: Database
+type DB interface {
+	// Upsert creates or replaces the flag. The creation time of an existing flag is kept.
+	Upsert(ctx context.Context, flag Flag) error
+	// Get returns the flag.
+	Get(ctx context.Context, name string) (Flag, error)
+	// List returns all flags ordered by name.
+	List(ctx context.Context) ([]Flag, error)
+	// Delete deletes the flag.
+	Delete(ctx context.Context, name string) error
+}
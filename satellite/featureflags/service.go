@@ -0,0 +1,206 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package featureflags
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+)
+
+// SetFlag contains the fields of a flag to create or change.
+type SetFlag struct {
+	Name        string
+	Description string
+	Enabled     bool
+	Percentage  int
+}
+
+// Service checks whether the behaviors are enabled for the projects or the users
+// and manages the flags.
+//
+// The flags are cached, hence the changes are applied by the other satellite
+// processes within the cache expiration.
+//
+// architecture: Service
+type Service struct {
+	log    *zap.Logger
+	db     DB
+	config Config
+
+	nowFn func() time.Time
+	cache sync2.ReadCacheOf[map[string]Flag]
+}
+
+// NewService creates a new feature flags service.
+func NewService(log *zap.Logger, db DB, config Config) (*Service, error) {
+	service := &Service{
+		log:    log,
+		db:     db,
+		config: config,
+
+		nowFn: time.Now,
+	}
+	return service, service.cache.Init(config.CacheExpiration/2, config.CacheExpiration, service.read)
+}
+
+// Run runs the background refreshes of the cached flags.
+func (service *Service) Run(ctx context.Context) error {
+	return service.cache.Run(ctx)
+}
+
+// Refresh rereads the cached flags from the database.
+func (service *Service) Refresh(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	_, err = service.cache.RefreshAndGet(ctx, service.nowFn())
+	return err
+}
+
+// TestSetNow allows tests to have the service act as if the current time is
+// whatever they want.
+func (service *Service) TestSetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
+func (service *Service) read(ctx context.Context) (_ map[string]Flag, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	list, err := service.db.List(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	flags := make(map[string]Flag, len(list))
+	for _, flag := range list {
+		flags[flag.Name] = flag
+	}
+	return flags, nil
+}
+
+// Enabled returns whether the behavior of the flag is enabled for the project or
+// the user with the id. The unknown flags and the flags, which couldn't be read,
+// are disabled.
+func (service *Service) Enabled(ctx context.Context, name string, id uuid.UUID) bool {
+	flags, err := service.cache.Get(ctx, service.nowFn())
+	if err != nil {
+		service.log.Warn("unable to read feature flags", zap.String("flag", name), zap.Error(err))
+		return false
+	}
+
+	flag, ok := flags[name]
+	if !ok || !flag.Enabled {
+		return false
+	}
+	return InCohort(name, id, flag.Percentage)
+}
+
+// InCohort returns whether the project or the user with the id is in the cohort of
+// the percentage of the flag. The id is in the cohort for all the percentages above
+// its hash, so increasing the percentage doesn't remove anyone from the cohort.
+func InCohort(name string, id uuid.UUID, percentage int) bool {
+	if percentage <= 0 {
+		return false
+	}
+	if percentage >= 100 {
+		return true
+	}
+
+	hash := sha256.New()
+	_, _ = hash.Write([]byte(name))
+	_, _ = hash.Write([]byte{0})
+	_, _ = hash.Write(id[:])
+	sum := hash.Sum(nil)
+
+	return binary.BigEndian.Uint64(sum[:8])%100 < uint64(percentage)
+}
+
+// Set creates or changes the flag.
+func (service *Service) Set(ctx context.Context, set SetFlag) (_ Flag, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := ValidateName(set.Name); err != nil {
+		return Flag{}, err
+	}
+	if set.Percentage < 0 || set.Percentage > 100 {
+		return Flag{}, ErrInvalid.New("percentage must be between 0 and 100, got %d", set.Percentage)
+	}
+
+	now := service.nowFn().UTC()
+	flag := Flag{
+		Name:        set.Name,
+		Description: set.Description,
+		Enabled:     set.Enabled,
+		Percentage:  set.Percentage,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := service.db.Upsert(ctx, flag); err != nil {
+		return Flag{}, Error.Wrap(err)
+	}
+
+	flag, err = service.db.Get(ctx, set.Name)
+	if err != nil {
+		return Flag{}, Error.Wrap(err)
+	}
+
+	service.log.Info("feature flag changed",
+		zap.String("flag", flag.Name),
+		zap.Bool("enabled", flag.Enabled),
+		zap.Int("percentage", flag.Percentage))
+	return flag, nil
+}
+
+// Get returns the flag from the database.
+func (service *Service) Get(ctx context.Context, name string) (_ Flag, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	flag, err := service.db.Get(ctx, name)
+	if err != nil {
+		if ErrNotFound.Has(err) {
+			return Flag{}, err
+		}
+		return Flag{}, Error.Wrap(err)
+	}
+	return flag, nil
+}
+
+// List returns all flags from the database ordered by name.
+func (service *Service) List(ctx context.Context) (_ []Flag, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	flags, err := service.db.List(ctx)
+	return flags, Error.Wrap(err)
+}
+
+// Delete deletes the flag, which disables its behavior for everyone.
+func (service *Service) Delete(ctx context.Context, name string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := service.db.Delete(ctx, name); err != nil {
+		if ErrNotFound.Has(err) {
+			return err
+		}
+		return Error.Wrap(err)
+	}
+
+	service.log.Info("feature flag deleted", zap.String("flag", name))
+	return nil
+}
+
+// ValidateName checks whether the name is a valid flag name, e.g. "listing-query".
+func ValidateName(name string) error {
+	if len(name) > maxNameLength {
+		return ErrInvalid.New("name is longer than %d characters", maxNameLength)
+	}
+	if !namePattern.MatchString(name) {
+		return ErrInvalid.New("name %q must consist of lowercase letters and digits separated by dots or dashes", name)
+	}
+	return nil
+}
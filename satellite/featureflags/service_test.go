@@ -0,0 +1,158 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package featureflags_test
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/featureflags"
+)
+
+func TestService(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	service, err := featureflags.NewService(zaptest.NewLogger(t), newFlagsDB(), featureflags.Config{
+		CacheExpiration: time.Hour,
+	})
+	require.NoError(t, err)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ctx.Go(func() error { return service.Run(runCtx) })
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, name := range []string{"", "Listing", "listing query", "-listing", "listing-"} {
+			_, err := service.Set(ctx, featureflags.SetFlag{Name: name, Enabled: true})
+			require.True(t, featureflags.ErrInvalid.Has(err), name)
+		}
+		for _, percentage := range []int{-1, 101} {
+			_, err := service.Set(ctx, featureflags.SetFlag{Name: "listing", Percentage: percentage})
+			require.True(t, featureflags.ErrInvalid.Has(err), percentage)
+		}
+	})
+
+	project := testrand.UUID()
+	require.False(t, service.Enabled(ctx, featureflags.ListingQuery, project))
+
+	flag, err := service.Set(ctx, featureflags.SetFlag{
+		Name:       featureflags.ListingQuery,
+		Enabled:    true,
+		Percentage: 100,
+	})
+	require.NoError(t, err)
+	require.True(t, flag.Enabled)
+
+	// the flags are cached.
+	require.False(t, service.Enabled(ctx, featureflags.ListingQuery, project))
+	require.NoError(t, service.Refresh(ctx))
+	require.True(t, service.Enabled(ctx, featureflags.ListingQuery, project))
+
+	_, err = service.Set(ctx, featureflags.SetFlag{
+		Name:       featureflags.ListingQuery,
+		Enabled:    false,
+		Percentage: 100,
+	})
+	require.NoError(t, err)
+	require.NoError(t, service.Refresh(ctx))
+	require.False(t, service.Enabled(ctx, featureflags.ListingQuery, project))
+
+	require.NoError(t, service.Delete(ctx, featureflags.ListingQuery))
+	require.True(t, featureflags.ErrNotFound.Has(service.Delete(ctx, featureflags.ListingQuery)))
+	_, err = service.Get(ctx, featureflags.ListingQuery)
+	require.True(t, featureflags.ErrNotFound.Has(err))
+}
+
+func TestInCohort(t *testing.T) {
+	ids := make([]uuid.UUID, 1000)
+	for i := range ids {
+		ids[i] = testrand.UUID()
+	}
+
+	percentages := []int{0, 10, 50, 90, 100}
+	counts := make([]int, len(percentages))
+	for _, id := range ids {
+		inCohort := false
+		for i, percentage := range percentages {
+			if featureflags.InCohort("listing-query", id, percentage) {
+				inCohort = true
+				counts[i]++
+			} else {
+				// the increased percentage keeps the ids of the smaller one.
+				require.False(t, inCohort)
+			}
+		}
+	}
+	for i, percentage := range percentages {
+		require.InDelta(t, len(ids)*percentage/100, counts[i], 60, percentage)
+	}
+
+	// the cohorts of the flags are independent.
+	var both int
+	for _, id := range ids {
+		if featureflags.InCohort("listing-query", id, 50) && featureflags.InCohort("node-selection", id, 50) {
+			both++
+		}
+	}
+	require.InDelta(t, len(ids)/4, both, 60)
+}
+
+type flagsDB struct {
+	mu    sync.Mutex
+	flags map[string]featureflags.Flag
+}
+
+func newFlagsDB() *flagsDB {
+	return &flagsDB{flags: map[string]featureflags.Flag{}}
+}
+
+func (db *flagsDB) Upsert(ctx context.Context, flag featureflags.Flag) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if existing, ok := db.flags[flag.Name]; ok {
+		flag.CreatedAt = existing.CreatedAt
+	}
+	db.flags[flag.Name] = flag
+	return nil
+}
+
+func (db *flagsDB) Get(ctx context.Context, name string) (featureflags.Flag, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	flag, ok := db.flags[name]
+	if !ok {
+		return featureflags.Flag{}, featureflags.ErrNotFound.New("%s", name)
+	}
+	return flag, nil
+}
+
+func (db *flagsDB) List(ctx context.Context) ([]featureflags.Flag, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	flags := make([]featureflags.Flag, 0, len(db.flags))
+	for _, flag := range db.flags {
+		flags = append(flags, flag)
+	}
+	sort.Slice(flags, func(i, k int) bool { return flags[i].Name < flags[k].Name })
+	return flags, nil
+}
+
+func (db *flagsDB) Delete(ctx context.Context, name string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if _, ok := db.flags[name]; !ok {
+		return featureflags.ErrNotFound.New("%s", name)
+	}
+	delete(db.flags, name)
+	return nil
+}
@@ -17,12 +17,14 @@ import (
 	"storj.io/common/peertls/extensions"
 	"storj.io/private/debug"
 	"storj.io/private/version"
+	"storj.io/storj/private/clock"
 	"storj.io/storj/private/lifecycle"
 	"storj.io/storj/satellite/gc/bloomfilter"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/rangedloop"
 	"storj.io/storj/satellite/metabase/segmentloop"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/reload"
 )
 
 // GarbageCollectionBF is the satellite garbage collection process which collects bloom filters.
@@ -35,6 +37,8 @@ type GarbageCollectionBF struct {
 	Servers  *lifecycle.Group
 	Services *lifecycle.Group
 
+	Clock clock.Clock
+
 	Debug struct {
 		Listener net.Listener
 		Server   *debug.Server
@@ -56,6 +60,10 @@ type GarbageCollectionBF struct {
 	RangedLoop struct {
 		Service *rangedloop.Service
 	}
+
+	Reload struct {
+		Registry *reload.Registry
+	}
 }
 
 // NewGarbageCollectionBF creates a new satellite garbage collection peer which collects storage nodes bloom filters.
@@ -88,6 +96,16 @@ func NewGarbageCollectionBF(log *zap.Logger, db DB, metabaseDB *metabase.DB, rev
 		})
 	}
 
+	peer.Clock = newClock(config.SimulateClock, peer.Debug.Server.Panel)
+
+	{ // setup configuration reload
+		peer.Reload.Registry = reload.NewRegistry(peer.Log.Named("reload"), config.Reload)
+		peer.Services.Add(lifecycle.Item{
+			Name: "reload",
+			Run:  peer.Reload.Registry.Run,
+		})
+	}
+
 	{ // setup overlay
 		peer.Overlay.DB = peer.DB.OverlayCache()
 	}
@@ -100,19 +118,34 @@ func NewGarbageCollectionBF(log *zap.Logger, db DB, metabaseDB *metabase.DB, rev
 
 			var observer rangedloop.Observer
 			if config.GarbageCollectionBF.UseSyncObserver {
-				observer = bloomfilter.NewSyncObserver(log.Named("gc-bf"),
+				syncObserver := bloomfilter.NewSyncObserver(log.Named("gc-bf"),
 					config.GarbageCollectionBF,
 					peer.Overlay.DB,
 				)
+				syncObserver.SetClock(peer.Clock)
+				peer.Reload.Registry.Register("garbage-collection-bf.false-positive-rate", reload.Float64(
+					syncObserver.FalsePositiveRate,
+					syncObserver.SetFalsePositiveRate,
+					bloomfilter.ValidateFalsePositiveRate,
+				))
+				observer = syncObserver
 			} else {
-				observer = bloomfilter.NewObserver(log.Named("gc-bf"),
+				bfObserver := bloomfilter.NewObserver(log.Named("gc-bf"),
 					config.GarbageCollectionBF,
 					peer.Overlay.DB,
 				)
+				bfObserver.SetClock(peer.Clock)
+				peer.Reload.Registry.Register("garbage-collection-bf.false-positive-rate", reload.Float64(
+					bfObserver.FalsePositiveRate,
+					bfObserver.SetFalsePositiveRate,
+					bloomfilter.ValidateFalsePositiveRate,
+				))
+				observer = bfObserver
 			}
 
 			provider := rangedloop.NewMetabaseRangeSplitter(metabaseDB, config.RangedLoop.AsOfSystemInterval, config.RangedLoop.BatchSize)
 			peer.RangedLoop.Service = rangedloop.NewService(log.Named("rangedloop"), config.RangedLoop, provider, []rangedloop.Observer{observer})
+			peer.RangedLoop.Service.SetCycleLeases(db.RangedLoopLeases(), "gc-bf")
 
 			if !config.GarbageCollectionBF.RunOnce {
 				peer.Services.Add(lifecycle.Item{
@@ -145,6 +178,7 @@ func NewGarbageCollectionBF(log *zap.Logger, db DB, metabaseDB *metabase.DB, rev
 				peer.Overlay.DB,
 				peer.Metainfo.SegmentLoop,
 			)
+			peer.GarbageCollection.Service.SetClock(peer.Clock)
 
 			if !config.GarbageCollectionBF.RunOnce {
 				peer.Services.Add(lifecycle.Item{
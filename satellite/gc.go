@@ -21,6 +21,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/private/debug"
 	"storj.io/private/version"
+	"storj.io/storj/private/clock"
 	"storj.io/storj/private/lifecycle"
 	version_checker "storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/gc/sender"
@@ -39,6 +40,8 @@ type GarbageCollection struct {
 	Servers  *lifecycle.Group
 	Services *lifecycle.Group
 
+	Clock clock.Clock
+
 	Dialer rpc.Dialer
 
 	Version struct {
@@ -92,6 +95,8 @@ func NewGarbageCollection(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 	}
 
+	peer.Clock = newClock(config.SimulateClock, peer.Debug.Server.Panel)
+
 	{ // setup version control
 		peer.Log.Info("Version info",
 			zap.Stringer("Version", versionInfo.Version.Version),
@@ -130,6 +135,7 @@ func NewGarbageCollection(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.Dialer,
 			peer.Overlay.DB,
 		)
+		peer.GarbageCollection.Sender.SetClock(peer.Clock)
 
 		peer.Services.Add(lifecycle.Item{
 			Name: "gc-sender",
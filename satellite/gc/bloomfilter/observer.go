@@ -5,6 +5,8 @@ package bloomfilter
 
 import (
 	"context"
+	"math"
+	"sync/atomic"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -12,6 +14,7 @@ import (
 
 	"storj.io/common/bloomfilter"
 	"storj.io/common/storj"
+	"storj.io/storj/private/clock"
 	"storj.io/storj/satellite/metabase/rangedloop"
 	"storj.io/storj/satellite/metabase/segmentloop"
 	"storj.io/storj/satellite/overlay"
@@ -26,6 +29,10 @@ type Observer struct {
 	upload  *Upload
 	overlay overlay.DB
 
+	// falsePositiveRate contains math.Float64bits of the false positive rate,
+	// which is used from the next loop. It can be changed by SetFalsePositiveRate.
+	falsePositiveRate uint64
+
 	// The following fields are reset for each loop.
 	startTime          time.Time
 	lastPieceCounts    map[storj.NodeID]int64
@@ -43,9 +50,28 @@ func NewObserver(log *zap.Logger, config Config, overlay overlay.DB) *Observer {
 		overlay: overlay,
 		upload:  NewUpload(log, config),
 		config:  config,
+
+		falsePositiveRate: math.Float64bits(config.FalsePositiveRate),
 	}
 }
 
+// SetClock sets the clock, which is used for the prefixes and the expiration of the
+// uploaded bloom filters.
+func (obs *Observer) SetClock(clock clock.Clock) {
+	obs.upload.SetClock(clock)
+}
+
+// FalsePositiveRate returns the false positive rate of the bloom filters.
+func (obs *Observer) FalsePositiveRate() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&obs.falsePositiveRate))
+}
+
+// SetFalsePositiveRate changes the false positive rate of the bloom filters,
+// which are created from the next loop.
+func (obs *Observer) SetFalsePositiveRate(rate float64) {
+	atomic.StoreUint64(&obs.falsePositiveRate, math.Float64bits(rate))
+}
+
 // Start is called at the beginning of each segment loop.
 func (obs *Observer) Start(ctx context.Context, startTime time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -54,6 +80,8 @@ func (obs *Observer) Start(ctx context.Context, startTime time.Time) (err error)
 		return err
 	}
 
+	obs.config.FalsePositiveRate = obs.FalsePositiveRate()
+
 	obs.log.Debug("collecting bloom filters started")
 
 	// load last piece counts from overlay db
@@ -100,6 +128,7 @@ func (obs *Observer) Join(ctx context.Context, partial rangedloop.Partial) (err
 	for nodeID, retainInfo := range pieceTracker.RetainInfos {
 		if existing, ok := obs.retainInfos[nodeID]; ok {
 			existing.Count += retainInfo.Count
+			existing.Bytes += retainInfo.Bytes
 			if err := existing.Filter.AddFilter(retainInfo.Filter); err != nil {
 				return err
 			}
@@ -122,6 +151,7 @@ func (obs *Observer) Finish(ctx context.Context) (err error) {
 	if err := obs.upload.UploadBloomFilters(ctx, obs.latestCreationTime, obs.retainInfos); err != nil {
 		return err
 	}
+	updateStorageEstimates(ctx, obs.log, obs.overlay, obs.startTime, obs.retainInfos)
 	obs.log.Debug("collecting bloom filters finished")
 	return nil
 }
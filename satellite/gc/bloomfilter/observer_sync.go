@@ -5,7 +5,9 @@ package bloomfilter
 
 import (
 	"context"
+	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -14,6 +16,7 @@ import (
 	"storj.io/common/bloomfilter"
 	"storj.io/common/memory"
 	"storj.io/common/storj"
+	"storj.io/storj/private/clock"
 	"storj.io/storj/satellite/metabase/rangedloop"
 	"storj.io/storj/satellite/metabase/segmentloop"
 	"storj.io/storj/satellite/overlay"
@@ -26,6 +29,10 @@ type SyncObserver struct {
 	overlay overlay.DB
 	upload  *Upload
 
+	// falsePositiveRate contains math.Float64bits of the false positive rate,
+	// which is used from the next loop. It can be changed by SetFalsePositiveRate.
+	falsePositiveRate uint64
+
 	// The following fields are reset for each loop.
 	startTime       time.Time
 	lastPieceCounts map[storj.NodeID]int64
@@ -48,9 +55,28 @@ func NewSyncObserver(log *zap.Logger, config Config, overlay overlay.DB) *SyncOb
 		overlay: overlay,
 		upload:  NewUpload(log, config),
 		config:  config,
+
+		falsePositiveRate: math.Float64bits(config.FalsePositiveRate),
 	}
 }
 
+// SetClock sets the clock, which is used for the prefixes and the expiration of the
+// uploaded bloom filters.
+func (obs *SyncObserver) SetClock(clock clock.Clock) {
+	obs.upload.SetClock(clock)
+}
+
+// FalsePositiveRate returns the false positive rate of the bloom filters.
+func (obs *SyncObserver) FalsePositiveRate() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&obs.falsePositiveRate))
+}
+
+// SetFalsePositiveRate changes the false positive rate of the bloom filters,
+// which are created from the next loop.
+func (obs *SyncObserver) SetFalsePositiveRate(rate float64) {
+	atomic.StoreUint64(&obs.falsePositiveRate, math.Float64bits(rate))
+}
+
 // Start is called at the beginning of each segment loop.
 func (obs *SyncObserver) Start(ctx context.Context, startTime time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -61,6 +87,8 @@ func (obs *SyncObserver) Start(ctx context.Context, startTime time.Time) (err er
 		return errs.New("Bucket is not set")
 	}
 
+	obs.config.FalsePositiveRate = obs.FalsePositiveRate()
+
 	obs.log.Debug("collecting bloom filters started")
 
 	// load last piece counts from overlay db
@@ -98,6 +126,7 @@ func (obs *SyncObserver) Finish(ctx context.Context) (err error) {
 	if err := obs.upload.UploadBloomFilters(ctx, obs.latestCreationTime, obs.retainInfos); err != nil {
 		return err
 	}
+	updateStorageEstimates(ctx, obs.log, obs.overlay, obs.startTime, obs.retainInfos)
 	obs.log.Debug("collecting bloom filters finished")
 	return nil
 }
@@ -122,10 +151,11 @@ func (obs *SyncObserver) Process(ctx context.Context, segments []segmentloop.Seg
 			latestCreationTime = segment.CreatedAt
 		}
 
+		pieceSize := segment.PieceSize()
 		deriver := segment.RootPieceID.Deriver()
 		for _, piece := range segment.Pieces {
 			pieceID := deriver.Derive(piece.StorageNode, int32(piece.Number))
-			obs.add(piece.StorageNode, pieceID)
+			obs.add(piece.StorageNode, pieceID, pieceSize)
 		}
 	}
 
@@ -140,7 +170,7 @@ func (obs *SyncObserver) Process(ctx context.Context, segments []segmentloop.Seg
 }
 
 // add adds a pieceID to the relevant node's RetainInfo.
-func (obs *SyncObserver) add(nodeID storj.NodeID, pieceID storj.PieceID) {
+func (obs *SyncObserver) add(nodeID storj.NodeID, pieceID storj.PieceID, pieceSize int64) {
 	obs.mu.Lock()
 	defer obs.mu.Unlock()
 
@@ -163,4 +193,5 @@ func (obs *SyncObserver) add(nodeID storj.NodeID, pieceID storj.PieceID) {
 
 	info.Filter.Add(pieceID)
 	info.Count++
+	info.Bytes += pieceSize
 }
@@ -22,6 +22,8 @@ var _ segmentloop.Observer = (*PieceTracker)(nil)
 type RetainInfo struct {
 	Filter *bloomfilter.Filter
 	Count  int
+	// Bytes is the total size of the pieces added to the filter.
+	Bytes int64
 }
 
 // PieceTracker implements the segments loop observer interface for garbage collection.
@@ -83,17 +85,18 @@ func (pieceTracker *PieceTracker) RemoteSegment(ctx context.Context, segment *se
 		pieceTracker.LatestCreationTime = segment.CreatedAt
 	}
 
+	pieceSize := segment.PieceSize()
 	deriver := segment.RootPieceID.Deriver()
 	for _, piece := range segment.Pieces {
 		pieceID := deriver.Derive(piece.StorageNode, int32(piece.Number))
-		pieceTracker.add(piece.StorageNode, pieceID)
+		pieceTracker.add(piece.StorageNode, pieceID, pieceSize)
 	}
 
 	return nil
 }
 
 // add adds a pieceID to the relevant node's RetainInfo.
-func (pieceTracker *PieceTracker) add(nodeID storj.NodeID, pieceID storj.PieceID) {
+func (pieceTracker *PieceTracker) add(nodeID storj.NodeID, pieceID storj.PieceID, pieceSize int64) {
 	info, ok := pieceTracker.RetainInfos[nodeID]
 	if !ok {
 		// If we know how many pieces a node should be storing, use that number. Otherwise use default.
@@ -113,6 +116,7 @@ func (pieceTracker *PieceTracker) add(nodeID storj.NodeID, pieceID storj.PieceID
 
 	info.Filter.Add(pieceID)
 	info.Count++
+	info.Bytes += pieceSize
 }
 
 // InlineSegment returns nil because we're only doing gc for storage nodes for now.
@@ -16,6 +16,7 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/private/clock"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase/segmentloop"
 	"storj.io/storj/satellite/overlay"
@@ -45,6 +46,14 @@ type Config struct {
 	ExpireIn     time.Duration `help:"how long bloom filters will remain in the bucket for gc/sender to consume before being automatically deleted" default:"336h"`
 }
 
+// ValidateFalsePositiveRate checks whether the rate can be used for the bloom filters.
+func ValidateFalsePositiveRate(rate float64) error {
+	if rate <= 0 || rate >= 1 {
+		return errs.New("false positive rate must be between 0 and 1, got %v", rate)
+	}
+	return nil
+}
+
 // Service implements service to collect bloom filters for the garbage collection.
 //
 // architecture: Chore
@@ -55,6 +64,7 @@ type Service struct {
 
 	overlay     overlay.DB
 	segmentLoop *segmentloop.Service
+	clock       clock.Clock
 }
 
 // NewService creates a new instance of the gc service.
@@ -65,9 +75,16 @@ func NewService(log *zap.Logger, config Config, overlay overlay.DB, loop *segmen
 		Loop:        sync2.NewCycle(config.Interval),
 		overlay:     overlay,
 		segmentLoop: loop,
+		clock:       clock.Real{},
 	}
 }
 
+// SetClock sets the clock, which is used for the prefixes and the expiration of the
+// uploaded bloom filters.
+func (service *Service) SetClock(clock clock.Clock) {
+	service.clock = clock
+}
+
 // Run starts the gc loop service.
 func (service *Service) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -116,11 +133,41 @@ func (service *Service) RunOnce(ctx context.Context) (err error) {
 		return err
 	}
 
+	updateStorageEstimates(ctx, service.log, service.overlay, pieceTracker.startTime, pieceTracker.RetainInfos)
+
 	service.log.Debug("collecting bloom filters finished")
 
 	return nil
 }
 
+// updateStorageEstimates stores the piece counts and the stored bytes gathered
+// by the loop started at estimatedAt as the storage estimates of the nodes.
+// Failures are only logged, because the estimates are not needed for the
+// garbage collection itself.
+func updateStorageEstimates(ctx context.Context, log *zap.Logger, db overlay.DB, estimatedAt time.Time, retainInfos map[storj.NodeID]*RetainInfo) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if len(retainInfos) == 0 {
+		return
+	}
+
+	estimates := make([]overlay.StorageEstimate, 0, len(retainInfos))
+	for nodeID, info := range retainInfos {
+		estimates = append(estimates, overlay.StorageEstimate{
+			NodeID:      nodeID,
+			PieceCount:  int64(info.Count),
+			StoredBytes: info.Bytes,
+			EstimatedAt: estimatedAt,
+		})
+	}
+
+	err = db.UpdateStorageEstimates(ctx, estimates)
+	if err != nil {
+		log.Error("error updating storage estimates", zap.Error(err))
+	}
+}
+
 // uploadBloomFilters stores a zipfile with multiple bloom filters in a bucket.
 func (service *Service) uploadBloomFilters(ctx context.Context, latestCreationDate time.Time, retainInfos map[storj.NodeID]*RetainInfo) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -129,9 +176,9 @@ func (service *Service) uploadBloomFilters(ctx context.Context, latestCreationDa
 		return nil
 	}
 
-	prefix := time.Now().Format(time.RFC3339)
+	prefix := service.clock.Now().Format(time.RFC3339)
 
-	expirationTime := time.Now().Add(service.config.ExpireIn)
+	expirationTime := service.clock.Now().Add(service.config.ExpireIn)
 
 	accessGrant, err := uplink.ParseAccess(service.config.AccessGrant)
 	if err != nil {
@@ -264,7 +311,7 @@ func (service *Service) uploadPack(ctx context.Context, project *uplink.Project,
 func (service *Service) cleanup(ctx context.Context, project *uplink.Project, prefix string) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	errPrefix := "upload-error-" + time.Now().Format(time.RFC3339)
+	errPrefix := "upload-error-" + service.clock.Now().Format(time.RFC3339)
 	o := uplink.ListObjectsOptions{
 		Prefix: prefix + "/",
 	}
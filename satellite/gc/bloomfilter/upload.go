@@ -14,6 +14,7 @@ import (
 
 	"storj.io/common/pb"
 	"storj.io/common/storj"
+	"storj.io/storj/private/clock"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/uplink"
 )
@@ -25,6 +26,7 @@ const LATEST = "LATEST"
 type Upload struct {
 	log    *zap.Logger
 	config Config
+	clock  clock.Clock
 }
 
 // NewUpload creates new upload for bloom filters.
@@ -32,9 +34,16 @@ func NewUpload(log *zap.Logger, config Config) *Upload {
 	return &Upload{
 		log:    log,
 		config: config,
+		clock:  clock.Real{},
 	}
 }
 
+// SetClock sets the clock, which is used for the prefixes and the expiration of the
+// uploaded bloom filters.
+func (bfu *Upload) SetClock(clock clock.Clock) {
+	bfu.clock = clock
+}
+
 // CheckConfig check configuration values.
 func (bfu *Upload) CheckConfig() error {
 	switch {
@@ -54,9 +63,9 @@ func (bfu *Upload) UploadBloomFilters(ctx context.Context, latestCreationDate ti
 		return nil
 	}
 
-	prefix := time.Now().Format(time.RFC3339)
+	prefix := bfu.clock.Now().Format(time.RFC3339)
 
-	expirationTime := time.Now().Add(bfu.config.ExpireIn)
+	expirationTime := bfu.clock.Now().Add(bfu.config.ExpireIn)
 
 	accessGrant, err := uplink.ParseAccess(bfu.config.AccessGrant)
 	if err != nil {
@@ -189,7 +198,7 @@ func (bfu *Upload) uploadPack(ctx context.Context, project *uplink.Project, pref
 func (bfu *Upload) cleanup(ctx context.Context, project *uplink.Project, prefix string) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	errPrefix := "upload-error-" + time.Now().Format(time.RFC3339)
+	errPrefix := "upload-error-" + bfu.clock.Now().Format(time.RFC3339)
 	o := uplink.ListObjectsOptions{
 		Prefix: prefix + "/",
 	}
@@ -18,6 +18,7 @@ import (
 	"storj.io/common/rpc"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/private/clock"
 	"storj.io/storj/satellite/gc/bloomfilter"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/overlay"
@@ -54,9 +55,15 @@ func NewService(log *zap.Logger, config Config, dialer rpc.Dialer, overlay overl
 
 		dialer:  dialer,
 		overlay: overlay,
+		clock:   clock.Real{},
 	}
 }
 
+// SetClock sets the clock, which is used for the timestamps of the sent retain filters.
+func (service *Service) SetClock(clock clock.Clock) {
+	service.clock = clock
+}
+
 // Service reads bloom filters of piece IDs to retain from a Storj bucket
 // and sends them out to the storage nodes. This is intended to run on a live satellite,
 // not on a backup database.
@@ -72,6 +79,7 @@ type Service struct {
 
 	dialer  rpc.Dialer
 	overlay overlay.DB
+	clock   clock.Clock
 }
 
 // Run continuously polls for new retain filters and sends them out.
@@ -89,7 +97,7 @@ func (service *Service) Run(ctx context.Context) (err error) {
 func (service *Service) RunOnce(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	loopStartTime := time.Now()
+	loopStartTime := service.clock.Now()
 
 	switch {
 	case service.Config.AccessGrant == "":
@@ -215,7 +223,7 @@ func (service *Service) uploadError(
 	ctx context.Context, project *uplink.Project, destinationObjectKey string, previousErr error,
 ) (err error) {
 	upload, err := project.UploadObject(ctx, service.Config.Bucket, destinationObjectKey, &uplink.UploadOptions{
-		Expires: time.Now().Add(service.Config.ExpireIn),
+		Expires: service.clock.Now().Add(service.Config.ExpireIn),
 	})
 	if err != nil {
 		return err
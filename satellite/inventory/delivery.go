@@ -0,0 +1,175 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inventory
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/private/clock"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/uplink"
+)
+
+var (
+	// Error is the error class for the bucket inventory reports.
+	Error = errs.Class("bucket inventory")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for the bucket inventory reports.
+type Config struct {
+	Enabled   bool `help:"whether to deliver the scheduled bucket inventory reports" default:"false"`
+	BatchSize int  `help:"the number of objects listed from the metabase at once" default:"1000"`
+}
+
+// Header is the first row of the CSV reports.
+var Header = []string{"bucket", "key", "version", "size", "encrypted_size", "segment_count", "created_at", "expires_at"}
+
+// Delivery writes the inventory reports and uploads them into the destination buckets.
+type Delivery struct {
+	log         *zap.Logger
+	config      Config
+	metabase    *metabase.DB
+	inventories console.BucketInventories
+	clock       clock.Clock
+}
+
+// NewDelivery creates a new delivery of the bucket inventory reports.
+func NewDelivery(log *zap.Logger, config Config, metabaseDB *metabase.DB, inventories console.BucketInventories) *Delivery {
+	return &Delivery{
+		log:         log,
+		config:      config,
+		metabase:    metabaseDB,
+		inventories: inventories,
+		clock:       clock.Real{},
+	}
+}
+
+// SetClock sets the clock, which is used for the schedule and the keys of the reports.
+func (delivery *Delivery) SetClock(clock clock.Clock) {
+	delivery.clock = clock
+}
+
+// Due returns the inventories whose report should be delivered now.
+func (delivery *Delivery) Due(ctx context.Context) (_ []console.BucketInventory, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	all, err := delivery.inventories.GetAll(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	now := delivery.clock.Now()
+	var due []console.BucketInventory
+	for _, inventory := range all {
+		if inventory.Due(now) {
+			due = append(due, inventory)
+		}
+	}
+	return due, nil
+}
+
+// Deliver uploads the report of the bucket and records the time of the delivery.
+func (delivery *Delivery) Deliver(ctx context.Context, inventory console.BucketInventory) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := delivery.clock.Now()
+
+	access, err := uplink.ParseAccess(inventory.DestinationAccess)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	project, err := uplink.OpenProject(ctx, access)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(project.Close())) }()
+
+	upload, err := project.UploadObject(ctx, inventory.DestinationBucket, ReportKey(inventory, now), nil)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if err := delivery.writeReport(ctx, upload, inventory); err != nil {
+		return errs.Combine(err, Error.Wrap(upload.Abort()))
+	}
+	if err := upload.Commit(); err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(delivery.inventories.SetDelivered(ctx, inventory.ProjectID, inventory.BucketName, now))
+}
+
+// writeReport lists the committed objects of the bucket into w.
+func (delivery *Delivery) writeReport(ctx context.Context, w io.Writer, inventory console.BucketInventory) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	report := csv.NewWriter(w)
+	if err := report.Write(Header); err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = delivery.metabase.IterateObjectsAllVersionsWithStatus(ctx, metabase.IterateObjectsWithStatus{
+		ProjectID:             inventory.ProjectID,
+		BucketName:            inventory.BucketName,
+		Recursive:             true,
+		BatchSize:             delivery.config.BatchSize,
+		Status:                metabase.Committed,
+		IncludeSystemMetadata: true,
+	}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+		var entry metabase.ObjectEntry
+		for it.Next(ctx, &entry) {
+			if err := report.Write(Row(inventory.BucketName, entry)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	report.Flush()
+	return Error.Wrap(report.Error())
+}
+
+// ReportKey returns the object key of the report delivered at the specified time.
+func ReportKey(inventory console.BucketInventory, deliveredAt time.Time) string {
+	prefix := inventory.DestinationPrefix
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return prefix + inventory.BucketName + "/" + deliveredAt.UTC().Format("2006-01-02") + "." + string(inventory.Format)
+}
+
+// Row returns the row of the CSV report for the object.
+func Row(bucketName string, entry metabase.ObjectEntry) []string {
+	var expiresAt string
+	if entry.ExpiresAt != nil {
+		expiresAt = entry.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+	return []string{
+		bucketName,
+		url.QueryEscape(string(entry.ObjectKey)),
+		strconv.FormatInt(int64(entry.Version), 10),
+		strconv.FormatInt(entry.TotalPlainSize, 10),
+		strconv.FormatInt(entry.TotalEncryptedSize, 10),
+		strconv.FormatInt(int64(entry.SegmentCount), 10),
+		entry.CreatedAt.UTC().Format(time.RFC3339),
+		expiresAt,
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+/*
+Package inventory delivers the scheduled inventory reports of the buckets.
+
+The inventory.Observer is added to the ranged loop. At the start of every loop
+it loads the inventory configurations, which users manage in the console, and
+picks those whose daily or weekly report is due. After the loop finishes the
+inventory.Delivery lists the committed objects of each due bucket from the
+metabase and uploads the listing into the configured destination bucket with
+the access grant the user supplied.
+
+The object keys are written as they are stored in the metabase, which means
+they are encrypted unless the bucket uses unencrypted object keys. The keys are
+URL-encoded, so that the reports stay valid text.
+*/
+package inventory
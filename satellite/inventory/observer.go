@@ -0,0 +1,99 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inventory
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/private/clock"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/metabase/segmentloop"
+)
+
+// Observer implements a rangedloop observer, which schedules the bucket inventory
+// reports and delivers them when the loop finishes.
+//
+// architecture: Observer
+type Observer struct {
+	log      *zap.Logger
+	delivery *Delivery
+
+	// due is reset for each loop.
+	due []console.BucketInventory
+}
+
+var _ (rangedloop.Observer) = (*Observer)(nil)
+var _ (rangedloop.Partial) = (*observerFork)(nil)
+
+// NewObserver creates a new instance of the bucket inventory rangedloop observer.
+func NewObserver(log *zap.Logger, config Config, metabaseDB *metabase.DB, inventories console.BucketInventories) *Observer {
+	return &Observer{
+		log:      log,
+		delivery: NewDelivery(log, config, metabaseDB, inventories),
+	}
+}
+
+// SetClock sets the clock, which is used for the schedule and the keys of the reports.
+func (obs *Observer) SetClock(clock clock.Clock) {
+	obs.delivery.SetClock(clock)
+}
+
+// Start loads the inventories whose report is due.
+func (obs *Observer) Start(ctx context.Context, startTime time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	obs.due, err = obs.delivery.Due(ctx)
+	return err
+}
+
+// Fork creates a Partial, which doesn't need to process the segments.
+func (obs *Observer) Fork(ctx context.Context) (_ rangedloop.Partial, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return &observerFork{}, nil
+}
+
+// Join is a noop, because the reports are built from the objects.
+func (obs *Observer) Join(ctx context.Context, partial rangedloop.Partial) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return nil
+}
+
+// Finish delivers the reports which are due. A failed delivery is retried in the
+// next loop, so it doesn't fail the other deliveries.
+func (obs *Observer) Finish(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var delivered int
+	for _, inventory := range obs.due {
+		if err := obs.delivery.Deliver(ctx, inventory); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			obs.log.Warn("unable to deliver bucket inventory report",
+				zap.Stringer("Project ID", inventory.ProjectID),
+				zap.String("Bucket", inventory.BucketName),
+				zap.String("Destination Bucket", inventory.DestinationBucket),
+				zap.Error(err))
+			continue
+		}
+		delivered++
+	}
+
+	mon.IntVal("bucket_inventory_reports_due").Observe(int64(len(obs.due)))
+	mon.IntVal("bucket_inventory_reports_delivered").Observe(int64(delivered))
+	obs.due = nil
+	return nil
+}
+
+type observerFork struct{}
+
+// Process is a noop.
+func (fork *observerFork) Process(ctx context.Context, segments []segmentloop.Segment) (err error) {
+	return nil
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inventory_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/inventory"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+)
+
+func TestObserverDeliversReports(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		uplinkPeer := planet.Uplinks[0]
+		projectID := uplinkPeer.Projects[0].ID
+
+		require.NoError(t, uplinkPeer.Upload(ctx, satellite, "source", "first", testrand.Bytes(memory.KiB)))
+		require.NoError(t, uplinkPeer.Upload(ctx, satellite, "source", "second", testrand.Bytes(memory.KiB)))
+		require.NoError(t, uplinkPeer.CreateBucket(ctx, satellite, "reports"))
+
+		access, err := uplinkPeer.Access[satellite.ID()].Serialize()
+		require.NoError(t, err)
+
+		inventories := satellite.DB.Console().BucketInventories()
+		require.NoError(t, inventories.Upsert(ctx, console.BucketInventory{
+			ProjectID:         projectID,
+			BucketName:        "source",
+			DestinationBucket: "reports",
+			DestinationPrefix: "inventory",
+			Format:            console.InventoryFormatCSV,
+			Frequency:         console.InventoryDaily,
+			DestinationAccess: access,
+			CreatedAt:         time.Now(),
+		}))
+
+		config := satellite.Config.BucketInventory
+		config.Enabled = true
+		observer := inventory.NewObserver(zaptest.NewLogger(t), config, satellite.Metabase.DB, inventories)
+
+		rangedloopConfig := satellite.Config.RangedLoop
+		segments := rangedloop.NewMetabaseRangeSplitter(satellite.Metabase.DB, rangedloopConfig.AsOfSystemInterval, rangedloopConfig.BatchSize)
+		rangedLoop := rangedloop.NewService(zaptest.NewLogger(t), rangedloopConfig, segments, []rangedloop.Observer{observer})
+
+		_, err = rangedLoop.RunOnce(ctx)
+		require.NoError(t, err)
+
+		configured, err := inventories.Get(ctx, projectID, "source")
+		require.NoError(t, err)
+		require.NotNil(t, configured.LastDeliveredAt)
+
+		data, err := uplinkPeer.Download(ctx, satellite, "reports", inventory.ReportKey(*configured, *configured.LastDeliveredAt))
+		require.NoError(t, err)
+
+		rows, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
+		require.Equal(t, inventory.Header, rows[0])
+		for _, row := range rows[1:] {
+			require.Equal(t, "source", row[0])
+			require.Equal(t, "1024", row[3])
+		}
+
+		// the next report isn't due yet.
+		_, err = rangedLoop.RunOnce(ctx)
+		require.NoError(t, err)
+
+		again, err := inventories.Get(ctx, projectID, "source")
+		require.NoError(t, err)
+		require.Equal(t, configured.LastDeliveredAt, again.LastDeliveredAt)
+	})
+}
+
+func TestReportKey(t *testing.T) {
+	deliveredAt := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, prefix := range []string{"inventory", "inventory/"} {
+		key := inventory.ReportKey(console.BucketInventory{
+			BucketName:        "source",
+			DestinationPrefix: prefix,
+			Format:            console.InventoryFormatCSV,
+		}, deliveredAt)
+		require.Equal(t, "inventory/source/2023-06-01.csv", key)
+	}
+
+	key := inventory.ReportKey(console.BucketInventory{
+		BucketName: "source",
+		Format:     console.InventoryFormatCSV,
+	}, deliveredAt)
+	require.Equal(t, "source/2023-06-01.csv", key)
+}
+
+func TestRow(t *testing.T) {
+	createdAt := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	expiresAt := createdAt.Add(time.Hour)
+
+	row := inventory.Row("source", metabase.ObjectEntry{
+		ObjectKey:          "a/b c",
+		Version:            1,
+		StreamID:           testrand.UUID(),
+		CreatedAt:          createdAt,
+		ExpiresAt:          &expiresAt,
+		SegmentCount:       2,
+		TotalPlainSize:     100,
+		TotalEncryptedSize: 128,
+	})
+	require.Equal(t, []string{
+		"source", "a%2Fb+c", "1", "100", "128", "2",
+		"2023-06-01T12:00:00Z", "2023-06-01T13:00:00Z",
+	}, row)
+
+	row = inventory.Row("source", metabase.ObjectEntry{ObjectKey: "key", CreatedAt: createdAt})
+	require.Equal(t, "", row[len(row)-1])
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package kms keeps the metadata keys of the buckets with server-side encryption and
+// the root keys of the projects with server-side access grants. The keys are stored
+// wrapped by a master key, which may be kept in a HSM or a KMS.
+package kms
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for the package.
+	Error = errs.Class("kms")
+
+	// ErrNotEnabled is returned when the bucket doesn't use server-side encryption.
+	ErrNotEnabled = errs.Class("server-side encryption not enabled")
+
+	// ErrNoRootKey is returned when the project doesn't keep its root key on the satellite.
+	ErrNoRootKey = errs.Class("project root key not found")
+)
+
+// MasterKey wraps the keys stored by the satellite.
+type MasterKey interface {
+	// ID identifies the master key, which wrapped a key.
+	ID() string
+	// Wrap encrypts the plaintext, binding it to the associated data.
+	Wrap(ctx context.Context, plaintext, associatedData []byte) ([]byte, error)
+	// Unwrap decrypts the ciphertext created by Wrap with the same associated data.
+	Unwrap(ctx context.Context, ciphertext, associatedData []byte) ([]byte, error)
+}
+
+// BucketKey is the wrapped metadata key of a bucket.
+type BucketKey struct {
+	ProjectID    uuid.UUID
+	BucketName   []byte
+	MasterKeyID  string
+	EncryptedKey []byte
+	CreatedAt    time.Time
+}
+
+// DB stores the wrapped metadata keys of the buckets.
+//
+// architecture: Database
+type DB interface {
+	// Set stores the key of the bucket, replacing the previous one.
+	Set(ctx context.Context, key BucketKey) error
+	// Get returns the key of the bucket or ErrNotEnabled when there's none.
+	Get(ctx context.Context, projectID uuid.UUID, bucketName []byte) (BucketKey, error)
+	// Delete removes the key of the bucket.
+	Delete(ctx context.Context, projectID uuid.UUID, bucketName []byte) error
+}
+
+// ProjectRootKey is the wrapped root encryption key of a project, which opted into
+// the access grants created by the satellite.
+type ProjectRootKey struct {
+	ProjectID    uuid.UUID
+	MasterKeyID  string
+	EncryptedKey []byte
+	CreatedBy    uuid.UUID
+	CreatedAt    time.Time
+}
+
+// RootKeysDB stores the wrapped root keys of the projects.
+//
+// architecture: Database
+type RootKeysDB interface {
+	// Set stores the root key of the project, replacing the previous one.
+	Set(ctx context.Context, key ProjectRootKey) error
+	// Get returns the root key of the project or ErrNoRootKey when there's none.
+	Get(ctx context.Context, projectID uuid.UUID) (ProjectRootKey, error)
+	// Delete removes the root key of the project.
+	Delete(ctx context.Context, projectID uuid.UUID) error
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"strings"
+)
+
+// LocalMasterKey is a master key kept in the memory of the satellite.
+type LocalMasterKey struct {
+	id   string
+	aead cipher.AEAD
+}
+
+var _ MasterKey = (*LocalMasterKey)(nil)
+
+// NewLocalMasterKey returns a master key wrapping the keys with AES-256-GCM.
+func NewLocalMasterKey(id string, key []byte) (*LocalMasterKey, error) {
+	if len(key) != 32 {
+		return nil, Error.New("master key must be 32 bytes, got %d", len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &LocalMasterKey{id: id, aead: aead}, nil
+}
+
+// OpenLocalMasterKey loads the hex encoded master key from the file.
+func OpenLocalMasterKey(id, path string) (*LocalMasterKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	key, err := hex.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, Error.New("invalid master key: %v", err)
+	}
+
+	return NewLocalMasterKey(id, key)
+}
+
+// ID implements MasterKey.
+func (key *LocalMasterKey) ID() string { return key.id }
+
+// Wrap implements MasterKey.
+func (key *LocalMasterKey) Wrap(ctx context.Context, plaintext, associatedData []byte) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nonce := make([]byte, key.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return key.aead.Seal(nonce, nonce, plaintext, associatedData), nil
+}
+
+// Unwrap implements MasterKey.
+func (key *LocalMasterKey) Unwrap(ctx context.Context, ciphertext, associatedData []byte) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(ciphertext) < key.aead.NonceSize() {
+		return nil, Error.New("wrapped key too short")
+	}
+
+	nonce, ciphertext := ciphertext[:key.aead.NonceSize()], ciphertext[key.aead.NonceSize():]
+	plaintext, err := key.aead.Open(nil, nonce, ciphertext, associatedData)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return plaintext, nil
+}
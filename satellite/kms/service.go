@@ -0,0 +1,187 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kms
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+)
+
+// Config contains the configuration of the server-side encryption.
+type Config struct {
+	Enabled       bool   `help:"whether the satellite keeps the metadata keys of the buckets with server-side encryption" default:"false"`
+	MasterKeyID   string `help:"identifier of the master key, recorded with every wrapped key" default:"local-1"`
+	MasterKeyPath string `help:"path to the file with the hex encoded 32 byte master key" default:""`
+}
+
+// Service wraps the metadata keys of the buckets and the root keys of the projects
+// before storing them.
+//
+// architecture: Service
+type Service struct {
+	log      *zap.Logger
+	db       DB
+	rootKeys RootKeysDB
+	master   MasterKey
+
+	nowFn func() time.Time
+}
+
+// NewService returns a new server-side encryption key service.
+func NewService(log *zap.Logger, db DB, rootKeys RootKeysDB, master MasterKey) *Service {
+	return &Service{
+		log:      log,
+		db:       db,
+		rootKeys: rootKeys,
+		master:   master,
+		nowFn:    time.Now,
+	}
+}
+
+// EnableBucket stores the metadata key of the bucket.
+func (service *Service) EnableBucket(ctx context.Context, projectID uuid.UUID, bucketName []byte, metadataKey []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(metadataKey) != storj.KeySize {
+		return Error.New("metadata key must be %d bytes, got %d", storj.KeySize, len(metadataKey))
+	}
+
+	wrapped, err := service.master.Wrap(ctx, metadataKey, associatedData(projectID, bucketName))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = service.db.Set(ctx, BucketKey{
+		ProjectID:    projectID,
+		BucketName:   bucketName,
+		MasterKeyID:  service.master.ID(),
+		EncryptedKey: wrapped,
+		CreatedAt:    service.nowFn(),
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	service.log.Info("server-side encryption enabled",
+		zap.Stringer("Project ID", projectID),
+		zap.ByteString("Bucket", bucketName))
+	return nil
+}
+
+// DisableBucket removes the metadata key of the bucket.
+func (service *Service) DisableBucket(ctx context.Context, projectID uuid.UUID, bucketName []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(service.db.Delete(ctx, projectID, bucketName))
+}
+
+// BucketKey returns the metadata key of the bucket or ErrNotEnabled when the bucket
+// doesn't use server-side encryption.
+func (service *Service) BucketKey(ctx context.Context, projectID uuid.UUID, bucketName []byte) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	key, err := service.db.Get(ctx, projectID, bucketName)
+	if err != nil {
+		if ErrNotEnabled.Has(err) {
+			return nil, err
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	if key.MasterKeyID != service.master.ID() {
+		return nil, Error.New("key wrapped by unknown master key %q", key.MasterKeyID)
+	}
+
+	metadataKey, err := service.master.Unwrap(ctx, key.EncryptedKey, associatedData(projectID, bucketName))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return metadataKey, nil
+}
+
+// StoreProjectRootKey stores the root encryption key of the project.
+func (service *Service) StoreProjectRootKey(ctx context.Context, projectID, createdBy uuid.UUID, rootKey *storj.Key) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	wrapped, err := service.master.Wrap(ctx, rootKey[:], rootKeyAssociatedData(projectID))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = service.rootKeys.Set(ctx, ProjectRootKey{
+		ProjectID:    projectID,
+		MasterKeyID:  service.master.ID(),
+		EncryptedKey: wrapped,
+		CreatedBy:    createdBy,
+		CreatedAt:    service.nowFn(),
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	service.log.Info("project root key stored",
+		zap.Stringer("Project ID", projectID),
+		zap.Stringer("Created By", createdBy))
+	return nil
+}
+
+// DeleteProjectRootKey removes the root encryption key of the project.
+func (service *Service) DeleteProjectRootKey(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(service.rootKeys.Delete(ctx, projectID))
+}
+
+// ProjectRootKey returns the root encryption key of the project or ErrNoRootKey when
+// the project doesn't keep it on the satellite.
+func (service *Service) ProjectRootKey(ctx context.Context, projectID uuid.UUID) (_ *storj.Key, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	key, err := service.rootKeys.Get(ctx, projectID)
+	if err != nil {
+		if ErrNoRootKey.Has(err) {
+			return nil, err
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	if key.MasterKeyID != service.master.ID() {
+		return nil, Error.New("key wrapped by unknown master key %q", key.MasterKeyID)
+	}
+
+	unwrapped, err := service.master.Unwrap(ctx, key.EncryptedKey, rootKeyAssociatedData(projectID))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if len(unwrapped) != storj.KeySize {
+		return nil, Error.New("root key must be %d bytes, got %d", storj.KeySize, len(unwrapped))
+	}
+
+	var rootKey storj.Key
+	copy(rootKey[:], unwrapped)
+	return &rootKey, nil
+}
+
+// associatedData binds the wrapped key to the bucket, so that it cannot be moved to another one.
+func associatedData(projectID uuid.UUID, bucketName []byte) []byte {
+	data := make([]byte, 0, len(projectID)+len(bucketName))
+	data = append(data, projectID[:]...)
+	return append(data, bucketName...)
+}
+
+// rootKeySuffix separates the associated data of the root keys from the bucket keys.
+const rootKeySuffix = "\x00root"
+
+// rootKeyAssociatedData binds the wrapped root key to the project. The bucket names
+// cannot contain the separator, so the root key cannot be used as a metadata key.
+func rootKeyAssociatedData(projectID uuid.UUID) []byte {
+	data := make([]byte, 0, len(projectID)+len(rootKeySuffix))
+	data = append(data, projectID[:]...)
+	return append(data, rootKeySuffix...)
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kms_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/kms"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestLocalMasterKey(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	_, err := kms.NewLocalMasterKey("short", testrand.BytesInt(16))
+	require.Error(t, err)
+
+	master, err := kms.NewLocalMasterKey("test", testrand.BytesInt(32))
+	require.NoError(t, err)
+	require.Equal(t, "test", master.ID())
+
+	plaintext := testrand.BytesInt(32)
+	wrapped, err := master.Wrap(ctx, plaintext, []byte("bucket"))
+	require.NoError(t, err)
+	require.NotContains(t, string(wrapped), string(plaintext))
+
+	unwrapped, err := master.Unwrap(ctx, wrapped, []byte("bucket"))
+	require.NoError(t, err)
+	require.Equal(t, plaintext, unwrapped)
+
+	// the wrapped key cannot be used for another bucket.
+	_, err = master.Unwrap(ctx, wrapped, []byte("other"))
+	require.Error(t, err)
+}
+
+func TestService(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		master, err := kms.NewLocalMasterKey("test", testrand.BytesInt(32))
+		require.NoError(t, err)
+
+		service := kms.NewService(zaptest.NewLogger(t), db.BucketEncryptionKeys(), db.ProjectRootKeys(), master)
+
+		projectID := testrand.UUID()
+		bucket := []byte("bucket")
+
+		_, err = service.BucketKey(ctx, projectID, bucket)
+		require.True(t, kms.ErrNotEnabled.Has(err))
+
+		require.Error(t, service.EnableBucket(ctx, projectID, bucket, testrand.BytesInt(16)))
+
+		metadataKey := testrand.BytesInt(32)
+		require.NoError(t, service.EnableBucket(ctx, projectID, bucket, metadataKey))
+
+		key, err := service.BucketKey(ctx, projectID, bucket)
+		require.NoError(t, err)
+		require.Equal(t, metadataKey, key)
+
+		// the key isn't shared with the other buckets of the project.
+		_, err = service.BucketKey(ctx, projectID, []byte("other"))
+		require.True(t, kms.ErrNotEnabled.Has(err))
+
+		require.NoError(t, service.DisableBucket(ctx, projectID, bucket))
+		_, err = service.BucketKey(ctx, projectID, bucket)
+		require.True(t, kms.ErrNotEnabled.Has(err))
+	})
+}
+
+func TestService_ProjectRootKey(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		master, err := kms.NewLocalMasterKey("test", testrand.BytesInt(32))
+		require.NoError(t, err)
+
+		service := kms.NewService(zaptest.NewLogger(t), db.BucketEncryptionKeys(), db.ProjectRootKeys(), master)
+
+		projectID := testrand.UUID()
+
+		_, err = service.ProjectRootKey(ctx, projectID)
+		require.True(t, kms.ErrNoRootKey.Has(err))
+
+		rootKey := testrand.Key()
+		require.NoError(t, service.StoreProjectRootKey(ctx, projectID, testrand.UUID(), &rootKey))
+
+		key, err := service.ProjectRootKey(ctx, projectID)
+		require.NoError(t, err)
+		require.Equal(t, rootKey, *key)
+
+		// the root key isn't usable as a metadata key of a bucket.
+		_, err = service.BucketKey(ctx, projectID, []byte{})
+		require.True(t, kms.ErrNotEnabled.Has(err))
+
+		// a key wrapped by another master key isn't returned.
+		other, err := kms.NewLocalMasterKey("other", testrand.BytesInt(32))
+		require.NoError(t, err)
+		_, err = kms.NewService(zaptest.NewLogger(t), db.BucketEncryptionKeys(), db.ProjectRootKeys(), other).ProjectRootKey(ctx, projectID)
+		require.Error(t, err)
+
+		require.NoError(t, service.DeleteProjectRootKey(ctx, projectID))
+		_, err = service.ProjectRootKey(ctx, projectID)
+		require.True(t, kms.ErrNoRootKey.Has(err))
+	})
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mailservice
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// DeliveryStatus is the delivery state of an outgoing email.
+type DeliveryStatus int
+
+const (
+	// DeliveryQueued means that the email was rendered and is about to be sent.
+	DeliveryQueued DeliveryStatus = 0
+	// DeliverySent means that the email was accepted by the mail server.
+	DeliverySent DeliveryStatus = 1
+	// DeliveryFailed means that the mail server did not accept the email.
+	DeliveryFailed DeliveryStatus = 2
+	// DeliveryBounced means that the provider reported that the email bounced.
+	DeliveryBounced DeliveryStatus = 3
+	// DeliveryComplained means that the recipient marked the email as spam.
+	DeliveryComplained DeliveryStatus = 4
+)
+
+// String returns a string representation of the status.
+func (status DeliveryStatus) String() string {
+	switch status {
+	case DeliveryQueued:
+		return "queued"
+	case DeliverySent:
+		return "sent"
+	case DeliveryFailed:
+		return "failed"
+	case DeliveryBounced:
+		return "bounced"
+	case DeliveryComplained:
+		return "complained"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseDeliveryStatus parses a status from its string representation.
+func ParseDeliveryStatus(s string) (DeliveryStatus, bool) {
+	for _, status := range []DeliveryStatus{DeliveryQueued, DeliverySent, DeliveryFailed, DeliveryBounced, DeliveryComplained} {
+		if status.String() == s {
+			return status, true
+		}
+	}
+	return 0, false
+}
+
+// IsProblem returns whether the status means that the email did not reach the recipient.
+func (status DeliveryStatus) IsProblem() bool {
+	return status == DeliveryFailed || status == DeliveryBounced || status == DeliveryComplained
+}
+
+// Delivery contains the delivery state of a single outgoing email.
+type Delivery struct {
+	ID        uuid.UUID
+	MessageID string
+	Recipient string
+	Template  string
+	Subject   string
+	Status    DeliveryStatus
+	Reason    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// DeliveryFilter selects deliveries to list.
+type DeliveryFilter struct {
+	// MessageID limits the results to a single message, when not empty.
+	MessageID string
+	// Recipient limits the results to a single email address, when not empty.
+	Recipient string
+	// Statuses limits the results to the given statuses, when not empty.
+	Statuses []DeliveryStatus
+	// Since limits the results to deliveries created after the given time, when not zero.
+	Since time.Time
+	Limit int
+}
+
+// Deliveries stores the delivery status of outgoing emails.
+//
+// architecture: Database
+type Deliveries interface {
+	// Insert adds a new delivery.
+	Insert(ctx context.Context, delivery Delivery) error
+	// UpdateStatus updates the status of the deliveries of message to recipient.
+	// When recipient is empty, the deliveries to all recipients are updated.
+	// It returns the number of updated deliveries.
+	UpdateStatus(ctx context.Context, messageID, recipient string, status DeliveryStatus, reason string, updatedAt time.Time) (int64, error)
+	// List returns deliveries matching filter, most recent first.
+	List(ctx context.Context, filter DeliveryFilter) ([]Delivery, error)
+}
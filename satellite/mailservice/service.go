@@ -4,10 +4,8 @@
 package mailservice
 
 import (
-	"bytes"
 	"context"
-	htmltemplate "html/template"
-	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -15,6 +13,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/context2"
+	"storj.io/common/uuid"
 	"storj.io/storj/private/post"
 )
 
@@ -30,6 +29,7 @@ type Config struct {
 	ClientID          string `help:"oauth2 app's client id" default:""`
 	ClientSecret      string `help:"oauth2 app's client secret" default:""`
 	TokenURI          string `help:"uri which is used when retrieving new access token" default:""`
+	Branding          Branding
 }
 
 var (
@@ -57,7 +57,12 @@ type Service struct {
 	log    *zap.Logger
 	Sender Sender
 
-	html *htmltemplate.Template
+	templatePath string
+	branding     Branding
+	deliveries   Deliveries
+
+	mu   sync.RWMutex
+	html *templateSet
 	// TODO(yar): prepare plain text version
 	// text *texttemplate.Template
 
@@ -65,9 +70,15 @@ type Service struct {
 }
 
 // New creates new service.
-func New(log *zap.Logger, sender Sender, templatePath string) (*Service, error) {
-	var err error
-	service := &Service{log: log, Sender: sender}
+// When deliveries is not nil, the delivery status of rendered emails is tracked.
+func New(log *zap.Logger, sender Sender, templatePath string, branding Branding, deliveries Deliveries) (*Service, error) {
+	service := &Service{
+		log:          log,
+		Sender:       sender,
+		templatePath: templatePath,
+		branding:     branding,
+		deliveries:   deliveries,
+	}
 
 	// TODO(yar): prepare plain text version
 	// service.text, err = texttemplate.ParseGlob(filepath.Join(templatePath, "*.txt"))
@@ -75,14 +86,45 @@ func New(log *zap.Logger, sender Sender, templatePath string) (*Service, error)
 	// 	return nil, err
 	// }
 
-	service.html, err = htmltemplate.ParseGlob(filepath.Join(templatePath, "*.html"))
-	if err != nil {
+	if err := service.Reload(); err != nil {
 		return nil, err
 	}
 
 	return service, nil
 }
 
+// Reload loads the templates and localized strings from the template path again.
+// The currently loaded templates are kept when loading fails.
+func (service *Service) Reload() error {
+	html, err := loadTemplates(service.templatePath, service.branding)
+	if err != nil {
+		return err
+	}
+
+	service.mu.Lock()
+	service.html = html
+	service.mu.Unlock()
+	return nil
+}
+
+// templates returns the currently loaded templates.
+func (service *Service) templates() *templateSet {
+	service.mu.RLock()
+	defer service.mu.RUnlock()
+	return service.html
+}
+
+// TemplateNames returns the names of the loaded templates and locales.
+func (service *Service) TemplateNames() (templates, locales []string) {
+	html := service.templates()
+	return html.names(), html.localeNames()
+}
+
+// Preview renders the template name in locale using data, without sending it.
+func (service *Service) Preview(name, locale string, data interface{}) (string, error) {
+	return service.templates().render(name, locale, data)
+}
+
 // Close closes and waits for any pending actions.
 func (service *Service) Close() error {
 	service.sending.Wait()
@@ -127,30 +169,114 @@ func (service *Service) SendRenderedAsync(ctx context.Context, to []post.Address
 func (service *Service) SendRendered(ctx context.Context, to []post.Address, msg Message) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var htmlBuffer bytes.Buffer
-	var textBuffer bytes.Buffer
+	var locale string
+	if localized, ok := msg.(LocalizedMessage); ok {
+		locale = localized.Locale()
+	}
 
 	// TODO(yar): prepare plain text version
 	// if err = service.text.ExecuteTemplate(&textBuffer, msg.Template() + ".txt", msg); err != nil {
 	// 	return
 	// }
 
-	if err = service.html.ExecuteTemplate(&htmlBuffer, msg.Template()+".html", msg); err != nil {
-		return
+	html, err := service.templates().render(msg.Template(), locale, msg)
+	if err != nil {
+		return err
 	}
 
+	from := service.Sender.FromAddress()
 	m := &post.Message{
-		From:      service.Sender.FromAddress(),
-		To:        to,
-		Subject:   msg.Subject(),
-		PlainText: textBuffer.String(),
+		From:    from,
+		To:      to,
+		Subject: msg.Subject(),
 		Parts: []post.Part{
 			{
 				Type:    "text/html; charset=UTF-8",
-				Content: htmlBuffer.String(),
+				Content: html,
 			},
 		},
 	}
 
-	return service.Sender.SendEmail(ctx, m)
+	if service.deliveries == nil {
+		return service.Sender.SendEmail(ctx, m)
+	}
+
+	messageID, err := uuid.New()
+	if err != nil {
+		return err
+	}
+	m.ID = messageID.String() + "@" + domainOf(from.Address)
+
+	now := time.Now()
+	for _, recipient := range to {
+		id, err := uuid.New()
+		if err != nil {
+			return err
+		}
+		err = service.deliveries.Insert(ctx, Delivery{
+			ID:        id,
+			MessageID: m.ID,
+			Recipient: recipient.Address,
+			Template:  msg.Template(),
+			Subject:   m.Subject,
+			Status:    DeliveryQueued,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+		if err != nil {
+			// tracking failures must not prevent sending the email.
+			service.log.Warn("failed to track email delivery", zap.String("message", m.ID), zap.Error(err))
+		}
+	}
+
+	sendErr := service.Sender.SendEmail(ctx, m)
+
+	status, reason := DeliverySent, ""
+	if sendErr != nil {
+		status, reason = DeliveryFailed, sendErr.Error()
+	}
+	if _, err := service.deliveries.UpdateStatus(ctx, m.ID, "", status, reason, time.Now()); err != nil {
+		service.log.Warn("failed to update email delivery", zap.String("message", m.ID), zap.Error(err))
+	}
+
+	return sendErr
+}
+
+// UpdateDeliveryStatus records a delivery status reported by the email provider.
+func (service *Service) UpdateDeliveryStatus(ctx context.Context, messageID, recipient string, status DeliveryStatus, reason string, at time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if service.deliveries == nil {
+		return nil
+	}
+
+	// providers commonly report the message id with the angle brackets from the header.
+	messageID = strings.TrimSuffix(strings.TrimPrefix(messageID, "<"), ">")
+
+	updated, err := service.deliveries.UpdateStatus(ctx, messageID, recipient, status, reason, at)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		service.log.Debug("delivery status for unknown email", zap.String("message", messageID))
+		return nil
+	}
+
+	mon.Counter("email_delivery_status", monkit.NewSeriesTag("status", status.String())).Inc(1)
+	if status.IsProblem() {
+		service.log.Warn("email was not delivered",
+			zap.String("message", messageID),
+			zap.String("recipient", recipient),
+			zap.Stringer("status", status),
+			zap.String("reason", reason))
+	}
+	return nil
+}
+
+// domainOf returns the domain part of an email address.
+func domainOf(address string) string {
+	if i := strings.LastIndexByte(address, '@'); i >= 0 {
+		return address[i+1:]
+	}
+	return "localhost"
 }
@@ -0,0 +1,96 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mailservice_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/post"
+	"storj.io/storj/satellite/mailservice"
+)
+
+type recordingSender struct {
+	messages []*post.Message
+}
+
+func (sender *recordingSender) SendEmail(ctx context.Context, msg *post.Message) error {
+	sender.messages = append(sender.messages, msg)
+	return nil
+}
+
+func (sender *recordingSender) FromAddress() post.Address {
+	return post.Address{Address: "storj@mail.test"}
+}
+
+type testMessage struct {
+	Name   string
+	locale string
+}
+
+func (*testMessage) Template() string       { return "Greeting" }
+func (*testMessage) Subject() string        { return "Hello" }
+func (message *testMessage) Locale() string { return message.locale }
+
+func TestLocalizedTemplates(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	dir := t.TempDir()
+	write := func(name, content string) {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	write("Greeting.html", `{{ T "hello" }} {{ .Name }} from {{ (branding).Name }}`)
+	write("strings.json", `{"hello": "Hello", "bye": "Bye"}`)
+	write("de/strings.json", `{"hello": "Hallo"}`)
+	write("pl/Greeting.html", `Cześć {{ .Name }}, {{ T "bye" }}`)
+
+	sender := &recordingSender{}
+	service, err := mailservice.New(zaptest.NewLogger(t), sender, dir, mailservice.Branding{Name: "Test Satellite"}, nil)
+	require.NoError(t, err)
+	defer ctx.Check(service.Close)
+
+	templates, locales := service.TemplateNames()
+	require.Equal(t, []string{"Greeting"}, templates)
+	require.Equal(t, []string{"de", "pl"}, locales)
+
+	for locale, expected := range map[string]string{
+		"":      "Hello Alice from Test Satellite",
+		"en-US": "Hello Alice from Test Satellite",
+		"de":    "Hallo Alice from Test Satellite",
+		"de_AT": "Hallo Alice from Test Satellite",
+		"pl":    "Cześć Alice, Bye",
+	} {
+		sender.messages = nil
+		err := service.SendRendered(ctx, []post.Address{{Address: "alice@mail.test"}}, &testMessage{Name: "Alice", locale: locale})
+		require.NoError(t, err)
+		require.Len(t, sender.messages, 1)
+		require.Equal(t, expected, sender.messages[0].Parts[0].Content, locale)
+	}
+
+	// reloading picks up changed templates.
+	write("Greeting.html", `Hi {{ .Name }}`)
+	require.NoError(t, service.Reload())
+
+	preview, err := service.Preview("Greeting", "", map[string]string{"Name": "Bob"})
+	require.NoError(t, err)
+	require.Equal(t, "Hi Bob", preview)
+
+	// a broken template doesn't replace the loaded ones.
+	write("Greeting.html", `{{ .Name `)
+	require.Error(t, service.Reload())
+
+	preview, err = service.Preview("Greeting", "", map[string]string{"Name": "Bob"})
+	require.NoError(t, err)
+	require.Equal(t, "Hi Bob", preview)
+}
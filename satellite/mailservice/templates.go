@@ -0,0 +1,194 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mailservice
+
+import (
+	"bytes"
+	"encoding/json"
+	htmltemplate "html/template"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// stringsFile is the name of the file containing localized strings.
+const stringsFile = "strings.json"
+
+// Branding contains per-satellite values which are available to email templates
+// through the "branding" template function, e.g. {{ (branding).Name }}.
+type Branding struct {
+	Name         string `help:"satellite name shown in emails" default:"Storj"`
+	LogoURL      string `help:"url of the logo shown in emails" default:""`
+	HomepageURL  string `help:"url of the homepage linked from emails" default:""`
+	SupportURL   string `help:"url of the support page linked from emails" default:""`
+	PrimaryColor string `help:"primary color used in emails" default:"#0149FF"`
+}
+
+// LocalizedMessage is a Message which should be rendered in a specific locale.
+type LocalizedMessage interface {
+	Message
+	Locale() string
+}
+
+// templateSet contains templates for the default locale and all localized overrides.
+//
+// Templates are loaded from a directory with the following layout:
+//
+//	<path>/*.html             default templates
+//	<path>/strings.json       default localized strings
+//	<path>/<locale>/*.html    templates overriding the defaults for locale
+//	<path>/<locale>/strings.json
+//
+// Localized strings are available through the "T" template function, e.g. {{ T "greeting" }}.
+type templateSet struct {
+	defaults *htmltemplate.Template
+	locales  map[string]*htmltemplate.Template
+}
+
+// loadTemplates loads all templates from path.
+func loadTemplates(path string, branding Branding) (*templateSet, error) {
+	defaultStrings, err := loadStrings(filepath.Join(path, stringsFile))
+	if err != nil {
+		return nil, err
+	}
+
+	defaults, err := htmltemplate.New("").
+		Funcs(templateFuncs(branding, defaultStrings, nil)).
+		ParseGlob(filepath.Join(path, "*.html"))
+	if err != nil {
+		return nil, err
+	}
+
+	set := &templateSet{
+		defaults: defaults,
+		locales:  map[string]*htmltemplate.Template{},
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		locale := normalizeLocale(entry.Name())
+		dir := filepath.Join(path, entry.Name())
+
+		localeStrings, err := loadStrings(filepath.Join(dir, stringsFile))
+		if err != nil {
+			return nil, err
+		}
+
+		localized, err := defaults.Clone()
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		localized.Funcs(templateFuncs(branding, localeStrings, defaultStrings))
+
+		matches, err := filepath.Glob(filepath.Join(dir, "*.html"))
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		if len(matches) > 0 {
+			localized, err = localized.ParseFiles(matches...)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		set.locales[locale] = localized
+	}
+
+	return set, nil
+}
+
+// lookup returns templates for locale, falling back to the base language and
+// then to the defaults.
+func (set *templateSet) lookup(locale string) *htmltemplate.Template {
+	locale = normalizeLocale(locale)
+	for locale != "" {
+		if tmpl, ok := set.locales[locale]; ok {
+			return tmpl
+		}
+		i := strings.LastIndexByte(locale, '-')
+		if i < 0 {
+			break
+		}
+		locale = locale[:i]
+	}
+	return set.defaults
+}
+
+// render executes the template name in locale with data.
+func (set *templateSet) render(name, locale string, data interface{}) (string, error) {
+	var buffer bytes.Buffer
+	if err := set.lookup(locale).ExecuteTemplate(&buffer, name+".html", data); err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
+
+// names returns the names of all default templates, without extension.
+func (set *templateSet) names() []string {
+	var names []string
+	for _, tmpl := range set.defaults.Templates() {
+		if name := tmpl.Name(); strings.HasSuffix(name, ".html") {
+			names = append(names, strings.TrimSuffix(name, ".html"))
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// localeNames returns the names of all loaded locales.
+func (set *templateSet) localeNames() []string {
+	var names []string
+	for locale := range set.locales {
+		names = append(names, locale)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// templateFuncs returns functions available to templates.
+func templateFuncs(branding Branding, localized, fallback map[string]string) htmltemplate.FuncMap {
+	return htmltemplate.FuncMap{
+		"branding": func() Branding { return branding },
+		"T": func(key string) string {
+			if value, ok := localized[key]; ok {
+				return value
+			}
+			if value, ok := fallback[key]; ok {
+				return value
+			}
+			return key
+		},
+	}
+}
+
+// loadStrings loads localized strings from a JSON object. Missing files are ignored.
+func loadStrings(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]string{}, nil
+		}
+		return nil, errs.Wrap(err)
+	}
+
+	var values map[string]string
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, errs.New("invalid strings file %q: %w", path, err)
+	}
+	return values, nil
+}
+
+// normalizeLocale converts locale to the lower-case, dash separated form, e.g. "pt_BR" to "pt-br".
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
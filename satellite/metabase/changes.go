@@ -0,0 +1,48 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"sync"
+)
+
+// ObjectsChangedHook is called after the objects of a bucket were committed, deleted,
+// moved, copied or their metadata was updated through the DB. It's called synchronously,
+// so it must not block.
+//
+// The hooks aren't called for the changes made by other processes, nor for the
+// changes of pending objects, nor for the deletion of expired and zombie objects.
+type ObjectsChangedHook func(ctx context.Context, bucket BucketLocation)
+
+// objectsChangedHooks contains the registered hooks. It's shared by the copies of DB.
+type objectsChangedHooks struct {
+	mu    sync.RWMutex
+	hooks []ObjectsChangedHook
+}
+
+// OnObjectsChanged registers the hook for the changes of the objects.
+func (db *DB) OnObjectsChanged(hook ObjectsChangedHook) {
+	db.changes.mu.Lock()
+	defer db.changes.mu.Unlock()
+
+	db.changes.hooks = append(db.changes.hooks, hook)
+}
+
+// objectsChanged calls the hooks for the buckets when err is nil.
+func (db *DB) objectsChanged(ctx context.Context, err error, buckets ...BucketLocation) {
+	if err != nil {
+		return
+	}
+
+	db.changes.mu.RLock()
+	hooks := db.changes.hooks
+	db.changes.mu.RUnlock()
+
+	for _, hook := range hooks {
+		for _, bucket := range buckets {
+			hook(ctx, bucket)
+		}
+	}
+}
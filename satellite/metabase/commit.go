@@ -32,6 +32,8 @@ var (
 	ErrInvalidRequest = errs.Class("metabase: invalid request")
 	// ErrConflict is used to indicate conflict with the request.
 	ErrConflict = errs.Class("metabase: conflict")
+	// ErrMetadataTooLarge is used to indicate that the encrypted metadata exceeds the limit of the request.
+	ErrMetadataTooLarge = errs.Class("metabase: metadata too large")
 )
 
 // BeginObjectNextVersion contains arguments necessary for starting an object upload.
@@ -44,6 +46,8 @@ type BeginObjectNextVersion struct {
 	EncryptedMetadata             []byte // optional
 	EncryptedMetadataNonce        []byte // optional
 	EncryptedMetadataEncryptedKey []byte // optional
+	// MaxEncryptedMetadataSize limits the size of EncryptedMetadata, 0 means no limit.
+	MaxEncryptedMetadataSize int
 
 	Encryption storj.EncryptionParameters
 }
@@ -63,7 +67,7 @@ func (opts *BeginObjectNextVersion) Verify() error {
 	} else if opts.EncryptedMetadata != nil && (opts.EncryptedMetadataNonce == nil || opts.EncryptedMetadataEncryptedKey == nil) {
 		return ErrInvalidRequest.New("EncryptedMetadataNonce and EncryptedMetadataEncryptedKey must be set if EncryptedMetadata is set")
 	}
-	return nil
+	return verifyEncryptedMetadataSize(opts.EncryptedMetadata, opts.MaxEncryptedMetadataSize)
 }
 
 // BeginObjectNextVersion adds a pending object to the database, with automatically assigned version.
@@ -485,6 +489,8 @@ type CommitObject struct {
 	EncryptedMetadata             []byte // optional
 	EncryptedMetadataNonce        []byte // optional
 	EncryptedMetadataEncryptedKey []byte // optional
+	// MaxEncryptedMetadataSize limits the size of the overriding EncryptedMetadata, 0 means no limit.
+	MaxEncryptedMetadataSize int
 
 	DisallowDelete bool
 	// OnDelete will be triggered when/if existing object will be overwritten on commit.
@@ -509,6 +515,9 @@ func (c *CommitObject) Verify() error {
 		} else if c.EncryptedMetadata != nil && (c.EncryptedMetadataNonce == nil || c.EncryptedMetadataEncryptedKey == nil) {
 			return ErrInvalidRequest.New("EncryptedMetadataNonce and EncryptedMetadataEncryptedKey must be set if EncryptedMetadata is set")
 		}
+		if err := verifyEncryptedMetadataSize(c.EncryptedMetadata, c.MaxEncryptedMetadataSize); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -517,6 +526,7 @@ func (c *CommitObject) Verify() error {
 // it will be deleted.
 func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Object, err error) {
 	defer mon.Task()(&ctx)(&err)
+	defer func() { db.objectsChanged(ctx, err, opts.Location().Bucket()) }()
 
 	if err := opts.Verify(); err != nil {
 		return Object{}, err
@@ -32,6 +32,7 @@ type CommitObjectWithSegments struct {
 // CommitObjectWithSegments commits pending object to the database.
 func (db *DB) CommitObjectWithSegments(ctx context.Context, opts CommitObjectWithSegments) (object Object, deletedSegments []DeletedSegmentInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
+	defer func() { db.objectsChanged(ctx, err, opts.Location().Bucket()) }()
 
 	if err := opts.ObjectStream.Verify(); err != nil {
 		return Object{}, nil, err
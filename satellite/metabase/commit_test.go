@@ -74,6 +74,26 @@ func TestBeginObjectNextVersion(t *testing.T) {
 			metabasetest.Verify{}.Check(ctx, t, db)
 		})
 
+		t.Run("EncryptedMetadata too large", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.BeginObjectNextVersion{
+				Opts: metabase.BeginObjectNextVersion{
+					ObjectStream:                  objectStream,
+					Encryption:                    metabasetest.DefaultEncryption,
+					EncryptedMetadata:             testrand.BytesInt(1025),
+					EncryptedMetadataNonce:        testrand.BytesInt(32),
+					EncryptedMetadataEncryptedKey: testrand.BytesInt(32),
+					MaxEncryptedMetadataSize:      1024,
+				},
+				Version:  -1,
+				ErrClass: &metabase.ErrMetadataTooLarge,
+				ErrText:  "encrypted metadata is 1025 bytes, the limit is 1024 bytes",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
 		t.Run("disallow exact version", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 
@@ -2171,6 +2191,31 @@ func TestCommitObject(t *testing.T) {
 			metabasetest.Verify{}.Check(ctx, t, db)
 		})
 
+		t.Run("EncryptedMetadata too large", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.CommitObject{
+				Opts: metabase.CommitObject{
+					ObjectStream: metabase.ObjectStream{
+						ProjectID:  obj.ProjectID,
+						BucketName: obj.BucketName,
+						ObjectKey:  obj.ObjectKey,
+						Version:    metabase.DefaultVersion,
+						StreamID:   obj.StreamID,
+					},
+					OverrideEncryptedMetadata:     true,
+					EncryptedMetadata:             testrand.BytesInt(1025),
+					EncryptedMetadataNonce:        testrand.BytesInt(32),
+					EncryptedMetadataEncryptedKey: testrand.BytesInt(32),
+					MaxEncryptedMetadataSize:      1024,
+				},
+				ErrClass: &metabase.ErrMetadataTooLarge,
+				ErrText:  "encrypted metadata is 1025 bytes, the limit is 1024 bytes",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
 		t.Run("version without pending", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 
@@ -97,6 +97,9 @@ func (finishCopy FinishCopyObject) Verify() error {
 // It returns the object at the destination location.
 func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (object Object, err error) {
 	defer mon.Task()(&ctx)(&err)
+	defer func() {
+		db.objectsChanged(ctx, err, BucketLocation{ProjectID: opts.ProjectID, BucketName: opts.NewBucket})
+	}()
 
 	if err := opts.Verify(); err != nil {
 		return Object{}, err
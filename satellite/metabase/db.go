@@ -45,37 +45,42 @@ type DB struct {
 	connstr string
 	impl    dbutil.Implementation
 
+	// readOnly is the database for reads tolerating stale data, it's nil when
+	// it's not configured.
+	readOnly tagsql.DB
+
 	aliasCache *NodeAliasCache
 
+	changes *objectsChangedHooks
+
 	testCleanup func() error
 
 	config Config
 }
 
+// readOnlyDBName is the name of the database in the mapping, which is used for reads
+// tolerating stale data, e.g. a read replica of the default database.
+const readOnlyDBName = "readonly"
+
 // Open opens a connection to metabase.
+//
+// The connstr may map the "readonly" database, e.g. to a read replica, which serves the
+// reads tolerating stale data, like the bucket tallies:
+// postgres://user:pw@primary/metabase,readonly:postgres://user:pw@replica/metabase.
 func Open(ctx context.Context, log *zap.Logger, connstr string, config Config) (*DB, error) {
-	var driverName string
-	_, _, impl, err := dbutil.SplitConnStr(connstr)
+	mapping, err := dbutil.ParseDBMapping(connstr)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
-	switch impl {
-	case dbutil.Postgres:
-		driverName = "pgx"
-	case dbutil.Cockroach:
-		driverName = "cockroach"
-	default:
-		return nil, Error.New("unsupported implementation: %s", connstr)
-	}
-
-	connstr, err = pgutil.CheckApplicationName(connstr, config.ApplicationName)
-	if err != nil {
-		return nil, Error.Wrap(err)
+	for name := range mapping {
+		if name != "" && name != readOnlyDBName {
+			return nil, Error.New("unsupported database in mapping: %q", name)
+		}
 	}
 
-	rawdb, err := tagsql.Open(ctx, driverName, connstr)
+	rawdb, connstr, impl, err := open(ctx, mapping[""], config.ApplicationName)
 	if err != nil {
-		return nil, Error.Wrap(err)
+		return nil, err
 	}
 	dbutil.Configure(ctx, rawdb, "metabase", mon)
 
@@ -84,16 +89,70 @@ func Open(ctx context.Context, log *zap.Logger, connstr string, config Config) (
 		db:          postgresRebind{rawdb},
 		connstr:     connstr,
 		impl:        impl,
+		changes:     &objectsChangedHooks{},
 		testCleanup: func() error { return nil },
 		config:      config,
 	}
 	db.aliasCache = NewNodeAliasCache(db)
 
+	if readOnlyConnstr, ok := mapping[readOnlyDBName]; ok {
+		readdb, _, readImpl, err := open(ctx, readOnlyConnstr, config.ApplicationName)
+		if err != nil {
+			return nil, errs.Combine(err, Error.Wrap(rawdb.Close()))
+		}
+		if readImpl != impl {
+			return nil, errs.Combine(
+				Error.New("read-only database implementation %s differs from %s", readImpl, impl),
+				Error.Wrap(readdb.Close()),
+				Error.Wrap(rawdb.Close()),
+			)
+		}
+		dbutil.Configure(ctx, readdb, "metabase:readonly", mon)
+
+		db.readOnly = postgresRebind{readdb}
+	}
+
 	log.Debug("Connected", zap.String("db source", connstr))
 
 	return db, nil
 }
 
+// open opens the database connection.
+func open(ctx context.Context, connstr, applicationName string) (_ tagsql.DB, _ string, _ dbutil.Implementation, err error) {
+	var driverName string
+	_, _, impl, err := dbutil.SplitConnStr(connstr)
+	if err != nil {
+		return nil, "", impl, Error.Wrap(err)
+	}
+	switch impl {
+	case dbutil.Postgres:
+		driverName = "pgx"
+	case dbutil.Cockroach:
+		driverName = "cockroach"
+	default:
+		return nil, "", impl, Error.New("unsupported implementation: %s", connstr)
+	}
+
+	connstr, err = pgutil.CheckApplicationName(connstr, applicationName)
+	if err != nil {
+		return nil, "", impl, Error.Wrap(err)
+	}
+
+	rawdb, err := tagsql.Open(ctx, driverName, connstr)
+	if err != nil {
+		return nil, "", impl, Error.Wrap(err)
+	}
+	return rawdb, connstr, impl, nil
+}
+
+// reads returns the database for reads tolerating stale data.
+func (db *DB) reads() tagsql.DB {
+	if db.readOnly != nil {
+		return db.readOnly
+	}
+	return db.db
+}
+
 // Implementation rturns the database implementation.
 func (db *DB) Implementation() dbutil.Implementation { return db.impl }
 
@@ -113,7 +172,11 @@ func (db *DB) TestingSetCleanup(cleanup func() error) {
 
 // Close closes the connection to database.
 func (db *DB) Close() error {
-	return errs.Combine(Error.Wrap(db.db.Close()), db.testCleanup())
+	var readOnlyErr error
+	if db.readOnly != nil {
+		readOnlyErr = Error.Wrap(db.readOnly.Close())
+	}
+	return errs.Combine(Error.Wrap(db.db.Close()), readOnlyErr, db.testCleanup())
 }
 
 // DestroyTables deletes all tables.
@@ -170,7 +233,7 @@ func (db *DB) TestMigrateToLatest(ctx context.Context) error {
 			{
 				DB:          &db.db,
 				Description: "Test snapshot",
-				Version:     16,
+				Version:     17,
 				Action: migrate.SQL{
 					`CREATE TABLE objects (
 						project_id   BYTEA NOT NULL,
@@ -300,6 +363,27 @@ func (db *DB) TestMigrateToLatest(ctx context.Context) error {
 					COMMENT ON TABLE  segment_copies                    is 'segment_copies contains a reference for sharing stream_id-s.';
 					COMMENT ON COLUMN segment_copies.stream_id          is 'stream_id refers to the objects.stream_id.';
 					COMMENT ON COLUMN segment_copies.ancestor_stream_id is 'ancestor_stream_id refers to the actual segments where data is stored.';
+
+					CREATE TABLE piece_deletion_queue (
+						stream_id           BYTEA NOT NULL,
+						position            INT8  NOT NULL,
+						root_piece_id       BYTEA NOT NULL,
+						remote_alias_pieces BYTEA NOT NULL,
+						encrypted_size      INT4  NOT NULL,
+						redundancy          INT8  NOT NULL,
+						queued_at           TIMESTAMPTZ NOT NULL DEFAULT now(),
+						PRIMARY KEY (stream_id, position)
+					);
+					CREATE INDEX piece_deletion_queue_queued_at_index ON piece_deletion_queue (queued_at);
+
+					COMMENT ON TABLE  piece_deletion_queue                     is 'piece_deletion_queue contains the deleted segments, whose pieces are deleted directly from the storagenodes instead of waiting for the garbage collection.';
+					COMMENT ON COLUMN piece_deletion_queue.stream_id           is 'stream_id is the stream_id of the deleted segment.';
+					COMMENT ON COLUMN piece_deletion_queue.position            is 'position is the position of the deleted segment.';
+					COMMENT ON COLUMN piece_deletion_queue.root_piece_id       is 'root_piece_id is used for deriving per storagenode piece numbers.';
+					COMMENT ON COLUMN piece_deletion_queue.remote_alias_pieces is 'remote_alias_pieces is a compressed list of storagenodes that contain the pieces. See metabase.AliasPieces to see how they are compressed.';
+					COMMENT ON COLUMN piece_deletion_queue.encrypted_size      is 'encrypted_size is the data size of the deleted segment before Reed-Solomon encoding.';
+					COMMENT ON COLUMN piece_deletion_queue.redundancy          is 'redundancy is the compressed Reed-Solomon redundancy parameters of the deleted segment.';
+					COMMENT ON COLUMN piece_deletion_queue.queued_at           is 'queued_at is the date when the segment was deleted and its pieces were queued.';
 					`,
 				},
 			},
@@ -597,6 +681,32 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					COMMENT ON COLUMN segment_copies.ancestor_stream_id is 'ancestor_stream_id refers to the actual segments where data is stored.';
 				`},
 			},
+			{
+				DB:          &db.db,
+				Description: "add table for the direct deletion of the pieces of the deleted segments",
+				Version:     17,
+				Action: migrate.SQL{
+					`CREATE TABLE piece_deletion_queue (
+						stream_id           BYTEA NOT NULL,
+						position            INT8  NOT NULL,
+						root_piece_id       BYTEA NOT NULL,
+						remote_alias_pieces BYTEA NOT NULL,
+						encrypted_size      INT4  NOT NULL,
+						redundancy          INT8  NOT NULL,
+						queued_at           TIMESTAMPTZ NOT NULL DEFAULT now(),
+						PRIMARY KEY (stream_id, position)
+					)`,
+					`CREATE INDEX piece_deletion_queue_queued_at_index ON piece_deletion_queue (queued_at)`,
+					`COMMENT ON TABLE  piece_deletion_queue                     is 'piece_deletion_queue contains the deleted segments, whose pieces are deleted directly from the storagenodes instead of waiting for the garbage collection.';
+					COMMENT ON COLUMN piece_deletion_queue.stream_id           is 'stream_id is the stream_id of the deleted segment.';
+					COMMENT ON COLUMN piece_deletion_queue.position            is 'position is the position of the deleted segment.';
+					COMMENT ON COLUMN piece_deletion_queue.root_piece_id       is 'root_piece_id is used for deriving per storagenode piece numbers.';
+					COMMENT ON COLUMN piece_deletion_queue.remote_alias_pieces is 'remote_alias_pieces is a compressed list of storagenodes that contain the pieces. See metabase.AliasPieces to see how they are compressed.';
+					COMMENT ON COLUMN piece_deletion_queue.encrypted_size      is 'encrypted_size is the data size of the deleted segment before Reed-Solomon encoding.';
+					COMMENT ON COLUMN piece_deletion_queue.redundancy          is 'redundancy is the compressed Reed-Solomon redundancy parameters of the deleted segment.';
+					COMMENT ON COLUMN piece_deletion_queue.queued_at           is 'queued_at is the date when the segment was deleted and its pieces were queued.';`,
+				},
+			},
 		},
 	}
 }
@@ -8,8 +8,11 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
 
 	"storj.io/common/testcontext"
+	"storj.io/private/dbutil/pgtest"
+	"storj.io/private/dbutil/tempdb"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/metabasetest"
 )
@@ -22,3 +25,40 @@ func TestNow(t *testing.T) {
 		require.WithinDuration(t, sysnow, now, 5*time.Second)
 	})
 }
+
+func TestOpenReadOnly(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	_, err := metabase.Open(ctx, zaptest.NewLogger(t), "postgres://primary,repairqueue:postgres://other", metabase.Config{})
+	require.Error(t, err)
+
+	pgtest.Run(t, func(ctx *testcontext.Context, t *testing.T, connstr string) {
+		tempDB, err := tempdb.OpenUnique(ctx, connstr, "metabase-readonly")
+		require.NoError(t, err)
+		defer ctx.Check(tempDB.Close)
+
+		db, err := metabase.Open(ctx, zaptest.NewLogger(t), tempDB.ConnStr+",readonly:"+tempDB.ConnStr, metabase.Config{
+			ApplicationName: "metabase-readonly-test",
+		})
+		require.NoError(t, err)
+		defer ctx.Check(db.Close)
+
+		require.NoError(t, db.TestMigrateToLatest(ctx))
+
+		obj := metabasetest.RandObjectStream()
+		metabasetest.CreateObject(ctx, t, db, obj, 2)
+
+		stats, err := db.GetTableStats(ctx, metabase.GetTableStats{})
+		require.NoError(t, err)
+		require.EqualValues(t, 2, stats.SegmentCount)
+
+		tallies, err := db.CollectBucketTallies(ctx, metabase.CollectBucketTallies{
+			From: obj.Location().Bucket(),
+			To:   obj.Location().Bucket(),
+		})
+		require.NoError(t, err)
+		require.Len(t, tallies, 1)
+		require.EqualValues(t, 1, tallies[0].ObjectCount)
+	})
+}
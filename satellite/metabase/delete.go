@@ -352,6 +352,8 @@ var updateSegmentsWithAncestor = `
 func (db *DB) DeleteObjectExactVersion(
 	ctx context.Context, opts DeleteObjectExactVersion,
 ) (result DeleteObjectResult, err error) {
+	defer func() { db.objectsChanged(ctx, err, opts.Bucket()) }()
+
 	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
 		result, err = db.deleteObjectExactVersion(ctx, opts, tx)
 		if err != nil {
@@ -569,6 +571,7 @@ func (db *DB) DeletePendingObject(ctx context.Context, opts DeletePendingObject)
 // DeleteObjectAnyStatusAllVersions deletes all object versions.
 func (db *DB) DeleteObjectAnyStatusAllVersions(ctx context.Context, opts DeleteObjectAnyStatusAllVersions) (result DeleteObjectResult, err error) {
 	defer mon.Task()(&ctx)(&err)
+	defer func() { db.objectsChanged(ctx, err, opts.Bucket()) }()
 
 	if db.config.ServerSideCopy {
 		return DeleteObjectResult{}, errs.New("method cannot be used when server-side copy is enabled")
@@ -646,6 +649,7 @@ func (db *DB) DeleteObjectsAllVersions(ctx context.Context, opts DeleteObjectsAl
 	// It is aleady verified that all object locations are in the same bucket
 	projectID := opts.Locations[0].ProjectID
 	bucketName := opts.Locations[0].BucketName
+	defer func() { db.objectsChanged(ctx, err, opts.Locations[0].Bucket()) }()
 
 	objectKeys := make([][]byte, len(opts.Locations))
 	for i := range opts.Locations {
@@ -892,6 +896,8 @@ func (obj *DeleteObjectLastCommitted) Verify() error {
 func (db *DB) DeleteObjectLastCommitted(
 	ctx context.Context, opts DeleteObjectLastCommitted,
 ) (result DeleteObjectResult, err error) {
+	defer func() { db.objectsChanged(ctx, err, opts.Bucket()) }()
+
 	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
 		result, err = db.deleteObjectLastCommitted(ctx, opts, tx)
 		if err != nil {
@@ -75,6 +75,7 @@ func getDeleteBucketObjectsSQLWithCopyFeature(impl dbutil.Implementation) (strin
 // when an error occurs.
 func (db *DB) DeleteBucketObjects(ctx context.Context, opts DeleteBucketObjects) (deletedObjectCount int64, err error) {
 	defer mon.Task()(&ctx)(&err)
+	defer func() { db.objectsChanged(ctx, err, opts.Bucket) }()
 
 	if err := opts.Bucket.Verify(); err != nil {
 		return 0, err
@@ -25,6 +25,11 @@ type DeleteExpiredObjects struct {
 	ExpiredBefore  time.Time
 	AsOfSystemTime time.Time
 	BatchSize      int
+
+	// QueuePieceDeletions adds the pieces of the deleted segments to the
+	// piece deletion queue, so they can be deleted directly from the
+	// storagenodes instead of waiting for the garbage collection.
+	QueuePieceDeletions bool
 }
 
 // DeleteExpiredObjects deletes all objects that expired before expiredBefore.
@@ -83,7 +88,7 @@ func (db *DB) DeleteExpiredObjects(ctx context.Context, opts DeleteExpiredObject
 			return ObjectStream{}, nil
 		}
 
-		err = db.deleteObjectsAndSegments(ctx, expiredObjects)
+		err = db.deleteObjectsAndSegments(ctx, expiredObjects, opts.QueuePieceDeletions)
 		if err != nil {
 			db.log.Warn("delete from DB expired objects", zap.Error(err))
 			return ObjectStream{}, nil
@@ -183,13 +188,17 @@ func (db *DB) deleteObjectsAndSegmentsBatch(ctx context.Context, batchsize int,
 	}
 }
 
-func (db *DB) deleteObjectsAndSegments(ctx context.Context, objects []ObjectStream) (err error) {
+func (db *DB) deleteObjectsAndSegments(ctx context.Context, objects []ObjectStream, queuePieceDeletions bool) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if len(objects) == 0 {
 		return nil
 	}
 
+	if queuePieceDeletions {
+		return db.deleteObjectsAndSegmentsQueuingPieces(ctx, objects)
+	}
+
 	err = pgxutil.Conn(ctx, db.db, func(conn *pgx.Conn) error {
 		var batch pgx.Batch
 		for _, obj := range objects {
@@ -237,6 +246,83 @@ func (db *DB) deleteObjectsAndSegments(ctx context.Context, objects []ObjectStre
 	return nil
 }
 
+// deleteObjectsAndSegmentsQueuingPieces deletes the objects and their segments
+// and, within the same statement, adds the remote segments to the piece deletion queue.
+//
+// Segments of copied objects are not queued, because their pieces may still be
+// referenced by the other copies. Those are left for the garbage collection.
+func (db *DB) deleteObjectsAndSegmentsQueuingPieces(ctx context.Context, objects []ObjectStream) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = pgxutil.Conn(ctx, db.db, func(conn *pgx.Conn) error {
+		var batch pgx.Batch
+		for _, obj := range objects {
+			obj := obj
+
+			batch.Queue(`
+				WITH deleted_objects AS (
+					DELETE FROM objects
+					WHERE (project_id, bucket_name, object_key, version, stream_id) = ($1::BYTEA, $2, $3, $4, $5::BYTEA)
+					RETURNING stream_id
+				), deleted_segments AS (
+					DELETE FROM segments
+					WHERE segments.stream_id = $5::BYTEA
+					RETURNING stream_id, position, root_piece_id, remote_alias_pieces, encrypted_size, redundancy
+				), queued_segments AS (
+					INSERT INTO piece_deletion_queue (
+						stream_id, position, root_piece_id, remote_alias_pieces, encrypted_size, redundancy
+					)
+					SELECT stream_id, position, root_piece_id, remote_alias_pieces, encrypted_size, redundancy
+					FROM deleted_segments
+					WHERE
+						remote_alias_pieces IS NOT NULL AND length(remote_alias_pieces) > 0
+						AND NOT EXISTS (
+							SELECT 1 FROM segment_copies
+							WHERE stream_id = $5::BYTEA OR ancestor_stream_id = $5::BYTEA
+						)
+					ON CONFLICT (stream_id, position) DO NOTHING
+					RETURNING 1
+				)
+				SELECT
+					(SELECT count(*) FROM deleted_segments),
+					(SELECT count(*) FROM queued_segments)
+			`, obj.ProjectID, []byte(obj.BucketName), []byte(obj.ObjectKey), obj.Version, obj.StreamID)
+		}
+
+		results := conn.SendBatch(ctx, &batch)
+		defer func() { err = errs.Combine(err, results.Close()) }()
+
+		var objectsDeletedGuess, segmentsDeleted, segmentsQueued int64
+
+		var errlist errs.Group
+		for i := 0; i < batch.Len(); i++ {
+			var deleted, queued int64
+			if err := results.QueryRow().Scan(&deleted, &queued); err != nil {
+				errlist.Add(err)
+				continue
+			}
+
+			if deleted > 0 {
+				// Note, this slightly miscounts objects without any segments,
+				// see deleteObjectsAndSegments.
+				objectsDeletedGuess++
+				segmentsDeleted += deleted
+			}
+			segmentsQueued += queued
+		}
+
+		mon.Meter("object_delete").Mark64(objectsDeletedGuess)
+		mon.Meter("segment_delete").Mark64(segmentsDeleted)
+		mon.Meter("piece_deletion_queue_insert").Mark64(segmentsQueued)
+
+		return errlist.Err()
+	})
+	if err != nil {
+		return Error.New("unable to delete expired objects: %w", err)
+	}
+	return nil
+}
+
 func (db *DB) deleteInactiveObjectsAndSegments(ctx context.Context, objects []ObjectStream, inactiveDeadline time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
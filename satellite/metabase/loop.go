@@ -451,7 +451,7 @@ func (db *DB) CollectBucketTallies(ctx context.Context, opts CollectBucketTallie
 		opts.Now = time.Now()
 	}
 
-	err = withRows(db.db.QueryContext(ctx, `
+	err = withRows(db.reads().QueryContext(ctx, `
 			SELECT project_id, bucket_name, SUM(total_encrypted_size), SUM(segment_count), COALESCE(SUM(length(encrypted_metadata)), 0), count(*)
 			FROM objects
 			`+db.asOfTime(opts.AsOfSystemTime, opts.AsOfSystemInterval)+`
@@ -0,0 +1,498 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabasetest
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// RandomOperations is for testing a random sequence of valid metabase operations.
+//
+// The operations begin, upload, commit, copy, move and delete the objects on a few
+// locations of a single bucket, so the versions of the objects collide often. After
+// each operation the objects and the segments in the database are verified against
+// a model of the expected state.
+type RandomOperations struct {
+	// Seed determines the sequence of the operations. Zero uses a random seed, which
+	// is logged, so a failing sequence can be replayed.
+	Seed int64
+	// Steps is the number of operations.
+	Steps int
+	// Locations is the number of object keys used by the operations.
+	Locations int
+}
+
+// Check runs the operations and verifies the invariants after each of them.
+func (step RandomOperations) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	seed := step.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	t.Logf("random operations seed: %d", seed)
+
+	model := newRandomModel(seed, step.Locations)
+	for i := 0; i < step.Steps; i++ {
+		op := model.next(ctx, t, db)
+		model.verify(ctx, t, db, fmt.Sprintf("seed %d, step %d: %s", seed, i, op))
+	}
+}
+
+// randomObject is an object in the model of RandomOperations.
+type randomObject struct {
+	metabase.ObjectStream
+	// Segments are the committed segments, true for the inline ones.
+	Segments []bool
+}
+
+// randomModel contains the expected objects of RandomOperations.
+type randomModel struct {
+	rng *rand.Rand
+
+	projectID  uuid.UUID
+	bucketName string
+	keys       []metabase.ObjectKey
+
+	committed map[metabase.ObjectKey]*randomObject
+	pending   map[metabase.ObjectKey][]*randomObject
+}
+
+func newRandomModel(seed int64, locations int) *randomModel {
+	if locations <= 0 {
+		locations = 1
+	}
+
+	model := &randomModel{
+		rng:        rand.New(rand.NewSource(seed)),
+		bucketName: "random-bucket",
+		committed:  map[metabase.ObjectKey]*randomObject{},
+		pending:    map[metabase.ObjectKey][]*randomObject{},
+	}
+	model.projectID = model.uuid()
+	for i := 0; i < locations; i++ {
+		model.keys = append(model.keys, metabase.ObjectKey(fmt.Sprintf("key-%d", i)))
+	}
+	return model
+}
+
+// uuid returns a random non-zero uuid.
+func (model *randomModel) uuid() uuid.UUID {
+	var id uuid.UUID
+	for id.IsZero() {
+		_, _ = model.rng.Read(id[:])
+	}
+	return id
+}
+
+func (model *randomModel) randomKey() metabase.ObjectKey {
+	return model.keys[model.rng.Intn(len(model.keys))]
+}
+
+func (model *randomModel) location(key metabase.ObjectKey) metabase.ObjectLocation {
+	return metabase.ObjectLocation{
+		ProjectID:  model.projectID,
+		BucketName: model.bucketName,
+		ObjectKey:  key,
+	}
+}
+
+// pendingObjects returns the pending objects in a deterministic order.
+func (model *randomModel) pendingObjects() []*randomObject {
+	var objects []*randomObject
+	for _, key := range model.keys {
+		objects = append(objects, model.pending[key]...)
+	}
+	return objects
+}
+
+// committedObjects returns the committed objects in a deterministic order.
+func (model *randomModel) committedObjects() []*randomObject {
+	var objects []*randomObject
+	for _, key := range model.keys {
+		if object, ok := model.committed[key]; ok {
+			objects = append(objects, object)
+		}
+	}
+	return objects
+}
+
+func (model *randomModel) removePending(object *randomObject) {
+	objects := model.pending[object.ObjectKey]
+	for i, pending := range objects {
+		if pending == object {
+			objects = append(objects[:i:i], objects[i+1:]...)
+			break
+		}
+	}
+	if len(objects) == 0 {
+		delete(model.pending, object.ObjectKey)
+		return
+	}
+	model.pending[object.ObjectKey] = objects
+}
+
+// newSegmentKeys returns new encryption keys for the segments at the positions.
+func (model *randomModel) newSegmentKeys(keys []metabase.EncryptedKeyAndNonce) []metabase.EncryptedKeyAndNonce {
+	newKeys := make([]metabase.EncryptedKeyAndNonce, len(keys))
+	for i, key := range keys {
+		newKeys[i] = metabase.EncryptedKeyAndNonce{
+			Position:          key.Position,
+			EncryptedKeyNonce: []byte{byte(model.rng.Intn(256))},
+			EncryptedKey:      []byte{byte(model.rng.Intn(256))},
+		}
+	}
+	return newKeys
+}
+
+// next runs a random operation, which is valid for the current state, and returns its
+// description.
+func (model *randomModel) next(ctx *testcontext.Context, t testing.TB, db *metabase.DB) string {
+	for {
+		var op string
+		switch model.rng.Intn(7) {
+		case 0:
+			op = model.begin(ctx, t, db)
+		case 1:
+			op = model.upload(ctx, t, db)
+		case 2:
+			op = model.commit(ctx, t, db)
+		case 3:
+			op = model.copy(ctx, t, db)
+		case 4:
+			op = model.move(ctx, t, db)
+		case 5:
+			op = model.deletePending(ctx, t, db)
+		case 6:
+			op = model.deleteLastCommitted(ctx, t, db)
+		}
+		if op != "" {
+			return op
+		}
+	}
+}
+
+func (model *randomModel) begin(ctx *testcontext.Context, t testing.TB, db *metabase.DB) string {
+	stream := metabase.ObjectStream{
+		ProjectID:  model.projectID,
+		BucketName: model.bucketName,
+		ObjectKey:  model.randomKey(),
+		Version:    metabase.NextVersion,
+		StreamID:   model.uuid(),
+	}
+	op := fmt.Sprintf("begin object %q stream %s", stream.ObjectKey, stream.StreamID)
+
+	object, err := db.BeginObjectNextVersion(ctx, metabase.BeginObjectNextVersion{
+		ObjectStream: stream,
+		Encryption:   DefaultEncryption,
+	})
+	require.NoError(t, err, op)
+
+	stream.Version = object.Version
+	model.pending[stream.ObjectKey] = append(model.pending[stream.ObjectKey], &randomObject{ObjectStream: stream})
+	return op
+}
+
+func (model *randomModel) upload(ctx *testcontext.Context, t testing.TB, db *metabase.DB) string {
+	objects := model.pendingObjects()
+	if len(objects) == 0 {
+		return ""
+	}
+	object := objects[model.rng.Intn(len(objects))]
+	position := metabase.SegmentPosition{Index: uint32(len(object.Segments))}
+	inline := model.rng.Intn(2) == 0
+
+	if inline {
+		op := fmt.Sprintf("upload inline segment %d of %q version %d", position.Index, object.ObjectKey, object.Version)
+		err := db.CommitInlineSegment(ctx, metabase.CommitInlineSegment{
+			ObjectStream:      object.ObjectStream,
+			Position:          position,
+			EncryptedKey:      []byte{3},
+			EncryptedKeyNonce: []byte{4},
+			EncryptedETag:     []byte{5},
+			PlainSize:         16,
+			InlineData:        []byte("inline-data-0123"),
+		})
+		require.NoError(t, err, op)
+
+		object.Segments = append(object.Segments, true)
+		return op
+	}
+
+	op := fmt.Sprintf("upload remote segment %d of %q version %d", position.Index, object.ObjectKey, object.Version)
+	rootPieceID := storj.PieceID{byte(model.rng.Intn(255) + 1)}
+	pieces := metabase.Pieces{{Number: 0, StorageNode: storj.NodeID{byte(model.rng.Intn(255) + 1)}}}
+
+	err := db.BeginSegment(ctx, metabase.BeginSegment{
+		ObjectStream: object.ObjectStream,
+		Position:     position,
+		RootPieceID:  rootPieceID,
+		Pieces:       pieces,
+	})
+	require.NoError(t, err, op)
+
+	err = db.CommitSegment(ctx, metabase.CommitSegment{
+		ObjectStream:      object.ObjectStream,
+		Position:          position,
+		RootPieceID:       rootPieceID,
+		Pieces:            pieces,
+		EncryptedKey:      []byte{3},
+		EncryptedKeyNonce: []byte{4},
+		EncryptedETag:     []byte{5},
+		EncryptedSize:     1024,
+		PlainSize:         512,
+		Redundancy:        DefaultRedundancy,
+	})
+	require.NoError(t, err, op)
+
+	object.Segments = append(object.Segments, false)
+	return op
+}
+
+func (model *randomModel) commit(ctx *testcontext.Context, t testing.TB, db *metabase.DB) string {
+	objects := model.pendingObjects()
+	if len(objects) == 0 {
+		return ""
+	}
+	object := objects[model.rng.Intn(len(objects))]
+	op := fmt.Sprintf("commit %q version %d with %d segments", object.ObjectKey, object.Version, len(object.Segments))
+
+	_, err := db.CommitObject(ctx, metabase.CommitObject{
+		ObjectStream: object.ObjectStream,
+	})
+	require.NoError(t, err, op)
+
+	// committing replaces the committed object at the location.
+	model.removePending(object)
+	model.committed[object.ObjectKey] = object
+	return op
+}
+
+func (model *randomModel) copy(ctx *testcontext.Context, t testing.TB, db *metabase.DB) string {
+	objects := model.committedObjects()
+	if len(objects) == 0 {
+		return ""
+	}
+	source := objects[model.rng.Intn(len(objects))]
+	destination := model.randomKey()
+	newStreamID := model.uuid()
+	op := fmt.Sprintf("copy %q to %q stream %s", source.ObjectKey, destination, newStreamID)
+
+	begin, err := db.BeginCopyObject(ctx, metabase.BeginCopyObject{
+		ObjectLocation: source.Location(),
+	})
+	require.NoError(t, err, op)
+	require.Equal(t, source.Version, begin.Version, op)
+	require.Len(t, begin.EncryptedKeysNonces, len(source.Segments), op)
+
+	copied, err := db.FinishCopyObject(ctx, metabase.FinishCopyObject{
+		ObjectStream:          source.ObjectStream,
+		NewBucket:             model.bucketName,
+		NewEncryptedObjectKey: destination,
+		NewStreamID:           newStreamID,
+		NewSegmentKeys:        model.newSegmentKeys(begin.EncryptedKeysNonces),
+	})
+	require.NoError(t, err, op)
+
+	if destination == source.ObjectKey {
+		// copying an object onto itself keeps the object.
+		require.Equal(t, source.StreamID, copied.StreamID, op)
+		return op
+	}
+	require.Equal(t, newStreamID, copied.StreamID, op)
+
+	stream := copied.ObjectStream
+	model.committed[destination] = &randomObject{
+		ObjectStream: stream,
+		Segments:     append([]bool(nil), source.Segments...),
+	}
+	return op
+}
+
+func (model *randomModel) move(ctx *testcontext.Context, t testing.TB, db *metabase.DB) string {
+	objects := model.committedObjects()
+	if len(objects) == 0 || len(model.keys) < 2 {
+		return ""
+	}
+	source := objects[model.rng.Intn(len(objects))]
+	destination := model.randomKey()
+	for destination == source.ObjectKey {
+		destination = model.randomKey()
+	}
+	op := fmt.Sprintf("move %q to %q", source.ObjectKey, destination)
+
+	begin, err := db.BeginMoveObject(ctx, metabase.BeginMoveObject{
+		ObjectLocation: source.Location(),
+	})
+	require.NoError(t, err, op)
+	require.Len(t, begin.EncryptedKeysNonces, len(source.Segments), op)
+
+	err = db.FinishMoveObject(ctx, metabase.FinishMoveObject{
+		ObjectStream:          source.ObjectStream,
+		NewBucket:             model.bucketName,
+		NewSegmentKeys:        model.newSegmentKeys(begin.EncryptedKeysNonces),
+		NewEncryptedObjectKey: []byte(destination),
+	})
+	if _, exists := model.committed[destination]; exists {
+		require.True(t, metabase.ErrObjectAlreadyExists.Has(err), "%s: %v", op, err)
+		return op + " (already exists)"
+	}
+	require.NoError(t, err, op)
+
+	moved, err := db.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+		ObjectLocation: model.location(destination),
+	})
+	require.NoError(t, err, op)
+	require.Equal(t, source.StreamID, moved.StreamID, op)
+
+	delete(model.committed, source.ObjectKey)
+	source.ObjectStream = moved.ObjectStream
+	model.committed[destination] = source
+	return op
+}
+
+func (model *randomModel) deletePending(ctx *testcontext.Context, t testing.TB, db *metabase.DB) string {
+	objects := model.pendingObjects()
+	if len(objects) == 0 {
+		return ""
+	}
+	object := objects[model.rng.Intn(len(objects))]
+	op := fmt.Sprintf("delete pending %q version %d", object.ObjectKey, object.Version)
+
+	result, err := db.DeletePendingObject(ctx, metabase.DeletePendingObject{
+		ObjectStream: object.ObjectStream,
+	})
+	require.NoError(t, err, op)
+	require.Len(t, result.Objects, 1, op)
+
+	model.removePending(object)
+	return op
+}
+
+func (model *randomModel) deleteLastCommitted(ctx *testcontext.Context, t testing.TB, db *metabase.DB) string {
+	key := model.randomKey()
+	op := fmt.Sprintf("delete last committed %q", key)
+
+	result, err := db.DeleteObjectLastCommitted(ctx, metabase.DeleteObjectLastCommitted{
+		ObjectLocation: model.location(key),
+	})
+	require.NoError(t, err, op)
+
+	object, exists := model.committed[key]
+	if !exists {
+		require.Empty(t, result.Objects, op)
+		return op
+	}
+	require.Len(t, result.Objects, 1, op)
+	require.Equal(t, object.StreamID, result.Objects[0].StreamID, op)
+
+	delete(model.committed, key)
+	return op
+}
+
+// verify checks that the database matches the model and that the invariants hold.
+func (model *randomModel) verify(ctx *testcontext.Context, t testing.TB, db *metabase.DB, op string) {
+	type objectInfo struct {
+		Key      metabase.ObjectKey
+		Version  metabase.Version
+		Status   metabase.ObjectStatus
+		Segments int32
+	}
+
+	expected := map[uuid.UUID]objectInfo{}
+	for _, object := range model.pendingObjects() {
+		expected[object.StreamID] = objectInfo{object.ObjectKey, object.Version, metabase.Pending, 0}
+	}
+	for _, object := range model.committedObjects() {
+		expected[object.StreamID] = objectInfo{object.ObjectKey, object.Version, metabase.Committed, int32(len(object.Segments))}
+	}
+
+	objects, err := db.TestingAllObjects(ctx)
+	require.NoError(t, err, op)
+
+	actual := map[uuid.UUID]objectInfo{}
+	committedAt := map[metabase.ObjectKey]int{}
+	for _, object := range objects {
+		_, duplicate := actual[object.StreamID]
+		require.False(t, duplicate, "%s: stream %s is used by several objects", op, object.StreamID)
+
+		actual[object.StreamID] = objectInfo{object.ObjectKey, object.Version, object.Status, object.SegmentCount}
+		if object.Status == metabase.Committed {
+			committedAt[object.ObjectKey]++
+		}
+	}
+	require.Equal(t, expected, actual, op)
+
+	for key, count := range committedAt {
+		require.Equal(t, 1, count, "%s: %q has several committed objects", op, key)
+	}
+
+	// every segment belongs to an object and every object has all of its segments.
+	segments, err := db.TestingAllSegments(ctx)
+	require.NoError(t, err, op)
+
+	segmentCount := map[uuid.UUID]int{}
+	for _, segment := range segments {
+		_, ok := actual[segment.StreamID]
+		require.True(t, ok, "%s: segment %v of stream %s has no object", op, segment.Position, segment.StreamID)
+		segmentCount[segment.StreamID]++
+	}
+
+	objectsByStream := map[uuid.UUID]*randomObject{}
+	for _, object := range append(model.pendingObjects(), model.committedObjects()...) {
+		objectsByStream[object.StreamID] = object
+	}
+	streamIDs := make([]uuid.UUID, 0, len(objectsByStream))
+	for streamID := range objectsByStream {
+		streamIDs = append(streamIDs, streamID)
+	}
+	sort.Slice(streamIDs, func(i, k int) bool { return streamIDs[i].Less(streamIDs[k]) })
+
+	for _, streamID := range streamIDs {
+		object := objectsByStream[streamID]
+		require.Equal(t, len(object.Segments), segmentCount[streamID], "%s: segments of %q version %d", op, object.ObjectKey, object.Version)
+	}
+
+	// the committed objects are readable with the pieces of all their remote segments,
+	// even if the pieces are stored in an ancestor segment.
+	for _, object := range model.committedObjects() {
+		last, err := db.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+			ObjectLocation: object.Location(),
+		})
+		require.NoError(t, err, op)
+		require.Equal(t, object.StreamID, last.StreamID, op)
+
+		list, err := db.ListSegments(ctx, metabase.ListSegments{
+			StreamID: object.StreamID,
+		})
+		require.NoError(t, err, op)
+		require.Len(t, list.Segments, len(object.Segments), op)
+
+		for i, segment := range list.Segments {
+			require.Equal(t, object.Segments[i], segment.Inline(), "%s: segment %d of %q", op, i, object.ObjectKey)
+			if !segment.Inline() {
+				require.NotEmpty(t, segment.Pieces, "%s: segment %d of %q has no pieces", op, i, object.ObjectKey)
+			}
+		}
+	}
+
+	for _, key := range model.keys {
+		if _, ok := model.committed[key]; ok {
+			continue
+		}
+		_, err := db.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+			ObjectLocation: model.location(key),
+		})
+		require.True(t, metabase.ErrObjectNotFound.Has(err), "%s: %q should not be committed: %v", op, key, err)
+	}
+}
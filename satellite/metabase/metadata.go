@@ -21,6 +21,8 @@ type UpdateObjectMetadata struct {
 	EncryptedMetadata             []byte
 	EncryptedMetadataNonce        []byte
 	EncryptedMetadataEncryptedKey []byte
+	// MaxEncryptedMetadataSize limits the size of EncryptedMetadata, 0 means no limit.
+	MaxEncryptedMetadataSize int
 }
 
 // Verify object stream fields.
@@ -35,12 +37,24 @@ func (obj *UpdateObjectMetadata) Verify() error {
 	case obj.StreamID.IsZero():
 		return ErrInvalidRequest.New("StreamID missing")
 	}
+	return verifyEncryptedMetadataSize(obj.EncryptedMetadata, obj.MaxEncryptedMetadataSize)
+}
+
+// verifyEncryptedMetadataSize checks the size of the encrypted metadata against the limit,
+// 0 means no limit.
+func verifyEncryptedMetadataSize(encryptedMetadata []byte, maxSize int) error {
+	if maxSize > 0 && len(encryptedMetadata) > maxSize {
+		return ErrMetadataTooLarge.New("encrypted metadata is %d bytes, the limit is %d bytes", len(encryptedMetadata), maxSize)
+	}
 	return nil
 }
 
 // UpdateObjectMetadata updates an object metadata.
 func (db *DB) UpdateObjectMetadata(ctx context.Context, opts UpdateObjectMetadata) (err error) {
 	defer mon.Task()(&ctx)(&err)
+	defer func() {
+		db.objectsChanged(ctx, err, BucketLocation{ProjectID: opts.ProjectID, BucketName: opts.BucketName})
+	}()
 
 	if err := opts.Verify(); err != nil {
 		return err
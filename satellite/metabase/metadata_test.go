@@ -68,6 +68,31 @@ func TestUpdateObjectMetadata(t *testing.T) {
 			metabasetest.Verify{}.Check(ctx, t, db)
 		})
 
+		t.Run("Metadata too large", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			metabasetest.UpdateObjectMetadata{
+				Opts: metabase.UpdateObjectMetadata{
+					ProjectID:                     obj.ProjectID,
+					BucketName:                    obj.BucketName,
+					ObjectKey:                     obj.ObjectKey,
+					StreamID:                      obj.StreamID,
+					EncryptedMetadata:             testrand.Bytes(1025),
+					EncryptedMetadataNonce:        testrand.Nonce().Bytes(),
+					EncryptedMetadataEncryptedKey: testrand.Bytes(32),
+					MaxEncryptedMetadataSize:      1024,
+				},
+				ErrClass: &metabase.ErrMetadataTooLarge,
+				ErrText:  "encrypted metadata is 1025 bytes, the limit is 1024 bytes",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{metabase.RawObject(object)},
+			}.Check(ctx, t, db)
+		})
+
 		t.Run("Update metadata", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 
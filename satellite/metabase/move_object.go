@@ -31,6 +31,10 @@ type EncryptedKeyAndNonce struct {
 // BeginMoveObject holds all data needed begin move object method.
 type BeginMoveObject struct {
 	ObjectLocation
+
+	// VerifyLimits holds a callback by which the caller can interrupt the move
+	// if it turns out the move would exceed a limit.
+	VerifyLimits func(encryptedObjectSize int64, nSegments int64) error
 }
 
 // BeginMoveCopyResults holds all data needed to begin move and copy object methods.
@@ -46,7 +50,7 @@ type BeginMoveCopyResults struct {
 
 // BeginMoveObject collects all data needed to begin object move procedure.
 func (db *DB) BeginMoveObject(ctx context.Context, opts BeginMoveObject) (_ BeginMoveObjectResult, err error) {
-	result, err := db.beginMoveCopyObject(ctx, opts.ObjectLocation, MoveSegmentLimit, nil)
+	result, err := db.beginMoveCopyObject(ctx, opts.ObjectLocation, MoveSegmentLimit, opts.VerifyLimits)
 	if err != nil {
 		return BeginMoveObjectResult{}, err
 	}
@@ -148,6 +152,9 @@ func (finishMove FinishMoveObject) Verify() error {
 // FinishMoveObject accepts new encryption keys for moved object and updates the corresponding object ObjectKey and segments EncryptedKey.
 func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (err error) {
 	defer mon.Task()(&ctx)(&err)
+	defer func() {
+		db.objectsChanged(ctx, err, opts.Location().Bucket(), BucketLocation{ProjectID: opts.ProjectID, BucketName: opts.NewBucket})
+	}()
 
 	if err := opts.Verify(); err != nil {
 		return err
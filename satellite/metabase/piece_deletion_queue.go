@@ -0,0 +1,122 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/tagsql"
+)
+
+// QueuedSegment is a deleted segment, whose pieces are waiting to be deleted
+// from the storagenodes.
+type QueuedSegment struct {
+	StreamID uuid.UUID
+	Position SegmentPosition
+
+	RootPieceID   storj.PieceID
+	Pieces        Pieces
+	EncryptedSize int32 // size of the whole segment (not a piece)
+	Redundancy    storj.RedundancyScheme
+
+	QueuedAt time.Time
+}
+
+// ListQueuedSegments contains arguments necessary for listing the piece deletion queue.
+type ListQueuedSegments struct {
+	Limit int
+}
+
+// ListQueuedSegmentsResult is the result of ListQueuedSegments.
+type ListQueuedSegmentsResult struct {
+	Segments []QueuedSegment
+}
+
+// ListQueuedSegments lists the oldest segments in the piece deletion queue.
+func (db *DB) ListQueuedSegments(ctx context.Context, opts ListQueuedSegments) (result ListQueuedSegmentsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.Limit <= 0 {
+		return ListQueuedSegmentsResult{}, ErrInvalidRequest.New("Invalid limit: %d", opts.Limit)
+	}
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			stream_id, position, root_piece_id, remote_alias_pieces,
+			encrypted_size, redundancy, queued_at
+		FROM piece_deletion_queue
+		ORDER BY queued_at, stream_id, position
+		LIMIT $1
+	`, opts.Limit))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var segment QueuedSegment
+			var aliasPieces AliasPieces
+			err = rows.Scan(
+				&segment.StreamID, &segment.Position,
+				&segment.RootPieceID, &aliasPieces,
+				&segment.EncryptedSize, redundancyScheme{&segment.Redundancy},
+				&segment.QueuedAt,
+			)
+			if err != nil {
+				return Error.New("failed to scan queued segments: %w", err)
+			}
+
+			segment.Pieces, err = db.aliasCache.ConvertAliasesToPieces(ctx, aliasPieces)
+			if err != nil {
+				return Error.New("failed to convert aliases to pieces: %w", err)
+			}
+
+			result.Segments = append(result.Segments, segment)
+		}
+		return nil
+	})
+	if err != nil {
+		return ListQueuedSegmentsResult{}, Error.New("unable to list piece deletion queue: %w", err)
+	}
+
+	return result, nil
+}
+
+// QueuedSegmentKey identifies a segment in the piece deletion queue.
+type QueuedSegmentKey struct {
+	StreamID uuid.UUID
+	Position SegmentPosition
+}
+
+// DeleteQueuedSegments contains arguments necessary for removing segments from the piece deletion queue.
+type DeleteQueuedSegments struct {
+	Segments []QueuedSegmentKey
+}
+
+// DeleteQueuedSegments removes the segments from the piece deletion queue.
+func (db *DB) DeleteQueuedSegments(ctx context.Context, opts DeleteQueuedSegments) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(opts.Segments) == 0 {
+		return nil
+	}
+
+	streamIDs := make([][]byte, len(opts.Segments))
+	positions := make([]int64, len(opts.Segments))
+	for i, segment := range opts.Segments {
+		streamIDs[i] = segment.StreamID.Bytes()
+		positions[i] = int64(segment.Position.Encode())
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		DELETE FROM piece_deletion_queue
+		WHERE (stream_id, position) IN (
+			SELECT unnest($1::BYTEA[]), unnest($2::INT8[])
+		)
+	`, pgutil.ByteaArray(streamIDs), pgutil.Int8Array(positions))
+	if err != nil {
+		return Error.New("unable to delete from piece deletion queue: %w", err)
+	}
+
+	return nil
+}
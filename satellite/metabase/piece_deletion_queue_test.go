@@ -0,0 +1,97 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestPieceDeletionQueue(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("invalid limit", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			_, err := db.ListQueuedSegments(ctx, metabase.ListQueuedSegments{})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+		})
+
+		t.Run("not queued by default", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			expiresAt := time.Now().Add(-time.Hour)
+			_ = metabasetest.CreateExpiredObject(ctx, t, db, metabasetest.RandObjectStream(), 2, expiresAt)
+
+			metabasetest.DeleteExpiredObjects{
+				Opts: metabase.DeleteExpiredObjects{
+					ExpiredBefore: time.Now(),
+				},
+			}.Check(ctx, t, db)
+			metabasetest.Verify{}.Check(ctx, t, db)
+
+			result, err := db.ListQueuedSegments(ctx, metabase.ListQueuedSegments{Limit: 10})
+			require.NoError(t, err)
+			require.Empty(t, result.Segments)
+		})
+
+		t.Run("queue expired segments", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			expired := metabasetest.RandObjectStream()
+			expiresAt := time.Now().Add(-time.Hour)
+			_ = metabasetest.CreateExpiredObject(ctx, t, db, expired, 2, expiresAt)
+
+			alive := metabasetest.RandObjectStream()
+			object := metabasetest.CreateObject(ctx, t, db, alive, 1)
+
+			metabasetest.DeleteExpiredObjects{
+				Opts: metabase.DeleteExpiredObjects{
+					ExpiredBefore:       time.Now(),
+					QueuePieceDeletions: true,
+				},
+			}.Check(ctx, t, db)
+
+			segments, err := db.TestingAllSegments(ctx)
+			require.NoError(t, err)
+			require.Len(t, segments, 1)
+			require.Equal(t, object.StreamID, segments[0].StreamID)
+
+			result, err := db.ListQueuedSegments(ctx, metabase.ListQueuedSegments{Limit: 10})
+			require.NoError(t, err)
+			require.Len(t, result.Segments, 2)
+
+			var keys []metabase.QueuedSegmentKey
+			for _, segment := range result.Segments {
+				require.Equal(t, expired.StreamID, segment.StreamID)
+				require.Equal(t, storj.PieceID{1}, segment.RootPieceID)
+				require.Equal(t, metabase.Pieces{{Number: 0, StorageNode: storj.NodeID{2}}}, segment.Pieces)
+				require.Equal(t, int32(1024), segment.EncryptedSize)
+				require.Equal(t, metabasetest.DefaultRedundancy, segment.Redundancy)
+				require.WithinDuration(t, time.Now(), segment.QueuedAt, time.Minute)
+
+				keys = append(keys, metabase.QueuedSegmentKey{
+					StreamID: segment.StreamID,
+					Position: segment.Position,
+				})
+			}
+
+			result, err = db.ListQueuedSegments(ctx, metabase.ListQueuedSegments{Limit: 1})
+			require.NoError(t, err)
+			require.Len(t, result.Segments, 1)
+
+			require.NoError(t, db.DeleteQueuedSegments(ctx, metabase.DeleteQueuedSegments{Segments: keys}))
+
+			result, err = db.ListQueuedSegments(ctx, metabase.ListQueuedSegments{Limit: 10})
+			require.NoError(t, err)
+			require.Empty(t, result.Segments)
+		})
+	})
+}
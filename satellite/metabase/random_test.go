@@ -0,0 +1,30 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"fmt"
+	"testing"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestRandomOperations(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		for _, seed := range []int64{1, 2, 3, 0} {
+			seed := seed
+			t.Run(fmt.Sprint(seed), func(t *testing.T) {
+				defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+				metabasetest.RandomOperations{
+					Seed:      seed,
+					Steps:     200,
+					Locations: 3,
+				}.Check(ctx, t, db)
+			})
+		}
+	})
+}
@@ -0,0 +1,171 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rangedloop
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+)
+
+// ErrCycleFenced is returned when another instance holds the lease of the loop cycle.
+var ErrCycleFenced = errs.Class("ranged loop cycle fenced")
+
+// CycleLease is the lease of a single cycle of a named loop.
+type CycleLease struct {
+	// Name identifies the loop.
+	Name string
+	// Owner is the random id of the instance holding the lease.
+	Owner uuid.UUID
+	// Token is the fencing token, which increases with every acquisition of the lease.
+	Token int64
+	// ExpiresAt is the time the lease expires, unless it's renewed.
+	ExpiresAt time.Time
+}
+
+// CycleLeases stores the leases of the loop cycles. A lease ensures that only a
+// single instance runs the observers of a loop against the same database.
+//
+// The expiration is computed by the database, so the clocks of the instances
+// don't need to be in sync.
+type CycleLeases interface {
+	// Acquire acquires the lease of the loop for the duration and increments the
+	// fencing token. It fails with ErrCycleFenced when another owner holds an
+	// unexpired lease.
+	Acquire(ctx context.Context, name string, owner uuid.UUID, duration time.Duration) (CycleLease, error)
+	// Renew extends the lease by the duration. It fails with ErrCycleFenced when
+	// the lease was acquired by another owner or cycle in the meantime.
+	Renew(ctx context.Context, lease CycleLease, duration time.Duration) (CycleLease, error)
+	// Release expires the lease, when it's still held with the fencing token.
+	Release(ctx context.Context, lease CycleLease) error
+}
+
+// cycleFence holds the lease of the running cycle and renews it periodically.
+// The zero value of *cycleFence is a no-op, which is used when fencing is disabled.
+type cycleFence struct {
+	log      *zap.Logger
+	leases   CycleLeases
+	duration time.Duration
+
+	mu    sync.Mutex
+	lease CycleLease
+	err   error
+}
+
+// acquireFence acquires the lease of the cycle. It returns nil, when fencing is
+// not configured.
+func (service *Service) acquireFence(ctx context.Context) (_ *cycleFence, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if service.leases == nil || service.config.CycleLeaseDuration <= 0 {
+		return nil, nil
+	}
+
+	if service.owner.IsZero() {
+		service.owner, err = uuid.New()
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+
+	lease, err := service.leases.Acquire(ctx, service.leaseName, service.owner, service.config.CycleLeaseDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	service.log.Debug("cycle lease acquired",
+		zap.String("name", lease.Name),
+		zap.Int64("token", lease.Token),
+		zap.Time("expires at", lease.ExpiresAt))
+
+	return &cycleFence{
+		log:      service.log,
+		leases:   service.leases,
+		duration: service.config.CycleLeaseDuration,
+		lease:    lease,
+	}, nil
+}
+
+// run renews the lease until ctx is canceled. When the lease is lost, it calls
+// cancel to stop processing the segments.
+func (fence *cycleFence) run(ctx context.Context, cancel func()) {
+	if fence == nil {
+		return
+	}
+
+	ticker := time.NewTicker(fence.duration / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := fence.renew(ctx); err != nil {
+			if ErrCycleFenced.Has(err) {
+				cancel()
+				return
+			}
+			// a transient failure, the lease is still valid until it expires.
+			fence.log.Warn("failed to renew cycle lease", zap.Error(err))
+		}
+	}
+}
+
+// renew extends the lease. It returns an ErrCycleFenced error, when the lease
+// has been lost.
+func (fence *cycleFence) renew(ctx context.Context) (err error) {
+	if fence == nil {
+		return nil
+	}
+
+	fence.mu.Lock()
+	defer fence.mu.Unlock()
+
+	if fence.err != nil {
+		return fence.err
+	}
+
+	lease, err := fence.leases.Renew(ctx, fence.lease, fence.duration)
+	if err != nil {
+		if ErrCycleFenced.Has(err) {
+			fence.err = err
+		}
+		return err
+	}
+	fence.lease = lease
+	return nil
+}
+
+// lostErr returns the ErrCycleFenced error, when the lease has been lost.
+func (fence *cycleFence) lostErr() error {
+	if fence == nil {
+		return nil
+	}
+
+	fence.mu.Lock()
+	defer fence.mu.Unlock()
+	return fence.err
+}
+
+// release releases the lease, unless it has been lost.
+func (fence *cycleFence) release(ctx context.Context) {
+	if fence == nil || fence.lostErr() != nil {
+		return
+	}
+
+	fence.mu.Lock()
+	defer fence.mu.Unlock()
+
+	if err := fence.leases.Release(ctx, fence.lease); err != nil {
+		fence.log.Warn("failed to release cycle lease", zap.Error(err))
+	}
+}
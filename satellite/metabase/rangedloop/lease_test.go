@@ -0,0 +1,107 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rangedloop_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/metabase/rangedloop/rangedlooptest"
+	"storj.io/storj/satellite/metabase/segmentloop"
+)
+
+func TestLoopCycleLeases(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	leases := &rangedlooptest.Leases{}
+	config := rangedloop.Config{
+		BatchSize:          2,
+		Parallelism:        2,
+		CycleLeaseDuration: time.Minute,
+	}
+
+	newService := func(observer rangedloop.Observer) *rangedloop.Service {
+		service := rangedloop.NewService(zaptest.NewLogger(t), config,
+			&rangedlooptest.RangeSplitter{
+				Segments: make([]segmentloop.Segment, 10),
+			},
+			[]rangedloop.Observer{observer},
+		)
+		service.SetCycleLeases(leases, "test")
+		return service
+	}
+
+	t.Run("released after cycle", func(t *testing.T) {
+		first, second := &rangedlooptest.CountObserver{}, &rangedlooptest.CountObserver{}
+
+		_, err := newService(first).RunOnce(ctx)
+		require.NoError(t, err)
+		_, err = newService(second).RunOnce(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, 10, first.NumSegments)
+		require.Equal(t, 10, second.NumSegments)
+	})
+
+	t.Run("held by another instance", func(t *testing.T) {
+		leases.Steal("test", time.Minute)
+		defer leases.Steal("test", 0)
+
+		started := false
+		service := newService(&rangedlooptest.CallbackObserver{
+			OnStart: func(ctx context.Context, t time.Time) error {
+				started = true
+				return nil
+			},
+		})
+
+		_, err := service.RunOnce(ctx)
+		require.True(t, rangedloop.ErrCycleFenced.Has(err))
+		require.False(t, started)
+	})
+
+	t.Run("lost during cycle", func(t *testing.T) {
+		finished := false
+		service := newService(&rangedlooptest.CallbackObserver{
+			OnProcess: func(ctx context.Context, segments []segmentloop.Segment) error {
+				leases.Steal("test", time.Minute)
+				return nil
+			},
+			OnFinish: func(ctx context.Context) error {
+				finished = true
+				return nil
+			},
+		})
+		defer leases.Steal("test", 0)
+
+		_, err := service.RunOnce(ctx)
+		require.True(t, rangedloop.ErrCycleFenced.Has(err))
+		require.False(t, finished)
+	})
+
+	t.Run("disabled without duration", func(t *testing.T) {
+		leases.Steal("test", time.Minute)
+		defer leases.Steal("test", 0)
+
+		observer := &rangedlooptest.CountObserver{}
+		service := rangedloop.NewService(zaptest.NewLogger(t),
+			rangedloop.Config{BatchSize: 2, Parallelism: 2},
+			&rangedlooptest.RangeSplitter{
+				Segments: make([]segmentloop.Segment, 10),
+			},
+			[]rangedloop.Observer{observer},
+		)
+		service.SetCycleLeases(leases, "test")
+
+		_, err := service.RunOnce(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 10, observer.NumSegments)
+	})
+}
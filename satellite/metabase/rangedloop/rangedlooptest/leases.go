@@ -0,0 +1,96 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rangedlooptest
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase/rangedloop"
+)
+
+var _ rangedloop.CycleLeases = (*Leases)(nil)
+
+// Leases is an in-memory implementation of the cycle leases for testing.
+type Leases struct {
+	mu     sync.Mutex
+	leases map[string]rangedloop.CycleLease
+}
+
+// Acquire acquires the lease of the loop for the duration.
+func (leases *Leases) Acquire(ctx context.Context, name string, owner uuid.UUID, duration time.Duration) (rangedloop.CycleLease, error) {
+	leases.mu.Lock()
+	defer leases.mu.Unlock()
+
+	if leases.leases == nil {
+		leases.leases = make(map[string]rangedloop.CycleLease)
+	}
+
+	now := time.Now()
+	lease := leases.leases[name]
+	if lease.Owner != owner && lease.ExpiresAt.After(now) {
+		return rangedloop.CycleLease{}, rangedloop.ErrCycleFenced.New("lease %q is held by %s", name, lease.Owner)
+	}
+
+	lease = rangedloop.CycleLease{
+		Name:      name,
+		Owner:     owner,
+		Token:     lease.Token + 1,
+		ExpiresAt: now.Add(duration),
+	}
+	leases.leases[name] = lease
+	return lease, nil
+}
+
+// Renew extends the lease by the duration.
+func (leases *Leases) Renew(ctx context.Context, lease rangedloop.CycleLease, duration time.Duration) (rangedloop.CycleLease, error) {
+	leases.mu.Lock()
+	defer leases.mu.Unlock()
+
+	current, ok := leases.leases[lease.Name]
+	if !ok || current.Owner != lease.Owner || current.Token != lease.Token {
+		return rangedloop.CycleLease{}, rangedloop.ErrCycleFenced.New("lease %q with token %d was lost", lease.Name, lease.Token)
+	}
+
+	current.ExpiresAt = time.Now().Add(duration)
+	leases.leases[lease.Name] = current
+	return current, nil
+}
+
+// Release expires the lease.
+func (leases *Leases) Release(ctx context.Context, lease rangedloop.CycleLease) error {
+	leases.mu.Lock()
+	defer leases.mu.Unlock()
+
+	current, ok := leases.leases[lease.Name]
+	if !ok || current.Owner != lease.Owner || current.Token != lease.Token {
+		return nil
+	}
+
+	current.ExpiresAt = time.Now()
+	leases.leases[lease.Name] = current
+	return nil
+}
+
+// Steal acquires the lease for a new random owner, regardless whether it's
+// held, to simulate another instance taking over the loop.
+func (leases *Leases) Steal(name string, duration time.Duration) rangedloop.CycleLease {
+	leases.mu.Lock()
+	defer leases.mu.Unlock()
+
+	if leases.leases == nil {
+		leases.leases = make(map[string]rangedloop.CycleLease)
+	}
+
+	lease := rangedloop.CycleLease{
+		Name:      name,
+		Owner:     uuid.UUID{1},
+		Token:     leases.leases[name].Token + 1,
+		ExpiresAt: time.Now().Add(duration),
+	}
+	leases.leases[name] = lease
+	return lease
+}
@@ -6,6 +6,7 @@ package rangedloop
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/jtolio/eventkit"
@@ -15,6 +16,7 @@ import (
 
 	"storj.io/common/errs2"
 	"storj.io/common/sync2"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase/segmentloop"
 )
 
@@ -34,6 +36,8 @@ type Config struct {
 	Interval           time.Duration `help:"how often to run the loop" releaseDefault:"2h" devDefault:"10s" testDefault:"10s"`
 
 	SuspiciousProcessedRatio float64 `help:"ratio where to consider processed count as supicious" default:"0.03"`
+
+	CycleLeaseDuration time.Duration `help:"how long the lease of a loop cycle is valid without renewal, the lease prevents running the observers of the same loop concurrently; 0 disables it" default:"10m" testDefault:"0"`
 }
 
 // Service iterates through all segments and calls the attached observers for every segment
@@ -45,6 +49,10 @@ type Service struct {
 	provider  RangeSplitter
 	observers []Observer
 
+	leases    CycleLeases
+	leaseName string
+	owner     uuid.UUID
+
 	Loop *sync2.Cycle
 }
 
@@ -59,6 +67,14 @@ func NewService(log *zap.Logger, config Config, provider RangeSplitter, observer
 	}
 }
 
+// SetCycleLeases enables fencing the cycles of the loop with the leases of the
+// given name. A cycle is only run, when the lease is acquired, and the observers
+// are not finished, when the lease is lost during the cycle.
+func (service *Service) SetCycleLeases(leases CycleLeases, name string) {
+	service.leases = leases
+	service.leaseName = name
+}
+
 // observerState contains information to manage an observer during a loop iteration.
 type observerState struct {
 	observer       Observer
@@ -102,6 +118,11 @@ func (service *Service) Run(ctx context.Context) (err error) {
 			zap.Int("batchSize", service.config.BatchSize),
 		)
 		_, err := service.RunOnce(ctx)
+		if ErrCycleFenced.Has(err) {
+			service.log.Warn("ranged loop cycle skipped, the loop is run by another instance", zap.Error(err))
+			mon.Event("rangedloop_cycle_fenced")
+			return nil
+		}
 		if err != nil {
 			service.log.Error("ranged loop failure", zap.Error(err))
 
@@ -125,6 +146,23 @@ func (service *Service) Run(ctx context.Context) (err error) {
 func (service *Service) RunOnce(ctx context.Context) (observerDurations []ObserverDuration, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	fence, err := service.acquireFence(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer fence.release(ctx)
+
+	// processing is stopped, when the lease is lost.
+	var renewal sync.WaitGroup
+	defer renewal.Wait()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	renewal.Add(1)
+	go func() {
+		defer renewal.Done()
+		fence.run(ctx, cancel)
+	}()
+
 	observerStates, err := startObservers(ctx, service.log, service.observers)
 	if err != nil {
 		return nil, err
@@ -160,11 +198,18 @@ func (service *Service) RunOnce(ctx context.Context) (observerDurations []Observ
 
 	// Improvement: stop all ranges when one has an error.
 	errList := group.Wait()
+	if err := fence.lostErr(); err != nil {
+		return nil, err
+	}
 	if errList != nil {
 		return nil, errs.Combine(errList...)
 	}
 
-	return finishObservers(ctx, service.log, observerStates), nil
+	observerDurations = finishObservers(ctx, service.log, fence, observerStates)
+	if err := fence.lostErr(); err != nil {
+		return observerDurations, err
+	}
+	return observerDurations, nil
 }
 
 func createGoroutineClosure(ctx context.Context, rangeProvider SegmentProvider, states []*rangeObserverState) func() error {
@@ -210,9 +255,9 @@ func startObserver(ctx context.Context, log *zap.Logger, startTime time.Time, ob
 	}
 }
 
-func finishObservers(ctx context.Context, log *zap.Logger, observerStates []observerState) (observerDurations []ObserverDuration) {
+func finishObservers(ctx context.Context, log *zap.Logger, fence *cycleFence, observerStates []observerState) (observerDurations []ObserverDuration) {
 	for _, state := range observerStates {
-		observerDurations = append(observerDurations, finishObserver(ctx, log, state))
+		observerDurations = append(observerDurations, finishObserver(ctx, log, fence, state))
 	}
 
 	sendObserverDurations(observerDurations)
@@ -222,7 +267,7 @@ func finishObservers(ctx context.Context, log *zap.Logger, observerStates []obse
 
 // Iterating over the segments is done.
 // This is the reduce step.
-func finishObserver(ctx context.Context, log *zap.Logger, state observerState) ObserverDuration {
+func finishObserver(ctx context.Context, log *zap.Logger, fence *cycleFence, state observerState) ObserverDuration {
 	if state.err != nil {
 		return ObserverDuration{
 			Observer: state.observer,
@@ -260,6 +305,20 @@ func finishObserver(ctx context.Context, log *zap.Logger, state observerState) O
 		duration += rangeObserver.duration
 	}
 
+	// the observer may write its results, ensure that no other instance
+	// started a cycle in the meantime.
+	if err := fence.renew(ctx); ErrCycleFenced.Has(err) {
+		log.Error(
+			"Lost the cycle lease, the observer will not be finalized in this run of the ranged segment loop",
+			zap.String("observer", fmt.Sprintf("%T", state.observer)),
+			zap.Error(err),
+		)
+		return ObserverDuration{
+			Observer: state.observer,
+			Duration: -1 * time.Second,
+		}
+	}
+
 	err := state.observer.Finish(ctx)
 	if err != nil {
 		log.Error(
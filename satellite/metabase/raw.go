@@ -110,6 +110,7 @@ func (db *DB) TestingDeleteAll(ctx context.Context) (err error) {
 		WITH testing AS (SELECT 1) DELETE FROM objects;
 		WITH testing AS (SELECT 1) DELETE FROM segments;
 		WITH testing AS (SELECT 1) DELETE FROM segment_copies;
+		WITH testing AS (SELECT 1) DELETE FROM piece_deletion_queue;
 		WITH testing AS (SELECT 1) DELETE FROM node_aliases;
 		WITH testing AS (SELECT 1) SELECT setval('node_alias_seq', 1, false);
 		
@@ -142,7 +142,7 @@ func (endpoint *Endpoint) tryUpdateBucketAttribution(ctx context.Context, header
 	bucket, err := endpoint.buckets.GetBucket(ctx, bucketName, projectID)
 	if err != nil {
 		if buckets.ErrBucketNotFound.Has(err) {
-			return rpcstatus.Errorf(rpcstatus.NotFound, "bucket %q does not exist", bucketName)
+			return codeErrorf(ErrorCodeBucketNotFound, "bucket %q does not exist", bucketName)
 		}
 		endpoint.log.Error("error while getting bucket", zap.ByteString("bucketName", bucketName), zap.Error(err))
 		return rpcstatus.Error(rpcstatus.Internal, "unable to set bucket attribution")
@@ -112,6 +112,14 @@ type RateLimiterConfig struct {
 	CacheExpiration time.Duration `help:"how long to cache the projects limiter." releaseDefault:"10m" devDefault:"10s"`
 }
 
+// ValidateRateLimit checks whether the rate can be used as the rate limit of the projects.
+func ValidateRateLimit(rate float64) error {
+	if rate <= 0 {
+		return Error.New("rate limit must be positive, got %v", rate)
+	}
+	return nil
+}
+
 // UploadLimiterConfig is a configuration struct for endpoint upload limiting.
 type UploadLimiterConfig struct {
 	Enabled           bool          `help:"whether rate limiting is enabled." releaseDefault:"true" devDefault:"true"`
@@ -120,6 +128,46 @@ type UploadLimiterConfig struct {
 	CacheCapacity int `help:"number of object locations to cache." releaseDefault:"10000" devDefault:"10" testDefault:"100"`
 }
 
+// ListCacheConfig is a configuration struct for the cache of the object listings.
+type ListCacheConfig struct {
+	Enabled         bool          `help:"whether the listings of the frequently listed prefixes are cached." default:"false"`
+	MinRequests     int           `help:"number of the same listing requests within the expiration, after which the listing is cached." default:"2"`
+	CacheCapacity   int           `help:"number of listings to cache." default:"10000" testDefault:"100"`
+	CacheExpiration time.Duration `help:"how long to cache the listings, i.e. how stale the listings may be after the changes made through other API instances." default:"5s"`
+}
+
+// MetadataLimitsConfig is a configuration struct for the limits of the encrypted object metadata
+// per project tier. The tier of a project is the tier of its owner. The custom metadata entries
+// can be counted only for the objects uploaded without encryption, the metadata of the encrypted
+// objects is limited only by its size.
+type MetadataLimitsConfig struct {
+	Enabled         bool        `help:"whether the encrypted object metadata size is limited per project tier." default:"false"`
+	FreeTier        memory.Size `help:"maximum encrypted object metadata size of the projects of the free tier users." default:"1KiB"`
+	PaidTier        memory.Size `help:"maximum encrypted object metadata size of the projects of the paid tier users." default:"2KiB"`
+	FreeTierEntries int         `help:"maximum number of custom metadata entries of the unencrypted objects of the projects of the free tier users, 0 means unlimited." default:"100"`
+	PaidTierEntries int         `help:"maximum number of custom metadata entries of the unencrypted objects of the projects of the paid tier users, 0 means unlimited." default:"1000"`
+}
+
+// CopyMoveLimitsConfig is a configuration struct for the daily limits of the server-side copies
+// and moves per project tier. The copies and moves don't transfer any data, so they aren't
+// limited by the bandwidth limits.
+type CopyMoveLimitsConfig struct {
+	Enabled       bool        `help:"whether the server-side copies and moves are limited per project and day." default:"false"`
+	FreeTierCount int64       `help:"maximum number of copies and moves per day of the projects of the free tier users." default:"1000"`
+	FreeTierSize  memory.Size `help:"maximum total size of the objects copied or moved per day by the projects of the free tier users." default:"100GB"`
+	PaidTierCount int64       `help:"maximum number of copies and moves per day of the projects of the paid tier users." default:"100000"`
+	PaidTierSize  memory.Size `help:"maximum total size of the objects copied or moved per day by the projects of the paid tier users." default:"100TB"`
+}
+
+// UsageCacheConfig is a configuration struct for the cache of the project usage and limits.
+type UsageCacheConfig struct {
+	Enabled           bool          `help:"whether the project limits are enforced from the locally cached usage, which is reconciled with the live accounting asynchronously." default:"false"`
+	GraceBuffer       float64       `help:"fraction of the limits, by which the projects may exceed them, because the cached usage doesn't include the usage from the other API instances until reconciled." default:"0.05"`
+	ReconcileInterval time.Duration `help:"how often the cached usage is flushed to and refreshed from the live accounting." default:"30s" testDefault:"1s"`
+	CacheCapacity     int           `help:"number of projects to cache. the usage of the other projects is checked against the live accounting directly." default:"10000" testDefault:"100"`
+	CacheExpiration   time.Duration `help:"how long the projects stay in the cache after they were last used." default:"10m"`
+}
+
 // ProjectLimitConfig is a configuration struct for default project limits.
 type ProjectLimitConfig struct {
 	MaxBuckets int `help:"max bucket count for a project." default:"100" testDefault:"10"`
@@ -145,11 +193,15 @@ type Config struct {
 	UploadLimiter               UploadLimiterConfig  `help:"object upload limiter configuration"`
 	ProjectLimits               ProjectLimitConfig   `help:"project limit configuration"`
 	PieceDeletion               piecedeletion.Config `help:"piece deletion configuration"`
+	ListCache                   ListCacheConfig      `help:"object listing cache configuration"`
+	UsageCache                  UsageCacheConfig     `help:"project usage cache configuration"`
+	MetadataLimits              MetadataLimitsConfig `help:"encrypted object metadata limits per project tier"`
+	CopyMoveLimits              CopyMoveLimitsConfig `help:"daily limits of the server-side copies and moves per project tier"`
 	// TODO remove this flag when server-side copy implementation will be finished
 	ServerSideCopy         bool `help:"enable code for server-side copy, deprecated. please leave this to true." default:"true"`
 	ServerSideCopyDisabled bool `help:"disable already enabled server-side copy. this is because once server side copy is enabled, delete code should stay changed, even if you want to disable server side copy" default:"false"`
 	// TODO remove when we benchmarking are done and decision is made.
-	TestListingQuery bool `default:"false" help:"test the new query for non-recursive listing"`
+	TestListingQuery bool `default:"false" help:"test the new query for non-recursive listing. it can be rolled out per project with the listing-query feature flag instead"`
 }
 
 // Metabase constructs Metabase configuration based on Metainfo configuration with specific application name.
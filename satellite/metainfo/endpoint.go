@@ -6,14 +6,15 @@ package metainfo
 import (
 	"context"
 	"fmt"
+	"math"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/jtolio/eventkit"
 	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
-	"golang.org/x/time/rate"
 
 	"storj.io/common/encryption"
 	"storj.io/common/lrucache"
@@ -22,10 +23,14 @@ import (
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/signing"
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/ratelimit"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/eventing"
+	"storj.io/storj/satellite/featureflags"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metainfo/piecedeletion"
@@ -33,6 +38,7 @@ import (
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/revocation"
+	"storj.io/storj/satellite/tracing"
 )
 
 const (
@@ -64,26 +70,44 @@ type APIKeys interface {
 type Endpoint struct {
 	pb.DRPCMetainfoUnimplementedServer
 
-	log                    *zap.Logger
-	buckets                *buckets.Service
-	metabase               *metabase.DB
-	deletePieces           *piecedeletion.Service
-	orders                 *orders.Service
-	overlay                *overlay.Service
-	attributions           attribution.DB
-	pointerVerification    *pointerverification.Service
-	projectUsage           *accounting.Service
-	projectLimits          *accounting.ProjectLimitCache
-	projects               console.Projects
-	apiKeys                APIKeys
-	satellite              signing.Signer
-	limiterCache           *lrucache.ExpiringLRUOf[*rate.Limiter]
+	log                 *zap.Logger
+	buckets             *buckets.Service
+	metabase            *metabase.DB
+	deletePieces        *piecedeletion.Service
+	orders              *orders.Service
+	overlay             *overlay.Service
+	attributions        attribution.DB
+	pointerVerification *pointerverification.Service
+	projectUsage        *accounting.Service
+	projectLimits       *accounting.ProjectLimitCache
+	projects            console.Projects
+	apiKeys             APIKeys
+	satellite           signing.Signer
+	rateLimiter         ratelimit.Limiter
+	rateLimitsCache     *lrucache.ExpiringLRUOf[ratelimit.Limit]
+	// defaultRate contains math.Float64bits of the rate limit of the projects,
+	// which don't have their own limit. It can be changed by SetDefaultRateLimit.
+	defaultRate            uint64
 	singleObjectLimitCache *lrucache.ExpiringLRUOf[struct{}]
 	encInlineSegmentSize   int64 // max inline segment size + encryption overhead
 	revocations            revocation.DB
 	defaultRS              *pb.RedundancyScheme
 	config                 Config
 	versionCollector       *versionCollector
+	events                 *eventing.Service
+	tracing                *tracing.Service
+	listCache              *listCache
+	usageCache             *usageCache
+	// userAgentRollups aggregates the traffic per user agent, when it's enabled.
+	userAgentRollups *attribution.UserAgentRollups
+	// gatewayCredentialUsage records the use of the access grants of the gateway credentials.
+	gatewayCredentialUsage *console.GatewayCredentialUsage
+	// featureFlags rolls out the behaviors of the endpoint per project, when it's set.
+	featureFlags *featureflags.Service
+	// apiUsageRollups counts the requests per project and operation, when it's enabled.
+	apiUsageRollups *accounting.APIUsageRollups
+	// notifier notifies the project owners about the exceeded limits, when it's enabled.
+	notifier *console.Notifier
 }
 
 // NewEndpoint creates new metainfo endpoint instance.
@@ -91,7 +115,7 @@ func NewEndpoint(log *zap.Logger, buckets *buckets.Service, metabaseDB *metabase
 	deletePieces *piecedeletion.Service, orders *orders.Service, cache *overlay.Service,
 	attributions attribution.DB, peerIdentities overlay.PeerIdentities,
 	apiKeys APIKeys, projectUsage *accounting.Service, projectLimits *accounting.ProjectLimitCache, projects console.Projects,
-	satellite signing.Signer, revocations revocation.DB, config Config) (*Endpoint, error) {
+	satellite signing.Signer, revocations revocation.DB, rateLimiter ratelimit.Limiter, events *eventing.Service, tracing *tracing.Service, config Config) (*Endpoint, error) {
 	// TODO do something with too many params
 
 	encInlineSegmentSize, err := encryption.CalcEncryptedSize(config.MaxInlineSegmentSize.Int64(), storj.EncryptionParameters{
@@ -111,6 +135,17 @@ func NewEndpoint(log *zap.Logger, buckets *buckets.Service, metabaseDB *metabase
 		ErasureShareSize: config.RS.ErasureShareSize.Int32(),
 	}
 
+	var listings *listCache
+	if config.ListCache.Enabled {
+		listings = newListCache(config.ListCache)
+		metabaseDB.OnObjectsChanged(listings.Invalidate)
+	}
+
+	var usage *usageCache
+	if config.UsageCache.Enabled {
+		usage = newUsageCache(log.Named("usage-cache"), config.UsageCache, projectUsage)
+	}
+
 	return &Endpoint{
 		log:                 log,
 		buckets:             buckets,
@@ -125,7 +160,8 @@ func NewEndpoint(log *zap.Logger, buckets *buckets.Service, metabaseDB *metabase
 		projectLimits:       projectLimits,
 		projects:            projects,
 		satellite:           satellite,
-		limiterCache: lrucache.NewOf[*rate.Limiter](lrucache.Options{
+		rateLimiter:         rateLimiter,
+		rateLimitsCache: lrucache.NewOf[ratelimit.Limit](lrucache.Options{
 			Capacity:   config.RateLimiter.CacheCapacity,
 			Expiration: config.RateLimiter.CacheExpiration,
 			Name:       "metainfo-ratelimit",
@@ -139,11 +175,70 @@ func NewEndpoint(log *zap.Logger, buckets *buckets.Service, metabaseDB *metabase
 		defaultRS:            defaultRSScheme,
 		config:               config,
 		versionCollector:     newVersionCollector(log),
+		events:               events,
+		tracing:              tracing,
+		listCache:            listings,
+		usageCache:           usage,
+		defaultRate:          math.Float64bits(config.RateLimiter.Rate),
 	}, nil
 }
 
+// DefaultRateLimit returns the rate limit of the projects, which don't have their own limit.
+func (endpoint *Endpoint) DefaultRateLimit() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&endpoint.defaultRate))
+}
+
+// SetDefaultRateLimit changes the rate limit of the projects, which don't have their
+// own limit. The projects use the new limit after their cached limit expires.
+func (endpoint *Endpoint) SetDefaultRateLimit(rate float64) {
+	atomic.StoreUint64(&endpoint.defaultRate, math.Float64bits(rate))
+}
+
+// SetGatewayCredentialUsage sets the recorder of the use of the gateway credentials.
+func (endpoint *Endpoint) SetGatewayCredentialUsage(usage *console.GatewayCredentialUsage) {
+	endpoint.gatewayCredentialUsage = usage
+}
+
+// SetUserAgentRollups sets the aggregator of the traffic per user agent.
+func (endpoint *Endpoint) SetUserAgentRollups(rollups *attribution.UserAgentRollups) {
+	endpoint.userAgentRollups = rollups
+}
+
+// SetFeatureFlags sets the feature flags, which roll out the behaviors of the endpoint per project.
+func (endpoint *Endpoint) SetFeatureFlags(flags *featureflags.Service) {
+	endpoint.featureFlags = flags
+}
+
+// SetAPIUsageRollups sets the counter of the requests per project and operation.
+func (endpoint *Endpoint) SetAPIUsageRollups(rollups *accounting.APIUsageRollups) {
+	endpoint.apiUsageRollups = rollups
+}
+
+// SetNotifier sets the notifier of the project owners about the exceeded limits.
+func (endpoint *Endpoint) SetNotifier(notifier *console.Notifier) {
+	endpoint.notifier = notifier
+}
+
+// featureEnabled returns whether the behavior of the feature flag is enabled for the project.
+func (endpoint *Endpoint) featureEnabled(ctx context.Context, name string, projectID uuid.UUID) bool {
+	return endpoint.featureFlags != nil && endpoint.featureFlags.Enabled(ctx, name, projectID)
+}
+
+// Run starts the reconciliation of the project usage cache, when it's enabled.
+func (endpoint *Endpoint) Run(ctx context.Context) error {
+	if endpoint.usageCache == nil {
+		return nil
+	}
+	return endpoint.usageCache.Run(ctx)
+}
+
 // Close closes resources.
-func (endpoint *Endpoint) Close() error { return nil }
+func (endpoint *Endpoint) Close() error {
+	if endpoint.usageCache == nil {
+		return nil
+	}
+	return endpoint.usageCache.Close()
+}
 
 // ProjectInfo returns allowed ProjectInfo for the provided API key.
 func (endpoint *Endpoint) ProjectInfo(ctx context.Context, req *pb.ProjectInfoRequest) (_ *pb.ProjectInfoResponse, err error) {
@@ -307,7 +402,7 @@ func (endpoint *Endpoint) convertMetabaseErr(err error) error {
 		message := strings.TrimPrefix(err.Error(), string(metabase.ErrObjectNotFound))
 		message = strings.TrimPrefix(message, ": ")
 		// uplink expects a message that starts with the specified prefix
-		return rpcstatus.Error(rpcstatus.NotFound, "object not found: "+message)
+		return codeError(ErrorCodeObjectNotFound, "object not found: "+message)
 	case metabase.ErrSegmentNotFound.Has(err):
 		message := strings.TrimPrefix(err.Error(), string(metabase.ErrSegmentNotFound))
 		message = strings.TrimPrefix(message, ": ")
@@ -321,16 +416,39 @@ func (endpoint *Endpoint) convertMetabaseErr(err error) error {
 		return rpcstatus.Error(rpcstatus.NotFound, err.Error())
 	case metabase.ErrPermissionDenied.Has(err):
 		return rpcstatus.Error(rpcstatus.PermissionDenied, err.Error())
+	case metabase.ErrMetadataTooLarge.Has(err):
+		return rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	default:
 		endpoint.log.Error("internal", zap.Error(err))
 		return rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 }
 
+// apiUsageOperations maps the requests, which are counted in the API usage rollups, to their operations.
+var apiUsageOperations = map[string]string{
+	fmt.Sprintf("%T", (*pb.BucketListRequest)(nil)):               accounting.APIOperationList,
+	fmt.Sprintf("%T", (*pb.ObjectListRequest)(nil)):               accounting.APIOperationList,
+	fmt.Sprintf("%T", (*pb.ObjectListPendingStreamsRequest)(nil)): accounting.APIOperationList,
+	fmt.Sprintf("%T", (*pb.SegmentListRequest)(nil)):              accounting.APIOperationList,
+	fmt.Sprintf("%T", (*pb.ObjectDownloadRequest)(nil)):           accounting.APIOperationDownload,
+	fmt.Sprintf("%T", (*pb.SegmentDownloadRequest)(nil)):          accounting.APIOperationDownload,
+	fmt.Sprintf("%T", (*pb.ObjectBeginRequest)(nil)):              accounting.APIOperationUpload,
+	fmt.Sprintf("%T", (*pb.SegmentBeginRequest)(nil)):             accounting.APIOperationUpload,
+	fmt.Sprintf("%T", (*pb.SegmentMakeInlineRequest)(nil)):        accounting.APIOperationUpload,
+	fmt.Sprintf("%T", (*pb.ObjectBeginDeleteRequest)(nil)):        accounting.APIOperationDelete,
+	fmt.Sprintf("%T", (*pb.BucketDeleteRequest)(nil)):             accounting.APIOperationDelete,
+}
+
 func (endpoint *Endpoint) usageTracking(keyInfo *console.APIKeyInfo, header *pb.RequestHeader, name string, tags ...eventkit.Tag) {
 	evs.Event("usage", append([]eventkit.Tag{
 		eventkit.Bytes("project-public-id", keyInfo.ProjectPublicID[:]),
 		eventkit.String("user-agent", string(header.UserAgent)),
 		eventkit.String("request", name),
 	}, tags...)...)
+
+	if endpoint.apiUsageRollups != nil {
+		if operation, ok := apiUsageOperations[name]; ok {
+			endpoint.apiUsageRollups.Add(keyInfo.ProjectID, operation)
+		}
+	}
 }
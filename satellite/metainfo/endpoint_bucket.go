@@ -16,6 +16,7 @@ import (
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/metabase"
 )
 
@@ -38,7 +39,7 @@ func (endpoint *Endpoint) GetBucket(ctx context.Context, req *pb.BucketGetReques
 	bucket, err := endpoint.buckets.GetMinimalBucket(ctx, req.GetName(), keyInfo.ProjectID)
 	if err != nil {
 		if buckets.ErrBucketNotFound.Has(err) {
-			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+			return nil, codeError(ErrorCodeBucketNotFound, err.Error())
 		}
 		endpoint.log.Error("internal", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
@@ -103,7 +104,7 @@ func (endpoint *Endpoint) CreateBucket(ctx context.Context, req *pb.BucketCreate
 		return nil, err
 	}
 	if bucketCount >= *maxBuckets {
-		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, fmt.Sprintf("number of allocated buckets (%d) exceeded", endpoint.config.ProjectLimits.MaxBuckets))
+		return nil, codeErrorf(ErrorCodeBucketLimitExceeded, "number of allocated buckets (%d) exceeded", endpoint.config.ProjectLimits.MaxBuckets)
 	}
 
 	bucketReq, err := convertProtoToBucket(req, keyInfo.ProjectID)
@@ -111,6 +112,18 @@ func (endpoint *Endpoint) CreateBucket(ctx context.Context, req *pb.BucketCreate
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
+	// the protocol doesn't allow selecting the placement, hence use the default
+	// one the project is entitled to.
+	entitlements, err := endpoint.projects.GetPlacementEntitlements(ctx, keyInfo.ProjectID)
+	if err != nil {
+		endpoint.log.Error("unable to get placement entitlements", zap.Stringer("projectID", keyInfo.ProjectID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to create bucket")
+	}
+	bucketReq.Placement, err = console.SelectBucketPlacement(entitlements, nil)
+	if err != nil {
+		return nil, codeError(ErrorCodePlacementNotEntitled, err.Error())
+	}
+
 	bucket, err := endpoint.buckets.CreateBucket(ctx, bucketReq)
 	if err != nil {
 		endpoint.log.Error("error while creating bucket", zap.String("bucketName", bucketReq.Name), zap.Error(err))
@@ -193,7 +206,7 @@ func (endpoint *Endpoint) DeleteBucket(ctx context.Context, req *pb.BucketDelete
 		bucket, err = endpoint.buckets.GetMinimalBucket(ctx, req.Name, keyInfo.ProjectID)
 		if err != nil {
 			if buckets.ErrBucketNotFound.Has(err) {
-				return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+				return nil, codeError(ErrorCodeBucketNotFound, err.Error())
 			}
 			return nil, err
 		}
@@ -22,6 +22,8 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/eventing"
+	"storj.io/storj/satellite/featureflags"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metainfo/piecedeletion"
@@ -90,7 +92,7 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 	placement, err := endpoint.buckets.GetBucketPlacement(ctx, req.Bucket, keyInfo.ProjectID)
 	if err != nil {
 		if buckets.ErrBucketNotFound.Has(err) {
-			return nil, rpcstatus.Errorf(rpcstatus.NotFound, "bucket not found: %s", req.Bucket)
+			return nil, codeErrorf(ErrorCodeBucketNotFound, "bucket not found: %s", req.Bucket)
 		}
 		endpoint.log.Error("unable to check bucket", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
@@ -125,6 +127,14 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		nonce = req.EncryptedMetadataNonce[:]
 	}
 
+	maxEncryptedMetadataSize, maxMetadataEntries, err := endpoint.metadataLimits(ctx, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkMetadataEntries(req.EncryptedMetadata, maxMetadataEntries); err != nil {
+		return nil, err
+	}
+
 	object, err := endpoint.metabase.BeginObjectNextVersion(ctx, metabase.BeginObjectNextVersion{
 		ObjectStream: metabase.ObjectStream{
 			ProjectID:  keyInfo.ProjectID,
@@ -139,6 +149,7 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		EncryptedMetadata:             req.EncryptedMetadata,
 		EncryptedMetadataEncryptedKey: req.EncryptedMetadataEncryptedKey,
 		EncryptedMetadataNonce:        nonce,
+		MaxEncryptedMetadataSize:      maxEncryptedMetadataSize,
 	})
 	if err != nil {
 		return nil, endpoint.convertMetabaseErr(err)
@@ -277,11 +288,21 @@ func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommit
 		return nil, err
 	}
 
+	var maxMetadataEntries int
+	request.MaxEncryptedMetadataSize, maxMetadataEntries, err = endpoint.metadataLimits(ctx, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkMetadataEntries(request.EncryptedMetadata, maxMetadataEntries); err != nil {
+		return nil, err
+	}
+
 	object, err := endpoint.metabase.CommitObject(ctx, request)
 	if err != nil {
 		return nil, endpoint.convertMetabaseErr(err)
 	}
 	committedObject = &object
+	endpoint.publishObjectEvent(ctx, eventing.ObjectCreatedPut, object)
 
 	mon.Meter("req_commit_object").Mark(1)
 
@@ -418,7 +439,7 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
-	if exceeded, limit, err := endpoint.projectUsage.ExceedsBandwidthUsage(ctx, keyInfo.ProjectID); err != nil {
+	if exceeded, limit, err := endpoint.exceedsBandwidthUsage(ctx, keyInfo.ProjectID); err != nil {
 		if errs2.IsCanceled(err) {
 			return nil, rpcstatus.Wrap(rpcstatus.Canceled, err)
 		}
@@ -433,7 +454,8 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 			zap.Stringer("Limit", limit),
 			zap.Stringer("Project ID", keyInfo.ProjectID),
 		)
-		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Usage Limit")
+		endpoint.notifier.NotifyLimitExceeded(ctx, keyInfo.ProjectID, "bandwidth")
+		return nil, retryAfterError(ErrorCodeQuotaExceeded, "Exceeded Usage Limit", bandwidthRetryAfter(time.Now()))
 	}
 
 	// get the object information
@@ -494,7 +516,7 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 		downloadSizes := endpoint.calculateDownloadSizes(streamRange, segment, object.Encryption)
 
 		// Update the current bandwidth cache value incrementing the SegmentSize.
-		err = endpoint.projectUsage.UpdateProjectBandwidthUsage(ctx, keyInfo.ProjectID, downloadSizes.encryptedSize)
+		err = endpoint.updateBandwidthUsage(ctx, keyInfo.ProjectID, downloadSizes.encryptedSize)
 		if err != nil {
 			if errs2.IsCanceled(err) {
 				return nil, rpcstatus.Wrap(rpcstatus.Canceled, err)
@@ -529,7 +551,7 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 			if streamRange != nil {
 				downloaded = int32(streamRange.PlainLimit)
 			}
-			endpoint.versionCollector.collectTransferStats(req.Header.UserAgent, download, int(downloaded))
+			endpoint.collectTransferStats(keyInfo.ProjectID, req.Bucket, req.Header.UserAgent, download, int(downloaded))
 
 			endpoint.log.Info("Inline Segment Download", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "get"), zap.String("type", "inline"))
 			mon.Meter("req_get_inline").Mark(1)
@@ -569,7 +591,7 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 		if streamRange != nil {
 			downloaded = int32(streamRange.PlainLimit)
 		}
-		endpoint.versionCollector.collectTransferStats(req.Header.UserAgent, download, int(downloaded))
+		endpoint.collectTransferStats(keyInfo.ProjectID, req.Bucket, req.Header.UserAgent, download, int(downloaded))
 
 		endpoint.log.Info("Segment Download", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "get"), zap.String("type", "remote"))
 		mon.Meter("req_get_remote").Mark(1)
@@ -817,7 +839,7 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 	placement, err := endpoint.buckets.GetBucketPlacement(ctx, req.Bucket, keyInfo.ProjectID)
 	if err != nil {
 		if buckets.ErrBucketNotFound.Has(err) {
-			return nil, rpcstatus.Errorf(rpcstatus.NotFound, "bucket not found: %s", req.Bucket)
+			return nil, codeErrorf(ErrorCodeBucketNotFound, "bucket not found: %s", req.Bucket)
 		}
 		endpoint.log.Error("unable to check bucket", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
@@ -870,60 +892,80 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 		includeSystemMetadata = status == metabase.Pending || !req.ObjectIncludes.ExcludeSystemMetadata
 	}
 
-	resp = &pb.ObjectListResponse{}
-	if endpoint.config.TestListingQuery {
-		result, err := endpoint.metabase.ListObjects(ctx,
-			metabase.ListObjects{
-				ProjectID:             keyInfo.ProjectID,
-				BucketName:            string(req.Bucket),
-				Prefix:                prefix,
-				Cursor:                metabase.ListObjectsCursor(cursor),
-				Recursive:             req.Recursive,
-				Limit:                 limit,
-				Status:                status,
-				IncludeCustomMetadata: includeCustomMetadata,
-				IncludeSystemMetadata: includeSystemMetadata,
-			})
-		if err != nil {
-			return nil, endpoint.convertMetabaseErr(err)
-		}
+	list := func() (resp *pb.ObjectListResponse, err error) {
+		resp = &pb.ObjectListResponse{}
+		if endpoint.config.TestListingQuery || endpoint.featureEnabled(ctx, featureflags.ListingQuery, keyInfo.ProjectID) {
+			result, err := endpoint.metabase.ListObjects(ctx,
+				metabase.ListObjects{
+					ProjectID:             keyInfo.ProjectID,
+					BucketName:            string(req.Bucket),
+					Prefix:                prefix,
+					Cursor:                metabase.ListObjectsCursor(cursor),
+					Recursive:             req.Recursive,
+					Limit:                 limit,
+					Status:                status,
+					IncludeCustomMetadata: includeCustomMetadata,
+					IncludeSystemMetadata: includeSystemMetadata,
+				})
+			if err != nil {
+				return nil, endpoint.convertMetabaseErr(err)
+			}
 
-		for _, entry := range result.Objects {
-			item, err := endpoint.objectEntryToProtoListItem(ctx, req.Bucket, entry, prefix, includeSystemMetadata, includeCustomMetadata, placement)
+			for _, entry := range result.Objects {
+				item, err := endpoint.objectEntryToProtoListItem(ctx, req.Bucket, entry, prefix, includeSystemMetadata, includeCustomMetadata, placement)
+				if err != nil {
+					return nil, endpoint.convertMetabaseErr(err)
+				}
+				resp.Items = append(resp.Items, item)
+			}
+			resp.More = result.More
+		} else {
+			err = endpoint.metabase.IterateObjectsAllVersionsWithStatus(ctx,
+				metabase.IterateObjectsWithStatus{
+					ProjectID:             keyInfo.ProjectID,
+					BucketName:            string(req.Bucket),
+					Prefix:                prefix,
+					Cursor:                cursor,
+					Recursive:             req.Recursive,
+					BatchSize:             limit + 1,
+					Status:                status,
+					IncludeCustomMetadata: includeCustomMetadata,
+					IncludeSystemMetadata: includeSystemMetadata,
+				}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+					entry := metabase.ObjectEntry{}
+					for len(resp.Items) < limit && it.Next(ctx, &entry) {
+						item, err := endpoint.objectEntryToProtoListItem(ctx, req.Bucket, entry, prefix, includeSystemMetadata, includeCustomMetadata, placement)
+						if err != nil {
+							return err
+						}
+						resp.Items = append(resp.Items, item)
+					}
+					resp.More = it.Next(ctx, &entry)
+					return nil
+				},
+			)
 			if err != nil {
 				return nil, endpoint.convertMetabaseErr(err)
 			}
-			resp.Items = append(resp.Items, item)
 		}
-		resp.More = result.More
+		return resp, nil
+	}
+
+	if endpoint.listCache != nil && status == metabase.Committed {
+		resp, err = endpoint.listCache.Get(ctx, listCacheKey{
+			bucket:                metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(req.Bucket)},
+			prefix:                prefix,
+			cursor:                cursor.Key,
+			recursive:             req.Recursive,
+			limit:                 limit,
+			includeCustomMetadata: includeCustomMetadata,
+			includeSystemMetadata: includeSystemMetadata,
+		}, list)
 	} else {
-		err = endpoint.metabase.IterateObjectsAllVersionsWithStatus(ctx,
-			metabase.IterateObjectsWithStatus{
-				ProjectID:             keyInfo.ProjectID,
-				BucketName:            string(req.Bucket),
-				Prefix:                prefix,
-				Cursor:                cursor,
-				Recursive:             req.Recursive,
-				BatchSize:             limit + 1,
-				Status:                status,
-				IncludeCustomMetadata: includeCustomMetadata,
-				IncludeSystemMetadata: includeSystemMetadata,
-			}, func(ctx context.Context, it metabase.ObjectsIterator) error {
-				entry := metabase.ObjectEntry{}
-				for len(resp.Items) < limit && it.Next(ctx, &entry) {
-					item, err := endpoint.objectEntryToProtoListItem(ctx, req.Bucket, entry, prefix, includeSystemMetadata, includeCustomMetadata, placement)
-					if err != nil {
-						return err
-					}
-					resp.Items = append(resp.Items, item)
-				}
-				resp.More = it.Next(ctx, &entry)
-				return nil
-			},
-		)
-		if err != nil {
-			return nil, endpoint.convertMetabaseErr(err)
-		}
+		resp, err = list()
+	}
+	if err != nil {
+		return nil, err
 	}
 	endpoint.log.Info("Object List", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "list"), zap.String("type", "object"))
 	mon.Meter("req_list_object").Mark(1)
@@ -956,7 +998,7 @@ func (endpoint *Endpoint) ListPendingObjectStreams(ctx context.Context, req *pb.
 	placement, err := endpoint.buckets.GetBucketPlacement(ctx, req.Bucket, keyInfo.ProjectID)
 	if err != nil {
 		if buckets.ErrBucketNotFound.Has(err) {
-			return nil, rpcstatus.Errorf(rpcstatus.NotFound, "bucket not found: %s", req.Bucket)
+			return nil, codeErrorf(ErrorCodeBucketNotFound, "bucket not found: %s", req.Bucket)
 		}
 		endpoint.log.Error("unable to check bucket", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
@@ -1252,6 +1294,14 @@ func (endpoint *Endpoint) UpdateObjectMetadata(ctx context.Context, req *pb.Obje
 		encryptedMetadataNonce = req.EncryptedMetadataNonce[:]
 	}
 
+	maxEncryptedMetadataSize, maxMetadataEntries, err := endpoint.metadataLimits(ctx, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkMetadataEntries(req.EncryptedMetadata, maxMetadataEntries); err != nil {
+		return nil, err
+	}
+
 	err = endpoint.metabase.UpdateObjectMetadata(ctx, metabase.UpdateObjectMetadata{
 		ProjectID:                     keyInfo.ProjectID,
 		BucketName:                    string(req.Bucket),
@@ -1260,6 +1310,7 @@ func (endpoint *Endpoint) UpdateObjectMetadata(ctx context.Context, req *pb.Obje
 		EncryptedMetadata:             req.EncryptedMetadata,
 		EncryptedMetadataNonce:        encryptedMetadataNonce,
 		EncryptedMetadataEncryptedKey: req.EncryptedMetadataEncryptedKey,
+		MaxEncryptedMetadataSize:      maxEncryptedMetadataSize,
 	})
 	if err != nil {
 		return nil, endpoint.convertMetabaseErr(err)
@@ -1475,6 +1526,10 @@ func (endpoint *Endpoint) DeleteCommittedObject(
 		return nil, Error.Wrap(err)
 	}
 
+	for _, object := range result.Objects {
+		endpoint.publishObjectEvent(ctx, eventing.ObjectRemovedDelete, object)
+	}
+
 	deletedObjects, err = endpoint.deleteObjectsPieces(ctx, result)
 	if err != nil {
 		endpoint.log.Error("failed to delete pointers",
@@ -1661,7 +1716,7 @@ func (endpoint *Endpoint) BeginMoveObject(ctx context.Context, req *pb.ObjectBeg
 		oldBucketPlacement, err := endpoint.buckets.GetBucketPlacement(ctx, req.Bucket, keyInfo.ProjectID)
 		if err != nil {
 			if buckets.ErrBucketNotFound.Has(err) {
-				return nil, rpcstatus.Errorf(rpcstatus.NotFound, "bucket not found: %s", req.Bucket)
+				return nil, codeErrorf(ErrorCodeBucketNotFound, "bucket not found: %s", req.Bucket)
 			}
 			endpoint.log.Error("unable to check bucket", zap.Error(err))
 			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
@@ -1669,13 +1724,13 @@ func (endpoint *Endpoint) BeginMoveObject(ctx context.Context, req *pb.ObjectBeg
 		newBucketPlacement, err := endpoint.buckets.GetBucketPlacement(ctx, req.NewBucket, keyInfo.ProjectID)
 		if err != nil {
 			if buckets.ErrBucketNotFound.Has(err) {
-				return nil, rpcstatus.Errorf(rpcstatus.NotFound, "bucket not found: %s", req.NewBucket)
+				return nil, codeErrorf(ErrorCodeBucketNotFound, "bucket not found: %s", req.NewBucket)
 			}
 			endpoint.log.Error("unable to check bucket", zap.Error(err))
 			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 		}
 		if oldBucketPlacement != newBucketPlacement {
-			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "copying object to bucket with different placement policy is not (yet) supported")
+			return nil, codeError(ErrorCodePlacementViolation, "copying object to bucket with different placement policy is not (yet) supported")
 		}
 	}
 
@@ -1685,6 +1740,9 @@ func (endpoint *Endpoint) BeginMoveObject(ctx context.Context, req *pb.ObjectBeg
 			BucketName: string(req.Bucket),
 			ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
 		},
+		VerifyLimits: func(encryptedObjectSize int64, nSegments int64) error {
+			return endpoint.addCopyMoveUsageUpToLimit(ctx, keyInfo.ProjectID, encryptedObjectSize)
+		},
 	})
 	if err != nil {
 		return nil, endpoint.convertMetabaseErr(err)
@@ -1805,7 +1863,7 @@ func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *pb.ObjectFi
 		endpoint.log.Error("unable to check bucket", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	} else if !exists {
-		return nil, rpcstatus.Errorf(rpcstatus.NotFound, "target bucket not found: %s", req.NewBucket)
+		return nil, codeErrorf(ErrorCodeBucketNotFound, "target bucket not found: %s", req.NewBucket)
 	}
 
 	streamUUID, err := uuid.FromBytes(streamID.StreamId)
@@ -1831,6 +1889,22 @@ func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *pb.ObjectFi
 		return nil, endpoint.convertMetabaseErr(err)
 	}
 
+	// the move doesn't return the object, so the events don't contain its size.
+	endpoint.events.Publish(ctx, eventing.Event{
+		Name:      eventing.ObjectRemovedDelete,
+		ProjectID: keyInfo.ProjectID,
+		Bucket:    string(streamID.Bucket),
+		ObjectKey: string(streamID.EncryptedObjectKey),
+		Version:   streamID.Version,
+	})
+	endpoint.events.Publish(ctx, eventing.Event{
+		Name:      eventing.ObjectCreatedCopy,
+		ProjectID: keyInfo.ProjectID,
+		Bucket:    string(req.NewBucket),
+		ObjectKey: string(req.NewEncryptedObjectKey),
+		Version:   streamID.Version,
+	})
+
 	endpoint.log.Info("Object Move Finished", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "move"), zap.String("type", "object"))
 	mon.Meter("req_move_object_finished").Mark(1)
 
@@ -1886,7 +1960,7 @@ func (endpoint *Endpoint) BeginCopyObject(ctx context.Context, req *pb.ObjectBeg
 		oldBucketPlacement, err := endpoint.buckets.GetBucketPlacement(ctx, req.Bucket, keyInfo.ProjectID)
 		if err != nil {
 			if buckets.ErrBucketNotFound.Has(err) {
-				return nil, rpcstatus.Errorf(rpcstatus.NotFound, "bucket not found: %s", req.Bucket)
+				return nil, codeErrorf(ErrorCodeBucketNotFound, "bucket not found: %s", req.Bucket)
 			}
 			endpoint.log.Error("unable to check bucket", zap.Error(err))
 			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
@@ -1894,13 +1968,13 @@ func (endpoint *Endpoint) BeginCopyObject(ctx context.Context, req *pb.ObjectBeg
 		newBucketPlacement, err := endpoint.buckets.GetBucketPlacement(ctx, req.NewBucket, keyInfo.ProjectID)
 		if err != nil {
 			if buckets.ErrBucketNotFound.Has(err) {
-				return nil, rpcstatus.Errorf(rpcstatus.NotFound, "bucket not found: %s", req.NewBucket)
+				return nil, codeErrorf(ErrorCodeBucketNotFound, "bucket not found: %s", req.NewBucket)
 			}
 			endpoint.log.Error("unable to check bucket", zap.Error(err))
 			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 		}
 		if oldBucketPlacement != newBucketPlacement {
-			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "copying object to bucket with different placement policy is not (yet) supported")
+			return nil, codeError(ErrorCodePlacementViolation, "copying object to bucket with different placement policy is not (yet) supported")
 		}
 	}
 
@@ -1911,7 +1985,10 @@ func (endpoint *Endpoint) BeginCopyObject(ctx context.Context, req *pb.ObjectBeg
 			ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
 		},
 		VerifyLimits: func(encryptedObjectSize int64, nSegments int64) error {
-			return endpoint.checkUploadLimitsForNewObject(ctx, keyInfo.ProjectID, encryptedObjectSize, nSegments)
+			if err := endpoint.checkUploadLimitsForNewObject(ctx, keyInfo.ProjectID, encryptedObjectSize, nSegments); err != nil {
+				return err
+			}
+			return endpoint.addCopyMoveUsageUpToLimit(ctx, keyInfo.ProjectID, encryptedObjectSize)
 		},
 	})
 	if err != nil {
@@ -2000,7 +2077,7 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 		endpoint.log.Error("unable to check bucket", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	} else if !exists {
-		return nil, rpcstatus.Errorf(rpcstatus.NotFound, "target bucket not found: %s", req.NewBucket)
+		return nil, codeErrorf(ErrorCodeBucketNotFound, "target bucket not found: %s", req.NewBucket)
 	}
 
 	streamUUID, err := uuid.FromBytes(streamID.StreamId)
@@ -2036,6 +2113,7 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 	if err != nil {
 		return nil, endpoint.convertMetabaseErr(err)
 	}
+	endpoint.publishObjectEvent(ctx, eventing.ObjectCreatedCopy, object)
 
 	// we can return nil redundancy because this request won't be used for downloading
 	protoObject, err := endpoint.objectToProto(ctx, object, nil)
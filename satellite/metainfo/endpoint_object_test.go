@@ -2259,6 +2259,92 @@ func TestEndpoint_UpdateObjectMetadata(t *testing.T) {
 	})
 }
 
+func TestEndpoint_UpdateObjectMetadata_TierLimits(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.MetadataLimits.Enabled = true
+				config.Metainfo.MetadataLimits.FreeTier = 512 * memory.B
+				config.Metainfo.MetadataLimits.PaidTier = memory.KiB
+				config.Metainfo.MetadataLimits.FreeTierEntries = 2
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[sat.ID()].SerializeRaw()
+		err := planet.Uplinks[0].Upload(ctx, sat, "testbucket", "object", testrand.Bytes(256))
+		require.NoError(t, err)
+
+		objects, err := sat.API.Metainfo.Metabase.TestingAllObjects(ctx)
+		require.NoError(t, err)
+
+		getObjectResponse, err := sat.API.Metainfo.Endpoint.GetObject(ctx, &pb.ObjectGetRequest{
+			Header:             &pb.RequestHeader{ApiKey: apiKey},
+			Bucket:             []byte("testbucket"),
+			EncryptedObjectKey: []byte(objects[0].ObjectKey),
+			Version:            int32(objects[0].Version),
+		})
+		require.NoError(t, err)
+
+		update := func(metadata []byte) error {
+			_, err := sat.API.Metainfo.Endpoint.UpdateObjectMetadata(ctx, &pb.ObjectUpdateMetadataRequest{
+				Header:                        &pb.RequestHeader{ApiKey: apiKey},
+				Bucket:                        []byte("testbucket"),
+				EncryptedObjectKey:            []byte(objects[0].ObjectKey),
+				Version:                       int32(objects[0].Version),
+				StreamId:                      getObjectResponse.Object.StreamId,
+				EncryptedMetadata:             metadata,
+				EncryptedMetadataEncryptedKey: randomEncryptedKey,
+			})
+			return err
+		}
+
+		// the uplink's user is in the free tier.
+		require.NoError(t, update(testrand.Bytes(512*memory.B)))
+
+		err = update(testrand.Bytes(513 * memory.B))
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+		require.Contains(t, err.Error(), "the limit is 512 bytes")
+
+		metadata, err := pb.Marshal(&pb.SerializableMeta{UserDefined: map[string]string{"a": "1", "b": "2", "c": "3"}})
+		require.NoError(t, err)
+		streamInfo, err := pb.Marshal(&pb.StreamInfo{Metadata: metadata})
+		require.NoError(t, err)
+		unencrypted, err := pb.Marshal(&pb.StreamMeta{EncryptedStreamInfo: streamInfo, EncryptionType: int32(storj.EncNull)})
+		require.NoError(t, err)
+
+		err = update(unencrypted)
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+		require.Contains(t, err.Error(), "metadata has 3 entries, the limit is 2 entries")
+
+		begin := func(metadata []byte) error {
+			_, err := sat.API.Metainfo.Endpoint.BeginObject(ctx, &pb.BeginObjectRequest{
+				Header:             &pb.RequestHeader{ApiKey: apiKey},
+				Bucket:             []byte("testbucket"),
+				EncryptedObjectKey: []byte("pending-object"),
+				EncryptionParameters: &pb.EncryptionParameters{
+					CipherSuite: pb.CipherSuite_ENC_AESGCM,
+				},
+				EncryptedMetadata:             metadata,
+				EncryptedMetadataNonce:        testrand.Nonce(),
+				EncryptedMetadataEncryptedKey: randomEncryptedKey,
+			})
+			return err
+		}
+
+		err = begin(testrand.Bytes(513 * memory.B))
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+		require.Contains(t, err.Error(), "the limit is 512 bytes")
+
+		err = begin(unencrypted)
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+		require.Contains(t, err.Error(), "metadata has 3 entries, the limit is 2 entries")
+
+		require.NoError(t, begin(testrand.Bytes(512*memory.B)))
+	})
+}
+
 func TestEndpoint_Object_CopyObject(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
@@ -2365,6 +2451,60 @@ func TestEndpoint_Object_MoveObject(t *testing.T) {
 	})
 }
 
+func TestEndpoint_CopyMoveLimits(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.CopyMoveLimits.Enabled = true
+				config.Metainfo.CopyMoveLimits.FreeTierCount = 2
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		endpoint := planet.Satellites[0].API.Metainfo.Endpoint
+
+		err := planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "testbucket", "testobject", testrand.Bytes(1*memory.KiB))
+		require.NoError(t, err)
+
+		objects, err := planet.Satellites[0].API.Metainfo.Metabase.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+
+		beginCopy := func() error {
+			_, err := endpoint.BeginCopyObject(ctx, &pb.ObjectBeginCopyRequest{
+				Header:                &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+				Bucket:                []byte("testbucket"),
+				EncryptedObjectKey:    []byte(objects[0].ObjectKey),
+				NewBucket:             []byte("testbucket"),
+				NewEncryptedObjectKey: []byte("newobjectkey"),
+			})
+			return err
+		}
+		beginMove := func() error {
+			_, err := endpoint.BeginMoveObject(ctx, &pb.ObjectBeginMoveRequest{
+				Header:                &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+				Bucket:                []byte("testbucket"),
+				EncryptedObjectKey:    []byte(objects[0].ObjectKey),
+				NewBucket:             []byte("testbucket"),
+				NewEncryptedObjectKey: []byte("newobjectkey"),
+			})
+			return err
+		}
+
+		require.NoError(t, beginCopy())
+		require.NoError(t, beginMove())
+
+		for _, begin := range []func() error{beginCopy, beginMove} {
+			err := begin()
+			require.Error(t, err)
+			require.Equal(t, rpcstatus.ResourceExhausted, rpcstatus.Code(err))
+			require.Equal(t, metainfo.ErrorCodeCopyMoveQuotaExceeded, metainfo.ErrorCodeOf(err))
+			require.NotZero(t, metainfo.RetryAfterOf(err))
+		}
+	})
+}
+
 func TestListObjectDuplicates(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
@@ -2468,3 +2608,38 @@ func TestListUploads(t *testing.T) {
 		require.Equal(t, 1000, items)
 	})
 }
+
+func TestListObjectsCache(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.ListCache.Enabled = true
+				config.Metainfo.ListCache.MinRequests = 1
+				config.Metainfo.ListCache.CacheExpiration = time.Hour
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		listKeys := func() []string {
+			objects, err := planet.Uplinks[0].ListObjects(ctx, sat, "bucket")
+			require.NoError(t, err)
+			var keys []string
+			for _, object := range objects {
+				keys = append(keys, object.Key)
+			}
+			return keys
+		}
+
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "bucket", "first", testrand.Bytes(memory.KiB)))
+		require.Equal(t, []string{"first"}, listKeys())
+
+		// the commit and the deletion invalidate the cached listing.
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "bucket", "second", testrand.Bytes(memory.KiB)))
+		require.ElementsMatch(t, []string{"first", "second"}, listKeys())
+
+		require.NoError(t, planet.Uplinks[0].DeleteObject(ctx, sat, "bucket", "first"))
+		require.Equal(t, []string{"second"}, listKeys())
+	})
+}
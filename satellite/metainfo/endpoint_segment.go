@@ -79,6 +79,7 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 	request := overlay.FindStorageNodesRequest{
 		RequestedCount: redundancy.TotalCount(),
 		Placement:      storj.PlacementConstraint(streamID.Placement),
+		PieceSize:      maxPieceSize,
 	}
 	nodes, err := endpoint.overlay.FindStorageNodesForUpload(ctx, request)
 	if err != nil {
@@ -206,10 +207,14 @@ func (endpoint *Endpoint) RetryBeginSegmentPieces(ctx context.Context, req *pb.R
 	for _, orderLimit := range segmentID.OriginalOrderLimits {
 		excludedIDs = append(excludedIDs, orderLimit.Limit.StorageNodeId)
 	}
+	// all the order limits of the segment have the same limit, which is the max piece size.
+	maxPieceSize := segmentID.OriginalOrderLimits[req.RetryPieceNumbers[0]].Limit.Limit
+
 	nodes, err := endpoint.overlay.FindStorageNodesForUpload(ctx, overlay.FindStorageNodesRequest{
 		RequestedCount: len(req.RetryPieceNumbers),
 		Placement:      storj.PlacementConstraint(segmentID.StreamId.Placement),
 		ExcludedIDs:    excludedIDs,
+		PieceSize:      maxPieceSize,
 	})
 	if err != nil {
 		endpoint.log.Error("internal", zap.Error(err))
@@ -410,7 +415,7 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 
 	// note: we collect transfer stats in CommitSegment instead because in BeginSegment
 	// they would always be MaxSegmentSize (64MiB)
-	endpoint.versionCollector.collectTransferStats(req.Header.UserAgent, upload, int(req.PlainSize))
+	endpoint.collectTransferStats(keyInfo.ProjectID, streamID.Bucket, req.Header.UserAgent, upload, int(req.PlainSize))
 
 	mon.Meter("req_commit_segment").Mark(1)
 
@@ -502,7 +507,7 @@ func (endpoint *Endpoint) MakeInlineSegment(ctx context.Context, req *pb.Segment
 		return nil, err
 	}
 
-	endpoint.versionCollector.collectTransferStats(req.Header.UserAgent, upload, int(req.PlainSize))
+	endpoint.collectTransferStats(keyInfo.ProjectID, streamID.Bucket, req.Header.UserAgent, upload, int(req.PlainSize))
 
 	endpoint.log.Info("Inline Segment Upload", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "put"), zap.String("type", "inline"))
 	mon.Meter("req_put_inline").Mark(1)
@@ -623,7 +628,7 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 
 	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(streamID.Bucket)}
 
-	if exceeded, limit, err := endpoint.projectUsage.ExceedsBandwidthUsage(ctx, keyInfo.ProjectID); err != nil {
+	if exceeded, limit, err := endpoint.exceedsBandwidthUsage(ctx, keyInfo.ProjectID); err != nil {
 		if errs2.IsCanceled(err) {
 			return nil, rpcstatus.Wrap(rpcstatus.Canceled, err)
 		}
@@ -638,7 +643,8 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 			zap.Stringer("Limit", limit),
 			zap.Stringer("Project ID", keyInfo.ProjectID),
 		)
-		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Usage Limit")
+		endpoint.notifier.NotifyLimitExceeded(ctx, keyInfo.ProjectID, "bandwidth")
+		return nil, retryAfterError(ErrorCodeQuotaExceeded, "Exceeded Usage Limit", bandwidthRetryAfter(time.Now()))
 	}
 
 	id, err := uuid.FromBytes(streamID.StreamId)
@@ -674,7 +680,7 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 	}
 
 	// Update the current bandwidth cache value incrementing the SegmentSize.
-	err = endpoint.projectUsage.UpdateProjectBandwidthUsage(ctx, keyInfo.ProjectID, int64(segment.EncryptedSize))
+	err = endpoint.updateBandwidthUsage(ctx, keyInfo.ProjectID, int64(segment.EncryptedSize))
 	if err != nil {
 		if errs2.IsCanceled(err) {
 			return nil, rpcstatus.Wrap(rpcstatus.Canceled, err)
@@ -702,7 +708,7 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 		}
 
-		endpoint.versionCollector.collectTransferStats(req.Header.UserAgent, download, len(segment.InlineData))
+		endpoint.collectTransferStats(keyInfo.ProjectID, streamID.Bucket, req.Header.UserAgent, download, len(segment.InlineData))
 
 		endpoint.log.Info("Inline Segment Download", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "get"), zap.String("type", "inline"))
 		mon.Meter("req_get_inline").Mark(1)
@@ -736,7 +742,7 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
-	endpoint.versionCollector.collectTransferStats(req.Header.UserAgent, download, int(segment.EncryptedSize))
+	endpoint.collectTransferStats(keyInfo.ProjectID, streamID.Bucket, req.Header.UserAgent, download, int(segment.EncryptedSize))
 
 	endpoint.log.Info("Segment Download", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "get"), zap.String("type", "remote"))
 	mon.Meter("req_get_remote").Mark(1)
@@ -0,0 +1,112 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/storj/private/ssepb"
+	"storj.io/storj/satellite/kms"
+)
+
+// SSEEndpoint allows the clients to opt in to the server-side encryption of the
+// bucket metadata, so that the satellite keeps the metadata keys of the buckets.
+//
+// architecture: Endpoint
+type SSEEndpoint struct {
+	metainfo *Endpoint
+	kms      *kms.Service
+}
+
+var _ ssepb.DRPCServerSideEncryptionServer = (*SSEEndpoint)(nil)
+
+// NewSSEEndpoint creates a new server-side encryption endpoint, which authorizes the
+// requests the same way as the metainfo endpoint.
+func NewSSEEndpoint(metainfo *Endpoint, kms *kms.Service) *SSEEndpoint {
+	return &SSEEndpoint{
+		metainfo: metainfo,
+		kms:      kms,
+	}
+}
+
+// SetBucketEncryption enables or disables the server-side encryption of the bucket.
+func (endpoint *SSEEndpoint) SetBucketEncryption(ctx context.Context, req *ssepb.SetBucketEncryptionRequest) (resp *ssepb.SetBucketEncryptionResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	header := &pb.RequestHeader{ApiKey: req.ApiKey, UserAgent: req.UserAgent}
+	keyInfo, err := endpoint.metainfo.validateAuth(ctx, header, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Bucket,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	endpoint.metainfo.usageTracking(keyInfo, header, fmt.Sprintf("%T", req))
+
+	if req.Enabled && len(req.MetadataKey) != storj.KeySize {
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "metadata key must be %d bytes", storj.KeySize)
+	}
+
+	exists, err := endpoint.metainfo.buckets.HasBucket(ctx, req.Bucket, keyInfo.ProjectID)
+	if err != nil {
+		endpoint.metainfo.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to check bucket")
+	}
+	if !exists {
+		return nil, codeError(ErrorCodeBucketNotFound, "bucket not found")
+	}
+
+	if req.Enabled {
+		err = endpoint.kms.EnableBucket(ctx, keyInfo.ProjectID, req.Bucket, req.MetadataKey)
+	} else {
+		err = endpoint.kms.DisableBucket(ctx, keyInfo.ProjectID, req.Bucket)
+	}
+	if err != nil {
+		endpoint.metainfo.log.Error("unable to update server-side encryption",
+			zap.Stringer("Project ID", keyInfo.ProjectID),
+			zap.Bool("Enabled", req.Enabled),
+			zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to update server-side encryption")
+	}
+
+	return &ssepb.SetBucketEncryptionResponse{}, nil
+}
+
+// GetBucketEncryptionKey returns the metadata key of a bucket with server-side encryption.
+func (endpoint *SSEEndpoint) GetBucketEncryptionKey(ctx context.Context, req *ssepb.GetBucketEncryptionKeyRequest) (resp *ssepb.GetBucketEncryptionKeyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	header := &pb.RequestHeader{ApiKey: req.ApiKey, UserAgent: req.UserAgent}
+	keyInfo, err := endpoint.metainfo.validateAuth(ctx, header, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Bucket,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	endpoint.metainfo.usageTracking(keyInfo, header, fmt.Sprintf("%T", req))
+
+	metadataKey, err := endpoint.kms.BucketKey(ctx, keyInfo.ProjectID, req.Bucket)
+	if err != nil {
+		if kms.ErrNotEnabled.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, "server-side encryption not enabled for the bucket")
+		}
+		endpoint.metainfo.log.Error("unable to get the metadata key",
+			zap.Stringer("Project ID", keyInfo.ProjectID),
+			zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to get the metadata key")
+	}
+
+	return &ssepb.GetBucketEncryptionKeyResponse{MetadataKey: metadataKey}, nil
+}
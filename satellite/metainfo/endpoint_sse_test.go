@@ -0,0 +1,96 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo_test
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/errs2"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/ssepb"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+)
+
+func TestServerSideEncryption(t *testing.T) {
+	masterKeyPath := filepath.Join(t.TempDir(), "master.key")
+	require.NoError(t, os.WriteFile(masterKeyPath, []byte(hex.EncodeToString(testrand.BytesInt(32))), 0600))
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.KMS.Enabled = true
+				config.KMS.MasterKeyPath = masterKeyPath
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		uplnk := planet.Uplinks[0]
+		apiKey := uplnk.APIKey[sat.ID()].SerializeRaw()
+
+		require.NoError(t, uplnk.CreateBucket(ctx, sat, "bucket"))
+
+		conn, err := uplnk.Dialer.DialNodeURL(ctx, sat.NodeURL())
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+		client := ssepb.NewDRPCServerSideEncryptionClient(conn)
+
+		_, err = client.GetBucketEncryptionKey(ctx, &ssepb.GetBucketEncryptionKeyRequest{
+			ApiKey: apiKey,
+			Bucket: []byte("bucket"),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+
+		_, err = client.SetBucketEncryption(ctx, &ssepb.SetBucketEncryptionRequest{
+			ApiKey:      apiKey,
+			Bucket:      []byte("missing"),
+			Enabled:     true,
+			MetadataKey: testrand.BytesInt(32),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+
+		_, err = client.SetBucketEncryption(ctx, &ssepb.SetBucketEncryptionRequest{
+			ApiKey:      apiKey,
+			Bucket:      []byte("bucket"),
+			Enabled:     true,
+			MetadataKey: testrand.BytesInt(8),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		metadataKey := testrand.BytesInt(32)
+		_, err = client.SetBucketEncryption(ctx, &ssepb.SetBucketEncryptionRequest{
+			ApiKey:      apiKey,
+			Bucket:      []byte("bucket"),
+			Enabled:     true,
+			MetadataKey: metadataKey,
+		})
+		require.NoError(t, err)
+
+		resp, err := client.GetBucketEncryptionKey(ctx, &ssepb.GetBucketEncryptionKeyRequest{
+			ApiKey: apiKey,
+			Bucket: []byte("bucket"),
+		})
+		require.NoError(t, err)
+		require.Equal(t, metadataKey, resp.MetadataKey)
+
+		// the key is removed together with the bucket.
+		require.NoError(t, uplnk.DeleteBucket(ctx, sat, "bucket"))
+		require.NoError(t, uplnk.CreateBucket(ctx, sat, "bucket"))
+
+		_, err = client.GetBucketEncryptionKey(ctx, &ssepb.GetBucketEncryptionKeyRequest{
+			ApiKey: apiKey,
+			Bucket: []byte("bucket"),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+	})
+}
@@ -0,0 +1,206 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/console"
+)
+
+// ErrorCode is a stable machine-readable code of a metainfo API error.
+//
+// The RPC errors carry only the status code and the message. The messages are
+// kept unchanged, because the deployed clients match them, so the errors
+// received over RPC are classified by the status and the stable part of the
+// message. The errors returned in-process carry the code itself. The clients
+// should use ErrorCodeOf instead of matching the messages.
+type ErrorCode string
+
+const (
+	// ErrorCodeUnknown is returned by ErrorCodeOf for the errors without a code.
+	ErrorCodeUnknown ErrorCode = ""
+	// ErrorCodeQuotaExceeded means the project exceeded its storage, segment or bandwidth limit.
+	ErrorCodeQuotaExceeded ErrorCode = "quota_exceeded"
+	// ErrorCodeCopyMoveQuotaExceeded means the project exceeded its daily server-side copy and move limit.
+	ErrorCodeCopyMoveQuotaExceeded ErrorCode = "copy_move_quota_exceeded"
+	// ErrorCodeBucketLimitExceeded means the project has the maximum number of buckets.
+	ErrorCodeBucketLimitExceeded ErrorCode = "bucket_limit_exceeded"
+	// ErrorCodeBucketNotFound means the bucket doesn't exist.
+	ErrorCodeBucketNotFound ErrorCode = "bucket_not_found"
+	// ErrorCodeObjectNotFound means the object doesn't exist.
+	ErrorCodeObjectNotFound ErrorCode = "object_not_found"
+	// ErrorCodePlacementViolation means the request conflicts with the placement of the bucket.
+	ErrorCodePlacementViolation ErrorCode = "placement_violation"
+	// ErrorCodePlacementNotEntitled means the project isn't entitled to the placement.
+	ErrorCodePlacementNotEntitled ErrorCode = "placement_not_entitled"
+	// ErrorCodeRateLimited means the project made too many requests.
+	ErrorCodeRateLimited ErrorCode = "rate_limited"
+	// ErrorCodeKeyExpired means the API key is past its expiration.
+	ErrorCodeKeyExpired ErrorCode = "key_expired"
+	// ErrorCodeUnauthorized means the API key doesn't exist or doesn't allow the request.
+	ErrorCodeUnauthorized ErrorCode = "unauthorized"
+)
+
+// errorCodeStatus maps the error codes to the RPC status codes, which are
+// returned with them.
+var errorCodeStatus = map[ErrorCode]rpcstatus.StatusCode{
+	ErrorCodeQuotaExceeded:         rpcstatus.ResourceExhausted,
+	ErrorCodeCopyMoveQuotaExceeded: rpcstatus.ResourceExhausted,
+	ErrorCodeBucketLimitExceeded:   rpcstatus.ResourceExhausted,
+	ErrorCodeBucketNotFound:        rpcstatus.NotFound,
+	ErrorCodeObjectNotFound:        rpcstatus.NotFound,
+	ErrorCodePlacementViolation:    rpcstatus.InvalidArgument,
+	ErrorCodePlacementNotEntitled:  rpcstatus.PermissionDenied,
+	ErrorCodeRateLimited:           rpcstatus.ResourceExhausted,
+	ErrorCodeKeyExpired:            rpcstatus.PermissionDenied,
+	ErrorCodeUnauthorized:          rpcstatus.PermissionDenied,
+}
+
+// StatusCode returns the RPC status code, which is returned with the error code.
+func (code ErrorCode) StatusCode() rpcstatus.StatusCode {
+	if status, ok := errorCodeStatus[code]; ok {
+		return status
+	}
+	return rpcstatus.Unknown
+}
+
+// codedError is a metainfo API error with its code and optionally the hint,
+// after how long the request may succeed.
+type codedError struct {
+	error
+	code       ErrorCode
+	retryAfter time.Duration
+}
+
+// Unwrap returns the RPC error.
+func (err *codedError) Unwrap() error { return err.error }
+
+// codeError returns an RPC error with the message, which carries the code.
+func codeError(code ErrorCode, msg string) error {
+	return &codedError{
+		error: rpcstatus.Error(code.StatusCode(), msg),
+		code:  code,
+	}
+}
+
+// codeErrorf returns an RPC error with the formatted message, which carries the code.
+func codeErrorf(code ErrorCode, format string, a ...interface{}) error {
+	return codeError(code, fmt.Sprintf(format, a...))
+}
+
+// retryAfterError returns an RPC error with the message, which carries the code
+// and the hint, after how long the request may succeed.
+func retryAfterError(code ErrorCode, msg string, retryAfter time.Duration) error {
+	return &codedError{
+		error:      rpcstatus.Error(code.StatusCode(), msg),
+		code:       code,
+		retryAfter: retryAfter,
+	}
+}
+
+// RetryAfterOf returns after how long the failed request may succeed or zero,
+// when the error doesn't have the hint. For the errors received over RPC the
+// hint is known only for the limits, which are reset at a fixed time: the
+// bandwidth limit and the copy and move limit.
+func RetryAfterOf(err error) time.Duration {
+	if err == nil {
+		return 0
+	}
+
+	var coded *codedError
+	if errors.As(err, &coded) {
+		return coded.retryAfter
+	}
+
+	if rpcstatus.Code(err) != rpcstatus.ResourceExhausted {
+		return 0
+	}
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "Exceeded Usage Limit"):
+		return bandwidthRetryAfter(time.Now())
+	case strings.Contains(msg, "Exceeded Copy and Move Limit"):
+		return copyMoveRetryAfter(time.Now())
+	}
+	return 0
+}
+
+// errorCodeMessages classifies the errors received over RPC by the status and
+// the stable part of the message. The first match wins.
+var errorCodeMessages = []struct {
+	status  rpcstatus.StatusCode
+	message string
+	code    ErrorCode
+}{
+	{rpcstatus.ResourceExhausted, "Exceeded Copy and Move Limit", ErrorCodeCopyMoveQuotaExceeded},
+	{rpcstatus.ResourceExhausted, "Too Many Requests", ErrorCodeRateLimited},
+	{rpcstatus.ResourceExhausted, "number of allocated buckets", ErrorCodeBucketLimitExceeded},
+	{rpcstatus.ResourceExhausted, "Exceeded Usage Limit", ErrorCodeQuotaExceeded},
+	{rpcstatus.ResourceExhausted, "Exceeded Storage Limit", ErrorCodeQuotaExceeded},
+	{rpcstatus.ResourceExhausted, "Exceeded Segments Limit", ErrorCodeQuotaExceeded},
+	{rpcstatus.ResourceExhausted, string(accounting.ErrProjectLimitExceeded), ErrorCodeQuotaExceeded},
+	{rpcstatus.NotFound, "object not found", ErrorCodeObjectNotFound},
+	{rpcstatus.NotFound, string(buckets.ErrBucketNotFound), ErrorCodeBucketNotFound},
+	{rpcstatus.NotFound, "does not exist", ErrorCodeBucketNotFound},
+	{rpcstatus.InvalidArgument, "different placement policy", ErrorCodePlacementViolation},
+	{rpcstatus.PermissionDenied, "Unauthorized API credentials", ErrorCodeUnauthorized},
+	{rpcstatus.PermissionDenied, string(console.ErrPlacementNotEntitled), ErrorCodePlacementNotEntitled},
+}
+
+// ErrorCodeOf returns the code of the metainfo API error or ErrorCodeUnknown,
+// when the error doesn't have a known code. ErrorCodeKeyExpired is returned
+// only for the errors returned in-process, the clients get ErrorCodeUnauthorized.
+func ErrorCodeOf(err error) ErrorCode {
+	if err == nil {
+		return ErrorCodeUnknown
+	}
+
+	var coded *codedError
+	if errors.As(err, &coded) {
+		return coded.code
+	}
+
+	status := rpcstatus.Code(err)
+	msg := err.Error()
+	for _, known := range errorCodeMessages {
+		if known.status == status && strings.Contains(msg, known.message) {
+			return known.code
+		}
+	}
+	return ErrorCodeUnknown
+}
+
+// unauthorizedError returns the error for a key, which didn't pass the check.
+func unauthorizedError(key *macaroon.APIKey, now time.Time) error {
+	if keyExpired(key, now) {
+		return codeError(ErrorCodeKeyExpired, "Unauthorized API credentials")
+	}
+	return codeError(ErrorCodeUnauthorized, "Unauthorized API credentials")
+}
+
+// keyExpired returns whether any of the caveats of the key expired.
+func keyExpired(key *macaroon.APIKey, now time.Time) bool {
+	mac, err := macaroon.ParseMacaroon(key.SerializeRaw())
+	if err != nil {
+		return false
+	}
+	for _, data := range mac.Caveats() {
+		caveat, err := macaroon.ParseCaveat(data)
+		if err != nil {
+			continue
+		}
+		if caveat.NotAfter != nil && now.After(*caveat.NotAfter) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/rpc/rpcstatus"
+)
+
+func TestErrorCodes(t *testing.T) {
+	for code, status := range errorCodeStatus {
+		require.NotEqual(t, rpcstatus.Unknown, status, code)
+		require.Equal(t, status, code.StatusCode())
+
+		err := codeErrorf(code, "failed %d times", 3)
+		require.Equal(t, status, rpcstatus.Code(err))
+		require.Equal(t, "failed 3 times", err.Error())
+		require.Equal(t, code, ErrorCodeOf(err))
+	}
+
+	require.Equal(t, rpcstatus.Unknown, ErrorCode("invented").StatusCode())
+	require.Equal(t, ErrorCodeUnknown, ErrorCodeOf(nil))
+	require.Equal(t, ErrorCodeUnknown, ErrorCodeOf(errors.New("bucket not found")))
+	require.Equal(t, ErrorCodeUnknown, ErrorCodeOf(rpcstatus.Error(rpcstatus.Internal, "bucket not found")))
+}
+
+func TestErrorCodeOfMessage(t *testing.T) {
+	// the clients receive only the status and the message
+	received := func(err error) error {
+		return rpcstatus.Error(rpcstatus.Code(err), "metaclient: "+err.Error())
+	}
+
+	for _, tc := range []struct {
+		err  error
+		code ErrorCode
+	}{
+		{codeError(ErrorCodeQuotaExceeded, "Exceeded Usage Limit"), ErrorCodeQuotaExceeded},
+		{codeError(ErrorCodeQuotaExceeded, "Exceeded Storage Limit"), ErrorCodeQuotaExceeded},
+		{codeError(ErrorCodeQuotaExceeded, "Exceeded Segments Limit"), ErrorCodeQuotaExceeded},
+		{codeError(ErrorCodeQuotaExceeded, "project limit: exceeded storage"), ErrorCodeQuotaExceeded},
+		{codeError(ErrorCodeCopyMoveQuotaExceeded, "Exceeded Copy and Move Limit"), ErrorCodeCopyMoveQuotaExceeded},
+		{codeError(ErrorCodeRateLimited, "Too Many Requests"), ErrorCodeRateLimited},
+		{codeErrorf(ErrorCodeBucketLimitExceeded, "number of allocated buckets (%d) exceeded", 100), ErrorCodeBucketLimitExceeded},
+		{codeError(ErrorCodeBucketNotFound, "bucket not found: testbucket"), ErrorCodeBucketNotFound},
+		{codeErrorf(ErrorCodeBucketNotFound, "bucket %q does not exist", "testbucket"), ErrorCodeBucketNotFound},
+		{codeError(ErrorCodeObjectNotFound, "object not found: sql"), ErrorCodeObjectNotFound},
+		{codeError(ErrorCodePlacementViolation, "copying object to bucket with different placement policy is not (yet) supported"), ErrorCodePlacementViolation},
+		{codeError(ErrorCodePlacementNotEntitled, "placement not entitled: 10"), ErrorCodePlacementNotEntitled},
+		{codeError(ErrorCodeUnauthorized, "Unauthorized API credentials"), ErrorCodeUnauthorized},
+		{codeError(ErrorCodeKeyExpired, "Unauthorized API credentials"), ErrorCodeUnauthorized},
+	} {
+		require.Equal(t, tc.code, ErrorCodeOf(received(tc.err)), tc.err.Error())
+	}
+}
+
+func TestRetryAfterOf(t *testing.T) {
+	err := retryAfterError(ErrorCodeQuotaExceeded, "Exceeded Storage Limit", 30*time.Second)
+	require.Equal(t, "Exceeded Storage Limit", err.Error())
+	require.Equal(t, rpcstatus.ResourceExhausted, rpcstatus.Code(err))
+	require.Equal(t, ErrorCodeQuotaExceeded, ErrorCodeOf(err))
+	require.Equal(t, 30*time.Second, RetryAfterOf(err))
+
+	require.Zero(t, RetryAfterOf(nil))
+	require.Zero(t, RetryAfterOf(codeError(ErrorCodeQuotaExceeded, "Exceeded Storage Limit")))
+	require.Zero(t, RetryAfterOf(rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Storage Limit")))
+
+	// the clients receive only the status and the message
+	received := rpcstatus.Error(rpcstatus.ResourceExhausted, "metaclient: Exceeded Usage Limit")
+	require.Positive(t, RetryAfterOf(received))
+	require.LessOrEqual(t, RetryAfterOf(received), 31*24*time.Hour)
+
+	received = rpcstatus.Error(rpcstatus.ResourceExhausted, "metaclient: Exceeded Copy and Move Limit")
+	require.Positive(t, RetryAfterOf(received))
+	require.LessOrEqual(t, RetryAfterOf(received), 24*time.Hour)
+
+	require.Zero(t, RetryAfterOf(rpcstatus.Error(rpcstatus.NotFound, "Exceeded Usage Limit")))
+}
+
+func TestCopyMoveRetryAfter(t *testing.T) {
+	now := time.Date(2023, time.May, 31, 22, 30, 0, 0, time.UTC)
+	require.Equal(t, 90*time.Minute, copyMoveRetryAfter(now))
+}
+
+func TestUnauthorizedError(t *testing.T) {
+	secret, err := macaroon.NewSecret()
+	require.NoError(t, err)
+	key, err := macaroon.NewAPIKey(secret)
+	require.NoError(t, err)
+
+	now := time.Now()
+	require.Equal(t, ErrorCodeUnauthorized, ErrorCodeOf(unauthorizedError(key, now)))
+
+	notAfter := now.Add(time.Hour)
+	restricted, err := key.Restrict(macaroon.Caveat{NotAfter: &notAfter})
+	require.NoError(t, err)
+	require.Equal(t, ErrorCodeUnauthorized, ErrorCodeOf(unauthorizedError(restricted, now)))
+
+	err = unauthorizedError(restricted, now.Add(2*time.Hour))
+	require.Equal(t, "Unauthorized API credentials", err.Error())
+	require.Equal(t, ErrorCodeKeyExpired, ErrorCodeOf(err))
+	require.Equal(t, rpcstatus.PermissionDenied, rpcstatus.Code(err))
+}
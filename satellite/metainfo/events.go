@@ -0,0 +1,23 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+
+	"storj.io/storj/satellite/eventing"
+	"storj.io/storj/satellite/metabase"
+)
+
+// publishObjectEvent publishes the event of the object to the targets configured for its bucket.
+func (endpoint *Endpoint) publishObjectEvent(ctx context.Context, name eventing.EventName, object metabase.Object) {
+	endpoint.events.Publish(ctx, eventing.Event{
+		Name:      name,
+		ProjectID: object.ProjectID,
+		Bucket:    object.BucketName,
+		ObjectKey: string(object.ObjectKey),
+		Version:   int64(object.Version),
+		Size:      object.TotalPlainSize,
+	})
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/eventing"
+)
+
+func TestObjectEvents(t *testing.T) {
+	events := make(chan string, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var message struct {
+			Records []struct {
+				EventName string `json:"eventName"`
+			} `json:"Records"`
+		}
+		if err := json.Unmarshal(body, &message); err != nil || len(message.Records) != 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		events <- message.Records[0].EventName
+	}))
+	defer server.Close()
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Eventing.Enabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "bucket"))
+		require.NoError(t, sat.DB.Console().BucketNotifications().Set(ctx, projectID, "bucket", eventing.BucketConfig{
+			Rules: []eventing.Rule{{
+				ID:     "all",
+				Events: []eventing.EventName{"s3:Object*"},
+				Target: eventing.Target{Type: eventing.TargetWebhook, URL: server.URL},
+			}},
+		}))
+
+		expect := func(name string) {
+			select {
+			case event := <-events:
+				require.Equal(t, name, event)
+			case <-time.After(10 * time.Second):
+				t.Fatalf("event %q wasn't delivered", name)
+			}
+		}
+
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "bucket", "object", testrand.Bytes(memory.KiB)))
+		expect("ObjectCreated:Put")
+
+		require.NoError(t, planet.Uplinks[0].DeleteObject(ctx, sat, "bucket", "object"))
+		expect("ObjectRemoved:Delete")
+	})
+}
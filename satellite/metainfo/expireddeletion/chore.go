@@ -26,6 +26,10 @@ type Config struct {
 	Interval  time.Duration `help:"the time between each attempt to go through the db and clean up expired segments" releaseDefault:"24h" devDefault:"10s" testDefault:"$TESTINTERVAL"`
 	Enabled   bool          `help:"set if expired segment cleanup is enabled or not" releaseDefault:"true" devDefault:"true"`
 	ListLimit int           `help:"how many expired objects to query in a batch" default:"100"`
+
+	QueuePieceDeletions bool          `help:"set if the pieces of the expired segments are deleted directly from the storage nodes instead of waiting for the garbage collection" default:"false"`
+	QueueInterval       time.Duration `help:"the time between each attempt to delete the queued pieces from the storage nodes" releaseDefault:"1m" devDefault:"10s" testDefault:"$TESTINTERVAL"`
+	QueueBatchSize      int           `help:"how many queued segments to process in a batch" default:"1000"`
 }
 
 // Chore implements the expired segment cleanup chore.
@@ -81,8 +85,9 @@ func (chore *Chore) deleteExpiredObjects(ctx context.Context) (err error) {
 	// TODO log error instead of crashing core until we will be sure
 	// that queries for deleting expired objects are stable
 	err = chore.metabase.DeleteExpiredObjects(ctx, metabase.DeleteExpiredObjects{
-		ExpiredBefore: chore.nowFn(),
-		BatchSize:     chore.config.ListLimit,
+		ExpiredBefore:       chore.nowFn(),
+		BatchSize:           chore.config.ListLimit,
+		QueuePieceDeletions: chore.config.QueuePieceDeletions,
 	})
 	if err != nil {
 		chore.log.Error("deleting expired objects failed", zap.Error(err))
@@ -15,6 +15,7 @@ import (
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metabase"
 )
 
 func TestExpiredDeletion(t *testing.T) {
@@ -186,3 +187,60 @@ func TestExpiredDeletionForCopiedObject(t *testing.T) {
 		require.Equal(t, int64(0), allSpaceUsedForPieces())
 	})
 }
+
+func TestExpiredDeletionQueuePieces(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				testplanet.ReconfigureRS(2, 3, 4, 4)(log, index, config)
+				config.ExpiredDeletion.QueuePieceDeletions = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		expiredChore := satellite.Core.ExpiredDeletion.Chore
+		queueChore := satellite.Core.ExpiredDeletion.QueueChore
+
+		allSpaceUsedForPieces := func() (all int64) {
+			for _, node := range planet.StorageNodes {
+				total, _, _, err := node.Storage2.Store.SpaceUsedTotalAndBySatellite(ctx)
+				require.NoError(t, err)
+				all += total
+			}
+			return all
+		}
+
+		expiredChore.Loop.Pause()
+		queueChore.Loop.Pause()
+
+		err := upl.UploadWithExpiration(ctx, satellite, "testbucket", "remote_expire", testrand.Bytes(8*memory.KiB), time.Now().Add(1*time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, planet.WaitForStorageNodeEndpoints(ctx))
+		require.NotZero(t, allSpaceUsedForPieces())
+
+		expiredChore.SetNow(func() time.Time {
+			return time.Now().Add(2 * time.Hour)
+		})
+		expiredChore.Loop.TriggerWait()
+
+		objects, err := satellite.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 0)
+
+		queued, err := satellite.Metabase.DB.ListQueuedSegments(ctx, metabase.ListQueuedSegments{Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, queued.Segments, 1)
+
+		// the pieces are deleted without running the garbage collection.
+		queueChore.Loop.TriggerWait()
+
+		queued, err = satellite.Metabase.DB.ListQueuedSegments(ctx, metabase.ListQueuedSegments{Limit: 10})
+		require.NoError(t, err)
+		require.Empty(t, queued.Segments)
+
+		require.NoError(t, planet.WaitForStorageNodeEndpoints(ctx))
+		require.Equal(t, int64(0), allSpaceUsedForPieces())
+	})
+}
@@ -0,0 +1,149 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package expireddeletion
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo/piecedeletion"
+)
+
+// PieceDeleter deletes pieces from the storage nodes.
+type PieceDeleter interface {
+	Delete(ctx context.Context, requests []piecedeletion.Request) error
+}
+
+// QueueChore deletes the pieces of the expired segments, which were queued
+// by the expired deletion, directly from the storage nodes.
+//
+// architecture: Chore
+type QueueChore struct {
+	log      *zap.Logger
+	config   Config
+	metabase *metabase.DB
+	deleter  PieceDeleter
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewQueueChore creates a new instance of the piece deletion queue chore.
+func NewQueueChore(log *zap.Logger, config Config, metabase *metabase.DB, deleter PieceDeleter) *QueueChore {
+	return &QueueChore{
+		log:      log,
+		config:   config,
+		metabase: metabase,
+		deleter:  deleter,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.QueueInterval),
+	}
+}
+
+// Run starts the piece deletion queue loop.
+func (chore *QueueChore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled || !chore.config.QueuePieceDeletions {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, chore.deleteQueuedPieces)
+}
+
+// Close stops the piece deletion queue chore.
+func (chore *QueueChore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// SetNow allows tests to have the server act as if the current time is whatever they want.
+func (chore *QueueChore) SetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+func (chore *QueueChore) deleteQueuedPieces(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		result, err := chore.metabase.ListQueuedSegments(ctx, metabase.ListQueuedSegments{
+			Limit: chore.config.QueueBatchSize,
+		})
+		if err != nil {
+			chore.log.Error("listing piece deletion queue failed", zap.Error(err))
+			return nil
+		}
+		if len(result.Segments) == 0 {
+			return nil
+		}
+
+		if err := chore.deleteBatch(ctx, result.Segments); err != nil {
+			// the segments stay in the queue and are retried on the next iteration.
+			chore.log.Error("deleting queued pieces failed", zap.Error(err))
+			return nil
+		}
+
+		if len(result.Segments) < chore.config.QueueBatchSize {
+			return nil
+		}
+	}
+}
+
+// deleteBatch deletes the pieces of the segments, grouped by node, and removes
+// the segments from the queue.
+func (chore *QueueChore) deleteBatch(ctx context.Context, segments []metabase.QueuedSegment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodePieces := make(map[storj.NodeID][]storj.PieceID)
+	keys := make([]metabase.QueuedSegmentKey, 0, len(segments))
+	var pieceCount, reclaimedBytes int64
+	for _, segment := range segments {
+		pieceSize := segment.Redundancy.PieceSize(int64(segment.EncryptedSize))
+		for _, piece := range segment.Pieces {
+			pieceID := segment.RootPieceID.Derive(piece.StorageNode, int32(piece.Number))
+			nodePieces[piece.StorageNode] = append(nodePieces[piece.StorageNode], pieceID)
+			pieceCount++
+			reclaimedBytes += pieceSize
+		}
+		keys = append(keys, metabase.QueuedSegmentKey{
+			StreamID: segment.StreamID,
+			Position: segment.Position,
+		})
+	}
+
+	requests := make([]piecedeletion.Request, 0, len(nodePieces))
+	for nodeID, pieces := range nodePieces {
+		requests = append(requests, piecedeletion.Request{
+			Node:   storj.NodeURL{ID: nodeID},
+			Pieces: pieces,
+		})
+	}
+
+	// pieces on the nodes, which couldn't be reached, are collected by the garbage collection.
+	if err := chore.deleter.Delete(ctx, requests); err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = chore.metabase.DeleteQueuedSegments(ctx, metabase.DeleteQueuedSegments{
+		Segments: keys,
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	now := chore.nowFn()
+	for _, segment := range segments {
+		mon.DurationVal("expired_pieces_reclaim_latency").Observe(now.Sub(segment.QueuedAt))
+	}
+	mon.Meter("expired_pieces_reclaimed_bytes").Mark64(reclaimedBytes)
+	mon.Meter("expired_pieces_deleted").Mark64(pieceCount)
+
+	return nil
+}
@@ -0,0 +1,148 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"storj.io/common/lrucache"
+	"storj.io/common/pb"
+	"storj.io/storj/satellite/metabase"
+)
+
+// listCacheKey identifies a listing request.
+type listCacheKey struct {
+	bucket                metabase.BucketLocation
+	prefix                metabase.ObjectKey
+	cursor                metabase.ObjectKey
+	recursive             bool
+	limit                 int
+	includeCustomMetadata bool
+	includeSystemMetadata bool
+}
+
+// listCache caches the listings of the committed objects, which are requested
+// repeatedly, e.g. by the gateways polling the same prefixes.
+//
+// The listings of a bucket are invalidated by the metabase hooks when the objects
+// of the bucket change through this API instance. The changes made through the
+// other instances are visible after the listings expire.
+type listCache struct {
+	config ListCacheConfig
+
+	requests *lrucache.ExpiringLRUOf[*int64]
+	listings *lrucache.ExpiringLRUOf[*pb.ObjectListResponse]
+
+	mu sync.Mutex
+	// generations contains the generation of the listings of the buckets, which
+	// changed within the expiration. The buckets, which didn't change, have
+	// the generation 0.
+	generations    map[metabase.BucketLocation]bucketGeneration
+	lastGeneration uint64
+}
+
+type bucketGeneration struct {
+	generation uint64
+	changed    time.Time
+}
+
+// newListCache creates a new cache of the listings.
+func newListCache(config ListCacheConfig) *listCache {
+	return &listCache{
+		config: config,
+		requests: lrucache.NewOf[*int64](lrucache.Options{
+			Capacity:   config.CacheCapacity,
+			Expiration: config.CacheExpiration,
+			Name:       "metainfo-list-requests",
+		}),
+		listings: lrucache.NewOf[*pb.ObjectListResponse](lrucache.Options{
+			Capacity:   config.CacheCapacity,
+			Expiration: config.CacheExpiration,
+			Name:       "metainfo-list-cache",
+		}),
+		generations: make(map[metabase.BucketLocation]bucketGeneration),
+	}
+}
+
+// Get returns the cached listing or calls list. The listing is cached when it
+// was requested often enough. The returned response must not be modified.
+func (cache *listCache) Get(ctx context.Context, key listCacheKey, list func() (*pb.ObjectListResponse, error)) (_ *pb.ObjectListResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	requestKey := cache.requestKey(key)
+	listingKey := strconv.FormatUint(cache.generation(key.bucket), 10) + "/" + requestKey
+
+	if resp, ok := cache.listings.GetCached(ctx, listingKey); ok {
+		mon.Event("list_cache_hit")
+		return resp, nil
+	}
+	mon.Event("list_cache_miss")
+
+	requests, err := cache.requests.Get(ctx, requestKey, func() (*int64, error) {
+		return new(int64), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if atomic.AddInt64(requests, 1) < int64(cache.config.MinRequests) {
+		return list()
+	}
+
+	return cache.listings.Get(ctx, listingKey, list)
+}
+
+// Invalidate drops the listings of the bucket.
+func (cache *listCache) Invalidate(ctx context.Context, bucket metabase.BucketLocation) {
+	now := time.Now()
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	// the listings of the forgotten generations have expired already.
+	if len(cache.generations) >= cache.config.CacheCapacity {
+		for location, generation := range cache.generations {
+			if now.Sub(generation.changed) > cache.config.CacheExpiration {
+				delete(cache.generations, location)
+			}
+		}
+	}
+
+	cache.lastGeneration++
+	cache.generations[bucket] = bucketGeneration{
+		generation: cache.lastGeneration,
+		changed:    now,
+	}
+}
+
+// generation returns the current generation of the listings of the bucket.
+func (cache *listCache) generation(bucket metabase.BucketLocation) uint64 {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	return cache.generations[bucket].generation
+}
+
+// requestKey encodes the listing request. The keys are binary, so they're length prefixed.
+func (cache *listCache) requestKey(key listCacheKey) string {
+	var b strings.Builder
+	b.WriteString(key.bucket.ProjectID.String())
+	for _, part := range []string{key.bucket.BucketName, string(key.prefix), string(key.cursor)} {
+		b.WriteString("/")
+		b.WriteString(strconv.Itoa(len(part)))
+		b.WriteString(":")
+		b.WriteString(part)
+	}
+	b.WriteString("/")
+	b.WriteString(strconv.Itoa(key.limit))
+	b.WriteString("/")
+	b.WriteString(strconv.FormatBool(key.recursive))
+	b.WriteString(strconv.FormatBool(key.includeCustomMetadata))
+	b.WriteString(strconv.FormatBool(key.includeSystemMetadata))
+	return b.String()
+}
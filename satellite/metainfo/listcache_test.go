@@ -0,0 +1,80 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestListCache(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	cache := newListCache(ListCacheConfig{
+		Enabled:         true,
+		MinRequests:     2,
+		CacheCapacity:   10,
+		CacheExpiration: time.Hour,
+	})
+
+	bucket := metabase.BucketLocation{ProjectID: testrand.UUID(), BucketName: "bucket"}
+	key := listCacheKey{bucket: bucket, prefix: "a/", limit: 10}
+
+	var calls int
+	list := func() (*pb.ObjectListResponse, error) {
+		calls++
+		return &pb.ObjectListResponse{More: calls > 1}, nil
+	}
+
+	// the first request isn't cached, the second is.
+	for i := 1; i <= 2; i++ {
+		_, err := cache.Get(ctx, key, list)
+		require.NoError(t, err)
+		require.Equal(t, i, calls)
+	}
+	resp, err := cache.Get(ctx, key, list)
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+	require.True(t, resp.More)
+
+	// a different request isn't affected by the cached one.
+	_, err = cache.Get(ctx, listCacheKey{bucket: bucket, prefix: "a/", cursor: "b", limit: 10}, list)
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+
+	// the changes of another bucket don't invalidate the listing.
+	cache.Invalidate(ctx, metabase.BucketLocation{ProjectID: bucket.ProjectID, BucketName: "other"})
+	_, err = cache.Get(ctx, key, list)
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+
+	cache.Invalidate(ctx, bucket)
+	_, err = cache.Get(ctx, key, list)
+	require.NoError(t, err)
+	require.Equal(t, 4, calls)
+
+	_, err = cache.Get(ctx, key, list)
+	require.NoError(t, err)
+	require.Equal(t, 4, calls)
+}
+
+func TestListCacheRequestKey(t *testing.T) {
+	cache := newListCache(ListCacheConfig{CacheCapacity: 10, CacheExpiration: time.Hour})
+	bucket := metabase.BucketLocation{ProjectID: testrand.UUID(), BucketName: "bucket"}
+
+	// the parts are length prefixed, so moving bytes between them changes the key.
+	require.NotEqual(t,
+		cache.requestKey(listCacheKey{bucket: bucket, prefix: "a/", cursor: "b"}),
+		cache.requestKey(listCacheKey{bucket: bucket, prefix: "a/b", cursor: ""}))
+	require.NotEqual(t,
+		cache.requestKey(listCacheKey{bucket: bucket, recursive: true}),
+		cache.requestKey(listCacheKey{bucket: bucket, includeCustomMetadata: true}))
+}
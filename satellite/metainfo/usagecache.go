@@ -0,0 +1,281 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
+)
+
+// usageSource is the live accounting of the project usage and limits.
+type usageSource interface {
+	GetProjectUsageAndLimits(ctx context.Context, projectID uuid.UUID) (accounting.ProjectUsageAndLimits, error)
+	AddProjectStorageUsage(ctx context.Context, projectID uuid.UUID, spaceUsed int64) error
+	UpdateProjectSegmentUsage(ctx context.Context, projectID uuid.UUID, increment int64) error
+	UpdateProjectBandwidthUsage(ctx context.Context, projectID uuid.UUID, increment int64) error
+}
+
+// usageDelta is a change of the project usage.
+type usageDelta struct {
+	storage   int64
+	segments  int64
+	bandwidth int64
+}
+
+func (delta *usageDelta) add(other usageDelta) {
+	delta.storage += other.storage
+	delta.segments += other.segments
+	delta.bandwidth += other.bandwidth
+}
+
+// cachedUsage is the usage of a project known to this API instance.
+type cachedUsage struct {
+	// usage is the usage from the last reconciliation including the pending changes.
+	usage accounting.ProjectUsageAndLimits
+	// pending are the changes, which weren't flushed to the live accounting yet.
+	pending usageDelta
+	usedAt  time.Time
+}
+
+// usageCache keeps the usage and the limits of the recently used projects, so
+// the limits are enforced without querying the live accounting on every request.
+//
+// The usage changes are accumulated locally and the loop periodically flushes
+// them to the live accounting and refreshes the cached usage, which includes
+// the changes made through the other API instances. Because the other changes
+// are visible only after the refresh, the projects may exceed their limits by
+// the grace buffer.
+type usageCache struct {
+	log    *zap.Logger
+	config UsageCacheConfig
+	source usageSource
+	nowFn  func() time.Time
+
+	Loop *sync2.Cycle
+
+	mu       sync.Mutex
+	projects map[uuid.UUID]*cachedUsage
+}
+
+// newUsageCache creates a new cache of the project usage.
+func newUsageCache(log *zap.Logger, config UsageCacheConfig, source usageSource) *usageCache {
+	return &usageCache{
+		log:      log,
+		config:   config,
+		source:   source,
+		nowFn:    time.Now,
+		Loop:     sync2.NewCycle(config.ReconcileInterval),
+		projects: make(map[uuid.UUID]*cachedUsage),
+	}
+}
+
+// Run starts the reconciliation loop.
+func (cache *usageCache) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return cache.Loop.Run(ctx, func(ctx context.Context) error {
+		cache.reconcile(ctx)
+		return nil
+	})
+}
+
+// Close stops the reconciliation loop and flushes the pending usage.
+func (cache *usageCache) Close() error {
+	cache.Loop.Close()
+
+	cache.mu.Lock()
+	pending := make(map[uuid.UUID]usageDelta, len(cache.projects))
+	for projectID, entry := range cache.projects {
+		pending[projectID] = entry.pending
+		entry.pending = usageDelta{}
+	}
+	cache.mu.Unlock()
+
+	ctx := context.Background()
+	for projectID, delta := range pending {
+		if _, err := cache.flush(ctx, projectID, delta); err != nil {
+			cache.log.Error("Could not flush the project usage", zap.Stringer("Project ID", projectID), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// Get returns the usage and the limits of the project. The usage of the
+// projects, which aren't cached, is loaded from the live accounting.
+func (cache *usageCache) Get(ctx context.Context, projectID uuid.UUID) (_ accounting.ProjectUsageAndLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := cache.nowFn()
+
+	cache.mu.Lock()
+	if entry, ok := cache.projects[projectID]; ok {
+		entry.usedAt = now
+		usage := entry.usage
+		cache.mu.Unlock()
+		mon.Event("metainfo_usage_cache_hit")
+		return usage, nil
+	}
+	cache.mu.Unlock()
+	mon.Event("metainfo_usage_cache_miss")
+
+	usage, err := cache.source.GetProjectUsageAndLimits(ctx, projectID)
+	if err != nil {
+		return accounting.ProjectUsageAndLimits{}, err
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if entry, ok := cache.projects[projectID]; ok {
+		// loaded concurrently by another request.
+		entry.usedAt = now
+		return entry.usage, nil
+	}
+	if len(cache.projects) < cache.config.CacheCapacity {
+		cache.projects[projectID] = &cachedUsage{
+			usage:  usage,
+			usedAt: now,
+		}
+	}
+	return usage, nil
+}
+
+// Add records a change of the project usage. The change is flushed to the
+// live accounting by the loop or, when the project isn't cached, immediately.
+func (cache *usageCache) Add(ctx context.Context, projectID uuid.UUID, delta usageDelta) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cache.mu.Lock()
+	if entry, ok := cache.projects[projectID]; ok {
+		entry.usage.StorageUsage += delta.storage
+		entry.usage.SegmentUsage += delta.segments
+		entry.usage.BandwidthUsage += delta.bandwidth
+		entry.pending.add(delta)
+		cache.mu.Unlock()
+		return nil
+	}
+	cache.mu.Unlock()
+
+	_, err = cache.flush(ctx, projectID, delta)
+	return err
+}
+
+// graceLimit returns the limit increased by the grace buffer.
+func (cache *usageCache) graceLimit(limit int64) int64 {
+	return limit + int64(float64(limit)*cache.config.GraceBuffer)
+}
+
+// uploadRetryAfter returns when the upload may succeed after the storage or
+// segment limit was exceeded, i.e. after the usage is refreshed.
+func (cache *usageCache) uploadRetryAfter() time.Duration {
+	return cache.config.ReconcileInterval
+}
+
+// reconcile flushes the pending usage of the cached projects to the live
+// accounting, refreshes their usage and evicts the projects, which weren't
+// used within the expiration.
+func (cache *usageCache) reconcile(ctx context.Context) {
+	defer mon.Task()(&ctx)(nil)
+
+	expiredBefore := cache.nowFn().Add(-cache.config.CacheExpiration)
+
+	cache.mu.Lock()
+	pending := make(map[uuid.UUID]usageDelta, len(cache.projects))
+	var expired []uuid.UUID
+	for projectID, entry := range cache.projects {
+		pending[projectID] = entry.pending
+		entry.pending = usageDelta{}
+		if entry.usedAt.Before(expiredBefore) {
+			expired = append(expired, projectID)
+			delete(cache.projects, projectID)
+		}
+	}
+	cache.mu.Unlock()
+
+	mon.IntVal("metainfo_usage_cache_projects").Observe(int64(len(pending)))
+	mon.IntVal("metainfo_usage_cache_evicted").Observe(int64(len(expired)))
+
+	for _, projectID := range expired {
+		if _, err := cache.flush(ctx, projectID, pending[projectID]); err != nil {
+			cache.log.Error("Could not flush the usage of an evicted project",
+				zap.Stringer("Project ID", projectID), zap.Error(err))
+		}
+		delete(pending, projectID)
+	}
+
+	for projectID, delta := range pending {
+		if err := ctx.Err(); err != nil {
+			cache.restore(projectID, delta)
+			continue
+		}
+
+		if remaining, err := cache.flush(ctx, projectID, delta); err != nil {
+			cache.log.Error("Could not flush the project usage", zap.Stringer("Project ID", projectID), zap.Error(err))
+			// the remaining usage is flushed again on the next iteration.
+			cache.restore(projectID, remaining)
+			continue
+		}
+
+		usage, err := cache.source.GetProjectUsageAndLimits(ctx, projectID)
+		if err != nil {
+			cache.log.Error("Could not refresh the project usage", zap.Stringer("Project ID", projectID), zap.Error(err))
+			continue
+		}
+
+		cache.mu.Lock()
+		if entry, ok := cache.projects[projectID]; ok {
+			usage.StorageUsage += entry.pending.storage
+			usage.SegmentUsage += entry.pending.segments
+			usage.BandwidthUsage += entry.pending.bandwidth
+			entry.usage = usage
+		}
+		cache.mu.Unlock()
+	}
+}
+
+// restore returns the usage, which couldn't be flushed, to the pending usage.
+func (cache *usageCache) restore(projectID uuid.UUID, delta usageDelta) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if entry, ok := cache.projects[projectID]; ok {
+		entry.pending.add(delta)
+	}
+}
+
+// flush adds the usage change to the live accounting. On failure, it returns
+// the part of the change, which wasn't added.
+func (cache *usageCache) flush(ctx context.Context, projectID uuid.UUID, delta usageDelta) (remaining usageDelta, err error) {
+	if delta.storage != 0 {
+		if err := cache.source.AddProjectStorageUsage(ctx, projectID, delta.storage); err != nil {
+			return delta, err
+		}
+		delta.storage = 0
+	}
+	if delta.segments != 0 {
+		if err := cache.source.UpdateProjectSegmentUsage(ctx, projectID, delta.segments); err != nil {
+			return delta, err
+		}
+		delta.segments = 0
+	}
+	if delta.bandwidth != 0 {
+		if err := cache.source.UpdateProjectBandwidthUsage(ctx, projectID, delta.bandwidth); err != nil {
+			return delta, err
+		}
+	}
+	return usageDelta{}, nil
+}
+
+// bandwidthRetryAfter returns the time until the monthly bandwidth usage is reset.
+func bandwidthRetryAfter(now time.Time) time.Duration {
+	year, month, _ := now.UTC().Date()
+	return time.Date(year, month+1, 1, 0, 0, 0, 0, time.UTC).Sub(now).Round(time.Second)
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
+)
+
+type fakeUsageSource struct {
+	mu     sync.Mutex
+	usage  map[uuid.UUID]accounting.ProjectUsageAndLimits
+	loads  int
+	failed bool
+}
+
+func (source *fakeUsageSource) GetProjectUsageAndLimits(ctx context.Context, projectID uuid.UUID) (accounting.ProjectUsageAndLimits, error) {
+	source.mu.Lock()
+	defer source.mu.Unlock()
+	source.loads++
+	return source.usage[projectID], nil
+}
+
+func (source *fakeUsageSource) update(projectID uuid.UUID, fn func(usage *accounting.ProjectUsageAndLimits)) error {
+	source.mu.Lock()
+	defer source.mu.Unlock()
+	if source.failed {
+		return errors.New("live accounting unavailable")
+	}
+	usage := source.usage[projectID]
+	fn(&usage)
+	source.usage[projectID] = usage
+	return nil
+}
+
+func (source *fakeUsageSource) AddProjectStorageUsage(ctx context.Context, projectID uuid.UUID, spaceUsed int64) error {
+	return source.update(projectID, func(usage *accounting.ProjectUsageAndLimits) { usage.StorageUsage += spaceUsed })
+}
+
+func (source *fakeUsageSource) UpdateProjectSegmentUsage(ctx context.Context, projectID uuid.UUID, increment int64) error {
+	return source.update(projectID, func(usage *accounting.ProjectUsageAndLimits) { usage.SegmentUsage += increment })
+}
+
+func (source *fakeUsageSource) UpdateProjectBandwidthUsage(ctx context.Context, projectID uuid.UUID, increment int64) error {
+	return source.update(projectID, func(usage *accounting.ProjectUsageAndLimits) { usage.BandwidthUsage += increment })
+}
+
+func TestUsageCache(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	projectID, otherID := testrand.UUID(), testrand.UUID()
+	source := &fakeUsageSource{usage: map[uuid.UUID]accounting.ProjectUsageAndLimits{
+		projectID: {StorageUsage: 10, StorageLimit: 100, SegmentLimit: 10, BandwidthLimit: 1000},
+		otherID:   {StorageLimit: 100},
+	}}
+
+	cache := newUsageCache(zaptest.NewLogger(t), UsageCacheConfig{
+		Enabled:           true,
+		GraceBuffer:       0.1,
+		ReconcileInterval: time.Minute,
+		CacheCapacity:     1,
+		CacheExpiration:   time.Hour,
+	}, source)
+	now := time.Now()
+	cache.nowFn = func() time.Time { return now }
+
+	require.EqualValues(t, 110, cache.graceLimit(100))
+	require.Equal(t, time.Minute, cache.uploadRetryAfter())
+
+	usage, err := cache.Get(ctx, projectID)
+	require.NoError(t, err)
+	require.EqualValues(t, 10, usage.StorageUsage)
+	require.Equal(t, 1, source.loads)
+
+	// the changes of a cached project are applied locally.
+	require.NoError(t, cache.Add(ctx, projectID, usageDelta{storage: 5, segments: 1, bandwidth: 50}))
+	usage, err = cache.Get(ctx, projectID)
+	require.NoError(t, err)
+	require.EqualValues(t, 15, usage.StorageUsage)
+	require.EqualValues(t, 1, usage.SegmentUsage)
+	require.EqualValues(t, 50, usage.BandwidthUsage)
+	require.Equal(t, 1, source.loads)
+	require.EqualValues(t, 10, source.usage[projectID].StorageUsage)
+
+	// the projects beyond the capacity aren't cached and their changes are written through.
+	_, err = cache.Get(ctx, otherID)
+	require.NoError(t, err)
+	require.NoError(t, cache.Add(ctx, otherID, usageDelta{storage: 7}))
+	require.EqualValues(t, 7, source.usage[otherID].StorageUsage)
+
+	// the failed flush is retried on the next reconciliation.
+	source.failed = true
+	cache.reconcile(ctx)
+	require.EqualValues(t, 10, source.usage[projectID].StorageUsage)
+
+	source.failed = false
+	require.NoError(t, source.UpdateProjectSegmentUsage(ctx, projectID, 3)) // through another instance.
+	cache.reconcile(ctx)
+	require.EqualValues(t, 15, source.usage[projectID].StorageUsage)
+	require.EqualValues(t, 50, source.usage[projectID].BandwidthUsage)
+
+	usage, err = cache.Get(ctx, projectID)
+	require.NoError(t, err)
+	require.EqualValues(t, 15, usage.StorageUsage)
+	require.EqualValues(t, 4, usage.SegmentUsage)
+
+	// the unused projects are flushed and evicted.
+	require.NoError(t, cache.Add(ctx, projectID, usageDelta{storage: 1}))
+	now = now.Add(2 * time.Hour)
+	cache.reconcile(ctx)
+	require.EqualValues(t, 16, source.usage[projectID].StorageUsage)
+	require.Empty(t, cache.projects)
+
+	// the pending changes are flushed on close.
+	_, err = cache.Get(ctx, projectID)
+	require.NoError(t, err)
+	require.NoError(t, cache.Add(ctx, projectID, usageDelta{bandwidth: 5}))
+	require.NoError(t, cache.Close())
+	require.EqualValues(t, 55, source.usage[projectID].BandwidthUsage)
+}
+
+func TestBandwidthRetryAfter(t *testing.T) {
+	now := time.Date(2023, time.May, 31, 23, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Hour, bandwidthRetryAfter(now))
+}
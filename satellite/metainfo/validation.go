@@ -15,7 +15,6 @@ import (
 	"github.com/jtolio/eventkit"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
-	"golang.org/x/time/rate"
 
 	"storj.io/common/encryption"
 	"storj.io/common/errs2"
@@ -25,6 +24,7 @@ import (
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/private/ratelimit"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
@@ -66,7 +66,7 @@ func (endpoint *Endpoint) validateAuth(ctx context.Context, header *pb.RequestHe
 	err = key.Check(ctx, keyInfo.Secret, action, endpoint.revocations)
 	if err != nil {
 		endpoint.log.Debug("unauthorized request", zap.Error(err))
-		return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
+		return nil, unauthorizedError(key, time.Now())
 	}
 
 	return keyInfo, nil
@@ -111,7 +111,7 @@ func (endpoint *Endpoint) validateAuthN(ctx context.Context, header *pb.RequestH
 		}
 		if err != nil && !p.optional {
 			endpoint.log.Debug("unauthorized request", zap.Error(err))
-			return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
+			return nil, unauthorizedError(key, time.Now())
 		}
 	}
 
@@ -143,7 +143,7 @@ func (endpoint *Endpoint) validateAuthAny(ctx context.Context, header *pb.Reques
 	}
 
 	endpoint.log.Debug("unauthorized request", zap.Error(combinedErrs))
-	return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
+	return nil, unauthorizedError(key, time.Now())
 }
 
 func (endpoint *Endpoint) validateBasic(ctx context.Context, header *pb.RequestHeader) (_ *macaroon.APIKey, _ *console.APIKeyInfo, err error) {
@@ -158,9 +158,11 @@ func (endpoint *Endpoint) validateBasic(ctx context.Context, header *pb.RequestH
 	keyInfo, err := endpoint.apiKeys.GetByHead(ctx, key.Head())
 	if err != nil {
 		endpoint.log.Debug("unauthorized request", zap.Error(err))
-		return nil, nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
+		return nil, nil, codeError(ErrorCodeUnauthorized, "Unauthorized API credentials")
 	}
 
+	endpoint.tracing.ObserveProject(ctx, keyInfo.ProjectID)
+
 	userAgent := ""
 	if keyInfo.UserAgent != nil {
 		userAgent = string(keyInfo.UserAgent)
@@ -176,6 +178,8 @@ func (endpoint *Endpoint) validateBasic(ctx context.Context, header *pb.RequestH
 		return nil, nil, err
 	}
 
+	endpoint.gatewayCredentialUsage.Observe(key.Tail())
+
 	return key, keyInfo, nil
 }
 
@@ -210,38 +214,46 @@ func (endpoint *Endpoint) checkRate(ctx context.Context, projectID uuid.UUID) (e
 	if !endpoint.config.RateLimiter.Enabled {
 		return nil
 	}
-	limiter, err := endpoint.limiterCache.Get(ctx, projectID.String(), func() (*rate.Limiter, error) {
-		rateLimit := rate.Limit(endpoint.config.RateLimiter.Rate)
-		burstLimit := int(endpoint.config.RateLimiter.Rate)
+	limit, err := endpoint.rateLimitsCache.Get(ctx, projectID.String(), func() (ratelimit.Limit, error) {
+		rate := endpoint.DefaultRateLimit()
+		limit := ratelimit.Limit{
+			Rate:  rate,
+			Burst: int(rate),
+		}
 
 		limits, err := endpoint.projectLimits.GetLimits(ctx, projectID)
 		if err != nil {
-			return nil, err
+			return ratelimit.Limit{}, err
 		}
 		if limits.RateLimit != nil {
-			rateLimit = rate.Limit(*limits.RateLimit)
-			burstLimit = *limits.RateLimit
+			limit.Rate = float64(*limits.RateLimit)
+			limit.Burst = *limits.RateLimit
 		}
 		// use the explicitly set burst value if it's defined
 		if limits.BurstLimit != nil {
-			burstLimit = *limits.BurstLimit
+			limit.Burst = *limits.BurstLimit
 		}
 
-		return rate.NewLimiter(rateLimit, burstLimit), nil
+		return limit, nil
 	})
 	if err != nil {
 		return rpcstatus.Error(rpcstatus.Unavailable, err.Error())
 	}
 
-	if !limiter.Allow() {
+	allowed, err := endpoint.rateLimiter.Allow(ctx, "metainfo:"+projectID.String(), limit)
+	if err != nil {
+		return rpcstatus.Error(rpcstatus.Unavailable, err.Error())
+	}
+
+	if !allowed {
 		endpoint.log.Warn("too many requests for project",
 			zap.Stringer("projectID", projectID),
-			zap.Float64("rate limit", float64(limiter.Limit())),
-			zap.Float64("burst limit", float64(limiter.Burst())))
+			zap.Float64("rate limit", limit.Rate),
+			zap.Float64("burst limit", float64(limit.Burst)))
 
 		mon.Event("metainfo_rate_limit_exceeded") //mon:locked
 
-		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Too Many Requests")
+		return codeError(ErrorCodeRateLimited, "Too Many Requests")
 	}
 
 	return nil
@@ -385,6 +397,10 @@ func (endpoint *Endpoint) checkUploadLimits(ctx context.Context, projectID uuid.
 func (endpoint *Endpoint) checkUploadLimitsForNewObject(
 	ctx context.Context, projectID uuid.UUID, newObjectSize int64, newObjectSegmentCount int64,
 ) error {
+	if endpoint.usageCache != nil {
+		return endpoint.checkCachedUploadLimits(ctx, projectID, newObjectSize, newObjectSegmentCount)
+	}
+
 	if limit, err := endpoint.projectUsage.ExceedsUploadLimits(ctx, projectID, newObjectSize, newObjectSegmentCount); err != nil {
 		if errs2.IsCanceled(err) {
 			return rpcstatus.Wrap(rpcstatus.Canceled, err)
@@ -401,7 +417,8 @@ func (endpoint *Endpoint) checkUploadLimitsForNewObject(
 				zap.String("Limit", strconv.Itoa(int(limit.SegmentsLimit))),
 				zap.Stringer("Project ID", projectID),
 			)
-			return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Segments Limit")
+			endpoint.notifier.NotifyLimitExceeded(ctx, projectID, "segments")
+			return codeError(ErrorCodeQuotaExceeded, "Exceeded Segments Limit")
 		}
 
 		if limit.ExceedsStorage {
@@ -409,7 +426,8 @@ func (endpoint *Endpoint) checkUploadLimitsForNewObject(
 				zap.String("Limit", strconv.Itoa(limit.StorageLimit.Int())),
 				zap.Stringer("Project ID", projectID),
 			)
-			return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Storage Limit")
+			endpoint.notifier.NotifyLimitExceeded(ctx, projectID, "storage")
+			return codeError(ErrorCodeQuotaExceeded, "Exceeded Storage Limit")
 		}
 	}
 
@@ -420,7 +438,86 @@ func (endpoint *Endpoint) addSegmentToUploadLimits(ctx context.Context, projectI
 	return endpoint.addToUploadLimits(ctx, projectID, segmentSize, 1)
 }
 
+// checkCachedUploadLimits checks the upload limits against the cached project
+// usage. The limits are increased by the grace buffer, because the cached usage
+// doesn't include the recent uploads through the other API instances.
+func (endpoint *Endpoint) checkCachedUploadLimits(
+	ctx context.Context, projectID uuid.UUID, newObjectSize int64, newObjectSegmentCount int64,
+) error {
+	usage, err := endpoint.usageCache.Get(ctx, projectID)
+	if err != nil {
+		if errs2.IsCanceled(err) {
+			return rpcstatus.Wrap(rpcstatus.Canceled, err)
+		}
+
+		endpoint.log.Error(
+			"Retrieving project upload limit failed; limit won't be enforced",
+			zap.Stringer("Project ID", projectID),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	if usage.SegmentUsage+newObjectSegmentCount > endpoint.usageCache.graceLimit(usage.SegmentLimit) {
+		endpoint.log.Warn("Segment limit exceeded",
+			zap.String("Limit", strconv.FormatInt(usage.SegmentLimit, 10)),
+			zap.Stringer("Project ID", projectID),
+		)
+		endpoint.notifier.NotifyLimitExceeded(ctx, projectID, "segments")
+		return retryAfterError(ErrorCodeQuotaExceeded, "Exceeded Segments Limit", endpoint.usageCache.uploadRetryAfter())
+	}
+
+	if usage.StorageUsage+newObjectSize > endpoint.usageCache.graceLimit(usage.StorageLimit) {
+		endpoint.log.Warn("Storage limit exceeded",
+			zap.String("Limit", strconv.FormatInt(usage.StorageLimit, 10)),
+			zap.Stringer("Project ID", projectID),
+		)
+		endpoint.notifier.NotifyLimitExceeded(ctx, projectID, "storage")
+		return retryAfterError(ErrorCodeQuotaExceeded, "Exceeded Storage Limit", endpoint.usageCache.uploadRetryAfter())
+	}
+
+	return nil
+}
+
+// exceedsBandwidthUsage returns whether the project exceeded its monthly bandwidth limit.
+func (endpoint *Endpoint) exceedsBandwidthUsage(ctx context.Context, projectID uuid.UUID) (_ bool, limit memory.Size, err error) {
+	if endpoint.usageCache == nil {
+		return endpoint.projectUsage.ExceedsBandwidthUsage(ctx, projectID)
+	}
+
+	usage, err := endpoint.usageCache.Get(ctx, projectID)
+	if err != nil {
+		return false, 0, err
+	}
+	return usage.BandwidthUsage >= endpoint.usageCache.graceLimit(usage.BandwidthLimit), memory.Size(usage.BandwidthLimit), nil
+}
+
+// updateBandwidthUsage increments the bandwidth usage of the project.
+func (endpoint *Endpoint) updateBandwidthUsage(ctx context.Context, projectID uuid.UUID, increment int64) error {
+	if endpoint.usageCache == nil {
+		return endpoint.projectUsage.UpdateProjectBandwidthUsage(ctx, projectID, increment)
+	}
+	return endpoint.usageCache.Add(ctx, projectID, usageDelta{bandwidth: increment})
+}
+
 func (endpoint *Endpoint) addToUploadLimits(ctx context.Context, projectID uuid.UUID, size int64, segmentCount int64) error {
+	if endpoint.usageCache != nil {
+		err := endpoint.usageCache.Add(ctx, projectID, usageDelta{storage: size, segments: segmentCount})
+		if err != nil {
+			if errs2.IsCanceled(err) {
+				return rpcstatus.Wrap(rpcstatus.Canceled, err)
+			}
+
+			// log it and continue. the only thing that will be affected is our
+			// per-project storage and segment limits.
+			endpoint.log.Error("Could not track new project's storage and segment usage",
+				zap.Stringer("Project ID", projectID),
+				zap.Error(err),
+			)
+		}
+		return nil
+	}
+
 	if err := endpoint.projectUsage.AddProjectStorageUsage(ctx, projectID, size); err != nil {
 		if errs2.IsCanceled(err) {
 			return rpcstatus.Wrap(rpcstatus.Canceled, err)
@@ -463,7 +560,7 @@ func (endpoint *Endpoint) addStorageUsageUpToLimit(ctx context.Context, projectI
 				zap.Stringer("Project ID", projectID),
 				zap.Error(err),
 			)
-			return rpcstatus.Error(rpcstatus.ResourceExhausted, err.Error())
+			return codeError(ErrorCodeQuotaExceeded, err.Error())
 		}
 
 		if errs2.IsCanceled(err) {
@@ -495,6 +592,123 @@ func (endpoint *Endpoint) checkEncryptedMetadataSize(encryptedMetadata, encrypte
 	return nil
 }
 
+// metadataLimits returns the encrypted metadata size limit, which is enforced by the metabase,
+// and the custom metadata entries limit of the project's tier. It returns zeros, when the limits
+// per tier are disabled.
+func (endpoint *Endpoint) metadataLimits(ctx context.Context, projectID uuid.UUID) (maxSize, maxEntries int, err error) {
+	if !endpoint.config.MetadataLimits.Enabled {
+		return 0, 0, nil
+	}
+
+	limits, err := endpoint.projectLimits.GetLimits(ctx, projectID)
+	if err != nil {
+		endpoint.log.Error("internal", zap.Error(err))
+		return 0, 0, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	if limits.PaidTier {
+		return endpoint.config.MetadataLimits.PaidTier.Int(), endpoint.config.MetadataLimits.PaidTierEntries, nil
+	}
+	return endpoint.config.MetadataLimits.FreeTier.Int(), endpoint.config.MetadataLimits.FreeTierEntries, nil
+}
+
+// checkMetadataEntries checks the number of the custom metadata entries against the limit,
+// 0 means no limit. The entries are readable only when the object is uploaded without
+// encryption, the encrypted metadata is limited only by its size.
+func checkMetadataEntries(encryptedMetadata []byte, maxEntries int) error {
+	if maxEntries <= 0 || len(encryptedMetadata) == 0 {
+		return nil
+	}
+
+	entries, ok := countMetadataEntries(encryptedMetadata)
+	if ok && entries > maxEntries {
+		return rpcstatus.Errorf(rpcstatus.InvalidArgument, "metadata has %d entries, the limit is %d entries", entries, maxEntries)
+	}
+	return nil
+}
+
+// countMetadataEntries returns the number of the custom metadata entries of the stream
+// metadata, when the stream info isn't encrypted.
+func countMetadataEntries(encryptedMetadata []byte) (int, bool) {
+	var streamMeta pb.StreamMeta
+	if err := pb.Unmarshal(encryptedMetadata, &streamMeta); err != nil {
+		return 0, false
+	}
+
+	if storj.CipherSuite(streamMeta.EncryptionType) != storj.EncNull {
+		return 0, false
+	}
+
+	var streamInfo pb.StreamInfo
+	if err := pb.Unmarshal(streamMeta.EncryptedStreamInfo, &streamInfo); err != nil {
+		return 0, false
+	}
+
+	var metadata pb.SerializableMeta
+	if err := pb.Unmarshal(streamInfo.Metadata, &metadata); err != nil {
+		return 0, false
+	}
+	return len(metadata.UserDefined), true
+}
+
+// addCopyMoveUsageUpToLimit counts the server-side copy or move of the object in the daily
+// usage of the project. The copies and moves are counted when they begin, so the limits
+// can't be bypassed by the operations, which are never finished.
+func (endpoint *Endpoint) addCopyMoveUsageUpToLimit(ctx context.Context, projectID uuid.UUID, encryptedObjectSize int64) error {
+	if !endpoint.config.CopyMoveLimits.Enabled {
+		return nil
+	}
+
+	limits, err := endpoint.projectLimits.GetLimits(ctx, projectID)
+	if err != nil {
+		if errs2.IsCanceled(err) {
+			return rpcstatus.Wrap(rpcstatus.Canceled, err)
+		}
+
+		endpoint.log.Error(
+			"Retrieving project copy and move limit failed; limit won't be enforced",
+			zap.Stringer("Project ID", projectID),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	config := endpoint.config.CopyMoveLimits
+	countLimit, sizeLimit := config.FreeTierCount, config.FreeTierSize.Int64()
+	if limits.PaidTier {
+		countLimit, sizeLimit = config.PaidTierCount, config.PaidTierSize.Int64()
+	}
+
+	err = endpoint.projectUsage.AddProjectCopyMoveUsageUpToLimit(ctx, projectID, encryptedObjectSize, countLimit, sizeLimit)
+	if err != nil {
+		if accounting.ErrProjectLimitExceeded.Has(err) {
+			endpoint.log.Warn("Copy and move limit exceeded",
+				zap.String("Count Limit", strconv.FormatInt(countLimit, 10)),
+				zap.String("Size Limit", strconv.FormatInt(sizeLimit, 10)),
+				zap.Stringer("Project ID", projectID),
+			)
+			mon.Event("copy_move_limit_exceeded")
+			return retryAfterError(ErrorCodeCopyMoveQuotaExceeded, "Exceeded Copy and Move Limit", copyMoveRetryAfter(time.Now()))
+		}
+		if errs2.IsCanceled(err) {
+			return rpcstatus.Wrap(rpcstatus.Canceled, err)
+		}
+
+		endpoint.log.Error(
+			"Updating project copy and move usage failed; limit won't be enforced",
+			zap.Stringer("Project ID", projectID),
+			zap.Error(err),
+		)
+	}
+
+	return nil
+}
+
+// copyMoveRetryAfter returns how long until the daily copy and move limits are reset.
+func copyMoveRetryAfter(now time.Time) time.Duration {
+	year, month, day := now.UTC().Date()
+	return time.Date(year, month, day+1, 0, 0, 0, 0, time.UTC).Sub(now).Round(time.Second)
+}
+
 func (endpoint *Endpoint) checkObjectUploadRate(ctx context.Context, projectID uuid.UUID, bucketName []byte, objectKey []byte) error {
 	if !endpoint.config.UploadLimiter.Enabled {
 		return nil
@@ -509,7 +723,7 @@ func (endpoint *Endpoint) checkObjectUploadRate(ctx context.Context, projectID u
 		return struct{}{}, nil
 	})
 	if limited {
-		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Too Many Requests")
+		return codeError(ErrorCodeRateLimited, "Too Many Requests")
 	}
 
 	return nil
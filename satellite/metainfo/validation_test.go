@@ -5,6 +5,7 @@ package metainfo
 
 import (
 	"context"
+	"strconv"
 	"testing"
 	"time"
 
@@ -12,8 +13,11 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
+	"storj.io/common/errs2"
 	"storj.io/common/macaroon"
 	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
@@ -231,3 +235,34 @@ func TestEndpoint_validateAuthN(t *testing.T) {
 		assert.Equal(t, tt.wantCanDelete, canDelete, i)
 	}
 }
+
+func TestCheckMetadataEntries(t *testing.T) {
+	streamMeta := func(cipher storj.CipherSuite, entries int) []byte {
+		userDefined := map[string]string{}
+		for i := 0; i < entries; i++ {
+			userDefined[strconv.Itoa(i)] = "value"
+		}
+		metadata, err := pb.Marshal(&pb.SerializableMeta{UserDefined: userDefined})
+		require.NoError(t, err)
+		streamInfo, err := pb.Marshal(&pb.StreamInfo{Metadata: metadata})
+		require.NoError(t, err)
+		streamMeta, err := pb.Marshal(&pb.StreamMeta{
+			EncryptedStreamInfo: streamInfo,
+			EncryptionType:      int32(cipher),
+		})
+		require.NoError(t, err)
+		return streamMeta
+	}
+
+	require.NoError(t, checkMetadataEntries(streamMeta(storj.EncNull, 3), 3))
+	require.NoError(t, checkMetadataEntries(streamMeta(storj.EncNull, 4), 0))
+	require.NoError(t, checkMetadataEntries(nil, 3))
+
+	err := checkMetadataEntries(streamMeta(storj.EncNull, 4), 3)
+	require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+	require.Contains(t, err.Error(), "metadata has 4 entries, the limit is 3 entries")
+
+	// the entries of the encrypted metadata can't be counted.
+	require.NoError(t, checkMetadataEntries(streamMeta(storj.EncAESGCM, 4), 3))
+	require.NoError(t, checkMetadataEntries([]byte("not a stream meta"), 3))
+}
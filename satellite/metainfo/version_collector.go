@@ -13,6 +13,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/useragent"
+	"storj.io/common/uuid"
 )
 
 const uplinkProduct = "uplink"
@@ -126,6 +127,22 @@ func (vc *versionCollector) collectTransferStats(useragentRaw []byte, transfer t
 	}
 }
 
+// collectTransferStats records the transfer in the user agent statistics and,
+// when they are enabled, in the user agent rollups of the bucket.
+func (endpoint *Endpoint) collectTransferStats(projectID uuid.UUID, bucketName, useragentRaw []byte, transfer transfer, transferSize int) {
+	endpoint.versionCollector.collectTransferStats(useragentRaw, transfer, transferSize)
+
+	if endpoint.userAgentRollups == nil {
+		return
+	}
+	switch transfer {
+	case upload:
+		endpoint.userAgentRollups.AddUpload(projectID, bucketName, useragentRaw, int64(transferSize))
+	case download:
+		endpoint.userAgentRollups.AddDownload(projectID, bucketName, useragentRaw, int64(transferSize))
+	}
+}
+
 // contains returns true if the given string is contained in the given slice.
 func contains(slice []string, testValue string) bool {
 	for _, sliceValue := range slice {
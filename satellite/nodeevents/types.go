@@ -25,6 +25,10 @@ const (
 	OfflineUnsuspended Type = 6
 	// BelowMinVersion indicates that the node's software is below the minimum version.
 	BelowMinVersion Type = 7
+	// Quarantined indicates that the node is quarantined for serving corrupted data.
+	Quarantined Type = 8
+	// Unquarantined indicates that the node is no longer quarantined.
+	Unquarantined Type = 9
 
 	onlineName                  = "online"
 	offlineName                 = "offline"
@@ -34,6 +38,8 @@ const (
 	offlineSuspendedName        = "offline suspended"
 	offlineUnsuspendedName      = "offline unsuspended"
 	belowMinVersionName         = "below minimum version"
+	quarantinedName             = "quarantined"
+	unquarantinedName           = "unquarantined"
 )
 
 // Name returns the name of the node event Type.
@@ -55,6 +61,10 @@ func (t Type) Name() (name string, err error) {
 		name = offlineUnsuspendedName
 	case BelowMinVersion:
 		name = belowMinVersionName
+	case Quarantined:
+		name = quarantinedName
+	case Unquarantined:
+		name = unquarantinedName
 	default:
 		err = errs.New("invalid Type")
 	}
@@ -0,0 +1,95 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodemessages
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/identity"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/nodemessagepb"
+)
+
+// Endpoint sends the messages to the storage nodes, which fetch them during their
+// check-ins, and receives the acknowledgments. The nodes are authenticated by their
+// identity.
+//
+// architecture: Endpoint
+type Endpoint struct {
+	nodemessagepb.DRPCNodeMessagesUnimplementedServer
+
+	log     *zap.Logger
+	service *Service
+}
+
+// NewEndpoint returns a new node messages endpoint.
+func NewEndpoint(log *zap.Logger, service *Service) *Endpoint {
+	return &Endpoint{
+		log:     log,
+		service: service,
+	}
+}
+
+// ListMessages returns the current messages sent to the calling node.
+func (endpoint *Endpoint) ListMessages(ctx context.Context, req *nodemessagepb.ListMessagesRequest) (_ *nodemessagepb.ListMessagesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+
+	messages, err := endpoint.service.ListForNode(ctx, peer.ID)
+	if err != nil {
+		endpoint.log.Error("failed to list node messages", zap.Stringer("Node ID", peer.ID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "failed to list messages")
+	}
+
+	resp := &nodemessagepb.ListMessagesResponse{
+		Messages: make([]*nodemessagepb.Message, 0, len(messages)),
+	}
+	for _, message := range messages {
+		resp.Messages = append(resp.Messages, &nodemessagepb.Message{
+			Id:             message.ID.Bytes(),
+			Kind:           string(message.Kind),
+			Title:          message.Title,
+			Body:           message.Body,
+			CreatedAt:      message.CreatedAt,
+			ExpiresAt:      message.ExpiresAt,
+			AcknowledgedAt: message.AcknowledgedAt,
+		})
+	}
+	return resp, nil
+}
+
+// AcknowledgeMessage records that the operator of the calling node acknowledged the message.
+func (endpoint *Endpoint) AcknowledgeMessage(ctx context.Context, req *nodemessagepb.AcknowledgeMessageRequest) (_ *nodemessagepb.AcknowledgeMessageResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+
+	id, err := uuid.FromBytes(req.GetId())
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	acknowledgedAt, err := endpoint.service.Acknowledge(ctx, id, peer.ID)
+	if err != nil {
+		if ErrNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		}
+		endpoint.log.Error("failed to acknowledge node message", zap.Stringer("Node ID", peer.ID), zap.Stringer("ID", id), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "failed to acknowledge message")
+	}
+
+	return &nodemessagepb.AcknowledgeMessageResponse{
+		AcknowledgedAt: acknowledgedAt,
+	}, nil
+}
@@ -0,0 +1,115 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package nodemessages implements the messages of the operators of the satellite to the
+// operators of the storage nodes, e.g. about deprecations or policy changes. The nodes fetch
+// the messages during their check-ins and acknowledge them from their dashboards.
+package nodemessages
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+)
+
+var (
+	// Error is the default error class for the node messages.
+	Error = errs.Class("node messages")
+	// ErrNotFound is returned when the message doesn't exist or isn't sent to the node.
+	ErrNotFound = errs.Class("node message not found")
+	// ErrInvalid is returned when the message is malformed.
+	ErrInvalid = errs.Class("invalid node message")
+
+	mon = monkit.Package()
+)
+
+// Config contains the configuration of the node messages.
+type Config struct {
+	Enabled        bool `help:"whether the messages to the storage node operators are sent to the nodes" default:"true"`
+	MaxTitleLength int  `help:"the longest title of a message" default:"256"`
+	MaxBodyLength  int  `help:"the longest body of a message" default:"8192"`
+}
+
+// Kind is the kind of a message.
+type Kind string
+
+const (
+	// KindInfo is a general announcement.
+	KindInfo Kind = "info"
+	// KindDeprecation announces a deprecation, e.g. of a node version.
+	KindDeprecation Kind = "deprecation"
+	// KindPolicy announces a change of the policies of the satellite.
+	KindPolicy Kind = "policy"
+)
+
+// ParseKind parses the string representation of a kind.
+func ParseKind(value string) (Kind, error) {
+	switch kind := Kind(value); kind {
+	case KindInfo, KindDeprecation, KindPolicy:
+		return kind, nil
+	default:
+		return "", ErrInvalid.New("unknown kind %q", value)
+	}
+}
+
+// Message is a message of the operators of the satellite to the operators of the nodes.
+type Message struct {
+	ID    uuid.UUID
+	Kind  Kind
+	Title string
+	Body  string
+	// Recipients are the nodes the message is sent to. The message is sent to all nodes,
+	// when there are no recipients.
+	Recipients []storj.NodeID
+	ExpiresAt  *time.Time
+	CreatedAt  time.Time
+
+	// Acknowledgments is the number of the nodes, which acknowledged the message.
+	Acknowledgments int
+}
+
+// NodeMessage is a message as it's sent to a node.
+type NodeMessage struct {
+	ID             uuid.UUID
+	Kind           Kind
+	Title          string
+	Body           string
+	ExpiresAt      *time.Time
+	CreatedAt      time.Time
+	AcknowledgedAt *time.Time
+}
+
+// Acknowledgment records that the operator of the node acknowledged the message.
+type Acknowledgment struct {
+	MessageID      uuid.UUID
+	NodeID         storj.NodeID
+	AcknowledgedAt time.Time
+}
+
+// DB stores the node messages and their acknowledgments.
+//
+// architecture: Database
+type DB interface {
+	// Insert stores the message with its recipients.
+	Insert(ctx context.Context, message Message) error
+	// Get returns the message with its recipients.
+	Get(ctx context.Context, id uuid.UUID) (Message, error)
+	// List returns at most limit messages without their recipients, the newest first.
+	List(ctx context.Context, limit int) ([]Message, error)
+	// Delete deletes the message with its recipients and acknowledgments.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListForNode returns the messages sent to the node, which haven't expired at now, the
+	// newest first.
+	ListForNode(ctx context.Context, nodeID storj.NodeID, now time.Time) ([]NodeMessage, error)
+	// Acknowledge records the acknowledgment of the message sent to the node. It returns the
+	// time of the first acknowledgment, when the node already acknowledged the message.
+	Acknowledge(ctx context.Context, id uuid.UUID, nodeID storj.NodeID, now time.Time) (acknowledgedAt time.Time, err error)
+	// ListAcknowledgments returns the acknowledgments of the message, the oldest first.
+	ListAcknowledgments(ctx context.Context, id uuid.UUID) ([]Acknowledgment, error)
+}
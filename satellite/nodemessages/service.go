@@ -0,0 +1,185 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodemessages
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+)
+
+// NewMessage contains the fields of a message to publish.
+type NewMessage struct {
+	Kind  Kind
+	Title string
+	Body  string
+	// Recipients are the nodes the message is sent to, or all nodes when empty.
+	Recipients []storj.NodeID
+	ExpiresAt  *time.Time
+}
+
+// Service publishes the messages to the storage node operators and tracks their
+// acknowledgments.
+//
+// architecture: Service
+type Service struct {
+	log    *zap.Logger
+	db     DB
+	config Config
+
+	nowFn func() time.Time
+}
+
+// NewService creates a new node messages service.
+func NewService(log *zap.Logger, db DB, config Config) *Service {
+	return &Service{
+		log:    log,
+		db:     db,
+		config: config,
+
+		nowFn: time.Now,
+	}
+}
+
+// TestSetNow allows tests to have the service act as if the current time is
+// whatever they want.
+func (service *Service) TestSetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
+// Publish publishes a message, which the nodes receive during their next check-in.
+func (service *Service) Publish(ctx context.Context, message NewMessage) (_ Message, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if _, err := ParseKind(string(message.Kind)); err != nil {
+		return Message{}, err
+	}
+	if err := service.validateText("title", message.Title, service.config.MaxTitleLength); err != nil {
+		return Message{}, err
+	}
+	if err := service.validateText("body", message.Body, service.config.MaxBodyLength); err != nil {
+		return Message{}, err
+	}
+
+	now := service.nowFn()
+	if message.ExpiresAt != nil && !message.ExpiresAt.After(now) {
+		return Message{}, ErrInvalid.New("message already expired")
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return Message{}, Error.Wrap(err)
+	}
+
+	published := Message{
+		ID:         id,
+		Kind:       message.Kind,
+		Title:      message.Title,
+		Body:       message.Body,
+		Recipients: dedupNodeIDs(message.Recipients),
+		ExpiresAt:  message.ExpiresAt,
+		CreatedAt:  now,
+	}
+	if err := service.db.Insert(ctx, published); err != nil {
+		return Message{}, Error.Wrap(err)
+	}
+
+	service.log.Info("node message published",
+		zap.Stringer("ID", published.ID),
+		zap.String("Kind", string(published.Kind)),
+		zap.Int("Recipients", len(published.Recipients)))
+	return published, nil
+}
+
+// validateText checks the field of a message.
+func (service *Service) validateText(field, value string, maxLength int) error {
+	if value == "" {
+		return ErrInvalid.New("missing %s", field)
+	}
+	if !utf8.ValidString(value) {
+		return ErrInvalid.New("%s is not valid utf-8", field)
+	}
+	if len(value) > maxLength {
+		return ErrInvalid.New("%s is longer than %d bytes", field, maxLength)
+	}
+	return nil
+}
+
+// Get returns the message with its acknowledgments.
+func (service *Service) Get(ctx context.Context, id uuid.UUID) (_ Message, _ []Acknowledgment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	message, err := service.db.Get(ctx, id)
+	if err != nil {
+		return Message{}, nil, err
+	}
+
+	acknowledgments, err := service.db.ListAcknowledgments(ctx, id)
+	if err != nil {
+		return Message{}, nil, Error.Wrap(err)
+	}
+	message.Acknowledgments = len(acknowledgments)
+
+	return message, acknowledgments, nil
+}
+
+// List returns at most limit messages, the newest first.
+func (service *Service) List(ctx context.Context, limit int) (_ []Message, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	messages, err := service.db.List(ctx, limit)
+	return messages, Error.Wrap(err)
+}
+
+// Delete withdraws the message, so it isn't sent to the nodes anymore.
+func (service *Service) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := service.db.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	service.log.Info("node message deleted", zap.Stringer("ID", id))
+	return nil
+}
+
+// ListForNode returns the current messages sent to the node, the newest first.
+func (service *Service) ListForNode(ctx context.Context, nodeID storj.NodeID) (_ []NodeMessage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	messages, err := service.db.ListForNode(ctx, nodeID, service.nowFn())
+	return messages, Error.Wrap(err)
+}
+
+// Acknowledge records that the operator of the node acknowledged the message.
+func (service *Service) Acknowledge(ctx context.Context, id uuid.UUID, nodeID storj.NodeID) (acknowledgedAt time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	acknowledgedAt, err = service.db.Acknowledge(ctx, id, nodeID, service.nowFn())
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	mon.Meter("node_message_acknowledged").Mark(1)
+	return acknowledgedAt, nil
+}
+
+// dedupNodeIDs returns the node IDs without the duplicates.
+func dedupNodeIDs(nodeIDs []storj.NodeID) []storj.NodeID {
+	seen := make(map[storj.NodeID]struct{}, len(nodeIDs))
+	unique := make([]storj.NodeID, 0, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		if _, ok := seen[nodeID]; ok {
+			continue
+		}
+		seen[nodeID] = struct{}{}
+		unique = append(unique, nodeID)
+	}
+	return unique
+}
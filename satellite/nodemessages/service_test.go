@@ -0,0 +1,256 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodemessages_test
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/nodemessages"
+)
+
+func TestService(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	config := nodemessages.Config{
+		MaxTitleLength: 16,
+		MaxBodyLength:  64,
+	}
+	service := nodemessages.NewService(zaptest.NewLogger(t), newMessagesDB(), config)
+	service.TestSetNow(func() time.Time { return now })
+
+	nodeA, nodeB := testrand.NodeID(), testrand.NodeID()
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := service.Publish(ctx, nodemessages.NewMessage{Kind: "unknown", Title: "title", Body: "body"})
+		require.True(t, nodemessages.ErrInvalid.Has(err))
+
+		_, err = service.Publish(ctx, nodemessages.NewMessage{Kind: nodemessages.KindInfo, Body: "body"})
+		require.True(t, nodemessages.ErrInvalid.Has(err))
+
+		_, err = service.Publish(ctx, nodemessages.NewMessage{Kind: nodemessages.KindInfo, Title: "this title is too long", Body: "body"})
+		require.True(t, nodemessages.ErrInvalid.Has(err))
+
+		expired := now.Add(-time.Hour)
+		_, err = service.Publish(ctx, nodemessages.NewMessage{Kind: nodemessages.KindInfo, Title: "title", Body: "body", ExpiresAt: &expired})
+		require.True(t, nodemessages.ErrInvalid.Has(err))
+	})
+
+	broadcast, err := service.Publish(ctx, nodemessages.NewMessage{
+		Kind:  nodemessages.KindPolicy,
+		Title: "new policy",
+		Body:  "the policy changes",
+	})
+	require.NoError(t, err)
+	require.Empty(t, broadcast.Recipients)
+
+	expiresAt := now.Add(24 * time.Hour)
+	targeted, err := service.Publish(ctx, nodemessages.NewMessage{
+		Kind:       nodemessages.KindDeprecation,
+		Title:      "update",
+		Body:       "your version is deprecated",
+		Recipients: []storj.NodeID{nodeA, nodeA},
+		ExpiresAt:  &expiresAt,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []storj.NodeID{nodeA}, targeted.Recipients)
+
+	t.Run("targeted", func(t *testing.T) {
+		messages, err := service.ListForNode(ctx, nodeA)
+		require.NoError(t, err)
+		require.Len(t, messages, 2)
+
+		messages, err = service.ListForNode(ctx, nodeB)
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		require.Equal(t, broadcast.ID, messages[0].ID)
+
+		_, err = service.Acknowledge(ctx, targeted.ID, nodeB)
+		require.True(t, nodemessages.ErrNotFound.Has(err))
+	})
+
+	t.Run("acknowledge", func(t *testing.T) {
+		acknowledgedAt, err := service.Acknowledge(ctx, targeted.ID, nodeA)
+		require.NoError(t, err)
+		require.Equal(t, now, acknowledgedAt)
+
+		// the first acknowledgment is kept.
+		service.TestSetNow(func() time.Time { return now.Add(time.Hour) })
+		acknowledgedAt, err = service.Acknowledge(ctx, targeted.ID, nodeA)
+		require.NoError(t, err)
+		require.Equal(t, now, acknowledgedAt)
+
+		message, acknowledgments, err := service.Get(ctx, targeted.ID)
+		require.NoError(t, err)
+		require.Equal(t, 1, message.Acknowledgments)
+		require.Equal(t, []nodemessages.Acknowledgment{{MessageID: targeted.ID, NodeID: nodeA, AcknowledgedAt: now}}, acknowledgments)
+
+		messages, err := service.ListForNode(ctx, nodeA)
+		require.NoError(t, err)
+		for _, message := range messages {
+			if message.ID == targeted.ID {
+				require.NotNil(t, message.AcknowledgedAt)
+			} else {
+				require.Nil(t, message.AcknowledgedAt)
+			}
+		}
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		service.TestSetNow(func() time.Time { return expiresAt })
+		messages, err := service.ListForNode(ctx, nodeA)
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		require.Equal(t, broadcast.ID, messages[0].ID)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, service.Delete(ctx, broadcast.ID))
+		require.True(t, nodemessages.ErrNotFound.Has(service.Delete(ctx, broadcast.ID)))
+
+		messages, err := service.ListForNode(ctx, nodeB)
+		require.NoError(t, err)
+		require.Empty(t, messages)
+
+		list, err := service.List(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, targeted.ID, list[0].ID)
+	})
+}
+
+type messagesDB struct {
+	mu              sync.Mutex
+	messages        map[uuid.UUID]nodemessages.Message
+	acknowledgments map[uuid.UUID][]nodemessages.Acknowledgment
+}
+
+func newMessagesDB() *messagesDB {
+	return &messagesDB{
+		messages:        map[uuid.UUID]nodemessages.Message{},
+		acknowledgments: map[uuid.UUID][]nodemessages.Acknowledgment{},
+	}
+}
+
+func (db *messagesDB) Insert(ctx context.Context, message nodemessages.Message) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.messages[message.ID] = message
+	return nil
+}
+
+func (db *messagesDB) Get(ctx context.Context, id uuid.UUID) (nodemessages.Message, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	message, ok := db.messages[id]
+	if !ok {
+		return nodemessages.Message{}, nodemessages.ErrNotFound.New("%s", id)
+	}
+	message.Acknowledgments = len(db.acknowledgments[id])
+	return message, nil
+}
+
+func (db *messagesDB) List(ctx context.Context, limit int) ([]nodemessages.Message, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	var list []nodemessages.Message
+	for _, message := range db.messages {
+		message.Recipients = nil
+		message.Acknowledgments = len(db.acknowledgments[message.ID])
+		list = append(list, message)
+	}
+	sort.Slice(list, func(i, k int) bool { return list[i].CreatedAt.After(list[k].CreatedAt) })
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
+
+func (db *messagesDB) Delete(ctx context.Context, id uuid.UUID) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if _, ok := db.messages[id]; !ok {
+		return nodemessages.ErrNotFound.New("%s", id)
+	}
+	delete(db.messages, id)
+	delete(db.acknowledgments, id)
+	return nil
+}
+
+func (db *messagesDB) ListForNode(ctx context.Context, nodeID storj.NodeID, now time.Time) ([]nodemessages.NodeMessage, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	var list []nodemessages.NodeMessage
+	for _, message := range db.messages {
+		if !sentTo(message, nodeID) || (message.ExpiresAt != nil && !message.ExpiresAt.After(now)) {
+			continue
+		}
+		nodeMessage := nodemessages.NodeMessage{
+			ID:        message.ID,
+			Kind:      message.Kind,
+			Title:     message.Title,
+			Body:      message.Body,
+			ExpiresAt: message.ExpiresAt,
+			CreatedAt: message.CreatedAt,
+		}
+		for _, acknowledgment := range db.acknowledgments[message.ID] {
+			if acknowledgment.NodeID == nodeID {
+				acknowledgedAt := acknowledgment.AcknowledgedAt
+				nodeMessage.AcknowledgedAt = &acknowledgedAt
+			}
+		}
+		list = append(list, nodeMessage)
+	}
+	sort.Slice(list, func(i, k int) bool { return list[i].CreatedAt.After(list[k].CreatedAt) })
+	return list, nil
+}
+
+func (db *messagesDB) Acknowledge(ctx context.Context, id uuid.UUID, nodeID storj.NodeID, now time.Time) (time.Time, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	message, ok := db.messages[id]
+	if !ok || !sentTo(message, nodeID) {
+		return time.Time{}, nodemessages.ErrNotFound.New("%s", id)
+	}
+	for _, acknowledgment := range db.acknowledgments[id] {
+		if acknowledgment.NodeID == nodeID {
+			return acknowledgment.AcknowledgedAt, nil
+		}
+	}
+	db.acknowledgments[id] = append(db.acknowledgments[id], nodemessages.Acknowledgment{
+		MessageID:      id,
+		NodeID:         nodeID,
+		AcknowledgedAt: now,
+	})
+	return now, nil
+}
+
+func (db *messagesDB) ListAcknowledgments(ctx context.Context, id uuid.UUID) ([]nodemessages.Acknowledgment, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return append([]nodemessages.Acknowledgment(nil), db.acknowledgments[id]...), nil
+}
+
+func sentTo(message nodemessages.Message, nodeID storj.NodeID) bool {
+	if len(message.Recipients) == 0 {
+		return true
+	}
+	for _, recipient := range message.Recipients {
+		if recipient == nodeID {
+			return true
+		}
+	}
+	return false
+}
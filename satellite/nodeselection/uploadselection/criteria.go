@@ -14,6 +14,7 @@ type Criteria struct {
 	AutoExcludeSubnets   map[string]struct{} // initialize it with empty map to keep only one node per subnet.
 	Placement            storj.PlacementConstraint
 	ExcludedCountryCodes []location.CountryCode
+	PieceSize            int64 // the size of the uploaded pieces, or 0 when unknown.
 }
 
 // MatchInclude returns with true if node is selected.
@@ -26,6 +27,10 @@ func (c *Criteria) MatchInclude(node *Node) bool {
 		return false
 	}
 
+	if c.PieceSize > 0 && node.MaxPieceSize > 0 && c.PieceSize > node.MaxPieceSize {
+		return false
+	}
+
 	if c.AutoExcludeSubnets != nil {
 		if _, excluded := c.AutoExcludeSubnets[node.LastNet]; excluded {
 			return false
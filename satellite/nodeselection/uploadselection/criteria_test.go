@@ -138,3 +138,16 @@ func TestCriteria_Geofencing(t *testing.T) {
 		})
 	}
 }
+
+func TestCriteria_PieceSize(t *testing.T) {
+	criteria := Criteria{
+		PieceSize: 1024,
+	}
+
+	assert.True(t, criteria.MatchInclude(&Node{}))
+	assert.True(t, criteria.MatchInclude(&Node{MaxPieceSize: 1024}))
+	assert.False(t, criteria.MatchInclude(&Node{MaxPieceSize: 1023}))
+
+	unknown := Criteria{}
+	assert.True(t, unknown.MatchInclude(&Node{MaxPieceSize: 1023}))
+}
@@ -14,14 +14,20 @@ type Node struct {
 	LastNet     string
 	LastIPPort  string
 	CountryCode location.CountryCode
+	// MaxPieceSize is the size of the largest piece the node accepts, or 0 without a limit.
+	MaxPieceSize int64
+	// NearlyFull nodes are selected only when there aren't enough other nodes.
+	NearlyFull bool
 }
 
 // Clone returns a deep clone of the selected node.
 func (node *Node) Clone() *Node {
 	return &Node{
-		NodeURL:     node.NodeURL,
-		LastNet:     node.LastNet,
-		LastIPPort:  node.LastIPPort,
-		CountryCode: node.CountryCode,
+		NodeURL:      node.NodeURL,
+		LastNet:      node.LastNet,
+		LastIPPort:   node.LastIPPort,
+		CountryCode:  node.CountryCode,
+		MaxPieceSize: node.MaxPieceSize,
+		NearlyFull:   node.NearlyFull,
 	}
 }
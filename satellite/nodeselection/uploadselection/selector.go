@@ -21,21 +21,12 @@ func (nodes SelectByID) Select(n int, criteria Criteria) []*Node {
 		return nil
 	}
 
-	selected := []*Node{}
+	candidates := make([]*Node, 0, len(nodes))
 	for _, idx := range mathrand.Perm(len(nodes)) {
-		node := nodes[idx]
-
-		if !criteria.MatchInclude(node) {
-			continue
-		}
-
-		selected = append(selected, node.Clone())
-		if len(selected) >= n {
-			break
-		}
+		candidates = append(candidates, nodes[idx])
 	}
 
-	return selected
+	return selectPreferringSpace(n, criteria, candidates)
 }
 
 // SelectBySubnet implements selection from nodes with every subnet having equal probability.
@@ -76,11 +67,37 @@ func (subnets SelectBySubnet) Select(n int, criteria Criteria) []*Node {
 		return nil
 	}
 
-	selected := []*Node{}
+	candidates := make([]*Node, 0, len(subnets))
 	for _, idx := range mathrand.Perm(len(subnets)) {
 		subnet := subnets[idx]
-		node := subnet.Nodes[mathrand.Intn(len(subnet.Nodes))]
+		candidates = append(candidates, subnet.Nodes[mathrand.Intn(len(subnet.Nodes))])
+	}
+
+	return selectPreferringSpace(n, criteria, candidates)
+}
+
+// selectPreferringSpace selects upto n nodes from the candidates in their order.
+// The nearly full nodes are selected only when there aren't enough other nodes.
+func selectPreferringSpace(n int, criteria Criteria, candidates []*Node) []*Node {
+	selected := []*Node{}
+	var nearlyFull []*Node
+	for _, node := range candidates {
+		if node.NearlyFull {
+			nearlyFull = append(nearlyFull, node)
+			continue
+		}
+
+		if !criteria.MatchInclude(node) {
+			continue
+		}
+
+		selected = append(selected, node.Clone())
+		if len(selected) >= n {
+			return selected
+		}
+	}
 
+	for _, node := range nearlyFull {
 		if !criteria.MatchInclude(node) {
 			continue
 		}
@@ -4,6 +4,7 @@
 package uploadselection_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -293,3 +294,53 @@ func TestSelectFiltered(t *testing.T) {
 		AutoExcludeSubnets: map[string]struct{}{},
 	}), 1)
 }
+
+func TestSelectNearlyFull(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	var nodes []*uploadselection.Node
+	for i := 0; i < 4; i++ {
+		lastNet := fmt.Sprintf("1.0.%d", i)
+		nodes = append(nodes, &uploadselection.Node{
+			NodeURL: storj.NodeURL{
+				ID:      testrand.NodeID(),
+				Address: lastNet + ".5:8080",
+			},
+			LastNet:    lastNet,
+			LastIPPort: lastNet + ".5:8080",
+			NearlyFull: i >= 2,
+		})
+	}
+
+	for _, selector := range []uploadselection.Selector{
+		uploadselection.SelectByID(nodes),
+		uploadselection.SelectBySubnetFromNodes(nodes),
+	} {
+		for i := 0; i < 100; i++ {
+			// nearly full nodes are avoided when there are enough other nodes
+			selected := selector.Select(2, uploadselection.Criteria{})
+			require.Len(t, selected, 2)
+			for _, node := range selected {
+				require.False(t, node.NearlyFull)
+			}
+
+			// nearly full nodes are used when there aren't enough other nodes
+			selected = selector.Select(3, uploadselection.Criteria{})
+			require.Len(t, selected, 3)
+			var nearlyFull int
+			for _, node := range selected {
+				if node.NearlyFull {
+					nearlyFull++
+				}
+			}
+			require.Equal(t, 1, nearlyFull)
+		}
+
+		// nearly full nodes still have to match the criteria
+		selected := selector.Select(4, uploadselection.Criteria{
+			ExcludeNodeIDs: []storj.NodeID{nodes[3].ID},
+		})
+		require.Len(t, selected, 3)
+	}
+}
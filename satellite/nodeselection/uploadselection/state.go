@@ -5,8 +5,10 @@ package uploadselection
 
 import (
 	"context"
+	"strconv"
 	"sync"
 
+	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
 
 	"storj.io/common/storj"
@@ -24,12 +26,34 @@ type State struct {
 	// netByID returns subnet based on storj.NodeID
 	netByID map[storj.NodeID]string
 	// distinct contains selectors for distinct selection.
-	distinct struct {
-		Reputable SelectBySubnet
-		New       SelectBySubnet
+	distinct pool
+	// pools contains the selectors of the nodes allowed by the placements, so
+	// the placements don't need to be evaluated over all nodes on every selection.
+	pools map[storj.PlacementConstraint]pool
+	// subnets contains the nodes of the subnets allowed by the placements, which
+	// are reused by the next state for the subnets, which didn't change.
+	subnets struct {
+		Reputable map[string]*subnetPlacements
+		New       map[string]*subnetPlacements
 	}
 }
 
+// pool contains the selectors of the subnets.
+type pool struct {
+	Reputable SelectBySubnet
+	New       SelectBySubnet
+}
+
+// subnetPlacements contains the nodes of a subnet and the nodes allowed by the pooled placements.
+type subnetPlacements struct {
+	nodes   []*Node
+	allowed map[storj.PlacementConstraint][]*Node
+}
+
+// pooledPlacements are the placements, for which the pools are precomputed.
+// The nodes for the other placements are filtered during the selection.
+var pooledPlacements = []storj.PlacementConstraint{storj.EU, storj.EEA, storj.US, storj.DE}
+
 // Stats contains state information.
 type Stats struct {
 	New       int
@@ -47,6 +71,17 @@ type Selector interface {
 
 // NewState returns a state based on the input.
 func NewState(reputableNodes, newNodes []*Node) *State {
+	return newState(nil, reputableNodes, newNodes)
+}
+
+// Refresh returns a new state based on the input. The placements are evaluated
+// only over the nodes of the subnets, which changed since the state was created,
+// the other subnets reuse their nodes allowed by the placements.
+func (state *State) Refresh(reputableNodes, newNodes []*Node) *State {
+	return newState(state, reputableNodes, newNodes)
+}
+
+func newState(previous *State, reputableNodes, newNodes []*Node) *State {
 	state := &State{}
 
 	state.netByID = map[storj.NodeID]string{}
@@ -60,6 +95,31 @@ func NewState(reputableNodes, newNodes []*Node) *State {
 	state.distinct.Reputable = SelectBySubnetFromNodes(reputableNodes)
 	state.distinct.New = SelectBySubnetFromNodes(newNodes)
 
+	var previousReputable, previousNew map[string]*subnetPlacements
+	if previous != nil {
+		previousReputable, previousNew = previous.subnets.Reputable, previous.subnets.New
+	}
+
+	var reputablePools, newPools map[storj.PlacementConstraint]SelectBySubnet
+	var reusedReputable, reusedNew int
+	state.subnets.Reputable, reputablePools, reusedReputable = placementPools(previousReputable, state.distinct.Reputable)
+	state.subnets.New, newPools, reusedNew = placementPools(previousNew, state.distinct.New)
+
+	mon.IntVal("selection_pool_reused_subnets").Observe(int64(reusedReputable + reusedNew))
+
+	state.pools = make(map[storj.PlacementConstraint]pool, len(pooledPlacements))
+	for _, placement := range pooledPlacements {
+		placementPool := pool{
+			Reputable: reputablePools[placement],
+			New:       newPools[placement],
+		}
+		state.pools[placement] = placementPool
+
+		tag := monkit.NewSeriesTag("placement", strconv.Itoa(int(placement)))
+		mon.IntVal("selection_pool_reputable_subnets", tag).Observe(int64(placementPool.Reputable.Count()))
+		mon.IntVal("selection_pool_new_subnets", tag).Observe(int64(placementPool.New.Count()))
+	}
+
 	state.stats = Stats{
 		New:       state.distinct.New.Count(),
 		Reputable: state.distinct.Reputable.Count(),
@@ -68,6 +128,55 @@ func NewState(reputableNodes, newNodes []*Node) *State {
 	return state
 }
 
+// placementPools returns the subnets with the nodes allowed by the pooled placements and
+// the selectors of the placements. The subnets, which have the same nodes as in the
+// previous state, reuse the nodes allowed by the placements.
+func placementPools(previous map[string]*subnetPlacements, subnets SelectBySubnet) (_ map[string]*subnetPlacements, pools map[storj.PlacementConstraint]SelectBySubnet, reused int) {
+	current := make(map[string]*subnetPlacements, len(subnets))
+	pools = make(map[storj.PlacementConstraint]SelectBySubnet, len(pooledPlacements))
+
+	for _, subnet := range subnets {
+		placements, ok := previous[subnet.Net]
+		if ok && sameNodes(placements.nodes, subnet.Nodes) {
+			reused++
+		} else {
+			placements = &subnetPlacements{
+				nodes:   subnet.Nodes,
+				allowed: make(map[storj.PlacementConstraint][]*Node, len(pooledPlacements)),
+			}
+			for _, placement := range pooledPlacements {
+				for _, node := range subnet.Nodes {
+					if placement.AllowedCountry(node.CountryCode) {
+						placements.allowed[placement] = append(placements.allowed[placement], node)
+					}
+				}
+			}
+		}
+		current[subnet.Net] = placements
+
+		for _, placement := range pooledPlacements {
+			if nodes := placements.allowed[placement]; len(nodes) > 0 {
+				pools[placement] = append(pools[placement], Subnet{Net: subnet.Net, Nodes: nodes})
+			}
+		}
+	}
+
+	return current, pools, reused
+}
+
+// sameNodes returns whether the nodes are the same in the same order.
+func sameNodes(a, b []*Node) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if *a[i] != *b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 // Request contains arguments for State.Request.
 type Request struct {
 	Count                int
@@ -75,6 +184,7 @@ type Request struct {
 	ExcludedIDs          []storj.NodeID
 	Placement            storj.PlacementConstraint
 	ExcludedCountryCodes []string
+	PieceSize            int64
 }
 
 // Select selects requestedCount nodes where there will be newFraction nodes.
@@ -103,6 +213,7 @@ func (state *State) Select(ctx context.Context, request Request) (_ []*Node, err
 	}
 
 	criteria.Placement = request.Placement
+	criteria.PieceSize = request.PieceSize
 
 	criteria.AutoExcludeSubnets = make(map[string]struct{})
 	for _, id := range request.ExcludedIDs {
@@ -110,8 +221,12 @@ func (state *State) Select(ctx context.Context, request Request) (_ []*Node, err
 			criteria.AutoExcludeSubnets[net] = struct{}{}
 		}
 	}
-	reputableNodes = state.distinct.Reputable
-	newNodes = state.distinct.New
+	selectors := state.distinct
+	if placementPool, ok := state.pools[request.Placement]; ok {
+		selectors = placementPool
+	}
+	reputableNodes = selectors.Reputable
+	newNodes = selectors.New
 
 	// Get a random selection of new nodes out of the cache first so that if there aren't
 	// enough new nodes on the network, we can fall back to using reputable nodes instead.
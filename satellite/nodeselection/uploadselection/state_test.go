@@ -11,6 +11,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/common/storj"
+	"storj.io/common/storj/location"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/satellite/nodeselection/uploadselection"
@@ -171,6 +172,132 @@ func TestState_Select_Concurrent(t *testing.T) {
 	require.NoError(t, group.Wait())
 }
 
+func TestState_SelectPlacement(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	withCountry := func(nodes []*uploadselection.Node, code location.CountryCode) []*uploadselection.Node {
+		for _, node := range nodes {
+			node.CountryCode = code
+		}
+		return nodes
+	}
+
+	germanNodes := withCountry(createRandomNodes(3, "1.0.1", false), location.Germany)
+	reputableNodes := joinNodes(
+		germanNodes,
+		withCountry(createRandomNodes(5, "1.0.2", false), location.UnitedStates),
+		// the subnet is shared by nodes in and out of the placement.
+		withCountry(createRandomNodes(2, "1.0.3", true), location.Germany),
+		withCountry(createRandomNodes(2, "1.0.3", true), location.UnitedStates),
+	)
+	newNodes := withCountry(createRandomNodes(2, "1.0.4", false), location.France)
+
+	state := uploadselection.NewState(reputableNodes, newNodes)
+
+	for i := 0; i < 10; i++ {
+		selected, err := state.Select(ctx, uploadselection.Request{
+			Count:     4,
+			Placement: storj.DE,
+		})
+		require.NoError(t, err)
+		require.Len(t, selected, 4)
+		for _, node := range selected {
+			require.Equal(t, location.Germany, node.CountryCode)
+		}
+	}
+
+	// the new nodes are only in the EU.
+	selected, err := state.Select(ctx, uploadselection.Request{
+		Count:       4,
+		NewFraction: 0.5,
+		Placement:   storj.EU,
+	})
+	require.NoError(t, err)
+	require.Len(t, intersectLists(selected, newNodes), 2)
+
+	_, err = state.Select(ctx, uploadselection.Request{
+		Count:       1,
+		NewFraction: 1,
+		Placement:   storj.US,
+	})
+	require.NoError(t, err)
+
+	_, err = state.Select(ctx, uploadselection.Request{
+		Count:     5,
+		Placement: storj.DE,
+	})
+	require.True(t, uploadselection.ErrNotEnoughNodes.Has(err))
+
+	// the placements without a pool are filtered during the selection.
+	_, err = state.Select(ctx, uploadselection.Request{
+		Count:     1,
+		Placement: storj.InvalidPlacement,
+	})
+	require.True(t, uploadselection.ErrNotEnoughNodes.Has(err))
+}
+
+func TestState_RefreshPlacement(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	withCountry := func(nodes []*uploadselection.Node, code location.CountryCode) []*uploadselection.Node {
+		for _, node := range nodes {
+			node.CountryCode = code
+		}
+		return nodes
+	}
+	clone := func(nodes []*uploadselection.Node) []*uploadselection.Node {
+		var xs []*uploadselection.Node
+		for _, node := range nodes {
+			xs = append(xs, node.Clone())
+		}
+		return xs
+	}
+
+	germanNodes := withCountry(createRandomNodes(2, "1.0.1", false), location.Germany)
+	movedNodes := withCountry(createRandomNodes(2, "1.0.2", true), location.UnitedStates)
+
+	state := uploadselection.NewState(joinNodes(germanNodes, movedNodes), nil)
+
+	_, err := state.Select(ctx, uploadselection.Request{
+		Count:     3,
+		Placement: storj.DE,
+	})
+	require.True(t, uploadselection.ErrNotEnoughNodes.Has(err))
+
+	// the unchanged subnets reuse their pools, the changed subnet is evaluated again.
+	movedNodes = withCountry(clone(movedNodes), location.Germany)
+	addedNodes := withCountry(createRandomNodes(1, "1.0.3", false), location.Germany)
+	refreshed := state.Refresh(joinNodes(clone(germanNodes), movedNodes, addedNodes), nil)
+
+	for i := 0; i < 10; i++ {
+		selected, err := refreshed.Select(ctx, uploadselection.Request{
+			Count:     4,
+			Placement: storj.DE,
+		})
+		require.NoError(t, err)
+		require.Len(t, selected, 4)
+		require.Len(t, intersectLists(selected, movedNodes), 1)
+		require.Len(t, intersectLists(selected, addedNodes), 1)
+	}
+
+	// the removed nodes are not selected.
+	refreshed = refreshed.Refresh(clone(germanNodes), nil)
+	_, err = refreshed.Select(ctx, uploadselection.Request{
+		Count:     3,
+		Placement: storj.DE,
+	})
+	require.True(t, uploadselection.ErrNotEnoughNodes.Has(err))
+
+	// the previous state isn't changed by the refresh.
+	_, err = state.Select(ctx, uploadselection.Request{
+		Count:     2,
+		Placement: storj.DE,
+	})
+	require.NoError(t, err)
+}
+
 // createRandomNodes creates n random nodes all in the subnet.
 func createRandomNodes(n int, subnet string, shareNets bool) []*uploadselection.Node {
 	xs := make([]*uploadselection.Node, n)
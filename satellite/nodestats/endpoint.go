@@ -12,7 +12,9 @@ import (
 	"storj.io/common/identity"
 	"storj.io/common/pb"
 	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/private/containmentpb"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/payments/paymentsconfig"
 	"storj.io/storj/satellite/reputation"
@@ -27,22 +29,25 @@ var (
 // architecture: Endpoint
 type Endpoint struct {
 	pb.DRPCNodeStatsUnimplementedServer
-
-	log        *zap.Logger
-	overlay    overlay.DB
-	reputation *reputation.Service
-	accounting accounting.StoragenodeAccounting
-	config     paymentsconfig.Config
+	containmentpb.DRPCNodeContainmentUnimplementedServer
+
+	log         *zap.Logger
+	overlay     overlay.DB
+	reputation  *reputation.Service
+	accounting  accounting.StoragenodeAccounting
+	containment audit.Containment
+	config      paymentsconfig.Config
 }
 
 // NewEndpoint creates new endpoint.
-func NewEndpoint(log *zap.Logger, overlay overlay.DB, reputation *reputation.Service, accounting accounting.StoragenodeAccounting, config paymentsconfig.Config) *Endpoint {
+func NewEndpoint(log *zap.Logger, overlay overlay.DB, reputation *reputation.Service, accounting accounting.StoragenodeAccounting, containment audit.Containment, config paymentsconfig.Config) *Endpoint {
 	return &Endpoint{
-		log:        log,
-		overlay:    overlay,
-		reputation: reputation,
-		accounting: accounting,
-		config:     config,
+		log:         log,
+		overlay:     overlay,
+		reputation:  reputation,
+		accounting:  accounting,
+		containment: containment,
+		config:      config,
 	}
 }
 
@@ -127,6 +132,28 @@ func (e *Endpoint) DailyStorageUsage(ctx context.Context, req *pb.DailyStorageUs
 	}, nil
 }
 
+// GetContainment returns the number of the pieces of the node awaiting a reverification
+// audit and the time the oldest of them was queued.
+func (e *Endpoint) GetContainment(ctx context.Context, req *containmentpb.GetContainmentRequest) (_ *containmentpb.GetContainmentResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+
+	status, err := e.containment.GetStatus(ctx, peer.ID)
+	if err != nil {
+		e.log.Error("containment.GetStatus failed", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return &containmentpb.GetContainmentResponse{
+		PendingCount:    status.PendingCount,
+		OldestPendingAt: status.OldestPendingAt,
+	}, nil
+}
+
 // PricingModel returns pricing model for storagenode.
 func (e *Endpoint) PricingModel(ctx context.Context, req *pb.PricingModelRequest) (_ *pb.PricingModelResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
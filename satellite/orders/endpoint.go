@@ -21,9 +21,12 @@ import (
 	"storj.io/common/signing"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/drpc/drpcmetadata"
+	"storj.io/storj/private/capabilitiespb"
 	"storj.io/storj/private/date"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/nodeapiversion"
+	"storj.io/storj/satellite/quarantine"
 )
 
 // DB implements saving order after receiving from storage node.
@@ -172,6 +175,8 @@ type Endpoint struct {
 	nodeAPIVersionDB nodeapiversion.DB
 	ordersSemaphore  chan struct{}
 	ordersService    *Service
+	outages          *OutageTracker
+	corruptions      *quarantine.Recorder
 }
 
 // NewEndpoint new orders receiving endpoint.
@@ -195,6 +200,17 @@ func NewEndpoint(log *zap.Logger, satelliteSignee signing.Signee, db DB, nodeAPI
 	}
 }
 
+// SetOutages enables accepting the orders, which expired during a downtime of the satellite,
+// when their settlement is flagged with the token of the downtime.
+func (endpoint *Endpoint) SetOutages(outages *OutageTracker) {
+	endpoint.outages = outages
+}
+
+// SetCorruptions sets the recorder of the anomalous orders, which may quarantine the nodes.
+func (endpoint *Endpoint) SetCorruptions(corruptions *quarantine.Recorder) {
+	endpoint.corruptions = corruptions
+}
+
 type bucketIDAction struct {
 	projectID  uuid.UUID
 	bucketname string
@@ -251,6 +267,8 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 	log := endpoint.log.Named(peer.ID.String())
 	log.Debug("SettlementWithWindow")
 
+	extensionToken := endpoint.extensionToken(ctx, log)
+
 	type bandwidthAmount struct {
 		Settled int64
 		Dead    int64
@@ -263,6 +281,7 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 	var window int64
 	var request *pb.SettlementRequest
 	var receivedCount int
+	var anomalies int
 	for {
 		request, err = stream.Recv()
 		if err != nil {
@@ -290,7 +309,11 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 		serialNum := order.SerialNumber
 
 		// don't process orders that aren't valid
-		if !endpoint.isValid(ctx, log, order, orderLimit, peer.ID, window) {
+		valid, anomalous := endpoint.isValid(ctx, log, order, orderLimit, peer.ID, window, extensionToken)
+		if anomalous {
+			anomalies++
+		}
+		if !valid {
 			continue
 		}
 
@@ -364,6 +387,11 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 		}
 	}
 
+	if anomalies > 0 {
+		log.Debug("anomalous orders submitted", zap.Int("count", anomalies))
+		endpoint.corruptions.RecordCounts(ctx, quarantine.SourceOrders, map[storj.NodeID]int{peer.ID: anomalies})
+	}
+
 	if len(storagenodeSettled) == 0 {
 		log.Debug("no orders were successfully processed", zap.Int("received count", receivedCount))
 		status = pb.SettlementWithWindowResponse_REJECTED
@@ -407,47 +435,74 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 	})
 }
 
+// extensionToken returns the token of the settlement extension, which flags the settlement.
+func (endpoint *Endpoint) extensionToken(ctx context.Context, log *zap.Logger) uuid.UUID {
+	if endpoint.outages == nil {
+		return uuid.UUID{}
+	}
+	metadata, ok := drpcmetadata.Get(ctx)
+	if !ok || metadata[capabilitiespb.SettlementExtensionKey] == "" {
+		return uuid.UUID{}
+	}
+	token, err := uuid.FromString(metadata[capabilitiespb.SettlementExtensionKey])
+	if err != nil {
+		log.Debug("invalid settlement extension token", zap.Error(err))
+		return uuid.UUID{}
+	}
+	return token
+}
+
+// isValid returns whether the order is valid. The order is anomalous, when an honest
+// storage node wouldn't submit it.
 func (endpoint *Endpoint) isValid(ctx context.Context, log *zap.Logger, order *pb.Order,
-	orderLimit *pb.OrderLimit, peerID storj.NodeID, window int64) bool {
+	orderLimit *pb.OrderLimit, peerID storj.NodeID, window int64, extensionToken uuid.UUID) (valid, anomalous bool) {
 	if orderLimit.StorageNodeId != peerID {
 		log.Debug("storage node id mismatch")
 		mon.Event("order_not_valid_storagenodeid")
-		return false
+		return false, true
 	}
 	// check expiration first before the signatures so that we can throw out the large amount
 	// of expired orders being sent to us before doing expensive signature verification.
-	if orderLimit.OrderExpiration.Before(time.Now().UTC()) {
-		log.Debug("invalid settlement: order limit expired")
-		mon.Event("order_not_valid_expired")
-		return false
+	if now := time.Now().UTC(); orderLimit.OrderExpiration.Before(now) {
+		// the orders, which expired while the satellite was down, are accepted late.
+		extension := endpoint.outages.Extension(extensionToken, orderLimit.OrderCreation, orderLimit.OrderExpiration)
+		if !orderLimit.OrderExpiration.Add(extension).After(now) {
+			log.Debug("invalid settlement: order limit expired")
+			mon.Event("order_not_valid_expired")
+			return false, false
+		}
+		log.Debug("accepting order limit expired during satellite downtime",
+			zap.Stringer("Token", extensionToken),
+			zap.Duration("Extension", extension))
+		mon.Event("order_accepted_late_outage")
 	}
 	// satellite verifies that it signed the order limit
 	if err := signing.VerifyOrderLimitSignature(ctx, endpoint.satelliteSignee, orderLimit); err != nil {
 		log.Debug("invalid settlement: unable to verify order limit")
 		mon.Event("order_not_valid_satellite_signature")
-		return false
+		return false, true
 	}
 	// satellite verifies that the order signature matches pub key in order limit
 	if err := signing.VerifyUplinkOrderSignature(ctx, orderLimit.UplinkPublicKey, order); err != nil {
 		log.Debug("invalid settlement: unable to verify order")
 		mon.Event("order_not_valid_uplink_signature")
-		return false
+		return false, true
 	}
 	if orderLimit.SerialNumber != order.SerialNumber {
 		log.Debug("invalid settlement: invalid serial number")
 		mon.Event("order_not_valid_serialnum_mismatch")
-		return false
+		return false, true
 	}
 	// verify the 1 hr windows match
 	if window != date.TruncateToHourInNano(orderLimit.OrderCreation) {
 		log.Debug("invalid settlement: window mismatch")
 		mon.Event("order_not_valid_window_mismatch")
-		return false
+		return false, false
 	}
 	if orderLimit.Limit < order.Amount {
 		log.Debug("invalid settlement: amounts mismatch")
 		mon.Event("order_not_valid_amounts_mismatch")
-		return false
+		return false, true
 	}
-	return true
+	return true, false
 }
@@ -0,0 +1,201 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package orders
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+)
+
+// apiHeartbeat is the name of the heartbeat of the api instances.
+const apiHeartbeat = "api"
+
+// SettlementExtensionConfig configures how the settlement of the orders is extended after a
+// downtime of the satellite.
+type SettlementExtensionConfig struct {
+	Enabled           bool          `help:"accept the orders, which expired while the satellite was down, for the duration of the downtime" default:"true"`
+	HeartbeatInterval time.Duration `help:"how often the api records that it's running, to detect the downtime of the satellite" default:"1m" testDefault:"$TESTINTERVAL"`
+	MinimumDowntime   time.Duration `help:"the shortest gap between the heartbeats, which is considered a downtime of the satellite" default:"1h"`
+	MaximumExtension  time.Duration `help:"the longest extension of the settlement of the orders after a downtime" default:"168h"`
+}
+
+// Outage is a downtime of the satellite, which is detected as a gap between the heartbeats
+// of the api instances.
+type Outage struct {
+	// Start is the time of the last heartbeat before the downtime.
+	Start time.Time
+	// End is the time of the first heartbeat after the downtime.
+	End time.Time
+	// Token identifies the extension of the settlement, which is announced to the nodes.
+	Token uuid.UUID
+}
+
+// Overlap returns how long the outage overlaps the period from start until end.
+func (outage Outage) Overlap(start, end time.Time) time.Duration {
+	if outage.Start.After(start) {
+		start = outage.Start
+	}
+	if outage.End.Before(end) {
+		end = outage.End
+	}
+	if !start.Before(end) {
+		return 0
+	}
+	return end.Sub(start)
+}
+
+// OutagesDB stores the heartbeats and the outages of the satellite.
+//
+// architecture: Database
+type OutagesDB interface {
+	// Heartbeat records that the satellite is running at now and returns the time of the
+	// previous heartbeat. It returns a zero time, when there wasn't any heartbeat yet.
+	Heartbeat(ctx context.Context, name string, now time.Time) (previous time.Time, err error)
+	// InsertOutage records an outage. An outage with the same start is ignored, since it
+	// was detected by another instance.
+	InsertOutage(ctx context.Context, outage Outage) error
+	// ListOutages returns the outages, which ended after the time, ordered by their start.
+	ListOutages(ctx context.Context, endedAfter time.Time) ([]Outage, error)
+}
+
+// OutageTracker detects the downtime of the satellite from the gaps between the heartbeats
+// of the api instances. The orders, which expired during a downtime, are accepted after
+// their expiration for the duration of the downtime, so the nodes don't lose the payouts
+// for the bandwidth they served before the satellite went down.
+//
+// architecture: Chore
+type OutageTracker struct {
+	log        *zap.Logger
+	db         OutagesDB
+	config     SettlementExtensionConfig
+	expiration time.Duration
+
+	mu      sync.RWMutex
+	outages []Outage
+
+	Loop *sync2.Cycle
+}
+
+// NewOutageTracker creates a new tracker of the outages. The expiration is the lifetime of
+// the issued order limits.
+func NewOutageTracker(log *zap.Logger, db OutagesDB, config SettlementExtensionConfig, expiration time.Duration) *OutageTracker {
+	return &OutageTracker{
+		log:        log,
+		db:         db,
+		config:     config,
+		expiration: expiration,
+		Loop:       sync2.NewCycle(config.HeartbeatInterval),
+	}
+}
+
+// Run records the heartbeats and loads the recent outages.
+func (tracker *OutageTracker) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return tracker.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := tracker.Heartbeat(ctx, time.Now()); err != nil {
+			tracker.log.Error("failed to record heartbeat", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Heartbeat records that the satellite is running at now. When the previous heartbeat is
+// older than the minimum downtime, the gap is recorded as an outage. Then the outages,
+// which can still extend the settlement of the orders, are loaded.
+func (tracker *OutageTracker) Heartbeat(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	previous, err := tracker.db.Heartbeat(ctx, apiHeartbeat, now)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if !previous.IsZero() && now.Sub(previous) >= tracker.config.MinimumDowntime {
+		token, err := uuid.New()
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		outage := Outage{Start: previous, End: now, Token: token}
+		if err := tracker.db.InsertOutage(ctx, outage); err != nil {
+			return Error.Wrap(err)
+		}
+		mon.Event("satellite_outage_detected")
+		tracker.log.Warn("satellite downtime detected, extending the settlement of the orders",
+			zap.Time("Start", outage.Start),
+			zap.Time("End", outage.End),
+			zap.Stringer("Token", outage.Token))
+	}
+
+	// an outage can extend the orders, which were valid during the outage, until the
+	// extension after their expiration.
+	outages, err := tracker.db.ListOutages(ctx, now.Add(-tracker.expiration-tracker.config.MaximumExtension))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	sort.Slice(outages, func(i, k int) bool { return outages[i].Start.Before(outages[k].Start) })
+
+	tracker.mu.Lock()
+	tracker.outages = outages
+	tracker.mu.Unlock()
+	return nil
+}
+
+// Outages returns the recent outages, which can still extend the settlement of the orders.
+func (tracker *OutageTracker) Outages() []Outage {
+	if tracker == nil {
+		return nil
+	}
+
+	tracker.mu.RLock()
+	defer tracker.mu.RUnlock()
+	return append([]Outage(nil), tracker.outages...)
+}
+
+// MaximumExtension returns the longest extension of the settlement of an order limit.
+func (tracker *OutageTracker) MaximumExtension() time.Duration {
+	return tracker.config.MaximumExtension
+}
+
+// Extension returns how long after its expiration an order limit, which was valid from
+// creation until expiration, is accepted when its settlement is flagged with the token. It's
+// the total downtime of the satellite while the order limit was valid, up to the maximum
+// extension. It's zero, when the token doesn't belong to any of these downtimes.
+func (tracker *OutageTracker) Extension(token uuid.UUID, creation, expiration time.Time) time.Duration {
+	if tracker == nil || token.IsZero() {
+		return 0
+	}
+
+	tracker.mu.RLock()
+	defer tracker.mu.RUnlock()
+
+	var extension time.Duration
+	var flagged bool
+	for _, outage := range tracker.outages {
+		if overlap := outage.Overlap(creation, expiration); overlap > 0 {
+			extension += overlap
+			flagged = flagged || outage.Token == token
+		}
+	}
+	if !flagged {
+		return 0
+	}
+	if extension > tracker.config.MaximumExtension {
+		extension = tracker.config.MaximumExtension
+	}
+	return extension
+}
+
+// Close stops the tracker.
+func (tracker *OutageTracker) Close() error {
+	tracker.Loop.Close()
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package orders_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/orders"
+)
+
+func TestOutageTracker(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	db := &outagesDB{}
+	config := orders.SettlementExtensionConfig{
+		Enabled:           true,
+		HeartbeatInterval: time.Minute,
+		MinimumDowntime:   time.Hour,
+		MaximumExtension:  5 * time.Hour,
+	}
+	tracker := orders.NewOutageTracker(zaptest.NewLogger(t), db, config, 24*time.Hour)
+
+	start := time.Now().Add(-72 * time.Hour)
+	require.NoError(t, tracker.Heartbeat(ctx, start))
+	require.NoError(t, tracker.Heartbeat(ctx, start.Add(time.Minute)))
+	require.Empty(t, tracker.Outages())
+
+	// the satellite was down for three hours.
+	restart := start.Add(3*time.Hour + time.Minute)
+	require.NoError(t, tracker.Heartbeat(ctx, restart))
+	outages := tracker.Outages()
+	require.Len(t, outages, 1)
+	require.True(t, outages[0].Start.Equal(start.Add(time.Minute)))
+	require.True(t, outages[0].End.Equal(restart))
+	require.False(t, outages[0].Token.IsZero())
+	token := outages[0].Token
+
+	// the order limits, which were valid during the downtime, are extended by the overlap.
+	creation := start.Add(-time.Hour)
+	require.Equal(t, 2*time.Hour-time.Minute, tracker.Extension(token, creation, start.Add(2*time.Hour)))
+	require.Equal(t, 3*time.Hour, tracker.Extension(token, creation, creation.Add(24*time.Hour)))
+	require.Zero(t, tracker.Extension(token, restart, restart.Add(24*time.Hour)))
+
+	// the settlement must be flagged with the token.
+	require.Zero(t, tracker.Extension(uuid.UUID{}, creation, creation.Add(24*time.Hour)))
+	require.Zero(t, tracker.Extension(uuid.UUID{1}, creation, creation.Add(24*time.Hour)))
+
+	// the total extension is limited.
+	next := restart.Add(time.Minute)
+	require.NoError(t, tracker.Heartbeat(ctx, next))
+	require.NoError(t, tracker.Heartbeat(ctx, next.Add(4*time.Hour)))
+	require.Len(t, tracker.Outages(), 2)
+	require.Equal(t, 5*time.Hour, tracker.Extension(token, creation, creation.Add(24*time.Hour)))
+
+	// the old outages can't extend the orders anymore, so only the latest one is kept.
+	latest := next.Add(4*time.Hour + 24*time.Hour + 5*time.Hour)
+	require.NoError(t, tracker.Heartbeat(ctx, latest))
+	outages = tracker.Outages()
+	require.Len(t, outages, 1)
+	require.True(t, outages[0].End.Equal(latest))
+}
+
+// outagesDB is an in-memory orders.OutagesDB.
+type outagesDB struct {
+	mu       sync.Mutex
+	lastBeat time.Time
+	outages  []orders.Outage
+}
+
+func (db *outagesDB) Heartbeat(ctx context.Context, name string, now time.Time) (time.Time, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	previous := db.lastBeat
+	if now.After(db.lastBeat) {
+		db.lastBeat = now
+	}
+	return previous, nil
+}
+
+func (db *outagesDB) InsertOutage(ctx context.Context, outage orders.Outage) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	for _, existing := range db.outages {
+		if existing.Start.Equal(outage.Start) {
+			return nil
+		}
+	}
+	db.outages = append(db.outages, outage)
+	return nil
+}
+
+func (db *outagesDB) ListOutages(ctx context.Context, endedAfter time.Time) ([]orders.Outage, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	var list []orders.Outage
+	for _, outage := range db.outages {
+		if outage.End.After(endedAfter) {
+			list = append(list, outage)
+		}
+	}
+	return list, nil
+}
@@ -0,0 +1,172 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package orders
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/pb"
+	"storj.io/common/uuid"
+)
+
+// ErrSpill is the error class of the rollups spill files.
+var ErrSpill = errs.Class("rollups spill")
+
+const (
+	// spillFilePattern matches the spill files in the spill directory.
+	spillFilePattern = "rollups-*.spill"
+
+	// spillRecordSize is the size of the fixed part of a spill record: the project id,
+	// the action, the interval start, and the allocated, inline, settled and dead amounts.
+	spillRecordSize = 16 + 4 + 8 + 4*8
+)
+
+// rollupsSpill is a write-ahead log of the rollups write cache. Every update of the cache
+// is appended to the current spill file before it's applied to the memory, so the updates,
+// which weren't flushed to the database yet, can be recovered after a crash.
+//
+// The spill file is rotated, when the cache is reset for a flush, and the rotated files are
+// removed right before the rollups are written to the database, so the rollups are never
+// written twice. The rollups of a failed flush are returned to the cache and appended to the
+// current spill file again. The spill files, which are left in the directory, are flushed to
+// the database on the next start.
+//
+// The records are written with a single write call, so they survive a crash of the process.
+// A record, which is cut by a crash of the host, is detected by its checksum and skipped.
+type rollupsSpill struct {
+	dir  string
+	seq  int
+	file *os.File
+	path string
+	size int64
+
+	// unflushed are the closed spill files, whose rollups weren't flushed yet.
+	unflushed []string
+}
+
+// newRollupsSpill creates the spill directory, when it doesn't exist.
+func newRollupsSpill(dir string) (*rollupsSpill, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, ErrSpill.Wrap(err)
+	}
+	return &rollupsSpill{dir: dir}, nil
+}
+
+// append appends the update of the rollup to the current spill file. The file is created
+// with the first update after a rotation.
+func (spill *rollupsSpill) append(key CacheKey, data CacheData) error {
+	if spill.file == nil {
+		spill.seq++
+		path := filepath.Join(spill.dir, fmt.Sprintf("rollups-%d-%d.spill", time.Now().UnixNano(), spill.seq))
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY|os.O_APPEND, 0600)
+		if err != nil {
+			return ErrSpill.Wrap(err)
+		}
+		spill.file, spill.path, spill.size = file, path, 0
+	}
+
+	record := encodeSpillRecord(key, data)
+	n, err := spill.file.Write(record)
+	spill.size += int64(n)
+	return ErrSpill.Wrap(err)
+}
+
+// rotate closes the current spill file and returns the paths of the spill files, which
+// contain the rollups of the cache, and the size of the current one. It returns no paths,
+// when there were no updates since the last rotation.
+func (spill *rollupsSpill) rotate() (paths []string, size int64, err error) {
+	paths, spill.unflushed = spill.unflushed, nil
+	if spill.file == nil {
+		return paths, 0, nil
+	}
+	paths, size = append(paths, spill.path), spill.size
+	err = spill.file.Close()
+	spill.file, spill.path, spill.size = nil, "", 0
+	return paths, size, ErrSpill.Wrap(err)
+}
+
+// keep keeps the closed spill files, whose rollups weren't flushed, for the next rotation.
+func (spill *rollupsSpill) keep(paths []string) {
+	spill.unflushed = append(spill.unflushed, paths...)
+}
+
+// files returns the spill files in the spill directory, the oldest first.
+func (spill *rollupsSpill) files() ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(spill.dir, spillFilePattern))
+	if err != nil {
+		return nil, ErrSpill.Wrap(err)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// encodeSpillRecord encodes the update of the rollup as the length of the record, the
+// record and its checksum.
+func encodeSpillRecord(key CacheKey, data CacheData) []byte {
+	size := spillRecordSize + len(key.BucketName)
+	record := make([]byte, 4+size+4)
+
+	binary.BigEndian.PutUint32(record, uint32(size))
+	body := record[4 : 4+size]
+	copy(body, key.ProjectID[:])
+	binary.BigEndian.PutUint32(body[16:], uint32(key.Action))
+	binary.BigEndian.PutUint64(body[20:], uint64(key.IntervalStart))
+	binary.BigEndian.PutUint64(body[28:], uint64(data.Allocated))
+	binary.BigEndian.PutUint64(body[36:], uint64(data.Inline))
+	binary.BigEndian.PutUint64(body[44:], uint64(data.Settled))
+	binary.BigEndian.PutUint64(body[52:], uint64(data.Dead))
+	copy(body[spillRecordSize:], key.BucketName)
+	binary.BigEndian.PutUint32(record[4+size:], crc32.ChecksumIEEE(body))
+
+	return record
+}
+
+// readSpillFile reads the updates of the spill file into the rollups. It returns whether
+// the file has a damaged tail, which was skipped.
+func readSpillFile(path string, rollups RollupData) (damaged bool, err error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return false, ErrSpill.Wrap(err)
+	}
+
+	for len(content) > 0 {
+		if len(content) < 4 {
+			return true, nil
+		}
+		size := int(binary.BigEndian.Uint32(content))
+		if size < spillRecordSize || len(content) < 4+size+4 {
+			return true, nil
+		}
+		body := content[4 : 4+size]
+		if crc32.ChecksumIEEE(body) != binary.BigEndian.Uint32(content[4+size:]) {
+			return true, nil
+		}
+		content = content[4+size+4:]
+
+		var projectID uuid.UUID
+		copy(projectID[:], body)
+		key := CacheKey{
+			ProjectID:     projectID,
+			BucketName:    string(body[spillRecordSize:]),
+			Action:        pb.PieceAction(binary.BigEndian.Uint32(body[16:])),
+			IntervalStart: int64(binary.BigEndian.Uint64(body[20:])),
+		}
+
+		data := rollups[key]
+		data.Allocated += int64(binary.BigEndian.Uint64(body[28:]))
+		data.Inline += int64(binary.BigEndian.Uint64(body[36:]))
+		data.Settled += int64(binary.BigEndian.Uint64(body[44:]))
+		data.Dead += int64(binary.BigEndian.Uint64(body[52:]))
+		rollups[key] = data
+	}
+	return false, nil
+}
@@ -0,0 +1,150 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package orders
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+)
+
+func TestRollupsSpill(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	spill, err := newRollupsSpill(ctx.Dir("spill"))
+	require.NoError(t, err)
+
+	key := CacheKey{
+		ProjectID:     testrand.UUID(),
+		BucketName:    "bucket",
+		Action:        pb.PieceAction_GET,
+		IntervalStart: time.Now().Truncate(time.Hour).Unix(),
+	}
+	other := key
+	other.Action = pb.PieceAction_PUT
+
+	require.NoError(t, spill.append(key, CacheData{Settled: 10, Inline: 1}))
+	require.NoError(t, spill.append(key, CacheData{Settled: 5, Dead: 2}))
+	require.NoError(t, spill.append(other, CacheData{Allocated: 7}))
+
+	paths, size, err := spill.rotate()
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+	path := paths[0]
+
+	stat, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, size, stat.Size())
+
+	// nothing to rotate without updates.
+	empty, _, err := spill.rotate()
+	require.NoError(t, err)
+	require.Empty(t, empty)
+
+	// the kept files are returned with the next rotation.
+	spill.keep(paths)
+	kept, _, err := spill.rotate()
+	require.NoError(t, err)
+	require.Equal(t, paths, kept)
+
+	rollups := make(RollupData)
+	damaged, err := readSpillFile(path, rollups)
+	require.NoError(t, err)
+	require.False(t, damaged)
+	require.Equal(t, RollupData{
+		key:   {Settled: 15, Inline: 1, Dead: 2},
+		other: {Allocated: 7},
+	}, rollups)
+
+	// a record cut by a crash is skipped.
+	record := encodeSpillRecord(key, CacheData{Settled: 100})
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	require.NoError(t, err)
+	_, err = file.Write(record[:len(record)-1])
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	rollups = make(RollupData)
+	damaged, err = readSpillFile(path, rollups)
+	require.NoError(t, err)
+	require.True(t, damaged)
+	require.Equal(t, int64(15), rollups[key].Settled)
+
+	files, err := spill.files()
+	require.NoError(t, err)
+	require.Equal(t, []string{path}, files)
+	require.Equal(t, filepath.Dir(path), spill.dir)
+}
+
+// flakyRollupsDB records the written settled bandwidth and fails the writes, while failing is set.
+type flakyRollupsDB struct {
+	noopDB
+	failing bool
+	settled int64
+}
+
+func (db *flakyRollupsDB) UpdateBandwidthBatch(ctx context.Context, rollups []BucketBandwidthRollup) error {
+	if db.failing {
+		return errs.New("database is down")
+	}
+	for _, rollup := range rollups {
+		db.settled += rollup.Settled
+	}
+	return nil
+}
+
+func TestRollupsWriteCacheSpillFlushFailure(t *testing.T) {
+	ctx := testcontext.New(t)
+	spillDir := ctx.Dir("spill")
+	projectID := testrand.UUID()
+
+	spillFiles := func() []string {
+		files, err := filepath.Glob(filepath.Join(spillDir, spillFilePattern))
+		require.NoError(t, err)
+		return files
+	}
+
+	db := &flakyRollupsDB{failing: true}
+	cache := NewRollupsWriteCache(zaptest.NewLogger(t), db, 10)
+	require.NoError(t, cache.OpenSpill(ctx, spillDir))
+
+	require.NoError(t, cache.UpdateBucketBandwidthSettle(ctx, projectID, []byte("bucket"), pb.PieceAction_GET, 100, 0, time.Now()))
+
+	// the rollups of the failed flush are kept in the cache and in a spill file.
+	cache.Flush(ctx)
+	require.Zero(t, db.settled)
+	settled, _ := cache.CurrentData().getBandwidth()
+	require.Equal(t, int64(100), settled)
+	require.Len(t, spillFiles(), 1)
+
+	// the retried flush writes them once and removes the spill file.
+	db.failing = false
+	cache.Flush(ctx)
+	require.Equal(t, int64(100), db.settled)
+	require.Empty(t, spillFiles())
+
+	// the written rollups aren't recovered again after a crash.
+	require.NoError(t, cache.UpdateBucketBandwidthSettle(ctx, projectID, []byte("bucket"), pb.PieceAction_GET, 50, 0, time.Now()))
+	db.failing = true
+	cache.Flush(ctx)
+
+	restarted := NewRollupsWriteCache(zaptest.NewLogger(t), db, 10)
+	db.failing = false
+	require.NoError(t, restarted.OpenSpill(ctx, spillDir))
+	require.Equal(t, int64(150), db.settled)
+	require.Empty(t, spillFiles())
+
+	require.NoError(t, restarted.CloseAndFlush(ctx))
+	require.Equal(t, int64(150), db.settled)
+}
@@ -5,6 +5,8 @@ package orders
 
 import (
 	"context"
+	"errors"
+	"os"
 	"sync"
 	"time"
 
@@ -36,6 +38,35 @@ type CacheKey struct {
 // RollupData contains the pending rollups waiting to be flushed to the db.
 type RollupData map[CacheKey]CacheData
 
+// rollups returns the bucket bandwidth rollups of the data.
+func (data RollupData) rollups() []BucketBandwidthRollup {
+	rollups := make([]BucketBandwidthRollup, 0, len(data))
+	for cacheKey, cacheData := range data {
+		rollups = append(rollups, BucketBandwidthRollup{
+			ProjectID:     cacheKey.ProjectID,
+			BucketName:    cacheKey.BucketName,
+			IntervalStart: time.Unix(cacheKey.IntervalStart, 0),
+			Action:        cacheKey.Action,
+			Inline:        cacheData.Inline,
+			Allocated:     cacheData.Allocated,
+			Settled:       cacheData.Settled,
+			Dead:          cacheData.Dead,
+		})
+	}
+	return rollups
+}
+
+// getBandwidth returns the settled and the inline GET bandwidth of the data.
+func (data RollupData) getBandwidth() (settled, inline int64) {
+	for cacheKey, cacheData := range data {
+		if cacheKey.Action == pb.PieceAction_GET {
+			settled += cacheData.Settled
+			inline += cacheData.Inline
+		}
+	}
+	return settled, inline
+}
+
 // RollupsWriteCache stores information needed to update bucket bandwidth rollups.
 type RollupsWriteCache struct {
 	DB
@@ -45,6 +76,8 @@ type RollupsWriteCache struct {
 
 	mu             sync.Mutex
 	pendingRollups RollupData
+	pendingBytes   int64
+	spill          *rollupsSpill
 	stopped        bool
 	flushing       bool
 
@@ -77,13 +110,80 @@ func (cache *RollupsWriteCache) UpdateBucketBandwidthSettle(ctx context.Context,
 	return cache.updateCacheValue(ctx, projectID, bucketName, action, 0, 0, settledAmount, deadAmount, intervalStart.UTC())
 }
 
+// OpenSpill flushes the rollups, which were left in the spill files of the directory by a
+// crash or a failed flush, to the database, and starts spilling the updates of the cache to
+// the directory. It must be called before the cache is used, and the directory must not be
+// shared with other processes. When the recovered rollups can't be flushed, they are kept
+// in the cache and in the spill files.
+func (cache *RollupsWriteCache) OpenSpill(ctx context.Context, dir string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	spill, err := newRollupsSpill(dir)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	paths, err := spill.files()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	recovered := make(RollupData)
+	for _, path := range paths {
+		damaged, err := readSpillFile(path, recovered)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if damaged {
+			mon.Event("rollups_write_cache_spill_damaged")
+			cache.log.Warn("skipped damaged tail of rollups spill file", zap.String("Path", path))
+		}
+	}
+
+	cache.mu.Lock()
+	cache.spill = spill
+	cache.spill.keep(paths)
+	cache.addPending(recovered)
+	cache.mu.Unlock()
+
+	if len(recovered) == 0 {
+		return nil
+	}
+
+	settled, inline := recovered.getBandwidth()
+	mon.Meter("rollups_write_cache_spill_recovered").Mark(len(recovered))
+	cache.log.Info("recovered bucket bandwidth rollups from spill files",
+		zap.Int("Files", len(paths)),
+		zap.Int("Rollups", len(recovered)),
+		zap.Int64("Settled", settled),
+		zap.Int64("Inline", inline))
+
+	cache.Flush(ctx)
+	return nil
+}
+
 // resetCache should only be called after you have acquired the cache lock. It
-// will reset the various cache values and return the pendingRollups.
-func (cache *RollupsWriteCache) resetCache() RollupData {
+// will reset the various cache values and return the pendingRollups and the
+// spill files, which contain them.
+func (cache *RollupsWriteCache) resetCache() (RollupData, []string) {
 	pendingRollups := cache.pendingRollups
 	cache.pendingRollups = make(RollupData)
 
-	return pendingRollups
+	mon.IntVal("rollups_write_cache_buffered_rollups").Observe(int64(len(pendingRollups)))
+	mon.IntVal("rollups_write_cache_buffered_bytes").Observe(cache.pendingBytes)
+	cache.pendingBytes = 0
+
+	var spillPaths []string
+	if cache.spill != nil {
+		paths, size, err := cache.spill.rotate()
+		if err != nil {
+			cache.log.Error("failed to close rollups spill file", zap.Strings("Paths", paths), zap.Error(err))
+		}
+		mon.IntVal("rollups_write_cache_spill_bytes").Observe(size)
+		spillPaths = paths
+	}
+
+	return pendingRollups, spillPaths
 }
 
 // Flush resets cache then flushes the everything in the rollups write cache to the database.
@@ -107,11 +207,11 @@ func (cache *RollupsWriteCache) Flush(ctx context.Context) {
 	}
 
 	cache.flushing = true
-	pendingRollups := cache.resetCache()
+	pendingRollups, spillPaths := cache.resetCache()
 
 	cache.mu.Unlock()
 
-	cache.flush(ctx, pendingRollups)
+	cache.flush(ctx, pendingRollups, spillPaths)
 }
 
 // CloseAndFlush flushes anything in the cache and marks the cache as stopped.
@@ -123,58 +223,112 @@ func (cache *RollupsWriteCache) CloseAndFlush(ctx context.Context) error {
 	cache.wg.Wait()
 
 	cache.Flush(ctx)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if cache.spill != nil {
+		// updates aren't accepted anymore, but the rollups of a failed flush may have been
+		// spilled again, so the file must be closed. the files are flushed on the next start.
+		if _, _, err := cache.spill.rotate(); err != nil {
+			return Error.Wrap(err)
+		}
+	}
 	return nil
 }
 
 // flush flushes the everything in the rollups write cache to the database.
-func (cache *RollupsWriteCache) flush(ctx context.Context, pendingRollups RollupData) {
+func (cache *RollupsWriteCache) flush(ctx context.Context, pendingRollups RollupData, spillPaths []string) {
 	defer mon.Task()(&ctx)(nil)
 
-	if len(pendingRollups) > 0 {
-		rollups := make([]BucketBandwidthRollup, 0, len(pendingRollups))
-		for cacheKey, cacheData := range pendingRollups {
-			rollups = append(rollups, BucketBandwidthRollup{
-				ProjectID:     cacheKey.ProjectID,
-				BucketName:    cacheKey.BucketName,
-				IntervalStart: time.Unix(cacheKey.IntervalStart, 0),
-				Action:        cacheKey.Action,
-				Inline:        cacheData.Inline,
-				Allocated:     cacheData.Allocated,
-				Settled:       cacheData.Settled,
-				Dead:          cacheData.Dead,
-			})
-		}
+	cache.write(ctx, pendingRollups, spillPaths)
 
-		// we would like to update bandwidth even if context was canceled. flushing
-		// is triggered by endpoint methods (metainfo/orders) but flushing is started
-		// in separate goroutine and because of that endpoint request can be finished
-		// and its context will be canceled before UpdateBandwidthBatch is finished.
-		ctx = context2.WithoutCancellation(ctx)
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
 
-		err := cache.DB.UpdateBandwidthBatch(ctx, rollups)
-		if err != nil {
-			mon.Event("rollups_write_cache_flush_lost")
-
-			// With error log only GET bandwidth because it's what we care most as we charge users for this.
-			var settled int64
-			var inline int64
-			for _, rollup := range rollups {
-				if rollup.Action == pb.PieceAction_GET {
-					settled += rollup.Settled
-					inline += rollup.Inline
-				}
-			}
+	cache.nextFlushCompletion.Release()
+	cache.nextFlushCompletion = new(sync2.Fence)
+	cache.flushing = false
+}
 
-			cache.log.Error("MONEY LOST! Bucket bandwidth rollup batch flush failed", zap.Int64("settled", settled), zap.Int64("inline", inline), zap.Error(err))
+// write writes the rollups to the database. The spill files, which contain the rollups, are
+// removed before the rollups are written, so the rollups can't be flushed again on the next
+// start after they were written. When the write fails, the rollups are returned to the cache
+// and spilled again.
+func (cache *RollupsWriteCache) write(ctx context.Context, pendingRollups RollupData, spillPaths []string) {
+	for i, path := range spillPaths {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			// the rollups of the files, which weren't removed yet, would be flushed again on the
+			// next start, so the write is postponed. the removed files aren't spilled again,
+			// because the rollups can't be told apart from the ones of the remaining files.
+			mon.Event("rollups_write_cache_flush_postponed")
+			cache.log.Error("failed to remove rollups spill file, flush is postponed", zap.String("path", path), zap.Error(err))
+			cache.requeue(pendingRollups, false, spillPaths[i:])
+			return
 		}
 	}
 
+	if len(pendingRollups) == 0 {
+		return
+	}
+
+	// we would like to update bandwidth even if context was canceled. flushing
+	// is triggered by endpoint methods (metainfo/orders) but flushing is started
+	// in separate goroutine and because of that endpoint request can be finished
+	// and its context will be canceled before UpdateBandwidthBatch is finished.
+	ctx = context2.WithoutCancellation(ctx)
+
+	err := cache.DB.UpdateBandwidthBatch(ctx, pendingRollups.rollups())
+	if err == nil {
+		return
+	}
+
+	// With error log only GET bandwidth because it's what we care most as we charge users for this.
+	settled, inline := pendingRollups.getBandwidth()
+
+	if cache.spill != nil {
+		mon.Event("rollups_write_cache_flush_spilled")
+		cache.log.Error("Bucket bandwidth rollup batch flush failed, rollups are returned to the cache and spilled again",
+			zap.Int64("settled", settled), zap.Int64("inline", inline), zap.Error(err))
+		cache.requeue(pendingRollups, true, nil)
+		return
+	}
+
+	mon.Event("rollups_write_cache_flush_lost")
+	cache.log.Error("MONEY LOST! Bucket bandwidth rollup batch flush failed", zap.Int64("settled", settled), zap.Int64("inline", inline), zap.Error(err))
+}
+
+// requeue returns the rollups of a failed flush to the cache, optionally appends them to the
+// current spill file, and keeps the spill files, which weren't removed, for the next flush.
+func (cache *RollupsWriteCache) requeue(rollups RollupData, respill bool, spillPaths []string) {
 	cache.mu.Lock()
 	defer cache.mu.Unlock()
 
-	cache.nextFlushCompletion.Release()
-	cache.nextFlushCompletion = new(sync2.Fence)
-	cache.flushing = false
+	if respill {
+		for key, data := range rollups {
+			if err := cache.spill.append(key, data); err != nil {
+				mon.Event("rollups_write_cache_spill_failed")
+				cache.log.Error("failed to spill bucket bandwidth rollup", zap.Stringer("ProjectID", key.ProjectID), zap.Error(err))
+			}
+		}
+	}
+	cache.addPending(rollups)
+	cache.spill.keep(spillPaths)
+}
+
+// addPending should only be called after you have acquired the cache lock. It adds
+// the rollups to the pending ones.
+func (cache *RollupsWriteCache) addPending(rollups RollupData) {
+	for key, data := range rollups {
+		cache.pendingBytes += data.Allocated + data.Inline + data.Settled
+
+		pending := cache.pendingRollups[key]
+		pending.Allocated += data.Allocated
+		pending.Inline += data.Inline
+		pending.Settled += data.Settled
+		pending.Dead += data.Dead
+		cache.pendingRollups[key] = pending
+	}
 }
 
 func (cache *RollupsWriteCache) updateCacheValue(ctx context.Context, projectID uuid.UUID, bucketName []byte, action pb.PieceAction, allocated, inline, settled, dead int64, intervalStart time.Time) error {
@@ -207,6 +361,14 @@ func (cache *RollupsWriteCache) updateCacheValue(ctx context.Context, projectID
 			zap.Int64("Settled", settled),
 		)
 	} else {
+		if cache.spill != nil {
+			err := cache.spill.append(key, CacheData{Allocated: allocated, Inline: inline, Settled: settled, Dead: dead})
+			if err != nil {
+				mon.Event("rollups_write_cache_spill_failed")
+				cache.log.Error("failed to spill bucket bandwidth rollup", zap.Stringer("ProjectID", projectID), zap.Error(err))
+			}
+		}
+		cache.pendingBytes += allocated + inline + settled
 
 		data.Allocated += allocated
 		data.Inline += inline
@@ -221,12 +383,12 @@ func (cache *RollupsWriteCache) updateCacheValue(ctx context.Context, projectID
 
 	if !cache.flushing {
 		cache.flushing = true
-		pendingRollups := cache.resetCache()
+		pendingRollups, spillPath := cache.resetCache()
 
 		cache.wg.Add(1)
 		go func() {
 			defer cache.wg.Done()
-			cache.flush(ctx, pendingRollups)
+			cache.flush(ctx, pendingRollups, spillPath)
 		}()
 	}
 
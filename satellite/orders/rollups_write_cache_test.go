@@ -6,6 +6,7 @@ package orders_test
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -136,6 +137,60 @@ func TestRollupsWriteCacheBatchChore(t *testing.T) {
 	)
 }
 
+// TestRollupsWriteCacheSpillRecovery makes sure the bandwidth rollups, which weren't flushed
+// before a crash, are recovered from the spill files.
+func TestRollupsWriteCacheSpillRecovery(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		useBatchSize := 10
+		amount := (memory.MB * 500).Int64()
+		projectID := testrand.UUID()
+		startTime := time.Now()
+		spillDir := ctx.Dir("spill")
+
+		accountingDB := db.ProjectAccounting()
+
+		crashed := orders.NewRollupsWriteCache(zaptest.NewLogger(t), db.Orders(), useBatchSize)
+		require.NoError(t, crashed.OpenSpill(ctx, spillDir))
+
+		expectedTotal := int64(0)
+		for i := 0; i < useBatchSize-1; i++ {
+			bucketName := fmt.Sprintf("my_files_%d", i%3)
+			err := crashed.UpdateBucketBandwidthSettle(ctx, projectID, []byte(bucketName), pb.PieceAction_GET, amount, 0, startTime)
+			require.NoError(t, err)
+			expectedTotal += amount
+		}
+
+		// the cache isn't flushed, as if the process crashed.
+		total, err := getSettledBandwidth(ctx, accountingDB, projectID, startTime)
+		require.NoError(t, err)
+		require.Zero(t, total)
+
+		rwc := orders.NewRollupsWriteCache(zaptest.NewLogger(t), db.Orders(), useBatchSize)
+		require.NoError(t, rwc.OpenSpill(ctx, spillDir))
+
+		total, err = getSettledBandwidth(ctx, accountingDB, projectID, startTime)
+		require.NoError(t, err)
+		require.Equal(t, expectedTotal, total)
+
+		// the flushed spill files are removed.
+		err = rwc.UpdateBucketBandwidthSettle(ctx, projectID, []byte("my_files_last"), pb.PieceAction_GET, amount, 0, startTime)
+		require.NoError(t, err)
+		expectedTotal += amount
+
+		rwc.Flush(ctx)
+
+		total, err = getSettledBandwidth(ctx, accountingDB, projectID, startTime)
+		require.NoError(t, err)
+		require.Equal(t, expectedTotal, total)
+
+		files, err := filepath.Glob(filepath.Join(spillDir, "*"))
+		require.NoError(t, err)
+		require.Empty(t, files)
+
+		require.NoError(t, rwc.CloseAndFlush(ctx))
+	})
+}
+
 func TestUpdateBucketBandwidth(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1,
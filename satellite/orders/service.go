@@ -36,6 +36,9 @@ type Config struct {
 	FlushInterval       time.Duration  `help:"how often to flush the rollups write cache to the database" devDefault:"30s" releaseDefault:"1m" testDefault:"$TESTINTERVAL"`
 	NodeStatusLogging   bool           `hidden:"true" help:"deprecated, log the offline/disqualification status of nodes" default:"false" testDefault:"true"`
 	OrdersSemaphoreSize int            `help:"how many concurrent orders to process at once. zero is unlimited" default:"2"`
+	RollupsSpillDir     string         `help:"directory of the write-ahead files of the rollups write cache, which keep the unflushed bandwidth rollups over a crash, it must not be shared between processes, the spilling is disabled when empty" default:""`
+
+	SettlementExtension SettlementExtensionConfig
 }
 
 // Overlay defines the overlay dependency of orders.Service.
@@ -236,6 +239,10 @@ func (service *Service) CreatePutOrderLimits(ctx context.Context, bucket metabas
 	}
 
 	for pieceNum, node := range nodes {
+		if !acceptsPieceSize(node, maxPieceSize) {
+			return storj.PieceID{}, nil, storj.PiecePrivateKey{}, Error.New("node %s does not accept pieces of %d bytes", node.ID, maxPieceSize)
+		}
+
 		_, err := signer.Sign(ctx, resolveStorageNode_Selected(node, true), int32(pieceNum))
 		if err != nil {
 			return storj.PieceID{}, nil, storj.PiecePrivateKey{}, Error.Wrap(err)
@@ -261,6 +268,10 @@ func (service *Service) ReplacePutOrderLimits(ctx context.Context, rootPieceID s
 
 		// TODO: clone?
 		newAddressedLimit := *addressedLimits[pieceNumber].Limit
+		if !acceptsPieceSize(nodes[i], newAddressedLimit.Limit) {
+			return nil, Error.New("node %s does not accept pieces of %d bytes", nodes[i].ID, newAddressedLimit.Limit)
+		}
+
 		newAddressedLimit.StorageNodeId = nodes[i].ID
 		newAddressedLimit.PieceId = pieceIDDeriver.Derive(nodes[i].ID, pieceNumber)
 		newAddressedLimit.SatelliteSignature = nil
@@ -582,6 +593,11 @@ func (service *Service) DecryptOrderMetadata(ctx context.Context, order *pb.Orde
 	return key.DecryptMetadata(order.SerialNumber, order.EncryptedMetadata)
 }
 
+// acceptsPieceSize returns whether the node advertised accepting pieces of the size.
+func acceptsPieceSize(node *overlay.SelectedNode, pieceSize int64) bool {
+	return node.MaxPieceSize <= 0 || pieceSize <= node.MaxPieceSize
+}
+
 func resolveStorageNode_Selected(node *overlay.SelectedNode, resolveDNS bool) *pb.Node {
 	return resolveStorageNode(&pb.Node{
 		Id:      node.ID,
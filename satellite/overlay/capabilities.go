@@ -0,0 +1,20 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"time"
+
+	"storj.io/common/pb"
+)
+
+// NodeCapabilities are the optional features advertised by a node.
+type NodeCapabilities struct {
+	HashAlgorithms []pb.PieceHashAlgorithm
+	TCPFastOpen    bool
+	Noise          bool
+	// MaxPieceSize is the size of the largest piece the node accepts, or 0 without a limit.
+	MaxPieceSize int64
+	UpdatedAt    time.Time
+}
@@ -23,12 +23,15 @@ type Config struct {
 	Node                            NodeSelectionConfig
 	NodeSelectionCache              UploadSelectionCacheConfig
 	GeoIP                           GeoIPConfig
+	Maintenance                     MaintenanceConfig
 	UpdateStatsBatchSize            int           `help:"number of update requests to process per transaction" default:"100"`
 	NodeCheckInWaitPeriod           time.Duration `help:"the amount of time to wait before accepting a redundant check-in from a node (unmodified info since last check-in)" default:"2h" testDefault:"30s"`
 	NodeSoftwareUpdateEmailCooldown time.Duration `help:"the amount of time to wait between sending Node Software Update emails" default:"168h"`
 	RepairExcludedCountryCodes      []string      `help:"list of country codes to exclude nodes from target repair selection" default:"" testDefault:"FR,BE"`
 	SendNodeEmails                  bool          `help:"whether to send emails to nodes" default:"false"`
 	MinimumNewNodeIDDifficulty      int           `help:"the minimum node id difficulty required for new nodes. existing nodes remain allowed" devDefault:"0" releaseDefault:"36"`
+	UpgradedNewNodeIDDifficulty     int           `help:"the minimum node id difficulty required for new nodes from the difficulty upgrade date. existing nodes remain allowed" default:"0"`
+	NodeIDDifficultyUpgradeDate     string        `help:"the date (YYYY-MM-DD) from which new nodes need the upgraded node id difficulty. empty disables the upgrade" default:""`
 }
 
 // AsOfSystemTimeConfig is a configuration struct to enable 'AS OF SYSTEM TIME' for CRDB queries.
@@ -47,12 +50,26 @@ type NodeSelectionConfig struct {
 	NetworkPrefixIPv4 int           `help:"the prefix to use in determining 'network' for IPv4 addresses" default:"24" hidden:"true"`
 	NetworkPrefixIPv6 int           `help:"the prefix to use in determining 'network' for IPv6 addresses" default:"64" hidden:"true"`
 	MinimumDiskSpace  memory.Size   `help:"how much disk space a node at minimum must have to be selected for upload" default:"500.00MB" testDefault:"100.00MB"`
+	NearlyFullRatio   float64       `help:"the ratio of the estimated stored data to the estimated capacity, above which a node is selected for upload only when there aren't enough other nodes (0 disables)" default:"0.95"`
 
 	AsOfSystemTime AsOfSystemTimeConfig
 
 	UploadExcludedCountryCodes []string `help:"list of country codes to exclude from node selection for uploads" default:"" testDefault:"FR,BE"`
 }
 
+// NearlyFull returns whether a node, which is estimated to store storedBytes and
+// reports freeDisk, is nearly full. The stored bytes are the satellite's own
+// estimate, so a node can't hide that it's filling up by reporting the capacity.
+func (config NodeSelectionConfig) NearlyFull(storedBytes, freeDisk int64) bool {
+	if config.NearlyFullRatio <= 0 || storedBytes <= 0 {
+		return false
+	}
+	if freeDisk < 0 {
+		freeDisk = 0
+	}
+	return float64(storedBytes) >= config.NearlyFullRatio*float64(storedBytes+freeDisk)
+}
+
 // GeoIPConfig is a configuration struct that helps configure the GeoIP lookup features on the satellite.
 type GeoIPConfig struct {
 	DB            string   `help:"the location of the maxmind database containing geoip country information"`
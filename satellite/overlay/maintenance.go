@@ -0,0 +1,202 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+)
+
+var (
+	// ErrMaintenanceInvalid is returned when the node maintenance window can't be scheduled.
+	ErrMaintenanceInvalid = errs.Class("invalid maintenance window")
+
+	// ErrMaintenanceNotFound is returned when the node maintenance window doesn't exist or
+	// can't be cancelled anymore.
+	ErrMaintenanceNotFound = errs.Class("maintenance window not found")
+)
+
+// MaintenanceConfig defines the limits of the maintenance windows, which the storage nodes
+// schedule themselves.
+type MaintenanceConfig struct {
+	Enabled          bool          `help:"whether the storage nodes can schedule the maintenance windows, during which their offline audits are not penalized" default:"false"`
+	MaxPerMonth      time.Duration `help:"the maximum total duration of the maintenance windows of a node in a calendar month" default:"8h"`
+	MinNotice        time.Duration `help:"how long before the start the maintenance windows must be scheduled" default:"24h"`
+	MaxAhead         time.Duration `help:"how far ahead the maintenance windows can be scheduled" default:"2160h"`
+	MaxReasonLength  int           `help:"the maximum length of the reason of a maintenance window in bytes" default:"500"`
+	MaxUpcomingCount int           `help:"the maximum number of the upcoming maintenance windows of a node" default:"10"`
+}
+
+// NodeMaintenanceWindow is a planned downtime of the storage node, during which the
+// audits, which find the node offline, are recorded, but not penalized.
+type NodeMaintenanceWindow struct {
+	ID       uuid.UUID
+	NodeID   storj.NodeID
+	StartsAt time.Time
+	EndsAt   time.Time
+	Reason   string
+
+	// OfflineAudits is the number of the audits, which found the node offline during the window.
+	OfflineAudits int64
+	CreatedAt     time.Time
+}
+
+// Duration returns the duration of the window.
+func (window NodeMaintenanceWindow) Duration() time.Duration {
+	return window.EndsAt.Sub(window.StartsAt)
+}
+
+// Contains returns whether the time is within the window.
+func (window NodeMaintenanceWindow) Contains(t time.Time) bool {
+	return !t.Before(window.StartsAt) && t.Before(window.EndsAt)
+}
+
+// overlap returns the duration of the part of the window between from and to.
+func (window NodeMaintenanceWindow) overlap(from, to time.Time) time.Duration {
+	start, end := window.StartsAt, window.EndsAt
+	if start.Before(from) {
+		start = from
+	}
+	if end.After(to) {
+		end = to
+	}
+	if !end.After(start) {
+		return 0
+	}
+	return end.Sub(start)
+}
+
+// monthStart returns the start of the calendar month (UTC) of the time.
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// ScheduleMaintenance schedules a maintenance window of the node. The window must be
+// scheduled in advance, it can't overlap the other windows of the node, and the windows
+// of the node in a calendar month can't exceed the configured total duration.
+func (service *Service) ScheduleMaintenance(ctx context.Context, nodeID storj.NodeID, startsAt, endsAt time.Time, reason string) (_ NodeMaintenanceWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	config := service.config.Maintenance
+	now := time.Now()
+
+	switch {
+	case !endsAt.After(startsAt):
+		return NodeMaintenanceWindow{}, ErrMaintenanceInvalid.New("the window must end after it starts")
+	case startsAt.Before(now.Add(config.MinNotice)):
+		return NodeMaintenanceWindow{}, ErrMaintenanceInvalid.New("the window must be scheduled at least %s in advance", config.MinNotice)
+	case startsAt.After(now.Add(config.MaxAhead)):
+		return NodeMaintenanceWindow{}, ErrMaintenanceInvalid.New("the window can't be scheduled more than %s in advance", config.MaxAhead)
+	case endsAt.Sub(startsAt) > config.MaxPerMonth:
+		return NodeMaintenanceWindow{}, ErrMaintenanceInvalid.New("the window can't be longer than %s", config.MaxPerMonth)
+	case !utf8.ValidString(reason):
+		return NodeMaintenanceWindow{}, ErrMaintenanceInvalid.New("reason is not valid utf-8")
+	case len(reason) > config.MaxReasonLength:
+		return NodeMaintenanceWindow{}, ErrMaintenanceInvalid.New("reason is longer than %d bytes", config.MaxReasonLength)
+	}
+
+	node, err := service.db.Get(ctx, nodeID)
+	if err != nil {
+		if ErrNodeNotFound.Has(err) {
+			return NodeMaintenanceWindow{}, ErrMaintenanceInvalid.New("unknown node")
+		}
+		return NodeMaintenanceWindow{}, Error.Wrap(err)
+	}
+	if node.Disqualified != nil || node.ExitStatus.ExitFinishedAt != nil {
+		return NodeMaintenanceWindow{}, ErrMaintenanceInvalid.New("node is disqualified or exited")
+	}
+
+	window := NodeMaintenanceWindow{
+		NodeID:    nodeID,
+		StartsAt:  startsAt.UTC(),
+		EndsAt:    endsAt.UTC(),
+		Reason:    reason,
+		CreatedAt: now.UTC(),
+	}
+	window.ID, err = uuid.New()
+	if err != nil {
+		return NodeMaintenanceWindow{}, Error.Wrap(err)
+	}
+
+	// the windows, which end in the month of the start, count to the limits.
+	windows, err := service.db.GetMaintenanceWindows(ctx, nodeID, monthStart(window.StartsAt))
+	if err != nil {
+		return NodeMaintenanceWindow{}, Error.Wrap(err)
+	}
+
+	var upcoming int
+	for _, other := range windows {
+		if other.EndsAt.After(now) {
+			upcoming++
+		}
+		if other.StartsAt.Before(window.EndsAt) && window.StartsAt.Before(other.EndsAt) {
+			return NodeMaintenanceWindow{}, ErrMaintenanceInvalid.New("the window overlaps the window from %s to %s",
+				other.StartsAt.Format(time.RFC3339), other.EndsAt.Format(time.RFC3339))
+		}
+	}
+	if upcoming >= config.MaxUpcomingCount {
+		return NodeMaintenanceWindow{}, ErrMaintenanceInvalid.New("the node can't have more than %d upcoming windows", config.MaxUpcomingCount)
+	}
+
+	for month := monthStart(window.StartsAt); month.Before(window.EndsAt); month = month.AddDate(0, 1, 0) {
+		monthEnd := month.AddDate(0, 1, 0)
+		total := window.overlap(month, monthEnd)
+		for _, other := range windows {
+			total += other.overlap(month, monthEnd)
+		}
+		if total > config.MaxPerMonth {
+			return NodeMaintenanceWindow{}, ErrMaintenanceInvalid.New("the windows in %s would exceed %s",
+				month.Format("January 2006"), config.MaxPerMonth)
+		}
+	}
+
+	if err := service.db.InsertMaintenanceWindow(ctx, window); err != nil {
+		return NodeMaintenanceWindow{}, Error.Wrap(err)
+	}
+	return window, nil
+}
+
+// GetMaintenanceWindows returns the maintenance windows of the node, which end after the time,
+// ordered by their start.
+func (service *Service) GetMaintenanceWindows(ctx context.Context, nodeID storj.NodeID, endsAfter time.Time) (_ []NodeMaintenanceWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	windows, err := service.db.GetMaintenanceWindows(ctx, nodeID, endsAfter)
+	return windows, Error.Wrap(err)
+}
+
+// CancelMaintenance cancels the maintenance window of the node, which hasn't started yet.
+func (service *Service) CancelMaintenance(ctx context.Context, nodeID storj.NodeID, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err := service.db.DeleteMaintenanceWindow(ctx, nodeID, id, time.Now())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !deleted {
+		return ErrMaintenanceNotFound.New("%s", id)
+	}
+	return nil
+}
+
+// RecordMaintenanceOfflineAudit records the audit, which found the node offline, when it's
+// during a maintenance window of the node. It returns whether the node is in maintenance,
+// in which case the audit shouldn't be penalized.
+func (service *Service) RecordMaintenanceOfflineAudit(ctx context.Context, nodeID storj.NodeID, at time.Time) (inMaintenance bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !service.config.Maintenance.Enabled {
+		return false, nil
+	}
+
+	inMaintenance, err = service.db.RecordMaintenanceOfflineAudit(ctx, nodeID, at)
+	return inMaintenance, Error.Wrap(err)
+}
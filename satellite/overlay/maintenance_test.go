@@ -0,0 +1,138 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/reputation"
+	"storj.io/storj/storagenode/nodestats"
+)
+
+func TestScheduleMaintenance(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Overlay.Maintenance.Enabled = true
+				config.Overlay.Maintenance.MaxPerMonth = 8 * time.Hour
+				config.Overlay.Maintenance.MinNotice = 24 * time.Hour
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+		service := node.NodeStats.Service
+
+		// a month, which is fully within the scheduling limits.
+		now := time.Now().UTC()
+		month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 1, 0)
+		if month.Sub(now) < 24*time.Hour {
+			month = month.AddDate(0, 1, 0)
+		}
+		day := func(day, hour int) time.Time {
+			return month.Add(time.Duration(day-1)*24*time.Hour + time.Duration(hour)*time.Hour)
+		}
+
+		for _, invalid := range []struct{ startsAt, endsAt time.Time }{
+			{now.Add(time.Hour), now.Add(2 * time.Hour)},
+			{day(2, 12), day(2, 10)},
+			{day(2, 0), day(2, 9)},
+			{now.AddDate(1, 0, 0), now.AddDate(1, 0, 0).Add(time.Hour)},
+		} {
+			_, err := service.ScheduleMaintenance(ctx, satellite.ID(), invalid.startsAt, invalid.endsAt, "")
+			require.True(t, nodestats.ErrMaintenanceRefused.Has(err), err)
+		}
+
+		first, err := service.ScheduleMaintenance(ctx, satellite.ID(), day(2, 10), day(2, 14), "disk replacement")
+		require.NoError(t, err)
+		require.Equal(t, satellite.ID(), first.SatelliteID)
+		require.Equal(t, "disk replacement", first.Reason)
+
+		// overlapping window
+		_, err = service.ScheduleMaintenance(ctx, satellite.ID(), day(2, 13), day(2, 15), "")
+		require.True(t, nodestats.ErrMaintenanceRefused.Has(err), err)
+
+		second, err := service.ScheduleMaintenance(ctx, satellite.ID(), day(3, 10), day(3, 14), "")
+		require.NoError(t, err)
+
+		// the monthly limit is exhausted
+		_, err = service.ScheduleMaintenance(ctx, satellite.ID(), day(4, 10), day(4, 11), "")
+		require.True(t, nodestats.ErrMaintenanceRefused.Has(err), err)
+
+		windows, err := service.ListMaintenance(ctx, satellite.ID())
+		require.NoError(t, err)
+		require.Len(t, windows, 2)
+		require.Equal(t, first.ID, windows[0].ID)
+		require.Equal(t, second.ID, windows[1].ID)
+
+		require.NoError(t, service.CancelMaintenance(ctx, satellite.ID(), second.ID))
+		err = service.CancelMaintenance(ctx, satellite.ID(), second.ID)
+		require.True(t, nodestats.ErrMaintenanceNotFound.Has(err), err)
+
+		// the cancelled window doesn't count to the monthly limit anymore.
+		_, err = service.ScheduleMaintenance(ctx, satellite.ID(), day(4, 10), day(4, 11), "")
+		require.NoError(t, err)
+
+		scheduled, err := satellite.Overlay.Service.GetMaintenanceWindows(ctx, node.ID(), now)
+		require.NoError(t, err)
+		require.Len(t, scheduled, 2)
+	})
+}
+
+func TestMaintenanceOfflineAudits(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Overlay.Maintenance.Enabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		inMaintenance := planet.StorageNodes[0].ID()
+		notInMaintenance := planet.StorageNodes[1].ID()
+
+		now := time.Now()
+		require.NoError(t, satellite.Overlay.DB.InsertMaintenanceWindow(ctx, overlay.NodeMaintenanceWindow{
+			ID:        testrand.UUID(),
+			NodeID:    inMaintenance,
+			StartsAt:  now.Add(-time.Hour),
+			EndsAt:    now.Add(time.Hour),
+			CreatedAt: now.Add(-48 * time.Hour),
+		}))
+
+		for _, nodeID := range []storj.NodeID{inMaintenance, notInMaintenance} {
+			before, err := satellite.Reputation.Service.Get(ctx, nodeID)
+			require.NoError(t, err)
+
+			dossier, err := satellite.Overlay.Service.Get(ctx, nodeID)
+			require.NoError(t, err)
+			require.NoError(t, satellite.Reputation.Service.ApplyAudit(ctx, nodeID, dossier.Reputation.Status, reputation.AuditOffline))
+
+			after, err := satellite.Reputation.Service.Get(ctx, nodeID)
+			require.NoError(t, err)
+			if nodeID == inMaintenance {
+				require.Equal(t, before.AuditHistory, after.AuditHistory)
+			} else {
+				require.NotEqual(t, before.AuditHistory, after.AuditHistory)
+			}
+		}
+
+		windows, err := satellite.Overlay.Service.GetMaintenanceWindows(ctx, inMaintenance, now)
+		require.NoError(t, err)
+		require.Len(t, windows, 1)
+		require.EqualValues(t, 1, windows[0].OfflineAudits)
+	})
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/identity"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/maintenancepb"
+)
+
+// MaintenanceEndpoint lets the storage nodes schedule their maintenance windows. The nodes
+// are authenticated by their identity.
+//
+// architecture: Endpoint
+type MaintenanceEndpoint struct {
+	maintenancepb.DRPCNodeMaintenanceUnimplementedServer
+
+	log     *zap.Logger
+	service *Service
+}
+
+// NewMaintenanceEndpoint returns a new node maintenance endpoint.
+func NewMaintenanceEndpoint(log *zap.Logger, service *Service) *MaintenanceEndpoint {
+	return &MaintenanceEndpoint{
+		log:     log,
+		service: service,
+	}
+}
+
+// ScheduleMaintenance schedules a maintenance window of the calling node.
+func (endpoint *MaintenanceEndpoint) ScheduleMaintenance(ctx context.Context, req *maintenancepb.ScheduleMaintenanceRequest) (_ *maintenancepb.ScheduleMaintenanceResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+
+	window, err := endpoint.service.ScheduleMaintenance(ctx, peer.ID, req.GetStartsAt(), req.GetEndsAt(), req.GetReason())
+	if err != nil {
+		if ErrMaintenanceInvalid.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+		endpoint.log.Error("failed to schedule maintenance", zap.Stringer("Node ID", peer.ID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "failed to schedule maintenance")
+	}
+
+	return &maintenancepb.ScheduleMaintenanceResponse{
+		Window: maintenanceToProto(window),
+	}, nil
+}
+
+// ListMaintenance returns the maintenance windows of the calling node, which end in the
+// current month or later.
+func (endpoint *MaintenanceEndpoint) ListMaintenance(ctx context.Context, req *maintenancepb.ListMaintenanceRequest) (_ *maintenancepb.ListMaintenanceResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+
+	windows, err := endpoint.service.GetMaintenanceWindows(ctx, peer.ID, monthStart(time.Now()))
+	if err != nil {
+		endpoint.log.Error("failed to list maintenance", zap.Stringer("Node ID", peer.ID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "failed to list maintenance")
+	}
+
+	resp := &maintenancepb.ListMaintenanceResponse{
+		Windows: make([]*maintenancepb.MaintenanceWindow, 0, len(windows)),
+	}
+	for _, window := range windows {
+		resp.Windows = append(resp.Windows, maintenanceToProto(window))
+	}
+	return resp, nil
+}
+
+// CancelMaintenance cancels the maintenance window of the calling node, which hasn't started yet.
+func (endpoint *MaintenanceEndpoint) CancelMaintenance(ctx context.Context, req *maintenancepb.CancelMaintenanceRequest) (_ *maintenancepb.CancelMaintenanceResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+
+	id, err := uuid.FromBytes(req.GetId())
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "invalid maintenance window id")
+	}
+
+	if err := endpoint.service.CancelMaintenance(ctx, peer.ID, id); err != nil {
+		if ErrMaintenanceNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		}
+		endpoint.log.Error("failed to cancel maintenance", zap.Stringer("Node ID", peer.ID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "failed to cancel maintenance")
+	}
+
+	return &maintenancepb.CancelMaintenanceResponse{}, nil
+}
+
+func maintenanceToProto(window NodeMaintenanceWindow) *maintenancepb.MaintenanceWindow {
+	return &maintenancepb.MaintenanceWindow{
+		Id:            window.ID.Bytes(),
+		StartsAt:      window.StartsAt,
+		EndsAt:        window.EndsAt,
+		Reason:        window.Reason,
+		OfflineAudits: window.OfflineAudits,
+		CreatedAt:     window.CreatedAt,
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/storj/location"
 	"storj.io/common/sync2"
+	"storj.io/common/uuid"
 	"storj.io/private/version"
 	"storj.io/storj/satellite/geoip"
 	"storj.io/storj/satellite/metabase"
@@ -72,12 +73,18 @@ type DB interface {
 	KnownReliable(ctx context.Context, onlineWindow time.Duration, nodeIDs storj.NodeIDList) ([]*pb.Node, error)
 	// Reliable returns all nodes that are reliable
 	Reliable(context.Context, *NodeCriteria) (storj.NodeIDList, error)
+	// ReliableWithLastContact returns all nodes that are reliable with their last successful contact
+	ReliableWithLastContact(context.Context, *NodeCriteria) (map[storj.NodeID]time.Time, error)
 	// UpdateReputation updates the DB columns for all reputation fields in ReputationStatus.
 	UpdateReputation(ctx context.Context, id storj.NodeID, request ReputationUpdate) error
 	// UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, capacity, and version.
 	UpdateNodeInfo(ctx context.Context, node storj.NodeID, nodeInfo *InfoResponse) (stats *NodeDossier, err error)
 	// UpdateCheckIn updates a single storagenode's check-in stats.
 	UpdateCheckIn(ctx context.Context, node NodeCheckInInfo, timestamp time.Time, config NodeSelectionConfig) (err error)
+	// UpdateNodeCapabilities replaces the capabilities advertised by the node.
+	UpdateNodeCapabilities(ctx context.Context, nodeID storj.NodeID, capabilities NodeCapabilities) (err error)
+	// GetNodeCapabilities returns the capabilities advertised by the node, or nil when the node hasn't advertised any.
+	GetNodeCapabilities(ctx context.Context, nodeID storj.NodeID) (_ *NodeCapabilities, err error)
 	// SetNodeContained updates the contained field for the node record.
 	SetNodeContained(ctx context.Context, node storj.NodeID, contained bool) (err error)
 	// SetAllContainedNodes updates the contained field for all nodes, as necessary.
@@ -87,6 +94,22 @@ type DB interface {
 	AllPieceCounts(ctx context.Context) (pieceCounts map[storj.NodeID]int64, err error)
 	// UpdatePieceCounts sets the piece count field for the given node IDs.
 	UpdatePieceCounts(ctx context.Context, pieceCounts map[storj.NodeID]int64) (err error)
+	// UpdateStorageEstimates replaces the storage estimates of the nodes and sets their piece counts.
+	UpdateStorageEstimates(ctx context.Context, estimates []StorageEstimate) (err error)
+	// UpdateStorageEstimatesSettled sets the ingress settled since the storage estimates.
+	UpdateStorageEstimatesSettled(ctx context.Context) (updated int64, err error)
+	// GetStorageEstimate returns the storage estimate of the node.
+	GetStorageEstimate(ctx context.Context, nodeID storj.NodeID) (_ StorageEstimate, err error)
+
+	// InsertMaintenanceWindow inserts a maintenance window of a node.
+	InsertMaintenanceWindow(ctx context.Context, window NodeMaintenanceWindow) (err error)
+	// GetMaintenanceWindows returns the maintenance windows of the node, which end after the time, ordered by their start.
+	GetMaintenanceWindows(ctx context.Context, nodeID storj.NodeID, endsAfter time.Time) (_ []NodeMaintenanceWindow, err error)
+	// DeleteMaintenanceWindow deletes the maintenance window of the node, when it starts after the time.
+	DeleteMaintenanceWindow(ctx context.Context, nodeID storj.NodeID, id uuid.UUID, startsAfter time.Time) (deleted bool, err error)
+	// RecordMaintenanceOfflineAudit counts the offline audit in the maintenance window of the node, which contains the time,
+	// and returns whether there is such window.
+	RecordMaintenanceOfflineAudit(ctx context.Context, nodeID storj.NodeID, at time.Time) (inMaintenance bool, err error)
 
 	// UpdateExitStatus is used to update a node's graceful exit status.
 	UpdateExitStatus(ctx context.Context, request *ExitStatusRequest) (_ *NodeDossier, err error)
@@ -106,6 +129,8 @@ type DB interface {
 
 	// DisqualifyNode disqualifies a storage node.
 	DisqualifyNode(ctx context.Context, nodeID storj.NodeID, disqualifiedAt time.Time, reason DisqualificationReason) (email string, err error)
+	// ReinstateNode clears the disqualification and the suspensions of a storage node.
+	ReinstateNode(ctx context.Context, nodeID storj.NodeID) (email string, err error)
 
 	// GetOfflineNodesForEmail gets offline nodes in need of an email.
 	GetOfflineNodesForEmail(ctx context.Context, offlineWindow time.Duration, cutoff time.Duration, cooldown time.Duration, limit int) (nodes map[storj.NodeID]string, err error)
@@ -187,6 +212,7 @@ type FindStorageNodesRequest struct {
 	MinimumVersion     string        // semver or empty
 	AsOfSystemInterval time.Duration // only used for CRDB queries
 	Placement          storj.PlacementConstraint
+	PieceSize          int64 // the size of the uploaded pieces, or 0 when unknown
 }
 
 // NodeCriteria are the requirements for selecting nodes.
@@ -198,6 +224,7 @@ type NodeCriteria struct {
 	OnlineWindow       time.Duration
 	AsOfSystemInterval time.Duration // only used for CRDB queries
 	ExcludedCountries  []string
+	PieceSize          int64 // the size of the uploaded pieces, or 0 when unknown
 }
 
 // ReputationStatus indicates current reputation status for a node.
@@ -285,25 +312,32 @@ type SelectedNode struct {
 	LastNet     string
 	LastIPPort  string
 	CountryCode location.CountryCode
+	// MaxPieceSize is the size of the largest piece the node accepts, or 0 without a limit.
+	MaxPieceSize int64
+	// NearlyFull is set, when the estimated stored data is close to the node's capacity.
+	NearlyFull bool
 }
 
 // NodeReputation is used as a result for creating orders limits for audits.
 type NodeReputation struct {
-	ID         storj.NodeID
-	Address    *pb.NodeAddress
-	LastNet    string
-	LastIPPort string
-	Reputation ReputationStatus
+	ID          storj.NodeID
+	Address     *pb.NodeAddress
+	LastNet     string
+	LastIPPort  string
+	CountryCode location.CountryCode
+	Reputation  ReputationStatus
 }
 
 // Clone returns a deep clone of the selected node.
 func (node *SelectedNode) Clone() *SelectedNode {
 	copy := pb.CopyNode(&pb.Node{Id: node.ID, Address: node.Address})
 	return &SelectedNode{
-		ID:         copy.Id,
-		Address:    copy.Address,
-		LastNet:    node.LastNet,
-		LastIPPort: node.LastIPPort,
+		ID:           copy.Id,
+		Address:      copy.Address,
+		LastNet:      node.LastNet,
+		LastIPPort:   node.LastIPPort,
+		MaxPieceSize: node.MaxPieceSize,
+		NearlyFull:   node.NearlyFull,
 	}
 }
 
@@ -318,6 +352,10 @@ type Service struct {
 	satelliteAddress string
 	config           Config
 
+	// difficultyUpgradeDate is when new nodes start to need the upgraded node id
+	// difficulty, or zero without an upgrade.
+	difficultyUpgradeDate time.Time
+
 	GeoIP                  geoip.IPToCountry
 	UploadSelectionCache   *UploadSelectionCache
 	DownloadSelectionCache *DownloadSelectionCache
@@ -348,6 +386,14 @@ func NewService(log *zap.Logger, db DB, nodeEvents nodeevents.DB, satelliteAddr,
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
+	var difficultyUpgradeDate time.Time
+	if config.NodeIDDifficultyUpgradeDate != "" {
+		difficultyUpgradeDate, err = time.Parse("2006-01-02", config.NodeIDDifficultyUpgradeDate)
+		if err != nil {
+			return nil, Error.New("invalid node id difficulty upgrade date %q: %v", config.NodeIDDifficultyUpgradeDate, err)
+		}
+	}
+
 	downloadSelectionCache, err := NewDownloadSelectionCache(log, db, DownloadSelectionCacheConfig{
 		Staleness:      config.NodeSelectionCache.Staleness,
 		OnlineWindow:   config.Node.OnlineWindow,
@@ -365,6 +411,8 @@ func NewService(log *zap.Logger, db DB, nodeEvents nodeevents.DB, satelliteAddr,
 		satelliteName:    satelliteName,
 		config:           config,
 
+		difficultyUpgradeDate: difficultyUpgradeDate,
+
 		GeoIP: geoIP,
 
 		UploadSelectionCache:   uploadSelectionCache,
@@ -507,6 +555,7 @@ func (service *Service) FindStorageNodesWithPreferences(ctx context.Context, req
 		MinimumVersion:     preferences.MinimumVersion,
 		OnlineWindow:       preferences.OnlineWindow,
 		AsOfSystemInterval: req.AsOfSystemInterval,
+		PieceSize:          req.PieceSize,
 	}
 	nodes, err = service.db.SelectStorageNodes(ctx, totalNeededNodes, newNodeCount, &criteria)
 	if err != nil {
@@ -599,6 +648,37 @@ func (service *Service) Reliable(ctx context.Context) (nodes storj.NodeIDList, e
 	return service.db.Reliable(ctx, criteria)
 }
 
+// ReliableWithOfflineGrace returns all nodes that are reliable, i.e. online and qualified, and the
+// nodes that would be reliable, but are offline for less than the offline grace, with the time
+// they were last contacted successfully.
+func (service *Service) ReliableWithOfflineGrace(ctx context.Context, offlineGrace time.Duration) (online storj.NodeIDList, offline map[storj.NodeID]time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	criteria := &NodeCriteria{
+		OnlineWindow: service.config.Node.OnlineWindow,
+	}
+	if offlineGrace > criteria.OnlineWindow {
+		criteria.OnlineWindow = offlineGrace
+	}
+	criteria.ExcludedCountries = service.config.RepairExcludedCountryCodes
+
+	lastContacts, err := service.db.ReliableWithLastContact(ctx, criteria)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	onlineSince := time.Now().Add(-service.config.Node.OnlineWindow)
+	offline = make(map[storj.NodeID]time.Time)
+	for id, lastContactSuccess := range lastContacts {
+		if lastContactSuccess.After(onlineSince) {
+			online = append(online, id)
+		} else {
+			offline[id] = lastContactSuccess
+		}
+	}
+	return online, offline, nil
+}
+
 // UpdateReputation updates the DB columns for any of the reputation fields.
 func (service *Service) UpdateReputation(ctx context.Context, id storj.NodeID, email string, request ReputationUpdate, reputationChanges []nodeevents.Type) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -632,6 +712,49 @@ func (service *Service) SetNodeContained(ctx context.Context, node storj.NodeID,
 	return service.db.SetNodeContained(ctx, node, contained)
 }
 
+// UpdateNodeCapabilities replaces the capabilities advertised by the node.
+func (service *Service) UpdateNodeCapabilities(ctx context.Context, nodeID storj.NodeID, capabilities NodeCapabilities) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.db.UpdateNodeCapabilities(ctx, nodeID, capabilities)
+}
+
+// GetNodeCapabilities returns the capabilities advertised by the node, or nil when the node hasn't advertised any.
+func (service *Service) GetNodeCapabilities(ctx context.Context, nodeID storj.NodeID) (_ *NodeCapabilities, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.db.GetNodeCapabilities(ctx, nodeID)
+}
+
+// RequiredNewNodeIDDifficulty returns the minimum node id difficulty required for the nodes
+// joining at the time.
+func (service *Service) RequiredNewNodeIDDifficulty(now time.Time) int {
+	required := service.config.MinimumNewNodeIDDifficulty
+	if !service.difficultyUpgradeDate.IsZero() && !now.Before(service.difficultyUpgradeDate) &&
+		service.config.UpgradedNewNodeIDDifficulty > required {
+		required = service.config.UpgradedNewNodeIDDifficulty
+	}
+	return required
+}
+
+// CheckNewNodeIDDifficulty returns ErrLowDifficulty, when the node id doesn't have the
+// difficulty required for the nodes joining at the time. It doesn't check whether the
+// node is new.
+func (service *Service) CheckNewNodeIDDifficulty(nodeID storj.NodeID, now time.Time) error {
+	difficulty, err := nodeID.Difficulty()
+	if err != nil {
+		// this should never happen
+		return Error.Wrap(err)
+	}
+
+	required := service.RequiredNewNodeIDDifficulty(now)
+	if int(difficulty) < required {
+		mon.Counter("checkin_low_node_id_difficulty").Inc(1)
+		return ErrLowDifficulty.New("node id difficulty is %d when %d is the minimum for new nodes", difficulty, required)
+	}
+	return nil
+}
+
 // UpdateCheckIn updates a single storagenode's check-in info if needed.
 /*
 The check-in info is updated in the database if:
@@ -659,15 +782,9 @@ func (service *Service) UpdateCheckIn(ctx context.Context, node NodeCheckInInfo,
 			return nil
 		}
 
-		difficulty, err := node.NodeID.Difficulty()
-		if err != nil {
-			// this should never happen
+		if err := service.CheckNewNodeIDDifficulty(node.NodeID, timestamp); err != nil {
 			return err
 		}
-		if int(difficulty) < service.config.MinimumNewNodeIDDifficulty {
-			return ErrLowDifficulty.New("node id difficulty is %d when %d is the minimum",
-				difficulty, service.config.MinimumNewNodeIDDifficulty)
-		}
 
 		node.CountryCode, err = service.GeoIP.LookupISOCountryCode(node.LastIPPort)
 		if err != nil {
@@ -1089,3 +1089,39 @@ func TestUpdateCheckInBelowMinVersionEvent(t *testing.T) {
 		require.True(t, ne2.CreatedAt.After(ne1.CreatedAt))
 	})
 }
+
+func TestCheckNewNodeIDDifficulty(t *testing.T) {
+	service, err := overlay.NewService(zaptest.NewLogger(t), nil, nil, "", "", overlay.Config{
+		NodeSelectionCache: overlay.UploadSelectionCacheConfig{
+			Staleness: time.Hour,
+		},
+		MinimumNewNodeIDDifficulty:  8,
+		UpgradedNewNodeIDDifficulty: 16,
+		NodeIDDifficultyUpgradeDate: "2023-06-01",
+	})
+	require.NoError(t, err)
+
+	// the last byte of the node id is the version, which doesn't count for the difficulty.
+	var lowDifficulty, highDifficulty storj.NodeID
+	lowDifficulty[len(lowDifficulty)-2] = 1
+	highDifficulty[len(highDifficulty)-3] = 1
+
+	upgradeDate := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, 8, service.RequiredNewNodeIDDifficulty(upgradeDate.Add(-time.Second)))
+	require.Equal(t, 16, service.RequiredNewNodeIDDifficulty(upgradeDate))
+
+	require.NoError(t, service.CheckNewNodeIDDifficulty(lowDifficulty, upgradeDate.Add(-time.Second)))
+	require.NoError(t, service.CheckNewNodeIDDifficulty(highDifficulty, upgradeDate.Add(-time.Second)))
+
+	err = service.CheckNewNodeIDDifficulty(lowDifficulty, upgradeDate)
+	require.True(t, overlay.ErrLowDifficulty.Has(err), err)
+	require.NoError(t, service.CheckNewNodeIDDifficulty(highDifficulty, upgradeDate))
+
+	_, err = overlay.NewService(zaptest.NewLogger(t), nil, nil, "", "", overlay.Config{
+		NodeSelectionCache: overlay.UploadSelectionCacheConfig{
+			Staleness: time.Hour,
+		},
+		NodeIDDifficultyUpgradeDate: "06/01/2023",
+	})
+	require.Error(t, err)
+}
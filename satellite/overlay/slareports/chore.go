@@ -0,0 +1,131 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package slareports
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/private/clock"
+)
+
+var mon = monkit.Package()
+
+// Config contains configurable values for the SLA reports chore.
+type Config struct {
+	Enabled            bool          `help:"whether the monthly uptime SLA reports of the nodes are generated" default:"false"`
+	Interval           time.Duration `help:"how often to check whether the report of the previous month has to be generated" releaseDefault:"1h" devDefault:"1m" testDefault:"$TESTINTERVAL"`
+	MinimumOnlineScore float64       `help:"the online score a node has to reach in a month to comply with the uptime SLA" default:"0.98"`
+	ListLimit          int           `help:"how many audit histories are read from the database at once" default:"1000"`
+}
+
+// Chore generates the uptime SLA report of the previous month, when it doesn't
+// exist yet.
+//
+// architecture: Chore
+type Chore struct {
+	log    *zap.Logger
+	db     DB
+	config Config
+	clock  clock.Clock
+
+	Loop *sync2.Cycle
+}
+
+// NewChore creates a new SLA reports chore.
+func NewChore(log *zap.Logger, db DB, config Config) *Chore {
+	return &Chore{
+		log:    log,
+		db:     db,
+		config: config,
+		clock:  clock.Real{},
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// SetClock sets the clock, which decides which month was the previous one.
+func (chore *Chore) SetClock(clock clock.Clock) {
+	chore.clock = clock
+}
+
+// Run runs the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		period := PeriodStart(chore.clock.Now()).AddDate(0, -1, 0)
+
+		periods, err := chore.db.ListPeriods(ctx)
+		if err != nil {
+			chore.log.Error("failed to list SLA report periods", zap.Error(err))
+			return nil
+		}
+		for _, generated := range periods {
+			if generated.Equal(period) {
+				return nil
+			}
+		}
+
+		count, err := chore.Generate(ctx, period)
+		if err != nil {
+			chore.log.Error("failed to generate SLA report", zap.Time("period", period), zap.Error(err))
+			return nil
+		}
+		chore.log.Info("SLA report generated", zap.Time("period", period), zap.Int("nodes", count))
+		return nil
+	})
+}
+
+// Generate computes the reports of the nodes for the month of period and replaces
+// the stored reports of the month with them. It returns the number of reported nodes.
+func (chore *Chore) Generate(ctx context.Context, period time.Time) (count int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	period = PeriodStart(period)
+
+	var reports []NodeReport
+	var cursor storj.NodeID
+	for {
+		histories, err := chore.db.ListNodeHistories(ctx, cursor, chore.config.ListLimit)
+		if err != nil {
+			return 0, Error.Wrap(err)
+		}
+		for _, history := range histories {
+			if report, ok := ComputeNodeReport(history, period, chore.config.MinimumOnlineScore); ok {
+				reports = append(reports, report)
+			}
+		}
+		if len(histories) == 0 || len(histories) < chore.config.ListLimit {
+			break
+		}
+		cursor = histories[len(histories)-1].NodeID
+	}
+
+	if err := chore.db.InsertReports(ctx, period, reports); err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	var compliant int64
+	for _, report := range reports {
+		if report.Compliant {
+			compliant++
+		}
+	}
+	mon.IntVal("sla_report_nodes").Observe(int64(len(reports)))
+	mon.IntVal("sla_report_compliant_nodes").Observe(compliant)
+
+	return len(reports), nil
+}
+
+// Close closes the chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package slareports generates the monthly uptime SLA reports of the storage nodes,
+// which are used by the operator incentive programs.
+package slareports
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+)
+
+// Error is the error class of the SLA reports.
+var Error = errs.Class("sla reports")
+
+// DB stores the audit histories of the nodes and the generated reports.
+//
+// architecture: Database
+type DB interface {
+	// ListNodeHistories returns up to limit audit histories of the nodes with an ID
+	// greater than cursor, ordered by the node ID.
+	ListNodeHistories(ctx context.Context, cursor storj.NodeID, limit int) ([]NodeHistory, error)
+	// InsertReports replaces the reports of the period with the given reports.
+	InsertReports(ctx context.Context, period time.Time, reports []NodeReport) error
+	// GetReports returns the reports of the period ordered by the node ID.
+	GetReports(ctx context.Context, period time.Time) ([]NodeReport, error)
+	// ListPeriods returns the periods with generated reports, the latest first.
+	ListPeriods(ctx context.Context) ([]time.Time, error)
+}
+
+// NodeHistory is the audit history of a node.
+type NodeHistory struct {
+	NodeID  storj.NodeID
+	Wallet  string
+	History *pb.AuditHistory
+}
+
+// NodeReport is the uptime SLA compliance of a node for a period.
+type NodeReport struct {
+	Period      time.Time
+	NodeID      storj.NodeID
+	Wallet      string
+	Windows     int
+	OnlineScore float64
+	Compliant   bool
+	CreatedAt   time.Time
+}
+
+// WalletReport is the uptime SLA compliance of the nodes of a wallet for a period.
+// The wallet is compliant only when all of its nodes are compliant.
+type WalletReport struct {
+	Period         time.Time
+	Wallet         string
+	Nodes          int
+	CompliantNodes int
+	OnlineScore    float64
+	Compliant      bool
+}
+
+// PeriodStart returns the start of the month, which contains t, in UTC.
+func PeriodStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// ComputeNodeReport computes the report of the node for the period from the audit
+// history windows starting within the period. The online score is the average online
+// ratio of the windows, the same way as for the online score of the reputation. It
+// returns false, when the node has no audited windows within the period.
+func ComputeNodeReport(node NodeHistory, period time.Time, minimumOnlineScore float64) (NodeReport, bool) {
+	period = PeriodStart(period)
+	end := period.AddDate(0, 1, 0)
+
+	var windows int
+	var total float64
+	if node.History != nil {
+		for _, window := range node.History.Windows {
+			if window.WindowStart.Before(period) || !window.WindowStart.Before(end) || window.TotalCount == 0 {
+				continue
+			}
+			windows++
+			total += float64(window.OnlineCount) / float64(window.TotalCount)
+		}
+	}
+	if windows == 0 {
+		return NodeReport{}, false
+	}
+
+	score := total / float64(windows)
+	return NodeReport{
+		Period:      period,
+		NodeID:      node.NodeID,
+		Wallet:      node.Wallet,
+		Windows:     windows,
+		OnlineScore: score,
+		Compliant:   score >= minimumOnlineScore,
+	}, true
+}
+
+// WalletReports aggregates the reports of the nodes by the wallet. The online score
+// of a wallet is the average online score of its nodes. The reports are ordered by
+// the wallet.
+func WalletReports(reports []NodeReport) []WalletReport {
+	byWallet := map[string]*WalletReport{}
+	for _, report := range reports {
+		wallet, ok := byWallet[report.Wallet]
+		if !ok {
+			wallet = &WalletReport{
+				Period:    report.Period,
+				Wallet:    report.Wallet,
+				Compliant: true,
+			}
+			byWallet[report.Wallet] = wallet
+		}
+		wallet.Nodes++
+		wallet.OnlineScore += report.OnlineScore
+		if report.Compliant {
+			wallet.CompliantNodes++
+		} else {
+			wallet.Compliant = false
+		}
+	}
+
+	wallets := make([]WalletReport, 0, len(byWallet))
+	for _, wallet := range byWallet {
+		wallet.OnlineScore /= float64(wallet.Nodes)
+		wallets = append(wallets, *wallet)
+	}
+	sort.Slice(wallets, func(i, k int) bool {
+		return wallets[i].Wallet < wallets[k].Wallet
+	})
+	return wallets
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package slareports_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/overlay/slareports"
+)
+
+func TestComputeNodeReport(t *testing.T) {
+	period := time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC)
+
+	node := slareports.NodeHistory{
+		NodeID: testrand.NodeID(),
+		Wallet: "0x0123456789012345678901234567890123456789",
+		History: &pb.AuditHistory{
+			Windows: []*pb.AuditWindow{
+				// outside of the period.
+				{WindowStart: period.Add(-12 * time.Hour), OnlineCount: 0, TotalCount: 10},
+				{WindowStart: period, OnlineCount: 10, TotalCount: 10},
+				{WindowStart: period.Add(12 * time.Hour), OnlineCount: 5, TotalCount: 10},
+				// without audits.
+				{WindowStart: period.Add(24 * time.Hour), OnlineCount: 0, TotalCount: 0},
+				// outside of the period.
+				{WindowStart: period.AddDate(0, 1, 0), OnlineCount: 0, TotalCount: 10},
+			},
+		},
+	}
+
+	report, ok := slareports.ComputeNodeReport(node, period.Add(10*24*time.Hour), 0.7)
+	require.True(t, ok)
+	require.Equal(t, period, report.Period)
+	require.Equal(t, node.NodeID, report.NodeID)
+	require.Equal(t, node.Wallet, report.Wallet)
+	require.Equal(t, 2, report.Windows)
+	require.InDelta(t, 0.75, report.OnlineScore, 1e-9)
+	require.True(t, report.Compliant)
+
+	report, ok = slareports.ComputeNodeReport(node, period, 0.8)
+	require.True(t, ok)
+	require.False(t, report.Compliant)
+
+	_, ok = slareports.ComputeNodeReport(node, period.AddDate(0, 2, 0), 0.8)
+	require.False(t, ok)
+}
+
+func TestWalletReports(t *testing.T) {
+	period := time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC)
+
+	wallets := slareports.WalletReports([]slareports.NodeReport{
+		{Period: period, NodeID: testrand.NodeID(), Wallet: "0xb", OnlineScore: 1, Compliant: true},
+		{Period: period, NodeID: testrand.NodeID(), Wallet: "0xa", OnlineScore: 0.99, Compliant: true},
+		{Period: period, NodeID: testrand.NodeID(), Wallet: "0xa", OnlineScore: 0.5, Compliant: false},
+		{Period: period, NodeID: testrand.NodeID(), Wallet: "0xb", OnlineScore: 0.98, Compliant: true},
+	})
+	require.Len(t, wallets, 2)
+
+	require.Equal(t, "0xa", wallets[0].Wallet)
+	require.Equal(t, period, wallets[0].Period)
+	require.Equal(t, 2, wallets[0].Nodes)
+	require.Equal(t, 1, wallets[0].CompliantNodes)
+	require.InDelta(t, 0.745, wallets[0].OnlineScore, 1e-9)
+	require.False(t, wallets[0].Compliant)
+
+	require.Equal(t, "0xb", wallets[1].Wallet)
+	require.Equal(t, 2, wallets[1].Nodes)
+	require.Equal(t, 2, wallets[1].CompliantNodes)
+	require.InDelta(t, 0.99, wallets[1].OnlineScore, 1e-9)
+	require.True(t, wallets[1].Compliant)
+}
@@ -0,0 +1,29 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"time"
+
+	"storj.io/common/storj"
+)
+
+// StorageEstimate is the satellite's own estimate of the data a storage node
+// stores. Unlike the free disk space, it doesn't depend on what the node reports.
+type StorageEstimate struct {
+	NodeID storj.NodeID
+	// PieceCount and StoredBytes are counted by the garbage collection loop,
+	// which started at EstimatedAt.
+	PieceCount  int64
+	StoredBytes int64
+	// SettledBytes is the ingress the node settled since EstimatedAt.
+	SettledBytes int64
+	EstimatedAt  time.Time
+	UpdatedAt    time.Time
+}
+
+// Total returns the estimated number of the bytes the node stores.
+func (estimate StorageEstimate) Total() int64 {
+	return estimate.StoredBytes + estimate.SettledBytes
+}
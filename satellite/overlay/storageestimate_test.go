@@ -0,0 +1,103 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestNodeSelectionConfig_NearlyFull(t *testing.T) {
+	config := overlay.NodeSelectionConfig{NearlyFullRatio: 0.9}
+
+	require.False(t, config.NearlyFull(0, 0))
+	require.False(t, config.NearlyFull(0, 100))
+	require.False(t, config.NearlyFull(89, 11))
+	require.True(t, config.NearlyFull(90, 10))
+	require.True(t, config.NearlyFull(100, 0))
+	// the stored data is estimated by the satellite, so it may exceed the reported capacity
+	require.True(t, config.NearlyFull(100, -10))
+
+	config.NearlyFullRatio = 0
+	require.False(t, config.NearlyFull(100, 0))
+}
+
+func TestDB_StorageEstimates(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		overlaydb := db.OverlayCache()
+
+		nodeID := testrand.NodeID()
+		d := overlay.NodeCheckInInfo{
+			NodeID:     nodeID,
+			Address:    &pb.NodeAddress{Address: "127.0.0.1:8080"},
+			LastIPPort: "127.0.0.1:8080",
+			LastNet:    "127.0.0",
+			Version:    &pb.NodeVersion{Version: "v1.0.0"},
+		}
+		require.NoError(t, overlaydb.UpdateCheckIn(ctx, d, time.Now().UTC(), overlay.NodeSelectionConfig{}))
+
+		_, err := overlaydb.GetStorageEstimate(ctx, nodeID)
+		require.True(t, overlay.ErrNodeNotFound.Has(err))
+
+		estimatedAt := time.Now().Add(-2 * time.Hour).Truncate(time.Hour).UTC()
+		err = overlaydb.UpdateStorageEstimates(ctx, []overlay.StorageEstimate{{
+			NodeID:      nodeID,
+			PieceCount:  100,
+			StoredBytes: memory.MiB.Int64(),
+			EstimatedAt: estimatedAt,
+		}})
+		require.NoError(t, err)
+
+		estimate, err := overlaydb.GetStorageEstimate(ctx, nodeID)
+		require.NoError(t, err)
+		require.EqualValues(t, 100, estimate.PieceCount)
+		require.Equal(t, memory.MiB.Int64(), estimate.StoredBytes)
+		require.Zero(t, estimate.SettledBytes)
+		require.WithinDuration(t, estimatedAt, estimate.EstimatedAt, time.Second)
+
+		pieceCounts, err := overlaydb.AllPieceCounts(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 100, pieceCounts[nodeID])
+
+		// only the ingress settled after the estimate is added
+		ordersdb := db.Orders()
+		require.NoError(t, ordersdb.UpdateStoragenodeBandwidthSettle(ctx, nodeID, pb.PieceAction_PUT, 1000, estimatedAt.Add(-time.Hour)))
+		require.NoError(t, ordersdb.UpdateStoragenodeBandwidthSettle(ctx, nodeID, pb.PieceAction_PUT, 2000, estimatedAt))
+		require.NoError(t, ordersdb.UpdateStoragenodeBandwidthSettle(ctx, nodeID, pb.PieceAction_PUT_REPAIR, 300, estimatedAt.Add(time.Hour)))
+		require.NoError(t, ordersdb.UpdateStoragenodeBandwidthSettle(ctx, nodeID, pb.PieceAction_GET, 5000, estimatedAt.Add(time.Hour)))
+
+		updated, err := overlaydb.UpdateStorageEstimatesSettled(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, updated)
+
+		estimate, err = overlaydb.GetStorageEstimate(ctx, nodeID)
+		require.NoError(t, err)
+		require.EqualValues(t, 2300, estimate.SettledBytes)
+		require.Equal(t, memory.MiB.Int64()+2300, estimate.Total())
+
+		// a new estimate resets the settled ingress
+		err = overlaydb.UpdateStorageEstimates(ctx, []overlay.StorageEstimate{{
+			NodeID:      nodeID,
+			PieceCount:  200,
+			StoredBytes: 2 * memory.MiB.Int64(),
+			EstimatedAt: estimatedAt.Add(2 * time.Hour),
+		}})
+		require.NoError(t, err)
+
+		estimate, err = overlaydb.GetStorageEstimate(ctx, nodeID)
+		require.NoError(t, err)
+		require.EqualValues(t, 200, estimate.PieceCount)
+		require.Zero(t, estimate.SettledBytes)
+	})
+}
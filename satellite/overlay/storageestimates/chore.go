@@ -0,0 +1,68 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storageestimates
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/overlay"
+)
+
+var mon = monkit.Package()
+
+// Config contains configurable values for storage estimates chore.
+type Config struct {
+	Enabled  bool          `help:"whether the storage estimates of nodes should be updated from the settled ingress" default:"true"`
+	Interval time.Duration `help:"how often to update the storage estimates of nodes from the settled ingress" releaseDefault:"1h" devDefault:"5m" testDefault:"$TESTINTERVAL"`
+}
+
+// Chore adds the ingress settled since the last garbage collection run to the
+// storage estimates of the nodes.
+//
+// The piece counts and stored bytes of the estimates are set by the bloom filter
+// generation, which sees every piece the satellite expects the node to store.
+// In between those runs the settled ingress is the best indication of how much
+// data was added to the node.
+//
+// architecture: Chore
+type Chore struct {
+	log  *zap.Logger
+	db   overlay.DB
+	Loop *sync2.Cycle
+}
+
+// NewChore creates a new storage estimates Chore.
+func NewChore(log *zap.Logger, db overlay.DB, config Config) *Chore {
+	return &Chore{
+		log:  log,
+		db:   db,
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		updated, err := chore.db.UpdateStorageEstimatesSettled(ctx)
+		if err != nil {
+			chore.log.Error("error updating storage estimates", zap.Error(err))
+			return nil
+		}
+		mon.IntVal("storage_estimates_updated").Observe(updated)
+		return nil
+	})
+}
+
+// Close closes chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -31,12 +31,17 @@ type UploadSelectionCacheConfig struct {
 // UploadSelectionCache keeps a list of all the storage nodes that are qualified to store data
 // We organize the nodes by if they are reputable or a new node on the network.
 // The cache will sync with the nodes table in the database and get refreshed once the staleness time has past.
+// The refresh also precomputes the selection pools of the placements, so the uploads with
+// a placement don't evaluate it over all nodes. The pools are refreshed incrementally, only
+// the subnets, which changed since the previous refresh, are evaluated again.
 type UploadSelectionCache struct {
 	log             *zap.Logger
 	db              UploadSelectionDB
 	selectionConfig NodeSelectionConfig
 
 	cache sync2.ReadCacheOf[*uploadselection.State]
+	// state is the state of the previous refresh, the refreshes don't run concurrently.
+	state *uploadselection.State
 }
 
 // NewUploadSelectionCache creates a new cache that keeps a list of all the storage nodes that are qualified to store data.
@@ -73,7 +78,13 @@ func (cache *UploadSelectionCache) read(ctx context.Context) (_ *uploadselection
 		return nil, Error.Wrap(err)
 	}
 
-	state := uploadselection.NewState(convSelectedNodesToNodes(reputableNodes), convSelectedNodesToNodes(newNodes))
+	var state *uploadselection.State
+	if cache.state == nil {
+		state = uploadselection.NewState(convSelectedNodesToNodes(reputableNodes), convSelectedNodesToNodes(newNodes))
+	} else {
+		state = cache.state.Refresh(convSelectedNodesToNodes(reputableNodes), convSelectedNodesToNodes(newNodes))
+	}
+	cache.state = state
 
 	mon.IntVal("refresh_cache_size_reputable").Observe(int64(len(reputableNodes)))
 	mon.IntVal("refresh_cache_size_new").Observe(int64(len(newNodes)))
@@ -98,6 +109,7 @@ func (cache *UploadSelectionCache) GetNodes(ctx context.Context, req FindStorage
 		ExcludedIDs:          req.ExcludedIDs,
 		Placement:            req.Placement,
 		ExcludedCountryCodes: cache.selectionConfig.UploadExcludedCountryCodes,
+		PieceSize:            req.PieceSize,
 	})
 	if uploadselection.ErrNotEnoughNodes.Has(err) {
 		err = ErrNotEnoughNodes.Wrap(err)
@@ -119,11 +131,13 @@ func (cache *UploadSelectionCache) Size(ctx context.Context) (reputableNodeCount
 func convNodesToSelectedNodes(nodes []*uploadselection.Node) (xs []*SelectedNode) {
 	for _, n := range nodes {
 		xs = append(xs, &SelectedNode{
-			ID:          n.ID,
-			Address:     pb.NodeFromNodeURL(n.NodeURL).Address,
-			LastNet:     n.LastNet,
-			LastIPPort:  n.LastIPPort,
-			CountryCode: n.CountryCode,
+			ID:           n.ID,
+			Address:      pb.NodeFromNodeURL(n.NodeURL).Address,
+			LastNet:      n.LastNet,
+			LastIPPort:   n.LastIPPort,
+			CountryCode:  n.CountryCode,
+			MaxPieceSize: n.MaxPieceSize,
+			NearlyFull:   n.NearlyFull,
 		})
 	}
 	return xs
@@ -136,9 +150,11 @@ func convSelectedNodesToNodes(nodes []*SelectedNode) (xs []*uploadselection.Node
 				Id:      n.ID,
 				Address: n.Address,
 			}).NodeURL(),
-			LastNet:     n.LastNet,
-			LastIPPort:  n.LastIPPort,
-			CountryCode: n.CountryCode,
+			LastNet:      n.LastNet,
+			LastIPPort:   n.LastIPPort,
+			CountryCode:  n.CountryCode,
+			MaxPieceSize: n.MaxPieceSize,
+			NearlyFull:   n.NearlyFull,
 		})
 	}
 	return xs
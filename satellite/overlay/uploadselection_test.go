@@ -271,6 +271,50 @@ func TestGetNodesExcludeCountryCodes(t *testing.T) {
 	})
 }
 
+func TestGetNodesPieceSize(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+
+		// the nodes advertise their capabilities after checking in.
+		for _, node := range planet.StorageNodes {
+			node.Contact.Chore.TriggerWait(ctx)
+
+			capabilities, err := satellite.Overlay.Service.GetNodeCapabilities(ctx, node.ID())
+			require.NoError(t, err)
+			require.NotNil(t, capabilities)
+			require.Contains(t, capabilities.HashAlgorithms, pb.PieceHashAlgorithm_SHA256)
+			require.Zero(t, capabilities.MaxPieceSize)
+		}
+
+		err := satellite.Overlay.Service.UpdateNodeCapabilities(ctx, planet.StorageNodes[0].ID(), overlay.NodeCapabilities{
+			HashAlgorithms: []pb.PieceHashAlgorithm{pb.PieceHashAlgorithm_SHA256},
+			MaxPieceSize:   memory.KiB.Int64(),
+		})
+		require.NoError(t, err)
+
+		cache := satellite.Overlay.Service.UploadSelectionCache
+		require.NoError(t, cache.Refresh(ctx))
+
+		selectedNodes, err := cache.GetNodes(ctx, overlay.FindStorageNodesRequest{
+			RequestedCount: 2,
+			PieceSize:      memory.KiB.Int64(),
+		})
+		require.NoError(t, err)
+		require.Len(t, selectedNodes, 2)
+
+		// only the node without a max piece size accepts larger pieces.
+		selectedNodes, err = cache.GetNodes(ctx, overlay.FindStorageNodesRequest{
+			RequestedCount: 2,
+			PieceSize:      memory.KiB.Int64() + 1,
+		})
+		require.Error(t, err)
+		require.Len(t, selectedNodes, 1)
+		require.Equal(t, planet.StorageNodes[1].ID(), selectedNodes[0].ID)
+	})
+}
+
 func TestGetNodesConcurrent(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
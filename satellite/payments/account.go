@@ -48,6 +48,10 @@ type Accounts interface {
 	// Charges returns list of all credit card charges related to account.
 	Charges(ctx context.Context, userID uuid.UUID) ([]Charge, error)
 
+	// ChargeAdjustments returns the refunds and the chargebacks of the credit card charges
+	// related to account, the newest first.
+	ChargeAdjustments(ctx context.Context, userID uuid.UUID) ([]ChargeAdjustment, error)
+
 	// CreditCards exposes all needed functionality to manage account credit cards.
 	CreditCards() CreditCards
 
@@ -7,10 +7,12 @@ import (
 	"context"
 	"time"
 
+	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
 	"storj.io/common/sync2"
+	"storj.io/storj/private/clock"
 )
 
 // ChoreErr is billing chore err class.
@@ -27,6 +29,7 @@ type Chore struct {
 
 	disableLoop bool
 	bonusRate   int64
+	clock       clock.Clock
 }
 
 // NewChore creates new chore.
@@ -38,9 +41,16 @@ func NewChore(log *zap.Logger, paymentTypes []PaymentType, transactionsDB Transa
 		TransactionCycle: sync2.NewCycle(interval),
 		disableLoop:      disableLoop,
 		bonusRate:        bonusRate,
+		clock:            clock.Real{},
 	}
 }
 
+// SetClock sets the clock, which is used to measure how far behind the
+// transactions of the payment types are.
+func (chore *Chore) SetClock(clock clock.Clock) {
+	chore.clock = clock
+}
+
 // Run runs billing transaction loop.
 func (chore *Chore) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -57,6 +67,10 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 				chore.log.Error("unable to determine timestamp of last transaction", zap.Error(ChoreErr.Wrap(err)))
 				continue
 			}
+			if !lastTransactionTime.IsZero() {
+				mon.DurationVal("billing_transactions_lag", monkit.NewSeriesTag("source", paymentType.Source())).Observe(chore.clock.Now().Sub(lastTransactionTime))
+			}
+
 			transactions, err := paymentType.GetNewTransactions(ctx, lastTransactionTime, lastTransactionMetadata)
 			if err != nil {
 				chore.log.Error("unable to get new billing transactions", zap.Error(ChoreErr.Wrap(err)))
@@ -45,8 +45,27 @@ const (
 	TransactionTypeDebit = "debit"
 	// TransactionTypeUnknown indicates that type of this transaction is unknown.
 	TransactionTypeUnknown = "unknown"
+	// TransactionTypeRefund indicates that this transaction is a refund of a card payment.
+	TransactionTypeRefund = "refund"
+	// TransactionTypeChargeback indicates that this transaction is a chargeback of a card
+	// payment, or its reversal when the dispute is won.
+	TransactionTypeChargeback = "chargeback"
 )
 
+// AffectsBalance returns whether the transactions of the type change the balance of the user.
+// Refunds return the money to the card, which paid the refunded charge, so they are only
+// recorded.
+func (t TransactionType) AffectsBalance() bool {
+	return t != TransactionTypeRefund
+}
+
+// CanOverdraw returns whether the transactions of the type can take the balance of the user
+// below zero. Chargebacks are forced by the card issuer, so the disputed amount is owed by
+// the user and the later deposits cover it first.
+func (t TransactionType) CanOverdraw() bool {
+	return t == TransactionTypeChargeback
+}
+
 // TransactionsDB is an interface which defines functionality
 // of DB which stores billing transactions.
 //
@@ -67,8 +86,18 @@ type TransactionsDB interface {
 	List(ctx context.Context, userID uuid.UUID) ([]Transaction, error)
 	// GetBalance returns the current usable balance for the specified user.
 	GetBalance(ctx context.Context, userID uuid.UUID) (currency.Amount, error)
+	// Reassign moves all transactions and the balance of a user to another user.
+	Reassign(ctx context.Context, fromUserID, toUserID uuid.UUID) error
+	// GetByReference returns the transaction of the source with the reference in its metadata.
+	// It returns ErrNoTransactions when there is no such transaction.
+	GetByReference(ctx context.Context, source, reference string) (Transaction, error)
 }
 
+// ReferenceKey is the metadata key of the identifier of the external object, e.g. a Stripe
+// refund, which the transaction was created from. It's used to record the external objects
+// only once.
+const ReferenceKey = "Reference"
+
 // PaymentType is an interface which defines functionality required for all billing payment types. Payment types can
 // include but are not limited to Bitcoin, Ether, credit or debit card, ACH transfer, or even physical transfer of live
 // goats. In each case, a source, type, and method to get new transactions must be defined by the service, though
@@ -191,6 +191,58 @@ func TestTransactionsDBBalance(t *testing.T) {
 	})
 }
 
+func TestTransactionsDBRefundsAndChargebacks(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		userID := testrand.UUID()
+
+		insert := func(txType billing.TransactionType, cents int64, reference string) error {
+			metadata, err := json.Marshal(map[string]interface{}{
+				billing.ReferenceKey: reference,
+			})
+			require.NoError(t, err)
+
+			_, err = db.Billing().Insert(ctx, billing.Transaction{
+				UserID:      userID,
+				Amount:      currency.AmountFromBaseUnits(cents, currency.USDollars),
+				Description: string(txType),
+				Source:      billing.StripeSource,
+				Status:      billing.TransactionStatusCompleted,
+				Type:        txType,
+				Metadata:    metadata,
+				Timestamp:   makeTimestamp(),
+			})
+			return err
+		}
+		balance := func() int64 {
+			balance, err := db.Billing().GetBalance(ctx, userID)
+			require.NoError(t, err)
+			return balance.BaseUnits()
+		}
+
+		require.NoError(t, insert(billing.TransactionTypeCredit, 1000, "credit"))
+
+		// refunds don't change the balance.
+		require.NoError(t, insert(billing.TransactionTypeRefund, -600, "refund"))
+		require.EqualValues(t, 10000000, balance())
+
+		// chargebacks can overdraw the balance, debits can't.
+		require.NoError(t, insert(billing.TransactionTypeChargeback, -1500, "chargeback"))
+		require.EqualValues(t, -5000000, balance())
+		require.ErrorIs(t, insert(billing.TransactionTypeDebit, -100, "debit"), billing.ErrInsufficientFunds)
+
+		// credits are accepted while the balance is overdrawn.
+		require.NoError(t, insert(billing.TransactionTypeCredit, 200, "second credit"))
+		require.EqualValues(t, -3000000, balance())
+
+		tx, err := db.Billing().GetByReference(ctx, billing.StripeSource, "chargeback")
+		require.NoError(t, err)
+		require.Equal(t, billing.TransactionTypeChargeback, tx.Type)
+
+		_, err = db.Billing().GetByReference(ctx, billing.StorjScanSource, "chargeback")
+		require.ErrorIs(t, err, billing.ErrNoTransactions)
+	})
+}
+
 func TestUpdateTransactions(t *testing.T) {
 	tenUSD := currency.AmountFromBaseUnits(1000, currency.USDollars)
 	userID := testrand.UUID()
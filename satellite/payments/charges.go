@@ -19,3 +19,14 @@ type Charge struct {
 	CardInfo  CardInfo  `json:"card"`
 	CreatedAt time.Time `json:"createdAt"`
 }
+
+// ChargeAdjustment is a refund or a chargeback of a credit card charge, which is recorded
+// in the billing transactions.
+type ChargeAdjustment struct {
+	ID          int64     `json:"id"`
+	Type        string    `json:"type"`
+	Description string    `json:"description"`
+	Amount      int64     `json:"amount"`
+	Status      string    `json:"status"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
@@ -17,6 +17,7 @@ import (
 	"storj.io/storj/satellite/payments/billing"
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripe"
+	"storj.io/storj/satellite/payments/tax"
 )
 
 // Error is payments config err class.
@@ -30,6 +31,7 @@ type Config struct {
 	BillingConfig            billing.Config
 	StripeCoinPayments       stripe.Config
 	Storjscan                storjscan.Config
+	Tax                      tax.Config
 	UsagePrice               ProjectUsagePrice
 	BonusRate                int64                      `help:"amount of percents that user will earn as bonus credits by depositing in STORJ tokens" default:"10"`
 	NodeEgressBandwidthPrice int64                      `help:"price node receive for storing TB of egress in cents" default:"2000"`
@@ -23,6 +23,8 @@ type WalletsDB interface {
 	GetUser(ctx context.Context, wallet blockchain.Address) (uuid.UUID, error)
 	// GetAll returns all saved wallet entries.
 	GetAll(ctx context.Context) (_ []Wallet, err error)
+	// Reassign associates the wallet of a user with another user.
+	Reassign(ctx context.Context, fromUserID, toUserID uuid.UUID) error
 }
 
 // Wallet associates a user ID and a wallet address.
@@ -10,9 +10,11 @@ import (
 	"github.com/stripe/stripe-go/v72"
 	"github.com/zeebo/errs"
 
+	"storj.io/common/currency"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/billing"
 )
 
 // ensures that accounts implements payments.Accounts.
@@ -288,6 +290,36 @@ func (accounts *accounts) Charges(ctx context.Context, userID uuid.UUID) (_ []pa
 	return charges, nil
 }
 
+// ChargeAdjustments returns the refunds and the chargebacks of the credit card charges related
+// to account, the newest first.
+func (accounts *accounts) ChargeAdjustments(ctx context.Context, userID uuid.UUID) (_ []payments.ChargeAdjustment, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	txs, err := accounts.service.billingDB.List(ctx, userID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var adjustments []payments.ChargeAdjustment
+	for _, tx := range txs {
+		if tx.Source != billing.StripeSource {
+			continue
+		}
+		if tx.Type != billing.TransactionTypeRefund && tx.Type != billing.TransactionTypeChargeback {
+			continue
+		}
+		adjustments = append(adjustments, payments.ChargeAdjustment{
+			ID:          tx.ID,
+			Type:        string(tx.Type),
+			Description: tx.Description,
+			Amount:      currency.AmountFromDecimal(tx.Amount.AsDecimal(), currency.USDollars).BaseUnits(),
+			Status:      string(tx.Status),
+			CreatedAt:   tx.Timestamp,
+		})
+	}
+	return adjustments, nil
+}
+
 // StorjTokens exposes all storj token related functionality.
 func (accounts *accounts) StorjTokens() payments.StorjTokens {
 	return &storjTokens{service: accounts.service}
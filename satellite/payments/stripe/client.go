@@ -76,6 +76,7 @@ type InvoiceItems interface {
 // Charges Stripe Charges interface.
 type Charges interface {
 	List(listParams *stripe.ChargeListParams) *charge.Iter
+	Get(id string, params *stripe.ChargeParams) (*stripe.Charge, error)
 }
 
 // PromoCodes is the Stripe PromoCodes interface.
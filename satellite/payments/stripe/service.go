@@ -29,6 +29,7 @@ import (
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/billing"
 	"storj.io/storj/satellite/payments/storjscan"
+	"storj.io/storj/satellite/payments/tax"
 )
 
 var (
@@ -50,6 +51,7 @@ type Config struct {
 	ListingLimit           int    `help:"sets the maximum amount of items before we start paging on requests" default:"100" hidden:"true"`
 	SkipEmptyInvoices      bool   `help:"if set, skips the creation of empty invoices for customers with zero usage for the billing period" default:"true"`
 	MaxParallelCalls       int    `help:"the maximum number of concurrent Stripe API calls in invoicing methods" default:"10"`
+	WebhookSecret          string `help:"signing secret of the Stripe webhook, which reports the refunds and the chargebacks, the webhook is disabled when empty" default:""`
 	Retries                RetryConfig
 }
 
@@ -68,6 +70,9 @@ type Service struct {
 	usageDB      accounting.ProjectAccounting
 	stripeClient Client
 
+	taxes            tax.Engine
+	billingAddresses console.BillingAddresses
+
 	usagePrices         payments.ProjectUsagePriceModel
 	usagePriceOverrides map[string]payments.ProjectUsagePriceModel
 	packagePlans        map[string]payments.PackagePlan
@@ -83,6 +88,7 @@ type Service struct {
 	listingLimit      int
 	skipEmptyInvoices bool
 	maxParallelCalls  int
+	webhookSecret     string
 	nowFn             func() time.Time
 }
 
@@ -112,6 +118,7 @@ func NewService(log *zap.Logger, stripeClient Client, config Config, db DB, wall
 		listingLimit:           config.ListingLimit,
 		skipEmptyInvoices:      config.SkipEmptyInvoices,
 		maxParallelCalls:       config.MaxParallelCalls,
+		webhookSecret:          config.WebhookSecret,
 		nowFn:                  time.Now,
 	}, nil
 }
@@ -560,6 +567,14 @@ func (service *Service) InvoiceItemsFromProjectUsage(projName string, partnerUsa
 		projectItem.UnitAmountDecimal = stripe.Float64(egressPrice)
 		result = append(result, projectItem)
 
+		// the repair and the audit egress is listed for the transparency, but it isn't charged.
+		if usage.RepairEgress > 0 {
+			result = append(result, exemptEgressItem(prefix+" - Repair Egress Bandwidth (MB, not charged)", usage.RepairEgress))
+		}
+		if usage.AuditEgress > 0 {
+			result = append(result, exemptEgressItem(prefix+" - Audit Egress Bandwidth (MB, not charged)", usage.AuditEgress))
+		}
+
 		projectItem = &stripe.InvoiceItemParams{}
 		projectItem.Description = stripe.String(prefix + " - Segment Fee (Segment-Month)")
 		projectItem.Quantity = stripe.Int64(segmentMonthDecimal(usage.SegmentCount).IntPart())
@@ -573,6 +588,15 @@ func (service *Service) InvoiceItemsFromProjectUsage(projName string, partnerUsa
 	return result
 }
 
+// exemptEgressItem returns a zero-rated invoice item for the egress, which isn't charged.
+func exemptEgressItem(description string, egress int64) *stripe.InvoiceItemParams {
+	item := &stripe.InvoiceItemParams{}
+	item.Description = stripe.String(description)
+	item.Quantity = stripe.Int64(egressMBDecimal(egress).IntPart())
+	item.UnitAmountDecimal = stripe.Float64(0)
+	return item
+}
+
 // ApplyFreeTierCoupons iterates through all customers in Stripe. For each customer,
 // if that customer does not currently have a Stripe coupon, the free tier Stripe coupon
 // is applied.
@@ -704,6 +728,10 @@ func (service *Service) CreateInvoices(ctx context.Context, period time.Time) (e
 func (service *Service) createInvoice(ctx context.Context, cusID string, period time.Time) (stripeInvoice *stripe.Invoice, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	if err := service.addTaxInvoiceItem(ctx, cusID); err != nil {
+		return nil, err
+	}
+
 	description := fmt.Sprintf("Storj DCS Cloud Storage for %s %d", period.Month(), period.Year())
 	stripeInvoice, err = service.stripeClient.Invoices().New(
 		&stripe.InvoiceParams{
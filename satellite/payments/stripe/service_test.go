@@ -33,6 +33,7 @@ import (
 	"storj.io/storj/satellite/payments/billing"
 	"storj.io/storj/satellite/payments/paymentsconfig"
 	stripe1 "storj.io/storj/satellite/payments/stripe"
+	"storj.io/storj/satellite/payments/tax"
 )
 
 func TestService_BalanceInvoiceItems(t *testing.T) {
@@ -397,6 +398,29 @@ func TestService_InvoiceItemsFromProjectUsage(t *testing.T) {
 				require.Equal(t, segment, *items[2].UnitAmountDecimal)
 			})
 		}
+
+		t.Run("repair and audit egress isn't charged", func(t *testing.T) {
+			items := planet.Satellites[0].API.Payments.StripeService.InvoiceItemsFromProjectUsage(projectName, map[string]accounting.ProjectUsage{
+				"": {
+					Egress:       123 * memory.MB.Int64(),
+					RepairEgress: 456 * memory.MB.Int64(),
+					AuditEgress:  789 * memory.MB.Int64(),
+				},
+			})
+			require.Len(t, items, 5)
+
+			prefix := "Project " + projectName
+			require.Equal(t, prefix+" - Egress Bandwidth (MB)", *items[1].Description)
+			require.EqualValues(t, 123, *items[1].Quantity)
+
+			require.Equal(t, prefix+" - Repair Egress Bandwidth (MB, not charged)", *items[2].Description)
+			require.EqualValues(t, 456, *items[2].Quantity)
+			require.Zero(t, *items[2].UnitAmountDecimal)
+
+			require.Equal(t, prefix+" - Audit Egress Bandwidth (MB, not charged)", *items[3].Description)
+			require.EqualValues(t, 789, *items[3].Quantity)
+			require.Zero(t, *items[3].UnitAmountDecimal)
+		})
 	})
 }
 
@@ -756,6 +780,83 @@ func TestService_GenerateInvoice(t *testing.T) {
 	}
 }
 
+func TestService_InvoiceTaxes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		payments := sat.API.Payments
+
+		engine, err := tax.NewEUVAT(tax.Config{})
+		require.NoError(t, err)
+		payments.StripeService.SetTaxes(engine, sat.DB.Console().BillingAddresses())
+
+		period := time.Now().UTC()
+		payments.StripeService.SetNow(func() time.Time {
+			return period.AddDate(0, 1, 0)
+		})
+
+		addCustomer := func(name string, address *console.BillingAddress) string {
+			user, err := sat.AddUser(ctx, console.CreateUser{
+				FullName: name,
+				Email:    name + "@mail.test",
+			}, 1)
+			require.NoError(t, err)
+
+			if address != nil {
+				require.NoError(t, sat.DB.Console().BillingAddresses().Upsert(ctx, user.ID, *address))
+			}
+
+			cusID, err := sat.DB.StripeCoinPayments().Customers().GetCustomerID(ctx, user.ID)
+			require.NoError(t, err)
+
+			_, err = payments.StripeClient.InvoiceItems().New(&stripe.InvoiceItemParams{
+				Params:      stripe.Params{Context: ctx},
+				Customer:    stripe.String(cusID),
+				Amount:      stripe.Int64(1000),
+				Description: stripe.String("usage"),
+			})
+			require.NoError(t, err)
+			return cusID
+		}
+
+		consumer := addCustomer("consumer", &console.BillingAddress{Name: "Consumer", Country: "DE"})
+		business := addCustomer("business", &console.BillingAddress{Name: "Business", Country: "NL", TaxID: "NL123456789B01"})
+		foreign := addCustomer("foreign", &console.BillingAddress{Name: "Foreign", Country: "US"})
+		unknown := addCustomer("unknown", nil)
+
+		require.NoError(t, payments.StripeService.CreateInvoices(ctx, period))
+
+		taxItem := func(cusID string) *stripe.InvoiceItem {
+			var found *stripe.InvoiceItem
+			for _, item := range getCustomerInvoiceItems(ctx, payments.StripeClient, cusID) {
+				if _, ok := item.Metadata["tax"]; ok {
+					require.Nil(t, found, "more than one tax item")
+					found = item
+				}
+			}
+			return found
+		}
+
+		item := taxItem(consumer)
+		require.NotNil(t, item)
+		require.EqualValues(t, 190, item.Amount)
+		require.Equal(t, "VAT", item.Metadata["tax"])
+		require.Equal(t, "DE", item.Metadata["taxCountry"])
+		require.Equal(t, "19", item.Metadata["taxRate"])
+		require.NotNil(t, item.Invoice)
+
+		item = taxItem(business)
+		require.NotNil(t, item)
+		require.Zero(t, item.Amount)
+		require.Equal(t, "true", item.Metadata["reverseCharge"])
+		require.Equal(t, "NL123456789B01", item.Metadata["taxID"])
+
+		require.Nil(t, taxItem(foreign))
+		require.Nil(t, taxItem(unknown))
+	})
+}
+
 func getCustomerInvoice(ctx context.Context, stripeClient stripe1.Client, cusID string) (*stripe.Invoice, bool) {
 	iter := stripeClient.Invoices().List(&stripe.InvoiceListParams{
 		ListParams: stripe.ListParams{Context: ctx},
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"sync"
 	"time"
 
@@ -697,8 +698,17 @@ func (m *mockInvoiceItems) New(params *stripe.InvoiceItemParams) (*stripe.Invoic
 	if params.UnitAmount != nil {
 		item.UnitAmount = *params.UnitAmount
 	}
-	if params.Amount != nil {
+	item.Quantity = 1
+	if params.Quantity != nil {
+		item.Quantity = *params.Quantity
+	}
+	switch {
+	case params.Amount != nil:
 		item.Amount = *params.Amount
+	case params.UnitAmountDecimal != nil:
+		item.Amount = int64(math.Round(item.UnitAmountDecimal * float64(item.Quantity)))
+	default:
+		item.Amount = item.UnitAmount * item.Quantity
 	}
 	m.items[*params.Customer] = append(m.items[*params.Customer], item)
 
@@ -720,10 +730,13 @@ func (m *mockInvoiceItems) List(listParams *stripe.InvoiceItemListParams) *invoi
 		if !ok {
 			list = []*stripe.InvoiceItem{}
 		}
-		ret := make([]interface{}, len(list))
+		ret := make([]interface{}, 0, len(list))
 
-		for i, v := range list {
-			ret[i] = v
+		for _, v := range list {
+			if listParams.Pending != nil && *listParams.Pending && v.Invoice != nil {
+				continue
+			}
+			ret = append(ret, v)
 		}
 
 		return ret, lc, nil
@@ -802,6 +815,10 @@ func (m *mockCharges) List(listParams *stripe.ChargeListParams) *charge.Iter {
 	return &charge.Iter{Iter: stripe.GetIter(listParams, mockEmptyQuery)}
 }
 
+func (m *mockCharges) Get(id string, params *stripe.ChargeParams) (*stripe.Charge, error) {
+	return nil, &stripe.Error{Code: stripe.ErrorCodeResourceMissing}
+}
+
 type mockPromoCodes struct {
 	root *mockStripeState
 
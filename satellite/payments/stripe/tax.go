@@ -0,0 +1,129 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stripe
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+	"github.com/stripe/stripe-go/v72"
+
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/payments/tax"
+)
+
+// taxMetadataKey marks the invoice items of the taxes, so they aren't taxed themselves and
+// aren't added twice when the invoice creation is retried.
+const taxMetadataKey = "tax"
+
+// SetTaxes sets the tax engine and the billing addresses, which the taxes added to the
+// invoices are calculated from.
+func (service *Service) SetTaxes(engine tax.Engine, addresses console.BillingAddresses) {
+	service.taxes = engine
+	service.billingAddresses = addresses
+}
+
+// addTaxInvoiceItem adds the tax of the pending invoice items of the customer as an
+// invoice item, which is included in the next invoice of the customer.
+func (service *Service) addTaxInvoiceItem(ctx context.Context, cusID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if service.taxes == nil || service.billingAddresses == nil {
+		return nil
+	}
+
+	userID, err := service.db.Customers().GetUserID(ctx, cusID)
+	if err != nil {
+		return err
+	}
+	address, err := service.billingAddresses.Get(ctx, userID)
+	if err != nil {
+		if console.ErrBillingAddressNotFound.Has(err) {
+			return nil
+		}
+		return err
+	}
+
+	var amount int64
+	itemIterator := service.stripeClient.InvoiceItems().List(&stripe.InvoiceItemListParams{
+		ListParams: stripe.ListParams{Context: ctx},
+		Customer:   stripe.String(cusID),
+		Pending:    stripe.Bool(true),
+	})
+	for itemIterator.Next() {
+		item := itemIterator.InvoiceItem()
+		if _, ok := item.Metadata[taxMetadataKey]; ok {
+			return nil
+		}
+		amount += item.Amount
+	}
+	if err = itemIterator.Err(); err != nil {
+		return err
+	}
+
+	amount, err = service.discountedAmount(ctx, cusID, amount)
+	if err != nil {
+		return err
+	}
+
+	calculated, err := service.taxes.Calculate(tax.Customer{
+		Country: address.Country,
+		TaxID:   address.TaxID,
+	}, amount)
+	if err != nil {
+		return err
+	}
+	if calculated == nil {
+		return nil
+	}
+
+	description := fmt.Sprintf("%s %s%% (%s)", calculated.Name, calculated.Rate.String(), calculated.Country)
+	if calculated.ReverseCharge {
+		description = fmt.Sprintf("%s reverse charge (%s), customer VAT ID %s", calculated.Name, calculated.Country, calculated.TaxID)
+	}
+
+	item := &stripe.InvoiceItemParams{
+		Params:      stripe.Params{Context: ctx},
+		Currency:    stripe.String(string(stripe.CurrencyUSD)),
+		Customer:    stripe.String(cusID),
+		Amount:      stripe.Int64(calculated.Amount),
+		Description: stripe.String(description),
+	}
+	item.AddMetadata(taxMetadataKey, calculated.Name)
+	item.AddMetadata("taxCountry", calculated.Country)
+	item.AddMetadata("taxRate", calculated.Rate.String())
+	item.AddMetadata("taxableAmount", decimal.NewFromInt(amount).String())
+	if calculated.ReverseCharge {
+		item.AddMetadata("reverseCharge", "true")
+		item.AddMetadata("taxID", calculated.TaxID)
+	}
+
+	_, err = service.stripeClient.InvoiceItems().New(item)
+	return err
+}
+
+// discountedAmount returns the amount in cents after the coupon of the customer is applied,
+// because the taxes are charged on the discounted amount.
+func (service *Service) discountedAmount(ctx context.Context, cusID string, amount int64) (_ int64, err error) {
+	customer, err := service.stripeClient.Customers().Get(cusID, &stripe.CustomerParams{Params: stripe.Params{Context: ctx}})
+	if err != nil {
+		return 0, err
+	}
+	if customer.Discount == nil || customer.Discount.Coupon == nil || !customer.Discount.Coupon.Valid {
+		return amount, nil
+	}
+
+	coupon := customer.Discount.Coupon
+	switch {
+	case coupon.AmountOff > 0:
+		amount -= coupon.AmountOff
+	case coupon.PercentOff > 0:
+		amount = decimal.NewFromInt(amount).Mul(decimal.NewFromFloat(100 - coupon.PercentOff)).Div(decimal.NewFromInt(100)).Round(0).IntPart()
+	}
+	if amount < 0 {
+		return 0, nil
+	}
+	return amount, nil
+}
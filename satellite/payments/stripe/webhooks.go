@@ -0,0 +1,305 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stripe
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/webhook"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/currency"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/payments/billing"
+)
+
+// ErrWebhookSignature is returned when the signature of the Stripe webhook event is invalid.
+var ErrWebhookSignature = errs.Class("stripe webhook signature")
+
+// The Stripe events, which change the ledger of the billing transactions.
+const (
+	eventChargeRefunded         = "charge.refunded"
+	eventChargeRefundUpdated    = "charge.refund.updated"
+	eventDisputeFundsWithdrawn  = "charge.dispute.funds_withdrawn"
+	eventDisputeFundsReinstated = "charge.dispute.funds_reinstated"
+)
+
+// WebhookEnabled returns whether the Stripe webhook is configured.
+func (service *Service) WebhookEnabled() bool {
+	return service.webhookSecret != ""
+}
+
+// HandleWebhook verifies the signature of the Stripe webhook event and records the refunds
+// and the chargebacks, which it reports, as billing transactions.
+func (service *Service) HandleWebhook(ctx context.Context, payload []byte, signature string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !service.WebhookEnabled() {
+		return ErrWebhookSignature.New("webhook is disabled")
+	}
+
+	event, err := webhook.ConstructEvent(payload, signature, service.webhookSecret)
+	if err != nil {
+		return ErrWebhookSignature.Wrap(err)
+	}
+	return service.HandleWebhookEvent(ctx, event)
+}
+
+// HandleWebhookEvent records the refunds and the chargebacks reported by the Stripe event as
+// billing transactions. The events are delivered at least once, so the transactions are
+// recorded only when they don't exist yet.
+func (service *Service) HandleWebhookEvent(ctx context.Context, event stripe.Event) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if event.Data == nil {
+		return Error.New("event %s has no data", event.ID)
+	}
+
+	switch event.Type {
+	case eventChargeRefunded:
+		var charge stripe.Charge
+		if err := json.Unmarshal(event.Data.Raw, &charge); err != nil {
+			return Error.Wrap(err)
+		}
+		return service.recordRefunds(ctx, &charge)
+	case eventChargeRefundUpdated:
+		var refund stripe.Refund
+		if err := json.Unmarshal(event.Data.Raw, &refund); err != nil {
+			return Error.Wrap(err)
+		}
+		return service.updateRefund(ctx, &refund)
+	case eventDisputeFundsWithdrawn, eventDisputeFundsReinstated:
+		var dispute stripe.Dispute
+		if err := json.Unmarshal(event.Data.Raw, &dispute); err != nil {
+			return Error.Wrap(err)
+		}
+		return service.recordChargeback(ctx, &dispute, event.Type == eventDisputeFundsReinstated)
+	default:
+		service.log.Debug("ignoring stripe webhook event", zap.String("Event ID", event.ID), zap.String("Type", event.Type))
+		return nil
+	}
+}
+
+// recordRefunds records the refunds of the charge, which haven't been recorded yet.
+func (service *Service) recordRefunds(ctx context.Context, charge *stripe.Charge) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if charge.Refunds == nil || len(charge.Refunds.Data) == 0 {
+		return nil
+	}
+
+	userID, ok, err := service.chargeUser(ctx, charge)
+	if err != nil || !ok {
+		return err
+	}
+
+	for _, refund := range charge.Refunds.Data {
+		status, ok := refundTransactionStatus(refund.Status)
+		if !ok {
+			continue
+		}
+
+		_, err := service.billingDB.GetByReference(ctx, billing.StripeSource, refund.ID)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, billing.ErrNoTransactions) {
+			return Error.Wrap(err)
+		}
+
+		amount, err := usdAmount(refund.Currency, -refund.Amount)
+		if err != nil {
+			service.log.Warn("ignoring refund", zap.String("Refund ID", refund.ID), zap.Error(err))
+			continue
+		}
+
+		description := "Refund of card payment"
+		if refund.Reason != "" {
+			description = fmt.Sprintf("%s (%s)", description, refund.Reason)
+		}
+
+		metadata, err := json.Marshal(map[string]interface{}{
+			billing.ReferenceKey: refund.ID,
+			"ChargeID":           charge.ID,
+			"Reason":             string(refund.Reason),
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		_, err = service.billingDB.Insert(ctx, billing.Transaction{
+			UserID:      userID,
+			Amount:      amount,
+			Description: description,
+			Source:      billing.StripeSource,
+			Status:      status,
+			Type:        billing.TransactionTypeRefund,
+			Metadata:    metadata,
+			Timestamp:   time.Unix(refund.Created, 0).UTC(),
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		mon.Meter("stripe_refunds_recorded").Mark(1) //mon:locked
+	}
+	return nil
+}
+
+// updateRefund updates the status of the recorded refund.
+func (service *Service) updateRefund(ctx context.Context, refund *stripe.Refund) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tx, err := service.billingDB.GetByReference(ctx, billing.StripeSource, refund.ID)
+	if err != nil {
+		if errors.Is(err, billing.ErrNoTransactions) {
+			// the refund is recorded with its current status from the charge.refunded event.
+			return nil
+		}
+		return Error.Wrap(err)
+	}
+
+	status, ok := refundTransactionStatus(refund.Status)
+	if !ok {
+		status = billing.TransactionStatusCancelled
+	}
+	if status == tx.Status {
+		return nil
+	}
+	return Error.Wrap(service.billingDB.UpdateStatus(ctx, tx.ID, status))
+}
+
+// recordChargeback records the funds withdrawn from the satellite because of the dispute, or
+// their reinstatement when the dispute is won.
+func (service *Service) recordChargeback(ctx context.Context, dispute *stripe.Dispute, reinstated bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if dispute.Charge == nil {
+		return Error.New("dispute %s has no charge", dispute.ID)
+	}
+
+	withdrawnReference := dispute.ID + ":withdrawn"
+	reference, description, amount := withdrawnReference, "Chargeback of card payment", -dispute.Amount
+	if reinstated {
+		reference, description, amount = dispute.ID+":reinstated", "Chargeback reversal", dispute.Amount
+	}
+
+	_, err = service.billingDB.GetByReference(ctx, billing.StripeSource, reference)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, billing.ErrNoTransactions) {
+		return Error.Wrap(err)
+	}
+
+	if reinstated {
+		// only the withdrawn funds are reinstated.
+		_, err = service.billingDB.GetByReference(ctx, billing.StripeSource, withdrawnReference)
+		if err != nil {
+			if errors.Is(err, billing.ErrNoTransactions) {
+				service.log.Warn("ignoring reinstatement of unrecorded chargeback", zap.String("Dispute ID", dispute.ID))
+				return nil
+			}
+			return Error.Wrap(err)
+		}
+	}
+
+	usd, err := usdAmount(dispute.Currency, amount)
+	if err != nil {
+		service.log.Warn("ignoring chargeback", zap.String("Dispute ID", dispute.ID), zap.Error(err))
+		return nil
+	}
+
+	charge := dispute.Charge
+	if charge.Customer == nil {
+		// the charge of the dispute isn't expanded in the webhook events.
+		charge, err = service.stripeClient.Charges().Get(charge.ID, &stripe.ChargeParams{Params: stripe.Params{Context: ctx}})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	userID, ok, err := service.chargeUser(ctx, charge)
+	if err != nil || !ok {
+		return err
+	}
+
+	metadata, err := json.Marshal(map[string]interface{}{
+		billing.ReferenceKey: reference,
+		"ChargeID":           charge.ID,
+		"DisputeID":          dispute.ID,
+		"Reason":             string(dispute.Reason),
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = service.billingDB.Insert(ctx, billing.Transaction{
+		UserID:      userID,
+		Amount:      usd,
+		Description: description,
+		Source:      billing.StripeSource,
+		Status:      billing.TransactionStatusCompleted,
+		Type:        billing.TransactionTypeChargeback,
+		Metadata:    metadata,
+		Timestamp:   service.nowFn().UTC(),
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if reinstated {
+		mon.Meter("stripe_chargebacks_reinstated").Mark(1) //mon:locked
+	} else {
+		mon.Meter("stripe_chargebacks_recorded").Mark(1) //mon:locked
+	}
+	return nil
+}
+
+// chargeUser returns the user, who is the customer of the charge. It returns false, when
+// the charge doesn't belong to any user of the satellite.
+func (service *Service) chargeUser(ctx context.Context, charge *stripe.Charge) (_ uuid.UUID, ok bool, err error) {
+	if charge.Customer == nil || charge.Customer.ID == "" {
+		service.log.Warn("ignoring charge without customer", zap.String("Charge ID", charge.ID))
+		return uuid.UUID{}, false, nil
+	}
+
+	userID, err := service.db.Customers().GetUserID(ctx, charge.Customer.ID)
+	if err != nil {
+		if errors.Is(err, ErrNoCustomer) {
+			service.log.Warn("ignoring charge of unknown customer",
+				zap.String("Charge ID", charge.ID),
+				zap.String("Customer ID", charge.Customer.ID))
+			return uuid.UUID{}, false, nil
+		}
+		return uuid.UUID{}, false, Error.Wrap(err)
+	}
+	return userID, true, nil
+}
+
+// refundTransactionStatus returns the status of the billing transaction of the refund. It
+// returns false, when the refund failed or was canceled.
+func refundTransactionStatus(status stripe.RefundStatus) (billing.TransactionStatus, bool) {
+	switch status {
+	case stripe.RefundStatusSucceeded:
+		return billing.TransactionStatusCompleted, true
+	case stripe.RefundStatusPending:
+		return billing.TransactionStatusPending, true
+	default:
+		return "", false
+	}
+}
+
+// usdAmount converts the amount in cents of the Stripe currency.
+func usdAmount(c stripe.Currency, cents int64) (currency.Amount, error) {
+	if c != stripe.CurrencyUSD {
+		return currency.Amount{}, errs.New("unsupported currency %q", c)
+	}
+	return currency.AmountFromBaseUnits(cents, currency.USDollars), nil
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stripe_test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v72/webhook"
+	"go.uber.org/zap"
+
+	"storj.io/common/currency"
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/payments/billing"
+	"storj.io/storj/satellite/payments/stripe"
+)
+
+func TestWebhookRefundsAndChargebacks(t *testing.T) {
+	const secret = "whsec_test"
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Payments.StripeCoinPayments.WebhookSecret = secret
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Payments.StripeService
+		userID := planet.Uplinks[0].Projects[0].Owner.ID
+
+		customerID, err := sat.DB.StripeCoinPayments().Customers().GetCustomerID(ctx, userID)
+		require.NoError(t, err)
+
+		send := func(eventType, object string) error {
+			payload := []byte(fmt.Sprintf(`{"id":"evt_test","type":%q,"data":{"object":%s}}`, eventType, object))
+			now := time.Now()
+			signature := fmt.Sprintf("t=%d,v1=%s", now.Unix(), hex.EncodeToString(webhook.ComputeSignature(now, payload, secret)))
+			return service.HandleWebhook(ctx, payload, signature)
+		}
+
+		balance := func() int64 {
+			balance, err := sat.DB.Billing().GetBalance(ctx, userID)
+			require.NoError(t, err)
+			return currency.AmountFromDecimal(balance.AsDecimal(), currency.USDollars).BaseUnits()
+		}
+
+		_, err = sat.DB.Billing().Insert(ctx, billing.Transaction{
+			UserID:      userID,
+			Amount:      currency.AmountFromBaseUnits(500, currency.USDollars),
+			Description: "credit from storjscan payment",
+			Source:      billing.StorjScanSource,
+			Status:      billing.TransactionStatusCompleted,
+			Type:        billing.TransactionTypeCredit,
+			Metadata:    []byte(`{}`),
+			Timestamp:   time.Now(),
+		})
+		require.NoError(t, err)
+
+		t.Run("invalid signature", func(t *testing.T) {
+			err := service.HandleWebhook(ctx, []byte(`{"id":"evt_test"}`), "t=1,v1=00")
+			require.True(t, stripe.ErrWebhookSignature.Has(err), err)
+		})
+
+		charge := fmt.Sprintf(`{"id":"ch_test","customer":%q,"refunds":{"object":"list","data":[
+			{"id":"re_test","amount":300,"currency":"usd","status":"succeeded","reason":"duplicate","created":%d}
+		]}}`, customerID, time.Now().Unix())
+
+		// the refunds are recorded only once and don't change the balance.
+		for i := 0; i < 2; i++ {
+			require.NoError(t, send("charge.refunded", charge))
+		}
+		require.EqualValues(t, 500, balance())
+
+		// the chargebacks can overdraw the balance.
+		dispute := fmt.Sprintf(`{"id":"dp_test","amount":1000,"currency":"usd","reason":"fraudulent","charge":{"id":"ch_test","customer":%q}}`, customerID)
+		for i := 0; i < 2; i++ {
+			require.NoError(t, send("charge.dispute.funds_withdrawn", dispute))
+		}
+		require.EqualValues(t, -500, balance())
+
+		require.NoError(t, send("charge.dispute.funds_reinstated", dispute))
+		require.EqualValues(t, 500, balance())
+
+		// the reinstatement of an unrecorded chargeback is ignored.
+		unrecorded := fmt.Sprintf(`{"id":"dp_other","amount":1000,"currency":"usd","charge":{"id":"ch_test","customer":%q}}`, customerID)
+		require.NoError(t, send("charge.dispute.funds_reinstated", unrecorded))
+		require.EqualValues(t, 500, balance())
+
+		require.NoError(t, send("charge.refund.updated", `{"id":"re_test","amount":300,"currency":"usd","status":"failed"}`))
+
+		adjustments, err := sat.API.Payments.Accounts.ChargeAdjustments(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, adjustments, 3)
+
+		var refunds, chargebacks int
+		for _, adjustment := range adjustments {
+			switch adjustment.Type {
+			case string(billing.TransactionTypeRefund):
+				refunds++
+				require.EqualValues(t, -300, adjustment.Amount)
+				require.Equal(t, string(billing.TransactionStatusCancelled), adjustment.Status)
+			case string(billing.TransactionTypeChargeback):
+				chargebacks++
+				require.Contains(t, []int64{-1000, 1000}, adjustment.Amount)
+			}
+		}
+		require.Equal(t, 1, refunds)
+		require.Equal(t, 2, chargebacks)
+	})
+}
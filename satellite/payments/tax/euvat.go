@@ -0,0 +1,143 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package tax
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// euCountry is the VAT of an EU member state.
+type euCountry struct {
+	// prefix is the prefix of the VAT IDs, which differs from the country code for Greece.
+	prefix string
+	// rate is the standard VAT rate in percents.
+	rate int64
+	// format matches the VAT IDs without the prefix.
+	format *regexp.Regexp
+}
+
+// euCountries are the standard VAT rates and the VAT ID formats of the EU member states.
+var euCountries = map[string]euCountry{
+	"AT": {"AT", 20, regexp.MustCompile(`^U[0-9]{8}$`)},
+	"BE": {"BE", 21, regexp.MustCompile(`^[01][0-9]{9}$`)},
+	"BG": {"BG", 20, regexp.MustCompile(`^[0-9]{9,10}$`)},
+	"CY": {"CY", 19, regexp.MustCompile(`^[0-9]{8}[A-Z]$`)},
+	"CZ": {"CZ", 21, regexp.MustCompile(`^[0-9]{8,10}$`)},
+	"DE": {"DE", 19, regexp.MustCompile(`^[0-9]{9}$`)},
+	"DK": {"DK", 25, regexp.MustCompile(`^[0-9]{8}$`)},
+	"EE": {"EE", 20, regexp.MustCompile(`^[0-9]{9}$`)},
+	"ES": {"ES", 21, regexp.MustCompile(`^[0-9A-Z][0-9]{7}[0-9A-Z]$`)},
+	"FI": {"FI", 24, regexp.MustCompile(`^[0-9]{8}$`)},
+	"FR": {"FR", 20, regexp.MustCompile(`^[0-9A-Z]{2}[0-9]{9}$`)},
+	"GR": {"EL", 24, regexp.MustCompile(`^[0-9]{9}$`)},
+	"HR": {"HR", 25, regexp.MustCompile(`^[0-9]{11}$`)},
+	"HU": {"HU", 27, regexp.MustCompile(`^[0-9]{8}$`)},
+	"IE": {"IE", 23, regexp.MustCompile(`^[0-9][0-9A-Z+*][0-9]{5}[A-Z]{1,2}$`)},
+	"IT": {"IT", 22, regexp.MustCompile(`^[0-9]{11}$`)},
+	"LT": {"LT", 21, regexp.MustCompile(`^([0-9]{9}|[0-9]{12})$`)},
+	"LU": {"LU", 17, regexp.MustCompile(`^[0-9]{8}$`)},
+	"LV": {"LV", 21, regexp.MustCompile(`^[0-9]{11}$`)},
+	"MT": {"MT", 18, regexp.MustCompile(`^[0-9]{8}$`)},
+	"NL": {"NL", 21, regexp.MustCompile(`^[0-9]{9}B[0-9]{2}$`)},
+	"PL": {"PL", 23, regexp.MustCompile(`^[0-9]{10}$`)},
+	"PT": {"PT", 23, regexp.MustCompile(`^[0-9]{9}$`)},
+	"RO": {"RO", 19, regexp.MustCompile(`^[0-9]{2,10}$`)},
+	"SE": {"SE", 25, regexp.MustCompile(`^[0-9]{12}$`)},
+	"SI": {"SI", 22, regexp.MustCompile(`^[0-9]{8}$`)},
+	"SK": {"SK", 20, regexp.MustCompile(`^[0-9]{10}$`)},
+}
+
+// maxTaxIDLength is the maximum length of the tax IDs of the countries outside of the EU,
+// whose format isn't checked.
+const maxTaxIDLength = 32
+
+// Ensure that EUVAT implements Engine.
+var _ Engine = (*EUVAT)(nil)
+
+// EUVAT charges the VAT of the EU member state of the customer. The businesses with a VAT
+// ID of another member state than the seller account for the VAT themselves (reverse
+// charge), and the customers outside of the EU aren't charged any tax.
+//
+// The VAT IDs are only checked for their format, not for their registration in VIES.
+type EUVAT struct {
+	sellerCountry string
+}
+
+// NewEUVAT creates a new EU VAT engine.
+func NewEUVAT(config Config) (*EUVAT, error) {
+	sellerCountry := normalize(config.SellerCountry)
+	if sellerCountry != "" {
+		if _, ok := euCountries[sellerCountry]; !ok {
+			return nil, Error.New("seller country %q is not an EU member state", config.SellerCountry)
+		}
+	}
+	return &EUVAT{sellerCountry: sellerCountry}, nil
+}
+
+// NormalizeTaxID validates the tax ID of a customer in the country and returns it in its
+// canonical form. The VAT IDs of the EU member states are returned with their prefix.
+func (engine *EUVAT) NormalizeTaxID(country, taxID string) (string, error) {
+	country = normalize(country)
+	taxID = normalize(taxID)
+	if !isCountryCode(country) {
+		return "", ErrInvalidTaxID.New("invalid country %q", country)
+	}
+	if taxID == "" {
+		return "", nil
+	}
+
+	eu, ok := euCountries[country]
+	if !ok {
+		if len(taxID) > maxTaxIDLength {
+			return "", ErrInvalidTaxID.New("tax ID is longer than %d characters", maxTaxIDLength)
+		}
+		return taxID, nil
+	}
+
+	number := strings.TrimPrefix(taxID, eu.prefix)
+	if !eu.format.MatchString(number) {
+		return "", ErrInvalidTaxID.New("%q is not a valid VAT ID of %s", taxID, country)
+	}
+	return eu.prefix + number, nil
+}
+
+// Calculate returns the VAT of the amount in cents charged to the customer.
+func (engine *EUVAT) Calculate(customer Customer, amount int64) (*Tax, error) {
+	country := normalize(customer.Country)
+	eu, ok := euCountries[country]
+	if !ok {
+		return nil, nil
+	}
+
+	// an invalid VAT ID is treated as a consumer, so the VAT is never left out by mistake.
+	taxID, err := engine.NormalizeTaxID(country, customer.TaxID)
+	if err != nil {
+		taxID = ""
+	}
+
+	if taxID != "" && country != engine.sellerCountry {
+		return &Tax{
+			Name:          "VAT",
+			Country:       country,
+			Rate:          decimal.Zero,
+			ReverseCharge: true,
+			TaxID:         taxID,
+		}, nil
+	}
+
+	if amount <= 0 {
+		return nil, nil
+	}
+
+	rate := decimal.NewFromInt(eu.rate)
+	return &Tax{
+		Name:    "VAT",
+		Country: country,
+		Rate:    rate,
+		Amount:  decimal.NewFromInt(amount).Mul(rate).Div(decimal.NewFromInt(100)).Round(0).IntPart(),
+	}, nil
+}
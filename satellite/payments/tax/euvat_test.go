@@ -0,0 +1,92 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package tax_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/payments/tax"
+)
+
+func TestEUVAT_NormalizeTaxID(t *testing.T) {
+	engine, err := tax.NewEUVAT(tax.Config{})
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		country, taxID string
+		expected       string
+		invalid        bool
+	}{
+		{country: "DE", taxID: "", expected: ""},
+		{country: "DE", taxID: "de 123 456 789", expected: "DE123456789"},
+		{country: "DE", taxID: "123456789", expected: "DE123456789"},
+		{country: "GR", taxID: "EL-123.456.789", expected: "EL123456789"},
+		{country: "NL", taxID: "NL123456789B01", expected: "NL123456789B01"},
+		{country: "AT", taxID: "ATU12345678", expected: "ATU12345678"},
+		{country: "US", taxID: "12-3456789", expected: "123456789"},
+		{country: "DE", taxID: "DE12345678", invalid: true},
+		{country: "NL", taxID: "NL123456789", invalid: true},
+		{country: "FR", taxID: "FR1234567890", invalid: true},
+		{country: "USA", taxID: "123", invalid: true},
+		{country: "US", taxID: "123456789012345678901234567890123", invalid: true},
+	} {
+		normalized, err := engine.NormalizeTaxID(tt.country, tt.taxID)
+		if tt.invalid {
+			require.True(t, tax.ErrInvalidTaxID.Has(err), "%s %s", tt.country, tt.taxID)
+			continue
+		}
+		require.NoError(t, err, "%s %s", tt.country, tt.taxID)
+		require.Equal(t, tt.expected, normalized)
+	}
+}
+
+func TestEUVAT_Calculate(t *testing.T) {
+	_, err := tax.NewEUVAT(tax.Config{SellerCountry: "US"})
+	require.Error(t, err)
+
+	engine, err := tax.NewEUVAT(tax.Config{})
+	require.NoError(t, err)
+
+	// consumers are charged the VAT of their country.
+	vat, err := engine.Calculate(tax.Customer{Country: "de"}, 1005)
+	require.NoError(t, err)
+	require.NotNil(t, vat)
+	require.Equal(t, "DE", vat.Country)
+	require.True(t, decimal.NewFromInt(19).Equal(vat.Rate))
+	require.EqualValues(t, 191, vat.Amount)
+	require.False(t, vat.ReverseCharge)
+
+	// an invalid VAT ID doesn't exempt the customer.
+	vat, err = engine.Calculate(tax.Customer{Country: "FR", TaxID: "123"}, 1000)
+	require.NoError(t, err)
+	require.EqualValues(t, 200, vat.Amount)
+
+	// businesses account for the VAT themselves.
+	vat, err = engine.Calculate(tax.Customer{Country: "NL", TaxID: "123456789B01"}, 1000)
+	require.NoError(t, err)
+	require.True(t, vat.ReverseCharge)
+	require.Zero(t, vat.Amount)
+	require.Equal(t, "NL123456789B01", vat.TaxID)
+
+	// nothing is charged for an empty invoice and outside of the EU.
+	vat, err = engine.Calculate(tax.Customer{Country: "DE"}, 0)
+	require.NoError(t, err)
+	require.Nil(t, vat)
+
+	vat, err = engine.Calculate(tax.Customer{Country: "US", TaxID: "123456789"}, 1000)
+	require.NoError(t, err)
+	require.Nil(t, vat)
+
+	// businesses of the seller country are charged the VAT.
+	engine, err = tax.NewEUVAT(tax.Config{SellerCountry: "DE"})
+	require.NoError(t, err)
+
+	vat, err = engine.Calculate(tax.Customer{Country: "DE", TaxID: "DE123456789"}, 1000)
+	require.NoError(t, err)
+	require.False(t, vat.ReverseCharge)
+	require.EqualValues(t, 190, vat.Amount)
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package tax
+
+import (
+	"strings"
+
+	"github.com/shopspring/decimal"
+	"github.com/zeebo/errs"
+)
+
+var (
+	// Error is the error class of the tax engines.
+	Error = errs.Class("tax")
+	// ErrInvalidTaxID is returned when the tax ID doesn't have the format of the country.
+	ErrInvalidTaxID = errs.Class("invalid tax id")
+)
+
+// Config contains the configuration of the taxes added to the invoices.
+type Config struct {
+	Enabled       bool   `help:"whether the taxes are calculated from the billing addresses and added to the invoices" default:"false"`
+	SellerCountry string `help:"ISO 3166-1 alpha-2 code of the country the satellite operator is registered for VAT in. empty if it's registered outside of the EU" default:""`
+}
+
+// Customer is the billing location of a customer, which the taxes depend on.
+type Customer struct {
+	// Country is the ISO 3166-1 alpha-2 code of the billing address.
+	Country string
+	// TaxID is the tax ID of a business, or empty for a consumer.
+	TaxID string
+}
+
+// Tax is the tax charged on an invoice.
+type Tax struct {
+	// Name is the name of the tax shown on the invoice, e.g. VAT.
+	Name string
+	// Country is the country the tax is charged for.
+	Country string
+	// Rate is the rate of the tax in percents.
+	Rate decimal.Decimal
+	// Amount is the amount of the tax in cents.
+	Amount int64
+	// ReverseCharge is set when the customer accounts for the tax instead of the seller.
+	ReverseCharge bool
+	// TaxID is the tax ID of the customer the reverse charge applies to.
+	TaxID string
+}
+
+// Engine calculates the taxes of the invoices.
+type Engine interface {
+	// NormalizeTaxID validates the tax ID of a customer in the country and returns it in
+	// its canonical form.
+	NormalizeTaxID(country, taxID string) (string, error)
+	// Calculate returns the tax of the amount in cents charged to the customer. The tax is
+	// nil when none applies.
+	Calculate(customer Customer, amount int64) (*Tax, error)
+}
+
+// normalize uppercases the value and removes the separators people usually type.
+func normalize(value string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '.', '-', '\t':
+			return -1
+		}
+		return r
+	}, strings.ToUpper(strings.TrimSpace(value)))
+}
+
+// isCountryCode returns whether the value looks like an ISO 3166-1 alpha-2 code.
+func isCountryCode(value string) bool {
+	if len(value) != 2 {
+		return false
+	}
+	for _, r := range value {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
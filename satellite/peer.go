@@ -8,6 +8,7 @@ import (
 	"net"
 	"net/mail"
 	"net/smtp"
+	"time"
 
 	hw "github.com/jtolds/monkit-hw/v2"
 	"github.com/spacemonkeygo/monkit/v3"
@@ -17,9 +18,11 @@ import (
 	"storj.io/common/identity"
 	"storj.io/private/debug"
 	"storj.io/private/tagsql"
+	"storj.io/storj/private/clock"
 	"storj.io/storj/private/migrate"
 	"storj.io/storj/private/post"
 	"storj.io/storj/private/post/oauth2"
+	"storj.io/storj/private/ratelimit"
 	"storj.io/storj/private/server"
 	version_checker "storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/accounting"
@@ -30,10 +33,13 @@ import (
 	"storj.io/storj/satellite/accounting/tally"
 	"storj.io/storj/satellite/admin"
 	"storj.io/storj/satellite/analytics"
+	"storj.io/storj/satellite/appeals"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/changes"
 	"storj.io/storj/satellite/compensation"
+	"storj.io/storj/satellite/compensation/heldrelease"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb"
@@ -42,9 +48,13 @@ import (
 	"storj.io/storj/satellite/console/restkeys"
 	"storj.io/storj/satellite/console/userinfo"
 	"storj.io/storj/satellite/contact"
+	"storj.io/storj/satellite/eventing"
+	"storj.io/storj/satellite/featureflags"
 	"storj.io/storj/satellite/gc/bloomfilter"
 	"storj.io/storj/satellite/gc/sender"
 	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/inventory"
+	"storj.io/storj/satellite/kms"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/mailservice/simulate"
 	"storj.io/storj/satellite/metabase/rangedloop"
@@ -53,22 +63,29 @@ import (
 	"storj.io/storj/satellite/metainfo/expireddeletion"
 	"storj.io/storj/satellite/nodeapiversion"
 	"storj.io/storj/satellite/nodeevents"
+	"storj.io/storj/satellite/nodemessages"
 	"storj.io/storj/satellite/oidc"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/overlay/offlinenodes"
+	"storj.io/storj/satellite/overlay/slareports"
+	"storj.io/storj/satellite/overlay/storageestimates"
 	"storj.io/storj/satellite/overlay/straynodes"
 	"storj.io/storj/satellite/payments/accountfreeze"
 	"storj.io/storj/satellite/payments/billing"
 	"storj.io/storj/satellite/payments/paymentsconfig"
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripe"
+	"storj.io/storj/satellite/quarantine"
+	"storj.io/storj/satellite/reload"
 	"storj.io/storj/satellite/repair/checker"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/repair/repairer"
 	"storj.io/storj/satellite/reputation"
 	"storj.io/storj/satellite/revocation"
 	"storj.io/storj/satellite/snopayouts"
+	"storj.io/storj/satellite/statuspage"
+	"storj.io/storj/satellite/tracing"
 )
 
 var mon = monkit.Package()
@@ -85,6 +102,8 @@ type DB interface {
 	MigrateToLatest(ctx context.Context) error
 	// CheckVersion checks the database is the correct version
 	CheckVersion(ctx context.Context) error
+	// DryRunMigration reports the changes, which MigrateToLatest would make, without changing the database
+	DryRunMigration(ctx context.Context) ([]MigrationReport, error)
 	// Close closes the database
 	Close() error
 
@@ -94,6 +113,8 @@ type DB interface {
 	OverlayCache() overlay.DB
 	// NodeEvents returns a database for node event information
 	NodeEvents() nodeevents.DB
+	// NodeSLAReports returns a database for the monthly uptime SLA reports of the nodes.
+	NodeSLAReports() slareports.DB
 	// Reputation returns database for audit reputation information
 	Reputation() reputation.DB
 	// Attribution returns database for partner keys information
@@ -118,6 +139,14 @@ type DB interface {
 	Containment() audit.Containment
 	// Buckets returns the database to interact with buckets
 	Buckets() buckets.DB
+	// BucketMetadataHistory returns the database of the recorded states of the bucket metadata
+	BucketMetadataHistory() buckets.MetadataHistory
+	// BucketEncryptionKeys returns the database of the metadata keys of the buckets with server-side encryption
+	BucketEncryptionKeys() kms.DB
+	// ProjectRootKeys returns the database of the root keys of the projects with server-side access grants
+	ProjectRootKeys() kms.RootKeysDB
+	// Changes returns the bus of the change events of the database tables
+	Changes() *changes.Bus
 	// GracefulExit returns database for graceful exit
 	GracefulExit() gracefulexit.DB
 	// StripeCoinPayments returns stripecoinpayments database.
@@ -136,11 +165,37 @@ type DB interface {
 	NodeAPIVersion() nodeapiversion.DB
 	// StorjscanPayments stores payments retrieved from storjscan.
 	StorjscanPayments() storjscan.PaymentsDB
+	// FeatureFlags stores the flags, which roll out the behaviors of the satellite gradually.
+	FeatureFlags() featureflags.DB
+	// EmailDeliveries tracks the delivery status of outgoing emails.
+	EmailDeliveries() mailservice.Deliveries
+	// RangedLoopLeases returns the leases, which fence the cycles of the ranged loops.
+	RangedLoopLeases() rangedloop.CycleLeases
+	// Outages stores the heartbeats and the detected downtime of the satellite.
+	Outages() orders.OutagesDB
+	// NodeAppeals stores the appeals of the suspended or disqualified nodes.
+	NodeAppeals() appeals.DB
+	// NodeQuarantines stores the corruptions and the quarantines of the nodes.
+	NodeQuarantines() quarantine.DB
+	// NodeMessages stores the messages to the storage node operators and their acknowledgments.
+	NodeMessages() nodemessages.DB
 
 	// Testing provides access to testing facilities. These should not be used in production code.
 	Testing() TestingDB
 }
 
+// MigrationReport describes the changes, which the migration would make to a database.
+type MigrationReport struct {
+	// Database is the name of the database.
+	Database string
+	// Pending are the migration steps, which haven't been applied yet.
+	Pending []*migrate.Step
+	// DriftChecked is false when the schema wasn't compared, because there are pending steps.
+	DriftChecked bool
+	// Drift are the differences between the schema of the database and the dbx schema.
+	Drift []migrate.SchemaDifference
+}
+
 // TestingDB defines access to database testing facilities.
 type TestingDB interface {
 	// RawDB returns the underlying database connection to the primary database.
@@ -153,6 +208,10 @@ type TestingDB interface {
 	ProductionMigration() *migrate.Migration
 	// TestMigration returns the migration used for tests.
 	TestMigration() *migrate.Migration
+	// SetQueryFault makes the queries fail with the error returned by fault, e.g. to test
+	// how the services handle database failures. A nil fault, or a nil error returned by
+	// it, lets the queries run. Starting a transaction is checked as the "BEGIN" query.
+	SetQueryFault(fault func(ctx context.Context, query string) error)
 }
 
 // Config is the global config satellite.
@@ -168,13 +227,30 @@ type Config struct {
 	OfflineNodes offlinenodes.Config
 	NodeEvents   nodeevents.Config
 	StrayNodes   straynodes.Config
+	SLAReports   slareports.Config
+
+	StorageEstimates storageestimates.Config
 
-	Metainfo metainfo.Config
-	Orders   orders.Config
+	Metainfo       metainfo.Config
+	KMS            kms.Config
+	Eventing       eventing.Config
+	RequestTracing tracing.Config
+	Orders         orders.Config
+	RateLimiter    ratelimit.Config
+	Reload         reload.Config
+	FeatureFlags   featureflags.Config
 
 	Userinfo userinfo.Config
 
-	Reputation reputation.Config
+	StatusPage statuspage.Config
+
+	UserAgentRollups attribution.UserAgentRollupsConfig
+	APIUsageRollups  accounting.APIUsageRollupsConfig
+
+	Reputation   reputation.Config
+	Appeals      appeals.Config
+	Quarantine   quarantine.Config
+	NodeMessages nodemessages.Config
 
 	Checker  checker.Config
 	Repairer repairer.Config
@@ -183,6 +259,8 @@ type Config struct {
 	GarbageCollection   sender.Config
 	GarbageCollectionBF bloomfilter.Config
 
+	BucketInventory inventory.Config
+
 	RangedLoop rangedloop.Config
 
 	ExpiredDeletion expireddeletion.Config
@@ -211,13 +289,27 @@ type Config struct {
 	GracefulExit gracefulexit.Config
 
 	Compensation compensation.Config
+	HeldReleases heldrelease.Config
 
 	ProjectLimit accounting.ProjectLimitConfig
 
 	Analytics analytics.Config
+
+	SimulateClock bool `help:"use a simulated clock for the chores, which is advanced only through the debug control panel, for development" default:"false" hidden:"true"`
+}
+
+// newClock returns the clock of a peer. The simulated clock starts at the current time
+// and is advanced through the buttons of the debug control panel.
+func newClock(simulate bool, panel *debug.Panel) clock.Clock {
+	if !simulate {
+		return clock.Real{}
+	}
+	simulated := clock.NewSimulated(time.Now())
+	panel.Add(clock.Buttons("Simulated Clock", simulated))
+	return simulated
 }
 
-func setupMailService(log *zap.Logger, config Config) (*mailservice.Service, error) {
+func setupMailService(log *zap.Logger, db DB, config Config) (*mailservice.Service, error) {
 	// TODO(yar): test multiple satellites using same OAUTH credentials
 	mailConfig := config.Mail
 
@@ -279,5 +371,7 @@ func setupMailService(log *zap.Logger, config Config) (*mailservice.Service, err
 		log.Named("mail:service"),
 		sender,
 		mailConfig.TemplatePath,
+		mailConfig.Branding,
+		db.EmailDeliveries(),
 	)
 }
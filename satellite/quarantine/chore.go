@@ -0,0 +1,171 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package quarantine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/nodeevents"
+	"storj.io/storj/satellite/overlay"
+)
+
+// Chore periodically correlates the corruptions of the nodes, quarantines the nodes
+// exceeding the thresholds and releases the nodes, which stopped corrupting data.
+//
+// architecture: Chore
+type Chore struct {
+	log        *zap.Logger
+	db         DB
+	overlay    overlay.DB
+	nodeEvents nodeevents.DB
+	config     Config
+	nowFn      func() time.Time
+
+	Loop *sync2.Cycle
+}
+
+// NewChore creates a new quarantine chore.
+func NewChore(log *zap.Logger, db DB, overlay overlay.DB, nodeEvents nodeevents.DB, config Config) *Chore {
+	return &Chore{
+		log:        log,
+		db:         db,
+		overlay:    overlay,
+		nodeEvents: nodeEvents,
+		config:     config,
+		nowFn:      time.Now,
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the quarantine chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := chore.RunOnce(ctx); err != nil {
+			chore.log.Error("failed to evaluate the corruptions of the nodes", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// RunOnce evaluates the corruptions observed in the window once.
+func (chore *Chore) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := chore.nowFn()
+	since := now.Add(-chore.config.Window)
+
+	counts, err := chore.db.SumEvents(ctx, since)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	active, err := chore.db.ListActive(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	quarantined := make(map[storj.NodeID]Quarantine, len(active))
+	for _, quarantine := range active {
+		quarantined[quarantine.NodeID] = quarantine
+	}
+
+	scores := make(map[storj.NodeID]float64, len(counts))
+	for _, nodeCounts := range counts {
+		score := chore.config.Score(nodeCounts)
+		scores[nodeCounts.NodeID] = score
+		if score < 1 {
+			continue
+		}
+		if _, ok := quarantined[nodeCounts.NodeID]; ok {
+			continue
+		}
+
+		quarantine := Quarantine{
+			NodeID:        nodeCounts.NodeID,
+			QuarantinedAt: now,
+			Score:         score,
+			Reason:        reason(nodeCounts),
+		}
+		if err := chore.db.Insert(ctx, quarantine); err != nil {
+			return Error.Wrap(err)
+		}
+		quarantined[quarantine.NodeID] = quarantine
+
+		mon.Event("node_quarantined")
+		chore.log.Warn("node quarantined",
+			zap.Stringer("Node ID", quarantine.NodeID),
+			zap.Float64("Score", score),
+			zap.String("Reason", quarantine.Reason))
+		chore.notify(ctx, quarantine.NodeID, nodeevents.Quarantined)
+	}
+
+	for _, quarantine := range active {
+		if scores[quarantine.NodeID] >= 1 || now.Sub(quarantine.QuarantinedAt) < chore.config.MinDuration {
+			continue
+		}
+		if err := chore.db.Release(ctx, quarantine.NodeID, now); err != nil {
+			return Error.Wrap(err)
+		}
+
+		mon.Event("node_unquarantined")
+		chore.log.Info("node released from quarantine", zap.Stringer("Node ID", quarantine.NodeID))
+		chore.notify(ctx, quarantine.NodeID, nodeevents.Unquarantined)
+	}
+
+	mon.IntVal("quarantined_nodes").Observe(int64(len(quarantined)))
+
+	_, err = chore.db.DeleteEventsBefore(ctx, since)
+	return Error.Wrap(err)
+}
+
+// notify notifies the operator of the node. The failures are logged, since the
+// quarantine is already recorded.
+func (chore *Chore) notify(ctx context.Context, nodeID storj.NodeID, event nodeevents.Type) {
+	node, err := chore.overlay.Get(ctx, nodeID)
+	if err != nil {
+		chore.log.Error("failed to get the node to notify", zap.Stringer("Node ID", nodeID), zap.Error(err))
+		return
+	}
+	if node.Operator.Email == "" {
+		return
+	}
+	if _, err := chore.nodeEvents.Insert(ctx, node.Operator.Email, nodeID, event); err != nil {
+		chore.log.Error("failed to insert the node event", zap.Stringer("Node ID", nodeID), zap.Error(err))
+	}
+}
+
+// reason describes the corruptions of a node.
+func reason(counts Counts) string {
+	var parts []string
+	if counts.AuditFailures > 0 {
+		parts = append(parts, fmt.Sprintf("audit failures: %d", counts.AuditFailures))
+	}
+	if counts.RepairCorruptions > 0 {
+		parts = append(parts, fmt.Sprintf("repair corruptions: %d", counts.RepairCorruptions))
+	}
+	if counts.OrderAnomalies > 0 {
+		parts = append(parts, fmt.Sprintf("order anomalies: %d", counts.OrderAnomalies))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// TestSetNow sets the function returning the current time.
+func (chore *Chore) TestSetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+// Close stops the quarantine chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -0,0 +1,211 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package quarantine_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/nodeevents"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/quarantine"
+)
+
+func TestChore(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	now := time.Now()
+	config := quarantine.Config{
+		Interval:          time.Hour,
+		Window:            24 * time.Hour,
+		AuditFailures:     4,
+		RepairCorruptions: 2,
+		OrderAnomalies:    10,
+		MinDuration:       48 * time.Hour,
+	}
+
+	corrupting, correlated, healthy := testrand.NodeID(), testrand.NodeID(), testrand.NodeID()
+
+	db := newQuarantineDB()
+	overlayDB := &overlayDB{}
+	nodeEvents := &nodeEventsDB{}
+	chore := quarantine.NewChore(zaptest.NewLogger(t), db, overlayDB, nodeEvents, config)
+	defer ctx.Check(chore.Close)
+	chore.TestSetNow(func() time.Time { return now })
+
+	recorder := quarantine.NewRecorder(zaptest.NewLogger(t), db)
+	recorder.Record(ctx, quarantine.SourceRepair, storj.NodeIDList{corrupting, corrupting})
+	// neither source alone exceeds its threshold, but together they do.
+	recorder.Record(ctx, quarantine.SourceAudit, storj.NodeIDList{correlated, correlated, healthy})
+	recorder.RecordCounts(ctx, quarantine.SourceOrders, map[storj.NodeID]int{correlated: 5, healthy: 1})
+
+	var nilRecorder *quarantine.Recorder
+	nilRecorder.Record(ctx, quarantine.SourceAudit, storj.NodeIDList{healthy})
+
+	require.NoError(t, chore.RunOnce(ctx))
+
+	active, err := db.ListActive(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []storj.NodeID{corrupting, correlated}, nodeIDs(active))
+	for _, q := range active {
+		require.GreaterOrEqual(t, q.Score, 1.0)
+		require.NotEmpty(t, q.Reason)
+	}
+	require.ElementsMatch(t, []storj.NodeID{corrupting, correlated}, nodeEvents.nodes(nodeevents.Quarantined))
+
+	// the quarantine isn't repeated.
+	require.NoError(t, chore.RunOnce(ctx))
+	require.Len(t, nodeEvents.nodes(nodeevents.Quarantined), 2)
+
+	// the events leave the window, but the minimum duration didn't pass.
+	now = now.Add(config.Window + time.Minute)
+	require.NoError(t, chore.RunOnce(ctx))
+	active, err = db.ListActive(ctx)
+	require.NoError(t, err)
+	require.Len(t, active, 2)
+	require.Empty(t, nodeEvents.nodes(nodeevents.Unquarantined))
+
+	now = now.Add(config.MinDuration)
+	require.NoError(t, db.InsertEvents(ctx, []quarantine.Event{{
+		ID:        testrand.UUID(),
+		NodeID:    corrupting,
+		Source:    quarantine.SourceRepair,
+		Count:     2,
+		CreatedAt: now,
+	}}))
+	require.NoError(t, chore.RunOnce(ctx))
+
+	active, err = db.ListActive(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []storj.NodeID{corrupting}, nodeIDs(active), "the node still corrupts data")
+	require.Equal(t, []storj.NodeID{correlated}, nodeEvents.nodes(nodeevents.Unquarantined))
+}
+
+func nodeIDs(quarantines []quarantine.Quarantine) []storj.NodeID {
+	var ids []storj.NodeID
+	for _, q := range quarantines {
+		ids = append(ids, q.NodeID)
+	}
+	return ids
+}
+
+type quarantineDB struct {
+	events      []quarantine.Event
+	quarantines []quarantine.Quarantine
+}
+
+func newQuarantineDB() *quarantineDB { return &quarantineDB{} }
+
+func (db *quarantineDB) InsertEvents(ctx context.Context, events []quarantine.Event) error {
+	for _, event := range events {
+		if event.ID == (uuid.UUID{}) {
+			panic("missing id")
+		}
+	}
+	db.events = append(db.events, events...)
+	return nil
+}
+
+func (db *quarantineDB) SumEvents(ctx context.Context, since time.Time) ([]quarantine.Counts, error) {
+	counts := map[storj.NodeID]*quarantine.Counts{}
+	for _, event := range db.events {
+		if event.CreatedAt.Before(since) {
+			continue
+		}
+		c, ok := counts[event.NodeID]
+		if !ok {
+			c = &quarantine.Counts{NodeID: event.NodeID}
+			counts[event.NodeID] = c
+		}
+		switch event.Source {
+		case quarantine.SourceAudit:
+			c.AuditFailures += int64(event.Count)
+		case quarantine.SourceRepair:
+			c.RepairCorruptions += int64(event.Count)
+		case quarantine.SourceOrders:
+			c.OrderAnomalies += int64(event.Count)
+		}
+	}
+	var list []quarantine.Counts
+	for _, c := range counts {
+		list = append(list, *c)
+	}
+	sort.Slice(list, func(i, k int) bool { return list[i].NodeID.Less(list[k].NodeID) })
+	return list, nil
+}
+
+func (db *quarantineDB) DeleteEventsBefore(ctx context.Context, before time.Time) (int64, error) {
+	var kept []quarantine.Event
+	for _, event := range db.events {
+		if !event.CreatedAt.Before(before) {
+			kept = append(kept, event)
+		}
+	}
+	deleted := int64(len(db.events) - len(kept))
+	db.events = kept
+	return deleted, nil
+}
+
+func (db *quarantineDB) Insert(ctx context.Context, q quarantine.Quarantine) error {
+	db.quarantines = append(db.quarantines, q)
+	return nil
+}
+
+func (db *quarantineDB) ListActive(ctx context.Context) ([]quarantine.Quarantine, error) {
+	var list []quarantine.Quarantine
+	for _, q := range db.quarantines {
+		if q.ReleasedAt == nil {
+			list = append(list, q)
+		}
+	}
+	return list, nil
+}
+
+func (db *quarantineDB) Release(ctx context.Context, nodeID storj.NodeID, releasedAt time.Time) error {
+	for i := range db.quarantines {
+		if db.quarantines[i].NodeID == nodeID && db.quarantines[i].ReleasedAt == nil {
+			db.quarantines[i].ReleasedAt = &releasedAt
+		}
+	}
+	return nil
+}
+
+type overlayDB struct {
+	overlay.DB
+}
+
+func (db *overlayDB) Get(ctx context.Context, nodeID storj.NodeID) (*overlay.NodeDossier, error) {
+	return &overlay.NodeDossier{Operator: pb.NodeOperator{Email: nodeID.String() + "@example.test"}}, nil
+}
+
+type nodeEventsDB struct {
+	nodeevents.DB
+	events []nodeevents.NodeEvent
+}
+
+func (db *nodeEventsDB) Insert(ctx context.Context, email string, nodeID storj.NodeID, event nodeevents.Type) (nodeevents.NodeEvent, error) {
+	nodeEvent := nodeevents.NodeEvent{Email: email, NodeID: nodeID, Event: event}
+	db.events = append(db.events, nodeEvent)
+	return nodeEvent, nil
+}
+
+func (db *nodeEventsDB) nodes(event nodeevents.Type) []storj.NodeID {
+	var ids []storj.NodeID
+	for _, e := range db.events {
+		if e.Event == event {
+			ids = append(ids, e.NodeID)
+		}
+	}
+	return ids
+}
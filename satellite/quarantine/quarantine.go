@@ -0,0 +1,113 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package quarantine quarantines the nodes, which serve corrupted data or submit
+// anomalous orders. Quarantined nodes aren't selected for uploads and they are
+// audited before the other nodes.
+package quarantine
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+)
+
+var (
+	// Error is the default error class of the package.
+	Error = errs.Class("quarantine")
+
+	mon = monkit.Package()
+)
+
+// Source is where a corruption of a node was observed.
+type Source string
+
+const (
+	// SourceAudit denotes failed audits.
+	SourceAudit Source = "audit"
+	// SourceRepair denotes pieces, which failed the hash verification when they were
+	// downloaded for a repair.
+	SourceRepair Source = "repair"
+	// SourceOrders denotes settled orders, which an honest node wouldn't submit,
+	// e.g. orders with invalid signatures or amounts exceeding the limits.
+	SourceOrders Source = "orders"
+)
+
+// Event is an observation of a corruption of a node.
+type Event struct {
+	ID        uuid.UUID
+	NodeID    storj.NodeID
+	Source    Source
+	Count     int
+	CreatedAt time.Time
+}
+
+// Counts are the numbers of the corruptions of a node observed by each source.
+type Counts struct {
+	NodeID            storj.NodeID
+	AuditFailures     int64
+	RepairCorruptions int64
+	OrderAnomalies    int64
+}
+
+// Quarantine is a quarantine of a node.
+type Quarantine struct {
+	NodeID        storj.NodeID
+	QuarantinedAt time.Time
+	Score         float64
+	Reason        string
+	ReleasedAt    *time.Time
+}
+
+// DB stores the corruption events and the quarantines of the nodes.
+//
+// architecture: Database
+type DB interface {
+	// InsertEvents stores the corruption events.
+	InsertEvents(ctx context.Context, events []Event) error
+	// SumEvents returns the numbers of the corruptions of the nodes observed since the time.
+	SumEvents(ctx context.Context, since time.Time) ([]Counts, error)
+	// DeleteEventsBefore deletes the corruption events observed before the time.
+	DeleteEventsBefore(ctx context.Context, before time.Time) (deleted int64, err error)
+
+	// Insert quarantines a node.
+	Insert(ctx context.Context, quarantine Quarantine) error
+	// ListActive returns the quarantines, which weren't released.
+	ListActive(ctx context.Context) ([]Quarantine, error)
+	// Release ends the active quarantine of the node.
+	Release(ctx context.Context, nodeID storj.NodeID, releasedAt time.Time) error
+}
+
+// Config contains the configuration of the quarantine of the nodes.
+type Config struct {
+	Enabled  bool          `help:"whether the nodes exceeding the corruption thresholds are quarantined" default:"false"`
+	Interval time.Duration `help:"how often the corruption rates of the nodes are evaluated" default:"1h" testDefault:"$TESTINTERVAL"`
+	Window   time.Duration `help:"the time window of the evaluated corruption events" default:"168h"`
+
+	AuditFailures     int `help:"the number of the failed audits in the window, which alone cause a quarantine" default:"10"`
+	RepairCorruptions int `help:"the number of the corrupted pieces downloaded for repairs in the window, which alone cause a quarantine" default:"10"`
+	OrderAnomalies    int `help:"the number of the anomalous settled orders in the window, which alone cause a quarantine" default:"100"`
+
+	MinDuration time.Duration `help:"the minimum time a node stays quarantined" default:"72h"`
+}
+
+// Score correlates the corruptions observed by the sources. Each source contributes
+// the fraction of its threshold, so the nodes scoring at least 1 are quarantined.
+func (config Config) Score(counts Counts) float64 {
+	var score float64
+	if config.AuditFailures > 0 {
+		score += float64(counts.AuditFailures) / float64(config.AuditFailures)
+	}
+	if config.RepairCorruptions > 0 {
+		score += float64(counts.RepairCorruptions) / float64(config.RepairCorruptions)
+	}
+	if config.OrderAnomalies > 0 {
+		score += float64(counts.OrderAnomalies) / float64(config.OrderAnomalies)
+	}
+	return score
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package quarantine
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+)
+
+// Recorder records the corruptions of the nodes observed by the satellite.
+// A nil recorder ignores the corruptions.
+type Recorder struct {
+	log *zap.Logger
+	db  DB
+}
+
+// NewRecorder creates a new recorder of the corruptions.
+func NewRecorder(log *zap.Logger, db DB) *Recorder {
+	return &Recorder{
+		log: log,
+		db:  db,
+	}
+}
+
+// Record stores a corruption event of each node. The failures are logged, so they
+// don't interrupt the caller.
+func (recorder *Recorder) Record(ctx context.Context, source Source, nodes storj.NodeIDList) {
+	if recorder == nil || len(nodes) == 0 {
+		return
+	}
+	counts := make(map[storj.NodeID]int, len(nodes))
+	for _, nodeID := range nodes {
+		counts[nodeID]++
+	}
+	recorder.RecordCounts(ctx, source, counts)
+}
+
+// RecordCounts stores a corruption event with the count of each node. The failures
+// are logged, so they don't interrupt the caller.
+func (recorder *Recorder) RecordCounts(ctx context.Context, source Source, counts map[storj.NodeID]int) {
+	if recorder == nil || len(counts) == 0 {
+		return
+	}
+
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+	events := make([]Event, 0, len(counts))
+	for nodeID, count := range counts {
+		if count <= 0 {
+			continue
+		}
+		id, err := uuid.New()
+		if err != nil {
+			recorder.log.Error("failed to record corruptions", zap.Error(Error.Wrap(err)))
+			return
+		}
+		events = append(events, Event{
+			ID:        id,
+			NodeID:    nodeID,
+			Source:    source,
+			Count:     count,
+			CreatedAt: now,
+		})
+	}
+	if len(events) == 0 {
+		return
+	}
+
+	mon.Counter("corruption_events", monkit.NewSeriesTag("source", string(source))).Inc(int64(len(events)))
+
+	err = recorder.db.InsertEvents(ctx, events)
+	if err != nil {
+		recorder.log.Error("failed to record corruptions",
+			zap.String("Source", string(source)),
+			zap.Int("Nodes", len(events)),
+			zap.Error(Error.Wrap(err)))
+	}
+}
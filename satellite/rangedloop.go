@@ -15,15 +15,18 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/private/debug"
+	"storj.io/storj/private/clock"
 	"storj.io/storj/private/lifecycle"
 	"storj.io/storj/satellite/accounting/nodetally"
 	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/gc/bloomfilter"
 	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/inventory"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/rangedloop"
 	"storj.io/storj/satellite/metrics"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/reload"
 	"storj.io/storj/satellite/repair/checker"
 )
 
@@ -37,6 +40,8 @@ type RangedLoop struct {
 	Servers  *lifecycle.Group
 	Services *lifecycle.Group
 
+	Clock clock.Clock
+
 	Audit struct {
 		Observer rangedloop.Observer
 	}
@@ -66,6 +71,10 @@ type RangedLoop struct {
 		Observer rangedloop.Observer
 	}
 
+	BucketInventory struct {
+		Observer *inventory.Observer
+	}
+
 	Accounting struct {
 		NodeTallyObserver *nodetally.Observer
 	}
@@ -73,6 +82,10 @@ type RangedLoop struct {
 	RangedLoop struct {
 		Service *rangedloop.Service
 	}
+
+	Reload struct {
+		Registry *reload.Registry
+	}
 }
 
 // NewRangedLoop creates a new satellite ranged loop process.
@@ -104,8 +117,22 @@ func NewRangedLoop(log *zap.Logger, db DB, metabaseDB *metabase.DB, config *Conf
 		})
 	}
 
+	peer.Clock = newClock(config.SimulateClock, peer.Debug.Server.Panel)
+
+	{ // setup configuration reload
+		peer.Reload.Registry = reload.NewRegistry(peer.Log.Named("reload"), config.Reload)
+		peer.Services.Add(lifecycle.Item{
+			Name: "reload",
+			Run:  peer.Reload.Registry.Run,
+		})
+	}
+
 	{ // setup audit observer
-		peer.Audit.Observer = audit.NewObserver(log.Named("audit"), db.VerifyQueue(), config.Audit)
+		auditObserver := audit.NewObserver(log.Named("audit"), db.VerifyQueue(), config.Audit)
+		if config.Quarantine.Enabled {
+			auditObserver.SetQuarantines(db.NodeQuarantines())
+		}
+		peer.Audit.Observer = auditObserver
 	}
 
 	{ // setup metrics observer
@@ -147,10 +174,28 @@ func NewRangedLoop(log *zap.Logger, db DB, metabaseDB *metabase.DB, config *Conf
 			peer.Overlay.Service,
 			config.Checker,
 		)
+		peer.Reload.Registry.Register("checker.repair-overrides", reload.Value[checker.RepairOverrides]{
+			Parse:  checker.ParseRepairOverrides,
+			Format: func(overrides checker.RepairOverrides) string { return overrides.String() },
+			Load:   peer.Repair.Observer.RepairOverrides,
+			Store:  peer.Repair.Observer.SetRepairOverrides,
+		})
 	}
 
 	{ // setup garbage collection bloom filter observer
-		peer.GarbageCollectionBF.Observer = bloomfilter.NewObserver(log.Named("gc-bf"), config.GarbageCollectionBF, db.OverlayCache())
+		observer := bloomfilter.NewObserver(log.Named("gc-bf"), config.GarbageCollectionBF, db.OverlayCache())
+		observer.SetClock(peer.Clock)
+		peer.GarbageCollectionBF.Observer = observer
+		peer.Reload.Registry.Register("garbage-collection-bf.false-positive-rate", reload.Float64(
+			observer.FalsePositiveRate,
+			observer.SetFalsePositiveRate,
+			bloomfilter.ValidateFalsePositiveRate,
+		))
+	}
+
+	{ // setup bucket inventory observer
+		peer.BucketInventory.Observer = inventory.NewObserver(log.Named("bucket-inventory"), config.BucketInventory, metabaseDB, db.Console().BucketInventories())
+		peer.BucketInventory.Observer.SetClock(peer.Clock)
 	}
 
 	{ // setup ranged loop
@@ -179,8 +224,13 @@ func NewRangedLoop(log *zap.Logger, db DB, metabaseDB *metabase.DB, config *Conf
 			observers = append(observers, peer.Repair.Observer)
 		}
 
+		if config.BucketInventory.Enabled {
+			observers = append(observers, peer.BucketInventory.Observer)
+		}
+
 		segments := rangedloop.NewMetabaseRangeSplitter(metabaseDB, config.RangedLoop.AsOfSystemInterval, config.RangedLoop.BatchSize)
 		peer.RangedLoop.Service = rangedloop.NewService(log.Named("rangedloop"), config.RangedLoop, segments, observers)
+		peer.RangedLoop.Service.SetCycleLeases(db.RangedLoopLeases(), "rangedloop")
 
 		peer.Services.Add(lifecycle.Item{
 			Name: "rangeloop",
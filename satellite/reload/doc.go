@@ -0,0 +1,18 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+/*
+Package reload changes the configuration of the running satellite processes.
+
+The peers register the settings, which their services can change without a
+restart, in a reload.Registry, e.g. the rate limits of the metainfo API, the
+repair thresholds of the checker or the false positive rate of the garbage
+collection bloom filters. When the process receives SIGHUP, the registry
+rereads the configuration file and validates the new values of all registered
+settings. The values are applied only when all of them are valid, so a typo
+doesn't leave the process half reconfigured. Every applied change is logged
+with its old and new value.
+
+The settings, which aren't registered, still require a restart.
+*/
+package reload
@@ -0,0 +1,46 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reload
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LoadFile reads the values of the configuration file. The nested keys are
+// joined with dots, so both "checker: {repair-overrides: ...}" and
+// "checker.repair-overrides: ..." produce the key "checker.repair-overrides".
+func LoadFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var raw map[string]interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, Error.New("invalid configuration file %q: %v", path, err)
+	}
+
+	values := map[string]string{}
+	flatten(values, "", raw)
+	return values, nil
+}
+
+func flatten(values map[string]string, prefix string, raw map[string]interface{}) {
+	for key, value := range raw {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		switch value := value.(type) {
+		case map[string]interface{}:
+			flatten(values, key, value)
+		case nil:
+			values[key] = ""
+		default:
+			values[key] = fmt.Sprint(value)
+		}
+	}
+}
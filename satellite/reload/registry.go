@@ -0,0 +1,187 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reload
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+)
+
+var (
+	// Error is the default error class for the configuration reload.
+	Error = errs.Class("reload")
+
+	mon = monkit.Package()
+)
+
+// maxChanges is the number of the applied changes, which are kept for auditing.
+const maxChanges = 100
+
+// Config contains the configuration of the reload.
+type Config struct {
+	Enabled    bool   `help:"reread the configuration file on SIGHUP and apply the changed reloadable settings" default:"false"`
+	ConfigFile string `help:"the configuration file, which is reread. When empty, the config.yaml in the configuration directory is used" default:""`
+}
+
+// Change is an applied change of a setting.
+type Change struct {
+	Key       string
+	Old       string
+	New       string
+	ChangedAt time.Time
+}
+
+// Registry contains the settings, which can be changed while the process is running.
+//
+// architecture: Service
+type Registry struct {
+	log    *zap.Logger
+	config Config
+
+	mu       sync.Mutex
+	settings map[string]Setting
+	changes  []Change
+}
+
+// NewRegistry creates a new registry of the reloadable settings.
+func NewRegistry(log *zap.Logger, config Config) *Registry {
+	return &Registry{
+		log:      log,
+		config:   config,
+		settings: map[string]Setting{},
+	}
+}
+
+// Register adds the setting with the key used in the configuration file,
+// e.g. "metainfo.rate-limiter.rate".
+func (registry *Registry) Register(key string, setting Setting) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	registry.settings[key] = setting
+}
+
+// Keys returns the sorted keys of the registered settings.
+func (registry *Registry) Keys() []string {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	keys := make([]string, 0, len(registry.settings))
+	for key := range registry.settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Changes returns the recently applied changes, the oldest first.
+func (registry *Registry) Changes() []Change {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	return append([]Change(nil), registry.changes...)
+}
+
+// Run reloads the configuration file whenever the process receives the reload signal.
+func (registry *Registry) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !registry.config.Enabled {
+		return nil
+	}
+
+	signals, stop := reloadSignal()
+	defer stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-signals:
+			registry.log.Info("Received reload signal, reloading", zap.String("file", registry.config.ConfigFile))
+			if _, err := registry.ReloadFile(ctx, registry.config.ConfigFile); err != nil {
+				registry.log.Error("Failed to reload, keeping the current configuration", zap.Error(err))
+			}
+		}
+	}
+}
+
+// ReloadFile applies the values of the registered settings in the configuration file.
+func (registry *Registry) ReloadFile(ctx context.Context, path string) (_ []Change, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	values, err := LoadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return registry.Reload(ctx, values)
+}
+
+// Reload applies the values of the registered settings. The values are keyed
+// by the settings' keys; the settings, which are missing, keep their current value.
+// Nothing is applied, when any of the values is invalid.
+func (registry *Registry) Reload(ctx context.Context, values map[string]string) (_ []Change, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	now := time.Now()
+
+	var changes []Change
+	var group errs.Group
+	for key, setting := range registry.settings {
+		value, ok := values[key]
+		if !ok {
+			continue
+		}
+		current := setting.String()
+		if value == current {
+			continue
+		}
+		if err := setting.Validate(value); err != nil {
+			group.Add(Error.New("invalid %s: %v", key, err))
+			continue
+		}
+		changes = append(changes, Change{Key: key, Old: current, New: value, ChangedAt: now})
+	}
+	if err := group.Err(); err != nil {
+		mon.Event("config_reload_rejected")
+		return nil, err
+	}
+
+	sort.Slice(changes, func(i, k int) bool { return changes[i].Key < changes[k].Key })
+
+	for i, change := range changes {
+		if err := registry.settings[change.Key].Set(change.New); err != nil {
+			// the value was validated, so this shouldn't happen.
+			registry.record(changes[:i])
+			return changes[:i], Error.New("failed to set %s: %v", change.Key, err)
+		}
+		registry.log.Info("Configuration changed",
+			zap.String("key", change.Key),
+			zap.String("old", change.Old),
+			zap.String("new", change.New))
+	}
+	registry.record(changes)
+
+	mon.Event("config_reload_applied")
+	mon.IntVal("config_reload_changes").Observe(int64(len(changes)))
+	return changes, nil
+}
+
+// record appends the applied changes to the audit log. It must be called
+// with the mutex held.
+func (registry *Registry) record(changes []Change) {
+	registry.changes = append(registry.changes, changes...)
+	if excess := len(registry.changes) - maxChanges; excess > 0 {
+		registry.changes = append([]Change(nil), registry.changes[excess:]...)
+	}
+}
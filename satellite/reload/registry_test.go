@@ -0,0 +1,111 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reload_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/reload"
+)
+
+func TestRegistryReload(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	rate, fpr := 100.0, 0.1
+	registry := reload.NewRegistry(zaptest.NewLogger(t), reload.Config{})
+	registry.Register("metainfo.rate-limiter.rate", reload.Float64(
+		func() float64 { return rate },
+		func(v float64) { rate = v },
+		nil,
+	))
+	registry.Register("garbage-collection-bf.false-positive-rate", reload.Float64(
+		func() float64 { return fpr },
+		func(v float64) { fpr = v },
+		func(v float64) error {
+			if v <= 0 || v >= 1 {
+				return errs.New("out of range")
+			}
+			return nil
+		},
+	))
+	require.Equal(t, []string{"garbage-collection-bf.false-positive-rate", "metainfo.rate-limiter.rate"}, registry.Keys())
+
+	// an invalid value rejects the whole reload.
+	_, err := registry.Reload(ctx, map[string]string{
+		"metainfo.rate-limiter.rate":                "200",
+		"garbage-collection-bf.false-positive-rate": "2",
+	})
+	require.Error(t, err)
+	require.Equal(t, 100.0, rate)
+	require.Equal(t, 0.1, fpr)
+	require.Empty(t, registry.Changes())
+
+	// the unchanged and unknown values are ignored.
+	changes, err := registry.Reload(ctx, map[string]string{
+		"metainfo.rate-limiter.rate":                "200",
+		"garbage-collection-bf.false-positive-rate": "0.1",
+		"checker.repair-overrides":                  "invalid",
+	})
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Equal(t, "metainfo.rate-limiter.rate", changes[0].Key)
+	require.Equal(t, "100", changes[0].Old)
+	require.Equal(t, "200", changes[0].New)
+	require.Equal(t, 200.0, rate)
+	require.Equal(t, 0.1, fpr)
+	require.Equal(t, changes, registry.Changes())
+}
+
+func TestRegistryReloadFile(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	rate := 100.0
+	registry := reload.NewRegistry(zaptest.NewLogger(t), reload.Config{})
+	registry.Register("metainfo.rate-limiter.rate", reload.Float64(
+		func() float64 { return rate },
+		func(v float64) { rate = v },
+		nil,
+	))
+
+	path := filepath.Join(ctx.Dir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("metainfo.rate-limiter.rate: 50\nlog.level: info\n"), 0644))
+
+	changes, err := registry.ReloadFile(ctx, path)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Equal(t, 50.0, rate)
+
+	_, err = registry.ReloadFile(ctx, filepath.Join(ctx.Dir(), "missing.yaml"))
+	require.Error(t, err)
+}
+
+func TestLoadFile(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	path := filepath.Join(ctx.Dir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+checker.repair-overrides: 29/80/110-52
+metainfo:
+  rate-limiter:
+    rate: 100.5
+    enabled: true
+admin.address:
+`), 0644))
+
+	values, err := reload.LoadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"checker.repair-overrides":      "29/80/110-52",
+		"metainfo.rate-limiter.rate":    "100.5",
+		"metainfo.rate-limiter.enabled": "true",
+		"admin.address":                 "",
+	}, values)
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reload
+
+import (
+	"strconv"
+)
+
+// Setting is a configuration value, which can be changed while the process is running.
+type Setting interface {
+	// String returns the current value in the format of the configuration file.
+	String() string
+	// Validate checks whether the value can be set.
+	Validate(value string) error
+	// Set changes the current value.
+	Set(value string) error
+}
+
+// Value implements a Setting with functions, which parse, format, load and
+// store the value. Parse is responsible for the validation.
+type Value[T any] struct {
+	Parse  func(string) (T, error)
+	Format func(T) string
+	Load   func() T
+	Store  func(T)
+}
+
+var _ Setting = Value[int]{}
+
+// String implements Setting.
+func (value Value[T]) String() string { return value.Format(value.Load()) }
+
+// Validate implements Setting.
+func (value Value[T]) Validate(s string) error {
+	_, err := value.Parse(s)
+	return err
+}
+
+// Set implements Setting.
+func (value Value[T]) Set(s string) error {
+	v, err := value.Parse(s)
+	if err != nil {
+		return err
+	}
+	value.Store(v)
+	return nil
+}
+
+// Float64 returns a setting for a float value. The optional check validates the value.
+func Float64(load func() float64, store func(float64), check func(float64) error) Value[float64] {
+	return Value[float64]{
+		Parse: func(s string) (float64, error) {
+			v, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return 0, err
+			}
+			if check != nil {
+				if err := check(v); err != nil {
+					return 0, err
+				}
+			}
+			return v, nil
+		},
+		Format: func(v float64) string { return strconv.FormatFloat(v, 'g', -1, 64) },
+		Load:   load,
+		Store:  store,
+	}
+}
@@ -0,0 +1,11 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reload
+
+import "os"
+
+// reloadSignal returns nil, because there's no reload signal on windows.
+func reloadSignal() (_ <-chan os.Signal, stop func()) {
+	return nil, func() {}
+}
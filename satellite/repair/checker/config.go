@@ -18,6 +18,7 @@ type Config struct {
 	Interval time.Duration `help:"how frequently checker should check for bad segments" releaseDefault:"30s" devDefault:"0h0m10s" testDefault:"$TESTINTERVAL"`
 
 	ReliabilityCacheStaleness time.Duration   `help:"how stale reliable node cache can be" releaseDefault:"5m" devDefault:"5m" testDefault:"1m"`
+	OfflineGrace              time.Duration   `help:"how long a node can be offline since it was last contacted successfully, before its pieces are counted as unhealthy. values below the overlay's online window have no effect" default:"0s"`
 	RepairOverrides           RepairOverrides `help:"comma-separated override values for repair threshold in the format k/o/n-override (min/optimal/total-override)" releaseDefault:"29/80/110-52,29/80/95-52,29/80/130-52" devDefault:""`
 	// Node failure rate is an estimation based on a 6 hour checker run interval (4 checker iterations per day), a network of about 9200 nodes, and about 2 nodes churning per day.
 	// This results in `2/9200/4 = 0.00005435` being the probability of any single node going down in the interval of one checker iteration.
@@ -137,6 +138,12 @@ func (ros *RepairOverrides) Set(s string) error {
 	return nil
 }
 
+// ParseRepairOverrides parses the overrides in the format "k/o/n-override,k/o/n-override,...".
+func ParseRepairOverrides(s string) (overrides RepairOverrides, err error) {
+	err = overrides.Set(s)
+	return overrides, err
+}
+
 // GetMap creates a RepairOverridesMap from the config.
 func (ros *RepairOverrides) GetMap() RepairOverridesMap {
 	newMap := RepairOverridesMap{
@@ -35,7 +35,6 @@ type Observer struct {
 	repairQueue          queue.RepairQueue
 	nodestate            *ReliabilityCache
 	overlayService       *overlay.Service
-	repairOverrides      RepairOverridesMap
 	nodeFailureRate      float64
 	repairQueueBatchSize int
 
@@ -45,6 +44,9 @@ type Observer struct {
 
 	mu             sync.Mutex
 	statsCollector map[string]*observerRSStats
+	// repairOverrides can be changed by SetRepairOverrides.
+	repairOverrides       RepairOverridesMap
+	repairOverridesConfig RepairOverrides
 }
 
 // NewObserver creates new checker observer instance.
@@ -52,13 +54,14 @@ func NewObserver(logger *zap.Logger, repairQueue queue.RepairQueue, overlay *ove
 	return &Observer{
 		logger: logger,
 
-		repairQueue:          repairQueue,
-		nodestate:            NewReliabilityCache(overlay, config.ReliabilityCacheStaleness),
-		overlayService:       overlay,
-		repairOverrides:      config.RepairOverrides.GetMap(),
-		nodeFailureRate:      config.NodeFailureRate,
-		repairQueueBatchSize: config.RepairQueueInsertBatchSize,
-		statsCollector:       make(map[string]*observerRSStats),
+		repairQueue:           repairQueue,
+		nodestate:             NewReliabilityCache(logger, overlay, config.ReliabilityCacheStaleness, config.OfflineGrace),
+		overlayService:        overlay,
+		repairOverrides:       config.RepairOverrides.GetMap(),
+		repairOverridesConfig: config.RepairOverrides,
+		nodeFailureRate:       config.NodeFailureRate,
+		repairQueueBatchSize:  config.RepairQueueInsertBatchSize,
+		statsCollector:        make(map[string]*observerRSStats),
 	}
 }
 
@@ -210,6 +213,24 @@ func (observer *Observer) getObserverStats(rsString string) *observerRSStats {
 	return observerStats
 }
 
+// SetRepairOverrides changes the repair threshold overrides. The new overrides
+// are used from the next loop iteration.
+func (observer *Observer) SetRepairOverrides(overrides RepairOverrides) {
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+
+	observer.repairOverrides = overrides.GetMap()
+	observer.repairOverridesConfig = overrides
+}
+
+// RepairOverrides returns the current repair threshold overrides.
+func (observer *Observer) RepairOverrides() RepairOverrides {
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+
+	return observer.repairOverridesConfig
+}
+
 // RefreshReliabilityCache forces refreshing node online status cache.
 func (observer *Observer) RefreshReliabilityCache(ctx context.Context) error {
 	return observer.nodestate.Refresh(ctx)
@@ -233,13 +254,17 @@ type observerFork struct {
 
 // newObserverFork creates new observer partial instance.
 func newObserverFork(observer *Observer) rangedloop.Partial {
+	observer.mu.Lock()
+	repairOverrides := observer.repairOverrides
+	observer.mu.Unlock()
+
 	// we can only share thread-safe objects.
 	return &observerFork{
 		repairQueue:      observer.createInsertBuffer(),
 		nodestate:        observer.nodestate,
 		overlayService:   observer.overlayService,
 		rsStats:          make(map[string]*partialRSStats),
-		repairOverrides:  observer.repairOverrides,
+		repairOverrides:  repairOverrides,
 		nodeFailureRate:  observer.nodeFailureRate,
 		getNodesEstimate: observer.getNodesEstimate,
 		log:              observer.logger,
@@ -9,6 +9,8 @@ import (
 	"sync/atomic"
 	"time"
 
+	"go.uber.org/zap"
+
 	"storj.io/common/storj"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/overlay"
@@ -18,24 +20,35 @@ import (
 // and updates automatically from overlay.
 //
 // architecture: Service
+//
+// When the offline grace is set, the nodes that are offline for less than the
+// grace are still considered reliable, so that short outages don't trigger
+// the repair of all the segments with pieces on them.
 type ReliabilityCache struct {
-	overlay   *overlay.Service
-	staleness time.Duration
-	mu        sync.Mutex
-	state     atomic.Value // contains immutable *reliabilityState
+	log          *zap.Logger
+	overlay      *overlay.Service
+	staleness    time.Duration
+	offlineGrace time.Duration
+	mu           sync.Mutex
+	state        atomic.Value // contains immutable *reliabilityState
 }
 
 // reliabilityState.
 type reliabilityState struct {
 	reliable map[storj.NodeID]struct{}
-	created  time.Time
+	// offline contains the nodes within the offline grace, with the time
+	// they were last contacted successfully.
+	offline map[storj.NodeID]time.Time
+	created time.Time
 }
 
 // NewReliabilityCache creates a new reliability checking cache.
-func NewReliabilityCache(overlay *overlay.Service, staleness time.Duration) *ReliabilityCache {
+func NewReliabilityCache(log *zap.Logger, overlay *overlay.Service, staleness, offlineGrace time.Duration) *ReliabilityCache {
 	return &ReliabilityCache{
-		overlay:   overlay,
-		staleness: staleness,
+		log:          log,
+		overlay:      overlay,
+		staleness:    staleness,
+		offlineGrace: offlineGrace,
 	}
 }
 
@@ -68,9 +81,13 @@ func (cache *ReliabilityCache) MissingPieces(ctx context.Context, created time.T
 	}
 	var unreliable []metabase.Piece
 	for _, p := range pieces {
-		if _, ok := state.reliable[p.StorageNode]; !ok {
-			unreliable = append(unreliable, p)
+		if _, ok := state.reliable[p.StorageNode]; ok {
+			continue
 		}
+		if _, ok := state.offline[p.StorageNode]; ok {
+			continue
+		}
+		unreliable = append(unreliable, p)
 	}
 	return unreliable, nil
 }
@@ -114,7 +131,13 @@ func (cache *ReliabilityCache) Refresh(ctx context.Context) (err error) {
 func (cache *ReliabilityCache) refreshLocked(ctx context.Context) (_ *reliabilityState, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	nodes, err := cache.overlay.Reliable(ctx)
+	var nodes storj.NodeIDList
+	var offline map[storj.NodeID]time.Time
+	if cache.offlineGrace > 0 {
+		nodes, offline, err = cache.overlay.ReliableWithOfflineGrace(ctx, cache.offlineGrace)
+	} else {
+		nodes, err = cache.overlay.Reliable(ctx)
+	}
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -122,11 +145,28 @@ func (cache *ReliabilityCache) refreshLocked(ctx context.Context) (_ *reliabilit
 	state := &reliabilityState{
 		created:  time.Now(),
 		reliable: make(map[storj.NodeID]struct{}, len(nodes)),
+		offline:  offline,
 	}
 	for _, id := range nodes {
 		state.reliable[id] = struct{}{}
 	}
 
+	// the pieces on the nodes, which returned within the grace, are counted
+	// as healthy again, when the segments are checked with the new state.
+	if previous, ok := cache.state.Load().(*reliabilityState); ok {
+		returned := 0
+		for id := range previous.offline {
+			if _, ok := state.reliable[id]; ok {
+				returned++
+			}
+		}
+		if returned > 0 {
+			cache.log.Debug("offline nodes returned within the grace", zap.Int("count", returned))
+		}
+		mon.IntVal("checker_offline_nodes_returned").Observe(int64(returned))
+	}
+	mon.IntVal("checker_offline_nodes_within_grace").Observe(int64(len(offline)))
+
 	cache.state.Store(state)
 	return state, nil
 }
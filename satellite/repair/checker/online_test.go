@@ -35,7 +35,7 @@ func TestReliabilityCache_Concurrent(t *testing.T) {
 	ctx.Go(func() error { return overlayCache.Run(cacheCtx) })
 	defer ctx.Check(overlayCache.Close)
 
-	cache := NewReliabilityCache(overlayCache, time.Millisecond)
+	cache := NewReliabilityCache(zap.NewNop(), overlayCache, time.Millisecond, 0)
 	var group errgroup.Group
 	for i := 0; i < 10; i++ {
 		group.Go(func() error {
@@ -63,3 +63,61 @@ func (fakeOverlayDB) Reliable(context.Context, *overlay.NodeCriteria) (storj.Nod
 		testrand.NodeID(),
 	}, nil
 }
+
+type fakeLastContactOverlayDB struct {
+	overlay.DB
+	lastContacts map[storj.NodeID]time.Time
+}
+
+func (db fakeLastContactOverlayDB) ReliableWithLastContact(ctx context.Context, criteria *overlay.NodeCriteria) (map[storj.NodeID]time.Time, error) {
+	lastContacts := make(map[storj.NodeID]time.Time)
+	for id, lastContactSuccess := range db.lastContacts {
+		if lastContactSuccess.After(time.Now().Add(-criteria.OnlineWindow)) {
+			lastContacts[id] = lastContactSuccess
+		}
+	}
+	return lastContacts, nil
+}
+
+func TestReliabilityCache_OfflineGrace(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	online, offline, gone := testrand.NodeID(), testrand.NodeID(), testrand.NodeID()
+	db := fakeLastContactOverlayDB{lastContacts: map[storj.NodeID]time.Time{
+		online:  time.Now().Add(-time.Minute),
+		offline: time.Now().Add(-5 * time.Hour),
+		gone:    time.Now().Add(-10 * time.Hour),
+	}}
+
+	overlayService, err := overlay.NewService(zap.NewNop(), db, fakeNodeEvents{}, "", "", overlay.Config{
+		Node: overlay.NodeSelectionConfig{
+			OnlineWindow: 4 * time.Hour,
+		},
+		NodeSelectionCache: overlay.UploadSelectionCacheConfig{
+			Staleness: time.Hour,
+		},
+	})
+	require.NoError(t, err)
+
+	cache := NewReliabilityCache(zap.NewNop(), overlayService, time.Hour, 8*time.Hour)
+	pieces := metabase.Pieces{
+		{Number: 0, StorageNode: online},
+		{Number: 1, StorageNode: offline},
+		{Number: 2, StorageNode: gone},
+	}
+
+	missing, err := cache.MissingPieces(ctx, time.Now(), pieces)
+	require.NoError(t, err)
+	require.Equal(t, []metabase.Piece{pieces[2]}, missing)
+
+	numNodes, err := cache.NumNodes(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, numNodes)
+
+	// without the grace, the offline node is unhealthy as well.
+	cache = NewReliabilityCache(zap.NewNop(), overlayService, time.Hour, 2*time.Hour)
+	missing, err = cache.MissingPieces(ctx, time.Now(), pieces)
+	require.NoError(t, err)
+	require.Equal(t, []metabase.Piece{pieces[1], pieces[2]}, missing)
+}
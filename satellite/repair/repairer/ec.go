@@ -25,6 +25,7 @@ import (
 	"storj.io/common/rpc/rpcpool"
 	"storj.io/common/signing"
 	"storj.io/common/storj"
+	"storj.io/common/storj/location"
 	"storj.io/common/sync2"
 	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/metabase"
@@ -49,6 +50,9 @@ type ECRepairer struct {
 	downloadTimeout time.Duration
 	inmemory        bool
 
+	country         location.CountryCode
+	proximityWeight float64
+
 	// used only in tests, where we expect failures and want to wait for them
 	minFailures int
 }
@@ -109,12 +113,8 @@ func (ec *ECRepairer) Get(ctx context.Context, limits []*pb.AddressedOrderLimit,
 	limiter := sync2.NewLimiter(es.RequiredCount())
 	cond := sync.NewCond(&sync.Mutex{})
 
-	for currentLimitIndex, limit := range limits {
-		if limit == nil {
-			continue
-		}
-
-		currentLimitIndex, limit := currentLimitIndex, limit
+	for _, currentLimitIndex := range ec.downloadOrder(limits, cachedNodesInfo) {
+		currentLimitIndex, limit := currentLimitIndex, limits[currentLimitIndex]
 		limiter.Go(ctx, func() {
 			cond.L.Lock()
 			defer cond.Signal()
@@ -0,0 +1,91 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/storj/location"
+	"storj.io/storj/satellite/overlay"
+)
+
+// continentCountries lists the country codes of the continents, which are used to estimate
+// the distance between the repair worker and the storage nodes.
+var continentCountries = map[string]string{
+	"AF": "AO BF BI BJ BW CD CF CG CI CM CV DJ DZ EG EH ER ET GA GH GM GN GQ GW KE KM LR LS LY MA MG ML MR MU MW MZ NA NE NG RE RW SC SD SH SL SN SO SS ST SZ TD TG TN TZ UG YT ZA ZM ZW",
+	"AS": "AE AF AM AZ BD BH BN BT CN CY GE HK ID IL IN IQ IR JO JP KG KH KP KR KW KZ LA LB LK MM MN MO MV MY NP OM PH PK PS QA SA SG SY TH TJ TL TM TR TW UZ VN YE",
+	"EU": "AD AL AT AX BA BE BG BY CH CZ DE DK EE ES FI FO FR GB GG GI GR HR HU IE IM IS IT JE LI LT LU LV MC MD ME MK MT NL NO PL PT RO RS RU SE SI SJ SK SM UA VA XK",
+	"NA": "AG AI AW BB BL BM BQ BS BZ CA CR CU CW DM DO GD GL GP GT HN HT JM KN KY LC MF MQ MS MX NI PA PM PR SV SX TC TT US VC VG VI",
+	"OC": "AS AU CK FJ FM GU KI MH MP NC NF NR NU NZ PF PG PN PW SB TK TO TV UM VU WF WS",
+	"SA": "AR BO BR CL CO EC FK GF GY PE PY SR UY VE",
+}
+
+// continents maps the country codes to their continents.
+var continents = func() map[location.CountryCode]string {
+	continents := map[location.CountryCode]string{}
+	for continent, countries := range continentCountries {
+		for _, country := range strings.Fields(countries) {
+			continents[location.ToCountryCode(country)] = continent
+		}
+	}
+	return continents
+}()
+
+// maxDistance is the distance to the nodes on the other continents and to the nodes with an
+// unknown location.
+const maxDistance = 2
+
+// distance estimates the distance between the countries: 0 for the same country, 1 for the
+// same continent and maxDistance otherwise.
+func distance(a, b location.CountryCode) int {
+	switch {
+	case a == location.None || b == location.None:
+		return maxDistance
+	case a == b:
+		return 0
+	}
+
+	continent, ok := continents[a]
+	if ok && continent == continents[b] {
+		return 1
+	}
+	return maxDistance
+}
+
+// SetDownloadProximity makes the repairer download the pieces from the nodes near the country
+// of the repair worker first. The weight between 0 and 1 sets how much the proximity is
+// preferred over spreading the downloads randomly among the nodes.
+func (ec *ECRepairer) SetDownloadProximity(country location.CountryCode, weight float64) {
+	ec.country = country
+	ec.proximityWeight = weight
+}
+
+// downloadOrder returns the indexes of the non-nil limits in the order, in which their pieces
+// should be downloaded.
+func (ec *ECRepairer) downloadOrder(limits []*pb.AddressedOrderLimit, cachedNodesInfo map[storj.NodeID]overlay.NodeReputation) []int {
+	order := make([]int, 0, len(limits))
+	for i, limit := range limits {
+		if limit != nil {
+			order = append(order, i)
+		}
+	}
+	if ec.country == location.None {
+		return order
+	}
+
+	scores := make(map[int]float64, len(order))
+	for _, i := range order {
+		info := cachedNodesInfo[limits[i].GetLimit().StorageNodeId]
+		proximity := float64(distance(ec.country, info.CountryCode)) / maxDistance
+		scores[i] = ec.proximityWeight*proximity + (1-ec.proximityWeight)*rand.Float64()
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return scores[order[a]] < scores[order[b]]
+	})
+	return order
+}
@@ -0,0 +1,48 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/storj/location"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/overlay"
+)
+
+func TestDistance(t *testing.T) {
+	require.Equal(t, 0, distance(location.Germany, location.Germany))
+	require.Equal(t, 1, distance(location.Germany, location.Poland))
+	require.Equal(t, maxDistance, distance(location.Germany, location.UnitedStates))
+	require.Equal(t, maxDistance, distance(location.Germany, location.None))
+	require.Equal(t, maxDistance, distance(location.None, location.None))
+}
+
+func TestDownloadOrder(t *testing.T) {
+	countries := []location.CountryCode{location.UnitedStates, location.None, location.Poland, location.Germany}
+
+	limits := make([]*pb.AddressedOrderLimit, len(countries)+1)
+	cachedNodesInfo := map[storj.NodeID]overlay.NodeReputation{}
+	for i, country := range countries {
+		nodeID := testrand.NodeID()
+		// the first limit is missing.
+		limits[i+1] = &pb.AddressedOrderLimit{Limit: &pb.OrderLimit{StorageNodeId: nodeID}}
+		cachedNodesInfo[nodeID] = overlay.NodeReputation{ID: nodeID, CountryCode: country}
+	}
+
+	ec := &ECRepairer{}
+	require.Equal(t, []int{1, 2, 3, 4}, ec.downloadOrder(limits, cachedNodesInfo))
+
+	ec.SetDownloadProximity(location.Germany, 1)
+	order := ec.downloadOrder(limits, cachedNodesInfo)
+	require.Equal(t, []int{4, 3}, order[:2])
+	require.ElementsMatch(t, []int{1, 2}, order[2:])
+
+	ec.SetDownloadProximity(location.Germany, 0)
+	require.ElementsMatch(t, []int{1, 2, 3, 4}, ec.downloadOrder(limits, cachedNodesInfo))
+}
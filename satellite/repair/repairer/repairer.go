@@ -35,6 +35,8 @@ type Config struct {
 	InMemoryRepair                bool          `help:"whether to download pieces for repair in memory (true) or download to disk (false)" default:"false"`
 	ReputationUpdateEnabled       bool          `help:"whether the audit score of nodes should be updated as a part of repair" default:"false"`
 	UseRangedLoop                 bool          `help:"whether to enable repair checker observer with ranged loop" default:"true"`
+	CountryCode                   string        `help:"country code of the repair worker, the pieces are downloaded from the nodes near it first when set" default:""`
+	ProximityWeight               float64       `help:"how much the nodes near the repair worker are preferred over spreading the downloads randomly, between 0 and 1" default:"0.8"`
 }
 
 // Service contains the information needed to run the repair service.
@@ -22,6 +22,7 @@ import (
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/quarantine"
 	"storj.io/storj/satellite/repair"
 	"storj.io/storj/satellite/repair/checker"
 	"storj.io/storj/satellite/repair/queue"
@@ -84,6 +85,7 @@ type SegmentRepairer struct {
 	ec             *ECRepairer
 	timeout        time.Duration
 	reporter       audit.Reporter
+	corruptions    *quarantine.Recorder
 
 	reputationUpdateEnabled bool
 
@@ -470,8 +472,12 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 	for _, outcome := range piecesReport.Successful {
 		report.Successes = append(report.Successes, outcome.Piece.StorageNode)
 	}
+	var corruptedNodes storj.NodeIDList
 	for _, outcome := range piecesReport.Failed {
 		report.Fails = append(report.Fails, outcome.Piece.StorageNode)
+		if ErrPieceHashVerifyFailed.Has(outcome.Err) {
+			corruptedNodes = append(corruptedNodes, outcome.Piece.StorageNode)
+		}
 	}
 	for _, outcome := range piecesReport.Offline {
 		report.Offlines = append(report.Offlines, outcome.Piece.StorageNode)
@@ -482,6 +488,7 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 	if repairer.reputationUpdateEnabled {
 		repairer.reporter.RecordAudits(ctx, report)
 	}
+	repairer.corruptions.Record(ctx, quarantine.SourceRepair, corruptedNodes)
 
 	// Upload the repaired pieces
 	successfulNodes, _, err := repairer.ec.Repair(ctx, putLimits, putPrivateKey, redundancy, segmentReader, repairer.timeout, minSuccessfulNeeded)
@@ -640,6 +647,11 @@ func (repairer *SegmentRepairer) loadRedundancy(redundancy *pb.RedundancyScheme)
 	return int(redundancy.MinReq), repair, int(redundancy.SuccessThreshold), int(redundancy.Total)
 }
 
+// SetCorruptions sets the recorder of the corrupted pieces, which may quarantine the nodes.
+func (repairer *SegmentRepairer) SetCorruptions(corruptions *quarantine.Recorder) {
+	repairer.corruptions = corruptions
+}
+
 // SetNow allows tests to have the server act as if the current time is whatever they want.
 func (repairer *SegmentRepairer) SetNow(nowFn func() time.Time) {
 	repairer.nowFn = nowFn
@@ -20,8 +20,10 @@ import (
 	"storj.io/common/rpc"
 	"storj.io/common/signing"
 	"storj.io/common/storj"
+	"storj.io/common/storj/location"
 	"storj.io/private/debug"
 	"storj.io/private/version"
+	"storj.io/storj/private/clock"
 	"storj.io/storj/private/lifecycle"
 	version_checker "storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/audit"
@@ -30,6 +32,7 @@ import (
 	"storj.io/storj/satellite/nodeevents"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/quarantine"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/repair/repairer"
 	"storj.io/storj/satellite/reputation"
@@ -45,6 +48,8 @@ type Repairer struct {
 	Servers  *lifecycle.Group
 	Services *lifecycle.Group
 
+	Clock clock.Clock
+
 	Dialer rpc.Dialer
 
 	Version struct {
@@ -82,6 +87,7 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 	nodeEvents nodeevents.DB,
 	reputationdb reputation.DB,
 	containmentDB audit.Containment,
+	quarantineDB quarantine.DB,
 	versionInfo version.Info, config *Config, atomicLogLevel *zap.AtomicLevel,
 ) (*Repairer, error) {
 	peer := &Repairer{
@@ -111,6 +117,8 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 		})
 	}
 
+	peer.Clock = newClock(config.SimulateClock, peer.Debug.Server.Panel)
+
 	{
 		peer.Log.Info("Version info",
 			zap.Stringer("Version", versionInfo.Version.Version),
@@ -165,6 +173,7 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 			reputationdb,
 			config.Reputation,
 		)
+		peer.Reputation.SetClock(peer.Clock)
 
 		peer.Services.Add(lifecycle.Item{
 			Name:  "reputation",
@@ -206,6 +215,12 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 			signing.SigneeFromPeerIdentity(peer.Identity.PeerIdentity()),
 			config.Repairer.DownloadTimeout,
 			config.Repairer.InMemoryRepair)
+		if config.Repairer.CountryCode != "" {
+			if len(config.Repairer.CountryCode) != 2 || config.Repairer.ProximityWeight < 0 || config.Repairer.ProximityWeight > 1 {
+				return nil, errs.Combine(errs.New("invalid repairer country code %q or proximity weight %v", config.Repairer.CountryCode, config.Repairer.ProximityWeight), peer.Close())
+			}
+			peer.EcRepairer.SetDownloadProximity(location.ToCountryCode(config.Repairer.CountryCode), config.Repairer.ProximityWeight)
+		}
 
 		peer.SegmentRepairer = repairer.NewSegmentRepairer(
 			log.Named("segment-repair"),
@@ -217,6 +232,9 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 			config.Checker.RepairOverrides,
 			config.Repairer,
 		)
+		if config.Quarantine.Enabled {
+			peer.SegmentRepairer.SetCorruptions(quarantine.NewRecorder(log.Named("quarantine:recorder"), quarantineDB))
+		}
 		peer.Repairer = repairer.NewService(log.Named("repairer"), repairQueue, &config.Repairer, peer.SegmentRepairer)
 
 		peer.Services.Add(lifecycle.Item{
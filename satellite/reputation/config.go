@@ -34,10 +34,11 @@ type Config struct {
 	SuspensionDQEnabled   bool          `help:"whether nodes will be disqualified if they have been suspended for longer than the suspended grace period" releaseDefault:"false" devDefault:"true"`
 	AuditCount            int64         `help:"the number of times a node has been audited to not be considered a New Node" releaseDefault:"100" devDefault:"0"`
 	AuditHistory          AuditHistoryConfig
-	FlushInterval         time.Duration `help:"the maximum amount of time that should elapse before cached reputation writes are flushed to the database (if 0, no reputation cache is used)" releaseDefault:"2h" devDefault:"2m"`
-	ErrorRetryInterval    time.Duration `help:"the amount of time that should elapse before the cache retries failed database operations" releaseDefault:"1m" devDefault:"5s"`
-	InitialAlpha          float64       `help:"the value to which an alpha reputation value should be initialized" default:"1000"`
-	InitialBeta           float64       `help:"the value to which a beta reputation value should be initialized" default:"0"`
+	FlushInterval         time.Duration      `help:"the maximum amount of time that should elapse before cached reputation writes are flushed to the database (if 0, no reputation cache is used)" releaseDefault:"2h" devDefault:"2m"`
+	ErrorRetryInterval    time.Duration      `help:"the amount of time that should elapse before the cache retries failed database operations" releaseDefault:"1m" devDefault:"5s"`
+	InitialAlpha          float64            `help:"the value to which an alpha reputation value should be initialized" default:"1000"`
+	InitialBeta           float64            `help:"the value to which a beta reputation value should be initialized" default:"0"`
+	MaintenanceWindows    MaintenanceWindows `help:"comma-separated planned maintenance windows of the satellite in the format start/end (RFC 3339), which are announced to the nodes and during which the offline audits are ignored" default:""`
 }
 
 // UpdateRequest is used to update a node's reputation status.
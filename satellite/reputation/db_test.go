@@ -259,6 +259,32 @@ func TestDBDisqualifyNode(t *testing.T) {
 	})
 }
 
+func TestDBAuditTotals(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		reputationDB := db.Reputation()
+
+		successCount, totalCount, err := reputationDB.AuditTotals(ctx)
+		require.NoError(t, err)
+		require.Zero(t, successCount)
+		require.Zero(t, totalCount)
+
+		config := reputation.Config{AuditHistory: testAuditHistoryConfig()}
+		for _, outcome := range []reputation.AuditType{reputation.AuditSuccess, reputation.AuditSuccess, reputation.AuditFailure} {
+			_, err := reputationDB.Update(ctx, reputation.UpdateRequest{
+				NodeID:       testrand.NodeID(),
+				AuditOutcome: outcome,
+				Config:       config,
+			}, time.Now())
+			require.NoError(t, err)
+		}
+
+		successCount, totalCount, err = reputationDB.AuditTotals(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, successCount)
+		require.EqualValues(t, 3, totalCount)
+	})
+}
+
 func TestDBDisqualificationAuditFailure(t *testing.T) {
 	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
 		reputationDB := db.Reputation()
@@ -0,0 +1,101 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reputation
+
+import (
+	"strings"
+	"time"
+)
+
+// MaintenanceWindow is a planned downtime of the satellite.
+type MaintenanceWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Contains returns whether t is within the window.
+func (window MaintenanceWindow) Contains(t time.Time) bool {
+	return !t.Before(window.Start) && t.Before(window.End)
+}
+
+// String returns the window in the format start/end.
+func (window MaintenanceWindow) String() string {
+	return window.Start.UTC().Format(time.RFC3339) + "/" + window.End.UTC().Format(time.RFC3339)
+}
+
+// MaintenanceWindows is a list of the planned downtimes of the satellite, which are
+// announced to the storage nodes. The offline audits within the windows don't count
+// against the nodes.
+//
+// Can be used as a flag.
+type MaintenanceWindows struct {
+	List []MaintenanceWindow
+}
+
+// Type implements pflag.Value.
+func (MaintenanceWindows) Type() string { return "reputation.MaintenanceWindows" }
+
+// String is required for pflag.Value. It is a comma separated list of the windows.
+func (windows *MaintenanceWindows) String() string {
+	var s strings.Builder
+	for i, window := range windows.List {
+		if i > 0 {
+			s.WriteString(",")
+		}
+		s.WriteString(window.String())
+	}
+	return s.String()
+}
+
+// Set sets the value from a string in the format "start/end,start/end,...", where
+// the times are in RFC 3339 format.
+func (windows *MaintenanceWindows) Set(s string) error {
+	windows.List = nil
+	for _, windowString := range strings.Split(s, ",") {
+		windowString = strings.TrimSpace(windowString)
+		if windowString == "" {
+			continue
+		}
+
+		startString, endString, ok := strings.Cut(windowString, "/")
+		if !ok {
+			return Error.New("invalid maintenance window (expect format start/end, got %s)", windowString)
+		}
+		start, err := time.Parse(time.RFC3339, strings.TrimSpace(startString))
+		if err != nil {
+			return Error.New("invalid maintenance window start %s: %v", startString, err)
+		}
+		end, err := time.Parse(time.RFC3339, strings.TrimSpace(endString))
+		if err != nil {
+			return Error.New("invalid maintenance window end %s: %v", endString, err)
+		}
+		if !start.Before(end) {
+			return Error.New("invalid maintenance window (start should be before end): %s", windowString)
+		}
+
+		windows.List = append(windows.List, MaintenanceWindow{Start: start, End: end})
+	}
+	return nil
+}
+
+// Contains returns whether t is within any of the windows.
+func (windows MaintenanceWindows) Contains(t time.Time) bool {
+	for _, window := range windows.List {
+		if window.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// Upcoming returns the windows, which haven't ended yet.
+func (windows MaintenanceWindows) Upcoming(now time.Time) []MaintenanceWindow {
+	var upcoming []MaintenanceWindow
+	for _, window := range windows.List {
+		if now.Before(window.End) {
+			upcoming = append(upcoming, window)
+		}
+	}
+	return upcoming
+}
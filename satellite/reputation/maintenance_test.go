@@ -0,0 +1,45 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reputation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/reputation"
+)
+
+func TestMaintenanceWindows(t *testing.T) {
+	var windows reputation.MaintenanceWindows
+	require.NoError(t, windows.Set(""))
+	require.Empty(t, windows.List)
+	require.False(t, windows.Contains(time.Now()))
+
+	value := "2023-06-01T10:00:00Z/2023-06-01T12:00:00Z,2023-07-01T10:00:00Z/2023-07-01T11:00:00Z"
+	require.NoError(t, windows.Set(value))
+	require.Len(t, windows.List, 2)
+	require.Equal(t, value, windows.String())
+
+	require.False(t, windows.Contains(time.Date(2023, 6, 1, 9, 59, 0, 0, time.UTC)))
+	require.True(t, windows.Contains(time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)))
+	require.True(t, windows.Contains(time.Date(2023, 7, 1, 10, 30, 0, 0, time.UTC)))
+	require.False(t, windows.Contains(time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)))
+
+	upcoming := windows.Upcoming(time.Date(2023, 6, 1, 11, 0, 0, 0, time.UTC))
+	require.Len(t, upcoming, 2)
+	upcoming = windows.Upcoming(time.Date(2023, 6, 2, 0, 0, 0, 0, time.UTC))
+	require.Len(t, upcoming, 1)
+	require.Equal(t, windows.List[1], upcoming[0])
+	require.Empty(t, windows.Upcoming(time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)))
+
+	for _, invalid := range []string{
+		"2023-06-01T10:00:00Z",
+		"2023-06-01T10:00:00Z/tomorrow",
+		"2023-06-01T12:00:00Z/2023-06-01T10:00:00Z",
+	} {
+		require.Error(t, windows.Set(invalid), invalid)
+	}
+}
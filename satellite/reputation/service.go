@@ -11,6 +11,7 @@ import (
 
 	"storj.io/common/pb"
 	"storj.io/common/storj"
+	"storj.io/storj/private/clock"
 	"storj.io/storj/satellite/nodeevents"
 	"storj.io/storj/satellite/overlay"
 )
@@ -29,6 +30,12 @@ type DB interface {
 	DisqualifyNode(ctx context.Context, nodeID storj.NodeID, disqualifiedAt time.Time, reason overlay.DisqualificationReason) (err error)
 	// SuspendNodeUnknownAudit suspends a storage node for unknown audits.
 	SuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID, suspendedAt time.Time) (err error)
+	// ReinstateNode clears the disqualification and the suspensions of a storage node and
+	// resets its scores to the initial values.
+	ReinstateNode(ctx context.Context, nodeID storj.NodeID, config Config) (err error)
+	// AuditTotals returns the number of the passed audits and the number of all
+	// audits of all nodes.
+	AuditTotals(ctx context.Context) (successCount, totalCount int64, err error)
 }
 
 // Info contains all reputation data to be stored in DB.
@@ -89,6 +96,7 @@ type Service struct {
 	overlay *overlay.Service
 	db      DB
 	config  Config
+	clock   clock.Clock
 }
 
 // NewService creates a new reputation service.
@@ -98,9 +106,16 @@ func NewService(log *zap.Logger, overlay *overlay.Service, db DB, config Config)
 		overlay: overlay,
 		db:      db,
 		config:  config,
+		clock:   clock.Real{},
 	}
 }
 
+// SetClock sets the clock, which is used for the reputation updates, e.g. to
+// check the grace periods of the suspensions.
+func (service *Service) SetClock(clock clock.Clock) {
+	service.clock = clock
+}
+
 // ApplyAudit receives an audit result and applies it to the relevant node in DB.
 func (service *Service) ApplyAudit(ctx context.Context, nodeID storj.NodeID, reputation overlay.ReputationStatus, result AuditType) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -124,7 +139,22 @@ func (service *Service) ApplyAudit(ctx context.Context, nodeID storj.NodeID, rep
 		return nil
 	}
 
-	now := time.Now()
+	now := service.clock.Now()
+	if result == AuditOffline && service.config.MaintenanceWindows.Contains(now) {
+		mon.Event("offline_audit_during_maintenance")
+		return nil
+	}
+	if result == AuditOffline {
+		inMaintenance, err := service.overlay.RecordMaintenanceOfflineAudit(ctx, nodeID, now)
+		if err != nil {
+			return err
+		}
+		if inMaintenance {
+			mon.Event("offline_audit_during_node_maintenance")
+			return nil
+		}
+	}
+
 	statusUpdate, err := service.db.Update(ctx, UpdateRequest{
 		NodeID:       nodeID,
 		AuditOutcome: result,
@@ -210,7 +240,7 @@ func (service *Service) TestSuspendNodeUnknownAudit(ctx context.Context, nodeID
 
 // TestDisqualifyNode disqualifies a storage node.
 func (service *Service) TestDisqualifyNode(ctx context.Context, nodeID storj.NodeID, reason overlay.DisqualificationReason) (err error) {
-	disqualifiedAt := time.Now()
+	disqualifiedAt := service.clock.Now()
 
 	err = service.db.DisqualifyNode(ctx, nodeID, disqualifiedAt, reason)
 	if err != nil {
@@ -379,6 +379,27 @@ func (cdb *CachingDB) SuspendNodeUnknownAudit(ctx context.Context, nodeID storj.
 	return cdb.RequestSync(ctx, nodeID)
 }
 
+// ReinstateNode clears the disqualification and the suspensions of a storage node and
+// resets its scores to the initial values.
+func (cdb *CachingDB) ReinstateNode(ctx context.Context, nodeID storj.NodeID, config Config) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = cdb.backingStore.ReinstateNode(ctx, nodeID, config)
+	if err != nil {
+		return err
+	}
+	// sync with database (this will get it marked as reinstated in the cache)
+	return cdb.RequestSync(ctx, nodeID)
+}
+
+// AuditTotals returns the number of the passed audits and the number of all
+// audits of all nodes. The audits, which weren't synced yet, aren't included.
+func (cdb *CachingDB) AuditTotals(ctx context.Context) (successCount, totalCount int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return cdb.backingStore.AuditTotals(ctx)
+}
+
 // RequestSync requests the managing goroutine to perform a sync of cached info
 // about the specified node to the backing store. This involves applying the
 // cached mutations and resetting the info attribute to match a snapshot of what
@@ -0,0 +1,92 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
+)
+
+// InsertRollupRun records the start of a rollup run.
+func (db *StoragenodeAccounting) InsertRollupRun(ctx context.Context, run accounting.RollupRun) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO accounting_rollup_runs (id, chore, status, period_start, period_end, started_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, run.ID.Bytes(), string(run.Chore), string(run.Status),
+		run.PeriodStart.UTC(), run.PeriodEnd.UTC(), run.StartedAt.UTC())
+	return Error.Wrap(err)
+}
+
+// UpdateRollupRunStatus updates the progress of a rollup run.
+func (db *StoragenodeAccounting) UpdateRollupRunStatus(ctx context.Context, id uuid.UUID, status accounting.RollupRunStatus, finishedAt *time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		UPDATE accounting_rollup_runs
+		SET status = $2, finished_at = $3
+		WHERE id = $1
+	`, id.Bytes(), string(status), finishedAt)
+	return Error.Wrap(err)
+}
+
+// ListIncompleteRollupRuns returns the runs of the chore, which were neither completed nor repaired, the oldest first.
+func (db *StoragenodeAccounting) ListIncompleteRollupRuns(ctx context.Context, chore accounting.RollupRunChore) (_ []accounting.RollupRun, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT id, chore, status, period_start, period_end, started_at, finished_at, repair_claimed_at
+		FROM accounting_rollup_runs
+		WHERE chore = $1 AND status IN ($2, $3)
+		ORDER BY started_at, id
+	`, string(chore), string(accounting.RollupRunStarted), string(accounting.RollupRunSaved))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var runs []accounting.RollupRun
+	for rows.Next() {
+		var run accounting.RollupRun
+		var id []byte
+		var chore, status string
+		err := rows.Scan(&id, &chore, &status, &run.PeriodStart, &run.PeriodEnd, &run.StartedAt,
+			&run.FinishedAt, &run.RepairClaimedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if run.ID, err = uuid.FromBytes(id); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		run.Chore = accounting.RollupRunChore(chore)
+		run.Status = accounting.RollupRunStatus(status)
+		runs = append(runs, run)
+	}
+	return runs, Error.Wrap(rows.Err())
+}
+
+// ClaimRollupRunRepair marks an incomplete rollup run as being repaired. It returns false when the run was already claimed.
+func (db *StoragenodeAccounting) ClaimRollupRunRepair(ctx context.Context, id uuid.UUID, claimedAt time.Time) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := db.db.ExecContext(ctx, `
+		UPDATE accounting_rollup_runs
+		SET repair_claimed_at = $2
+		WHERE id = $1 AND repair_claimed_at IS NULL AND status IN ($3, $4)
+	`, id.Bytes(), claimedAt.UTC(), string(accounting.RollupRunStarted), string(accounting.RollupRunSaved))
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	return affected > 0, nil
+}
@@ -7,11 +7,13 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"sort"
 	"time"
 
 	"github.com/zeebo/errs"
 
 	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/satellitedb/dbx"
 )
@@ -319,6 +321,109 @@ func (keys *attributionDB) QueryAllAttribution(ctx context.Context, start time.T
 	return results, Error.Wrap(rows.Err())
 }
 
+// UpdateUserAgentRollups adds the traffic of the rollups to the daily user agent rollups.
+func (keys *attributionDB) UpdateUserAgentRollups(ctx context.Context, rollups []attribution.UserAgentRollup) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(rollups) == 0 {
+		return nil
+	}
+
+	// sort the rollups to avoid deadlocks between the concurrent batches.
+	sorted := append([]attribution.UserAgentRollup(nil), rollups...)
+	sort.Slice(sorted, func(i, k int) bool {
+		a, b := sorted[i], sorted[k]
+		if c := a.ProjectID.Compare(b.ProjectID); c != 0 {
+			return c < 0
+		}
+		if a.BucketName != b.BucketName {
+			return a.BucketName < b.BucketName
+		}
+		if a.Product != b.Product {
+			return a.Product < b.Product
+		}
+		if a.Version != b.Version {
+			return a.Version < b.Version
+		}
+		return a.IntervalDay.Before(b.IntervalDay)
+	})
+
+	var (
+		projectIDs     = make([]uuid.UUID, 0, len(sorted))
+		bucketNames    = make([][]byte, 0, len(sorted))
+		products       = make([]string, 0, len(sorted))
+		versions       = make([]string, 0, len(sorted))
+		intervalDays   = make([]time.Time, 0, len(sorted))
+		uploadCounts   = make([]int64, 0, len(sorted))
+		uploadBytes    = make([]int64, 0, len(sorted))
+		downloadCounts = make([]int64, 0, len(sorted))
+		downloadBytes  = make([]int64, 0, len(sorted))
+	)
+	for _, rollup := range sorted {
+		projectIDs = append(projectIDs, rollup.ProjectID)
+		bucketNames = append(bucketNames, []byte(rollup.BucketName))
+		products = append(products, rollup.Product)
+		versions = append(versions, rollup.Version)
+		intervalDays = append(intervalDays, rollup.IntervalDay)
+		uploadCounts = append(uploadCounts, rollup.UploadCount)
+		uploadBytes = append(uploadBytes, rollup.UploadBytes)
+		downloadCounts = append(downloadCounts, rollup.DownloadCount)
+		downloadBytes = append(downloadBytes, rollup.DownloadBytes)
+	}
+
+	_, err = keys.db.ExecContext(ctx, `
+		INSERT INTO user_agent_rollups (
+			project_id, bucket_name, product, version, interval_day,
+			upload_count, upload_bytes, download_count, download_bytes)
+		SELECT
+			unnest($1::bytea[]), unnest($2::bytea[]), unnest($3::text[]), unnest($4::text[]), unnest($5::date[]),
+			unnest($6::bigint[]), unnest($7::bigint[]), unnest($8::bigint[]), unnest($9::bigint[])
+		ON CONFLICT (project_id, bucket_name, product, version, interval_day)
+		DO UPDATE SET
+			upload_count   = user_agent_rollups.upload_count   + EXCLUDED.upload_count,
+			upload_bytes   = user_agent_rollups.upload_bytes   + EXCLUDED.upload_bytes,
+			download_count = user_agent_rollups.download_count + EXCLUDED.download_count,
+			download_bytes = user_agent_rollups.download_bytes + EXCLUDED.download_bytes
+	`, pgutil.UUIDArray(projectIDs), pgutil.ByteaArray(bucketNames), pgutil.TextArray(products), pgutil.TextArray(versions),
+		pgutil.DateArray(intervalDays), pgutil.Int8Array(uploadCounts), pgutil.Int8Array(uploadBytes),
+		pgutil.Int8Array(downloadCounts), pgutil.Int8Array(downloadBytes))
+	return Error.Wrap(err)
+}
+
+// GetUserAgentRollups returns the daily user agent rollups of the project for the days from since to before, inclusive.
+func (keys *attributionDB) GetUserAgentRollups(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ []attribution.UserAgentRollup, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := keys.db.QueryContext(ctx, `
+		SELECT bucket_name, product, version, interval_day,
+			upload_count, upload_bytes, download_count, download_bytes
+		FROM user_agent_rollups
+		WHERE project_id = $1
+			AND interval_day >= $2::date
+			AND interval_day <= $3::date
+		ORDER BY interval_day, bucket_name, product, version
+	`, projectID, since.UTC(), before.UTC())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	rollups := []attribution.UserAgentRollup{}
+	for rows.Next() {
+		rollup := attribution.UserAgentRollup{ProjectID: projectID}
+		var bucketName []byte
+		err := rows.Scan(&bucketName, &rollup.Product, &rollup.Version, &rollup.IntervalDay,
+			&rollup.UploadCount, &rollup.UploadBytes, &rollup.DownloadCount, &rollup.DownloadBytes)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		rollup.BucketName = string(bucketName)
+		rollup.IntervalDay = rollup.IntervalDay.UTC()
+		rollups = append(rollups, rollup)
+	}
+	return rollups, Error.Wrap(rows.Err())
+}
+
 func attributionFromDBX(info *dbx.ValueAttribution) (*attribution.Info, error) {
 	userAgent := info.UserAgent
 	projectID, err := uuid.FromBytes(info.ProjectId)
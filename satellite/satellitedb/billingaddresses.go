@@ -0,0 +1,65 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+// Ensure that billingAddresses implements console.BillingAddresses.
+var _ console.BillingAddresses = (*billingAddresses)(nil)
+
+// billingAddresses is an implementation of console.BillingAddresses.
+type billingAddresses struct {
+	db *satelliteDB
+}
+
+// Get returns the billing address of the user.
+func (addresses *billingAddresses) Get(ctx context.Context, userID uuid.UUID) (_ *console.BillingAddress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var address console.BillingAddress
+	err = addresses.db.QueryRowContext(ctx, addresses.db.Rebind(`
+		SELECT name, line1, line2, city, postal_code, state, country, tax_id, updated_at
+		FROM billing_addresses
+		WHERE user_id = ?
+	`), userID).Scan(&address.Name, &address.Line1, &address.Line2, &address.City, &address.PostalCode,
+		&address.State, &address.Country, &address.TaxID, &address.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, console.ErrBillingAddressNotFound.New("%s", userID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &address, nil
+}
+
+// Upsert creates or replaces the billing address of the user.
+func (addresses *billingAddresses) Upsert(ctx context.Context, userID uuid.UUID, address console.BillingAddress) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = addresses.db.ExecContext(ctx, addresses.db.Rebind(`
+		INSERT INTO billing_addresses (
+			user_id, name, line1, line2, city, postal_code, state, country, tax_id, updated_at
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (user_id) DO UPDATE SET
+			name = EXCLUDED.name,
+			line1 = EXCLUDED.line1,
+			line2 = EXCLUDED.line2,
+			city = EXCLUDED.city,
+			postal_code = EXCLUDED.postal_code,
+			state = EXCLUDED.state,
+			country = EXCLUDED.country,
+			tax_id = EXCLUDED.tax_id,
+			updated_at = EXCLUDED.updated_at
+	`), userID, address.Name, address.Line1, address.Line2, address.City, address.PostalCode,
+		address.State, address.Country, address.TaxID, address.UpdatedAt)
+	return err
+}
@@ -0,0 +1,52 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestBillingAddresses(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		addresses := db.Console().BillingAddresses()
+		now := time.Now().Truncate(time.Second).UTC()
+		userID := testrand.UUID()
+
+		_, err := addresses.Get(ctx, userID)
+		require.True(t, console.ErrBillingAddressNotFound.Has(err))
+
+		address := console.BillingAddress{
+			Name:       "Storj Test",
+			Line1:      "Main Street 1",
+			City:       "Berlin",
+			PostalCode: "10115",
+			Country:    "DE",
+			UpdatedAt:  now,
+		}
+		require.NoError(t, addresses.Upsert(ctx, userID, address))
+
+		stored, err := addresses.Get(ctx, userID)
+		require.NoError(t, err)
+		stored.UpdatedAt = stored.UpdatedAt.UTC()
+		require.Equal(t, address, *stored)
+
+		address.TaxID = "DE123456789"
+		address.UpdatedAt = now.Add(time.Hour)
+		require.NoError(t, addresses.Upsert(ctx, userID, address))
+
+		stored, err = addresses.Get(ctx, userID)
+		require.NoError(t, err)
+		stored.UpdatedAt = stored.UpdatedAt.UTC()
+		require.Equal(t, address, *stored)
+	})
+}
@@ -117,7 +117,12 @@ func (db billingDB) tryInsert(ctx context.Context, primaryTx billing.Transaction
 
 	balances := make(map[uuid.UUID]*balanceUpdate)
 
-	adjustBalance := func(userID uuid.UUID, amount currency.Amount) error {
+	adjustBalance := func(billingTX billing.Transaction) error {
+		if !billingTX.Type.AffectsBalance() {
+			return nil
+		}
+		userID, amount := billingTX.UserID, billingTX.Amount
+
 		balance, ok := balances[userID]
 		if !ok {
 			oldBalance, err := db.GetBalance(ctx, userID)
@@ -131,18 +136,19 @@ func (db billingDB) tryInsert(ctx context.Context, primaryTx billing.Transaction
 		switch {
 		case err != nil:
 			return Error.Wrap(err)
-		case newBalance.IsNegative():
+		case newBalance.IsNegative() && amount.IsNegative() && !billingTX.Type.CanOverdraw():
+			// the deposits, which don't cover the overdrawn balance completely, are allowed.
 			return billing.ErrInsufficientFunds
 		}
 		balance.NewBalance = newBalance
 		return nil
 	}
 
-	if err := adjustBalance(primaryTx.UserID, primaryTx.Amount); err != nil {
+	if err := adjustBalance(primaryTx); err != nil {
 		return nil, err
 	}
 	for _, supplementalTx := range supplementalTxs {
-		if err := adjustBalance(supplementalTx.UserID, supplementalTx.Amount); err != nil {
+		if err := adjustBalance(supplementalTx); err != nil {
 			return nil, err
 		}
 	}
@@ -249,6 +255,65 @@ func (db billingDB) GetBalance(ctx context.Context, userID uuid.UUID) (_ currenc
 	return currency.AmountFromBaseUnits(dbxBilling.Balance, currency.USDollarsMicro), nil
 }
 
+func (db billingDB) Reassign(ctx context.Context, fromUserID, toUserID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Tx.ExecContext(ctx, db.db.Rebind(`
+			UPDATE billing_transactions SET user_id = ? WHERE user_id = ?
+		`), toUserID[:], fromUserID[:])
+		if err != nil {
+			return err
+		}
+
+		// the concurrent inserts of the transactions of the target user fail to
+		// update the balance they've read, and are retried.
+		_, err = tx.Tx.ExecContext(ctx, db.db.Rebind(`
+			INSERT INTO billing_balances (user_id, balance, last_updated)
+			SELECT ?, balance, ? FROM billing_balances WHERE user_id = ?
+			ON CONFLICT (user_id) DO UPDATE SET
+				balance = billing_balances.balance + EXCLUDED.balance,
+				last_updated = EXCLUDED.last_updated
+		`), toUserID[:], time.Now(), fromUserID[:])
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, db.db.Rebind(`
+			DELETE FROM billing_balances WHERE user_id = ?
+		`), fromUserID[:])
+		return err
+	}))
+}
+
+func (db billingDB) GetByReference(ctx context.Context, source, reference string) (_ billing.Transaction, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var tx billing.Transaction
+	var userID []byte
+	var amount int64
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(`
+		SELECT id, user_id, amount, description, source, status, type, metadata, timestamp, created_at
+		FROM billing_transactions
+		WHERE source = ? AND metadata->>'`+billing.ReferenceKey+`' = ?
+		ORDER BY id
+		LIMIT 1
+	`), source, reference).Scan(&tx.ID, &userID, &amount, &tx.Description, &tx.Source, &tx.Status, &tx.Type, &tx.Metadata, &tx.Timestamp, &tx.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return billing.Transaction{}, billing.ErrNoTransactions
+		}
+		return billing.Transaction{}, Error.Wrap(err)
+	}
+
+	tx.UserID, err = uuid.FromBytes(userID)
+	if err != nil {
+		return billing.Transaction{}, Error.Wrap(err)
+	}
+	tx.Amount = currency.AmountFromBaseUnits(amount, currency.USDollarsMicro)
+	return tx, nil
+}
+
 // fromDBXBillingTransaction converts *dbx.BillingTransaction to *billing.Transaction.
 func fromDBXBillingTransaction(dbxTX *dbx.BillingTransaction) (*billing.Transaction, error) {
 	userID, err := uuid.FromBytes(dbxTX.UserId)
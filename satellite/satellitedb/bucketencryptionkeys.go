@@ -0,0 +1,69 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/kms"
+)
+
+// ensures that bucketEncryptionKeysDB implements kms.DB.
+var _ kms.DB = (*bucketEncryptionKeysDB)(nil)
+
+// bucketEncryptionKeysDB stores the wrapped metadata keys of the buckets.
+type bucketEncryptionKeysDB struct {
+	db *satelliteDB
+}
+
+// Set stores the key of the bucket, replacing the previous one.
+func (db *bucketEncryptionKeysDB) Set(ctx context.Context, key kms.BucketKey) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`
+		INSERT INTO bucket_encryption_keys (project_id, bucket_name, master_key_id, encrypted_key, created_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (project_id, bucket_name) DO UPDATE SET
+			master_key_id = EXCLUDED.master_key_id,
+			encrypted_key = EXCLUDED.encrypted_key,
+			created_at    = EXCLUDED.created_at
+	`), key.ProjectID, key.BucketName, key.MasterKeyID, key.EncryptedKey, key.CreatedAt)
+	return err
+}
+
+// Get returns the key of the bucket or kms.ErrNotEnabled when there's none.
+func (db *bucketEncryptionKeysDB) Get(ctx context.Context, projectID uuid.UUID, bucketName []byte) (_ kms.BucketKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	key := kms.BucketKey{
+		ProjectID:  projectID,
+		BucketName: bucketName,
+	}
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(`
+		SELECT master_key_id, encrypted_key, created_at
+		FROM bucket_encryption_keys
+		WHERE project_id = ? AND bucket_name = ?
+	`), projectID, bucketName).Scan(&key.MasterKeyID, &key.EncryptedKey, &key.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return kms.BucketKey{}, kms.ErrNotEnabled.New("%s", bucketName)
+	}
+	if err != nil {
+		return kms.BucketKey{}, err
+	}
+	return key, nil
+}
+
+// Delete removes the key of the bucket.
+func (db *bucketEncryptionKeysDB) Delete(ctx context.Context, projectID uuid.UUID, bucketName []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`
+		DELETE FROM bucket_encryption_keys
+		WHERE project_id = ? AND bucket_name = ?
+	`), projectID, bucketName)
+	return err
+}
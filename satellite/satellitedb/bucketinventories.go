@@ -0,0 +1,136 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+// Ensure that bucketInventories implements console.BucketInventories.
+var _ console.BucketInventories = (*bucketInventories)(nil)
+
+// bucketInventories is an implementation of console.BucketInventories.
+type bucketInventories struct {
+	db *satelliteDB
+}
+
+// Get returns the inventory configuration of the bucket.
+func (inventories *bucketInventories) Get(ctx context.Context, projectID uuid.UUID, bucketName string) (_ *console.BucketInventory, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := inventories.db.QueryRowContext(ctx, inventories.db.Rebind(`
+		SELECT project_id, bucket_name, destination_bucket, destination_prefix, format, frequency,
+			destination_access, last_delivered_at, created_at
+		FROM bucket_inventories
+		WHERE project_id = ? AND bucket_name = ?
+	`), projectID, []byte(bucketName))
+
+	inventory, err := scanBucketInventory(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, console.ErrBucketInventoryNotFound.New("%s", bucketName)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &inventory, nil
+}
+
+// GetAll returns the inventory configurations of all the buckets.
+func (inventories *bucketInventories) GetAll(ctx context.Context) (_ []console.BucketInventory, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := inventories.db.QueryContext(ctx, `
+		SELECT project_id, bucket_name, destination_bucket, destination_prefix, format, frequency,
+			destination_access, last_delivered_at, created_at
+		FROM bucket_inventories
+		ORDER BY project_id, bucket_name
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var all []console.BucketInventory
+	for rows.Next() {
+		inventory, err := scanBucketInventory(rows)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, inventory)
+	}
+	return all, rows.Err()
+}
+
+// Upsert creates the inventory configuration of the bucket or replaces the existing one.
+func (inventories *bucketInventories) Upsert(ctx context.Context, inventory console.BucketInventory) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = inventories.db.ExecContext(ctx, inventories.db.Rebind(`
+		INSERT INTO bucket_inventories (
+			project_id, bucket_name, destination_bucket, destination_prefix, format, frequency,
+			destination_access, last_delivered_at, created_at
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (project_id, bucket_name) DO UPDATE SET
+			destination_bucket = EXCLUDED.destination_bucket,
+			destination_prefix = EXCLUDED.destination_prefix,
+			format             = EXCLUDED.format,
+			frequency          = EXCLUDED.frequency,
+			destination_access = EXCLUDED.destination_access
+	`), inventory.ProjectID, []byte(inventory.BucketName), []byte(inventory.DestinationBucket), inventory.DestinationPrefix,
+		string(inventory.Format), string(inventory.Frequency), inventory.DestinationAccess, inventory.LastDeliveredAt, inventory.CreatedAt)
+	return err
+}
+
+// Delete removes the inventory configuration of the bucket.
+func (inventories *bucketInventories) Delete(ctx context.Context, projectID uuid.UUID, bucketName string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = inventories.db.ExecContext(ctx, inventories.db.Rebind(`
+		DELETE FROM bucket_inventories
+		WHERE project_id = ? AND bucket_name = ?
+	`), projectID, []byte(bucketName))
+	return err
+}
+
+// SetDelivered updates the time the last report of the bucket was delivered.
+func (inventories *bucketInventories) SetDelivered(ctx context.Context, projectID uuid.UUID, bucketName string, deliveredAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = inventories.db.ExecContext(ctx, inventories.db.Rebind(`
+		UPDATE bucket_inventories
+		SET last_delivered_at = ?
+		WHERE project_id = ? AND bucket_name = ?
+	`), deliveredAt, projectID, []byte(bucketName))
+	return err
+}
+
+// scanBucketInventory reads a bucket inventory from a row.
+func scanBucketInventory(row interface{ Scan(...interface{}) error }) (inventory console.BucketInventory, err error) {
+	var bucketName, destinationBucket []byte
+	var format, frequency string
+	var lastDeliveredAt sql.NullTime
+	err = row.Scan(&inventory.ProjectID, &bucketName, &destinationBucket, &inventory.DestinationPrefix, &format, &frequency,
+		&inventory.DestinationAccess, &lastDeliveredAt, &inventory.CreatedAt)
+	if err != nil {
+		return console.BucketInventory{}, err
+	}
+
+	inventory.BucketName = string(bucketName)
+	inventory.DestinationBucket = string(destinationBucket)
+	inventory.Format = console.InventoryFormat(format)
+	inventory.Frequency = console.InventoryFrequency(frequency)
+	if lastDeliveredAt.Valid {
+		inventory.LastDeliveredAt = &lastDeliveredAt.Time
+	}
+	return inventory, nil
+}
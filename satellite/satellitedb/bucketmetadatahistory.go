@@ -0,0 +1,144 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil/pgerrcode"
+	"storj.io/storj/satellite/buckets"
+)
+
+// Ensure that bucketMetadataHistory implements buckets.MetadataHistory.
+var _ buckets.MetadataHistory = (*bucketMetadataHistory)(nil)
+
+// bucketMetadataHistory is an implementation of buckets.MetadataHistory.
+type bucketMetadataHistory struct {
+	db *satelliteDB
+}
+
+// Insert records the state with its version. It returns buckets.ErrMetadataStateConflict,
+// when the version of the bucket name is already recorded.
+func (history *bucketMetadataHistory) Insert(ctx context.Context, state buckets.MetadataState) (_ buckets.MetadataState, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = history.db.ExecContext(ctx, history.db.Rebind(`
+		INSERT INTO bucket_metadata_history (
+			project_id, bucket_name, version, bucket_id,
+			default_encryption_cipher_suite, default_encryption_block_size,
+			versioning, object_lock_enabled, deleted, changed_at
+		) VALUES (
+			?, ?, ?, ?,
+			?, ?,
+			?, ?, ?, ?
+		)
+	`), state.ProjectID, []byte(state.BucketName), state.Version, state.BucketID,
+		int(state.DefaultEncryptionParameters.CipherSuite), state.DefaultEncryptionParameters.BlockSize,
+		int(state.Versioning), state.ObjectLockEnabled, state.Deleted, state.ChangedAt,
+	)
+	if err != nil {
+		if pgerrcode.IsConstraintViolation(err) {
+			return buckets.MetadataState{}, buckets.ErrMetadataStateConflict.New("%s version %d", state.BucketName, state.Version)
+		}
+		return buckets.MetadataState{}, err
+	}
+	return state, nil
+}
+
+// Latest returns the current state of the bucket name.
+func (history *bucketMetadataHistory) Latest(ctx context.Context, projectID uuid.UUID, bucketName string) (_ buckets.MetadataState, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := history.db.QueryRowContext(ctx, history.db.Rebind(`
+		SELECT project_id, bucket_name, version, bucket_id,
+			default_encryption_cipher_suite, default_encryption_block_size,
+			versioning, object_lock_enabled, deleted, changed_at
+		FROM bucket_metadata_history
+		WHERE project_id = ? AND bucket_name = ?
+		ORDER BY version DESC
+		LIMIT 1
+	`), projectID, []byte(bucketName))
+
+	state, err := scanBucketMetadataState(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return buckets.MetadataState{}, buckets.ErrMetadataStateNotFound.New("%s", bucketName)
+	}
+	return state, err
+}
+
+// At returns the state of the bucket name at the time.
+func (history *bucketMetadataHistory) At(ctx context.Context, projectID uuid.UUID, bucketName string, at time.Time) (_ buckets.MetadataState, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := history.db.QueryRowContext(ctx, history.db.Rebind(`
+		SELECT project_id, bucket_name, version, bucket_id,
+			default_encryption_cipher_suite, default_encryption_block_size,
+			versioning, object_lock_enabled, deleted, changed_at
+		FROM bucket_metadata_history
+		WHERE project_id = ? AND bucket_name = ? AND changed_at <= ?
+		ORDER BY version DESC
+		LIMIT 1
+	`), projectID, []byte(bucketName), at)
+
+	state, err := scanBucketMetadataState(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return buckets.MetadataState{}, buckets.ErrMetadataStateNotFound.New("%s at %s", bucketName, at.Format(time.RFC3339))
+	}
+	return state, err
+}
+
+// List returns the states of the bucket name, the newest first.
+func (history *bucketMetadataHistory) List(ctx context.Context, projectID uuid.UUID, bucketName string, limit int) (_ []buckets.MetadataState, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := history.db.QueryContext(ctx, history.db.Rebind(`
+		SELECT project_id, bucket_name, version, bucket_id,
+			default_encryption_cipher_suite, default_encryption_block_size,
+			versioning, object_lock_enabled, deleted, changed_at
+		FROM bucket_metadata_history
+		WHERE project_id = ? AND bucket_name = ?
+		ORDER BY version DESC
+		LIMIT ?
+	`), projectID, []byte(bucketName), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var states []buckets.MetadataState
+	for rows.Next() {
+		state, err := scanBucketMetadataState(rows)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, state)
+	}
+	return states, rows.Err()
+}
+
+func scanBucketMetadataState(row interface{ Scan(...interface{}) error }) (state buckets.MetadataState, err error) {
+	var bucketName []byte
+	var cipherSuite, blockSize, versioning int
+	err = row.Scan(&state.ProjectID, &bucketName, &state.Version, &state.BucketID,
+		&cipherSuite, &blockSize,
+		&versioning, &state.ObjectLockEnabled, &state.Deleted, &state.ChangedAt)
+	if err != nil {
+		return buckets.MetadataState{}, err
+	}
+
+	state.BucketName = string(bucketName)
+	state.DefaultEncryptionParameters = storj.EncryptionParameters{
+		CipherSuite: storj.CipherSuite(cipherSuite),
+		BlockSize:   int32(blockSize),
+	}
+	state.Versioning = buckets.Versioning(versioning)
+	return state, nil
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestBucketMetadataHistory(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		history := db.BucketMetadataHistory()
+		now := time.Now().Truncate(time.Second).UTC()
+		projectID := testrand.UUID()
+
+		_, err := history.Latest(ctx, projectID, "bucket")
+		require.True(t, buckets.ErrMetadataStateNotFound.Has(err))
+
+		state := buckets.MetadataState{
+			ProjectID:  projectID,
+			BucketName: "bucket",
+			BucketID:   testrand.UUID(),
+			DefaultEncryptionParameters: storj.EncryptionParameters{
+				CipherSuite: storj.EncAESGCM,
+				BlockSize:   7424,
+			},
+			ChangedAt: now,
+		}
+
+		var recorded []buckets.MetadataState
+		for i, versioning := range []buckets.Versioning{buckets.Unversioned, buckets.VersioningEnabled, buckets.VersioningSuspended} {
+			state.Version = int64(i + 1)
+			state.Versioning = versioning
+			state.ChangedAt = now.Add(time.Duration(i) * time.Hour)
+
+			inserted, err := history.Insert(ctx, state)
+			require.NoError(t, err)
+			require.EqualValues(t, i+1, inserted.Version)
+			recorded = append(recorded, inserted)
+		}
+
+		// a concurrent change can't record the same version.
+		_, err = history.Insert(ctx, state)
+		require.True(t, buckets.ErrMetadataStateConflict.Has(err))
+
+		// the history of the other buckets is separate.
+		other, err := history.Insert(ctx, buckets.MetadataState{
+			ProjectID:  projectID,
+			BucketName: "other",
+			Version:    1,
+			BucketID:   testrand.UUID(),
+			ChangedAt:  now,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, other.Version)
+
+		latest, err := history.Latest(ctx, projectID, "bucket")
+		require.NoError(t, err)
+		latest.ChangedAt = latest.ChangedAt.UTC()
+		require.Equal(t, recorded[2], latest)
+
+		at, err := history.At(ctx, projectID, "bucket", now.Add(90*time.Minute))
+		require.NoError(t, err)
+		require.Equal(t, buckets.VersioningEnabled, at.Versioning)
+		require.EqualValues(t, 2, at.Version)
+
+		_, err = history.At(ctx, projectID, "bucket", now.Add(-time.Minute))
+		require.True(t, buckets.ErrMetadataStateNotFound.Has(err))
+
+		list, err := history.List(ctx, projectID, "bucket", 2)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.EqualValues(t, 3, list[0].Version)
+		require.EqualValues(t, 2, list[1].Version)
+	})
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/eventing"
+)
+
+// Ensure that bucketNotifications implements eventing.DB.
+var _ eventing.DB = (*bucketNotifications)(nil)
+
+// bucketNotifications is an implementation of eventing.DB.
+type bucketNotifications struct {
+	db *satelliteDB
+}
+
+// Get returns the configuration of the bucket or an empty one when the bucket has none.
+func (notifications *bucketNotifications) Get(ctx context.Context, projectID uuid.UUID, bucketName string) (_ eventing.BucketConfig, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var configuration []byte
+	err = notifications.db.QueryRowContext(ctx, notifications.db.Rebind(`
+		SELECT configuration
+		FROM bucket_notification_configs
+		WHERE project_id = ? AND bucket_name = ?
+	`), projectID, []byte(bucketName)).Scan(&configuration)
+	if errors.Is(err, sql.ErrNoRows) {
+		return eventing.BucketConfig{}, nil
+	}
+	if err != nil {
+		return eventing.BucketConfig{}, err
+	}
+
+	var config eventing.BucketConfig
+	if err := json.Unmarshal(configuration, &config); err != nil {
+		return eventing.BucketConfig{}, err
+	}
+	return config, nil
+}
+
+// Set replaces the configuration of the bucket.
+func (notifications *bucketNotifications) Set(ctx context.Context, projectID uuid.UUID, bucketName string, config eventing.BucketConfig) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	configuration, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	_, err = notifications.db.ExecContext(ctx, notifications.db.Rebind(`
+		INSERT INTO bucket_notification_configs (project_id, bucket_name, configuration, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (project_id, bucket_name) DO UPDATE SET
+			configuration = EXCLUDED.configuration,
+			updated_at    = EXCLUDED.updated_at
+	`), projectID, []byte(bucketName), configuration, now, now)
+	return err
+}
+
+// Delete removes the configuration of the bucket.
+func (notifications *bucketNotifications) Delete(ctx context.Context, projectID uuid.UUID, bucketName string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = notifications.db.ExecContext(ctx, notifications.db.Rebind(`
+		DELETE FROM bucket_notification_configs
+		WHERE project_id = ? AND bucket_name = ?
+	`), projectID, []byte(bucketName))
+	return err
+}
@@ -14,6 +14,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/changes"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/satellitedb/dbx"
 )
@@ -54,6 +55,8 @@ func (db *bucketsDB) CreateBucket(ctx context.Context, bucket buckets.Bucket) (_
 		return buckets.Bucket{}, buckets.ErrBucket.Wrap(err)
 	}
 
+	db.db.publish(ctx, changes.TableBucketMetainfos, changes.OpInsert, bucketKey(bucket.ProjectID, []byte(bucket.Name)))
+
 	bucket, err = convertDBXtoBucket(row)
 	if err != nil {
 		return buckets.Bucket{}, buckets.ErrBucket.Wrap(err)
@@ -165,6 +168,7 @@ func (db *bucketsDB) UpdateBucket(ctx context.Context, bucket buckets.Bucket) (_
 	if err != nil {
 		return buckets.Bucket{}, buckets.ErrBucket.Wrap(err)
 	}
+	db.db.publish(ctx, changes.TableBucketMetainfos, changes.OpUpdate, bucketKey(bucket.ProjectID, []byte(bucket.Name)))
 	return convertDBXtoBucket(dbxBucket)
 }
 
@@ -181,9 +185,29 @@ func (db *bucketsDB) DeleteBucket(ctx context.Context, bucketName []byte, projec
 	if !deleted {
 		return buckets.ErrBucketNotFound.New("%s", bucketName)
 	}
+	// the metadata key of the server-side encryption must not outlive the bucket.
+	err = (&bucketEncryptionKeysDB{db: db.db}).Delete(ctx, projectID, bucketName)
+	if err != nil {
+		return buckets.ErrBucket.Wrap(err)
+	}
+	// a new bucket with the same name must not inherit the inventory reports and the event notifications.
+	err = (&bucketInventories{db: db.db}).Delete(ctx, projectID, string(bucketName))
+	if err != nil {
+		return buckets.ErrBucket.Wrap(err)
+	}
+	err = (&bucketNotifications{db: db.db}).Delete(ctx, projectID, string(bucketName))
+	if err != nil {
+		return buckets.ErrBucket.Wrap(err)
+	}
+	db.db.publish(ctx, changes.TableBucketMetainfos, changes.OpDelete, bucketKey(projectID, bucketName))
 	return nil
 }
 
+// bucketKey returns the key of the change events of the bucket.
+func bucketKey(projectID uuid.UUID, bucketName []byte) string {
+	return projectID.String() + "/" + string(bucketName)
+}
+
 // ListBuckets returns a list of buckets for a project.
 func (db *bucketsDB) ListBuckets(ctx context.Context, projectID uuid.UUID, listOpts buckets.ListOptions, allowedBuckets macaroon.AllowedBuckets) (bucketList buckets.List, err error) {
 	defer mon.Task()(&ctx)(&err)
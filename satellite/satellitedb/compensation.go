@@ -6,6 +6,8 @@ package satellitedb
 import (
 	"context"
 
+	"github.com/zeebo/errs"
+
 	"storj.io/common/storj"
 	"storj.io/storj/private/currency"
 	"storj.io/storj/satellite/compensation"
@@ -86,15 +88,9 @@ func (comp *compensationDB) RecordPayments(ctx context.Context, payments []compe
 				}
 			}
 
-			return Error.Wrap(tx.CreateNoReturn_StoragenodePayment(ctx,
-				dbx.StoragenodePayment_NodeId(payment.NodeID.Bytes()),
-				dbx.StoragenodePayment_Period(payment.Period.String()),
-				dbx.StoragenodePayment_Amount(payment.Amount.Value()),
-				dbx.StoragenodePayment_Create_Fields{
-					Receipt: dbx.StoragenodePayment_Receipt_Raw(payment.Receipt),
-					Notes:   dbx.StoragenodePayment_Notes_Raw(payment.Notes),
-				},
-			))
+			return Error.Wrap(createPayment(ctx, comp.db, tx,
+				storj.NodeID(payment.NodeID), payment.Period.String(), payment.Amount.Value(),
+				payment.Receipt, payment.Notes))
 		})
 		if err != nil {
 			return err
@@ -135,3 +131,153 @@ func (comp *compensationDB) RecordPaystubs(ctx context.Context, paystubs []compe
 	}
 	return nil
 }
+
+// CreateRateSchedule records the rates in effect from the period as the next version of
+// the schedule of the period.
+func (comp *compensationDB) CreateRateSchedule(ctx context.Context, period compensation.Period, rates compensation.Rates, note string) (_ compensation.RateSchedule, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	schedule := compensation.RateSchedule{
+		Period: period,
+		Rates:  rates,
+		Note:   note,
+	}
+
+	err = comp.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		row := tx.Tx.QueryRowContext(ctx, comp.db.Rebind(`
+			INSERT INTO storagenode_rate_schedules (
+				period, version,
+				at_rest_gb_hours, get_tb, put_tb, get_repair_tb, put_repair_tb, get_audit_tb,
+				note
+			)
+			SELECT
+				?, coalesce(MAX(version), 0) + 1,
+				?, ?, ?, ?, ?, ?,
+				?
+			FROM storagenode_rate_schedules
+			WHERE period = ?
+			RETURNING version, created_at
+		`), period.String(),
+			rates.AtRestGBHours.String(), rates.GetTB.String(), rates.PutTB.String(),
+			rates.GetRepairTB.String(), rates.PutRepairTB.String(), rates.GetAuditTB.String(),
+			note, period.String())
+
+		return row.Scan(&schedule.Version, &schedule.CreatedAt)
+	})
+	if err != nil {
+		return compensation.RateSchedule{}, Error.Wrap(err)
+	}
+
+	return schedule, nil
+}
+
+// ListRateSchedules returns all versions of the rate schedules ordered by the period and
+// the version.
+func (comp *compensationDB) ListRateSchedules(ctx context.Context) (_ []compensation.RateSchedule, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := comp.db.QueryContext(ctx, `
+		SELECT
+			period, version,
+			at_rest_gb_hours, get_tb, put_tb, get_repair_tb, put_repair_tb, get_audit_tb,
+			note, created_at
+		FROM storagenode_rate_schedules
+		ORDER BY period, version
+	`)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var schedules []compensation.RateSchedule
+	for rows.Next() {
+		var period string
+		var atRestGBHours, getTB, putTB, getRepairTB, putRepairTB, getAuditTB string
+		var schedule compensation.RateSchedule
+		err := rows.Scan(&period, &schedule.Version,
+			&atRestGBHours, &getTB, &putTB, &getRepairTB, &putRepairTB, &getAuditTB,
+			&schedule.Note, &schedule.CreatedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		schedule.Period, err = compensation.PeriodFromString(period)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		var group errs.Group
+		for _, rate := range []struct {
+			value string
+			dest  *compensation.Rate
+		}{
+			{atRestGBHours, &schedule.Rates.AtRestGBHours},
+			{getTB, &schedule.Rates.GetTB},
+			{putTB, &schedule.Rates.PutTB},
+			{getRepairTB, &schedule.Rates.GetRepairTB},
+			{putRepairTB, &schedule.Rates.PutRepairTB},
+			{getAuditTB, &schedule.Rates.GetAuditTB},
+		} {
+			group.Add(rate.dest.Set(rate.value))
+		}
+		if err := group.Err(); err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		schedule.CreatedAt = schedule.CreatedAt.UTC()
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules, Error.Wrap(rows.Err())
+}
+
+// ScheduleHeldReleases records the releases of the held amounts. The amount of an already
+// scheduled release of the node for the period is replaced.
+func (comp *compensationDB) ScheduleHeldReleases(ctx context.Context, releases []compensation.HeldRelease) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(comp.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		for _, release := range releases {
+			_, err := tx.Tx.ExecContext(ctx, comp.db.Rebind(`
+				INSERT INTO storagenode_held_releases (
+					node_id, period, amount
+				) VALUES (?, ?, ?)
+				ON CONFLICT (node_id, period) DO UPDATE SET amount = EXCLUDED.amount
+			`), release.NodeID.Bytes(), release.Period.String(), release.Amount.Value())
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+// ListHeldReleases returns the held releases of the period ordered by the node ID.
+func (comp *compensationDB) ListHeldReleases(ctx context.Context, period compensation.Period) (_ []compensation.HeldRelease, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := comp.db.QueryContext(ctx, comp.db.Rebind(`
+		SELECT node_id, amount, created_at
+		FROM storagenode_held_releases
+		WHERE period = ?
+		ORDER BY node_id
+	`), period.String())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var releases []compensation.HeldRelease
+	for rows.Next() {
+		var amount int64
+		release := compensation.HeldRelease{Period: period}
+		if err := rows.Scan(&release.NodeID, &amount, &release.CreatedAt); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		release.Amount = currency.NewMicroUnit(amount)
+		release.CreatedAt = release.CreatedAt.UTC()
+		releases = append(releases, release)
+	}
+
+	return releases, Error.Wrap(rows.Err())
+}
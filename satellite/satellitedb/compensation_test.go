@@ -0,0 +1,68 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/compensation"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestRateSchedules(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		comp := db.Compensation()
+
+		rates := func(getTB string) compensation.Rates {
+			return compensation.Rates{
+				AtRestGBHours: compensation.RequireRateFromString("0.00000205"),
+				GetTB:         compensation.RequireRateFromString(getTB),
+				PutTB:         compensation.RequireRateFromString("0"),
+				GetRepairTB:   compensation.RequireRateFromString("10"),
+				PutRepairTB:   compensation.RequireRateFromString("0"),
+				GetAuditTB:    compensation.RequireRateFromString("10"),
+			}
+		}
+
+		may := compensation.Period{Year: 2023, Month: 5}
+		april := compensation.Period{Year: 2023, Month: 4}
+
+		schedules, err := comp.ListRateSchedules(ctx)
+		require.NoError(t, err)
+		require.Empty(t, schedules)
+
+		first, err := comp.CreateRateSchedule(ctx, may, rates("20"), "initial rates")
+		require.NoError(t, err)
+		require.Equal(t, 1, first.Version)
+		require.False(t, first.CreatedAt.IsZero())
+
+		correction, err := comp.CreateRateSchedule(ctx, may, rates("18.5"), "corrected egress rate")
+		require.NoError(t, err)
+		require.Equal(t, 2, correction.Version)
+
+		earlier, err := comp.CreateRateSchedule(ctx, april, rates("15"), "")
+		require.NoError(t, err)
+		require.Equal(t, 1, earlier.Version)
+
+		schedules, err = comp.ListRateSchedules(ctx)
+		require.NoError(t, err)
+		require.Len(t, schedules, 3)
+		require.Equal(t, april, schedules[0].Period)
+		require.Equal(t, may, schedules[1].Period)
+		require.Equal(t, 1, schedules[1].Version)
+		require.Equal(t, may, schedules[2].Period)
+		require.Equal(t, 2, schedules[2].Version)
+		require.Equal(t, "18.5", schedules[2].Rates.GetTB.String())
+		require.Equal(t, "0.00000205", schedules[2].Rates.AtRestGBHours.String())
+		require.Equal(t, "corrected egress rate", schedules[2].Note)
+
+		schedule, ok := compensation.ScheduleForPeriod(schedules, compensation.Period{Year: 2023, Month: 6})
+		require.True(t, ok)
+		require.Equal(t, 2, schedule.Version)
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"storj.io/common/lrucache"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/eventing"
 	"storj.io/storj/satellite/satellitedb/dbx"
 )
 
@@ -24,6 +25,8 @@ type ConsoleDB struct {
 
 	db *satelliteDB
 	tx *dbx.Tx
+	// readDB serves the listings, which tolerate stale data.
+	readDB *satelliteDB
 
 	methods dbx.Methods
 
@@ -38,7 +41,7 @@ func (db *ConsoleDB) Users() console.Users {
 
 // Projects is a getter for Projects repository.
 func (db *ConsoleDB) Projects() console.Projects {
-	return &projects{db: db.methods, sdb: db.db}
+	return &projects{db: db.methods, sdb: db.db, readDB: db.readDB}
 }
 
 // ProjectMembers is a getter for ProjectMembers repository.
@@ -86,6 +89,31 @@ func (db *ConsoleDB) AccountFreezeEvents() console.AccountFreezeEvents {
 	return &accountFreezeEvents{db.methods}
 }
 
+// BucketInventories is a getter for BucketInventories repository.
+func (db *ConsoleDB) BucketInventories() console.BucketInventories {
+	return &bucketInventories{db.db}
+}
+
+// BucketNotifications is a getter for the repository of the bucket event configurations.
+func (db *ConsoleDB) BucketNotifications() eventing.DB {
+	return &bucketNotifications{db.db}
+}
+
+// GatewayCredentials is a getter for GatewayCredentials repository.
+func (db *ConsoleDB) GatewayCredentials() console.GatewayCredentials {
+	return &gatewayCredentials{db.db}
+}
+
+// BillingAddresses is a getter for BillingAddresses repository.
+func (db *ConsoleDB) BillingAddresses() console.BillingAddresses {
+	return &billingAddresses{db.db}
+}
+
+// Notifications is a getter for Notifications repository.
+func (db *ConsoleDB) Notifications() console.Notifications {
+	return &notifications{db.db}
+}
+
 // WithTx is a method for executing and retrying transaction.
 func (db *ConsoleDB) WithTx(ctx context.Context, fn func(context.Context, console.DBTx) error) error {
 	if db.db == nil {
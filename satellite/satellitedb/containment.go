@@ -62,3 +62,14 @@ func (containment *containment) GetAllContainedNodes(ctx context.Context) (nodes
 
 	return containment.reverifyQueue.GetAllContainedNodes(ctx)
 }
+
+// GetStatus returns the number of the pending reverification audits of the node
+// and the time the oldest of them was queued.
+func (containment *containment) GetStatus(ctx context.Context, id pb.NodeID) (_ audit.ContainmentStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if id.IsZero() {
+		return audit.ContainmentStatus{}, audit.ContainError.New("node ID empty")
+	}
+
+	return containment.reverifyQueue.GetStatusByNodeID(ctx, id)
+}
@@ -5,7 +5,9 @@ package satellitedb
 
 import (
 	"context"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -17,20 +19,29 @@ import (
 	"storj.io/storj/private/migrate"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/appeals"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/changes"
 	"storj.io/storj/satellite/compensation"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/featureflags"
 	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/kms"
+	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/metabase/rangedloop"
 	"storj.io/storj/satellite/nodeapiversion"
 	"storj.io/storj/satellite/nodeevents"
+	"storj.io/storj/satellite/nodemessages"
 	"storj.io/storj/satellite/oidc"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/overlay/slareports"
 	"storj.io/storj/satellite/payments/billing"
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripe"
+	"storj.io/storj/satellite/quarantine"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/reputation"
 	"storj.io/storj/satellite/revocation"
@@ -41,8 +52,18 @@ import (
 // Error is the default satellitedb errs class.
 var Error = errs.Class("satellitedb")
 
+// readOnlyDBName is the name of the database in the mapping, which is used for reads
+// tolerating stale data, e.g. a read replica of the default database.
+const readOnlyDBName = "readonly"
+
 type satelliteDBCollection struct {
 	dbs map[string]*satelliteDB
+
+	// readOnly is the database for reads tolerating stale data, it's nil when
+	// it's not configured.
+	readOnly *satelliteDB
+
+	changes *changes.Bus
 }
 
 // satelliteDB combines access to different database tables with a record
@@ -54,10 +75,19 @@ type satelliteDB struct {
 
 	opts   Options
 	log    *zap.Logger
+	name   string
 	driver string
 	impl   dbutil.Implementation
 	source string
 
+	retryPolicy retryPolicy
+
+	// faults injects errors into the queries in tests.
+	faults *queryFaults
+
+	// changes receives the change events of the tables.
+	changes *changes.Bus
+
 	consoleDBOnce sync.Once
 	consoleDB     *ConsoleDB
 
@@ -74,6 +104,14 @@ type Options struct {
 	// How many storage node rollups to save/read in one batch.
 	SaveRollupBatchSize int
 	ReadRollupBatchSize int
+
+	// SlowQueryThreshold is the duration, after which the queries are logged as slow.
+	// Zero disables the logging.
+	SlowQueryThreshold time.Duration
+
+	// ChangeSinkURL is the url, to which the change events of the tables are posted.
+	// Empty disables the sink.
+	ChangeSinkURL string
 }
 
 var _ dbx.DBMethods = &satelliteDB{}
@@ -89,13 +127,25 @@ var safelyPartitionableDBs = map[string]bool{
 }
 
 // Open creates instance of satellite.DB.
+//
+// The databaseURL may map the "readonly" database, e.g. to a read replica, which serves
+// the reads tolerating stale data, like the dashboards and the node stats:
+// postgres://user:pw@primary/database,readonly:postgres://user:pw@replica/database.
 func Open(ctx context.Context, log *zap.Logger, databaseURL string, opts Options) (rv satellite.DB, err error) {
 	dbMapping, err := dbutil.ParseDBMapping(databaseURL)
 	if err != nil {
 		return nil, err
 	}
 
-	dbc := &satelliteDBCollection{dbs: map[string]*satelliteDB{}}
+	var sink changes.Sink
+	if opts.ChangeSinkURL != "" {
+		sink = changes.NewWebhookSink(opts.ChangeSinkURL)
+	}
+
+	dbc := &satelliteDBCollection{
+		dbs:     map[string]*satelliteDB{},
+		changes: changes.NewBus(log.Named("changes"), sink),
+	}
 	defer func() {
 		if err != nil {
 			err = errs.Combine(err, dbc.Close())
@@ -107,6 +157,11 @@ func Open(ctx context.Context, log *zap.Logger, databaseURL string, opts Options
 		if err != nil {
 			return nil, err
 		}
+		db.changes = dbc.changes
+		if key == readOnlyDBName {
+			dbc.readOnly = db
+			continue
+		}
 		dbc.dbs[key] = db
 	}
 
@@ -140,14 +195,27 @@ func open(ctx context.Context, log *zap.Logger, databaseURL string, opts Options
 	}
 	dbutil.Configure(ctx, dbxDB.DB, name, mon)
 
+	stats := &queryStats{
+		log:                log.Named("query"),
+		slowQueryThreshold: opts.SlowQueryThreshold,
+	}
+	dbxDB.WrapDB(stats.wrap)
+
+	faults := &queryFaults{}
+	dbxDB.WrapDB(faults.wrap)
+
 	core := &satelliteDB{
 		DB: dbxDB,
 
 		opts:   opts,
 		log:    log,
+		name:   name,
 		driver: driver,
 		impl:   impl,
 		source: source,
+
+		retryPolicy: defaultRetryPolicy,
+		faults:      faults,
 	}
 
 	core.migrationDB = core
@@ -164,6 +232,16 @@ func (dbc *satelliteDBCollection) getByName(name string) *satelliteDB {
 	return dbc.dbs[""]
 }
 
+// getForReads returns the database for reads tolerating stale data. It's the read-only
+// database, when it's configured and name isn't partitioned to its own database.
+func (dbc *satelliteDBCollection) getForReads(name string) *satelliteDB {
+	db := dbc.getByName(name)
+	if dbc.readOnly != nil && db == dbc.dbs[""] {
+		return dbc.readOnly
+	}
+	return db
+}
+
 // PeerIdentities returns a storage for peer identities.
 func (dbc *satelliteDBCollection) PeerIdentities() overlay.PeerIdentities {
 	return &peerIdentities{db: dbc.getByName("peeridentities")}
@@ -184,6 +262,46 @@ func (dbc *satelliteDBCollection) NodeEvents() nodeevents.DB {
 	return &nodeEvents{db: dbc.getByName("nodeevents")}
 }
 
+// NodeSLAReports is a getter for node uptime SLA reports repository.
+func (dbc *satelliteDBCollection) NodeSLAReports() slareports.DB {
+	return &nodeSLAReports{db: dbc.getByName("nodeslareports")}
+}
+
+// RangedLoopLeases is a getter for the leases of the ranged loop cycles.
+func (dbc *satelliteDBCollection) RangedLoopLeases() rangedloop.CycleLeases {
+	return &rangedLoopLeases{db: dbc.getByName("rangedloopleases")}
+}
+
+// Outages is a getter for the heartbeats and the outages of the satellite.
+func (dbc *satelliteDBCollection) Outages() orders.OutagesDB {
+	return &satelliteOutages{db: dbc.getByName("outages")}
+}
+
+// NodeAppeals is a getter for the appeals of the suspended or disqualified nodes.
+func (dbc *satelliteDBCollection) NodeAppeals() appeals.DB {
+	return &nodeAppeals{db: dbc.getByName("nodeappeals")}
+}
+
+// NodeQuarantines is a getter for the corruptions and the quarantines of the nodes.
+func (dbc *satelliteDBCollection) NodeQuarantines() quarantine.DB {
+	return &nodeQuarantines{db: dbc.getByName("nodequarantines")}
+}
+
+// NodeMessages is a getter for the messages to the storage node operators.
+func (dbc *satelliteDBCollection) NodeMessages() nodemessages.DB {
+	return &nodeMessages{db: dbc.getByName("nodemessages")}
+}
+
+// FeatureFlags is a getter for the feature flags.
+func (dbc *satelliteDBCollection) FeatureFlags() featureflags.DB {
+	return &featureFlags{db: dbc.getByName("featureflags")}
+}
+
+// EmailDeliveries is a getter for email deliveries repository.
+func (dbc *satelliteDBCollection) EmailDeliveries() mailservice.Deliveries {
+	return &emailDeliveries{db: dbc.getByName("emaildeliveries")}
+}
+
 // Reputation is a getter for overlay cache repository.
 func (dbc *satelliteDBCollection) Reputation() reputation.DB {
 	return &reputations{db: dbc.getByName("reputations")}
@@ -206,12 +324,18 @@ func (dbc *satelliteDBCollection) ReverifyQueue() audit.ReverifyQueue {
 
 // StoragenodeAccounting returns database for tracking storagenode usage.
 func (dbc *satelliteDBCollection) StoragenodeAccounting() accounting.StoragenodeAccounting {
-	return &StoragenodeAccounting{db: dbc.getByName("storagenodeaccounting")}
+	return &StoragenodeAccounting{
+		db:     dbc.getByName("storagenodeaccounting"),
+		readDB: dbc.getForReads("storagenodeaccounting"),
+	}
 }
 
 // ProjectAccounting returns database for tracking project data use.
 func (dbc *satelliteDBCollection) ProjectAccounting() accounting.ProjectAccounting {
-	return &ProjectAccounting{db: dbc.getByName("projectaccounting")}
+	return &ProjectAccounting{
+		db:     dbc.getByName("projectaccounting"),
+		readDB: dbc.getForReads("projectaccounting"),
+	}
 }
 
 // Revocation returns the database to deal with macaroon revocation.
@@ -237,6 +361,7 @@ func (dbc *satelliteDBCollection) Console() console.DB {
 			apikeysLRUOptions: db.opts.APIKeysLRUOptions,
 
 			db:      db,
+			readDB:  dbc.getForReads("console"),
 			methods: db,
 
 			apikeysOnce: new(sync.Once),
@@ -299,11 +424,36 @@ func (dbc *satelliteDBCollection) NodeAPIVersion() nodeapiversion.DB {
 	return &nodeAPIVersionDB{db: dbc.getByName("nodeapiversion")}
 }
 
+// BucketEncryptionKeys returns database for the metadata keys of the buckets with server-side encryption.
+func (dbc *satelliteDBCollection) BucketEncryptionKeys() kms.DB {
+	return &bucketEncryptionKeysDB{db: dbc.getByName("buckets")}
+}
+
+// ProjectRootKeys returns database for the root keys of the projects with server-side access grants.
+func (dbc *satelliteDBCollection) ProjectRootKeys() kms.RootKeysDB {
+	return &projectRootKeysDB{db: dbc.getByName("console")}
+}
+
+// Changes returns the bus of the change events of the tables.
+func (dbc *satelliteDBCollection) Changes() *changes.Bus {
+	return dbc.changes
+}
+
+// publish publishes the change of a row of the table.
+func (db *satelliteDB) publish(ctx context.Context, table changes.Table, op changes.Op, key string) {
+	db.changes.Publish(ctx, changes.Event{Table: table, Op: op, Key: key})
+}
+
 // Buckets returns database for interacting with buckets.
 func (dbc *satelliteDBCollection) Buckets() buckets.DB {
 	return &bucketsDB{db: dbc.getByName("buckets")}
 }
 
+// BucketMetadataHistory returns database of the recorded states of the bucket metadata.
+func (dbc *satelliteDBCollection) BucketMetadataHistory() buckets.MetadataHistory {
+	return &bucketMetadataHistory{db: dbc.getByName("buckets")}
+}
+
 // StorjscanPayments returns database for storjscan payments.
 func (dbc *satelliteDBCollection) StorjscanPayments() storjscan.PaymentsDB {
 	return &storjscanPayments{db: dbc.getByName("storjscan_payments")}
@@ -318,6 +468,25 @@ func (dbc *satelliteDBCollection) CheckVersion(ctx context.Context) error {
 	return eg.Err()
 }
 
+// DryRunMigration reports the pending migrations and the schema drift of all databases.
+func (dbc *satelliteDBCollection) DryRunMigration(ctx context.Context) ([]satellite.MigrationReport, error) {
+	names := make([]string, 0, len(dbc.dbs))
+	for name := range dbc.dbs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	reports := make([]satellite.MigrationReport, 0, len(names))
+	for _, name := range names {
+		report, err := dbc.dbs[name].DryRunMigration(ctx)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
 // MigrateToLatest migrates all databases to the latest version.
 func (dbc *satelliteDBCollection) MigrateToLatest(ctx context.Context) error {
 	var eg errs.Group
@@ -330,9 +499,13 @@ func (dbc *satelliteDBCollection) MigrateToLatest(ctx context.Context) error {
 // Close closes all satellite dbs.
 func (dbc *satelliteDBCollection) Close() error {
 	var eg errs.Group
+	eg.Add(dbc.changes.Close())
 	for _, db := range dbc.dbs {
 		eg.Add(db.Close())
 	}
+	if dbc.readOnly != nil {
+		eg.Add(dbc.readOnly.Close())
+	}
 	return eg.Err()
 }
 
@@ -353,6 +526,11 @@ func (db *satelliteDBTesting) Schema() string {
 	return db.satelliteDB.Schema()
 }
 
+// SetQueryFault makes the queries fail with the error returned by fault.
+func (db *satelliteDBTesting) SetQueryFault(fault func(ctx context.Context, query string) error) {
+	db.faults.set(fault)
+}
+
 // ProductionMigration returns the primary migration.
 func (db *satelliteDBTesting) ProductionMigration() *migrate.Migration {
 	return db.satelliteDB.ProductionMigration()
@@ -389,6 +567,16 @@ func (dbc *satelliteDBCollectionTesting) TestMigrateToLatest(ctx context.Context
 	return eg.Err()
 }
 
+// SetQueryFault makes the queries of all the databases fail with the error returned by fault.
+func (dbc *satelliteDBCollectionTesting) SetQueryFault(fault func(ctx context.Context, query string) error) {
+	for _, db := range dbc.dbs {
+		db.faults.set(fault)
+	}
+	if dbc.readOnly != nil {
+		dbc.readOnly.faults.set(fault)
+	}
+}
+
 // ProductionMigration returns the primary migration.
 func (dbc *satelliteDBCollectionTesting) ProductionMigration() *migrate.Migration {
 	return dbc.getByName("").ProductionMigration()
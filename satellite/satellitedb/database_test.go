@@ -0,0 +1,57 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/private/dbutil/pgtest"
+	"storj.io/private/dbutil/tempdb"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+func TestReadOnlyDatabase(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	tempDB, err := tempdb.OpenUnique(ctx, pgtest.PickPostgres(t), "readonly")
+	require.NoError(t, err)
+	defer ctx.Check(tempDB.Close)
+
+	db, err := satellitedb.Open(ctx, zaptest.NewLogger(t), tempDB.ConnStr+",readonly:"+tempDB.ConnStr, satellitedb.Options{
+		ApplicationName: "satellite-readonly-test",
+	})
+	require.NoError(t, err)
+	defer ctx.Check(db.Close)
+
+	require.NoError(t, db.Testing().TestMigrateToLatest(ctx))
+
+	// the read-only database isn't migrated on its own.
+	reports, err := db.DryRunMigration(ctx)
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+
+	owner := testrand.UUID()
+	project, err := db.Console().Projects().Insert(ctx, &console.Project{
+		ID:      testrand.UUID(),
+		Name:    "readonly",
+		OwnerID: owner,
+	})
+	require.NoError(t, err)
+
+	page, err := db.Console().Projects().ListByOwnerID(ctx, owner, console.ProjectsCursor{Limit: 10, Page: 1})
+	require.NoError(t, err)
+	require.Len(t, page.Projects, 1)
+	require.Equal(t, project.ID, page.Projects[0].ID)
+
+	usage, err := db.StoragenodeAccounting().QueryStorageNodeUsage(ctx, testrand.NodeID(), project.CreatedAt, project.CreatedAt)
+	require.NoError(t, err)
+	require.Empty(t, usage)
+}
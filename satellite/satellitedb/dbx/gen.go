@@ -77,3 +77,15 @@ func (db *DB) WithTx(ctx context.Context, fn func(context.Context, *Tx) error) (
 		})
 	})
 }
+
+// WrapDB replaces the database connection with the one returned by wrap, e.g. to measure
+// the queries. It must be called before the DB is used.
+func (db *DB) WrapDB(wrap func(tagsql.DB) tagsql.DB) {
+	db.DB = wrap(db.DB)
+	switch db.driver {
+	case "pgx":
+		db.dbMethods = newpgx(db)
+	case "pgxcockroach":
+		db.dbMethods = newpgxcockroach(db)
+	}
+}
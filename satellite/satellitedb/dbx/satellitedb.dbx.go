@@ -325,6 +325,17 @@ func (obj *pgxDB) Schema() string {
 	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	PRIMARY KEY ( user_id, event )
 );
+CREATE TABLE accounting_rollup_runs (
+	id bytea NOT NULL,
+	chore text NOT NULL,
+	status text NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	period_end timestamp with time zone NOT NULL,
+	started_at timestamp with time zone NOT NULL,
+	finished_at timestamp with time zone,
+	repair_claimed_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE accounting_rollups (
 	node_id bytea NOT NULL,
 	start_time timestamp with time zone NOT NULL,
@@ -342,6 +353,19 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE billing_addresses (
+	user_id bytea NOT NULL,
+	name text NOT NULL,
+	line1 text NOT NULL,
+	line2 text NOT NULL,
+	city text NOT NULL,
+	postal_code text NOT NULL,
+	state text NOT NULL,
+	country text NOT NULL,
+	tax_id text NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( user_id )
+);
 CREATE TABLE billing_balances (
 	user_id bytea NOT NULL,
 	balance bigint NOT NULL,
@@ -384,6 +408,47 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_encryption_keys (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	master_key_id text NOT NULL,
+	encrypted_key bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_inventories (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	destination_bucket bytea NOT NULL,
+	destination_prefix text NOT NULL,
+	format text NOT NULL,
+	frequency text NOT NULL,
+	destination_access text NOT NULL,
+	last_delivered_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_metadata_history (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	version bigint NOT NULL,
+	bucket_id bytea NOT NULL,
+	default_encryption_cipher_suite integer NOT NULL,
+	default_encryption_block_size integer NOT NULL,
+	versioning integer NOT NULL,
+	object_lock_enabled boolean NOT NULL,
+	deleted boolean NOT NULL,
+	changed_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, version )
+);
+CREATE TABLE bucket_notification_configs (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	configuration bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -410,6 +475,41 @@ CREATE TABLE coinpayments_transactions (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE email_deliveries (
+	id bytea NOT NULL,
+	message_id text NOT NULL,
+	recipient text NOT NULL,
+	template text NOT NULL,
+	subject text NOT NULL,
+	status integer NOT NULL,
+	reason text,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE feature_flags (
+	name text NOT NULL,
+	description text NOT NULL,
+	enabled boolean NOT NULL,
+	percentage integer NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( name )
+);
+CREATE TABLE gateway_credentials (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	api_key_id bytea NOT NULL,
+	name text NOT NULL,
+	access_key_id text NOT NULL,
+	endpoint text NOT NULL,
+	tail bytea NOT NULL,
+	created_by bytea NOT NULL,
+	last_used_at timestamp with time zone,
+	revoked_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -483,6 +583,46 @@ CREATE TABLE node_api_versions (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_appeals (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	kind integer NOT NULL,
+	status integer NOT NULL,
+	message text NOT NULL,
+	reputation bytea NOT NULL,
+	review_note text,
+	reviewed_by text,
+	reviewed_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_appeal_events (
+	id bytea NOT NULL,
+	appeal_id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	action text NOT NULL,
+	actor text NOT NULL,
+	note text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_capabilities (
+	node_id bytea NOT NULL,
+	hash_algorithms text NOT NULL,
+	tcp_fast_open boolean NOT NULL,
+	noise boolean NOT NULL,
+	max_piece_size bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE node_corruption_events (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	source text NOT NULL,
+	count integer NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE node_events (
 	id bytea NOT NULL,
 	email text NOT NULL,
@@ -493,6 +633,64 @@ CREATE TABLE node_events (
 	email_sent timestamp with time zone,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_maintenance_windows (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	starts_at timestamp with time zone NOT NULL,
+	ends_at timestamp with time zone NOT NULL,
+	reason text NOT NULL,
+	offline_audits bigint NOT NULL DEFAULT 0,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_messages (
+	id bytea NOT NULL,
+	kind text NOT NULL,
+	title text NOT NULL,
+	body text NOT NULL,
+	targeted boolean NOT NULL,
+	expires_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_message_acknowledgments (
+	message_id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	acknowledged_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( message_id, node_id )
+);
+CREATE TABLE node_message_recipients (
+	message_id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	PRIMARY KEY ( message_id, node_id )
+);
+CREATE TABLE node_quarantines (
+	node_id bytea NOT NULL,
+	quarantined_at timestamp with time zone NOT NULL,
+	score double precision NOT NULL,
+	reason text NOT NULL,
+	released_at timestamp with time zone,
+	PRIMARY KEY ( node_id, quarantined_at )
+);
+CREATE TABLE node_sla_reports (
+	period timestamp with time zone NOT NULL,
+	node_id bytea NOT NULL,
+	wallet text NOT NULL,
+	windows integer NOT NULL,
+	online_score double precision NOT NULL,
+	compliant boolean NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( period, node_id )
+);
+CREATE TABLE node_storage_estimates (
+	node_id bytea NOT NULL,
+	piece_count bigint NOT NULL,
+	stored_bytes bigint NOT NULL,
+	settled_bytes bigint NOT NULL DEFAULT 0,
+	estimated_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE oauth_clients (
 	id bytea NOT NULL,
 	encrypted_secret bytea NOT NULL,
@@ -552,6 +750,13 @@ CREATE TABLE projects (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE project_api_usage_rollups (
+	project_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	operation text NOT NULL,
+	count bigint NOT NULL,
+	PRIMARY KEY ( project_id, interval_start, operation )
+);
 CREATE TABLE project_bandwidth_daily_rollups (
 	project_id bytea NOT NULL,
 	interval_day date NOT NULL,
@@ -560,6 +765,14 @@ CREATE TABLE project_bandwidth_daily_rollups (
 	egress_dead bigint NOT NULL DEFAULT 0,
 	PRIMARY KEY ( project_id, interval_day )
 );
+CREATE TABLE ranged_loop_leases (
+	name text NOT NULL,
+	owner bytea NOT NULL,
+	token bigint NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( name )
+);
 CREATE TABLE registration_tokens (
 	secret bytea NOT NULL,
 	owner_id bytea,
@@ -619,6 +832,18 @@ CREATE TABLE revocations (
 	api_key_id bytea NOT NULL,
 	PRIMARY KEY ( revoked )
 );
+CREATE TABLE satellite_heartbeats (
+	name text NOT NULL,
+	beat_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( name )
+);
+CREATE TABLE satellite_outages (
+	start_at timestamp with time zone NOT NULL,
+	end_at timestamp with time zone NOT NULL,
+	token bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( start_at )
+);
 CREATE TABLE segment_pending_audits (
 	node_id bytea NOT NULL,
 	stream_id bytea NOT NULL,
@@ -657,6 +882,13 @@ CREATE TABLE storagenode_bandwidth_rollups_phase2 (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( storagenode_id, interval_start, action )
 );
+CREATE TABLE storagenode_held_releases (
+	node_id bytea NOT NULL,
+	period text NOT NULL,
+	amount bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( node_id, period )
+);
 CREATE TABLE storagenode_payments (
 	id bigserial NOT NULL,
 	created_at timestamp with time zone NOT NULL,
@@ -692,6 +924,19 @@ CREATE TABLE storagenode_paystubs (
 	distributed bigint NOT NULL,
 	PRIMARY KEY ( period, node_id )
 );
+CREATE TABLE storagenode_rate_schedules (
+	period text NOT NULL,
+	version integer NOT NULL,
+	at_rest_gb_hours text NOT NULL,
+	get_tb text NOT NULL,
+	put_tb text NOT NULL,
+	get_repair_tb text NOT NULL,
+	put_repair_tb text NOT NULL,
+	get_audit_tb text NOT NULL,
+	note text NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( period, version )
+);
 CREATE TABLE storagenode_storage_tallies (
 	node_id bytea NOT NULL,
 	interval_end_time timestamp with time zone NOT NULL,
@@ -780,6 +1025,28 @@ CREATE TABLE users (
 	signup_captcha double precision,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE user_agent_rollups (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	product text NOT NULL,
+	version text NOT NULL,
+	interval_day date NOT NULL,
+	upload_count bigint NOT NULL,
+	upload_bytes bigint NOT NULL,
+	download_count bigint NOT NULL,
+	download_bytes bigint NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, product, version, interval_day )
+);
+CREATE TABLE user_notifications (
+	user_id bytea NOT NULL,
+	id bytea NOT NULL,
+	kind text NOT NULL,
+	title text NOT NULL,
+	message text NOT NULL,
+	read_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( user_id, id )
+);
 CREATE TABLE user_settings (
 	user_id bytea NOT NULL,
 	session_minutes integer,
@@ -860,12 +1127,36 @@ CREATE TABLE project_members (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( member_id, project_id )
 );
+CREATE TABLE project_placement_entitlements (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	placement integer NOT NULL,
+	is_default boolean NOT NULL DEFAULT false,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, placement )
+);
+CREATE TABLE project_root_keys (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	master_key_id text NOT NULL,
+	encrypted_key bytea NOT NULL,
+	created_by bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE storagenode_payment_transactions (
+	payment_id bigint NOT NULL REFERENCES storagenode_payments( id ) ON DELETE CASCADE,
+	chain text NOT NULL,
+	tx_hash bytea NOT NULL,
+	layer2 boolean NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( payment_id )
+);
 CREATE TABLE stripecoinpayments_apply_balance_intents (
 	tx_id text NOT NULL REFERENCES coinpayments_transactions( id ) ON DELETE CASCADE,
 	state integer NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( tx_id )
 );
+CREATE INDEX accounting_rollup_runs_chore_status_index ON accounting_rollup_runs ( chore, status ) ;
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time ) ;
 CREATE INDEX billing_transactions_timestamp_index ON billing_transactions ( timestamp ) ;
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start ) ;
@@ -873,12 +1164,21 @@ CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket
 CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index ON bucket_bandwidth_rollup_archives ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_action_interval_project_id_index ON bucket_bandwidth_rollup_archives ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_storage_tallies ( project_id, interval_start ) ;
+CREATE INDEX email_deliveries_message_id_index ON email_deliveries ( message_id ) ;
+CREATE INDEX email_deliveries_recipient_created_at_index ON email_deliveries ( recipient, created_at ) ;
+CREATE INDEX gateway_credentials_project_id_index ON gateway_credentials ( project_id ) ;
+CREATE INDEX gateway_credentials_tail_index ON gateway_credentials ( tail ) ;
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at ) ;
 CREATE INDEX node_last_ip ON nodes ( last_net ) ;
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success ) ;
 CREATE INDEX nodes_type_last_cont_success_free_disk_ma_mi_patch_vetted_partial_index ON nodes ( type, last_contact_success, free_disk, major, minor, patch, vetted_at ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true AND nodes.last_net != '' ;
 CREATE INDEX nodes_dis_unk_aud_exit_init_rel_type_last_cont_success_stored_index ON nodes ( disqualified, unknown_audit_suspended, exit_initiated_at, release, type, last_contact_success ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true ;
+CREATE INDEX node_appeals_node_id_created_at_index ON node_appeals ( node_id, created_at ) ;
+CREATE INDEX node_appeal_events_appeal_id_index ON node_appeal_events ( appeal_id ) ;
+CREATE INDEX node_corruption_events_created_at_index ON node_corruption_events ( created_at ) ;
 CREATE INDEX node_events_email_event_created_at_index ON node_events ( email, event, created_at ) WHERE node_events.email_sent is NULL ;
+CREATE INDEX node_maintenance_windows_node_id_ends_at_index ON node_maintenance_windows ( node_id, ends_at ) ;
+CREATE INDEX node_message_recipients_node_id_index ON node_message_recipients ( node_id ) ;
 CREATE INDEX oauth_clients_user_id_index ON oauth_clients ( user_id ) ;
 CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id ) ;
 CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
@@ -892,6 +1192,7 @@ CREATE INDEX repair_queue_num_healthy_pieces_attempted_at_index ON repair_queue
 CREATE INDEX reverification_audits_inserted_at_index ON reverification_audits ( inserted_at ) ;
 CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start ) ;
 CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start ) ;
+CREATE INDEX storagenode_held_releases_period_index ON storagenode_held_releases ( period ) ;
 CREATE INDEX storagenode_payments_node_id_period_index ON storagenode_payments ( node_id, period ) ;
 CREATE INDEX storagenode_paystubs_node_id_index ON storagenode_paystubs ( node_id ) ;
 CREATE INDEX storagenode_storage_tallies_node_id_index ON storagenode_storage_tallies ( node_id ) ;
@@ -1007,6 +1308,17 @@ func (obj *pgxcockroachDB) Schema() string {
 	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	PRIMARY KEY ( user_id, event )
 );
+CREATE TABLE accounting_rollup_runs (
+	id bytea NOT NULL,
+	chore text NOT NULL,
+	status text NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	period_end timestamp with time zone NOT NULL,
+	started_at timestamp with time zone NOT NULL,
+	finished_at timestamp with time zone,
+	repair_claimed_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE accounting_rollups (
 	node_id bytea NOT NULL,
 	start_time timestamp with time zone NOT NULL,
@@ -1024,6 +1336,19 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE billing_addresses (
+	user_id bytea NOT NULL,
+	name text NOT NULL,
+	line1 text NOT NULL,
+	line2 text NOT NULL,
+	city text NOT NULL,
+	postal_code text NOT NULL,
+	state text NOT NULL,
+	country text NOT NULL,
+	tax_id text NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( user_id )
+);
 CREATE TABLE billing_balances (
 	user_id bytea NOT NULL,
 	balance bigint NOT NULL,
@@ -1066,6 +1391,47 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_encryption_keys (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	master_key_id text NOT NULL,
+	encrypted_key bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_inventories (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	destination_bucket bytea NOT NULL,
+	destination_prefix text NOT NULL,
+	format text NOT NULL,
+	frequency text NOT NULL,
+	destination_access text NOT NULL,
+	last_delivered_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_metadata_history (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	version bigint NOT NULL,
+	bucket_id bytea NOT NULL,
+	default_encryption_cipher_suite integer NOT NULL,
+	default_encryption_block_size integer NOT NULL,
+	versioning integer NOT NULL,
+	object_lock_enabled boolean NOT NULL,
+	deleted boolean NOT NULL,
+	changed_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, version )
+);
+CREATE TABLE bucket_notification_configs (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	configuration bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -1092,6 +1458,41 @@ CREATE TABLE coinpayments_transactions (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE email_deliveries (
+	id bytea NOT NULL,
+	message_id text NOT NULL,
+	recipient text NOT NULL,
+	template text NOT NULL,
+	subject text NOT NULL,
+	status integer NOT NULL,
+	reason text,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE feature_flags (
+	name text NOT NULL,
+	description text NOT NULL,
+	enabled boolean NOT NULL,
+	percentage integer NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( name )
+);
+CREATE TABLE gateway_credentials (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	api_key_id bytea NOT NULL,
+	name text NOT NULL,
+	access_key_id text NOT NULL,
+	endpoint text NOT NULL,
+	tail bytea NOT NULL,
+	created_by bytea NOT NULL,
+	last_used_at timestamp with time zone,
+	revoked_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -1165,6 +1566,46 @@ CREATE TABLE node_api_versions (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_appeals (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	kind integer NOT NULL,
+	status integer NOT NULL,
+	message text NOT NULL,
+	reputation bytea NOT NULL,
+	review_note text,
+	reviewed_by text,
+	reviewed_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_appeal_events (
+	id bytea NOT NULL,
+	appeal_id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	action text NOT NULL,
+	actor text NOT NULL,
+	note text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_capabilities (
+	node_id bytea NOT NULL,
+	hash_algorithms text NOT NULL,
+	tcp_fast_open boolean NOT NULL,
+	noise boolean NOT NULL,
+	max_piece_size bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE node_corruption_events (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	source text NOT NULL,
+	count integer NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE node_events (
 	id bytea NOT NULL,
 	email text NOT NULL,
@@ -1175,6 +1616,64 @@ CREATE TABLE node_events (
 	email_sent timestamp with time zone,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_maintenance_windows (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	starts_at timestamp with time zone NOT NULL,
+	ends_at timestamp with time zone NOT NULL,
+	reason text NOT NULL,
+	offline_audits bigint NOT NULL DEFAULT 0,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_messages (
+	id bytea NOT NULL,
+	kind text NOT NULL,
+	title text NOT NULL,
+	body text NOT NULL,
+	targeted boolean NOT NULL,
+	expires_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_message_acknowledgments (
+	message_id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	acknowledged_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( message_id, node_id )
+);
+CREATE TABLE node_message_recipients (
+	message_id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	PRIMARY KEY ( message_id, node_id )
+);
+CREATE TABLE node_quarantines (
+	node_id bytea NOT NULL,
+	quarantined_at timestamp with time zone NOT NULL,
+	score double precision NOT NULL,
+	reason text NOT NULL,
+	released_at timestamp with time zone,
+	PRIMARY KEY ( node_id, quarantined_at )
+);
+CREATE TABLE node_sla_reports (
+	period timestamp with time zone NOT NULL,
+	node_id bytea NOT NULL,
+	wallet text NOT NULL,
+	windows integer NOT NULL,
+	online_score double precision NOT NULL,
+	compliant boolean NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( period, node_id )
+);
+CREATE TABLE node_storage_estimates (
+	node_id bytea NOT NULL,
+	piece_count bigint NOT NULL,
+	stored_bytes bigint NOT NULL,
+	settled_bytes bigint NOT NULL DEFAULT 0,
+	estimated_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE oauth_clients (
 	id bytea NOT NULL,
 	encrypted_secret bytea NOT NULL,
@@ -1234,6 +1733,13 @@ CREATE TABLE projects (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE project_api_usage_rollups (
+	project_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	operation text NOT NULL,
+	count bigint NOT NULL,
+	PRIMARY KEY ( project_id, interval_start, operation )
+);
 CREATE TABLE project_bandwidth_daily_rollups (
 	project_id bytea NOT NULL,
 	interval_day date NOT NULL,
@@ -1242,6 +1748,14 @@ CREATE TABLE project_bandwidth_daily_rollups (
 	egress_dead bigint NOT NULL DEFAULT 0,
 	PRIMARY KEY ( project_id, interval_day )
 );
+CREATE TABLE ranged_loop_leases (
+	name text NOT NULL,
+	owner bytea NOT NULL,
+	token bigint NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( name )
+);
 CREATE TABLE registration_tokens (
 	secret bytea NOT NULL,
 	owner_id bytea,
@@ -1301,6 +1815,18 @@ CREATE TABLE revocations (
 	api_key_id bytea NOT NULL,
 	PRIMARY KEY ( revoked )
 );
+CREATE TABLE satellite_heartbeats (
+	name text NOT NULL,
+	beat_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( name )
+);
+CREATE TABLE satellite_outages (
+	start_at timestamp with time zone NOT NULL,
+	end_at timestamp with time zone NOT NULL,
+	token bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( start_at )
+);
 CREATE TABLE segment_pending_audits (
 	node_id bytea NOT NULL,
 	stream_id bytea NOT NULL,
@@ -1339,6 +1865,13 @@ CREATE TABLE storagenode_bandwidth_rollups_phase2 (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( storagenode_id, interval_start, action )
 );
+CREATE TABLE storagenode_held_releases (
+	node_id bytea NOT NULL,
+	period text NOT NULL,
+	amount bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( node_id, period )
+);
 CREATE TABLE storagenode_payments (
 	id bigserial NOT NULL,
 	created_at timestamp with time zone NOT NULL,
@@ -1374,7 +1907,20 @@ CREATE TABLE storagenode_paystubs (
 	distributed bigint NOT NULL,
 	PRIMARY KEY ( period, node_id )
 );
-CREATE TABLE storagenode_storage_tallies (
+CREATE TABLE storagenode_rate_schedules (
+	period text NOT NULL,
+	version integer NOT NULL,
+	at_rest_gb_hours text NOT NULL,
+	get_tb text NOT NULL,
+	put_tb text NOT NULL,
+	get_repair_tb text NOT NULL,
+	put_repair_tb text NOT NULL,
+	get_audit_tb text NOT NULL,
+	note text NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( period, version )
+);
+CREATE TABLE storagenode_storage_tallies (
 	node_id bytea NOT NULL,
 	interval_end_time timestamp with time zone NOT NULL,
 	data_total double precision NOT NULL,
@@ -1462,6 +2008,28 @@ CREATE TABLE users (
 	signup_captcha double precision,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE user_agent_rollups (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	product text NOT NULL,
+	version text NOT NULL,
+	interval_day date NOT NULL,
+	upload_count bigint NOT NULL,
+	upload_bytes bigint NOT NULL,
+	download_count bigint NOT NULL,
+	download_bytes bigint NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, product, version, interval_day )
+);
+CREATE TABLE user_notifications (
+	user_id bytea NOT NULL,
+	id bytea NOT NULL,
+	kind text NOT NULL,
+	title text NOT NULL,
+	message text NOT NULL,
+	read_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( user_id, id )
+);
 CREATE TABLE user_settings (
 	user_id bytea NOT NULL,
 	session_minutes integer,
@@ -1542,12 +2110,36 @@ CREATE TABLE project_members (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( member_id, project_id )
 );
+CREATE TABLE project_placement_entitlements (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	placement integer NOT NULL,
+	is_default boolean NOT NULL DEFAULT false,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, placement )
+);
+CREATE TABLE project_root_keys (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	master_key_id text NOT NULL,
+	encrypted_key bytea NOT NULL,
+	created_by bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE storagenode_payment_transactions (
+	payment_id bigint NOT NULL REFERENCES storagenode_payments( id ) ON DELETE CASCADE,
+	chain text NOT NULL,
+	tx_hash bytea NOT NULL,
+	layer2 boolean NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( payment_id )
+);
 CREATE TABLE stripecoinpayments_apply_balance_intents (
 	tx_id text NOT NULL REFERENCES coinpayments_transactions( id ) ON DELETE CASCADE,
 	state integer NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( tx_id )
 );
+CREATE INDEX accounting_rollup_runs_chore_status_index ON accounting_rollup_runs ( chore, status ) ;
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time ) ;
 CREATE INDEX billing_transactions_timestamp_index ON billing_transactions ( timestamp ) ;
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start ) ;
@@ -1555,12 +2147,21 @@ CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket
 CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index ON bucket_bandwidth_rollup_archives ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_action_interval_project_id_index ON bucket_bandwidth_rollup_archives ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_storage_tallies ( project_id, interval_start ) ;
+CREATE INDEX email_deliveries_message_id_index ON email_deliveries ( message_id ) ;
+CREATE INDEX email_deliveries_recipient_created_at_index ON email_deliveries ( recipient, created_at ) ;
+CREATE INDEX gateway_credentials_project_id_index ON gateway_credentials ( project_id ) ;
+CREATE INDEX gateway_credentials_tail_index ON gateway_credentials ( tail ) ;
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at ) ;
 CREATE INDEX node_last_ip ON nodes ( last_net ) ;
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success ) ;
 CREATE INDEX nodes_type_last_cont_success_free_disk_ma_mi_patch_vetted_partial_index ON nodes ( type, last_contact_success, free_disk, major, minor, patch, vetted_at ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true AND nodes.last_net != '' ;
 CREATE INDEX nodes_dis_unk_aud_exit_init_rel_type_last_cont_success_stored_index ON nodes ( disqualified, unknown_audit_suspended, exit_initiated_at, release, type, last_contact_success ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true ;
+CREATE INDEX node_appeals_node_id_created_at_index ON node_appeals ( node_id, created_at ) ;
+CREATE INDEX node_appeal_events_appeal_id_index ON node_appeal_events ( appeal_id ) ;
+CREATE INDEX node_corruption_events_created_at_index ON node_corruption_events ( created_at ) ;
 CREATE INDEX node_events_email_event_created_at_index ON node_events ( email, event, created_at ) WHERE node_events.email_sent is NULL ;
+CREATE INDEX node_maintenance_windows_node_id_ends_at_index ON node_maintenance_windows ( node_id, ends_at ) ;
+CREATE INDEX node_message_recipients_node_id_index ON node_message_recipients ( node_id ) ;
 CREATE INDEX oauth_clients_user_id_index ON oauth_clients ( user_id ) ;
 CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id ) ;
 CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
@@ -1574,6 +2175,7 @@ CREATE INDEX repair_queue_num_healthy_pieces_attempted_at_index ON repair_queue
 CREATE INDEX reverification_audits_inserted_at_index ON reverification_audits ( inserted_at ) ;
 CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start ) ;
 CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start ) ;
+CREATE INDEX storagenode_held_releases_period_index ON storagenode_held_releases ( period ) ;
 CREATE INDEX storagenode_payments_node_id_period_index ON storagenode_payments ( node_id, period ) ;
 CREATE INDEX storagenode_paystubs_node_id_index ON storagenode_paystubs ( node_id ) ;
 CREATE INDEX storagenode_storage_tallies_node_id_index ON storagenode_storage_tallies ( node_id ) ;
@@ -1961,9543 +2563,14067 @@ func (f AccountingRollup_IntervalEndTime_Field) value() interface{} {
 
 func (AccountingRollup_IntervalEndTime_Field) _Column() string { return "interval_end_time" }
 
-type AccountingTimestamps struct {
-	Name  string
-	Value time.Time
+type AccountingRollupRun struct {
+	Id              []byte
+	Chore           string
+	Status          string
+	PeriodStart     time.Time
+	PeriodEnd       time.Time
+	StartedAt       time.Time
+	FinishedAt      *time.Time
+	RepairClaimedAt *time.Time
 }
 
-func (AccountingTimestamps) _Table() string { return "accounting_timestamps" }
+func (AccountingRollupRun) _Table() string { return "accounting_rollup_runs" }
 
-type AccountingTimestamps_Update_Fields struct {
-	Value AccountingTimestamps_Value_Field
+type AccountingRollupRun_Create_Fields struct {
+	FinishedAt      AccountingRollupRun_FinishedAt_Field
+	RepairClaimedAt AccountingRollupRun_RepairClaimedAt_Field
 }
 
-type AccountingTimestamps_Name_Field struct {
+type AccountingRollupRun_Update_Fields struct {
+	Status          AccountingRollupRun_Status_Field
+	FinishedAt      AccountingRollupRun_FinishedAt_Field
+	RepairClaimedAt AccountingRollupRun_RepairClaimedAt_Field
+}
+
+type AccountingRollupRun_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func AccountingTimestamps_Name(v string) AccountingTimestamps_Name_Field {
-	return AccountingTimestamps_Name_Field{_set: true, _value: v}
+func AccountingRollupRun_Id(v []byte) AccountingRollupRun_Id_Field {
+	return AccountingRollupRun_Id_Field{_set: true, _value: v}
 }
 
-func (f AccountingTimestamps_Name_Field) value() interface{} {
+func (f AccountingRollupRun_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (AccountingTimestamps_Name_Field) _Column() string { return "name" }
+func (AccountingRollupRun_Id_Field) _Column() string { return "id" }
 
-type AccountingTimestamps_Value_Field struct {
+type AccountingRollupRun_Chore_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func AccountingTimestamps_Value(v time.Time) AccountingTimestamps_Value_Field {
-	return AccountingTimestamps_Value_Field{_set: true, _value: v}
+func AccountingRollupRun_Chore(v string) AccountingRollupRun_Chore_Field {
+	return AccountingRollupRun_Chore_Field{_set: true, _value: v}
 }
 
-func (f AccountingTimestamps_Value_Field) value() interface{} {
+func (f AccountingRollupRun_Chore_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (AccountingTimestamps_Value_Field) _Column() string { return "value" }
+func (AccountingRollupRun_Chore_Field) _Column() string { return "chore" }
 
-type BillingBalance struct {
-	UserId      []byte
-	Balance     int64
-	LastUpdated time.Time
+type AccountingRollupRun_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (BillingBalance) _Table() string { return "billing_balances" }
+func AccountingRollupRun_Status(v string) AccountingRollupRun_Status_Field {
+	return AccountingRollupRun_Status_Field{_set: true, _value: v}
+}
 
-type BillingBalance_Update_Fields struct {
-	Balance BillingBalance_Balance_Field
+func (f AccountingRollupRun_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type BillingBalance_UserId_Field struct {
+func (AccountingRollupRun_Status_Field) _Column() string { return "status" }
+
+type AccountingRollupRun_PeriodStart_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func BillingBalance_UserId(v []byte) BillingBalance_UserId_Field {
-	return BillingBalance_UserId_Field{_set: true, _value: v}
+func AccountingRollupRun_PeriodStart(v time.Time) AccountingRollupRun_PeriodStart_Field {
+	return AccountingRollupRun_PeriodStart_Field{_set: true, _value: v}
 }
 
-func (f BillingBalance_UserId_Field) value() interface{} {
+func (f AccountingRollupRun_PeriodStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingBalance_UserId_Field) _Column() string { return "user_id" }
+func (AccountingRollupRun_PeriodStart_Field) _Column() string { return "period_start" }
 
-type BillingBalance_Balance_Field struct {
+type AccountingRollupRun_PeriodEnd_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func BillingBalance_Balance(v int64) BillingBalance_Balance_Field {
-	return BillingBalance_Balance_Field{_set: true, _value: v}
+func AccountingRollupRun_PeriodEnd(v time.Time) AccountingRollupRun_PeriodEnd_Field {
+	return AccountingRollupRun_PeriodEnd_Field{_set: true, _value: v}
 }
 
-func (f BillingBalance_Balance_Field) value() interface{} {
+func (f AccountingRollupRun_PeriodEnd_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingBalance_Balance_Field) _Column() string { return "balance" }
+func (AccountingRollupRun_PeriodEnd_Field) _Column() string { return "period_end" }
 
-type BillingBalance_LastUpdated_Field struct {
+type AccountingRollupRun_StartedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func BillingBalance_LastUpdated(v time.Time) BillingBalance_LastUpdated_Field {
-	return BillingBalance_LastUpdated_Field{_set: true, _value: v}
+func AccountingRollupRun_StartedAt(v time.Time) AccountingRollupRun_StartedAt_Field {
+	return AccountingRollupRun_StartedAt_Field{_set: true, _value: v}
 }
 
-func (f BillingBalance_LastUpdated_Field) value() interface{} {
+func (f AccountingRollupRun_StartedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingBalance_LastUpdated_Field) _Column() string { return "last_updated" }
+func (AccountingRollupRun_StartedAt_Field) _Column() string { return "started_at" }
 
-type BillingTransaction struct {
-	Id          int64
-	UserId      []byte
-	Amount      int64
-	Currency    string
-	Description string
-	Source      string
-	Status      string
-	Type        string
-	Metadata    []byte
-	Timestamp   time.Time
-	CreatedAt   time.Time
+type AccountingRollupRun_FinishedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
 }
 
-func (BillingTransaction) _Table() string { return "billing_transactions" }
+func AccountingRollupRun_FinishedAt(v time.Time) AccountingRollupRun_FinishedAt_Field {
+	return AccountingRollupRun_FinishedAt_Field{_set: true, _value: &v}
+}
 
-type BillingTransaction_Update_Fields struct {
-	Status   BillingTransaction_Status_Field
-	Metadata BillingTransaction_Metadata_Field
+func AccountingRollupRun_FinishedAt_Raw(v *time.Time) AccountingRollupRun_FinishedAt_Field {
+	if v == nil {
+		return AccountingRollupRun_FinishedAt_Null()
+	}
+	return AccountingRollupRun_FinishedAt(*v)
 }
 
-type BillingTransaction_Id_Field struct {
-	_set   bool
-	_null  bool
-	_value int64
+func AccountingRollupRun_FinishedAt_Null() AccountingRollupRun_FinishedAt_Field {
+	return AccountingRollupRun_FinishedAt_Field{_set: true, _null: true}
 }
 
-func BillingTransaction_Id(v int64) BillingTransaction_Id_Field {
-	return BillingTransaction_Id_Field{_set: true, _value: v}
+func (f AccountingRollupRun_FinishedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f BillingTransaction_Id_Field) value() interface{} {
+func (f AccountingRollupRun_FinishedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Id_Field) _Column() string { return "id" }
+func (AccountingRollupRun_FinishedAt_Field) _Column() string { return "finished_at" }
 
-type BillingTransaction_UserId_Field struct {
+type AccountingRollupRun_RepairClaimedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *time.Time
 }
 
-func BillingTransaction_UserId(v []byte) BillingTransaction_UserId_Field {
-	return BillingTransaction_UserId_Field{_set: true, _value: v}
+func AccountingRollupRun_RepairClaimedAt(v time.Time) AccountingRollupRun_RepairClaimedAt_Field {
+	return AccountingRollupRun_RepairClaimedAt_Field{_set: true, _value: &v}
 }
 
-func (f BillingTransaction_UserId_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func AccountingRollupRun_RepairClaimedAt_Raw(v *time.Time) AccountingRollupRun_RepairClaimedAt_Field {
+	if v == nil {
+		return AccountingRollupRun_RepairClaimedAt_Null()
 	}
-	return f._value
+	return AccountingRollupRun_RepairClaimedAt(*v)
 }
 
-func (BillingTransaction_UserId_Field) _Column() string { return "user_id" }
-
-type BillingTransaction_Amount_Field struct {
-	_set   bool
-	_null  bool
-	_value int64
+func AccountingRollupRun_RepairClaimedAt_Null() AccountingRollupRun_RepairClaimedAt_Field {
+	return AccountingRollupRun_RepairClaimedAt_Field{_set: true, _null: true}
 }
 
-func BillingTransaction_Amount(v int64) BillingTransaction_Amount_Field {
-	return BillingTransaction_Amount_Field{_set: true, _value: v}
+func (f AccountingRollupRun_RepairClaimedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f BillingTransaction_Amount_Field) value() interface{} {
+func (f AccountingRollupRun_RepairClaimedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Amount_Field) _Column() string { return "amount" }
+func (AccountingRollupRun_RepairClaimedAt_Field) _Column() string { return "repair_claimed_at" }
 
-type BillingTransaction_Currency_Field struct {
+type AccountingTimestamps struct {
+	Name  string
+	Value time.Time
+}
+
+func (AccountingTimestamps) _Table() string { return "accounting_timestamps" }
+
+type AccountingTimestamps_Update_Fields struct {
+	Value AccountingTimestamps_Value_Field
+}
+
+type AccountingTimestamps_Name_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func BillingTransaction_Currency(v string) BillingTransaction_Currency_Field {
-	return BillingTransaction_Currency_Field{_set: true, _value: v}
+func AccountingTimestamps_Name(v string) AccountingTimestamps_Name_Field {
+	return AccountingTimestamps_Name_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Currency_Field) value() interface{} {
+func (f AccountingTimestamps_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Currency_Field) _Column() string { return "currency" }
+func (AccountingTimestamps_Name_Field) _Column() string { return "name" }
 
-type BillingTransaction_Description_Field struct {
+type AccountingTimestamps_Value_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func BillingTransaction_Description(v string) BillingTransaction_Description_Field {
-	return BillingTransaction_Description_Field{_set: true, _value: v}
+func AccountingTimestamps_Value(v time.Time) AccountingTimestamps_Value_Field {
+	return AccountingTimestamps_Value_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Description_Field) value() interface{} {
+func (f AccountingTimestamps_Value_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Description_Field) _Column() string { return "description" }
+func (AccountingTimestamps_Value_Field) _Column() string { return "value" }
 
-type BillingTransaction_Source_Field struct {
+type BillingAddress struct {
+	UserId     []byte
+	Name       string
+	Line1      string
+	Line2      string
+	City       string
+	PostalCode string
+	State      string
+	Country    string
+	TaxId      string
+	UpdatedAt  time.Time
+}
+
+func (BillingAddress) _Table() string { return "billing_addresses" }
+
+type BillingAddress_Create_Fields struct {
+}
+
+type BillingAddress_Update_Fields struct {
+	Name       BillingAddress_Name_Field
+	Line1      BillingAddress_Line1_Field
+	Line2      BillingAddress_Line2_Field
+	City       BillingAddress_City_Field
+	PostalCode BillingAddress_PostalCode_Field
+	State      BillingAddress_State_Field
+	Country    BillingAddress_Country_Field
+	TaxId      BillingAddress_TaxId_Field
+}
+
+type BillingAddress_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func BillingTransaction_Source(v string) BillingTransaction_Source_Field {
-	return BillingTransaction_Source_Field{_set: true, _value: v}
+func BillingAddress_UserId(v []byte) BillingAddress_UserId_Field {
+	return BillingAddress_UserId_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Source_Field) value() interface{} {
+func (f BillingAddress_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Source_Field) _Column() string { return "source" }
+func (BillingAddress_UserId_Field) _Column() string { return "user_id" }
 
-type BillingTransaction_Status_Field struct {
+type BillingAddress_Name_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func BillingTransaction_Status(v string) BillingTransaction_Status_Field {
-	return BillingTransaction_Status_Field{_set: true, _value: v}
+func BillingAddress_Name(v string) BillingAddress_Name_Field {
+	return BillingAddress_Name_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Status_Field) value() interface{} {
+func (f BillingAddress_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Status_Field) _Column() string { return "status" }
+func (BillingAddress_Name_Field) _Column() string { return "name" }
 
-type BillingTransaction_Type_Field struct {
+type BillingAddress_Line1_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func BillingTransaction_Type(v string) BillingTransaction_Type_Field {
-	return BillingTransaction_Type_Field{_set: true, _value: v}
+func BillingAddress_Line1(v string) BillingAddress_Line1_Field {
+	return BillingAddress_Line1_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Type_Field) value() interface{} {
+func (f BillingAddress_Line1_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Type_Field) _Column() string { return "type" }
+func (BillingAddress_Line1_Field) _Column() string { return "line1" }
 
-type BillingTransaction_Metadata_Field struct {
+type BillingAddress_Line2_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func BillingTransaction_Metadata(v []byte) BillingTransaction_Metadata_Field {
-	return BillingTransaction_Metadata_Field{_set: true, _value: v}
+func BillingAddress_Line2(v string) BillingAddress_Line2_Field {
+	return BillingAddress_Line2_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Metadata_Field) value() interface{} {
+func (f BillingAddress_Line2_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Metadata_Field) _Column() string { return "metadata" }
+func (BillingAddress_Line2_Field) _Column() string { return "line2" }
 
-type BillingTransaction_Timestamp_Field struct {
+type BillingAddress_City_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func BillingTransaction_Timestamp(v time.Time) BillingTransaction_Timestamp_Field {
-	return BillingTransaction_Timestamp_Field{_set: true, _value: v}
+func BillingAddress_City(v string) BillingAddress_City_Field {
+	return BillingAddress_City_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Timestamp_Field) value() interface{} {
+func (f BillingAddress_City_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Timestamp_Field) _Column() string { return "timestamp" }
+func (BillingAddress_City_Field) _Column() string { return "city" }
 
-type BillingTransaction_CreatedAt_Field struct {
+type BillingAddress_PostalCode_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func BillingTransaction_CreatedAt(v time.Time) BillingTransaction_CreatedAt_Field {
-	return BillingTransaction_CreatedAt_Field{_set: true, _value: v}
+func BillingAddress_PostalCode(v string) BillingAddress_PostalCode_Field {
+	return BillingAddress_PostalCode_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_CreatedAt_Field) value() interface{} {
+func (f BillingAddress_PostalCode_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_CreatedAt_Field) _Column() string { return "created_at" }
-
-type BucketBandwidthRollup struct {
-	BucketName      []byte
-	ProjectId       []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Inline          uint64
-	Allocated       uint64
-	Settled         uint64
-}
-
-func (BucketBandwidthRollup) _Table() string { return "bucket_bandwidth_rollups" }
-
-type BucketBandwidthRollup_Update_Fields struct {
-	Inline    BucketBandwidthRollup_Inline_Field
-	Allocated BucketBandwidthRollup_Allocated_Field
-	Settled   BucketBandwidthRollup_Settled_Field
-}
+func (BillingAddress_PostalCode_Field) _Column() string { return "postal_code" }
 
-type BucketBandwidthRollup_BucketName_Field struct {
+type BillingAddress_State_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func BucketBandwidthRollup_BucketName(v []byte) BucketBandwidthRollup_BucketName_Field {
-	return BucketBandwidthRollup_BucketName_Field{_set: true, _value: v}
+func BillingAddress_State(v string) BillingAddress_State_Field {
+	return BillingAddress_State_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_BucketName_Field) value() interface{} {
+func (f BillingAddress_State_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_BucketName_Field) _Column() string { return "bucket_name" }
+func (BillingAddress_State_Field) _Column() string { return "state" }
 
-type BucketBandwidthRollup_ProjectId_Field struct {
+type BillingAddress_Country_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func BucketBandwidthRollup_ProjectId(v []byte) BucketBandwidthRollup_ProjectId_Field {
-	return BucketBandwidthRollup_ProjectId_Field{_set: true, _value: v}
+func BillingAddress_Country(v string) BillingAddress_Country_Field {
+	return BillingAddress_Country_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_ProjectId_Field) value() interface{} {
+func (f BillingAddress_Country_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
+func (BillingAddress_Country_Field) _Column() string { return "country" }
 
-type BucketBandwidthRollup_IntervalStart_Field struct {
+type BillingAddress_TaxId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func BucketBandwidthRollup_IntervalStart(v time.Time) BucketBandwidthRollup_IntervalStart_Field {
-	return BucketBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
+func BillingAddress_TaxId(v string) BillingAddress_TaxId_Field {
+	return BillingAddress_TaxId_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_IntervalStart_Field) value() interface{} {
+func (f BillingAddress_TaxId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
+func (BillingAddress_TaxId_Field) _Column() string { return "tax_id" }
 
-type BucketBandwidthRollup_IntervalSeconds_Field struct {
+type BillingAddress_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value time.Time
 }
 
-func BucketBandwidthRollup_IntervalSeconds(v uint) BucketBandwidthRollup_IntervalSeconds_Field {
-	return BucketBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
+func BillingAddress_UpdatedAt(v time.Time) BillingAddress_UpdatedAt_Field {
+	return BillingAddress_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_IntervalSeconds_Field) value() interface{} {
+func (f BillingAddress_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+func (BillingAddress_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type BucketBandwidthRollup_Action_Field struct {
-	_set   bool
-	_null  bool
-	_value uint
+type BillingBalance struct {
+	UserId      []byte
+	Balance     int64
+	LastUpdated time.Time
 }
 
-func BucketBandwidthRollup_Action(v uint) BucketBandwidthRollup_Action_Field {
-	return BucketBandwidthRollup_Action_Field{_set: true, _value: v}
-}
+func (BillingBalance) _Table() string { return "billing_balances" }
 
-func (f BucketBandwidthRollup_Action_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type BillingBalance_Update_Fields struct {
+	Balance BillingBalance_Balance_Field
 }
 
-func (BucketBandwidthRollup_Action_Field) _Column() string { return "action" }
-
-type BucketBandwidthRollup_Inline_Field struct {
+type BillingBalance_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func BucketBandwidthRollup_Inline(v uint64) BucketBandwidthRollup_Inline_Field {
-	return BucketBandwidthRollup_Inline_Field{_set: true, _value: v}
+func BillingBalance_UserId(v []byte) BillingBalance_UserId_Field {
+	return BillingBalance_UserId_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Inline_Field) value() interface{} {
+func (f BillingBalance_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Inline_Field) _Column() string { return "inline" }
+func (BillingBalance_UserId_Field) _Column() string { return "user_id" }
 
-type BucketBandwidthRollup_Allocated_Field struct {
+type BillingBalance_Balance_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value int64
 }
 
-func BucketBandwidthRollup_Allocated(v uint64) BucketBandwidthRollup_Allocated_Field {
-	return BucketBandwidthRollup_Allocated_Field{_set: true, _value: v}
+func BillingBalance_Balance(v int64) BillingBalance_Balance_Field {
+	return BillingBalance_Balance_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Allocated_Field) value() interface{} {
+func (f BillingBalance_Balance_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
+func (BillingBalance_Balance_Field) _Column() string { return "balance" }
 
-type BucketBandwidthRollup_Settled_Field struct {
+type BillingBalance_LastUpdated_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value time.Time
 }
 
-func BucketBandwidthRollup_Settled(v uint64) BucketBandwidthRollup_Settled_Field {
-	return BucketBandwidthRollup_Settled_Field{_set: true, _value: v}
+func BillingBalance_LastUpdated(v time.Time) BillingBalance_LastUpdated_Field {
+	return BillingBalance_LastUpdated_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Settled_Field) value() interface{} {
+func (f BillingBalance_LastUpdated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Settled_Field) _Column() string { return "settled" }
+func (BillingBalance_LastUpdated_Field) _Column() string { return "last_updated" }
 
-type BucketBandwidthRollupArchive struct {
-	BucketName      []byte
-	ProjectId       []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Inline          uint64
-	Allocated       uint64
-	Settled         uint64
+type BillingTransaction struct {
+	Id          int64
+	UserId      []byte
+	Amount      int64
+	Currency    string
+	Description string
+	Source      string
+	Status      string
+	Type        string
+	Metadata    []byte
+	Timestamp   time.Time
+	CreatedAt   time.Time
 }
 
-func (BucketBandwidthRollupArchive) _Table() string { return "bucket_bandwidth_rollup_archives" }
+func (BillingTransaction) _Table() string { return "billing_transactions" }
 
-type BucketBandwidthRollupArchive_Update_Fields struct {
-	Inline    BucketBandwidthRollupArchive_Inline_Field
-	Allocated BucketBandwidthRollupArchive_Allocated_Field
-	Settled   BucketBandwidthRollupArchive_Settled_Field
+type BillingTransaction_Update_Fields struct {
+	Status   BillingTransaction_Status_Field
+	Metadata BillingTransaction_Metadata_Field
 }
 
-type BucketBandwidthRollupArchive_BucketName_Field struct {
+type BillingTransaction_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func BucketBandwidthRollupArchive_BucketName(v []byte) BucketBandwidthRollupArchive_BucketName_Field {
-	return BucketBandwidthRollupArchive_BucketName_Field{_set: true, _value: v}
+func BillingTransaction_Id(v int64) BillingTransaction_Id_Field {
+	return BillingTransaction_Id_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_BucketName_Field) value() interface{} {
+func (f BillingTransaction_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_BucketName_Field) _Column() string { return "bucket_name" }
+func (BillingTransaction_Id_Field) _Column() string { return "id" }
 
-type BucketBandwidthRollupArchive_ProjectId_Field struct {
+type BillingTransaction_UserId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketBandwidthRollupArchive_ProjectId(v []byte) BucketBandwidthRollupArchive_ProjectId_Field {
-	return BucketBandwidthRollupArchive_ProjectId_Field{_set: true, _value: v}
+func BillingTransaction_UserId(v []byte) BillingTransaction_UserId_Field {
+	return BillingTransaction_UserId_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_ProjectId_Field) value() interface{} {
+func (f BillingTransaction_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_ProjectId_Field) _Column() string { return "project_id" }
+func (BillingTransaction_UserId_Field) _Column() string { return "user_id" }
 
-type BucketBandwidthRollupArchive_IntervalStart_Field struct {
+type BillingTransaction_Amount_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func BucketBandwidthRollupArchive_IntervalStart(v time.Time) BucketBandwidthRollupArchive_IntervalStart_Field {
-	return BucketBandwidthRollupArchive_IntervalStart_Field{_set: true, _value: v}
+func BillingTransaction_Amount(v int64) BillingTransaction_Amount_Field {
+	return BillingTransaction_Amount_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_IntervalStart_Field) value() interface{} {
+func (f BillingTransaction_Amount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_IntervalStart_Field) _Column() string { return "interval_start" }
+func (BillingTransaction_Amount_Field) _Column() string { return "amount" }
 
-type BucketBandwidthRollupArchive_IntervalSeconds_Field struct {
+type BillingTransaction_Currency_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value string
 }
 
-func BucketBandwidthRollupArchive_IntervalSeconds(v uint) BucketBandwidthRollupArchive_IntervalSeconds_Field {
-	return BucketBandwidthRollupArchive_IntervalSeconds_Field{_set: true, _value: v}
+func BillingTransaction_Currency(v string) BillingTransaction_Currency_Field {
+	return BillingTransaction_Currency_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_IntervalSeconds_Field) value() interface{} {
+func (f BillingTransaction_Currency_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+func (BillingTransaction_Currency_Field) _Column() string { return "currency" }
 
-type BucketBandwidthRollupArchive_Action_Field struct {
+type BillingTransaction_Description_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value string
 }
 
-func BucketBandwidthRollupArchive_Action(v uint) BucketBandwidthRollupArchive_Action_Field {
-	return BucketBandwidthRollupArchive_Action_Field{_set: true, _value: v}
+func BillingTransaction_Description(v string) BillingTransaction_Description_Field {
+	return BillingTransaction_Description_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_Action_Field) value() interface{} {
+func (f BillingTransaction_Description_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Action_Field) _Column() string { return "action" }
+func (BillingTransaction_Description_Field) _Column() string { return "description" }
 
-type BucketBandwidthRollupArchive_Inline_Field struct {
+type BillingTransaction_Source_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value string
 }
 
-func BucketBandwidthRollupArchive_Inline(v uint64) BucketBandwidthRollupArchive_Inline_Field {
-	return BucketBandwidthRollupArchive_Inline_Field{_set: true, _value: v}
+func BillingTransaction_Source(v string) BillingTransaction_Source_Field {
+	return BillingTransaction_Source_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_Inline_Field) value() interface{} {
+func (f BillingTransaction_Source_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Inline_Field) _Column() string { return "inline" }
+func (BillingTransaction_Source_Field) _Column() string { return "source" }
 
-type BucketBandwidthRollupArchive_Allocated_Field struct {
+type BillingTransaction_Status_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value string
 }
 
-func BucketBandwidthRollupArchive_Allocated(v uint64) BucketBandwidthRollupArchive_Allocated_Field {
-	return BucketBandwidthRollupArchive_Allocated_Field{_set: true, _value: v}
+func BillingTransaction_Status(v string) BillingTransaction_Status_Field {
+	return BillingTransaction_Status_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_Allocated_Field) value() interface{} {
+func (f BillingTransaction_Status_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Allocated_Field) _Column() string { return "allocated" }
+func (BillingTransaction_Status_Field) _Column() string { return "status" }
 
-type BucketBandwidthRollupArchive_Settled_Field struct {
+type BillingTransaction_Type_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value string
 }
 
-func BucketBandwidthRollupArchive_Settled(v uint64) BucketBandwidthRollupArchive_Settled_Field {
-	return BucketBandwidthRollupArchive_Settled_Field{_set: true, _value: v}
+func BillingTransaction_Type(v string) BillingTransaction_Type_Field {
+	return BillingTransaction_Type_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_Settled_Field) value() interface{} {
+func (f BillingTransaction_Type_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Settled_Field) _Column() string { return "settled" }
-
-type BucketStorageTally struct {
-	BucketName          []byte
-	ProjectId           []byte
-	IntervalStart       time.Time
-	TotalBytes          uint64
-	Inline              uint64
-	Remote              uint64
-	TotalSegmentsCount  uint
-	RemoteSegmentsCount uint
-	InlineSegmentsCount uint
-	ObjectCount         uint
-	MetadataSize        uint64
-}
-
-func (BucketStorageTally) _Table() string { return "bucket_storage_tallies" }
-
-type BucketStorageTally_Create_Fields struct {
-	TotalBytes         BucketStorageTally_TotalBytes_Field
-	TotalSegmentsCount BucketStorageTally_TotalSegmentsCount_Field
-}
-
-type BucketStorageTally_Update_Fields struct {
-}
+func (BillingTransaction_Type_Field) _Column() string { return "type" }
 
-type BucketStorageTally_BucketName_Field struct {
+type BillingTransaction_Metadata_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketStorageTally_BucketName(v []byte) BucketStorageTally_BucketName_Field {
-	return BucketStorageTally_BucketName_Field{_set: true, _value: v}
+func BillingTransaction_Metadata(v []byte) BillingTransaction_Metadata_Field {
+	return BillingTransaction_Metadata_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_BucketName_Field) value() interface{} {
+func (f BillingTransaction_Metadata_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_BucketName_Field) _Column() string { return "bucket_name" }
+func (BillingTransaction_Metadata_Field) _Column() string { return "metadata" }
 
-type BucketStorageTally_ProjectId_Field struct {
+type BillingTransaction_Timestamp_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func BucketStorageTally_ProjectId(v []byte) BucketStorageTally_ProjectId_Field {
-	return BucketStorageTally_ProjectId_Field{_set: true, _value: v}
+func BillingTransaction_Timestamp(v time.Time) BillingTransaction_Timestamp_Field {
+	return BillingTransaction_Timestamp_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_ProjectId_Field) value() interface{} {
+func (f BillingTransaction_Timestamp_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_ProjectId_Field) _Column() string { return "project_id" }
+func (BillingTransaction_Timestamp_Field) _Column() string { return "timestamp" }
 
-type BucketStorageTally_IntervalStart_Field struct {
+type BillingTransaction_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func BucketStorageTally_IntervalStart(v time.Time) BucketStorageTally_IntervalStart_Field {
-	return BucketStorageTally_IntervalStart_Field{_set: true, _value: v}
+func BillingTransaction_CreatedAt(v time.Time) BillingTransaction_CreatedAt_Field {
+	return BillingTransaction_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_IntervalStart_Field) value() interface{} {
+func (f BillingTransaction_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_IntervalStart_Field) _Column() string { return "interval_start" }
+func (BillingTransaction_CreatedAt_Field) _Column() string { return "created_at" }
 
-type BucketStorageTally_TotalBytes_Field struct {
-	_set   bool
-	_null  bool
-	_value uint64
+type BucketBandwidthRollup struct {
+	BucketName      []byte
+	ProjectId       []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Inline          uint64
+	Allocated       uint64
+	Settled         uint64
 }
 
-func BucketStorageTally_TotalBytes(v uint64) BucketStorageTally_TotalBytes_Field {
-	return BucketStorageTally_TotalBytes_Field{_set: true, _value: v}
-}
+func (BucketBandwidthRollup) _Table() string { return "bucket_bandwidth_rollups" }
 
-func (f BucketStorageTally_TotalBytes_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type BucketBandwidthRollup_Update_Fields struct {
+	Inline    BucketBandwidthRollup_Inline_Field
+	Allocated BucketBandwidthRollup_Allocated_Field
+	Settled   BucketBandwidthRollup_Settled_Field
 }
 
-func (BucketStorageTally_TotalBytes_Field) _Column() string { return "total_bytes" }
-
-type BucketStorageTally_Inline_Field struct {
+type BucketBandwidthRollup_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func BucketStorageTally_Inline(v uint64) BucketStorageTally_Inline_Field {
-	return BucketStorageTally_Inline_Field{_set: true, _value: v}
+func BucketBandwidthRollup_BucketName(v []byte) BucketBandwidthRollup_BucketName_Field {
+	return BucketBandwidthRollup_BucketName_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_Inline_Field) value() interface{} {
+func (f BucketBandwidthRollup_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_Inline_Field) _Column() string { return "inline" }
+func (BucketBandwidthRollup_BucketName_Field) _Column() string { return "bucket_name" }
 
-type BucketStorageTally_Remote_Field struct {
+type BucketBandwidthRollup_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func BucketStorageTally_Remote(v uint64) BucketStorageTally_Remote_Field {
-	return BucketStorageTally_Remote_Field{_set: true, _value: v}
+func BucketBandwidthRollup_ProjectId(v []byte) BucketBandwidthRollup_ProjectId_Field {
+	return BucketBandwidthRollup_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_Remote_Field) value() interface{} {
+func (f BucketBandwidthRollup_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_Remote_Field) _Column() string { return "remote" }
+func (BucketBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
 
-type BucketStorageTally_TotalSegmentsCount_Field struct {
+type BucketBandwidthRollup_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value time.Time
 }
 
-func BucketStorageTally_TotalSegmentsCount(v uint) BucketStorageTally_TotalSegmentsCount_Field {
-	return BucketStorageTally_TotalSegmentsCount_Field{_set: true, _value: v}
+func BucketBandwidthRollup_IntervalStart(v time.Time) BucketBandwidthRollup_IntervalStart_Field {
+	return BucketBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_TotalSegmentsCount_Field) value() interface{} {
+func (f BucketBandwidthRollup_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_TotalSegmentsCount_Field) _Column() string { return "total_segments_count" }
+func (BucketBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type BucketStorageTally_RemoteSegmentsCount_Field struct {
+type BucketBandwidthRollup_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
 	_value uint
 }
 
-func BucketStorageTally_RemoteSegmentsCount(v uint) BucketStorageTally_RemoteSegmentsCount_Field {
-	return BucketStorageTally_RemoteSegmentsCount_Field{_set: true, _value: v}
+func BucketBandwidthRollup_IntervalSeconds(v uint) BucketBandwidthRollup_IntervalSeconds_Field {
+	return BucketBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_RemoteSegmentsCount_Field) value() interface{} {
+func (f BucketBandwidthRollup_IntervalSeconds_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_RemoteSegmentsCount_Field) _Column() string { return "remote_segments_count" }
+func (BucketBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
 
-type BucketStorageTally_InlineSegmentsCount_Field struct {
+type BucketBandwidthRollup_Action_Field struct {
 	_set   bool
 	_null  bool
 	_value uint
 }
 
-func BucketStorageTally_InlineSegmentsCount(v uint) BucketStorageTally_InlineSegmentsCount_Field {
-	return BucketStorageTally_InlineSegmentsCount_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Action(v uint) BucketBandwidthRollup_Action_Field {
+	return BucketBandwidthRollup_Action_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_InlineSegmentsCount_Field) value() interface{} {
+func (f BucketBandwidthRollup_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_InlineSegmentsCount_Field) _Column() string { return "inline_segments_count" }
+func (BucketBandwidthRollup_Action_Field) _Column() string { return "action" }
 
-type BucketStorageTally_ObjectCount_Field struct {
+type BucketBandwidthRollup_Inline_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value uint64
 }
 
-func BucketStorageTally_ObjectCount(v uint) BucketStorageTally_ObjectCount_Field {
-	return BucketStorageTally_ObjectCount_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Inline(v uint64) BucketBandwidthRollup_Inline_Field {
+	return BucketBandwidthRollup_Inline_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_ObjectCount_Field) value() interface{} {
+func (f BucketBandwidthRollup_Inline_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_ObjectCount_Field) _Column() string { return "object_count" }
+func (BucketBandwidthRollup_Inline_Field) _Column() string { return "inline" }
 
-type BucketStorageTally_MetadataSize_Field struct {
+type BucketBandwidthRollup_Allocated_Field struct {
 	_set   bool
 	_null  bool
 	_value uint64
 }
 
-func BucketStorageTally_MetadataSize(v uint64) BucketStorageTally_MetadataSize_Field {
-	return BucketStorageTally_MetadataSize_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Allocated(v uint64) BucketBandwidthRollup_Allocated_Field {
+	return BucketBandwidthRollup_Allocated_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_MetadataSize_Field) value() interface{} {
+func (f BucketBandwidthRollup_Allocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_MetadataSize_Field) _Column() string { return "metadata_size" }
-
-type CoinpaymentsTransaction struct {
-	Id              string
-	UserId          []byte
-	Address         string
-	AmountNumeric   int64
-	ReceivedNumeric int64
-	Status          int
-	Key             string
-	Timeout         int
-	CreatedAt       time.Time
-}
-
-func (CoinpaymentsTransaction) _Table() string { return "coinpayments_transactions" }
-
-type CoinpaymentsTransaction_Update_Fields struct {
-	ReceivedNumeric CoinpaymentsTransaction_ReceivedNumeric_Field
-	Status          CoinpaymentsTransaction_Status_Field
-}
+func (BucketBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
 
-type CoinpaymentsTransaction_Id_Field struct {
+type BucketBandwidthRollup_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value uint64
 }
 
-func CoinpaymentsTransaction_Id(v string) CoinpaymentsTransaction_Id_Field {
-	return CoinpaymentsTransaction_Id_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Settled(v uint64) BucketBandwidthRollup_Settled_Field {
+	return BucketBandwidthRollup_Settled_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Id_Field) value() interface{} {
+func (f BucketBandwidthRollup_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Id_Field) _Column() string { return "id" }
+func (BucketBandwidthRollup_Settled_Field) _Column() string { return "settled" }
 
-type CoinpaymentsTransaction_UserId_Field struct {
+type BucketBandwidthRollupArchive struct {
+	BucketName      []byte
+	ProjectId       []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Inline          uint64
+	Allocated       uint64
+	Settled         uint64
+}
+
+func (BucketBandwidthRollupArchive) _Table() string { return "bucket_bandwidth_rollup_archives" }
+
+type BucketBandwidthRollupArchive_Update_Fields struct {
+	Inline    BucketBandwidthRollupArchive_Inline_Field
+	Allocated BucketBandwidthRollupArchive_Allocated_Field
+	Settled   BucketBandwidthRollupArchive_Settled_Field
+}
+
+type BucketBandwidthRollupArchive_BucketName_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func CoinpaymentsTransaction_UserId(v []byte) CoinpaymentsTransaction_UserId_Field {
-	return CoinpaymentsTransaction_UserId_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_BucketName(v []byte) BucketBandwidthRollupArchive_BucketName_Field {
+	return BucketBandwidthRollupArchive_BucketName_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_UserId_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_UserId_Field) _Column() string { return "user_id" }
+func (BucketBandwidthRollupArchive_BucketName_Field) _Column() string { return "bucket_name" }
 
-type CoinpaymentsTransaction_Address_Field struct {
+type BucketBandwidthRollupArchive_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func CoinpaymentsTransaction_Address(v string) CoinpaymentsTransaction_Address_Field {
-	return CoinpaymentsTransaction_Address_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_ProjectId(v []byte) BucketBandwidthRollupArchive_ProjectId_Field {
+	return BucketBandwidthRollupArchive_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Address_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Address_Field) _Column() string { return "address" }
+func (BucketBandwidthRollupArchive_ProjectId_Field) _Column() string { return "project_id" }
 
-type CoinpaymentsTransaction_AmountNumeric_Field struct {
+type BucketBandwidthRollupArchive_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func CoinpaymentsTransaction_AmountNumeric(v int64) CoinpaymentsTransaction_AmountNumeric_Field {
-	return CoinpaymentsTransaction_AmountNumeric_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_IntervalStart(v time.Time) BucketBandwidthRollupArchive_IntervalStart_Field {
+	return BucketBandwidthRollupArchive_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_AmountNumeric_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_AmountNumeric_Field) _Column() string { return "amount_numeric" }
+func (BucketBandwidthRollupArchive_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type CoinpaymentsTransaction_ReceivedNumeric_Field struct {
+type BucketBandwidthRollupArchive_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint
 }
 
-func CoinpaymentsTransaction_ReceivedNumeric(v int64) CoinpaymentsTransaction_ReceivedNumeric_Field {
-	return CoinpaymentsTransaction_ReceivedNumeric_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_IntervalSeconds(v uint) BucketBandwidthRollupArchive_IntervalSeconds_Field {
+	return BucketBandwidthRollupArchive_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_ReceivedNumeric_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_IntervalSeconds_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_ReceivedNumeric_Field) _Column() string { return "received_numeric" }
+func (BucketBandwidthRollupArchive_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
 
-type CoinpaymentsTransaction_Status_Field struct {
+type BucketBandwidthRollupArchive_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint
 }
 
-func CoinpaymentsTransaction_Status(v int) CoinpaymentsTransaction_Status_Field {
-	return CoinpaymentsTransaction_Status_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_Action(v uint) BucketBandwidthRollupArchive_Action_Field {
+	return BucketBandwidthRollupArchive_Action_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Status_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Status_Field) _Column() string { return "status" }
+func (BucketBandwidthRollupArchive_Action_Field) _Column() string { return "action" }
 
-type CoinpaymentsTransaction_Key_Field struct {
+type BucketBandwidthRollupArchive_Inline_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value uint64
 }
 
-func CoinpaymentsTransaction_Key(v string) CoinpaymentsTransaction_Key_Field {
-	return CoinpaymentsTransaction_Key_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_Inline(v uint64) BucketBandwidthRollupArchive_Inline_Field {
+	return BucketBandwidthRollupArchive_Inline_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Key_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_Inline_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Key_Field) _Column() string { return "key" }
+func (BucketBandwidthRollupArchive_Inline_Field) _Column() string { return "inline" }
 
-type CoinpaymentsTransaction_Timeout_Field struct {
+type BucketBandwidthRollupArchive_Allocated_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint64
 }
 
-func CoinpaymentsTransaction_Timeout(v int) CoinpaymentsTransaction_Timeout_Field {
-	return CoinpaymentsTransaction_Timeout_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_Allocated(v uint64) BucketBandwidthRollupArchive_Allocated_Field {
+	return BucketBandwidthRollupArchive_Allocated_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Timeout_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_Allocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Timeout_Field) _Column() string { return "timeout" }
+func (BucketBandwidthRollupArchive_Allocated_Field) _Column() string { return "allocated" }
 
-type CoinpaymentsTransaction_CreatedAt_Field struct {
+type BucketBandwidthRollupArchive_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value uint64
 }
 
-func CoinpaymentsTransaction_CreatedAt(v time.Time) CoinpaymentsTransaction_CreatedAt_Field {
-	return CoinpaymentsTransaction_CreatedAt_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_Settled(v uint64) BucketBandwidthRollupArchive_Settled_Field {
+	return BucketBandwidthRollupArchive_Settled_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_CreatedAt_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_CreatedAt_Field) _Column() string { return "created_at" }
+func (BucketBandwidthRollupArchive_Settled_Field) _Column() string { return "settled" }
 
-type GracefulExitProgress struct {
-	NodeId            []byte
-	BytesTransferred  int64
-	PiecesTransferred int64
-	PiecesFailed      int64
-	UpdatedAt         time.Time
+type BucketEncryptionKey struct {
+	ProjectId    []byte
+	BucketName   []byte
+	MasterKeyId  string
+	EncryptedKey []byte
+	CreatedAt    time.Time
 }
 
-func (GracefulExitProgress) _Table() string { return "graceful_exit_progress" }
+func (BucketEncryptionKey) _Table() string { return "bucket_encryption_keys" }
 
-type GracefulExitProgress_Update_Fields struct {
-	BytesTransferred  GracefulExitProgress_BytesTransferred_Field
-	PiecesTransferred GracefulExitProgress_PiecesTransferred_Field
-	PiecesFailed      GracefulExitProgress_PiecesFailed_Field
+type BucketEncryptionKey_Create_Fields struct {
 }
 
-type GracefulExitProgress_NodeId_Field struct {
+type BucketEncryptionKey_Update_Fields struct {
+}
+
+type BucketEncryptionKey_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func GracefulExitProgress_NodeId(v []byte) GracefulExitProgress_NodeId_Field {
-	return GracefulExitProgress_NodeId_Field{_set: true, _value: v}
+func BucketEncryptionKey_ProjectId(v []byte) BucketEncryptionKey_ProjectId_Field {
+	return BucketEncryptionKey_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_NodeId_Field) value() interface{} {
+func (f BucketEncryptionKey_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_NodeId_Field) _Column() string { return "node_id" }
+func (BucketEncryptionKey_ProjectId_Field) _Column() string { return "project_id" }
 
-type GracefulExitProgress_BytesTransferred_Field struct {
+type BucketEncryptionKey_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func GracefulExitProgress_BytesTransferred(v int64) GracefulExitProgress_BytesTransferred_Field {
-	return GracefulExitProgress_BytesTransferred_Field{_set: true, _value: v}
+func BucketEncryptionKey_BucketName(v []byte) BucketEncryptionKey_BucketName_Field {
+	return BucketEncryptionKey_BucketName_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_BytesTransferred_Field) value() interface{} {
+func (f BucketEncryptionKey_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_BytesTransferred_Field) _Column() string { return "bytes_transferred" }
+func (BucketEncryptionKey_BucketName_Field) _Column() string { return "bucket_name" }
 
-type GracefulExitProgress_PiecesTransferred_Field struct {
+type BucketEncryptionKey_MasterKeyId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func GracefulExitProgress_PiecesTransferred(v int64) GracefulExitProgress_PiecesTransferred_Field {
-	return GracefulExitProgress_PiecesTransferred_Field{_set: true, _value: v}
+func BucketEncryptionKey_MasterKeyId(v string) BucketEncryptionKey_MasterKeyId_Field {
+	return BucketEncryptionKey_MasterKeyId_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_PiecesTransferred_Field) value() interface{} {
+func (f BucketEncryptionKey_MasterKeyId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_PiecesTransferred_Field) _Column() string { return "pieces_transferred" }
+func (BucketEncryptionKey_MasterKeyId_Field) _Column() string { return "master_key_id" }
 
-type GracefulExitProgress_PiecesFailed_Field struct {
+type BucketEncryptionKey_EncryptedKey_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func GracefulExitProgress_PiecesFailed(v int64) GracefulExitProgress_PiecesFailed_Field {
-	return GracefulExitProgress_PiecesFailed_Field{_set: true, _value: v}
+func BucketEncryptionKey_EncryptedKey(v []byte) BucketEncryptionKey_EncryptedKey_Field {
+	return BucketEncryptionKey_EncryptedKey_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_PiecesFailed_Field) value() interface{} {
+func (f BucketEncryptionKey_EncryptedKey_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_PiecesFailed_Field) _Column() string { return "pieces_failed" }
+func (BucketEncryptionKey_EncryptedKey_Field) _Column() string { return "encrypted_key" }
 
-type GracefulExitProgress_UpdatedAt_Field struct {
+type BucketEncryptionKey_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func GracefulExitProgress_UpdatedAt(v time.Time) GracefulExitProgress_UpdatedAt_Field {
-	return GracefulExitProgress_UpdatedAt_Field{_set: true, _value: v}
+func BucketEncryptionKey_CreatedAt(v time.Time) BucketEncryptionKey_CreatedAt_Field {
+	return BucketEncryptionKey_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_UpdatedAt_Field) value() interface{} {
+func (f BucketEncryptionKey_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (BucketEncryptionKey_CreatedAt_Field) _Column() string { return "created_at" }
 
-type GracefulExitSegmentTransfer struct {
-	NodeId              []byte
-	StreamId            []byte
-	Position            uint64
-	PieceNum            int
-	RootPieceId         []byte
-	DurabilityRatio     float64
-	QueuedAt            time.Time
-	RequestedAt         *time.Time
-	LastFailedAt        *time.Time
-	LastFailedCode      *int
-	FailedCount         *int
-	FinishedAt          *time.Time
-	OrderLimitSendCount int
+type BucketInventory struct {
+	ProjectId         []byte
+	BucketName        []byte
+	DestinationBucket []byte
+	DestinationPrefix string
+	Format            string
+	Frequency         string
+	DestinationAccess string
+	LastDeliveredAt   *time.Time
+	CreatedAt         time.Time
 }
 
-func (GracefulExitSegmentTransfer) _Table() string { return "graceful_exit_segment_transfer_queue" }
+func (BucketInventory) _Table() string { return "bucket_inventories" }
 
-type GracefulExitSegmentTransfer_Create_Fields struct {
-	RootPieceId         GracefulExitSegmentTransfer_RootPieceId_Field
-	RequestedAt         GracefulExitSegmentTransfer_RequestedAt_Field
-	LastFailedAt        GracefulExitSegmentTransfer_LastFailedAt_Field
-	LastFailedCode      GracefulExitSegmentTransfer_LastFailedCode_Field
-	FailedCount         GracefulExitSegmentTransfer_FailedCount_Field
-	FinishedAt          GracefulExitSegmentTransfer_FinishedAt_Field
-	OrderLimitSendCount GracefulExitSegmentTransfer_OrderLimitSendCount_Field
+type BucketInventory_Create_Fields struct {
+	LastDeliveredAt BucketInventory_LastDeliveredAt_Field
 }
 
-type GracefulExitSegmentTransfer_Update_Fields struct {
-	DurabilityRatio     GracefulExitSegmentTransfer_DurabilityRatio_Field
-	RequestedAt         GracefulExitSegmentTransfer_RequestedAt_Field
-	LastFailedAt        GracefulExitSegmentTransfer_LastFailedAt_Field
-	LastFailedCode      GracefulExitSegmentTransfer_LastFailedCode_Field
-	FailedCount         GracefulExitSegmentTransfer_FailedCount_Field
-	FinishedAt          GracefulExitSegmentTransfer_FinishedAt_Field
-	OrderLimitSendCount GracefulExitSegmentTransfer_OrderLimitSendCount_Field
+type BucketInventory_Update_Fields struct {
+	DestinationBucket BucketInventory_DestinationBucket_Field
+	DestinationPrefix BucketInventory_DestinationPrefix_Field
+	Format            BucketInventory_Format_Field
+	Frequency         BucketInventory_Frequency_Field
+	DestinationAccess BucketInventory_DestinationAccess_Field
+	LastDeliveredAt   BucketInventory_LastDeliveredAt_Field
 }
 
-type GracefulExitSegmentTransfer_NodeId_Field struct {
+type BucketInventory_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func GracefulExitSegmentTransfer_NodeId(v []byte) GracefulExitSegmentTransfer_NodeId_Field {
-	return GracefulExitSegmentTransfer_NodeId_Field{_set: true, _value: v}
+func BucketInventory_ProjectId(v []byte) BucketInventory_ProjectId_Field {
+	return BucketInventory_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_NodeId_Field) value() interface{} {
+func (f BucketInventory_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_NodeId_Field) _Column() string { return "node_id" }
+func (BucketInventory_ProjectId_Field) _Column() string { return "project_id" }
 
-type GracefulExitSegmentTransfer_StreamId_Field struct {
+type BucketInventory_BucketName_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func GracefulExitSegmentTransfer_StreamId(v []byte) GracefulExitSegmentTransfer_StreamId_Field {
-	return GracefulExitSegmentTransfer_StreamId_Field{_set: true, _value: v}
+func BucketInventory_BucketName(v []byte) BucketInventory_BucketName_Field {
+	return BucketInventory_BucketName_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_StreamId_Field) value() interface{} {
+func (f BucketInventory_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_StreamId_Field) _Column() string { return "stream_id" }
+func (BucketInventory_BucketName_Field) _Column() string { return "bucket_name" }
 
-type GracefulExitSegmentTransfer_Position_Field struct {
+type BucketInventory_DestinationBucket_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func GracefulExitSegmentTransfer_Position(v uint64) GracefulExitSegmentTransfer_Position_Field {
-	return GracefulExitSegmentTransfer_Position_Field{_set: true, _value: v}
+func BucketInventory_DestinationBucket(v []byte) BucketInventory_DestinationBucket_Field {
+	return BucketInventory_DestinationBucket_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_Position_Field) value() interface{} {
+func (f BucketInventory_DestinationBucket_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_Position_Field) _Column() string { return "position" }
+func (BucketInventory_DestinationBucket_Field) _Column() string { return "destination_bucket" }
 
-type GracefulExitSegmentTransfer_PieceNum_Field struct {
+type BucketInventory_DestinationPrefix_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func GracefulExitSegmentTransfer_PieceNum(v int) GracefulExitSegmentTransfer_PieceNum_Field {
-	return GracefulExitSegmentTransfer_PieceNum_Field{_set: true, _value: v}
+func BucketInventory_DestinationPrefix(v string) BucketInventory_DestinationPrefix_Field {
+	return BucketInventory_DestinationPrefix_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_PieceNum_Field) value() interface{} {
+func (f BucketInventory_DestinationPrefix_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_PieceNum_Field) _Column() string { return "piece_num" }
+func (BucketInventory_DestinationPrefix_Field) _Column() string { return "destination_prefix" }
 
-type GracefulExitSegmentTransfer_RootPieceId_Field struct {
+type BucketInventory_Format_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func GracefulExitSegmentTransfer_RootPieceId(v []byte) GracefulExitSegmentTransfer_RootPieceId_Field {
-	return GracefulExitSegmentTransfer_RootPieceId_Field{_set: true, _value: v}
+func BucketInventory_Format(v string) BucketInventory_Format_Field {
+	return BucketInventory_Format_Field{_set: true, _value: v}
 }
 
-func GracefulExitSegmentTransfer_RootPieceId_Raw(v []byte) GracefulExitSegmentTransfer_RootPieceId_Field {
-	if v == nil {
-		return GracefulExitSegmentTransfer_RootPieceId_Null()
+func (f BucketInventory_Format_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return GracefulExitSegmentTransfer_RootPieceId(v)
+	return f._value
 }
 
-func GracefulExitSegmentTransfer_RootPieceId_Null() GracefulExitSegmentTransfer_RootPieceId_Field {
-	return GracefulExitSegmentTransfer_RootPieceId_Field{_set: true, _null: true}
+func (BucketInventory_Format_Field) _Column() string { return "format" }
+
+type BucketInventory_Frequency_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f GracefulExitSegmentTransfer_RootPieceId_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func BucketInventory_Frequency(v string) BucketInventory_Frequency_Field {
+	return BucketInventory_Frequency_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_RootPieceId_Field) value() interface{} {
+func (f BucketInventory_Frequency_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_RootPieceId_Field) _Column() string { return "root_piece_id" }
+func (BucketInventory_Frequency_Field) _Column() string { return "frequency" }
 
-type GracefulExitSegmentTransfer_DurabilityRatio_Field struct {
+type BucketInventory_DestinationAccess_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value string
 }
 
-func GracefulExitSegmentTransfer_DurabilityRatio(v float64) GracefulExitSegmentTransfer_DurabilityRatio_Field {
-	return GracefulExitSegmentTransfer_DurabilityRatio_Field{_set: true, _value: v}
+func BucketInventory_DestinationAccess(v string) BucketInventory_DestinationAccess_Field {
+	return BucketInventory_DestinationAccess_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_DurabilityRatio_Field) value() interface{} {
+func (f BucketInventory_DestinationAccess_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_DurabilityRatio_Field) _Column() string { return "durability_ratio" }
+func (BucketInventory_DestinationAccess_Field) _Column() string { return "destination_access" }
 
-type GracefulExitSegmentTransfer_QueuedAt_Field struct {
+type BucketInventory_LastDeliveredAt_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *time.Time
 }
 
-func GracefulExitSegmentTransfer_QueuedAt(v time.Time) GracefulExitSegmentTransfer_QueuedAt_Field {
-	return GracefulExitSegmentTransfer_QueuedAt_Field{_set: true, _value: v}
+func BucketInventory_LastDeliveredAt(v time.Time) BucketInventory_LastDeliveredAt_Field {
+	return BucketInventory_LastDeliveredAt_Field{_set: true, _value: &v}
 }
 
-func (f GracefulExitSegmentTransfer_QueuedAt_Field) value() interface{} {
+func BucketInventory_LastDeliveredAt_Raw(v *time.Time) BucketInventory_LastDeliveredAt_Field {
+	if v == nil {
+		return BucketInventory_LastDeliveredAt_Null()
+	}
+	return BucketInventory_LastDeliveredAt(*v)
+}
+
+func BucketInventory_LastDeliveredAt_Null() BucketInventory_LastDeliveredAt_Field {
+	return BucketInventory_LastDeliveredAt_Field{_set: true, _null: true}
+}
+
+func (f BucketInventory_LastDeliveredAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f BucketInventory_LastDeliveredAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_QueuedAt_Field) _Column() string { return "queued_at" }
+func (BucketInventory_LastDeliveredAt_Field) _Column() string { return "last_delivered_at" }
 
-type GracefulExitSegmentTransfer_RequestedAt_Field struct {
+type BucketInventory_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value time.Time
 }
 
-func GracefulExitSegmentTransfer_RequestedAt(v time.Time) GracefulExitSegmentTransfer_RequestedAt_Field {
-	return GracefulExitSegmentTransfer_RequestedAt_Field{_set: true, _value: &v}
+func BucketInventory_CreatedAt(v time.Time) BucketInventory_CreatedAt_Field {
+	return BucketInventory_CreatedAt_Field{_set: true, _value: v}
 }
 
-func GracefulExitSegmentTransfer_RequestedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_RequestedAt_Field {
-	if v == nil {
-		return GracefulExitSegmentTransfer_RequestedAt_Null()
+func (f BucketInventory_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return GracefulExitSegmentTransfer_RequestedAt(*v)
+	return f._value
 }
 
-func GracefulExitSegmentTransfer_RequestedAt_Null() GracefulExitSegmentTransfer_RequestedAt_Field {
-	return GracefulExitSegmentTransfer_RequestedAt_Field{_set: true, _null: true}
+func (BucketInventory_CreatedAt_Field) _Column() string { return "created_at" }
+
+type BucketMetadataHistory struct {
+	ProjectId                    []byte
+	BucketName                   []byte
+	Version                      int64
+	BucketId                     []byte
+	DefaultEncryptionCipherSuite int
+	DefaultEncryptionBlockSize   int
+	Versioning                   int
+	ObjectLockEnabled            bool
+	Deleted                      bool
+	ChangedAt                    time.Time
 }
 
-func (f GracefulExitSegmentTransfer_RequestedAt_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func (BucketMetadataHistory) _Table() string { return "bucket_metadata_history" }
+
+type BucketMetadataHistory_Create_Fields struct {
 }
 
-func (f GracefulExitSegmentTransfer_RequestedAt_Field) value() interface{} {
+type BucketMetadataHistory_Update_Fields struct {
+}
+
+type BucketMetadataHistory_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketMetadataHistory_ProjectId(v []byte) BucketMetadataHistory_ProjectId_Field {
+	return BucketMetadataHistory_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f BucketMetadataHistory_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_RequestedAt_Field) _Column() string { return "requested_at" }
+func (BucketMetadataHistory_ProjectId_Field) _Column() string { return "project_id" }
 
-type GracefulExitSegmentTransfer_LastFailedAt_Field struct {
+type BucketMetadataHistory_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value []byte
 }
 
-func GracefulExitSegmentTransfer_LastFailedAt(v time.Time) GracefulExitSegmentTransfer_LastFailedAt_Field {
-	return GracefulExitSegmentTransfer_LastFailedAt_Field{_set: true, _value: &v}
+func BucketMetadataHistory_BucketName(v []byte) BucketMetadataHistory_BucketName_Field {
+	return BucketMetadataHistory_BucketName_Field{_set: true, _value: v}
 }
 
-func GracefulExitSegmentTransfer_LastFailedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_LastFailedAt_Field {
-	if v == nil {
-		return GracefulExitSegmentTransfer_LastFailedAt_Null()
+func (f BucketMetadataHistory_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return GracefulExitSegmentTransfer_LastFailedAt(*v)
+	return f._value
 }
 
-func GracefulExitSegmentTransfer_LastFailedAt_Null() GracefulExitSegmentTransfer_LastFailedAt_Field {
-	return GracefulExitSegmentTransfer_LastFailedAt_Field{_set: true, _null: true}
+func (BucketMetadataHistory_BucketName_Field) _Column() string { return "bucket_name" }
+
+type BucketMetadataHistory_Version_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (f GracefulExitSegmentTransfer_LastFailedAt_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func BucketMetadataHistory_Version(v int64) BucketMetadataHistory_Version_Field {
+	return BucketMetadataHistory_Version_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_LastFailedAt_Field) value() interface{} {
+func (f BucketMetadataHistory_Version_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_LastFailedAt_Field) _Column() string { return "last_failed_at" }
+func (BucketMetadataHistory_Version_Field) _Column() string { return "version" }
 
-type GracefulExitSegmentTransfer_LastFailedCode_Field struct {
+type BucketMetadataHistory_BucketId_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value []byte
 }
 
-func GracefulExitSegmentTransfer_LastFailedCode(v int) GracefulExitSegmentTransfer_LastFailedCode_Field {
-	return GracefulExitSegmentTransfer_LastFailedCode_Field{_set: true, _value: &v}
+func BucketMetadataHistory_BucketId(v []byte) BucketMetadataHistory_BucketId_Field {
+	return BucketMetadataHistory_BucketId_Field{_set: true, _value: v}
 }
 
-func GracefulExitSegmentTransfer_LastFailedCode_Raw(v *int) GracefulExitSegmentTransfer_LastFailedCode_Field {
-	if v == nil {
-		return GracefulExitSegmentTransfer_LastFailedCode_Null()
+func (f BucketMetadataHistory_BucketId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return GracefulExitSegmentTransfer_LastFailedCode(*v)
+	return f._value
 }
 
-func GracefulExitSegmentTransfer_LastFailedCode_Null() GracefulExitSegmentTransfer_LastFailedCode_Field {
-	return GracefulExitSegmentTransfer_LastFailedCode_Field{_set: true, _null: true}
+func (BucketMetadataHistory_BucketId_Field) _Column() string { return "bucket_id" }
+
+type BucketMetadataHistory_DefaultEncryptionCipherSuite_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (f GracefulExitSegmentTransfer_LastFailedCode_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func BucketMetadataHistory_DefaultEncryptionCipherSuite(v int) BucketMetadataHistory_DefaultEncryptionCipherSuite_Field {
+	return BucketMetadataHistory_DefaultEncryptionCipherSuite_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_LastFailedCode_Field) value() interface{} {
+func (f BucketMetadataHistory_DefaultEncryptionCipherSuite_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_LastFailedCode_Field) _Column() string { return "last_failed_code" }
+func (BucketMetadataHistory_DefaultEncryptionCipherSuite_Field) _Column() string {
+	return "default_encryption_cipher_suite"
+}
 
-type GracefulExitSegmentTransfer_FailedCount_Field struct {
+type BucketMetadataHistory_DefaultEncryptionBlockSize_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value int
 }
 
-func GracefulExitSegmentTransfer_FailedCount(v int) GracefulExitSegmentTransfer_FailedCount_Field {
-	return GracefulExitSegmentTransfer_FailedCount_Field{_set: true, _value: &v}
+func BucketMetadataHistory_DefaultEncryptionBlockSize(v int) BucketMetadataHistory_DefaultEncryptionBlockSize_Field {
+	return BucketMetadataHistory_DefaultEncryptionBlockSize_Field{_set: true, _value: v}
 }
 
-func GracefulExitSegmentTransfer_FailedCount_Raw(v *int) GracefulExitSegmentTransfer_FailedCount_Field {
-	if v == nil {
-		return GracefulExitSegmentTransfer_FailedCount_Null()
+func (f BucketMetadataHistory_DefaultEncryptionBlockSize_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return GracefulExitSegmentTransfer_FailedCount(*v)
+	return f._value
 }
 
-func GracefulExitSegmentTransfer_FailedCount_Null() GracefulExitSegmentTransfer_FailedCount_Field {
-	return GracefulExitSegmentTransfer_FailedCount_Field{_set: true, _null: true}
+func (BucketMetadataHistory_DefaultEncryptionBlockSize_Field) _Column() string {
+	return "default_encryption_block_size"
 }
 
-func (f GracefulExitSegmentTransfer_FailedCount_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+type BucketMetadataHistory_Versioning_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (f GracefulExitSegmentTransfer_FailedCount_Field) value() interface{} {
+func BucketMetadataHistory_Versioning(v int) BucketMetadataHistory_Versioning_Field {
+	return BucketMetadataHistory_Versioning_Field{_set: true, _value: v}
+}
+
+func (f BucketMetadataHistory_Versioning_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_FailedCount_Field) _Column() string { return "failed_count" }
+func (BucketMetadataHistory_Versioning_Field) _Column() string { return "versioning" }
 
-type GracefulExitSegmentTransfer_FinishedAt_Field struct {
+type BucketMetadataHistory_ObjectLockEnabled_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value bool
 }
 
-func GracefulExitSegmentTransfer_FinishedAt(v time.Time) GracefulExitSegmentTransfer_FinishedAt_Field {
-	return GracefulExitSegmentTransfer_FinishedAt_Field{_set: true, _value: &v}
+func BucketMetadataHistory_ObjectLockEnabled(v bool) BucketMetadataHistory_ObjectLockEnabled_Field {
+	return BucketMetadataHistory_ObjectLockEnabled_Field{_set: true, _value: v}
 }
 
-func GracefulExitSegmentTransfer_FinishedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_FinishedAt_Field {
-	if v == nil {
-		return GracefulExitSegmentTransfer_FinishedAt_Null()
+func (f BucketMetadataHistory_ObjectLockEnabled_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return GracefulExitSegmentTransfer_FinishedAt(*v)
+	return f._value
 }
 
-func GracefulExitSegmentTransfer_FinishedAt_Null() GracefulExitSegmentTransfer_FinishedAt_Field {
-	return GracefulExitSegmentTransfer_FinishedAt_Field{_set: true, _null: true}
+func (BucketMetadataHistory_ObjectLockEnabled_Field) _Column() string { return "object_lock_enabled" }
+
+type BucketMetadataHistory_Deleted_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
 }
 
-func (f GracefulExitSegmentTransfer_FinishedAt_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func BucketMetadataHistory_Deleted(v bool) BucketMetadataHistory_Deleted_Field {
+	return BucketMetadataHistory_Deleted_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_FinishedAt_Field) value() interface{} {
+func (f BucketMetadataHistory_Deleted_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_FinishedAt_Field) _Column() string { return "finished_at" }
+func (BucketMetadataHistory_Deleted_Field) _Column() string { return "deleted" }
 
-type GracefulExitSegmentTransfer_OrderLimitSendCount_Field struct {
+type BucketMetadataHistory_ChangedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func GracefulExitSegmentTransfer_OrderLimitSendCount(v int) GracefulExitSegmentTransfer_OrderLimitSendCount_Field {
-	return GracefulExitSegmentTransfer_OrderLimitSendCount_Field{_set: true, _value: v}
+func BucketMetadataHistory_ChangedAt(v time.Time) BucketMetadataHistory_ChangedAt_Field {
+	return BucketMetadataHistory_ChangedAt_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_OrderLimitSendCount_Field) value() interface{} {
+func (f BucketMetadataHistory_ChangedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_OrderLimitSendCount_Field) _Column() string {
-	return "order_limit_send_count"
+func (BucketMetadataHistory_ChangedAt_Field) _Column() string { return "changed_at" }
+
+type BucketNotificationConfig struct {
+	ProjectId     []byte
+	BucketName    []byte
+	Configuration []byte
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
 }
 
-type Node struct {
-	Id                      []byte
-	Address                 string
-	LastNet                 string
-	LastIpPort              *string
-	CountryCode             *string
-	Protocol                int
-	Type                    int
-	Email                   string
-	Wallet                  string
-	WalletFeatures          string
-	FreeDisk                int64
-	PieceCount              int64
-	Major                   int64
-	Minor                   int64
-	Patch                   int64
-	Hash                    string
-	Timestamp               time.Time
-	Release                 bool
-	Latency90               int64
-	VettedAt                *time.Time
-	CreatedAt               time.Time
-	UpdatedAt               time.Time
-	LastContactSuccess      time.Time
-	LastContactFailure      time.Time
-	Disqualified            *time.Time
-	DisqualificationReason  *int
-	UnknownAuditSuspended   *time.Time
-	OfflineSuspended        *time.Time
-	UnderReview             *time.Time
-	ExitInitiatedAt         *time.Time
-	ExitLoopCompletedAt     *time.Time
-	ExitFinishedAt          *time.Time
-	ExitSuccess             bool
-	Contained               *time.Time
-	LastOfflineEmail        *time.Time
-	LastSoftwareUpdateEmail *time.Time
-	NoiseProto              *int
-	NoisePublicKey          []byte
-	DebounceLimit           int
-}
-
-func (Node) _Table() string { return "nodes" }
+func (BucketNotificationConfig) _Table() string { return "bucket_notification_configs" }
 
-type Node_Create_Fields struct {
-	Address                 Node_Address_Field
-	LastIpPort              Node_LastIpPort_Field
-	CountryCode             Node_CountryCode_Field
-	Protocol                Node_Protocol_Field
-	Type                    Node_Type_Field
-	WalletFeatures          Node_WalletFeatures_Field
-	FreeDisk                Node_FreeDisk_Field
-	Major                   Node_Major_Field
-	Minor                   Node_Minor_Field
-	Patch                   Node_Patch_Field
-	Hash                    Node_Hash_Field
-	Timestamp               Node_Timestamp_Field
-	Release                 Node_Release_Field
-	Latency90               Node_Latency90_Field
-	VettedAt                Node_VettedAt_Field
-	LastContactSuccess      Node_LastContactSuccess_Field
-	LastContactFailure      Node_LastContactFailure_Field
-	Disqualified            Node_Disqualified_Field
-	DisqualificationReason  Node_DisqualificationReason_Field
-	UnknownAuditSuspended   Node_UnknownAuditSuspended_Field
-	OfflineSuspended        Node_OfflineSuspended_Field
-	UnderReview             Node_UnderReview_Field
-	ExitInitiatedAt         Node_ExitInitiatedAt_Field
-	ExitLoopCompletedAt     Node_ExitLoopCompletedAt_Field
-	ExitFinishedAt          Node_ExitFinishedAt_Field
-	ExitSuccess             Node_ExitSuccess_Field
-	Contained               Node_Contained_Field
-	LastOfflineEmail        Node_LastOfflineEmail_Field
-	LastSoftwareUpdateEmail Node_LastSoftwareUpdateEmail_Field
-	NoiseProto              Node_NoiseProto_Field
-	NoisePublicKey          Node_NoisePublicKey_Field
-	DebounceLimit           Node_DebounceLimit_Field
+type BucketNotificationConfig_Create_Fields struct {
 }
 
-type Node_Update_Fields struct {
-	Address                 Node_Address_Field
-	LastNet                 Node_LastNet_Field
-	LastIpPort              Node_LastIpPort_Field
-	CountryCode             Node_CountryCode_Field
-	Protocol                Node_Protocol_Field
-	Type                    Node_Type_Field
-	Email                   Node_Email_Field
-	Wallet                  Node_Wallet_Field
-	WalletFeatures          Node_WalletFeatures_Field
-	FreeDisk                Node_FreeDisk_Field
-	PieceCount              Node_PieceCount_Field
-	Major                   Node_Major_Field
-	Minor                   Node_Minor_Field
-	Patch                   Node_Patch_Field
-	Hash                    Node_Hash_Field
-	Timestamp               Node_Timestamp_Field
-	Release                 Node_Release_Field
-	Latency90               Node_Latency90_Field
-	VettedAt                Node_VettedAt_Field
-	LastContactSuccess      Node_LastContactSuccess_Field
-	LastContactFailure      Node_LastContactFailure_Field
-	Disqualified            Node_Disqualified_Field
-	DisqualificationReason  Node_DisqualificationReason_Field
-	UnknownAuditSuspended   Node_UnknownAuditSuspended_Field
-	OfflineSuspended        Node_OfflineSuspended_Field
-	UnderReview             Node_UnderReview_Field
-	ExitInitiatedAt         Node_ExitInitiatedAt_Field
-	ExitLoopCompletedAt     Node_ExitLoopCompletedAt_Field
-	ExitFinishedAt          Node_ExitFinishedAt_Field
-	ExitSuccess             Node_ExitSuccess_Field
-	Contained               Node_Contained_Field
-	LastOfflineEmail        Node_LastOfflineEmail_Field
-	LastSoftwareUpdateEmail Node_LastSoftwareUpdateEmail_Field
-	NoiseProto              Node_NoiseProto_Field
-	NoisePublicKey          Node_NoisePublicKey_Field
-	DebounceLimit           Node_DebounceLimit_Field
+type BucketNotificationConfig_Update_Fields struct {
+	Configuration BucketNotificationConfig_Configuration_Field
 }
 
-type Node_Id_Field struct {
+type BucketNotificationConfig_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Node_Id(v []byte) Node_Id_Field {
-	return Node_Id_Field{_set: true, _value: v}
+func BucketNotificationConfig_ProjectId(v []byte) BucketNotificationConfig_ProjectId_Field {
+	return BucketNotificationConfig_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Node_Id_Field) value() interface{} {
+func (f BucketNotificationConfig_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Id_Field) _Column() string { return "id" }
+func (BucketNotificationConfig_ProjectId_Field) _Column() string { return "project_id" }
 
-type Node_Address_Field struct {
+type BucketNotificationConfig_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func Node_Address(v string) Node_Address_Field {
-	return Node_Address_Field{_set: true, _value: v}
+func BucketNotificationConfig_BucketName(v []byte) BucketNotificationConfig_BucketName_Field {
+	return BucketNotificationConfig_BucketName_Field{_set: true, _value: v}
 }
 
-func (f Node_Address_Field) value() interface{} {
+func (f BucketNotificationConfig_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Address_Field) _Column() string { return "address" }
+func (BucketNotificationConfig_BucketName_Field) _Column() string { return "bucket_name" }
 
-type Node_LastNet_Field struct {
+type BucketNotificationConfig_Configuration_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func Node_LastNet(v string) Node_LastNet_Field {
-	return Node_LastNet_Field{_set: true, _value: v}
+func BucketNotificationConfig_Configuration(v []byte) BucketNotificationConfig_Configuration_Field {
+	return BucketNotificationConfig_Configuration_Field{_set: true, _value: v}
 }
 
-func (f Node_LastNet_Field) value() interface{} {
+func (f BucketNotificationConfig_Configuration_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastNet_Field) _Column() string { return "last_net" }
+func (BucketNotificationConfig_Configuration_Field) _Column() string { return "configuration" }
 
-type Node_LastIpPort_Field struct {
+type BucketNotificationConfig_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value time.Time
 }
 
-func Node_LastIpPort(v string) Node_LastIpPort_Field {
-	return Node_LastIpPort_Field{_set: true, _value: &v}
+func BucketNotificationConfig_CreatedAt(v time.Time) BucketNotificationConfig_CreatedAt_Field {
+	return BucketNotificationConfig_CreatedAt_Field{_set: true, _value: v}
 }
 
-func Node_LastIpPort_Raw(v *string) Node_LastIpPort_Field {
-	if v == nil {
-		return Node_LastIpPort_Null()
+func (f BucketNotificationConfig_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Node_LastIpPort(*v)
+	return f._value
 }
 
-func Node_LastIpPort_Null() Node_LastIpPort_Field {
-	return Node_LastIpPort_Field{_set: true, _null: true}
+func (BucketNotificationConfig_CreatedAt_Field) _Column() string { return "created_at" }
+
+type BucketNotificationConfig_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f Node_LastIpPort_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func BucketNotificationConfig_UpdatedAt(v time.Time) BucketNotificationConfig_UpdatedAt_Field {
+	return BucketNotificationConfig_UpdatedAt_Field{_set: true, _value: v}
+}
 
-func (f Node_LastIpPort_Field) value() interface{} {
+func (f BucketNotificationConfig_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastIpPort_Field) _Column() string { return "last_ip_port" }
+func (BucketNotificationConfig_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type Node_CountryCode_Field struct {
-	_set   bool
-	_null  bool
-	_value *string
+type BucketStorageTally struct {
+	BucketName          []byte
+	ProjectId           []byte
+	IntervalStart       time.Time
+	TotalBytes          uint64
+	Inline              uint64
+	Remote              uint64
+	TotalSegmentsCount  uint
+	RemoteSegmentsCount uint
+	InlineSegmentsCount uint
+	ObjectCount         uint
+	MetadataSize        uint64
 }
 
-func Node_CountryCode(v string) Node_CountryCode_Field {
-	return Node_CountryCode_Field{_set: true, _value: &v}
+func (BucketStorageTally) _Table() string { return "bucket_storage_tallies" }
+
+type BucketStorageTally_Create_Fields struct {
+	TotalBytes         BucketStorageTally_TotalBytes_Field
+	TotalSegmentsCount BucketStorageTally_TotalSegmentsCount_Field
 }
 
-func Node_CountryCode_Raw(v *string) Node_CountryCode_Field {
-	if v == nil {
-		return Node_CountryCode_Null()
-	}
-	return Node_CountryCode(*v)
+type BucketStorageTally_Update_Fields struct {
 }
 
-func Node_CountryCode_Null() Node_CountryCode_Field {
-	return Node_CountryCode_Field{_set: true, _null: true}
+type BucketStorageTally_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f Node_CountryCode_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func BucketStorageTally_BucketName(v []byte) BucketStorageTally_BucketName_Field {
+	return BucketStorageTally_BucketName_Field{_set: true, _value: v}
+}
 
-func (f Node_CountryCode_Field) value() interface{} {
+func (f BucketStorageTally_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_CountryCode_Field) _Column() string { return "country_code" }
+func (BucketStorageTally_BucketName_Field) _Column() string { return "bucket_name" }
 
-type Node_Protocol_Field struct {
+type BucketStorageTally_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func Node_Protocol(v int) Node_Protocol_Field {
-	return Node_Protocol_Field{_set: true, _value: v}
+func BucketStorageTally_ProjectId(v []byte) BucketStorageTally_ProjectId_Field {
+	return BucketStorageTally_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Node_Protocol_Field) value() interface{} {
+func (f BucketStorageTally_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Protocol_Field) _Column() string { return "protocol" }
+func (BucketStorageTally_ProjectId_Field) _Column() string { return "project_id" }
 
-type Node_Type_Field struct {
+type BucketStorageTally_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func Node_Type(v int) Node_Type_Field {
-	return Node_Type_Field{_set: true, _value: v}
+func BucketStorageTally_IntervalStart(v time.Time) BucketStorageTally_IntervalStart_Field {
+	return BucketStorageTally_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f Node_Type_Field) value() interface{} {
+func (f BucketStorageTally_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Type_Field) _Column() string { return "type" }
+func (BucketStorageTally_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type Node_Email_Field struct {
+type BucketStorageTally_TotalBytes_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value uint64
 }
 
-func Node_Email(v string) Node_Email_Field {
-	return Node_Email_Field{_set: true, _value: v}
+func BucketStorageTally_TotalBytes(v uint64) BucketStorageTally_TotalBytes_Field {
+	return BucketStorageTally_TotalBytes_Field{_set: true, _value: v}
 }
 
-func (f Node_Email_Field) value() interface{} {
+func (f BucketStorageTally_TotalBytes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Email_Field) _Column() string { return "email" }
+func (BucketStorageTally_TotalBytes_Field) _Column() string { return "total_bytes" }
 
-type Node_Wallet_Field struct {
+type BucketStorageTally_Inline_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value uint64
 }
 
-func Node_Wallet(v string) Node_Wallet_Field {
-	return Node_Wallet_Field{_set: true, _value: v}
+func BucketStorageTally_Inline(v uint64) BucketStorageTally_Inline_Field {
+	return BucketStorageTally_Inline_Field{_set: true, _value: v}
 }
 
-func (f Node_Wallet_Field) value() interface{} {
+func (f BucketStorageTally_Inline_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Wallet_Field) _Column() string { return "wallet" }
+func (BucketStorageTally_Inline_Field) _Column() string { return "inline" }
 
-type Node_WalletFeatures_Field struct {
+type BucketStorageTally_Remote_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value uint64
 }
 
-func Node_WalletFeatures(v string) Node_WalletFeatures_Field {
-	return Node_WalletFeatures_Field{_set: true, _value: v}
+func BucketStorageTally_Remote(v uint64) BucketStorageTally_Remote_Field {
+	return BucketStorageTally_Remote_Field{_set: true, _value: v}
 }
 
-func (f Node_WalletFeatures_Field) value() interface{} {
+func (f BucketStorageTally_Remote_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_WalletFeatures_Field) _Column() string { return "wallet_features" }
+func (BucketStorageTally_Remote_Field) _Column() string { return "remote" }
 
-type Node_FreeDisk_Field struct {
+type BucketStorageTally_TotalSegmentsCount_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint
 }
 
-func Node_FreeDisk(v int64) Node_FreeDisk_Field {
-	return Node_FreeDisk_Field{_set: true, _value: v}
+func BucketStorageTally_TotalSegmentsCount(v uint) BucketStorageTally_TotalSegmentsCount_Field {
+	return BucketStorageTally_TotalSegmentsCount_Field{_set: true, _value: v}
 }
 
-func (f Node_FreeDisk_Field) value() interface{} {
+func (f BucketStorageTally_TotalSegmentsCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_FreeDisk_Field) _Column() string { return "free_disk" }
+func (BucketStorageTally_TotalSegmentsCount_Field) _Column() string { return "total_segments_count" }
 
-type Node_PieceCount_Field struct {
+type BucketStorageTally_RemoteSegmentsCount_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint
 }
 
-func Node_PieceCount(v int64) Node_PieceCount_Field {
-	return Node_PieceCount_Field{_set: true, _value: v}
+func BucketStorageTally_RemoteSegmentsCount(v uint) BucketStorageTally_RemoteSegmentsCount_Field {
+	return BucketStorageTally_RemoteSegmentsCount_Field{_set: true, _value: v}
 }
 
-func (f Node_PieceCount_Field) value() interface{} {
+func (f BucketStorageTally_RemoteSegmentsCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_PieceCount_Field) _Column() string { return "piece_count" }
+func (BucketStorageTally_RemoteSegmentsCount_Field) _Column() string { return "remote_segments_count" }
 
-type Node_Major_Field struct {
+type BucketStorageTally_InlineSegmentsCount_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint
 }
 
-func Node_Major(v int64) Node_Major_Field {
-	return Node_Major_Field{_set: true, _value: v}
+func BucketStorageTally_InlineSegmentsCount(v uint) BucketStorageTally_InlineSegmentsCount_Field {
+	return BucketStorageTally_InlineSegmentsCount_Field{_set: true, _value: v}
 }
 
-func (f Node_Major_Field) value() interface{} {
+func (f BucketStorageTally_InlineSegmentsCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Major_Field) _Column() string { return "major" }
+func (BucketStorageTally_InlineSegmentsCount_Field) _Column() string { return "inline_segments_count" }
 
-type Node_Minor_Field struct {
+type BucketStorageTally_ObjectCount_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint
 }
 
-func Node_Minor(v int64) Node_Minor_Field {
-	return Node_Minor_Field{_set: true, _value: v}
+func BucketStorageTally_ObjectCount(v uint) BucketStorageTally_ObjectCount_Field {
+	return BucketStorageTally_ObjectCount_Field{_set: true, _value: v}
 }
 
-func (f Node_Minor_Field) value() interface{} {
+func (f BucketStorageTally_ObjectCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Minor_Field) _Column() string { return "minor" }
+func (BucketStorageTally_ObjectCount_Field) _Column() string { return "object_count" }
 
-type Node_Patch_Field struct {
+type BucketStorageTally_MetadataSize_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint64
 }
 
-func Node_Patch(v int64) Node_Patch_Field {
-	return Node_Patch_Field{_set: true, _value: v}
+func BucketStorageTally_MetadataSize(v uint64) BucketStorageTally_MetadataSize_Field {
+	return BucketStorageTally_MetadataSize_Field{_set: true, _value: v}
 }
 
-func (f Node_Patch_Field) value() interface{} {
+func (f BucketStorageTally_MetadataSize_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Patch_Field) _Column() string { return "patch" }
+func (BucketStorageTally_MetadataSize_Field) _Column() string { return "metadata_size" }
 
-type Node_Hash_Field struct {
+type CoinpaymentsTransaction struct {
+	Id              string
+	UserId          []byte
+	Address         string
+	AmountNumeric   int64
+	ReceivedNumeric int64
+	Status          int
+	Key             string
+	Timeout         int
+	CreatedAt       time.Time
+}
+
+func (CoinpaymentsTransaction) _Table() string { return "coinpayments_transactions" }
+
+type CoinpaymentsTransaction_Update_Fields struct {
+	ReceivedNumeric CoinpaymentsTransaction_ReceivedNumeric_Field
+	Status          CoinpaymentsTransaction_Status_Field
+}
+
+type CoinpaymentsTransaction_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Node_Hash(v string) Node_Hash_Field {
-	return Node_Hash_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_Id(v string) CoinpaymentsTransaction_Id_Field {
+	return CoinpaymentsTransaction_Id_Field{_set: true, _value: v}
 }
 
-func (f Node_Hash_Field) value() interface{} {
+func (f CoinpaymentsTransaction_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Hash_Field) _Column() string { return "hash" }
+func (CoinpaymentsTransaction_Id_Field) _Column() string { return "id" }
 
-type Node_Timestamp_Field struct {
+type CoinpaymentsTransaction_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Node_Timestamp(v time.Time) Node_Timestamp_Field {
-	return Node_Timestamp_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_UserId(v []byte) CoinpaymentsTransaction_UserId_Field {
+	return CoinpaymentsTransaction_UserId_Field{_set: true, _value: v}
 }
 
-func (f Node_Timestamp_Field) value() interface{} {
+func (f CoinpaymentsTransaction_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Timestamp_Field) _Column() string { return "timestamp" }
+func (CoinpaymentsTransaction_UserId_Field) _Column() string { return "user_id" }
 
-type Node_Release_Field struct {
+type CoinpaymentsTransaction_Address_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value string
 }
 
-func Node_Release(v bool) Node_Release_Field {
-	return Node_Release_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_Address(v string) CoinpaymentsTransaction_Address_Field {
+	return CoinpaymentsTransaction_Address_Field{_set: true, _value: v}
 }
 
-func (f Node_Release_Field) value() interface{} {
+func (f CoinpaymentsTransaction_Address_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Release_Field) _Column() string { return "release" }
+func (CoinpaymentsTransaction_Address_Field) _Column() string { return "address" }
 
-type Node_Latency90_Field struct {
+type CoinpaymentsTransaction_AmountNumeric_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func Node_Latency90(v int64) Node_Latency90_Field {
-	return Node_Latency90_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_AmountNumeric(v int64) CoinpaymentsTransaction_AmountNumeric_Field {
+	return CoinpaymentsTransaction_AmountNumeric_Field{_set: true, _value: v}
 }
 
-func (f Node_Latency90_Field) value() interface{} {
+func (f CoinpaymentsTransaction_AmountNumeric_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Latency90_Field) _Column() string { return "latency_90" }
+func (CoinpaymentsTransaction_AmountNumeric_Field) _Column() string { return "amount_numeric" }
 
-type Node_VettedAt_Field struct {
+type CoinpaymentsTransaction_ReceivedNumeric_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value int64
 }
 
-func Node_VettedAt(v time.Time) Node_VettedAt_Field {
-	return Node_VettedAt_Field{_set: true, _value: &v}
+func CoinpaymentsTransaction_ReceivedNumeric(v int64) CoinpaymentsTransaction_ReceivedNumeric_Field {
+	return CoinpaymentsTransaction_ReceivedNumeric_Field{_set: true, _value: v}
 }
 
-func Node_VettedAt_Raw(v *time.Time) Node_VettedAt_Field {
-	if v == nil {
-		return Node_VettedAt_Null()
+func (f CoinpaymentsTransaction_ReceivedNumeric_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Node_VettedAt(*v)
+	return f._value
 }
 
-func Node_VettedAt_Null() Node_VettedAt_Field {
-	return Node_VettedAt_Field{_set: true, _null: true}
+func (CoinpaymentsTransaction_ReceivedNumeric_Field) _Column() string { return "received_numeric" }
+
+type CoinpaymentsTransaction_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (f Node_VettedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func CoinpaymentsTransaction_Status(v int) CoinpaymentsTransaction_Status_Field {
+	return CoinpaymentsTransaction_Status_Field{_set: true, _value: v}
+}
 
-func (f Node_VettedAt_Field) value() interface{} {
+func (f CoinpaymentsTransaction_Status_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_VettedAt_Field) _Column() string { return "vetted_at" }
+func (CoinpaymentsTransaction_Status_Field) _Column() string { return "status" }
 
-type Node_CreatedAt_Field struct {
+type CoinpaymentsTransaction_Key_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func Node_CreatedAt(v time.Time) Node_CreatedAt_Field {
-	return Node_CreatedAt_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_Key(v string) CoinpaymentsTransaction_Key_Field {
+	return CoinpaymentsTransaction_Key_Field{_set: true, _value: v}
 }
 
-func (f Node_CreatedAt_Field) value() interface{} {
+func (f CoinpaymentsTransaction_Key_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_CreatedAt_Field) _Column() string { return "created_at" }
+func (CoinpaymentsTransaction_Key_Field) _Column() string { return "key" }
 
-type Node_UpdatedAt_Field struct {
+type CoinpaymentsTransaction_Timeout_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int
 }
 
-func Node_UpdatedAt(v time.Time) Node_UpdatedAt_Field {
-	return Node_UpdatedAt_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_Timeout(v int) CoinpaymentsTransaction_Timeout_Field {
+	return CoinpaymentsTransaction_Timeout_Field{_set: true, _value: v}
 }
 
-func (f Node_UpdatedAt_Field) value() interface{} {
+func (f CoinpaymentsTransaction_Timeout_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (CoinpaymentsTransaction_Timeout_Field) _Column() string { return "timeout" }
 
-type Node_LastContactSuccess_Field struct {
+type CoinpaymentsTransaction_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func Node_LastContactSuccess(v time.Time) Node_LastContactSuccess_Field {
-	return Node_LastContactSuccess_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_CreatedAt(v time.Time) CoinpaymentsTransaction_CreatedAt_Field {
+	return CoinpaymentsTransaction_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_LastContactSuccess_Field) value() interface{} {
+func (f CoinpaymentsTransaction_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastContactSuccess_Field) _Column() string { return "last_contact_success" }
+func (CoinpaymentsTransaction_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Node_LastContactFailure_Field struct {
+type EmailDelivery struct {
+	Id        []byte
+	MessageId string
+	Recipient string
+	Template  string
+	Subject   string
+	Status    int
+	Reason    *string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (EmailDelivery) _Table() string { return "email_deliveries" }
+
+type EmailDelivery_Create_Fields struct {
+	Reason    EmailDelivery_Reason_Field
+	CreatedAt EmailDelivery_CreatedAt_Field
+	UpdatedAt EmailDelivery_UpdatedAt_Field
+}
+
+type EmailDelivery_Update_Fields struct {
+	Status    EmailDelivery_Status_Field
+	Reason    EmailDelivery_Reason_Field
+	UpdatedAt EmailDelivery_UpdatedAt_Field
+}
+
+type EmailDelivery_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Node_LastContactFailure(v time.Time) Node_LastContactFailure_Field {
-	return Node_LastContactFailure_Field{_set: true, _value: v}
+func EmailDelivery_Id(v []byte) EmailDelivery_Id_Field {
+	return EmailDelivery_Id_Field{_set: true, _value: v}
 }
 
-func (f Node_LastContactFailure_Field) value() interface{} {
+func (f EmailDelivery_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastContactFailure_Field) _Column() string { return "last_contact_failure" }
+func (EmailDelivery_Id_Field) _Column() string { return "id" }
 
-type Node_Disqualified_Field struct {
+type EmailDelivery_MessageId_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value string
 }
 
-func Node_Disqualified(v time.Time) Node_Disqualified_Field {
-	return Node_Disqualified_Field{_set: true, _value: &v}
+func EmailDelivery_MessageId(v string) EmailDelivery_MessageId_Field {
+	return EmailDelivery_MessageId_Field{_set: true, _value: v}
 }
 
-func Node_Disqualified_Raw(v *time.Time) Node_Disqualified_Field {
-	if v == nil {
-		return Node_Disqualified_Null()
+func (f EmailDelivery_MessageId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Node_Disqualified(*v)
+	return f._value
 }
 
-func Node_Disqualified_Null() Node_Disqualified_Field {
-	return Node_Disqualified_Field{_set: true, _null: true}
+func (EmailDelivery_MessageId_Field) _Column() string { return "message_id" }
+
+type EmailDelivery_Recipient_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f Node_Disqualified_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func EmailDelivery_Recipient(v string) EmailDelivery_Recipient_Field {
+	return EmailDelivery_Recipient_Field{_set: true, _value: v}
+}
 
-func (f Node_Disqualified_Field) value() interface{} {
+func (f EmailDelivery_Recipient_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Disqualified_Field) _Column() string { return "disqualified" }
+func (EmailDelivery_Recipient_Field) _Column() string { return "recipient" }
 
-type Node_DisqualificationReason_Field struct {
+type EmailDelivery_Template_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value string
 }
 
-func Node_DisqualificationReason(v int) Node_DisqualificationReason_Field {
-	return Node_DisqualificationReason_Field{_set: true, _value: &v}
+func EmailDelivery_Template(v string) EmailDelivery_Template_Field {
+	return EmailDelivery_Template_Field{_set: true, _value: v}
 }
 
-func Node_DisqualificationReason_Raw(v *int) Node_DisqualificationReason_Field {
-	if v == nil {
-		return Node_DisqualificationReason_Null()
+func (f EmailDelivery_Template_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Node_DisqualificationReason(*v)
+	return f._value
 }
 
-func Node_DisqualificationReason_Null() Node_DisqualificationReason_Field {
-	return Node_DisqualificationReason_Field{_set: true, _null: true}
+func (EmailDelivery_Template_Field) _Column() string { return "template" }
+
+type EmailDelivery_Subject_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f Node_DisqualificationReason_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func EmailDelivery_Subject(v string) EmailDelivery_Subject_Field {
+	return EmailDelivery_Subject_Field{_set: true, _value: v}
 }
 
-func (f Node_DisqualificationReason_Field) value() interface{} {
+func (f EmailDelivery_Subject_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_DisqualificationReason_Field) _Column() string { return "disqualification_reason" }
+func (EmailDelivery_Subject_Field) _Column() string { return "subject" }
 
-type Node_UnknownAuditSuspended_Field struct {
+type EmailDelivery_Status_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value int
 }
 
-func Node_UnknownAuditSuspended(v time.Time) Node_UnknownAuditSuspended_Field {
-	return Node_UnknownAuditSuspended_Field{_set: true, _value: &v}
+func EmailDelivery_Status(v int) EmailDelivery_Status_Field {
+	return EmailDelivery_Status_Field{_set: true, _value: v}
 }
 
-func Node_UnknownAuditSuspended_Raw(v *time.Time) Node_UnknownAuditSuspended_Field {
-	if v == nil {
-		return Node_UnknownAuditSuspended_Null()
-	}
-	return Node_UnknownAuditSuspended(*v)
+func (f EmailDelivery_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-func Node_UnknownAuditSuspended_Null() Node_UnknownAuditSuspended_Field {
-	return Node_UnknownAuditSuspended_Field{_set: true, _null: true}
+func (EmailDelivery_Status_Field) _Column() string { return "status" }
+
+type EmailDelivery_Reason_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
 }
 
-func (f Node_UnknownAuditSuspended_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func EmailDelivery_Reason(v string) EmailDelivery_Reason_Field {
+	return EmailDelivery_Reason_Field{_set: true, _value: &v}
+}
 
-func (f Node_UnknownAuditSuspended_Field) value() interface{} {
+func EmailDelivery_Reason_Raw(v *string) EmailDelivery_Reason_Field {
+	if v == nil {
+		return EmailDelivery_Reason_Null()
+	}
+	return EmailDelivery_Reason(*v)
+}
+
+func EmailDelivery_Reason_Null() EmailDelivery_Reason_Field {
+	return EmailDelivery_Reason_Field{_set: true, _null: true}
+}
+
+func (f EmailDelivery_Reason_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f EmailDelivery_Reason_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UnknownAuditSuspended_Field) _Column() string { return "unknown_audit_suspended" }
+func (EmailDelivery_Reason_Field) _Column() string { return "reason" }
 
-type Node_OfflineSuspended_Field struct {
+type EmailDelivery_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value time.Time
 }
 
-func Node_OfflineSuspended(v time.Time) Node_OfflineSuspended_Field {
-	return Node_OfflineSuspended_Field{_set: true, _value: &v}
+func EmailDelivery_CreatedAt(v time.Time) EmailDelivery_CreatedAt_Field {
+	return EmailDelivery_CreatedAt_Field{_set: true, _value: v}
 }
 
-func Node_OfflineSuspended_Raw(v *time.Time) Node_OfflineSuspended_Field {
-	if v == nil {
-		return Node_OfflineSuspended_Null()
+func (f EmailDelivery_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Node_OfflineSuspended(*v)
+	return f._value
 }
 
-func Node_OfflineSuspended_Null() Node_OfflineSuspended_Field {
-	return Node_OfflineSuspended_Field{_set: true, _null: true}
+func (EmailDelivery_CreatedAt_Field) _Column() string { return "created_at" }
+
+type EmailDelivery_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f Node_OfflineSuspended_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func EmailDelivery_UpdatedAt(v time.Time) EmailDelivery_UpdatedAt_Field {
+	return EmailDelivery_UpdatedAt_Field{_set: true, _value: v}
+}
 
-func (f Node_OfflineSuspended_Field) value() interface{} {
+func (f EmailDelivery_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_OfflineSuspended_Field) _Column() string { return "offline_suspended" }
+func (EmailDelivery_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type Node_UnderReview_Field struct {
-	_set   bool
-	_null  bool
-	_value *time.Time
+type FeatureFlag struct {
+	Name        string
+	Description string
+	Enabled     bool
+	Percentage  int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
 
-func Node_UnderReview(v time.Time) Node_UnderReview_Field {
-	return Node_UnderReview_Field{_set: true, _value: &v}
+func (FeatureFlag) _Table() string { return "feature_flags" }
+
+type FeatureFlag_Create_Fields struct {
 }
 
-func Node_UnderReview_Raw(v *time.Time) Node_UnderReview_Field {
-	if v == nil {
-		return Node_UnderReview_Null()
-	}
-	return Node_UnderReview(*v)
+type FeatureFlag_Update_Fields struct {
+	Description FeatureFlag_Description_Field
+	Enabled     FeatureFlag_Enabled_Field
+	Percentage  FeatureFlag_Percentage_Field
+	UpdatedAt   FeatureFlag_UpdatedAt_Field
 }
 
-func Node_UnderReview_Null() Node_UnderReview_Field {
-	return Node_UnderReview_Field{_set: true, _null: true}
+type FeatureFlag_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f Node_UnderReview_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func FeatureFlag_Name(v string) FeatureFlag_Name_Field {
+	return FeatureFlag_Name_Field{_set: true, _value: v}
+}
 
-func (f Node_UnderReview_Field) value() interface{} {
+func (f FeatureFlag_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UnderReview_Field) _Column() string { return "under_review" }
+func (FeatureFlag_Name_Field) _Column() string { return "name" }
 
-type Node_ExitInitiatedAt_Field struct {
+type FeatureFlag_Description_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value string
 }
 
-func Node_ExitInitiatedAt(v time.Time) Node_ExitInitiatedAt_Field {
-	return Node_ExitInitiatedAt_Field{_set: true, _value: &v}
+func FeatureFlag_Description(v string) FeatureFlag_Description_Field {
+	return FeatureFlag_Description_Field{_set: true, _value: v}
 }
 
-func Node_ExitInitiatedAt_Raw(v *time.Time) Node_ExitInitiatedAt_Field {
-	if v == nil {
-		return Node_ExitInitiatedAt_Null()
+func (f FeatureFlag_Description_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Node_ExitInitiatedAt(*v)
+	return f._value
 }
 
-func Node_ExitInitiatedAt_Null() Node_ExitInitiatedAt_Field {
-	return Node_ExitInitiatedAt_Field{_set: true, _null: true}
+func (FeatureFlag_Description_Field) _Column() string { return "description" }
+
+type FeatureFlag_Enabled_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
 }
 
-func (f Node_ExitInitiatedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func FeatureFlag_Enabled(v bool) FeatureFlag_Enabled_Field {
+	return FeatureFlag_Enabled_Field{_set: true, _value: v}
+}
 
-func (f Node_ExitInitiatedAt_Field) value() interface{} {
+func (f FeatureFlag_Enabled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_ExitInitiatedAt_Field) _Column() string { return "exit_initiated_at" }
+func (FeatureFlag_Enabled_Field) _Column() string { return "enabled" }
 
-type Node_ExitLoopCompletedAt_Field struct {
+type FeatureFlag_Percentage_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value int
 }
 
-func Node_ExitLoopCompletedAt(v time.Time) Node_ExitLoopCompletedAt_Field {
-	return Node_ExitLoopCompletedAt_Field{_set: true, _value: &v}
+func FeatureFlag_Percentage(v int) FeatureFlag_Percentage_Field {
+	return FeatureFlag_Percentage_Field{_set: true, _value: v}
 }
 
-func Node_ExitLoopCompletedAt_Raw(v *time.Time) Node_ExitLoopCompletedAt_Field {
-	if v == nil {
-		return Node_ExitLoopCompletedAt_Null()
+func (f FeatureFlag_Percentage_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Node_ExitLoopCompletedAt(*v)
+	return f._value
 }
 
-func Node_ExitLoopCompletedAt_Null() Node_ExitLoopCompletedAt_Field {
-	return Node_ExitLoopCompletedAt_Field{_set: true, _null: true}
+func (FeatureFlag_Percentage_Field) _Column() string { return "percentage" }
+
+type FeatureFlag_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f Node_ExitLoopCompletedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func FeatureFlag_CreatedAt(v time.Time) FeatureFlag_CreatedAt_Field {
+	return FeatureFlag_CreatedAt_Field{_set: true, _value: v}
+}
 
-func (f Node_ExitLoopCompletedAt_Field) value() interface{} {
+func (f FeatureFlag_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_ExitLoopCompletedAt_Field) _Column() string { return "exit_loop_completed_at" }
+func (FeatureFlag_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Node_ExitFinishedAt_Field struct {
+type FeatureFlag_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value time.Time
 }
 
-func Node_ExitFinishedAt(v time.Time) Node_ExitFinishedAt_Field {
-	return Node_ExitFinishedAt_Field{_set: true, _value: &v}
+func FeatureFlag_UpdatedAt(v time.Time) FeatureFlag_UpdatedAt_Field {
+	return FeatureFlag_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func Node_ExitFinishedAt_Raw(v *time.Time) Node_ExitFinishedAt_Field {
-	if v == nil {
-		return Node_ExitFinishedAt_Null()
+func (f FeatureFlag_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Node_ExitFinishedAt(*v)
+	return f._value
 }
 
-func Node_ExitFinishedAt_Null() Node_ExitFinishedAt_Field {
-	return Node_ExitFinishedAt_Field{_set: true, _null: true}
+func (FeatureFlag_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type GatewayCredential struct {
+	Id          []byte
+	ProjectId   []byte
+	ApiKeyId    []byte
+	Name        string
+	AccessKeyId string
+	Endpoint    string
+	Tail        []byte
+	CreatedBy   []byte
+	LastUsedAt  *time.Time
+	RevokedAt   *time.Time
+	CreatedAt   time.Time
 }
 
-func (f Node_ExitFinishedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (GatewayCredential) _Table() string { return "gateway_credentials" }
 
-func (f Node_ExitFinishedAt_Field) value() interface{} {
+type GatewayCredential_Create_Fields struct {
+	LastUsedAt GatewayCredential_LastUsedAt_Field
+	RevokedAt  GatewayCredential_RevokedAt_Field
+}
+
+type GatewayCredential_Update_Fields struct {
+	LastUsedAt GatewayCredential_LastUsedAt_Field
+	RevokedAt  GatewayCredential_RevokedAt_Field
+}
+
+type GatewayCredential_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GatewayCredential_Id(v []byte) GatewayCredential_Id_Field {
+	return GatewayCredential_Id_Field{_set: true, _value: v}
+}
+
+func (f GatewayCredential_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_ExitFinishedAt_Field) _Column() string { return "exit_finished_at" }
+func (GatewayCredential_Id_Field) _Column() string { return "id" }
 
-type Node_ExitSuccess_Field struct {
+type GatewayCredential_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value []byte
 }
 
-func Node_ExitSuccess(v bool) Node_ExitSuccess_Field {
-	return Node_ExitSuccess_Field{_set: true, _value: v}
+func GatewayCredential_ProjectId(v []byte) GatewayCredential_ProjectId_Field {
+	return GatewayCredential_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Node_ExitSuccess_Field) value() interface{} {
+func (f GatewayCredential_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_ExitSuccess_Field) _Column() string { return "exit_success" }
+func (GatewayCredential_ProjectId_Field) _Column() string { return "project_id" }
 
-type Node_Contained_Field struct {
+type GatewayCredential_ApiKeyId_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value []byte
 }
 
-func Node_Contained(v time.Time) Node_Contained_Field {
-	return Node_Contained_Field{_set: true, _value: &v}
+func GatewayCredential_ApiKeyId(v []byte) GatewayCredential_ApiKeyId_Field {
+	return GatewayCredential_ApiKeyId_Field{_set: true, _value: v}
 }
 
-func Node_Contained_Raw(v *time.Time) Node_Contained_Field {
-	if v == nil {
-		return Node_Contained_Null()
+func (f GatewayCredential_ApiKeyId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Node_Contained(*v)
+	return f._value
 }
 
-func Node_Contained_Null() Node_Contained_Field {
-	return Node_Contained_Field{_set: true, _null: true}
+func (GatewayCredential_ApiKeyId_Field) _Column() string { return "api_key_id" }
+
+type GatewayCredential_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f Node_Contained_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func GatewayCredential_Name(v string) GatewayCredential_Name_Field {
+	return GatewayCredential_Name_Field{_set: true, _value: v}
+}
 
-func (f Node_Contained_Field) value() interface{} {
+func (f GatewayCredential_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Contained_Field) _Column() string { return "contained" }
+func (GatewayCredential_Name_Field) _Column() string { return "name" }
 
-type Node_LastOfflineEmail_Field struct {
+type GatewayCredential_AccessKeyId_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value string
 }
 
-func Node_LastOfflineEmail(v time.Time) Node_LastOfflineEmail_Field {
-	return Node_LastOfflineEmail_Field{_set: true, _value: &v}
+func GatewayCredential_AccessKeyId(v string) GatewayCredential_AccessKeyId_Field {
+	return GatewayCredential_AccessKeyId_Field{_set: true, _value: v}
 }
 
-func Node_LastOfflineEmail_Raw(v *time.Time) Node_LastOfflineEmail_Field {
-	if v == nil {
-		return Node_LastOfflineEmail_Null()
+func (f GatewayCredential_AccessKeyId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Node_LastOfflineEmail(*v)
+	return f._value
 }
 
-func Node_LastOfflineEmail_Null() Node_LastOfflineEmail_Field {
-	return Node_LastOfflineEmail_Field{_set: true, _null: true}
+func (GatewayCredential_AccessKeyId_Field) _Column() string { return "access_key_id" }
+
+type GatewayCredential_Endpoint_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f Node_LastOfflineEmail_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func GatewayCredential_Endpoint(v string) GatewayCredential_Endpoint_Field {
+	return GatewayCredential_Endpoint_Field{_set: true, _value: v}
+}
 
-func (f Node_LastOfflineEmail_Field) value() interface{} {
+func (f GatewayCredential_Endpoint_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastOfflineEmail_Field) _Column() string { return "last_offline_email" }
+func (GatewayCredential_Endpoint_Field) _Column() string { return "endpoint" }
 
-type Node_LastSoftwareUpdateEmail_Field struct {
+type GatewayCredential_Tail_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value []byte
 }
 
-func Node_LastSoftwareUpdateEmail(v time.Time) Node_LastSoftwareUpdateEmail_Field {
-	return Node_LastSoftwareUpdateEmail_Field{_set: true, _value: &v}
+func GatewayCredential_Tail(v []byte) GatewayCredential_Tail_Field {
+	return GatewayCredential_Tail_Field{_set: true, _value: v}
 }
 
-func Node_LastSoftwareUpdateEmail_Raw(v *time.Time) Node_LastSoftwareUpdateEmail_Field {
-	if v == nil {
-		return Node_LastSoftwareUpdateEmail_Null()
+func (f GatewayCredential_Tail_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Node_LastSoftwareUpdateEmail(*v)
+	return f._value
 }
 
-func Node_LastSoftwareUpdateEmail_Null() Node_LastSoftwareUpdateEmail_Field {
-	return Node_LastSoftwareUpdateEmail_Field{_set: true, _null: true}
+func (GatewayCredential_Tail_Field) _Column() string { return "tail" }
+
+type GatewayCredential_CreatedBy_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f Node_LastSoftwareUpdateEmail_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func GatewayCredential_CreatedBy(v []byte) GatewayCredential_CreatedBy_Field {
+	return GatewayCredential_CreatedBy_Field{_set: true, _value: v}
 }
 
-func (f Node_LastSoftwareUpdateEmail_Field) value() interface{} {
+func (f GatewayCredential_CreatedBy_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastSoftwareUpdateEmail_Field) _Column() string { return "last_software_update_email" }
+func (GatewayCredential_CreatedBy_Field) _Column() string { return "created_by" }
 
-type Node_NoiseProto_Field struct {
+type GatewayCredential_LastUsedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value *time.Time
 }
 
-func Node_NoiseProto(v int) Node_NoiseProto_Field {
-	return Node_NoiseProto_Field{_set: true, _value: &v}
+func GatewayCredential_LastUsedAt(v time.Time) GatewayCredential_LastUsedAt_Field {
+	return GatewayCredential_LastUsedAt_Field{_set: true, _value: &v}
 }
 
-func Node_NoiseProto_Raw(v *int) Node_NoiseProto_Field {
+func GatewayCredential_LastUsedAt_Raw(v *time.Time) GatewayCredential_LastUsedAt_Field {
 	if v == nil {
-		return Node_NoiseProto_Null()
+		return GatewayCredential_LastUsedAt_Null()
 	}
-	return Node_NoiseProto(*v)
+	return GatewayCredential_LastUsedAt(*v)
 }
 
-func Node_NoiseProto_Null() Node_NoiseProto_Field {
-	return Node_NoiseProto_Field{_set: true, _null: true}
+func GatewayCredential_LastUsedAt_Null() GatewayCredential_LastUsedAt_Field {
+	return GatewayCredential_LastUsedAt_Field{_set: true, _null: true}
 }
 
-func (f Node_NoiseProto_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f GatewayCredential_LastUsedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f Node_NoiseProto_Field) value() interface{} {
+func (f GatewayCredential_LastUsedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_NoiseProto_Field) _Column() string { return "noise_proto" }
+func (GatewayCredential_LastUsedAt_Field) _Column() string { return "last_used_at" }
 
-type Node_NoisePublicKey_Field struct {
+type GatewayCredential_RevokedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *time.Time
 }
 
-func Node_NoisePublicKey(v []byte) Node_NoisePublicKey_Field {
-	return Node_NoisePublicKey_Field{_set: true, _value: v}
+func GatewayCredential_RevokedAt(v time.Time) GatewayCredential_RevokedAt_Field {
+	return GatewayCredential_RevokedAt_Field{_set: true, _value: &v}
 }
 
-func Node_NoisePublicKey_Raw(v []byte) Node_NoisePublicKey_Field {
+func GatewayCredential_RevokedAt_Raw(v *time.Time) GatewayCredential_RevokedAt_Field {
 	if v == nil {
-		return Node_NoisePublicKey_Null()
+		return GatewayCredential_RevokedAt_Null()
 	}
-	return Node_NoisePublicKey(v)
+	return GatewayCredential_RevokedAt(*v)
 }
 
-func Node_NoisePublicKey_Null() Node_NoisePublicKey_Field {
-	return Node_NoisePublicKey_Field{_set: true, _null: true}
+func GatewayCredential_RevokedAt_Null() GatewayCredential_RevokedAt_Field {
+	return GatewayCredential_RevokedAt_Field{_set: true, _null: true}
 }
 
-func (f Node_NoisePublicKey_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f GatewayCredential_RevokedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f Node_NoisePublicKey_Field) value() interface{} {
+func (f GatewayCredential_RevokedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_NoisePublicKey_Field) _Column() string { return "noise_public_key" }
+func (GatewayCredential_RevokedAt_Field) _Column() string { return "revoked_at" }
 
-type Node_DebounceLimit_Field struct {
+type GatewayCredential_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func Node_DebounceLimit(v int) Node_DebounceLimit_Field {
-	return Node_DebounceLimit_Field{_set: true, _value: v}
+func GatewayCredential_CreatedAt(v time.Time) GatewayCredential_CreatedAt_Field {
+	return GatewayCredential_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_DebounceLimit_Field) value() interface{} {
+func (f GatewayCredential_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_DebounceLimit_Field) _Column() string { return "debounce_limit" }
+func (GatewayCredential_CreatedAt_Field) _Column() string { return "created_at" }
 
-type NodeApiVersion struct {
-	Id         []byte
-	ApiVersion int
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+type GracefulExitProgress struct {
+	NodeId            []byte
+	BytesTransferred  int64
+	PiecesTransferred int64
+	PiecesFailed      int64
+	UpdatedAt         time.Time
 }
 
-func (NodeApiVersion) _Table() string { return "node_api_versions" }
+func (GracefulExitProgress) _Table() string { return "graceful_exit_progress" }
 
-type NodeApiVersion_Update_Fields struct {
-	ApiVersion NodeApiVersion_ApiVersion_Field
+type GracefulExitProgress_Update_Fields struct {
+	BytesTransferred  GracefulExitProgress_BytesTransferred_Field
+	PiecesTransferred GracefulExitProgress_PiecesTransferred_Field
+	PiecesFailed      GracefulExitProgress_PiecesFailed_Field
 }
 
-type NodeApiVersion_Id_Field struct {
+type GracefulExitProgress_NodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func NodeApiVersion_Id(v []byte) NodeApiVersion_Id_Field {
-	return NodeApiVersion_Id_Field{_set: true, _value: v}
+func GracefulExitProgress_NodeId(v []byte) GracefulExitProgress_NodeId_Field {
+	return GracefulExitProgress_NodeId_Field{_set: true, _value: v}
 }
 
-func (f NodeApiVersion_Id_Field) value() interface{} {
+func (f GracefulExitProgress_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeApiVersion_Id_Field) _Column() string { return "id" }
+func (GracefulExitProgress_NodeId_Field) _Column() string { return "node_id" }
 
-type NodeApiVersion_ApiVersion_Field struct {
+type GracefulExitProgress_BytesTransferred_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value int64
 }
 
-func NodeApiVersion_ApiVersion(v int) NodeApiVersion_ApiVersion_Field {
-	return NodeApiVersion_ApiVersion_Field{_set: true, _value: v}
+func GracefulExitProgress_BytesTransferred(v int64) GracefulExitProgress_BytesTransferred_Field {
+	return GracefulExitProgress_BytesTransferred_Field{_set: true, _value: v}
 }
 
-func (f NodeApiVersion_ApiVersion_Field) value() interface{} {
+func (f GracefulExitProgress_BytesTransferred_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeApiVersion_ApiVersion_Field) _Column() string { return "api_version" }
+func (GracefulExitProgress_BytesTransferred_Field) _Column() string { return "bytes_transferred" }
 
-type NodeApiVersion_CreatedAt_Field struct {
+type GracefulExitProgress_PiecesTransferred_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func NodeApiVersion_CreatedAt(v time.Time) NodeApiVersion_CreatedAt_Field {
-	return NodeApiVersion_CreatedAt_Field{_set: true, _value: v}
+func GracefulExitProgress_PiecesTransferred(v int64) GracefulExitProgress_PiecesTransferred_Field {
+	return GracefulExitProgress_PiecesTransferred_Field{_set: true, _value: v}
 }
 
-func (f NodeApiVersion_CreatedAt_Field) value() interface{} {
+func (f GracefulExitProgress_PiecesTransferred_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeApiVersion_CreatedAt_Field) _Column() string { return "created_at" }
+func (GracefulExitProgress_PiecesTransferred_Field) _Column() string { return "pieces_transferred" }
 
-type NodeApiVersion_UpdatedAt_Field struct {
+type GracefulExitProgress_PiecesFailed_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExitProgress_PiecesFailed(v int64) GracefulExitProgress_PiecesFailed_Field {
+	return GracefulExitProgress_PiecesFailed_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_PiecesFailed_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_PiecesFailed_Field) _Column() string { return "pieces_failed" }
+
+type GracefulExitProgress_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func NodeApiVersion_UpdatedAt(v time.Time) NodeApiVersion_UpdatedAt_Field {
-	return NodeApiVersion_UpdatedAt_Field{_set: true, _value: v}
+func GracefulExitProgress_UpdatedAt(v time.Time) GracefulExitProgress_UpdatedAt_Field {
+	return GracefulExitProgress_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f NodeApiVersion_UpdatedAt_Field) value() interface{} {
+func (f GracefulExitProgress_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeApiVersion_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (GracefulExitProgress_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type NodeEvent struct {
-	Id            []byte
-	Email         string
-	NodeId        []byte
-	Event         int
-	CreatedAt     time.Time
-	LastAttempted *time.Time
-	EmailSent     *time.Time
+type GracefulExitSegmentTransfer struct {
+	NodeId              []byte
+	StreamId            []byte
+	Position            uint64
+	PieceNum            int
+	RootPieceId         []byte
+	DurabilityRatio     float64
+	QueuedAt            time.Time
+	RequestedAt         *time.Time
+	LastFailedAt        *time.Time
+	LastFailedCode      *int
+	FailedCount         *int
+	FinishedAt          *time.Time
+	OrderLimitSendCount int
 }
 
-func (NodeEvent) _Table() string { return "node_events" }
+func (GracefulExitSegmentTransfer) _Table() string { return "graceful_exit_segment_transfer_queue" }
 
-type NodeEvent_Create_Fields struct {
-	CreatedAt     NodeEvent_CreatedAt_Field
-	LastAttempted NodeEvent_LastAttempted_Field
-	EmailSent     NodeEvent_EmailSent_Field
+type GracefulExitSegmentTransfer_Create_Fields struct {
+	RootPieceId         GracefulExitSegmentTransfer_RootPieceId_Field
+	RequestedAt         GracefulExitSegmentTransfer_RequestedAt_Field
+	LastFailedAt        GracefulExitSegmentTransfer_LastFailedAt_Field
+	LastFailedCode      GracefulExitSegmentTransfer_LastFailedCode_Field
+	FailedCount         GracefulExitSegmentTransfer_FailedCount_Field
+	FinishedAt          GracefulExitSegmentTransfer_FinishedAt_Field
+	OrderLimitSendCount GracefulExitSegmentTransfer_OrderLimitSendCount_Field
 }
 
-type NodeEvent_Update_Fields struct {
-	LastAttempted NodeEvent_LastAttempted_Field
-	EmailSent     NodeEvent_EmailSent_Field
+type GracefulExitSegmentTransfer_Update_Fields struct {
+	DurabilityRatio     GracefulExitSegmentTransfer_DurabilityRatio_Field
+	RequestedAt         GracefulExitSegmentTransfer_RequestedAt_Field
+	LastFailedAt        GracefulExitSegmentTransfer_LastFailedAt_Field
+	LastFailedCode      GracefulExitSegmentTransfer_LastFailedCode_Field
+	FailedCount         GracefulExitSegmentTransfer_FailedCount_Field
+	FinishedAt          GracefulExitSegmentTransfer_FinishedAt_Field
+	OrderLimitSendCount GracefulExitSegmentTransfer_OrderLimitSendCount_Field
 }
 
-type NodeEvent_Id_Field struct {
+type GracefulExitSegmentTransfer_NodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func NodeEvent_Id(v []byte) NodeEvent_Id_Field {
-	return NodeEvent_Id_Field{_set: true, _value: v}
+func GracefulExitSegmentTransfer_NodeId(v []byte) GracefulExitSegmentTransfer_NodeId_Field {
+	return GracefulExitSegmentTransfer_NodeId_Field{_set: true, _value: v}
 }
 
-func (f NodeEvent_Id_Field) value() interface{} {
+func (f GracefulExitSegmentTransfer_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_Id_Field) _Column() string { return "id" }
+func (GracefulExitSegmentTransfer_NodeId_Field) _Column() string { return "node_id" }
 
-type NodeEvent_Email_Field struct {
+type GracefulExitSegmentTransfer_StreamId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func NodeEvent_Email(v string) NodeEvent_Email_Field {
-	return NodeEvent_Email_Field{_set: true, _value: v}
+func GracefulExitSegmentTransfer_StreamId(v []byte) GracefulExitSegmentTransfer_StreamId_Field {
+	return GracefulExitSegmentTransfer_StreamId_Field{_set: true, _value: v}
 }
 
-func (f NodeEvent_Email_Field) value() interface{} {
+func (f GracefulExitSegmentTransfer_StreamId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_Email_Field) _Column() string { return "email" }
+func (GracefulExitSegmentTransfer_StreamId_Field) _Column() string { return "stream_id" }
 
-type NodeEvent_NodeId_Field struct {
+type GracefulExitSegmentTransfer_Position_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint64
 }
 
-func NodeEvent_NodeId(v []byte) NodeEvent_NodeId_Field {
-	return NodeEvent_NodeId_Field{_set: true, _value: v}
+func GracefulExitSegmentTransfer_Position(v uint64) GracefulExitSegmentTransfer_Position_Field {
+	return GracefulExitSegmentTransfer_Position_Field{_set: true, _value: v}
 }
 
-func (f NodeEvent_NodeId_Field) value() interface{} {
+func (f GracefulExitSegmentTransfer_Position_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_NodeId_Field) _Column() string { return "node_id" }
+func (GracefulExitSegmentTransfer_Position_Field) _Column() string { return "position" }
 
-type NodeEvent_Event_Field struct {
+type GracefulExitSegmentTransfer_PieceNum_Field struct {
 	_set   bool
 	_null  bool
 	_value int
 }
 
-func NodeEvent_Event(v int) NodeEvent_Event_Field {
-	return NodeEvent_Event_Field{_set: true, _value: v}
+func GracefulExitSegmentTransfer_PieceNum(v int) GracefulExitSegmentTransfer_PieceNum_Field {
+	return GracefulExitSegmentTransfer_PieceNum_Field{_set: true, _value: v}
 }
 
-func (f NodeEvent_Event_Field) value() interface{} {
+func (f GracefulExitSegmentTransfer_PieceNum_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_Event_Field) _Column() string { return "event" }
+func (GracefulExitSegmentTransfer_PieceNum_Field) _Column() string { return "piece_num" }
 
-type NodeEvent_CreatedAt_Field struct {
+type GracefulExitSegmentTransfer_RootPieceId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func NodeEvent_CreatedAt(v time.Time) NodeEvent_CreatedAt_Field {
-	return NodeEvent_CreatedAt_Field{_set: true, _value: v}
+func GracefulExitSegmentTransfer_RootPieceId(v []byte) GracefulExitSegmentTransfer_RootPieceId_Field {
+	return GracefulExitSegmentTransfer_RootPieceId_Field{_set: true, _value: v}
 }
 
-func (f NodeEvent_CreatedAt_Field) value() interface{} {
+func GracefulExitSegmentTransfer_RootPieceId_Raw(v []byte) GracefulExitSegmentTransfer_RootPieceId_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_RootPieceId_Null()
+	}
+	return GracefulExitSegmentTransfer_RootPieceId(v)
+}
+
+func GracefulExitSegmentTransfer_RootPieceId_Null() GracefulExitSegmentTransfer_RootPieceId_Field {
+	return GracefulExitSegmentTransfer_RootPieceId_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_RootPieceId_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_RootPieceId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_CreatedAt_Field) _Column() string { return "created_at" }
+func (GracefulExitSegmentTransfer_RootPieceId_Field) _Column() string { return "root_piece_id" }
 
-type NodeEvent_LastAttempted_Field struct {
+type GracefulExitSegmentTransfer_DurabilityRatio_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value float64
 }
 
-func NodeEvent_LastAttempted(v time.Time) NodeEvent_LastAttempted_Field {
-	return NodeEvent_LastAttempted_Field{_set: true, _value: &v}
+func GracefulExitSegmentTransfer_DurabilityRatio(v float64) GracefulExitSegmentTransfer_DurabilityRatio_Field {
+	return GracefulExitSegmentTransfer_DurabilityRatio_Field{_set: true, _value: v}
 }
 
-func NodeEvent_LastAttempted_Raw(v *time.Time) NodeEvent_LastAttempted_Field {
-	if v == nil {
-		return NodeEvent_LastAttempted_Null()
+func (f GracefulExitSegmentTransfer_DurabilityRatio_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return NodeEvent_LastAttempted(*v)
+	return f._value
 }
 
-func NodeEvent_LastAttempted_Null() NodeEvent_LastAttempted_Field {
-	return NodeEvent_LastAttempted_Field{_set: true, _null: true}
+func (GracefulExitSegmentTransfer_DurabilityRatio_Field) _Column() string { return "durability_ratio" }
+
+type GracefulExitSegmentTransfer_QueuedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f NodeEvent_LastAttempted_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func GracefulExitSegmentTransfer_QueuedAt(v time.Time) GracefulExitSegmentTransfer_QueuedAt_Field {
+	return GracefulExitSegmentTransfer_QueuedAt_Field{_set: true, _value: v}
+}
 
-func (f NodeEvent_LastAttempted_Field) value() interface{} {
+func (f GracefulExitSegmentTransfer_QueuedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_LastAttempted_Field) _Column() string { return "last_attempted" }
+func (GracefulExitSegmentTransfer_QueuedAt_Field) _Column() string { return "queued_at" }
 
-type NodeEvent_EmailSent_Field struct {
+type GracefulExitSegmentTransfer_RequestedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value *time.Time
 }
 
-func NodeEvent_EmailSent(v time.Time) NodeEvent_EmailSent_Field {
-	return NodeEvent_EmailSent_Field{_set: true, _value: &v}
+func GracefulExitSegmentTransfer_RequestedAt(v time.Time) GracefulExitSegmentTransfer_RequestedAt_Field {
+	return GracefulExitSegmentTransfer_RequestedAt_Field{_set: true, _value: &v}
 }
 
-func NodeEvent_EmailSent_Raw(v *time.Time) NodeEvent_EmailSent_Field {
+func GracefulExitSegmentTransfer_RequestedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_RequestedAt_Field {
 	if v == nil {
-		return NodeEvent_EmailSent_Null()
+		return GracefulExitSegmentTransfer_RequestedAt_Null()
 	}
-	return NodeEvent_EmailSent(*v)
+	return GracefulExitSegmentTransfer_RequestedAt(*v)
 }
 
-func NodeEvent_EmailSent_Null() NodeEvent_EmailSent_Field {
-	return NodeEvent_EmailSent_Field{_set: true, _null: true}
+func GracefulExitSegmentTransfer_RequestedAt_Null() GracefulExitSegmentTransfer_RequestedAt_Field {
+	return GracefulExitSegmentTransfer_RequestedAt_Field{_set: true, _null: true}
 }
 
-func (f NodeEvent_EmailSent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f GracefulExitSegmentTransfer_RequestedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f NodeEvent_EmailSent_Field) value() interface{} {
+func (f GracefulExitSegmentTransfer_RequestedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_EmailSent_Field) _Column() string { return "email_sent" }
+func (GracefulExitSegmentTransfer_RequestedAt_Field) _Column() string { return "requested_at" }
 
-type OauthClient struct {
-	Id              []byte
-	EncryptedSecret []byte
-	RedirectUrl     string
-	UserId          []byte
-	AppName         string
-	AppLogoUrl      string
-}
-
-func (OauthClient) _Table() string { return "oauth_clients" }
-
-type OauthClient_Update_Fields struct {
-	EncryptedSecret OauthClient_EncryptedSecret_Field
-	RedirectUrl     OauthClient_RedirectUrl_Field
-	AppName         OauthClient_AppName_Field
-	AppLogoUrl      OauthClient_AppLogoUrl_Field
-}
-
-type OauthClient_Id_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
-}
-
-func OauthClient_Id(v []byte) OauthClient_Id_Field {
-	return OauthClient_Id_Field{_set: true, _value: v}
-}
-
-func (f OauthClient_Id_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
-}
-
-func (OauthClient_Id_Field) _Column() string { return "id" }
-
-type OauthClient_EncryptedSecret_Field struct {
+type GracefulExitSegmentTransfer_LastFailedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *time.Time
 }
 
-func OauthClient_EncryptedSecret(v []byte) OauthClient_EncryptedSecret_Field {
-	return OauthClient_EncryptedSecret_Field{_set: true, _value: v}
+func GracefulExitSegmentTransfer_LastFailedAt(v time.Time) GracefulExitSegmentTransfer_LastFailedAt_Field {
+	return GracefulExitSegmentTransfer_LastFailedAt_Field{_set: true, _value: &v}
 }
 
-func (f OauthClient_EncryptedSecret_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func GracefulExitSegmentTransfer_LastFailedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_LastFailedAt_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_LastFailedAt_Null()
 	}
-	return f._value
+	return GracefulExitSegmentTransfer_LastFailedAt(*v)
 }
 
-func (OauthClient_EncryptedSecret_Field) _Column() string { return "encrypted_secret" }
-
-type OauthClient_RedirectUrl_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+func GracefulExitSegmentTransfer_LastFailedAt_Null() GracefulExitSegmentTransfer_LastFailedAt_Field {
+	return GracefulExitSegmentTransfer_LastFailedAt_Field{_set: true, _null: true}
 }
 
-func OauthClient_RedirectUrl(v string) OauthClient_RedirectUrl_Field {
-	return OauthClient_RedirectUrl_Field{_set: true, _value: v}
+func (f GracefulExitSegmentTransfer_LastFailedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f OauthClient_RedirectUrl_Field) value() interface{} {
+func (f GracefulExitSegmentTransfer_LastFailedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_RedirectUrl_Field) _Column() string { return "redirect_url" }
+func (GracefulExitSegmentTransfer_LastFailedAt_Field) _Column() string { return "last_failed_at" }
 
-type OauthClient_UserId_Field struct {
+type GracefulExitSegmentTransfer_LastFailedCode_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *int
 }
 
-func OauthClient_UserId(v []byte) OauthClient_UserId_Field {
-	return OauthClient_UserId_Field{_set: true, _value: v}
+func GracefulExitSegmentTransfer_LastFailedCode(v int) GracefulExitSegmentTransfer_LastFailedCode_Field {
+	return GracefulExitSegmentTransfer_LastFailedCode_Field{_set: true, _value: &v}
 }
 
-func (f OauthClient_UserId_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func GracefulExitSegmentTransfer_LastFailedCode_Raw(v *int) GracefulExitSegmentTransfer_LastFailedCode_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_LastFailedCode_Null()
 	}
-	return f._value
+	return GracefulExitSegmentTransfer_LastFailedCode(*v)
 }
 
-func (OauthClient_UserId_Field) _Column() string { return "user_id" }
-
-type OauthClient_AppName_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+func GracefulExitSegmentTransfer_LastFailedCode_Null() GracefulExitSegmentTransfer_LastFailedCode_Field {
+	return GracefulExitSegmentTransfer_LastFailedCode_Field{_set: true, _null: true}
 }
 
-func OauthClient_AppName(v string) OauthClient_AppName_Field {
-	return OauthClient_AppName_Field{_set: true, _value: v}
+func (f GracefulExitSegmentTransfer_LastFailedCode_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f OauthClient_AppName_Field) value() interface{} {
+func (f GracefulExitSegmentTransfer_LastFailedCode_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_AppName_Field) _Column() string { return "app_name" }
+func (GracefulExitSegmentTransfer_LastFailedCode_Field) _Column() string { return "last_failed_code" }
 
-type OauthClient_AppLogoUrl_Field struct {
+type GracefulExitSegmentTransfer_FailedCount_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value *int
 }
 
-func OauthClient_AppLogoUrl(v string) OauthClient_AppLogoUrl_Field {
-	return OauthClient_AppLogoUrl_Field{_set: true, _value: v}
+func GracefulExitSegmentTransfer_FailedCount(v int) GracefulExitSegmentTransfer_FailedCount_Field {
+	return GracefulExitSegmentTransfer_FailedCount_Field{_set: true, _value: &v}
 }
 
-func (f OauthClient_AppLogoUrl_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func GracefulExitSegmentTransfer_FailedCount_Raw(v *int) GracefulExitSegmentTransfer_FailedCount_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_FailedCount_Null()
 	}
-	return f._value
-}
-
-func (OauthClient_AppLogoUrl_Field) _Column() string { return "app_logo_url" }
-
-type OauthCode struct {
-	ClientId        []byte
-	UserId          []byte
-	Scope           string
-	RedirectUrl     string
-	Challenge       string
-	ChallengeMethod string
-	Code            string
-	CreatedAt       time.Time
-	ExpiresAt       time.Time
-	ClaimedAt       *time.Time
-}
-
-func (OauthCode) _Table() string { return "oauth_codes" }
-
-type OauthCode_Create_Fields struct {
-	ClaimedAt OauthCode_ClaimedAt_Field
-}
-
-type OauthCode_Update_Fields struct {
-	ClaimedAt OauthCode_ClaimedAt_Field
+	return GracefulExitSegmentTransfer_FailedCount(*v)
 }
 
-type OauthCode_ClientId_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func GracefulExitSegmentTransfer_FailedCount_Null() GracefulExitSegmentTransfer_FailedCount_Field {
+	return GracefulExitSegmentTransfer_FailedCount_Field{_set: true, _null: true}
 }
 
-func OauthCode_ClientId(v []byte) OauthCode_ClientId_Field {
-	return OauthCode_ClientId_Field{_set: true, _value: v}
+func (f GracefulExitSegmentTransfer_FailedCount_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f OauthCode_ClientId_Field) value() interface{} {
+func (f GracefulExitSegmentTransfer_FailedCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_ClientId_Field) _Column() string { return "client_id" }
+func (GracefulExitSegmentTransfer_FailedCount_Field) _Column() string { return "failed_count" }
 
-type OauthCode_UserId_Field struct {
+type GracefulExitSegmentTransfer_FinishedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *time.Time
 }
 
-func OauthCode_UserId(v []byte) OauthCode_UserId_Field {
-	return OauthCode_UserId_Field{_set: true, _value: v}
+func GracefulExitSegmentTransfer_FinishedAt(v time.Time) GracefulExitSegmentTransfer_FinishedAt_Field {
+	return GracefulExitSegmentTransfer_FinishedAt_Field{_set: true, _value: &v}
 }
 
-func (f OauthCode_UserId_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func GracefulExitSegmentTransfer_FinishedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_FinishedAt_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_FinishedAt_Null()
 	}
-	return f._value
+	return GracefulExitSegmentTransfer_FinishedAt(*v)
 }
 
-func (OauthCode_UserId_Field) _Column() string { return "user_id" }
-
-type OauthCode_Scope_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+func GracefulExitSegmentTransfer_FinishedAt_Null() GracefulExitSegmentTransfer_FinishedAt_Field {
+	return GracefulExitSegmentTransfer_FinishedAt_Field{_set: true, _null: true}
 }
 
-func OauthCode_Scope(v string) OauthCode_Scope_Field {
-	return OauthCode_Scope_Field{_set: true, _value: v}
+func (f GracefulExitSegmentTransfer_FinishedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f OauthCode_Scope_Field) value() interface{} {
+func (f GracefulExitSegmentTransfer_FinishedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_Scope_Field) _Column() string { return "scope" }
+func (GracefulExitSegmentTransfer_FinishedAt_Field) _Column() string { return "finished_at" }
 
-type OauthCode_RedirectUrl_Field struct {
+type GracefulExitSegmentTransfer_OrderLimitSendCount_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int
 }
 
-func OauthCode_RedirectUrl(v string) OauthCode_RedirectUrl_Field {
-	return OauthCode_RedirectUrl_Field{_set: true, _value: v}
+func GracefulExitSegmentTransfer_OrderLimitSendCount(v int) GracefulExitSegmentTransfer_OrderLimitSendCount_Field {
+	return GracefulExitSegmentTransfer_OrderLimitSendCount_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_RedirectUrl_Field) value() interface{} {
+func (f GracefulExitSegmentTransfer_OrderLimitSendCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_RedirectUrl_Field) _Column() string { return "redirect_url" }
-
-type OauthCode_Challenge_Field struct {
-	_set   bool
-	_null  bool
-	_value string
-}
-
-func OauthCode_Challenge(v string) OauthCode_Challenge_Field {
-	return OauthCode_Challenge_Field{_set: true, _value: v}
+func (GracefulExitSegmentTransfer_OrderLimitSendCount_Field) _Column() string {
+	return "order_limit_send_count"
 }
 
-func (f OauthCode_Challenge_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type Node struct {
+	Id                      []byte
+	Address                 string
+	LastNet                 string
+	LastIpPort              *string
+	CountryCode             *string
+	Protocol                int
+	Type                    int
+	Email                   string
+	Wallet                  string
+	WalletFeatures          string
+	FreeDisk                int64
+	PieceCount              int64
+	Major                   int64
+	Minor                   int64
+	Patch                   int64
+	Hash                    string
+	Timestamp               time.Time
+	Release                 bool
+	Latency90               int64
+	VettedAt                *time.Time
+	CreatedAt               time.Time
+	UpdatedAt               time.Time
+	LastContactSuccess      time.Time
+	LastContactFailure      time.Time
+	Disqualified            *time.Time
+	DisqualificationReason  *int
+	UnknownAuditSuspended   *time.Time
+	OfflineSuspended        *time.Time
+	UnderReview             *time.Time
+	ExitInitiatedAt         *time.Time
+	ExitLoopCompletedAt     *time.Time
+	ExitFinishedAt          *time.Time
+	ExitSuccess             bool
+	Contained               *time.Time
+	LastOfflineEmail        *time.Time
+	LastSoftwareUpdateEmail *time.Time
+	NoiseProto              *int
+	NoisePublicKey          []byte
+	DebounceLimit           int
 }
 
-func (OauthCode_Challenge_Field) _Column() string { return "challenge" }
+func (Node) _Table() string { return "nodes" }
 
-type OauthCode_ChallengeMethod_Field struct {
-	_set   bool
+type Node_Create_Fields struct {
+	Address                 Node_Address_Field
+	LastIpPort              Node_LastIpPort_Field
+	CountryCode             Node_CountryCode_Field
+	Protocol                Node_Protocol_Field
+	Type                    Node_Type_Field
+	WalletFeatures          Node_WalletFeatures_Field
+	FreeDisk                Node_FreeDisk_Field
+	Major                   Node_Major_Field
+	Minor                   Node_Minor_Field
+	Patch                   Node_Patch_Field
+	Hash                    Node_Hash_Field
+	Timestamp               Node_Timestamp_Field
+	Release                 Node_Release_Field
+	Latency90               Node_Latency90_Field
+	VettedAt                Node_VettedAt_Field
+	LastContactSuccess      Node_LastContactSuccess_Field
+	LastContactFailure      Node_LastContactFailure_Field
+	Disqualified            Node_Disqualified_Field
+	DisqualificationReason  Node_DisqualificationReason_Field
+	UnknownAuditSuspended   Node_UnknownAuditSuspended_Field
+	OfflineSuspended        Node_OfflineSuspended_Field
+	UnderReview             Node_UnderReview_Field
+	ExitInitiatedAt         Node_ExitInitiatedAt_Field
+	ExitLoopCompletedAt     Node_ExitLoopCompletedAt_Field
+	ExitFinishedAt          Node_ExitFinishedAt_Field
+	ExitSuccess             Node_ExitSuccess_Field
+	Contained               Node_Contained_Field
+	LastOfflineEmail        Node_LastOfflineEmail_Field
+	LastSoftwareUpdateEmail Node_LastSoftwareUpdateEmail_Field
+	NoiseProto              Node_NoiseProto_Field
+	NoisePublicKey          Node_NoisePublicKey_Field
+	DebounceLimit           Node_DebounceLimit_Field
+}
+
+type Node_Update_Fields struct {
+	Address                 Node_Address_Field
+	LastNet                 Node_LastNet_Field
+	LastIpPort              Node_LastIpPort_Field
+	CountryCode             Node_CountryCode_Field
+	Protocol                Node_Protocol_Field
+	Type                    Node_Type_Field
+	Email                   Node_Email_Field
+	Wallet                  Node_Wallet_Field
+	WalletFeatures          Node_WalletFeatures_Field
+	FreeDisk                Node_FreeDisk_Field
+	PieceCount              Node_PieceCount_Field
+	Major                   Node_Major_Field
+	Minor                   Node_Minor_Field
+	Patch                   Node_Patch_Field
+	Hash                    Node_Hash_Field
+	Timestamp               Node_Timestamp_Field
+	Release                 Node_Release_Field
+	Latency90               Node_Latency90_Field
+	VettedAt                Node_VettedAt_Field
+	LastContactSuccess      Node_LastContactSuccess_Field
+	LastContactFailure      Node_LastContactFailure_Field
+	Disqualified            Node_Disqualified_Field
+	DisqualificationReason  Node_DisqualificationReason_Field
+	UnknownAuditSuspended   Node_UnknownAuditSuspended_Field
+	OfflineSuspended        Node_OfflineSuspended_Field
+	UnderReview             Node_UnderReview_Field
+	ExitInitiatedAt         Node_ExitInitiatedAt_Field
+	ExitLoopCompletedAt     Node_ExitLoopCompletedAt_Field
+	ExitFinishedAt          Node_ExitFinishedAt_Field
+	ExitSuccess             Node_ExitSuccess_Field
+	Contained               Node_Contained_Field
+	LastOfflineEmail        Node_LastOfflineEmail_Field
+	LastSoftwareUpdateEmail Node_LastSoftwareUpdateEmail_Field
+	NoiseProto              Node_NoiseProto_Field
+	NoisePublicKey          Node_NoisePublicKey_Field
+	DebounceLimit           Node_DebounceLimit_Field
+}
+
+type Node_Id_Field struct {
+	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func OauthCode_ChallengeMethod(v string) OauthCode_ChallengeMethod_Field {
-	return OauthCode_ChallengeMethod_Field{_set: true, _value: v}
+func Node_Id(v []byte) Node_Id_Field {
+	return Node_Id_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_ChallengeMethod_Field) value() interface{} {
+func (f Node_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_ChallengeMethod_Field) _Column() string { return "challenge_method" }
+func (Node_Id_Field) _Column() string { return "id" }
 
-type OauthCode_Code_Field struct {
+type Node_Address_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func OauthCode_Code(v string) OauthCode_Code_Field {
-	return OauthCode_Code_Field{_set: true, _value: v}
+func Node_Address(v string) Node_Address_Field {
+	return Node_Address_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_Code_Field) value() interface{} {
+func (f Node_Address_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_Code_Field) _Column() string { return "code" }
+func (Node_Address_Field) _Column() string { return "address" }
 
-type OauthCode_CreatedAt_Field struct {
+type Node_LastNet_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func OauthCode_CreatedAt(v time.Time) OauthCode_CreatedAt_Field {
-	return OauthCode_CreatedAt_Field{_set: true, _value: v}
+func Node_LastNet(v string) Node_LastNet_Field {
+	return Node_LastNet_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_CreatedAt_Field) value() interface{} {
+func (f Node_LastNet_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_CreatedAt_Field) _Column() string { return "created_at" }
+func (Node_LastNet_Field) _Column() string { return "last_net" }
 
-type OauthCode_ExpiresAt_Field struct {
+type Node_LastIpPort_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *string
 }
 
-func OauthCode_ExpiresAt(v time.Time) OauthCode_ExpiresAt_Field {
-	return OauthCode_ExpiresAt_Field{_set: true, _value: v}
+func Node_LastIpPort(v string) Node_LastIpPort_Field {
+	return Node_LastIpPort_Field{_set: true, _value: &v}
 }
 
-func (f OauthCode_ExpiresAt_Field) value() interface{} {
+func Node_LastIpPort_Raw(v *string) Node_LastIpPort_Field {
+	if v == nil {
+		return Node_LastIpPort_Null()
+	}
+	return Node_LastIpPort(*v)
+}
+
+func Node_LastIpPort_Null() Node_LastIpPort_Field {
+	return Node_LastIpPort_Field{_set: true, _null: true}
+}
+
+func (f Node_LastIpPort_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_LastIpPort_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_ExpiresAt_Field) _Column() string { return "expires_at" }
+func (Node_LastIpPort_Field) _Column() string { return "last_ip_port" }
 
-type OauthCode_ClaimedAt_Field struct {
+type Node_CountryCode_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value *string
 }
 
-func OauthCode_ClaimedAt(v time.Time) OauthCode_ClaimedAt_Field {
-	return OauthCode_ClaimedAt_Field{_set: true, _value: &v}
+func Node_CountryCode(v string) Node_CountryCode_Field {
+	return Node_CountryCode_Field{_set: true, _value: &v}
 }
 
-func OauthCode_ClaimedAt_Raw(v *time.Time) OauthCode_ClaimedAt_Field {
+func Node_CountryCode_Raw(v *string) Node_CountryCode_Field {
 	if v == nil {
-		return OauthCode_ClaimedAt_Null()
+		return Node_CountryCode_Null()
 	}
-	return OauthCode_ClaimedAt(*v)
+	return Node_CountryCode(*v)
 }
 
-func OauthCode_ClaimedAt_Null() OauthCode_ClaimedAt_Field {
-	return OauthCode_ClaimedAt_Field{_set: true, _null: true}
+func Node_CountryCode_Null() Node_CountryCode_Field {
+	return Node_CountryCode_Field{_set: true, _null: true}
 }
 
-func (f OauthCode_ClaimedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Node_CountryCode_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f OauthCode_ClaimedAt_Field) value() interface{} {
+func (f Node_CountryCode_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_ClaimedAt_Field) _Column() string { return "claimed_at" }
-
-type OauthToken struct {
-	ClientId  []byte
-	UserId    []byte
-	Scope     string
-	Kind      int
-	Token     []byte
-	CreatedAt time.Time
-	ExpiresAt time.Time
-}
-
-func (OauthToken) _Table() string { return "oauth_tokens" }
-
-type OauthToken_Update_Fields struct {
-	ExpiresAt OauthToken_ExpiresAt_Field
-}
+func (Node_CountryCode_Field) _Column() string { return "country_code" }
 
-type OauthToken_ClientId_Field struct {
+type Node_Protocol_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func OauthToken_ClientId(v []byte) OauthToken_ClientId_Field {
-	return OauthToken_ClientId_Field{_set: true, _value: v}
+func Node_Protocol(v int) Node_Protocol_Field {
+	return Node_Protocol_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_ClientId_Field) value() interface{} {
+func (f Node_Protocol_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_ClientId_Field) _Column() string { return "client_id" }
+func (Node_Protocol_Field) _Column() string { return "protocol" }
 
-type OauthToken_UserId_Field struct {
+type Node_Type_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func OauthToken_UserId(v []byte) OauthToken_UserId_Field {
-	return OauthToken_UserId_Field{_set: true, _value: v}
+func Node_Type(v int) Node_Type_Field {
+	return Node_Type_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_UserId_Field) value() interface{} {
+func (f Node_Type_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_UserId_Field) _Column() string { return "user_id" }
+func (Node_Type_Field) _Column() string { return "type" }
 
-type OauthToken_Scope_Field struct {
+type Node_Email_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func OauthToken_Scope(v string) OauthToken_Scope_Field {
-	return OauthToken_Scope_Field{_set: true, _value: v}
+func Node_Email(v string) Node_Email_Field {
+	return Node_Email_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_Scope_Field) value() interface{} {
+func (f Node_Email_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_Scope_Field) _Column() string { return "scope" }
+func (Node_Email_Field) _Column() string { return "email" }
 
-type OauthToken_Kind_Field struct {
+type Node_Wallet_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func OauthToken_Kind(v int) OauthToken_Kind_Field {
-	return OauthToken_Kind_Field{_set: true, _value: v}
+func Node_Wallet(v string) Node_Wallet_Field {
+	return Node_Wallet_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_Kind_Field) value() interface{} {
+func (f Node_Wallet_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_Kind_Field) _Column() string { return "kind" }
+func (Node_Wallet_Field) _Column() string { return "wallet" }
 
-type OauthToken_Token_Field struct {
+type Node_WalletFeatures_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func OauthToken_Token(v []byte) OauthToken_Token_Field {
-	return OauthToken_Token_Field{_set: true, _value: v}
+func Node_WalletFeatures(v string) Node_WalletFeatures_Field {
+	return Node_WalletFeatures_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_Token_Field) value() interface{} {
+func (f Node_WalletFeatures_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_Token_Field) _Column() string { return "token" }
+func (Node_WalletFeatures_Field) _Column() string { return "wallet_features" }
 
-type OauthToken_CreatedAt_Field struct {
+type Node_FreeDisk_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func OauthToken_CreatedAt(v time.Time) OauthToken_CreatedAt_Field {
-	return OauthToken_CreatedAt_Field{_set: true, _value: v}
+func Node_FreeDisk(v int64) Node_FreeDisk_Field {
+	return Node_FreeDisk_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_CreatedAt_Field) value() interface{} {
+func (f Node_FreeDisk_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_CreatedAt_Field) _Column() string { return "created_at" }
+func (Node_FreeDisk_Field) _Column() string { return "free_disk" }
 
-type OauthToken_ExpiresAt_Field struct {
+type Node_PieceCount_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func OauthToken_ExpiresAt(v time.Time) OauthToken_ExpiresAt_Field {
-	return OauthToken_ExpiresAt_Field{_set: true, _value: v}
+func Node_PieceCount(v int64) Node_PieceCount_Field {
+	return Node_PieceCount_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_ExpiresAt_Field) value() interface{} {
+func (f Node_PieceCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_ExpiresAt_Field) _Column() string { return "expires_at" }
+func (Node_PieceCount_Field) _Column() string { return "piece_count" }
 
-type PeerIdentity struct {
-	NodeId           []byte
-	LeafSerialNumber []byte
-	Chain            []byte
-	UpdatedAt        time.Time
+type Node_Major_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (PeerIdentity) _Table() string { return "peer_identities" }
+func Node_Major(v int64) Node_Major_Field {
+	return Node_Major_Field{_set: true, _value: v}
+}
 
-type PeerIdentity_Update_Fields struct {
-	LeafSerialNumber PeerIdentity_LeafSerialNumber_Field
-	Chain            PeerIdentity_Chain_Field
+func (f Node_Major_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type PeerIdentity_NodeId_Field struct {
+func (Node_Major_Field) _Column() string { return "major" }
+
+type Node_Minor_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func PeerIdentity_NodeId(v []byte) PeerIdentity_NodeId_Field {
-	return PeerIdentity_NodeId_Field{_set: true, _value: v}
+func Node_Minor(v int64) Node_Minor_Field {
+	return Node_Minor_Field{_set: true, _value: v}
 }
 
-func (f PeerIdentity_NodeId_Field) value() interface{} {
+func (f Node_Minor_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PeerIdentity_NodeId_Field) _Column() string { return "node_id" }
+func (Node_Minor_Field) _Column() string { return "minor" }
 
-type PeerIdentity_LeafSerialNumber_Field struct {
+type Node_Patch_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func PeerIdentity_LeafSerialNumber(v []byte) PeerIdentity_LeafSerialNumber_Field {
-	return PeerIdentity_LeafSerialNumber_Field{_set: true, _value: v}
+func Node_Patch(v int64) Node_Patch_Field {
+	return Node_Patch_Field{_set: true, _value: v}
 }
 
-func (f PeerIdentity_LeafSerialNumber_Field) value() interface{} {
+func (f Node_Patch_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PeerIdentity_LeafSerialNumber_Field) _Column() string { return "leaf_serial_number" }
+func (Node_Patch_Field) _Column() string { return "patch" }
 
-type PeerIdentity_Chain_Field struct {
+type Node_Hash_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func PeerIdentity_Chain(v []byte) PeerIdentity_Chain_Field {
-	return PeerIdentity_Chain_Field{_set: true, _value: v}
+func Node_Hash(v string) Node_Hash_Field {
+	return Node_Hash_Field{_set: true, _value: v}
 }
 
-func (f PeerIdentity_Chain_Field) value() interface{} {
+func (f Node_Hash_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PeerIdentity_Chain_Field) _Column() string { return "chain" }
+func (Node_Hash_Field) _Column() string { return "hash" }
 
-type PeerIdentity_UpdatedAt_Field struct {
+type Node_Timestamp_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func PeerIdentity_UpdatedAt(v time.Time) PeerIdentity_UpdatedAt_Field {
-	return PeerIdentity_UpdatedAt_Field{_set: true, _value: v}
+func Node_Timestamp(v time.Time) Node_Timestamp_Field {
+	return Node_Timestamp_Field{_set: true, _value: v}
 }
 
-func (f PeerIdentity_UpdatedAt_Field) value() interface{} {
+func (f Node_Timestamp_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PeerIdentity_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (Node_Timestamp_Field) _Column() string { return "timestamp" }
 
-type Project struct {
-	Id                          []byte
-	PublicId                    []byte
-	Name                        string
-	Description                 string
-	UsageLimit                  *int64
-	BandwidthLimit              *int64
-	UserSpecifiedUsageLimit     *int64
-	UserSpecifiedBandwidthLimit *int64
-	SegmentLimit                *int64
-	RateLimit                   *int
-	BurstLimit                  *int
-	MaxBuckets                  *int
-	PartnerId                   []byte
-	UserAgent                   []byte
-	OwnerId                     []byte
-	Salt                        []byte
-	CreatedAt                   time.Time
+type Node_Release_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
 }
 
-func (Project) _Table() string { return "projects" }
-
-type Project_Create_Fields struct {
-	PublicId                    Project_PublicId_Field
-	UsageLimit                  Project_UsageLimit_Field
-	BandwidthLimit              Project_BandwidthLimit_Field
-	UserSpecifiedUsageLimit     Project_UserSpecifiedUsageLimit_Field
-	UserSpecifiedBandwidthLimit Project_UserSpecifiedBandwidthLimit_Field
-	SegmentLimit                Project_SegmentLimit_Field
-	RateLimit                   Project_RateLimit_Field
-	BurstLimit                  Project_BurstLimit_Field
-	MaxBuckets                  Project_MaxBuckets_Field
-	PartnerId                   Project_PartnerId_Field
-	UserAgent                   Project_UserAgent_Field
-	Salt                        Project_Salt_Field
+func Node_Release(v bool) Node_Release_Field {
+	return Node_Release_Field{_set: true, _value: v}
 }
 
-type Project_Update_Fields struct {
-	Name                        Project_Name_Field
-	Description                 Project_Description_Field
-	UsageLimit                  Project_UsageLimit_Field
-	BandwidthLimit              Project_BandwidthLimit_Field
-	UserSpecifiedUsageLimit     Project_UserSpecifiedUsageLimit_Field
-	UserSpecifiedBandwidthLimit Project_UserSpecifiedBandwidthLimit_Field
-	SegmentLimit                Project_SegmentLimit_Field
-	RateLimit                   Project_RateLimit_Field
-	BurstLimit                  Project_BurstLimit_Field
-	MaxBuckets                  Project_MaxBuckets_Field
+func (f Node_Release_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type Project_Id_Field struct {
+func (Node_Release_Field) _Column() string { return "release" }
+
+type Node_Latency90_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func Project_Id(v []byte) Project_Id_Field {
-	return Project_Id_Field{_set: true, _value: v}
+func Node_Latency90(v int64) Node_Latency90_Field {
+	return Node_Latency90_Field{_set: true, _value: v}
 }
 
-func (f Project_Id_Field) value() interface{} {
+func (f Node_Latency90_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Id_Field) _Column() string { return "id" }
+func (Node_Latency90_Field) _Column() string { return "latency_90" }
 
-type Project_PublicId_Field struct {
+type Node_VettedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *time.Time
 }
 
-func Project_PublicId(v []byte) Project_PublicId_Field {
-	return Project_PublicId_Field{_set: true, _value: v}
+func Node_VettedAt(v time.Time) Node_VettedAt_Field {
+	return Node_VettedAt_Field{_set: true, _value: &v}
 }
 
-func Project_PublicId_Raw(v []byte) Project_PublicId_Field {
+func Node_VettedAt_Raw(v *time.Time) Node_VettedAt_Field {
 	if v == nil {
-		return Project_PublicId_Null()
+		return Node_VettedAt_Null()
 	}
-	return Project_PublicId(v)
+	return Node_VettedAt(*v)
 }
 
-func Project_PublicId_Null() Project_PublicId_Field {
-	return Project_PublicId_Field{_set: true, _null: true}
+func Node_VettedAt_Null() Node_VettedAt_Field {
+	return Node_VettedAt_Field{_set: true, _null: true}
 }
 
-func (f Project_PublicId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Node_VettedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Project_PublicId_Field) value() interface{} {
+func (f Node_VettedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_PublicId_Field) _Column() string { return "public_id" }
+func (Node_VettedAt_Field) _Column() string { return "vetted_at" }
 
-type Project_Name_Field struct {
+type Node_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func Project_Name(v string) Project_Name_Field {
-	return Project_Name_Field{_set: true, _value: v}
+func Node_CreatedAt(v time.Time) Node_CreatedAt_Field {
+	return Node_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Project_Name_Field) value() interface{} {
+func (f Node_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Name_Field) _Column() string { return "name" }
+func (Node_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Project_Description_Field struct {
+type Node_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func Project_Description(v string) Project_Description_Field {
-	return Project_Description_Field{_set: true, _value: v}
+func Node_UpdatedAt(v time.Time) Node_UpdatedAt_Field {
+	return Node_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f Project_Description_Field) value() interface{} {
+func (f Node_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Description_Field) _Column() string { return "description" }
+func (Node_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type Project_UsageLimit_Field struct {
+type Node_LastContactSuccess_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value time.Time
 }
 
-func Project_UsageLimit(v int64) Project_UsageLimit_Field {
-	return Project_UsageLimit_Field{_set: true, _value: &v}
+func Node_LastContactSuccess(v time.Time) Node_LastContactSuccess_Field {
+	return Node_LastContactSuccess_Field{_set: true, _value: v}
 }
 
-func Project_UsageLimit_Raw(v *int64) Project_UsageLimit_Field {
-	if v == nil {
-		return Project_UsageLimit_Null()
+func (f Node_LastContactSuccess_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_UsageLimit(*v)
+	return f._value
 }
 
-func Project_UsageLimit_Null() Project_UsageLimit_Field {
-	return Project_UsageLimit_Field{_set: true, _null: true}
+func (Node_LastContactSuccess_Field) _Column() string { return "last_contact_success" }
+
+type Node_LastContactFailure_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f Project_UsageLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func Node_LastContactFailure(v time.Time) Node_LastContactFailure_Field {
+	return Node_LastContactFailure_Field{_set: true, _value: v}
+}
 
-func (f Project_UsageLimit_Field) value() interface{} {
+func (f Node_LastContactFailure_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_UsageLimit_Field) _Column() string { return "usage_limit" }
+func (Node_LastContactFailure_Field) _Column() string { return "last_contact_failure" }
 
-type Project_BandwidthLimit_Field struct {
+type Node_Disqualified_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value *time.Time
 }
 
-func Project_BandwidthLimit(v int64) Project_BandwidthLimit_Field {
-	return Project_BandwidthLimit_Field{_set: true, _value: &v}
+func Node_Disqualified(v time.Time) Node_Disqualified_Field {
+	return Node_Disqualified_Field{_set: true, _value: &v}
 }
 
-func Project_BandwidthLimit_Raw(v *int64) Project_BandwidthLimit_Field {
+func Node_Disqualified_Raw(v *time.Time) Node_Disqualified_Field {
 	if v == nil {
-		return Project_BandwidthLimit_Null()
+		return Node_Disqualified_Null()
 	}
-	return Project_BandwidthLimit(*v)
+	return Node_Disqualified(*v)
 }
 
-func Project_BandwidthLimit_Null() Project_BandwidthLimit_Field {
-	return Project_BandwidthLimit_Field{_set: true, _null: true}
+func Node_Disqualified_Null() Node_Disqualified_Field {
+	return Node_Disqualified_Field{_set: true, _null: true}
 }
 
-func (f Project_BandwidthLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Node_Disqualified_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Project_BandwidthLimit_Field) value() interface{} {
+func (f Node_Disqualified_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_BandwidthLimit_Field) _Column() string { return "bandwidth_limit" }
+func (Node_Disqualified_Field) _Column() string { return "disqualified" }
 
-type Project_UserSpecifiedUsageLimit_Field struct {
+type Node_DisqualificationReason_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value *int
 }
 
-func Project_UserSpecifiedUsageLimit(v int64) Project_UserSpecifiedUsageLimit_Field {
-	return Project_UserSpecifiedUsageLimit_Field{_set: true, _value: &v}
+func Node_DisqualificationReason(v int) Node_DisqualificationReason_Field {
+	return Node_DisqualificationReason_Field{_set: true, _value: &v}
 }
 
-func Project_UserSpecifiedUsageLimit_Raw(v *int64) Project_UserSpecifiedUsageLimit_Field {
+func Node_DisqualificationReason_Raw(v *int) Node_DisqualificationReason_Field {
 	if v == nil {
-		return Project_UserSpecifiedUsageLimit_Null()
+		return Node_DisqualificationReason_Null()
 	}
-	return Project_UserSpecifiedUsageLimit(*v)
+	return Node_DisqualificationReason(*v)
 }
 
-func Project_UserSpecifiedUsageLimit_Null() Project_UserSpecifiedUsageLimit_Field {
-	return Project_UserSpecifiedUsageLimit_Field{_set: true, _null: true}
+func Node_DisqualificationReason_Null() Node_DisqualificationReason_Field {
+	return Node_DisqualificationReason_Field{_set: true, _null: true}
 }
 
-func (f Project_UserSpecifiedUsageLimit_Field) isnull() bool {
+func (f Node_DisqualificationReason_Field) isnull() bool {
 	return !f._set || f._null || f._value == nil
 }
 
-func (f Project_UserSpecifiedUsageLimit_Field) value() interface{} {
+func (f Node_DisqualificationReason_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_UserSpecifiedUsageLimit_Field) _Column() string { return "user_specified_usage_limit" }
+func (Node_DisqualificationReason_Field) _Column() string { return "disqualification_reason" }
 
-type Project_UserSpecifiedBandwidthLimit_Field struct {
+type Node_UnknownAuditSuspended_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value *time.Time
 }
 
-func Project_UserSpecifiedBandwidthLimit(v int64) Project_UserSpecifiedBandwidthLimit_Field {
-	return Project_UserSpecifiedBandwidthLimit_Field{_set: true, _value: &v}
+func Node_UnknownAuditSuspended(v time.Time) Node_UnknownAuditSuspended_Field {
+	return Node_UnknownAuditSuspended_Field{_set: true, _value: &v}
 }
 
-func Project_UserSpecifiedBandwidthLimit_Raw(v *int64) Project_UserSpecifiedBandwidthLimit_Field {
+func Node_UnknownAuditSuspended_Raw(v *time.Time) Node_UnknownAuditSuspended_Field {
 	if v == nil {
-		return Project_UserSpecifiedBandwidthLimit_Null()
+		return Node_UnknownAuditSuspended_Null()
 	}
-	return Project_UserSpecifiedBandwidthLimit(*v)
+	return Node_UnknownAuditSuspended(*v)
 }
 
-func Project_UserSpecifiedBandwidthLimit_Null() Project_UserSpecifiedBandwidthLimit_Field {
-	return Project_UserSpecifiedBandwidthLimit_Field{_set: true, _null: true}
+func Node_UnknownAuditSuspended_Null() Node_UnknownAuditSuspended_Field {
+	return Node_UnknownAuditSuspended_Field{_set: true, _null: true}
 }
 
-func (f Project_UserSpecifiedBandwidthLimit_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
-}
+func (f Node_UnknownAuditSuspended_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Project_UserSpecifiedBandwidthLimit_Field) value() interface{} {
+func (f Node_UnknownAuditSuspended_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_UserSpecifiedBandwidthLimit_Field) _Column() string {
-	return "user_specified_bandwidth_limit"
-}
+func (Node_UnknownAuditSuspended_Field) _Column() string { return "unknown_audit_suspended" }
 
-type Project_SegmentLimit_Field struct {
+type Node_OfflineSuspended_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value *time.Time
 }
 
-func Project_SegmentLimit(v int64) Project_SegmentLimit_Field {
-	return Project_SegmentLimit_Field{_set: true, _value: &v}
+func Node_OfflineSuspended(v time.Time) Node_OfflineSuspended_Field {
+	return Node_OfflineSuspended_Field{_set: true, _value: &v}
 }
 
-func Project_SegmentLimit_Raw(v *int64) Project_SegmentLimit_Field {
+func Node_OfflineSuspended_Raw(v *time.Time) Node_OfflineSuspended_Field {
 	if v == nil {
-		return Project_SegmentLimit_Null()
+		return Node_OfflineSuspended_Null()
 	}
-	return Project_SegmentLimit(*v)
+	return Node_OfflineSuspended(*v)
 }
 
-func Project_SegmentLimit_Null() Project_SegmentLimit_Field {
-	return Project_SegmentLimit_Field{_set: true, _null: true}
+func Node_OfflineSuspended_Null() Node_OfflineSuspended_Field {
+	return Node_OfflineSuspended_Field{_set: true, _null: true}
 }
 
-func (f Project_SegmentLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Node_OfflineSuspended_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Project_SegmentLimit_Field) value() interface{} {
+func (f Node_OfflineSuspended_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_SegmentLimit_Field) _Column() string { return "segment_limit" }
+func (Node_OfflineSuspended_Field) _Column() string { return "offline_suspended" }
 
-type Project_RateLimit_Field struct {
+type Node_UnderReview_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value *time.Time
 }
 
-func Project_RateLimit(v int) Project_RateLimit_Field {
-	return Project_RateLimit_Field{_set: true, _value: &v}
+func Node_UnderReview(v time.Time) Node_UnderReview_Field {
+	return Node_UnderReview_Field{_set: true, _value: &v}
 }
 
-func Project_RateLimit_Raw(v *int) Project_RateLimit_Field {
+func Node_UnderReview_Raw(v *time.Time) Node_UnderReview_Field {
 	if v == nil {
-		return Project_RateLimit_Null()
+		return Node_UnderReview_Null()
 	}
-	return Project_RateLimit(*v)
+	return Node_UnderReview(*v)
 }
 
-func Project_RateLimit_Null() Project_RateLimit_Field {
-	return Project_RateLimit_Field{_set: true, _null: true}
+func Node_UnderReview_Null() Node_UnderReview_Field {
+	return Node_UnderReview_Field{_set: true, _null: true}
 }
 
-func (f Project_RateLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Node_UnderReview_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Project_RateLimit_Field) value() interface{} {
+func (f Node_UnderReview_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_RateLimit_Field) _Column() string { return "rate_limit" }
+func (Node_UnderReview_Field) _Column() string { return "under_review" }
 
-type Project_BurstLimit_Field struct {
+type Node_ExitInitiatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value *time.Time
 }
 
-func Project_BurstLimit(v int) Project_BurstLimit_Field {
-	return Project_BurstLimit_Field{_set: true, _value: &v}
+func Node_ExitInitiatedAt(v time.Time) Node_ExitInitiatedAt_Field {
+	return Node_ExitInitiatedAt_Field{_set: true, _value: &v}
 }
 
-func Project_BurstLimit_Raw(v *int) Project_BurstLimit_Field {
+func Node_ExitInitiatedAt_Raw(v *time.Time) Node_ExitInitiatedAt_Field {
 	if v == nil {
-		return Project_BurstLimit_Null()
+		return Node_ExitInitiatedAt_Null()
 	}
-	return Project_BurstLimit(*v)
+	return Node_ExitInitiatedAt(*v)
 }
 
-func Project_BurstLimit_Null() Project_BurstLimit_Field {
-	return Project_BurstLimit_Field{_set: true, _null: true}
+func Node_ExitInitiatedAt_Null() Node_ExitInitiatedAt_Field {
+	return Node_ExitInitiatedAt_Field{_set: true, _null: true}
 }
 
-func (f Project_BurstLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Node_ExitInitiatedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Project_BurstLimit_Field) value() interface{} {
+func (f Node_ExitInitiatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_BurstLimit_Field) _Column() string { return "burst_limit" }
+func (Node_ExitInitiatedAt_Field) _Column() string { return "exit_initiated_at" }
 
-type Project_MaxBuckets_Field struct {
+type Node_ExitLoopCompletedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value *time.Time
 }
 
-func Project_MaxBuckets(v int) Project_MaxBuckets_Field {
-	return Project_MaxBuckets_Field{_set: true, _value: &v}
+func Node_ExitLoopCompletedAt(v time.Time) Node_ExitLoopCompletedAt_Field {
+	return Node_ExitLoopCompletedAt_Field{_set: true, _value: &v}
 }
 
-func Project_MaxBuckets_Raw(v *int) Project_MaxBuckets_Field {
+func Node_ExitLoopCompletedAt_Raw(v *time.Time) Node_ExitLoopCompletedAt_Field {
 	if v == nil {
-		return Project_MaxBuckets_Null()
+		return Node_ExitLoopCompletedAt_Null()
 	}
-	return Project_MaxBuckets(*v)
+	return Node_ExitLoopCompletedAt(*v)
 }
 
-func Project_MaxBuckets_Null() Project_MaxBuckets_Field {
-	return Project_MaxBuckets_Field{_set: true, _null: true}
+func Node_ExitLoopCompletedAt_Null() Node_ExitLoopCompletedAt_Field {
+	return Node_ExitLoopCompletedAt_Field{_set: true, _null: true}
 }
 
-func (f Project_MaxBuckets_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Node_ExitLoopCompletedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Project_MaxBuckets_Field) value() interface{} {
+func (f Node_ExitLoopCompletedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_MaxBuckets_Field) _Column() string { return "max_buckets" }
+func (Node_ExitLoopCompletedAt_Field) _Column() string { return "exit_loop_completed_at" }
 
-type Project_PartnerId_Field struct {
+type Node_ExitFinishedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *time.Time
 }
 
-func Project_PartnerId(v []byte) Project_PartnerId_Field {
-	return Project_PartnerId_Field{_set: true, _value: v}
+func Node_ExitFinishedAt(v time.Time) Node_ExitFinishedAt_Field {
+	return Node_ExitFinishedAt_Field{_set: true, _value: &v}
 }
 
-func Project_PartnerId_Raw(v []byte) Project_PartnerId_Field {
+func Node_ExitFinishedAt_Raw(v *time.Time) Node_ExitFinishedAt_Field {
 	if v == nil {
-		return Project_PartnerId_Null()
+		return Node_ExitFinishedAt_Null()
 	}
-	return Project_PartnerId(v)
+	return Node_ExitFinishedAt(*v)
 }
 
-func Project_PartnerId_Null() Project_PartnerId_Field {
-	return Project_PartnerId_Field{_set: true, _null: true}
+func Node_ExitFinishedAt_Null() Node_ExitFinishedAt_Field {
+	return Node_ExitFinishedAt_Field{_set: true, _null: true}
 }
 
-func (f Project_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Node_ExitFinishedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Project_PartnerId_Field) value() interface{} {
+func (f Node_ExitFinishedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_PartnerId_Field) _Column() string { return "partner_id" }
+func (Node_ExitFinishedAt_Field) _Column() string { return "exit_finished_at" }
 
-type Project_UserAgent_Field struct {
+type Node_ExitSuccess_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
-}
-
-func Project_UserAgent(v []byte) Project_UserAgent_Field {
-	return Project_UserAgent_Field{_set: true, _value: v}
-}
-
-func Project_UserAgent_Raw(v []byte) Project_UserAgent_Field {
-	if v == nil {
-		return Project_UserAgent_Null()
-	}
-	return Project_UserAgent(v)
+	_value bool
 }
 
-func Project_UserAgent_Null() Project_UserAgent_Field {
-	return Project_UserAgent_Field{_set: true, _null: true}
+func Node_ExitSuccess(v bool) Node_ExitSuccess_Field {
+	return Node_ExitSuccess_Field{_set: true, _value: v}
 }
 
-func (f Project_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Project_UserAgent_Field) value() interface{} {
+func (f Node_ExitSuccess_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_UserAgent_Field) _Column() string { return "user_agent" }
+func (Node_ExitSuccess_Field) _Column() string { return "exit_success" }
 
-type Project_OwnerId_Field struct {
+type Node_Contained_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *time.Time
 }
 
-func Project_OwnerId(v []byte) Project_OwnerId_Field {
-	return Project_OwnerId_Field{_set: true, _value: v}
+func Node_Contained(v time.Time) Node_Contained_Field {
+	return Node_Contained_Field{_set: true, _value: &v}
 }
 
-func (f Project_OwnerId_Field) value() interface{} {
+func Node_Contained_Raw(v *time.Time) Node_Contained_Field {
+	if v == nil {
+		return Node_Contained_Null()
+	}
+	return Node_Contained(*v)
+}
+
+func Node_Contained_Null() Node_Contained_Field {
+	return Node_Contained_Field{_set: true, _null: true}
+}
+
+func (f Node_Contained_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_Contained_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_OwnerId_Field) _Column() string { return "owner_id" }
+func (Node_Contained_Field) _Column() string { return "contained" }
 
-type Project_Salt_Field struct {
+type Node_LastOfflineEmail_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *time.Time
 }
 
-func Project_Salt(v []byte) Project_Salt_Field {
-	return Project_Salt_Field{_set: true, _value: v}
+func Node_LastOfflineEmail(v time.Time) Node_LastOfflineEmail_Field {
+	return Node_LastOfflineEmail_Field{_set: true, _value: &v}
 }
 
-func Project_Salt_Raw(v []byte) Project_Salt_Field {
+func Node_LastOfflineEmail_Raw(v *time.Time) Node_LastOfflineEmail_Field {
 	if v == nil {
-		return Project_Salt_Null()
+		return Node_LastOfflineEmail_Null()
 	}
-	return Project_Salt(v)
+	return Node_LastOfflineEmail(*v)
 }
 
-func Project_Salt_Null() Project_Salt_Field {
-	return Project_Salt_Field{_set: true, _null: true}
+func Node_LastOfflineEmail_Null() Node_LastOfflineEmail_Field {
+	return Node_LastOfflineEmail_Field{_set: true, _null: true}
 }
 
-func (f Project_Salt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Node_LastOfflineEmail_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Project_Salt_Field) value() interface{} {
+func (f Node_LastOfflineEmail_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Salt_Field) _Column() string { return "salt" }
+func (Node_LastOfflineEmail_Field) _Column() string { return "last_offline_email" }
 
-type Project_CreatedAt_Field struct {
+type Node_LastSoftwareUpdateEmail_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *time.Time
 }
 
-func Project_CreatedAt(v time.Time) Project_CreatedAt_Field {
-	return Project_CreatedAt_Field{_set: true, _value: v}
+func Node_LastSoftwareUpdateEmail(v time.Time) Node_LastSoftwareUpdateEmail_Field {
+	return Node_LastSoftwareUpdateEmail_Field{_set: true, _value: &v}
 }
 
-func (f Project_CreatedAt_Field) value() interface{} {
+func Node_LastSoftwareUpdateEmail_Raw(v *time.Time) Node_LastSoftwareUpdateEmail_Field {
+	if v == nil {
+		return Node_LastSoftwareUpdateEmail_Null()
+	}
+	return Node_LastSoftwareUpdateEmail(*v)
+}
+
+func Node_LastSoftwareUpdateEmail_Null() Node_LastSoftwareUpdateEmail_Field {
+	return Node_LastSoftwareUpdateEmail_Field{_set: true, _null: true}
+}
+
+func (f Node_LastSoftwareUpdateEmail_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f Node_LastSoftwareUpdateEmail_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_CreatedAt_Field) _Column() string { return "created_at" }
-
-type ProjectBandwidthDailyRollup struct {
-	ProjectId       []byte
-	IntervalDay     time.Time
-	EgressAllocated uint64
-	EgressSettled   uint64
-	EgressDead      uint64
-}
-
-func (ProjectBandwidthDailyRollup) _Table() string { return "project_bandwidth_daily_rollups" }
-
-type ProjectBandwidthDailyRollup_Create_Fields struct {
-	EgressDead ProjectBandwidthDailyRollup_EgressDead_Field
-}
-
-type ProjectBandwidthDailyRollup_Update_Fields struct {
-	EgressAllocated ProjectBandwidthDailyRollup_EgressAllocated_Field
-	EgressSettled   ProjectBandwidthDailyRollup_EgressSettled_Field
-	EgressDead      ProjectBandwidthDailyRollup_EgressDead_Field
-}
+func (Node_LastSoftwareUpdateEmail_Field) _Column() string { return "last_software_update_email" }
 
-type ProjectBandwidthDailyRollup_ProjectId_Field struct {
+type Node_NoiseProto_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *int
 }
 
-func ProjectBandwidthDailyRollup_ProjectId(v []byte) ProjectBandwidthDailyRollup_ProjectId_Field {
-	return ProjectBandwidthDailyRollup_ProjectId_Field{_set: true, _value: v}
+func Node_NoiseProto(v int) Node_NoiseProto_Field {
+	return Node_NoiseProto_Field{_set: true, _value: &v}
 }
 
-func (f ProjectBandwidthDailyRollup_ProjectId_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Node_NoiseProto_Raw(v *int) Node_NoiseProto_Field {
+	if v == nil {
+		return Node_NoiseProto_Null()
 	}
-	return f._value
+	return Node_NoiseProto(*v)
 }
 
-func (ProjectBandwidthDailyRollup_ProjectId_Field) _Column() string { return "project_id" }
-
-type ProjectBandwidthDailyRollup_IntervalDay_Field struct {
-	_set   bool
-	_null  bool
-	_value time.Time
+func Node_NoiseProto_Null() Node_NoiseProto_Field {
+	return Node_NoiseProto_Field{_set: true, _null: true}
 }
 
-func ProjectBandwidthDailyRollup_IntervalDay(v time.Time) ProjectBandwidthDailyRollup_IntervalDay_Field {
-	v = toDate(v)
-	return ProjectBandwidthDailyRollup_IntervalDay_Field{_set: true, _value: v}
-}
+func (f Node_NoiseProto_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f ProjectBandwidthDailyRollup_IntervalDay_Field) value() interface{} {
+func (f Node_NoiseProto_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthDailyRollup_IntervalDay_Field) _Column() string { return "interval_day" }
+func (Node_NoiseProto_Field) _Column() string { return "noise_proto" }
 
-type ProjectBandwidthDailyRollup_EgressAllocated_Field struct {
+type Node_NoisePublicKey_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func ProjectBandwidthDailyRollup_EgressAllocated(v uint64) ProjectBandwidthDailyRollup_EgressAllocated_Field {
-	return ProjectBandwidthDailyRollup_EgressAllocated_Field{_set: true, _value: v}
+func Node_NoisePublicKey(v []byte) Node_NoisePublicKey_Field {
+	return Node_NoisePublicKey_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthDailyRollup_EgressAllocated_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Node_NoisePublicKey_Raw(v []byte) Node_NoisePublicKey_Field {
+	if v == nil {
+		return Node_NoisePublicKey_Null()
 	}
-	return f._value
+	return Node_NoisePublicKey(v)
 }
 
-func (ProjectBandwidthDailyRollup_EgressAllocated_Field) _Column() string { return "egress_allocated" }
-
-type ProjectBandwidthDailyRollup_EgressSettled_Field struct {
-	_set   bool
-	_null  bool
-	_value uint64
+func Node_NoisePublicKey_Null() Node_NoisePublicKey_Field {
+	return Node_NoisePublicKey_Field{_set: true, _null: true}
 }
 
-func ProjectBandwidthDailyRollup_EgressSettled(v uint64) ProjectBandwidthDailyRollup_EgressSettled_Field {
-	return ProjectBandwidthDailyRollup_EgressSettled_Field{_set: true, _value: v}
-}
+func (f Node_NoisePublicKey_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f ProjectBandwidthDailyRollup_EgressSettled_Field) value() interface{} {
+func (f Node_NoisePublicKey_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthDailyRollup_EgressSettled_Field) _Column() string { return "egress_settled" }
+func (Node_NoisePublicKey_Field) _Column() string { return "noise_public_key" }
 
-type ProjectBandwidthDailyRollup_EgressDead_Field struct {
+type Node_DebounceLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value int
 }
 
-func ProjectBandwidthDailyRollup_EgressDead(v uint64) ProjectBandwidthDailyRollup_EgressDead_Field {
-	return ProjectBandwidthDailyRollup_EgressDead_Field{_set: true, _value: v}
+func Node_DebounceLimit(v int) Node_DebounceLimit_Field {
+	return Node_DebounceLimit_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthDailyRollup_EgressDead_Field) value() interface{} {
+func (f Node_DebounceLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthDailyRollup_EgressDead_Field) _Column() string { return "egress_dead" }
+func (Node_DebounceLimit_Field) _Column() string { return "debounce_limit" }
 
-type RegistrationToken struct {
-	Secret       []byte
-	OwnerId      []byte
-	ProjectLimit int
-	CreatedAt    time.Time
+type NodeApiVersion struct {
+	Id         []byte
+	ApiVersion int
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
 }
 
-func (RegistrationToken) _Table() string { return "registration_tokens" }
-
-type RegistrationToken_Create_Fields struct {
-	OwnerId RegistrationToken_OwnerId_Field
-}
+func (NodeApiVersion) _Table() string { return "node_api_versions" }
 
-type RegistrationToken_Update_Fields struct {
-	OwnerId RegistrationToken_OwnerId_Field
+type NodeApiVersion_Update_Fields struct {
+	ApiVersion NodeApiVersion_ApiVersion_Field
 }
 
-type RegistrationToken_Secret_Field struct {
+type NodeApiVersion_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func RegistrationToken_Secret(v []byte) RegistrationToken_Secret_Field {
-	return RegistrationToken_Secret_Field{_set: true, _value: v}
+func NodeApiVersion_Id(v []byte) NodeApiVersion_Id_Field {
+	return NodeApiVersion_Id_Field{_set: true, _value: v}
 }
 
-func (f RegistrationToken_Secret_Field) value() interface{} {
+func (f NodeApiVersion_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RegistrationToken_Secret_Field) _Column() string { return "secret" }
+func (NodeApiVersion_Id_Field) _Column() string { return "id" }
 
-type RegistrationToken_OwnerId_Field struct {
+type NodeApiVersion_ApiVersion_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
-}
-
-func RegistrationToken_OwnerId(v []byte) RegistrationToken_OwnerId_Field {
-	return RegistrationToken_OwnerId_Field{_set: true, _value: v}
-}
-
-func RegistrationToken_OwnerId_Raw(v []byte) RegistrationToken_OwnerId_Field {
-	if v == nil {
-		return RegistrationToken_OwnerId_Null()
-	}
-	return RegistrationToken_OwnerId(v)
+	_value int
 }
 
-func RegistrationToken_OwnerId_Null() RegistrationToken_OwnerId_Field {
-	return RegistrationToken_OwnerId_Field{_set: true, _null: true}
+func NodeApiVersion_ApiVersion(v int) NodeApiVersion_ApiVersion_Field {
+	return NodeApiVersion_ApiVersion_Field{_set: true, _value: v}
 }
 
-func (f RegistrationToken_OwnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f RegistrationToken_OwnerId_Field) value() interface{} {
+func (f NodeApiVersion_ApiVersion_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RegistrationToken_OwnerId_Field) _Column() string { return "owner_id" }
+func (NodeApiVersion_ApiVersion_Field) _Column() string { return "api_version" }
 
-type RegistrationToken_ProjectLimit_Field struct {
+type NodeApiVersion_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func RegistrationToken_ProjectLimit(v int) RegistrationToken_ProjectLimit_Field {
-	return RegistrationToken_ProjectLimit_Field{_set: true, _value: v}
+func NodeApiVersion_CreatedAt(v time.Time) NodeApiVersion_CreatedAt_Field {
+	return NodeApiVersion_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f RegistrationToken_ProjectLimit_Field) value() interface{} {
+func (f NodeApiVersion_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RegistrationToken_ProjectLimit_Field) _Column() string { return "project_limit" }
+func (NodeApiVersion_CreatedAt_Field) _Column() string { return "created_at" }
 
-type RegistrationToken_CreatedAt_Field struct {
+type NodeApiVersion_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func RegistrationToken_CreatedAt(v time.Time) RegistrationToken_CreatedAt_Field {
-	return RegistrationToken_CreatedAt_Field{_set: true, _value: v}
+func NodeApiVersion_UpdatedAt(v time.Time) NodeApiVersion_UpdatedAt_Field {
+	return NodeApiVersion_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f RegistrationToken_CreatedAt_Field) value() interface{} {
+func (f NodeApiVersion_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RegistrationToken_CreatedAt_Field) _Column() string { return "created_at" }
+func (NodeApiVersion_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type RepairQueue struct {
-	StreamId      []byte
-	Position      uint64
-	AttemptedAt   *time.Time
-	UpdatedAt     time.Time
-	InsertedAt    time.Time
-	SegmentHealth float64
+type NodeAppeal struct {
+	Id         []byte
+	NodeId     []byte
+	Kind       int
+	Status     int
+	Message    string
+	Reputation []byte
+	ReviewNote *string
+	ReviewedBy *string
+	ReviewedAt *time.Time
+	CreatedAt  time.Time
 }
 
-func (RepairQueue) _Table() string { return "repair_queue" }
+func (NodeAppeal) _Table() string { return "node_appeals" }
 
-type RepairQueue_Create_Fields struct {
-	AttemptedAt   RepairQueue_AttemptedAt_Field
-	UpdatedAt     RepairQueue_UpdatedAt_Field
-	InsertedAt    RepairQueue_InsertedAt_Field
-	SegmentHealth RepairQueue_SegmentHealth_Field
+type NodeAppeal_Create_Fields struct {
+	ReviewNote NodeAppeal_ReviewNote_Field
+	ReviewedBy NodeAppeal_ReviewedBy_Field
+	ReviewedAt NodeAppeal_ReviewedAt_Field
 }
 
-type RepairQueue_Update_Fields struct {
-	AttemptedAt RepairQueue_AttemptedAt_Field
-	UpdatedAt   RepairQueue_UpdatedAt_Field
+type NodeAppeal_Update_Fields struct {
+	Status     NodeAppeal_Status_Field
+	ReviewNote NodeAppeal_ReviewNote_Field
+	ReviewedBy NodeAppeal_ReviewedBy_Field
+	ReviewedAt NodeAppeal_ReviewedAt_Field
 }
 
-type RepairQueue_StreamId_Field struct {
+type NodeAppeal_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func RepairQueue_StreamId(v []byte) RepairQueue_StreamId_Field {
-	return RepairQueue_StreamId_Field{_set: true, _value: v}
+func NodeAppeal_Id(v []byte) NodeAppeal_Id_Field {
+	return NodeAppeal_Id_Field{_set: true, _value: v}
 }
 
-func (f RepairQueue_StreamId_Field) value() interface{} {
+func (f NodeAppeal_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RepairQueue_StreamId_Field) _Column() string { return "stream_id" }
+func (NodeAppeal_Id_Field) _Column() string { return "id" }
 
-type RepairQueue_Position_Field struct {
+type NodeAppeal_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func RepairQueue_Position(v uint64) RepairQueue_Position_Field {
-	return RepairQueue_Position_Field{_set: true, _value: v}
+func NodeAppeal_NodeId(v []byte) NodeAppeal_NodeId_Field {
+	return NodeAppeal_NodeId_Field{_set: true, _value: v}
 }
 
-func (f RepairQueue_Position_Field) value() interface{} {
+func (f NodeAppeal_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RepairQueue_Position_Field) _Column() string { return "position" }
+func (NodeAppeal_NodeId_Field) _Column() string { return "node_id" }
 
-type RepairQueue_AttemptedAt_Field struct {
+type NodeAppeal_Kind_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value int
 }
 
-func RepairQueue_AttemptedAt(v time.Time) RepairQueue_AttemptedAt_Field {
-	return RepairQueue_AttemptedAt_Field{_set: true, _value: &v}
+func NodeAppeal_Kind(v int) NodeAppeal_Kind_Field {
+	return NodeAppeal_Kind_Field{_set: true, _value: v}
 }
 
-func RepairQueue_AttemptedAt_Raw(v *time.Time) RepairQueue_AttemptedAt_Field {
-	if v == nil {
-		return RepairQueue_AttemptedAt_Null()
+func (f NodeAppeal_Kind_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return RepairQueue_AttemptedAt(*v)
+	return f._value
 }
 
-func RepairQueue_AttemptedAt_Null() RepairQueue_AttemptedAt_Field {
-	return RepairQueue_AttemptedAt_Field{_set: true, _null: true}
+func (NodeAppeal_Kind_Field) _Column() string { return "kind" }
+
+type NodeAppeal_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (f RepairQueue_AttemptedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func NodeAppeal_Status(v int) NodeAppeal_Status_Field {
+	return NodeAppeal_Status_Field{_set: true, _value: v}
+}
 
-func (f RepairQueue_AttemptedAt_Field) value() interface{} {
+func (f NodeAppeal_Status_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RepairQueue_AttemptedAt_Field) _Column() string { return "attempted_at" }
+func (NodeAppeal_Status_Field) _Column() string { return "status" }
 
-type RepairQueue_UpdatedAt_Field struct {
+type NodeAppeal_Message_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func RepairQueue_UpdatedAt(v time.Time) RepairQueue_UpdatedAt_Field {
-	return RepairQueue_UpdatedAt_Field{_set: true, _value: v}
+func NodeAppeal_Message(v string) NodeAppeal_Message_Field {
+	return NodeAppeal_Message_Field{_set: true, _value: v}
 }
 
-func (f RepairQueue_UpdatedAt_Field) value() interface{} {
+func (f NodeAppeal_Message_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RepairQueue_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (NodeAppeal_Message_Field) _Column() string { return "message" }
 
-type RepairQueue_InsertedAt_Field struct {
+type NodeAppeal_Reputation_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func RepairQueue_InsertedAt(v time.Time) RepairQueue_InsertedAt_Field {
-	return RepairQueue_InsertedAt_Field{_set: true, _value: v}
+func NodeAppeal_Reputation(v []byte) NodeAppeal_Reputation_Field {
+	return NodeAppeal_Reputation_Field{_set: true, _value: v}
 }
 
-func (f RepairQueue_InsertedAt_Field) value() interface{} {
+func (f NodeAppeal_Reputation_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RepairQueue_InsertedAt_Field) _Column() string { return "inserted_at" }
+func (NodeAppeal_Reputation_Field) _Column() string { return "reputation" }
 
-type RepairQueue_SegmentHealth_Field struct {
+type NodeAppeal_ReviewNote_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value *string
 }
 
-func RepairQueue_SegmentHealth(v float64) RepairQueue_SegmentHealth_Field {
-	return RepairQueue_SegmentHealth_Field{_set: true, _value: v}
+func NodeAppeal_ReviewNote(v string) NodeAppeal_ReviewNote_Field {
+	return NodeAppeal_ReviewNote_Field{_set: true, _value: &v}
 }
 
-func (f RepairQueue_SegmentHealth_Field) value() interface{} {
+func NodeAppeal_ReviewNote_Raw(v *string) NodeAppeal_ReviewNote_Field {
+	if v == nil {
+		return NodeAppeal_ReviewNote_Null()
+	}
+	return NodeAppeal_ReviewNote(*v)
+}
+
+func NodeAppeal_ReviewNote_Null() NodeAppeal_ReviewNote_Field {
+	return NodeAppeal_ReviewNote_Field{_set: true, _null: true}
+}
+
+func (f NodeAppeal_ReviewNote_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f NodeAppeal_ReviewNote_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RepairQueue_SegmentHealth_Field) _Column() string { return "segment_health" }
+func (NodeAppeal_ReviewNote_Field) _Column() string { return "review_note" }
 
-type Reputation struct {
-	Id                          []byte
-	AuditSuccessCount           int64
-	TotalAuditCount             int64
-	VettedAt                    *time.Time
-	CreatedAt                   time.Time
-	UpdatedAt                   time.Time
-	Disqualified                *time.Time
-	DisqualificationReason      *int
-	UnknownAuditSuspended       *time.Time
-	OfflineSuspended            *time.Time
-	UnderReview                 *time.Time
-	OnlineScore                 float64
-	AuditHistory                []byte
-	AuditReputationAlpha        float64
-	AuditReputationBeta         float64
-	UnknownAuditReputationAlpha float64
-	UnknownAuditReputationBeta  float64
+type NodeAppeal_ReviewedBy_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
 }
 
-func (Reputation) _Table() string { return "reputations" }
-
-type Reputation_Create_Fields struct {
-	AuditSuccessCount           Reputation_AuditSuccessCount_Field
-	TotalAuditCount             Reputation_TotalAuditCount_Field
-	VettedAt                    Reputation_VettedAt_Field
-	Disqualified                Reputation_Disqualified_Field
-	DisqualificationReason      Reputation_DisqualificationReason_Field
-	UnknownAuditSuspended       Reputation_UnknownAuditSuspended_Field
-	OfflineSuspended            Reputation_OfflineSuspended_Field
-	UnderReview                 Reputation_UnderReview_Field
-	OnlineScore                 Reputation_OnlineScore_Field
-	AuditReputationAlpha        Reputation_AuditReputationAlpha_Field
-	AuditReputationBeta         Reputation_AuditReputationBeta_Field
-	UnknownAuditReputationAlpha Reputation_UnknownAuditReputationAlpha_Field
-	UnknownAuditReputationBeta  Reputation_UnknownAuditReputationBeta_Field
+func NodeAppeal_ReviewedBy(v string) NodeAppeal_ReviewedBy_Field {
+	return NodeAppeal_ReviewedBy_Field{_set: true, _value: &v}
 }
 
-type Reputation_Update_Fields struct {
-	AuditSuccessCount           Reputation_AuditSuccessCount_Field
-	TotalAuditCount             Reputation_TotalAuditCount_Field
-	VettedAt                    Reputation_VettedAt_Field
-	Disqualified                Reputation_Disqualified_Field
-	DisqualificationReason      Reputation_DisqualificationReason_Field
-	UnknownAuditSuspended       Reputation_UnknownAuditSuspended_Field
-	OfflineSuspended            Reputation_OfflineSuspended_Field
-	UnderReview                 Reputation_UnderReview_Field
-	OnlineScore                 Reputation_OnlineScore_Field
-	AuditHistory                Reputation_AuditHistory_Field
-	AuditReputationAlpha        Reputation_AuditReputationAlpha_Field
-	AuditReputationBeta         Reputation_AuditReputationBeta_Field
-	UnknownAuditReputationAlpha Reputation_UnknownAuditReputationAlpha_Field
-	UnknownAuditReputationBeta  Reputation_UnknownAuditReputationBeta_Field
+func NodeAppeal_ReviewedBy_Raw(v *string) NodeAppeal_ReviewedBy_Field {
+	if v == nil {
+		return NodeAppeal_ReviewedBy_Null()
+	}
+	return NodeAppeal_ReviewedBy(*v)
 }
 
-type Reputation_Id_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func NodeAppeal_ReviewedBy_Null() NodeAppeal_ReviewedBy_Field {
+	return NodeAppeal_ReviewedBy_Field{_set: true, _null: true}
 }
 
-func Reputation_Id(v []byte) Reputation_Id_Field {
-	return Reputation_Id_Field{_set: true, _value: v}
-}
+func (f NodeAppeal_ReviewedBy_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Reputation_Id_Field) value() interface{} {
+func (f NodeAppeal_ReviewedBy_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_Id_Field) _Column() string { return "id" }
+func (NodeAppeal_ReviewedBy_Field) _Column() string { return "reviewed_by" }
 
-type Reputation_AuditSuccessCount_Field struct {
+type NodeAppeal_ReviewedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *time.Time
 }
 
-func Reputation_AuditSuccessCount(v int64) Reputation_AuditSuccessCount_Field {
-	return Reputation_AuditSuccessCount_Field{_set: true, _value: v}
+func NodeAppeal_ReviewedAt(v time.Time) NodeAppeal_ReviewedAt_Field {
+	return NodeAppeal_ReviewedAt_Field{_set: true, _value: &v}
 }
 
-func (f Reputation_AuditSuccessCount_Field) value() interface{} {
+func NodeAppeal_ReviewedAt_Raw(v *time.Time) NodeAppeal_ReviewedAt_Field {
+	if v == nil {
+		return NodeAppeal_ReviewedAt_Null()
+	}
+	return NodeAppeal_ReviewedAt(*v)
+}
+
+func NodeAppeal_ReviewedAt_Null() NodeAppeal_ReviewedAt_Field {
+	return NodeAppeal_ReviewedAt_Field{_set: true, _null: true}
+}
+
+func (f NodeAppeal_ReviewedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f NodeAppeal_ReviewedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
+func (NodeAppeal_ReviewedAt_Field) _Column() string { return "reviewed_at" }
 
-type Reputation_TotalAuditCount_Field struct {
+type NodeAppeal_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Reputation_TotalAuditCount(v int64) Reputation_TotalAuditCount_Field {
-	return Reputation_TotalAuditCount_Field{_set: true, _value: v}
+func NodeAppeal_CreatedAt(v time.Time) NodeAppeal_CreatedAt_Field {
+	return NodeAppeal_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Reputation_TotalAuditCount_Field) value() interface{} {
+func (f NodeAppeal_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_TotalAuditCount_Field) _Column() string { return "total_audit_count" }
+func (NodeAppeal_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Reputation_VettedAt_Field struct {
-	_set   bool
-	_null  bool
-	_value *time.Time
+type NodeAppealEvent struct {
+	Id        []byte
+	AppealId  []byte
+	NodeId    []byte
+	Action    string
+	Actor     string
+	Note      string
+	CreatedAt time.Time
 }
 
-func Reputation_VettedAt(v time.Time) Reputation_VettedAt_Field {
-	return Reputation_VettedAt_Field{_set: true, _value: &v}
+func (NodeAppealEvent) _Table() string { return "node_appeal_events" }
+
+type NodeAppealEvent_Create_Fields struct {
 }
 
-func Reputation_VettedAt_Raw(v *time.Time) Reputation_VettedAt_Field {
-	if v == nil {
-		return Reputation_VettedAt_Null()
-	}
-	return Reputation_VettedAt(*v)
+type NodeAppealEvent_Update_Fields struct {
 }
 
-func Reputation_VettedAt_Null() Reputation_VettedAt_Field {
-	return Reputation_VettedAt_Field{_set: true, _null: true}
+type NodeAppealEvent_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f Reputation_VettedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func NodeAppealEvent_Id(v []byte) NodeAppealEvent_Id_Field {
+	return NodeAppealEvent_Id_Field{_set: true, _value: v}
+}
 
-func (f Reputation_VettedAt_Field) value() interface{} {
+func (f NodeAppealEvent_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_VettedAt_Field) _Column() string { return "vetted_at" }
+func (NodeAppealEvent_Id_Field) _Column() string { return "id" }
 
-type Reputation_CreatedAt_Field struct {
+type NodeAppealEvent_AppealId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Reputation_CreatedAt(v time.Time) Reputation_CreatedAt_Field {
-	return Reputation_CreatedAt_Field{_set: true, _value: v}
+func NodeAppealEvent_AppealId(v []byte) NodeAppealEvent_AppealId_Field {
+	return NodeAppealEvent_AppealId_Field{_set: true, _value: v}
 }
 
-func (f Reputation_CreatedAt_Field) value() interface{} {
+func (f NodeAppealEvent_AppealId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_CreatedAt_Field) _Column() string { return "created_at" }
+func (NodeAppealEvent_AppealId_Field) _Column() string { return "appeal_id" }
 
-type Reputation_UpdatedAt_Field struct {
+type NodeAppealEvent_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Reputation_UpdatedAt(v time.Time) Reputation_UpdatedAt_Field {
-	return Reputation_UpdatedAt_Field{_set: true, _value: v}
+func NodeAppealEvent_NodeId(v []byte) NodeAppealEvent_NodeId_Field {
+	return NodeAppealEvent_NodeId_Field{_set: true, _value: v}
 }
 
-func (f Reputation_UpdatedAt_Field) value() interface{} {
+func (f NodeAppealEvent_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (NodeAppealEvent_NodeId_Field) _Column() string { return "node_id" }
 
-type Reputation_Disqualified_Field struct {
+type NodeAppealEvent_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
-}
-
-func Reputation_Disqualified(v time.Time) Reputation_Disqualified_Field {
-	return Reputation_Disqualified_Field{_set: true, _value: &v}
-}
-
-func Reputation_Disqualified_Raw(v *time.Time) Reputation_Disqualified_Field {
-	if v == nil {
-		return Reputation_Disqualified_Null()
-	}
-	return Reputation_Disqualified(*v)
+	_value string
 }
 
-func Reputation_Disqualified_Null() Reputation_Disqualified_Field {
-	return Reputation_Disqualified_Field{_set: true, _null: true}
+func NodeAppealEvent_Action(v string) NodeAppealEvent_Action_Field {
+	return NodeAppealEvent_Action_Field{_set: true, _value: v}
 }
 
-func (f Reputation_Disqualified_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Reputation_Disqualified_Field) value() interface{} {
+func (f NodeAppealEvent_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_Disqualified_Field) _Column() string { return "disqualified" }
+func (NodeAppealEvent_Action_Field) _Column() string { return "action" }
 
-type Reputation_DisqualificationReason_Field struct {
+type NodeAppealEvent_Actor_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
-}
-
-func Reputation_DisqualificationReason(v int) Reputation_DisqualificationReason_Field {
-	return Reputation_DisqualificationReason_Field{_set: true, _value: &v}
-}
-
-func Reputation_DisqualificationReason_Raw(v *int) Reputation_DisqualificationReason_Field {
-	if v == nil {
-		return Reputation_DisqualificationReason_Null()
-	}
-	return Reputation_DisqualificationReason(*v)
-}
-
-func Reputation_DisqualificationReason_Null() Reputation_DisqualificationReason_Field {
-	return Reputation_DisqualificationReason_Field{_set: true, _null: true}
+	_value string
 }
 
-func (f Reputation_DisqualificationReason_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func NodeAppealEvent_Actor(v string) NodeAppealEvent_Actor_Field {
+	return NodeAppealEvent_Actor_Field{_set: true, _value: v}
 }
 
-func (f Reputation_DisqualificationReason_Field) value() interface{} {
+func (f NodeAppealEvent_Actor_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_DisqualificationReason_Field) _Column() string { return "disqualification_reason" }
+func (NodeAppealEvent_Actor_Field) _Column() string { return "actor" }
 
-type Reputation_UnknownAuditSuspended_Field struct {
+type NodeAppealEvent_Note_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value string
 }
 
-func Reputation_UnknownAuditSuspended(v time.Time) Reputation_UnknownAuditSuspended_Field {
-	return Reputation_UnknownAuditSuspended_Field{_set: true, _value: &v}
+func NodeAppealEvent_Note(v string) NodeAppealEvent_Note_Field {
+	return NodeAppealEvent_Note_Field{_set: true, _value: v}
 }
 
-func Reputation_UnknownAuditSuspended_Raw(v *time.Time) Reputation_UnknownAuditSuspended_Field {
-	if v == nil {
-		return Reputation_UnknownAuditSuspended_Null()
+func (f NodeAppealEvent_Note_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Reputation_UnknownAuditSuspended(*v)
+	return f._value
 }
 
-func Reputation_UnknownAuditSuspended_Null() Reputation_UnknownAuditSuspended_Field {
-	return Reputation_UnknownAuditSuspended_Field{_set: true, _null: true}
+func (NodeAppealEvent_Note_Field) _Column() string { return "note" }
+
+type NodeAppealEvent_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f Reputation_UnknownAuditSuspended_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func NodeAppealEvent_CreatedAt(v time.Time) NodeAppealEvent_CreatedAt_Field {
+	return NodeAppealEvent_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Reputation_UnknownAuditSuspended_Field) value() interface{} {
+func (f NodeAppealEvent_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_UnknownAuditSuspended_Field) _Column() string { return "unknown_audit_suspended" }
+func (NodeAppealEvent_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Reputation_OfflineSuspended_Field struct {
-	_set   bool
-	_null  bool
-	_value *time.Time
+type NodeCapability struct {
+	NodeId         []byte
+	HashAlgorithms string
+	TcpFastOpen    bool
+	Noise          bool
+	MaxPieceSize   int64
+	UpdatedAt      time.Time
 }
 
-func Reputation_OfflineSuspended(v time.Time) Reputation_OfflineSuspended_Field {
-	return Reputation_OfflineSuspended_Field{_set: true, _value: &v}
+func (NodeCapability) _Table() string { return "node_capabilities" }
+
+type NodeCapability_Create_Fields struct {
+	UpdatedAt NodeCapability_UpdatedAt_Field
 }
 
-func Reputation_OfflineSuspended_Raw(v *time.Time) Reputation_OfflineSuspended_Field {
-	if v == nil {
-		return Reputation_OfflineSuspended_Null()
-	}
-	return Reputation_OfflineSuspended(*v)
+type NodeCapability_Update_Fields struct {
 }
 
-func Reputation_OfflineSuspended_Null() Reputation_OfflineSuspended_Field {
-	return Reputation_OfflineSuspended_Field{_set: true, _null: true}
+type NodeCapability_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f Reputation_OfflineSuspended_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func NodeCapability_NodeId(v []byte) NodeCapability_NodeId_Field {
+	return NodeCapability_NodeId_Field{_set: true, _value: v}
 }
 
-func (f Reputation_OfflineSuspended_Field) value() interface{} {
+func (f NodeCapability_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_OfflineSuspended_Field) _Column() string { return "offline_suspended" }
+func (NodeCapability_NodeId_Field) _Column() string { return "node_id" }
 
-type Reputation_UnderReview_Field struct {
+type NodeCapability_HashAlgorithms_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
-}
-
-func Reputation_UnderReview(v time.Time) Reputation_UnderReview_Field {
-	return Reputation_UnderReview_Field{_set: true, _value: &v}
-}
-
-func Reputation_UnderReview_Raw(v *time.Time) Reputation_UnderReview_Field {
-	if v == nil {
-		return Reputation_UnderReview_Null()
-	}
-	return Reputation_UnderReview(*v)
+	_value string
 }
 
-func Reputation_UnderReview_Null() Reputation_UnderReview_Field {
-	return Reputation_UnderReview_Field{_set: true, _null: true}
+func NodeCapability_HashAlgorithms(v string) NodeCapability_HashAlgorithms_Field {
+	return NodeCapability_HashAlgorithms_Field{_set: true, _value: v}
 }
 
-func (f Reputation_UnderReview_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Reputation_UnderReview_Field) value() interface{} {
+func (f NodeCapability_HashAlgorithms_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_UnderReview_Field) _Column() string { return "under_review" }
+func (NodeCapability_HashAlgorithms_Field) _Column() string { return "hash_algorithms" }
 
-type Reputation_OnlineScore_Field struct {
+type NodeCapability_TcpFastOpen_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value bool
 }
 
-func Reputation_OnlineScore(v float64) Reputation_OnlineScore_Field {
-	return Reputation_OnlineScore_Field{_set: true, _value: v}
+func NodeCapability_TcpFastOpen(v bool) NodeCapability_TcpFastOpen_Field {
+	return NodeCapability_TcpFastOpen_Field{_set: true, _value: v}
 }
 
-func (f Reputation_OnlineScore_Field) value() interface{} {
+func (f NodeCapability_TcpFastOpen_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_OnlineScore_Field) _Column() string { return "online_score" }
+func (NodeCapability_TcpFastOpen_Field) _Column() string { return "tcp_fast_open" }
 
-type Reputation_AuditHistory_Field struct {
+type NodeCapability_Noise_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value bool
 }
 
-func Reputation_AuditHistory(v []byte) Reputation_AuditHistory_Field {
-	return Reputation_AuditHistory_Field{_set: true, _value: v}
+func NodeCapability_Noise(v bool) NodeCapability_Noise_Field {
+	return NodeCapability_Noise_Field{_set: true, _value: v}
 }
 
-func (f Reputation_AuditHistory_Field) value() interface{} {
+func (f NodeCapability_Noise_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_AuditHistory_Field) _Column() string { return "audit_history" }
+func (NodeCapability_Noise_Field) _Column() string { return "noise" }
 
-type Reputation_AuditReputationAlpha_Field struct {
+type NodeCapability_MaxPieceSize_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value int64
 }
 
-func Reputation_AuditReputationAlpha(v float64) Reputation_AuditReputationAlpha_Field {
-	return Reputation_AuditReputationAlpha_Field{_set: true, _value: v}
+func NodeCapability_MaxPieceSize(v int64) NodeCapability_MaxPieceSize_Field {
+	return NodeCapability_MaxPieceSize_Field{_set: true, _value: v}
 }
 
-func (f Reputation_AuditReputationAlpha_Field) value() interface{} {
+func (f NodeCapability_MaxPieceSize_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_AuditReputationAlpha_Field) _Column() string { return "audit_reputation_alpha" }
+func (NodeCapability_MaxPieceSize_Field) _Column() string { return "max_piece_size" }
 
-type Reputation_AuditReputationBeta_Field struct {
+type NodeCapability_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value time.Time
 }
 
-func Reputation_AuditReputationBeta(v float64) Reputation_AuditReputationBeta_Field {
-	return Reputation_AuditReputationBeta_Field{_set: true, _value: v}
+func NodeCapability_UpdatedAt(v time.Time) NodeCapability_UpdatedAt_Field {
+	return NodeCapability_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f Reputation_AuditReputationBeta_Field) value() interface{} {
+func (f NodeCapability_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_AuditReputationBeta_Field) _Column() string { return "audit_reputation_beta" }
+func (NodeCapability_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type Reputation_UnknownAuditReputationAlpha_Field struct {
+type NodeCorruptionEvent struct {
+	Id        []byte
+	NodeId    []byte
+	Source    string
+	Count     int
+	CreatedAt time.Time
+}
+
+func (NodeCorruptionEvent) _Table() string { return "node_corruption_events" }
+
+type NodeCorruptionEvent_Create_Fields struct {
+}
+
+type NodeCorruptionEvent_Update_Fields struct {
+}
+
+type NodeCorruptionEvent_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value []byte
 }
 
-func Reputation_UnknownAuditReputationAlpha(v float64) Reputation_UnknownAuditReputationAlpha_Field {
-	return Reputation_UnknownAuditReputationAlpha_Field{_set: true, _value: v}
+func NodeCorruptionEvent_Id(v []byte) NodeCorruptionEvent_Id_Field {
+	return NodeCorruptionEvent_Id_Field{_set: true, _value: v}
 }
 
-func (f Reputation_UnknownAuditReputationAlpha_Field) value() interface{} {
+func (f NodeCorruptionEvent_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_UnknownAuditReputationAlpha_Field) _Column() string {
-	return "unknown_audit_reputation_alpha"
-}
+func (NodeCorruptionEvent_Id_Field) _Column() string { return "id" }
 
-type Reputation_UnknownAuditReputationBeta_Field struct {
+type NodeCorruptionEvent_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value []byte
 }
 
-func Reputation_UnknownAuditReputationBeta(v float64) Reputation_UnknownAuditReputationBeta_Field {
-	return Reputation_UnknownAuditReputationBeta_Field{_set: true, _value: v}
+func NodeCorruptionEvent_NodeId(v []byte) NodeCorruptionEvent_NodeId_Field {
+	return NodeCorruptionEvent_NodeId_Field{_set: true, _value: v}
 }
 
-func (f Reputation_UnknownAuditReputationBeta_Field) value() interface{} {
+func (f NodeCorruptionEvent_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_UnknownAuditReputationBeta_Field) _Column() string {
-	return "unknown_audit_reputation_beta"
-}
-
-type ResetPasswordToken struct {
-	Secret    []byte
-	OwnerId   []byte
-	CreatedAt time.Time
-}
-
-func (ResetPasswordToken) _Table() string { return "reset_password_tokens" }
-
-type ResetPasswordToken_Update_Fields struct {
-	OwnerId ResetPasswordToken_OwnerId_Field
-}
+func (NodeCorruptionEvent_NodeId_Field) _Column() string { return "node_id" }
 
-type ResetPasswordToken_Secret_Field struct {
+type NodeCorruptionEvent_Source_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func ResetPasswordToken_Secret(v []byte) ResetPasswordToken_Secret_Field {
-	return ResetPasswordToken_Secret_Field{_set: true, _value: v}
+func NodeCorruptionEvent_Source(v string) NodeCorruptionEvent_Source_Field {
+	return NodeCorruptionEvent_Source_Field{_set: true, _value: v}
 }
 
-func (f ResetPasswordToken_Secret_Field) value() interface{} {
+func (f NodeCorruptionEvent_Source_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ResetPasswordToken_Secret_Field) _Column() string { return "secret" }
+func (NodeCorruptionEvent_Source_Field) _Column() string { return "source" }
 
-type ResetPasswordToken_OwnerId_Field struct {
+type NodeCorruptionEvent_Count_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func ResetPasswordToken_OwnerId(v []byte) ResetPasswordToken_OwnerId_Field {
-	return ResetPasswordToken_OwnerId_Field{_set: true, _value: v}
+func NodeCorruptionEvent_Count(v int) NodeCorruptionEvent_Count_Field {
+	return NodeCorruptionEvent_Count_Field{_set: true, _value: v}
 }
 
-func (f ResetPasswordToken_OwnerId_Field) value() interface{} {
+func (f NodeCorruptionEvent_Count_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ResetPasswordToken_OwnerId_Field) _Column() string { return "owner_id" }
+func (NodeCorruptionEvent_Count_Field) _Column() string { return "count" }
 
-type ResetPasswordToken_CreatedAt_Field struct {
+type NodeCorruptionEvent_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func ResetPasswordToken_CreatedAt(v time.Time) ResetPasswordToken_CreatedAt_Field {
-	return ResetPasswordToken_CreatedAt_Field{_set: true, _value: v}
+func NodeCorruptionEvent_CreatedAt(v time.Time) NodeCorruptionEvent_CreatedAt_Field {
+	return NodeCorruptionEvent_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f ResetPasswordToken_CreatedAt_Field) value() interface{} {
+func (f NodeCorruptionEvent_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ResetPasswordToken_CreatedAt_Field) _Column() string { return "created_at" }
+func (NodeCorruptionEvent_CreatedAt_Field) _Column() string { return "created_at" }
 
-type ReverificationAudits struct {
+type NodeEvent struct {
+	Id            []byte
+	Email         string
 	NodeId        []byte
-	StreamId      []byte
-	Position      uint64
-	PieceNum      int
-	InsertedAt    time.Time
-	LastAttempt   *time.Time
-	ReverifyCount int64
+	Event         int
+	CreatedAt     time.Time
+	LastAttempted *time.Time
+	EmailSent     *time.Time
 }
 
-func (ReverificationAudits) _Table() string { return "reverification_audits" }
+func (NodeEvent) _Table() string { return "node_events" }
 
-type ReverificationAudits_Create_Fields struct {
-	InsertedAt    ReverificationAudits_InsertedAt_Field
-	LastAttempt   ReverificationAudits_LastAttempt_Field
-	ReverifyCount ReverificationAudits_ReverifyCount_Field
+type NodeEvent_Create_Fields struct {
+	CreatedAt     NodeEvent_CreatedAt_Field
+	LastAttempted NodeEvent_LastAttempted_Field
+	EmailSent     NodeEvent_EmailSent_Field
 }
 
-type ReverificationAudits_Update_Fields struct {
-	LastAttempt   ReverificationAudits_LastAttempt_Field
-	ReverifyCount ReverificationAudits_ReverifyCount_Field
+type NodeEvent_Update_Fields struct {
+	LastAttempted NodeEvent_LastAttempted_Field
+	EmailSent     NodeEvent_EmailSent_Field
 }
 
-type ReverificationAudits_NodeId_Field struct {
+type NodeEvent_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func ReverificationAudits_NodeId(v []byte) ReverificationAudits_NodeId_Field {
-	return ReverificationAudits_NodeId_Field{_set: true, _value: v}
+func NodeEvent_Id(v []byte) NodeEvent_Id_Field {
+	return NodeEvent_Id_Field{_set: true, _value: v}
 }
 
-func (f ReverificationAudits_NodeId_Field) value() interface{} {
+func (f NodeEvent_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ReverificationAudits_NodeId_Field) _Column() string { return "node_id" }
+func (NodeEvent_Id_Field) _Column() string { return "id" }
 
-type ReverificationAudits_StreamId_Field struct {
+type NodeEvent_Email_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func ReverificationAudits_StreamId(v []byte) ReverificationAudits_StreamId_Field {
-	return ReverificationAudits_StreamId_Field{_set: true, _value: v}
+func NodeEvent_Email(v string) NodeEvent_Email_Field {
+	return NodeEvent_Email_Field{_set: true, _value: v}
 }
 
-func (f ReverificationAudits_StreamId_Field) value() interface{} {
+func (f NodeEvent_Email_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ReverificationAudits_StreamId_Field) _Column() string { return "stream_id" }
+func (NodeEvent_Email_Field) _Column() string { return "email" }
 
-type ReverificationAudits_Position_Field struct {
+type NodeEvent_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func ReverificationAudits_Position(v uint64) ReverificationAudits_Position_Field {
-	return ReverificationAudits_Position_Field{_set: true, _value: v}
+func NodeEvent_NodeId(v []byte) NodeEvent_NodeId_Field {
+	return NodeEvent_NodeId_Field{_set: true, _value: v}
 }
 
-func (f ReverificationAudits_Position_Field) value() interface{} {
+func (f NodeEvent_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ReverificationAudits_Position_Field) _Column() string { return "position" }
+func (NodeEvent_NodeId_Field) _Column() string { return "node_id" }
 
-type ReverificationAudits_PieceNum_Field struct {
+type NodeEvent_Event_Field struct {
 	_set   bool
 	_null  bool
 	_value int
 }
 
-func ReverificationAudits_PieceNum(v int) ReverificationAudits_PieceNum_Field {
-	return ReverificationAudits_PieceNum_Field{_set: true, _value: v}
+func NodeEvent_Event(v int) NodeEvent_Event_Field {
+	return NodeEvent_Event_Field{_set: true, _value: v}
 }
 
-func (f ReverificationAudits_PieceNum_Field) value() interface{} {
+func (f NodeEvent_Event_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ReverificationAudits_PieceNum_Field) _Column() string { return "piece_num" }
+func (NodeEvent_Event_Field) _Column() string { return "event" }
 
-type ReverificationAudits_InsertedAt_Field struct {
+type NodeEvent_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func ReverificationAudits_InsertedAt(v time.Time) ReverificationAudits_InsertedAt_Field {
-	return ReverificationAudits_InsertedAt_Field{_set: true, _value: v}
+func NodeEvent_CreatedAt(v time.Time) NodeEvent_CreatedAt_Field {
+	return NodeEvent_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f ReverificationAudits_InsertedAt_Field) value() interface{} {
+func (f NodeEvent_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ReverificationAudits_InsertedAt_Field) _Column() string { return "inserted_at" }
+func (NodeEvent_CreatedAt_Field) _Column() string { return "created_at" }
 
-type ReverificationAudits_LastAttempt_Field struct {
+type NodeEvent_LastAttempted_Field struct {
 	_set   bool
 	_null  bool
 	_value *time.Time
 }
 
-func ReverificationAudits_LastAttempt(v time.Time) ReverificationAudits_LastAttempt_Field {
-	return ReverificationAudits_LastAttempt_Field{_set: true, _value: &v}
+func NodeEvent_LastAttempted(v time.Time) NodeEvent_LastAttempted_Field {
+	return NodeEvent_LastAttempted_Field{_set: true, _value: &v}
 }
 
-func ReverificationAudits_LastAttempt_Raw(v *time.Time) ReverificationAudits_LastAttempt_Field {
+func NodeEvent_LastAttempted_Raw(v *time.Time) NodeEvent_LastAttempted_Field {
 	if v == nil {
-		return ReverificationAudits_LastAttempt_Null()
+		return NodeEvent_LastAttempted_Null()
 	}
-	return ReverificationAudits_LastAttempt(*v)
+	return NodeEvent_LastAttempted(*v)
 }
 
-func ReverificationAudits_LastAttempt_Null() ReverificationAudits_LastAttempt_Field {
-	return ReverificationAudits_LastAttempt_Field{_set: true, _null: true}
+func NodeEvent_LastAttempted_Null() NodeEvent_LastAttempted_Field {
+	return NodeEvent_LastAttempted_Field{_set: true, _null: true}
 }
 
-func (f ReverificationAudits_LastAttempt_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
-}
+func (f NodeEvent_LastAttempted_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f ReverificationAudits_LastAttempt_Field) value() interface{} {
+func (f NodeEvent_LastAttempted_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ReverificationAudits_LastAttempt_Field) _Column() string { return "last_attempt" }
+func (NodeEvent_LastAttempted_Field) _Column() string { return "last_attempted" }
 
-type ReverificationAudits_ReverifyCount_Field struct {
+type NodeEvent_EmailSent_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *time.Time
 }
 
-func ReverificationAudits_ReverifyCount(v int64) ReverificationAudits_ReverifyCount_Field {
-	return ReverificationAudits_ReverifyCount_Field{_set: true, _value: v}
+func NodeEvent_EmailSent(v time.Time) NodeEvent_EmailSent_Field {
+	return NodeEvent_EmailSent_Field{_set: true, _value: &v}
 }
 
-func (f ReverificationAudits_ReverifyCount_Field) value() interface{} {
+func NodeEvent_EmailSent_Raw(v *time.Time) NodeEvent_EmailSent_Field {
+	if v == nil {
+		return NodeEvent_EmailSent_Null()
+	}
+	return NodeEvent_EmailSent(*v)
+}
+
+func NodeEvent_EmailSent_Null() NodeEvent_EmailSent_Field {
+	return NodeEvent_EmailSent_Field{_set: true, _null: true}
+}
+
+func (f NodeEvent_EmailSent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f NodeEvent_EmailSent_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ReverificationAudits_ReverifyCount_Field) _Column() string { return "reverify_count" }
+func (NodeEvent_EmailSent_Field) _Column() string { return "email_sent" }
 
-type Revocation struct {
-	Revoked  []byte
-	ApiKeyId []byte
+type NodeMaintenanceWindow struct {
+	Id            []byte
+	NodeId        []byte
+	StartsAt      time.Time
+	EndsAt        time.Time
+	Reason        string
+	OfflineAudits int64
+	CreatedAt     time.Time
 }
 
-func (Revocation) _Table() string { return "revocations" }
+func (NodeMaintenanceWindow) _Table() string { return "node_maintenance_windows" }
 
-type Revocation_Update_Fields struct {
+type NodeMaintenanceWindow_Create_Fields struct {
+	OfflineAudits NodeMaintenanceWindow_OfflineAudits_Field
 }
 
-type Revocation_Revoked_Field struct {
+type NodeMaintenanceWindow_Update_Fields struct {
+	OfflineAudits NodeMaintenanceWindow_OfflineAudits_Field
+}
+
+type NodeMaintenanceWindow_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Revocation_Revoked(v []byte) Revocation_Revoked_Field {
-	return Revocation_Revoked_Field{_set: true, _value: v}
+func NodeMaintenanceWindow_Id(v []byte) NodeMaintenanceWindow_Id_Field {
+	return NodeMaintenanceWindow_Id_Field{_set: true, _value: v}
 }
 
-func (f Revocation_Revoked_Field) value() interface{} {
+func (f NodeMaintenanceWindow_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Revocation_Revoked_Field) _Column() string { return "revoked" }
+func (NodeMaintenanceWindow_Id_Field) _Column() string { return "id" }
 
-type Revocation_ApiKeyId_Field struct {
+type NodeMaintenanceWindow_NodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Revocation_ApiKeyId(v []byte) Revocation_ApiKeyId_Field {
-	return Revocation_ApiKeyId_Field{_set: true, _value: v}
+func NodeMaintenanceWindow_NodeId(v []byte) NodeMaintenanceWindow_NodeId_Field {
+	return NodeMaintenanceWindow_NodeId_Field{_set: true, _value: v}
 }
 
-func (f Revocation_ApiKeyId_Field) value() interface{} {
+func (f NodeMaintenanceWindow_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Revocation_ApiKeyId_Field) _Column() string { return "api_key_id" }
-
-type SegmentPendingAudits struct {
-	NodeId            []byte
-	StreamId          []byte
-	Position          uint64
-	PieceId           []byte
-	StripeIndex       int64
-	ShareSize         int64
-	ExpectedShareHash []byte
-	ReverifyCount     int64
-}
-
-func (SegmentPendingAudits) _Table() string { return "segment_pending_audits" }
-
-type SegmentPendingAudits_Update_Fields struct {
-	ReverifyCount SegmentPendingAudits_ReverifyCount_Field
-}
+func (NodeMaintenanceWindow_NodeId_Field) _Column() string { return "node_id" }
 
-type SegmentPendingAudits_NodeId_Field struct {
+type NodeMaintenanceWindow_StartsAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func SegmentPendingAudits_NodeId(v []byte) SegmentPendingAudits_NodeId_Field {
-	return SegmentPendingAudits_NodeId_Field{_set: true, _value: v}
+func NodeMaintenanceWindow_StartsAt(v time.Time) NodeMaintenanceWindow_StartsAt_Field {
+	return NodeMaintenanceWindow_StartsAt_Field{_set: true, _value: v}
 }
 
-func (f SegmentPendingAudits_NodeId_Field) value() interface{} {
+func (f NodeMaintenanceWindow_StartsAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SegmentPendingAudits_NodeId_Field) _Column() string { return "node_id" }
+func (NodeMaintenanceWindow_StartsAt_Field) _Column() string { return "starts_at" }
 
-type SegmentPendingAudits_StreamId_Field struct {
+type NodeMaintenanceWindow_EndsAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func SegmentPendingAudits_StreamId(v []byte) SegmentPendingAudits_StreamId_Field {
-	return SegmentPendingAudits_StreamId_Field{_set: true, _value: v}
+func NodeMaintenanceWindow_EndsAt(v time.Time) NodeMaintenanceWindow_EndsAt_Field {
+	return NodeMaintenanceWindow_EndsAt_Field{_set: true, _value: v}
 }
 
-func (f SegmentPendingAudits_StreamId_Field) value() interface{} {
+func (f NodeMaintenanceWindow_EndsAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SegmentPendingAudits_StreamId_Field) _Column() string { return "stream_id" }
+func (NodeMaintenanceWindow_EndsAt_Field) _Column() string { return "ends_at" }
 
-type SegmentPendingAudits_Position_Field struct {
+type NodeMaintenanceWindow_Reason_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value string
 }
 
-func SegmentPendingAudits_Position(v uint64) SegmentPendingAudits_Position_Field {
-	return SegmentPendingAudits_Position_Field{_set: true, _value: v}
+func NodeMaintenanceWindow_Reason(v string) NodeMaintenanceWindow_Reason_Field {
+	return NodeMaintenanceWindow_Reason_Field{_set: true, _value: v}
 }
 
-func (f SegmentPendingAudits_Position_Field) value() interface{} {
+func (f NodeMaintenanceWindow_Reason_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SegmentPendingAudits_Position_Field) _Column() string { return "position" }
+func (NodeMaintenanceWindow_Reason_Field) _Column() string { return "reason" }
 
-type SegmentPendingAudits_PieceId_Field struct {
+type NodeMaintenanceWindow_OfflineAudits_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func SegmentPendingAudits_PieceId(v []byte) SegmentPendingAudits_PieceId_Field {
-	return SegmentPendingAudits_PieceId_Field{_set: true, _value: v}
+func NodeMaintenanceWindow_OfflineAudits(v int64) NodeMaintenanceWindow_OfflineAudits_Field {
+	return NodeMaintenanceWindow_OfflineAudits_Field{_set: true, _value: v}
 }
 
-func (f SegmentPendingAudits_PieceId_Field) value() interface{} {
+func (f NodeMaintenanceWindow_OfflineAudits_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SegmentPendingAudits_PieceId_Field) _Column() string { return "piece_id" }
+func (NodeMaintenanceWindow_OfflineAudits_Field) _Column() string { return "offline_audits" }
 
-type SegmentPendingAudits_StripeIndex_Field struct {
+type NodeMaintenanceWindow_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func SegmentPendingAudits_StripeIndex(v int64) SegmentPendingAudits_StripeIndex_Field {
-	return SegmentPendingAudits_StripeIndex_Field{_set: true, _value: v}
+func NodeMaintenanceWindow_CreatedAt(v time.Time) NodeMaintenanceWindow_CreatedAt_Field {
+	return NodeMaintenanceWindow_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f SegmentPendingAudits_StripeIndex_Field) value() interface{} {
+func (f NodeMaintenanceWindow_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SegmentPendingAudits_StripeIndex_Field) _Column() string { return "stripe_index" }
+func (NodeMaintenanceWindow_CreatedAt_Field) _Column() string { return "created_at" }
 
-type SegmentPendingAudits_ShareSize_Field struct {
-	_set   bool
-	_null  bool
-	_value int64
+type NodeMessage struct {
+	Id        []byte
+	Kind      string
+	Title     string
+	Body      string
+	Targeted  bool
+	ExpiresAt *time.Time
+	CreatedAt time.Time
 }
 
-func SegmentPendingAudits_ShareSize(v int64) SegmentPendingAudits_ShareSize_Field {
-	return SegmentPendingAudits_ShareSize_Field{_set: true, _value: v}
-}
+func (NodeMessage) _Table() string { return "node_messages" }
 
-func (f SegmentPendingAudits_ShareSize_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type NodeMessage_Create_Fields struct {
+	ExpiresAt NodeMessage_ExpiresAt_Field
 }
 
-func (SegmentPendingAudits_ShareSize_Field) _Column() string { return "share_size" }
+type NodeMessage_Update_Fields struct {
+}
 
-type SegmentPendingAudits_ExpectedShareHash_Field struct {
+type NodeMessage_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func SegmentPendingAudits_ExpectedShareHash(v []byte) SegmentPendingAudits_ExpectedShareHash_Field {
-	return SegmentPendingAudits_ExpectedShareHash_Field{_set: true, _value: v}
+func NodeMessage_Id(v []byte) NodeMessage_Id_Field {
+	return NodeMessage_Id_Field{_set: true, _value: v}
 }
 
-func (f SegmentPendingAudits_ExpectedShareHash_Field) value() interface{} {
+func (f NodeMessage_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SegmentPendingAudits_ExpectedShareHash_Field) _Column() string { return "expected_share_hash" }
+func (NodeMessage_Id_Field) _Column() string { return "id" }
 
-type SegmentPendingAudits_ReverifyCount_Field struct {
+type NodeMessage_Kind_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func SegmentPendingAudits_ReverifyCount(v int64) SegmentPendingAudits_ReverifyCount_Field {
-	return SegmentPendingAudits_ReverifyCount_Field{_set: true, _value: v}
+func NodeMessage_Kind(v string) NodeMessage_Kind_Field {
+	return NodeMessage_Kind_Field{_set: true, _value: v}
 }
 
-func (f SegmentPendingAudits_ReverifyCount_Field) value() interface{} {
+func (f NodeMessage_Kind_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SegmentPendingAudits_ReverifyCount_Field) _Column() string { return "reverify_count" }
+func (NodeMessage_Kind_Field) _Column() string { return "kind" }
 
-type StoragenodeBandwidthRollup struct {
-	StoragenodeId   []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Allocated       *uint64
-	Settled         uint64
+type NodeMessage_Title_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (StoragenodeBandwidthRollup) _Table() string { return "storagenode_bandwidth_rollups" }
-
-type StoragenodeBandwidthRollup_Create_Fields struct {
-	Allocated StoragenodeBandwidthRollup_Allocated_Field
+func NodeMessage_Title(v string) NodeMessage_Title_Field {
+	return NodeMessage_Title_Field{_set: true, _value: v}
 }
 
-type StoragenodeBandwidthRollup_Update_Fields struct {
-	Allocated StoragenodeBandwidthRollup_Allocated_Field
-	Settled   StoragenodeBandwidthRollup_Settled_Field
+func (f NodeMessage_Title_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type StoragenodeBandwidthRollup_StoragenodeId_Field struct {
+func (NodeMessage_Title_Field) _Column() string { return "title" }
+
+type NodeMessage_Body_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func StoragenodeBandwidthRollup_StoragenodeId(v []byte) StoragenodeBandwidthRollup_StoragenodeId_Field {
-	return StoragenodeBandwidthRollup_StoragenodeId_Field{_set: true, _value: v}
+func NodeMessage_Body(v string) NodeMessage_Body_Field {
+	return NodeMessage_Body_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_StoragenodeId_Field) value() interface{} {
+func (f NodeMessage_Body_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_StoragenodeId_Field) _Column() string { return "storagenode_id" }
+func (NodeMessage_Body_Field) _Column() string { return "body" }
 
-type StoragenodeBandwidthRollup_IntervalStart_Field struct {
+type NodeMessage_Targeted_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value bool
 }
 
-func StoragenodeBandwidthRollup_IntervalStart(v time.Time) StoragenodeBandwidthRollup_IntervalStart_Field {
-	return StoragenodeBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
+func NodeMessage_Targeted(v bool) NodeMessage_Targeted_Field {
+	return NodeMessage_Targeted_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_IntervalStart_Field) value() interface{} {
+func (f NodeMessage_Targeted_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
+func (NodeMessage_Targeted_Field) _Column() string { return "targeted" }
 
-type StoragenodeBandwidthRollup_IntervalSeconds_Field struct {
+type NodeMessage_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value *time.Time
 }
 
-func StoragenodeBandwidthRollup_IntervalSeconds(v uint) StoragenodeBandwidthRollup_IntervalSeconds_Field {
-	return StoragenodeBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
+func NodeMessage_ExpiresAt(v time.Time) NodeMessage_ExpiresAt_Field {
+	return NodeMessage_ExpiresAt_Field{_set: true, _value: &v}
 }
 
-func (f StoragenodeBandwidthRollup_IntervalSeconds_Field) value() interface{} {
+func NodeMessage_ExpiresAt_Raw(v *time.Time) NodeMessage_ExpiresAt_Field {
+	if v == nil {
+		return NodeMessage_ExpiresAt_Null()
+	}
+	return NodeMessage_ExpiresAt(*v)
+}
+
+func NodeMessage_ExpiresAt_Null() NodeMessage_ExpiresAt_Field {
+	return NodeMessage_ExpiresAt_Field{_set: true, _null: true}
+}
+
+func (f NodeMessage_ExpiresAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f NodeMessage_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+func (NodeMessage_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type StoragenodeBandwidthRollup_Action_Field struct {
+type NodeMessage_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value time.Time
 }
 
-func StoragenodeBandwidthRollup_Action(v uint) StoragenodeBandwidthRollup_Action_Field {
-	return StoragenodeBandwidthRollup_Action_Field{_set: true, _value: v}
+func NodeMessage_CreatedAt(v time.Time) NodeMessage_CreatedAt_Field {
+	return NodeMessage_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_Action_Field) value() interface{} {
+func (f NodeMessage_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_Action_Field) _Column() string { return "action" }
+func (NodeMessage_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StoragenodeBandwidthRollup_Allocated_Field struct {
+type NodeMessageAcknowledgment struct {
+	MessageId      []byte
+	NodeId         []byte
+	AcknowledgedAt time.Time
+}
+
+func (NodeMessageAcknowledgment) _Table() string { return "node_message_acknowledgments" }
+
+type NodeMessageAcknowledgment_Create_Fields struct {
+}
+
+type NodeMessageAcknowledgment_Update_Fields struct {
+}
+
+type NodeMessageAcknowledgment_MessageId_Field struct {
 	_set   bool
 	_null  bool
-	_value *uint64
+	_value []byte
 }
 
-func StoragenodeBandwidthRollup_Allocated(v uint64) StoragenodeBandwidthRollup_Allocated_Field {
-	return StoragenodeBandwidthRollup_Allocated_Field{_set: true, _value: &v}
+func NodeMessageAcknowledgment_MessageId(v []byte) NodeMessageAcknowledgment_MessageId_Field {
+	return NodeMessageAcknowledgment_MessageId_Field{_set: true, _value: v}
 }
 
-func StoragenodeBandwidthRollup_Allocated_Raw(v *uint64) StoragenodeBandwidthRollup_Allocated_Field {
-	if v == nil {
-		return StoragenodeBandwidthRollup_Allocated_Null()
+func (f NodeMessageAcknowledgment_MessageId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return StoragenodeBandwidthRollup_Allocated(*v)
+	return f._value
 }
 
-func StoragenodeBandwidthRollup_Allocated_Null() StoragenodeBandwidthRollup_Allocated_Field {
-	return StoragenodeBandwidthRollup_Allocated_Field{_set: true, _null: true}
+func (NodeMessageAcknowledgment_MessageId_Field) _Column() string { return "message_id" }
+
+type NodeMessageAcknowledgment_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f StoragenodeBandwidthRollup_Allocated_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func NodeMessageAcknowledgment_NodeId(v []byte) NodeMessageAcknowledgment_NodeId_Field {
+	return NodeMessageAcknowledgment_NodeId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_Allocated_Field) value() interface{} {
+func (f NodeMessageAcknowledgment_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
+func (NodeMessageAcknowledgment_NodeId_Field) _Column() string { return "node_id" }
 
-type StoragenodeBandwidthRollup_Settled_Field struct {
+type NodeMessageAcknowledgment_AcknowledgedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value time.Time
 }
 
-func StoragenodeBandwidthRollup_Settled(v uint64) StoragenodeBandwidthRollup_Settled_Field {
-	return StoragenodeBandwidthRollup_Settled_Field{_set: true, _value: v}
+func NodeMessageAcknowledgment_AcknowledgedAt(v time.Time) NodeMessageAcknowledgment_AcknowledgedAt_Field {
+	return NodeMessageAcknowledgment_AcknowledgedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_Settled_Field) value() interface{} {
+func (f NodeMessageAcknowledgment_AcknowledgedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_Settled_Field) _Column() string { return "settled" }
+func (NodeMessageAcknowledgment_AcknowledgedAt_Field) _Column() string { return "acknowledged_at" }
 
-type StoragenodeBandwidthRollupArchive struct {
-	StoragenodeId   []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Allocated       *uint64
-	Settled         uint64
+type NodeMessageRecipient struct {
+	MessageId []byte
+	NodeId    []byte
 }
 
-func (StoragenodeBandwidthRollupArchive) _Table() string {
-	return "storagenode_bandwidth_rollup_archives"
-}
+func (NodeMessageRecipient) _Table() string { return "node_message_recipients" }
 
-type StoragenodeBandwidthRollupArchive_Create_Fields struct {
-	Allocated StoragenodeBandwidthRollupArchive_Allocated_Field
+type NodeMessageRecipient_Create_Fields struct {
 }
 
-type StoragenodeBandwidthRollupArchive_Update_Fields struct {
-	Allocated StoragenodeBandwidthRollupArchive_Allocated_Field
-	Settled   StoragenodeBandwidthRollupArchive_Settled_Field
+type NodeMessageRecipient_Update_Fields struct {
 }
 
-type StoragenodeBandwidthRollupArchive_StoragenodeId_Field struct {
+type NodeMessageRecipient_MessageId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func StoragenodeBandwidthRollupArchive_StoragenodeId(v []byte) StoragenodeBandwidthRollupArchive_StoragenodeId_Field {
-	return StoragenodeBandwidthRollupArchive_StoragenodeId_Field{_set: true, _value: v}
+func NodeMessageRecipient_MessageId(v []byte) NodeMessageRecipient_MessageId_Field {
+	return NodeMessageRecipient_MessageId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupArchive_StoragenodeId_Field) value() interface{} {
+func (f NodeMessageRecipient_MessageId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_StoragenodeId_Field) _Column() string {
-	return "storagenode_id"
-}
+func (NodeMessageRecipient_MessageId_Field) _Column() string { return "message_id" }
 
-type StoragenodeBandwidthRollupArchive_IntervalStart_Field struct {
+type NodeMessageRecipient_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func StoragenodeBandwidthRollupArchive_IntervalStart(v time.Time) StoragenodeBandwidthRollupArchive_IntervalStart_Field {
-	return StoragenodeBandwidthRollupArchive_IntervalStart_Field{_set: true, _value: v}
+func NodeMessageRecipient_NodeId(v []byte) NodeMessageRecipient_NodeId_Field {
+	return NodeMessageRecipient_NodeId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupArchive_IntervalStart_Field) value() interface{} {
+func (f NodeMessageRecipient_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_IntervalStart_Field) _Column() string {
-	return "interval_start"
+func (NodeMessageRecipient_NodeId_Field) _Column() string { return "node_id" }
+
+type NodeQuarantine struct {
+	NodeId        []byte
+	QuarantinedAt time.Time
+	Score         float64
+	Reason        string
+	ReleasedAt    *time.Time
 }
 
-type StoragenodeBandwidthRollupArchive_IntervalSeconds_Field struct {
+func (NodeQuarantine) _Table() string { return "node_quarantines" }
+
+type NodeQuarantine_Create_Fields struct {
+	ReleasedAt NodeQuarantine_ReleasedAt_Field
+}
+
+type NodeQuarantine_Update_Fields struct {
+	ReleasedAt NodeQuarantine_ReleasedAt_Field
+}
+
+type NodeQuarantine_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value []byte
 }
 
-func StoragenodeBandwidthRollupArchive_IntervalSeconds(v uint) StoragenodeBandwidthRollupArchive_IntervalSeconds_Field {
-	return StoragenodeBandwidthRollupArchive_IntervalSeconds_Field{_set: true, _value: v}
+func NodeQuarantine_NodeId(v []byte) NodeQuarantine_NodeId_Field {
+	return NodeQuarantine_NodeId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupArchive_IntervalSeconds_Field) value() interface{} {
+func (f NodeQuarantine_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_IntervalSeconds_Field) _Column() string {
-	return "interval_seconds"
-}
+func (NodeQuarantine_NodeId_Field) _Column() string { return "node_id" }
 
-type StoragenodeBandwidthRollupArchive_Action_Field struct {
+type NodeQuarantine_QuarantinedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value time.Time
 }
 
-func StoragenodeBandwidthRollupArchive_Action(v uint) StoragenodeBandwidthRollupArchive_Action_Field {
-	return StoragenodeBandwidthRollupArchive_Action_Field{_set: true, _value: v}
+func NodeQuarantine_QuarantinedAt(v time.Time) NodeQuarantine_QuarantinedAt_Field {
+	return NodeQuarantine_QuarantinedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupArchive_Action_Field) value() interface{} {
+func (f NodeQuarantine_QuarantinedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_Action_Field) _Column() string { return "action" }
+func (NodeQuarantine_QuarantinedAt_Field) _Column() string { return "quarantined_at" }
 
-type StoragenodeBandwidthRollupArchive_Allocated_Field struct {
+type NodeQuarantine_Score_Field struct {
 	_set   bool
 	_null  bool
-	_value *uint64
+	_value float64
 }
 
-func StoragenodeBandwidthRollupArchive_Allocated(v uint64) StoragenodeBandwidthRollupArchive_Allocated_Field {
-	return StoragenodeBandwidthRollupArchive_Allocated_Field{_set: true, _value: &v}
+func NodeQuarantine_Score(v float64) NodeQuarantine_Score_Field {
+	return NodeQuarantine_Score_Field{_set: true, _value: v}
 }
 
-func StoragenodeBandwidthRollupArchive_Allocated_Raw(v *uint64) StoragenodeBandwidthRollupArchive_Allocated_Field {
-	if v == nil {
-		return StoragenodeBandwidthRollupArchive_Allocated_Null()
+func (f NodeQuarantine_Score_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return StoragenodeBandwidthRollupArchive_Allocated(*v)
+	return f._value
 }
 
-func StoragenodeBandwidthRollupArchive_Allocated_Null() StoragenodeBandwidthRollupArchive_Allocated_Field {
-	return StoragenodeBandwidthRollupArchive_Allocated_Field{_set: true, _null: true}
+func (NodeQuarantine_Score_Field) _Column() string { return "score" }
+
+type NodeQuarantine_Reason_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f StoragenodeBandwidthRollupArchive_Allocated_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func NodeQuarantine_Reason(v string) NodeQuarantine_Reason_Field {
+	return NodeQuarantine_Reason_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupArchive_Allocated_Field) value() interface{} {
+func (f NodeQuarantine_Reason_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_Allocated_Field) _Column() string { return "allocated" }
+func (NodeQuarantine_Reason_Field) _Column() string { return "reason" }
 
-type StoragenodeBandwidthRollupArchive_Settled_Field struct {
+type NodeQuarantine_ReleasedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value *time.Time
 }
 
-func StoragenodeBandwidthRollupArchive_Settled(v uint64) StoragenodeBandwidthRollupArchive_Settled_Field {
-	return StoragenodeBandwidthRollupArchive_Settled_Field{_set: true, _value: v}
+func NodeQuarantine_ReleasedAt(v time.Time) NodeQuarantine_ReleasedAt_Field {
+	return NodeQuarantine_ReleasedAt_Field{_set: true, _value: &v}
 }
 
-func (f StoragenodeBandwidthRollupArchive_Settled_Field) value() interface{} {
+func NodeQuarantine_ReleasedAt_Raw(v *time.Time) NodeQuarantine_ReleasedAt_Field {
+	if v == nil {
+		return NodeQuarantine_ReleasedAt_Null()
+	}
+	return NodeQuarantine_ReleasedAt(*v)
+}
+
+func NodeQuarantine_ReleasedAt_Null() NodeQuarantine_ReleasedAt_Field {
+	return NodeQuarantine_ReleasedAt_Field{_set: true, _null: true}
+}
+
+func (f NodeQuarantine_ReleasedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f NodeQuarantine_ReleasedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_Settled_Field) _Column() string { return "settled" }
+func (NodeQuarantine_ReleasedAt_Field) _Column() string { return "released_at" }
 
-type StoragenodeBandwidthRollupPhase2 struct {
-	StoragenodeId   []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Allocated       *uint64
-	Settled         uint64
+type NodeSlaReport struct {
+	Period      time.Time
+	NodeId      []byte
+	Wallet      string
+	Windows     int
+	OnlineScore float64
+	Compliant   bool
+	CreatedAt   time.Time
 }
 
-func (StoragenodeBandwidthRollupPhase2) _Table() string {
-	return "storagenode_bandwidth_rollups_phase2"
-}
+func (NodeSlaReport) _Table() string { return "node_sla_reports" }
 
-type StoragenodeBandwidthRollupPhase2_Create_Fields struct {
-	Allocated StoragenodeBandwidthRollupPhase2_Allocated_Field
+type NodeSlaReport_Create_Fields struct {
+	CreatedAt NodeSlaReport_CreatedAt_Field
 }
 
-type StoragenodeBandwidthRollupPhase2_Update_Fields struct {
-	Allocated StoragenodeBandwidthRollupPhase2_Allocated_Field
-	Settled   StoragenodeBandwidthRollupPhase2_Settled_Field
+type NodeSlaReport_Update_Fields struct {
 }
 
-type StoragenodeBandwidthRollupPhase2_StoragenodeId_Field struct {
+type NodeSlaReport_Period_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func StoragenodeBandwidthRollupPhase2_StoragenodeId(v []byte) StoragenodeBandwidthRollupPhase2_StoragenodeId_Field {
-	return StoragenodeBandwidthRollupPhase2_StoragenodeId_Field{_set: true, _value: v}
+func NodeSlaReport_Period(v time.Time) NodeSlaReport_Period_Field {
+	return NodeSlaReport_Period_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_StoragenodeId_Field) value() interface{} {
+func (f NodeSlaReport_Period_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_StoragenodeId_Field) _Column() string { return "storagenode_id" }
+func (NodeSlaReport_Period_Field) _Column() string { return "period" }
 
-type StoragenodeBandwidthRollupPhase2_IntervalStart_Field struct {
+type NodeSlaReport_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func StoragenodeBandwidthRollupPhase2_IntervalStart(v time.Time) StoragenodeBandwidthRollupPhase2_IntervalStart_Field {
-	return StoragenodeBandwidthRollupPhase2_IntervalStart_Field{_set: true, _value: v}
+func NodeSlaReport_NodeId(v []byte) NodeSlaReport_NodeId_Field {
+	return NodeSlaReport_NodeId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_IntervalStart_Field) value() interface{} {
+func (f NodeSlaReport_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_IntervalStart_Field) _Column() string { return "interval_start" }
+func (NodeSlaReport_NodeId_Field) _Column() string { return "node_id" }
 
-type StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field struct {
+type NodeSlaReport_Wallet_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value string
 }
 
-func StoragenodeBandwidthRollupPhase2_IntervalSeconds(v uint) StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field {
-	return StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field{_set: true, _value: v}
+func NodeSlaReport_Wallet(v string) NodeSlaReport_Wallet_Field {
+	return NodeSlaReport_Wallet_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field) value() interface{} {
+func (f NodeSlaReport_Wallet_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field) _Column() string {
-	return "interval_seconds"
-}
+func (NodeSlaReport_Wallet_Field) _Column() string { return "wallet" }
 
-type StoragenodeBandwidthRollupPhase2_Action_Field struct {
+type NodeSlaReport_Windows_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value int
 }
 
-func StoragenodeBandwidthRollupPhase2_Action(v uint) StoragenodeBandwidthRollupPhase2_Action_Field {
-	return StoragenodeBandwidthRollupPhase2_Action_Field{_set: true, _value: v}
+func NodeSlaReport_Windows(v int) NodeSlaReport_Windows_Field {
+	return NodeSlaReport_Windows_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_Action_Field) value() interface{} {
+func (f NodeSlaReport_Windows_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_Action_Field) _Column() string { return "action" }
+func (NodeSlaReport_Windows_Field) _Column() string { return "windows" }
 
-type StoragenodeBandwidthRollupPhase2_Allocated_Field struct {
+type NodeSlaReport_OnlineScore_Field struct {
 	_set   bool
 	_null  bool
-	_value *uint64
+	_value float64
 }
 
-func StoragenodeBandwidthRollupPhase2_Allocated(v uint64) StoragenodeBandwidthRollupPhase2_Allocated_Field {
-	return StoragenodeBandwidthRollupPhase2_Allocated_Field{_set: true, _value: &v}
+func NodeSlaReport_OnlineScore(v float64) NodeSlaReport_OnlineScore_Field {
+	return NodeSlaReport_OnlineScore_Field{_set: true, _value: v}
 }
 
-func StoragenodeBandwidthRollupPhase2_Allocated_Raw(v *uint64) StoragenodeBandwidthRollupPhase2_Allocated_Field {
-	if v == nil {
-		return StoragenodeBandwidthRollupPhase2_Allocated_Null()
+func (f NodeSlaReport_OnlineScore_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return StoragenodeBandwidthRollupPhase2_Allocated(*v)
+	return f._value
 }
 
-func StoragenodeBandwidthRollupPhase2_Allocated_Null() StoragenodeBandwidthRollupPhase2_Allocated_Field {
-	return StoragenodeBandwidthRollupPhase2_Allocated_Field{_set: true, _null: true}
+func (NodeSlaReport_OnlineScore_Field) _Column() string { return "online_score" }
+
+type NodeSlaReport_Compliant_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
 }
 
-func (f StoragenodeBandwidthRollupPhase2_Allocated_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func NodeSlaReport_Compliant(v bool) NodeSlaReport_Compliant_Field {
+	return NodeSlaReport_Compliant_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_Allocated_Field) value() interface{} {
+func (f NodeSlaReport_Compliant_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_Allocated_Field) _Column() string { return "allocated" }
+func (NodeSlaReport_Compliant_Field) _Column() string { return "compliant" }
 
-type StoragenodeBandwidthRollupPhase2_Settled_Field struct {
+type NodeSlaReport_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value time.Time
 }
 
-func StoragenodeBandwidthRollupPhase2_Settled(v uint64) StoragenodeBandwidthRollupPhase2_Settled_Field {
-	return StoragenodeBandwidthRollupPhase2_Settled_Field{_set: true, _value: v}
+func NodeSlaReport_CreatedAt(v time.Time) NodeSlaReport_CreatedAt_Field {
+	return NodeSlaReport_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_Settled_Field) value() interface{} {
+func (f NodeSlaReport_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_Settled_Field) _Column() string { return "settled" }
-
-type StoragenodePayment struct {
-	Id        int64
-	CreatedAt time.Time
-	NodeId    []byte
-	Period    string
-	Amount    int64
-	Receipt   *string
-	Notes     *string
-}
-
-func (StoragenodePayment) _Table() string { return "storagenode_payments" }
-
-type StoragenodePayment_Create_Fields struct {
-	Receipt StoragenodePayment_Receipt_Field
-	Notes   StoragenodePayment_Notes_Field
-}
+func (NodeSlaReport_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StoragenodePayment_Update_Fields struct {
+type NodeStorageEstimate struct {
+	NodeId       []byte
+	PieceCount   int64
+	StoredBytes  int64
+	SettledBytes int64
+	EstimatedAt  time.Time
+	UpdatedAt    time.Time
 }
 
-type StoragenodePayment_Id_Field struct {
-	_set   bool
-	_null  bool
-	_value int64
-}
+func (NodeStorageEstimate) _Table() string { return "node_storage_estimates" }
 
-func StoragenodePayment_Id(v int64) StoragenodePayment_Id_Field {
-	return StoragenodePayment_Id_Field{_set: true, _value: v}
+type NodeStorageEstimate_Create_Fields struct {
+	SettledBytes NodeStorageEstimate_SettledBytes_Field
 }
 
-func (f StoragenodePayment_Id_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type NodeStorageEstimate_Update_Fields struct {
+	PieceCount   NodeStorageEstimate_PieceCount_Field
+	StoredBytes  NodeStorageEstimate_StoredBytes_Field
+	SettledBytes NodeStorageEstimate_SettledBytes_Field
+	EstimatedAt  NodeStorageEstimate_EstimatedAt_Field
 }
 
-func (StoragenodePayment_Id_Field) _Column() string { return "id" }
-
-type StoragenodePayment_CreatedAt_Field struct {
+type NodeStorageEstimate_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func StoragenodePayment_CreatedAt(v time.Time) StoragenodePayment_CreatedAt_Field {
-	return StoragenodePayment_CreatedAt_Field{_set: true, _value: v}
+func NodeStorageEstimate_NodeId(v []byte) NodeStorageEstimate_NodeId_Field {
+	return NodeStorageEstimate_NodeId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePayment_CreatedAt_Field) value() interface{} {
+func (f NodeStorageEstimate_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_CreatedAt_Field) _Column() string { return "created_at" }
+func (NodeStorageEstimate_NodeId_Field) _Column() string { return "node_id" }
 
-type StoragenodePayment_NodeId_Field struct {
+type NodeStorageEstimate_PieceCount_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func StoragenodePayment_NodeId(v []byte) StoragenodePayment_NodeId_Field {
-	return StoragenodePayment_NodeId_Field{_set: true, _value: v}
+func NodeStorageEstimate_PieceCount(v int64) NodeStorageEstimate_PieceCount_Field {
+	return NodeStorageEstimate_PieceCount_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePayment_NodeId_Field) value() interface{} {
+func (f NodeStorageEstimate_PieceCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_NodeId_Field) _Column() string { return "node_id" }
+func (NodeStorageEstimate_PieceCount_Field) _Column() string { return "piece_count" }
 
-type StoragenodePayment_Period_Field struct {
+type NodeStorageEstimate_StoredBytes_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func StoragenodePayment_Period(v string) StoragenodePayment_Period_Field {
-	return StoragenodePayment_Period_Field{_set: true, _value: v}
+func NodeStorageEstimate_StoredBytes(v int64) NodeStorageEstimate_StoredBytes_Field {
+	return NodeStorageEstimate_StoredBytes_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePayment_Period_Field) value() interface{} {
+func (f NodeStorageEstimate_StoredBytes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_Period_Field) _Column() string { return "period" }
+func (NodeStorageEstimate_StoredBytes_Field) _Column() string { return "stored_bytes" }
 
-type StoragenodePayment_Amount_Field struct {
+type NodeStorageEstimate_SettledBytes_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func StoragenodePayment_Amount(v int64) StoragenodePayment_Amount_Field {
-	return StoragenodePayment_Amount_Field{_set: true, _value: v}
+func NodeStorageEstimate_SettledBytes(v int64) NodeStorageEstimate_SettledBytes_Field {
+	return NodeStorageEstimate_SettledBytes_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePayment_Amount_Field) value() interface{} {
+func (f NodeStorageEstimate_SettledBytes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_Amount_Field) _Column() string { return "amount" }
+func (NodeStorageEstimate_SettledBytes_Field) _Column() string { return "settled_bytes" }
 
-type StoragenodePayment_Receipt_Field struct {
+type NodeStorageEstimate_EstimatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
-}
-
-func StoragenodePayment_Receipt(v string) StoragenodePayment_Receipt_Field {
-	return StoragenodePayment_Receipt_Field{_set: true, _value: &v}
-}
-
-func StoragenodePayment_Receipt_Raw(v *string) StoragenodePayment_Receipt_Field {
-	if v == nil {
-		return StoragenodePayment_Receipt_Null()
-	}
-	return StoragenodePayment_Receipt(*v)
+	_value time.Time
 }
 
-func StoragenodePayment_Receipt_Null() StoragenodePayment_Receipt_Field {
-	return StoragenodePayment_Receipt_Field{_set: true, _null: true}
+func NodeStorageEstimate_EstimatedAt(v time.Time) NodeStorageEstimate_EstimatedAt_Field {
+	return NodeStorageEstimate_EstimatedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePayment_Receipt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f StoragenodePayment_Receipt_Field) value() interface{} {
+func (f NodeStorageEstimate_EstimatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_Receipt_Field) _Column() string { return "receipt" }
+func (NodeStorageEstimate_EstimatedAt_Field) _Column() string { return "estimated_at" }
 
-type StoragenodePayment_Notes_Field struct {
+type NodeStorageEstimate_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
-}
-
-func StoragenodePayment_Notes(v string) StoragenodePayment_Notes_Field {
-	return StoragenodePayment_Notes_Field{_set: true, _value: &v}
-}
-
-func StoragenodePayment_Notes_Raw(v *string) StoragenodePayment_Notes_Field {
-	if v == nil {
-		return StoragenodePayment_Notes_Null()
-	}
-	return StoragenodePayment_Notes(*v)
+	_value time.Time
 }
 
-func StoragenodePayment_Notes_Null() StoragenodePayment_Notes_Field {
-	return StoragenodePayment_Notes_Field{_set: true, _null: true}
+func NodeStorageEstimate_UpdatedAt(v time.Time) NodeStorageEstimate_UpdatedAt_Field {
+	return NodeStorageEstimate_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePayment_Notes_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f StoragenodePayment_Notes_Field) value() interface{} {
+func (f NodeStorageEstimate_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_Notes_Field) _Column() string { return "notes" }
+func (NodeStorageEstimate_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type StoragenodePaystub struct {
-	Period         string
-	NodeId         []byte
-	CreatedAt      time.Time
-	Codes          string
-	UsageAtRest    float64
-	UsageGet       int64
-	UsagePut       int64
-	UsageGetRepair int64
-	UsagePutRepair int64
-	UsageGetAudit  int64
-	CompAtRest     int64
-	CompGet        int64
-	CompPut        int64
-	CompGetRepair  int64
-	CompPutRepair  int64
-	CompGetAudit   int64
-	SurgePercent   int64
-	Held           int64
-	Owed           int64
-	Disposed       int64
-	Paid           int64
-	Distributed    int64
+type OauthClient struct {
+	Id              []byte
+	EncryptedSecret []byte
+	RedirectUrl     string
+	UserId          []byte
+	AppName         string
+	AppLogoUrl      string
 }
 
-func (StoragenodePaystub) _Table() string { return "storagenode_paystubs" }
+func (OauthClient) _Table() string { return "oauth_clients" }
 
-type StoragenodePaystub_Update_Fields struct {
+type OauthClient_Update_Fields struct {
+	EncryptedSecret OauthClient_EncryptedSecret_Field
+	RedirectUrl     OauthClient_RedirectUrl_Field
+	AppName         OauthClient_AppName_Field
+	AppLogoUrl      OauthClient_AppLogoUrl_Field
 }
 
-type StoragenodePaystub_Period_Field struct {
+type OauthClient_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func StoragenodePaystub_Period(v string) StoragenodePaystub_Period_Field {
-	return StoragenodePaystub_Period_Field{_set: true, _value: v}
+func OauthClient_Id(v []byte) OauthClient_Id_Field {
+	return OauthClient_Id_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_Period_Field) value() interface{} {
+func (f OauthClient_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Period_Field) _Column() string { return "period" }
+func (OauthClient_Id_Field) _Column() string { return "id" }
 
-type StoragenodePaystub_NodeId_Field struct {
+type OauthClient_EncryptedSecret_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func StoragenodePaystub_NodeId(v []byte) StoragenodePaystub_NodeId_Field {
-	return StoragenodePaystub_NodeId_Field{_set: true, _value: v}
+func OauthClient_EncryptedSecret(v []byte) OauthClient_EncryptedSecret_Field {
+	return OauthClient_EncryptedSecret_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_NodeId_Field) value() interface{} {
+func (f OauthClient_EncryptedSecret_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_NodeId_Field) _Column() string { return "node_id" }
+func (OauthClient_EncryptedSecret_Field) _Column() string { return "encrypted_secret" }
 
-type StoragenodePaystub_CreatedAt_Field struct {
+type OauthClient_RedirectUrl_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func StoragenodePaystub_CreatedAt(v time.Time) StoragenodePaystub_CreatedAt_Field {
-	return StoragenodePaystub_CreatedAt_Field{_set: true, _value: v}
+func OauthClient_RedirectUrl(v string) OauthClient_RedirectUrl_Field {
+	return OauthClient_RedirectUrl_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_CreatedAt_Field) value() interface{} {
+func (f OauthClient_RedirectUrl_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CreatedAt_Field) _Column() string { return "created_at" }
+func (OauthClient_RedirectUrl_Field) _Column() string { return "redirect_url" }
 
-type StoragenodePaystub_Codes_Field struct {
+type OauthClient_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func StoragenodePaystub_Codes(v string) StoragenodePaystub_Codes_Field {
-	return StoragenodePaystub_Codes_Field{_set: true, _value: v}
+func OauthClient_UserId(v []byte) OauthClient_UserId_Field {
+	return OauthClient_UserId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_Codes_Field) value() interface{} {
+func (f OauthClient_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Codes_Field) _Column() string { return "codes" }
+func (OauthClient_UserId_Field) _Column() string { return "user_id" }
 
-type StoragenodePaystub_UsageAtRest_Field struct {
+type OauthClient_AppName_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value string
 }
 
-func StoragenodePaystub_UsageAtRest(v float64) StoragenodePaystub_UsageAtRest_Field {
-	return StoragenodePaystub_UsageAtRest_Field{_set: true, _value: v}
+func OauthClient_AppName(v string) OauthClient_AppName_Field {
+	return OauthClient_AppName_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_UsageAtRest_Field) value() interface{} {
+func (f OauthClient_AppName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_UsageAtRest_Field) _Column() string { return "usage_at_rest" }
+func (OauthClient_AppName_Field) _Column() string { return "app_name" }
 
-type StoragenodePaystub_UsageGet_Field struct {
+type OauthClient_AppLogoUrl_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func StoragenodePaystub_UsageGet(v int64) StoragenodePaystub_UsageGet_Field {
-	return StoragenodePaystub_UsageGet_Field{_set: true, _value: v}
+func OauthClient_AppLogoUrl(v string) OauthClient_AppLogoUrl_Field {
+	return OauthClient_AppLogoUrl_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_UsageGet_Field) value() interface{} {
+func (f OauthClient_AppLogoUrl_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_UsageGet_Field) _Column() string { return "usage_get" }
-
-type StoragenodePaystub_UsagePut_Field struct {
-	_set   bool
-	_null  bool
-	_value int64
-}
-
-func StoragenodePaystub_UsagePut(v int64) StoragenodePaystub_UsagePut_Field {
-	return StoragenodePaystub_UsagePut_Field{_set: true, _value: v}
-}
+func (OauthClient_AppLogoUrl_Field) _Column() string { return "app_logo_url" }
 
-func (f StoragenodePaystub_UsagePut_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type OauthCode struct {
+	ClientId        []byte
+	UserId          []byte
+	Scope           string
+	RedirectUrl     string
+	Challenge       string
+	ChallengeMethod string
+	Code            string
+	CreatedAt       time.Time
+	ExpiresAt       time.Time
+	ClaimedAt       *time.Time
 }
 
-func (StoragenodePaystub_UsagePut_Field) _Column() string { return "usage_put" }
+func (OauthCode) _Table() string { return "oauth_codes" }
 
-type StoragenodePaystub_UsageGetRepair_Field struct {
+type OauthCode_Create_Fields struct {
+	ClaimedAt OauthCode_ClaimedAt_Field
+}
+
+type OauthCode_Update_Fields struct {
+	ClaimedAt OauthCode_ClaimedAt_Field
+}
+
+type OauthCode_ClientId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func StoragenodePaystub_UsageGetRepair(v int64) StoragenodePaystub_UsageGetRepair_Field {
-	return StoragenodePaystub_UsageGetRepair_Field{_set: true, _value: v}
+func OauthCode_ClientId(v []byte) OauthCode_ClientId_Field {
+	return OauthCode_ClientId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_UsageGetRepair_Field) value() interface{} {
+func (f OauthCode_ClientId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_UsageGetRepair_Field) _Column() string { return "usage_get_repair" }
+func (OauthCode_ClientId_Field) _Column() string { return "client_id" }
 
-type StoragenodePaystub_UsagePutRepair_Field struct {
+type OauthCode_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func StoragenodePaystub_UsagePutRepair(v int64) StoragenodePaystub_UsagePutRepair_Field {
-	return StoragenodePaystub_UsagePutRepair_Field{_set: true, _value: v}
+func OauthCode_UserId(v []byte) OauthCode_UserId_Field {
+	return OauthCode_UserId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_UsagePutRepair_Field) value() interface{} {
+func (f OauthCode_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_UsagePutRepair_Field) _Column() string { return "usage_put_repair" }
+func (OauthCode_UserId_Field) _Column() string { return "user_id" }
 
-type StoragenodePaystub_UsageGetAudit_Field struct {
+type OauthCode_Scope_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func StoragenodePaystub_UsageGetAudit(v int64) StoragenodePaystub_UsageGetAudit_Field {
-	return StoragenodePaystub_UsageGetAudit_Field{_set: true, _value: v}
+func OauthCode_Scope(v string) OauthCode_Scope_Field {
+	return OauthCode_Scope_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_UsageGetAudit_Field) value() interface{} {
+func (f OauthCode_Scope_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_UsageGetAudit_Field) _Column() string { return "usage_get_audit" }
+func (OauthCode_Scope_Field) _Column() string { return "scope" }
 
-type StoragenodePaystub_CompAtRest_Field struct {
+type OauthCode_RedirectUrl_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func StoragenodePaystub_CompAtRest(v int64) StoragenodePaystub_CompAtRest_Field {
-	return StoragenodePaystub_CompAtRest_Field{_set: true, _value: v}
+func OauthCode_RedirectUrl(v string) OauthCode_RedirectUrl_Field {
+	return OauthCode_RedirectUrl_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_CompAtRest_Field) value() interface{} {
+func (f OauthCode_RedirectUrl_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CompAtRest_Field) _Column() string { return "comp_at_rest" }
+func (OauthCode_RedirectUrl_Field) _Column() string { return "redirect_url" }
 
-type StoragenodePaystub_CompGet_Field struct {
+type OauthCode_Challenge_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func StoragenodePaystub_CompGet(v int64) StoragenodePaystub_CompGet_Field {
-	return StoragenodePaystub_CompGet_Field{_set: true, _value: v}
+func OauthCode_Challenge(v string) OauthCode_Challenge_Field {
+	return OauthCode_Challenge_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_CompGet_Field) value() interface{} {
+func (f OauthCode_Challenge_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CompGet_Field) _Column() string { return "comp_get" }
+func (OauthCode_Challenge_Field) _Column() string { return "challenge" }
 
-type StoragenodePaystub_CompPut_Field struct {
+type OauthCode_ChallengeMethod_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func StoragenodePaystub_CompPut(v int64) StoragenodePaystub_CompPut_Field {
-	return StoragenodePaystub_CompPut_Field{_set: true, _value: v}
+func OauthCode_ChallengeMethod(v string) OauthCode_ChallengeMethod_Field {
+	return OauthCode_ChallengeMethod_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_CompPut_Field) value() interface{} {
+func (f OauthCode_ChallengeMethod_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CompPut_Field) _Column() string { return "comp_put" }
+func (OauthCode_ChallengeMethod_Field) _Column() string { return "challenge_method" }
 
-type StoragenodePaystub_CompGetRepair_Field struct {
+type OauthCode_Code_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func StoragenodePaystub_CompGetRepair(v int64) StoragenodePaystub_CompGetRepair_Field {
-	return StoragenodePaystub_CompGetRepair_Field{_set: true, _value: v}
+func OauthCode_Code(v string) OauthCode_Code_Field {
+	return OauthCode_Code_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_CompGetRepair_Field) value() interface{} {
+func (f OauthCode_Code_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CompGetRepair_Field) _Column() string { return "comp_get_repair" }
+func (OauthCode_Code_Field) _Column() string { return "code" }
 
-type StoragenodePaystub_CompPutRepair_Field struct {
+type OauthCode_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func StoragenodePaystub_CompPutRepair(v int64) StoragenodePaystub_CompPutRepair_Field {
-	return StoragenodePaystub_CompPutRepair_Field{_set: true, _value: v}
+func OauthCode_CreatedAt(v time.Time) OauthCode_CreatedAt_Field {
+	return OauthCode_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_CompPutRepair_Field) value() interface{} {
+func (f OauthCode_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CompPutRepair_Field) _Column() string { return "comp_put_repair" }
+func (OauthCode_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StoragenodePaystub_CompGetAudit_Field struct {
+type OauthCode_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func StoragenodePaystub_CompGetAudit(v int64) StoragenodePaystub_CompGetAudit_Field {
-	return StoragenodePaystub_CompGetAudit_Field{_set: true, _value: v}
+func OauthCode_ExpiresAt(v time.Time) OauthCode_ExpiresAt_Field {
+	return OauthCode_ExpiresAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_CompGetAudit_Field) value() interface{} {
+func (f OauthCode_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CompGetAudit_Field) _Column() string { return "comp_get_audit" }
+func (OauthCode_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type StoragenodePaystub_SurgePercent_Field struct {
+type OauthCode_ClaimedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *time.Time
 }
 
-func StoragenodePaystub_SurgePercent(v int64) StoragenodePaystub_SurgePercent_Field {
-	return StoragenodePaystub_SurgePercent_Field{_set: true, _value: v}
+func OauthCode_ClaimedAt(v time.Time) OauthCode_ClaimedAt_Field {
+	return OauthCode_ClaimedAt_Field{_set: true, _value: &v}
 }
 
-func (f StoragenodePaystub_SurgePercent_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func OauthCode_ClaimedAt_Raw(v *time.Time) OauthCode_ClaimedAt_Field {
+	if v == nil {
+		return OauthCode_ClaimedAt_Null()
 	}
-	return f._value
+	return OauthCode_ClaimedAt(*v)
 }
 
-func (StoragenodePaystub_SurgePercent_Field) _Column() string { return "surge_percent" }
-
-type StoragenodePaystub_Held_Field struct {
-	_set   bool
-	_null  bool
-	_value int64
+func OauthCode_ClaimedAt_Null() OauthCode_ClaimedAt_Field {
+	return OauthCode_ClaimedAt_Field{_set: true, _null: true}
 }
 
-func StoragenodePaystub_Held(v int64) StoragenodePaystub_Held_Field {
-	return StoragenodePaystub_Held_Field{_set: true, _value: v}
-}
+func (f OauthCode_ClaimedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f StoragenodePaystub_Held_Field) value() interface{} {
+func (f OauthCode_ClaimedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Held_Field) _Column() string { return "held" }
+func (OauthCode_ClaimedAt_Field) _Column() string { return "claimed_at" }
 
-type StoragenodePaystub_Owed_Field struct {
+type OauthToken struct {
+	ClientId  []byte
+	UserId    []byte
+	Scope     string
+	Kind      int
+	Token     []byte
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+func (OauthToken) _Table() string { return "oauth_tokens" }
+
+type OauthToken_Update_Fields struct {
+	ExpiresAt OauthToken_ExpiresAt_Field
+}
+
+type OauthToken_ClientId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func StoragenodePaystub_Owed(v int64) StoragenodePaystub_Owed_Field {
-	return StoragenodePaystub_Owed_Field{_set: true, _value: v}
+func OauthToken_ClientId(v []byte) OauthToken_ClientId_Field {
+	return OauthToken_ClientId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_Owed_Field) value() interface{} {
+func (f OauthToken_ClientId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Owed_Field) _Column() string { return "owed" }
+func (OauthToken_ClientId_Field) _Column() string { return "client_id" }
 
-type StoragenodePaystub_Disposed_Field struct {
+type OauthToken_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func StoragenodePaystub_Disposed(v int64) StoragenodePaystub_Disposed_Field {
-	return StoragenodePaystub_Disposed_Field{_set: true, _value: v}
+func OauthToken_UserId(v []byte) OauthToken_UserId_Field {
+	return OauthToken_UserId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_Disposed_Field) value() interface{} {
+func (f OauthToken_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Disposed_Field) _Column() string { return "disposed" }
+func (OauthToken_UserId_Field) _Column() string { return "user_id" }
 
-type StoragenodePaystub_Paid_Field struct {
+type OauthToken_Scope_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func StoragenodePaystub_Paid(v int64) StoragenodePaystub_Paid_Field {
-	return StoragenodePaystub_Paid_Field{_set: true, _value: v}
+func OauthToken_Scope(v string) OauthToken_Scope_Field {
+	return OauthToken_Scope_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_Paid_Field) value() interface{} {
+func (f OauthToken_Scope_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Paid_Field) _Column() string { return "paid" }
+func (OauthToken_Scope_Field) _Column() string { return "scope" }
 
-type StoragenodePaystub_Distributed_Field struct {
+type OauthToken_Kind_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value int
 }
 
-func StoragenodePaystub_Distributed(v int64) StoragenodePaystub_Distributed_Field {
-	return StoragenodePaystub_Distributed_Field{_set: true, _value: v}
+func OauthToken_Kind(v int) OauthToken_Kind_Field {
+	return OauthToken_Kind_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_Distributed_Field) value() interface{} {
+func (f OauthToken_Kind_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Distributed_Field) _Column() string { return "distributed" }
-
-type StoragenodeStorageTally struct {
-	NodeId          []byte
-	IntervalEndTime time.Time
-	DataTotal       float64
-}
-
-func (StoragenodeStorageTally) _Table() string { return "storagenode_storage_tallies" }
-
-type StoragenodeStorageTally_Update_Fields struct {
-}
+func (OauthToken_Kind_Field) _Column() string { return "kind" }
 
-type StoragenodeStorageTally_NodeId_Field struct {
+type OauthToken_Token_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func StoragenodeStorageTally_NodeId(v []byte) StoragenodeStorageTally_NodeId_Field {
-	return StoragenodeStorageTally_NodeId_Field{_set: true, _value: v}
+func OauthToken_Token(v []byte) OauthToken_Token_Field {
+	return OauthToken_Token_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeStorageTally_NodeId_Field) value() interface{} {
+func (f OauthToken_Token_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeStorageTally_NodeId_Field) _Column() string { return "node_id" }
+func (OauthToken_Token_Field) _Column() string { return "token" }
 
-type StoragenodeStorageTally_IntervalEndTime_Field struct {
+type OauthToken_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func StoragenodeStorageTally_IntervalEndTime(v time.Time) StoragenodeStorageTally_IntervalEndTime_Field {
-	return StoragenodeStorageTally_IntervalEndTime_Field{_set: true, _value: v}
+func OauthToken_CreatedAt(v time.Time) OauthToken_CreatedAt_Field {
+	return OauthToken_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeStorageTally_IntervalEndTime_Field) value() interface{} {
+func (f OauthToken_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeStorageTally_IntervalEndTime_Field) _Column() string { return "interval_end_time" }
+func (OauthToken_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StoragenodeStorageTally_DataTotal_Field struct {
+type OauthToken_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value time.Time
 }
 
-func StoragenodeStorageTally_DataTotal(v float64) StoragenodeStorageTally_DataTotal_Field {
-	return StoragenodeStorageTally_DataTotal_Field{_set: true, _value: v}
+func OauthToken_ExpiresAt(v time.Time) OauthToken_ExpiresAt_Field {
+	return OauthToken_ExpiresAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeStorageTally_DataTotal_Field) value() interface{} {
+func (f OauthToken_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeStorageTally_DataTotal_Field) _Column() string { return "data_total" }
+func (OauthToken_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type StorjscanPayment struct {
-	BlockHash   []byte
-	BlockNumber int64
-	Transaction []byte
-	LogIndex    int
-	FromAddress []byte
-	ToAddress   []byte
-	TokenValue  int64
-	UsdValue    int64
-	Status      string
-	Timestamp   time.Time
-	CreatedAt   time.Time
+type PeerIdentity struct {
+	NodeId           []byte
+	LeafSerialNumber []byte
+	Chain            []byte
+	UpdatedAt        time.Time
 }
 
-func (StorjscanPayment) _Table() string { return "storjscan_payments" }
+func (PeerIdentity) _Table() string { return "peer_identities" }
 
-type StorjscanPayment_Update_Fields struct {
+type PeerIdentity_Update_Fields struct {
+	LeafSerialNumber PeerIdentity_LeafSerialNumber_Field
+	Chain            PeerIdentity_Chain_Field
 }
 
-type StorjscanPayment_BlockHash_Field struct {
+type PeerIdentity_NodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func StorjscanPayment_BlockHash(v []byte) StorjscanPayment_BlockHash_Field {
-	return StorjscanPayment_BlockHash_Field{_set: true, _value: v}
+func PeerIdentity_NodeId(v []byte) PeerIdentity_NodeId_Field {
+	return PeerIdentity_NodeId_Field{_set: true, _value: v}
 }
 
-func (f StorjscanPayment_BlockHash_Field) value() interface{} {
+func (f PeerIdentity_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanPayment_BlockHash_Field) _Column() string { return "block_hash" }
+func (PeerIdentity_NodeId_Field) _Column() string { return "node_id" }
 
-type StorjscanPayment_BlockNumber_Field struct {
+type PeerIdentity_LeafSerialNumber_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func StorjscanPayment_BlockNumber(v int64) StorjscanPayment_BlockNumber_Field {
-	return StorjscanPayment_BlockNumber_Field{_set: true, _value: v}
+func PeerIdentity_LeafSerialNumber(v []byte) PeerIdentity_LeafSerialNumber_Field {
+	return PeerIdentity_LeafSerialNumber_Field{_set: true, _value: v}
 }
 
-func (f StorjscanPayment_BlockNumber_Field) value() interface{} {
+func (f PeerIdentity_LeafSerialNumber_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanPayment_BlockNumber_Field) _Column() string { return "block_number" }
+func (PeerIdentity_LeafSerialNumber_Field) _Column() string { return "leaf_serial_number" }
 
-type StorjscanPayment_Transaction_Field struct {
+type PeerIdentity_Chain_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func StorjscanPayment_Transaction(v []byte) StorjscanPayment_Transaction_Field {
-	return StorjscanPayment_Transaction_Field{_set: true, _value: v}
+func PeerIdentity_Chain(v []byte) PeerIdentity_Chain_Field {
+	return PeerIdentity_Chain_Field{_set: true, _value: v}
 }
 
-func (f StorjscanPayment_Transaction_Field) value() interface{} {
+func (f PeerIdentity_Chain_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanPayment_Transaction_Field) _Column() string { return "transaction" }
+func (PeerIdentity_Chain_Field) _Column() string { return "chain" }
 
-type StorjscanPayment_LogIndex_Field struct {
+type PeerIdentity_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func StorjscanPayment_LogIndex(v int) StorjscanPayment_LogIndex_Field {
-	return StorjscanPayment_LogIndex_Field{_set: true, _value: v}
+func PeerIdentity_UpdatedAt(v time.Time) PeerIdentity_UpdatedAt_Field {
+	return PeerIdentity_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f StorjscanPayment_LogIndex_Field) value() interface{} {
+func (f PeerIdentity_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanPayment_LogIndex_Field) _Column() string { return "log_index" }
+func (PeerIdentity_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type StorjscanPayment_FromAddress_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+type Project struct {
+	Id                          []byte
+	PublicId                    []byte
+	Name                        string
+	Description                 string
+	UsageLimit                  *int64
+	BandwidthLimit              *int64
+	UserSpecifiedUsageLimit     *int64
+	UserSpecifiedBandwidthLimit *int64
+	SegmentLimit                *int64
+	RateLimit                   *int
+	BurstLimit                  *int
+	MaxBuckets                  *int
+	PartnerId                   []byte
+	UserAgent                   []byte
+	OwnerId                     []byte
+	Salt                        []byte
+	CreatedAt                   time.Time
 }
 
-func StorjscanPayment_FromAddress(v []byte) StorjscanPayment_FromAddress_Field {
-	return StorjscanPayment_FromAddress_Field{_set: true, _value: v}
-}
+func (Project) _Table() string { return "projects" }
 
-func (f StorjscanPayment_FromAddress_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type Project_Create_Fields struct {
+	PublicId                    Project_PublicId_Field
+	UsageLimit                  Project_UsageLimit_Field
+	BandwidthLimit              Project_BandwidthLimit_Field
+	UserSpecifiedUsageLimit     Project_UserSpecifiedUsageLimit_Field
+	UserSpecifiedBandwidthLimit Project_UserSpecifiedBandwidthLimit_Field
+	SegmentLimit                Project_SegmentLimit_Field
+	RateLimit                   Project_RateLimit_Field
+	BurstLimit                  Project_BurstLimit_Field
+	MaxBuckets                  Project_MaxBuckets_Field
+	PartnerId                   Project_PartnerId_Field
+	UserAgent                   Project_UserAgent_Field
+	Salt                        Project_Salt_Field
 }
 
-func (StorjscanPayment_FromAddress_Field) _Column() string { return "from_address" }
+type Project_Update_Fields struct {
+	Name                        Project_Name_Field
+	Description                 Project_Description_Field
+	UsageLimit                  Project_UsageLimit_Field
+	BandwidthLimit              Project_BandwidthLimit_Field
+	UserSpecifiedUsageLimit     Project_UserSpecifiedUsageLimit_Field
+	UserSpecifiedBandwidthLimit Project_UserSpecifiedBandwidthLimit_Field
+	SegmentLimit                Project_SegmentLimit_Field
+	RateLimit                   Project_RateLimit_Field
+	BurstLimit                  Project_BurstLimit_Field
+	MaxBuckets                  Project_MaxBuckets_Field
+}
 
-type StorjscanPayment_ToAddress_Field struct {
+type Project_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func StorjscanPayment_ToAddress(v []byte) StorjscanPayment_ToAddress_Field {
-	return StorjscanPayment_ToAddress_Field{_set: true, _value: v}
+func Project_Id(v []byte) Project_Id_Field {
+	return Project_Id_Field{_set: true, _value: v}
 }
 
-func (f StorjscanPayment_ToAddress_Field) value() interface{} {
+func (f Project_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanPayment_ToAddress_Field) _Column() string { return "to_address" }
+func (Project_Id_Field) _Column() string { return "id" }
 
-type StorjscanPayment_TokenValue_Field struct {
+type Project_PublicId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func StorjscanPayment_TokenValue(v int64) StorjscanPayment_TokenValue_Field {
-	return StorjscanPayment_TokenValue_Field{_set: true, _value: v}
+func Project_PublicId(v []byte) Project_PublicId_Field {
+	return Project_PublicId_Field{_set: true, _value: v}
 }
 
-func (f StorjscanPayment_TokenValue_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_PublicId_Raw(v []byte) Project_PublicId_Field {
+	if v == nil {
+		return Project_PublicId_Null()
 	}
-	return f._value
+	return Project_PublicId(v)
 }
 
-func (StorjscanPayment_TokenValue_Field) _Column() string { return "token_value" }
-
-type StorjscanPayment_UsdValue_Field struct {
-	_set   bool
-	_null  bool
-	_value int64
+func Project_PublicId_Null() Project_PublicId_Field {
+	return Project_PublicId_Field{_set: true, _null: true}
 }
 
-func StorjscanPayment_UsdValue(v int64) StorjscanPayment_UsdValue_Field {
-	return StorjscanPayment_UsdValue_Field{_set: true, _value: v}
-}
+func (f Project_PublicId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f StorjscanPayment_UsdValue_Field) value() interface{} {
+func (f Project_PublicId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanPayment_UsdValue_Field) _Column() string { return "usd_value" }
+func (Project_PublicId_Field) _Column() string { return "public_id" }
 
-type StorjscanPayment_Status_Field struct {
+type Project_Name_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func StorjscanPayment_Status(v string) StorjscanPayment_Status_Field {
-	return StorjscanPayment_Status_Field{_set: true, _value: v}
+func Project_Name(v string) Project_Name_Field {
+	return Project_Name_Field{_set: true, _value: v}
 }
 
-func (f StorjscanPayment_Status_Field) value() interface{} {
+func (f Project_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanPayment_Status_Field) _Column() string { return "status" }
+func (Project_Name_Field) _Column() string { return "name" }
 
-type StorjscanPayment_Timestamp_Field struct {
+type Project_Description_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func StorjscanPayment_Timestamp(v time.Time) StorjscanPayment_Timestamp_Field {
-	return StorjscanPayment_Timestamp_Field{_set: true, _value: v}
+func Project_Description(v string) Project_Description_Field {
+	return Project_Description_Field{_set: true, _value: v}
 }
 
-func (f StorjscanPayment_Timestamp_Field) value() interface{} {
+func (f Project_Description_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanPayment_Timestamp_Field) _Column() string { return "timestamp" }
+func (Project_Description_Field) _Column() string { return "description" }
 
-type StorjscanPayment_CreatedAt_Field struct {
+type Project_UsageLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *int64
 }
 
-func StorjscanPayment_CreatedAt(v time.Time) StorjscanPayment_CreatedAt_Field {
-	return StorjscanPayment_CreatedAt_Field{_set: true, _value: v}
+func Project_UsageLimit(v int64) Project_UsageLimit_Field {
+	return Project_UsageLimit_Field{_set: true, _value: &v}
 }
 
-func (f StorjscanPayment_CreatedAt_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_UsageLimit_Raw(v *int64) Project_UsageLimit_Field {
+	if v == nil {
+		return Project_UsageLimit_Null()
 	}
-	return f._value
-}
-
-func (StorjscanPayment_CreatedAt_Field) _Column() string { return "created_at" }
-
-type StorjscanWallet struct {
-	UserId        []byte
-	WalletAddress []byte
-	CreatedAt     time.Time
-}
-
-func (StorjscanWallet) _Table() string { return "storjscan_wallets" }
-
-type StorjscanWallet_Update_Fields struct {
+	return Project_UsageLimit(*v)
 }
 
-type StorjscanWallet_UserId_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func Project_UsageLimit_Null() Project_UsageLimit_Field {
+	return Project_UsageLimit_Field{_set: true, _null: true}
 }
 
-func StorjscanWallet_UserId(v []byte) StorjscanWallet_UserId_Field {
-	return StorjscanWallet_UserId_Field{_set: true, _value: v}
-}
+func (f Project_UsageLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f StorjscanWallet_UserId_Field) value() interface{} {
+func (f Project_UsageLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanWallet_UserId_Field) _Column() string { return "user_id" }
+func (Project_UsageLimit_Field) _Column() string { return "usage_limit" }
 
-type StorjscanWallet_WalletAddress_Field struct {
+type Project_BandwidthLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *int64
 }
 
-func StorjscanWallet_WalletAddress(v []byte) StorjscanWallet_WalletAddress_Field {
-	return StorjscanWallet_WalletAddress_Field{_set: true, _value: v}
+func Project_BandwidthLimit(v int64) Project_BandwidthLimit_Field {
+	return Project_BandwidthLimit_Field{_set: true, _value: &v}
 }
 
-func (f StorjscanWallet_WalletAddress_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_BandwidthLimit_Raw(v *int64) Project_BandwidthLimit_Field {
+	if v == nil {
+		return Project_BandwidthLimit_Null()
 	}
-	return f._value
+	return Project_BandwidthLimit(*v)
 }
 
-func (StorjscanWallet_WalletAddress_Field) _Column() string { return "wallet_address" }
-
-type StorjscanWallet_CreatedAt_Field struct {
-	_set   bool
-	_null  bool
-	_value time.Time
+func Project_BandwidthLimit_Null() Project_BandwidthLimit_Field {
+	return Project_BandwidthLimit_Field{_set: true, _null: true}
 }
 
-func StorjscanWallet_CreatedAt(v time.Time) StorjscanWallet_CreatedAt_Field {
-	return StorjscanWallet_CreatedAt_Field{_set: true, _value: v}
-}
+func (f Project_BandwidthLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f StorjscanWallet_CreatedAt_Field) value() interface{} {
+func (f Project_BandwidthLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanWallet_CreatedAt_Field) _Column() string { return "created_at" }
+func (Project_BandwidthLimit_Field) _Column() string { return "bandwidth_limit" }
 
-type StripeCustomer struct {
-	UserId             []byte
-	CustomerId         string
-	PackagePlan        *string
-	PurchasedPackageAt *time.Time
-	CreatedAt          time.Time
+type Project_UserSpecifiedUsageLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
 }
 
-func (StripeCustomer) _Table() string { return "stripe_customers" }
-
-type StripeCustomer_Create_Fields struct {
-	PackagePlan        StripeCustomer_PackagePlan_Field
-	PurchasedPackageAt StripeCustomer_PurchasedPackageAt_Field
+func Project_UserSpecifiedUsageLimit(v int64) Project_UserSpecifiedUsageLimit_Field {
+	return Project_UserSpecifiedUsageLimit_Field{_set: true, _value: &v}
 }
 
-type StripeCustomer_Update_Fields struct {
-	PackagePlan        StripeCustomer_PackagePlan_Field
-	PurchasedPackageAt StripeCustomer_PurchasedPackageAt_Field
+func Project_UserSpecifiedUsageLimit_Raw(v *int64) Project_UserSpecifiedUsageLimit_Field {
+	if v == nil {
+		return Project_UserSpecifiedUsageLimit_Null()
+	}
+	return Project_UserSpecifiedUsageLimit(*v)
 }
 
-type StripeCustomer_UserId_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func Project_UserSpecifiedUsageLimit_Null() Project_UserSpecifiedUsageLimit_Field {
+	return Project_UserSpecifiedUsageLimit_Field{_set: true, _null: true}
 }
 
-func StripeCustomer_UserId(v []byte) StripeCustomer_UserId_Field {
-	return StripeCustomer_UserId_Field{_set: true, _value: v}
+func (f Project_UserSpecifiedUsageLimit_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f StripeCustomer_UserId_Field) value() interface{} {
+func (f Project_UserSpecifiedUsageLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripeCustomer_UserId_Field) _Column() string { return "user_id" }
+func (Project_UserSpecifiedUsageLimit_Field) _Column() string { return "user_specified_usage_limit" }
 
-type StripeCustomer_CustomerId_Field struct {
+type Project_UserSpecifiedBandwidthLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value *int64
 }
 
-func StripeCustomer_CustomerId(v string) StripeCustomer_CustomerId_Field {
-	return StripeCustomer_CustomerId_Field{_set: true, _value: v}
+func Project_UserSpecifiedBandwidthLimit(v int64) Project_UserSpecifiedBandwidthLimit_Field {
+	return Project_UserSpecifiedBandwidthLimit_Field{_set: true, _value: &v}
 }
 
-func (f StripeCustomer_CustomerId_Field) value() interface{} {
+func Project_UserSpecifiedBandwidthLimit_Raw(v *int64) Project_UserSpecifiedBandwidthLimit_Field {
+	if v == nil {
+		return Project_UserSpecifiedBandwidthLimit_Null()
+	}
+	return Project_UserSpecifiedBandwidthLimit(*v)
+}
+
+func Project_UserSpecifiedBandwidthLimit_Null() Project_UserSpecifiedBandwidthLimit_Field {
+	return Project_UserSpecifiedBandwidthLimit_Field{_set: true, _null: true}
+}
+
+func (f Project_UserSpecifiedBandwidthLimit_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f Project_UserSpecifiedBandwidthLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripeCustomer_CustomerId_Field) _Column() string { return "customer_id" }
+func (Project_UserSpecifiedBandwidthLimit_Field) _Column() string {
+	return "user_specified_bandwidth_limit"
+}
 
-type StripeCustomer_PackagePlan_Field struct {
+type Project_SegmentLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value *int64
 }
 
-func StripeCustomer_PackagePlan(v string) StripeCustomer_PackagePlan_Field {
-	return StripeCustomer_PackagePlan_Field{_set: true, _value: &v}
+func Project_SegmentLimit(v int64) Project_SegmentLimit_Field {
+	return Project_SegmentLimit_Field{_set: true, _value: &v}
 }
 
-func StripeCustomer_PackagePlan_Raw(v *string) StripeCustomer_PackagePlan_Field {
+func Project_SegmentLimit_Raw(v *int64) Project_SegmentLimit_Field {
 	if v == nil {
-		return StripeCustomer_PackagePlan_Null()
+		return Project_SegmentLimit_Null()
 	}
-	return StripeCustomer_PackagePlan(*v)
+	return Project_SegmentLimit(*v)
 }
 
-func StripeCustomer_PackagePlan_Null() StripeCustomer_PackagePlan_Field {
-	return StripeCustomer_PackagePlan_Field{_set: true, _null: true}
+func Project_SegmentLimit_Null() Project_SegmentLimit_Field {
+	return Project_SegmentLimit_Field{_set: true, _null: true}
 }
 
-func (f StripeCustomer_PackagePlan_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Project_SegmentLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f StripeCustomer_PackagePlan_Field) value() interface{} {
+func (f Project_SegmentLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripeCustomer_PackagePlan_Field) _Column() string { return "package_plan" }
+func (Project_SegmentLimit_Field) _Column() string { return "segment_limit" }
 
-type StripeCustomer_PurchasedPackageAt_Field struct {
+type Project_RateLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value *int
 }
 
-func StripeCustomer_PurchasedPackageAt(v time.Time) StripeCustomer_PurchasedPackageAt_Field {
-	return StripeCustomer_PurchasedPackageAt_Field{_set: true, _value: &v}
+func Project_RateLimit(v int) Project_RateLimit_Field {
+	return Project_RateLimit_Field{_set: true, _value: &v}
 }
 
-func StripeCustomer_PurchasedPackageAt_Raw(v *time.Time) StripeCustomer_PurchasedPackageAt_Field {
+func Project_RateLimit_Raw(v *int) Project_RateLimit_Field {
 	if v == nil {
-		return StripeCustomer_PurchasedPackageAt_Null()
+		return Project_RateLimit_Null()
 	}
-	return StripeCustomer_PurchasedPackageAt(*v)
+	return Project_RateLimit(*v)
 }
 
-func StripeCustomer_PurchasedPackageAt_Null() StripeCustomer_PurchasedPackageAt_Field {
-	return StripeCustomer_PurchasedPackageAt_Field{_set: true, _null: true}
+func Project_RateLimit_Null() Project_RateLimit_Field {
+	return Project_RateLimit_Field{_set: true, _null: true}
 }
 
-func (f StripeCustomer_PurchasedPackageAt_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
-}
+func (f Project_RateLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f StripeCustomer_PurchasedPackageAt_Field) value() interface{} {
+func (f Project_RateLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripeCustomer_PurchasedPackageAt_Field) _Column() string { return "purchased_package_at" }
+func (Project_RateLimit_Field) _Column() string { return "rate_limit" }
 
-type StripeCustomer_CreatedAt_Field struct {
+type Project_BurstLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *int
 }
 
-func StripeCustomer_CreatedAt(v time.Time) StripeCustomer_CreatedAt_Field {
-	return StripeCustomer_CreatedAt_Field{_set: true, _value: v}
+func Project_BurstLimit(v int) Project_BurstLimit_Field {
+	return Project_BurstLimit_Field{_set: true, _value: &v}
 }
 
-func (f StripeCustomer_CreatedAt_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_BurstLimit_Raw(v *int) Project_BurstLimit_Field {
+	if v == nil {
+		return Project_BurstLimit_Null()
 	}
-	return f._value
+	return Project_BurstLimit(*v)
 }
 
-func (StripeCustomer_CreatedAt_Field) _Column() string { return "created_at" }
-
-type StripecoinpaymentsInvoiceProjectRecord struct {
-	Id          []byte
-	ProjectId   []byte
-	Storage     float64
-	Egress      int64
-	Objects     *int64
-	Segments    *int64
-	PeriodStart time.Time
-	PeriodEnd   time.Time
-	State       int
-	CreatedAt   time.Time
+func Project_BurstLimit_Null() Project_BurstLimit_Field {
+	return Project_BurstLimit_Field{_set: true, _null: true}
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord) _Table() string {
-	return "stripecoinpayments_invoice_project_records"
-}
+func (f Project_BurstLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-type StripecoinpaymentsInvoiceProjectRecord_Create_Fields struct {
-	Objects  StripecoinpaymentsInvoiceProjectRecord_Objects_Field
-	Segments StripecoinpaymentsInvoiceProjectRecord_Segments_Field
+func (f Project_BurstLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type StripecoinpaymentsInvoiceProjectRecord_Update_Fields struct {
-	State StripecoinpaymentsInvoiceProjectRecord_State_Field
-}
+func (Project_BurstLimit_Field) _Column() string { return "burst_limit" }
 
-type StripecoinpaymentsInvoiceProjectRecord_Id_Field struct {
+type Project_MaxBuckets_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *int
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Id(v []byte) StripecoinpaymentsInvoiceProjectRecord_Id_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Id_Field{_set: true, _value: v}
+func Project_MaxBuckets(v int) Project_MaxBuckets_Field {
+	return Project_MaxBuckets_Field{_set: true, _value: &v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Id_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_MaxBuckets_Raw(v *int) Project_MaxBuckets_Field {
+	if v == nil {
+		return Project_MaxBuckets_Null()
 	}
-	return f._value
+	return Project_MaxBuckets(*v)
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_Id_Field) _Column() string { return "id" }
-
-type StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func Project_MaxBuckets_Null() Project_MaxBuckets_Field {
+	return Project_MaxBuckets_Field{_set: true, _null: true}
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_ProjectId(v []byte) StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field{_set: true, _value: v}
-}
+func (f Project_MaxBuckets_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field) value() interface{} {
+func (f Project_MaxBuckets_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field) _Column() string { return "project_id" }
+func (Project_MaxBuckets_Field) _Column() string { return "max_buckets" }
 
-type StripecoinpaymentsInvoiceProjectRecord_Storage_Field struct {
+type Project_PartnerId_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value []byte
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Storage(v float64) StripecoinpaymentsInvoiceProjectRecord_Storage_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Storage_Field{_set: true, _value: v}
+func Project_PartnerId(v []byte) Project_PartnerId_Field {
+	return Project_PartnerId_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Storage_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_PartnerId_Raw(v []byte) Project_PartnerId_Field {
+	if v == nil {
+		return Project_PartnerId_Null()
 	}
-	return f._value
+	return Project_PartnerId(v)
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_Storage_Field) _Column() string { return "storage" }
-
-type StripecoinpaymentsInvoiceProjectRecord_Egress_Field struct {
-	_set   bool
-	_null  bool
-	_value int64
+func Project_PartnerId_Null() Project_PartnerId_Field {
+	return Project_PartnerId_Field{_set: true, _null: true}
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Egress(v int64) StripecoinpaymentsInvoiceProjectRecord_Egress_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Egress_Field{_set: true, _value: v}
-}
+func (f Project_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Egress_Field) value() interface{} {
+func (f Project_PartnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_Egress_Field) _Column() string { return "egress" }
+func (Project_PartnerId_Field) _Column() string { return "partner_id" }
 
-type StripecoinpaymentsInvoiceProjectRecord_Objects_Field struct {
+type Project_UserAgent_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value []byte
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Objects(v int64) StripecoinpaymentsInvoiceProjectRecord_Objects_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Objects_Field{_set: true, _value: &v}
+func Project_UserAgent(v []byte) Project_UserAgent_Field {
+	return Project_UserAgent_Field{_set: true, _value: v}
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Objects_Raw(v *int64) StripecoinpaymentsInvoiceProjectRecord_Objects_Field {
+func Project_UserAgent_Raw(v []byte) Project_UserAgent_Field {
 	if v == nil {
-		return StripecoinpaymentsInvoiceProjectRecord_Objects_Null()
+		return Project_UserAgent_Null()
 	}
-	return StripecoinpaymentsInvoiceProjectRecord_Objects(*v)
+	return Project_UserAgent(v)
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Objects_Null() StripecoinpaymentsInvoiceProjectRecord_Objects_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Objects_Field{_set: true, _null: true}
+func Project_UserAgent_Null() Project_UserAgent_Field {
+	return Project_UserAgent_Field{_set: true, _null: true}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Objects_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func (f Project_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_UserAgent_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Objects_Field) value() interface{} {
+func (Project_UserAgent_Field) _Column() string { return "user_agent" }
+
+type Project_OwnerId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Project_OwnerId(v []byte) Project_OwnerId_Field {
+	return Project_OwnerId_Field{_set: true, _value: v}
+}
+
+func (f Project_OwnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_Objects_Field) _Column() string { return "objects" }
+func (Project_OwnerId_Field) _Column() string { return "owner_id" }
 
-type StripecoinpaymentsInvoiceProjectRecord_Segments_Field struct {
+type Project_Salt_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value []byte
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Segments(v int64) StripecoinpaymentsInvoiceProjectRecord_Segments_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Segments_Field{_set: true, _value: &v}
+func Project_Salt(v []byte) Project_Salt_Field {
+	return Project_Salt_Field{_set: true, _value: v}
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Segments_Raw(v *int64) StripecoinpaymentsInvoiceProjectRecord_Segments_Field {
+func Project_Salt_Raw(v []byte) Project_Salt_Field {
 	if v == nil {
-		return StripecoinpaymentsInvoiceProjectRecord_Segments_Null()
+		return Project_Salt_Null()
 	}
-	return StripecoinpaymentsInvoiceProjectRecord_Segments(*v)
+	return Project_Salt(v)
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Segments_Null() StripecoinpaymentsInvoiceProjectRecord_Segments_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Segments_Field{_set: true, _null: true}
+func Project_Salt_Null() Project_Salt_Field {
+	return Project_Salt_Field{_set: true, _null: true}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Segments_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
-}
+func (f Project_Salt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Segments_Field) value() interface{} {
+func (f Project_Salt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_Segments_Field) _Column() string { return "segments" }
+func (Project_Salt_Field) _Column() string { return "salt" }
 
-type StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field struct {
+type Project_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_PeriodStart(v time.Time) StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field{_set: true, _value: v}
+func Project_CreatedAt(v time.Time) Project_CreatedAt_Field {
+	return Project_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field) value() interface{} {
+func (f Project_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field) _Column() string {
-	return "period_start"
+func (Project_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ProjectApiUsageRollup struct {
+	ProjectId     []byte
+	IntervalStart time.Time
+	Operation     string
+	Count         int64
 }
 
-type StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field struct {
+func (ProjectApiUsageRollup) _Table() string { return "project_api_usage_rollups" }
+
+type ProjectApiUsageRollup_Create_Fields struct {
+}
+
+type ProjectApiUsageRollup_Update_Fields struct {
+	Count ProjectApiUsageRollup_Count_Field
+}
+
+type ProjectApiUsageRollup_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectApiUsageRollup_ProjectId(v []byte) ProjectApiUsageRollup_ProjectId_Field {
+	return ProjectApiUsageRollup_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectApiUsageRollup_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectApiUsageRollup_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectApiUsageRollup_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_PeriodEnd(v time.Time) StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field{_set: true, _value: v}
+func ProjectApiUsageRollup_IntervalStart(v time.Time) ProjectApiUsageRollup_IntervalStart_Field {
+	return ProjectApiUsageRollup_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field) value() interface{} {
+func (f ProjectApiUsageRollup_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field) _Column() string { return "period_end" }
+func (ProjectApiUsageRollup_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type StripecoinpaymentsInvoiceProjectRecord_State_Field struct {
+type ProjectApiUsageRollup_Operation_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_State(v int) StripecoinpaymentsInvoiceProjectRecord_State_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_State_Field{_set: true, _value: v}
+func ProjectApiUsageRollup_Operation(v string) ProjectApiUsageRollup_Operation_Field {
+	return ProjectApiUsageRollup_Operation_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_State_Field) value() interface{} {
+func (f ProjectApiUsageRollup_Operation_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_State_Field) _Column() string { return "state" }
+func (ProjectApiUsageRollup_Operation_Field) _Column() string { return "operation" }
 
-type StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field struct {
+type ProjectApiUsageRollup_Count_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_CreatedAt(v time.Time) StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field{_set: true, _value: v}
+func ProjectApiUsageRollup_Count(v int64) ProjectApiUsageRollup_Count_Field {
+	return ProjectApiUsageRollup_Count_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field) value() interface{} {
+func (f ProjectApiUsageRollup_Count_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field) _Column() string { return "created_at" }
+func (ProjectApiUsageRollup_Count_Field) _Column() string { return "count" }
 
-type StripecoinpaymentsTxConversionRate struct {
-	TxId        string
-	RateNumeric float64
-	CreatedAt   time.Time
+type ProjectBandwidthDailyRollup struct {
+	ProjectId       []byte
+	IntervalDay     time.Time
+	EgressAllocated uint64
+	EgressSettled   uint64
+	EgressDead      uint64
 }
 
-func (StripecoinpaymentsTxConversionRate) _Table() string {
-	return "stripecoinpayments_tx_conversion_rates"
+func (ProjectBandwidthDailyRollup) _Table() string { return "project_bandwidth_daily_rollups" }
+
+type ProjectBandwidthDailyRollup_Create_Fields struct {
+	EgressDead ProjectBandwidthDailyRollup_EgressDead_Field
 }
 
-type StripecoinpaymentsTxConversionRate_Update_Fields struct {
+type ProjectBandwidthDailyRollup_Update_Fields struct {
+	EgressAllocated ProjectBandwidthDailyRollup_EgressAllocated_Field
+	EgressSettled   ProjectBandwidthDailyRollup_EgressSettled_Field
+	EgressDead      ProjectBandwidthDailyRollup_EgressDead_Field
 }
 
-type StripecoinpaymentsTxConversionRate_TxId_Field struct {
+type ProjectBandwidthDailyRollup_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func StripecoinpaymentsTxConversionRate_TxId(v string) StripecoinpaymentsTxConversionRate_TxId_Field {
-	return StripecoinpaymentsTxConversionRate_TxId_Field{_set: true, _value: v}
+func ProjectBandwidthDailyRollup_ProjectId(v []byte) ProjectBandwidthDailyRollup_ProjectId_Field {
+	return ProjectBandwidthDailyRollup_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsTxConversionRate_TxId_Field) value() interface{} {
+func (f ProjectBandwidthDailyRollup_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsTxConversionRate_TxId_Field) _Column() string { return "tx_id" }
+func (ProjectBandwidthDailyRollup_ProjectId_Field) _Column() string { return "project_id" }
 
-type StripecoinpaymentsTxConversionRate_RateNumeric_Field struct {
+type ProjectBandwidthDailyRollup_IntervalDay_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value time.Time
 }
 
-func StripecoinpaymentsTxConversionRate_RateNumeric(v float64) StripecoinpaymentsTxConversionRate_RateNumeric_Field {
-	return StripecoinpaymentsTxConversionRate_RateNumeric_Field{_set: true, _value: v}
+func ProjectBandwidthDailyRollup_IntervalDay(v time.Time) ProjectBandwidthDailyRollup_IntervalDay_Field {
+	v = toDate(v)
+	return ProjectBandwidthDailyRollup_IntervalDay_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsTxConversionRate_RateNumeric_Field) value() interface{} {
+func (f ProjectBandwidthDailyRollup_IntervalDay_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsTxConversionRate_RateNumeric_Field) _Column() string { return "rate_numeric" }
+func (ProjectBandwidthDailyRollup_IntervalDay_Field) _Column() string { return "interval_day" }
 
-type StripecoinpaymentsTxConversionRate_CreatedAt_Field struct {
+type ProjectBandwidthDailyRollup_EgressAllocated_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value uint64
 }
 
-func StripecoinpaymentsTxConversionRate_CreatedAt(v time.Time) StripecoinpaymentsTxConversionRate_CreatedAt_Field {
-	return StripecoinpaymentsTxConversionRate_CreatedAt_Field{_set: true, _value: v}
+func ProjectBandwidthDailyRollup_EgressAllocated(v uint64) ProjectBandwidthDailyRollup_EgressAllocated_Field {
+	return ProjectBandwidthDailyRollup_EgressAllocated_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsTxConversionRate_CreatedAt_Field) value() interface{} {
+func (f ProjectBandwidthDailyRollup_EgressAllocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsTxConversionRate_CreatedAt_Field) _Column() string { return "created_at" }
+func (ProjectBandwidthDailyRollup_EgressAllocated_Field) _Column() string { return "egress_allocated" }
 
-type User struct {
-	Id                     []byte
-	Email                  string
-	NormalizedEmail        string
-	FullName               string
-	ShortName              *string
-	PasswordHash           []byte
-	Status                 int
-	PartnerId              []byte
-	UserAgent              []byte
-	CreatedAt              time.Time
-	ProjectLimit           int
-	ProjectBandwidthLimit  int64
-	ProjectStorageLimit    int64
-	ProjectSegmentLimit    int64
-	PaidTier               bool
-	Position               *string
-	CompanyName            *string
-	CompanySize            *int
-	WorkingOn              *string
-	IsProfessional         bool
-	EmployeeCount          *string
-	HaveSalesContact       bool
-	MfaEnabled             bool
-	MfaSecretKey           *string
-	MfaRecoveryCodes       *string
-	SignupPromoCode        *string
-	VerificationReminders  int
-	FailedLoginCount       *int
-	LoginLockoutExpiration *time.Time
-	SignupCaptcha          *float64
+type ProjectBandwidthDailyRollup_EgressSettled_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
 }
 
-func (User) _Table() string { return "users" }
+func ProjectBandwidthDailyRollup_EgressSettled(v uint64) ProjectBandwidthDailyRollup_EgressSettled_Field {
+	return ProjectBandwidthDailyRollup_EgressSettled_Field{_set: true, _value: v}
+}
 
-type User_Create_Fields struct {
-	ShortName              User_ShortName_Field
-	PartnerId              User_PartnerId_Field
-	UserAgent              User_UserAgent_Field
-	ProjectLimit           User_ProjectLimit_Field
-	ProjectBandwidthLimit  User_ProjectBandwidthLimit_Field
-	ProjectStorageLimit    User_ProjectStorageLimit_Field
-	ProjectSegmentLimit    User_ProjectSegmentLimit_Field
-	PaidTier               User_PaidTier_Field
-	Position               User_Position_Field
-	CompanyName            User_CompanyName_Field
-	CompanySize            User_CompanySize_Field
-	WorkingOn              User_WorkingOn_Field
-	IsProfessional         User_IsProfessional_Field
-	EmployeeCount          User_EmployeeCount_Field
-	HaveSalesContact       User_HaveSalesContact_Field
-	MfaEnabled             User_MfaEnabled_Field
-	MfaSecretKey           User_MfaSecretKey_Field
-	MfaRecoveryCodes       User_MfaRecoveryCodes_Field
-	SignupPromoCode        User_SignupPromoCode_Field
-	VerificationReminders  User_VerificationReminders_Field
-	FailedLoginCount       User_FailedLoginCount_Field
-	LoginLockoutExpiration User_LoginLockoutExpiration_Field
-	SignupCaptcha          User_SignupCaptcha_Field
+func (f ProjectBandwidthDailyRollup_EgressSettled_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type User_Update_Fields struct {
-	Email                  User_Email_Field
-	NormalizedEmail        User_NormalizedEmail_Field
-	FullName               User_FullName_Field
-	ShortName              User_ShortName_Field
-	PasswordHash           User_PasswordHash_Field
-	Status                 User_Status_Field
-	ProjectLimit           User_ProjectLimit_Field
-	ProjectBandwidthLimit  User_ProjectBandwidthLimit_Field
-	ProjectStorageLimit    User_ProjectStorageLimit_Field
-	ProjectSegmentLimit    User_ProjectSegmentLimit_Field
-	PaidTier               User_PaidTier_Field
-	Position               User_Position_Field
-	CompanyName            User_CompanyName_Field
-	CompanySize            User_CompanySize_Field
-	WorkingOn              User_WorkingOn_Field
-	IsProfessional         User_IsProfessional_Field
-	EmployeeCount          User_EmployeeCount_Field
-	HaveSalesContact       User_HaveSalesContact_Field
-	MfaEnabled             User_MfaEnabled_Field
-	MfaSecretKey           User_MfaSecretKey_Field
-	MfaRecoveryCodes       User_MfaRecoveryCodes_Field
-	SignupPromoCode        User_SignupPromoCode_Field
-	VerificationReminders  User_VerificationReminders_Field
-	FailedLoginCount       User_FailedLoginCount_Field
-	LoginLockoutExpiration User_LoginLockoutExpiration_Field
-}
+func (ProjectBandwidthDailyRollup_EgressSettled_Field) _Column() string { return "egress_settled" }
 
-type User_Id_Field struct {
+type ProjectBandwidthDailyRollup_EgressDead_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint64
 }
 
-func User_Id(v []byte) User_Id_Field {
-	return User_Id_Field{_set: true, _value: v}
+func ProjectBandwidthDailyRollup_EgressDead(v uint64) ProjectBandwidthDailyRollup_EgressDead_Field {
+	return ProjectBandwidthDailyRollup_EgressDead_Field{_set: true, _value: v}
 }
 
-func (f User_Id_Field) value() interface{} {
+func (f ProjectBandwidthDailyRollup_EgressDead_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_Id_Field) _Column() string { return "id" }
+func (ProjectBandwidthDailyRollup_EgressDead_Field) _Column() string { return "egress_dead" }
 
-type User_Email_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+type RangedLoopLease struct {
+	Name      string
+	Owner     []byte
+	Token     int64
+	ExpiresAt time.Time
+	UpdatedAt time.Time
 }
 
-func User_Email(v string) User_Email_Field {
-	return User_Email_Field{_set: true, _value: v}
-}
+func (RangedLoopLease) _Table() string { return "ranged_loop_leases" }
 
-func (f User_Email_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type RangedLoopLease_Create_Fields struct {
+	Name      RangedLoopLease_Name_Field
+	Owner     RangedLoopLease_Owner_Field
+	Token     RangedLoopLease_Token_Field
+	ExpiresAt RangedLoopLease_ExpiresAt_Field
 }
 
-func (User_Email_Field) _Column() string { return "email" }
+type RangedLoopLease_Update_Fields struct {
+	Owner     RangedLoopLease_Owner_Field
+	Token     RangedLoopLease_Token_Field
+	ExpiresAt RangedLoopLease_ExpiresAt_Field
+}
 
-type User_NormalizedEmail_Field struct {
+type RangedLoopLease_Name_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func User_NormalizedEmail(v string) User_NormalizedEmail_Field {
-	return User_NormalizedEmail_Field{_set: true, _value: v}
+func RangedLoopLease_Name(v string) RangedLoopLease_Name_Field {
+	return RangedLoopLease_Name_Field{_set: true, _value: v}
 }
 
-func (f User_NormalizedEmail_Field) value() interface{} {
+func (f RangedLoopLease_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_NormalizedEmail_Field) _Column() string { return "normalized_email" }
+func (RangedLoopLease_Name_Field) _Column() string { return "name" }
 
-type User_FullName_Field struct {
+type RangedLoopLease_Owner_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func User_FullName(v string) User_FullName_Field {
-	return User_FullName_Field{_set: true, _value: v}
+func RangedLoopLease_Owner(v []byte) RangedLoopLease_Owner_Field {
+	return RangedLoopLease_Owner_Field{_set: true, _value: v}
 }
 
-func (f User_FullName_Field) value() interface{} {
+func (f RangedLoopLease_Owner_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_FullName_Field) _Column() string { return "full_name" }
+func (RangedLoopLease_Owner_Field) _Column() string { return "owner" }
 
-type User_ShortName_Field struct {
+type RangedLoopLease_Token_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
-}
-
-func User_ShortName(v string) User_ShortName_Field {
-	return User_ShortName_Field{_set: true, _value: &v}
-}
-
-func User_ShortName_Raw(v *string) User_ShortName_Field {
-	if v == nil {
-		return User_ShortName_Null()
-	}
-	return User_ShortName(*v)
+	_value int64
 }
 
-func User_ShortName_Null() User_ShortName_Field {
-	return User_ShortName_Field{_set: true, _null: true}
+func RangedLoopLease_Token(v int64) RangedLoopLease_Token_Field {
+	return RangedLoopLease_Token_Field{_set: true, _value: v}
 }
 
-func (f User_ShortName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f User_ShortName_Field) value() interface{} {
+func (f RangedLoopLease_Token_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_ShortName_Field) _Column() string { return "short_name" }
+func (RangedLoopLease_Token_Field) _Column() string { return "token" }
 
-type User_PasswordHash_Field struct {
+type RangedLoopLease_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func User_PasswordHash(v []byte) User_PasswordHash_Field {
-	return User_PasswordHash_Field{_set: true, _value: v}
+func RangedLoopLease_ExpiresAt(v time.Time) RangedLoopLease_ExpiresAt_Field {
+	return RangedLoopLease_ExpiresAt_Field{_set: true, _value: v}
 }
 
-func (f User_PasswordHash_Field) value() interface{} {
+func (f RangedLoopLease_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_PasswordHash_Field) _Column() string { return "password_hash" }
+func (RangedLoopLease_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type User_Status_Field struct {
+type RangedLoopLease_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func User_Status(v int) User_Status_Field {
-	return User_Status_Field{_set: true, _value: v}
+func RangedLoopLease_UpdatedAt(v time.Time) RangedLoopLease_UpdatedAt_Field {
+	return RangedLoopLease_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f User_Status_Field) value() interface{} {
+func (f RangedLoopLease_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_Status_Field) _Column() string { return "status" }
+func (RangedLoopLease_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type User_PartnerId_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+type RegistrationToken struct {
+	Secret       []byte
+	OwnerId      []byte
+	ProjectLimit int
+	CreatedAt    time.Time
 }
 
-func User_PartnerId(v []byte) User_PartnerId_Field {
-	return User_PartnerId_Field{_set: true, _value: v}
+func (RegistrationToken) _Table() string { return "registration_tokens" }
+
+type RegistrationToken_Create_Fields struct {
+	OwnerId RegistrationToken_OwnerId_Field
 }
 
-func User_PartnerId_Raw(v []byte) User_PartnerId_Field {
-	if v == nil {
-		return User_PartnerId_Null()
-	}
-	return User_PartnerId(v)
+type RegistrationToken_Update_Fields struct {
+	OwnerId RegistrationToken_OwnerId_Field
 }
 
-func User_PartnerId_Null() User_PartnerId_Field {
-	return User_PartnerId_Field{_set: true, _null: true}
+type RegistrationToken_Secret_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f User_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func RegistrationToken_Secret(v []byte) RegistrationToken_Secret_Field {
+	return RegistrationToken_Secret_Field{_set: true, _value: v}
+}
 
-func (f User_PartnerId_Field) value() interface{} {
+func (f RegistrationToken_Secret_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_PartnerId_Field) _Column() string { return "partner_id" }
+func (RegistrationToken_Secret_Field) _Column() string { return "secret" }
 
-type User_UserAgent_Field struct {
+type RegistrationToken_OwnerId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func User_UserAgent(v []byte) User_UserAgent_Field {
-	return User_UserAgent_Field{_set: true, _value: v}
+func RegistrationToken_OwnerId(v []byte) RegistrationToken_OwnerId_Field {
+	return RegistrationToken_OwnerId_Field{_set: true, _value: v}
 }
 
-func User_UserAgent_Raw(v []byte) User_UserAgent_Field {
+func RegistrationToken_OwnerId_Raw(v []byte) RegistrationToken_OwnerId_Field {
 	if v == nil {
-		return User_UserAgent_Null()
+		return RegistrationToken_OwnerId_Null()
 	}
-	return User_UserAgent(v)
+	return RegistrationToken_OwnerId(v)
 }
 
-func User_UserAgent_Null() User_UserAgent_Field {
-	return User_UserAgent_Field{_set: true, _null: true}
+func RegistrationToken_OwnerId_Null() RegistrationToken_OwnerId_Field {
+	return RegistrationToken_OwnerId_Field{_set: true, _null: true}
 }
 
-func (f User_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f RegistrationToken_OwnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f User_UserAgent_Field) value() interface{} {
+func (f RegistrationToken_OwnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_UserAgent_Field) _Column() string { return "user_agent" }
+func (RegistrationToken_OwnerId_Field) _Column() string { return "owner_id" }
 
-type User_CreatedAt_Field struct {
+type RegistrationToken_ProjectLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int
 }
 
-func User_CreatedAt(v time.Time) User_CreatedAt_Field {
-	return User_CreatedAt_Field{_set: true, _value: v}
+func RegistrationToken_ProjectLimit(v int) RegistrationToken_ProjectLimit_Field {
+	return RegistrationToken_ProjectLimit_Field{_set: true, _value: v}
 }
 
-func (f User_CreatedAt_Field) value() interface{} {
+func (f RegistrationToken_ProjectLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_CreatedAt_Field) _Column() string { return "created_at" }
+func (RegistrationToken_ProjectLimit_Field) _Column() string { return "project_limit" }
 
-type User_ProjectLimit_Field struct {
+type RegistrationToken_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func User_ProjectLimit(v int) User_ProjectLimit_Field {
-	return User_ProjectLimit_Field{_set: true, _value: v}
+func RegistrationToken_CreatedAt(v time.Time) RegistrationToken_CreatedAt_Field {
+	return RegistrationToken_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f User_ProjectLimit_Field) value() interface{} {
+func (f RegistrationToken_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_ProjectLimit_Field) _Column() string { return "project_limit" }
+func (RegistrationToken_CreatedAt_Field) _Column() string { return "created_at" }
 
-type User_ProjectBandwidthLimit_Field struct {
+type RepairQueue struct {
+	StreamId      []byte
+	Position      uint64
+	AttemptedAt   *time.Time
+	UpdatedAt     time.Time
+	InsertedAt    time.Time
+	SegmentHealth float64
+}
+
+func (RepairQueue) _Table() string { return "repair_queue" }
+
+type RepairQueue_Create_Fields struct {
+	AttemptedAt   RepairQueue_AttemptedAt_Field
+	UpdatedAt     RepairQueue_UpdatedAt_Field
+	InsertedAt    RepairQueue_InsertedAt_Field
+	SegmentHealth RepairQueue_SegmentHealth_Field
+}
+
+type RepairQueue_Update_Fields struct {
+	AttemptedAt RepairQueue_AttemptedAt_Field
+	UpdatedAt   RepairQueue_UpdatedAt_Field
+}
+
+type RepairQueue_StreamId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func User_ProjectBandwidthLimit(v int64) User_ProjectBandwidthLimit_Field {
-	return User_ProjectBandwidthLimit_Field{_set: true, _value: v}
+func RepairQueue_StreamId(v []byte) RepairQueue_StreamId_Field {
+	return RepairQueue_StreamId_Field{_set: true, _value: v}
 }
 
-func (f User_ProjectBandwidthLimit_Field) value() interface{} {
+func (f RepairQueue_StreamId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_ProjectBandwidthLimit_Field) _Column() string { return "project_bandwidth_limit" }
+func (RepairQueue_StreamId_Field) _Column() string { return "stream_id" }
 
-type User_ProjectStorageLimit_Field struct {
+type RepairQueue_Position_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint64
 }
 
-func User_ProjectStorageLimit(v int64) User_ProjectStorageLimit_Field {
-	return User_ProjectStorageLimit_Field{_set: true, _value: v}
+func RepairQueue_Position(v uint64) RepairQueue_Position_Field {
+	return RepairQueue_Position_Field{_set: true, _value: v}
 }
 
-func (f User_ProjectStorageLimit_Field) value() interface{} {
+func (f RepairQueue_Position_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_ProjectStorageLimit_Field) _Column() string { return "project_storage_limit" }
+func (RepairQueue_Position_Field) _Column() string { return "position" }
 
-type User_ProjectSegmentLimit_Field struct {
+type RepairQueue_AttemptedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *time.Time
 }
 
-func User_ProjectSegmentLimit(v int64) User_ProjectSegmentLimit_Field {
-	return User_ProjectSegmentLimit_Field{_set: true, _value: v}
+func RepairQueue_AttemptedAt(v time.Time) RepairQueue_AttemptedAt_Field {
+	return RepairQueue_AttemptedAt_Field{_set: true, _value: &v}
 }
 
-func (f User_ProjectSegmentLimit_Field) value() interface{} {
+func RepairQueue_AttemptedAt_Raw(v *time.Time) RepairQueue_AttemptedAt_Field {
+	if v == nil {
+		return RepairQueue_AttemptedAt_Null()
+	}
+	return RepairQueue_AttemptedAt(*v)
+}
+
+func RepairQueue_AttemptedAt_Null() RepairQueue_AttemptedAt_Field {
+	return RepairQueue_AttemptedAt_Field{_set: true, _null: true}
+}
+
+func (f RepairQueue_AttemptedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f RepairQueue_AttemptedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_ProjectSegmentLimit_Field) _Column() string { return "project_segment_limit" }
+func (RepairQueue_AttemptedAt_Field) _Column() string { return "attempted_at" }
 
-type User_PaidTier_Field struct {
+type RepairQueue_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value time.Time
 }
 
-func User_PaidTier(v bool) User_PaidTier_Field {
-	return User_PaidTier_Field{_set: true, _value: v}
+func RepairQueue_UpdatedAt(v time.Time) RepairQueue_UpdatedAt_Field {
+	return RepairQueue_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f User_PaidTier_Field) value() interface{} {
+func (f RepairQueue_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_PaidTier_Field) _Column() string { return "paid_tier" }
+func (RepairQueue_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type User_Position_Field struct {
+type RepairQueue_InsertedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
-}
-
-func User_Position(v string) User_Position_Field {
-	return User_Position_Field{_set: true, _value: &v}
-}
-
-func User_Position_Raw(v *string) User_Position_Field {
-	if v == nil {
-		return User_Position_Null()
-	}
-	return User_Position(*v)
+	_value time.Time
 }
 
-func User_Position_Null() User_Position_Field {
-	return User_Position_Field{_set: true, _null: true}
+func RepairQueue_InsertedAt(v time.Time) RepairQueue_InsertedAt_Field {
+	return RepairQueue_InsertedAt_Field{_set: true, _value: v}
 }
 
-func (f User_Position_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f User_Position_Field) value() interface{} {
+func (f RepairQueue_InsertedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_Position_Field) _Column() string { return "position" }
+func (RepairQueue_InsertedAt_Field) _Column() string { return "inserted_at" }
 
-type User_CompanyName_Field struct {
+type RepairQueue_SegmentHealth_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
-}
-
-func User_CompanyName(v string) User_CompanyName_Field {
-	return User_CompanyName_Field{_set: true, _value: &v}
-}
-
-func User_CompanyName_Raw(v *string) User_CompanyName_Field {
-	if v == nil {
-		return User_CompanyName_Null()
-	}
-	return User_CompanyName(*v)
+	_value float64
 }
 
-func User_CompanyName_Null() User_CompanyName_Field {
-	return User_CompanyName_Field{_set: true, _null: true}
+func RepairQueue_SegmentHealth(v float64) RepairQueue_SegmentHealth_Field {
+	return RepairQueue_SegmentHealth_Field{_set: true, _value: v}
 }
 
-func (f User_CompanyName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f User_CompanyName_Field) value() interface{} {
+func (f RepairQueue_SegmentHealth_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_CompanyName_Field) _Column() string { return "company_name" }
+func (RepairQueue_SegmentHealth_Field) _Column() string { return "segment_health" }
 
-type User_CompanySize_Field struct {
-	_set   bool
-	_null  bool
-	_value *int
+type Reputation struct {
+	Id                          []byte
+	AuditSuccessCount           int64
+	TotalAuditCount             int64
+	VettedAt                    *time.Time
+	CreatedAt                   time.Time
+	UpdatedAt                   time.Time
+	Disqualified                *time.Time
+	DisqualificationReason      *int
+	UnknownAuditSuspended       *time.Time
+	OfflineSuspended            *time.Time
+	UnderReview                 *time.Time
+	OnlineScore                 float64
+	AuditHistory                []byte
+	AuditReputationAlpha        float64
+	AuditReputationBeta         float64
+	UnknownAuditReputationAlpha float64
+	UnknownAuditReputationBeta  float64
 }
 
-func User_CompanySize(v int) User_CompanySize_Field {
-	return User_CompanySize_Field{_set: true, _value: &v}
+func (Reputation) _Table() string { return "reputations" }
+
+type Reputation_Create_Fields struct {
+	AuditSuccessCount           Reputation_AuditSuccessCount_Field
+	TotalAuditCount             Reputation_TotalAuditCount_Field
+	VettedAt                    Reputation_VettedAt_Field
+	Disqualified                Reputation_Disqualified_Field
+	DisqualificationReason      Reputation_DisqualificationReason_Field
+	UnknownAuditSuspended       Reputation_UnknownAuditSuspended_Field
+	OfflineSuspended            Reputation_OfflineSuspended_Field
+	UnderReview                 Reputation_UnderReview_Field
+	OnlineScore                 Reputation_OnlineScore_Field
+	AuditReputationAlpha        Reputation_AuditReputationAlpha_Field
+	AuditReputationBeta         Reputation_AuditReputationBeta_Field
+	UnknownAuditReputationAlpha Reputation_UnknownAuditReputationAlpha_Field
+	UnknownAuditReputationBeta  Reputation_UnknownAuditReputationBeta_Field
 }
 
-func User_CompanySize_Raw(v *int) User_CompanySize_Field {
-	if v == nil {
-		return User_CompanySize_Null()
-	}
-	return User_CompanySize(*v)
+type Reputation_Update_Fields struct {
+	AuditSuccessCount           Reputation_AuditSuccessCount_Field
+	TotalAuditCount             Reputation_TotalAuditCount_Field
+	VettedAt                    Reputation_VettedAt_Field
+	Disqualified                Reputation_Disqualified_Field
+	DisqualificationReason      Reputation_DisqualificationReason_Field
+	UnknownAuditSuspended       Reputation_UnknownAuditSuspended_Field
+	OfflineSuspended            Reputation_OfflineSuspended_Field
+	UnderReview                 Reputation_UnderReview_Field
+	OnlineScore                 Reputation_OnlineScore_Field
+	AuditHistory                Reputation_AuditHistory_Field
+	AuditReputationAlpha        Reputation_AuditReputationAlpha_Field
+	AuditReputationBeta         Reputation_AuditReputationBeta_Field
+	UnknownAuditReputationAlpha Reputation_UnknownAuditReputationAlpha_Field
+	UnknownAuditReputationBeta  Reputation_UnknownAuditReputationBeta_Field
 }
 
-func User_CompanySize_Null() User_CompanySize_Field {
-	return User_CompanySize_Field{_set: true, _null: true}
+type Reputation_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f User_CompanySize_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func Reputation_Id(v []byte) Reputation_Id_Field {
+	return Reputation_Id_Field{_set: true, _value: v}
+}
 
-func (f User_CompanySize_Field) value() interface{} {
+func (f Reputation_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_CompanySize_Field) _Column() string { return "company_size" }
+func (Reputation_Id_Field) _Column() string { return "id" }
 
-type User_WorkingOn_Field struct {
+type Reputation_AuditSuccessCount_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
-}
-
-func User_WorkingOn(v string) User_WorkingOn_Field {
-	return User_WorkingOn_Field{_set: true, _value: &v}
-}
-
-func User_WorkingOn_Raw(v *string) User_WorkingOn_Field {
-	if v == nil {
-		return User_WorkingOn_Null()
-	}
-	return User_WorkingOn(*v)
+	_value int64
 }
 
-func User_WorkingOn_Null() User_WorkingOn_Field {
-	return User_WorkingOn_Field{_set: true, _null: true}
+func Reputation_AuditSuccessCount(v int64) Reputation_AuditSuccessCount_Field {
+	return Reputation_AuditSuccessCount_Field{_set: true, _value: v}
 }
 
-func (f User_WorkingOn_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f User_WorkingOn_Field) value() interface{} {
+func (f Reputation_AuditSuccessCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_WorkingOn_Field) _Column() string { return "working_on" }
+func (Reputation_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
 
-type User_IsProfessional_Field struct {
+type Reputation_TotalAuditCount_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value int64
 }
 
-func User_IsProfessional(v bool) User_IsProfessional_Field {
-	return User_IsProfessional_Field{_set: true, _value: v}
+func Reputation_TotalAuditCount(v int64) Reputation_TotalAuditCount_Field {
+	return Reputation_TotalAuditCount_Field{_set: true, _value: v}
 }
 
-func (f User_IsProfessional_Field) value() interface{} {
+func (f Reputation_TotalAuditCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_IsProfessional_Field) _Column() string { return "is_professional" }
+func (Reputation_TotalAuditCount_Field) _Column() string { return "total_audit_count" }
 
-type User_EmployeeCount_Field struct {
+type Reputation_VettedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value *time.Time
 }
 
-func User_EmployeeCount(v string) User_EmployeeCount_Field {
-	return User_EmployeeCount_Field{_set: true, _value: &v}
+func Reputation_VettedAt(v time.Time) Reputation_VettedAt_Field {
+	return Reputation_VettedAt_Field{_set: true, _value: &v}
 }
 
-func User_EmployeeCount_Raw(v *string) User_EmployeeCount_Field {
+func Reputation_VettedAt_Raw(v *time.Time) Reputation_VettedAt_Field {
 	if v == nil {
-		return User_EmployeeCount_Null()
+		return Reputation_VettedAt_Null()
 	}
-	return User_EmployeeCount(*v)
+	return Reputation_VettedAt(*v)
 }
 
-func User_EmployeeCount_Null() User_EmployeeCount_Field {
-	return User_EmployeeCount_Field{_set: true, _null: true}
+func Reputation_VettedAt_Null() Reputation_VettedAt_Field {
+	return Reputation_VettedAt_Field{_set: true, _null: true}
 }
 
-func (f User_EmployeeCount_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Reputation_VettedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f User_EmployeeCount_Field) value() interface{} {
+func (f Reputation_VettedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_EmployeeCount_Field) _Column() string { return "employee_count" }
+func (Reputation_VettedAt_Field) _Column() string { return "vetted_at" }
 
-type User_HaveSalesContact_Field struct {
+type Reputation_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value time.Time
 }
 
-func User_HaveSalesContact(v bool) User_HaveSalesContact_Field {
-	return User_HaveSalesContact_Field{_set: true, _value: v}
+func Reputation_CreatedAt(v time.Time) Reputation_CreatedAt_Field {
+	return Reputation_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f User_HaveSalesContact_Field) value() interface{} {
+func (f Reputation_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_HaveSalesContact_Field) _Column() string { return "have_sales_contact" }
+func (Reputation_CreatedAt_Field) _Column() string { return "created_at" }
 
-type User_MfaEnabled_Field struct {
+type Reputation_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value time.Time
 }
 
-func User_MfaEnabled(v bool) User_MfaEnabled_Field {
-	return User_MfaEnabled_Field{_set: true, _value: v}
+func Reputation_UpdatedAt(v time.Time) Reputation_UpdatedAt_Field {
+	return Reputation_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f User_MfaEnabled_Field) value() interface{} {
+func (f Reputation_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_MfaEnabled_Field) _Column() string { return "mfa_enabled" }
+func (Reputation_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type User_MfaSecretKey_Field struct {
+type Reputation_Disqualified_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value *time.Time
 }
 
-func User_MfaSecretKey(v string) User_MfaSecretKey_Field {
-	return User_MfaSecretKey_Field{_set: true, _value: &v}
+func Reputation_Disqualified(v time.Time) Reputation_Disqualified_Field {
+	return Reputation_Disqualified_Field{_set: true, _value: &v}
 }
 
-func User_MfaSecretKey_Raw(v *string) User_MfaSecretKey_Field {
+func Reputation_Disqualified_Raw(v *time.Time) Reputation_Disqualified_Field {
 	if v == nil {
-		return User_MfaSecretKey_Null()
+		return Reputation_Disqualified_Null()
 	}
-	return User_MfaSecretKey(*v)
+	return Reputation_Disqualified(*v)
 }
 
-func User_MfaSecretKey_Null() User_MfaSecretKey_Field {
-	return User_MfaSecretKey_Field{_set: true, _null: true}
+func Reputation_Disqualified_Null() Reputation_Disqualified_Field {
+	return Reputation_Disqualified_Field{_set: true, _null: true}
 }
 
-func (f User_MfaSecretKey_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Reputation_Disqualified_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f User_MfaSecretKey_Field) value() interface{} {
+func (f Reputation_Disqualified_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_MfaSecretKey_Field) _Column() string { return "mfa_secret_key" }
+func (Reputation_Disqualified_Field) _Column() string { return "disqualified" }
 
-type User_MfaRecoveryCodes_Field struct {
+type Reputation_DisqualificationReason_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value *int
 }
 
-func User_MfaRecoveryCodes(v string) User_MfaRecoveryCodes_Field {
-	return User_MfaRecoveryCodes_Field{_set: true, _value: &v}
+func Reputation_DisqualificationReason(v int) Reputation_DisqualificationReason_Field {
+	return Reputation_DisqualificationReason_Field{_set: true, _value: &v}
 }
 
-func User_MfaRecoveryCodes_Raw(v *string) User_MfaRecoveryCodes_Field {
+func Reputation_DisqualificationReason_Raw(v *int) Reputation_DisqualificationReason_Field {
 	if v == nil {
-		return User_MfaRecoveryCodes_Null()
+		return Reputation_DisqualificationReason_Null()
 	}
-	return User_MfaRecoveryCodes(*v)
+	return Reputation_DisqualificationReason(*v)
 }
 
-func User_MfaRecoveryCodes_Null() User_MfaRecoveryCodes_Field {
-	return User_MfaRecoveryCodes_Field{_set: true, _null: true}
+func Reputation_DisqualificationReason_Null() Reputation_DisqualificationReason_Field {
+	return Reputation_DisqualificationReason_Field{_set: true, _null: true}
 }
 
-func (f User_MfaRecoveryCodes_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Reputation_DisqualificationReason_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f User_MfaRecoveryCodes_Field) value() interface{} {
+func (f Reputation_DisqualificationReason_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_MfaRecoveryCodes_Field) _Column() string { return "mfa_recovery_codes" }
+func (Reputation_DisqualificationReason_Field) _Column() string { return "disqualification_reason" }
 
-type User_SignupPromoCode_Field struct {
+type Reputation_UnknownAuditSuspended_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value *time.Time
 }
 
-func User_SignupPromoCode(v string) User_SignupPromoCode_Field {
-	return User_SignupPromoCode_Field{_set: true, _value: &v}
+func Reputation_UnknownAuditSuspended(v time.Time) Reputation_UnknownAuditSuspended_Field {
+	return Reputation_UnknownAuditSuspended_Field{_set: true, _value: &v}
 }
 
-func User_SignupPromoCode_Raw(v *string) User_SignupPromoCode_Field {
+func Reputation_UnknownAuditSuspended_Raw(v *time.Time) Reputation_UnknownAuditSuspended_Field {
 	if v == nil {
-		return User_SignupPromoCode_Null()
-	}
-	return User_SignupPromoCode(*v)
-}
-
-func User_SignupPromoCode_Null() User_SignupPromoCode_Field {
-	return User_SignupPromoCode_Field{_set: true, _null: true}
-}
-
-func (f User_SignupPromoCode_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f User_SignupPromoCode_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+		return Reputation_UnknownAuditSuspended_Null()
 	}
-	return f._value
+	return Reputation_UnknownAuditSuspended(*v)
 }
 
-func (User_SignupPromoCode_Field) _Column() string { return "signup_promo_code" }
-
-type User_VerificationReminders_Field struct {
-	_set   bool
-	_null  bool
-	_value int
+func Reputation_UnknownAuditSuspended_Null() Reputation_UnknownAuditSuspended_Field {
+	return Reputation_UnknownAuditSuspended_Field{_set: true, _null: true}
 }
 
-func User_VerificationReminders(v int) User_VerificationReminders_Field {
-	return User_VerificationReminders_Field{_set: true, _value: v}
+func (f Reputation_UnknownAuditSuspended_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f User_VerificationReminders_Field) value() interface{} {
+func (f Reputation_UnknownAuditSuspended_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_VerificationReminders_Field) _Column() string { return "verification_reminders" }
+func (Reputation_UnknownAuditSuspended_Field) _Column() string { return "unknown_audit_suspended" }
 
-type User_FailedLoginCount_Field struct {
+type Reputation_OfflineSuspended_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value *time.Time
 }
 
-func User_FailedLoginCount(v int) User_FailedLoginCount_Field {
-	return User_FailedLoginCount_Field{_set: true, _value: &v}
+func Reputation_OfflineSuspended(v time.Time) Reputation_OfflineSuspended_Field {
+	return Reputation_OfflineSuspended_Field{_set: true, _value: &v}
 }
 
-func User_FailedLoginCount_Raw(v *int) User_FailedLoginCount_Field {
+func Reputation_OfflineSuspended_Raw(v *time.Time) Reputation_OfflineSuspended_Field {
 	if v == nil {
-		return User_FailedLoginCount_Null()
+		return Reputation_OfflineSuspended_Null()
 	}
-	return User_FailedLoginCount(*v)
+	return Reputation_OfflineSuspended(*v)
 }
 
-func User_FailedLoginCount_Null() User_FailedLoginCount_Field {
-	return User_FailedLoginCount_Field{_set: true, _null: true}
+func Reputation_OfflineSuspended_Null() Reputation_OfflineSuspended_Field {
+	return Reputation_OfflineSuspended_Field{_set: true, _null: true}
 }
 
-func (f User_FailedLoginCount_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Reputation_OfflineSuspended_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f User_FailedLoginCount_Field) value() interface{} {
+func (f Reputation_OfflineSuspended_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_FailedLoginCount_Field) _Column() string { return "failed_login_count" }
+func (Reputation_OfflineSuspended_Field) _Column() string { return "offline_suspended" }
 
-type User_LoginLockoutExpiration_Field struct {
+type Reputation_UnderReview_Field struct {
 	_set   bool
 	_null  bool
 	_value *time.Time
 }
 
-func User_LoginLockoutExpiration(v time.Time) User_LoginLockoutExpiration_Field {
-	return User_LoginLockoutExpiration_Field{_set: true, _value: &v}
+func Reputation_UnderReview(v time.Time) Reputation_UnderReview_Field {
+	return Reputation_UnderReview_Field{_set: true, _value: &v}
 }
 
-func User_LoginLockoutExpiration_Raw(v *time.Time) User_LoginLockoutExpiration_Field {
+func Reputation_UnderReview_Raw(v *time.Time) Reputation_UnderReview_Field {
 	if v == nil {
-		return User_LoginLockoutExpiration_Null()
+		return Reputation_UnderReview_Null()
 	}
-	return User_LoginLockoutExpiration(*v)
+	return Reputation_UnderReview(*v)
 }
 
-func User_LoginLockoutExpiration_Null() User_LoginLockoutExpiration_Field {
-	return User_LoginLockoutExpiration_Field{_set: true, _null: true}
+func Reputation_UnderReview_Null() Reputation_UnderReview_Field {
+	return Reputation_UnderReview_Field{_set: true, _null: true}
 }
 
-func (f User_LoginLockoutExpiration_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
-}
+func (f Reputation_UnderReview_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f User_LoginLockoutExpiration_Field) value() interface{} {
+func (f Reputation_UnderReview_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_LoginLockoutExpiration_Field) _Column() string { return "login_lockout_expiration" }
+func (Reputation_UnderReview_Field) _Column() string { return "under_review" }
 
-type User_SignupCaptcha_Field struct {
+type Reputation_OnlineScore_Field struct {
 	_set   bool
 	_null  bool
-	_value *float64
+	_value float64
 }
 
-func User_SignupCaptcha(v float64) User_SignupCaptcha_Field {
-	return User_SignupCaptcha_Field{_set: true, _value: &v}
+func Reputation_OnlineScore(v float64) Reputation_OnlineScore_Field {
+	return Reputation_OnlineScore_Field{_set: true, _value: v}
 }
 
-func User_SignupCaptcha_Raw(v *float64) User_SignupCaptcha_Field {
-	if v == nil {
-		return User_SignupCaptcha_Null()
+func (f Reputation_OnlineScore_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return User_SignupCaptcha(*v)
+	return f._value
 }
 
-func User_SignupCaptcha_Null() User_SignupCaptcha_Field {
-	return User_SignupCaptcha_Field{_set: true, _null: true}
+func (Reputation_OnlineScore_Field) _Column() string { return "online_score" }
+
+type Reputation_AuditHistory_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f User_SignupCaptcha_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func Reputation_AuditHistory(v []byte) Reputation_AuditHistory_Field {
+	return Reputation_AuditHistory_Field{_set: true, _value: v}
+}
 
-func (f User_SignupCaptcha_Field) value() interface{} {
+func (f Reputation_AuditHistory_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_SignupCaptcha_Field) _Column() string { return "signup_captcha" }
+func (Reputation_AuditHistory_Field) _Column() string { return "audit_history" }
 
-type UserSettings struct {
-	UserId           []byte
-	SessionMinutes   *uint
-	PassphrasePrompt *bool
-	OnboardingStart  bool
-	OnboardingEnd    bool
-	OnboardingStep   *string
+type Reputation_AuditReputationAlpha_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
 }
 
-func (UserSettings) _Table() string { return "user_settings" }
-
-type UserSettings_Create_Fields struct {
-	SessionMinutes   UserSettings_SessionMinutes_Field
-	PassphrasePrompt UserSettings_PassphrasePrompt_Field
-	OnboardingStart  UserSettings_OnboardingStart_Field
-	OnboardingEnd    UserSettings_OnboardingEnd_Field
-	OnboardingStep   UserSettings_OnboardingStep_Field
+func Reputation_AuditReputationAlpha(v float64) Reputation_AuditReputationAlpha_Field {
+	return Reputation_AuditReputationAlpha_Field{_set: true, _value: v}
 }
 
-type UserSettings_Update_Fields struct {
-	SessionMinutes   UserSettings_SessionMinutes_Field
-	PassphrasePrompt UserSettings_PassphrasePrompt_Field
-	OnboardingStart  UserSettings_OnboardingStart_Field
-	OnboardingEnd    UserSettings_OnboardingEnd_Field
-	OnboardingStep   UserSettings_OnboardingStep_Field
+func (f Reputation_AuditReputationAlpha_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type UserSettings_UserId_Field struct {
+func (Reputation_AuditReputationAlpha_Field) _Column() string { return "audit_reputation_alpha" }
+
+type Reputation_AuditReputationBeta_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value float64
 }
 
-func UserSettings_UserId(v []byte) UserSettings_UserId_Field {
-	return UserSettings_UserId_Field{_set: true, _value: v}
+func Reputation_AuditReputationBeta(v float64) Reputation_AuditReputationBeta_Field {
+	return Reputation_AuditReputationBeta_Field{_set: true, _value: v}
 }
 
-func (f UserSettings_UserId_Field) value() interface{} {
+func (f Reputation_AuditReputationBeta_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (UserSettings_UserId_Field) _Column() string { return "user_id" }
+func (Reputation_AuditReputationBeta_Field) _Column() string { return "audit_reputation_beta" }
 
-type UserSettings_SessionMinutes_Field struct {
+type Reputation_UnknownAuditReputationAlpha_Field struct {
 	_set   bool
 	_null  bool
-	_value *uint
+	_value float64
 }
 
-func UserSettings_SessionMinutes(v uint) UserSettings_SessionMinutes_Field {
-	return UserSettings_SessionMinutes_Field{_set: true, _value: &v}
+func Reputation_UnknownAuditReputationAlpha(v float64) Reputation_UnknownAuditReputationAlpha_Field {
+	return Reputation_UnknownAuditReputationAlpha_Field{_set: true, _value: v}
 }
 
-func UserSettings_SessionMinutes_Raw(v *uint) UserSettings_SessionMinutes_Field {
-	if v == nil {
-		return UserSettings_SessionMinutes_Null()
+func (f Reputation_UnknownAuditReputationAlpha_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return UserSettings_SessionMinutes(*v)
+	return f._value
 }
 
-func UserSettings_SessionMinutes_Null() UserSettings_SessionMinutes_Field {
-	return UserSettings_SessionMinutes_Field{_set: true, _null: true}
+func (Reputation_UnknownAuditReputationAlpha_Field) _Column() string {
+	return "unknown_audit_reputation_alpha"
 }
 
-func (f UserSettings_SessionMinutes_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+type Reputation_UnknownAuditReputationBeta_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
 }
 
-func (f UserSettings_SessionMinutes_Field) value() interface{} {
+func Reputation_UnknownAuditReputationBeta(v float64) Reputation_UnknownAuditReputationBeta_Field {
+	return Reputation_UnknownAuditReputationBeta_Field{_set: true, _value: v}
+}
+
+func (f Reputation_UnknownAuditReputationBeta_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (UserSettings_SessionMinutes_Field) _Column() string { return "session_minutes" }
-
-type UserSettings_PassphrasePrompt_Field struct {
-	_set   bool
-	_null  bool
-	_value *bool
+func (Reputation_UnknownAuditReputationBeta_Field) _Column() string {
+	return "unknown_audit_reputation_beta"
 }
 
-func UserSettings_PassphrasePrompt(v bool) UserSettings_PassphrasePrompt_Field {
-	return UserSettings_PassphrasePrompt_Field{_set: true, _value: &v}
+type ResetPasswordToken struct {
+	Secret    []byte
+	OwnerId   []byte
+	CreatedAt time.Time
 }
 
-func UserSettings_PassphrasePrompt_Raw(v *bool) UserSettings_PassphrasePrompt_Field {
-	if v == nil {
-		return UserSettings_PassphrasePrompt_Null()
-	}
-	return UserSettings_PassphrasePrompt(*v)
+func (ResetPasswordToken) _Table() string { return "reset_password_tokens" }
+
+type ResetPasswordToken_Update_Fields struct {
+	OwnerId ResetPasswordToken_OwnerId_Field
 }
 
-func UserSettings_PassphrasePrompt_Null() UserSettings_PassphrasePrompt_Field {
-	return UserSettings_PassphrasePrompt_Field{_set: true, _null: true}
+type ResetPasswordToken_Secret_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f UserSettings_PassphrasePrompt_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func ResetPasswordToken_Secret(v []byte) ResetPasswordToken_Secret_Field {
+	return ResetPasswordToken_Secret_Field{_set: true, _value: v}
 }
 
-func (f UserSettings_PassphrasePrompt_Field) value() interface{} {
+func (f ResetPasswordToken_Secret_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (UserSettings_PassphrasePrompt_Field) _Column() string { return "passphrase_prompt" }
+func (ResetPasswordToken_Secret_Field) _Column() string { return "secret" }
 
-type UserSettings_OnboardingStart_Field struct {
+type ResetPasswordToken_OwnerId_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value []byte
 }
 
-func UserSettings_OnboardingStart(v bool) UserSettings_OnboardingStart_Field {
-	return UserSettings_OnboardingStart_Field{_set: true, _value: v}
+func ResetPasswordToken_OwnerId(v []byte) ResetPasswordToken_OwnerId_Field {
+	return ResetPasswordToken_OwnerId_Field{_set: true, _value: v}
 }
 
-func (f UserSettings_OnboardingStart_Field) value() interface{} {
+func (f ResetPasswordToken_OwnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (UserSettings_OnboardingStart_Field) _Column() string { return "onboarding_start" }
+func (ResetPasswordToken_OwnerId_Field) _Column() string { return "owner_id" }
 
-type UserSettings_OnboardingEnd_Field struct {
+type ResetPasswordToken_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value time.Time
 }
 
-func UserSettings_OnboardingEnd(v bool) UserSettings_OnboardingEnd_Field {
-	return UserSettings_OnboardingEnd_Field{_set: true, _value: v}
+func ResetPasswordToken_CreatedAt(v time.Time) ResetPasswordToken_CreatedAt_Field {
+	return ResetPasswordToken_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f UserSettings_OnboardingEnd_Field) value() interface{} {
+func (f ResetPasswordToken_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (UserSettings_OnboardingEnd_Field) _Column() string { return "onboarding_end" }
+func (ResetPasswordToken_CreatedAt_Field) _Column() string { return "created_at" }
 
-type UserSettings_OnboardingStep_Field struct {
-	_set   bool
-	_null  bool
-	_value *string
+type ReverificationAudits struct {
+	NodeId        []byte
+	StreamId      []byte
+	Position      uint64
+	PieceNum      int
+	InsertedAt    time.Time
+	LastAttempt   *time.Time
+	ReverifyCount int64
 }
 
-func UserSettings_OnboardingStep(v string) UserSettings_OnboardingStep_Field {
-	return UserSettings_OnboardingStep_Field{_set: true, _value: &v}
+func (ReverificationAudits) _Table() string { return "reverification_audits" }
+
+type ReverificationAudits_Create_Fields struct {
+	InsertedAt    ReverificationAudits_InsertedAt_Field
+	LastAttempt   ReverificationAudits_LastAttempt_Field
+	ReverifyCount ReverificationAudits_ReverifyCount_Field
 }
 
-func UserSettings_OnboardingStep_Raw(v *string) UserSettings_OnboardingStep_Field {
-	if v == nil {
-		return UserSettings_OnboardingStep_Null()
-	}
-	return UserSettings_OnboardingStep(*v)
+type ReverificationAudits_Update_Fields struct {
+	LastAttempt   ReverificationAudits_LastAttempt_Field
+	ReverifyCount ReverificationAudits_ReverifyCount_Field
 }
 
-func UserSettings_OnboardingStep_Null() UserSettings_OnboardingStep_Field {
-	return UserSettings_OnboardingStep_Field{_set: true, _null: true}
+type ReverificationAudits_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f UserSettings_OnboardingStep_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func ReverificationAudits_NodeId(v []byte) ReverificationAudits_NodeId_Field {
+	return ReverificationAudits_NodeId_Field{_set: true, _value: v}
 }
 
-func (f UserSettings_OnboardingStep_Field) value() interface{} {
+func (f ReverificationAudits_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (UserSettings_OnboardingStep_Field) _Column() string { return "onboarding_step" }
-
-type ValueAttribution struct {
-	ProjectId   []byte
-	BucketName  []byte
-	PartnerId   []byte
-	UserAgent   []byte
-	LastUpdated time.Time
-}
-
-func (ValueAttribution) _Table() string { return "value_attributions" }
-
-type ValueAttribution_Create_Fields struct {
-	PartnerId ValueAttribution_PartnerId_Field
-	UserAgent ValueAttribution_UserAgent_Field
-}
-
-type ValueAttribution_Update_Fields struct {
-}
+func (ReverificationAudits_NodeId_Field) _Column() string { return "node_id" }
 
-type ValueAttribution_ProjectId_Field struct {
+type ReverificationAudits_StreamId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func ValueAttribution_ProjectId(v []byte) ValueAttribution_ProjectId_Field {
-	return ValueAttribution_ProjectId_Field{_set: true, _value: v}
+func ReverificationAudits_StreamId(v []byte) ReverificationAudits_StreamId_Field {
+	return ReverificationAudits_StreamId_Field{_set: true, _value: v}
 }
 
-func (f ValueAttribution_ProjectId_Field) value() interface{} {
+func (f ReverificationAudits_StreamId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ValueAttribution_ProjectId_Field) _Column() string { return "project_id" }
+func (ReverificationAudits_StreamId_Field) _Column() string { return "stream_id" }
 
-type ValueAttribution_BucketName_Field struct {
+type ReverificationAudits_Position_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint64
 }
 
-func ValueAttribution_BucketName(v []byte) ValueAttribution_BucketName_Field {
-	return ValueAttribution_BucketName_Field{_set: true, _value: v}
+func ReverificationAudits_Position(v uint64) ReverificationAudits_Position_Field {
+	return ReverificationAudits_Position_Field{_set: true, _value: v}
 }
 
-func (f ValueAttribution_BucketName_Field) value() interface{} {
+func (f ReverificationAudits_Position_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ValueAttribution_BucketName_Field) _Column() string { return "bucket_name" }
+func (ReverificationAudits_Position_Field) _Column() string { return "position" }
 
-type ValueAttribution_PartnerId_Field struct {
+type ReverificationAudits_PieceNum_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func ValueAttribution_PartnerId(v []byte) ValueAttribution_PartnerId_Field {
-	return ValueAttribution_PartnerId_Field{_set: true, _value: v}
+func ReverificationAudits_PieceNum(v int) ReverificationAudits_PieceNum_Field {
+	return ReverificationAudits_PieceNum_Field{_set: true, _value: v}
 }
 
-func ValueAttribution_PartnerId_Raw(v []byte) ValueAttribution_PartnerId_Field {
-	if v == nil {
-		return ValueAttribution_PartnerId_Null()
+func (f ReverificationAudits_PieceNum_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return ValueAttribution_PartnerId(v)
+	return f._value
 }
 
-func ValueAttribution_PartnerId_Null() ValueAttribution_PartnerId_Field {
-	return ValueAttribution_PartnerId_Field{_set: true, _null: true}
+func (ReverificationAudits_PieceNum_Field) _Column() string { return "piece_num" }
+
+type ReverificationAudits_InsertedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f ValueAttribution_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ReverificationAudits_InsertedAt(v time.Time) ReverificationAudits_InsertedAt_Field {
+	return ReverificationAudits_InsertedAt_Field{_set: true, _value: v}
+}
 
-func (f ValueAttribution_PartnerId_Field) value() interface{} {
+func (f ReverificationAudits_InsertedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ValueAttribution_PartnerId_Field) _Column() string { return "partner_id" }
+func (ReverificationAudits_InsertedAt_Field) _Column() string { return "inserted_at" }
 
-type ValueAttribution_UserAgent_Field struct {
+type ReverificationAudits_LastAttempt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *time.Time
 }
 
-func ValueAttribution_UserAgent(v []byte) ValueAttribution_UserAgent_Field {
-	return ValueAttribution_UserAgent_Field{_set: true, _value: v}
+func ReverificationAudits_LastAttempt(v time.Time) ReverificationAudits_LastAttempt_Field {
+	return ReverificationAudits_LastAttempt_Field{_set: true, _value: &v}
 }
 
-func ValueAttribution_UserAgent_Raw(v []byte) ValueAttribution_UserAgent_Field {
+func ReverificationAudits_LastAttempt_Raw(v *time.Time) ReverificationAudits_LastAttempt_Field {
 	if v == nil {
-		return ValueAttribution_UserAgent_Null()
+		return ReverificationAudits_LastAttempt_Null()
 	}
-	return ValueAttribution_UserAgent(v)
+	return ReverificationAudits_LastAttempt(*v)
 }
 
-func ValueAttribution_UserAgent_Null() ValueAttribution_UserAgent_Field {
-	return ValueAttribution_UserAgent_Field{_set: true, _null: true}
+func ReverificationAudits_LastAttempt_Null() ReverificationAudits_LastAttempt_Field {
+	return ReverificationAudits_LastAttempt_Field{_set: true, _null: true}
 }
 
-func (f ValueAttribution_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f ReverificationAudits_LastAttempt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f ValueAttribution_UserAgent_Field) value() interface{} {
+func (f ReverificationAudits_LastAttempt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ValueAttribution_UserAgent_Field) _Column() string { return "user_agent" }
+func (ReverificationAudits_LastAttempt_Field) _Column() string { return "last_attempt" }
 
-type ValueAttribution_LastUpdated_Field struct {
+type ReverificationAudits_ReverifyCount_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func ValueAttribution_LastUpdated(v time.Time) ValueAttribution_LastUpdated_Field {
-	return ValueAttribution_LastUpdated_Field{_set: true, _value: v}
+func ReverificationAudits_ReverifyCount(v int64) ReverificationAudits_ReverifyCount_Field {
+	return ReverificationAudits_ReverifyCount_Field{_set: true, _value: v}
 }
 
-func (f ValueAttribution_LastUpdated_Field) value() interface{} {
+func (f ReverificationAudits_ReverifyCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ValueAttribution_LastUpdated_Field) _Column() string { return "last_updated" }
+func (ReverificationAudits_ReverifyCount_Field) _Column() string { return "reverify_count" }
 
-type VerificationAudits struct {
-	InsertedAt    time.Time
-	StreamId      []byte
-	Position      uint64
-	ExpiresAt     *time.Time
-	EncryptedSize int
+type Revocation struct {
+	Revoked  []byte
+	ApiKeyId []byte
 }
 
-func (VerificationAudits) _Table() string { return "verification_audits" }
-
-type VerificationAudits_Create_Fields struct {
-	InsertedAt VerificationAudits_InsertedAt_Field
-	ExpiresAt  VerificationAudits_ExpiresAt_Field
-}
+func (Revocation) _Table() string { return "revocations" }
 
-type VerificationAudits_Update_Fields struct {
+type Revocation_Update_Fields struct {
 }
 
-type VerificationAudits_InsertedAt_Field struct {
+type Revocation_Revoked_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func VerificationAudits_InsertedAt(v time.Time) VerificationAudits_InsertedAt_Field {
-	return VerificationAudits_InsertedAt_Field{_set: true, _value: v}
+func Revocation_Revoked(v []byte) Revocation_Revoked_Field {
+	return Revocation_Revoked_Field{_set: true, _value: v}
 }
 
-func (f VerificationAudits_InsertedAt_Field) value() interface{} {
+func (f Revocation_Revoked_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (VerificationAudits_InsertedAt_Field) _Column() string { return "inserted_at" }
+func (Revocation_Revoked_Field) _Column() string { return "revoked" }
 
-type VerificationAudits_StreamId_Field struct {
+type Revocation_ApiKeyId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func VerificationAudits_StreamId(v []byte) VerificationAudits_StreamId_Field {
-	return VerificationAudits_StreamId_Field{_set: true, _value: v}
+func Revocation_ApiKeyId(v []byte) Revocation_ApiKeyId_Field {
+	return Revocation_ApiKeyId_Field{_set: true, _value: v}
 }
 
-func (f VerificationAudits_StreamId_Field) value() interface{} {
+func (f Revocation_ApiKeyId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (VerificationAudits_StreamId_Field) _Column() string { return "stream_id" }
+func (Revocation_ApiKeyId_Field) _Column() string { return "api_key_id" }
 
-type VerificationAudits_Position_Field struct {
+type SatelliteHeartbeat struct {
+	Name   string
+	BeatAt time.Time
+}
+
+func (SatelliteHeartbeat) _Table() string { return "satellite_heartbeats" }
+
+type SatelliteHeartbeat_Create_Fields struct {
+}
+
+type SatelliteHeartbeat_Update_Fields struct {
+	BeatAt SatelliteHeartbeat_BeatAt_Field
+}
+
+type SatelliteHeartbeat_Name_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value string
 }
 
-func VerificationAudits_Position(v uint64) VerificationAudits_Position_Field {
-	return VerificationAudits_Position_Field{_set: true, _value: v}
+func SatelliteHeartbeat_Name(v string) SatelliteHeartbeat_Name_Field {
+	return SatelliteHeartbeat_Name_Field{_set: true, _value: v}
 }
 
-func (f VerificationAudits_Position_Field) value() interface{} {
+func (f SatelliteHeartbeat_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (VerificationAudits_Position_Field) _Column() string { return "position" }
+func (SatelliteHeartbeat_Name_Field) _Column() string { return "name" }
 
-type VerificationAudits_ExpiresAt_Field struct {
+type SatelliteHeartbeat_BeatAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value time.Time
 }
 
-func VerificationAudits_ExpiresAt(v time.Time) VerificationAudits_ExpiresAt_Field {
-	return VerificationAudits_ExpiresAt_Field{_set: true, _value: &v}
+func SatelliteHeartbeat_BeatAt(v time.Time) SatelliteHeartbeat_BeatAt_Field {
+	return SatelliteHeartbeat_BeatAt_Field{_set: true, _value: v}
 }
 
-func VerificationAudits_ExpiresAt_Raw(v *time.Time) VerificationAudits_ExpiresAt_Field {
-	if v == nil {
-		return VerificationAudits_ExpiresAt_Null()
+func (f SatelliteHeartbeat_BeatAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return VerificationAudits_ExpiresAt(*v)
+	return f._value
 }
 
-func VerificationAudits_ExpiresAt_Null() VerificationAudits_ExpiresAt_Field {
-	return VerificationAudits_ExpiresAt_Field{_set: true, _null: true}
+func (SatelliteHeartbeat_BeatAt_Field) _Column() string { return "beat_at" }
+
+type SatelliteOutage struct {
+	StartAt   time.Time
+	EndAt     time.Time
+	Token     []byte
+	CreatedAt time.Time
 }
 
-func (f VerificationAudits_ExpiresAt_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func (SatelliteOutage) _Table() string { return "satellite_outages" }
+
+type SatelliteOutage_Create_Fields struct {
 }
 
-func (f VerificationAudits_ExpiresAt_Field) value() interface{} {
+type SatelliteOutage_Update_Fields struct {
+}
+
+type SatelliteOutage_StartAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func SatelliteOutage_StartAt(v time.Time) SatelliteOutage_StartAt_Field {
+	return SatelliteOutage_StartAt_Field{_set: true, _value: v}
+}
+
+func (f SatelliteOutage_StartAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (VerificationAudits_ExpiresAt_Field) _Column() string { return "expires_at" }
+func (SatelliteOutage_StartAt_Field) _Column() string { return "start_at" }
 
-type VerificationAudits_EncryptedSize_Field struct {
+type SatelliteOutage_EndAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func VerificationAudits_EncryptedSize(v int) VerificationAudits_EncryptedSize_Field {
-	return VerificationAudits_EncryptedSize_Field{_set: true, _value: v}
+func SatelliteOutage_EndAt(v time.Time) SatelliteOutage_EndAt_Field {
+	return SatelliteOutage_EndAt_Field{_set: true, _value: v}
 }
 
-func (f VerificationAudits_EncryptedSize_Field) value() interface{} {
+func (f SatelliteOutage_EndAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (VerificationAudits_EncryptedSize_Field) _Column() string { return "encrypted_size" }
+func (SatelliteOutage_EndAt_Field) _Column() string { return "end_at" }
 
-type WebappSession struct {
-	Id        []byte
-	UserId    []byte
-	IpAddress string
-	UserAgent string
-	Status    int
-	ExpiresAt time.Time
-}
-
-func (WebappSession) _Table() string { return "webapp_sessions" }
-
-type WebappSession_Update_Fields struct {
-	Status    WebappSession_Status_Field
-	ExpiresAt WebappSession_ExpiresAt_Field
-}
-
-type WebappSession_Id_Field struct {
+type SatelliteOutage_Token_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func WebappSession_Id(v []byte) WebappSession_Id_Field {
-	return WebappSession_Id_Field{_set: true, _value: v}
+func SatelliteOutage_Token(v []byte) SatelliteOutage_Token_Field {
+	return SatelliteOutage_Token_Field{_set: true, _value: v}
 }
 
-func (f WebappSession_Id_Field) value() interface{} {
+func (f SatelliteOutage_Token_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (WebappSession_Id_Field) _Column() string { return "id" }
+func (SatelliteOutage_Token_Field) _Column() string { return "token" }
 
-type WebappSession_UserId_Field struct {
+type SatelliteOutage_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func WebappSession_UserId(v []byte) WebappSession_UserId_Field {
-	return WebappSession_UserId_Field{_set: true, _value: v}
+func SatelliteOutage_CreatedAt(v time.Time) SatelliteOutage_CreatedAt_Field {
+	return SatelliteOutage_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f WebappSession_UserId_Field) value() interface{} {
+func (f SatelliteOutage_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (WebappSession_UserId_Field) _Column() string { return "user_id" }
+func (SatelliteOutage_CreatedAt_Field) _Column() string { return "created_at" }
 
-type WebappSession_IpAddress_Field struct {
+type SegmentPendingAudits struct {
+	NodeId            []byte
+	StreamId          []byte
+	Position          uint64
+	PieceId           []byte
+	StripeIndex       int64
+	ShareSize         int64
+	ExpectedShareHash []byte
+	ReverifyCount     int64
+}
+
+func (SegmentPendingAudits) _Table() string { return "segment_pending_audits" }
+
+type SegmentPendingAudits_Update_Fields struct {
+	ReverifyCount SegmentPendingAudits_ReverifyCount_Field
+}
+
+type SegmentPendingAudits_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func WebappSession_IpAddress(v string) WebappSession_IpAddress_Field {
-	return WebappSession_IpAddress_Field{_set: true, _value: v}
+func SegmentPendingAudits_NodeId(v []byte) SegmentPendingAudits_NodeId_Field {
+	return SegmentPendingAudits_NodeId_Field{_set: true, _value: v}
 }
 
-func (f WebappSession_IpAddress_Field) value() interface{} {
+func (f SegmentPendingAudits_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (WebappSession_IpAddress_Field) _Column() string { return "ip_address" }
+func (SegmentPendingAudits_NodeId_Field) _Column() string { return "node_id" }
 
-type WebappSession_UserAgent_Field struct {
+type SegmentPendingAudits_StreamId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func WebappSession_UserAgent(v string) WebappSession_UserAgent_Field {
-	return WebappSession_UserAgent_Field{_set: true, _value: v}
+func SegmentPendingAudits_StreamId(v []byte) SegmentPendingAudits_StreamId_Field {
+	return SegmentPendingAudits_StreamId_Field{_set: true, _value: v}
 }
 
-func (f WebappSession_UserAgent_Field) value() interface{} {
+func (f SegmentPendingAudits_StreamId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (WebappSession_UserAgent_Field) _Column() string { return "user_agent" }
+func (SegmentPendingAudits_StreamId_Field) _Column() string { return "stream_id" }
 
-type WebappSession_Status_Field struct {
+type SegmentPendingAudits_Position_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint64
 }
 
-func WebappSession_Status(v int) WebappSession_Status_Field {
-	return WebappSession_Status_Field{_set: true, _value: v}
+func SegmentPendingAudits_Position(v uint64) SegmentPendingAudits_Position_Field {
+	return SegmentPendingAudits_Position_Field{_set: true, _value: v}
 }
 
-func (f WebappSession_Status_Field) value() interface{} {
+func (f SegmentPendingAudits_Position_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (WebappSession_Status_Field) _Column() string { return "status" }
+func (SegmentPendingAudits_Position_Field) _Column() string { return "position" }
 
-type WebappSession_ExpiresAt_Field struct {
+type SegmentPendingAudits_PieceId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func WebappSession_ExpiresAt(v time.Time) WebappSession_ExpiresAt_Field {
-	return WebappSession_ExpiresAt_Field{_set: true, _value: v}
+func SegmentPendingAudits_PieceId(v []byte) SegmentPendingAudits_PieceId_Field {
+	return SegmentPendingAudits_PieceId_Field{_set: true, _value: v}
 }
 
-func (f WebappSession_ExpiresAt_Field) value() interface{} {
+func (f SegmentPendingAudits_PieceId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (WebappSession_ExpiresAt_Field) _Column() string { return "expires_at" }
+func (SegmentPendingAudits_PieceId_Field) _Column() string { return "piece_id" }
 
-type ApiKey struct {
-	Id        []byte
-	ProjectId []byte
-	Head      []byte
-	Name      string
-	Secret    []byte
-	PartnerId []byte
-	UserAgent []byte
-	CreatedAt time.Time
+type SegmentPendingAudits_StripeIndex_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (ApiKey) _Table() string { return "api_keys" }
-
-type ApiKey_Create_Fields struct {
-	PartnerId ApiKey_PartnerId_Field
-	UserAgent ApiKey_UserAgent_Field
+func SegmentPendingAudits_StripeIndex(v int64) SegmentPendingAudits_StripeIndex_Field {
+	return SegmentPendingAudits_StripeIndex_Field{_set: true, _value: v}
 }
 
-type ApiKey_Update_Fields struct {
-	Name ApiKey_Name_Field
+func (f SegmentPendingAudits_StripeIndex_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type ApiKey_Id_Field struct {
+func (SegmentPendingAudits_StripeIndex_Field) _Column() string { return "stripe_index" }
+
+type SegmentPendingAudits_ShareSize_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func ApiKey_Id(v []byte) ApiKey_Id_Field {
-	return ApiKey_Id_Field{_set: true, _value: v}
+func SegmentPendingAudits_ShareSize(v int64) SegmentPendingAudits_ShareSize_Field {
+	return SegmentPendingAudits_ShareSize_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_Id_Field) value() interface{} {
+func (f SegmentPendingAudits_ShareSize_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_Id_Field) _Column() string { return "id" }
+func (SegmentPendingAudits_ShareSize_Field) _Column() string { return "share_size" }
 
-type ApiKey_ProjectId_Field struct {
+type SegmentPendingAudits_ExpectedShareHash_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func ApiKey_ProjectId(v []byte) ApiKey_ProjectId_Field {
-	return ApiKey_ProjectId_Field{_set: true, _value: v}
+func SegmentPendingAudits_ExpectedShareHash(v []byte) SegmentPendingAudits_ExpectedShareHash_Field {
+	return SegmentPendingAudits_ExpectedShareHash_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_ProjectId_Field) value() interface{} {
+func (f SegmentPendingAudits_ExpectedShareHash_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_ProjectId_Field) _Column() string { return "project_id" }
+func (SegmentPendingAudits_ExpectedShareHash_Field) _Column() string { return "expected_share_hash" }
 
-type ApiKey_Head_Field struct {
+type SegmentPendingAudits_ReverifyCount_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func ApiKey_Head(v []byte) ApiKey_Head_Field {
-	return ApiKey_Head_Field{_set: true, _value: v}
+func SegmentPendingAudits_ReverifyCount(v int64) SegmentPendingAudits_ReverifyCount_Field {
+	return SegmentPendingAudits_ReverifyCount_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_Head_Field) value() interface{} {
+func (f SegmentPendingAudits_ReverifyCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_Head_Field) _Column() string { return "head" }
+func (SegmentPendingAudits_ReverifyCount_Field) _Column() string { return "reverify_count" }
 
-type ApiKey_Name_Field struct {
+type StoragenodeBandwidthRollup struct {
+	StoragenodeId   []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Allocated       *uint64
+	Settled         uint64
+}
+
+func (StoragenodeBandwidthRollup) _Table() string { return "storagenode_bandwidth_rollups" }
+
+type StoragenodeBandwidthRollup_Create_Fields struct {
+	Allocated StoragenodeBandwidthRollup_Allocated_Field
+}
+
+type StoragenodeBandwidthRollup_Update_Fields struct {
+	Allocated StoragenodeBandwidthRollup_Allocated_Field
+	Settled   StoragenodeBandwidthRollup_Settled_Field
+}
+
+type StoragenodeBandwidthRollup_StoragenodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func ApiKey_Name(v string) ApiKey_Name_Field {
-	return ApiKey_Name_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollup_StoragenodeId(v []byte) StoragenodeBandwidthRollup_StoragenodeId_Field {
+	return StoragenodeBandwidthRollup_StoragenodeId_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_Name_Field) value() interface{} {
+func (f StoragenodeBandwidthRollup_StoragenodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_Name_Field) _Column() string { return "name" }
+func (StoragenodeBandwidthRollup_StoragenodeId_Field) _Column() string { return "storagenode_id" }
 
-type ApiKey_Secret_Field struct {
+type StoragenodeBandwidthRollup_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func ApiKey_Secret(v []byte) ApiKey_Secret_Field {
-	return ApiKey_Secret_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollup_IntervalStart(v time.Time) StoragenodeBandwidthRollup_IntervalStart_Field {
+	return StoragenodeBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_Secret_Field) value() interface{} {
+func (f StoragenodeBandwidthRollup_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_Secret_Field) _Column() string { return "secret" }
+func (StoragenodeBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type ApiKey_PartnerId_Field struct {
+type StoragenodeBandwidthRollup_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint
 }
 
-func ApiKey_PartnerId(v []byte) ApiKey_PartnerId_Field {
-	return ApiKey_PartnerId_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollup_IntervalSeconds(v uint) StoragenodeBandwidthRollup_IntervalSeconds_Field {
+	return StoragenodeBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func ApiKey_PartnerId_Raw(v []byte) ApiKey_PartnerId_Field {
-	if v == nil {
-		return ApiKey_PartnerId_Null()
+func (f StoragenodeBandwidthRollup_IntervalSeconds_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return ApiKey_PartnerId(v)
+	return f._value
 }
 
-func ApiKey_PartnerId_Null() ApiKey_PartnerId_Field {
-	return ApiKey_PartnerId_Field{_set: true, _null: true}
+func (StoragenodeBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+
+type StoragenodeBandwidthRollup_Action_Field struct {
+	_set   bool
+	_null  bool
+	_value uint
 }
 
-func (f ApiKey_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func StoragenodeBandwidthRollup_Action(v uint) StoragenodeBandwidthRollup_Action_Field {
+	return StoragenodeBandwidthRollup_Action_Field{_set: true, _value: v}
+}
 
-func (f ApiKey_PartnerId_Field) value() interface{} {
+func (f StoragenodeBandwidthRollup_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_PartnerId_Field) _Column() string { return "partner_id" }
+func (StoragenodeBandwidthRollup_Action_Field) _Column() string { return "action" }
 
-type ApiKey_UserAgent_Field struct {
+type StoragenodeBandwidthRollup_Allocated_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *uint64
 }
 
-func ApiKey_UserAgent(v []byte) ApiKey_UserAgent_Field {
-	return ApiKey_UserAgent_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollup_Allocated(v uint64) StoragenodeBandwidthRollup_Allocated_Field {
+	return StoragenodeBandwidthRollup_Allocated_Field{_set: true, _value: &v}
 }
 
-func ApiKey_UserAgent_Raw(v []byte) ApiKey_UserAgent_Field {
+func StoragenodeBandwidthRollup_Allocated_Raw(v *uint64) StoragenodeBandwidthRollup_Allocated_Field {
 	if v == nil {
-		return ApiKey_UserAgent_Null()
+		return StoragenodeBandwidthRollup_Allocated_Null()
 	}
-	return ApiKey_UserAgent(v)
+	return StoragenodeBandwidthRollup_Allocated(*v)
 }
 
-func ApiKey_UserAgent_Null() ApiKey_UserAgent_Field {
-	return ApiKey_UserAgent_Field{_set: true, _null: true}
+func StoragenodeBandwidthRollup_Allocated_Null() StoragenodeBandwidthRollup_Allocated_Field {
+	return StoragenodeBandwidthRollup_Allocated_Field{_set: true, _null: true}
 }
 
-func (f ApiKey_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f StoragenodeBandwidthRollup_Allocated_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f ApiKey_UserAgent_Field) value() interface{} {
+func (f StoragenodeBandwidthRollup_Allocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_UserAgent_Field) _Column() string { return "user_agent" }
+func (StoragenodeBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
 
-type ApiKey_CreatedAt_Field struct {
+type StoragenodeBandwidthRollup_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value uint64
 }
 
-func ApiKey_CreatedAt(v time.Time) ApiKey_CreatedAt_Field {
-	return ApiKey_CreatedAt_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollup_Settled(v uint64) StoragenodeBandwidthRollup_Settled_Field {
+	return StoragenodeBandwidthRollup_Settled_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_CreatedAt_Field) value() interface{} {
+func (f StoragenodeBandwidthRollup_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_CreatedAt_Field) _Column() string { return "created_at" }
+func (StoragenodeBandwidthRollup_Settled_Field) _Column() string { return "settled" }
 
-type BucketMetainfo struct {
-	Id                              []byte
-	ProjectId                       []byte
-	Name                            []byte
-	PartnerId                       []byte
-	UserAgent                       []byte
-	PathCipher                      int
-	CreatedAt                       time.Time
-	DefaultSegmentSize              int
-	DefaultEncryptionCipherSuite    int
-	DefaultEncryptionBlockSize      int
-	DefaultRedundancyAlgorithm      int
-	DefaultRedundancyShareSize      int
-	DefaultRedundancyRequiredShares int
-	DefaultRedundancyRepairShares   int
-	DefaultRedundancyOptimalShares  int
-	DefaultRedundancyTotalShares    int
-	Placement                       *int
+type StoragenodeBandwidthRollupArchive struct {
+	StoragenodeId   []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Allocated       *uint64
+	Settled         uint64
 }
 
-func (BucketMetainfo) _Table() string { return "bucket_metainfos" }
+func (StoragenodeBandwidthRollupArchive) _Table() string {
+	return "storagenode_bandwidth_rollup_archives"
+}
 
-type BucketMetainfo_Create_Fields struct {
-	PartnerId BucketMetainfo_PartnerId_Field
-	UserAgent BucketMetainfo_UserAgent_Field
-	Placement BucketMetainfo_Placement_Field
+type StoragenodeBandwidthRollupArchive_Create_Fields struct {
+	Allocated StoragenodeBandwidthRollupArchive_Allocated_Field
 }
 
-type BucketMetainfo_Update_Fields struct {
-	PartnerId                       BucketMetainfo_PartnerId_Field
-	UserAgent                       BucketMetainfo_UserAgent_Field
-	DefaultSegmentSize              BucketMetainfo_DefaultSegmentSize_Field
-	DefaultEncryptionCipherSuite    BucketMetainfo_DefaultEncryptionCipherSuite_Field
-	DefaultEncryptionBlockSize      BucketMetainfo_DefaultEncryptionBlockSize_Field
-	DefaultRedundancyAlgorithm      BucketMetainfo_DefaultRedundancyAlgorithm_Field
-	DefaultRedundancyShareSize      BucketMetainfo_DefaultRedundancyShareSize_Field
-	DefaultRedundancyRequiredShares BucketMetainfo_DefaultRedundancyRequiredShares_Field
-	DefaultRedundancyRepairShares   BucketMetainfo_DefaultRedundancyRepairShares_Field
-	DefaultRedundancyOptimalShares  BucketMetainfo_DefaultRedundancyOptimalShares_Field
-	DefaultRedundancyTotalShares    BucketMetainfo_DefaultRedundancyTotalShares_Field
-	Placement                       BucketMetainfo_Placement_Field
+type StoragenodeBandwidthRollupArchive_Update_Fields struct {
+	Allocated StoragenodeBandwidthRollupArchive_Allocated_Field
+	Settled   StoragenodeBandwidthRollupArchive_Settled_Field
 }
 
-type BucketMetainfo_Id_Field struct {
+type StoragenodeBandwidthRollupArchive_StoragenodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketMetainfo_Id(v []byte) BucketMetainfo_Id_Field {
-	return BucketMetainfo_Id_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupArchive_StoragenodeId(v []byte) StoragenodeBandwidthRollupArchive_StoragenodeId_Field {
+	return StoragenodeBandwidthRollupArchive_StoragenodeId_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_Id_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupArchive_StoragenodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_Id_Field) _Column() string { return "id" }
+func (StoragenodeBandwidthRollupArchive_StoragenodeId_Field) _Column() string {
+	return "storagenode_id"
+}
 
-type BucketMetainfo_ProjectId_Field struct {
+type StoragenodeBandwidthRollupArchive_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func BucketMetainfo_ProjectId(v []byte) BucketMetainfo_ProjectId_Field {
-	return BucketMetainfo_ProjectId_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupArchive_IntervalStart(v time.Time) StoragenodeBandwidthRollupArchive_IntervalStart_Field {
+	return StoragenodeBandwidthRollupArchive_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_ProjectId_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupArchive_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_ProjectId_Field) _Column() string { return "project_id" }
+func (StoragenodeBandwidthRollupArchive_IntervalStart_Field) _Column() string {
+	return "interval_start"
+}
 
-type BucketMetainfo_Name_Field struct {
+type StoragenodeBandwidthRollupArchive_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint
 }
 
-func BucketMetainfo_Name(v []byte) BucketMetainfo_Name_Field {
-	return BucketMetainfo_Name_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupArchive_IntervalSeconds(v uint) StoragenodeBandwidthRollupArchive_IntervalSeconds_Field {
+	return StoragenodeBandwidthRollupArchive_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_Name_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupArchive_IntervalSeconds_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_Name_Field) _Column() string { return "name" }
+func (StoragenodeBandwidthRollupArchive_IntervalSeconds_Field) _Column() string {
+	return "interval_seconds"
+}
 
-type BucketMetainfo_PartnerId_Field struct {
+type StoragenodeBandwidthRollupArchive_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
-}
-
-func BucketMetainfo_PartnerId(v []byte) BucketMetainfo_PartnerId_Field {
-	return BucketMetainfo_PartnerId_Field{_set: true, _value: v}
-}
-
-func BucketMetainfo_PartnerId_Raw(v []byte) BucketMetainfo_PartnerId_Field {
-	if v == nil {
-		return BucketMetainfo_PartnerId_Null()
-	}
-	return BucketMetainfo_PartnerId(v)
+	_value uint
 }
 
-func BucketMetainfo_PartnerId_Null() BucketMetainfo_PartnerId_Field {
-	return BucketMetainfo_PartnerId_Field{_set: true, _null: true}
+func StoragenodeBandwidthRollupArchive_Action(v uint) StoragenodeBandwidthRollupArchive_Action_Field {
+	return StoragenodeBandwidthRollupArchive_Action_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f BucketMetainfo_PartnerId_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupArchive_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_PartnerId_Field) _Column() string { return "partner_id" }
+func (StoragenodeBandwidthRollupArchive_Action_Field) _Column() string { return "action" }
 
-type BucketMetainfo_UserAgent_Field struct {
+type StoragenodeBandwidthRollupArchive_Allocated_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *uint64
 }
 
-func BucketMetainfo_UserAgent(v []byte) BucketMetainfo_UserAgent_Field {
-	return BucketMetainfo_UserAgent_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupArchive_Allocated(v uint64) StoragenodeBandwidthRollupArchive_Allocated_Field {
+	return StoragenodeBandwidthRollupArchive_Allocated_Field{_set: true, _value: &v}
 }
 
-func BucketMetainfo_UserAgent_Raw(v []byte) BucketMetainfo_UserAgent_Field {
+func StoragenodeBandwidthRollupArchive_Allocated_Raw(v *uint64) StoragenodeBandwidthRollupArchive_Allocated_Field {
 	if v == nil {
-		return BucketMetainfo_UserAgent_Null()
+		return StoragenodeBandwidthRollupArchive_Allocated_Null()
 	}
-	return BucketMetainfo_UserAgent(v)
+	return StoragenodeBandwidthRollupArchive_Allocated(*v)
 }
 
-func BucketMetainfo_UserAgent_Null() BucketMetainfo_UserAgent_Field {
-	return BucketMetainfo_UserAgent_Field{_set: true, _null: true}
+func StoragenodeBandwidthRollupArchive_Allocated_Null() StoragenodeBandwidthRollupArchive_Allocated_Field {
+	return StoragenodeBandwidthRollupArchive_Allocated_Field{_set: true, _null: true}
 }
 
-func (f BucketMetainfo_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f StoragenodeBandwidthRollupArchive_Allocated_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f BucketMetainfo_UserAgent_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupArchive_Allocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_UserAgent_Field) _Column() string { return "user_agent" }
+func (StoragenodeBandwidthRollupArchive_Allocated_Field) _Column() string { return "allocated" }
 
-type BucketMetainfo_PathCipher_Field struct {
+type StoragenodeBandwidthRollupArchive_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint64
 }
 
-func BucketMetainfo_PathCipher(v int) BucketMetainfo_PathCipher_Field {
-	return BucketMetainfo_PathCipher_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupArchive_Settled(v uint64) StoragenodeBandwidthRollupArchive_Settled_Field {
+	return StoragenodeBandwidthRollupArchive_Settled_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_PathCipher_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupArchive_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_PathCipher_Field) _Column() string { return "path_cipher" }
+func (StoragenodeBandwidthRollupArchive_Settled_Field) _Column() string { return "settled" }
 
-type BucketMetainfo_CreatedAt_Field struct {
-	_set   bool
-	_null  bool
-	_value time.Time
+type StoragenodeBandwidthRollupPhase2 struct {
+	StoragenodeId   []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Allocated       *uint64
+	Settled         uint64
 }
 
-func BucketMetainfo_CreatedAt(v time.Time) BucketMetainfo_CreatedAt_Field {
-	return BucketMetainfo_CreatedAt_Field{_set: true, _value: v}
+func (StoragenodeBandwidthRollupPhase2) _Table() string {
+	return "storagenode_bandwidth_rollups_phase2"
 }
 
-func (f BucketMetainfo_CreatedAt_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type StoragenodeBandwidthRollupPhase2_Create_Fields struct {
+	Allocated StoragenodeBandwidthRollupPhase2_Allocated_Field
 }
 
-func (BucketMetainfo_CreatedAt_Field) _Column() string { return "created_at" }
+type StoragenodeBandwidthRollupPhase2_Update_Fields struct {
+	Allocated StoragenodeBandwidthRollupPhase2_Allocated_Field
+	Settled   StoragenodeBandwidthRollupPhase2_Settled_Field
+}
 
-type BucketMetainfo_DefaultSegmentSize_Field struct {
+type StoragenodeBandwidthRollupPhase2_StoragenodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func BucketMetainfo_DefaultSegmentSize(v int) BucketMetainfo_DefaultSegmentSize_Field {
-	return BucketMetainfo_DefaultSegmentSize_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupPhase2_StoragenodeId(v []byte) StoragenodeBandwidthRollupPhase2_StoragenodeId_Field {
+	return StoragenodeBandwidthRollupPhase2_StoragenodeId_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultSegmentSize_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_StoragenodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultSegmentSize_Field) _Column() string { return "default_segment_size" }
+func (StoragenodeBandwidthRollupPhase2_StoragenodeId_Field) _Column() string { return "storagenode_id" }
 
-type BucketMetainfo_DefaultEncryptionCipherSuite_Field struct {
+type StoragenodeBandwidthRollupPhase2_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func BucketMetainfo_DefaultEncryptionCipherSuite(v int) BucketMetainfo_DefaultEncryptionCipherSuite_Field {
-	return BucketMetainfo_DefaultEncryptionCipherSuite_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupPhase2_IntervalStart(v time.Time) StoragenodeBandwidthRollupPhase2_IntervalStart_Field {
+	return StoragenodeBandwidthRollupPhase2_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultEncryptionCipherSuite_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultEncryptionCipherSuite_Field) _Column() string {
-	return "default_encryption_cipher_suite"
-}
+func (StoragenodeBandwidthRollupPhase2_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type BucketMetainfo_DefaultEncryptionBlockSize_Field struct {
+type StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint
 }
 
-func BucketMetainfo_DefaultEncryptionBlockSize(v int) BucketMetainfo_DefaultEncryptionBlockSize_Field {
-	return BucketMetainfo_DefaultEncryptionBlockSize_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupPhase2_IntervalSeconds(v uint) StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field {
+	return StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultEncryptionBlockSize_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultEncryptionBlockSize_Field) _Column() string {
-	return "default_encryption_block_size"
+func (StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field) _Column() string {
+	return "interval_seconds"
 }
 
-type BucketMetainfo_DefaultRedundancyAlgorithm_Field struct {
+type StoragenodeBandwidthRollupPhase2_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint
 }
 
-func BucketMetainfo_DefaultRedundancyAlgorithm(v int) BucketMetainfo_DefaultRedundancyAlgorithm_Field {
-	return BucketMetainfo_DefaultRedundancyAlgorithm_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupPhase2_Action(v uint) StoragenodeBandwidthRollupPhase2_Action_Field {
+	return StoragenodeBandwidthRollupPhase2_Action_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyAlgorithm_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyAlgorithm_Field) _Column() string {
-	return "default_redundancy_algorithm"
-}
+func (StoragenodeBandwidthRollupPhase2_Action_Field) _Column() string { return "action" }
 
-type BucketMetainfo_DefaultRedundancyShareSize_Field struct {
+type StoragenodeBandwidthRollupPhase2_Allocated_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *uint64
 }
 
-func BucketMetainfo_DefaultRedundancyShareSize(v int) BucketMetainfo_DefaultRedundancyShareSize_Field {
-	return BucketMetainfo_DefaultRedundancyShareSize_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupPhase2_Allocated(v uint64) StoragenodeBandwidthRollupPhase2_Allocated_Field {
+	return StoragenodeBandwidthRollupPhase2_Allocated_Field{_set: true, _value: &v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyShareSize_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func StoragenodeBandwidthRollupPhase2_Allocated_Raw(v *uint64) StoragenodeBandwidthRollupPhase2_Allocated_Field {
+	if v == nil {
+		return StoragenodeBandwidthRollupPhase2_Allocated_Null()
 	}
-	return f._value
-}
-
-func (BucketMetainfo_DefaultRedundancyShareSize_Field) _Column() string {
-	return "default_redundancy_share_size"
+	return StoragenodeBandwidthRollupPhase2_Allocated(*v)
 }
 
-type BucketMetainfo_DefaultRedundancyRequiredShares_Field struct {
-	_set   bool
-	_null  bool
-	_value int
+func StoragenodeBandwidthRollupPhase2_Allocated_Null() StoragenodeBandwidthRollupPhase2_Allocated_Field {
+	return StoragenodeBandwidthRollupPhase2_Allocated_Field{_set: true, _null: true}
 }
 
-func BucketMetainfo_DefaultRedundancyRequiredShares(v int) BucketMetainfo_DefaultRedundancyRequiredShares_Field {
-	return BucketMetainfo_DefaultRedundancyRequiredShares_Field{_set: true, _value: v}
+func (f StoragenodeBandwidthRollupPhase2_Allocated_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f BucketMetainfo_DefaultRedundancyRequiredShares_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_Allocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyRequiredShares_Field) _Column() string {
-	return "default_redundancy_required_shares"
-}
+func (StoragenodeBandwidthRollupPhase2_Allocated_Field) _Column() string { return "allocated" }
 
-type BucketMetainfo_DefaultRedundancyRepairShares_Field struct {
+type StoragenodeBandwidthRollupPhase2_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint64
 }
 
-func BucketMetainfo_DefaultRedundancyRepairShares(v int) BucketMetainfo_DefaultRedundancyRepairShares_Field {
-	return BucketMetainfo_DefaultRedundancyRepairShares_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupPhase2_Settled(v uint64) StoragenodeBandwidthRollupPhase2_Settled_Field {
+	return StoragenodeBandwidthRollupPhase2_Settled_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyRepairShares_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyRepairShares_Field) _Column() string {
-	return "default_redundancy_repair_shares"
+func (StoragenodeBandwidthRollupPhase2_Settled_Field) _Column() string { return "settled" }
+
+type StoragenodeHeldRelease struct {
+	NodeId    []byte
+	Period    string
+	Amount    int64
+	CreatedAt time.Time
 }
 
-type BucketMetainfo_DefaultRedundancyOptimalShares_Field struct {
+func (StoragenodeHeldRelease) _Table() string { return "storagenode_held_releases" }
+
+type StoragenodeHeldRelease_Create_Fields struct {
+	CreatedAt StoragenodeHeldRelease_CreatedAt_Field
+}
+
+type StoragenodeHeldRelease_Update_Fields struct {
+}
+
+type StoragenodeHeldRelease_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func BucketMetainfo_DefaultRedundancyOptimalShares(v int) BucketMetainfo_DefaultRedundancyOptimalShares_Field {
-	return BucketMetainfo_DefaultRedundancyOptimalShares_Field{_set: true, _value: v}
+func StoragenodeHeldRelease_NodeId(v []byte) StoragenodeHeldRelease_NodeId_Field {
+	return StoragenodeHeldRelease_NodeId_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyOptimalShares_Field) value() interface{} {
+func (f StoragenodeHeldRelease_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyOptimalShares_Field) _Column() string {
-	return "default_redundancy_optimal_shares"
-}
+func (StoragenodeHeldRelease_NodeId_Field) _Column() string { return "node_id" }
 
-type BucketMetainfo_DefaultRedundancyTotalShares_Field struct {
+type StoragenodeHeldRelease_Period_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func BucketMetainfo_DefaultRedundancyTotalShares(v int) BucketMetainfo_DefaultRedundancyTotalShares_Field {
-	return BucketMetainfo_DefaultRedundancyTotalShares_Field{_set: true, _value: v}
+func StoragenodeHeldRelease_Period(v string) StoragenodeHeldRelease_Period_Field {
+	return StoragenodeHeldRelease_Period_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyTotalShares_Field) value() interface{} {
+func (f StoragenodeHeldRelease_Period_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyTotalShares_Field) _Column() string {
-	return "default_redundancy_total_shares"
-}
+func (StoragenodeHeldRelease_Period_Field) _Column() string { return "period" }
 
-type BucketMetainfo_Placement_Field struct {
+type StoragenodeHeldRelease_Amount_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value int64
 }
 
-func BucketMetainfo_Placement(v int) BucketMetainfo_Placement_Field {
-	return BucketMetainfo_Placement_Field{_set: true, _value: &v}
+func StoragenodeHeldRelease_Amount(v int64) StoragenodeHeldRelease_Amount_Field {
+	return StoragenodeHeldRelease_Amount_Field{_set: true, _value: v}
 }
 
-func BucketMetainfo_Placement_Raw(v *int) BucketMetainfo_Placement_Field {
-	if v == nil {
-		return BucketMetainfo_Placement_Null()
+func (f StoragenodeHeldRelease_Amount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return BucketMetainfo_Placement(*v)
+	return f._value
 }
 
-func BucketMetainfo_Placement_Null() BucketMetainfo_Placement_Field {
-	return BucketMetainfo_Placement_Field{_set: true, _null: true}
+func (StoragenodeHeldRelease_Amount_Field) _Column() string { return "amount" }
+
+type StoragenodeHeldRelease_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f BucketMetainfo_Placement_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func StoragenodeHeldRelease_CreatedAt(v time.Time) StoragenodeHeldRelease_CreatedAt_Field {
+	return StoragenodeHeldRelease_CreatedAt_Field{_set: true, _value: v}
+}
 
-func (f BucketMetainfo_Placement_Field) value() interface{} {
+func (f StoragenodeHeldRelease_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_Placement_Field) _Column() string { return "placement" }
+func (StoragenodeHeldRelease_CreatedAt_Field) _Column() string { return "created_at" }
 
-type ProjectInvitation struct {
-	ProjectId []byte
-	Email     string
+type StoragenodePayment struct {
+	Id        int64
 	CreatedAt time.Time
+	NodeId    []byte
+	Period    string
+	Amount    int64
+	Receipt   *string
+	Notes     *string
 }
 
-func (ProjectInvitation) _Table() string { return "project_invitations" }
+func (StoragenodePayment) _Table() string { return "storagenode_payments" }
 
-type ProjectInvitation_Update_Fields struct {
+type StoragenodePayment_Create_Fields struct {
+	Receipt StoragenodePayment_Receipt_Field
+	Notes   StoragenodePayment_Notes_Field
 }
 
-type ProjectInvitation_ProjectId_Field struct {
+type StoragenodePayment_Update_Fields struct {
+}
+
+type StoragenodePayment_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func ProjectInvitation_ProjectId(v []byte) ProjectInvitation_ProjectId_Field {
-	return ProjectInvitation_ProjectId_Field{_set: true, _value: v}
+func StoragenodePayment_Id(v int64) StoragenodePayment_Id_Field {
+	return StoragenodePayment_Id_Field{_set: true, _value: v}
 }
 
-func (f ProjectInvitation_ProjectId_Field) value() interface{} {
+func (f StoragenodePayment_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectInvitation_ProjectId_Field) _Column() string { return "project_id" }
+func (StoragenodePayment_Id_Field) _Column() string { return "id" }
 
-type ProjectInvitation_Email_Field struct {
+type StoragenodePayment_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func ProjectInvitation_Email(v string) ProjectInvitation_Email_Field {
-	return ProjectInvitation_Email_Field{_set: true, _value: v}
+func StoragenodePayment_CreatedAt(v time.Time) StoragenodePayment_CreatedAt_Field {
+	return StoragenodePayment_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f ProjectInvitation_Email_Field) value() interface{} {
+func (f StoragenodePayment_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectInvitation_Email_Field) _Column() string { return "email" }
+func (StoragenodePayment_CreatedAt_Field) _Column() string { return "created_at" }
 
-type ProjectInvitation_CreatedAt_Field struct {
+type StoragenodePayment_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func ProjectInvitation_CreatedAt(v time.Time) ProjectInvitation_CreatedAt_Field {
-	return ProjectInvitation_CreatedAt_Field{_set: true, _value: v}
+func StoragenodePayment_NodeId(v []byte) StoragenodePayment_NodeId_Field {
+	return StoragenodePayment_NodeId_Field{_set: true, _value: v}
 }
 
-func (f ProjectInvitation_CreatedAt_Field) value() interface{} {
+func (f StoragenodePayment_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectInvitation_CreatedAt_Field) _Column() string { return "created_at" }
+func (StoragenodePayment_NodeId_Field) _Column() string { return "node_id" }
 
-type ProjectMember struct {
-	MemberId  []byte
-	ProjectId []byte
-	CreatedAt time.Time
+type StoragenodePayment_Period_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (ProjectMember) _Table() string { return "project_members" }
+func StoragenodePayment_Period(v string) StoragenodePayment_Period_Field {
+	return StoragenodePayment_Period_Field{_set: true, _value: v}
+}
 
-type ProjectMember_Update_Fields struct {
+func (f StoragenodePayment_Period_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type ProjectMember_MemberId_Field struct {
+func (StoragenodePayment_Period_Field) _Column() string { return "period" }
+
+type StoragenodePayment_Amount_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func ProjectMember_MemberId(v []byte) ProjectMember_MemberId_Field {
-	return ProjectMember_MemberId_Field{_set: true, _value: v}
+func StoragenodePayment_Amount(v int64) StoragenodePayment_Amount_Field {
+	return StoragenodePayment_Amount_Field{_set: true, _value: v}
 }
 
-func (f ProjectMember_MemberId_Field) value() interface{} {
+func (f StoragenodePayment_Amount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectMember_MemberId_Field) _Column() string { return "member_id" }
+func (StoragenodePayment_Amount_Field) _Column() string { return "amount" }
 
-type ProjectMember_ProjectId_Field struct {
+type StoragenodePayment_Receipt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *string
 }
 
-func ProjectMember_ProjectId(v []byte) ProjectMember_ProjectId_Field {
-	return ProjectMember_ProjectId_Field{_set: true, _value: v}
+func StoragenodePayment_Receipt(v string) StoragenodePayment_Receipt_Field {
+	return StoragenodePayment_Receipt_Field{_set: true, _value: &v}
 }
 
-func (f ProjectMember_ProjectId_Field) value() interface{} {
+func StoragenodePayment_Receipt_Raw(v *string) StoragenodePayment_Receipt_Field {
+	if v == nil {
+		return StoragenodePayment_Receipt_Null()
+	}
+	return StoragenodePayment_Receipt(*v)
+}
+
+func StoragenodePayment_Receipt_Null() StoragenodePayment_Receipt_Field {
+	return StoragenodePayment_Receipt_Field{_set: true, _null: true}
+}
+
+func (f StoragenodePayment_Receipt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f StoragenodePayment_Receipt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectMember_ProjectId_Field) _Column() string { return "project_id" }
+func (StoragenodePayment_Receipt_Field) _Column() string { return "receipt" }
 
-type ProjectMember_CreatedAt_Field struct {
+type StoragenodePayment_Notes_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *string
 }
 
-func ProjectMember_CreatedAt(v time.Time) ProjectMember_CreatedAt_Field {
-	return ProjectMember_CreatedAt_Field{_set: true, _value: v}
+func StoragenodePayment_Notes(v string) StoragenodePayment_Notes_Field {
+	return StoragenodePayment_Notes_Field{_set: true, _value: &v}
 }
 
-func (f ProjectMember_CreatedAt_Field) value() interface{} {
+func StoragenodePayment_Notes_Raw(v *string) StoragenodePayment_Notes_Field {
+	if v == nil {
+		return StoragenodePayment_Notes_Null()
+	}
+	return StoragenodePayment_Notes(*v)
+}
+
+func StoragenodePayment_Notes_Null() StoragenodePayment_Notes_Field {
+	return StoragenodePayment_Notes_Field{_set: true, _null: true}
+}
+
+func (f StoragenodePayment_Notes_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f StoragenodePayment_Notes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectMember_CreatedAt_Field) _Column() string { return "created_at" }
+func (StoragenodePayment_Notes_Field) _Column() string { return "notes" }
 
-type StripecoinpaymentsApplyBalanceIntent struct {
-	TxId      string
-	State     int
+type StoragenodePaymentTransaction struct {
+	PaymentId int64
+	Chain     string
+	TxHash    []byte
+	Layer2    bool
 	CreatedAt time.Time
 }
 
-func (StripecoinpaymentsApplyBalanceIntent) _Table() string {
-	return "stripecoinpayments_apply_balance_intents"
+func (StoragenodePaymentTransaction) _Table() string { return "storagenode_payment_transactions" }
+
+type StoragenodePaymentTransaction_Create_Fields struct {
+	CreatedAt StoragenodePaymentTransaction_CreatedAt_Field
 }
 
-type StripecoinpaymentsApplyBalanceIntent_Update_Fields struct {
-	State StripecoinpaymentsApplyBalanceIntent_State_Field
+type StoragenodePaymentTransaction_Update_Fields struct {
 }
 
-type StripecoinpaymentsApplyBalanceIntent_TxId_Field struct {
+type StoragenodePaymentTransaction_PaymentId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func StripecoinpaymentsApplyBalanceIntent_TxId(v string) StripecoinpaymentsApplyBalanceIntent_TxId_Field {
-	return StripecoinpaymentsApplyBalanceIntent_TxId_Field{_set: true, _value: v}
+func StoragenodePaymentTransaction_PaymentId(v int64) StoragenodePaymentTransaction_PaymentId_Field {
+	return StoragenodePaymentTransaction_PaymentId_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsApplyBalanceIntent_TxId_Field) value() interface{} {
+func (f StoragenodePaymentTransaction_PaymentId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsApplyBalanceIntent_TxId_Field) _Column() string { return "tx_id" }
+func (StoragenodePaymentTransaction_PaymentId_Field) _Column() string { return "payment_id" }
 
-type StripecoinpaymentsApplyBalanceIntent_State_Field struct {
+type StoragenodePaymentTransaction_Chain_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func StripecoinpaymentsApplyBalanceIntent_State(v int) StripecoinpaymentsApplyBalanceIntent_State_Field {
-	return StripecoinpaymentsApplyBalanceIntent_State_Field{_set: true, _value: v}
+func StoragenodePaymentTransaction_Chain(v string) StoragenodePaymentTransaction_Chain_Field {
+	return StoragenodePaymentTransaction_Chain_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsApplyBalanceIntent_State_Field) value() interface{} {
+func (f StoragenodePaymentTransaction_Chain_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsApplyBalanceIntent_State_Field) _Column() string { return "state" }
+func (StoragenodePaymentTransaction_Chain_Field) _Column() string { return "chain" }
 
-type StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field struct {
+type StoragenodePaymentTransaction_TxHash_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func StripecoinpaymentsApplyBalanceIntent_CreatedAt(v time.Time) StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field {
-	return StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field{_set: true, _value: v}
+func StoragenodePaymentTransaction_TxHash(v []byte) StoragenodePaymentTransaction_TxHash_Field {
+	return StoragenodePaymentTransaction_TxHash_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field) value() interface{} {
+func (f StoragenodePaymentTransaction_TxHash_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field) _Column() string { return "created_at" }
+func (StoragenodePaymentTransaction_TxHash_Field) _Column() string { return "tx_hash" }
 
-func toUTC(t time.Time) time.Time {
-	return t.UTC()
+type StoragenodePaymentTransaction_Layer2_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func StoragenodePaymentTransaction_Layer2(v bool) StoragenodePaymentTransaction_Layer2_Field {
+	return StoragenodePaymentTransaction_Layer2_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaymentTransaction_Layer2_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaymentTransaction_Layer2_Field) _Column() string { return "layer2" }
+
+type StoragenodePaymentTransaction_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func StoragenodePaymentTransaction_CreatedAt(v time.Time) StoragenodePaymentTransaction_CreatedAt_Field {
+	return StoragenodePaymentTransaction_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaymentTransaction_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaymentTransaction_CreatedAt_Field) _Column() string { return "created_at" }
+
+type StoragenodePaystub struct {
+	Period         string
+	NodeId         []byte
+	CreatedAt      time.Time
+	Codes          string
+	UsageAtRest    float64
+	UsageGet       int64
+	UsagePut       int64
+	UsageGetRepair int64
+	UsagePutRepair int64
+	UsageGetAudit  int64
+	CompAtRest     int64
+	CompGet        int64
+	CompPut        int64
+	CompGetRepair  int64
+	CompPutRepair  int64
+	CompGetAudit   int64
+	SurgePercent   int64
+	Held           int64
+	Owed           int64
+	Disposed       int64
+	Paid           int64
+	Distributed    int64
+}
+
+func (StoragenodePaystub) _Table() string { return "storagenode_paystubs" }
+
+type StoragenodePaystub_Update_Fields struct {
+}
+
+type StoragenodePaystub_Period_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func StoragenodePaystub_Period(v string) StoragenodePaystub_Period_Field {
+	return StoragenodePaystub_Period_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_Period_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_Period_Field) _Column() string { return "period" }
+
+type StoragenodePaystub_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func StoragenodePaystub_NodeId(v []byte) StoragenodePaystub_NodeId_Field {
+	return StoragenodePaystub_NodeId_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_NodeId_Field) _Column() string { return "node_id" }
+
+type StoragenodePaystub_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func StoragenodePaystub_CreatedAt(v time.Time) StoragenodePaystub_CreatedAt_Field {
+	return StoragenodePaystub_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_CreatedAt_Field) _Column() string { return "created_at" }
+
+type StoragenodePaystub_Codes_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func StoragenodePaystub_Codes(v string) StoragenodePaystub_Codes_Field {
+	return StoragenodePaystub_Codes_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_Codes_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_Codes_Field) _Column() string { return "codes" }
+
+type StoragenodePaystub_UsageAtRest_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func StoragenodePaystub_UsageAtRest(v float64) StoragenodePaystub_UsageAtRest_Field {
+	return StoragenodePaystub_UsageAtRest_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_UsageAtRest_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_UsageAtRest_Field) _Column() string { return "usage_at_rest" }
+
+type StoragenodePaystub_UsageGet_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StoragenodePaystub_UsageGet(v int64) StoragenodePaystub_UsageGet_Field {
+	return StoragenodePaystub_UsageGet_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_UsageGet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_UsageGet_Field) _Column() string { return "usage_get" }
+
+type StoragenodePaystub_UsagePut_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StoragenodePaystub_UsagePut(v int64) StoragenodePaystub_UsagePut_Field {
+	return StoragenodePaystub_UsagePut_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_UsagePut_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_UsagePut_Field) _Column() string { return "usage_put" }
+
+type StoragenodePaystub_UsageGetRepair_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StoragenodePaystub_UsageGetRepair(v int64) StoragenodePaystub_UsageGetRepair_Field {
+	return StoragenodePaystub_UsageGetRepair_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_UsageGetRepair_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_UsageGetRepair_Field) _Column() string { return "usage_get_repair" }
+
+type StoragenodePaystub_UsagePutRepair_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StoragenodePaystub_UsagePutRepair(v int64) StoragenodePaystub_UsagePutRepair_Field {
+	return StoragenodePaystub_UsagePutRepair_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_UsagePutRepair_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_UsagePutRepair_Field) _Column() string { return "usage_put_repair" }
+
+type StoragenodePaystub_UsageGetAudit_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StoragenodePaystub_UsageGetAudit(v int64) StoragenodePaystub_UsageGetAudit_Field {
+	return StoragenodePaystub_UsageGetAudit_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_UsageGetAudit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_UsageGetAudit_Field) _Column() string { return "usage_get_audit" }
+
+type StoragenodePaystub_CompAtRest_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StoragenodePaystub_CompAtRest(v int64) StoragenodePaystub_CompAtRest_Field {
+	return StoragenodePaystub_CompAtRest_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_CompAtRest_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_CompAtRest_Field) _Column() string { return "comp_at_rest" }
+
+type StoragenodePaystub_CompGet_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StoragenodePaystub_CompGet(v int64) StoragenodePaystub_CompGet_Field {
+	return StoragenodePaystub_CompGet_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_CompGet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_CompGet_Field) _Column() string { return "comp_get" }
+
+type StoragenodePaystub_CompPut_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StoragenodePaystub_CompPut(v int64) StoragenodePaystub_CompPut_Field {
+	return StoragenodePaystub_CompPut_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_CompPut_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_CompPut_Field) _Column() string { return "comp_put" }
+
+type StoragenodePaystub_CompGetRepair_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StoragenodePaystub_CompGetRepair(v int64) StoragenodePaystub_CompGetRepair_Field {
+	return StoragenodePaystub_CompGetRepair_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_CompGetRepair_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_CompGetRepair_Field) _Column() string { return "comp_get_repair" }
+
+type StoragenodePaystub_CompPutRepair_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StoragenodePaystub_CompPutRepair(v int64) StoragenodePaystub_CompPutRepair_Field {
+	return StoragenodePaystub_CompPutRepair_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_CompPutRepair_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_CompPutRepair_Field) _Column() string { return "comp_put_repair" }
+
+type StoragenodePaystub_CompGetAudit_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StoragenodePaystub_CompGetAudit(v int64) StoragenodePaystub_CompGetAudit_Field {
+	return StoragenodePaystub_CompGetAudit_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_CompGetAudit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_CompGetAudit_Field) _Column() string { return "comp_get_audit" }
+
+type StoragenodePaystub_SurgePercent_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StoragenodePaystub_SurgePercent(v int64) StoragenodePaystub_SurgePercent_Field {
+	return StoragenodePaystub_SurgePercent_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_SurgePercent_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_SurgePercent_Field) _Column() string { return "surge_percent" }
+
+type StoragenodePaystub_Held_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StoragenodePaystub_Held(v int64) StoragenodePaystub_Held_Field {
+	return StoragenodePaystub_Held_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_Held_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_Held_Field) _Column() string { return "held" }
+
+type StoragenodePaystub_Owed_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StoragenodePaystub_Owed(v int64) StoragenodePaystub_Owed_Field {
+	return StoragenodePaystub_Owed_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_Owed_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_Owed_Field) _Column() string { return "owed" }
+
+type StoragenodePaystub_Disposed_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StoragenodePaystub_Disposed(v int64) StoragenodePaystub_Disposed_Field {
+	return StoragenodePaystub_Disposed_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_Disposed_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_Disposed_Field) _Column() string { return "disposed" }
+
+type StoragenodePaystub_Paid_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StoragenodePaystub_Paid(v int64) StoragenodePaystub_Paid_Field {
+	return StoragenodePaystub_Paid_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_Paid_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_Paid_Field) _Column() string { return "paid" }
+
+type StoragenodePaystub_Distributed_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StoragenodePaystub_Distributed(v int64) StoragenodePaystub_Distributed_Field {
+	return StoragenodePaystub_Distributed_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_Distributed_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_Distributed_Field) _Column() string { return "distributed" }
+
+type StoragenodeRateSchedule struct {
+	Period        string
+	Version       int
+	AtRestGbHours string
+	GetTb         string
+	PutTb         string
+	GetRepairTb   string
+	PutRepairTb   string
+	GetAuditTb    string
+	Note          string
+	CreatedAt     time.Time
+}
+
+func (StoragenodeRateSchedule) _Table() string { return "storagenode_rate_schedules" }
+
+type StoragenodeRateSchedule_Create_Fields struct {
+	CreatedAt StoragenodeRateSchedule_CreatedAt_Field
+}
+
+type StoragenodeRateSchedule_Update_Fields struct {
+}
+
+type StoragenodeRateSchedule_Period_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func StoragenodeRateSchedule_Period(v string) StoragenodeRateSchedule_Period_Field {
+	return StoragenodeRateSchedule_Period_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeRateSchedule_Period_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeRateSchedule_Period_Field) _Column() string { return "period" }
+
+type StoragenodeRateSchedule_Version_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func StoragenodeRateSchedule_Version(v int) StoragenodeRateSchedule_Version_Field {
+	return StoragenodeRateSchedule_Version_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeRateSchedule_Version_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeRateSchedule_Version_Field) _Column() string { return "version" }
+
+type StoragenodeRateSchedule_AtRestGbHours_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func StoragenodeRateSchedule_AtRestGbHours(v string) StoragenodeRateSchedule_AtRestGbHours_Field {
+	return StoragenodeRateSchedule_AtRestGbHours_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeRateSchedule_AtRestGbHours_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeRateSchedule_AtRestGbHours_Field) _Column() string { return "at_rest_gb_hours" }
+
+type StoragenodeRateSchedule_GetTb_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func StoragenodeRateSchedule_GetTb(v string) StoragenodeRateSchedule_GetTb_Field {
+	return StoragenodeRateSchedule_GetTb_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeRateSchedule_GetTb_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeRateSchedule_GetTb_Field) _Column() string { return "get_tb" }
+
+type StoragenodeRateSchedule_PutTb_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func StoragenodeRateSchedule_PutTb(v string) StoragenodeRateSchedule_PutTb_Field {
+	return StoragenodeRateSchedule_PutTb_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeRateSchedule_PutTb_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeRateSchedule_PutTb_Field) _Column() string { return "put_tb" }
+
+type StoragenodeRateSchedule_GetRepairTb_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func StoragenodeRateSchedule_GetRepairTb(v string) StoragenodeRateSchedule_GetRepairTb_Field {
+	return StoragenodeRateSchedule_GetRepairTb_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeRateSchedule_GetRepairTb_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeRateSchedule_GetRepairTb_Field) _Column() string { return "get_repair_tb" }
+
+type StoragenodeRateSchedule_PutRepairTb_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func StoragenodeRateSchedule_PutRepairTb(v string) StoragenodeRateSchedule_PutRepairTb_Field {
+	return StoragenodeRateSchedule_PutRepairTb_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeRateSchedule_PutRepairTb_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeRateSchedule_PutRepairTb_Field) _Column() string { return "put_repair_tb" }
+
+type StoragenodeRateSchedule_GetAuditTb_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func StoragenodeRateSchedule_GetAuditTb(v string) StoragenodeRateSchedule_GetAuditTb_Field {
+	return StoragenodeRateSchedule_GetAuditTb_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeRateSchedule_GetAuditTb_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeRateSchedule_GetAuditTb_Field) _Column() string { return "get_audit_tb" }
+
+type StoragenodeRateSchedule_Note_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func StoragenodeRateSchedule_Note(v string) StoragenodeRateSchedule_Note_Field {
+	return StoragenodeRateSchedule_Note_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeRateSchedule_Note_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeRateSchedule_Note_Field) _Column() string { return "note" }
+
+type StoragenodeRateSchedule_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func StoragenodeRateSchedule_CreatedAt(v time.Time) StoragenodeRateSchedule_CreatedAt_Field {
+	return StoragenodeRateSchedule_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeRateSchedule_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeRateSchedule_CreatedAt_Field) _Column() string { return "created_at" }
+
+type StoragenodeStorageTally struct {
+	NodeId          []byte
+	IntervalEndTime time.Time
+	DataTotal       float64
+}
+
+func (StoragenodeStorageTally) _Table() string { return "storagenode_storage_tallies" }
+
+type StoragenodeStorageTally_Update_Fields struct {
+}
+
+type StoragenodeStorageTally_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func StoragenodeStorageTally_NodeId(v []byte) StoragenodeStorageTally_NodeId_Field {
+	return StoragenodeStorageTally_NodeId_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeStorageTally_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeStorageTally_NodeId_Field) _Column() string { return "node_id" }
+
+type StoragenodeStorageTally_IntervalEndTime_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func StoragenodeStorageTally_IntervalEndTime(v time.Time) StoragenodeStorageTally_IntervalEndTime_Field {
+	return StoragenodeStorageTally_IntervalEndTime_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeStorageTally_IntervalEndTime_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeStorageTally_IntervalEndTime_Field) _Column() string { return "interval_end_time" }
+
+type StoragenodeStorageTally_DataTotal_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func StoragenodeStorageTally_DataTotal(v float64) StoragenodeStorageTally_DataTotal_Field {
+	return StoragenodeStorageTally_DataTotal_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeStorageTally_DataTotal_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeStorageTally_DataTotal_Field) _Column() string { return "data_total" }
+
+type StorjscanPayment struct {
+	BlockHash   []byte
+	BlockNumber int64
+	Transaction []byte
+	LogIndex    int
+	FromAddress []byte
+	ToAddress   []byte
+	TokenValue  int64
+	UsdValue    int64
+	Status      string
+	Timestamp   time.Time
+	CreatedAt   time.Time
+}
+
+func (StorjscanPayment) _Table() string { return "storjscan_payments" }
+
+type StorjscanPayment_Update_Fields struct {
+}
+
+type StorjscanPayment_BlockHash_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func StorjscanPayment_BlockHash(v []byte) StorjscanPayment_BlockHash_Field {
+	return StorjscanPayment_BlockHash_Field{_set: true, _value: v}
+}
+
+func (f StorjscanPayment_BlockHash_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StorjscanPayment_BlockHash_Field) _Column() string { return "block_hash" }
+
+type StorjscanPayment_BlockNumber_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StorjscanPayment_BlockNumber(v int64) StorjscanPayment_BlockNumber_Field {
+	return StorjscanPayment_BlockNumber_Field{_set: true, _value: v}
+}
+
+func (f StorjscanPayment_BlockNumber_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StorjscanPayment_BlockNumber_Field) _Column() string { return "block_number" }
+
+type StorjscanPayment_Transaction_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func StorjscanPayment_Transaction(v []byte) StorjscanPayment_Transaction_Field {
+	return StorjscanPayment_Transaction_Field{_set: true, _value: v}
+}
+
+func (f StorjscanPayment_Transaction_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StorjscanPayment_Transaction_Field) _Column() string { return "transaction" }
+
+type StorjscanPayment_LogIndex_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func StorjscanPayment_LogIndex(v int) StorjscanPayment_LogIndex_Field {
+	return StorjscanPayment_LogIndex_Field{_set: true, _value: v}
+}
+
+func (f StorjscanPayment_LogIndex_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StorjscanPayment_LogIndex_Field) _Column() string { return "log_index" }
+
+type StorjscanPayment_FromAddress_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func StorjscanPayment_FromAddress(v []byte) StorjscanPayment_FromAddress_Field {
+	return StorjscanPayment_FromAddress_Field{_set: true, _value: v}
+}
+
+func (f StorjscanPayment_FromAddress_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StorjscanPayment_FromAddress_Field) _Column() string { return "from_address" }
+
+type StorjscanPayment_ToAddress_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func StorjscanPayment_ToAddress(v []byte) StorjscanPayment_ToAddress_Field {
+	return StorjscanPayment_ToAddress_Field{_set: true, _value: v}
+}
+
+func (f StorjscanPayment_ToAddress_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StorjscanPayment_ToAddress_Field) _Column() string { return "to_address" }
+
+type StorjscanPayment_TokenValue_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StorjscanPayment_TokenValue(v int64) StorjscanPayment_TokenValue_Field {
+	return StorjscanPayment_TokenValue_Field{_set: true, _value: v}
+}
+
+func (f StorjscanPayment_TokenValue_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StorjscanPayment_TokenValue_Field) _Column() string { return "token_value" }
+
+type StorjscanPayment_UsdValue_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StorjscanPayment_UsdValue(v int64) StorjscanPayment_UsdValue_Field {
+	return StorjscanPayment_UsdValue_Field{_set: true, _value: v}
+}
+
+func (f StorjscanPayment_UsdValue_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StorjscanPayment_UsdValue_Field) _Column() string { return "usd_value" }
+
+type StorjscanPayment_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func StorjscanPayment_Status(v string) StorjscanPayment_Status_Field {
+	return StorjscanPayment_Status_Field{_set: true, _value: v}
+}
+
+func (f StorjscanPayment_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StorjscanPayment_Status_Field) _Column() string { return "status" }
+
+type StorjscanPayment_Timestamp_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func StorjscanPayment_Timestamp(v time.Time) StorjscanPayment_Timestamp_Field {
+	return StorjscanPayment_Timestamp_Field{_set: true, _value: v}
+}
+
+func (f StorjscanPayment_Timestamp_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StorjscanPayment_Timestamp_Field) _Column() string { return "timestamp" }
+
+type StorjscanPayment_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func StorjscanPayment_CreatedAt(v time.Time) StorjscanPayment_CreatedAt_Field {
+	return StorjscanPayment_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f StorjscanPayment_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StorjscanPayment_CreatedAt_Field) _Column() string { return "created_at" }
+
+type StorjscanWallet struct {
+	UserId        []byte
+	WalletAddress []byte
+	CreatedAt     time.Time
+}
+
+func (StorjscanWallet) _Table() string { return "storjscan_wallets" }
+
+type StorjscanWallet_Update_Fields struct {
+}
+
+type StorjscanWallet_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func StorjscanWallet_UserId(v []byte) StorjscanWallet_UserId_Field {
+	return StorjscanWallet_UserId_Field{_set: true, _value: v}
+}
+
+func (f StorjscanWallet_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StorjscanWallet_UserId_Field) _Column() string { return "user_id" }
+
+type StorjscanWallet_WalletAddress_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func StorjscanWallet_WalletAddress(v []byte) StorjscanWallet_WalletAddress_Field {
+	return StorjscanWallet_WalletAddress_Field{_set: true, _value: v}
+}
+
+func (f StorjscanWallet_WalletAddress_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StorjscanWallet_WalletAddress_Field) _Column() string { return "wallet_address" }
+
+type StorjscanWallet_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func StorjscanWallet_CreatedAt(v time.Time) StorjscanWallet_CreatedAt_Field {
+	return StorjscanWallet_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f StorjscanWallet_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StorjscanWallet_CreatedAt_Field) _Column() string { return "created_at" }
+
+type StripeCustomer struct {
+	UserId             []byte
+	CustomerId         string
+	PackagePlan        *string
+	PurchasedPackageAt *time.Time
+	CreatedAt          time.Time
+}
+
+func (StripeCustomer) _Table() string { return "stripe_customers" }
+
+type StripeCustomer_Create_Fields struct {
+	PackagePlan        StripeCustomer_PackagePlan_Field
+	PurchasedPackageAt StripeCustomer_PurchasedPackageAt_Field
+}
+
+type StripeCustomer_Update_Fields struct {
+	PackagePlan        StripeCustomer_PackagePlan_Field
+	PurchasedPackageAt StripeCustomer_PurchasedPackageAt_Field
+}
+
+type StripeCustomer_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func StripeCustomer_UserId(v []byte) StripeCustomer_UserId_Field {
+	return StripeCustomer_UserId_Field{_set: true, _value: v}
+}
+
+func (f StripeCustomer_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripeCustomer_UserId_Field) _Column() string { return "user_id" }
+
+type StripeCustomer_CustomerId_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func StripeCustomer_CustomerId(v string) StripeCustomer_CustomerId_Field {
+	return StripeCustomer_CustomerId_Field{_set: true, _value: v}
+}
+
+func (f StripeCustomer_CustomerId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripeCustomer_CustomerId_Field) _Column() string { return "customer_id" }
+
+type StripeCustomer_PackagePlan_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func StripeCustomer_PackagePlan(v string) StripeCustomer_PackagePlan_Field {
+	return StripeCustomer_PackagePlan_Field{_set: true, _value: &v}
+}
+
+func StripeCustomer_PackagePlan_Raw(v *string) StripeCustomer_PackagePlan_Field {
+	if v == nil {
+		return StripeCustomer_PackagePlan_Null()
+	}
+	return StripeCustomer_PackagePlan(*v)
+}
+
+func StripeCustomer_PackagePlan_Null() StripeCustomer_PackagePlan_Field {
+	return StripeCustomer_PackagePlan_Field{_set: true, _null: true}
+}
+
+func (f StripeCustomer_PackagePlan_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f StripeCustomer_PackagePlan_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripeCustomer_PackagePlan_Field) _Column() string { return "package_plan" }
+
+type StripeCustomer_PurchasedPackageAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func StripeCustomer_PurchasedPackageAt(v time.Time) StripeCustomer_PurchasedPackageAt_Field {
+	return StripeCustomer_PurchasedPackageAt_Field{_set: true, _value: &v}
+}
+
+func StripeCustomer_PurchasedPackageAt_Raw(v *time.Time) StripeCustomer_PurchasedPackageAt_Field {
+	if v == nil {
+		return StripeCustomer_PurchasedPackageAt_Null()
+	}
+	return StripeCustomer_PurchasedPackageAt(*v)
+}
+
+func StripeCustomer_PurchasedPackageAt_Null() StripeCustomer_PurchasedPackageAt_Field {
+	return StripeCustomer_PurchasedPackageAt_Field{_set: true, _null: true}
+}
+
+func (f StripeCustomer_PurchasedPackageAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f StripeCustomer_PurchasedPackageAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripeCustomer_PurchasedPackageAt_Field) _Column() string { return "purchased_package_at" }
+
+type StripeCustomer_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func StripeCustomer_CreatedAt(v time.Time) StripeCustomer_CreatedAt_Field {
+	return StripeCustomer_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f StripeCustomer_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripeCustomer_CreatedAt_Field) _Column() string { return "created_at" }
+
+type StripecoinpaymentsInvoiceProjectRecord struct {
+	Id          []byte
+	ProjectId   []byte
+	Storage     float64
+	Egress      int64
+	Objects     *int64
+	Segments    *int64
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	State       int
+	CreatedAt   time.Time
+}
+
+func (StripecoinpaymentsInvoiceProjectRecord) _Table() string {
+	return "stripecoinpayments_invoice_project_records"
+}
+
+type StripecoinpaymentsInvoiceProjectRecord_Create_Fields struct {
+	Objects  StripecoinpaymentsInvoiceProjectRecord_Objects_Field
+	Segments StripecoinpaymentsInvoiceProjectRecord_Segments_Field
+}
+
+type StripecoinpaymentsInvoiceProjectRecord_Update_Fields struct {
+	State StripecoinpaymentsInvoiceProjectRecord_State_Field
+}
+
+type StripecoinpaymentsInvoiceProjectRecord_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func StripecoinpaymentsInvoiceProjectRecord_Id(v []byte) StripecoinpaymentsInvoiceProjectRecord_Id_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Id_Field{_set: true, _value: v}
+}
+
+func (f StripecoinpaymentsInvoiceProjectRecord_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsInvoiceProjectRecord_Id_Field) _Column() string { return "id" }
+
+type StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func StripecoinpaymentsInvoiceProjectRecord_ProjectId(v []byte) StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field) _Column() string { return "project_id" }
+
+type StripecoinpaymentsInvoiceProjectRecord_Storage_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func StripecoinpaymentsInvoiceProjectRecord_Storage(v float64) StripecoinpaymentsInvoiceProjectRecord_Storage_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Storage_Field{_set: true, _value: v}
+}
+
+func (f StripecoinpaymentsInvoiceProjectRecord_Storage_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsInvoiceProjectRecord_Storage_Field) _Column() string { return "storage" }
+
+type StripecoinpaymentsInvoiceProjectRecord_Egress_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StripecoinpaymentsInvoiceProjectRecord_Egress(v int64) StripecoinpaymentsInvoiceProjectRecord_Egress_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Egress_Field{_set: true, _value: v}
+}
+
+func (f StripecoinpaymentsInvoiceProjectRecord_Egress_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsInvoiceProjectRecord_Egress_Field) _Column() string { return "egress" }
+
+type StripecoinpaymentsInvoiceProjectRecord_Objects_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func StripecoinpaymentsInvoiceProjectRecord_Objects(v int64) StripecoinpaymentsInvoiceProjectRecord_Objects_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Objects_Field{_set: true, _value: &v}
+}
+
+func StripecoinpaymentsInvoiceProjectRecord_Objects_Raw(v *int64) StripecoinpaymentsInvoiceProjectRecord_Objects_Field {
+	if v == nil {
+		return StripecoinpaymentsInvoiceProjectRecord_Objects_Null()
+	}
+	return StripecoinpaymentsInvoiceProjectRecord_Objects(*v)
+}
+
+func StripecoinpaymentsInvoiceProjectRecord_Objects_Null() StripecoinpaymentsInvoiceProjectRecord_Objects_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Objects_Field{_set: true, _null: true}
+}
+
+func (f StripecoinpaymentsInvoiceProjectRecord_Objects_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f StripecoinpaymentsInvoiceProjectRecord_Objects_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsInvoiceProjectRecord_Objects_Field) _Column() string { return "objects" }
+
+type StripecoinpaymentsInvoiceProjectRecord_Segments_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func StripecoinpaymentsInvoiceProjectRecord_Segments(v int64) StripecoinpaymentsInvoiceProjectRecord_Segments_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Segments_Field{_set: true, _value: &v}
+}
+
+func StripecoinpaymentsInvoiceProjectRecord_Segments_Raw(v *int64) StripecoinpaymentsInvoiceProjectRecord_Segments_Field {
+	if v == nil {
+		return StripecoinpaymentsInvoiceProjectRecord_Segments_Null()
+	}
+	return StripecoinpaymentsInvoiceProjectRecord_Segments(*v)
+}
+
+func StripecoinpaymentsInvoiceProjectRecord_Segments_Null() StripecoinpaymentsInvoiceProjectRecord_Segments_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Segments_Field{_set: true, _null: true}
+}
+
+func (f StripecoinpaymentsInvoiceProjectRecord_Segments_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f StripecoinpaymentsInvoiceProjectRecord_Segments_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsInvoiceProjectRecord_Segments_Field) _Column() string { return "segments" }
+
+type StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func StripecoinpaymentsInvoiceProjectRecord_PeriodStart(v time.Time) StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field{_set: true, _value: v}
+}
+
+func (f StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field) _Column() string {
+	return "period_start"
+}
+
+type StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func StripecoinpaymentsInvoiceProjectRecord_PeriodEnd(v time.Time) StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field{_set: true, _value: v}
+}
+
+func (f StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field) _Column() string { return "period_end" }
+
+type StripecoinpaymentsInvoiceProjectRecord_State_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func StripecoinpaymentsInvoiceProjectRecord_State(v int) StripecoinpaymentsInvoiceProjectRecord_State_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_State_Field{_set: true, _value: v}
+}
+
+func (f StripecoinpaymentsInvoiceProjectRecord_State_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsInvoiceProjectRecord_State_Field) _Column() string { return "state" }
+
+type StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func StripecoinpaymentsInvoiceProjectRecord_CreatedAt(v time.Time) StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field) _Column() string { return "created_at" }
+
+type StripecoinpaymentsTxConversionRate struct {
+	TxId        string
+	RateNumeric float64
+	CreatedAt   time.Time
+}
+
+func (StripecoinpaymentsTxConversionRate) _Table() string {
+	return "stripecoinpayments_tx_conversion_rates"
+}
+
+type StripecoinpaymentsTxConversionRate_Update_Fields struct {
+}
+
+type StripecoinpaymentsTxConversionRate_TxId_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func StripecoinpaymentsTxConversionRate_TxId(v string) StripecoinpaymentsTxConversionRate_TxId_Field {
+	return StripecoinpaymentsTxConversionRate_TxId_Field{_set: true, _value: v}
+}
+
+func (f StripecoinpaymentsTxConversionRate_TxId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsTxConversionRate_TxId_Field) _Column() string { return "tx_id" }
+
+type StripecoinpaymentsTxConversionRate_RateNumeric_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func StripecoinpaymentsTxConversionRate_RateNumeric(v float64) StripecoinpaymentsTxConversionRate_RateNumeric_Field {
+	return StripecoinpaymentsTxConversionRate_RateNumeric_Field{_set: true, _value: v}
+}
+
+func (f StripecoinpaymentsTxConversionRate_RateNumeric_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsTxConversionRate_RateNumeric_Field) _Column() string { return "rate_numeric" }
+
+type StripecoinpaymentsTxConversionRate_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func StripecoinpaymentsTxConversionRate_CreatedAt(v time.Time) StripecoinpaymentsTxConversionRate_CreatedAt_Field {
+	return StripecoinpaymentsTxConversionRate_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f StripecoinpaymentsTxConversionRate_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsTxConversionRate_CreatedAt_Field) _Column() string { return "created_at" }
+
+type User struct {
+	Id                     []byte
+	Email                  string
+	NormalizedEmail        string
+	FullName               string
+	ShortName              *string
+	PasswordHash           []byte
+	Status                 int
+	PartnerId              []byte
+	UserAgent              []byte
+	CreatedAt              time.Time
+	ProjectLimit           int
+	ProjectBandwidthLimit  int64
+	ProjectStorageLimit    int64
+	ProjectSegmentLimit    int64
+	PaidTier               bool
+	Position               *string
+	CompanyName            *string
+	CompanySize            *int
+	WorkingOn              *string
+	IsProfessional         bool
+	EmployeeCount          *string
+	HaveSalesContact       bool
+	MfaEnabled             bool
+	MfaSecretKey           *string
+	MfaRecoveryCodes       *string
+	SignupPromoCode        *string
+	VerificationReminders  int
+	FailedLoginCount       *int
+	LoginLockoutExpiration *time.Time
+	SignupCaptcha          *float64
+}
+
+func (User) _Table() string { return "users" }
+
+type User_Create_Fields struct {
+	ShortName              User_ShortName_Field
+	PartnerId              User_PartnerId_Field
+	UserAgent              User_UserAgent_Field
+	ProjectLimit           User_ProjectLimit_Field
+	ProjectBandwidthLimit  User_ProjectBandwidthLimit_Field
+	ProjectStorageLimit    User_ProjectStorageLimit_Field
+	ProjectSegmentLimit    User_ProjectSegmentLimit_Field
+	PaidTier               User_PaidTier_Field
+	Position               User_Position_Field
+	CompanyName            User_CompanyName_Field
+	CompanySize            User_CompanySize_Field
+	WorkingOn              User_WorkingOn_Field
+	IsProfessional         User_IsProfessional_Field
+	EmployeeCount          User_EmployeeCount_Field
+	HaveSalesContact       User_HaveSalesContact_Field
+	MfaEnabled             User_MfaEnabled_Field
+	MfaSecretKey           User_MfaSecretKey_Field
+	MfaRecoveryCodes       User_MfaRecoveryCodes_Field
+	SignupPromoCode        User_SignupPromoCode_Field
+	VerificationReminders  User_VerificationReminders_Field
+	FailedLoginCount       User_FailedLoginCount_Field
+	LoginLockoutExpiration User_LoginLockoutExpiration_Field
+	SignupCaptcha          User_SignupCaptcha_Field
+}
+
+type User_Update_Fields struct {
+	Email                  User_Email_Field
+	NormalizedEmail        User_NormalizedEmail_Field
+	FullName               User_FullName_Field
+	ShortName              User_ShortName_Field
+	PasswordHash           User_PasswordHash_Field
+	Status                 User_Status_Field
+	ProjectLimit           User_ProjectLimit_Field
+	ProjectBandwidthLimit  User_ProjectBandwidthLimit_Field
+	ProjectStorageLimit    User_ProjectStorageLimit_Field
+	ProjectSegmentLimit    User_ProjectSegmentLimit_Field
+	PaidTier               User_PaidTier_Field
+	Position               User_Position_Field
+	CompanyName            User_CompanyName_Field
+	CompanySize            User_CompanySize_Field
+	WorkingOn              User_WorkingOn_Field
+	IsProfessional         User_IsProfessional_Field
+	EmployeeCount          User_EmployeeCount_Field
+	HaveSalesContact       User_HaveSalesContact_Field
+	MfaEnabled             User_MfaEnabled_Field
+	MfaSecretKey           User_MfaSecretKey_Field
+	MfaRecoveryCodes       User_MfaRecoveryCodes_Field
+	SignupPromoCode        User_SignupPromoCode_Field
+	VerificationReminders  User_VerificationReminders_Field
+	FailedLoginCount       User_FailedLoginCount_Field
+	LoginLockoutExpiration User_LoginLockoutExpiration_Field
+}
+
+type User_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func User_Id(v []byte) User_Id_Field {
+	return User_Id_Field{_set: true, _value: v}
+}
+
+func (f User_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_Id_Field) _Column() string { return "id" }
+
+type User_Email_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func User_Email(v string) User_Email_Field {
+	return User_Email_Field{_set: true, _value: v}
+}
+
+func (f User_Email_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_Email_Field) _Column() string { return "email" }
+
+type User_NormalizedEmail_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func User_NormalizedEmail(v string) User_NormalizedEmail_Field {
+	return User_NormalizedEmail_Field{_set: true, _value: v}
+}
+
+func (f User_NormalizedEmail_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_NormalizedEmail_Field) _Column() string { return "normalized_email" }
+
+type User_FullName_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func User_FullName(v string) User_FullName_Field {
+	return User_FullName_Field{_set: true, _value: v}
+}
+
+func (f User_FullName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_FullName_Field) _Column() string { return "full_name" }
+
+type User_ShortName_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func User_ShortName(v string) User_ShortName_Field {
+	return User_ShortName_Field{_set: true, _value: &v}
+}
+
+func User_ShortName_Raw(v *string) User_ShortName_Field {
+	if v == nil {
+		return User_ShortName_Null()
+	}
+	return User_ShortName(*v)
+}
+
+func User_ShortName_Null() User_ShortName_Field {
+	return User_ShortName_Field{_set: true, _null: true}
+}
+
+func (f User_ShortName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_ShortName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_ShortName_Field) _Column() string { return "short_name" }
+
+type User_PasswordHash_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func User_PasswordHash(v []byte) User_PasswordHash_Field {
+	return User_PasswordHash_Field{_set: true, _value: v}
+}
+
+func (f User_PasswordHash_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_PasswordHash_Field) _Column() string { return "password_hash" }
+
+type User_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func User_Status(v int) User_Status_Field {
+	return User_Status_Field{_set: true, _value: v}
+}
+
+func (f User_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_Status_Field) _Column() string { return "status" }
+
+type User_PartnerId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func User_PartnerId(v []byte) User_PartnerId_Field {
+	return User_PartnerId_Field{_set: true, _value: v}
+}
+
+func User_PartnerId_Raw(v []byte) User_PartnerId_Field {
+	if v == nil {
+		return User_PartnerId_Null()
+	}
+	return User_PartnerId(v)
+}
+
+func User_PartnerId_Null() User_PartnerId_Field {
+	return User_PartnerId_Field{_set: true, _null: true}
+}
+
+func (f User_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_PartnerId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_PartnerId_Field) _Column() string { return "partner_id" }
+
+type User_UserAgent_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func User_UserAgent(v []byte) User_UserAgent_Field {
+	return User_UserAgent_Field{_set: true, _value: v}
+}
+
+func User_UserAgent_Raw(v []byte) User_UserAgent_Field {
+	if v == nil {
+		return User_UserAgent_Null()
+	}
+	return User_UserAgent(v)
+}
+
+func User_UserAgent_Null() User_UserAgent_Field {
+	return User_UserAgent_Field{_set: true, _null: true}
+}
+
+func (f User_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_UserAgent_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_UserAgent_Field) _Column() string { return "user_agent" }
+
+type User_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func User_CreatedAt(v time.Time) User_CreatedAt_Field {
+	return User_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f User_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_CreatedAt_Field) _Column() string { return "created_at" }
+
+type User_ProjectLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func User_ProjectLimit(v int) User_ProjectLimit_Field {
+	return User_ProjectLimit_Field{_set: true, _value: v}
+}
+
+func (f User_ProjectLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_ProjectLimit_Field) _Column() string { return "project_limit" }
+
+type User_ProjectBandwidthLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func User_ProjectBandwidthLimit(v int64) User_ProjectBandwidthLimit_Field {
+	return User_ProjectBandwidthLimit_Field{_set: true, _value: v}
+}
+
+func (f User_ProjectBandwidthLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_ProjectBandwidthLimit_Field) _Column() string { return "project_bandwidth_limit" }
+
+type User_ProjectStorageLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func User_ProjectStorageLimit(v int64) User_ProjectStorageLimit_Field {
+	return User_ProjectStorageLimit_Field{_set: true, _value: v}
+}
+
+func (f User_ProjectStorageLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_ProjectStorageLimit_Field) _Column() string { return "project_storage_limit" }
+
+type User_ProjectSegmentLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func User_ProjectSegmentLimit(v int64) User_ProjectSegmentLimit_Field {
+	return User_ProjectSegmentLimit_Field{_set: true, _value: v}
+}
+
+func (f User_ProjectSegmentLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_ProjectSegmentLimit_Field) _Column() string { return "project_segment_limit" }
+
+type User_PaidTier_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func User_PaidTier(v bool) User_PaidTier_Field {
+	return User_PaidTier_Field{_set: true, _value: v}
+}
+
+func (f User_PaidTier_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_PaidTier_Field) _Column() string { return "paid_tier" }
+
+type User_Position_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func User_Position(v string) User_Position_Field {
+	return User_Position_Field{_set: true, _value: &v}
+}
+
+func User_Position_Raw(v *string) User_Position_Field {
+	if v == nil {
+		return User_Position_Null()
+	}
+	return User_Position(*v)
+}
+
+func User_Position_Null() User_Position_Field {
+	return User_Position_Field{_set: true, _null: true}
+}
+
+func (f User_Position_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_Position_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_Position_Field) _Column() string { return "position" }
+
+type User_CompanyName_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func User_CompanyName(v string) User_CompanyName_Field {
+	return User_CompanyName_Field{_set: true, _value: &v}
+}
+
+func User_CompanyName_Raw(v *string) User_CompanyName_Field {
+	if v == nil {
+		return User_CompanyName_Null()
+	}
+	return User_CompanyName(*v)
+}
+
+func User_CompanyName_Null() User_CompanyName_Field {
+	return User_CompanyName_Field{_set: true, _null: true}
+}
+
+func (f User_CompanyName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_CompanyName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_CompanyName_Field) _Column() string { return "company_name" }
+
+type User_CompanySize_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func User_CompanySize(v int) User_CompanySize_Field {
+	return User_CompanySize_Field{_set: true, _value: &v}
+}
+
+func User_CompanySize_Raw(v *int) User_CompanySize_Field {
+	if v == nil {
+		return User_CompanySize_Null()
+	}
+	return User_CompanySize(*v)
+}
+
+func User_CompanySize_Null() User_CompanySize_Field {
+	return User_CompanySize_Field{_set: true, _null: true}
+}
+
+func (f User_CompanySize_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_CompanySize_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_CompanySize_Field) _Column() string { return "company_size" }
+
+type User_WorkingOn_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func User_WorkingOn(v string) User_WorkingOn_Field {
+	return User_WorkingOn_Field{_set: true, _value: &v}
+}
+
+func User_WorkingOn_Raw(v *string) User_WorkingOn_Field {
+	if v == nil {
+		return User_WorkingOn_Null()
+	}
+	return User_WorkingOn(*v)
+}
+
+func User_WorkingOn_Null() User_WorkingOn_Field {
+	return User_WorkingOn_Field{_set: true, _null: true}
+}
+
+func (f User_WorkingOn_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_WorkingOn_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_WorkingOn_Field) _Column() string { return "working_on" }
+
+type User_IsProfessional_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func User_IsProfessional(v bool) User_IsProfessional_Field {
+	return User_IsProfessional_Field{_set: true, _value: v}
+}
+
+func (f User_IsProfessional_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_IsProfessional_Field) _Column() string { return "is_professional" }
+
+type User_EmployeeCount_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func User_EmployeeCount(v string) User_EmployeeCount_Field {
+	return User_EmployeeCount_Field{_set: true, _value: &v}
+}
+
+func User_EmployeeCount_Raw(v *string) User_EmployeeCount_Field {
+	if v == nil {
+		return User_EmployeeCount_Null()
+	}
+	return User_EmployeeCount(*v)
+}
+
+func User_EmployeeCount_Null() User_EmployeeCount_Field {
+	return User_EmployeeCount_Field{_set: true, _null: true}
+}
+
+func (f User_EmployeeCount_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_EmployeeCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_EmployeeCount_Field) _Column() string { return "employee_count" }
+
+type User_HaveSalesContact_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func User_HaveSalesContact(v bool) User_HaveSalesContact_Field {
+	return User_HaveSalesContact_Field{_set: true, _value: v}
+}
+
+func (f User_HaveSalesContact_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_HaveSalesContact_Field) _Column() string { return "have_sales_contact" }
+
+type User_MfaEnabled_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func User_MfaEnabled(v bool) User_MfaEnabled_Field {
+	return User_MfaEnabled_Field{_set: true, _value: v}
+}
+
+func (f User_MfaEnabled_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_MfaEnabled_Field) _Column() string { return "mfa_enabled" }
+
+type User_MfaSecretKey_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func User_MfaSecretKey(v string) User_MfaSecretKey_Field {
+	return User_MfaSecretKey_Field{_set: true, _value: &v}
+}
+
+func User_MfaSecretKey_Raw(v *string) User_MfaSecretKey_Field {
+	if v == nil {
+		return User_MfaSecretKey_Null()
+	}
+	return User_MfaSecretKey(*v)
+}
+
+func User_MfaSecretKey_Null() User_MfaSecretKey_Field {
+	return User_MfaSecretKey_Field{_set: true, _null: true}
+}
+
+func (f User_MfaSecretKey_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_MfaSecretKey_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_MfaSecretKey_Field) _Column() string { return "mfa_secret_key" }
+
+type User_MfaRecoveryCodes_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func User_MfaRecoveryCodes(v string) User_MfaRecoveryCodes_Field {
+	return User_MfaRecoveryCodes_Field{_set: true, _value: &v}
+}
+
+func User_MfaRecoveryCodes_Raw(v *string) User_MfaRecoveryCodes_Field {
+	if v == nil {
+		return User_MfaRecoveryCodes_Null()
+	}
+	return User_MfaRecoveryCodes(*v)
+}
+
+func User_MfaRecoveryCodes_Null() User_MfaRecoveryCodes_Field {
+	return User_MfaRecoveryCodes_Field{_set: true, _null: true}
+}
+
+func (f User_MfaRecoveryCodes_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_MfaRecoveryCodes_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_MfaRecoveryCodes_Field) _Column() string { return "mfa_recovery_codes" }
+
+type User_SignupPromoCode_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func User_SignupPromoCode(v string) User_SignupPromoCode_Field {
+	return User_SignupPromoCode_Field{_set: true, _value: &v}
+}
+
+func User_SignupPromoCode_Raw(v *string) User_SignupPromoCode_Field {
+	if v == nil {
+		return User_SignupPromoCode_Null()
+	}
+	return User_SignupPromoCode(*v)
+}
+
+func User_SignupPromoCode_Null() User_SignupPromoCode_Field {
+	return User_SignupPromoCode_Field{_set: true, _null: true}
+}
+
+func (f User_SignupPromoCode_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_SignupPromoCode_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_SignupPromoCode_Field) _Column() string { return "signup_promo_code" }
+
+type User_VerificationReminders_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func User_VerificationReminders(v int) User_VerificationReminders_Field {
+	return User_VerificationReminders_Field{_set: true, _value: v}
+}
+
+func (f User_VerificationReminders_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_VerificationReminders_Field) _Column() string { return "verification_reminders" }
+
+type User_FailedLoginCount_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func User_FailedLoginCount(v int) User_FailedLoginCount_Field {
+	return User_FailedLoginCount_Field{_set: true, _value: &v}
+}
+
+func User_FailedLoginCount_Raw(v *int) User_FailedLoginCount_Field {
+	if v == nil {
+		return User_FailedLoginCount_Null()
+	}
+	return User_FailedLoginCount(*v)
+}
+
+func User_FailedLoginCount_Null() User_FailedLoginCount_Field {
+	return User_FailedLoginCount_Field{_set: true, _null: true}
+}
+
+func (f User_FailedLoginCount_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_FailedLoginCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_FailedLoginCount_Field) _Column() string { return "failed_login_count" }
+
+type User_LoginLockoutExpiration_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func User_LoginLockoutExpiration(v time.Time) User_LoginLockoutExpiration_Field {
+	return User_LoginLockoutExpiration_Field{_set: true, _value: &v}
+}
+
+func User_LoginLockoutExpiration_Raw(v *time.Time) User_LoginLockoutExpiration_Field {
+	if v == nil {
+		return User_LoginLockoutExpiration_Null()
+	}
+	return User_LoginLockoutExpiration(*v)
+}
+
+func User_LoginLockoutExpiration_Null() User_LoginLockoutExpiration_Field {
+	return User_LoginLockoutExpiration_Field{_set: true, _null: true}
+}
+
+func (f User_LoginLockoutExpiration_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f User_LoginLockoutExpiration_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_LoginLockoutExpiration_Field) _Column() string { return "login_lockout_expiration" }
+
+type User_SignupCaptcha_Field struct {
+	_set   bool
+	_null  bool
+	_value *float64
+}
+
+func User_SignupCaptcha(v float64) User_SignupCaptcha_Field {
+	return User_SignupCaptcha_Field{_set: true, _value: &v}
+}
+
+func User_SignupCaptcha_Raw(v *float64) User_SignupCaptcha_Field {
+	if v == nil {
+		return User_SignupCaptcha_Null()
+	}
+	return User_SignupCaptcha(*v)
+}
+
+func User_SignupCaptcha_Null() User_SignupCaptcha_Field {
+	return User_SignupCaptcha_Field{_set: true, _null: true}
+}
+
+func (f User_SignupCaptcha_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_SignupCaptcha_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_SignupCaptcha_Field) _Column() string { return "signup_captcha" }
+
+type UserAgentRollup struct {
+	ProjectId     []byte
+	BucketName    []byte
+	Product       string
+	Version       string
+	IntervalDay   time.Time
+	UploadCount   int64
+	UploadBytes   int64
+	DownloadCount int64
+	DownloadBytes int64
+}
+
+func (UserAgentRollup) _Table() string { return "user_agent_rollups" }
+
+type UserAgentRollup_Create_Fields struct {
+}
+
+type UserAgentRollup_Update_Fields struct {
+	UploadCount   UserAgentRollup_UploadCount_Field
+	UploadBytes   UserAgentRollup_UploadBytes_Field
+	DownloadCount UserAgentRollup_DownloadCount_Field
+	DownloadBytes UserAgentRollup_DownloadBytes_Field
+}
+
+type UserAgentRollup_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func UserAgentRollup_ProjectId(v []byte) UserAgentRollup_ProjectId_Field {
+	return UserAgentRollup_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f UserAgentRollup_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserAgentRollup_ProjectId_Field) _Column() string { return "project_id" }
+
+type UserAgentRollup_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func UserAgentRollup_BucketName(v []byte) UserAgentRollup_BucketName_Field {
+	return UserAgentRollup_BucketName_Field{_set: true, _value: v}
+}
+
+func (f UserAgentRollup_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserAgentRollup_BucketName_Field) _Column() string { return "bucket_name" }
+
+type UserAgentRollup_Product_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func UserAgentRollup_Product(v string) UserAgentRollup_Product_Field {
+	return UserAgentRollup_Product_Field{_set: true, _value: v}
+}
+
+func (f UserAgentRollup_Product_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserAgentRollup_Product_Field) _Column() string { return "product" }
+
+type UserAgentRollup_Version_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func UserAgentRollup_Version(v string) UserAgentRollup_Version_Field {
+	return UserAgentRollup_Version_Field{_set: true, _value: v}
+}
+
+func (f UserAgentRollup_Version_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserAgentRollup_Version_Field) _Column() string { return "version" }
+
+type UserAgentRollup_IntervalDay_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func UserAgentRollup_IntervalDay(v time.Time) UserAgentRollup_IntervalDay_Field {
+	return UserAgentRollup_IntervalDay_Field{_set: true, _value: v}
+}
+
+func (f UserAgentRollup_IntervalDay_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserAgentRollup_IntervalDay_Field) _Column() string { return "interval_day" }
+
+type UserAgentRollup_UploadCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func UserAgentRollup_UploadCount(v int64) UserAgentRollup_UploadCount_Field {
+	return UserAgentRollup_UploadCount_Field{_set: true, _value: v}
+}
+
+func (f UserAgentRollup_UploadCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserAgentRollup_UploadCount_Field) _Column() string { return "upload_count" }
+
+type UserAgentRollup_UploadBytes_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func UserAgentRollup_UploadBytes(v int64) UserAgentRollup_UploadBytes_Field {
+	return UserAgentRollup_UploadBytes_Field{_set: true, _value: v}
+}
+
+func (f UserAgentRollup_UploadBytes_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserAgentRollup_UploadBytes_Field) _Column() string { return "upload_bytes" }
+
+type UserAgentRollup_DownloadCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func UserAgentRollup_DownloadCount(v int64) UserAgentRollup_DownloadCount_Field {
+	return UserAgentRollup_DownloadCount_Field{_set: true, _value: v}
+}
+
+func (f UserAgentRollup_DownloadCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserAgentRollup_DownloadCount_Field) _Column() string { return "download_count" }
+
+type UserAgentRollup_DownloadBytes_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func UserAgentRollup_DownloadBytes(v int64) UserAgentRollup_DownloadBytes_Field {
+	return UserAgentRollup_DownloadBytes_Field{_set: true, _value: v}
+}
+
+func (f UserAgentRollup_DownloadBytes_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserAgentRollup_DownloadBytes_Field) _Column() string { return "download_bytes" }
+
+type UserNotification struct {
+	UserId    []byte
+	Id        []byte
+	Kind      string
+	Title     string
+	Message   string
+	ReadAt    *time.Time
+	CreatedAt time.Time
+}
+
+func (UserNotification) _Table() string { return "user_notifications" }
+
+type UserNotification_Create_Fields struct {
+	ReadAt UserNotification_ReadAt_Field
+}
+
+type UserNotification_Update_Fields struct {
+	ReadAt UserNotification_ReadAt_Field
+}
+
+type UserNotification_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func UserNotification_UserId(v []byte) UserNotification_UserId_Field {
+	return UserNotification_UserId_Field{_set: true, _value: v}
+}
+
+func (f UserNotification_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserNotification_UserId_Field) _Column() string { return "user_id" }
+
+type UserNotification_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func UserNotification_Id(v []byte) UserNotification_Id_Field {
+	return UserNotification_Id_Field{_set: true, _value: v}
+}
+
+func (f UserNotification_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserNotification_Id_Field) _Column() string { return "id" }
+
+type UserNotification_Kind_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func UserNotification_Kind(v string) UserNotification_Kind_Field {
+	return UserNotification_Kind_Field{_set: true, _value: v}
+}
+
+func (f UserNotification_Kind_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserNotification_Kind_Field) _Column() string { return "kind" }
+
+type UserNotification_Title_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func UserNotification_Title(v string) UserNotification_Title_Field {
+	return UserNotification_Title_Field{_set: true, _value: v}
+}
+
+func (f UserNotification_Title_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserNotification_Title_Field) _Column() string { return "title" }
+
+type UserNotification_Message_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func UserNotification_Message(v string) UserNotification_Message_Field {
+	return UserNotification_Message_Field{_set: true, _value: v}
+}
+
+func (f UserNotification_Message_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserNotification_Message_Field) _Column() string { return "message" }
+
+type UserNotification_ReadAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func UserNotification_ReadAt(v time.Time) UserNotification_ReadAt_Field {
+	return UserNotification_ReadAt_Field{_set: true, _value: &v}
+}
+
+func UserNotification_ReadAt_Raw(v *time.Time) UserNotification_ReadAt_Field {
+	if v == nil {
+		return UserNotification_ReadAt_Null()
+	}
+	return UserNotification_ReadAt(*v)
+}
+
+func UserNotification_ReadAt_Null() UserNotification_ReadAt_Field {
+	return UserNotification_ReadAt_Field{_set: true, _null: true}
+}
+
+func (f UserNotification_ReadAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f UserNotification_ReadAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserNotification_ReadAt_Field) _Column() string { return "read_at" }
+
+type UserNotification_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func UserNotification_CreatedAt(v time.Time) UserNotification_CreatedAt_Field {
+	return UserNotification_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f UserNotification_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserNotification_CreatedAt_Field) _Column() string { return "created_at" }
+
+type UserSettings struct {
+	UserId           []byte
+	SessionMinutes   *uint
+	PassphrasePrompt *bool
+	OnboardingStart  bool
+	OnboardingEnd    bool
+	OnboardingStep   *string
+}
+
+func (UserSettings) _Table() string { return "user_settings" }
+
+type UserSettings_Create_Fields struct {
+	SessionMinutes   UserSettings_SessionMinutes_Field
+	PassphrasePrompt UserSettings_PassphrasePrompt_Field
+	OnboardingStart  UserSettings_OnboardingStart_Field
+	OnboardingEnd    UserSettings_OnboardingEnd_Field
+	OnboardingStep   UserSettings_OnboardingStep_Field
+}
+
+type UserSettings_Update_Fields struct {
+	SessionMinutes   UserSettings_SessionMinutes_Field
+	PassphrasePrompt UserSettings_PassphrasePrompt_Field
+	OnboardingStart  UserSettings_OnboardingStart_Field
+	OnboardingEnd    UserSettings_OnboardingEnd_Field
+	OnboardingStep   UserSettings_OnboardingStep_Field
+}
+
+type UserSettings_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func UserSettings_UserId(v []byte) UserSettings_UserId_Field {
+	return UserSettings_UserId_Field{_set: true, _value: v}
+}
+
+func (f UserSettings_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserSettings_UserId_Field) _Column() string { return "user_id" }
+
+type UserSettings_SessionMinutes_Field struct {
+	_set   bool
+	_null  bool
+	_value *uint
+}
+
+func UserSettings_SessionMinutes(v uint) UserSettings_SessionMinutes_Field {
+	return UserSettings_SessionMinutes_Field{_set: true, _value: &v}
+}
+
+func UserSettings_SessionMinutes_Raw(v *uint) UserSettings_SessionMinutes_Field {
+	if v == nil {
+		return UserSettings_SessionMinutes_Null()
+	}
+	return UserSettings_SessionMinutes(*v)
+}
+
+func UserSettings_SessionMinutes_Null() UserSettings_SessionMinutes_Field {
+	return UserSettings_SessionMinutes_Field{_set: true, _null: true}
+}
+
+func (f UserSettings_SessionMinutes_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f UserSettings_SessionMinutes_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserSettings_SessionMinutes_Field) _Column() string { return "session_minutes" }
+
+type UserSettings_PassphrasePrompt_Field struct {
+	_set   bool
+	_null  bool
+	_value *bool
+}
+
+func UserSettings_PassphrasePrompt(v bool) UserSettings_PassphrasePrompt_Field {
+	return UserSettings_PassphrasePrompt_Field{_set: true, _value: &v}
+}
+
+func UserSettings_PassphrasePrompt_Raw(v *bool) UserSettings_PassphrasePrompt_Field {
+	if v == nil {
+		return UserSettings_PassphrasePrompt_Null()
+	}
+	return UserSettings_PassphrasePrompt(*v)
+}
+
+func UserSettings_PassphrasePrompt_Null() UserSettings_PassphrasePrompt_Field {
+	return UserSettings_PassphrasePrompt_Field{_set: true, _null: true}
+}
+
+func (f UserSettings_PassphrasePrompt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f UserSettings_PassphrasePrompt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserSettings_PassphrasePrompt_Field) _Column() string { return "passphrase_prompt" }
+
+type UserSettings_OnboardingStart_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func UserSettings_OnboardingStart(v bool) UserSettings_OnboardingStart_Field {
+	return UserSettings_OnboardingStart_Field{_set: true, _value: v}
+}
+
+func (f UserSettings_OnboardingStart_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserSettings_OnboardingStart_Field) _Column() string { return "onboarding_start" }
+
+type UserSettings_OnboardingEnd_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func UserSettings_OnboardingEnd(v bool) UserSettings_OnboardingEnd_Field {
+	return UserSettings_OnboardingEnd_Field{_set: true, _value: v}
+}
+
+func (f UserSettings_OnboardingEnd_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserSettings_OnboardingEnd_Field) _Column() string { return "onboarding_end" }
+
+type UserSettings_OnboardingStep_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func UserSettings_OnboardingStep(v string) UserSettings_OnboardingStep_Field {
+	return UserSettings_OnboardingStep_Field{_set: true, _value: &v}
+}
+
+func UserSettings_OnboardingStep_Raw(v *string) UserSettings_OnboardingStep_Field {
+	if v == nil {
+		return UserSettings_OnboardingStep_Null()
+	}
+	return UserSettings_OnboardingStep(*v)
+}
+
+func UserSettings_OnboardingStep_Null() UserSettings_OnboardingStep_Field {
+	return UserSettings_OnboardingStep_Field{_set: true, _null: true}
+}
+
+func (f UserSettings_OnboardingStep_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f UserSettings_OnboardingStep_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (UserSettings_OnboardingStep_Field) _Column() string { return "onboarding_step" }
+
+type ValueAttribution struct {
+	ProjectId   []byte
+	BucketName  []byte
+	PartnerId   []byte
+	UserAgent   []byte
+	LastUpdated time.Time
+}
+
+func (ValueAttribution) _Table() string { return "value_attributions" }
+
+type ValueAttribution_Create_Fields struct {
+	PartnerId ValueAttribution_PartnerId_Field
+	UserAgent ValueAttribution_UserAgent_Field
+}
+
+type ValueAttribution_Update_Fields struct {
+}
+
+type ValueAttribution_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ValueAttribution_ProjectId(v []byte) ValueAttribution_ProjectId_Field {
+	return ValueAttribution_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ValueAttribution_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ValueAttribution_ProjectId_Field) _Column() string { return "project_id" }
+
+type ValueAttribution_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ValueAttribution_BucketName(v []byte) ValueAttribution_BucketName_Field {
+	return ValueAttribution_BucketName_Field{_set: true, _value: v}
+}
+
+func (f ValueAttribution_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ValueAttribution_BucketName_Field) _Column() string { return "bucket_name" }
+
+type ValueAttribution_PartnerId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ValueAttribution_PartnerId(v []byte) ValueAttribution_PartnerId_Field {
+	return ValueAttribution_PartnerId_Field{_set: true, _value: v}
+}
+
+func ValueAttribution_PartnerId_Raw(v []byte) ValueAttribution_PartnerId_Field {
+	if v == nil {
+		return ValueAttribution_PartnerId_Null()
+	}
+	return ValueAttribution_PartnerId(v)
+}
+
+func ValueAttribution_PartnerId_Null() ValueAttribution_PartnerId_Field {
+	return ValueAttribution_PartnerId_Field{_set: true, _null: true}
+}
+
+func (f ValueAttribution_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f ValueAttribution_PartnerId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ValueAttribution_PartnerId_Field) _Column() string { return "partner_id" }
+
+type ValueAttribution_UserAgent_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ValueAttribution_UserAgent(v []byte) ValueAttribution_UserAgent_Field {
+	return ValueAttribution_UserAgent_Field{_set: true, _value: v}
+}
+
+func ValueAttribution_UserAgent_Raw(v []byte) ValueAttribution_UserAgent_Field {
+	if v == nil {
+		return ValueAttribution_UserAgent_Null()
+	}
+	return ValueAttribution_UserAgent(v)
+}
+
+func ValueAttribution_UserAgent_Null() ValueAttribution_UserAgent_Field {
+	return ValueAttribution_UserAgent_Field{_set: true, _null: true}
+}
+
+func (f ValueAttribution_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f ValueAttribution_UserAgent_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ValueAttribution_UserAgent_Field) _Column() string { return "user_agent" }
+
+type ValueAttribution_LastUpdated_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ValueAttribution_LastUpdated(v time.Time) ValueAttribution_LastUpdated_Field {
+	return ValueAttribution_LastUpdated_Field{_set: true, _value: v}
+}
+
+func (f ValueAttribution_LastUpdated_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ValueAttribution_LastUpdated_Field) _Column() string { return "last_updated" }
+
+type VerificationAudits struct {
+	InsertedAt    time.Time
+	StreamId      []byte
+	Position      uint64
+	ExpiresAt     *time.Time
+	EncryptedSize int
+}
+
+func (VerificationAudits) _Table() string { return "verification_audits" }
+
+type VerificationAudits_Create_Fields struct {
+	InsertedAt VerificationAudits_InsertedAt_Field
+	ExpiresAt  VerificationAudits_ExpiresAt_Field
+}
+
+type VerificationAudits_Update_Fields struct {
+}
+
+type VerificationAudits_InsertedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func VerificationAudits_InsertedAt(v time.Time) VerificationAudits_InsertedAt_Field {
+	return VerificationAudits_InsertedAt_Field{_set: true, _value: v}
+}
+
+func (f VerificationAudits_InsertedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (VerificationAudits_InsertedAt_Field) _Column() string { return "inserted_at" }
+
+type VerificationAudits_StreamId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func VerificationAudits_StreamId(v []byte) VerificationAudits_StreamId_Field {
+	return VerificationAudits_StreamId_Field{_set: true, _value: v}
+}
+
+func (f VerificationAudits_StreamId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (VerificationAudits_StreamId_Field) _Column() string { return "stream_id" }
+
+type VerificationAudits_Position_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func VerificationAudits_Position(v uint64) VerificationAudits_Position_Field {
+	return VerificationAudits_Position_Field{_set: true, _value: v}
+}
+
+func (f VerificationAudits_Position_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (VerificationAudits_Position_Field) _Column() string { return "position" }
+
+type VerificationAudits_ExpiresAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func VerificationAudits_ExpiresAt(v time.Time) VerificationAudits_ExpiresAt_Field {
+	return VerificationAudits_ExpiresAt_Field{_set: true, _value: &v}
+}
+
+func VerificationAudits_ExpiresAt_Raw(v *time.Time) VerificationAudits_ExpiresAt_Field {
+	if v == nil {
+		return VerificationAudits_ExpiresAt_Null()
+	}
+	return VerificationAudits_ExpiresAt(*v)
+}
+
+func VerificationAudits_ExpiresAt_Null() VerificationAudits_ExpiresAt_Field {
+	return VerificationAudits_ExpiresAt_Field{_set: true, _null: true}
+}
+
+func (f VerificationAudits_ExpiresAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f VerificationAudits_ExpiresAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (VerificationAudits_ExpiresAt_Field) _Column() string { return "expires_at" }
+
+type VerificationAudits_EncryptedSize_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func VerificationAudits_EncryptedSize(v int) VerificationAudits_EncryptedSize_Field {
+	return VerificationAudits_EncryptedSize_Field{_set: true, _value: v}
+}
+
+func (f VerificationAudits_EncryptedSize_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (VerificationAudits_EncryptedSize_Field) _Column() string { return "encrypted_size" }
+
+type WebappSession struct {
+	Id        []byte
+	UserId    []byte
+	IpAddress string
+	UserAgent string
+	Status    int
+	ExpiresAt time.Time
+}
+
+func (WebappSession) _Table() string { return "webapp_sessions" }
+
+type WebappSession_Update_Fields struct {
+	Status    WebappSession_Status_Field
+	ExpiresAt WebappSession_ExpiresAt_Field
+}
+
+type WebappSession_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func WebappSession_Id(v []byte) WebappSession_Id_Field {
+	return WebappSession_Id_Field{_set: true, _value: v}
+}
+
+func (f WebappSession_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (WebappSession_Id_Field) _Column() string { return "id" }
+
+type WebappSession_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func WebappSession_UserId(v []byte) WebappSession_UserId_Field {
+	return WebappSession_UserId_Field{_set: true, _value: v}
+}
+
+func (f WebappSession_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (WebappSession_UserId_Field) _Column() string { return "user_id" }
+
+type WebappSession_IpAddress_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func WebappSession_IpAddress(v string) WebappSession_IpAddress_Field {
+	return WebappSession_IpAddress_Field{_set: true, _value: v}
+}
+
+func (f WebappSession_IpAddress_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (WebappSession_IpAddress_Field) _Column() string { return "ip_address" }
+
+type WebappSession_UserAgent_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func WebappSession_UserAgent(v string) WebappSession_UserAgent_Field {
+	return WebappSession_UserAgent_Field{_set: true, _value: v}
+}
+
+func (f WebappSession_UserAgent_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (WebappSession_UserAgent_Field) _Column() string { return "user_agent" }
+
+type WebappSession_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func WebappSession_Status(v int) WebappSession_Status_Field {
+	return WebappSession_Status_Field{_set: true, _value: v}
+}
+
+func (f WebappSession_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (WebappSession_Status_Field) _Column() string { return "status" }
+
+type WebappSession_ExpiresAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func WebappSession_ExpiresAt(v time.Time) WebappSession_ExpiresAt_Field {
+	return WebappSession_ExpiresAt_Field{_set: true, _value: v}
+}
+
+func (f WebappSession_ExpiresAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (WebappSession_ExpiresAt_Field) _Column() string { return "expires_at" }
+
+type ApiKey struct {
+	Id        []byte
+	ProjectId []byte
+	Head      []byte
+	Name      string
+	Secret    []byte
+	PartnerId []byte
+	UserAgent []byte
+	CreatedAt time.Time
+}
+
+func (ApiKey) _Table() string { return "api_keys" }
+
+type ApiKey_Create_Fields struct {
+	PartnerId ApiKey_PartnerId_Field
+	UserAgent ApiKey_UserAgent_Field
+}
+
+type ApiKey_Update_Fields struct {
+	Name ApiKey_Name_Field
+}
+
+type ApiKey_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ApiKey_Id(v []byte) ApiKey_Id_Field {
+	return ApiKey_Id_Field{_set: true, _value: v}
+}
+
+func (f ApiKey_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_Id_Field) _Column() string { return "id" }
+
+type ApiKey_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ApiKey_ProjectId(v []byte) ApiKey_ProjectId_Field {
+	return ApiKey_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ApiKey_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_ProjectId_Field) _Column() string { return "project_id" }
+
+type ApiKey_Head_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ApiKey_Head(v []byte) ApiKey_Head_Field {
+	return ApiKey_Head_Field{_set: true, _value: v}
+}
+
+func (f ApiKey_Head_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_Head_Field) _Column() string { return "head" }
+
+type ApiKey_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ApiKey_Name(v string) ApiKey_Name_Field {
+	return ApiKey_Name_Field{_set: true, _value: v}
+}
+
+func (f ApiKey_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_Name_Field) _Column() string { return "name" }
+
+type ApiKey_Secret_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ApiKey_Secret(v []byte) ApiKey_Secret_Field {
+	return ApiKey_Secret_Field{_set: true, _value: v}
+}
+
+func (f ApiKey_Secret_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_Secret_Field) _Column() string { return "secret" }
+
+type ApiKey_PartnerId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ApiKey_PartnerId(v []byte) ApiKey_PartnerId_Field {
+	return ApiKey_PartnerId_Field{_set: true, _value: v}
+}
+
+func ApiKey_PartnerId_Raw(v []byte) ApiKey_PartnerId_Field {
+	if v == nil {
+		return ApiKey_PartnerId_Null()
+	}
+	return ApiKey_PartnerId(v)
+}
+
+func ApiKey_PartnerId_Null() ApiKey_PartnerId_Field {
+	return ApiKey_PartnerId_Field{_set: true, _null: true}
+}
+
+func (f ApiKey_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f ApiKey_PartnerId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_PartnerId_Field) _Column() string { return "partner_id" }
+
+type ApiKey_UserAgent_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ApiKey_UserAgent(v []byte) ApiKey_UserAgent_Field {
+	return ApiKey_UserAgent_Field{_set: true, _value: v}
+}
+
+func ApiKey_UserAgent_Raw(v []byte) ApiKey_UserAgent_Field {
+	if v == nil {
+		return ApiKey_UserAgent_Null()
+	}
+	return ApiKey_UserAgent(v)
+}
+
+func ApiKey_UserAgent_Null() ApiKey_UserAgent_Field {
+	return ApiKey_UserAgent_Field{_set: true, _null: true}
+}
+
+func (f ApiKey_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f ApiKey_UserAgent_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_UserAgent_Field) _Column() string { return "user_agent" }
+
+type ApiKey_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ApiKey_CreatedAt(v time.Time) ApiKey_CreatedAt_Field {
+	return ApiKey_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ApiKey_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_CreatedAt_Field) _Column() string { return "created_at" }
+
+type BucketMetainfo struct {
+	Id                              []byte
+	ProjectId                       []byte
+	Name                            []byte
+	PartnerId                       []byte
+	UserAgent                       []byte
+	PathCipher                      int
+	CreatedAt                       time.Time
+	DefaultSegmentSize              int
+	DefaultEncryptionCipherSuite    int
+	DefaultEncryptionBlockSize      int
+	DefaultRedundancyAlgorithm      int
+	DefaultRedundancyShareSize      int
+	DefaultRedundancyRequiredShares int
+	DefaultRedundancyRepairShares   int
+	DefaultRedundancyOptimalShares  int
+	DefaultRedundancyTotalShares    int
+	Placement                       *int
+}
+
+func (BucketMetainfo) _Table() string { return "bucket_metainfos" }
+
+type BucketMetainfo_Create_Fields struct {
+	PartnerId BucketMetainfo_PartnerId_Field
+	UserAgent BucketMetainfo_UserAgent_Field
+	Placement BucketMetainfo_Placement_Field
+}
+
+type BucketMetainfo_Update_Fields struct {
+	PartnerId                       BucketMetainfo_PartnerId_Field
+	UserAgent                       BucketMetainfo_UserAgent_Field
+	DefaultSegmentSize              BucketMetainfo_DefaultSegmentSize_Field
+	DefaultEncryptionCipherSuite    BucketMetainfo_DefaultEncryptionCipherSuite_Field
+	DefaultEncryptionBlockSize      BucketMetainfo_DefaultEncryptionBlockSize_Field
+	DefaultRedundancyAlgorithm      BucketMetainfo_DefaultRedundancyAlgorithm_Field
+	DefaultRedundancyShareSize      BucketMetainfo_DefaultRedundancyShareSize_Field
+	DefaultRedundancyRequiredShares BucketMetainfo_DefaultRedundancyRequiredShares_Field
+	DefaultRedundancyRepairShares   BucketMetainfo_DefaultRedundancyRepairShares_Field
+	DefaultRedundancyOptimalShares  BucketMetainfo_DefaultRedundancyOptimalShares_Field
+	DefaultRedundancyTotalShares    BucketMetainfo_DefaultRedundancyTotalShares_Field
+	Placement                       BucketMetainfo_Placement_Field
+}
+
+type BucketMetainfo_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketMetainfo_Id(v []byte) BucketMetainfo_Id_Field {
+	return BucketMetainfo_Id_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_Id_Field) _Column() string { return "id" }
+
+type BucketMetainfo_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketMetainfo_ProjectId(v []byte) BucketMetainfo_ProjectId_Field {
+	return BucketMetainfo_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_ProjectId_Field) _Column() string { return "project_id" }
+
+type BucketMetainfo_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketMetainfo_Name(v []byte) BucketMetainfo_Name_Field {
+	return BucketMetainfo_Name_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_Name_Field) _Column() string { return "name" }
+
+type BucketMetainfo_PartnerId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketMetainfo_PartnerId(v []byte) BucketMetainfo_PartnerId_Field {
+	return BucketMetainfo_PartnerId_Field{_set: true, _value: v}
+}
+
+func BucketMetainfo_PartnerId_Raw(v []byte) BucketMetainfo_PartnerId_Field {
+	if v == nil {
+		return BucketMetainfo_PartnerId_Null()
+	}
+	return BucketMetainfo_PartnerId(v)
+}
+
+func BucketMetainfo_PartnerId_Null() BucketMetainfo_PartnerId_Field {
+	return BucketMetainfo_PartnerId_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f BucketMetainfo_PartnerId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_PartnerId_Field) _Column() string { return "partner_id" }
+
+type BucketMetainfo_UserAgent_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketMetainfo_UserAgent(v []byte) BucketMetainfo_UserAgent_Field {
+	return BucketMetainfo_UserAgent_Field{_set: true, _value: v}
+}
+
+func BucketMetainfo_UserAgent_Raw(v []byte) BucketMetainfo_UserAgent_Field {
+	if v == nil {
+		return BucketMetainfo_UserAgent_Null()
+	}
+	return BucketMetainfo_UserAgent(v)
+}
+
+func BucketMetainfo_UserAgent_Null() BucketMetainfo_UserAgent_Field {
+	return BucketMetainfo_UserAgent_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f BucketMetainfo_UserAgent_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_UserAgent_Field) _Column() string { return "user_agent" }
+
+type BucketMetainfo_PathCipher_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_PathCipher(v int) BucketMetainfo_PathCipher_Field {
+	return BucketMetainfo_PathCipher_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_PathCipher_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_PathCipher_Field) _Column() string { return "path_cipher" }
+
+type BucketMetainfo_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BucketMetainfo_CreatedAt(v time.Time) BucketMetainfo_CreatedAt_Field {
+	return BucketMetainfo_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_CreatedAt_Field) _Column() string { return "created_at" }
+
+type BucketMetainfo_DefaultSegmentSize_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultSegmentSize(v int) BucketMetainfo_DefaultSegmentSize_Field {
+	return BucketMetainfo_DefaultSegmentSize_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultSegmentSize_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultSegmentSize_Field) _Column() string { return "default_segment_size" }
+
+type BucketMetainfo_DefaultEncryptionCipherSuite_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultEncryptionCipherSuite(v int) BucketMetainfo_DefaultEncryptionCipherSuite_Field {
+	return BucketMetainfo_DefaultEncryptionCipherSuite_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultEncryptionCipherSuite_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultEncryptionCipherSuite_Field) _Column() string {
+	return "default_encryption_cipher_suite"
+}
+
+type BucketMetainfo_DefaultEncryptionBlockSize_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultEncryptionBlockSize(v int) BucketMetainfo_DefaultEncryptionBlockSize_Field {
+	return BucketMetainfo_DefaultEncryptionBlockSize_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultEncryptionBlockSize_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultEncryptionBlockSize_Field) _Column() string {
+	return "default_encryption_block_size"
+}
+
+type BucketMetainfo_DefaultRedundancyAlgorithm_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultRedundancyAlgorithm(v int) BucketMetainfo_DefaultRedundancyAlgorithm_Field {
+	return BucketMetainfo_DefaultRedundancyAlgorithm_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyAlgorithm_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultRedundancyAlgorithm_Field) _Column() string {
+	return "default_redundancy_algorithm"
+}
+
+type BucketMetainfo_DefaultRedundancyShareSize_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultRedundancyShareSize(v int) BucketMetainfo_DefaultRedundancyShareSize_Field {
+	return BucketMetainfo_DefaultRedundancyShareSize_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyShareSize_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultRedundancyShareSize_Field) _Column() string {
+	return "default_redundancy_share_size"
+}
+
+type BucketMetainfo_DefaultRedundancyRequiredShares_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultRedundancyRequiredShares(v int) BucketMetainfo_DefaultRedundancyRequiredShares_Field {
+	return BucketMetainfo_DefaultRedundancyRequiredShares_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyRequiredShares_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultRedundancyRequiredShares_Field) _Column() string {
+	return "default_redundancy_required_shares"
+}
+
+type BucketMetainfo_DefaultRedundancyRepairShares_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultRedundancyRepairShares(v int) BucketMetainfo_DefaultRedundancyRepairShares_Field {
+	return BucketMetainfo_DefaultRedundancyRepairShares_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyRepairShares_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultRedundancyRepairShares_Field) _Column() string {
+	return "default_redundancy_repair_shares"
+}
+
+type BucketMetainfo_DefaultRedundancyOptimalShares_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultRedundancyOptimalShares(v int) BucketMetainfo_DefaultRedundancyOptimalShares_Field {
+	return BucketMetainfo_DefaultRedundancyOptimalShares_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyOptimalShares_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultRedundancyOptimalShares_Field) _Column() string {
+	return "default_redundancy_optimal_shares"
+}
+
+type BucketMetainfo_DefaultRedundancyTotalShares_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultRedundancyTotalShares(v int) BucketMetainfo_DefaultRedundancyTotalShares_Field {
+	return BucketMetainfo_DefaultRedundancyTotalShares_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyTotalShares_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultRedundancyTotalShares_Field) _Column() string {
+	return "default_redundancy_total_shares"
+}
+
+type BucketMetainfo_Placement_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func BucketMetainfo_Placement(v int) BucketMetainfo_Placement_Field {
+	return BucketMetainfo_Placement_Field{_set: true, _value: &v}
+}
+
+func BucketMetainfo_Placement_Raw(v *int) BucketMetainfo_Placement_Field {
+	if v == nil {
+		return BucketMetainfo_Placement_Null()
+	}
+	return BucketMetainfo_Placement(*v)
+}
+
+func BucketMetainfo_Placement_Null() BucketMetainfo_Placement_Field {
+	return BucketMetainfo_Placement_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_Placement_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f BucketMetainfo_Placement_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_Placement_Field) _Column() string { return "placement" }
+
+type ProjectInvitation struct {
+	ProjectId []byte
+	Email     string
+	CreatedAt time.Time
+}
+
+func (ProjectInvitation) _Table() string { return "project_invitations" }
+
+type ProjectInvitation_Update_Fields struct {
+}
+
+type ProjectInvitation_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectInvitation_ProjectId(v []byte) ProjectInvitation_ProjectId_Field {
+	return ProjectInvitation_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectInvitation_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectInvitation_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectInvitation_Email_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ProjectInvitation_Email(v string) ProjectInvitation_Email_Field {
+	return ProjectInvitation_Email_Field{_set: true, _value: v}
+}
+
+func (f ProjectInvitation_Email_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectInvitation_Email_Field) _Column() string { return "email" }
+
+type ProjectInvitation_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectInvitation_CreatedAt(v time.Time) ProjectInvitation_CreatedAt_Field {
+	return ProjectInvitation_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectInvitation_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectInvitation_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ProjectMember struct {
+	MemberId  []byte
+	ProjectId []byte
+	CreatedAt time.Time
+}
+
+func (ProjectMember) _Table() string { return "project_members" }
+
+type ProjectMember_Update_Fields struct {
+}
+
+type ProjectMember_MemberId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectMember_MemberId(v []byte) ProjectMember_MemberId_Field {
+	return ProjectMember_MemberId_Field{_set: true, _value: v}
+}
+
+func (f ProjectMember_MemberId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectMember_MemberId_Field) _Column() string { return "member_id" }
+
+type ProjectMember_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectMember_ProjectId(v []byte) ProjectMember_ProjectId_Field {
+	return ProjectMember_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectMember_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectMember_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectMember_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectMember_CreatedAt(v time.Time) ProjectMember_CreatedAt_Field {
+	return ProjectMember_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectMember_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectMember_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ProjectPlacementEntitlement struct {
+	ProjectId []byte
+	Placement int
+	IsDefault bool
+	CreatedAt time.Time
+}
+
+func (ProjectPlacementEntitlement) _Table() string { return "project_placement_entitlements" }
+
+type ProjectPlacementEntitlement_Create_Fields struct {
+	IsDefault ProjectPlacementEntitlement_IsDefault_Field
+}
+
+type ProjectPlacementEntitlement_Update_Fields struct {
+}
+
+type ProjectPlacementEntitlement_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectPlacementEntitlement_ProjectId(v []byte) ProjectPlacementEntitlement_ProjectId_Field {
+	return ProjectPlacementEntitlement_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectPlacementEntitlement_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectPlacementEntitlement_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectPlacementEntitlement_Placement_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func ProjectPlacementEntitlement_Placement(v int) ProjectPlacementEntitlement_Placement_Field {
+	return ProjectPlacementEntitlement_Placement_Field{_set: true, _value: v}
+}
+
+func (f ProjectPlacementEntitlement_Placement_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectPlacementEntitlement_Placement_Field) _Column() string { return "placement" }
+
+type ProjectPlacementEntitlement_IsDefault_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func ProjectPlacementEntitlement_IsDefault(v bool) ProjectPlacementEntitlement_IsDefault_Field {
+	return ProjectPlacementEntitlement_IsDefault_Field{_set: true, _value: v}
+}
+
+func (f ProjectPlacementEntitlement_IsDefault_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectPlacementEntitlement_IsDefault_Field) _Column() string { return "is_default" }
+
+type ProjectPlacementEntitlement_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectPlacementEntitlement_CreatedAt(v time.Time) ProjectPlacementEntitlement_CreatedAt_Field {
+	return ProjectPlacementEntitlement_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectPlacementEntitlement_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectPlacementEntitlement_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ProjectRootKey struct {
+	ProjectId    []byte
+	MasterKeyId  string
+	EncryptedKey []byte
+	CreatedBy    []byte
+	CreatedAt    time.Time
+}
+
+func (ProjectRootKey) _Table() string { return "project_root_keys" }
+
+type ProjectRootKey_Create_Fields struct {
+}
+
+type ProjectRootKey_Update_Fields struct {
+}
+
+type ProjectRootKey_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectRootKey_ProjectId(v []byte) ProjectRootKey_ProjectId_Field {
+	return ProjectRootKey_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectRootKey_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectRootKey_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectRootKey_MasterKeyId_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ProjectRootKey_MasterKeyId(v string) ProjectRootKey_MasterKeyId_Field {
+	return ProjectRootKey_MasterKeyId_Field{_set: true, _value: v}
+}
+
+func (f ProjectRootKey_MasterKeyId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectRootKey_MasterKeyId_Field) _Column() string { return "master_key_id" }
+
+type ProjectRootKey_EncryptedKey_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectRootKey_EncryptedKey(v []byte) ProjectRootKey_EncryptedKey_Field {
+	return ProjectRootKey_EncryptedKey_Field{_set: true, _value: v}
+}
+
+func (f ProjectRootKey_EncryptedKey_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectRootKey_EncryptedKey_Field) _Column() string { return "encrypted_key" }
+
+type ProjectRootKey_CreatedBy_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectRootKey_CreatedBy(v []byte) ProjectRootKey_CreatedBy_Field {
+	return ProjectRootKey_CreatedBy_Field{_set: true, _value: v}
+}
+
+func (f ProjectRootKey_CreatedBy_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectRootKey_CreatedBy_Field) _Column() string { return "created_by" }
+
+type ProjectRootKey_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectRootKey_CreatedAt(v time.Time) ProjectRootKey_CreatedAt_Field {
+	return ProjectRootKey_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectRootKey_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectRootKey_CreatedAt_Field) _Column() string { return "created_at" }
+
+type StripecoinpaymentsApplyBalanceIntent struct {
+	TxId      string
+	State     int
+	CreatedAt time.Time
+}
+
+func (StripecoinpaymentsApplyBalanceIntent) _Table() string {
+	return "stripecoinpayments_apply_balance_intents"
+}
+
+type StripecoinpaymentsApplyBalanceIntent_Update_Fields struct {
+	State StripecoinpaymentsApplyBalanceIntent_State_Field
+}
+
+type StripecoinpaymentsApplyBalanceIntent_TxId_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func StripecoinpaymentsApplyBalanceIntent_TxId(v string) StripecoinpaymentsApplyBalanceIntent_TxId_Field {
+	return StripecoinpaymentsApplyBalanceIntent_TxId_Field{_set: true, _value: v}
+}
+
+func (f StripecoinpaymentsApplyBalanceIntent_TxId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsApplyBalanceIntent_TxId_Field) _Column() string { return "tx_id" }
+
+type StripecoinpaymentsApplyBalanceIntent_State_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func StripecoinpaymentsApplyBalanceIntent_State(v int) StripecoinpaymentsApplyBalanceIntent_State_Field {
+	return StripecoinpaymentsApplyBalanceIntent_State_Field{_set: true, _value: v}
+}
+
+func (f StripecoinpaymentsApplyBalanceIntent_State_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsApplyBalanceIntent_State_Field) _Column() string { return "state" }
+
+type StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func StripecoinpaymentsApplyBalanceIntent_CreatedAt(v time.Time) StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field {
+	return StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field) _Column() string { return "created_at" }
+
+func toUTC(t time.Time) time.Time {
+	return t.UTC()
 }
 
 func toDate(t time.Time) time.Time {
@@ -19082,38 +24208,283 @@ func (obj *pgxImpl) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.C
 	deleted bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_members WHERE project_members.member_id = ? AND project_members.project_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_members WHERE project_members.member_id = ? AND project_members.project_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_member_member_id.value(), project_member_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxImpl) Delete_ProjectInvitation_By_ProjectId_And_Email(ctx context.Context,
+	project_invitation_project_id ProjectInvitation_ProjectId_Field,
+	project_invitation_email ProjectInvitation_Email_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_invitations WHERE project_invitations.project_id = ? AND project_invitations.email = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_invitation_project_id.value(), project_invitation_email.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxImpl) Delete_ApiKey_By_Id(ctx context.Context,
+	api_key_id ApiKey_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM api_keys WHERE api_keys.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, api_key_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxImpl) Delete_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxImpl) Delete_RepairQueue_By_UpdatedAt_Less(ctx context.Context,
+	repair_queue_updated_at_less RepairQueue_UpdatedAt_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM repair_queue WHERE repair_queue.updated_at < ?")
+
+	var __values []interface{}
+	__values = append(__values, repair_queue_updated_at_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *pgxImpl) Delete_User_By_Id(ctx context.Context,
+	user_id User_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM users WHERE users.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxImpl) Delete_WebappSession_By_Id(ctx context.Context,
+	webapp_session_id WebappSession_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM webapp_sessions WHERE webapp_sessions.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, webapp_session_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxImpl) Delete_WebappSession_By_UserId(ctx context.Context,
+	webapp_session_user_id WebappSession_UserId_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM webapp_sessions WHERE webapp_sessions.user_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, webapp_session_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *pgxImpl) Delete_ResetPasswordToken_By_Secret(ctx context.Context,
+	reset_password_token_secret ResetPasswordToken_Secret_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM reset_password_tokens WHERE reset_password_tokens.secret = ?")
+
+	var __values []interface{}
+	__values = append(__values, reset_password_token_secret.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxImpl) Delete_AccountFreezeEvent_By_UserId(ctx context.Context,
+	account_freeze_event_user_id AccountFreezeEvent_UserId_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM account_freeze_events WHERE account_freeze_events.user_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, project_member_member_id.value(), project_member_project_id.value())
+	__values = append(__values, account_freeze_event_user_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	__count, err := __res.RowsAffected()
+	count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	return __count > 0, nil
+	return count, nil
 
 }
 
-func (obj *pgxImpl) Delete_ProjectInvitation_By_ProjectId_And_Email(ctx context.Context,
-	project_invitation_project_id ProjectInvitation_ProjectId_Field,
-	project_invitation_email ProjectInvitation_Email_Field) (
+func (obj *pgxImpl) Delete_AccountFreezeEvent_By_UserId_And_Event(ctx context.Context,
+	account_freeze_event_user_id AccountFreezeEvent_UserId_Field,
+	account_freeze_event_event AccountFreezeEvent_Event_Field) (
 	deleted bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_invitations WHERE project_invitations.project_id = ? AND project_invitations.email = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM account_freeze_events WHERE account_freeze_events.user_id = ? AND account_freeze_events.event = ?")
 
 	var __values []interface{}
-	__values = append(__values, project_invitation_project_id.value(), project_invitation_email.value())
+	__values = append(__values, account_freeze_event_user_id.value(), account_freeze_event_event.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -19132,266 +24503,331 @@ func (obj *pgxImpl) Delete_ProjectInvitation_By_ProjectId_And_Email(ctx context.
 
 }
 
-func (obj *pgxImpl) Delete_ApiKey_By_Id(ctx context.Context,
-	api_key_id ApiKey_Id_Field) (
-	deleted bool, err error) {
+func (impl pgxImpl) isConstraintError(err error) (
+	constraint string, ok bool) {
+	if e, ok := err.(*pgconn.PgError); ok {
+		if e.Code[:2] == "23" {
+			return e.ConstraintName, true
+		}
+	}
+	return "", false
+}
+
+func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 	defer mon.Task()(&ctx)(&err)
+	var __res sql.Result
+	var __count int64
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_apply_balance_intents;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM api_keys WHERE api_keys.id = ?")
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_payment_transactions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __values []interface{}
-	__values = append(__values, api_key_id.value())
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_root_keys;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_placement_entitlements;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_members;")
+	if err != nil {
+		return 0, obj.makeErr(err)
 	}
 
-	__count, err := __res.RowsAffected()
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_invitations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
 	}
 
-	return __count > 0, nil
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_metainfos;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-}
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM api_keys;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-func (obj *pgxImpl) Delete_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
-	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
-	bucket_metainfo_name BucketMetainfo_Name_Field) (
-	deleted bool, err error) {
-	defer mon.Task()(&ctx)(&err)
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM webapp_sessions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM verification_audits;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __values []interface{}
-	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM value_attributions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM user_settings;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM user_notifications;")
+	if err != nil {
+		return 0, obj.makeErr(err)
 	}
 
-	__count, err := __res.RowsAffected()
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM user_agent_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
 	}
 
-	return __count > 0, nil
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM users;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-}
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_tx_conversion_rates;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-func (obj *pgxImpl) Delete_RepairQueue_By_UpdatedAt_Less(ctx context.Context,
-	repair_queue_updated_at_less RepairQueue_UpdatedAt_Field) (
-	count int64, err error) {
-	defer mon.Task()(&ctx)(&err)
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_invoice_project_records;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM repair_queue WHERE repair_queue.updated_at < ?")
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripe_customers;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __values []interface{}
-	__values = append(__values, repair_queue_updated_at_less.value())
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storjscan_wallets;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storjscan_payments;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_storage_tallies;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_rate_schedules;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
-
-	count, err = __res.RowsAffected()
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_paystubs;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
 
-	return count, nil
-
-}
-
-func (obj *pgxImpl) Delete_User_By_Id(ctx context.Context,
-	user_id User_Id_Field) (
-	deleted bool, err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM users WHERE users.id = ?")
-
-	var __values []interface{}
-	__values = append(__values, user_id.value())
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
-
-	__count, err := __res.RowsAffected()
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_payments;")
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	return __count > 0, nil
-
-}
-
-func (obj *pgxImpl) Delete_WebappSession_By_Id(ctx context.Context,
-	webapp_session_id WebappSession_Id_Field) (
-	deleted bool, err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM webapp_sessions WHERE webapp_sessions.id = ?")
-
-	var __values []interface{}
-	__values = append(__values, webapp_session_id.value())
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
-
-	__count, err := __res.RowsAffected()
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_held_releases;")
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	return __count > 0, nil
-
-}
-
-func (obj *pgxImpl) Delete_WebappSession_By_UserId(ctx context.Context,
-	webapp_session_user_id WebappSession_UserId_Field) (
-	count int64, err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM webapp_sessions WHERE webapp_sessions.user_id = ?")
-
-	var __values []interface{}
-	__values = append(__values, webapp_session_user_id.value())
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
-
-	count, err = __res.RowsAffected()
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollups_phase2;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
 
-	return count, nil
-
-}
-
-func (obj *pgxImpl) Delete_ResetPasswordToken_By_Secret(ctx context.Context,
-	reset_password_token_secret ResetPasswordToken_Secret_Field) (
-	deleted bool, err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM reset_password_tokens WHERE reset_password_tokens.secret = ?")
-
-	var __values []interface{}
-	__values = append(__values, reset_password_token_secret.value())
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
-
-	__count, err := __res.RowsAffected()
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollup_archives;")
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	return __count > 0, nil
-
-}
-
-func (obj *pgxImpl) Delete_AccountFreezeEvent_By_UserId(ctx context.Context,
-	account_freeze_event_user_id AccountFreezeEvent_UserId_Field) (
-	count int64, err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM account_freeze_events WHERE account_freeze_events.user_id = ?")
-
-	var __values []interface{}
-	__values = append(__values, account_freeze_event_user_id.value())
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
-
-	count, err = __res.RowsAffected()
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollups;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
 
-	return count, nil
-
-}
-
-func (obj *pgxImpl) Delete_AccountFreezeEvent_By_UserId_And_Event(ctx context.Context,
-	account_freeze_event_user_id AccountFreezeEvent_UserId_Field,
-	account_freeze_event_event AccountFreezeEvent_Event_Field) (
-	deleted bool, err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM account_freeze_events WHERE account_freeze_events.user_id = ? AND account_freeze_events.event = ?")
-
-	var __values []interface{}
-	__values = append(__values, account_freeze_event_user_id.value(), account_freeze_event_event.value())
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
-
-	__count, err := __res.RowsAffected()
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM segment_pending_audits;")
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	return __count > 0, nil
-
-}
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM satellite_outages;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-func (impl pgxImpl) isConstraintError(err error) (
-	constraint string, ok bool) {
-	if e, ok := err.(*pgconn.PgError); ok {
-		if e.Code[:2] == "23" {
-			return e.ConstraintName, true
-		}
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM satellite_heartbeats;")
+	if err != nil {
+		return 0, obj.makeErr(err)
 	}
-	return "", false
-}
 
-func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
-	defer mon.Task()(&ctx)(&err)
-	var __res sql.Result
-	var __count int64
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_apply_balance_intents;")
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM revocations;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19401,7 +24837,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_members;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reverification_audits;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19411,7 +24847,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_invitations;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reset_password_tokens;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19421,7 +24857,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_metainfos;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reputations;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19431,7 +24867,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM api_keys;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM repair_queue;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19441,7 +24877,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM webapp_sessions;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM registration_tokens;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19451,7 +24887,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM verification_audits;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM ranged_loop_leases;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19461,7 +24897,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM value_attributions;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_bandwidth_daily_rollups;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19471,7 +24907,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM user_settings;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_api_usage_rollups;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19481,7 +24917,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM users;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM projects;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19491,7 +24927,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_tx_conversion_rates;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM peer_identities;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19501,7 +24937,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_invoice_project_records;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_tokens;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19511,7 +24947,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripe_customers;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_codes;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19521,7 +24957,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storjscan_wallets;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_clients;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19531,7 +24967,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storjscan_payments;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_storage_estimates;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19541,7 +24977,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_storage_tallies;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_sla_reports;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19551,7 +24987,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_paystubs;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_quarantines;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19561,7 +24997,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_payments;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_message_recipients;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19571,7 +25007,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollups_phase2;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_message_acknowledgments;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19581,7 +25017,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollup_archives;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_messages;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19591,7 +25027,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollups;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_maintenance_windows;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19601,7 +25037,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM segment_pending_audits;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_events;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19611,7 +25047,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM revocations;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_corruption_events;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19621,7 +25057,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reverification_audits;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_capabilities;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19631,7 +25067,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reset_password_tokens;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_appeal_events;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19641,7 +25077,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reputations;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_appeals;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19651,7 +25087,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM repair_queue;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_api_versions;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19661,7 +25097,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM registration_tokens;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM nodes;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19671,7 +25107,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_bandwidth_daily_rollups;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_segment_transfer_queue;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19681,7 +25117,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM projects;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_progress;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19691,7 +25127,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM peer_identities;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM gateway_credentials;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19701,7 +25137,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_tokens;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM feature_flags;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19711,7 +25147,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_codes;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM email_deliveries;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19721,7 +25157,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_clients;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM coinpayments_transactions;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19731,7 +25167,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_events;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_storage_tallies;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19741,7 +25177,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_api_versions;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_notification_configs;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19751,7 +25187,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM nodes;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_metadata_history;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19761,7 +25197,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_segment_transfer_queue;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_inventories;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19771,7 +25207,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_progress;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_encryption_keys;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19781,7 +25217,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM coinpayments_transactions;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_bandwidth_rollup_archives;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19791,7 +25227,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_storage_tallies;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_bandwidth_rollups;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19801,7 +25237,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_bandwidth_rollup_archives;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM billing_transactions;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19811,7 +25247,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_bandwidth_rollups;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM billing_balances;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19821,7 +25257,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM billing_transactions;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM billing_addresses;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19831,7 +25267,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM billing_balances;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM accounting_timestamps;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19841,7 +25277,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM accounting_timestamps;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM accounting_rollups;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -19851,7 +25287,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM accounting_rollups;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM accounting_rollup_runs;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -26890,28 +32326,273 @@ func (obj *pgxcockroachImpl) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx
 
 	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
 	if err != nil {
-		return false, obj.makeErr(err)
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxcockroachImpl) Delete_ProjectInvitation_By_ProjectId_And_Email(ctx context.Context,
+	project_invitation_project_id ProjectInvitation_ProjectId_Field,
+	project_invitation_email ProjectInvitation_Email_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_invitations WHERE project_invitations.project_id = ? AND project_invitations.email = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_invitation_project_id.value(), project_invitation_email.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxcockroachImpl) Delete_ApiKey_By_Id(ctx context.Context,
+	api_key_id ApiKey_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM api_keys WHERE api_keys.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, api_key_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxcockroachImpl) Delete_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxcockroachImpl) Delete_RepairQueue_By_UpdatedAt_Less(ctx context.Context,
+	repair_queue_updated_at_less RepairQueue_UpdatedAt_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM repair_queue WHERE repair_queue.updated_at < ?")
+
+	var __values []interface{}
+	__values = append(__values, repair_queue_updated_at_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *pgxcockroachImpl) Delete_User_By_Id(ctx context.Context,
+	user_id User_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM users WHERE users.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxcockroachImpl) Delete_WebappSession_By_Id(ctx context.Context,
+	webapp_session_id WebappSession_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM webapp_sessions WHERE webapp_sessions.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, webapp_session_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxcockroachImpl) Delete_WebappSession_By_UserId(ctx context.Context,
+	webapp_session_user_id WebappSession_UserId_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM webapp_sessions WHERE webapp_sessions.user_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, webapp_session_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *pgxcockroachImpl) Delete_ResetPasswordToken_By_Secret(ctx context.Context,
+	reset_password_token_secret ResetPasswordToken_Secret_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM reset_password_tokens WHERE reset_password_tokens.secret = ?")
+
+	var __values []interface{}
+	__values = append(__values, reset_password_token_secret.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxcockroachImpl) Delete_AccountFreezeEvent_By_UserId(ctx context.Context,
+	account_freeze_event_user_id AccountFreezeEvent_UserId_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM account_freeze_events WHERE account_freeze_events.user_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, account_freeze_event_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
 	}
 
-	__count, err := __res.RowsAffected()
+	count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	return __count > 0, nil
+	return count, nil
 
 }
 
-func (obj *pgxcockroachImpl) Delete_ProjectInvitation_By_ProjectId_And_Email(ctx context.Context,
-	project_invitation_project_id ProjectInvitation_ProjectId_Field,
-	project_invitation_email ProjectInvitation_Email_Field) (
+func (obj *pgxcockroachImpl) Delete_AccountFreezeEvent_By_UserId_And_Event(ctx context.Context,
+	account_freeze_event_user_id AccountFreezeEvent_UserId_Field,
+	account_freeze_event_event AccountFreezeEvent_Event_Field) (
 	deleted bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_invitations WHERE project_invitations.project_id = ? AND project_invitations.email = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM account_freeze_events WHERE account_freeze_events.user_id = ? AND account_freeze_events.event = ?")
 
 	var __values []interface{}
-	__values = append(__values, project_invitation_project_id.value(), project_invitation_email.value())
+	__values = append(__values, account_freeze_event_user_id.value(), account_freeze_event_event.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -26930,266 +32611,311 @@ func (obj *pgxcockroachImpl) Delete_ProjectInvitation_By_ProjectId_And_Email(ctx
 
 }
 
-func (obj *pgxcockroachImpl) Delete_ApiKey_By_Id(ctx context.Context,
-	api_key_id ApiKey_Id_Field) (
-	deleted bool, err error) {
+func (impl pgxcockroachImpl) isConstraintError(err error) (
+	constraint string, ok bool) {
+	if e, ok := err.(*pgconn.PgError); ok {
+		if e.Code[:2] == "23" {
+			return e.ConstraintName, true
+		}
+	}
+	return "", false
+}
+
+func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err error) {
 	defer mon.Task()(&ctx)(&err)
+	var __res sql.Result
+	var __count int64
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_apply_balance_intents;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM api_keys WHERE api_keys.id = ?")
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_payment_transactions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __values []interface{}
-	__values = append(__values, api_key_id.value())
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_root_keys;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_placement_entitlements;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_members;")
+	if err != nil {
+		return 0, obj.makeErr(err)
 	}
 
-	__count, err := __res.RowsAffected()
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_invitations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
 	}
 
-	return __count > 0, nil
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_metainfos;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-}
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM api_keys;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-func (obj *pgxcockroachImpl) Delete_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
-	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
-	bucket_metainfo_name BucketMetainfo_Name_Field) (
-	deleted bool, err error) {
-	defer mon.Task()(&ctx)(&err)
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM webapp_sessions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM verification_audits;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __values []interface{}
-	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM value_attributions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM user_settings;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM user_notifications;")
+	if err != nil {
+		return 0, obj.makeErr(err)
 	}
 
-	__count, err := __res.RowsAffected()
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM user_agent_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
 	}
 
-	return __count > 0, nil
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM users;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-}
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_tx_conversion_rates;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-func (obj *pgxcockroachImpl) Delete_RepairQueue_By_UpdatedAt_Less(ctx context.Context,
-	repair_queue_updated_at_less RepairQueue_UpdatedAt_Field) (
-	count int64, err error) {
-	defer mon.Task()(&ctx)(&err)
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_invoice_project_records;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM repair_queue WHERE repair_queue.updated_at < ?")
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripe_customers;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __values []interface{}
-	__values = append(__values, repair_queue_updated_at_less.value())
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storjscan_wallets;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storjscan_payments;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_storage_tallies;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_rate_schedules;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_paystubs;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
 
-	count, err = __res.RowsAffected()
+	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
-
-	return count, nil
-
-}
-
-func (obj *pgxcockroachImpl) Delete_User_By_Id(ctx context.Context,
-	user_id User_Id_Field) (
-	deleted bool, err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM users WHERE users.id = ?")
-
-	var __values []interface{}
-	__values = append(__values, user_id.value())
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_payments;")
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	__count, err := __res.RowsAffected()
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
-
-	return __count > 0, nil
-
-}
-
-func (obj *pgxcockroachImpl) Delete_WebappSession_By_Id(ctx context.Context,
-	webapp_session_id WebappSession_Id_Field) (
-	deleted bool, err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM webapp_sessions WHERE webapp_sessions.id = ?")
-
-	var __values []interface{}
-	__values = append(__values, webapp_session_id.value())
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_held_releases;")
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	__count, err := __res.RowsAffected()
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
-
-	return __count > 0, nil
-
-}
-
-func (obj *pgxcockroachImpl) Delete_WebappSession_By_UserId(ctx context.Context,
-	webapp_session_user_id WebappSession_UserId_Field) (
-	count int64, err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM webapp_sessions WHERE webapp_sessions.user_id = ?")
-
-	var __values []interface{}
-	__values = append(__values, webapp_session_user_id.value())
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollups_phase2;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
 
-	count, err = __res.RowsAffected()
+	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
-
-	return count, nil
-
-}
-
-func (obj *pgxcockroachImpl) Delete_ResetPasswordToken_By_Secret(ctx context.Context,
-	reset_password_token_secret ResetPasswordToken_Secret_Field) (
-	deleted bool, err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM reset_password_tokens WHERE reset_password_tokens.secret = ?")
-
-	var __values []interface{}
-	__values = append(__values, reset_password_token_secret.value())
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollup_archives;")
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	__count, err := __res.RowsAffected()
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
-
-	return __count > 0, nil
-
-}
-
-func (obj *pgxcockroachImpl) Delete_AccountFreezeEvent_By_UserId(ctx context.Context,
-	account_freeze_event_user_id AccountFreezeEvent_UserId_Field) (
-	count int64, err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM account_freeze_events WHERE account_freeze_events.user_id = ?")
-
-	var __values []interface{}
-	__values = append(__values, account_freeze_event_user_id.value())
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollups;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
 
-	count, err = __res.RowsAffected()
+	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
-
-	return count, nil
-
-}
-
-func (obj *pgxcockroachImpl) Delete_AccountFreezeEvent_By_UserId_And_Event(ctx context.Context,
-	account_freeze_event_user_id AccountFreezeEvent_UserId_Field,
-	account_freeze_event_event AccountFreezeEvent_Event_Field) (
-	deleted bool, err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM account_freeze_events WHERE account_freeze_events.user_id = ? AND account_freeze_events.event = ?")
-
-	var __values []interface{}
-	__values = append(__values, account_freeze_event_user_id.value(), account_freeze_event_event.value())
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM segment_pending_audits;")
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	__count, err := __res.RowsAffected()
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
-	}
-
-	return __count > 0, nil
-
-}
-
-func (impl pgxcockroachImpl) isConstraintError(err error) (
-	constraint string, ok bool) {
-	if e, ok := err.(*pgconn.PgError); ok {
-		if e.Code[:2] == "23" {
-			return e.ConstraintName, true
-		}
+		return 0, obj.makeErr(err)
 	}
-	return "", false
-}
-
-func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err error) {
-	defer mon.Task()(&ctx)(&err)
-	var __res sql.Result
-	var __count int64
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_apply_balance_intents;")
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM satellite_outages;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27199,7 +32925,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_members;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM satellite_heartbeats;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27209,7 +32935,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_invitations;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM revocations;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27219,7 +32945,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_metainfos;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reverification_audits;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27229,7 +32955,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM api_keys;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reset_password_tokens;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27239,7 +32965,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM webapp_sessions;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reputations;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27249,7 +32975,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM verification_audits;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM repair_queue;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27259,7 +32985,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM value_attributions;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM registration_tokens;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27269,7 +32995,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM user_settings;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM ranged_loop_leases;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27279,7 +33005,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM users;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_bandwidth_daily_rollups;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27289,7 +33015,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_tx_conversion_rates;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_api_usage_rollups;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27299,7 +33025,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_invoice_project_records;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM projects;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27309,7 +33035,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripe_customers;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM peer_identities;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27319,7 +33045,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storjscan_wallets;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_tokens;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27329,7 +33055,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storjscan_payments;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_codes;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27339,7 +33065,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_storage_tallies;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_clients;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27349,7 +33075,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_paystubs;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_storage_estimates;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27359,7 +33085,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_payments;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_sla_reports;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27369,7 +33095,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollups_phase2;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_quarantines;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27379,7 +33105,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollup_archives;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_message_recipients;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27389,7 +33115,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollups;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_message_acknowledgments;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27399,7 +33125,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM segment_pending_audits;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_messages;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27409,7 +33135,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM revocations;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_maintenance_windows;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27419,7 +33145,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reverification_audits;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_events;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27429,7 +33155,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reset_password_tokens;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_corruption_events;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27439,7 +33165,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reputations;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_capabilities;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27449,7 +33175,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM repair_queue;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_appeal_events;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27459,7 +33185,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM registration_tokens;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_appeals;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27469,7 +33195,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_bandwidth_daily_rollups;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_api_versions;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27479,7 +33205,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM projects;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM nodes;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27489,7 +33215,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM peer_identities;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_segment_transfer_queue;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27499,7 +33225,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_tokens;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_progress;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27509,7 +33235,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_codes;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM gateway_credentials;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27519,7 +33245,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_clients;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM feature_flags;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27529,7 +33255,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_events;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM email_deliveries;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27539,7 +33265,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_api_versions;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM coinpayments_transactions;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27549,7 +33275,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM nodes;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_storage_tallies;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27559,7 +33285,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_segment_transfer_queue;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_notification_configs;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27569,7 +33295,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_progress;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_metadata_history;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27579,7 +33305,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM coinpayments_transactions;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_inventories;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27589,7 +33315,7 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_storage_tallies;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_encryption_keys;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -27634,6 +33360,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM billing_addresses;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -27654,6 +33390,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM accounting_rollup_runs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -0,0 +1,132 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/mailservice"
+)
+
+var _ mailservice.Deliveries = (*emailDeliveries)(nil)
+
+// defaultDeliveriesLimit is the number of deliveries returned by List when no limit is given.
+const defaultDeliveriesLimit = 100
+
+type emailDeliveries struct {
+	db *satelliteDB
+}
+
+// Insert adds a new delivery.
+func (deliveries *emailDeliveries) Insert(ctx context.Context, delivery mailservice.Delivery) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var reason sql.NullString
+	if delivery.Reason != "" {
+		reason = sql.NullString{String: delivery.Reason, Valid: true}
+	}
+
+	_, err = deliveries.db.ExecContext(ctx, deliveries.db.Rebind(`
+		INSERT INTO email_deliveries (
+			id, message_id, recipient, template, subject, status, reason, created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`), delivery.ID.Bytes(), delivery.MessageID, delivery.Recipient, delivery.Template, delivery.Subject,
+		int(delivery.Status), reason, delivery.CreatedAt, delivery.UpdatedAt)
+	return Error.Wrap(err)
+}
+
+// UpdateStatus updates the status of the deliveries of message to recipient.
+func (deliveries *emailDeliveries) UpdateStatus(ctx context.Context, messageID, recipient string, status mailservice.DeliveryStatus, reason string, updatedAt time.Time) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var reasonValue sql.NullString
+	if reason != "" {
+		reasonValue = sql.NullString{String: reason, Valid: true}
+	}
+
+	query := `UPDATE email_deliveries SET status = ?, reason = ?, updated_at = ? WHERE message_id = ?`
+	args := []interface{}{int(status), reasonValue, updatedAt, messageID}
+	if recipient != "" {
+		query += ` AND recipient = ?`
+		args = append(args, recipient)
+	}
+
+	result, err := deliveries.db.ExecContext(ctx, deliveries.db.Rebind(query), args...)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	count, err := result.RowsAffected()
+	return count, Error.Wrap(err)
+}
+
+// List returns deliveries matching filter, most recent first.
+func (deliveries *emailDeliveries) List(ctx context.Context, filter mailservice.DeliveryFilter) (_ []mailservice.Delivery, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var conditions []string
+	var args []interface{}
+	if filter.MessageID != "" {
+		conditions = append(conditions, "message_id = ?")
+		args = append(args, filter.MessageID)
+	}
+	if filter.Recipient != "" {
+		conditions = append(conditions, "recipient = ?")
+		args = append(args, filter.Recipient)
+	}
+	if len(filter.Statuses) > 0 {
+		placeholders := make([]string, len(filter.Statuses))
+		for i, status := range filter.Statuses {
+			placeholders[i] = "?"
+			args = append(args, int(status))
+		}
+		conditions = append(conditions, "status IN ("+strings.Join(placeholders, ", ")+")")
+	}
+	if !filter.Since.IsZero() {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, filter.Since)
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultDeliveriesLimit
+	}
+
+	query := `
+		SELECT id, message_id, recipient, template, subject, status, reason, created_at, updated_at
+		FROM email_deliveries`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY created_at DESC LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := deliveries.db.QueryContext(ctx, deliveries.db.Rebind(query), args...)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var result []mailservice.Delivery
+	for rows.Next() {
+		var delivery mailservice.Delivery
+		var status int
+		var reason sql.NullString
+		err := rows.Scan(&delivery.ID, &delivery.MessageID, &delivery.Recipient, &delivery.Template, &delivery.Subject,
+			&status, &reason, &delivery.CreatedAt, &delivery.UpdatedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		delivery.Status = mailservice.DeliveryStatus(status)
+		delivery.Reason = reason.String
+		result = append(result, delivery)
+	}
+
+	return result, Error.Wrap(rows.Err())
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestEmailDeliveries(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		deliveries := db.EmailDeliveries()
+
+		now := time.Now().Truncate(time.Second)
+		insert := func(messageID, recipient string, createdAt time.Time) {
+			require.NoError(t, deliveries.Insert(ctx, mailservice.Delivery{
+				ID:        testrand.UUID(),
+				MessageID: messageID,
+				Recipient: recipient,
+				Template:  "Forgot",
+				Subject:   "Password recovery request",
+				Status:    mailservice.DeliveryQueued,
+				CreatedAt: createdAt,
+				UpdatedAt: createdAt,
+			}))
+		}
+
+		insert("first@mail.test", "alice@mail.test", now.Add(-2*time.Hour))
+		insert("first@mail.test", "bob@mail.test", now.Add(-2*time.Hour))
+		insert("second@mail.test", "alice@mail.test", now.Add(-time.Hour))
+
+		// updating without recipient updates every recipient of the message.
+		updated, err := deliveries.UpdateStatus(ctx, "first@mail.test", "", mailservice.DeliverySent, "", now)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, updated)
+
+		updated, err = deliveries.UpdateStatus(ctx, "first@mail.test", "bob@mail.test", mailservice.DeliveryBounced, "mailbox does not exist", now)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, updated)
+
+		updated, err = deliveries.UpdateStatus(ctx, "unknown@mail.test", "", mailservice.DeliverySent, "", now)
+		require.NoError(t, err)
+		require.Zero(t, updated)
+
+		all, err := deliveries.List(ctx, mailservice.DeliveryFilter{})
+		require.NoError(t, err)
+		require.Len(t, all, 3)
+		require.Equal(t, "second@mail.test", all[0].MessageID)
+		require.Equal(t, mailservice.DeliveryQueued, all[0].Status)
+
+		alice, err := deliveries.List(ctx, mailservice.DeliveryFilter{Recipient: "alice@mail.test"})
+		require.NoError(t, err)
+		require.Len(t, alice, 2)
+
+		problems, err := deliveries.List(ctx, mailservice.DeliveryFilter{
+			Statuses: []mailservice.DeliveryStatus{mailservice.DeliveryFailed, mailservice.DeliveryBounced},
+		})
+		require.NoError(t, err)
+		require.Len(t, problems, 1)
+		require.Equal(t, "bob@mail.test", problems[0].Recipient)
+		require.Equal(t, "mailbox does not exist", problems[0].Reason)
+
+		recent, err := deliveries.List(ctx, mailservice.DeliveryFilter{Since: now.Add(-90 * time.Minute)})
+		require.NoError(t, err)
+		require.Len(t, recent, 1)
+
+		limited, err := deliveries.List(ctx, mailservice.DeliveryFilter{Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, limited, 1)
+	})
+}
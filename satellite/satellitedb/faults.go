@@ -0,0 +1,201 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"sync"
+
+	"storj.io/private/tagsql"
+)
+
+// queryFaults injects errors into the queries of the database, so tests can check how
+// the services handle database failures.
+type queryFaults struct {
+	mu    sync.RWMutex
+	fault func(ctx context.Context, query string) error
+}
+
+// set sets the func, which decides the error of every query. A nil fault disables the
+// injection.
+func (faults *queryFaults) set(fault func(ctx context.Context, query string) error) {
+	faults.mu.Lock()
+	defer faults.mu.Unlock()
+	faults.fault = fault
+}
+
+// check returns the error, which the query should fail with.
+func (faults *queryFaults) check(ctx context.Context, query string) error {
+	faults.mu.RLock()
+	fault := faults.fault
+	faults.mu.RUnlock()
+
+	if fault == nil {
+		return nil
+	}
+	return fault(ctx, query)
+}
+
+// wrap returns db, which injects the faults into its queries.
+func (faults *queryFaults) wrap(db tagsql.DB) tagsql.DB {
+	return &faultDB{DB: db, faults: faults}
+}
+
+// beginQuery is the query checked, when a transaction is started.
+const beginQuery = "BEGIN"
+
+// faultDB is a tagsql.DB, which injects the faults into its queries.
+type faultDB struct {
+	tagsql.DB
+	faults *queryFaults
+}
+
+// Begin starts a transaction, which injects the faults into its queries.
+func (db *faultDB) Begin(ctx context.Context) (tagsql.Tx, error) {
+	if err := db.faults.check(ctx, beginQuery); err != nil {
+		return nil, err
+	}
+	tx, err := db.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &faultTx{Tx: tx, faults: db.faults}, nil
+}
+
+// BeginTx starts a transaction, which injects the faults into its queries.
+func (db *faultDB) BeginTx(ctx context.Context, txOptions *sql.TxOptions) (tagsql.Tx, error) {
+	if err := db.faults.check(ctx, beginQuery); err != nil {
+		return nil, err
+	}
+	tx, err := db.DB.BeginTx(ctx, txOptions)
+	if err != nil {
+		return nil, err
+	}
+	return &faultTx{Tx: tx, faults: db.faults}, nil
+}
+
+// Exec executes the query, unless it should fail.
+func (db *faultDB) Exec(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if err := db.faults.check(ctx, query); err != nil {
+		return nil, err
+	}
+	return db.DB.Exec(ctx, query, args...)
+}
+
+// ExecContext executes the query, unless it should fail.
+func (db *faultDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if err := db.faults.check(ctx, query); err != nil {
+		return nil, err
+	}
+	return db.DB.ExecContext(ctx, query, args...)
+}
+
+// Query executes the query, unless it should fail.
+func (db *faultDB) Query(ctx context.Context, query string, args ...interface{}) (tagsql.Rows, error) {
+	if err := db.faults.check(ctx, query); err != nil {
+		return nil, err
+	}
+	return db.DB.Query(ctx, query, args...)
+}
+
+// QueryContext executes the query, unless it should fail.
+func (db *faultDB) QueryContext(ctx context.Context, query string, args ...interface{}) (tagsql.Rows, error) {
+	if err := db.faults.check(ctx, query); err != nil {
+		return nil, err
+	}
+	return db.DB.QueryContext(ctx, query, args...)
+}
+
+// QueryRow executes the query, unless it should fail.
+func (db *faultDB) QueryRow(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if err := db.faults.check(ctx, query); err != nil {
+		return failedRow(ctx, err)
+	}
+	return db.DB.QueryRow(ctx, query, args...)
+}
+
+// QueryRowContext executes the query, unless it should fail.
+func (db *faultDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if err := db.faults.check(ctx, query); err != nil {
+		return failedRow(ctx, err)
+	}
+	return db.DB.QueryRowContext(ctx, query, args...)
+}
+
+// faultTx is a tagsql.Tx, which injects the faults into its queries.
+type faultTx struct {
+	tagsql.Tx
+	faults *queryFaults
+}
+
+// Exec executes the query, unless it should fail.
+func (tx *faultTx) Exec(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if err := tx.faults.check(ctx, query); err != nil {
+		return nil, err
+	}
+	return tx.Tx.Exec(ctx, query, args...)
+}
+
+// ExecContext executes the query, unless it should fail.
+func (tx *faultTx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if err := tx.faults.check(ctx, query); err != nil {
+		return nil, err
+	}
+	return tx.Tx.ExecContext(ctx, query, args...)
+}
+
+// Query executes the query, unless it should fail.
+func (tx *faultTx) Query(ctx context.Context, query string, args ...interface{}) (tagsql.Rows, error) {
+	if err := tx.faults.check(ctx, query); err != nil {
+		return nil, err
+	}
+	return tx.Tx.Query(ctx, query, args...)
+}
+
+// QueryContext executes the query, unless it should fail.
+func (tx *faultTx) QueryContext(ctx context.Context, query string, args ...interface{}) (tagsql.Rows, error) {
+	if err := tx.faults.check(ctx, query); err != nil {
+		return nil, err
+	}
+	return tx.Tx.QueryContext(ctx, query, args...)
+}
+
+// QueryRow executes the query, unless it should fail.
+func (tx *faultTx) QueryRow(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if err := tx.faults.check(ctx, query); err != nil {
+		return failedRow(ctx, err)
+	}
+	return tx.Tx.QueryRow(ctx, query, args...)
+}
+
+// QueryRowContext executes the query, unless it should fail.
+func (tx *faultTx) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if err := tx.faults.check(ctx, query); err != nil {
+		return failedRow(ctx, err)
+	}
+	return tx.Tx.QueryRowContext(ctx, query, args...)
+}
+
+// failedRow returns a row, which fails with err when scanned. sql.Row can't be created
+// outside of database/sql, so the row is queried from a database, which fails to connect.
+func failedRow(ctx context.Context, err error) *sql.Row {
+	db := sql.OpenDB(failingConnector{err: err})
+	defer func() { _ = db.Close() }()
+	return db.QueryRowContext(ctx, beginQuery)
+}
+
+// failingConnector is a driver.Connector, which fails to connect with err.
+type failingConnector struct {
+	err error
+}
+
+// Connect returns the error of the connector.
+func (connector failingConnector) Connect(context.Context) (driver.Conn, error) {
+	return nil, connector.err
+}
+
+// Driver isn't used by database/sql for connectors.
+func (connector failingConnector) Driver() driver.Driver { return nil }
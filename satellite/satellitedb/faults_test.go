@@ -0,0 +1,73 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/private/tagsql"
+)
+
+func TestQueryFaults(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	rawDB, err := tagsql.Open(ctx, "sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer ctx.Check(rawDB.Close)
+
+	faults := &queryFaults{}
+	db := faults.wrap(rawDB)
+
+	_, err = db.ExecContext(ctx, "CREATE TABLE example (id INTEGER)")
+	require.NoError(t, err)
+
+	injected := errors.New("injected")
+	faults.set(func(ctx context.Context, query string) error {
+		if strings.Contains(query, "example") {
+			return injected
+		}
+		return nil
+	})
+
+	_, err = db.ExecContext(ctx, "INSERT INTO example VALUES (1)")
+	require.ErrorIs(t, err, injected)
+
+	_, err = db.QueryContext(ctx, "SELECT id FROM example")
+	require.ErrorIs(t, err, injected)
+
+	var id int
+	err = db.QueryRowContext(ctx, "SELECT id FROM example").Scan(&id)
+	require.ErrorIs(t, err, injected)
+
+	// the queries, which don't match, still run.
+	var one int
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT 1").Scan(&one))
+	require.Equal(t, 1, one)
+
+	tx, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	_, err = tx.ExecContext(ctx, "INSERT INTO example VALUES (1)")
+	require.ErrorIs(t, err, injected)
+	require.NoError(t, tx.Rollback())
+
+	faults.set(func(ctx context.Context, query string) error {
+		if query == beginQuery {
+			return injected
+		}
+		return nil
+	})
+	_, err = db.BeginTx(ctx, nil)
+	require.ErrorIs(t, err, injected)
+
+	faults.set(nil)
+	_, err = db.ExecContext(ctx, "INSERT INTO example VALUES (1)")
+	require.NoError(t, err)
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/featureflags"
+)
+
+var _ featureflags.DB = (*featureFlags)(nil)
+
+type featureFlags struct {
+	db *satelliteDB
+}
+
+// Upsert creates or replaces the flag. The creation time of an existing flag is kept.
+func (db *featureFlags) Upsert(ctx context.Context, flag featureflags.Flag) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO feature_flags (name, description, enabled, percentage, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (name) DO UPDATE SET
+			description = EXCLUDED.description,
+			enabled     = EXCLUDED.enabled,
+			percentage  = EXCLUDED.percentage,
+			updated_at  = EXCLUDED.updated_at
+	`, flag.Name, flag.Description, flag.Enabled, flag.Percentage, flag.CreatedAt.UTC(), flag.UpdatedAt.UTC())
+	return Error.Wrap(err)
+}
+
+// Get returns the flag.
+func (db *featureFlags) Get(ctx context.Context, name string) (_ featureflags.Flag, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var flag featureflags.Flag
+	err = db.db.QueryRowContext(ctx, `
+		SELECT name, description, enabled, percentage, created_at, updated_at
+		FROM feature_flags
+		WHERE name = $1
+	`, name).Scan(&flag.Name, &flag.Description, &flag.Enabled, &flag.Percentage, &flag.CreatedAt, &flag.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return featureflags.Flag{}, featureflags.ErrNotFound.New("%s", name)
+	}
+	if err != nil {
+		return featureflags.Flag{}, Error.Wrap(err)
+	}
+	return flag, nil
+}
+
+// List returns all flags ordered by name.
+func (db *featureFlags) List(ctx context.Context) (_ []featureflags.Flag, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT name, description, enabled, percentage, created_at, updated_at
+		FROM feature_flags
+		ORDER BY name
+	`)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var flags []featureflags.Flag
+	for rows.Next() {
+		var flag featureflags.Flag
+		err := rows.Scan(&flag.Name, &flag.Description, &flag.Enabled, &flag.Percentage, &flag.CreatedAt, &flag.UpdatedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		flags = append(flags, flag)
+	}
+	return flags, Error.Wrap(rows.Err())
+}
+
+// Delete deletes the flag.
+func (db *featureFlags) Delete(ctx context.Context, name string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := db.db.ExecContext(ctx, `DELETE FROM feature_flags WHERE name = $1`, name)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if deleted == 0 {
+		return featureflags.ErrNotFound.New("%s", name)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/featureflags"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestFeatureFlags(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		flags := db.FeatureFlags()
+
+		_, err := flags.Get(ctx, "missing")
+		require.True(t, featureflags.ErrNotFound.Has(err))
+		require.True(t, featureflags.ErrNotFound.Has(flags.Delete(ctx, "missing")))
+
+		createdAt := time.Now().Truncate(time.Microsecond)
+		listing := featureflags.Flag{
+			Name:        "listing-query",
+			Description: "new listing query",
+			Enabled:     true,
+			Percentage:  10,
+			CreatedAt:   createdAt,
+			UpdatedAt:   createdAt,
+		}
+		selection := featureflags.Flag{
+			Name:       "node-selection",
+			CreatedAt:  createdAt,
+			UpdatedAt:  createdAt,
+			Percentage: 100,
+		}
+		require.NoError(t, flags.Upsert(ctx, selection))
+		require.NoError(t, flags.Upsert(ctx, listing))
+
+		// the update keeps the creation time.
+		updatedAt := createdAt.Add(time.Hour)
+		listing.Percentage = 50
+		listing.UpdatedAt = updatedAt
+		require.NoError(t, flags.Upsert(ctx, featureflags.Flag{
+			Name:        listing.Name,
+			Description: listing.Description,
+			Enabled:     listing.Enabled,
+			Percentage:  listing.Percentage,
+			CreatedAt:   updatedAt,
+			UpdatedAt:   updatedAt,
+		}))
+
+		flag, err := flags.Get(ctx, listing.Name)
+		require.NoError(t, err)
+		require.Equal(t, listing.Description, flag.Description)
+		require.Equal(t, 50, flag.Percentage)
+		require.True(t, flag.Enabled)
+		require.WithinDuration(t, createdAt, flag.CreatedAt, time.Microsecond)
+		require.WithinDuration(t, updatedAt, flag.UpdatedAt, time.Microsecond)
+
+		list, err := flags.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, listing.Name, list[0].Name)
+		require.Equal(t, selection.Name, list[1].Name)
+
+		require.NoError(t, flags.Delete(ctx, listing.Name))
+		list, err = flags.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+	})
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/storj/satellite/console"
+)
+
+// Ensure that gatewayCredentials implements console.GatewayCredentials.
+var _ console.GatewayCredentials = (*gatewayCredentials)(nil)
+
+// gatewayCredentials is an implementation of console.GatewayCredentials.
+type gatewayCredentials struct {
+	db *satelliteDB
+}
+
+// Create stores a new gateway credential.
+func (credentials *gatewayCredentials) Create(ctx context.Context, credential console.GatewayCredential) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = credentials.db.ExecContext(ctx, credentials.db.Rebind(`
+		INSERT INTO gateway_credentials (
+			id, project_id, api_key_id, name, access_key_id, endpoint, tail, created_by, created_at
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`), credential.ID, credential.ProjectID, credential.APIKeyID, credential.Name, credential.AccessKeyID,
+		credential.Endpoint, credential.Tail, credential.CreatedBy, credential.CreatedAt)
+	return err
+}
+
+// Get returns the gateway credential, which isn't revoked.
+func (credentials *gatewayCredentials) Get(ctx context.Context, id uuid.UUID) (_ *console.GatewayCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := credentials.db.QueryRowContext(ctx, credentials.db.Rebind(`
+		SELECT id, project_id, api_key_id, name, access_key_id, endpoint, tail, created_by, last_used_at, created_at
+		FROM gateway_credentials
+		WHERE id = ? AND revoked_at IS NULL
+	`), id)
+
+	credential, err := scanGatewayCredential(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, console.ErrGatewayCredentialNotFound.New("%s", id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &credential, nil
+}
+
+// ListActive returns the gateway credentials of the project, which aren't revoked, the newest first.
+func (credentials *gatewayCredentials) ListActive(ctx context.Context, projectID uuid.UUID) (_ []console.GatewayCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := credentials.db.QueryContext(ctx, credentials.db.Rebind(`
+		SELECT id, project_id, api_key_id, name, access_key_id, endpoint, tail, created_by, last_used_at, created_at
+		FROM gateway_credentials
+		WHERE project_id = ? AND revoked_at IS NULL
+		ORDER BY created_at DESC, id
+	`), projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var list []console.GatewayCredential
+	for rows.Next() {
+		credential, err := scanGatewayCredential(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, credential)
+	}
+	return list, rows.Err()
+}
+
+// Revoke marks the gateway credential as revoked.
+func (credentials *gatewayCredentials) Revoke(ctx context.Context, id uuid.UUID, revokedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := credentials.db.ExecContext(ctx, credentials.db.Rebind(`
+		UPDATE gateway_credentials
+		SET revoked_at = ?
+		WHERE id = ? AND revoked_at IS NULL
+	`), revokedAt, id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return console.ErrGatewayCredentialNotFound.New("%s", id)
+	}
+	return nil
+}
+
+// UpdateLastUsed updates the time the gateway credentials with the macaroon tails were last used.
+func (credentials *gatewayCredentials) UpdateLastUsed(ctx context.Context, tails [][]byte, usedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(tails) == 0 {
+		return nil
+	}
+
+	_, err = credentials.db.ExecContext(ctx, `
+		UPDATE gateway_credentials
+		SET last_used_at = $2
+		WHERE tail = ANY($1)
+			AND revoked_at IS NULL
+			AND (last_used_at IS NULL OR last_used_at < $2)
+	`, pgutil.ByteaArray(tails), usedAt)
+	return err
+}
+
+// scanGatewayCredential reads a gateway credential from a row.
+func scanGatewayCredential(row interface{ Scan(...interface{}) error }) (credential console.GatewayCredential, err error) {
+	var lastUsedAt sql.NullTime
+	err = row.Scan(&credential.ID, &credential.ProjectID, &credential.APIKeyID, &credential.Name, &credential.AccessKeyID,
+		&credential.Endpoint, &credential.Tail, &credential.CreatedBy, &lastUsedAt, &credential.CreatedAt)
+	if err != nil {
+		return console.GatewayCredential{}, err
+	}
+	if lastUsedAt.Valid {
+		credential.LastUsedAt = &lastUsedAt.Time
+	}
+	return credential, nil
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestGatewayCredentials(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		credentials := db.Console().GatewayCredentials()
+		now := time.Now().Truncate(time.Second).UTC()
+		projectID := testrand.UUID()
+
+		first := console.GatewayCredential{
+			ID:          testrand.UUID(),
+			ProjectID:   projectID,
+			APIKeyID:    testrand.UUID(),
+			Name:        "first",
+			AccessKeyID: "access1",
+			Endpoint:    "https://gateway.example.test",
+			Tail:        testrand.BytesInt(32),
+			CreatedBy:   testrand.UUID(),
+			CreatedAt:   now.Add(-time.Hour),
+		}
+		second := first
+		second.ID = testrand.UUID()
+		second.Name = "second"
+		second.AccessKeyID = "access2"
+		second.Tail = testrand.BytesInt(32)
+		second.CreatedAt = now
+
+		require.NoError(t, credentials.Create(ctx, first))
+		require.NoError(t, credentials.Create(ctx, second))
+
+		credential, err := credentials.Get(ctx, first.ID)
+		require.NoError(t, err)
+		require.Equal(t, first.Name, credential.Name)
+		require.Equal(t, first.Tail, credential.Tail)
+		require.Nil(t, credential.LastUsedAt)
+
+		_, err = credentials.Get(ctx, testrand.UUID())
+		require.True(t, console.ErrGatewayCredentialNotFound.Has(err))
+
+		list, err := credentials.ListActive(ctx, projectID)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, second.ID, list[0].ID)
+		require.Equal(t, first.ID, list[1].ID)
+
+		require.NoError(t, credentials.UpdateLastUsed(ctx, [][]byte{first.Tail, testrand.BytesInt(32)}, now))
+		// an older use doesn't overwrite a newer one.
+		require.NoError(t, credentials.UpdateLastUsed(ctx, [][]byte{first.Tail}, now.Add(-time.Minute)))
+
+		credential, err = credentials.Get(ctx, first.ID)
+		require.NoError(t, err)
+		require.NotNil(t, credential.LastUsedAt)
+		require.True(t, now.Equal(*credential.LastUsedAt))
+
+		require.NoError(t, credentials.Revoke(ctx, first.ID, now))
+		err = credentials.Revoke(ctx, first.ID, now)
+		require.True(t, console.ErrGatewayCredentialNotFound.Has(err))
+
+		_, err = credentials.Get(ctx, first.ID)
+		require.True(t, console.ErrGatewayCredentialNotFound.Has(err))
+
+		list, err = credentials.ListActive(ctx, projectID)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, second.ID, list[0].ID)
+	})
+}
@@ -13,9 +13,12 @@ import (
 
 	"storj.io/private/dbutil"
 	"storj.io/private/dbutil/cockroachutil"
+	"storj.io/private/dbutil/dbschema"
 	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/dbutil/tempdb"
 	"storj.io/private/tagsql"
 	"storj.io/storj/private/migrate"
+	"storj.io/storj/satellite"
 )
 
 //go:generate go run migrate_gen.go
@@ -142,6 +145,57 @@ func (db *satelliteDB) CheckVersion(ctx context.Context) error {
 	}
 }
 
+// DryRunMigration reports the pending migration steps without running them. When the
+// database is up to date, it compares its schema with the dbx schema.
+func (db *satelliteDB) DryRunMigration(ctx context.Context) (_ satellite.MigrationReport, err error) {
+	report := satellite.MigrationReport{Database: db.name}
+
+	switch db.impl {
+	case dbutil.Postgres, dbutil.Cockroach:
+	default:
+		return report, nil
+	}
+
+	report.Pending, err = db.ProductionMigration().PendingSteps(ctx)
+	if err != nil {
+		return report, ErrMigrate.Wrap(err)
+	}
+	if len(report.Pending) > 0 {
+		return report, nil
+	}
+
+	expected, err := db.dbxSchema(ctx)
+	if err != nil {
+		return report, ErrMigrate.Wrap(err)
+	}
+
+	actual, err := pgutil.QuerySchema(ctx, db.DB)
+	if err != nil {
+		return report, ErrMigrate.Wrap(err)
+	}
+	// we don't care about the versions table, it's not part of the dbx schema.
+	actual.DropTable("versions")
+
+	report.DriftChecked = true
+	report.Drift = migrate.DiffSchemas(expected, actual)
+	return report, nil
+}
+
+// dbxSchema loads the dbx schema into a temporary schema or database and queries it.
+func (db *satelliteDB) dbxSchema(ctx context.Context) (_ *dbschema.Schema, err error) {
+	tempDB, err := tempdb.OpenUnique(ctx, db.source, "schema-drift")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, tempDB.Close()) }()
+
+	if _, err := tempDB.ExecContext(ctx, db.Schema()); err != nil {
+		return nil, err
+	}
+
+	return pgutil.QuerySchema(ctx, tempDB)
+}
+
 // TestMigration returns steps needed for migrating test postgres database.
 func (db *satelliteDB) TestMigration() *migrate.Migration {
 	return db.testMigration()
@@ -2355,6 +2409,489 @@ func (db *satelliteDB) ProductionMigration() *migrate.Migration {
 					`CREATE INDEX projects_owner_id_index ON projects ( owner_id )`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create email_deliveries table",
+				Version:     235,
+				Action: migrate.SQL{
+					`CREATE TABLE email_deliveries (
+						id bytea NOT NULL,
+						message_id text NOT NULL,
+						recipient text NOT NULL,
+						template text NOT NULL,
+						subject text NOT NULL,
+						status integer NOT NULL,
+						reason text,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX email_deliveries_message_id_index ON email_deliveries ( message_id );`,
+					`CREATE INDEX email_deliveries_recipient_created_at_index ON email_deliveries ( recipient, created_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create node_sla_reports table",
+				Version:     236,
+				Action: migrate.SQL{
+					`CREATE TABLE node_sla_reports (
+						period timestamp with time zone NOT NULL,
+						node_id bytea NOT NULL,
+						wallet text NOT NULL,
+						windows integer NOT NULL,
+						online_score double precision NOT NULL,
+						compliant boolean NOT NULL,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( period, node_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create storagenode_rate_schedules table",
+				Version:     237,
+				Action: migrate.SQL{
+					`CREATE TABLE storagenode_rate_schedules (
+						period text NOT NULL,
+						version integer NOT NULL,
+						at_rest_gb_hours text NOT NULL,
+						get_tb text NOT NULL,
+						put_tb text NOT NULL,
+						get_repair_tb text NOT NULL,
+						put_repair_tb text NOT NULL,
+						get_audit_tb text NOT NULL,
+						note text NOT NULL,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( period, version )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create storagenode_payment_transactions table",
+				Version:     238,
+				Action: migrate.SQL{
+					`CREATE TABLE storagenode_payment_transactions (
+						payment_id bigint NOT NULL REFERENCES storagenode_payments( id ) ON DELETE CASCADE,
+						chain text NOT NULL,
+						tx_hash bytea NOT NULL,
+						layer2 boolean NOT NULL,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( payment_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create storagenode_held_releases table",
+				Version:     239,
+				Action: migrate.SQL{
+					`CREATE TABLE storagenode_held_releases (
+						node_id bytea NOT NULL,
+						period text NOT NULL,
+						amount bigint NOT NULL,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( node_id, period )
+					);`,
+					`CREATE INDEX storagenode_held_releases_period_index ON storagenode_held_releases ( period );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create node_capabilities table",
+				Version:     240,
+				Action: migrate.SQL{
+					`CREATE TABLE node_capabilities (
+						node_id bytea NOT NULL,
+						hash_algorithms text NOT NULL,
+						tcp_fast_open boolean NOT NULL,
+						noise boolean NOT NULL,
+						max_piece_size bigint NOT NULL,
+						updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( node_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create project_placement_entitlements table",
+				Version:     241,
+				Action: migrate.SQL{
+					`CREATE TABLE project_placement_entitlements (
+						project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+						placement integer NOT NULL,
+						is_default boolean NOT NULL DEFAULT false,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, placement )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create bucket_encryption_keys table",
+				Version:     242,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_encryption_keys (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						master_key_id text NOT NULL,
+						encrypted_key bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create bucket_inventories table",
+				Version:     243,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_inventories (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						destination_bucket bytea NOT NULL,
+						destination_prefix text NOT NULL,
+						format text NOT NULL,
+						frequency text NOT NULL,
+						destination_access text NOT NULL,
+						last_delivered_at timestamp with time zone,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create bucket_notification_configs table",
+				Version:     244,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_notification_configs (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						configuration bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create node_storage_estimates table",
+				Version:     245,
+				Action: migrate.SQL{
+					`CREATE TABLE node_storage_estimates (
+						node_id bytea NOT NULL,
+						piece_count bigint NOT NULL,
+						stored_bytes bigint NOT NULL,
+						settled_bytes bigint NOT NULL DEFAULT 0,
+						estimated_at timestamp with time zone NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( node_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create ranged_loop_leases table",
+				Version:     246,
+				Action: migrate.SQL{
+					`CREATE TABLE ranged_loop_leases (
+						name text NOT NULL,
+						owner bytea NOT NULL,
+						token bigint NOT NULL,
+						expires_at timestamp with time zone NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( name )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create satellite_heartbeats and satellite_outages tables",
+				Version:     247,
+				Action: migrate.SQL{
+					`CREATE TABLE satellite_heartbeats (
+						name text NOT NULL,
+						beat_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( name )
+					);`,
+					`CREATE TABLE satellite_outages (
+						start_at timestamp with time zone NOT NULL,
+						end_at timestamp with time zone NOT NULL,
+						token bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( start_at )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create node_appeals and node_appeal_events tables",
+				Version:     248,
+				Action: migrate.SQL{
+					`CREATE TABLE node_appeals (
+						id bytea NOT NULL,
+						node_id bytea NOT NULL,
+						kind integer NOT NULL,
+						status integer NOT NULL,
+						message text NOT NULL,
+						reputation bytea NOT NULL,
+						review_note text,
+						reviewed_by text,
+						reviewed_at timestamp with time zone,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX node_appeals_node_id_created_at_index ON node_appeals ( node_id, created_at );`,
+					`CREATE TABLE node_appeal_events (
+						id bytea NOT NULL,
+						appeal_id bytea NOT NULL,
+						node_id bytea NOT NULL,
+						action text NOT NULL,
+						actor text NOT NULL,
+						note text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX node_appeal_events_appeal_id_index ON node_appeal_events ( appeal_id );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create gateway_credentials table",
+				Version:     249,
+				Action: migrate.SQL{
+					`CREATE TABLE gateway_credentials (
+						id bytea NOT NULL,
+						project_id bytea NOT NULL,
+						api_key_id bytea NOT NULL,
+						name text NOT NULL,
+						access_key_id text NOT NULL,
+						endpoint text NOT NULL,
+						tail bytea NOT NULL,
+						created_by bytea NOT NULL,
+						last_used_at timestamp with time zone,
+						revoked_at timestamp with time zone,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX gateway_credentials_project_id_index ON gateway_credentials ( project_id );`,
+					`CREATE INDEX gateway_credentials_tail_index ON gateway_credentials ( tail );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create node_corruption_events and node_quarantines tables",
+				Version:     250,
+				Action: migrate.SQL{
+					`CREATE TABLE node_corruption_events (
+						id bytea NOT NULL,
+						node_id bytea NOT NULL,
+						source text NOT NULL,
+						count integer NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX node_corruption_events_created_at_index ON node_corruption_events ( created_at );`,
+					`CREATE TABLE node_quarantines (
+						node_id bytea NOT NULL,
+						quarantined_at timestamp with time zone NOT NULL,
+						score double precision NOT NULL,
+						reason text NOT NULL,
+						released_at timestamp with time zone,
+						PRIMARY KEY ( node_id, quarantined_at )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create node_messages, node_message_acknowledgments and node_message_recipients tables",
+				Version:     251,
+				Action: migrate.SQL{
+					`CREATE TABLE node_messages (
+						id bytea NOT NULL,
+						kind text NOT NULL,
+						title text NOT NULL,
+						body text NOT NULL,
+						targeted boolean NOT NULL,
+						expires_at timestamp with time zone,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE TABLE node_message_acknowledgments (
+						message_id bytea NOT NULL,
+						node_id bytea NOT NULL,
+						acknowledged_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( message_id, node_id )
+					);`,
+					`CREATE TABLE node_message_recipients (
+						message_id bytea NOT NULL,
+						node_id bytea NOT NULL,
+						PRIMARY KEY ( message_id, node_id )
+					);`,
+					`CREATE INDEX node_message_recipients_node_id_index ON node_message_recipients ( node_id );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create accounting_rollup_runs table",
+				Version:     252,
+				Action: migrate.SQL{
+					`CREATE TABLE accounting_rollup_runs (
+						id bytea NOT NULL,
+						chore text NOT NULL,
+						status text NOT NULL,
+						period_start timestamp with time zone NOT NULL,
+						period_end timestamp with time zone NOT NULL,
+						started_at timestamp with time zone NOT NULL,
+						finished_at timestamp with time zone,
+						repair_claimed_at timestamp with time zone,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX accounting_rollup_runs_chore_status_index ON accounting_rollup_runs ( chore, status );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create user_agent_rollups table",
+				Version:     253,
+				Action: migrate.SQL{
+					`CREATE TABLE user_agent_rollups (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						product text NOT NULL,
+						version text NOT NULL,
+						interval_day date NOT NULL,
+						upload_count bigint NOT NULL,
+						upload_bytes bigint NOT NULL,
+						download_count bigint NOT NULL,
+						download_bytes bigint NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name, product, version, interval_day )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create feature_flags table",
+				Version:     254,
+				Action: migrate.SQL{
+					`CREATE TABLE feature_flags (
+						name text NOT NULL,
+						description text NOT NULL,
+						enabled boolean NOT NULL,
+						percentage integer NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( name )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create project_root_keys table",
+				Version:     255,
+				Action: migrate.SQL{
+					`CREATE TABLE project_root_keys (
+						project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+						master_key_id text NOT NULL,
+						encrypted_key bytea NOT NULL,
+						created_by bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create project_api_usage_rollups table",
+				Version:     256,
+				Action: migrate.SQL{
+					`CREATE TABLE project_api_usage_rollups (
+						project_id bytea NOT NULL,
+						interval_start timestamp with time zone NOT NULL,
+						operation text NOT NULL,
+						count bigint NOT NULL,
+						PRIMARY KEY ( project_id, interval_start, operation )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create billing_addresses table",
+				Version:     257,
+				Action: migrate.SQL{
+					`CREATE TABLE billing_addresses (
+						user_id bytea NOT NULL,
+						name text NOT NULL,
+						line1 text NOT NULL,
+						line2 text NOT NULL,
+						city text NOT NULL,
+						postal_code text NOT NULL,
+						state text NOT NULL,
+						country text NOT NULL,
+						tax_id text NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( user_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create user_notifications table",
+				Version:     258,
+				Action: migrate.SQL{
+					`CREATE TABLE user_notifications (
+						user_id bytea NOT NULL,
+						id bytea NOT NULL,
+						kind text NOT NULL,
+						title text NOT NULL,
+						message text NOT NULL,
+						read_at timestamp with time zone,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( user_id, id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create bucket_metadata_history table",
+				Version:     259,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_metadata_history (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						version bigint NOT NULL,
+						bucket_id bytea NOT NULL,
+						default_encryption_cipher_suite integer NOT NULL,
+						default_encryption_block_size integer NOT NULL,
+						versioning integer NOT NULL,
+						object_lock_enabled boolean NOT NULL,
+						deleted boolean NOT NULL,
+						changed_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name, version )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create node_maintenance_windows table",
+				Version:     260,
+				Action: migrate.SQL{
+					`CREATE TABLE node_maintenance_windows (
+						id bytea NOT NULL,
+						node_id bytea NOT NULL,
+						starts_at timestamp with time zone NOT NULL,
+						ends_at timestamp with time zone NOT NULL,
+						reason text NOT NULL,
+						offline_audits bigint NOT NULL DEFAULT 0,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX node_maintenance_windows_node_id_ends_at_index ON node_maintenance_windows ( node_id, ends_at );`,
+				},
+			},
 			// NB: after updating testdata in `testdata`, run
 			//     `go generate` to update `migratez.go`.
 		},
@@ -13,7 +13,7 @@ func (db *satelliteDB) testMigration() *migrate.Migration {
 			{
 				DB:          &db.migrationDB,
 				Description: "Testing setup",
-				Version:     234,
+				Version:     260,
 				Action: migrate.SQL{`-- AUTOGENERATED BY storj.io/dbx
 -- DO NOT EDIT
 CREATE TABLE account_freeze_events (
@@ -23,6 +23,17 @@ CREATE TABLE account_freeze_events (
 	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	PRIMARY KEY ( user_id, event )
 );
+CREATE TABLE accounting_rollup_runs (
+	id bytea NOT NULL,
+	chore text NOT NULL,
+	status text NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	period_end timestamp with time zone NOT NULL,
+	started_at timestamp with time zone NOT NULL,
+	finished_at timestamp with time zone,
+	repair_claimed_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE accounting_rollups (
 	node_id bytea NOT NULL,
 	start_time timestamp with time zone NOT NULL,
@@ -40,6 +51,19 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE billing_addresses (
+	user_id bytea NOT NULL,
+	name text NOT NULL,
+	line1 text NOT NULL,
+	line2 text NOT NULL,
+	city text NOT NULL,
+	postal_code text NOT NULL,
+	state text NOT NULL,
+	country text NOT NULL,
+	tax_id text NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( user_id )
+);
 CREATE TABLE billing_balances (
 	user_id bytea NOT NULL,
 	balance bigint NOT NULL,
@@ -82,6 +106,47 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_encryption_keys (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	master_key_id text NOT NULL,
+	encrypted_key bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_inventories (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	destination_bucket bytea NOT NULL,
+	destination_prefix text NOT NULL,
+	format text NOT NULL,
+	frequency text NOT NULL,
+	destination_access text NOT NULL,
+	last_delivered_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_metadata_history (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	version bigint NOT NULL,
+	bucket_id bytea NOT NULL,
+	default_encryption_cipher_suite integer NOT NULL,
+	default_encryption_block_size integer NOT NULL,
+	versioning integer NOT NULL,
+	object_lock_enabled boolean NOT NULL,
+	deleted boolean NOT NULL,
+	changed_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, version )
+);
+CREATE TABLE bucket_notification_configs (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	configuration bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -108,6 +173,41 @@ CREATE TABLE coinpayments_transactions (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE email_deliveries (
+	id bytea NOT NULL,
+	message_id text NOT NULL,
+	recipient text NOT NULL,
+	template text NOT NULL,
+	subject text NOT NULL,
+	status integer NOT NULL,
+	reason text,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE feature_flags (
+	name text NOT NULL,
+	description text NOT NULL,
+	enabled boolean NOT NULL,
+	percentage integer NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( name )
+);
+CREATE TABLE gateway_credentials (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	api_key_id bytea NOT NULL,
+	name text NOT NULL,
+	access_key_id text NOT NULL,
+	endpoint text NOT NULL,
+	tail bytea NOT NULL,
+	created_by bytea NOT NULL,
+	last_used_at timestamp with time zone,
+	revoked_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -174,6 +274,46 @@ CREATE TABLE nodes (
 	debounce_limit int NOT NULL DEFAULT 0,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_appeals (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	kind integer NOT NULL,
+	status integer NOT NULL,
+	message text NOT NULL,
+	reputation bytea NOT NULL,
+	review_note text,
+	reviewed_by text,
+	reviewed_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_appeal_events (
+	id bytea NOT NULL,
+	appeal_id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	action text NOT NULL,
+	actor text NOT NULL,
+	note text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_capabilities (
+	node_id bytea NOT NULL,
+	hash_algorithms text NOT NULL,
+	tcp_fast_open boolean NOT NULL,
+	noise boolean NOT NULL,
+	max_piece_size bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE node_corruption_events (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	source text NOT NULL,
+	count integer NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE node_events (
 	id bytea NOT NULL,
 	email text NOT NULL,
@@ -191,6 +331,64 @@ CREATE TABLE node_api_versions (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_maintenance_windows (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	starts_at timestamp with time zone NOT NULL,
+	ends_at timestamp with time zone NOT NULL,
+	reason text NOT NULL,
+	offline_audits bigint NOT NULL DEFAULT 0,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_messages (
+	id bytea NOT NULL,
+	kind text NOT NULL,
+	title text NOT NULL,
+	body text NOT NULL,
+	targeted boolean NOT NULL,
+	expires_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_message_acknowledgments (
+	message_id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	acknowledged_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( message_id, node_id )
+);
+CREATE TABLE node_message_recipients (
+	message_id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	PRIMARY KEY ( message_id, node_id )
+);
+CREATE TABLE node_quarantines (
+	node_id bytea NOT NULL,
+	quarantined_at timestamp with time zone NOT NULL,
+	score double precision NOT NULL,
+	reason text NOT NULL,
+	released_at timestamp with time zone,
+	PRIMARY KEY ( node_id, quarantined_at )
+);
+CREATE TABLE node_sla_reports (
+	period timestamp with time zone NOT NULL,
+	node_id bytea NOT NULL,
+	wallet text NOT NULL,
+	windows integer NOT NULL,
+	online_score double precision NOT NULL,
+	compliant boolean NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( period, node_id )
+);
+CREATE TABLE node_storage_estimates (
+	node_id bytea NOT NULL,
+	piece_count bigint NOT NULL,
+	stored_bytes bigint NOT NULL,
+	settled_bytes bigint NOT NULL DEFAULT 0,
+	estimated_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE oauth_clients (
 	id bytea NOT NULL,
 	encrypted_secret bytea NOT NULL,
@@ -250,6 +448,13 @@ CREATE TABLE projects (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE project_api_usage_rollups (
+	project_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	operation text NOT NULL,
+	count bigint NOT NULL,
+	PRIMARY KEY ( project_id, interval_start, operation )
+);
 CREATE TABLE project_bandwidth_daily_rollups (
 	project_id bytea NOT NULL,
 	interval_day date NOT NULL,
@@ -258,6 +463,14 @@ CREATE TABLE project_bandwidth_daily_rollups (
 	egress_dead bigint NOT NULL DEFAULT 0,
 	PRIMARY KEY ( project_id, interval_day )
 );
+CREATE TABLE ranged_loop_leases (
+	name text NOT NULL,
+	owner bytea NOT NULL,
+	token bigint NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( name )
+);
 CREATE TABLE registration_tokens (
 	secret bytea NOT NULL,
 	owner_id bytea,
@@ -317,6 +530,18 @@ CREATE TABLE revocations (
 	api_key_id bytea NOT NULL,
 	PRIMARY KEY ( revoked )
 );
+CREATE TABLE satellite_heartbeats (
+	name text NOT NULL,
+	beat_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( name )
+);
+CREATE TABLE satellite_outages (
+	start_at timestamp with time zone NOT NULL,
+	end_at timestamp with time zone NOT NULL,
+	token bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( start_at )
+);
 CREATE TABLE segment_pending_audits (
 	node_id bytea NOT NULL,
 	stream_id bytea NOT NULL,
@@ -355,6 +580,13 @@ CREATE TABLE storagenode_bandwidth_rollups_phase2 (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( storagenode_id, interval_start, action )
 );
+CREATE TABLE storagenode_held_releases (
+	node_id bytea NOT NULL,
+	period text NOT NULL,
+	amount bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( node_id, period )
+);
 CREATE TABLE storagenode_payments (
 	id bigserial NOT NULL,
 	created_at timestamp with time zone NOT NULL,
@@ -390,6 +622,19 @@ CREATE TABLE storagenode_paystubs (
 	distributed bigint NOT NULL,
 	PRIMARY KEY ( period, node_id )
 );
+CREATE TABLE storagenode_rate_schedules (
+	period text NOT NULL,
+	version integer NOT NULL,
+	at_rest_gb_hours text NOT NULL,
+	get_tb text NOT NULL,
+	put_tb text NOT NULL,
+	get_repair_tb text NOT NULL,
+	put_repair_tb text NOT NULL,
+	get_audit_tb text NOT NULL,
+	note text NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( period, version )
+);
 CREATE TABLE storagenode_storage_tallies (
 	node_id bytea NOT NULL,
 	interval_end_time timestamp with time zone NOT NULL,
@@ -478,6 +723,28 @@ CREATE TABLE users (
 	signup_captcha double precision,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE user_agent_rollups (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	product text NOT NULL,
+	version text NOT NULL,
+	interval_day date NOT NULL,
+	upload_count bigint NOT NULL,
+	upload_bytes bigint NOT NULL,
+	download_count bigint NOT NULL,
+	download_bytes bigint NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, product, version, interval_day )
+);
+CREATE TABLE user_notifications (
+	user_id bytea NOT NULL,
+	id bytea NOT NULL,
+	kind text NOT NULL,
+	title text NOT NULL,
+	message text NOT NULL,
+	read_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( user_id, id )
+);
 CREATE TABLE user_settings (
 	user_id bytea NOT NULL,
 	session_minutes integer,
@@ -558,26 +825,59 @@ CREATE TABLE project_members (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( member_id, project_id )
 );
+CREATE TABLE project_placement_entitlements (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	placement integer NOT NULL,
+	is_default boolean NOT NULL DEFAULT false,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, placement )
+);
+CREATE TABLE project_root_keys (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	master_key_id text NOT NULL,
+	encrypted_key bytea NOT NULL,
+	created_by bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE storagenode_payment_transactions (
+	payment_id bigint NOT NULL REFERENCES storagenode_payments( id ) ON DELETE CASCADE,
+	chain text NOT NULL,
+	tx_hash bytea NOT NULL,
+	layer2 boolean NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( payment_id )
+);
 CREATE TABLE stripecoinpayments_apply_balance_intents (
 	tx_id text NOT NULL REFERENCES coinpayments_transactions( id ) ON DELETE CASCADE,
 	state integer NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( tx_id )
 );
+CREATE INDEX accounting_rollup_runs_chore_status_index ON accounting_rollup_runs ( chore, status ) ;
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time ) ;
 CREATE INDEX billing_transactions_timestamp_index ON billing_transactions ( timestamp ) ;
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket_bandwidth_rollups ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index ON bucket_bandwidth_rollup_archives ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_action_interval_project_id_index ON bucket_bandwidth_rollup_archives ( action, interval_start, project_id ) ;
+CREATE INDEX email_deliveries_message_id_index ON email_deliveries ( message_id ) ;
+CREATE INDEX email_deliveries_recipient_created_at_index ON email_deliveries ( recipient, created_at ) ;
 CREATE INDEX project_bandwidth_daily_rollup_interval_day_index ON project_bandwidth_daily_rollups ( interval_day ) ;
 CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_storage_tallies ( project_id, interval_start ) ;
+CREATE INDEX gateway_credentials_project_id_index ON gateway_credentials ( project_id ) ;
+CREATE INDEX gateway_credentials_tail_index ON gateway_credentials ( tail ) ;
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at ) ;
 CREATE INDEX node_last_ip ON nodes ( last_net ) ;
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success ) ;
 CREATE INDEX nodes_type_last_cont_success_free_disk_ma_mi_patch_vetted_partial_index ON nodes ( type, last_contact_success, free_disk, major, minor, patch, vetted_at ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true AND nodes.last_net != '' ;
 CREATE INDEX nodes_dis_unk_aud_exit_init_rel_type_last_cont_success_stored_index ON nodes ( disqualified, unknown_audit_suspended, exit_initiated_at, release, type, last_contact_success ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true ;
+CREATE INDEX node_appeals_node_id_created_at_index ON node_appeals ( node_id, created_at ) ;
+CREATE INDEX node_appeal_events_appeal_id_index ON node_appeal_events ( appeal_id ) ;
+CREATE INDEX node_corruption_events_created_at_index ON node_corruption_events ( created_at ) ;
 CREATE INDEX node_events_email_event_created_at_index ON node_events ( email, event, created_at ) WHERE node_events.email_sent is NULL ;
+CREATE INDEX node_maintenance_windows_node_id_ends_at_index ON node_maintenance_windows ( node_id, ends_at ) ;
+CREATE INDEX node_message_recipients_node_id_index ON node_message_recipients ( node_id ) ;
 CREATE INDEX oauth_clients_user_id_index ON oauth_clients ( user_id ) ;
 CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id ) ;
 CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
@@ -590,6 +890,7 @@ CREATE INDEX repair_queue_num_healthy_pieces_attempted_at_index ON repair_queue
 CREATE INDEX reverification_audits_inserted_at_index ON reverification_audits ( inserted_at ) ;
 CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start ) ;
 CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start ) ;
+CREATE INDEX storagenode_held_releases_period_index ON storagenode_held_releases ( period ) ;
 CREATE INDEX storagenode_payments_node_id_period_index ON storagenode_payments ( node_id, period ) ;
 CREATE INDEX storagenode_paystubs_node_id_index ON storagenode_paystubs ( node_id ) ;
 CREATE INDEX storagenode_storage_tallies_node_id_index ON storagenode_storage_tallies ( node_id ) ;
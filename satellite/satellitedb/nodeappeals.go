@@ -0,0 +1,228 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/appeals"
+)
+
+var _ appeals.DB = (*nodeAppeals)(nil)
+
+type nodeAppeals struct {
+	db *satelliteDB
+}
+
+// Insert inserts a new appeal.
+func (db *nodeAppeals) Insert(ctx context.Context, appeal appeals.Appeal) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reputation, err := json.Marshal(appeal.Reputation)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO node_appeals (id, node_id, kind, status, message, reputation, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, appeal.ID.Bytes(), appeal.NodeID.Bytes(), int(appeal.Kind), int(appeal.Status),
+		appeal.Message, reputation, appeal.CreatedAt.UTC())
+	return Error.Wrap(err)
+}
+
+// Get returns the appeal.
+func (db *nodeAppeals) Get(ctx context.Context, id uuid.UUID) (_ appeals.Appeal, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	appeal, err := scanNodeAppeal(db.db.QueryRowContext(ctx, `
+		SELECT `+nodeAppealColumns+`
+		FROM node_appeals
+		WHERE id = $1
+	`, id.Bytes()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return appeals.Appeal{}, appeals.ErrNotFound.New("%s", id)
+	}
+	return appeal, Error.Wrap(err)
+}
+
+// ListByNode returns the appeals of the node, the newest first.
+func (db *nodeAppeals) ListByNode(ctx context.Context, nodeID storj.NodeID) (_ []appeals.Appeal, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT `+nodeAppealColumns+`
+		FROM node_appeals
+		WHERE node_id = $1
+		ORDER BY created_at DESC
+	`, nodeID.Bytes())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var list []appeals.Appeal
+	for rows.Next() {
+		appeal, err := scanNodeAppeal(rows)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		list = append(list, appeal)
+	}
+	return list, Error.Wrap(rows.Err())
+}
+
+// ListByStatus returns at most limit appeals with the status, the oldest first.
+func (db *nodeAppeals) ListByStatus(ctx context.Context, status appeals.Status, limit int) (_ []appeals.Appeal, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT `+nodeAppealColumns+`
+		FROM node_appeals
+		WHERE status = $1
+		ORDER BY created_at
+		LIMIT $2
+	`, int(status), limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var list []appeals.Appeal
+	for rows.Next() {
+		appeal, err := scanNodeAppeal(rows)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		list = append(list, appeal)
+	}
+	return list, Error.Wrap(rows.Err())
+}
+
+// Review records the decision about a pending appeal.
+func (db *nodeAppeals) Review(ctx context.Context, id uuid.UUID, review appeals.Review) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := db.db.ExecContext(ctx, `
+		UPDATE node_appeals
+		SET status = $2, review_note = $3, reviewed_by = $4, reviewed_at = $5
+		WHERE id = $1 AND status = $6
+	`, id.Bytes(), int(review.Status), review.Note, review.ReviewedBy, review.ReviewedAt.UTC(),
+		int(appeals.StatusPending))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if affected > 0 {
+		return nil
+	}
+
+	// the appeal is either missing or it was reviewed concurrently.
+	if _, err := db.Get(ctx, id); err != nil {
+		return err
+	}
+	return appeals.ErrReviewed.New("%s", id)
+}
+
+// InsertEvent logs an action taken on an appeal.
+func (db *nodeAppeals) InsertEvent(ctx context.Context, event appeals.Event) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO node_appeal_events (id, appeal_id, node_id, action, actor, note, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, event.ID.Bytes(), event.AppealID.Bytes(), event.NodeID.Bytes(), string(event.Action),
+		event.Actor, event.Note, event.CreatedAt.UTC())
+	return Error.Wrap(err)
+}
+
+// ListEvents returns the actions taken on an appeal, the oldest first.
+func (db *nodeAppeals) ListEvents(ctx context.Context, appealID uuid.UUID) (_ []appeals.Event, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT id, appeal_id, node_id, action, actor, note, created_at
+		FROM node_appeal_events
+		WHERE appeal_id = $1
+		ORDER BY created_at, id
+	`, appealID.Bytes())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var list []appeals.Event
+	for rows.Next() {
+		var event appeals.Event
+		var id, appealID, nodeID []byte
+		var action string
+		if err := rows.Scan(&id, &appealID, &nodeID, &action, &event.Actor, &event.Note, &event.CreatedAt); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if event.ID, err = uuid.FromBytes(id); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if event.AppealID, err = uuid.FromBytes(appealID); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if event.NodeID, err = storj.NodeIDFromBytes(nodeID); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		event.Action = appeals.Action(action)
+		list = append(list, event)
+	}
+	return list, Error.Wrap(rows.Err())
+}
+
+const nodeAppealColumns = `id, node_id, kind, status, message, reputation, review_note, reviewed_by, reviewed_at, created_at`
+
+// nodeAppealRow is either a single row or the current row of the rows.
+type nodeAppealRow interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanNodeAppeal scans the nodeAppealColumns of a row.
+func scanNodeAppeal(row nodeAppealRow) (appeals.Appeal, error) {
+	var appeal appeals.Appeal
+	var id, nodeID, reputation []byte
+	var kind, status int
+	var reviewNote, reviewedBy *string
+	var reviewedAt *time.Time
+	err := row.Scan(&id, &nodeID, &kind, &status, &appeal.Message, &reputation,
+		&reviewNote, &reviewedBy, &reviewedAt, &appeal.CreatedAt)
+	if err != nil {
+		return appeals.Appeal{}, err
+	}
+
+	if appeal.ID, err = uuid.FromBytes(id); err != nil {
+		return appeals.Appeal{}, err
+	}
+	if appeal.NodeID, err = storj.NodeIDFromBytes(nodeID); err != nil {
+		return appeals.Appeal{}, err
+	}
+	if err := json.Unmarshal(reputation, &appeal.Reputation); err != nil {
+		return appeals.Appeal{}, err
+	}
+	appeal.Kind = appeals.Kind(kind)
+	appeal.Status = appeals.Status(status)
+	if reviewNote != nil {
+		appeal.ReviewNote = *reviewNote
+	}
+	if reviewedBy != nil {
+		appeal.ReviewedBy = *reviewedBy
+	}
+	appeal.ReviewedAt = reviewedAt
+	return appeal, nil
+}
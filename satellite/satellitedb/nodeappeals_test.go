@@ -0,0 +1,108 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/appeals"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestNodeAppeals(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		nodeAppeals := db.NodeAppeals()
+		now := time.Now().Truncate(time.Second).UTC()
+		nodeID := testrand.NodeID()
+
+		first := appeals.Appeal{
+			ID:      testrand.UUID(),
+			NodeID:  nodeID,
+			Kind:    appeals.KindSuspension,
+			Status:  appeals.StatusPending,
+			Message: "first",
+			Reputation: appeals.ReputationSnapshot{
+				AuditScore:       0.9,
+				OfflineSuspended: &now,
+			},
+			CreatedAt: now.Add(-time.Hour),
+		}
+		second := first
+		second.ID = testrand.UUID()
+		second.Kind = appeals.KindDisqualification
+		second.Message = "second"
+		second.CreatedAt = now
+
+		require.NoError(t, nodeAppeals.Insert(ctx, first))
+		require.NoError(t, nodeAppeals.Insert(ctx, second))
+
+		appeal, err := nodeAppeals.Get(ctx, first.ID)
+		require.NoError(t, err)
+		require.Equal(t, first.Message, appeal.Message)
+		require.Equal(t, first.Kind, appeal.Kind)
+		require.Equal(t, 0.9, appeal.Reputation.AuditScore)
+		require.NotNil(t, appeal.Reputation.OfflineSuspended)
+		require.True(t, now.Equal(*appeal.Reputation.OfflineSuspended))
+		require.Nil(t, appeal.ReviewedAt)
+
+		_, err = nodeAppeals.Get(ctx, testrand.UUID())
+		require.True(t, appeals.ErrNotFound.Has(err))
+
+		list, err := nodeAppeals.ListByNode(ctx, nodeID)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, second.ID, list[0].ID)
+		require.Equal(t, first.ID, list[1].ID)
+
+		review := appeals.Review{
+			Status:     appeals.StatusRejected,
+			Note:       "no",
+			ReviewedBy: "operator",
+			ReviewedAt: now,
+		}
+		require.NoError(t, nodeAppeals.Review(ctx, first.ID, review))
+
+		err = nodeAppeals.Review(ctx, first.ID, review)
+		require.True(t, appeals.ErrReviewed.Has(err))
+		err = nodeAppeals.Review(ctx, testrand.UUID(), review)
+		require.True(t, appeals.ErrNotFound.Has(err))
+
+		appeal, err = nodeAppeals.Get(ctx, first.ID)
+		require.NoError(t, err)
+		require.Equal(t, appeals.StatusRejected, appeal.Status)
+		require.Equal(t, "no", appeal.ReviewNote)
+		require.Equal(t, "operator", appeal.ReviewedBy)
+		require.NotNil(t, appeal.ReviewedAt)
+		require.True(t, now.Equal(*appeal.ReviewedAt))
+
+		pending, err := nodeAppeals.ListByStatus(ctx, appeals.StatusPending, 10)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		require.Equal(t, second.ID, pending[0].ID)
+
+		for i, action := range []appeals.Action{appeals.ActionFiled, appeals.ActionRejected} {
+			require.NoError(t, nodeAppeals.InsertEvent(ctx, appeals.Event{
+				ID:        testrand.UUID(),
+				AppealID:  first.ID,
+				NodeID:    nodeID,
+				Action:    action,
+				Actor:     "operator",
+				CreatedAt: now.Add(time.Duration(i) * time.Minute),
+			}))
+		}
+
+		events, err := nodeAppeals.ListEvents(ctx, first.ID)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		require.Equal(t, appeals.ActionFiled, events[0].Action)
+		require.Equal(t, appeals.ActionRejected, events[1].Action)
+		require.Equal(t, nodeID, events[0].NodeID)
+	})
+}
@@ -0,0 +1,262 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/storj/satellite/nodemessages"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+var _ nodemessages.DB = (*nodeMessages)(nil)
+
+type nodeMessages struct {
+	db *satelliteDB
+}
+
+// Insert stores the message with its recipients.
+func (db *nodeMessages) Insert(ctx context.Context, message nodemessages.Message) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Tx.ExecContext(ctx, `
+			INSERT INTO node_messages (id, kind, title, body, targeted, expires_at, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`, message.ID.Bytes(), string(message.Kind), message.Title, message.Body,
+			len(message.Recipients) > 0, message.ExpiresAt, message.CreatedAt.UTC())
+		if err != nil {
+			return err
+		}
+		if len(message.Recipients) == 0 {
+			return nil
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `
+			INSERT INTO node_message_recipients (message_id, node_id)
+			SELECT $1, unnest($2::bytea[])
+		`, message.ID.Bytes(), pgutil.NodeIDArray(message.Recipients))
+		return err
+	}))
+}
+
+// Get returns the message with its recipients.
+func (db *nodeMessages) Get(ctx context.Context, id uuid.UUID) (_ nodemessages.Message, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	message, err := scanNodeMessage(db.db.QueryRowContext(ctx, `
+		SELECT `+nodeMessageColumns+`
+		FROM node_messages
+		WHERE id = $1
+	`, id.Bytes()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nodemessages.Message{}, nodemessages.ErrNotFound.New("%s", id)
+	}
+	if err != nil {
+		return nodemessages.Message{}, Error.Wrap(err)
+	}
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT node_id
+		FROM node_message_recipients
+		WHERE message_id = $1
+		ORDER BY node_id
+	`, id.Bytes())
+	if err != nil {
+		return nodemessages.Message{}, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var nodeID storj.NodeID
+		if err := rows.Scan(&nodeID); err != nil {
+			return nodemessages.Message{}, Error.Wrap(err)
+		}
+		message.Recipients = append(message.Recipients, nodeID)
+	}
+	return message, Error.Wrap(rows.Err())
+}
+
+// List returns at most limit messages without their recipients, the newest first.
+func (db *nodeMessages) List(ctx context.Context, limit int) (_ []nodemessages.Message, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT `+nodeMessageColumns+`
+		FROM node_messages
+		ORDER BY created_at DESC
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var list []nodemessages.Message
+	for rows.Next() {
+		message, err := scanNodeMessage(rows)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		list = append(list, message)
+	}
+	return list, Error.Wrap(rows.Err())
+}
+
+// Delete deletes the message with its recipients and acknowledgments.
+func (db *nodeMessages) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var deleted int64
+	err = db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		for _, query := range []string{
+			`DELETE FROM node_message_acknowledgments WHERE message_id = $1`,
+			`DELETE FROM node_message_recipients WHERE message_id = $1`,
+		} {
+			if _, err := tx.Tx.ExecContext(ctx, query, id.Bytes()); err != nil {
+				return err
+			}
+		}
+
+		result, err := tx.Tx.ExecContext(ctx, `DELETE FROM node_messages WHERE id = $1`, id.Bytes())
+		if err != nil {
+			return err
+		}
+		deleted, err = result.RowsAffected()
+		return err
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if deleted == 0 {
+		return nodemessages.ErrNotFound.New("%s", id)
+	}
+	return nil
+}
+
+// ListForNode returns the messages sent to the node, which haven't expired at now, the
+// newest first.
+func (db *nodeMessages) ListForNode(ctx context.Context, nodeID storj.NodeID, now time.Time) (_ []nodemessages.NodeMessage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT m.id, m.kind, m.title, m.body, m.expires_at, m.created_at, a.acknowledged_at
+		FROM node_messages m
+		LEFT JOIN node_message_acknowledgments a ON a.message_id = m.id AND a.node_id = $1
+		WHERE (m.expires_at IS NULL OR m.expires_at > $2)
+			AND `+nodeMessageSentTo+`
+		ORDER BY m.created_at DESC
+	`, nodeID.Bytes(), now.UTC())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var list []nodemessages.NodeMessage
+	for rows.Next() {
+		var message nodemessages.NodeMessage
+		var kind string
+		var expiresAt, acknowledgedAt sql.NullTime
+		err := rows.Scan(&message.ID, &kind, &message.Title, &message.Body, &expiresAt, &message.CreatedAt, &acknowledgedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		message.Kind = nodemessages.Kind(kind)
+		if expiresAt.Valid {
+			message.ExpiresAt = &expiresAt.Time
+		}
+		if acknowledgedAt.Valid {
+			message.AcknowledgedAt = &acknowledgedAt.Time
+		}
+		list = append(list, message)
+	}
+	return list, Error.Wrap(rows.Err())
+}
+
+// Acknowledge records the acknowledgment of the message sent to the node. It returns the
+// time of the first acknowledgment, when the node already acknowledged the message.
+func (db *nodeMessages) Acknowledge(ctx context.Context, id uuid.UUID, nodeID storj.NodeID, now time.Time) (acknowledgedAt time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO node_message_acknowledgments (message_id, node_id, acknowledged_at)
+		SELECT m.id, $2, $3
+		FROM node_messages m
+		WHERE m.id = $1 AND `+nodeMessageSentTo+`
+		ON CONFLICT (message_id, node_id) DO NOTHING
+	`, id.Bytes(), nodeID.Bytes(), now.UTC())
+	if err != nil {
+		return time.Time{}, Error.Wrap(err)
+	}
+
+	err = db.db.QueryRowContext(ctx, `
+		SELECT acknowledged_at
+		FROM node_message_acknowledgments
+		WHERE message_id = $1 AND node_id = $2
+	`, id.Bytes(), nodeID.Bytes()).Scan(&acknowledgedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nodemessages.ErrNotFound.New("%s", id)
+	}
+	return acknowledgedAt, Error.Wrap(err)
+}
+
+// ListAcknowledgments returns the acknowledgments of the message, the oldest first.
+func (db *nodeMessages) ListAcknowledgments(ctx context.Context, id uuid.UUID) (_ []nodemessages.Acknowledgment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT message_id, node_id, acknowledged_at
+		FROM node_message_acknowledgments
+		WHERE message_id = $1
+		ORDER BY acknowledged_at, node_id
+	`, id.Bytes())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var list []nodemessages.Acknowledgment
+	for rows.Next() {
+		var acknowledgment nodemessages.Acknowledgment
+		err := rows.Scan(&acknowledgment.MessageID, &acknowledgment.NodeID, &acknowledgment.AcknowledgedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		list = append(list, acknowledgment)
+	}
+	return list, Error.Wrap(rows.Err())
+}
+
+// nodeMessageSentTo matches the messages m, which are sent to the node $1.
+const nodeMessageSentTo = `(NOT m.targeted OR EXISTS (
+	SELECT 1 FROM node_message_recipients r
+	WHERE r.message_id = m.id AND r.node_id = $1
+))`
+
+const nodeMessageColumns = `id, kind, title, body, targeted, expires_at, created_at,
+	(SELECT COUNT(*) FROM node_message_acknowledgments a WHERE a.message_id = node_messages.id)`
+
+func scanNodeMessage(row interface{ Scan(...interface{}) error }) (message nodemessages.Message, err error) {
+	var kind string
+	var targeted bool
+	var expiresAt sql.NullTime
+	err = row.Scan(&message.ID, &kind, &message.Title, &message.Body, &targeted, &expiresAt,
+		&message.CreatedAt, &message.Acknowledgments)
+	if err != nil {
+		return nodemessages.Message{}, err
+	}
+	message.Kind = nodemessages.Kind(kind)
+	if expiresAt.Valid {
+		message.ExpiresAt = &expiresAt.Time
+	}
+	return message, nil
+}
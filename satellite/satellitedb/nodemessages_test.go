@@ -0,0 +1,100 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/nodemessages"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestNodeMessages(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		messages := db.NodeMessages()
+
+		now := time.Now().Truncate(time.Microsecond)
+		expiresAt := now.Add(time.Hour)
+		nodeA, nodeB := testrand.NodeID(), testrand.NodeID()
+
+		broadcast := nodemessages.Message{
+			ID:        testrand.UUID(),
+			Kind:      nodemessages.KindPolicy,
+			Title:     "new policy",
+			Body:      "the policy changes",
+			CreatedAt: now.Add(-time.Minute),
+		}
+		targeted := nodemessages.Message{
+			ID:         testrand.UUID(),
+			Kind:       nodemessages.KindDeprecation,
+			Title:      "update",
+			Body:       "your version is deprecated",
+			Recipients: []storj.NodeID{nodeA},
+			ExpiresAt:  &expiresAt,
+			CreatedAt:  now,
+		}
+		require.NoError(t, messages.Insert(ctx, broadcast))
+		require.NoError(t, messages.Insert(ctx, targeted))
+
+		message, err := messages.Get(ctx, targeted.ID)
+		require.NoError(t, err)
+		require.Equal(t, targeted.Recipients, message.Recipients)
+		require.Equal(t, targeted.Kind, message.Kind)
+		require.WithinDuration(t, expiresAt, *message.ExpiresAt, time.Second)
+
+		_, err = messages.Get(ctx, testrand.UUID())
+		require.True(t, nodemessages.ErrNotFound.Has(err))
+
+		forA, err := messages.ListForNode(ctx, nodeA, now)
+		require.NoError(t, err)
+		require.Len(t, forA, 2)
+		require.Equal(t, targeted.ID, forA[0].ID)
+		require.Equal(t, broadcast.ID, forA[1].ID)
+
+		forB, err := messages.ListForNode(ctx, nodeB, now)
+		require.NoError(t, err)
+		require.Len(t, forB, 1)
+		require.Equal(t, broadcast.ID, forB[0].ID)
+
+		forA, err = messages.ListForNode(ctx, nodeA, expiresAt)
+		require.NoError(t, err)
+		require.Len(t, forA, 1)
+
+		_, err = messages.Acknowledge(ctx, targeted.ID, nodeB, now)
+		require.True(t, nodemessages.ErrNotFound.Has(err))
+
+		acknowledgedAt, err := messages.Acknowledge(ctx, targeted.ID, nodeA, now)
+		require.NoError(t, err)
+		require.WithinDuration(t, now, acknowledgedAt, time.Second)
+
+		acknowledgedAt, err = messages.Acknowledge(ctx, targeted.ID, nodeA, now.Add(time.Minute))
+		require.NoError(t, err)
+		require.WithinDuration(t, now, acknowledgedAt, time.Second)
+
+		acknowledgments, err := messages.ListAcknowledgments(ctx, targeted.ID)
+		require.NoError(t, err)
+		require.Len(t, acknowledgments, 1)
+		require.Equal(t, nodeA, acknowledgments[0].NodeID)
+
+		list, err := messages.List(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, targeted.ID, list[0].ID)
+		require.Equal(t, 1, list[0].Acknowledgments)
+
+		require.NoError(t, messages.Delete(ctx, targeted.ID))
+		require.True(t, nodemessages.ErrNotFound.Has(messages.Delete(ctx, targeted.ID)))
+
+		acknowledgments, err = messages.ListAcknowledgments(ctx, targeted.ID)
+		require.NoError(t, err)
+		require.Empty(t, acknowledgments)
+	})
+}
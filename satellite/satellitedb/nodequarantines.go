@@ -0,0 +1,144 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/storj/satellite/quarantine"
+)
+
+var _ quarantine.DB = (*nodeQuarantines)(nil)
+
+type nodeQuarantines struct {
+	db *satelliteDB
+}
+
+// InsertEvents stores the corruption events.
+func (db *nodeQuarantines) InsertEvents(ctx context.Context, events []quarantine.Event) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	ids := make([][]byte, len(events))
+	nodeIDs := make([][]byte, len(events))
+	sources := make([]string, len(events))
+	counts := make([]int64, len(events))
+	createdAts := make([]time.Time, len(events))
+	for i, event := range events {
+		ids[i] = event.ID.Bytes()
+		nodeIDs[i] = event.NodeID.Bytes()
+		sources[i] = string(event.Source)
+		counts[i] = int64(event.Count)
+		createdAts[i] = event.CreatedAt.UTC()
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO node_corruption_events (id, node_id, source, count, created_at)
+		SELECT unnest($1::bytea[]), unnest($2::bytea[]), unnest($3::text[]), unnest($4::int8[]), unnest($5::timestamptz[])
+	`, pgutil.ByteaArray(ids), pgutil.ByteaArray(nodeIDs), pgutil.TextArray(sources),
+		pgutil.Int8Array(counts), pgutil.TimestampTZArray(createdAts))
+	return Error.Wrap(err)
+}
+
+// SumEvents returns the numbers of the corruptions of the nodes observed since the time.
+func (db *nodeQuarantines) SumEvents(ctx context.Context, since time.Time) (_ []quarantine.Counts, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT node_id,
+			COALESCE(SUM(count) FILTER (WHERE source = $2), 0),
+			COALESCE(SUM(count) FILTER (WHERE source = $3), 0),
+			COALESCE(SUM(count) FILTER (WHERE source = $4), 0)
+		FROM node_corruption_events
+		WHERE created_at >= $1
+		GROUP BY node_id
+		ORDER BY node_id
+	`, since.UTC(), string(quarantine.SourceAudit), string(quarantine.SourceRepair), string(quarantine.SourceOrders))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var list []quarantine.Counts
+	for rows.Next() {
+		var counts quarantine.Counts
+		if err := rows.Scan(&counts.NodeID, &counts.AuditFailures, &counts.RepairCorruptions, &counts.OrderAnomalies); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		list = append(list, counts)
+	}
+	return list, Error.Wrap(rows.Err())
+}
+
+// DeleteEventsBefore deletes the corruption events observed before the time.
+func (db *nodeQuarantines) DeleteEventsBefore(ctx context.Context, before time.Time) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := db.db.ExecContext(ctx, `
+		DELETE FROM node_corruption_events
+		WHERE created_at < $1
+	`, before.UTC())
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	deleted, err = result.RowsAffected()
+	return deleted, Error.Wrap(err)
+}
+
+// Insert quarantines a node.
+func (db *nodeQuarantines) Insert(ctx context.Context, quarantine quarantine.Quarantine) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO node_quarantines (node_id, quarantined_at, score, reason)
+		VALUES ($1, $2, $3, $4)
+	`, quarantine.NodeID.Bytes(), quarantine.QuarantinedAt.UTC(), quarantine.Score, quarantine.Reason)
+	return Error.Wrap(err)
+}
+
+// ListActive returns the quarantines, which weren't released.
+func (db *nodeQuarantines) ListActive(ctx context.Context) (_ []quarantine.Quarantine, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT node_id, quarantined_at, score, reason
+		FROM node_quarantines
+		WHERE released_at IS NULL
+		ORDER BY quarantined_at, node_id
+	`)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var list []quarantine.Quarantine
+	for rows.Next() {
+		var quarantine quarantine.Quarantine
+		if err := rows.Scan(&quarantine.NodeID, &quarantine.QuarantinedAt, &quarantine.Score, &quarantine.Reason); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		list = append(list, quarantine)
+	}
+	return list, Error.Wrap(rows.Err())
+}
+
+// Release ends the active quarantine of the node.
+func (db *nodeQuarantines) Release(ctx context.Context, nodeID storj.NodeID, releasedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		UPDATE node_quarantines
+		SET released_at = $2
+		WHERE node_id = $1 AND released_at IS NULL
+	`, nodeID.Bytes(), releasedAt.UTC())
+	return Error.Wrap(err)
+}
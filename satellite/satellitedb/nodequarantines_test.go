@@ -0,0 +1,65 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/quarantine"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestNodeQuarantines(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		quarantines := db.NodeQuarantines()
+
+		now := time.Now()
+		nodeA, nodeB := testrand.NodeID(), testrand.NodeID()
+
+		require.NoError(t, quarantines.InsertEvents(ctx, []quarantine.Event{
+			{ID: testrand.UUID(), NodeID: nodeA, Source: quarantine.SourceAudit, Count: 2, CreatedAt: now},
+			{ID: testrand.UUID(), NodeID: nodeA, Source: quarantine.SourceAudit, Count: 3, CreatedAt: now},
+			{ID: testrand.UUID(), NodeID: nodeA, Source: quarantine.SourceOrders, Count: 7, CreatedAt: now},
+			{ID: testrand.UUID(), NodeID: nodeB, Source: quarantine.SourceRepair, Count: 1, CreatedAt: now},
+			{ID: testrand.UUID(), NodeID: nodeB, Source: quarantine.SourceRepair, Count: 4, CreatedAt: now.Add(-48 * time.Hour)},
+		}))
+
+		counts, err := quarantines.SumEvents(ctx, now.Add(-time.Hour))
+		require.NoError(t, err)
+		require.ElementsMatch(t, []quarantine.Counts{
+			{NodeID: nodeA, AuditFailures: 5, OrderAnomalies: 7},
+			{NodeID: nodeB, RepairCorruptions: 1},
+		}, counts)
+
+		deleted, err := quarantines.DeleteEventsBefore(ctx, now.Add(-time.Hour))
+		require.NoError(t, err)
+		require.EqualValues(t, 1, deleted)
+
+		require.NoError(t, quarantines.Insert(ctx, quarantine.Quarantine{
+			NodeID:        nodeA,
+			QuarantinedAt: now,
+			Score:         1.5,
+			Reason:        "audit failures: 5",
+		}))
+
+		active, err := quarantines.ListActive(ctx)
+		require.NoError(t, err)
+		require.Len(t, active, 1)
+		require.Equal(t, nodeA, active[0].NodeID)
+		require.Equal(t, 1.5, active[0].Score)
+		require.Nil(t, active[0].ReleasedAt)
+
+		require.NoError(t, quarantines.Release(ctx, nodeA, now.Add(time.Hour)))
+
+		active, err = quarantines.ListActive(ctx)
+		require.NoError(t, err)
+		require.Empty(t, active)
+	})
+}
@@ -103,14 +103,16 @@ func (cache *overlaycache) selectStorageNodesOnce(ctx context.Context, reputable
 	// Note: the true/false at the end of each selection string indicates if the selection is for new nodes or not.
 	// Later, the flag allows us to distinguish if a node is new when scanning the db rows.
 	reputableNodeQuery = partialQuery{
-		selection: `SELECT DISTINCT ON (last_net) last_net, id, address, last_ip_port, noise_proto, noise_public_key, debounce_limit, false FROM nodes`,
+		selection: `SELECT DISTINCT ON (last_net) last_net, id, address, last_ip_port, noise_proto, noise_public_key, debounce_limit, node_capabilities.noise, node_capabilities.max_piece_size, false
+			FROM nodes LEFT JOIN node_capabilities ON node_capabilities.node_id = nodes.id`,
 		condition: reputableNodesCondition,
 		distinct:  true,
 		limit:     reputableNodeCount,
 		orderBy:   "last_net",
 	}
 	newNodeQuery = partialQuery{
-		selection: `SELECT DISTINCT ON (last_net) last_net, id, address, last_ip_port, noise_proto, noise_public_key, debounce_limit, true FROM nodes`,
+		selection: `SELECT DISTINCT ON (last_net) last_net, id, address, last_ip_port, noise_proto, noise_public_key, debounce_limit, node_capabilities.noise, node_capabilities.max_piece_size, true
+			FROM nodes LEFT JOIN node_capabilities ON node_capabilities.node_id = nodes.id`,
 		condition: newNodesCondition,
 		distinct:  true,
 		limit:     newNodeCount,
@@ -132,8 +134,10 @@ func (cache *overlaycache) selectStorageNodesOnce(ctx context.Context, reputable
 		var lastIPPort sql.NullString
 		var isNew bool
 		var noise noiseScanner
+		var capabilities capabilitiesScanner
 
-		err = rows.Scan(&node.LastNet, &node.ID, &node.Address.Address, &node.LastIPPort, &noise.Proto, &noise.PublicKey, &node.Address.DebounceLimit, &isNew)
+		err = rows.Scan(&node.LastNet, &node.ID, &node.Address.Address, &node.LastIPPort, &noise.Proto, &noise.PublicKey, &node.Address.DebounceLimit,
+			&capabilities.Noise, &capabilities.MaxPieceSize, &isNew)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -142,6 +146,7 @@ func (cache *overlaycache) selectStorageNodesOnce(ctx context.Context, reputable
 			node.LastIPPort = lastIPPort.String
 		}
 		node.Address.NoiseInfo = noise.Convert()
+		capabilities.Apply(&node)
 
 		if isNew {
 			newNodes = append(newNodes, &node)
@@ -163,6 +168,7 @@ func nodeSelectionCondition(ctx context.Context, criteria *overlay.NodeCriteria,
 	conds.add(`unknown_audit_suspended IS NULL`)
 	conds.add(`offline_suspended IS NULL`)
 	conds.add(`exit_initiated_at IS NULL`)
+	conds.add(`NOT EXISTS (` + activeNodeQuarantine + `)`)
 
 	conds.add(`type = ?`, int(pb.NodeType_STORAGE))
 	conds.add(`free_disk >= ?`, criteria.FreeDisk)
@@ -201,6 +207,12 @@ func nodeSelectionCondition(ctx context.Context, criteria *overlay.NodeCriteria,
 			pgutil.TextArray(excludedNetworks),
 		)
 	}
+	if criteria.PieceSize > 0 {
+		conds.add(
+			`(node_capabilities.max_piece_size IS NULL OR node_capabilities.max_piece_size <= 0 OR node_capabilities.max_piece_size >= ?)`,
+			criteria.PieceSize,
+		)
+	}
 	conds.add(`last_net <> ''`)
 	return conds.combine(), nil
 }
@@ -0,0 +1,138 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/overlay/slareports"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+var _ slareports.DB = (*nodeSLAReports)(nil)
+
+type nodeSLAReports struct {
+	db *satelliteDB
+}
+
+// ListNodeHistories returns up to limit audit histories of the nodes with an ID
+// greater than cursor, ordered by the node ID.
+func (reports *nodeSLAReports) ListNodeHistories(ctx context.Context, cursor storj.NodeID, limit int) (_ []slareports.NodeHistory, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := reports.db.QueryContext(ctx, reports.db.Rebind(`
+		SELECT reputations.id, nodes.wallet, reputations.audit_history
+		FROM reputations
+		JOIN nodes ON nodes.id = reputations.id
+		WHERE reputations.id > ?
+		ORDER BY reputations.id
+		LIMIT ?
+	`), cursor.Bytes(), limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var histories []slareports.NodeHistory
+	for rows.Next() {
+		var history slareports.NodeHistory
+		var auditHistory []byte
+		if err := rows.Scan(&history.NodeID, &history.Wallet, &auditHistory); err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		history.History = &pb.AuditHistory{}
+		if err := pb.Unmarshal(auditHistory, history.History); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		histories = append(histories, history)
+	}
+
+	return histories, Error.Wrap(rows.Err())
+}
+
+// InsertReports replaces the reports of the period with the given reports.
+func (reports *nodeSLAReports) InsertReports(ctx context.Context, period time.Time, nodeReports []slareports.NodeReport) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(reports.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Tx.ExecContext(ctx, reports.db.Rebind(`DELETE FROM node_sla_reports WHERE period = ?`), period)
+		if err != nil {
+			return err
+		}
+
+		for _, report := range nodeReports {
+			_, err := tx.Tx.ExecContext(ctx, reports.db.Rebind(`
+				INSERT INTO node_sla_reports (
+					period, node_id, wallet, windows, online_score, compliant
+				) VALUES (?, ?, ?, ?, ?, ?)
+			`), period, report.NodeID.Bytes(), report.Wallet, report.Windows, report.OnlineScore, report.Compliant)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+// GetReports returns the reports of the period ordered by the node ID.
+func (reports *nodeSLAReports) GetReports(ctx context.Context, period time.Time) (_ []slareports.NodeReport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := reports.db.QueryContext(ctx, reports.db.Rebind(`
+		SELECT period, node_id, wallet, windows, online_score, compliant, created_at
+		FROM node_sla_reports
+		WHERE period = ?
+		ORDER BY node_id
+	`), period)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var result []slareports.NodeReport
+	for rows.Next() {
+		var report slareports.NodeReport
+		err := rows.Scan(&report.Period, &report.NodeID, &report.Wallet, &report.Windows,
+			&report.OnlineScore, &report.Compliant, &report.CreatedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		report.Period = report.Period.UTC()
+		result = append(result, report)
+	}
+
+	return result, Error.Wrap(rows.Err())
+}
+
+// ListPeriods returns the periods with generated reports, the latest first.
+func (reports *nodeSLAReports) ListPeriods(ctx context.Context) (_ []time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := reports.db.QueryContext(ctx, `
+		SELECT DISTINCT period
+		FROM node_sla_reports
+		ORDER BY period DESC
+	`)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var periods []time.Time
+	for rows.Next() {
+		var period time.Time
+		if err := rows.Scan(&period); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		periods = append(periods, period.UTC())
+	}
+
+	return periods, Error.Wrap(rows.Err())
+}
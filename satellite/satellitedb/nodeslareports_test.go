@@ -0,0 +1,102 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/overlay/slareports"
+	"storj.io/storj/satellite/reputation"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestNodeSLAReports(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		period := time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC)
+
+		reputationConfig := reputation.Config{
+			AuditHistory: reputation.AuditHistoryConfig{
+				WindowSize:     12 * time.Hour,
+				TrackingPeriod: 30 * 24 * time.Hour,
+			},
+		}
+
+		addNode := func(wallet string, online ...bool) storj.NodeID {
+			nodeID := testrand.NodeID()
+			require.NoError(t, db.OverlayCache().UpdateCheckIn(ctx, overlay.NodeCheckInInfo{
+				NodeID:   nodeID,
+				IsUp:     true,
+				Address:  &pb.NodeAddress{Address: "1.2.3.4"},
+				Version:  &pb.NodeVersion{Version: "v1.0.0"},
+				Operator: &pb.NodeOperator{Email: "operator@mail.test", Wallet: wallet},
+			}, period, overlay.NodeSelectionConfig{}))
+
+			history := &pb.AuditHistory{}
+			for i, isOnline := range online {
+				auditTime := period.Add(time.Duration(i) * reputationConfig.AuditHistory.WindowSize)
+				require.NoError(t, reputation.AddAuditToHistory(history, isOnline, auditTime, reputationConfig.AuditHistory))
+			}
+			_, err := db.Reputation().ApplyUpdates(ctx, nodeID, reputation.Mutations{
+				PositiveResults: len(online),
+				OnlineHistory:   history,
+			}, reputationConfig, period.Add(24*time.Hour))
+			require.NoError(t, err)
+			return nodeID
+		}
+
+		compliant := addNode("0xa", true, true, true, true)
+		noncompliant := addNode("0xa", true, false, false, true)
+		other := addNode("0xb", true, true)
+
+		chore := slareports.NewChore(zaptest.NewLogger(t), db.NodeSLAReports(), slareports.Config{
+			MinimumOnlineScore: 0.9,
+			ListLimit:          2,
+		})
+
+		count, err := chore.Generate(ctx, period.Add(48*time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, 3, count)
+
+		// generating again replaces the reports of the period.
+		count, err = chore.Generate(ctx, period)
+		require.NoError(t, err)
+		require.Equal(t, 3, count)
+
+		periods, err := db.NodeSLAReports().ListPeriods(ctx)
+		require.NoError(t, err)
+		require.Len(t, periods, 1)
+		require.True(t, periods[0].Equal(period))
+
+		reports, err := db.NodeSLAReports().GetReports(ctx, period)
+		require.NoError(t, err)
+		require.Len(t, reports, 3)
+
+		byNode := map[storj.NodeID]slareports.NodeReport{}
+		for _, report := range reports {
+			require.True(t, report.Period.Equal(period))
+			byNode[report.NodeID] = report
+		}
+		require.True(t, byNode[compliant].Compliant)
+		require.Equal(t, 4, byNode[compliant].Windows)
+		require.Equal(t, "0xa", byNode[compliant].Wallet)
+		require.False(t, byNode[noncompliant].Compliant)
+		require.InDelta(t, 0.5, byNode[noncompliant].OnlineScore, 1e-9)
+		require.True(t, byNode[other].Compliant)
+		require.Equal(t, "0xb", byNode[other].Wallet)
+
+		reports, err = db.NodeSLAReports().GetReports(ctx, period.AddDate(0, 1, 0))
+		require.NoError(t, err)
+		require.Empty(t, reports)
+	})
+}
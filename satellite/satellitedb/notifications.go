@@ -0,0 +1,150 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+	"storj.io/storj/satellite/console"
+)
+
+// Ensure that notifications implements console.Notifications.
+var _ console.Notifications = (*notifications)(nil)
+
+// notifications is an implementation of console.Notifications.
+type notifications struct {
+	db *satelliteDB
+}
+
+// Insert stores a new notification of the user.
+func (notifications *notifications) Insert(ctx context.Context, notification console.Notification) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = notifications.db.ExecContext(ctx, notifications.db.Rebind(`
+		INSERT INTO user_notifications (user_id, id, kind, title, message, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`), notification.UserID, notification.ID, notification.Kind, notification.Title, notification.Message, notification.CreatedAt)
+	return err
+}
+
+// InsertForActiveUsers stores the notification for all the active users, and returns the
+// number of the notified users.
+func (notifications *notifications) InsertForActiveUsers(ctx context.Context, notification console.Notification) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := notifications.db.ExecContext(ctx, notifications.db.Rebind(`
+		INSERT INTO user_notifications (user_id, id, kind, title, message, created_at)
+		SELECT users.id, ?::BYTEA, ?::TEXT, ?::TEXT, ?::TEXT, ?::TIMESTAMPTZ
+		FROM users
+		WHERE users.status = ?
+	`), notification.ID, notification.Kind, notification.Title, notification.Message, notification.CreatedAt, console.Active)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// List returns the notifications of the user, the newest first.
+func (notifications *notifications) List(ctx context.Context, userID uuid.UUID, limit int, unreadOnly bool) (_ []console.Notification, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := notifications.db.QueryContext(ctx, notifications.db.Rebind(`
+		SELECT user_id, id, kind, title, message, read_at, created_at
+		FROM user_notifications
+		WHERE user_id = ? AND (read_at IS NULL OR NOT ?)
+		ORDER BY created_at DESC, id
+		LIMIT ?
+	`), userID, unreadOnly, limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanNotifications(rows)
+}
+
+// ListAfter returns the notifications of the user created after the time, the oldest first.
+func (notifications *notifications) ListAfter(ctx context.Context, userID uuid.UUID, after time.Time, limit int) (_ []console.Notification, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := notifications.db.QueryContext(ctx, notifications.db.Rebind(`
+		SELECT user_id, id, kind, title, message, read_at, created_at
+		FROM user_notifications
+		WHERE user_id = ? AND created_at > ?
+		ORDER BY created_at, id
+		LIMIT ?
+	`), userID, after, limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanNotifications(rows)
+}
+
+// CountUnread returns the number of the unread notifications of the user.
+func (notifications *notifications) CountUnread(ctx context.Context, userID uuid.UUID) (count int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = notifications.db.QueryRowContext(ctx, notifications.db.Rebind(`
+		SELECT COUNT(*) FROM user_notifications WHERE user_id = ? AND read_at IS NULL
+	`), userID).Scan(&count)
+	return count, err
+}
+
+// MarkRead marks the notification of the user as read.
+func (notifications *notifications) MarkRead(ctx context.Context, userID, id uuid.UUID, readAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := notifications.db.ExecContext(ctx, notifications.db.Rebind(`
+		UPDATE user_notifications
+		SET read_at = COALESCE(read_at, ?)
+		WHERE user_id = ? AND id = ?
+	`), readAt, userID, id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return console.ErrNotificationNotFound.New("%s", id)
+	}
+	return nil
+}
+
+// MarkAllRead marks all the notifications of the user as read.
+func (notifications *notifications) MarkAllRead(ctx context.Context, userID uuid.UUID, readAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = notifications.db.ExecContext(ctx, notifications.db.Rebind(`
+		UPDATE user_notifications
+		SET read_at = ?
+		WHERE user_id = ? AND read_at IS NULL
+	`), readAt, userID)
+	return err
+}
+
+// scanNotifications reads the notifications from the rows and closes them.
+func scanNotifications(rows tagsql.Rows) (list []console.Notification, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var notification console.Notification
+		var readAt sql.NullTime
+		err := rows.Scan(&notification.UserID, &notification.ID, &notification.Kind, &notification.Title,
+			&notification.Message, &readAt, &notification.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		if readAt.Valid {
+			notification.ReadAt = &readAt.Time
+		}
+		list = append(list, notification)
+	}
+	return list, rows.Err()
+}
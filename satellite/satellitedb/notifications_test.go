@@ -0,0 +1,107 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestNotifications(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		notifications := db.Console().Notifications()
+		now := time.Now().Truncate(time.Second).UTC()
+		userID := testrand.UUID()
+
+		var inserted []console.Notification
+		for i := 0; i < 3; i++ {
+			notification := console.Notification{
+				ID:        testrand.UUID(),
+				UserID:    userID,
+				Kind:      console.NotificationAccountWarned,
+				Title:     "title",
+				Message:   "message",
+				CreatedAt: now.Add(time.Duration(i) * time.Minute),
+			}
+			require.NoError(t, notifications.Insert(ctx, notification))
+			inserted = append(inserted, notification)
+		}
+
+		list, err := notifications.List(ctx, userID, 2, false)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, inserted[2].ID, list[0].ID)
+		require.Equal(t, inserted[1].ID, list[1].ID)
+
+		list, err = notifications.ListAfter(ctx, userID, inserted[0].CreatedAt, 10)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, inserted[1].ID, list[0].ID)
+		require.Equal(t, inserted[2].ID, list[1].ID)
+
+		require.NoError(t, notifications.MarkRead(ctx, userID, inserted[1].ID, now))
+		err = notifications.MarkRead(ctx, testrand.UUID(), inserted[1].ID, now)
+		require.True(t, console.ErrNotificationNotFound.Has(err))
+
+		count, err := notifications.CountUnread(ctx, userID)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+
+		list, err = notifications.List(ctx, userID, 10, true)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		for _, notification := range list {
+			require.Nil(t, notification.ReadAt)
+		}
+
+		require.NoError(t, notifications.MarkAllRead(ctx, userID, now))
+		count, err = notifications.CountUnread(ctx, userID)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		// only the active users receive the announcements.
+		active, err := db.Console().Users().Insert(ctx, &console.User{
+			ID:           testrand.UUID(),
+			FullName:     "Active User",
+			Email:        "active@example.com",
+			PasswordHash: []byte("example_password"),
+		})
+		require.NoError(t, err)
+		activeStatus := console.Active
+		require.NoError(t, db.Console().Users().Update(ctx, active.ID, console.UpdateUserRequest{Status: &activeStatus}))
+
+		inactive, err := db.Console().Users().Insert(ctx, &console.User{
+			ID:           testrand.UUID(),
+			FullName:     "Inactive User",
+			Email:        "inactive@example.com",
+			PasswordHash: []byte("example_password"),
+		})
+		require.NoError(t, err)
+
+		notified, err := notifications.InsertForActiveUsers(ctx, console.Notification{
+			ID:        testrand.UUID(),
+			Kind:      console.NotificationMaintenance,
+			Title:     "Planned maintenance",
+			CreatedAt: now,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, notified)
+
+		count, err = notifications.CountUnread(ctx, active.ID)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		count, err = notifications.CountUnread(ctx, inactive.ID)
+		require.NoError(t, err)
+		require.Zero(t, count)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,10 +20,11 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/storj"
 	"storj.io/common/storj/location"
-	"storj.io/private/dbutil/cockroachutil"
+	"storj.io/common/uuid"
 	"storj.io/private/dbutil/pgutil"
 	"storj.io/private/tagsql"
 	"storj.io/private/version"
+	"storj.io/storj/satellite/changes"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/satellitedb/dbx"
 )
@@ -33,23 +35,23 @@ var (
 
 var _ overlay.DB = (*overlaycache)(nil)
 
+// activeNodeQuarantine selects the active quarantine of the node, which isn't
+// selected for uploads.
+const activeNodeQuarantine = `
+	SELECT 1 FROM node_quarantines
+	WHERE node_quarantines.node_id = nodes.id AND node_quarantines.released_at IS NULL
+`
+
 type overlaycache struct {
 	db *satelliteDB
 }
 
 // SelectAllStorageNodesUpload returns all nodes that qualify to store data, organized as reputable nodes and new nodes.
 func (cache *overlaycache) SelectAllStorageNodesUpload(ctx context.Context, selectionCfg overlay.NodeSelectionConfig) (reputable, new []*overlay.SelectedNode, err error) {
-	for {
+	err = cache.db.retry(ctx, "overlaycache.SelectAllStorageNodesUpload", func(ctx context.Context) (err error) {
 		reputable, new, err = cache.selectAllStorageNodesUpload(ctx, selectionCfg)
-		if err != nil {
-			if cockroachutil.NeedsRetry(err) {
-				continue
-			}
-			return reputable, new, err
-		}
-		break
-	}
-
+		return err
+	})
 	return reputable, new, err
 }
 
@@ -57,13 +59,18 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 	defer mon.Task()(&ctx)(&err)
 
 	query := `
-		SELECT id, address, last_net, last_ip_port, vetted_at, country_code, noise_proto, noise_public_key, debounce_limit
+		SELECT id, address, last_net, last_ip_port, vetted_at, country_code, noise_proto, noise_public_key, debounce_limit,
+			node_capabilities.noise, node_capabilities.max_piece_size,
+			free_disk, node_storage_estimates.stored_bytes + node_storage_estimates.settled_bytes
 			FROM nodes
+			LEFT JOIN node_capabilities ON node_capabilities.node_id = nodes.id
+			LEFT JOIN node_storage_estimates ON node_storage_estimates.node_id = nodes.id
 			` + cache.db.impl.AsOfSystemInterval(selectionCfg.AsOfSystemTime.Interval()) + `
 			WHERE disqualified IS NULL
 			AND unknown_audit_suspended IS NULL
 			AND offline_suspended IS NULL
 			AND exit_initiated_at IS NULL
+			AND NOT EXISTS (` + activeNodeQuarantine + `)
 			AND type = $1
 			AND free_disk >= $2
 			AND last_contact_success > $3
@@ -102,7 +109,12 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 		var lastIPPort sql.NullString
 		var vettedAt *time.Time
 		var noise noiseScanner
-		err = rows.Scan(&node.ID, &node.Address.Address, &node.LastNet, &lastIPPort, &vettedAt, &node.CountryCode, &noise.Proto, &noise.PublicKey, &node.Address.DebounceLimit)
+		var capabilities capabilitiesScanner
+		var freeDisk int64
+		var storedBytes sql.NullInt64
+		err = rows.Scan(&node.ID, &node.Address.Address, &node.LastNet, &lastIPPort, &vettedAt, &node.CountryCode, &noise.Proto, &noise.PublicKey, &node.Address.DebounceLimit,
+			&capabilities.Noise, &capabilities.MaxPieceSize,
+			&freeDisk, &storedBytes)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -110,6 +122,8 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 			node.LastIPPort = lastIPPort.String
 		}
 		node.Address.NoiseInfo = noise.Convert()
+		capabilities.Apply(&node)
+		node.NearlyFull = selectionCfg.NearlyFull(storedBytes.Int64, freeDisk)
 
 		if vettedAt == nil {
 			newNodes = append(newNodes, &node)
@@ -123,17 +137,10 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 
 // SelectAllStorageNodesDownload returns all nodes that qualify to store data, organized as reputable nodes and new nodes.
 func (cache *overlaycache) SelectAllStorageNodesDownload(ctx context.Context, onlineWindow time.Duration, asOf overlay.AsOfSystemTimeConfig) (nodes []*overlay.SelectedNode, err error) {
-	for {
+	err = cache.db.retry(ctx, "overlaycache.SelectAllStorageNodesDownload", func(ctx context.Context) (err error) {
 		nodes, err = cache.selectAllStorageNodesDownload(ctx, onlineWindow, asOf)
-		if err != nil {
-			if cockroachutil.NeedsRetry(err) {
-				continue
-			}
-			return nodes, err
-		}
-		break
-	}
-
+		return err
+	})
 	return nodes, err
 }
 
@@ -186,17 +193,10 @@ func (cache *overlaycache) GetNodesNetwork(ctx context.Context, nodeIDs []storj.
 		SELECT last_net FROM nodes
 			WHERE id = any($1::bytea[])
 	`
-	for {
+	err = cache.db.retry(ctx, "overlaycache.GetNodesNetwork", func(ctx context.Context) (err error) {
 		nodeNets, err = cache.getNodesNetwork(ctx, nodeIDs, query)
-		if err != nil {
-			if cockroachutil.NeedsRetry(err) {
-				continue
-			}
-			return nodeNets, err
-		}
-		break
-	}
-
+		return err
+	})
 	return nodeNets, err
 }
 
@@ -210,17 +210,10 @@ func (cache *overlaycache) GetNodesNetworkInOrder(ctx context.Context, nodeIDs [
 			LEFT OUTER JOIN nodes n ON input.node_id = n.id
 		ORDER BY input.ord
 	`
-	for {
+	err = cache.db.retry(ctx, "overlaycache.GetNodesNetworkInOrder", func(ctx context.Context) (err error) {
 		nodeNets, err = cache.getNodesNetwork(ctx, nodeIDs, query)
-		if err != nil {
-			if cockroachutil.NeedsRetry(err) {
-				continue
-			}
-			return nodeNets, err
-		}
-		break
-	}
-
+		return err
+	})
 	return nodeNets, err
 }
 
@@ -266,17 +259,10 @@ func (cache *overlaycache) Get(ctx context.Context, id storj.NodeID) (dossier *o
 
 // GetOnlineNodesForGetDelete returns a map of nodes for the supplied nodeIDs.
 func (cache *overlaycache) GetOnlineNodesForGetDelete(ctx context.Context, nodeIDs []storj.NodeID, onlineWindow time.Duration, asOf overlay.AsOfSystemTimeConfig) (nodes map[storj.NodeID]*overlay.SelectedNode, err error) {
-	for {
+	err = cache.db.retry(ctx, "overlaycache.GetOnlineNodesForGetDelete", func(ctx context.Context) (err error) {
 		nodes, err = cache.getOnlineNodesForGetDelete(ctx, nodeIDs, onlineWindow, asOf)
-		if err != nil {
-			if cockroachutil.NeedsRetry(err) {
-				continue
-			}
-			return nodes, err
-		}
-		break
-	}
-
+		return err
+	})
 	return nodes, err
 }
 
@@ -322,17 +308,10 @@ func (cache *overlaycache) getOnlineNodesForGetDelete(ctx context.Context, nodeI
 
 // GetOnlineNodesForAuditRepair returns a map of nodes for the supplied nodeIDs.
 func (cache *overlaycache) GetOnlineNodesForAuditRepair(ctx context.Context, nodeIDs []storj.NodeID, onlineWindow time.Duration) (nodes map[storj.NodeID]*overlay.NodeReputation, err error) {
-	for {
+	err = cache.db.retry(ctx, "overlaycache.GetOnlineNodesForAuditRepair", func(ctx context.Context) (err error) {
 		nodes, err = cache.getOnlineNodesForAuditRepair(ctx, nodeIDs, onlineWindow)
-		if err != nil {
-			if cockroachutil.NeedsRetry(err) {
-				continue
-			}
-			return nodes, err
-		}
-		break
-	}
-
+		return err
+	})
 	return nodes, err
 }
 
@@ -342,7 +321,7 @@ func (cache *overlaycache) getOnlineNodesForAuditRepair(ctx context.Context, nod
 	var rows tagsql.Rows
 	rows, err = cache.db.Query(ctx, cache.db.Rebind(`
 		SELECT last_net, id, address, email, last_ip_port, noise_proto, noise_public_key, debounce_limit,
-			vetted_at, unknown_audit_suspended, offline_suspended
+			vetted_at, unknown_audit_suspended, offline_suspended, country_code
 		FROM nodes
 		WHERE id = any($1::bytea[])
 			AND disqualified IS NULL
@@ -361,7 +340,7 @@ func (cache *overlaycache) getOnlineNodesForAuditRepair(ctx context.Context, nod
 
 		var lastIPPort sql.NullString
 		var noise noiseScanner
-		err = rows.Scan(&node.LastNet, &node.ID, &node.Address.Address, &node.Reputation.Email, &lastIPPort, &noise.Proto, &noise.PublicKey, &node.Address.DebounceLimit, &node.Reputation.VettedAt, &node.Reputation.UnknownAuditSuspended, &node.Reputation.OfflineSuspended)
+		err = rows.Scan(&node.LastNet, &node.ID, &node.Address.Address, &node.Reputation.Email, &lastIPPort, &noise.Proto, &noise.PublicKey, &node.Address.DebounceLimit, &node.Reputation.VettedAt, &node.Reputation.UnknownAuditSuspended, &node.Reputation.OfflineSuspended, &node.CountryCode)
 		if err != nil {
 			return nil, err
 		}
@@ -378,17 +357,10 @@ func (cache *overlaycache) getOnlineNodesForAuditRepair(ctx context.Context, nod
 
 // KnownOffline filters a set of nodes to offline nodes.
 func (cache *overlaycache) KnownOffline(ctx context.Context, criteria *overlay.NodeCriteria, nodeIDs storj.NodeIDList) (offlineNodes storj.NodeIDList, err error) {
-	for {
+	err = cache.db.retry(ctx, "overlaycache.KnownOffline", func(ctx context.Context) (err error) {
 		offlineNodes, err = cache.knownOffline(ctx, criteria, nodeIDs)
-		if err != nil {
-			if cockroachutil.NeedsRetry(err) {
-				continue
-			}
-			return offlineNodes, err
-		}
-		break
-	}
-
+		return err
+	})
 	return offlineNodes, err
 }
 
@@ -426,17 +398,10 @@ func (cache *overlaycache) knownOffline(ctx context.Context, criteria *overlay.N
 
 // KnownUnreliableOrOffline filters a set of nodes to unreliable or offlines node, independent of new.
 func (cache *overlaycache) KnownUnreliableOrOffline(ctx context.Context, criteria *overlay.NodeCriteria, nodeIDs storj.NodeIDList) (badNodes storj.NodeIDList, err error) {
-	for {
+	err = cache.db.retry(ctx, "overlaycache.KnownUnreliableOrOffline", func(ctx context.Context) (err error) {
 		badNodes, err = cache.knownUnreliableOrOffline(ctx, criteria, nodeIDs)
-		if err != nil {
-			if cockroachutil.NeedsRetry(err) {
-				continue
-			}
-			return badNodes, err
-		}
-		break
-	}
-
+		return err
+	})
 	return badNodes, err
 }
 
@@ -493,17 +458,10 @@ func (cache *overlaycache) UpdateLastOfflineEmail(ctx context.Context, nodeIDs s
 
 // KnownReliableInExcludedCountries filters healthy nodes that are in excluded countries.
 func (cache *overlaycache) KnownReliableInExcludedCountries(ctx context.Context, criteria *overlay.NodeCriteria, nodeIDs storj.NodeIDList) (reliableInExcluded storj.NodeIDList, err error) {
-	for {
+	err = cache.db.retry(ctx, "overlaycache.KnownReliableInExcludedCountries", func(ctx context.Context) (err error) {
 		reliableInExcluded, err = cache.knownReliableInExcludedCountries(ctx, criteria, nodeIDs)
-		if err != nil {
-			if cockroachutil.NeedsRetry(err) {
-				continue
-			}
-			return reliableInExcluded, err
-		}
-		break
-	}
-
+		return err
+	})
 	return reliableInExcluded, err
 }
 
@@ -610,17 +568,10 @@ func (cache *overlaycache) knownUnreliableOrOffline(ctx context.Context, criteri
 
 // KnownReliable filters a set of nodes to reliable (online and qualified) nodes.
 func (cache *overlaycache) KnownReliable(ctx context.Context, onlineWindow time.Duration, nodeIDs storj.NodeIDList) (nodes []*pb.Node, err error) {
-	for {
+	err = cache.db.retry(ctx, "overlaycache.KnownReliable", func(ctx context.Context) (err error) {
 		nodes, err = cache.knownReliable(ctx, onlineWindow, nodeIDs)
-		if err != nil {
-			if cockroachutil.NeedsRetry(err) {
-				continue
-			}
-			return nodes, err
-		}
-		break
-	}
-
+		return err
+	})
 	return nodes, err
 }
 
@@ -665,21 +616,30 @@ func (cache *overlaycache) knownReliable(ctx context.Context, onlineWindow time.
 
 // Reliable returns all reliable nodes.
 func (cache *overlaycache) Reliable(ctx context.Context, criteria *overlay.NodeCriteria) (nodes storj.NodeIDList, err error) {
-	for {
-		nodes, err = cache.reliable(ctx, criteria)
+	err = cache.db.retry(ctx, "overlaycache.Reliable", func(ctx context.Context) (err error) {
+		lastContacts, err := cache.reliableWithLastContact(ctx, criteria)
 		if err != nil {
-			if cockroachutil.NeedsRetry(err) {
-				continue
-			}
-			return nodes, err
+			return err
 		}
-		break
-	}
-
+		nodes = make(storj.NodeIDList, 0, len(lastContacts))
+		for id := range lastContacts {
+			nodes = append(nodes, id)
+		}
+		return nil
+	})
 	return nodes, err
 }
 
-func (cache *overlaycache) reliable(ctx context.Context, criteria *overlay.NodeCriteria) (nodes storj.NodeIDList, err error) {
+// ReliableWithLastContact returns all reliable nodes with their last successful contact.
+func (cache *overlaycache) ReliableWithLastContact(ctx context.Context, criteria *overlay.NodeCriteria) (lastContacts map[storj.NodeID]time.Time, err error) {
+	err = cache.db.retry(ctx, "overlaycache.ReliableWithLastContact", func(ctx context.Context) (err error) {
+		lastContacts, err = cache.reliableWithLastContact(ctx, criteria)
+		return err
+	})
+	return lastContacts, err
+}
+
+func (cache *overlaycache) reliableWithLastContact(ctx context.Context, criteria *overlay.NodeCriteria) (lastContacts map[storj.NodeID]time.Time, err error) {
 	args := []interface{}{
 		time.Now().Add(-criteria.OnlineWindow),
 	}
@@ -694,7 +654,7 @@ func (cache *overlaycache) reliable(ctx context.Context, criteria *overlay.NodeC
 
 	// get reliable and online nodes
 	rows, err := cache.db.Query(ctx, cache.db.Rebind(`
-		SELECT id
+		SELECT id, last_contact_success
 		FROM nodes
 		`+cache.db.impl.AsOfSystemInterval(criteria.AsOfSystemInterval)+`
 		WHERE disqualified IS NULL
@@ -711,15 +671,17 @@ func (cache *overlaycache) reliable(ctx context.Context, criteria *overlay.NodeC
 		err = errs.Combine(err, rows.Close())
 	}()
 
+	lastContacts = make(map[storj.NodeID]time.Time)
 	for rows.Next() {
 		var id storj.NodeID
-		err = rows.Scan(&id)
+		var lastContactSuccess time.Time
+		err = rows.Scan(&id, &lastContactSuccess)
 		if err != nil {
 			return nil, err
 		}
-		nodes = append(nodes, id)
+		lastContacts[id] = lastContactSuccess
 	}
-	return nodes, Error.Wrap(rows.Err())
+	return lastContacts, Error.Wrap(rows.Err())
 }
 
 // UpdateReputation updates the DB columns for any of the reputation fields in ReputationUpdate.
@@ -737,7 +699,11 @@ func (cache *overlaycache) UpdateReputation(ctx context.Context, id storj.NodeID
 	}
 
 	err = cache.db.UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_ExitFinishedAt_Is_Null(ctx, dbx.Node_Id(id.Bytes()), updateFields)
-	return Error.Wrap(err)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	cache.db.publish(ctx, changes.TableNodes, changes.OpUpdate, id.String())
+	return nil
 }
 
 // UpdateNodeInfo updates the following fields for a given node ID:
@@ -781,6 +747,7 @@ func (cache *overlaycache) UpdateNodeInfo(ctx context.Context, nodeID storj.Node
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
+	cache.db.publish(ctx, changes.TableNodes, changes.OpUpdate, nodeID.String())
 
 	return convertDBNode(ctx, updatedDBNode)
 }
@@ -799,6 +766,27 @@ func (cache *overlaycache) DisqualifyNode(ctx context.Context, nodeID storj.Node
 	if dbNode == nil {
 		return "", errs.New("unable to get node by ID: %v", nodeID)
 	}
+	cache.db.publish(ctx, changes.TableNodes, changes.OpUpdate, nodeID.String())
+	return dbNode.Email, nil
+}
+
+// ReinstateNode clears the disqualification and the suspensions of a storage node.
+func (cache *overlaycache) ReinstateNode(ctx context.Context, nodeID storj.NodeID) (email string, err error) {
+	defer mon.Task()(&ctx)(&err)
+	updateFields := dbx.Node_Update_Fields{}
+	updateFields.Disqualified = dbx.Node_Disqualified_Null()
+	updateFields.DisqualificationReason = dbx.Node_DisqualificationReason_Null()
+	updateFields.UnknownAuditSuspended = dbx.Node_UnknownAuditSuspended_Null()
+	updateFields.OfflineSuspended = dbx.Node_OfflineSuspended_Null()
+
+	dbNode, err := cache.db.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
+	if err != nil {
+		return "", err
+	}
+	if dbNode == nil {
+		return "", overlay.ErrNodeNotFound.New("%v", nodeID)
+	}
+	cache.db.publish(ctx, changes.TableNodes, changes.OpUpdate, nodeID.String())
 	return dbNode.Email, nil
 }
 
@@ -902,19 +890,108 @@ func (cache *overlaycache) UpdatePieceCounts(ctx context.Context, pieceCounts ma
 	return Error.Wrap(err)
 }
 
-// GetExitingNodes returns nodes who have initiated a graceful exit and is not disqualified, but have not completed it.
-func (cache *overlaycache) GetExitingNodes(ctx context.Context) (exitingNodes []*overlay.ExitStatus, err error) {
-	for {
-		exitingNodes, err = cache.getExitingNodes(ctx)
+// UpdateStorageEstimates replaces the storage estimates of the nodes and sets their piece counts.
+func (cache *overlaycache) UpdateStorageEstimates(ctx context.Context, estimates []overlay.StorageEstimate) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if len(estimates) == 0 {
+		return nil
+	}
+
+	estimates = append([]overlay.StorageEstimate(nil), estimates...)
+	sort.Slice(estimates, func(i, k int) bool {
+		return estimates[i].NodeID.Less(estimates[k].NodeID)
+	})
+
+	var nodeIDs []storj.NodeID
+	var pieceCounts, storedBytes []int64
+	var estimatedAt []time.Time
+	for _, estimate := range estimates {
+		nodeIDs = append(nodeIDs, estimate.NodeID)
+		pieceCounts = append(pieceCounts, estimate.PieceCount)
+		storedBytes = append(storedBytes, estimate.StoredBytes)
+		estimatedAt = append(estimatedAt, estimate.EstimatedAt)
+	}
+
+	return Error.Wrap(cache.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Tx.ExecContext(ctx, `
+			INSERT INTO node_storage_estimates (
+				node_id, piece_count, stored_bytes, settled_bytes, estimated_at, updated_at
+			)
+			SELECT
+				unnest($1::bytea[]), unnest($2::int8[]), unnest($3::int8[]), 0, unnest($4::timestamptz[]), now()
+			ON CONFLICT (node_id) DO UPDATE SET
+				piece_count   = EXCLUDED.piece_count,
+				stored_bytes  = EXCLUDED.stored_bytes,
+				settled_bytes = 0,
+				estimated_at  = EXCLUDED.estimated_at,
+				updated_at    = now()
+		`, pgutil.NodeIDArray(nodeIDs), pgutil.Int8Array(pieceCounts), pgutil.Int8Array(storedBytes), pgutil.TimestampTZArray(estimatedAt))
 		if err != nil {
-			if cockroachutil.NeedsRetry(err) {
-				continue
-			}
-			return exitingNodes, err
+			return err
 		}
-		break
+
+		_, err = tx.Tx.ExecContext(ctx, `
+			UPDATE nodes
+				SET piece_count = update.count
+			FROM (
+				SELECT unnest($1::bytea[]) as id, unnest($2::bigint[]) as count
+			) as update
+			WHERE nodes.id = update.id
+		`, pgutil.NodeIDArray(nodeIDs), pgutil.Int8Array(pieceCounts))
+		return err
+	}))
+}
+
+// UpdateStorageEstimatesSettled sets the ingress settled since the storage estimates
+// from the storage node bandwidth rollups.
+func (cache *overlaycache) UpdateStorageEstimatesSettled(ctx context.Context) (updated int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := cache.db.ExecContext(ctx, `
+		UPDATE node_storage_estimates
+			SET settled_bytes = coalesce((
+				SELECT sum(rollups.settled)
+				FROM storagenode_bandwidth_rollups AS rollups
+				WHERE rollups.storagenode_id = node_storage_estimates.node_id
+					AND rollups.interval_start >= node_storage_estimates.estimated_at
+					AND rollups.action = ANY($1::int4[])
+			), 0),
+			updated_at = now()
+	`, pgutil.Int4Array([]int32{
+		int32(pb.PieceAction_PUT),
+		int32(pb.PieceAction_PUT_REPAIR),
+		int32(pb.PieceAction_PUT_GRACEFUL_EXIT),
+	}))
+	if err != nil {
+		return 0, Error.Wrap(err)
 	}
 
+	updated, err = result.RowsAffected()
+	return updated, Error.Wrap(err)
+}
+
+// GetStorageEstimate returns the storage estimate of the node.
+func (cache *overlaycache) GetStorageEstimate(ctx context.Context, nodeID storj.NodeID) (_ overlay.StorageEstimate, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	estimate := overlay.StorageEstimate{NodeID: nodeID}
+	err = cache.db.QueryRowContext(ctx, `
+		SELECT piece_count, stored_bytes, settled_bytes, estimated_at, updated_at
+		FROM node_storage_estimates
+		WHERE node_id = $1
+	`, nodeID.Bytes()).Scan(&estimate.PieceCount, &estimate.StoredBytes, &estimate.SettledBytes, &estimate.EstimatedAt, &estimate.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return overlay.StorageEstimate{}, overlay.ErrNodeNotFound.New("%v", nodeID)
+	}
+	return estimate, Error.Wrap(err)
+}
+
+// GetExitingNodes returns nodes who have initiated a graceful exit and is not disqualified, but have not completed it.
+func (cache *overlaycache) GetExitingNodes(ctx context.Context) (exitingNodes []*overlay.ExitStatus, err error) {
+	err = cache.db.retry(ctx, "overlaycache.GetExitingNodes", func(ctx context.Context) (err error) {
+		exitingNodes, err = cache.getExitingNodes(ctx)
+		return err
+	})
 	return exitingNodes, err
 }
 
@@ -945,17 +1022,10 @@ func (cache *overlaycache) getExitingNodes(ctx context.Context) (exitingNodes []
 
 // GetExitStatus returns a node's graceful exit status.
 func (cache *overlaycache) GetExitStatus(ctx context.Context, nodeID storj.NodeID) (exitStatus *overlay.ExitStatus, err error) {
-	for {
+	err = cache.db.retry(ctx, "overlaycache.GetExitStatus", func(ctx context.Context) (err error) {
 		exitStatus, err = cache.getExitStatus(ctx, nodeID)
-		if err != nil {
-			if cockroachutil.NeedsRetry(err) {
-				continue
-			}
-			return exitStatus, err
-		}
-		break
-	}
-
+		return err
+	})
 	return exitStatus, err
 }
 
@@ -985,17 +1055,10 @@ func (cache *overlaycache) getExitStatus(ctx context.Context, nodeID storj.NodeI
 
 // GetGracefulExitCompletedByTimeFrame returns nodes who have completed graceful exit within a time window (time window is around graceful exit completion).
 func (cache *overlaycache) GetGracefulExitCompletedByTimeFrame(ctx context.Context, begin, end time.Time) (exitedNodes storj.NodeIDList, err error) {
-	for {
+	err = cache.db.retry(ctx, "overlaycache.GetGracefulExitCompletedByTimeFrame", func(ctx context.Context) (err error) {
 		exitedNodes, err = cache.getGracefulExitCompletedByTimeFrame(ctx, begin, end)
-		if err != nil {
-			if cockroachutil.NeedsRetry(err) {
-				continue
-			}
-			return exitedNodes, err
-		}
-		break
-	}
-
+		return err
+	})
 	return exitedNodes, err
 }
 
@@ -1029,17 +1092,10 @@ func (cache *overlaycache) getGracefulExitCompletedByTimeFrame(ctx context.Conte
 
 // GetGracefulExitIncompleteByTimeFrame returns nodes who have initiated, but not completed graceful exit within a time window (time window is around graceful exit initiation).
 func (cache *overlaycache) GetGracefulExitIncompleteByTimeFrame(ctx context.Context, begin, end time.Time) (exitingNodes storj.NodeIDList, err error) {
-	for {
+	err = cache.db.retry(ctx, "overlaycache.GetGracefulExitIncompleteByTimeFrame", func(ctx context.Context) (err error) {
 		exitingNodes, err = cache.getGracefulExitIncompleteByTimeFrame(ctx, begin, end)
-		if err != nil {
-			if cockroachutil.NeedsRetry(err) {
-				continue
-			}
-			return exitingNodes, err
-		}
-		break
-	}
-
+		return err
+	})
 	return exitingNodes, err
 }
 
@@ -1088,6 +1144,7 @@ func (cache *overlaycache) UpdateExitStatus(ctx context.Context, request *overla
 	if dbNode == nil {
 		return nil, Error.Wrap(errs.New("unable to get node by ID: %v", nodeID))
 	}
+	cache.db.publish(ctx, changes.TableNodes, changes.OpUpdate, nodeID.String())
 
 	return convertDBNode(ctx, dbNode)
 }
@@ -1212,6 +1269,31 @@ func decodeWalletFeatures(encoded string) []string {
 	return strings.Split(encoded, ",")
 }
 
+// encodeHashAlgorithms encodes hash algorithms into comma separated list string.
+func encodeHashAlgorithms(algorithms []pb.PieceHashAlgorithm) string {
+	encoded := make([]string, 0, len(algorithms))
+	for _, algorithm := range algorithms {
+		encoded = append(encoded, strconv.Itoa(int(algorithm)))
+	}
+	return strings.Join(encoded, ",")
+}
+
+// decodeHashAlgorithms decodes comma separated hash algorithms list string.
+func decodeHashAlgorithms(encoded string) (algorithms []pb.PieceHashAlgorithm, err error) {
+	if encoded == "" {
+		return nil, nil
+	}
+
+	for _, value := range strings.Split(encoded, ",") {
+		algorithm, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, err
+		}
+		algorithms = append(algorithms, pb.PieceHashAlgorithm(algorithm))
+	}
+	return algorithms, nil
+}
+
 func getNodeStats(dbNode *dbx.Node) *overlay.NodeStats {
 	nodeStats := &overlay.NodeStats{
 		Latency90:          dbNode.Latency90,
@@ -1236,18 +1318,15 @@ func (cache *overlaycache) DQNodesLastSeenBefore(ctx context.Context, cutoff tim
 
 	var nodeIDs []storj.NodeID
 	nodeEmails = make(map[storj.NodeID]string)
-	for {
+	err = cache.db.retry(ctx, "overlaycache.DQNodesLastSeenBefore", func(ctx context.Context) (err error) {
 		nodeIDs, err = cache.getNodesForDQLastSeenBefore(ctx, cutoff, limit)
-		if err != nil {
-			if cockroachutil.NeedsRetry(err) {
-				continue
-			}
-			return nil, 0, err
-		}
-		if len(nodeIDs) == 0 {
-			return nil, 0, nil
-		}
-		break
+		return err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(nodeIDs) == 0 {
+		return nil, 0, nil
 	}
 
 	var rows tagsql.Rows
@@ -1416,6 +1495,7 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 		return Error.Wrap(err)
 	}
 	if updated {
+		cache.db.publish(ctx, changes.TableNodes, changes.OpUpdate, node.NodeID.String())
 		return nil
 	}
 
@@ -1507,10 +1587,134 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 	if err != nil {
 		return Error.Wrap(err)
 	}
+	// the node might have been updated instead of inserted.
+	cache.db.publish(ctx, changes.TableNodes, changes.OpUpdate, node.NodeID.String())
 
 	return nil
 }
 
+// UpdateNodeCapabilities replaces the capabilities advertised by the node.
+func (cache *overlaycache) UpdateNodeCapabilities(ctx context.Context, nodeID storj.NodeID, capabilities overlay.NodeCapabilities) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = cache.db.ExecContext(ctx, `
+		INSERT INTO node_capabilities (
+			node_id, hash_algorithms, tcp_fast_open, noise, max_piece_size, updated_at
+		) VALUES ($1, $2, $3, $4, $5, current_timestamp)
+		ON CONFLICT (node_id) DO UPDATE SET
+			hash_algorithms = EXCLUDED.hash_algorithms,
+			tcp_fast_open = EXCLUDED.tcp_fast_open,
+			noise = EXCLUDED.noise,
+			max_piece_size = EXCLUDED.max_piece_size,
+			updated_at = EXCLUDED.updated_at
+	`, nodeID.Bytes(), encodeHashAlgorithms(capabilities.HashAlgorithms),
+		capabilities.TCPFastOpen, capabilities.Noise, capabilities.MaxPieceSize)
+	return Error.Wrap(err)
+}
+
+// GetNodeCapabilities returns the capabilities advertised by the node, or nil when the node hasn't advertised any.
+func (cache *overlaycache) GetNodeCapabilities(ctx context.Context, nodeID storj.NodeID) (_ *overlay.NodeCapabilities, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var capabilities overlay.NodeCapabilities
+	var hashAlgorithms string
+	err = cache.db.QueryRowContext(ctx, `
+		SELECT hash_algorithms, tcp_fast_open, noise, max_piece_size, updated_at
+		FROM node_capabilities
+		WHERE node_id = $1
+	`, nodeID.Bytes()).Scan(&hashAlgorithms, &capabilities.TCPFastOpen, &capabilities.Noise, &capabilities.MaxPieceSize, &capabilities.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	capabilities.HashAlgorithms, err = decodeHashAlgorithms(hashAlgorithms)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &capabilities, nil
+}
+
+// InsertMaintenanceWindow inserts the maintenance window of the node.
+func (cache *overlaycache) InsertMaintenanceWindow(ctx context.Context, window overlay.NodeMaintenanceWindow) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = cache.db.ExecContext(ctx, `
+		INSERT INTO node_maintenance_windows (
+			id, node_id, starts_at, ends_at, reason, offline_audits, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, window.ID, window.NodeID, window.StartsAt, window.EndsAt, window.Reason, window.OfflineAudits, window.CreatedAt)
+	return Error.Wrap(err)
+}
+
+// GetMaintenanceWindows returns the maintenance windows of the node, which end after the time,
+// ordered by their start.
+func (cache *overlaycache) GetMaintenanceWindows(ctx context.Context, nodeID storj.NodeID, endsAfter time.Time) (windows []overlay.NodeMaintenanceWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.QueryContext(ctx, `
+		SELECT id, starts_at, ends_at, reason, offline_audits, created_at
+		FROM node_maintenance_windows
+		WHERE node_id = $1 AND ends_at > $2
+		ORDER BY starts_at
+	`, nodeID, endsAfter)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		window := overlay.NodeMaintenanceWindow{NodeID: nodeID}
+		err := rows.Scan(&window.ID, &window.StartsAt, &window.EndsAt, &window.Reason, &window.OfflineAudits, &window.CreatedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		windows = append(windows, window)
+	}
+	return windows, Error.Wrap(rows.Err())
+}
+
+// DeleteMaintenanceWindow deletes the maintenance window of the node, when it starts after the time.
+func (cache *overlaycache) DeleteMaintenanceWindow(ctx context.Context, nodeID storj.NodeID, id uuid.UUID, startsAfter time.Time) (deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := cache.db.ExecContext(ctx, `
+		DELETE FROM node_maintenance_windows
+		WHERE node_id = $1 AND id = $2 AND starts_at > $3
+	`, nodeID, id, startsAfter)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	return affected > 0, nil
+}
+
+// RecordMaintenanceOfflineAudit increments the offline audits of the maintenance window of the
+// node, which contains the time. It returns whether there is such a window.
+func (cache *overlaycache) RecordMaintenanceOfflineAudit(ctx context.Context, nodeID storj.NodeID, at time.Time) (inMaintenance bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := cache.db.ExecContext(ctx, `
+		UPDATE node_maintenance_windows
+		SET offline_audits = offline_audits + 1
+		WHERE node_id = $1 AND starts_at <= $2 AND ends_at > $2
+	`, nodeID, at)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	return affected > 0, nil
+}
+
 // SetNodeContained updates the contained field for the node record. If
 // `contained` is true, the contained field in the record is set to the current
 // database time, if it is not already set. If `contained` is false, the
@@ -1533,7 +1737,11 @@ func (cache *overlaycache) SetNodeContained(ctx context.Context, nodeID storj.No
 		`
 	}
 	_, err = cache.db.DB.ExecContext(ctx, query, nodeID[:])
-	return Error.Wrap(err)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	cache.db.publish(ctx, changes.TableNodes, changes.OpUpdate, nodeID.String())
+	return nil
 }
 
 // SetAllContainedNodes updates the contained field for all nodes, as necessary.
@@ -1711,6 +1919,24 @@ func (n *noiseScanner) Convert() *pb.NoiseInfo {
 	}
 }
 
+// capabilitiesScanner scans the node_capabilities columns of a node, which may not have
+// advertised its capabilities.
+type capabilitiesScanner struct {
+	Noise        sql.NullBool
+	MaxPieceSize sql.NullInt64
+}
+
+// Apply applies the advertised capabilities to the selected node. The noise info is
+// dropped when the node advertised that it doesn't accept noise connections.
+func (c *capabilitiesScanner) Apply(node *overlay.SelectedNode) {
+	if c.Noise.Valid && !c.Noise.Bool {
+		node.Address.NoiseInfo = nil
+	}
+	if c.MaxPieceSize.Valid {
+		node.MaxPieceSize = c.MaxPieceSize.Int64
+	}
+}
+
 // OneTimeFixLastNets updates the last_net values for all node records to be equal to their
 // last_ip_port values.
 //
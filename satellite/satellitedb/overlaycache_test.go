@@ -358,3 +358,87 @@ func TestGetNodesNetwork(t *testing.T) {
 		})
 	})
 }
+
+func TestMaintenanceWindows(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		cache := db.OverlayCache()
+
+		nodeID := testrand.NodeID()
+		now := time.Now().Truncate(time.Second)
+
+		past := overlay.NodeMaintenanceWindow{
+			ID:        testrand.UUID(),
+			NodeID:    nodeID,
+			StartsAt:  now.Add(-48 * time.Hour),
+			EndsAt:    now.Add(-46 * time.Hour),
+			Reason:    "past",
+			CreatedAt: now.Add(-72 * time.Hour),
+		}
+		current := overlay.NodeMaintenanceWindow{
+			ID:        testrand.UUID(),
+			NodeID:    nodeID,
+			StartsAt:  now.Add(-time.Hour),
+			EndsAt:    now.Add(time.Hour),
+			Reason:    "current",
+			CreatedAt: now.Add(-48 * time.Hour),
+		}
+		upcoming := overlay.NodeMaintenanceWindow{
+			ID:        testrand.UUID(),
+			NodeID:    nodeID,
+			StartsAt:  now.Add(24 * time.Hour),
+			EndsAt:    now.Add(26 * time.Hour),
+			Reason:    "upcoming",
+			CreatedAt: now,
+		}
+		for _, window := range []overlay.NodeMaintenanceWindow{upcoming, past, current} {
+			require.NoError(t, cache.InsertMaintenanceWindow(ctx, window))
+		}
+
+		// another node
+		inMaintenance, err := cache.RecordMaintenanceOfflineAudit(ctx, testrand.NodeID(), now)
+		require.NoError(t, err)
+		require.False(t, inMaintenance)
+
+		inMaintenance, err = cache.RecordMaintenanceOfflineAudit(ctx, nodeID, now.Add(2*time.Hour))
+		require.NoError(t, err)
+		require.False(t, inMaintenance)
+
+		for i := 0; i < 2; i++ {
+			inMaintenance, err = cache.RecordMaintenanceOfflineAudit(ctx, nodeID, now)
+			require.NoError(t, err)
+			require.True(t, inMaintenance)
+		}
+
+		windows, err := cache.GetMaintenanceWindows(ctx, nodeID, now.Add(-72*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, windows, 3)
+		require.Equal(t, past.ID, windows[0].ID)
+		require.Equal(t, current.ID, windows[1].ID)
+		require.Equal(t, upcoming.ID, windows[2].ID)
+		require.EqualValues(t, 2, windows[1].OfflineAudits)
+		require.Equal(t, "current", windows[1].Reason)
+		require.WithinDuration(t, current.StartsAt, windows[1].StartsAt, 0)
+
+		windows, err = cache.GetMaintenanceWindows(ctx, nodeID, now)
+		require.NoError(t, err)
+		require.Len(t, windows, 2)
+
+		// the windows, which have already started, can't be deleted.
+		deleted, err := cache.DeleteMaintenanceWindow(ctx, nodeID, current.ID, now)
+		require.NoError(t, err)
+		require.False(t, deleted)
+
+		deleted, err = cache.DeleteMaintenanceWindow(ctx, testrand.NodeID(), upcoming.ID, now)
+		require.NoError(t, err)
+		require.False(t, deleted)
+
+		deleted, err = cache.DeleteMaintenanceWindow(ctx, nodeID, upcoming.ID, now)
+		require.NoError(t, err)
+		require.True(t, deleted)
+
+		windows, err = cache.GetMaintenanceWindows(ctx, nodeID, now)
+		require.NoError(t, err)
+		require.Len(t, windows, 1)
+		require.Equal(t, current.ID, windows[0].ID)
+	})
+}
@@ -7,8 +7,12 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
 
 	"storj.io/common/storj"
+	"storj.io/storj/private/blockchain"
 	"storj.io/storj/satellite/satellitedb/dbx"
 	"storj.io/storj/satellite/snopayouts"
 )
@@ -101,7 +105,18 @@ func (db *snopayoutsDB) GetPayment(ctx context.Context, nodeID storj.NodeID, per
 	case 0:
 		return snopayouts.Payment{}, snopayouts.ErrNoDataForPeriod.Wrap(sql.ErrNoRows)
 	case 1:
-		return convertDBXPayment(dbxPayments[0])
+		payment, err := convertDBXPayment(dbxPayments[0])
+		if err != nil {
+			return snopayouts.Payment{}, err
+		}
+
+		transactions, err := db.getPaymentTransactions(ctx, nodeID)
+		if err != nil {
+			return snopayouts.Payment{}, err
+		}
+		payment.Transaction = transactions[payment.ID]
+
+		return payment, nil
 	default:
 		return snopayouts.Payment{}, Error.New("impossible number of rows returned: %d", len(dbxPayments))
 	}
@@ -115,17 +130,123 @@ func (db *snopayoutsDB) GetAllPayments(ctx context.Context, nodeID storj.NodeID)
 		return nil, Error.Wrap(err)
 	}
 
+	transactions, err := db.getPaymentTransactions(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, dbxPayment := range dbxPayments {
 		payment, err := convertDBXPayment(dbxPayment)
 		if err != nil {
 			return nil, Error.Wrap(err)
 		}
+		payment.Transaction = transactions[payment.ID]
 		payments = append(payments, payment)
 	}
 
 	return payments, nil
 }
 
+// GetHeldReleases returns the scheduled held amount releases of the node ordered by the period.
+func (db *snopayoutsDB) GetHeldReleases(ctx context.Context, nodeID storj.NodeID) (_ []snopayouts.HeldRelease, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, db.db.Rebind(`
+		SELECT period, amount, created_at
+		FROM storagenode_held_releases
+		WHERE node_id = ?
+		ORDER BY period
+	`), nodeID.Bytes())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var releases []snopayouts.HeldRelease
+	for rows.Next() {
+		release := snopayouts.HeldRelease{NodeID: nodeID}
+		if err := rows.Scan(&release.Period, &release.Amount, &release.Created); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		releases = append(releases, release)
+	}
+
+	return releases, Error.Wrap(rows.Err())
+}
+
+// getPaymentTransactions returns the on-chain transactions of the payments of the node
+// by the payment ID.
+func (db *snopayoutsDB) getPaymentTransactions(ctx context.Context, nodeID storj.NodeID) (_ map[int64]*blockchain.Transaction, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, db.db.Rebind(`
+		SELECT transactions.payment_id, transactions.chain, transactions.tx_hash, transactions.layer2
+		FROM storagenode_payment_transactions AS transactions
+		JOIN storagenode_payments AS payments ON payments.id = transactions.payment_id
+		WHERE payments.node_id = ?
+	`), nodeID.Bytes())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	transactions := map[int64]*blockchain.Transaction{}
+	for rows.Next() {
+		var paymentID int64
+		var chain string
+		var hash []byte
+		var layer2 bool
+		if err := rows.Scan(&paymentID, &chain, &hash, &layer2); err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		transaction := &blockchain.Transaction{
+			Chain:  blockchain.Chain(chain),
+			Layer2: layer2,
+		}
+		transaction.Hash, err = blockchain.BytesToHash(hash)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		transactions[paymentID] = transaction
+	}
+
+	return transactions, Error.Wrap(rows.Err())
+}
+
+// createPayment inserts the payment. When the receipt references a transaction on a
+// supported chain, the transaction is stored as well, so that the nodes can verify the
+// payment on the chain. Receipts of payments made by other means are only kept as text.
+func createPayment(ctx context.Context, db *satelliteDB, tx *dbx.Tx, nodeID storj.NodeID, period string, amount int64, receipt, notes *string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var paymentID int64
+	err = tx.Tx.QueryRowContext(ctx, db.Rebind(`
+		INSERT INTO storagenode_payments (
+			created_at, node_id, period, amount, receipt, notes
+		) VALUES (?, ?, ?, ?, ?, ?)
+		RETURNING id
+	`), time.Now().UTC(), nodeID.Bytes(), period, amount, receipt, notes).Scan(&paymentID)
+	if err != nil {
+		return err
+	}
+
+	if receipt == nil {
+		return nil
+	}
+	transaction, err := blockchain.ParseReceipt(*receipt)
+	if err != nil {
+		return nil //nolint: nilerr // not an on-chain payment
+	}
+
+	_, err = tx.Tx.ExecContext(ctx, db.Rebind(`
+		INSERT INTO storagenode_payment_transactions (
+			payment_id, chain, tx_hash, layer2
+		) VALUES (?, ?, ?, ?)
+	`), paymentID, string(transaction.Chain), transaction.Hash.Bytes(), transaction.Layer2)
+	return err
+}
+
 func convertDBXPayment(dbxPayment *dbx.StoragenodePayment) (snopayouts.Payment, error) {
 	nodeID, err := storj.NodeIDFromBytes(dbxPayment.NodeId)
 	if err != nil {
@@ -182,13 +303,9 @@ func (db *snopayoutsDB) TestCreatePaystub(ctx context.Context, stub snopayouts.P
 
 // TestCreatePayment inserts storagenode_payment into database. Only used for tests.
 func (db *snopayoutsDB) TestCreatePayment(ctx context.Context, payment snopayouts.Payment) (err error) {
-	return db.db.CreateNoReturn_StoragenodePayment(ctx,
-		dbx.StoragenodePayment_NodeId(payment.NodeID.Bytes()),
-		dbx.StoragenodePayment_Period(payment.Period),
-		dbx.StoragenodePayment_Amount(payment.Amount),
-		dbx.StoragenodePayment_Create_Fields{
-			Receipt: dbx.StoragenodePayment_Receipt(payment.Receipt),
-			Notes:   dbx.StoragenodePayment_Notes(payment.Notes),
-		},
-	)
+	return Error.Wrap(db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		return createPayment(ctx, db.db, tx,
+			payment.NodeID, payment.Period, payment.Amount,
+			&payment.Receipt, &payment.Notes)
+	}))
 }
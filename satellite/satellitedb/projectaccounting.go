@@ -8,6 +8,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	pgxerrcode "github.com/jackc/pgerrcode"
@@ -22,6 +23,7 @@ import (
 	"storj.io/private/dbutil/pgutil"
 	"storj.io/private/dbutil/pgutil/pgerrcode"
 	"storj.io/private/dbutil/pgxutil"
+	"storj.io/private/tagsql"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/orders"
@@ -36,6 +38,16 @@ var allocatedExpirationInDays = 2
 // ProjectAccounting implements the accounting/db ProjectAccounting interface.
 type ProjectAccounting struct {
 	db *satelliteDB
+	// readDB serves the dashboard reads, which tolerate stale data.
+	readDB *satelliteDB
+}
+
+// forReads returns ProjectAccounting, which queries the database for reads.
+func (db *ProjectAccounting) forReads() *ProjectAccounting {
+	if db.readDB == nil {
+		return db
+	}
+	return &ProjectAccounting{db: db.readDB, readDB: db.readDB}
 }
 
 // SaveTallies saves the latest bucket info.
@@ -207,6 +219,7 @@ func (db *ProjectAccounting) GetProjectDailyBandwidth(ctx context.Context, proje
 // GetProjectDailyUsageByDateRange returns project daily allocated, settled bandwidth and storage usage by specific date range.
 func (db *ProjectAccounting) GetProjectDailyUsageByDateRange(ctx context.Context, projectID uuid.UUID, from, to time.Time, crdbInterval time.Duration) (_ *accounting.ProjectDailyUsage, err error) {
 	defer mon.Task()(&ctx)(&err)
+	db = db.forReads()
 
 	now := time.Now()
 	nowBeginningOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
@@ -366,6 +379,206 @@ func (db *ProjectAccounting) GetProjectDailyUsageByDateRange(ctx context.Context
 	}, nil
 }
 
+// RecomputeProjectDailyBandwidth recomputes the project's daily bandwidth rollups of the days in the period
+// from the bucket bandwidth rollups, including the archived ones.
+//
+// The dead bandwidth isn't recorded in the bucket bandwidth rollups, hence it's kept as long as it doesn't
+// exceed the allocated bandwidth, which wasn't settled.
+func (db *ProjectAccounting) RecomputeProjectDailyBandwidth(ctx context.Context, projectID uuid.UUID, since, before time.Time, dryRun bool) (corrections []accounting.ProjectDailyBandwidthCorrection, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	toDay := func(t time.Time) time.Time {
+		t = t.UTC()
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	since, before = toDay(since), toDay(before)
+
+	err = db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		days := make(map[time.Time]*accounting.ProjectDailyBandwidthCorrection)
+		getDay := func(t time.Time) *accounting.ProjectDailyBandwidthCorrection {
+			intervalDay := toDay(t)
+			correction, ok := days[intervalDay]
+			if !ok {
+				correction = &accounting.ProjectDailyBandwidthCorrection{
+					Before: accounting.ProjectDailyBandwidth{IntervalDay: intervalDay},
+					After:  accounting.ProjectDailyBandwidth{IntervalDay: intervalDay},
+				}
+				days[intervalDay] = correction
+			}
+			return correction
+		}
+
+		err := withRows(tx.Tx.QueryContext(ctx, `
+			SELECT interval_day, egress_allocated, egress_settled, egress_dead
+			FROM project_bandwidth_daily_rollups
+			WHERE project_id = $1 AND interval_day >= $2 AND interval_day < $3
+		`, projectID[:], since, before))(func(rows tagsql.Rows) error {
+			for rows.Next() {
+				var intervalDay time.Time
+				var allocated, settled, dead int64
+				if err := rows.Scan(&intervalDay, &allocated, &settled, &dead); err != nil {
+					return err
+				}
+				correction := getDay(intervalDay)
+				correction.Before.Allocated = allocated
+				correction.Before.Settled = settled
+				correction.Before.Dead = dead
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		err = withRows(tx.Tx.QueryContext(ctx, `
+			SELECT interval_start, allocated, settled
+			FROM bucket_bandwidth_rollups
+			WHERE project_id = $1 AND action = $2 AND interval_start >= $3 AND interval_start < $4
+			UNION ALL
+			SELECT interval_start, allocated, settled
+			FROM bucket_bandwidth_rollup_archives
+			WHERE project_id = $1 AND action = $2 AND interval_start >= $3 AND interval_start < $4
+		`, projectID[:], pb.PieceAction_GET, since, before))(func(rows tagsql.Rows) error {
+			for rows.Next() {
+				var intervalStart time.Time
+				var allocated, settled int64
+				if err := rows.Scan(&intervalStart, &allocated, &settled); err != nil {
+					return err
+				}
+				correction := getDay(intervalStart)
+				correction.After.Allocated += allocated
+				correction.After.Settled += settled
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		corrections = make([]accounting.ProjectDailyBandwidthCorrection, 0, len(days))
+		for _, correction := range days {
+			correction.After.Dead = correction.Before.Dead
+			if unsettled := correction.After.Allocated - correction.After.Settled; correction.After.Dead > unsettled {
+				correction.After.Dead = unsettled
+			}
+			if correction.After.Dead < 0 {
+				correction.After.Dead = 0
+			}
+			corrections = append(corrections, *correction)
+		}
+		sort.Slice(corrections, func(i, k int) bool {
+			return corrections[i].After.IntervalDay.Before(corrections[k].After.IntervalDay)
+		})
+
+		if dryRun {
+			return nil
+		}
+
+		var intervalDays []time.Time
+		var allocated, settled, dead []int64
+		for _, correction := range corrections {
+			if correction.Before == correction.After {
+				continue
+			}
+			intervalDays = append(intervalDays, correction.After.IntervalDay)
+			allocated = append(allocated, correction.After.Allocated)
+			settled = append(settled, correction.After.Settled)
+			dead = append(dead, correction.After.Dead)
+		}
+		if len(intervalDays) == 0 {
+			return nil
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `
+			INSERT INTO project_bandwidth_daily_rollups (project_id, interval_day, egress_allocated, egress_settled, egress_dead)
+				SELECT $1, unnest($2::date[]), unnest($3::bigint[]), unnest($4::bigint[]), unnest($5::bigint[])
+			ON CONFLICT (project_id, interval_day)
+			DO UPDATE SET
+				egress_allocated = EXCLUDED.egress_allocated,
+				egress_settled   = EXCLUDED.egress_settled,
+				egress_dead      = EXCLUDED.egress_dead
+		`, projectID[:], pgutil.DateArray(intervalDays), pgutil.Int8Array(allocated), pgutil.Int8Array(settled), pgutil.Int8Array(dead))
+		return err
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return corrections, nil
+}
+
+// UpdateAPIUsageRollups adds the request counts of the rollups to the hourly API usage rollups.
+func (db *ProjectAccounting) UpdateAPIUsageRollups(ctx context.Context, rollups []accounting.APIUsageRollup) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(rollups) == 0 {
+		return nil
+	}
+
+	// sort the rollups to avoid deadlocks between the concurrent batches.
+	sorted := append([]accounting.APIUsageRollup(nil), rollups...)
+	sort.Slice(sorted, func(i, k int) bool {
+		a, b := sorted[i], sorted[k]
+		if c := a.ProjectID.Compare(b.ProjectID); c != 0 {
+			return c < 0
+		}
+		if !a.IntervalStart.Equal(b.IntervalStart) {
+			return a.IntervalStart.Before(b.IntervalStart)
+		}
+		return a.Operation < b.Operation
+	})
+
+	var (
+		projectIDs     = make([]uuid.UUID, 0, len(sorted))
+		intervalStarts = make([]time.Time, 0, len(sorted))
+		operations     = make([]string, 0, len(sorted))
+		counts         = make([]int64, 0, len(sorted))
+	)
+	for _, rollup := range sorted {
+		projectIDs = append(projectIDs, rollup.ProjectID)
+		intervalStarts = append(intervalStarts, rollup.IntervalStart.UTC())
+		operations = append(operations, rollup.Operation)
+		counts = append(counts, rollup.Count)
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO project_api_usage_rollups (project_id, interval_start, operation, count)
+		SELECT unnest($1::bytea[]), unnest($2::timestamptz[]), unnest($3::text[]), unnest($4::bigint[])
+		ON CONFLICT (project_id, interval_start, operation)
+		DO UPDATE SET count = project_api_usage_rollups.count + EXCLUDED.count
+	`, pgutil.UUIDArray(projectIDs), pgutil.TimestampTZArray(intervalStarts), pgutil.TextArray(operations), pgutil.Int8Array(counts))
+	return Error.Wrap(err)
+}
+
+// GetAPIUsageRollups returns the hourly API usage rollups of the project for the hours from since, inclusive, to before, exclusive.
+func (db *ProjectAccounting) GetAPIUsageRollups(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ []accounting.APIUsageRollup, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT interval_start, operation, count
+		FROM project_api_usage_rollups
+		WHERE project_id = $1
+			AND interval_start >= $2
+			AND interval_start < $3
+		ORDER BY interval_start, operation
+	`, projectID, since.UTC(), before.UTC())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	rollups := []accounting.APIUsageRollup{}
+	for rows.Next() {
+		rollup := accounting.APIUsageRollup{ProjectID: projectID}
+		if err := rows.Scan(&rollup.IntervalStart, &rollup.Operation, &rollup.Count); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		rollup.IntervalStart = rollup.IntervalStart.UTC()
+		rollups = append(rollups, rollup)
+	}
+	return rollups, Error.Wrap(rows.Err())
+}
+
 // DeleteProjectBandwidthBefore deletes project bandwidth rollups before the given time.
 func (db *ProjectAccounting) DeleteProjectBandwidthBefore(ctx context.Context, before time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -448,6 +661,7 @@ func (db *ProjectAccounting) GetProjectBandwidthLimit(ctx context.Context, proje
 // GetProjectObjectsSegments returns project objects and segments number.
 func (db *ProjectAccounting) GetProjectObjectsSegments(ctx context.Context, projectID uuid.UUID) (objectsSegments accounting.ProjectObjectsSegments, err error) {
 	defer mon.Task()(&ctx)(&err)
+	db = db.forReads()
 
 	var latestDate time.Time
 	latestDateRow := db.db.QueryRowContext(ctx, db.db.Rebind(`
@@ -538,8 +752,10 @@ func (db *ProjectAccounting) GetProjectTotalByPartner(ctx context.Context, proje
 		ORDER BY bucket_storage_tallies.interval_start DESC
 	`)
 
+	// the repair and the audit egress is invoiced separately from the download egress.
 	totalEgressQuery := db.db.Rebind(`
 		SELECT
+			action,
 			COALESCE(SUM(settled) + SUM(inline), 0)
 		FROM
 			bucket_bandwidth_rollups
@@ -548,7 +764,8 @@ func (db *ProjectAccounting) GetProjectTotalByPartner(ctx context.Context, proje
 			bucket_name = ? AND
 			interval_start >= ? AND
 			interval_start < ? AND
-			action = ?;
+			action IN (?, ?, ?)
+		GROUP BY action;
 	`)
 
 	usages = make(map[string]accounting.ProjectUsage)
@@ -618,16 +835,33 @@ func (db *ProjectAccounting) GetProjectTotalByPartner(ctx context.Context, proje
 			return nil, err
 		}
 
-		totalEgressRow := db.db.QueryRowContext(ctx, totalEgressQuery, projectID[:], []byte(bucket), since, before, pb.PieceAction_GET)
+		totalEgressRows, err := db.db.QueryContext(ctx, totalEgressQuery, projectID[:], []byte(bucket), since, before,
+			pb.PieceAction_GET, pb.PieceAction_GET_REPAIR, pb.PieceAction_GET_AUDIT)
 		if err != nil {
 			return nil, err
 		}
 
-		var egress int64
-		if err = totalEgressRow.Scan(&egress); err != nil {
+		for totalEgressRows.Next() {
+			var action pb.PieceAction
+			var egress int64
+			if err = totalEgressRows.Scan(&action, &egress); err != nil {
+				return nil, errs.Combine(err, totalEgressRows.Close())
+			}
+
+			switch action {
+			case pb.PieceAction_GET:
+				usage.Egress += egress
+			case pb.PieceAction_GET_REPAIR:
+				usage.RepairEgress += egress
+			case pb.PieceAction_GET_AUDIT:
+				usage.AuditEgress += egress
+			}
+		}
+
+		err = errs.Combine(totalEgressRows.Err(), totalEgressRows.Close())
+		if err != nil {
 			return nil, err
 		}
-		usage.Egress += egress
 
 		usages[partner] = usage
 	}
@@ -638,6 +872,7 @@ func (db *ProjectAccounting) GetProjectTotalByPartner(ctx context.Context, proje
 // GetBucketUsageRollups retrieves summed usage rollups for every bucket of particular project for a given period.
 func (db *ProjectAccounting) GetBucketUsageRollups(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ []accounting.BucketUsageRollup, err error) {
 	defer mon.Task()(&ctx)(&err)
+	db = db.forReads()
 	since = timeTruncateDown(since.UTC())
 	before = before.UTC()
 
@@ -662,6 +897,7 @@ func (db *ProjectAccounting) GetBucketUsageRollups(ctx context.Context, projectI
 // GetSingleBucketUsageRollup retrieves usage rollup for a single bucket of particular project for a given period.
 func (db *ProjectAccounting) GetSingleBucketUsageRollup(ctx context.Context, projectID uuid.UUID, bucket string, since, before time.Time) (_ *accounting.BucketUsageRollup, err error) {
 	defer mon.Task()(&ctx)(&err)
+	db = db.forReads()
 	since = timeTruncateDown(since.UTC())
 	before = before.UTC()
 
@@ -816,6 +1052,7 @@ func (db *ProjectAccounting) prefixMatch(expr string, prefix []byte) (string, []
 // GetBucketTotals retrieves bucket usage totals for period of time.
 func (db *ProjectAccounting) GetBucketTotals(ctx context.Context, projectID uuid.UUID, cursor accounting.BucketUsageCursor, before time.Time) (_ *accounting.BucketUsagePage, err error) {
 	defer mon.Task()(&ctx)(&err)
+	db = db.forReads()
 	bucketPrefix := []byte(cursor.Search)
 
 	if cursor.Limit > 50 {
@@ -1084,21 +1321,25 @@ func timeTruncateDown(t time.Time) time.Time {
 func (db *ProjectAccounting) GetProjectLimits(ctx context.Context, projectID uuid.UUID) (_ accounting.ProjectLimits, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	row, err := db.db.Get_Project_BandwidthLimit_Project_UsageLimit_Project_SegmentLimit_Project_RateLimit_Project_BurstLimit_By_Id(ctx,
-		dbx.Project_Id(projectID[:]),
+	var limits accounting.ProjectLimits
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(`
+		SELECT
+			projects.bandwidth_limit, projects.usage_limit, projects.segment_limit,
+			projects.rate_limit, projects.burst_limit,
+			COALESCE(users.paid_tier, false)
+		FROM projects
+		LEFT JOIN users ON users.id = projects.owner_id
+		WHERE projects.id = ?
+	`), projectID[:]).Scan(
+		&limits.Bandwidth, &limits.Usage, &limits.Segments,
+		&limits.RateLimit, &limits.BurstLimit,
+		&limits.PaidTier,
 	)
 	if err != nil {
 		return accounting.ProjectLimits{}, err
 	}
 
-	return accounting.ProjectLimits{
-		Usage:     row.UsageLimit,
-		Bandwidth: row.BandwidthLimit,
-		Segments:  row.SegmentLimit,
-
-		RateLimit:  row.RateLimit,
-		BurstLimit: row.BurstLimit,
-	}, nil
+	return limits, nil
 }
 
 // GetRollupsSince retrieves all archived rollup records since a given time.
@@ -15,11 +15,13 @@ import (
 	"storj.io/common/testrand"
 	"storj.io/common/uuid"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 )
 
 func Test_DailyUsage(t *testing.T) {
@@ -257,10 +259,12 @@ func Test_GetProjectTotalByPartner(t *testing.T) {
 			require.NoError(t, err)
 
 			type expectedTotal struct {
-				storage  float64
-				segments float64
-				objects  float64
-				egress   int64
+				storage      float64
+				segments     float64
+				objects      float64
+				egress       int64
+				repairEgress int64
+				auditEgress  int64
 			}
 			expectedTotals := make(map[string]expectedTotal)
 			var beforeTotal expectedTotal
@@ -270,6 +274,8 @@ func Test_GetProjectTotalByPartner(t *testing.T) {
 				require.InDelta(t, expected.segments, actual.SegmentCount, epsilon)
 				require.InDelta(t, expected.objects, actual.ObjectCount, epsilon)
 				require.Equal(t, expected.egress, actual.Egress)
+				require.Equal(t, expected.repairEgress, actual.RepairEgress)
+				require.Equal(t, expected.auditEgress, actual.AuditEgress)
 				require.Equal(t, expectedSince, actual.Since)
 				require.Equal(t, expectedBefore, actual.Before)
 			}
@@ -320,6 +326,17 @@ func Test_GetProjectTotalByPartner(t *testing.T) {
 						beforeTotal.egress += rollup.Inline + rollup.Settled
 					}
 				}
+
+				// the repair and the audit egress is summed separately.
+				repairRollup := randRollup(bucket.Name, project.ID, since)
+				repairRollup.Action = pb.PieceAction_GET_REPAIR
+				auditRollup := randRollup(bucket.Name, project.ID, since)
+				auditRollup.Action = pb.PieceAction_GET_AUDIT
+				rollups = append(rollups, repairRollup, auditRollup)
+				total.repairEgress += repairRollup.Inline + repairRollup.Settled
+				total.auditEgress += auditRollup.Inline + auditRollup.Settled
+				beforeTotal.repairEgress += repairRollup.Inline + repairRollup.Settled
+				beforeTotal.auditEgress += auditRollup.Inline + auditRollup.Settled
 				require.NoError(t, sat.DB.Orders().UpdateBandwidthBatch(ctx, rollups))
 
 				expectedTotals[name] = total
@@ -338,6 +355,8 @@ func Test_GetProjectTotalByPartner(t *testing.T) {
 					summedTotal.segments += total.segments
 					summedTotal.objects += total.objects
 					summedTotal.egress += total.egress
+					summedTotal.repairEgress += total.repairEgress
+					summedTotal.auditEgress += total.auditEgress
 				}
 
 				requireTotal(t, summedTotal, since, before, usages[""])
@@ -372,6 +391,8 @@ func Test_GetProjectTotalByPartner(t *testing.T) {
 					summedTotal.segments += expectedTotals[partner].segments
 					summedTotal.objects += expectedTotals[partner].objects
 					summedTotal.egress += expectedTotals[partner].egress
+					summedTotal.repairEgress += expectedTotals[partner].repairEgress
+					summedTotal.auditEgress += expectedTotals[partner].auditEgress
 				}
 
 				requireTotal(t, expectedTotals[partner], since, before, usages[partner])
@@ -452,3 +473,36 @@ func Test_GetProjectObjectsSegments(t *testing.T) {
 			require.Zero(t, projectStats.SegmentCount)
 		})
 }
+
+func Test_APIUsageRollups(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		projectAccounting := db.ProjectAccounting()
+		project1, project2 := testrand.UUID(), testrand.UUID()
+		hour := time.Date(2023, time.June, 1, 10, 0, 0, 0, time.UTC)
+
+		require.NoError(t, projectAccounting.UpdateAPIUsageRollups(ctx, []accounting.APIUsageRollup{
+			{ProjectID: project1, IntervalStart: hour, Operation: accounting.APIOperationList, Count: 3},
+			{ProjectID: project1, IntervalStart: hour, Operation: accounting.APIOperationDownload, Count: 1},
+			{ProjectID: project1, IntervalStart: hour.Add(time.Hour), Operation: accounting.APIOperationList, Count: 2},
+			{ProjectID: project2, IntervalStart: hour, Operation: accounting.APIOperationList, Count: 5},
+		}))
+
+		// the counts of the existing rollups are added up.
+		require.NoError(t, projectAccounting.UpdateAPIUsageRollups(ctx, []accounting.APIUsageRollup{
+			{ProjectID: project1, IntervalStart: hour, Operation: accounting.APIOperationList, Count: 4},
+		}))
+
+		rollups, err := projectAccounting.GetAPIUsageRollups(ctx, project1, hour, hour.Add(2*time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, []accounting.APIUsageRollup{
+			{ProjectID: project1, IntervalStart: hour, Operation: accounting.APIOperationDownload, Count: 1},
+			{ProjectID: project1, IntervalStart: hour, Operation: accounting.APIOperationList, Count: 7},
+			{ProjectID: project1, IntervalStart: hour.Add(time.Hour), Operation: accounting.APIOperationList, Count: 2},
+		}, rollups)
+
+		rollups, err = projectAccounting.GetAPIUsageRollups(ctx, project1, hour.Add(time.Hour), hour.Add(3*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, rollups, 1)
+		require.EqualValues(t, 2, rollups[0].Count)
+	})
+}
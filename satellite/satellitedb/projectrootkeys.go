@@ -0,0 +1,69 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/kms"
+)
+
+// ensures that projectRootKeysDB implements kms.RootKeysDB.
+var _ kms.RootKeysDB = (*projectRootKeysDB)(nil)
+
+// projectRootKeysDB stores the wrapped root keys of the projects.
+type projectRootKeysDB struct {
+	db *satelliteDB
+}
+
+// Set stores the root key of the project, replacing the previous one.
+func (db *projectRootKeysDB) Set(ctx context.Context, key kms.ProjectRootKey) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`
+		INSERT INTO project_root_keys (project_id, master_key_id, encrypted_key, created_by, created_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (project_id) DO UPDATE SET
+			master_key_id = EXCLUDED.master_key_id,
+			encrypted_key = EXCLUDED.encrypted_key,
+			created_by    = EXCLUDED.created_by,
+			created_at    = EXCLUDED.created_at
+	`), key.ProjectID, key.MasterKeyID, key.EncryptedKey, key.CreatedBy, key.CreatedAt)
+	return err
+}
+
+// Get returns the root key of the project or kms.ErrNoRootKey when there's none.
+func (db *projectRootKeysDB) Get(ctx context.Context, projectID uuid.UUID) (_ kms.ProjectRootKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	key := kms.ProjectRootKey{
+		ProjectID: projectID,
+	}
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(`
+		SELECT master_key_id, encrypted_key, created_by, created_at
+		FROM project_root_keys
+		WHERE project_id = ?
+	`), projectID).Scan(&key.MasterKeyID, &key.EncryptedKey, &key.CreatedBy, &key.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return kms.ProjectRootKey{}, kms.ErrNoRootKey.New("%s", projectID)
+	}
+	if err != nil {
+		return kms.ProjectRootKey{}, err
+	}
+	return key, nil
+}
+
+// Delete removes the root key of the project.
+func (db *projectRootKeysDB) Delete(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`
+		DELETE FROM project_root_keys
+		WHERE project_id = ?
+	`), projectID)
+	return err
+}
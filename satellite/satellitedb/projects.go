@@ -12,7 +12,9 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/common/memory"
+	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/changes"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/satellitedb/dbx"
 )
@@ -24,6 +26,8 @@ var _ console.Projects = (*projects)(nil)
 type projects struct {
 	db  dbx.Methods
 	sdb *satelliteDB
+	// readDB serves the listings, which tolerate stale data.
+	readDB *satelliteDB
 }
 
 // GetAll is a method for querying all projects from the database.
@@ -165,6 +169,7 @@ func (projects *projects) Insert(ctx context.Context, project *console.Project)
 	if err != nil {
 		return nil, err
 	}
+	projects.sdb.publish(ctx, changes.TableProjects, changes.OpInsert, projectID.String())
 
 	return projectFromDBX(ctx, createdProject)
 }
@@ -174,8 +179,12 @@ func (projects *projects) Delete(ctx context.Context, id uuid.UUID) (err error)
 	defer mon.Task()(&ctx)(&err)
 
 	_, err = projects.db.Delete_Project_By_Id(ctx, dbx.Project_Id(id[:]))
+	if err != nil {
+		return err
+	}
+	projects.sdb.publish(ctx, changes.TableProjects, changes.OpDelete, id.String())
 
-	return err
+	return nil
 }
 
 // Update is a method for updating project entity.
@@ -207,8 +216,12 @@ func (projects *projects) Update(ctx context.Context, project *console.Project)
 	_, err = projects.db.Update_Project_By_Id(ctx,
 		dbx.Project_Id(project.ID[:]),
 		updateFields)
+	if err != nil {
+		return err
+	}
+	projects.sdb.publish(ctx, changes.TableProjects, changes.OpUpdate, project.ID.String())
 
-	return err
+	return nil
 }
 
 // UpdateRateLimit is a method for updating projects rate limit.
@@ -224,8 +237,12 @@ func (projects *projects) UpdateRateLimit(ctx context.Context, id uuid.UUID, new
 		dbx.Project_Update_Fields{
 			RateLimit: dbx.Project_RateLimit(newLimit),
 		})
+	if err != nil {
+		return err
+	}
+	projects.sdb.publish(ctx, changes.TableProjects, changes.OpUpdate, id.String())
 
-	return err
+	return nil
 }
 
 // UpdateBurstLimit is a method for updating projects burst limit.
@@ -241,8 +258,12 @@ func (projects *projects) UpdateBurstLimit(ctx context.Context, id uuid.UUID, ne
 		dbx.Project_Update_Fields{
 			BurstLimit: dbx.Project_BurstLimit(newLimit),
 		})
+	if err != nil {
+		return err
+	}
+	projects.sdb.publish(ctx, changes.TableProjects, changes.OpUpdate, id.String())
 
-	return err
+	return nil
 }
 
 // UpdateBucketLimit is a method for updating projects bucket limit.
@@ -254,8 +275,28 @@ func (projects *projects) UpdateBucketLimit(ctx context.Context, id uuid.UUID, n
 		dbx.Project_Update_Fields{
 			MaxBuckets: dbx.Project_MaxBuckets(newLimit),
 		})
+	if err != nil {
+		return err
+	}
+	projects.sdb.publish(ctx, changes.TableProjects, changes.OpUpdate, id.String())
 
-	return err
+	return nil
+}
+
+// UpdateOwner is a method for transferring the project to another owner.
+func (projects *projects) UpdateOwner(ctx context.Context, id uuid.UUID, ownerID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// owner_id isn't updatable through dbx, as the owner only changes when accounts are merged.
+	_, err = projects.sdb.ExecContext(ctx, projects.sdb.Rebind(`
+		UPDATE projects SET owner_id = ? WHERE id = ?
+	`), ownerID, id)
+	if err != nil {
+		return err
+	}
+	projects.sdb.publish(ctx, changes.TableProjects, changes.OpUpdate, id.String())
+
+	return nil
 }
 
 // List returns paginated projects, created before provided timestamp.
@@ -307,7 +348,12 @@ func (projects *projects) ListByOwnerID(ctx context.Context, ownerID uuid.UUID,
 		Offset:      int64((cursor.Page - 1) * cursor.Limit),
 	}
 
-	countRow := projects.sdb.QueryRowContext(ctx, projects.sdb.Rebind(`
+	sdb := projects.sdb
+	if projects.readDB != nil {
+		sdb = projects.readDB
+	}
+
+	countRow := sdb.QueryRowContext(ctx, sdb.Rebind(`
 		SELECT COUNT(*) FROM projects WHERE owner_id = ?
 	`), ownerID)
 	err = countRow.Scan(&page.TotalCount)
@@ -319,7 +365,7 @@ func (projects *projects) ListByOwnerID(ctx context.Context, ownerID uuid.UUID,
 		page.PageCount++
 	}
 
-	rows, err := projects.sdb.Query(ctx, projects.sdb.Rebind(`
+	rows, err := sdb.Query(ctx, sdb.Rebind(`
 		SELECT id, public_id, name, description, owner_id, rate_limit, max_buckets, created_at,
 			(SELECT COUNT(*) FROM project_members WHERE project_id = projects.id) AS member_count
 			FROM projects
@@ -456,5 +502,63 @@ func (projects *projects) UpdateUsageLimits(ctx context.Context, id uuid.UUID, l
 			SegmentLimit:   dbx.Project_SegmentLimit(limits.Segment),
 		},
 	)
-	return err
+	if err != nil {
+		return err
+	}
+	projects.sdb.publish(ctx, changes.TableProjects, changes.OpUpdate, id.String())
+
+	return nil
+}
+
+// GetPlacementEntitlements returns the placements the project may select for new buckets.
+func (projects *projects) GetPlacementEntitlements(ctx context.Context, id uuid.UUID) (_ []console.PlacementEntitlement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := projects.sdb.QueryContext(ctx, projects.sdb.Rebind(`
+		SELECT placement, is_default, created_at
+		FROM project_placement_entitlements
+		WHERE project_id = ?
+		ORDER BY placement ASC
+	`), id)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var entitlements []console.PlacementEntitlement
+	for rows.Next() {
+		var entitlement console.PlacementEntitlement
+		if err := rows.Scan(&entitlement.Placement, &entitlement.Default, &entitlement.CreatedAt); err != nil {
+			return nil, err
+		}
+		entitlements = append(entitlements, entitlement)
+	}
+
+	return entitlements, rows.Err()
+}
+
+// EntitlePlacement allows the project to select the placement for new buckets.
+func (projects *projects) EntitlePlacement(ctx context.Context, id uuid.UUID, placement storj.PlacementConstraint, isDefault bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return projects.sdb.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		if isDefault {
+			// only a single placement can be the default one.
+			_, err := tx.Tx.ExecContext(ctx, projects.sdb.Rebind(`
+				UPDATE project_placement_entitlements
+				SET is_default = false
+				WHERE project_id = ? AND placement <> ?
+			`), id, int(placement))
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err := tx.Tx.ExecContext(ctx, projects.sdb.Rebind(`
+			INSERT INTO project_placement_entitlements (project_id, placement, is_default, created_at)
+			VALUES (?, ?, ?, ?)
+			ON CONFLICT (project_id, placement) DO UPDATE SET is_default = EXCLUDED.is_default
+		`), id, int(placement), isDefault, time.Now())
+		return err
+	})
 }
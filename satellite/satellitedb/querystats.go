@@ -0,0 +1,232 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+
+	"storj.io/private/tagsql"
+)
+
+// queryStats measures the duration of the queries and logs the slow ones.
+//
+// The queries are labeled with the method, which runs them, and the subsystem, e.g. audit
+// or console, which calls the database.
+type queryStats struct {
+	log                *zap.Logger
+	slowQueryThreshold time.Duration
+}
+
+// wrap returns db, which measures its queries.
+func (stats *queryStats) wrap(db tagsql.DB) tagsql.DB {
+	return &statsDB{DB: db, stats: stats}
+}
+
+// start starts measuring the query. The returned func finishes the measurement.
+func (stats *queryStats) start(ctx context.Context, query string) func() {
+	subsystem, method := queryLabels(ctx)
+	start := time.Now()
+
+	return func() {
+		duration := time.Since(start)
+		tags := []monkit.SeriesTag{
+			monkit.NewSeriesTag("subsystem", subsystem),
+			monkit.NewSeriesTag("method", method),
+		}
+		mon.DurationVal("db_query_duration", tags...).Observe(duration)
+
+		if stats.slowQueryThreshold <= 0 || duration < stats.slowQueryThreshold {
+			return
+		}
+		mon.Counter("db_slow_query", tags...).Inc(1)
+		stats.log.Warn("slow database query",
+			zap.String("Subsystem", subsystem),
+			zap.String("Method", method),
+			zap.Duration("Duration", duration),
+			zap.String("Query", strings.Join(strings.Fields(query), " ")))
+	}
+}
+
+// queryLabels returns the subsystem, which calls the database, and the method, which
+// runs the query.
+//
+// The method is the innermost monkit span of the context and the subsystem is found from
+// the first caller outside of the database packages.
+func queryLabels(ctx context.Context) (subsystem, method string) {
+	subsystem, method = "unknown", "unknown"
+	if span := monkit.SpanFromCtx(ctx); span != nil {
+		method = span.Func().ShortName()
+	}
+
+	var pcs [32]uintptr
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs[:])])
+	for {
+		frame, more := frames.Next()
+		pkg := packageOf(frame.Function)
+		if pkg != "" && !strings.HasPrefix(pkg, "storj.io/storj/satellite/satellitedb") && !strings.HasPrefix(pkg, "storj.io/private/") {
+			return subsystemOf(pkg), method
+		}
+		if !more {
+			return subsystem, method
+		}
+	}
+}
+
+// packageOf returns the package of the function, e.g. storj.io/storj/satellite/audit for
+// storj.io/storj/satellite/audit.(*Verifier).Verify.
+func packageOf(function string) string {
+	slash := strings.LastIndexByte(function, '/') + 1
+	if dot := strings.IndexByte(function[slash:], '.'); dot >= 0 {
+		return function[:slash+dot]
+	}
+	return function
+}
+
+// subsystemOf returns the subsystem of the package, e.g. "audit" for
+// storj.io/storj/satellite/audit and "console" for storj.io/storj/satellite/console/consoleweb.
+func subsystemOf(pkg string) string {
+	name := strings.TrimPrefix(pkg, "storj.io/storj/")
+	name = strings.TrimPrefix(name, "satellite/")
+	if i := strings.IndexByte(name, '/'); i >= 0 && strings.HasPrefix(pkg, "storj.io/storj/satellite/") {
+		name = name[:i]
+	}
+	return name
+}
+
+// statsDB is a tagsql.DB, which measures its queries.
+type statsDB struct {
+	tagsql.DB
+	stats *queryStats
+}
+
+// Begin starts a transaction, which measures its queries.
+func (db *statsDB) Begin(ctx context.Context) (tagsql.Tx, error) {
+	tx, err := db.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &statsTx{Tx: tx, stats: db.stats}, nil
+}
+
+// BeginTx starts a transaction, which measures its queries.
+func (db *statsDB) BeginTx(ctx context.Context, txOptions *sql.TxOptions) (tagsql.Tx, error) {
+	tx, err := db.DB.BeginTx(ctx, txOptions)
+	if err != nil {
+		return nil, err
+	}
+	return &statsTx{Tx: tx, stats: db.stats}, nil
+}
+
+// Exec executes the query and measures it.
+func (db *statsDB) Exec(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	defer db.stats.start(ctx, query)()
+	return db.DB.Exec(ctx, query, args...)
+}
+
+// ExecContext executes the query and measures it.
+func (db *statsDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	defer db.stats.start(ctx, query)()
+	return db.DB.ExecContext(ctx, query, args...)
+}
+
+// Query executes the query and measures it until the rows are closed.
+func (db *statsDB) Query(ctx context.Context, query string, args ...interface{}) (tagsql.Rows, error) {
+	finish := db.stats.start(ctx, query)
+	return statsQuery(finish)(db.DB.Query(ctx, query, args...))
+}
+
+// QueryContext executes the query and measures it until the rows are closed.
+func (db *statsDB) QueryContext(ctx context.Context, query string, args ...interface{}) (tagsql.Rows, error) {
+	finish := db.stats.start(ctx, query)
+	return statsQuery(finish)(db.DB.QueryContext(ctx, query, args...))
+}
+
+// QueryRow executes the query and measures it.
+func (db *statsDB) QueryRow(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	defer db.stats.start(ctx, query)()
+	return db.DB.QueryRow(ctx, query, args...)
+}
+
+// QueryRowContext executes the query and measures it.
+func (db *statsDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	defer db.stats.start(ctx, query)()
+	return db.DB.QueryRowContext(ctx, query, args...)
+}
+
+// statsTx is a tagsql.Tx, which measures its queries.
+type statsTx struct {
+	tagsql.Tx
+	stats *queryStats
+}
+
+// Exec executes the query and measures it.
+func (tx *statsTx) Exec(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	defer tx.stats.start(ctx, query)()
+	return tx.Tx.Exec(ctx, query, args...)
+}
+
+// ExecContext executes the query and measures it.
+func (tx *statsTx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	defer tx.stats.start(ctx, query)()
+	return tx.Tx.ExecContext(ctx, query, args...)
+}
+
+// Query executes the query and measures it until the rows are closed.
+func (tx *statsTx) Query(ctx context.Context, query string, args ...interface{}) (tagsql.Rows, error) {
+	finish := tx.stats.start(ctx, query)
+	return statsQuery(finish)(tx.Tx.Query(ctx, query, args...))
+}
+
+// QueryContext executes the query and measures it until the rows are closed.
+func (tx *statsTx) QueryContext(ctx context.Context, query string, args ...interface{}) (tagsql.Rows, error) {
+	finish := tx.stats.start(ctx, query)
+	return statsQuery(finish)(tx.Tx.QueryContext(ctx, query, args...))
+}
+
+// QueryRow executes the query and measures it.
+func (tx *statsTx) QueryRow(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	defer tx.stats.start(ctx, query)()
+	return tx.Tx.QueryRow(ctx, query, args...)
+}
+
+// QueryRowContext executes the query and measures it.
+func (tx *statsTx) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	defer tx.stats.start(ctx, query)()
+	return tx.Tx.QueryRowContext(ctx, query, args...)
+}
+
+// statsQuery finishes the measurement, when the query fails, otherwise when the rows are
+// closed.
+func statsQuery(finish func()) func(tagsql.Rows, error) (tagsql.Rows, error) {
+	return func(rows tagsql.Rows, err error) (tagsql.Rows, error) {
+		if err != nil {
+			finish()
+			return nil, err
+		}
+		return &statsRows{Rows: rows, finish: finish}, nil
+	}
+}
+
+// statsRows finishes the measurement of the query, when it's closed.
+type statsRows struct {
+	tagsql.Rows
+	finish func()
+	closed bool
+}
+
+// Close closes the rows and finishes the measurement.
+func (rows *statsRows) Close() error {
+	if !rows.closed {
+		rows.closed = true
+		rows.finish()
+	}
+	return rows.Rows.Close()
+}
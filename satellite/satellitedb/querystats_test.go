@@ -0,0 +1,86 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"storj.io/common/testcontext"
+	"storj.io/private/tagsql"
+)
+
+func TestQueryLabels(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	// the test isn't called from a satellite subsystem.
+	subsystem, method := queryLabels(ctx)
+	require.Equal(t, "testing", subsystem)
+	require.Equal(t, "unknown", method)
+
+	spanCtx := context.Context(ctx)
+	defer mon.TaskNamed("GetSegment")(&spanCtx)(nil)
+
+	_, method = queryLabels(spanCtx)
+	require.Equal(t, "GetSegment", method)
+
+	require.Equal(t, "storj.io/storj/satellite/audit", packageOf("storj.io/storj/satellite/audit.(*Verifier).Verify"))
+	require.Equal(t, "storj.io/storj/satellite/console/consoleweb", packageOf("storj.io/storj/satellite/console/consoleweb.(*Server).Run.func1"))
+	require.Equal(t, "testing", packageOf("testing.tRunner"))
+
+	require.Equal(t, "audit", subsystemOf("storj.io/storj/satellite/audit"))
+	require.Equal(t, "console", subsystemOf("storj.io/storj/satellite/console/consoleweb"))
+	require.Equal(t, "private/server", subsystemOf("storj.io/storj/private/server"))
+}
+
+func TestQueryStatsSlowQuery(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	rawDB, err := tagsql.Open(ctx, "sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer ctx.Check(rawDB.Close)
+
+	core, logs := observer.New(zapcore.WarnLevel)
+	stats := &queryStats{log: zap.New(core), slowQueryThreshold: time.Nanosecond}
+	db := stats.wrap(rawDB)
+
+	queryCtx := context.Context(ctx)
+	defer mon.TaskNamed("Run")(&queryCtx)(nil)
+
+	_, err = db.ExecContext(queryCtx, "CREATE TABLE example (id INTEGER)")
+	require.NoError(t, err)
+
+	tx, err := db.BeginTx(queryCtx, nil)
+	require.NoError(t, err)
+	_, err = tx.ExecContext(queryCtx, "INSERT INTO example VALUES (1)")
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+
+	rows, err := db.QueryContext(queryCtx, "SELECT id\n\tFROM example")
+	require.NoError(t, err)
+	require.True(t, rows.Next())
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+
+	entries := logs.FilterMessage("slow database query").All()
+	require.Len(t, entries, 3)
+	for _, entry := range entries {
+		fields := entry.ContextMap()
+		require.Equal(t, "testing", fields["Subsystem"])
+		require.Equal(t, "Run", fields["Method"])
+	}
+	require.Equal(t, "SELECT id FROM example", entries[2].ContextMap()["Query"])
+
+	stats.slowQueryThreshold = time.Hour
+	_, err = db.ExecContext(queryCtx, "DELETE FROM example")
+	require.NoError(t, err)
+	require.Equal(t, 3, logs.FilterMessage("slow database query").Len())
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase/rangedloop"
+)
+
+var _ rangedloop.CycleLeases = (*rangedLoopLeases)(nil)
+
+type rangedLoopLeases struct {
+	db *satelliteDB
+}
+
+// Acquire acquires the lease of the loop for the duration and increments the
+// fencing token, unless another owner holds an unexpired lease.
+func (leases *rangedLoopLeases) Acquire(ctx context.Context, name string, owner uuid.UUID, duration time.Duration) (_ rangedloop.CycleLease, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	lease := rangedloop.CycleLease{Name: name, Owner: owner}
+	err = leases.db.QueryRowContext(ctx, `
+		INSERT INTO ranged_loop_leases (name, owner, token, expires_at, updated_at)
+		VALUES ($1, $2, 1, now() + $3::INT8 * INTERVAL '1 microsecond', now())
+		ON CONFLICT (name) DO UPDATE SET
+			owner      = EXCLUDED.owner,
+			token      = ranged_loop_leases.token + 1,
+			expires_at = EXCLUDED.expires_at,
+			updated_at = now()
+		WHERE ranged_loop_leases.expires_at <= now()
+			OR ranged_loop_leases.owner = EXCLUDED.owner
+		RETURNING token, expires_at
+	`, name, owner, duration.Microseconds()).Scan(&lease.Token, &lease.ExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return rangedloop.CycleLease{}, rangedloop.ErrCycleFenced.New("lease %q is held by another instance", name)
+	}
+	if err != nil {
+		return rangedloop.CycleLease{}, Error.Wrap(err)
+	}
+	return lease, nil
+}
+
+// Renew extends the lease by the duration, when it's still held with the fencing token.
+func (leases *rangedLoopLeases) Renew(ctx context.Context, lease rangedloop.CycleLease, duration time.Duration) (_ rangedloop.CycleLease, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = leases.db.QueryRowContext(ctx, `
+		UPDATE ranged_loop_leases SET
+			expires_at = now() + $4::INT8 * INTERVAL '1 microsecond',
+			updated_at = now()
+		WHERE name = $1 AND owner = $2 AND token = $3
+		RETURNING expires_at
+	`, lease.Name, lease.Owner, lease.Token, duration.Microseconds()).Scan(&lease.ExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return rangedloop.CycleLease{}, rangedloop.ErrCycleFenced.New("lease %q with token %d was acquired by another instance", lease.Name, lease.Token)
+	}
+	if err != nil {
+		return rangedloop.CycleLease{}, Error.Wrap(err)
+	}
+	return lease, nil
+}
+
+// Release expires the lease, when it's still held with the fencing token. The
+// row is kept, so that the fencing token keeps increasing.
+func (leases *rangedLoopLeases) Release(ctx context.Context, lease rangedloop.CycleLease) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = leases.db.ExecContext(ctx, `
+		UPDATE ranged_loop_leases SET
+			expires_at = now(),
+			updated_at = now()
+		WHERE name = $1 AND owner = $2 AND token = $3
+	`, lease.Name, lease.Owner, lease.Token)
+	return Error.Wrap(err)
+}
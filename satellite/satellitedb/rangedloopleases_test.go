@@ -0,0 +1,64 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestRangedLoopLeases(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		leases := db.RangedLoopLeases()
+		first, second := testrand.UUID(), testrand.UUID()
+
+		lease, err := leases.Acquire(ctx, "rangedloop", first, time.Hour)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, lease.Token)
+		require.Equal(t, first, lease.Owner)
+
+		// another loop has its own lease
+		other, err := leases.Acquire(ctx, "gc-bf", second, time.Hour)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, other.Token)
+
+		// the lease is held by the first owner
+		_, err = leases.Acquire(ctx, "rangedloop", second, time.Hour)
+		require.True(t, rangedloop.ErrCycleFenced.Has(err))
+
+		renewed, err := leases.Renew(ctx, lease, 2*time.Hour)
+		require.NoError(t, err)
+		require.Equal(t, lease.Token, renewed.Token)
+		require.True(t, renewed.ExpiresAt.After(lease.ExpiresAt))
+
+		// after releasing the lease can be acquired by another owner with a new token
+		require.NoError(t, leases.Release(ctx, renewed))
+		taken, err := leases.Acquire(ctx, "rangedloop", second, time.Hour)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, taken.Token)
+
+		// the first owner is fenced out
+		_, err = leases.Renew(ctx, renewed, time.Hour)
+		require.True(t, rangedloop.ErrCycleFenced.Has(err))
+		require.NoError(t, leases.Release(ctx, renewed))
+		_, err = leases.Renew(ctx, taken, time.Hour)
+		require.NoError(t, err)
+
+		// expired leases can be acquired
+		expiring, err := leases.Acquire(ctx, "expiring", first, time.Microsecond)
+		require.NoError(t, err)
+		time.Sleep(time.Millisecond)
+		acquired, err := leases.Acquire(ctx, "expiring", second, time.Hour)
+		require.NoError(t, err)
+		require.Equal(t, expiring.Token+1, acquired.Token)
+	})
+}
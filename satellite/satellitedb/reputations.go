@@ -15,6 +15,7 @@ import (
 
 	"storj.io/common/pb"
 	"storj.io/common/storj"
+	"storj.io/storj/satellite/changes"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/reputation"
 	"storj.io/storj/satellite/satellitedb/dbx"
@@ -41,7 +42,7 @@ func (reputations *reputations) Update(ctx context.Context, updateReq reputation
 }
 
 // ApplyUpdates updates a node's reputation stats.
-// The update is done in a loop to handle concurrent update calls and to avoid
+// The update is retried to handle concurrent update calls and to avoid
 // the need for an explicit transaction.
 // There are three main steps go into the update process:
 //  1. Get existing row for the node
@@ -52,100 +53,115 @@ func (reputations *reputations) Update(ctx context.Context, updateReq reputation
 // If the node (as represented in the returned info) becomes newly vetted,
 // disqualified, or suspended as a result of these updates, the caller is
 // responsible for updating the records in the overlay to match.
-func (reputations *reputations) ApplyUpdates(ctx context.Context, nodeID storj.NodeID, updates reputation.Mutations, reputationConfig reputation.Config, now time.Time) (_ *reputation.Info, err error) {
+func (reputations *reputations) ApplyUpdates(ctx context.Context, nodeID storj.NodeID, updates reputation.Mutations, reputationConfig reputation.Config, now time.Time) (info *reputation.Info, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	for {
-		// get existing reputation stats
-		dbNode, err := reputations.db.Get_Reputation_By_Id(ctx, dbx.Reputation_Id(nodeID.Bytes()))
-		if err != nil && !errors.Is(err, sql.ErrNoRows) {
-			return nil, Error.Wrap(err)
-		}
+	err = reputations.db.retry(ctx, "reputations.ApplyUpdates", func(ctx context.Context) (err error) {
+		info, err = reputations.applyUpdates(ctx, nodeID, updates, reputationConfig, now)
+		return err
+	})
+	if errRetryConflict.Has(err) {
+		return nil, Error.Wrap(err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	reputations.db.publish(ctx, changes.TableReputations, changes.OpUpdate, nodeID.String())
+	return info, nil
+}
 
-		// if this is a new node, we will insert a new entry into the table
-		if dbNode == nil {
-			historyBytes, err := pb.Marshal(&pb.AuditHistory{})
-			if err != nil {
-				return nil, Error.Wrap(err)
-			}
+// applyUpdates makes a single attempt to update a node's reputation stats. It fails with
+// errRetryConflict, when a concurrent update changed the stats.
+func (reputations *reputations) applyUpdates(ctx context.Context, nodeID storj.NodeID, updates reputation.Mutations, reputationConfig reputation.Config, now time.Time) (_ *reputation.Info, err error) {
+	// get existing reputation stats
+	dbNode, err := reputations.db.Get_Reputation_By_Id(ctx, dbx.Reputation_Id(nodeID.Bytes()))
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, Error.Wrap(err)
+	}
 
-			// set default reputation stats for new node
-			newNode := dbx.Reputation{
-				Id:                          nodeID.Bytes(),
-				UnknownAuditReputationAlpha: 1,
-				AuditReputationAlpha:        reputationConfig.InitialAlpha,
-				AuditReputationBeta:         reputationConfig.InitialBeta,
-				OnlineScore:                 1,
-				AuditHistory:                historyBytes,
-			}
+	// if this is a new node, we will insert a new entry into the table
+	if dbNode == nil {
+		historyBytes, err := pb.Marshal(&pb.AuditHistory{})
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
 
-			var windows []*pb.AuditWindow
-			if updates.OnlineHistory != nil {
-				windows = updates.OnlineHistory.Windows
-			}
-			auditHistoryResponse, err := mergeAuditHistory(ctx, historyBytes, windows, reputationConfig.AuditHistory)
-			if err != nil {
-				return nil, Error.Wrap(err)
-			}
+		// set default reputation stats for new node
+		newNode := dbx.Reputation{
+			Id:                          nodeID.Bytes(),
+			UnknownAuditReputationAlpha: 1,
+			AuditReputationAlpha:        reputationConfig.InitialAlpha,
+			AuditReputationBeta:         reputationConfig.InitialBeta,
+			OnlineScore:                 1,
+			AuditHistory:                historyBytes,
+		}
 
-			update := reputations.populateUpdateNodeStats(&newNode, updates, reputationConfig, auditHistoryResponse, now)
+		var windows []*pb.AuditWindow
+		if updates.OnlineHistory != nil {
+			windows = updates.OnlineHistory.Windows
+		}
+		auditHistoryResponse, err := mergeAuditHistory(ctx, historyBytes, windows, reputationConfig.AuditHistory)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
 
-			createFields := reputations.populateCreateFields(update)
-			stats, err := reputations.db.Create_Reputation(ctx, dbx.Reputation_Id(nodeID.Bytes()), dbx.Reputation_AuditHistory(auditHistoryResponse.History), createFields)
-			if err != nil {
-				// if node has been added into the table during a concurrent
-				// Update call happened between Get and Insert, we will try again so the audit is recorded
-				// correctly
-				if dbx.IsConstraintError(err) {
-					mon.Event("reputations_update_query_retry_create")
-					continue
-				}
+		update := reputations.populateUpdateNodeStats(&newNode, updates, reputationConfig, auditHistoryResponse, now)
 
-				return nil, Error.Wrap(err)
+		createFields := reputations.populateCreateFields(update)
+		stats, err := reputations.db.Create_Reputation(ctx, dbx.Reputation_Id(nodeID.Bytes()), dbx.Reputation_AuditHistory(auditHistoryResponse.History), createFields)
+		if err != nil {
+			// if node has been added into the table during a concurrent
+			// Update call happened between Get and Insert, we will try again so the audit is recorded
+			// correctly
+			if dbx.IsConstraintError(err) {
+				mon.Event("reputations_update_query_retry_create")
+				return nil, errRetryConflict.Wrap(err)
 			}
 
-			status, err := dbxToReputationInfo(stats)
-			if err != nil {
-				return nil, Error.Wrap(err)
-			}
-			return &status, nil
+			return nil, Error.Wrap(err)
 		}
 
-		if updates.PositiveResults != 0 ||
-			updates.UnknownResults != 0 ||
-			updates.FailureResults != 0 ||
-			updates.OfflineResults != 0 ||
-			(updates.OnlineHistory != nil && len(updates.OnlineHistory.Windows) != 0) {
-			// there is something to change
+		status, err := dbxToReputationInfo(stats)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		return &status, nil
+	}
 
-			auditHistoryResponse, err := mergeAuditHistory(ctx, dbNode.AuditHistory, updates.OnlineHistory.Windows, reputationConfig.AuditHistory)
-			if err != nil {
-				return nil, Error.Wrap(err)
-			}
+	if updates.PositiveResults != 0 ||
+		updates.UnknownResults != 0 ||
+		updates.FailureResults != 0 ||
+		updates.OfflineResults != 0 ||
+		(updates.OnlineHistory != nil && len(updates.OnlineHistory.Windows) != 0) {
+		// there is something to change
 
-			update := reputations.populateUpdateNodeStats(dbNode, updates, reputationConfig, auditHistoryResponse, now)
+		auditHistoryResponse, err := mergeAuditHistory(ctx, dbNode.AuditHistory, updates.OnlineHistory.Windows, reputationConfig.AuditHistory)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
 
-			updateFields := reputations.populateUpdateFields(update, auditHistoryResponse.History)
-			oldAuditHistory := dbx.Reputation_AuditHistory(dbNode.AuditHistory)
-			dbNode, err = reputations.db.Update_Reputation_By_Id_And_AuditHistory(ctx, dbx.Reputation_Id(nodeID.Bytes()), oldAuditHistory, updateFields)
-			if err != nil && !errors.Is(err, sql.ErrNoRows) {
-				return nil, Error.Wrap(err)
-			}
+		update := reputations.populateUpdateNodeStats(dbNode, updates, reputationConfig, auditHistoryResponse, now)
 
-			// if update failed due to concurrent audit_history updates, we will try
-			// again to get the latest data and update it
-			if dbNode == nil {
-				mon.Event("reputations_update_query_retry_update")
-				continue
-			}
+		updateFields := reputations.populateUpdateFields(update, auditHistoryResponse.History)
+		oldAuditHistory := dbx.Reputation_AuditHistory(dbNode.AuditHistory)
+		dbNode, err = reputations.db.Update_Reputation_By_Id_And_AuditHistory(ctx, dbx.Reputation_Id(nodeID.Bytes()), oldAuditHistory, updateFields)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, Error.Wrap(err)
 		}
 
-		status, err := dbxToReputationInfo(dbNode)
-		if err != nil {
-			return nil, Error.Wrap(err)
+		// if update failed due to concurrent audit_history updates, we will try
+		// again to get the latest data and update it
+		if dbNode == nil {
+			mon.Event("reputations_update_query_retry_update")
+			return nil, errRetryConflict.New("audit history changed")
 		}
-		return &status, nil
 	}
+
+	status, err := dbxToReputationInfo(dbNode)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &status, nil
 }
 
 func (reputations *reputations) Get(ctx context.Context, nodeID storj.NodeID) (*reputation.Info, error) {
@@ -188,7 +204,7 @@ func (reputations *reputations) Get(ctx context.Context, nodeID storj.NodeID) (*
 func (reputations *reputations) DisqualifyNode(ctx context.Context, nodeID storj.NodeID, disqualifiedAt time.Time, disqualificationReason overlay.DisqualificationReason) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	err = reputations.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) (err error) {
+	err = reputations.db.retryTx(ctx, "reputations.DisqualifyNode", func(ctx context.Context, tx *dbx.Tx) (err error) {
 		_, err = tx.Tx.ExecContext(ctx, "SET TRANSACTION ISOLATION LEVEL SERIALIZABLE")
 		if err != nil {
 			return err
@@ -220,14 +236,49 @@ func (reputations *reputations) DisqualifyNode(ctx context.Context, nodeID storj
 		_, err = tx.Update_Reputation_By_Id(ctx, dbx.Reputation_Id(nodeID.Bytes()), updateFields)
 		return err
 	})
-	return Error.Wrap(err)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	reputations.db.publish(ctx, changes.TableReputations, changes.OpUpdate, nodeID.String())
+	return nil
+}
+
+// ReinstateNode clears the disqualification and the suspensions of a storage node and
+// resets its audit and online scores, so that it's evaluated from the start again.
+func (reputations *reputations) ReinstateNode(ctx context.Context, nodeID storj.NodeID, config reputation.Config) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	historyBytes, err := pb.Marshal(&pb.AuditHistory{})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	updateFields := dbx.Reputation_Update_Fields{}
+	updateFields.Disqualified = dbx.Reputation_Disqualified_Null()
+	updateFields.DisqualificationReason = dbx.Reputation_DisqualificationReason_Null()
+	updateFields.UnknownAuditSuspended = dbx.Reputation_UnknownAuditSuspended_Null()
+	updateFields.OfflineSuspended = dbx.Reputation_OfflineSuspended_Null()
+	updateFields.UnderReview = dbx.Reputation_UnderReview_Null()
+	updateFields.OnlineScore = dbx.Reputation_OnlineScore(1)
+	updateFields.AuditHistory = dbx.Reputation_AuditHistory(historyBytes)
+	updateFields.AuditReputationAlpha = dbx.Reputation_AuditReputationAlpha(config.InitialAlpha)
+	updateFields.AuditReputationBeta = dbx.Reputation_AuditReputationBeta(config.InitialBeta)
+	updateFields.UnknownAuditReputationAlpha = dbx.Reputation_UnknownAuditReputationAlpha(1)
+	updateFields.UnknownAuditReputationBeta = dbx.Reputation_UnknownAuditReputationBeta(0)
+
+	_, err = reputations.db.Update_Reputation_By_Id(ctx, dbx.Reputation_Id(nodeID.Bytes()), updateFields)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	reputations.db.publish(ctx, changes.TableReputations, changes.OpUpdate, nodeID.String())
+	return nil
 }
 
 // SuspendNodeUnknownAudit suspends a storage node for unknown audits.
 func (reputations *reputations) SuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID, suspendedAt time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	err = reputations.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) (err error) {
+	err = reputations.db.retryTx(ctx, "reputations.SuspendNodeUnknownAudit", func(ctx context.Context, tx *dbx.Tx) (err error) {
 		_, err = tx.Tx.ExecContext(ctx, "SET TRANSACTION ISOLATION LEVEL SERIALIZABLE")
 		if err != nil {
 			return err
@@ -258,14 +309,18 @@ func (reputations *reputations) SuspendNodeUnknownAudit(ctx context.Context, nod
 		_, err = tx.Update_Reputation_By_Id(ctx, dbx.Reputation_Id(nodeID.Bytes()), updateFields)
 		return err
 	})
-	return Error.Wrap(err)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	reputations.db.publish(ctx, changes.TableReputations, changes.OpUpdate, nodeID.String())
+	return nil
 }
 
 // UnsuspendNodeUnknownAudit unsuspends a storage node for unknown audits.
 func (reputations *reputations) UnsuspendNodeUnknownAudit(ctx context.Context, nodeID storj.NodeID) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	err = reputations.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) (err error) {
+	err = reputations.db.retryTx(ctx, "reputations.UnsuspendNodeUnknownAudit", func(ctx context.Context, tx *dbx.Tx) (err error) {
 		_, err = tx.Tx.ExecContext(ctx, "SET TRANSACTION ISOLATION LEVEL SERIALIZABLE")
 		if err != nil {
 			return err
@@ -297,7 +352,11 @@ func (reputations *reputations) UnsuspendNodeUnknownAudit(ctx context.Context, n
 		return err
 
 	})
-	return Error.Wrap(err)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	reputations.db.publish(ctx, changes.TableReputations, changes.OpUpdate, nodeID.String())
+	return nil
 }
 
 func (reputations *reputations) populateCreateFields(update updateNodeStats) dbx.Reputation_Create_Fields {
@@ -693,3 +752,15 @@ func (z zapNodeIDBytes) String() string {
 	}
 	return nodeID.String()
 }
+
+// AuditTotals returns the number of the passed audits and the number of all
+// audits of all nodes.
+func (reputations *reputations) AuditTotals(ctx context.Context) (successCount, totalCount int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = reputations.db.QueryRowContext(ctx, `
+		SELECT COALESCE(SUM(audit_success_count), 0), COALESCE(SUM(total_audit_count), 0)
+		FROM reputations
+	`).Scan(&successCount, &totalCount)
+	return successCount, totalCount, Error.Wrap(err)
+}
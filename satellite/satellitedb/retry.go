@@ -0,0 +1,109 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/sync2"
+	"storj.io/private/dbutil/cockroachutil"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// errRetryConflict is returned by the retried operations, which lost a race with a
+// concurrent update, e.g. a compare-and-swap, and should be tried again.
+var errRetryConflict = errs.Class("retry conflict")
+
+// retryPolicy limits the retries of the operations, which fail with errors expected to go
+// away when tried again, e.g. the serialization failures of SERIALIZABLE transactions.
+type retryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	MaxAttempts int
+	// Budget is the maximum duration after which no more attempts are started.
+	Budget time.Duration
+	// InitialBackoff is the wait before the first retry, it doubles with every retry.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum wait between the retries.
+	MaxBackoff time.Duration
+}
+
+// defaultRetryPolicy matches the limits of the transactions started by dbx, but waits
+// between the attempts.
+var defaultRetryPolicy = retryPolicy{
+	MaxAttempts:    10,
+	Budget:         5 * time.Minute,
+	InitialBackoff: 10 * time.Millisecond,
+	MaxBackoff:     time.Second,
+}
+
+// needsRetry returns whether the operation, which failed with err, should be tried again.
+//
+// Postgres, CockroachDB and the PostgreSQL interface of Spanner all report the aborted
+// serializable transactions with the 40001 code, which is handled by cockroachutil.
+func needsRetry(err error) bool {
+	return errRetryConflict.Has(err) || cockroachutil.NeedsRetry(err)
+}
+
+// do calls fn until it succeeds, it fails with an error, which doesn't need a retry, or
+// the retries run out. The waits between the attempts are jittered to avoid the retries
+// colliding again. The number of retries is tracked per operation.
+func (policy retryPolicy) do(ctx context.Context, operation string, fn func(ctx context.Context) error) (err error) {
+	tag := monkit.NewSeriesTag("operation", operation)
+	start := time.Now()
+	backoff := policy.InitialBackoff
+
+	for attempt := 1; ; attempt++ {
+		err = fn(ctx)
+		if err == nil || !needsRetry(err) {
+			mon.IntVal("db_retries", tag).Observe(int64(attempt - 1))
+			return err
+		}
+
+		if attempt >= policy.MaxAttempts || time.Since(start)+backoff > policy.Budget {
+			mon.IntVal("db_retries", tag).Observe(int64(attempt - 1))
+			mon.Counter("db_retries_exhausted", tag).Inc(1)
+			return err
+		}
+
+		// wait between half and the full backoff.
+		wait := backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+		if !sync2.Sleep(ctx, wait) {
+			return errs.Combine(ctx.Err(), err)
+		}
+
+		backoff *= 2
+		if backoff > policy.MaxBackoff {
+			backoff = policy.MaxBackoff
+		}
+	}
+}
+
+// retry calls fn with the retry policy of the database.
+func (db *satelliteDB) retry(ctx context.Context, operation string, fn func(ctx context.Context) error) error {
+	return db.retryPolicy.do(ctx, operation, fn)
+}
+
+// retryTx runs fn in a transaction with the retry policy of the database. Like with
+// WithTx, fn may be called more than once, so its side effects must be idempotent.
+func (db *satelliteDB) retryTx(ctx context.Context, operation string, fn func(ctx context.Context, tx *dbx.Tx) error) error {
+	return db.retry(ctx, operation, func(ctx context.Context) (err error) {
+		tx, err := db.DB.Open(ctx)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err == nil {
+				err = tx.Commit()
+			} else {
+				err = errs.Combine(err, tx.Rollback())
+			}
+		}()
+		return fn(ctx, tx)
+	})
+}
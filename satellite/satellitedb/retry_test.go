@@ -0,0 +1,98 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	policy := retryPolicy{
+		MaxAttempts:    4,
+		Budget:         time.Minute,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     2 * time.Millisecond,
+	}
+	serializationFailure := Error.Wrap(&pgconn.PgError{Code: pgerrcode.SerializationFailure})
+
+	t.Run("succeeds after retries", func(t *testing.T) {
+		attempts := 0
+		err := policy.do(ctx, "test", func(ctx context.Context) error {
+			attempts++
+			switch attempts {
+			case 1:
+				return serializationFailure
+			case 2:
+				return errRetryConflict.New("changed")
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 3, attempts)
+	})
+
+	t.Run("doesn't retry other errors", func(t *testing.T) {
+		attempts := 0
+		failure := errors.New("failure")
+		err := policy.do(ctx, "test", func(ctx context.Context) error {
+			attempts++
+			return failure
+		})
+		require.ErrorIs(t, err, failure)
+		require.Equal(t, 1, attempts)
+	})
+
+	t.Run("max attempts", func(t *testing.T) {
+		attempts := 0
+		err := policy.do(ctx, "test", func(ctx context.Context) error {
+			attempts++
+			return serializationFailure
+		})
+		require.True(t, needsRetry(err))
+		require.Equal(t, policy.MaxAttempts, attempts)
+	})
+
+	t.Run("budget", func(t *testing.T) {
+		budget := policy
+		budget.MaxAttempts = 100
+		budget.Budget = 0
+
+		attempts := 0
+		err := budget.do(ctx, "test", func(ctx context.Context) error {
+			attempts++
+			return serializationFailure
+		})
+		require.True(t, needsRetry(err))
+		require.Equal(t, 1, attempts)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		slow := policy
+		slow.InitialBackoff = time.Hour
+		slow.MaxBackoff = time.Hour
+		slow.Budget = 2 * time.Hour
+
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		attempts := 0
+		err := slow.do(canceledCtx, "test", func(ctx context.Context) error {
+			attempts++
+			return serializationFailure
+		})
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, 1, attempts)
+	})
+}
@@ -31,11 +31,14 @@ var _ audit.ReverifyQueue = (*reverifyQueue)(nil)
 func (rq *reverifyQueue) Insert(ctx context.Context, piece *audit.PieceLocator) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	_, err = rq.db.DB.ExecContext(ctx, `
-		INSERT INTO reverification_audits ("node_id", "stream_id", "position", "piece_num")
-			VALUES ($1, $2, $3, $4)
-		ON CONFLICT ("node_id", "stream_id", "position") DO NOTHING
-	`, piece.NodeID[:], piece.StreamID[:], piece.Position.Encode(), piece.PieceNum)
+	err = rq.db.retry(ctx, "reverifyqueue.Insert", func(ctx context.Context) (err error) {
+		_, err = rq.db.DB.ExecContext(ctx, `
+			INSERT INTO reverification_audits ("node_id", "stream_id", "position", "piece_num")
+				VALUES ($1, $2, $3, $4)
+			ON CONFLICT ("node_id", "stream_id", "position") DO NOTHING
+		`, piece.NodeID[:], piece.StreamID[:], piece.Position.Encode(), piece.PieceNum)
+		return err
+	})
 
 	return audit.ContainError.Wrap(err)
 }
@@ -52,30 +55,32 @@ func (rq *reverifyQueue) GetNextJob(ctx context.Context, retryInterval time.Dura
 	defer mon.Task()(&ctx)(&err)
 
 	job = &audit.ReverificationJob{}
-	err = rq.db.QueryRowContext(ctx, `
-		WITH next_entry AS (
-			SELECT *
-			FROM reverification_audits
-			WHERE COALESCE(last_attempt, inserted_at) < (now() - '1 microsecond'::interval * $1::bigint)
-			ORDER BY inserted_at
-			LIMIT 1
+	err = rq.db.retry(ctx, "reverifyqueue.GetNextJob", func(ctx context.Context) error {
+		return rq.db.QueryRowContext(ctx, `
+			WITH next_entry AS (
+				SELECT *
+				FROM reverification_audits
+				WHERE COALESCE(last_attempt, inserted_at) < (now() - '1 microsecond'::interval * $1::bigint)
+				ORDER BY inserted_at
+				LIMIT 1
+			)
+			UPDATE reverification_audits ra
+			SET last_attempt = now(),
+				reverify_count = ra.reverify_count + 1
+			FROM next_entry
+			WHERE ra.node_id = next_entry.node_id
+				AND ra.stream_id = next_entry.stream_id
+				AND ra.position = next_entry.position
+			RETURNING ra.node_id, ra.stream_id, ra.position, ra.piece_num, ra.inserted_at, ra.reverify_count
+		`, retryInterval.Microseconds()).Scan(
+			&job.Locator.NodeID,
+			&job.Locator.StreamID,
+			&job.Locator.Position,
+			&job.Locator.PieceNum,
+			&job.InsertedAt,
+			&job.ReverifyCount,
 		)
-		UPDATE reverification_audits ra
-		SET last_attempt = now(),
-			reverify_count = ra.reverify_count + 1
-		FROM next_entry
-		WHERE ra.node_id = next_entry.node_id
-			AND ra.stream_id = next_entry.stream_id
-			AND ra.position = next_entry.position
-		RETURNING ra.node_id, ra.stream_id, ra.position, ra.piece_num, ra.inserted_at, ra.reverify_count
-	`, retryInterval.Microseconds()).Scan(
-		&job.Locator.NodeID,
-		&job.Locator.StreamID,
-		&job.Locator.Position,
-		&job.Locator.PieceNum,
-		&job.InsertedAt,
-		&job.ReverifyCount,
-	)
+	})
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, audit.ErrEmptyQueue.Wrap(err)
 	}
@@ -89,12 +94,16 @@ func (rq *reverifyQueue) GetNextJob(ctx context.Context, retryInterval time.Dura
 func (rq *reverifyQueue) Remove(ctx context.Context, piece *audit.PieceLocator) (wasDeleted bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	return rq.db.Delete_ReverificationAudits_By_NodeId_And_StreamId_And_Position(
-		ctx,
-		dbx.ReverificationAudits_NodeId(piece.NodeID[:]),
-		dbx.ReverificationAudits_StreamId(piece.StreamID[:]),
-		dbx.ReverificationAudits_Position(piece.Position.Encode()),
-	)
+	err = rq.db.retry(ctx, "reverifyqueue.Remove", func(ctx context.Context) (err error) {
+		wasDeleted, err = rq.db.Delete_ReverificationAudits_By_NodeId_And_StreamId_And_Position(
+			ctx,
+			dbx.ReverificationAudits_NodeId(piece.NodeID[:]),
+			dbx.ReverificationAudits_StreamId(piece.StreamID[:]),
+			dbx.ReverificationAudits_Position(piece.Position.Encode()),
+		)
+		return err
+	})
+	return wasDeleted, err
 }
 
 // TestingFudgeUpdateTime (used only for testing) changes the last_update
@@ -139,6 +148,65 @@ func (rq *reverifyQueue) GetByNodeID(ctx context.Context, nodeID storj.NodeID) (
 	return convertDBJob(ctx, pending)
 }
 
+// GetStatusByNodeID returns the number of the pending reverification audits of
+// the node and the time the oldest of them was queued.
+func (rq *reverifyQueue) GetStatusByNodeID(ctx context.Context, nodeID storj.NodeID) (status audit.ContainmentStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var oldest *time.Time
+	err = rq.db.QueryRowContext(ctx, `
+		SELECT count(*), min(inserted_at)
+		FROM reverification_audits
+		WHERE node_id = $1
+	`, nodeID.Bytes()).Scan(&status.PendingCount, &oldest)
+	if err != nil {
+		return audit.ContainmentStatus{}, audit.ContainError.Wrap(err)
+	}
+	if oldest != nil {
+		oldestUTC := oldest.UTC()
+		status.OldestPendingAt = &oldestUTC
+	}
+
+	return status, nil
+}
+
+// GetExpired returns the oldest jobs, which were queued before insertedBefore and which
+// weren't attempted by a worker since attemptedBefore.
+func (rq *reverifyQueue) GetExpired(ctx context.Context, insertedBefore, attemptedBefore time.Time, limit int) (jobs []*audit.ReverificationJob, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := rq.db.QueryContext(ctx, `
+		SELECT node_id, stream_id, position, piece_num, inserted_at, last_attempt, reverify_count
+		FROM reverification_audits
+		WHERE inserted_at < $1
+			AND COALESCE(last_attempt, inserted_at) < $2
+		ORDER BY inserted_at
+		LIMIT $3
+	`, insertedBefore, attemptedBefore, limit)
+	if err != nil {
+		return nil, audit.ContainError.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, audit.ContainError.Wrap(rows.Close()))
+	}()
+
+	for rows.Next() {
+		var info dbx.ReverificationAudits
+		err := rows.Scan(&info.NodeId, &info.StreamId, &info.Position, &info.PieceNum,
+			&info.InsertedAt, &info.LastAttempt, &info.ReverifyCount)
+		if err != nil {
+			return nil, audit.ContainError.Wrap(err)
+		}
+		job, err := convertDBJob(ctx, &info)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, audit.ContainError.Wrap(rows.Err())
+}
+
 func (rq *reverifyQueue) GetAllContainedNodes(ctx context.Context) (nodes []storj.NodeID, err error) {
 	defer mon.Task()(&ctx)(&err)
 
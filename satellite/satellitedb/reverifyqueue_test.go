@@ -169,6 +169,94 @@ func TestReverifyQueueGetByNodeID(t *testing.T) {
 	})
 }
 
+func TestReverifyQueueGetStatusByNodeID(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		reverifyQueue := db.ReverifyQueue()
+
+		locator1 := randomLocator()
+		locator2 := randomLocator()
+		locator2.NodeID = locator1.NodeID
+
+		status, err := reverifyQueue.GetStatusByNodeID(ctx, locator1.NodeID)
+		require.NoError(t, err)
+		require.False(t, status.Contained())
+		require.Nil(t, status.OldestPendingAt)
+
+		err = reverifyQueue.Insert(ctx, locator1)
+		require.NoError(t, err)
+
+		sync2.Sleep(ctx, time.Microsecond)
+
+		err = reverifyQueue.Insert(ctx, locator2)
+		require.NoError(t, err)
+
+		job, err := reverifyQueue.GetNextJob(ctx, retryInterval)
+		require.NoError(t, err)
+		require.Equal(t, *locator1, job.Locator)
+
+		status, err = reverifyQueue.GetStatusByNodeID(ctx, locator1.NodeID)
+		require.NoError(t, err)
+		require.True(t, status.Contained())
+		require.EqualValues(t, 2, status.PendingCount)
+		require.NotNil(t, status.OldestPendingAt)
+		require.WithinDuration(t, job.InsertedAt, *status.OldestPendingAt, time.Microsecond)
+
+		status, err = reverifyQueue.GetStatusByNodeID(ctx, testrand.NodeID())
+		require.NoError(t, err)
+		require.False(t, status.Contained())
+	})
+}
+
+func TestReverifyQueueGetExpired(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		reverifyQueue := db.ReverifyQueue()
+
+		locator1 := randomLocator()
+		locator2 := randomLocator()
+
+		err := reverifyQueue.Insert(ctx, locator1)
+		require.NoError(t, err)
+
+		sync2.Sleep(ctx, time.Microsecond)
+
+		err = reverifyQueue.Insert(ctx, locator2)
+		require.NoError(t, err)
+
+		later := time.Now().Add(time.Hour)
+
+		// nothing was queued before an hour ago
+		jobs, err := reverifyQueue.GetExpired(ctx, time.Now().Add(-time.Hour), later, 10)
+		require.NoError(t, err)
+		require.Empty(t, jobs)
+
+		jobs, err = reverifyQueue.GetExpired(ctx, later, later, 10)
+		require.NoError(t, err)
+		require.Len(t, jobs, 2)
+		require.Equal(t, *locator1, jobs[0].Locator)
+		require.Equal(t, *locator2, jobs[1].Locator)
+
+		jobs, err = reverifyQueue.GetExpired(ctx, later, later, 1)
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		require.Equal(t, *locator1, jobs[0].Locator)
+
+		job, err := reverifyQueue.GetNextJob(ctx, retryInterval)
+		require.NoError(t, err)
+		require.Equal(t, *locator1, job.Locator)
+
+		// the job attempted an hour later than now is left to the worker
+		err = reverifyQueue.(interface {
+			TestingFudgeUpdateTime(ctx context.Context, piece *audit.PieceLocator, updateTime time.Time) error
+		}).TestingFudgeUpdateTime(ctx, locator1, later)
+		require.NoError(t, err)
+
+		jobs, err = reverifyQueue.GetExpired(ctx, later, later, 10)
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		require.Equal(t, *locator2, jobs[0].Locator)
+	})
+}
+
 // checkGetAllContainedNodes checks that the GetAllContainedNodes method works as expected
 // in a particular situation.
 func checkGetAllContainedNodes(ctx context.Context, t testing.TB, reverifyQueue audit.ReverifyQueue, expectedIDs ...storj.NodeID) {
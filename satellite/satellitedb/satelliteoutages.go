@@ -0,0 +1,90 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/orders"
+)
+
+var _ orders.OutagesDB = (*satelliteOutages)(nil)
+
+type satelliteOutages struct {
+	db *satelliteDB
+}
+
+// Heartbeat records that the satellite is running at now and returns the time of the
+// previous heartbeat.
+func (outages *satelliteOutages) Heartbeat(ctx context.Context, name string, now time.Time) (previous time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var previousBeat *time.Time
+	err = outages.db.QueryRowContext(ctx, `
+		WITH previous AS (
+			SELECT beat_at FROM satellite_heartbeats WHERE name = $1
+		), upserted AS (
+			INSERT INTO satellite_heartbeats (name, beat_at)
+			VALUES ($1, $2)
+			ON CONFLICT (name) DO UPDATE SET
+				beat_at = GREATEST(satellite_heartbeats.beat_at, EXCLUDED.beat_at)
+			RETURNING 1
+		)
+		SELECT (SELECT beat_at FROM previous) FROM upserted
+	`, name, now.UTC()).Scan(&previousBeat)
+	if err != nil {
+		return time.Time{}, Error.Wrap(err)
+	}
+	if previousBeat == nil {
+		return time.Time{}, nil
+	}
+	return *previousBeat, nil
+}
+
+// InsertOutage records an outage, unless an outage with the same start exists.
+func (outages *satelliteOutages) InsertOutage(ctx context.Context, outage orders.Outage) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = outages.db.ExecContext(ctx, `
+		INSERT INTO satellite_outages (start_at, end_at, token, created_at)
+		VALUES ($1, $2, $3, now())
+		ON CONFLICT (start_at) DO NOTHING
+	`, outage.Start.UTC(), outage.End.UTC(), outage.Token.Bytes())
+	return Error.Wrap(err)
+}
+
+// ListOutages returns the outages, which ended after the time, ordered by their start.
+func (outages *satelliteOutages) ListOutages(ctx context.Context, endedAfter time.Time) (_ []orders.Outage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := outages.db.QueryContext(ctx, `
+		SELECT start_at, end_at, token
+		FROM satellite_outages
+		WHERE end_at > $1
+		ORDER BY start_at
+	`, endedAfter.UTC())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var list []orders.Outage
+	for rows.Next() {
+		var outage orders.Outage
+		var token []byte
+		if err := rows.Scan(&outage.Start, &outage.End, &token); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		outage.Token, err = uuid.FromBytes(token)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		list = append(list, outage)
+	}
+	return list, Error.Wrap(rows.Err())
+}
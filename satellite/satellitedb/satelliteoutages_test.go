@@ -0,0 +1,63 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestSatelliteOutages(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		outages := db.Outages()
+		now := time.Now().Truncate(time.Second)
+
+		previous, err := outages.Heartbeat(ctx, "api", now)
+		require.NoError(t, err)
+		require.True(t, previous.IsZero())
+
+		previous, err = outages.Heartbeat(ctx, "api", now.Add(time.Minute))
+		require.NoError(t, err)
+		require.True(t, now.Equal(previous))
+
+		// an older heartbeat doesn't move the latest one back.
+		previous, err = outages.Heartbeat(ctx, "api", now)
+		require.NoError(t, err)
+		require.True(t, now.Add(time.Minute).Equal(previous))
+
+		previous, err = outages.Heartbeat(ctx, "api", now.Add(2*time.Minute))
+		require.NoError(t, err)
+		require.True(t, now.Add(time.Minute).Equal(previous))
+
+		first := orders.Outage{Start: now.Add(-48 * time.Hour), End: now.Add(-40 * time.Hour), Token: testrand.UUID()}
+		second := orders.Outage{Start: now.Add(-4 * time.Hour), End: now.Add(-time.Hour), Token: testrand.UUID()}
+		require.NoError(t, outages.InsertOutage(ctx, second))
+		require.NoError(t, outages.InsertOutage(ctx, first))
+
+		// the outage detected by another instance is ignored.
+		require.NoError(t, outages.InsertOutage(ctx, orders.Outage{Start: second.Start, End: now, Token: testrand.UUID()}))
+
+		list, err := outages.ListOutages(ctx, now.Add(-72*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		for i, expected := range []orders.Outage{first, second} {
+			require.True(t, expected.Start.Equal(list[i].Start))
+			require.True(t, expected.End.Equal(list[i].End))
+			require.Equal(t, expected.Token, list[i].Token)
+		}
+
+		list, err = outages.ListOutages(ctx, now.Add(-2*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, second.Token, list[0].Token)
+	})
+}
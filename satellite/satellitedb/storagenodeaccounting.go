@@ -12,7 +12,6 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/private/dbutil"
-	"storj.io/private/dbutil/cockroachutil"
 	"storj.io/private/dbutil/pgutil"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/compensation"
@@ -22,6 +21,16 @@ import (
 // StoragenodeAccounting implements the accounting/db StoragenodeAccounting interface.
 type StoragenodeAccounting struct {
 	db *satelliteDB
+	// readDB serves the node stats reads, which tolerate stale data.
+	readDB *satelliteDB
+}
+
+// forReads returns StoragenodeAccounting, which queries the database for reads.
+func (db *StoragenodeAccounting) forReads() *StoragenodeAccounting {
+	if db.readDB == nil {
+		return db
+	}
+	return &StoragenodeAccounting{db: db.readDB, readDB: db.readDB}
 }
 
 // SaveTallies records raw tallies of at rest data to the database.
@@ -200,15 +209,12 @@ func (db *StoragenodeAccounting) GetBandwidthSince(ctx context.Context, latestRo
 	// things easier on the database by making individual requests node by node. This is also
 	// going to allow us to avoid 16 minute queries.
 	var nodeids [][]byte
-	for {
+	err = db.db.retry(ctx, "storagenodeaccounting.GetBandwidthSince", func(ctx context.Context) (err error) {
 		nodeids, err = db.getNodeIdsSince(ctx, latestRollup)
-		if err != nil {
-			if cockroachutil.NeedsRetry(err) {
-				continue
-			}
-			return err
-		}
-		break
+		return err
+	})
+	if err != nil {
+		return err
 	}
 
 	for _, nodeid := range nodeids {
@@ -453,6 +459,7 @@ func (db *StoragenodeAccounting) QueryStorageNodePeriodUsage(ctx context.Context
 // QueryStorageNodeUsage returns slice of StorageNodeUsage for given period.
 func (db *StoragenodeAccounting) QueryStorageNodeUsage(ctx context.Context, nodeID storj.NodeID, start time.Time, end time.Time) (_ []accounting.StorageNodeUsage, err error) {
 	defer mon.Task()(&ctx)(&err)
+	db = db.forReads()
 
 	lastRollup, err := db.db.Find_AccountingTimestamps_Value_By_Name(ctx, dbx.AccountingTimestamps_Name(accounting.LastRollup))
 	if err != nil {
@@ -85,3 +85,12 @@ func (walletsDB storjscanWalletsDB) GetAll(ctx context.Context) (_ []storjscan.W
 	}
 	return wallets, nil
 }
+
+// Reassign associates the wallet of a user with another user.
+func (walletsDB storjscanWalletsDB) Reassign(ctx context.Context, fromUserID, toUserID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	_, err = walletsDB.db.ExecContext(ctx, walletsDB.db.Rebind(`
+		UPDATE storjscan_wallets SET user_id = ? WHERE user_id = ?
+	`), toUserID[:], fromUserID[:])
+	return Error.Wrap(err)
+}
@@ -304,6 +304,16 @@ func (users *users) UpdatePaidTier(ctx context.Context, id uuid.UUID, paidTier b
 	return err
 }
 
+// UpdateUserAgent sets the user agent the user signed up with.
+func (users *users) UpdateUserAgent(ctx context.Context, id uuid.UUID, userAgent []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = users.db.ExecContext(ctx, users.db.Rebind(`
+		UPDATE users SET user_agent = ? WHERE id = ?
+	`), userAgent, id.Bytes())
+	return err
+}
+
 // UpdateUserProjectLimits is a method to update the user's usage limits for new projects.
 func (users *users) UpdateUserProjectLimits(ctx context.Context, id uuid.UUID, limits console.UsageLimits) (err error) {
 	defer mon.Task()(&ctx)(&err)
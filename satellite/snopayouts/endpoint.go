@@ -203,13 +203,20 @@ func convertPayment(payment Payment) (*pb.GetPaymentResponse, error) {
 		return nil, rpcstatus.Wrap(rpcstatus.Internal, Error.Wrap(err))
 	}
 
+	// the nodes parse the transaction from the receipt, hence send it in the
+	// canonical form, when the payment references a transaction.
+	receipt := payment.Receipt
+	if payment.Transaction != nil {
+		receipt = payment.Transaction.Receipt()
+	}
+
 	return &pb.GetPaymentResponse{
 		Id:        payment.ID,
 		CreatedAt: payment.Created,
 		NodeId:    payment.NodeID,
 		Period:    period,
 		Amount:    payment.Amount,
-		Receipt:   payment.Receipt,
+		Receipt:   receipt,
 		Notes:     payment.Notes,
 	}, nil
 }
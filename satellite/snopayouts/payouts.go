@@ -11,6 +11,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/storj"
+	"storj.io/storj/private/blockchain"
 )
 
 // DB exposes all needed functionality to manage payouts.
@@ -27,6 +28,9 @@ type DB interface {
 	// GetAllPayments return all payments by nodeID.
 	GetAllPayments(ctx context.Context, nodeID storj.NodeID) ([]Payment, error)
 
+	// GetHeldReleases returns the scheduled held amount releases of the node.
+	GetHeldReleases(ctx context.Context, nodeID storj.NodeID) ([]HeldRelease, error)
+
 	// TestCreatePaystub insert paystub into db. Only used for tests.
 	TestCreatePaystub(ctx context.Context, stub Paystub) (err error)
 	// TestCreatePayment insert payment into db. Only used for tests.
@@ -74,6 +78,18 @@ type Payment struct {
 	Amount  int64        `json:"amount"`
 	Receipt string       `json:"receipt"`
 	Notes   string       `json:"notes"`
+	// Transaction is the on-chain transaction of the payment, when the receipt
+	// references one.
+	Transaction *blockchain.Transaction `json:"transaction,omitempty"`
+}
+
+// HeldRelease is a scheduled disbursement of the held amount to the storagenode operator,
+// when the storagenode leaves withholding.
+type HeldRelease struct {
+	NodeID  storj.NodeID `json:"nodeId"`
+	Period  string       `json:"period"`
+	Amount  int64        `json:"amount"`
+	Created time.Time    `json:"created"`
 }
 
 // Service is used to store and handle node paystub information.
@@ -131,3 +147,23 @@ func (service *Service) GetAllPayments(ctx context.Context, nodeID storj.NodeID)
 
 	return payments, nil
 }
+
+// GetUpcomingHeldReleases returns the held amount releases of the node, which are
+// scheduled for the period of now or later.
+func (service *Service) GetUpcomingHeldReleases(ctx context.Context, nodeID storj.NodeID, now time.Time) ([]HeldRelease, error) {
+	releases, err := service.db.GetHeldReleases(ctx, nodeID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	current := now.UTC().Format("2006-01")
+	upcoming := make([]HeldRelease, 0, len(releases))
+	for _, release := range releases {
+		// periods are YYYY-MM encoded, hence they are ordered as strings.
+		if release.Period >= current {
+			upcoming = append(upcoming, release)
+		}
+	}
+
+	return upcoming, nil
+}
@@ -12,6 +12,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/storj/private/blockchain"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 	"storj.io/storj/satellite/snopayouts"
@@ -157,5 +158,33 @@ func TestPayoutDB(t *testing.T) {
 			_, err = snoPayoutDB.GetPayment(ctx, testrand.NodeID(), "2020-01")
 			require.Error(t, err)
 		}
+
+		onChain := snopayouts.Payment{
+			NodeID:  NodeID,
+			Period:  "2020-02",
+			Amount:  456,
+			Receipt: "zkwithdraw:0xdea1082dbea119c822dfe804264f5b880d4208ef51e8c5a8995eff10a5094de8",
+		}
+
+		{
+			err := snoPayoutDB.TestCreatePayment(ctx, onChain)
+			require.NoError(t, err)
+		}
+
+		{
+			actual, err := snoPayoutDB.GetPayment(ctx, NodeID, "2020-02")
+			require.NoError(t, err)
+			require.NotNil(t, actual.Transaction)
+			require.Equal(t, blockchain.ChainZkSync, actual.Transaction.Chain)
+			require.Equal(t, "0xdea1082dbea119c822dfe804264f5b880d4208ef51e8c5a8995eff10a5094de8", actual.Transaction.Hash.Hex())
+			require.True(t, actual.Transaction.Layer2)
+
+			payments, err := snoPayoutDB.GetAllPayments(ctx, NodeID)
+			require.NoError(t, err)
+			require.Len(t, payments, 2)
+			for _, payment := range payments {
+				require.Equal(t, payment.Period == "2020-02", payment.Transaction != nil)
+			}
+		}
 	})
 }
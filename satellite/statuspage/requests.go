@@ -0,0 +1,49 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package statuspage
+
+import (
+	"sync/atomic"
+
+	"storj.io/common/errs2"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/private/server"
+)
+
+var _ server.RequestObserver = (*RequestStats)(nil)
+
+// RequestStats counts the api requests and the requests, which failed with
+// server errors. The requests rejected because of the client, e.g. with invalid
+// arguments or exceeded limits, aren't failures.
+type RequestStats struct {
+	requests int64
+	failures int64
+}
+
+// Handled implements server.RequestObserver.
+func (stats *RequestStats) Handled(rpc string, err error) {
+	atomic.AddInt64(&stats.requests, 1)
+	if isServerError(err) {
+		atomic.AddInt64(&stats.failures, 1)
+	}
+}
+
+// Totals returns the number of the requests and the failures since the start.
+func (stats *RequestStats) Totals() (requests, failures int64) {
+	return atomic.LoadInt64(&stats.requests), atomic.LoadInt64(&stats.failures)
+}
+
+// isServerError returns whether the request failed because of the satellite.
+func isServerError(err error) bool {
+	if err == nil || errs2.IsCanceled(err) {
+		return false
+	}
+
+	switch rpcstatus.Code(err) {
+	case rpcstatus.Unknown, rpcstatus.Internal, rpcstatus.Unavailable, rpcstatus.DataLoss:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+)
+
+// RepairQueue counts the segments waiting for a repair.
+type RepairQueue interface {
+	Count(ctx context.Context) (count int, err error)
+}
+
+// Audits returns the totals of the audits of all nodes.
+type Audits interface {
+	AuditTotals(ctx context.Context) (successCount, totalCount int64, err error)
+}
+
+// Nodes counts the nodes available for uploads.
+type Nodes interface {
+	Size(ctx context.Context) (reputableNodeCount int, newNodeCount int, _ error)
+}
+
+// sample contains the cumulative counters at the time of a collection.
+type sample struct {
+	at           time.Time
+	requests     int64
+	failures     int64
+	auditSuccess int64
+	auditTotal   int64
+}
+
+// Service periodically collects the health indicators and serves the last
+// collected status over http.
+//
+// architecture: Service
+type Service struct {
+	log         *zap.Logger
+	config      Config
+	requests    *RequestStats
+	repairQueue RepairQueue
+	audits      Audits
+	nodes       Nodes
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+
+	mu      sync.Mutex
+	samples []sample
+	status  Status
+}
+
+// NewService creates a new status page service.
+func NewService(log *zap.Logger, config Config, requests *RequestStats, repairQueue RepairQueue, audits Audits, nodes Nodes) *Service {
+	return &Service{
+		log:         log,
+		config:      config,
+		requests:    requests,
+		repairQueue: repairQueue,
+		audits:      audits,
+		nodes:       nodes,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+
+		status: Status{Level: LevelUnknown, Indicators: []Indicator{}},
+	}
+}
+
+// Run starts the collection of the health indicators.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.Loop.Run(ctx, service.Collect)
+}
+
+// Close stops the collection of the health indicators.
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// SetNow allows tests to have the service act as if the current time is whatever they want.
+func (service *Service) SetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
+// Status returns the last collected status.
+func (service *Service) Status() Status {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return service.status
+}
+
+// Collect collects the health indicators. The indicators, which couldn't be
+// collected, are omitted from the status.
+func (service *Service) Collect(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := service.nowFn()
+	indicators := []Indicator{}
+
+	service.mu.Lock()
+	current := sample{at: now}
+	if len(service.samples) > 0 {
+		last := service.samples[len(service.samples)-1]
+		current.auditSuccess, current.auditTotal = last.auditSuccess, last.auditTotal
+	}
+	service.mu.Unlock()
+
+	current.requests, current.failures = service.requests.Totals()
+
+	if successCount, totalCount, err := service.audits.AuditTotals(ctx); err != nil {
+		service.log.Error("failed to collect the audit totals", zap.Error(err))
+	} else {
+		current.auditSuccess, current.auditTotal = successCount, totalCount
+	}
+
+	if count, err := service.repairQueue.Count(ctx); err != nil {
+		service.log.Error("failed to collect the repair backlog", zap.Error(err))
+	} else {
+		indicators = append(indicators, Indicator{
+			Name:      IndicatorRepairBacklog,
+			Value:     float64(count),
+			Threshold: float64(service.config.MaxRepairBacklog),
+			Degraded:  service.config.MaxRepairBacklog > 0 && int64(count) > service.config.MaxRepairBacklog,
+		})
+	}
+
+	if reputable, newNodes, err := service.nodes.Size(ctx); err != nil {
+		service.log.Error("failed to collect the node counts", zap.Error(err))
+	} else {
+		indicators = append(indicators, Indicator{
+			Name:      IndicatorReputableNodes,
+			Value:     float64(reputable),
+			Threshold: float64(service.config.MinReputableNodes),
+			Degraded:  int64(reputable) < service.config.MinReputableNodes,
+		}, Indicator{
+			Name:  IndicatorNewNodes,
+			Value: float64(newNodes),
+		})
+	}
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	// keep the samples within the window and the current one.
+	windowStart := now.Add(-service.config.Window)
+	first := 0
+	for first < len(service.samples) && service.samples[first].at.Before(windowStart) {
+		first++
+	}
+	service.samples = append(service.samples[first:], current)
+	oldest := service.samples[0]
+
+	if requests := current.requests - oldest.requests; requests > 0 {
+		rate := float64(current.failures-oldest.failures) / float64(requests)
+		indicators = append(indicators, Indicator{
+			Name:      IndicatorAPIErrorRate,
+			Value:     rate,
+			Threshold: service.config.MaxAPIErrorRate,
+			Degraded:  rate > service.config.MaxAPIErrorRate,
+		})
+	}
+
+	if total := current.auditTotal - oldest.auditTotal; total > 0 {
+		rate := float64(current.auditSuccess-oldest.auditSuccess) / float64(total)
+		indicators = append(indicators, Indicator{
+			Name:      IndicatorAuditSuccessRate,
+			Value:     rate,
+			Threshold: service.config.MinAuditSuccessRate,
+			Degraded:  rate < service.config.MinAuditSuccessRate,
+		})
+	}
+
+	level := LevelOperational
+	for _, indicator := range indicators {
+		if indicator.Degraded {
+			level = LevelDegraded
+			mon.Event("statuspage_degraded", monkit.NewSeriesTag("indicator", indicator.Name))
+		}
+	}
+
+	service.status = Status{
+		Level:      level,
+		UpdatedAt:  now,
+		Indicators: indicators,
+	}
+	return nil
+}
+
+// ServeHTTP serves the last collected status as json.
+func (service *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+	// the status page may be hosted on another domain.
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+
+	err = json.NewEncoder(w).Encode(service.Status())
+	if err != nil {
+		service.log.Error("failed to write the status", zap.Error(Error.Wrap(err)))
+	}
+}
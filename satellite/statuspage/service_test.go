@@ -0,0 +1,136 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package statuspage_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/statuspage"
+)
+
+type fakeRepairQueue struct{ count int }
+
+func (queue *fakeRepairQueue) Count(ctx context.Context) (int, error) { return queue.count, nil }
+
+type fakeAudits struct {
+	success, total int64
+	err            error
+}
+
+func (audits *fakeAudits) AuditTotals(ctx context.Context) (int64, int64, error) {
+	return audits.success, audits.total, audits.err
+}
+
+type fakeNodes struct{ reputable, new int }
+
+func (nodes *fakeNodes) Size(ctx context.Context) (int, int, error) {
+	return nodes.reputable, nodes.new, nil
+}
+
+func indicators(status statuspage.Status) map[string]statuspage.Indicator {
+	byName := make(map[string]statuspage.Indicator)
+	for _, indicator := range status.Indicators {
+		byName[indicator.Name] = indicator
+	}
+	return byName
+}
+
+func TestService(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	requests := &statuspage.RequestStats{}
+	queue := &fakeRepairQueue{count: 10}
+	audits := &fakeAudits{success: 90, total: 100}
+	nodes := &fakeNodes{reputable: 100, new: 5}
+
+	service := statuspage.NewService(zaptest.NewLogger(t), statuspage.Config{
+		Interval:            time.Minute,
+		Window:              10 * time.Minute,
+		MaxAPIErrorRate:     0.1,
+		MaxRepairBacklog:    100,
+		MinAuditSuccessRate: 0.9,
+		MinReputableNodes:   50,
+	}, requests, queue, audits, nodes)
+
+	now := time.Now()
+	service.SetNow(func() time.Time { return now })
+	require.Equal(t, statuspage.LevelUnknown, service.Status().Level)
+
+	// the rates need two samples.
+	require.NoError(t, service.Collect(ctx))
+	status := service.Status()
+	require.Equal(t, statuspage.LevelOperational, status.Level)
+	require.Equal(t, now, status.UpdatedAt)
+	byName := indicators(status)
+	require.Len(t, byName, 3)
+	require.EqualValues(t, 10, byName[statuspage.IndicatorRepairBacklog].Value)
+	require.EqualValues(t, 100, byName[statuspage.IndicatorReputableNodes].Value)
+	require.EqualValues(t, 5, byName[statuspage.IndicatorNewNodes].Value)
+
+	for i := 0; i < 9; i++ {
+		requests.Handled("/metainfo.Metainfo/BeginObject", nil)
+	}
+	requests.Handled("/metainfo.Metainfo/BeginObject", rpcstatus.Error(rpcstatus.Internal, "database failure"))
+	requests.Handled("/metainfo.Metainfo/GetObject", rpcstatus.Error(rpcstatus.NotFound, "object not found"))
+	requests.Handled("/metainfo.Metainfo/GetObject", context.Canceled)
+	audits.success, audits.total = 180, 200
+
+	now = now.Add(time.Minute)
+	require.NoError(t, service.Collect(ctx))
+	status = service.Status()
+	require.Equal(t, statuspage.LevelOperational, status.Level)
+	byName = indicators(status)
+	require.InDelta(t, 1.0/12, byName[statuspage.IndicatorAPIErrorRate].Value, 1e-9)
+	require.InDelta(t, 0.9, byName[statuspage.IndicatorAuditSuccessRate].Value, 1e-9)
+
+	// the indicators crossing the thresholds degrade the status.
+	queue.count = 1000
+	nodes.reputable = 10
+	audits.success, audits.total = 200, 300
+	requests.Handled("/metainfo.Metainfo/BeginObject", errors.New("unexpected failure"))
+
+	now = now.Add(time.Minute)
+	require.NoError(t, service.Collect(ctx))
+	status = service.Status()
+	require.Equal(t, statuspage.LevelDegraded, status.Level)
+	byName = indicators(status)
+	require.True(t, byName[statuspage.IndicatorRepairBacklog].Degraded)
+	require.True(t, byName[statuspage.IndicatorReputableNodes].Degraded)
+	require.True(t, byName[statuspage.IndicatorAuditSuccessRate].Degraded)
+	require.True(t, byName[statuspage.IndicatorAPIErrorRate].Degraded)
+	require.False(t, byName[statuspage.IndicatorNewNodes].Degraded)
+
+	// the samples outside of the window are dropped and the failed audit
+	// totals don't count as new audits.
+	queue.count, nodes.reputable = 10, 100
+	audits.err = errors.New("database unavailable")
+	now = now.Add(time.Hour)
+	require.NoError(t, service.Collect(ctx))
+	status = service.Status()
+	require.Equal(t, statuspage.LevelOperational, status.Level)
+	byName = indicators(status)
+	require.NotContains(t, byName, statuspage.IndicatorAPIErrorRate)
+	require.NotContains(t, byName, statuspage.IndicatorAuditSuccessRate)
+
+	recorder := httptest.NewRecorder()
+	service.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v0/status", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "*", recorder.Header().Get("Access-Control-Allow-Origin"))
+
+	var served statuspage.Status
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&served))
+	require.Equal(t, statuspage.LevelOperational, served.Level)
+	require.Equal(t, status.Indicators, served.Indicators)
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package statuspage publishes the aggregate health indicators of the satellite,
+// which are suitable for feeding a public status page.
+package statuspage
+
+import (
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+)
+
+var (
+	// Error is the default error class of the package.
+	Error = errs.Class("statuspage")
+
+	mon = monkit.Package()
+)
+
+// Config contains the configurable values of the status page.
+type Config struct {
+	Enabled  bool          `help:"whether the health indicators are published for the status page" default:"false"`
+	Interval time.Duration `help:"how often the health indicators are collected" default:"1m" testDefault:"$TESTINTERVAL"`
+	Window   time.Duration `help:"period, over which the api error rate and the audit success rate are computed" default:"15m"`
+
+	MaxAPIErrorRate     float64 `help:"fraction of the api requests failing with server errors, above which the api is degraded" default:"0.05"`
+	MaxRepairBacklog    int64   `help:"number of segments in the repair queue, above which the repair is degraded. zero disables the threshold" default:"0"`
+	MinAuditSuccessRate float64 `help:"fraction of the passed audits, below which the audits are degraded" default:"0.95"`
+	MinReputableNodes   int64   `help:"number of the reputable nodes available for uploads, below which the storage is degraded. zero disables the threshold" default:"0"`
+}
+
+// Level is the overall health of the satellite.
+type Level string
+
+const (
+	// LevelUnknown means the indicators weren't collected yet.
+	LevelUnknown Level = "unknown"
+	// LevelOperational means all indicators are within the thresholds.
+	LevelOperational Level = "operational"
+	// LevelDegraded means some indicator crossed its threshold.
+	LevelDegraded Level = "degraded"
+)
+
+// Names of the indicators.
+const (
+	IndicatorAPIErrorRate     = "api_error_rate"
+	IndicatorRepairBacklog    = "repair_backlog"
+	IndicatorAuditSuccessRate = "audit_success_rate"
+	IndicatorReputableNodes   = "reputable_nodes"
+	IndicatorNewNodes         = "new_nodes"
+)
+
+// Indicator is a single health indicator. The indicators without a threshold
+// are informational and they are never degraded.
+type Indicator struct {
+	Name      string  `json:"name"`
+	Value     float64 `json:"value"`
+	Threshold float64 `json:"threshold,omitempty"`
+	Degraded  bool    `json:"degraded"`
+}
+
+// Status is the published health of the satellite. The indicators, which
+// couldn't be computed, e.g. because there were no audits within the window,
+// are omitted.
+type Status struct {
+	Level      Level       `json:"status"`
+	UpdatedAt  time.Time   `json:"updatedAt"`
+	Indicators []Indicator `json:"indicators"`
+}
@@ -0,0 +1,273 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/sync2"
+)
+
+// Exporter sends the finished spans in batches to the OTLP/HTTP endpoint of
+// a collector, using the JSON encoding of OTLP.
+//
+// architecture: Chore
+type Exporter struct {
+	log    *zap.Logger
+	config Config
+	client *http.Client
+
+	mu    sync.Mutex
+	queue []Span
+
+	Loop *sync2.Cycle
+}
+
+var _ monkit.SpanObserver = (*Exporter)(nil)
+
+// NewExporter creates a new exporter of the spans.
+func NewExporter(log *zap.Logger, config Config) *Exporter {
+	return &Exporter{
+		log:    log,
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+		Loop:   sync2.NewCycle(config.FlushInterval),
+	}
+}
+
+// Start implements monkit.SpanObserver.
+func (exporter *Exporter) Start(s *monkit.Span) {}
+
+// Finish implements monkit.SpanObserver. It queues the span for the export.
+// The span is dropped when the queue is full.
+func (exporter *Exporter) Finish(s *monkit.Span, err error, panicked bool, finish time.Time) {
+	span := NewSpan(s, err, panicked, finish)
+
+	exporter.mu.Lock()
+	defer exporter.mu.Unlock()
+
+	if len(exporter.queue) >= exporter.config.QueueSize {
+		mon.Event("tracing_span_dropped")
+		return
+	}
+	exporter.queue = append(exporter.queue, span)
+}
+
+// Run exports the queued spans periodically.
+func (exporter *Exporter) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return exporter.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := exporter.Flush(ctx); err != nil {
+			exporter.log.Warn("unable to export spans", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Flush exports the queued spans. The spans of the failed batches are dropped.
+func (exporter *Exporter) Flush(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exporter.mu.Lock()
+	spans := exporter.queue
+	exporter.queue = nil
+	exporter.mu.Unlock()
+
+	batchSize := exporter.config.BatchSize
+	if batchSize <= 0 {
+		batchSize = len(spans)
+	}
+
+	var group errs.Group
+	for len(spans) > 0 {
+		n := batchSize
+		if n > len(spans) {
+			n = len(spans)
+		}
+		if err := exporter.send(ctx, spans[:n]); err != nil {
+			mon.Counter("tracing_spans_failed").Inc(int64(n))
+			group.Add(err)
+		} else {
+			mon.Counter("tracing_spans_exported").Inc(int64(n))
+		}
+		spans = spans[n:]
+	}
+	return group.Err()
+}
+
+// Close stops the exporter. The queued spans are not exported.
+func (exporter *Exporter) Close() error {
+	exporter.Loop.Close()
+	return nil
+}
+
+// send posts a batch of spans to the collector.
+func (exporter *Exporter) send(ctx context.Context, spans []Span) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	body, err := json.Marshal(exportRequest{
+		ResourceSpans: []resourceSpans{{
+			Resource: resource{
+				Attributes: []Attribute{StringAttribute("service.name", exporter.config.ServiceName)},
+			},
+			ScopeSpans: []scopeSpans{{
+				Scope: scope{Name: "storj.io/storj/satellite/tracing"},
+				Spans: spans,
+			}},
+		}},
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, exporter.config.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := exporter.client.Do(request)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { _ = response.Body.Close() }()
+	_, _ = io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return Error.New("collector responded with %s", response.Status)
+	}
+	return nil
+}
+
+// Span is a span encoded for OTLP/JSON.
+type Span struct {
+	TraceID           string      `json:"traceId"`
+	SpanID            string      `json:"spanId"`
+	ParentSpanID      string      `json:"parentSpanId,omitempty"`
+	Name              string      `json:"name"`
+	Kind              int         `json:"kind"`
+	StartTimeUnixNano string      `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string      `json:"endTimeUnixNano"`
+	Attributes        []Attribute `json:"attributes,omitempty"`
+	Status            Status      `json:"status"`
+}
+
+// Status is the status of a span.
+type Status struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+// Attribute is a key value attribute of a span or resource.
+type Attribute struct {
+	Key   string         `json:"key"`
+	Value AttributeValue `json:"value"`
+}
+
+// AttributeValue is a string value of an attribute.
+type AttributeValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+// StringAttribute creates an attribute with a string value.
+func StringAttribute(key, value string) Attribute {
+	return Attribute{Key: key, Value: AttributeValue{StringValue: value}}
+}
+
+const (
+	// spanKindInternal is used for all the spans, the root span of the request
+	// is not distinguished by monkit.
+	spanKindInternal = 1
+
+	statusCodeUnset = 0
+	statusCodeError = 2
+)
+
+// NewSpan converts a finished monkit span to an OTLP span. The monkit trace
+// and span IDs are 64-bit, so the upper half of the 128-bit OTLP trace ID is
+// zero.
+//
+// The errors may contain private information of the users, so only the RPC
+// status code of an error is exported.
+func NewSpan(s *monkit.Span, err error, panicked bool, finish time.Time) Span {
+	span := Span{
+		TraceID:           hex.EncodeToString(append(make([]byte, 8), idBytes(s.Trace().Id())...)),
+		SpanID:            hex.EncodeToString(idBytes(s.Id())),
+		Name:              s.Func().FullName(),
+		Kind:              spanKindInternal,
+		StartTimeUnixNano: strconv.FormatInt(s.Start().UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(finish.UnixNano(), 10),
+		Status:            Status{Code: statusCodeUnset},
+	}
+
+	parentID, hasParent := s.ParentId()
+	if hasParent {
+		span.ParentSpanID = hex.EncodeToString(idBytes(parentID))
+	}
+
+	for _, annotation := range s.Annotations() {
+		span.Attributes = append(span.Attributes, StringAttribute(annotation.Name, annotation.Value))
+	}
+	if projectID, ok := s.Trace().Get(ProjectIDKey).(string); ok {
+		span.Attributes = append(span.Attributes, StringAttribute(ProjectIDKey, projectID))
+	}
+
+	switch {
+	case panicked:
+		span.Status = Status{Code: statusCodeError, Message: "panicked"}
+	case errors.Is(err, context.Canceled):
+		span.Status = Status{Code: statusCodeError, Message: "canceled"}
+	case err != nil:
+		span.Status = Status{Code: statusCodeError}
+		if code := rpcstatus.Code(err); code != rpcstatus.Unknown {
+			span.Status.Message = code.String()
+		}
+	}
+
+	return span
+}
+
+func idBytes(id int64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(id))
+	return b[:]
+}
+
+type exportRequest struct {
+	ResourceSpans []resourceSpans `json:"resourceSpans"`
+}
+
+type resourceSpans struct {
+	Resource   resource     `json:"resource"`
+	ScopeSpans []scopeSpans `json:"scopeSpans"`
+}
+
+type resource struct {
+	Attributes []Attribute `json:"attributes"`
+}
+
+type scopeSpans struct {
+	Scope scope  `json:"scope"`
+	Spans []Span `json:"spans"`
+}
+
+type scope struct {
+	Name string `json:"name"`
+}
@@ -0,0 +1,173 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package tracing
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// Config contains configurable values for the tracing of the API requests.
+type Config struct {
+	Enabled bool `help:"whether the spans of the sampled API requests are exported to an OTLP collector" default:"false"`
+
+	Endpoint    string `help:"the OTLP/HTTP traces endpoint of the collector" default:"http://127.0.0.1:4318/v1/traces"`
+	ServiceName string `help:"the service name of the exported spans" default:"satellite-api"`
+
+	SampleRate         float64            `help:"the fraction of the requests traced, when their project has no sample rate" default:"0"`
+	ProjectSampleRates ProjectSampleRates `help:"the fractions of the requests traced for the projects, e.g. 'project-id=1,project-id=0.5'" default:""`
+
+	QueueSize     int           `help:"the number of spans waiting to be exported, after which the spans are dropped" default:"10000"`
+	BatchSize     int           `help:"the maximum number of spans exported in a single request" default:"500"`
+	FlushInterval time.Duration `help:"how often the queued spans are exported" default:"5s" testDefault:"$TESTINTERVAL"`
+	Timeout       time.Duration `help:"the timeout of a single export request" default:"10s"`
+}
+
+// ProjectSampleRates contains the sample rates of the projects.
+type ProjectSampleRates map[uuid.UUID]float64
+
+// Type implements pflag.Value.
+func (ProjectSampleRates) Type() string { return "tracing.ProjectSampleRates" }
+
+// String implements pflag.Value.
+func (rates *ProjectSampleRates) String() string {
+	if rates == nil || len(*rates) == 0 {
+		return ""
+	}
+
+	var entries []string
+	for projectID, rate := range *rates {
+		entries = append(entries, projectID.String()+"="+strconv.FormatFloat(rate, 'g', -1, 64))
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
+}
+
+// Set implements pflag.Value.
+func (rates *ProjectSampleRates) Set(s string) error {
+	parsed, err := ParseProjectSampleRates(s)
+	if err != nil {
+		return err
+	}
+	*rates = parsed
+	return nil
+}
+
+// ParseProjectSampleRates parses the rates in the format 'project-id=rate,project-id=rate'.
+func ParseProjectSampleRates(s string) (ProjectSampleRates, error) {
+	rates := ProjectSampleRates{}
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		idString, rateString, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, Error.New("invalid project sample rate %q, expected 'project-id=rate'", entry)
+		}
+
+		projectID, err := uuid.FromString(strings.TrimSpace(idString))
+		if err != nil {
+			return nil, Error.New("invalid project id %q: %v", idString, err)
+		}
+
+		rate, err := strconv.ParseFloat(strings.TrimSpace(rateString), 64)
+		if err != nil {
+			return nil, Error.New("invalid sample rate %q: %v", rateString, err)
+		}
+		if err := ValidateSampleRate(rate); err != nil {
+			return nil, err
+		}
+
+		rates[projectID] = rate
+	}
+	return rates, nil
+}
+
+// ValidateSampleRate checks whether the rate can be used as a sample rate.
+func ValidateSampleRate(rate float64) error {
+	if math.IsNaN(rate) || rate < 0 || rate > 1 {
+		return Error.New("sample rate must be between 0 and 1, got %v", rate)
+	}
+	return nil
+}
+
+// Sampler contains the sample rates, which can be changed while the satellite
+// is running.
+type Sampler struct {
+	defaultRate uint64 // math.Float64bits
+
+	mu       sync.RWMutex
+	projects ProjectSampleRates
+}
+
+// NewSampler creates a new sampler with the default rate and the rates of the projects.
+func NewSampler(defaultRate float64, projects ProjectSampleRates) (*Sampler, error) {
+	if err := ValidateSampleRate(defaultRate); err != nil {
+		return nil, err
+	}
+	for _, rate := range projects {
+		if err := ValidateSampleRate(rate); err != nil {
+			return nil, err
+		}
+	}
+
+	sampler := &Sampler{}
+	sampler.SetDefaultRate(defaultRate)
+	sampler.SetProjectRates(projects)
+	return sampler, nil
+}
+
+// Rate returns the sample rate of the project.
+func (sampler *Sampler) Rate(projectID uuid.UUID) float64 {
+	sampler.mu.RLock()
+	rate, ok := sampler.projects[projectID]
+	sampler.mu.RUnlock()
+	if ok {
+		return rate
+	}
+	return sampler.DefaultRate()
+}
+
+// DefaultRate returns the sample rate of the projects without their own rate.
+func (sampler *Sampler) DefaultRate() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&sampler.defaultRate))
+}
+
+// SetDefaultRate changes the sample rate of the projects without their own rate.
+func (sampler *Sampler) SetDefaultRate(rate float64) {
+	atomic.StoreUint64(&sampler.defaultRate, math.Float64bits(rate))
+}
+
+// ProjectRates returns a copy of the sample rates of the projects.
+func (sampler *Sampler) ProjectRates() ProjectSampleRates {
+	sampler.mu.RLock()
+	defer sampler.mu.RUnlock()
+
+	rates := make(ProjectSampleRates, len(sampler.projects))
+	for projectID, rate := range sampler.projects {
+		rates[projectID] = rate
+	}
+	return rates
+}
+
+// SetProjectRates replaces the sample rates of the projects.
+func (sampler *Sampler) SetProjectRates(rates ProjectSampleRates) {
+	copied := make(ProjectSampleRates, len(rates))
+	for projectID, rate := range rates {
+		copied[projectID] = rate
+	}
+
+	sampler.mu.Lock()
+	defer sampler.mu.Unlock()
+	sampler.projects = copied
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package tracing exports the traces of the sampled API requests to an
+// OpenTelemetry (OTLP) collector.
+//
+// The traces are the monkit traces of the requests. When the uplink traces a
+// request, the trace ID is propagated to the satellite, so the exported spans
+// continue the trace of the uplink. The spans of the metainfo endpoint and the
+// metabase queries made on behalf of the request are exported.
+//
+// A request is sampled, when the uplink sampled it, or with the sample rate of
+// its project. The project is known only after the API key is validated, so
+// the spans finished before that are not exported.
+package tracing
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc/rpctracing"
+	"storj.io/common/uuid"
+)
+
+var (
+	// Error is the default error class for the tracing package.
+	Error = errs.Class("tracing")
+
+	mon = monkit.Package()
+)
+
+// ProjectIDKey is the key of the project ID in the trace metadata.
+const ProjectIDKey = "project_id"
+
+// observedKey marks the traces, which are exported.
+type observedKey struct{}
+
+// Service decides which requests are traced and exports their spans.
+//
+// architecture: Service
+type Service struct {
+	log      *zap.Logger
+	sampler  *Sampler
+	exporter *Exporter
+
+	mu sync.Mutex
+}
+
+// NewService creates a new tracing service.
+func NewService(log *zap.Logger, config Config) (*Service, error) {
+	sampler, err := NewSampler(config.SampleRate, config.ProjectSampleRates)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Service{
+		log:      log,
+		sampler:  sampler,
+		exporter: NewExporter(log.Named("exporter"), config),
+	}, nil
+}
+
+// Sampler returns the sampler, which decides the sampled projects.
+func (service *Service) Sampler() *Sampler { return service.sampler }
+
+// Exporter returns the exporter of the spans.
+func (service *Service) Exporter() *Exporter { return service.exporter }
+
+// ObserveProject starts exporting the spans of the trace of ctx, when the trace
+// is sampled by the uplink or by the sample rate of the project. A nil service
+// doesn't trace anything.
+func (service *Service) ObserveProject(ctx context.Context, projectID uuid.UUID) {
+	if service == nil {
+		return
+	}
+
+	span := monkit.SpanFromCtx(ctx)
+	if span == nil {
+		return
+	}
+	trace := span.Trace()
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	if trace.Get(observedKey{}) != nil {
+		return
+	}
+
+	sampled, _ := trace.Get(rpctracing.Sampled).(bool)
+	if !sampled {
+		sampled = rand.Float64() < service.sampler.Rate(projectID)
+	}
+	if !sampled {
+		return
+	}
+
+	trace.Set(observedKey{}, struct{}{})
+	trace.Set(ProjectIDKey, projectID.String())
+	trace.ObserveSpans(service.exporter)
+	mon.Event("tracing_trace_sampled")
+}
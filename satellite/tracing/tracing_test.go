@@ -0,0 +1,180 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package tracing_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/rpc/rpctracing"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/tracing"
+)
+
+var mon = monkit.Package()
+
+func TestParseProjectSampleRates(t *testing.T) {
+	first, second := testrand.UUID(), testrand.UUID()
+
+	rates, err := tracing.ParseProjectSampleRates(first.String() + "=1, " + second.String() + "=0.25,")
+	require.NoError(t, err)
+	require.Equal(t, tracing.ProjectSampleRates{first: 1, second: 0.25}, rates)
+
+	parsed, err := tracing.ParseProjectSampleRates(rates.String())
+	require.NoError(t, err)
+	require.Equal(t, rates, parsed)
+
+	rates, err = tracing.ParseProjectSampleRates("")
+	require.NoError(t, err)
+	require.Empty(t, rates)
+
+	for _, invalid := range []string{
+		first.String(),
+		"invalid=1",
+		first.String() + "=x",
+		first.String() + "=1.5",
+		first.String() + "=-1",
+	} {
+		_, err := tracing.ParseProjectSampleRates(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestSampler(t *testing.T) {
+	project, other := testrand.UUID(), testrand.UUID()
+
+	sampler, err := tracing.NewSampler(0.1, tracing.ProjectSampleRates{project: 1})
+	require.NoError(t, err)
+	require.Equal(t, 1.0, sampler.Rate(project))
+	require.Equal(t, 0.1, sampler.Rate(other))
+
+	sampler.SetDefaultRate(0.5)
+	sampler.SetProjectRates(tracing.ProjectSampleRates{other: 0})
+	require.Equal(t, 0.5, sampler.Rate(project))
+	require.Equal(t, 0.0, sampler.Rate(other))
+
+	_, err = tracing.NewSampler(2, nil)
+	require.Error(t, err)
+}
+
+func TestService(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var mu sync.Mutex
+	var received []tracing.Span
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var request struct {
+			ResourceSpans []struct {
+				ScopeSpans []struct {
+					Spans []tracing.Span `json:"spans"`
+				} `json:"scopeSpans"`
+			} `json:"resourceSpans"`
+		}
+		require.NoError(t, json.Unmarshal(body, &request))
+
+		mu.Lock()
+		defer mu.Unlock()
+		for _, resource := range request.ResourceSpans {
+			for _, scope := range resource.ScopeSpans {
+				received = append(received, scope.Spans...)
+			}
+		}
+	}))
+	defer server.Close()
+
+	traced, other := testrand.UUID(), testrand.UUID()
+	service, err := tracing.NewService(zaptest.NewLogger(t), tracing.Config{
+		Endpoint:           server.URL,
+		ServiceName:        "satellite-api",
+		ProjectSampleRates: tracing.ProjectSampleRates{traced: 1},
+		QueueSize:          100,
+		BatchSize:          1,
+	})
+	require.NoError(t, err)
+
+	request := func(ctx context.Context, projectID uuid.UUID, fail bool) (err error) {
+		defer mon.Task()(&ctx)(&err)
+		service.ObserveProject(ctx, projectID)
+		if err := query(ctx); err != nil {
+			return err
+		}
+		if fail {
+			return rpcstatus.Error(rpcstatus.NotFound, "private details")
+		}
+		return nil
+	}
+
+	// the traced project is sampled
+	require.Error(t, request(ctx, traced, true))
+	// other projects are not sampled
+	require.NoError(t, request(ctx, other, false))
+
+	// the uplink sampled the trace
+	trace := monkit.NewTrace(monkit.NewId())
+	trace.Set(rpctracing.Sampled, true)
+	uplinkCtx := monkit.ResetContextSpan(ctx)
+	var uplinkErr error
+	func() {
+		defer mon.Func().RemoteTrace(&uplinkCtx, 1234, trace)(&uplinkErr)
+		uplinkErr = request(uplinkCtx, other, false)
+	}()
+	require.NoError(t, uplinkErr)
+
+	require.NoError(t, service.Exporter().Flush(ctx))
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	// request and query spans for both sampled traces, the uplink trace also
+	// contains the span started with the remote parent.
+	require.Len(t, received, 5)
+
+	traces := map[string][]tracing.Span{}
+	for _, span := range received {
+		traces[span.TraceID] = append(traces[span.TraceID], span)
+		require.Len(t, span.TraceID, 32)
+		require.Len(t, span.SpanID, 16)
+	}
+	require.Len(t, traces, 2)
+
+	var failed []tracing.Span
+	var remoteChildren int
+	for _, span := range received {
+		require.Subset(t, []tracing.Attribute{
+			tracing.StringAttribute(tracing.ProjectIDKey, traced.String()),
+			tracing.StringAttribute(tracing.ProjectIDKey, other.String()),
+		}, span.Attributes)
+		if span.ParentSpanID == "00000000000004d2" { // 1234
+			remoteChildren++
+		}
+		if span.Status.Code != 0 {
+			failed = append(failed, span)
+		}
+	}
+	require.Equal(t, 1, remoteChildren)
+	require.Len(t, failed, 1)
+	// only the status code of the error is exported
+	require.Equal(t, "NotFound", failed[0].Status.Message)
+}
+
+func query(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return nil
+}
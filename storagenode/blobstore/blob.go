@@ -87,6 +87,11 @@ type Blobs interface {
 	Trash(ctx context.Context, ref BlobRef) error
 	// RestoreTrash restores all files in the trash for a given namespace and returns the keys restored.
 	RestoreTrash(ctx context.Context, namespace []byte) ([][]byte, error)
+	// RestoreTrashKeys restores the files with the given keys in the trash for a given namespace and returns the keys restored.
+	RestoreTrashKeys(ctx context.Context, namespace []byte, keys [][]byte) ([][]byte, error)
+	// WalkTrash executes walkFunc for each blob in the trash for a given namespace. The mtime
+	// of the blobs is the time they were moved to the trash.
+	WalkTrash(ctx context.Context, namespace []byte, walkFunc func(BlobInfo) error) error
 	// EmptyTrash removes all files in trash that were moved to trash prior to trashedBefore and returns the total bytes emptied and keys deleted.
 	EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (int64, [][]byte, error)
 	// Stat looks up disk metadata on the blob file.
@@ -112,6 +117,14 @@ type Blobs interface {
 	// error, WalkNamespace will stop iterating and return the error immediately. The ctx
 	// parameter is intended to allow canceling iteration early.
 	WalkNamespace(ctx context.Context, namespace []byte, walkFunc func(BlobInfo) error) error
+	// WalkNamespaceFrom is like WalkNamespace, but it walks the key prefixes in order, skipping
+	// the ones up to and including startAfter. prefixDone, when not nil, is called after all the
+	// blobs with a key prefix have been walked, which allows resuming an interrupted walk.
+	WalkNamespaceFrom(ctx context.Context, namespace []byte, startAfter string, walkFunc func(BlobInfo) error, prefixDone func(keyPrefix string) error) error
+	// ListKeyPrefixes returns the sorted key prefixes in the given namespace.
+	ListKeyPrefixes(ctx context.Context, namespace []byte) ([]string, error)
+	// WalkNamespacePrefix is like WalkNamespace, but it only walks the blobs with the given key prefix.
+	WalkNamespacePrefix(ctx context.Context, namespace []byte, keyPrefix string, walkFunc func(BlobInfo) error) error
 
 	// CheckWritability tests writability of the storage directory by creating and deleting a file.
 	CheckWritability(ctx context.Context) error
@@ -125,6 +138,26 @@ type Blobs interface {
 	Close() error
 }
 
+// TrashEmptier is implemented by the blob stores, which report the deleted blobs while
+// the trash is emptied.
+type TrashEmptier interface {
+	// EmptyTrashWithProgress is like EmptyTrash, but it calls deleted with the number and
+	// the size of the deleted blobs as they are deleted.
+	EmptyTrashWithProgress(ctx context.Context, namespace []byte, trashedBefore time.Time, deleted func(blobs, bytes int64)) (int64, [][]byte, error)
+}
+
+// EmptyTrash empties the trash of the namespace in blobs and reports the deleted blobs to
+// deleted. The deleted blobs are reported as they are deleted when blobs is a TrashEmptier,
+// and at once after the trash is emptied otherwise.
+func EmptyTrash(ctx context.Context, blobs Blobs, namespace []byte, trashedBefore time.Time, deleted func(blobs, bytes int64)) (int64, [][]byte, error) {
+	if emptier, ok := blobs.(TrashEmptier); ok {
+		return emptier.EmptyTrashWithProgress(ctx, namespace, trashedBefore, deleted)
+	}
+	bytesEmptied, keys, err := blobs.EmptyTrash(ctx, namespace, trashedBefore)
+	deleted(int64(len(keys)), bytesEmptied)
+	return bytesEmptied, keys, err
+}
+
 // BlobInfo allows lazy inspection of a blob and its underlying file during iteration with
 // WalkNamespace-type methods.
 type BlobInfo interface {
@@ -13,6 +13,7 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -426,57 +427,104 @@ func (dir *Dir) ReplaceTrashnow(trashnow func() time.Time) {
 // RestoreTrash moves every piece in the trash folder back into blobsdir.
 func (dir *Dir) RestoreTrash(ctx context.Context, namespace []byte) (keysRestored [][]byte, err error) {
 	var errorsEncountered errs.Group
-	err = dir.walkNamespaceInPath(ctx, namespace, dir.trashdir(), func(info blobstore.BlobInfo) error {
-		blobsBasePath, err := dir.blobToBasePath(info.BlobRef())
+	err = dir.walkNamespaceInPath(ctx, namespace, dir.trashdir(), "", func(info blobstore.BlobInfo) error {
+		restored, err := dir.restoreTrashWithStorageFormat(info.BlobRef(), info.StorageFormatVersion())
 		if err != nil {
 			errorsEncountered.Add(err)
 			return nil
 		}
-
-		blobsVerPath := blobPathForFormatVersion(blobsBasePath, info.StorageFormatVersion())
-
-		trashBasePath, err := dir.refToDirPath(info.BlobRef(), dir.trashdir())
-		if err != nil {
-			errorsEncountered.Add(err)
-			return nil
+		if restored {
+			keysRestored = append(keysRestored, info.BlobRef().Key)
 		}
+		return nil
+	}, nil)
+	errorsEncountered.Add(err)
+	return keysRestored, errorsEncountered.Err()
+}
 
-		trashVerPath := blobPathForFormatVersion(trashBasePath, info.StorageFormatVersion())
-
-		// ensure the dirs exist for blobs path
-		err = os.MkdirAll(filepath.Dir(blobsVerPath), dirPermission)
-		if err != nil && !os.IsExist(err) {
+// RestoreTrashKeys moves the pieces with the given keys in the trash folder back into blobsdir.
+func (dir *Dir) RestoreTrashKeys(ctx context.Context, namespace []byte, keys [][]byte) (keysRestored [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var errorsEncountered errs.Group
+	for _, key := range keys {
+		if err := ctx.Err(); err != nil {
 			errorsEncountered.Add(err)
-			return nil
+			break
 		}
-
-		// move back to blobsdir
-		err = rename(trashVerPath, blobsVerPath)
-		if os.IsNotExist(err) {
-			// no piece at that path; either it has a different storage format
-			// version or there was a concurrent call. (This function is expected
-			// by callers to return a nil error in the case of concurrent calls.)
-			return nil
+		ref := blobstore.BlobRef{Namespace: namespace, Key: key}
+		anyRestored := false
+		for formatVer := MinFormatVersionSupported; formatVer <= MaxFormatVersionSupported; formatVer++ {
+			restored, err := dir.restoreTrashWithStorageFormat(ref, formatVer)
+			if err != nil {
+				errorsEncountered.Add(err)
+				continue
+			}
+			anyRestored = anyRestored || restored
 		}
-		if err != nil {
-			errorsEncountered.Add(err)
-			return nil
+		if anyRestored {
+			keysRestored = append(keysRestored, key)
 		}
-
-		keysRestored = append(keysRestored, info.BlobRef().Key)
-		return nil
-	})
-	errorsEncountered.Add(err)
+	}
 	return keysRestored, errorsEncountered.Err()
 }
 
+// restoreTrashWithStorageFormat moves the piece with the given storage format version from the
+// trash folder back into blobsdir. It returns false when the piece is not in the trash.
+func (dir *Dir) restoreTrashWithStorageFormat(ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (restored bool, err error) {
+	blobsBasePath, err := dir.blobToBasePath(ref)
+	if err != nil {
+		return false, err
+	}
+
+	blobsVerPath := blobPathForFormatVersion(blobsBasePath, formatVer)
+
+	trashBasePath, err := dir.refToDirPath(ref, dir.trashdir())
+	if err != nil {
+		return false, err
+	}
+
+	trashVerPath := blobPathForFormatVersion(trashBasePath, formatVer)
+
+	// ensure the dirs exist for blobs path
+	err = os.MkdirAll(filepath.Dir(blobsVerPath), dirPermission)
+	if err != nil && !os.IsExist(err) {
+		return false, err
+	}
+
+	// move back to blobsdir
+	err = rename(trashVerPath, blobsVerPath)
+	if os.IsNotExist(err) {
+		// no piece at that path; either it has a different storage format
+		// version or there was a concurrent call. (This function is expected
+		// by callers to return a nil error in the case of concurrent calls.)
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// WalkTrash executes walkFunc for each piece in the trash folder of the namespace.
+func (dir *Dir) WalkTrash(ctx context.Context, namespace []byte, walkFunc func(blobstore.BlobInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return dir.walkNamespaceInPath(ctx, namespace, dir.trashdir(), "", walkFunc, nil)
+}
+
 // EmptyTrash walks the trash files for the given namespace and deletes any
 // file whose mtime is older than trashedBefore. The mtime is modified when
 // Trash is called.
 func (dir *Dir) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (bytesEmptied int64, deletedKeys [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return dir.emptyTrash(ctx, namespace, trashedBefore, nil, nil)
+}
+
+// emptyTrash empties the trash like EmptyTrash, pacing the deletions with pacer. deleted,
+// when not nil, is called after every deleted blob.
+func (dir *Dir) emptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time, pacer *Pacer, deleted func(blobs, bytes int64)) (bytesEmptied int64, deletedKeys [][]byte, err error) {
 	defer mon.Task()(&ctx)(&err)
 	var errorsEncountered errs.Group
-	err = dir.walkNamespaceInPath(ctx, namespace, dir.trashdir(), func(info blobstore.BlobInfo) error {
+	err = dir.walkNamespaceInPath(ctx, namespace, dir.trashdir(), "", func(info blobstore.BlobInfo) error {
 		fileInfo, err := info.Stat(ctx)
 		if err != nil {
 			if os.IsNotExist(err) {
@@ -497,16 +545,24 @@ func (dir *Dir) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore
 
 		mtime := fileInfo.ModTime()
 		if mtime.Before(trashedBefore) {
-			err = dir.deleteWithStorageFormatInPath(ctx, dir.trashdir(), info.BlobRef(), info.StorageFormatVersion())
+			err = pacer.Do(ctx, func() error {
+				return dir.deleteWithStorageFormatInPath(ctx, dir.trashdir(), info.BlobRef(), info.StorageFormatVersion())
+			})
 			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
 				errorsEncountered.Add(err)
 				return nil
 			}
 			deletedKeys = append(deletedKeys, info.BlobRef().Key)
 			bytesEmptied += fileInfo.Size()
+			if deleted != nil {
+				deleted(1, fileInfo.Size())
+			}
 		}
 		return nil
-	})
+	}, nil)
 	errorsEncountered.Add(err)
 	return bytesEmptied, deletedKeys, errorsEncountered.Err()
 }
@@ -707,14 +763,22 @@ func (dir *Dir) listNamespacesInPath(ctx context.Context, path string) (ids [][]
 // canceling iteration early.
 func (dir *Dir) WalkNamespace(ctx context.Context, namespace []byte, walkFunc func(blobstore.BlobInfo) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	return dir.walkNamespaceInPath(ctx, namespace, dir.blobsdir(), walkFunc)
+	return dir.walkNamespaceInPath(ctx, namespace, dir.blobsdir(), "", walkFunc, nil)
+}
+
+// WalkNamespaceFrom is like WalkNamespace, but it walks the key prefix directories in order,
+// skipping the ones up to and including startAfter. prefixDone, when not nil, is called after all
+// the blobs with a key prefix have been walked.
+func (dir *Dir) WalkNamespaceFrom(ctx context.Context, namespace []byte, startAfter string, walkFunc func(blobstore.BlobInfo) error, prefixDone func(keyPrefix string) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return dir.walkNamespaceInPath(ctx, namespace, dir.blobsdir(), startAfter, walkFunc, prefixDone)
 }
 
-func (dir *Dir) walkNamespaceInPath(ctx context.Context, namespace []byte, path string, walkFunc func(blobstore.BlobInfo) error) (err error) {
+func (dir *Dir) walkNamespaceInPath(ctx context.Context, namespace []byte, path, startAfter string, walkFunc func(blobstore.BlobInfo) error, prefixDone func(keyPrefix string) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	namespaceDir := pathEncoding.EncodeToString(namespace)
 	nsDir := filepath.Join(path, namespaceDir)
-	openDir, err := os.Open(nsDir)
+	keyPrefixes, err := listKeyPrefixes(ctx, nsDir)
 	if err != nil {
 		if os.IsNotExist(err) {
 			dir.log.Debug("directory not found", zap.String("dir", nsDir))
@@ -723,24 +787,67 @@ func (dir *Dir) walkNamespaceInPath(ctx context.Context, namespace []byte, path
 		}
 		return err
 	}
+	for _, keyPrefix := range keyPrefixes {
+		if keyPrefix <= startAfter {
+			continue
+		}
+		err := walkNamespaceWithPrefix(ctx, dir.log, namespace, nsDir, keyPrefix, walkFunc)
+		if err != nil {
+			return err
+		}
+		if prefixDone != nil {
+			if err := prefixDone(keyPrefix); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ListKeyPrefixes returns the sorted key prefixes in the given namespace.
+func (dir *Dir) ListKeyPrefixes(ctx context.Context, namespace []byte) (_ []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+	keyPrefixes, err := listKeyPrefixes(ctx, filepath.Join(dir.blobsdir(), pathEncoding.EncodeToString(namespace)))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return keyPrefixes, err
+}
+
+// WalkNamespacePrefix executes walkFunc for each locally stored blob, stored with storage format V1
+// or greater, with the given key prefix in the given namespace.
+func (dir *Dir) WalkNamespacePrefix(ctx context.Context, namespace []byte, keyPrefix string, walkFunc func(blobstore.BlobInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	nsDir := filepath.Join(dir.blobsdir(), pathEncoding.EncodeToString(namespace))
+	err = walkNamespaceWithPrefix(ctx, dir.log, namespace, nsDir, keyPrefix, walkFunc)
+	if os.IsNotExist(err) {
+		// the directory was removed after listing it.
+		return nil
+	}
+	return err
+}
+
+// listKeyPrefixes returns the sorted names of the key prefix directories in nsDir.
+func listKeyPrefixes(ctx context.Context, nsDir string) (keyPrefixes []string, err error) {
+	openDir, err := os.Open(nsDir)
+	if err != nil {
+		return nil, err
+	}
 	defer func() { err = errs.Combine(err, openDir.Close()) }()
 	for {
 		// check for context done both before and after our readdir() call
 		if err := ctx.Err(); err != nil {
-			return err
+			return nil, err
 		}
 		subdirNames, err := openDir.Readdirnames(nameBatchSize)
 		if err != nil {
 			if errors.Is(err, io.EOF) || os.IsNotExist(err) {
-				return nil
+				break
 			}
-			return err
+			return nil, err
 		}
 		if len(subdirNames) == 0 {
-			return nil
-		}
-		if err := ctx.Err(); err != nil {
-			return err
+			break
 		}
 		for _, keyPrefix := range subdirNames {
 			if len(keyPrefix) != 2 {
@@ -748,12 +855,11 @@ func (dir *Dir) walkNamespaceInPath(ctx context.Context, namespace []byte, path
 				// don't need to pass on this error
 				continue
 			}
-			err := walkNamespaceWithPrefix(ctx, dir.log, namespace, nsDir, keyPrefix, walkFunc)
-			if err != nil {
-				return err
-			}
+			keyPrefixes = append(keyPrefixes, keyPrefix)
 		}
 	}
+	sort.Strings(keyPrefixes)
+	return keyPrefixes, ctx.Err()
 }
 
 func decodeBlobInfo(namespace []byte, keyPrefix, keyDir, name string) (info blobstore.BlobInfo, ok bool) {
@@ -0,0 +1,115 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package filestore
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"storj.io/common/sync2"
+)
+
+// PacerConfig is the configuration of the pacing of the deletions.
+type PacerConfig struct {
+	BatchSize     int           `help:"number of pieces deleted in a batch, after which the deletions are paused when the disk is slow" default:"100"`
+	TargetLatency time.Duration `help:"the deletions are paused when the average latency of the deletions is higher than this, 0 disables the pacing" default:"20ms"`
+	MaxPause      time.Duration `help:"maximum pause after a batch of deletions" default:"1s"`
+}
+
+// Enabled returns whether the deletions are paced.
+func (config PacerConfig) Enabled() bool {
+	return config.BatchSize > 0 && config.TargetLatency > 0
+}
+
+// latencyWeight is the weight of a new observation in the moving average of the latency.
+const latencyWeight = 0.1
+
+// Pacer paces the deletions based on their measured latency, so that deleting many pieces
+// leaves the disk time for the uploads and downloads.
+//
+// The deletions are done in batches, and after each batch the deletions are paused for
+// longer, the more the average latency is above the target latency. A nil Pacer doesn't
+// pace the deletions.
+type Pacer struct {
+	config PacerConfig
+
+	mu          sync.Mutex
+	latency     time.Duration
+	batchCount  int
+	batchStart  time.Time
+	pausedUntil time.Time
+}
+
+// NewPacer creates a new pacer. It returns nil, when the pacing isn't enabled.
+func NewPacer(config PacerConfig) *Pacer {
+	if !config.Enabled() {
+		return nil
+	}
+	return &Pacer{config: config}
+}
+
+// BatchSize returns the number of deletions in a batch.
+func (pacer *Pacer) BatchSize() int {
+	if pacer == nil {
+		return 1
+	}
+	return pacer.config.BatchSize
+}
+
+// Do waits for the pause of the previous batch to end, and runs and measures fn.
+func (pacer *Pacer) Do(ctx context.Context, fn func() error) error {
+	if pacer == nil {
+		return fn()
+	}
+
+	pacer.mu.Lock()
+	wait := time.Until(pacer.pausedUntil)
+	pacer.mu.Unlock()
+	if wait > 0 {
+		mon.DurationVal("delete_pacer_pause").Observe(wait)
+		if !sync2.Sleep(ctx, wait) {
+			return ctx.Err()
+		}
+	}
+
+	start := time.Now()
+	err := fn()
+	pacer.observe(start, time.Since(start))
+	return err
+}
+
+// observe records the latency of a deletion, and starts a pause at the end of a batch.
+func (pacer *Pacer) observe(start time.Time, latency time.Duration) {
+	pacer.mu.Lock()
+	defer pacer.mu.Unlock()
+
+	if pacer.latency == 0 {
+		pacer.latency = latency
+	} else {
+		pacer.latency += time.Duration(latencyWeight * float64(latency-pacer.latency))
+	}
+
+	if pacer.batchCount == 0 {
+		pacer.batchStart = start
+	}
+	pacer.batchCount++
+	if pacer.batchCount < pacer.config.BatchSize {
+		return
+	}
+	pacer.batchCount = 0
+
+	mon.DurationVal("delete_pacer_latency").Observe(pacer.latency)
+	if pacer.latency <= pacer.config.TargetLatency {
+		return
+	}
+	// the pause is in proportion to how much the disk is slower than the target, e.g. the
+	// deletions take half of the time when the latency is twice the target.
+	elapsed := time.Since(pacer.batchStart)
+	pause := time.Duration(float64(elapsed) * float64(pacer.latency-pacer.config.TargetLatency) / float64(pacer.config.TargetLatency))
+	if pause > pacer.config.MaxPause {
+		pause = pacer.config.MaxPause
+	}
+	pacer.pausedUntil = time.Now().Add(pause)
+}
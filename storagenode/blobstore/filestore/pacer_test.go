@@ -0,0 +1,68 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package filestore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/storagenode/blobstore/filestore"
+)
+
+func TestPacer(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	require.Nil(t, filestore.NewPacer(filestore.PacerConfig{BatchSize: 10}))
+
+	var disabled *filestore.Pacer
+	require.Equal(t, 1, disabled.BatchSize())
+	calls := 0
+	require.NoError(t, disabled.Do(ctx, func() error { calls++; return nil }))
+	require.Equal(t, 1, calls)
+
+	pacer := filestore.NewPacer(filestore.PacerConfig{
+		BatchSize:     2,
+		TargetLatency: time.Millisecond,
+		MaxPause:      time.Second,
+	})
+	require.Equal(t, 2, pacer.BatchSize())
+
+	slow := func() error {
+		time.Sleep(5 * time.Millisecond)
+		return nil
+	}
+
+	// the deletions are paused after a batch of slow deletions.
+	require.NoError(t, pacer.Do(ctx, slow))
+	require.NoError(t, pacer.Do(ctx, slow))
+	start := time.Now()
+	require.NoError(t, pacer.Do(ctx, func() error { return nil }))
+	require.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+
+	// the pause is capped.
+	capped := filestore.NewPacer(filestore.PacerConfig{
+		BatchSize:     1,
+		TargetLatency: time.Microsecond,
+		MaxPause:      10 * time.Millisecond,
+	})
+	require.NoError(t, capped.Do(ctx, slow))
+	start = time.Now()
+	require.NoError(t, capped.Do(ctx, func() error { return nil }))
+	require.Less(t, time.Since(start), time.Second)
+
+	// the pause is interrupted by the context.
+	canceled := filestore.NewPacer(filestore.PacerConfig{
+		BatchSize:     1,
+		TargetLatency: time.Microsecond,
+		MaxPause:      time.Hour,
+	})
+	require.NoError(t, canceled.Do(ctx, slow))
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.ErrorIs(t, canceled.Do(cancelCtx, func() error { return nil }), context.Canceled)
+}
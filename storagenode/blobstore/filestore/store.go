@@ -43,11 +43,17 @@ func monFileInTrash(namespace []byte) *monkit.Meter {
 // Config is configuration for the blob store.
 type Config struct {
 	WriteBufferSize memory.Size `help:"in-memory buffer for uploads" default:"128KiB"`
+	DeletePacing    PacerConfig
 }
 
 // DefaultConfig is the default value for Config.
 var DefaultConfig = Config{
 	WriteBufferSize: 128 * memory.KiB,
+	DeletePacing: PacerConfig{
+		BatchSize:     100,
+		TargetLatency: 20 * time.Millisecond,
+		MaxPause:      time.Second,
+	},
 }
 
 // blobStore implements a blob store.
@@ -55,13 +61,14 @@ type blobStore struct {
 	log    *zap.Logger
 	dir    *Dir
 	config Config
+	pacer  *Pacer
 
 	track leak.Ref
 }
 
 // New creates a new disk blob store in the specified directory.
 func New(log *zap.Logger, dir *Dir, config Config) blobstore.Blobs {
-	return &blobStore{dir: dir, log: log, config: config, track: leak.Root(1)}
+	return &blobStore{dir: dir, log: log, config: config, pacer: NewPacer(config.DeletePacing), track: leak.Root(1)}
 }
 
 // NewAt creates a new disk blob store in the specified directory.
@@ -70,7 +77,7 @@ func NewAt(log *zap.Logger, path string, config Config) (blobstore.Blobs, error)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
-	return &blobStore{dir: dir, log: log, config: config, track: leak.Root(1)}, nil
+	return &blobStore{dir: dir, log: log, config: config, pacer: NewPacer(config.DeletePacing), track: leak.Root(1)}, nil
 }
 
 // Close closes the store.
@@ -154,10 +161,30 @@ func (store *blobStore) RestoreTrash(ctx context.Context, namespace []byte) (key
 	return keysRestored, Error.Wrap(err)
 }
 
+// RestoreTrashKeys moves the pieces with the given keys in the trash back into the regular location.
+func (store *blobStore) RestoreTrashKeys(ctx context.Context, namespace []byte, keys [][]byte) (keysRestored [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	keysRestored, err = store.dir.RestoreTrashKeys(ctx, namespace, keys)
+	return keysRestored, Error.Wrap(err)
+}
+
+// WalkTrash executes walkFunc for each piece in the trash of the namespace.
+func (store *blobStore) WalkTrash(ctx context.Context, namespace []byte, walkFunc func(blobstore.BlobInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.dir.WalkTrash(ctx, namespace, walkFunc)
+}
+
 // // EmptyTrash removes all files in trash that have been there longer than trashExpiryDur.
 func (store *blobStore) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (bytesEmptied int64, keys [][]byte, err error) {
 	defer mon.Task()(&ctx)(&err)
-	bytesEmptied, keys, err = store.dir.EmptyTrash(ctx, namespace, trashedBefore)
+	bytesEmptied, keys, err = store.dir.emptyTrash(ctx, namespace, trashedBefore, store.pacer, nil)
+	return bytesEmptied, keys, Error.Wrap(err)
+}
+
+// EmptyTrashWithProgress is like EmptyTrash, but it reports every deleted blob to deleted.
+func (store *blobStore) EmptyTrashWithProgress(ctx context.Context, namespace []byte, trashedBefore time.Time, deleted func(blobs, bytes int64)) (bytesEmptied int64, keys [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	bytesEmptied, keys, err = store.dir.emptyTrash(ctx, namespace, trashedBefore, store.pacer, deleted)
 	return bytesEmptied, keys, Error.Wrap(err)
 }
 
@@ -291,6 +318,24 @@ func (store *blobStore) WalkNamespace(ctx context.Context, namespace []byte, wal
 	return store.dir.WalkNamespace(ctx, namespace, walkFunc)
 }
 
+// WalkNamespaceFrom is like WalkNamespace, but it walks the key prefixes in order, skipping the
+// ones up to and including startAfter, and calls prefixDone after all the blobs with a key prefix
+// have been walked.
+func (store *blobStore) WalkNamespaceFrom(ctx context.Context, namespace []byte, startAfter string, walkFunc func(blobstore.BlobInfo) error, prefixDone func(keyPrefix string) error) (err error) {
+	return store.dir.WalkNamespaceFrom(ctx, namespace, startAfter, walkFunc, prefixDone)
+}
+
+// ListKeyPrefixes returns the sorted key prefixes in the given namespace.
+func (store *blobStore) ListKeyPrefixes(ctx context.Context, namespace []byte) ([]string, error) {
+	return store.dir.ListKeyPrefixes(ctx, namespace)
+}
+
+// WalkNamespacePrefix executes walkFunc for each locally stored blob with the given key prefix in
+// the given namespace.
+func (store *blobStore) WalkNamespacePrefix(ctx context.Context, namespace []byte, keyPrefix string, walkFunc func(blobstore.BlobInfo) error) error {
+	return store.dir.WalkNamespacePrefix(ctx, namespace, keyPrefix, walkFunc)
+}
+
 // TestCreateV0 creates a new V0 blob that can be written. This is ONLY appropriate in test situations.
 func (store *blobStore) TestCreateV0(ctx context.Context, ref blobstore.BlobRef) (_ blobstore.BlobWriter, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -0,0 +1,108 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multistore
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+)
+
+// MoveStats are the results of moving the blobs between directories.
+type MoveStats struct {
+	Moved      int64
+	MovedBytes int64
+	// Skipped are the blobs with the V0 storage format, which can't be moved, because the
+	// piece info database refers to them.
+	Skipped int64
+}
+
+// MoveBlobs moves all the blobs from one directory to another, keeping their modification
+// time. The trash isn't moved. It's not safe to use the directories while moving.
+func MoveBlobs(ctx context.Context, log *zap.Logger, from, to blobstore.Blobs) (stats MoveStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	namespaces, err := from.ListNamespaces(ctx)
+	if err != nil {
+		return stats, Error.Wrap(err)
+	}
+
+	for _, namespace := range namespaces {
+		var infos []blobstore.BlobInfo
+		// the blobs of a key prefix are collected first, to avoid deleting files from a directory
+		// while it's being read.
+		err := from.WalkNamespaceFrom(ctx, namespace, "", func(info blobstore.BlobInfo) error {
+			infos = append(infos, info)
+			return nil
+		}, func(keyPrefix string) error {
+			for _, info := range infos {
+				if info.StorageFormatVersion() < filestore.FormatV1 {
+					stats.Skipped++
+					continue
+				}
+				size, err := moveBlob(ctx, from, to, info)
+				if err != nil {
+					return err
+				}
+				stats.Moved++
+				stats.MovedBytes += size
+			}
+			infos = infos[:0]
+
+			log.Debug("moved key prefix", zap.Binary("Namespace", namespace), zap.String("Prefix", keyPrefix), zap.Int64("Moved", stats.Moved))
+			return nil
+		})
+		if err != nil {
+			return stats, Error.Wrap(err)
+		}
+	}
+	return stats, nil
+}
+
+// moveBlob copies the blob to the target directory, and deletes it from the source directory.
+func moveBlob(ctx context.Context, from, to blobstore.Blobs, info blobstore.BlobInfo) (size int64, err error) {
+	ref := info.BlobRef()
+	stat, err := info.Stat(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	reader, err := from.OpenWithStorageFormat(ctx, ref, info.StorageFormatVersion())
+	if err != nil {
+		return 0, err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	writer, err := to.Create(ctx, ref, stat.Size())
+	if err != nil {
+		return 0, err
+	}
+	size, err = io.Copy(writer, reader)
+	if err != nil {
+		return 0, errs.Combine(err, writer.Cancel(ctx))
+	}
+	if err := writer.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	moved, err := to.StatWithStorageFormat(ctx, ref, writer.StorageFormatVersion())
+	if err != nil {
+		return 0, err
+	}
+	path, err := moved.FullPath(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.Chtimes(path, stat.ModTime(), stat.ModTime()); err != nil {
+		return 0, err
+	}
+
+	return size, from.DeleteWithStorageFormat(ctx, ref, info.StorageFormatVersion())
+}
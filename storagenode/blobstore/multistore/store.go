@@ -0,0 +1,470 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package multistore implements a blob store which spans multiple storage directories.
+package multistore
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/blobstore"
+)
+
+var (
+	// Error is the default multistore error class.
+	Error = errs.Class("multistore error")
+	// ErrNoWritableDir is returned when none of the storage directories is writable.
+	ErrNoWritableDir = Error.New("no writable storage directory")
+
+	mon = monkit.Package()
+
+	_ blobstore.Blobs = (*blobStore)(nil)
+)
+
+// Dir is a storage directory of the store.
+type Dir struct {
+	Path  string
+	Blobs blobstore.Blobs
+}
+
+// dir is a storage directory with its health.
+type dir struct {
+	Dir
+
+	mu      sync.Mutex
+	healthy bool
+}
+
+func (dir *dir) isHealthy() bool {
+	dir.mu.Lock()
+	defer dir.mu.Unlock()
+	return dir.healthy
+}
+
+// setHealthy updates the health of the directory, and returns whether it changed.
+func (dir *dir) setHealthy(healthy bool) bool {
+	dir.mu.Lock()
+	defer dir.mu.Unlock()
+	changed := dir.healthy != healthy
+	dir.healthy = healthy
+	return changed
+}
+
+// blobStore implements a blob store which spans multiple storage directories.
+//
+// New blobs are placed in the healthy directory with the most free space. Reading, trashing
+// and deleting a blob looks it up in all the directories, and the space used is the sum of
+// the space used in the directories. A directory is unhealthy while its writability check
+// fails; no new blobs are placed there, but the existing blobs are still read from it.
+type blobStore struct {
+	log  *zap.Logger
+	dirs []*dir
+}
+
+// New creates a blob store over the storage directories. The first directory is the main one.
+func New(log *zap.Logger, dirs []Dir) blobstore.Blobs {
+	store := &blobStore{log: log}
+	for _, d := range dirs {
+		store.dirs = append(store.dirs, &dir{Dir: d, healthy: true})
+	}
+	return store
+}
+
+// Close closes the blob stores of all the directories.
+func (store *blobStore) Close() error {
+	var group errs.Group
+	for _, dir := range store.dirs {
+		group.Add(dir.Blobs.Close())
+	}
+	return group.Err()
+}
+
+// Create creates a new blob in the healthy directory with the most free space.
+func (store *blobStore) Create(ctx context.Context, ref blobstore.BlobRef, size int64) (_ blobstore.BlobWriter, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var best *dir
+	var bestFree int64
+	for _, dir := range store.dirs {
+		if !dir.isHealthy() {
+			continue
+		}
+		free, err := dir.Blobs.FreeSpace(ctx)
+		if err != nil {
+			store.log.Warn("failed to get free space of storage directory", zap.String("Path", dir.Path), zap.Error(err))
+			continue
+		}
+		if best == nil || free > bestFree {
+			best, bestFree = dir, free
+		}
+	}
+	if best == nil {
+		return nil, ErrNoWritableDir
+	}
+	return best.Blobs.Create(ctx, ref, size)
+}
+
+// find calls fn for the directories until it succeeds. When the blob isn't found in any of
+// them, the not exist error is returned.
+func (store *blobStore) find(fn func(blobs blobstore.Blobs) error) error {
+	var notFound error
+	for _, dir := range store.dirs {
+		err := fn(dir.Blobs)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		notFound = err
+	}
+	if notFound == nil {
+		notFound = os.ErrNotExist
+	}
+	return notFound
+}
+
+// Open opens a reader for the blob in the directory which has it.
+func (store *blobStore) Open(ctx context.Context, ref blobstore.BlobRef) (reader blobstore.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.find(func(blobs blobstore.Blobs) (err error) {
+		reader, err = blobs.Open(ctx, ref)
+		return err
+	})
+	return reader, err
+}
+
+// OpenWithStorageFormat opens a reader for the blob with the storage format in the directory which has it.
+func (store *blobStore) OpenWithStorageFormat(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (reader blobstore.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.find(func(blobs blobstore.Blobs) (err error) {
+		reader, err = blobs.OpenWithStorageFormat(ctx, ref, formatVer)
+		return err
+	})
+	return reader, err
+}
+
+// Stat looks up the metadata of the blob in the directory which has it.
+func (store *blobStore) Stat(ctx context.Context, ref blobstore.BlobRef) (info blobstore.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.find(func(blobs blobstore.Blobs) (err error) {
+		info, err = blobs.Stat(ctx, ref)
+		return err
+	})
+	return info, err
+}
+
+// StatWithStorageFormat looks up the metadata of the blob with the storage format in the directory which has it.
+func (store *blobStore) StatWithStorageFormat(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (info blobstore.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.find(func(blobs blobstore.Blobs) (err error) {
+		info, err = blobs.StatWithStorageFormat(ctx, ref, formatVer)
+		return err
+	})
+	return info, err
+}
+
+// Trash moves the blob to the trash of the directory which has it.
+func (store *blobStore) Trash(ctx context.Context, ref blobstore.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.find(func(blobs blobstore.Blobs) error {
+		return blobs.Trash(ctx, ref)
+	})
+}
+
+// Delete deletes the blob from all the directories.
+func (store *blobStore) Delete(ctx context.Context, ref blobstore.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.all(func(blobs blobstore.Blobs) error {
+		return blobs.Delete(ctx, ref)
+	})
+}
+
+// DeleteWithStorageFormat deletes the blob with the storage format from all the directories.
+func (store *blobStore) DeleteWithStorageFormat(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.all(func(blobs blobstore.Blobs) error {
+		return blobs.DeleteWithStorageFormat(ctx, ref, formatVer)
+	})
+}
+
+// DeleteNamespace deletes the blobs of the namespace from all the directories.
+func (store *blobStore) DeleteNamespace(ctx context.Context, ref []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.all(func(blobs blobstore.Blobs) error {
+		return blobs.DeleteNamespace(ctx, ref)
+	})
+}
+
+// all calls fn for each directory, and combines the errors.
+func (store *blobStore) all(fn func(blobs blobstore.Blobs) error) error {
+	var group errs.Group
+	for _, dir := range store.dirs {
+		group.Add(fn(dir.Blobs))
+	}
+	return group.Err()
+}
+
+// RestoreTrash restores the trashed blobs of the namespace in all the directories.
+func (store *blobStore) RestoreTrash(ctx context.Context, namespace []byte) (keysRestored [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.all(func(blobs blobstore.Blobs) error {
+		keys, err := blobs.RestoreTrash(ctx, namespace)
+		keysRestored = append(keysRestored, keys...)
+		return err
+	})
+	return keysRestored, err
+}
+
+// RestoreTrashKeys restores the trashed blobs of the namespace with the given keys in all the directories.
+func (store *blobStore) RestoreTrashKeys(ctx context.Context, namespace []byte, keys [][]byte) (keysRestored [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.all(func(blobs blobstore.Blobs) error {
+		restored, err := blobs.RestoreTrashKeys(ctx, namespace, keys)
+		keysRestored = append(keysRestored, restored...)
+		return err
+	})
+	return keysRestored, err
+}
+
+// WalkTrash walks the trashed blobs of the namespace in all the directories.
+func (store *blobStore) WalkTrash(ctx context.Context, namespace []byte, walkFunc func(blobstore.BlobInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	for _, dir := range store.dirs {
+		if err := dir.Blobs.WalkTrash(ctx, namespace, walkFunc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EmptyTrash empties the trash of the namespace in all the directories.
+func (store *blobStore) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (bytesEmptied int64, keys [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.all(func(blobs blobstore.Blobs) error {
+		emptied, deleted, err := blobs.EmptyTrash(ctx, namespace, trashedBefore)
+		bytesEmptied += emptied
+		keys = append(keys, deleted...)
+		return err
+	})
+	return bytesEmptied, keys, err
+}
+
+// EmptyTrashWithProgress is like EmptyTrash, but it reports the deleted blobs of all the
+// directories to deleted.
+func (store *blobStore) EmptyTrashWithProgress(ctx context.Context, namespace []byte, trashedBefore time.Time, deleted func(blobs, bytes int64)) (bytesEmptied int64, keys [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.all(func(blobs blobstore.Blobs) error {
+		emptied, deletedKeys, err := blobstore.EmptyTrash(ctx, blobs, namespace, trashedBefore, deleted)
+		bytesEmptied += emptied
+		keys = append(keys, deletedKeys...)
+		return err
+	})
+	return bytesEmptied, keys, err
+}
+
+// FreeSpace returns the free space of the healthy directories. Directories on the same
+// filesystem are counted multiple times.
+func (store *blobStore) FreeSpace(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	for _, dir := range store.dirs {
+		if !dir.isHealthy() {
+			continue
+		}
+		free, err := dir.Blobs.FreeSpace(ctx)
+		if err != nil {
+			return 0, err
+		}
+		total += free
+	}
+	return total, nil
+}
+
+// SpaceUsedForTrash returns the space used by the trash in all the directories.
+func (store *blobStore) SpaceUsedForTrash(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.all(func(blobs blobstore.Blobs) error {
+		used, err := blobs.SpaceUsedForTrash(ctx)
+		total += used
+		return err
+	})
+	return total, err
+}
+
+// SpaceUsedForBlobs returns the space used by the blobs in all the directories.
+func (store *blobStore) SpaceUsedForBlobs(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.all(func(blobs blobstore.Blobs) error {
+		used, err := blobs.SpaceUsedForBlobs(ctx)
+		total += used
+		return err
+	})
+	return total, err
+}
+
+// SpaceUsedForBlobsInNamespace returns the space used by the blobs of the namespace in all the directories.
+func (store *blobStore) SpaceUsedForBlobsInNamespace(ctx context.Context, namespace []byte) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.all(func(blobs blobstore.Blobs) error {
+		used, err := blobs.SpaceUsedForBlobsInNamespace(ctx, namespace)
+		total += used
+		return err
+	})
+	return total, err
+}
+
+// ListNamespaces returns the namespaces of all the directories.
+func (store *blobStore) ListNamespaces(ctx context.Context) (ids [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	seen := map[string]struct{}{}
+	for _, dir := range store.dirs {
+		namespaces, err := dir.Blobs.ListNamespaces(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, namespace := range namespaces {
+			if _, ok := seen[string(namespace)]; ok {
+				continue
+			}
+			seen[string(namespace)] = struct{}{}
+			ids = append(ids, namespace)
+		}
+	}
+	return ids, nil
+}
+
+// WalkNamespace executes walkFunc for each blob in the namespace, one directory after the other.
+func (store *blobStore) WalkNamespace(ctx context.Context, namespace []byte, walkFunc func(blobstore.BlobInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	for _, dir := range store.dirs {
+		if err := dir.Blobs.WalkNamespace(ctx, namespace, walkFunc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WalkNamespaceFrom is like WalkNamespace, but it walks the key prefixes in order, skipping the
+// ones up to and including startAfter. Each key prefix is walked in all the directories before
+// prefixDone is called.
+func (store *blobStore) WalkNamespaceFrom(ctx context.Context, namespace []byte, startAfter string, walkFunc func(blobstore.BlobInfo) error, prefixDone func(keyPrefix string) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyPrefixes, err := store.ListKeyPrefixes(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	for _, keyPrefix := range keyPrefixes {
+		if keyPrefix <= startAfter {
+			continue
+		}
+		if err := store.WalkNamespacePrefix(ctx, namespace, keyPrefix, walkFunc); err != nil {
+			return err
+		}
+		if prefixDone != nil {
+			if err := prefixDone(keyPrefix); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ListKeyPrefixes returns the sorted key prefixes of the namespace in all the directories.
+func (store *blobStore) ListKeyPrefixes(ctx context.Context, namespace []byte) (_ []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	seen := map[string]struct{}{}
+	for _, dir := range store.dirs {
+		keyPrefixes, err := dir.Blobs.ListKeyPrefixes(ctx, namespace)
+		if err != nil {
+			return nil, err
+		}
+		for _, keyPrefix := range keyPrefixes {
+			seen[keyPrefix] = struct{}{}
+		}
+	}
+
+	keyPrefixes := make([]string, 0, len(seen))
+	for keyPrefix := range seen {
+		keyPrefixes = append(keyPrefixes, keyPrefix)
+	}
+	sort.Strings(keyPrefixes)
+	return keyPrefixes, nil
+}
+
+// WalkNamespacePrefix executes walkFunc for each blob with the key prefix in all the directories.
+func (store *blobStore) WalkNamespacePrefix(ctx context.Context, namespace []byte, keyPrefix string, walkFunc func(blobstore.BlobInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	for _, dir := range store.dirs {
+		if err := dir.Blobs.WalkNamespacePrefix(ctx, namespace, keyPrefix, walkFunc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckWritability checks the writability of each directory, and updates their health. It only
+// fails when none of the directories is writable.
+func (store *blobStore) CheckWritability(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var failures errs.Group
+	for _, dir := range store.dirs {
+		checkErr := dir.Blobs.CheckWritability(ctx)
+		if checkErr != nil {
+			failures.Add(errs.New("%s: %v", dir.Path, checkErr))
+		}
+		if dir.setHealthy(checkErr == nil) {
+			if checkErr != nil {
+				store.log.Error("storage directory is not writable, no new pieces are stored in it", zap.String("Path", dir.Path), zap.Error(checkErr))
+			} else {
+				store.log.Info("storage directory is writable again", zap.String("Path", dir.Path))
+			}
+		}
+	}
+	if len(failures) == len(store.dirs) {
+		return failures.Err()
+	}
+	return nil
+}
+
+// CreateVerificationFile creates the verification file in all the directories.
+func (store *blobStore) CreateVerificationFile(ctx context.Context, id storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.all(func(blobs blobstore.Blobs) error {
+		return blobs.CreateVerificationFile(ctx, id)
+	})
+}
+
+// VerifyStorageDir verifies all the directories. A directory without a verification file,
+// which doesn't have any blobs yet, was added to the node and is initialized.
+func (store *blobStore) VerifyStorageDir(ctx context.Context, id storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, dir := range store.dirs {
+		verifyErr := dir.Blobs.VerifyStorageDir(ctx, id)
+		if errors.Is(verifyErr, os.ErrNotExist) {
+			namespaces, err := dir.Blobs.ListNamespaces(ctx)
+			if err == nil && len(namespaces) == 0 {
+				store.log.Info("initializing new storage directory", zap.String("Path", dir.Path))
+				verifyErr = dir.Blobs.CreateVerificationFile(ctx, id)
+			}
+		}
+		if verifyErr != nil {
+			group.Add(errs.New("%s: %v", dir.Path, verifyErr))
+		}
+	}
+	return group.Err()
+}
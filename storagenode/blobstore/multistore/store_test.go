@@ -0,0 +1,183 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multistore_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/blobstore/multistore"
+)
+
+// testDir is a storage directory with a fixed free space, whose writability check can be broken.
+type testDir struct {
+	blobstore.Blobs
+	free     int64
+	checkErr error
+}
+
+func (dir *testDir) FreeSpace(ctx context.Context) (int64, error) { return dir.free, nil }
+
+func (dir *testDir) CheckWritability(ctx context.Context) error {
+	if dir.checkErr != nil {
+		return dir.checkErr
+	}
+	return dir.Blobs.CheckWritability(ctx)
+}
+
+func newTestDir(t *testing.T, ctx *testcontext.Context, name string, free int64) *testDir {
+	blobs, err := filestore.NewAt(zaptest.NewLogger(t), ctx.Dir(name), filestore.DefaultConfig)
+	require.NoError(t, err)
+	return &testDir{Blobs: blobs, free: free}
+}
+
+func writeBlob(ctx context.Context, t *testing.T, store blobstore.Blobs, ref blobstore.BlobRef, data []byte) {
+	writer, err := store.Create(ctx, ref, int64(len(data)))
+	require.NoError(t, err)
+	_, err = writer.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit(ctx))
+}
+
+func TestStore(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	small := newTestDir(t, ctx, "small", 1000)
+	large := newTestDir(t, ctx, "large", 2000)
+	store := multistore.New(zaptest.NewLogger(t), []multistore.Dir{
+		{Path: "small", Blobs: small},
+		{Path: "large", Blobs: large},
+	})
+	defer ctx.Check(store.Close)
+
+	namespace := testrand.Bytes(32)
+	data := testrand.Bytes(100)
+
+	// new blobs are placed in the directory with the most free space.
+	first := blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	writeBlob(ctx, t, store, first, data)
+	_, err := large.Stat(ctx, first)
+	require.NoError(t, err)
+
+	small.free, large.free = 2000, 1000
+	second := blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	writeBlob(ctx, t, store, second, data)
+	_, err = small.Stat(ctx, second)
+	require.NoError(t, err)
+
+	// blobs are found in any directory.
+	for _, ref := range []blobstore.BlobRef{first, second} {
+		reader, err := store.Open(ctx, ref)
+		require.NoError(t, err)
+		read, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.Equal(t, data, read)
+		require.NoError(t, reader.Close())
+	}
+	_, err = store.Open(ctx, blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)})
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	used, err := store.SpaceUsedForBlobsInNamespace(ctx, namespace)
+	require.NoError(t, err)
+	require.EqualValues(t, 2*len(data), used)
+
+	var walked []blobstore.BlobRef
+	require.NoError(t, store.WalkNamespaceFrom(ctx, namespace, "", func(info blobstore.BlobInfo) error {
+		walked = append(walked, info.BlobRef())
+		return nil
+	}, nil))
+	require.ElementsMatch(t, []blobstore.BlobRef{first, second}, walked)
+
+	namespaces, err := store.ListNamespaces(ctx)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{namespace}, namespaces)
+
+	// trashing works in the directory which has the blob.
+	require.NoError(t, store.Trash(ctx, second))
+	restored, err := store.RestoreTrash(ctx, namespace)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{second.Key}, restored)
+
+	// unhealthy directories don't get new blobs.
+	small.checkErr = errors.New("read-only filesystem")
+	require.NoError(t, store.CheckWritability(ctx))
+	third := blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	writeBlob(ctx, t, store, third, data)
+	_, err = large.Stat(ctx, third)
+	require.NoError(t, err)
+
+	large.checkErr = errors.New("read-only filesystem")
+	require.Error(t, store.CheckWritability(ctx))
+	_, err = store.Create(ctx, blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}, -1)
+	require.ErrorIs(t, err, multistore.ErrNoWritableDir)
+
+	small.checkErr, large.checkErr = nil, nil
+	require.NoError(t, store.CheckWritability(ctx))
+
+	// a new directory is initialized when verifying the directories.
+	nodeID := testrand.NodeID()
+	require.NoError(t, small.CreateVerificationFile(ctx, nodeID))
+	require.NoError(t, large.CreateVerificationFile(ctx, nodeID))
+	empty := newTestDir(t, ctx, "empty", 0)
+	withEmpty := multistore.New(zaptest.NewLogger(t), []multistore.Dir{
+		{Path: "small", Blobs: small},
+		{Path: "large", Blobs: large},
+		{Path: "empty", Blobs: empty},
+	})
+	require.NoError(t, withEmpty.VerifyStorageDir(ctx, nodeID))
+	require.NoError(t, empty.VerifyStorageDir(ctx, nodeID))
+	require.Error(t, withEmpty.VerifyStorageDir(ctx, testrand.NodeID()))
+}
+
+func TestMoveBlobs(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	from := newTestDir(t, ctx, "from", 0)
+	defer ctx.Check(from.Close)
+	to := newTestDir(t, ctx, "to", 0)
+	defer ctx.Check(to.Close)
+
+	namespace := testrand.Bytes(32)
+	var refs []blobstore.BlobRef
+	for i := 0; i < 20; i++ {
+		ref := blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+		writeBlob(ctx, t, from, ref, testrand.Bytes(100))
+		refs = append(refs, ref)
+	}
+	before, err := from.Stat(ctx, refs[0])
+	require.NoError(t, err)
+	beforeStat, err := before.Stat(ctx)
+	require.NoError(t, err)
+
+	stats, err := multistore.MoveBlobs(ctx, zaptest.NewLogger(t), from, to)
+	require.NoError(t, err)
+	require.EqualValues(t, len(refs), stats.Moved)
+	require.EqualValues(t, 100*len(refs), stats.MovedBytes)
+	require.Zero(t, stats.Skipped)
+
+	for _, ref := range refs {
+		_, err := from.Stat(ctx, ref)
+		require.ErrorIs(t, err, os.ErrNotExist)
+		_, err = to.Stat(ctx, ref)
+		require.NoError(t, err)
+	}
+
+	after, err := to.Stat(ctx, refs[0])
+	require.NoError(t, err)
+	afterStat, err := after.Stat(ctx)
+	require.NoError(t, err)
+	require.True(t, beforeStat.ModTime().Equal(afterStat.ModTime()))
+}
@@ -0,0 +1,267 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package packstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+)
+
+// candidate is a blob of the underlying store which will be packed.
+type candidate struct {
+	ref     blobstore.BlobRef
+	modTime time.Time
+}
+
+// compact packs the small blobs of the underlying store, and rewrites the pack files which
+// have too much garbage.
+func (store *blobStore) compact(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	namespaces, err := store.blobs.ListNamespaces(ctx)
+	if err != nil {
+		return err
+	}
+
+	var packed int
+	for _, namespace := range namespaces {
+		packedBefore := time.Now().Add(-store.config.MinAge)
+
+		var candidates []candidate
+		err := store.blobs.WalkNamespaceFrom(ctx, namespace, "", func(info blobstore.BlobInfo) error {
+			if info.StorageFormatVersion() != filestore.FormatV1 {
+				return nil
+			}
+			stat, err := info.Stat(ctx)
+			if err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					return nil
+				}
+				return err
+			}
+			if stat.Size() >= store.config.Threshold.Int64() || !stat.ModTime().Before(packedBefore) {
+				return nil
+			}
+			candidates = append(candidates, candidate{ref: info.BlobRef(), modTime: stat.ModTime()})
+			return nil
+		}, func(keyPrefix string) error {
+			for len(candidates) > 0 {
+				batch := candidates
+				if len(batch) > compactBatchSize {
+					batch = batch[:compactBatchSize]
+				}
+				candidates = candidates[len(batch):]
+
+				n, err := store.packBlobs(ctx, batch)
+				packed += n
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if packed > 0 {
+		store.log.Info("packed small pieces", zap.Int("count", packed))
+	}
+	mon.IntVal("packed_blobs").Observe(int64(packed))
+
+	return store.rewritePacks(ctx)
+}
+
+// packBlobs moves the blobs of the underlying store into the current pack file.
+func (store *blobStore) packBlobs(ctx context.Context, batch []candidate) (packed int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	type packedBlob struct {
+		ref   blobstore.BlobRef
+		entry entry
+	}
+	var blobs []packedBlob
+	for _, c := range batch {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		data, err := store.readLoose(ctx, c.ref)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				// the blob was deleted or trashed meanwhile.
+				continue
+			}
+			return 0, err
+		}
+		e, err := store.appendBlob(c.ref, data, c.modTime)
+		if err != nil {
+			return 0, err
+		}
+		blobs = append(blobs, packedBlob{ref: c.ref, entry: e})
+	}
+	if len(blobs) == 0 {
+		return 0, nil
+	}
+
+	// the blobs must be durable in the pack file before they are removed from the underlying store.
+	if err := store.current.file.Sync(); err != nil {
+		return 0, Error.Wrap(err)
+	}
+	err = store.db.Update(func(tx *bbolt.Tx) error {
+		for _, blob := range blobs {
+			if err := putEntry(tx, blob.ref.Namespace, encodeKey(blob.ref.Key), blob.entry); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	for _, blob := range blobs {
+		if err := store.blobs.DeleteWithStorageFormat(ctx, blob.ref, filestore.FormatV1); err != nil {
+			// the blob is walked and read from the pack file, so the file is only wasted space.
+			store.log.Warn("failed to delete packed piece", zap.Binary("Key", blob.ref.Key), zap.Error(err))
+		}
+	}
+	return len(blobs), nil
+}
+
+// readLoose reads a V1 blob of the underlying store completely.
+func (store *blobStore) readLoose(ctx context.Context, ref blobstore.BlobRef) (_ []byte, err error) {
+	reader, err := store.blobs.OpenWithStorageFormat(ctx, ref, filestore.FormatV1)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+	return io.ReadAll(reader)
+}
+
+// rewritePacks removes the pack files without live blobs, and rewrites the pack files which
+// have more garbage than MaxGarbage.
+func (store *blobStore) rewritePacks(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	packs, err := store.listPacks()
+	if err != nil {
+		return err
+	}
+	var live map[uint32]int64
+	err = store.db.View(func(tx *bbolt.Tx) error {
+		live = packLive(tx)
+		return nil
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	// the live blobs are copied to the current pack file and the ones after it, which are
+	// never rewritten.
+	current := store.current.id
+	for id, size := range packs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if id >= current || size == 0 {
+			continue
+		}
+		if live[id] == 0 {
+			if err := store.removePack(id); err != nil {
+				return err
+			}
+			continue
+		}
+		if float64(size-live[id])/float64(size) > store.config.MaxGarbage {
+			if err := store.rewritePack(ctx, id); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// rewritePack copies the live blobs of the pack file to the current pack file, and removes it.
+// store.mu must be held.
+func (store *blobStore) rewritePack(ctx context.Context, id uint32) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	type liveBlob struct {
+		namespace []byte
+		key       string
+		entry     entry
+	}
+	var blobs []liveBlob
+	err = store.db.View(func(tx *bbolt.Tx) error {
+		for _, namespace := range namespaces(tx) {
+			namespaceEntries(tx, namespace, "", func(key string, e entry) {
+				if e.pack == id {
+					blobs = append(blobs, liveBlob{namespace: namespace, key: key, entry: e})
+				}
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for i, blob := range blobs {
+		data, err := store.readPacked(blob.entry)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		blobKey, err := decodeKey(blob.key)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		e, err := store.appendBlob(blobstore.BlobRef{Namespace: blob.namespace, Key: blobKey}, data, blob.entry.modTime)
+		if err != nil {
+			return err
+		}
+		blobs[i].entry = e
+	}
+	if err := store.current.file.Sync(); err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = store.db.Update(func(tx *bbolt.Tx) error {
+		for _, blob := range blobs {
+			if err := putEntry(tx, blob.namespace, blob.key, blob.entry); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return store.removePack(id)
+}
+
+// removePack removes the pack file and its live bytes. store.mu must be held.
+func (store *blobStore) removePack(id uint32) error {
+	if err := os.Remove(store.packPath(id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(store.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(packsBucket).Delete(packKey(id))
+	}))
+}
@@ -0,0 +1,170 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package packstore
+
+import (
+	"encoding/binary"
+	"strings"
+	"time"
+
+	"go.etcd.io/bbolt"
+)
+
+var (
+	// piecesBucket contains a bucket of packed blobs for every namespace, keyed by the encoded blob key.
+	piecesBucket = []byte("pieces")
+	// packsBucket contains the live bytes of every pack file.
+	packsBucket = []byte("packs")
+)
+
+const (
+	// entrySize is the size of an encoded entry: pack, offset, size and mtime.
+	entrySize = 4 + 3*8
+	// indexOpenTimeout is how long opening the index waits for another process to release it.
+	indexOpenTimeout = time.Second
+)
+
+// entry is the location of a packed blob.
+type entry struct {
+	pack    uint32
+	offset  int64
+	size    int64
+	modTime time.Time
+}
+
+func (e entry) encode() []byte {
+	var data [entrySize]byte
+	binary.BigEndian.PutUint32(data[0:], e.pack)
+	binary.BigEndian.PutUint64(data[4:], uint64(e.offset))
+	binary.BigEndian.PutUint64(data[12:], uint64(e.size))
+	binary.BigEndian.PutUint64(data[20:], uint64(e.modTime.UnixNano()))
+	return data[:]
+}
+
+func decodeEntry(data []byte) (entry, bool) {
+	if len(data) != entrySize {
+		return entry{}, false
+	}
+	return entry{
+		pack:    binary.BigEndian.Uint32(data[0:]),
+		offset:  int64(binary.BigEndian.Uint64(data[4:])),
+		size:    int64(binary.BigEndian.Uint64(data[12:])),
+		modTime: time.Unix(0, int64(binary.BigEndian.Uint64(data[20:]))),
+	}, true
+}
+
+func packKey(pack uint32) []byte {
+	var key [4]byte
+	binary.BigEndian.PutUint32(key[:], pack)
+	return key[:]
+}
+
+// addLive adds delta to the live bytes of the pack.
+func addLive(tx *bbolt.Tx, pack uint32, delta int64) error {
+	packs := tx.Bucket(packsBucket)
+	var live int64
+	if data := packs.Get(packKey(pack)); len(data) == 8 {
+		live = int64(binary.BigEndian.Uint64(data))
+	}
+	live += delta
+	if live < 0 {
+		live = 0
+	}
+	var data [8]byte
+	binary.BigEndian.PutUint64(data[:], uint64(live))
+	return packs.Put(packKey(pack), data[:])
+}
+
+// packLive returns the live bytes of all the packs.
+func packLive(tx *bbolt.Tx) map[uint32]int64 {
+	live := map[uint32]int64{}
+	_ = tx.Bucket(packsBucket).ForEach(func(k, v []byte) error {
+		if len(k) == 4 && len(v) == 8 {
+			live[binary.BigEndian.Uint32(k)] = int64(binary.BigEndian.Uint64(v))
+		}
+		return nil
+	})
+	return live
+}
+
+// putEntry adds the blob to the index, replacing any previous entry.
+func putEntry(tx *bbolt.Tx, namespace []byte, key string, e entry) error {
+	bucket, err := tx.Bucket(piecesBucket).CreateBucketIfNotExists(namespace)
+	if err != nil {
+		return err
+	}
+	if err := removeEntry(tx, namespace, key); err != nil {
+		return err
+	}
+	if err := bucket.Put([]byte(key), e.encode()); err != nil {
+		return err
+	}
+	return addLive(tx, e.pack, e.size)
+}
+
+// getEntry returns the entry of the blob.
+func getEntry(tx *bbolt.Tx, namespace []byte, key string) (entry, bool) {
+	bucket := tx.Bucket(piecesBucket).Bucket(namespace)
+	if bucket == nil {
+		return entry{}, false
+	}
+	return decodeEntry(bucket.Get([]byte(key)))
+}
+
+// removeEntry removes the blob from the index.
+func removeEntry(tx *bbolt.Tx, namespace []byte, key string) error {
+	e, ok := getEntry(tx, namespace, key)
+	if !ok {
+		return nil
+	}
+	if err := tx.Bucket(piecesBucket).Bucket(namespace).Delete([]byte(key)); err != nil {
+		return err
+	}
+	return addLive(tx, e.pack, -e.size)
+}
+
+// namespaceEntries calls fn for the entries of the namespace whose key starts with prefix.
+func namespaceEntries(tx *bbolt.Tx, namespace []byte, prefix string, fn func(key string, e entry)) {
+	bucket := tx.Bucket(piecesBucket).Bucket(namespace)
+	if bucket == nil {
+		return
+	}
+	cursor := bucket.Cursor()
+	for k, v := cursor.Seek([]byte(prefix)); k != nil && strings.HasPrefix(string(k), prefix); k, v = cursor.Next() {
+		if e, ok := decodeEntry(v); ok {
+			fn(string(k), e)
+		}
+	}
+}
+
+// namespaceKeyPrefixes returns the sorted key prefixes of the namespace.
+func namespaceKeyPrefixes(tx *bbolt.Tx, namespace []byte) []string {
+	bucket := tx.Bucket(piecesBucket).Bucket(namespace)
+	if bucket == nil {
+		return nil
+	}
+	var keyPrefixes []string
+	cursor := bucket.Cursor()
+	for k, _ := cursor.First(); k != nil; {
+		keyPrefix := string(k[:2])
+		keyPrefixes = append(keyPrefixes, keyPrefix)
+		// skip the rest of the keys with the same prefix.
+		k, _ = cursor.Seek([]byte(keyPrefix + "\xff"))
+	}
+	return keyPrefixes
+}
+
+// namespaces returns the namespaces which have packed blobs.
+func namespaces(tx *bbolt.Tx) (ids [][]byte) {
+	_ = tx.Bucket(piecesBucket).ForEach(func(k, v []byte) error {
+		if v != nil {
+			return nil
+		}
+		if first, _ := tx.Bucket(piecesBucket).Bucket(k).Cursor().First(); first != nil {
+			ids = append(ids, append([]byte(nil), k...))
+		}
+		return nil
+	})
+	return ids
+}
@@ -0,0 +1,177 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package packstore
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+)
+
+const (
+	packSuffix = ".pack"
+
+	// recordMagic starts every record in a pack file.
+	recordMagic = "SJPK"
+	// recordHeaderSize is the size of the record header: magic, namespace length, key length,
+	// blob size and mtime. The header allows recovering the blobs from the pack files.
+	recordHeaderSize = 4 + 2 + 2 + 8 + 8
+)
+
+// packWriter appends records to the current pack file.
+type packWriter struct {
+	id   uint32
+	file *os.File
+	size int64
+}
+
+// packPath returns the path of the pack file.
+func (store *blobStore) packPath(id uint32) string {
+	return filepath.Join(store.dir, strconv.FormatUint(uint64(id), 10)+packSuffix)
+}
+
+// listPacks returns the ids and sizes of the pack files.
+func (store *blobStore) listPacks() (map[uint32]int64, error) {
+	entries, err := os.ReadDir(store.dir)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	packs := map[uint32]int64{}
+	for _, dirEntry := range entries {
+		name := dirEntry.Name()
+		if !strings.HasSuffix(name, packSuffix) {
+			continue
+		}
+		id, err := strconv.ParseUint(strings.TrimSuffix(name, packSuffix), 10, 32)
+		if err != nil {
+			continue
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, Error.Wrap(err)
+		}
+		packs[uint32(id)] = info.Size()
+	}
+	return packs, nil
+}
+
+// openCurrent opens the last pack file for appending, or starts a new one when it's full.
+// store.mu must be held.
+func (store *blobStore) openCurrent() error {
+	packs, err := store.listPacks()
+	if err != nil {
+		return err
+	}
+	var last uint32
+	for id := range packs {
+		if id > last {
+			last = id
+		}
+	}
+	if last == 0 || packs[last] >= store.config.PackSize.Int64() {
+		return store.startPack(last + 1)
+	}
+
+	file, err := os.OpenFile(store.packPath(last), os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	store.current = &packWriter{id: last, file: file, size: packs[last]}
+	return nil
+}
+
+// startPack closes the current pack file, and creates a new one. store.mu must be held.
+func (store *blobStore) startPack(id uint32) error {
+	if err := store.closeCurrent(); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(store.packPath(id), os.O_WRONLY|os.O_APPEND|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	store.current = &packWriter{id: id, file: file}
+	return nil
+}
+
+// closeCurrent syncs and closes the current pack file. store.mu must be held.
+func (store *blobStore) closeCurrent() error {
+	if store.current == nil {
+		return nil
+	}
+	err := errs.Combine(store.current.file.Sync(), store.current.file.Close())
+	store.current = nil
+	return Error.Wrap(err)
+}
+
+// appendBlob appends the blob to the current pack file, and returns its location. The pack
+// file must be synced before the location is added to the index. store.mu must be held.
+func (store *blobStore) appendBlob(ref blobstore.BlobRef, data []byte, modTime time.Time) (entry, error) {
+	if store.current.size >= store.config.PackSize.Int64() {
+		if err := store.startPack(store.current.id + 1); err != nil {
+			return entry{}, err
+		}
+	}
+
+	record := make([]byte, recordHeaderSize, recordHeaderSize+len(ref.Namespace)+len(ref.Key)+len(data))
+	copy(record, recordMagic)
+	binary.BigEndian.PutUint16(record[4:], uint16(len(ref.Namespace)))
+	binary.BigEndian.PutUint16(record[6:], uint16(len(ref.Key)))
+	binary.BigEndian.PutUint64(record[8:], uint64(len(data)))
+	binary.BigEndian.PutUint64(record[16:], uint64(modTime.UnixNano()))
+	record = append(record, ref.Namespace...)
+	record = append(record, ref.Key...)
+	dataOffset := store.current.size + int64(len(record))
+	record = append(record, data...)
+
+	if _, err := store.current.file.Write(record); err != nil {
+		return entry{}, Error.Wrap(err)
+	}
+	store.current.size += int64(len(record))
+
+	return entry{
+		pack:    store.current.id,
+		offset:  dataOffset,
+		size:    int64(len(data)),
+		modTime: modTime,
+	}, nil
+}
+
+// readPacked reads a packed blob completely.
+func (store *blobStore) readPacked(e entry) ([]byte, error) {
+	file, err := os.Open(store.packPath(e.pack))
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, e.size)
+	_, err = file.ReadAt(data, e.offset)
+	return data, errs.Combine(err, file.Close())
+}
+
+// packReader reads a blob from a pack file.
+type packReader struct {
+	*io.SectionReader
+	file *os.File
+}
+
+// Close closes the pack file.
+func (reader *packReader) Close() error { return reader.file.Close() }
+
+// StorageFormatVersion returns the storage format version of the blob.
+func (reader *packReader) StorageFormatVersion() blobstore.FormatVersion { return filestore.FormatV1 }
+
+// Size returns the size of the blob.
+func (reader *packReader) Size() (int64, error) { return reader.SectionReader.Size(), nil }
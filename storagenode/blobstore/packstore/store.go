@@ -0,0 +1,609 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package packstore implements a blob store which packs small blobs into larger pack files.
+package packstore
+
+import (
+	"context"
+	"encoding/base32"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+)
+
+var (
+	// Error is the default packstore error class.
+	Error = errs.Class("packstore error")
+
+	mon = monkit.Package()
+
+	_ blobstore.Blobs = (*blobStore)(nil)
+)
+
+// pathEncoding is the encoding used by the filestore, so that the key prefixes of the packed
+// blobs are the same as the key prefixes of the blob files.
+var pathEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// compactBatchSize is the number of blobs packed while holding the lock.
+const compactBatchSize = 100
+
+// Config is the configuration of the pack files.
+type Config struct {
+	Enabled            bool          `help:"pack small pieces into larger pack files to reduce the number of files" default:"false"`
+	Threshold          memory.Size   `help:"pieces smaller than this are packed" default:"64KiB"`
+	PackSize           memory.Size   `help:"size of a pack file, after which a new pack file is started" default:"256MiB"`
+	MinAge             time.Duration `help:"how old pieces must be before they are packed" default:"1h0m0s"`
+	CompactionInterval time.Duration `help:"how often small pieces are packed, and pack files with many deleted pieces are rewritten" default:"1h0m0s"`
+	MaxGarbage         float64       `help:"fraction of deleted data in a pack file, above which the pack file is rewritten" default:"0.5"`
+}
+
+// blobStore packs the small blobs of the underlying store into pack files.
+//
+// New blobs are written to the underlying store. Compaction periodically moves the blobs
+// below the size threshold into append-only pack files, and records their location in an
+// index. Reads, walks and space usage include both the packed blobs and the blobs in the
+// underlying store. Trashing a packed blob unpacks it, so the trash is only in the underlying
+// store. Deleted blobs leave garbage in the pack files, and compaction rewrites the pack files
+// with too much garbage.
+type blobStore struct {
+	log    *zap.Logger
+	blobs  blobstore.Blobs
+	dir    string
+	config Config
+	db     *bbolt.DB
+
+	// mu serializes the changes to the pack files and the index.
+	mu      sync.Mutex
+	current *packWriter
+
+	compaction *sync2.Cycle
+	cancel     context.CancelFunc
+	done       chan struct{}
+}
+
+// New creates a blob store which packs the small blobs of blobs into pack files in dir, and
+// starts the background compaction.
+func New(log *zap.Logger, blobs blobstore.Blobs, dir string, config Config) (_ blobstore.Blobs, err error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	db, err := bbolt.Open(filepath.Join(dir, "index.db"), 0600, &bbolt.Options{Timeout: indexOpenTimeout})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	err = db.Update(func(tx *bbolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(piecesBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists(packsBucket)
+		return err
+	})
+	if err != nil {
+		return nil, Error.Wrap(errs.Combine(err, db.Close()))
+	}
+
+	store := &blobStore{
+		log:    log,
+		blobs:  blobs,
+		dir:    dir,
+		config: config,
+		db:     db,
+
+		compaction: sync2.NewCycle(config.CompactionInterval),
+		done:       make(chan struct{}),
+	}
+	if err := store.openCurrent(); err != nil {
+		return nil, errs.Combine(err, db.Close())
+	}
+
+	var ctx context.Context
+	ctx, store.cancel = context.WithCancel(context.Background())
+	store.compaction.SetDelayStart()
+	go func() {
+		defer close(store.done)
+		_ = store.compaction.Run(ctx, func(ctx context.Context) error {
+			if err := store.compact(ctx); err != nil && !errs.Is(err, context.Canceled) {
+				store.log.Error("pack file compaction failed", zap.Error(err))
+			}
+			return nil
+		})
+	}()
+	return store, nil
+}
+
+// Close stops the compaction, and closes the pack files, the index and the underlying store.
+func (store *blobStore) Close() error {
+	store.cancel()
+	<-store.done
+	store.compaction.Close()
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	return errs.Combine(store.closeCurrent(), Error.Wrap(store.db.Close()), store.blobs.Close())
+}
+
+// encodeKey returns the blob key as it's encoded in the file names of the filestore.
+func encodeKey(key []byte) string {
+	encoded := pathEncoding.EncodeToString(key)
+	if len(encoded) < 3 {
+		// ensure we always have enough characters to split [:2] and [2:]
+		encoded = "11" + encoded
+	}
+	return encoded
+}
+
+func decodeKey(encoded string) ([]byte, error) {
+	return pathEncoding.DecodeString(strings.TrimPrefix(encoded, "11"))
+}
+
+// lookup returns the location of the blob, when it's packed.
+func (store *blobStore) lookup(ref blobstore.BlobRef) (e entry, ok bool, err error) {
+	if !ref.IsValid() {
+		return entry{}, false, blobstore.ErrInvalidBlobRef.New("")
+	}
+	err = store.db.View(func(tx *bbolt.Tx) error {
+		e, ok = getEntry(tx, ref.Namespace, encodeKey(ref.Key))
+		return nil
+	})
+	return e, ok, Error.Wrap(err)
+}
+
+// Create creates a new blob in the underlying store.
+func (store *blobStore) Create(ctx context.Context, ref blobstore.BlobRef, size int64) (_ blobstore.BlobWriter, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.blobs.Create(ctx, ref, size)
+}
+
+// Open opens a reader for the blob, which is either packed or in the underlying store.
+func (store *blobStore) Open(ctx context.Context, ref blobstore.BlobRef) (_ blobstore.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the pack file can be removed by a rewrite between the lookup and opening it, in
+	// which case the new location is looked up.
+	for attempt := 0; attempt < 2; attempt++ {
+		e, ok, err := store.lookup(ref)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return store.blobs.Open(ctx, ref)
+		}
+
+		file, err := os.Open(store.packPath(e.pack))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, Error.Wrap(err)
+		}
+		return &packReader{SectionReader: io.NewSectionReader(file, e.offset, e.size), file: file}, nil
+	}
+	return store.blobs.Open(ctx, ref)
+}
+
+// OpenWithStorageFormat opens a reader for the blob with the storage format. Only V1 blobs are packed.
+func (store *blobStore) OpenWithStorageFormat(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (_ blobstore.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if formatVer != filestore.FormatV1 {
+		return store.blobs.OpenWithStorageFormat(ctx, ref, formatVer)
+	}
+	return store.Open(ctx, ref)
+}
+
+// Stat looks up the metadata of the blob, which is either packed or in the underlying store.
+func (store *blobStore) Stat(ctx context.Context, ref blobstore.BlobRef) (_ blobstore.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	e, ok, err := store.lookup(ref)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return store.blobs.Stat(ctx, ref)
+	}
+	return store.packedInfo(ref, e), nil
+}
+
+// StatWithStorageFormat looks up the metadata of the blob with the storage format. Only V1 blobs are packed.
+func (store *blobStore) StatWithStorageFormat(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (_ blobstore.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if formatVer != filestore.FormatV1 {
+		return store.blobs.StatWithStorageFormat(ctx, ref, formatVer)
+	}
+	return store.Stat(ctx, ref)
+}
+
+// Delete deletes the blob from the index and from the underlying store.
+func (store *blobStore) Delete(ctx context.Context, ref blobstore.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	if err := store.removePacked(ref); err != nil {
+		return err
+	}
+	return store.blobs.Delete(ctx, ref)
+}
+
+// DeleteWithStorageFormat deletes the blob with the storage format. Only V1 blobs are packed.
+func (store *blobStore) DeleteWithStorageFormat(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	if formatVer == filestore.FormatV1 {
+		if err := store.removePacked(ref); err != nil {
+			return err
+		}
+	}
+	return store.blobs.DeleteWithStorageFormat(ctx, ref, formatVer)
+}
+
+// removePacked removes the blob from the index. store.mu must be held.
+func (store *blobStore) removePacked(ref blobstore.BlobRef) error {
+	if !ref.IsValid() {
+		return blobstore.ErrInvalidBlobRef.New("")
+	}
+	return Error.Wrap(store.db.Update(func(tx *bbolt.Tx) error {
+		return removeEntry(tx, ref.Namespace, encodeKey(ref.Key))
+	}))
+}
+
+// DeleteNamespace deletes the blobs of the namespace from the index and from the underlying store.
+func (store *blobStore) DeleteNamespace(ctx context.Context, ref []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	err = store.db.Update(func(tx *bbolt.Tx) error {
+		var removed []entry
+		namespaceEntries(tx, ref, "", func(key string, e entry) {
+			removed = append(removed, e)
+		})
+		for _, e := range removed {
+			if err := addLive(tx, e.pack, -e.size); err != nil {
+				return err
+			}
+		}
+		err := tx.Bucket(piecesBucket).DeleteBucket(ref)
+		if errors.Is(err, bbolt.ErrBucketNotFound) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return store.blobs.DeleteNamespace(ctx, ref)
+}
+
+// Trash moves the blob to the trash of the underlying store. A packed blob is unpacked first.
+func (store *blobStore) Trash(ctx context.Context, ref blobstore.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	e, ok, err := store.lookup(ref)
+	if err != nil {
+		return err
+	}
+	if ok {
+		if err := store.unpack(ctx, ref, e); err != nil {
+			return err
+		}
+	}
+	return store.blobs.Trash(ctx, ref)
+}
+
+// unpack writes the packed blob to the underlying store, and removes it from the index.
+// store.mu must be held.
+func (store *blobStore) unpack(ctx context.Context, ref blobstore.BlobRef, e entry) (err error) {
+	data, err := store.readPacked(e)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	writer, err := store.blobs.Create(ctx, ref, int64(len(data)))
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(data); err != nil {
+		return errs.Combine(err, writer.Cancel(ctx))
+	}
+	if err := writer.Commit(ctx); err != nil {
+		return err
+	}
+	return store.removePacked(ref)
+}
+
+// RestoreTrash restores the trash of the underlying store.
+func (store *blobStore) RestoreTrash(ctx context.Context, namespace []byte) (_ [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.blobs.RestoreTrash(ctx, namespace)
+}
+
+// RestoreTrashKeys restores the blobs with the given keys from the trash of the underlying store.
+func (store *blobStore) RestoreTrashKeys(ctx context.Context, namespace []byte, keys [][]byte) (_ [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.blobs.RestoreTrashKeys(ctx, namespace, keys)
+}
+
+// WalkTrash walks the trash of the underlying store.
+func (store *blobStore) WalkTrash(ctx context.Context, namespace []byte, walkFunc func(blobstore.BlobInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.blobs.WalkTrash(ctx, namespace, walkFunc)
+}
+
+// EmptyTrash empties the trash of the underlying store.
+func (store *blobStore) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (_ int64, _ [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.blobs.EmptyTrash(ctx, namespace, trashedBefore)
+}
+
+// EmptyTrashWithProgress empties the trash of the underlying store and reports the deleted
+// blobs to deleted.
+func (store *blobStore) EmptyTrashWithProgress(ctx context.Context, namespace []byte, trashedBefore time.Time, deleted func(blobs, bytes int64)) (_ int64, _ [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return blobstore.EmptyTrash(ctx, store.blobs, namespace, trashedBefore, deleted)
+}
+
+// FreeSpace returns the free space of the underlying store.
+func (store *blobStore) FreeSpace(ctx context.Context) (int64, error) {
+	return store.blobs.FreeSpace(ctx)
+}
+
+// SpaceUsedForTrash returns the space used by the trash of the underlying store.
+func (store *blobStore) SpaceUsedForTrash(ctx context.Context) (int64, error) {
+	return store.blobs.SpaceUsedForTrash(ctx)
+}
+
+// SpaceUsedForBlobs returns the space used by the packed blobs and the blobs in the
+// underlying store. The garbage in the pack files isn't included.
+func (store *blobStore) SpaceUsedForBlobs(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	total, err = store.blobs.SpaceUsedForBlobs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	err = store.db.View(func(tx *bbolt.Tx) error {
+		for _, live := range packLive(tx) {
+			total += live
+		}
+		return nil
+	})
+	return total, Error.Wrap(err)
+}
+
+// SpaceUsedForBlobsInNamespace returns the space used by the packed blobs and the blobs in the
+// underlying store in the namespace.
+func (store *blobStore) SpaceUsedForBlobsInNamespace(ctx context.Context, namespace []byte) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	total, err = store.blobs.SpaceUsedForBlobsInNamespace(ctx, namespace)
+	if err != nil {
+		return 0, err
+	}
+	err = store.db.View(func(tx *bbolt.Tx) error {
+		namespaceEntries(tx, namespace, "", func(key string, e entry) {
+			total += e.size
+		})
+		return nil
+	})
+	return total, Error.Wrap(err)
+}
+
+// ListNamespaces returns the namespaces of the packed blobs and of the underlying store.
+func (store *blobStore) ListNamespaces(ctx context.Context) (ids [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ids, err = store.blobs.ListNamespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]struct{}{}
+	for _, id := range ids {
+		seen[string(id)] = struct{}{}
+	}
+
+	var packed [][]byte
+	err = store.db.View(func(tx *bbolt.Tx) error {
+		packed = namespaces(tx)
+		return nil
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	for _, id := range packed {
+		if _, ok := seen[string(id)]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// WalkNamespace executes walkFunc for each blob in the namespace.
+func (store *blobStore) WalkNamespace(ctx context.Context, namespace []byte, walkFunc func(blobstore.BlobInfo) error) (err error) {
+	return store.WalkNamespaceFrom(ctx, namespace, "", walkFunc, nil)
+}
+
+// WalkNamespaceFrom is like WalkNamespace, but it walks the key prefixes in order, skipping the
+// ones up to and including startAfter, and calls prefixDone after all the blobs with a key prefix
+// have been walked.
+func (store *blobStore) WalkNamespaceFrom(ctx context.Context, namespace []byte, startAfter string, walkFunc func(blobstore.BlobInfo) error, prefixDone func(keyPrefix string) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyPrefixes, err := store.ListKeyPrefixes(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	for _, keyPrefix := range keyPrefixes {
+		if keyPrefix <= startAfter {
+			continue
+		}
+		if err := store.WalkNamespacePrefix(ctx, namespace, keyPrefix, walkFunc); err != nil {
+			return err
+		}
+		if prefixDone != nil {
+			if err := prefixDone(keyPrefix); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ListKeyPrefixes returns the sorted key prefixes of the packed blobs and of the underlying store.
+func (store *blobStore) ListKeyPrefixes(ctx context.Context, namespace []byte) (_ []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyPrefixes, err := store.blobs.ListKeyPrefixes(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]struct{}{}
+	for _, keyPrefix := range keyPrefixes {
+		seen[keyPrefix] = struct{}{}
+	}
+
+	err = store.db.View(func(tx *bbolt.Tx) error {
+		for _, keyPrefix := range namespaceKeyPrefixes(tx, namespace) {
+			if _, ok := seen[keyPrefix]; !ok {
+				keyPrefixes = append(keyPrefixes, keyPrefix)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	sort.Strings(keyPrefixes)
+	return keyPrefixes, nil
+}
+
+// WalkNamespacePrefix executes walkFunc for each packed blob and each blob of the underlying
+// store with the key prefix in the namespace.
+func (store *blobStore) WalkNamespacePrefix(ctx context.Context, namespace []byte, keyPrefix string, walkFunc func(blobstore.BlobInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the entries are collected first, so that walkFunc can change the index.
+	packed := map[string]entry{}
+	err = store.db.View(func(tx *bbolt.Tx) error {
+		namespaceEntries(tx, namespace, keyPrefix, func(key string, e entry) {
+			packed[key] = e
+		})
+		return nil
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = store.blobs.WalkNamespacePrefix(ctx, namespace, keyPrefix, func(info blobstore.BlobInfo) error {
+		if info.StorageFormatVersion() == filestore.FormatV1 {
+			// the blob was packed, but the compaction was interrupted before removing the file.
+			if _, ok := packed[encodeKey(info.BlobRef().Key)]; ok {
+				return nil
+			}
+		}
+		return walkFunc(info)
+	})
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(packed))
+	for key := range packed {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		blobKey, err := decodeKey(key)
+		if err != nil {
+			continue
+		}
+		ref := blobstore.BlobRef{Namespace: namespace, Key: blobKey}
+		if err := walkFunc(store.packedInfo(ref, packed[key])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckWritability checks the writability of the underlying store.
+func (store *blobStore) CheckWritability(ctx context.Context) error {
+	return store.blobs.CheckWritability(ctx)
+}
+
+// CreateVerificationFile creates the verification file in the underlying store.
+func (store *blobStore) CreateVerificationFile(ctx context.Context, id storj.NodeID) error {
+	return store.blobs.CreateVerificationFile(ctx, id)
+}
+
+// VerifyStorageDir verifies the underlying store.
+func (store *blobStore) VerifyStorageDir(ctx context.Context, id storj.NodeID) error {
+	return store.blobs.VerifyStorageDir(ctx, id)
+}
+
+// packedInfo returns the BlobInfo of a packed blob.
+func (store *blobStore) packedInfo(ref blobstore.BlobRef, e entry) *blobInfo {
+	return &blobInfo{ref: ref, entry: e, path: store.packPath(e.pack)}
+}
+
+// blobInfo is the metadata of a packed blob.
+type blobInfo struct {
+	ref   blobstore.BlobRef
+	entry entry
+	path  string
+}
+
+// BlobRef returns the relevant BlobRef for the blob.
+func (info *blobInfo) BlobRef() blobstore.BlobRef { return info.ref }
+
+// StorageFormatVersion indicates the storage format version used to store the blob.
+func (info *blobInfo) StorageFormatVersion() blobstore.FormatVersion { return filestore.FormatV1 }
+
+// FullPath returns the path of the pack file which contains the blob.
+func (info *blobInfo) FullPath(ctx context.Context) (string, error) { return info.path, nil }
+
+// Stat returns the size and the original mtime of the blob.
+func (info *blobInfo) Stat(ctx context.Context) (os.FileInfo, error) {
+	return packedFileInfo{name: encodeKey(info.ref.Key), entry: info.entry}, nil
+}
+
+// packedFileInfo implements os.FileInfo for a packed blob.
+type packedFileInfo struct {
+	name  string
+	entry entry
+}
+
+func (info packedFileInfo) Name() string       { return info.name }
+func (info packedFileInfo) Size() int64        { return info.entry.size }
+func (info packedFileInfo) Mode() fs.FileMode  { return 0600 }
+func (info packedFileInfo) ModTime() time.Time { return info.entry.modTime }
+func (info packedFileInfo) IsDir() bool        { return false }
+func (info packedFileInfo) Sys() interface{}   { return nil }
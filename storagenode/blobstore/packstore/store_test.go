@@ -0,0 +1,148 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package packstore
+
+import (
+	"context"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+)
+
+func writeBlob(ctx context.Context, t *testing.T, store blobstore.Blobs, ref blobstore.BlobRef, data []byte) {
+	writer, err := store.Create(ctx, ref, int64(len(data)))
+	require.NoError(t, err)
+	_, err = writer.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit(ctx))
+}
+
+func readBlob(ctx context.Context, t *testing.T, store blobstore.Blobs, ref blobstore.BlobRef) []byte {
+	reader, err := store.Open(ctx, ref)
+	require.NoError(t, err)
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	return data
+}
+
+func TestStore(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	log := zaptest.NewLogger(t)
+	loose, err := filestore.NewAt(log, ctx.Dir("pieces"), filestore.DefaultConfig)
+	require.NoError(t, err)
+
+	blobs, err := New(log, loose, ctx.Dir("packs"), Config{
+		Threshold:          memory.KiB,
+		PackSize:           4 * memory.KiB,
+		CompactionInterval: time.Hour,
+		MaxGarbage:         0.5,
+	})
+	require.NoError(t, err)
+	defer ctx.Check(blobs.Close)
+	store := blobs.(*blobStore)
+
+	namespace := testrand.Bytes(32)
+	contents := map[string][]byte{}
+	var small []blobstore.BlobRef
+	for i := 0; i < 20; i++ {
+		ref := blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+		data := testrand.BytesInt(512)
+		writeBlob(ctx, t, store, ref, data)
+		contents[string(ref.Key)] = data
+		small = append(small, ref)
+	}
+	large := blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	contents[string(large.Key)] = testrand.BytesInt(2048)
+	writeBlob(ctx, t, store, large, contents[string(large.Key)])
+
+	usedBefore, err := store.SpaceUsedForBlobsInNamespace(ctx, namespace)
+	require.NoError(t, err)
+
+	require.NoError(t, store.compact(ctx))
+
+	// the small blobs are only in the pack files.
+	for _, ref := range small {
+		_, err := loose.Stat(ctx, ref)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	}
+	_, err = loose.Stat(ctx, large)
+	require.NoError(t, err)
+	packs, err := store.listPacks()
+	require.NoError(t, err)
+	require.Greater(t, len(packs), 1)
+
+	for key, data := range contents {
+		ref := blobstore.BlobRef{Namespace: namespace, Key: []byte(key)}
+		require.Equal(t, data, readBlob(ctx, t, store, ref))
+
+		info, err := store.Stat(ctx, ref)
+		require.NoError(t, err)
+		stat, err := info.Stat(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, len(data), stat.Size())
+	}
+
+	usedAfter, err := store.SpaceUsedForBlobsInNamespace(ctx, namespace)
+	require.NoError(t, err)
+	require.Equal(t, usedBefore, usedAfter)
+
+	walked := map[string]bool{}
+	require.NoError(t, store.WalkNamespace(ctx, namespace, func(info blobstore.BlobInfo) error {
+		require.False(t, walked[string(info.BlobRef().Key)])
+		walked[string(info.BlobRef().Key)] = true
+		return nil
+	}))
+	require.Len(t, walked, len(contents))
+
+	namespaces, err := store.ListNamespaces(ctx)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{namespace}, namespaces)
+
+	// trashed blobs are unpacked, and restored to the underlying store.
+	require.NoError(t, store.Trash(ctx, small[0]))
+	_, err = store.Stat(ctx, small[0])
+	require.ErrorIs(t, err, os.ErrNotExist)
+	restored, err := store.RestoreTrash(ctx, namespace)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{small[0].Key}, restored)
+	require.Equal(t, contents[string(small[0].Key)], readBlob(ctx, t, loose, small[0]))
+
+	// pack files are rewritten or removed when most of their blobs are deleted.
+	for _, ref := range small[1:16] {
+		require.NoError(t, store.Delete(ctx, ref))
+		_, err := store.Open(ctx, ref)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	}
+	require.NoError(t, store.rewritePacks(ctx))
+
+	packsAfter, err := store.listPacks()
+	require.NoError(t, err)
+	var packedSize, packsSize int64
+	for _, size := range packsAfter {
+		packsSize += size
+	}
+	for _, ref := range small[16:] {
+		require.Equal(t, contents[string(ref.Key)], readBlob(ctx, t, store, ref))
+		packedSize += int64(len(contents[string(ref.Key)]))
+	}
+	require.Less(t, packsSize, 2*packedSize+int64(len(small))*recordHeaderSize*4)
+
+	// the blobs are still found after reopening.
+	require.NoError(t, store.closeCurrent())
+	require.NoError(t, store.openCurrent())
+	require.Equal(t, contents[string(small[19].Key)], readBlob(ctx, t, store, small[19]))
+}
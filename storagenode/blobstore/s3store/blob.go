@@ -0,0 +1,228 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package s3store
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+)
+
+// blobInfo is the metadata of a blob in the store.
+type blobInfo struct {
+	ref    blobstore.BlobRef
+	bucket string
+	object objectInfo
+}
+
+var _ blobstore.BlobInfo = (*blobInfo)(nil)
+
+// BlobRef returns the relevant BlobRef for the blob.
+func (info *blobInfo) BlobRef() blobstore.BlobRef { return info.ref }
+
+// StorageFormatVersion indicates the storage format version used to store the blob.
+func (info *blobInfo) StorageFormatVersion() blobstore.FormatVersion { return filestore.FormatV1 }
+
+// FullPath returns the location of the object, as bucket/key.
+func (info *blobInfo) FullPath(ctx context.Context) (string, error) {
+	return info.bucket + "/" + info.object.Key, nil
+}
+
+// Stat returns the metadata of the object.
+func (info *blobInfo) Stat(ctx context.Context) (os.FileInfo, error) {
+	return objectFileInfo{info.object}, nil
+}
+
+// objectFileInfo implements os.FileInfo for an object.
+type objectFileInfo struct {
+	object objectInfo
+}
+
+func (info objectFileInfo) Name() string       { return path.Base(info.object.Key) }
+func (info objectFileInfo) Size() int64        { return info.object.Size }
+func (info objectFileInfo) Mode() fs.FileMode  { return 0600 }
+func (info objectFileInfo) ModTime() time.Time { return info.object.LastModified }
+func (info objectFileInfo) IsDir() bool        { return false }
+func (info objectFileInfo) Sys() interface{}   { return nil }
+
+// fileReader reads a blob from the local cache.
+type fileReader struct {
+	*os.File
+	size int64
+}
+
+// Size returns the size of the blob.
+func (reader *fileReader) Size() (int64, error) { return reader.size, nil }
+
+// StorageFormatVersion returns the storage format version of the blob.
+func (reader *fileReader) StorageFormatVersion() blobstore.FormatVersion { return filestore.FormatV1 }
+
+// remoteReader streams a blob from the bucket. Sequential reads use a single request, which
+// is only restarted after a seek.
+type remoteReader struct {
+	ctx    context.Context
+	client *client
+	key    string
+	size   int64
+
+	pos     int64
+	body    io.ReadCloser
+	bodyPos int64
+}
+
+// Read reads from the current position of the blob.
+func (reader *remoteReader) Read(p []byte) (n int, err error) {
+	if reader.pos >= reader.size {
+		return 0, io.EOF
+	}
+	if reader.body == nil || reader.bodyPos != reader.pos {
+		if err := reader.closeBody(); err != nil {
+			return 0, err
+		}
+		reader.body, err = reader.client.getObject(reader.ctx, reader.key, reader.pos, -1)
+		if err != nil {
+			return 0, err
+		}
+		reader.bodyPos = reader.pos
+	}
+
+	n, err = reader.body.Read(p)
+	reader.pos += int64(n)
+	reader.bodyPos += int64(n)
+	if errors.Is(err, io.EOF) && reader.pos < reader.size {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+// ReadAt reads from the given offset of the blob, without changing the current position.
+func (reader *remoteReader) ReadAt(p []byte, off int64) (n int, err error) {
+	if off >= reader.size {
+		return 0, io.EOF
+	}
+	length := int64(len(p))
+	if off+length > reader.size {
+		length = reader.size - off
+	}
+
+	body, err := reader.client.getObject(reader.ctx, reader.key, off, length)
+	if err != nil {
+		return 0, err
+	}
+	n, err = io.ReadFull(body, p[:length])
+	err = errs.Combine(err, body.Close())
+	if err == nil && int64(n) < int64(len(p)) {
+		err = io.EOF
+	}
+	return n, err
+}
+
+// Seek sets the position of the next Read.
+func (reader *remoteReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += reader.pos
+	case io.SeekEnd:
+		offset += reader.size
+	default:
+		return reader.pos, Error.New("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return reader.pos, Error.New("negative position %d", offset)
+	}
+	reader.pos = offset
+	return offset, nil
+}
+
+// Close closes the open request.
+func (reader *remoteReader) Close() error {
+	return reader.closeBody()
+}
+
+func (reader *remoteReader) closeBody() error {
+	if reader.body == nil {
+		return nil
+	}
+	err := reader.body.Close()
+	reader.body = nil
+	return Error.Wrap(err)
+}
+
+// Size returns the size of the blob.
+func (reader *remoteReader) Size() (int64, error) { return reader.size, nil }
+
+// StorageFormatVersion returns the storage format version of the blob.
+func (reader *remoteReader) StorageFormatVersion() blobstore.FormatVersion { return filestore.FormatV1 }
+
+// blobWriter writes a new blob into a temporary file of the cache.
+type blobWriter struct {
+	cache  *cache
+	key    string
+	file   *os.File
+	closed bool
+}
+
+// Write adds data to the blob.
+func (blob *blobWriter) Write(p []byte) (int, error) {
+	return blob.file.Write(p)
+}
+
+// Seek seeks the temporary file.
+func (blob *blobWriter) Seek(offset int64, whence int) (int64, error) {
+	return blob.file.Seek(offset, whence)
+}
+
+// Cancel discards the blob.
+func (blob *blobWriter) Cancel(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if blob.closed {
+		return nil
+	}
+	blob.closed = true
+
+	err = blob.file.Close()
+	removeErr := os.Remove(blob.file.Name())
+	return Error.Wrap(errs.Combine(err, removeErr))
+}
+
+// Commit queues the blob for upload. The blob is readable from the cache until then.
+func (blob *blobWriter) Commit(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if blob.closed {
+		return Error.New("already closed")
+	}
+	blob.closed = true
+
+	position, err := blob.file.Seek(0, io.SeekCurrent)
+	if err == nil {
+		err = blob.file.Truncate(position)
+	}
+	if err != nil {
+		_ = blob.file.Close()
+		return Error.Wrap(errs.Combine(err, os.Remove(blob.file.Name())))
+	}
+	return blob.cache.commit(blob.file, blob.key)
+}
+
+// Size returns how much has been written so far.
+func (blob *blobWriter) Size() (int64, error) {
+	return blob.file.Seek(0, io.SeekCurrent)
+}
+
+// StorageFormatVersion indicates what storage format version the blob is using.
+func (blob *blobWriter) StorageFormatVersion() blobstore.FormatVersion {
+	return filestore.FormatV1
+}
@@ -0,0 +1,403 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package s3store
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// uploadRetryDelay is how long an upload worker waits after a failed upload.
+const uploadRetryDelay = 10 * time.Second
+
+// pendingObject is a committed blob, which hasn't been uploaded yet.
+type pendingObject struct {
+	size    int64
+	modTime time.Time
+}
+
+// cachedObject is an uploaded blob, which is kept in the cache.
+type cachedObject struct {
+	key  string
+	size int64
+}
+
+// cache is the local write-back cache of the store.
+//
+// Committed blobs are kept as pending files until an upload worker uploads them to the
+// bucket. Afterwards they stay in the cache, so that the recently uploaded pieces, which
+// are the most likely to be downloaded, are read from the local disk. The least recently
+// used blobs are evicted when the cache is full. Pending blobs are never evicted, and they
+// are uploaded after a restart.
+type cache struct {
+	log    *zap.Logger
+	dir    string
+	limit  int64
+	client *client
+
+	notify chan struct{}
+
+	mu       sync.Mutex
+	pending  map[string]*pendingObject
+	queue    []string
+	uploaded map[string]*list.Element
+	lru      *list.List // of *cachedObject, least recently used first
+	used     int64
+}
+
+// newCache opens the cache in the directory, and queues the blobs which weren't
+// uploaded before the last shutdown.
+func newCache(log *zap.Logger, dir string, limit int64, workers int, client *client) (*cache, error) {
+	c := &cache{
+		log:    log,
+		dir:    dir,
+		limit:  limit,
+		client: client,
+
+		notify: make(chan struct{}, workers),
+
+		pending:  map[string]*pendingObject{},
+		uploaded: map[string]*list.Element{},
+		lru:      list.New(),
+	}
+
+	// partially written blobs are useless after a restart.
+	if err := os.RemoveAll(c.tempDir()); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	for _, subdir := range []string{c.tempDir(), c.pendingDir(), c.objectsDir()} {
+		if err := os.MkdirAll(subdir, 0700); err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+
+	err := walkFiles(c.pendingDir(), func(key string, info fs.FileInfo) {
+		c.pending[key] = &pendingObject{size: info.Size(), modTime: info.ModTime()}
+		c.queue = append(c.queue, key)
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var cached []*cachedObject
+	modTimes := map[*cachedObject]time.Time{}
+	err = walkFiles(c.objectsDir(), func(key string, info fs.FileInfo) {
+		object := &cachedObject{key: key, size: info.Size()}
+		cached = append(cached, object)
+		modTimes[object] = info.ModTime()
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	sort.Slice(cached, func(i, k int) bool {
+		return modTimes[cached[i]].Before(modTimes[cached[k]])
+	})
+	for _, object := range cached {
+		c.uploaded[object.key] = c.lru.PushBack(object)
+		c.used += object.size
+	}
+	c.evict()
+
+	return c, nil
+}
+
+func (c *cache) tempDir() string    { return filepath.Join(c.dir, "temp") }
+func (c *cache) pendingDir() string { return filepath.Join(c.dir, "pending") }
+func (c *cache) objectsDir() string { return filepath.Join(c.dir, "objects") }
+
+func (c *cache) pendingPath(key string) string {
+	return filepath.Join(c.pendingDir(), filepath.FromSlash(key))
+}
+
+func (c *cache) objectPath(key string) string {
+	return filepath.Join(c.objectsDir(), filepath.FromSlash(key))
+}
+
+// createTemp creates a temporary file for a new blob.
+func (c *cache) createTemp() (*os.File, error) {
+	file, err := os.CreateTemp(c.tempDir(), "blob-*.partial")
+	return file, Error.Wrap(err)
+}
+
+// commit moves the written temporary file to the pending blobs, and queues it for upload.
+// The file is closed.
+func (c *cache) commit(file *os.File, key string) (err error) {
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return Error.Wrap(err)
+	}
+	if err := file.Close(); err != nil {
+		return Error.Wrap(err)
+	}
+
+	path := c.pendingPath(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return Error.Wrap(err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := os.Rename(file.Name(), path); err != nil {
+		return Error.Wrap(err)
+	}
+	c.removeUploaded(key)
+	c.pending[key] = &pendingObject{size: info.Size(), modTime: info.ModTime()}
+	c.queue = append(c.queue, key)
+
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// open opens the cached blob. It returns an os.ErrNotExist error when the blob isn't in the cache.
+func (c *cache) open(key string) (file *os.File, pending bool, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.pending[key]; ok {
+		file, err := os.Open(c.pendingPath(key))
+		return file, true, err
+	}
+	if element, ok := c.uploaded[key]; ok {
+		c.lru.MoveToBack(element)
+		file, err := os.Open(c.objectPath(key))
+		return file, false, err
+	}
+	return nil, false, os.ErrNotExist
+}
+
+// stat returns the metadata of the cached blob.
+func (c *cache) stat(key string) (os.FileInfo, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	path := ""
+	if _, ok := c.pending[key]; ok {
+		path = c.pendingPath(key)
+	} else if _, ok := c.uploaded[key]; ok {
+		path = c.objectPath(key)
+	} else {
+		return nil, false
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, false
+	}
+	return info, true
+}
+
+// remove removes the blob from the cache. A pending blob won't be uploaded anymore.
+func (c *cache) remove(key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var err error
+	if _, ok := c.pending[key]; ok {
+		delete(c.pending, key)
+		err = os.Remove(c.pendingPath(key))
+	}
+	c.removeUploaded(key)
+
+	if errors.Is(err, os.ErrNotExist) {
+		err = nil
+	}
+	return Error.Wrap(err)
+}
+
+// pendingObjects returns the pending blobs whose key starts with the prefix.
+func (c *cache) pendingObjects(prefix string) []objectInfo {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var objects []objectInfo
+	for key, object := range c.pending {
+		if strings.HasPrefix(key, prefix) {
+			objects = append(objects, objectInfo{
+				Key:          key,
+				Size:         object.size,
+				LastModified: object.modTime,
+			})
+		}
+	}
+	sort.Slice(objects, func(i, k int) bool {
+		return objects[i].Key < objects[k].Key
+	})
+	return objects
+}
+
+// run uploads the pending blobs with the given number of workers until the context is canceled.
+func (c *cache) run(ctx context.Context, workers int) error {
+	var group errgroup.Group
+	for i := 0; i < workers; i++ {
+		group.Go(func() error {
+			c.uploadLoop(ctx)
+			return nil
+		})
+	}
+	return group.Wait()
+}
+
+// uploadLoop uploads queued blobs until the context is canceled.
+func (c *cache) uploadLoop(ctx context.Context) {
+	for {
+		key, object, ok := c.next()
+		if !ok {
+			select {
+			case <-ctx.Done():
+				return
+			case <-c.notify:
+				continue
+			}
+		}
+
+		if err := c.upload(ctx, key, object); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			c.log.Warn("failed to upload piece", zap.String("Key", key), zap.Error(err))
+
+			c.mu.Lock()
+			if c.pending[key] == object {
+				c.queue = append(c.queue, key)
+			}
+			c.mu.Unlock()
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(uploadRetryDelay):
+			}
+		}
+	}
+}
+
+// next returns the next queued blob which is still pending.
+func (c *cache) next() (string, *pendingObject, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for len(c.queue) > 0 {
+		key := c.queue[0]
+		c.queue = c.queue[1:]
+		if object, ok := c.pending[key]; ok {
+			return key, object, true
+		}
+	}
+	return "", nil, false
+}
+
+// upload uploads the pending blob, and moves it to the uploaded blobs.
+func (c *cache) upload(ctx context.Context, key string, object *pendingObject) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	file, err := os.Open(c.pendingPath(key))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// the blob was deleted since it was queued.
+			return nil
+		}
+		return Error.Wrap(err)
+	}
+	err = c.client.putObject(ctx, key, file, object.size)
+	if closeErr := file.Close(); err == nil {
+		err = Error.Wrap(closeErr)
+	}
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	current, stillPending := c.pending[key]
+	if current == object {
+		delete(c.pending, key)
+		err = c.addUploaded(key, object.size)
+	}
+	c.mu.Unlock()
+
+	if !stillPending {
+		// the blob was deleted or trashed during the upload.
+		return c.client.deleteObject(ctx, key)
+	}
+	return err
+}
+
+// addUploaded moves the uploaded blob from the pending blobs to the cached blobs.
+// c.mu must be held.
+func (c *cache) addUploaded(key string, size int64) error {
+	path := c.objectPath(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return Error.Wrap(err)
+	}
+	if err := os.Rename(c.pendingPath(key), path); err != nil {
+		return Error.Wrap(err)
+	}
+	c.uploaded[key] = c.lru.PushBack(&cachedObject{key: key, size: size})
+	c.used += size
+	c.evict()
+	return nil
+}
+
+// removeUploaded removes the blob from the cached blobs. c.mu must be held.
+func (c *cache) removeUploaded(key string) {
+	element, ok := c.uploaded[key]
+	if !ok {
+		return
+	}
+	object := element.Value.(*cachedObject)
+	c.lru.Remove(element)
+	delete(c.uploaded, key)
+	c.used -= object.size
+	if err := os.Remove(c.objectPath(key)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		c.log.Warn("failed to remove cached piece", zap.String("Key", key), zap.Error(err))
+	}
+}
+
+// evict removes the least recently used blobs, until the cache fits the limit. c.mu must be held.
+func (c *cache) evict() {
+	for c.used > c.limit && c.lru.Len() > 0 {
+		c.removeUploaded(c.lru.Front().Value.(*cachedObject).key)
+	}
+}
+
+// walkFiles calls fn for each file in the directory tree, with the slash separated
+// path relative to the directory as the key.
+func walkFiles(dir string, fn func(key string, info fs.FileInfo)) error {
+	return filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		fn(filepath.ToSlash(rel), info)
+		return nil
+	})
+}
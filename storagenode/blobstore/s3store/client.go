@@ -0,0 +1,407 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package s3store
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// emptyPayloadHash is the SHA256 of an empty request body.
+const emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// objectInfo is the metadata of a stored object.
+type objectInfo struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+// client is a minimal client for the S3 API, which signs the requests with AWS signature version 4.
+// It only implements the calls needed by the blob store.
+type client struct {
+	http     *http.Client
+	endpoint *url.URL
+	config   Config
+}
+
+// newClient creates a client for the bucket in the config.
+func newClient(config Config) (*client, error) {
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return nil, Error.New("invalid endpoint %q: %v", config.Endpoint, err)
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return nil, Error.New("invalid endpoint %q: scheme must be http or https", config.Endpoint)
+	}
+	if config.Bucket == "" {
+		return nil, Error.New("bucket is not set")
+	}
+	return &client{
+		http:     &http.Client{},
+		endpoint: endpoint,
+		config:   config,
+	}, nil
+}
+
+// putObject uploads an object of the given size.
+func (c *client) putObject(ctx context.Context, key string, body io.ReadSeeker, size int64) error {
+	hash := sha256.New()
+	if _, err := io.Copy(hash, body); err != nil {
+		return Error.Wrap(err)
+	}
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return Error.Wrap(err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPut, key, nil, io.NopCloser(body), hex.EncodeToString(hash.Sum(nil)))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+
+	resp, err := c.do(req, key)
+	if err != nil {
+		return err
+	}
+	return Error.Wrap(discard(resp))
+}
+
+// getObject downloads length bytes of the object starting at offset. A negative length
+// downloads the rest of the object.
+func (c *client) getObject(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, key, nil, nil, emptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case length >= 0:
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+	case offset > 0:
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := c.do(req, key)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// headObject returns the metadata of the object.
+func (c *client) headObject(ctx context.Context, key string) (objectInfo, error) {
+	req, err := c.newRequest(ctx, http.MethodHead, key, nil, nil, emptyPayloadHash)
+	if err != nil {
+		return objectInfo{}, err
+	}
+
+	resp, err := c.do(req, key)
+	if err != nil {
+		return objectInfo{}, err
+	}
+	if err := discard(resp); err != nil {
+		return objectInfo{}, Error.Wrap(err)
+	}
+
+	lastModified, err := http.ParseTime(resp.Header.Get("Last-Modified"))
+	if err != nil {
+		return objectInfo{}, Error.New("invalid Last-Modified header: %v", err)
+	}
+	return objectInfo{
+		Key:          key,
+		Size:         resp.ContentLength,
+		LastModified: lastModified,
+	}, nil
+}
+
+// deleteObject deletes the object. Deleting a missing object is not an error.
+func (c *client) deleteObject(ctx context.Context, key string) error {
+	req, err := c.newRequest(ctx, http.MethodDelete, key, nil, nil, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(req, key)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	return Error.Wrap(discard(resp))
+}
+
+// copyObject copies the object to a new key within the bucket.
+func (c *client) copyObject(ctx context.Context, source, target string) error {
+	req, err := c.newRequest(ctx, http.MethodPut, target, nil, nil, emptyPayloadHash, func(header http.Header) {
+		header.Set("X-Amz-Copy-Source", "/"+c.config.Bucket+"/"+uriEncode(source, false))
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(req, source)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// a copy can fail after the response status was sent, in which case the body contains an error.
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	var failure errorResponse
+	if xml.Unmarshal(body, &failure) == nil && failure.Code != "" {
+		return Error.New("copy %q: %s: %s", source, failure.Code, failure.Message)
+	}
+	return nil
+}
+
+// listObjects calls objectFn for each object with the given prefix, in key order, starting
+// after the given key. When delimiter is set, the keys which contain the delimiter after the
+// prefix are grouped, and prefixFn is called for each group instead.
+func (c *client) listObjects(ctx context.Context, prefix, delimiter, startAfter string, objectFn func(objectInfo) error, prefixFn func(string) error) error {
+	continuation := ""
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", prefix)
+		if delimiter != "" {
+			query.Set("delimiter", delimiter)
+		}
+		if continuation != "" {
+			query.Set("continuation-token", continuation)
+		} else if startAfter != "" {
+			query.Set("start-after", startAfter)
+		}
+
+		req, err := c.newRequest(ctx, http.MethodGet, "", query, nil, emptyPayloadHash)
+		if err != nil {
+			return err
+		}
+		resp, err := c.do(req, "")
+		if err != nil {
+			return err
+		}
+
+		var result listBucketResult
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		err = errs.Combine(err, resp.Body.Close())
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		for _, object := range result.Contents {
+			if err := objectFn(objectInfo{
+				Key:          object.Key,
+				Size:         object.Size,
+				LastModified: object.LastModified,
+			}); err != nil {
+				return err
+			}
+		}
+		if prefixFn != nil {
+			for _, commonPrefix := range result.CommonPrefixes {
+				if err := prefixFn(commonPrefix.Prefix); err != nil {
+					return err
+				}
+			}
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return nil
+		}
+		continuation = result.NextContinuationToken
+	}
+}
+
+// newRequest creates a signed request for the object key, or for the bucket when the key is empty.
+func (c *client) newRequest(ctx context.Context, method, key string, query url.Values, body io.ReadCloser, payloadHash string, headers ...func(http.Header)) (*http.Request, error) {
+	target := *c.endpoint
+	path := strings.TrimSuffix(target.Path, "/")
+	if c.config.PathStyle {
+		path += "/" + c.config.Bucket
+	} else {
+		target.Host = c.config.Bucket + "." + target.Host
+	}
+	path += "/" + key
+
+	target.Path = path
+	target.RawPath = uriEncode(path, false)
+	target.RawQuery = canonicalQuery(query)
+
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), body)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	for _, fn := range headers {
+		fn(req.Header)
+	}
+	c.sign(req, payloadHash, time.Now().UTC())
+	return req, nil
+}
+
+// sign adds the AWS signature version 4 authorization to the request.
+func (c *client) sign(req *http.Request, payloadHash string, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		name = strings.ToLower(name)
+		if strings.HasPrefix(name, "x-amz-") {
+			headers[name] = strings.TrimSpace(strings.Join(values, ","))
+		}
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + c.config.Region + "/s3/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+c.config.SecretKey), date)
+	key = hmacSHA256(key, c.config.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+c.config.AccessKey+"/"+scope+
+		", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+// do sends the request. Responses about missing objects are returned as os.ErrNotExist errors.
+func (c *client) do(req *http.Request, key string) (*http.Response, error) {
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound && key != "" {
+		return nil, &os.PathError{Op: strings.ToLower(req.Method), Path: key, Err: os.ErrNotExist}
+	}
+
+	var failure errorResponse
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if xml.Unmarshal(body, &failure) == nil && failure.Code != "" {
+		if failure.Code == "NoSuchKey" {
+			return nil, &os.PathError{Op: strings.ToLower(req.Method), Path: key, Err: os.ErrNotExist}
+		}
+		return nil, Error.New("%s %q: %s: %s", req.Method, key, failure.Code, failure.Message)
+	}
+	return nil, Error.New("%s %q: unexpected status %s", req.Method, key, resp.Status)
+}
+
+// listBucketResult is the response of ListObjectsV2.
+type listBucketResult struct {
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+	Contents              []struct {
+		Key          string    `xml:"Key"`
+		Size         int64     `xml:"Size"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+	CommonPrefixes []struct {
+		Prefix string `xml:"Prefix"`
+	} `xml:"CommonPrefixes"`
+}
+
+// errorResponse is the body of a failed request.
+type errorResponse struct {
+	XMLName xml.Name `xml:"Error"`
+	Code    string   `xml:"Code"`
+	Message string   `xml:"Message"`
+}
+
+// discard reads the rest of the response body and closes it, so that the connection can be reused.
+func discard(resp *http.Response) error {
+	_, err := io.Copy(io.Discard, resp.Body)
+	return errs.Combine(err, resp.Body.Close())
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// canonicalQuery encodes the query parameters sorted by name, as required by the signature.
+func canonicalQuery(query url.Values) string {
+	if len(query) == 0 {
+		return ""
+	}
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var encoded []string
+	for _, name := range names {
+		for _, value := range query[name] {
+			encoded = append(encoded, uriEncode(name, true)+"="+uriEncode(value, true))
+		}
+	}
+	return strings.Join(encoded, "&")
+}
+
+// uriEncode percent-encodes everything except the unreserved characters. Slashes are
+// only encoded when encodeSlash is set.
+func uriEncode(s string, encodeSlash bool) string {
+	var b bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			b.WriteString("%" + strings.ToUpper(strconv.FormatInt(int64(c)|0x100, 16)[1:]))
+		}
+	}
+	return b.String()
+}
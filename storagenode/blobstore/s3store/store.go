@@ -0,0 +1,681 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package s3store implements a blob store on top of an S3-compatible object storage.
+package s3store
+
+import (
+	"bytes"
+	"context"
+	"encoding/base32"
+	"errors"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+)
+
+var (
+	// Error is the default s3store error class.
+	Error = errs.Class("s3store error")
+
+	mon = monkit.Package()
+
+	_ blobstore.Blobs = (*blobStore)(nil)
+)
+
+// pathEncoding is the same encoding as used by the filestore, so that the object keys
+// look like the paths of the blob files.
+var pathEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+const (
+	blobsArea = "blobs/"
+	trashArea = "trash/"
+
+	blobSuffix = ".sj1"
+
+	verificationKey = "storage-dir-verification"
+	writeTestKey    = "write-test"
+)
+
+// Config is the configuration of the object storage backend.
+type Config struct {
+	Endpoint  string `help:"endpoint of the S3-compatible object storage to store the pieces in, instead of the storage directory" default:""`
+	Bucket    string `help:"bucket to store the pieces in" default:""`
+	Region    string `help:"region of the bucket" default:"us-east-1"`
+	AccessKey string `help:"access key of the object storage" default:""`
+	SecretKey string `help:"secret key of the object storage" default:""`
+	Prefix    string `help:"prefix of the object keys in the bucket" default:""`
+	PathStyle bool   `help:"use path-style requests instead of virtual-hosted-style requests" default:"true"`
+
+	CacheDir      string      `help:"directory of the local write-back cache, defaults to a directory in the storage directory" default:""`
+	CacheSize     memory.Size `help:"how much of the recently uploaded pieces are kept in the local cache" default:"10GiB"`
+	UploadWorkers int         `help:"number of concurrent uploads from the local cache to the object storage" default:"8"`
+
+	Capacity memory.Size `help:"free space reported for the object storage, 0 means that only the allocated disk space limits the usage" default:"0B"`
+}
+
+// Enabled returns whether the object storage backend is configured.
+func (config Config) Enabled() bool {
+	return config.Endpoint != ""
+}
+
+// blobStore implements a blob store on top of an S3-compatible object storage, with a local
+// write-back cache for the new blobs.
+//
+// The blobs are stored with the same layout as in the filestore, under the blobs/ and trash/
+// prefixes. Trashing a blob copies it to the trash, which sets the modification time of the
+// trashed object to the time of trashing.
+type blobStore struct {
+	log    *zap.Logger
+	config Config
+	client *client
+	cache  *cache
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// New creates a blob store using the object storage in the config, and starts the uploads
+// of the pending blobs in the cache.
+func New(log *zap.Logger, config Config) (blobstore.Blobs, error) {
+	if config.Prefix != "" && !strings.HasSuffix(config.Prefix, "/") {
+		config.Prefix += "/"
+	}
+	if config.CacheDir == "" {
+		return nil, Error.New("cache directory is not set")
+	}
+	if config.UploadWorkers <= 0 {
+		config.UploadWorkers = 1
+	}
+
+	client, err := newClient(config)
+	if err != nil {
+		return nil, err
+	}
+	cache, err := newCache(log, config.CacheDir, config.CacheSize.Int64(), config.UploadWorkers, client)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	store := &blobStore{
+		log:    log,
+		config: config,
+		client: client,
+		cache:  cache,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go func() {
+		defer close(store.done)
+		_ = cache.run(ctx, config.UploadWorkers)
+	}()
+	return store, nil
+}
+
+// Close stops the uploads. The blobs which weren't uploaded yet are uploaded after the
+// store is opened again.
+func (store *blobStore) Close() error {
+	store.cancel()
+	<-store.done
+	return nil
+}
+
+// objectKey returns the object key of the blob in the given area.
+func (store *blobStore) objectKey(area string, ref blobstore.BlobRef) (string, error) {
+	if !ref.IsValid() {
+		return "", blobstore.ErrInvalidBlobRef.New("")
+	}
+	key := pathEncoding.EncodeToString(ref.Key)
+	if len(key) < 3 {
+		// ensure we always have enough characters to split [:2] and [2:]
+		key = "11" + key
+	}
+	return store.namespacePrefix(area, ref.Namespace) + key[:2] + "/" + key[2:] + blobSuffix, nil
+}
+
+// namespacePrefix returns the common prefix of the object keys of the namespace in the given area.
+func (store *blobStore) namespacePrefix(area string, namespace []byte) string {
+	return store.config.Prefix + area + pathEncoding.EncodeToString(namespace) + "/"
+}
+
+// parseKey returns the blob ref of the object key in the given area.
+func (store *blobStore) parseKey(area, key string) (blobstore.BlobRef, bool) {
+	if !strings.HasPrefix(key, store.config.Prefix+area) || !strings.HasSuffix(key, blobSuffix) {
+		return blobstore.BlobRef{}, false
+	}
+	rest := strings.TrimPrefix(key, store.config.Prefix+area)
+	parts := strings.Split(strings.TrimSuffix(rest, blobSuffix), "/")
+	if len(parts) != 3 {
+		return blobstore.BlobRef{}, false
+	}
+
+	namespace, err := pathEncoding.DecodeString(parts[0])
+	if err != nil {
+		return blobstore.BlobRef{}, false
+	}
+	encodedKey := strings.TrimPrefix(parts[1]+parts[2], "11")
+	blobKey, err := pathEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return blobstore.BlobRef{}, false
+	}
+	return blobstore.BlobRef{Namespace: namespace, Key: blobKey}, true
+}
+
+// Create creates a new blob that can be written. The size is ignored.
+func (store *blobStore) Create(ctx context.Context, ref blobstore.BlobRef, size int64) (_ blobstore.BlobWriter, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	key, err := store.objectKey(blobsArea, ref)
+	if err != nil {
+		return nil, err
+	}
+	file, err := store.cache.createTemp()
+	if err != nil {
+		return nil, err
+	}
+	return &blobWriter{cache: store.cache, key: key, file: file}, nil
+}
+
+// Open opens a reader for the blob. Recently written blobs are read from the cache.
+func (store *blobStore) Open(ctx context.Context, ref blobstore.BlobRef) (_ blobstore.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	key, err := store.objectKey(blobsArea, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	file, _, err := store.cache.open(key)
+	if err == nil {
+		info, err := file.Stat()
+		if err != nil {
+			return nil, Error.Wrap(errs.Combine(err, file.Close()))
+		}
+		return &fileReader{File: file, size: info.Size()}, nil
+	}
+
+	object, err := store.client.headObject(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return &remoteReader{
+		ctx:    ctx,
+		client: store.client,
+		key:    key,
+		size:   object.Size,
+	}, nil
+}
+
+// OpenWithStorageFormat opens a reader for the blob. Only the V1 storage format is supported.
+func (store *blobStore) OpenWithStorageFormat(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (_ blobstore.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if formatVer != filestore.FormatV1 {
+		return nil, &os.PathError{Op: "open", Path: string(ref.Key), Err: os.ErrNotExist}
+	}
+	return store.Open(ctx, ref)
+}
+
+// Stat looks up the metadata of the blob.
+func (store *blobStore) Stat(ctx context.Context, ref blobstore.BlobRef) (_ blobstore.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	key, err := store.objectKey(blobsArea, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	if info, ok := store.cache.stat(key); ok {
+		return store.blobInfo(ref, objectInfo{Key: key, Size: info.Size(), LastModified: info.ModTime()}), nil
+	}
+
+	object, err := store.client.headObject(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return store.blobInfo(ref, object), nil
+}
+
+// StatWithStorageFormat looks up the metadata of the blob. Only the V1 storage format is supported.
+func (store *blobStore) StatWithStorageFormat(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (_ blobstore.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if formatVer != filestore.FormatV1 {
+		return nil, &os.PathError{Op: "stat", Path: string(ref.Key), Err: os.ErrNotExist}
+	}
+	return store.Stat(ctx, ref)
+}
+
+func (store *blobStore) blobInfo(ref blobstore.BlobRef, object objectInfo) *blobInfo {
+	return &blobInfo{ref: ref, bucket: store.config.Bucket, object: object}
+}
+
+// Delete deletes the blob.
+func (store *blobStore) Delete(ctx context.Context, ref blobstore.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	key, err := store.objectKey(blobsArea, ref)
+	if err != nil {
+		return err
+	}
+	return store.deleteObject(ctx, key)
+}
+
+// DeleteWithStorageFormat deletes the blob. Only the V1 storage format is supported.
+func (store *blobStore) DeleteWithStorageFormat(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if formatVer != filestore.FormatV1 {
+		return nil
+	}
+	return store.Delete(ctx, ref)
+}
+
+// deleteObject deletes the blob from the cache and from the bucket.
+func (store *blobStore) deleteObject(ctx context.Context, key string) error {
+	return errs.Combine(store.cache.remove(key), store.client.deleteObject(ctx, key))
+}
+
+// DeleteNamespace deletes all the blobs of the namespace.
+func (store *blobStore) DeleteNamespace(ctx context.Context, ref []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var keys []string
+	err = store.walkObjects(ctx, store.namespacePrefix(blobsArea, ref), func(object objectInfo) error {
+		keys = append(keys, object.Key)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := store.deleteObject(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Trash moves the blob to the trash.
+func (store *blobStore) Trash(ctx context.Context, ref blobstore.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	key, err := store.objectKey(blobsArea, ref)
+	if err != nil {
+		return err
+	}
+	trashKey, err := store.objectKey(trashArea, ref)
+	if err != nil {
+		return err
+	}
+
+	file, pending, err := store.cache.open(key)
+	switch {
+	case err == nil && pending:
+		// the blob may not be uploaded yet, so it's uploaded directly to the trash.
+		err = store.putFile(ctx, trashKey, file)
+	case err == nil:
+		err = errs.Combine(file.Close(), store.client.copyObject(ctx, key, trashKey))
+	case errors.Is(err, os.ErrNotExist):
+		err = store.client.copyObject(ctx, key, trashKey)
+	}
+	if err != nil {
+		return err
+	}
+
+	return store.deleteObject(ctx, key)
+}
+
+// putFile uploads the file to the object, and closes it.
+func (store *blobStore) putFile(ctx context.Context, key string, file *os.File) (err error) {
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	info, err := file.Stat()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return store.client.putObject(ctx, key, file, info.Size())
+}
+
+// RestoreTrash moves the trashed blobs of the namespace back, and returns their keys.
+func (store *blobStore) RestoreTrash(ctx context.Context, namespace []byte) (keysRestored [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var trashed []objectInfo
+	err = store.client.listObjects(ctx, store.namespacePrefix(trashArea, namespace), "", "", func(object objectInfo) error {
+		trashed = append(trashed, object)
+		return nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, object := range trashed {
+		ref, ok := store.parseKey(trashArea, object.Key)
+		if !ok {
+			continue
+		}
+		if _, err := store.restoreObject(ctx, object.Key, ref); err != nil {
+			return keysRestored, err
+		}
+		keysRestored = append(keysRestored, ref.Key)
+	}
+	return keysRestored, nil
+}
+
+// RestoreTrashKeys moves the trashed blobs of the namespace with the given keys back, and
+// returns the keys which were in the trash.
+func (store *blobStore) RestoreTrashKeys(ctx context.Context, namespace []byte, keys [][]byte) (keysRestored [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, key := range keys {
+		ref := blobstore.BlobRef{Namespace: namespace, Key: key}
+		trashKey, err := store.objectKey(trashArea, ref)
+		if err != nil {
+			return keysRestored, err
+		}
+		restored, err := store.restoreObject(ctx, trashKey, ref)
+		if err != nil {
+			return keysRestored, err
+		}
+		if restored {
+			keysRestored = append(keysRestored, key)
+		}
+	}
+	return keysRestored, nil
+}
+
+// restoreObject moves the trashed object back to the blob. It returns false when the object
+// isn't in the trash.
+func (store *blobStore) restoreObject(ctx context.Context, trashKey string, ref blobstore.BlobRef) (bool, error) {
+	key, err := store.objectKey(blobsArea, ref)
+	if err != nil {
+		return false, err
+	}
+	if err := store.client.copyObject(ctx, trashKey, key); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, store.client.deleteObject(ctx, trashKey)
+}
+
+// WalkTrash executes walkFunc for each trashed blob of the namespace. The modification time of
+// the trashed blobs is the time they were trashed.
+func (store *blobStore) WalkTrash(ctx context.Context, namespace []byte, walkFunc func(blobstore.BlobInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return store.client.listObjects(ctx, store.namespacePrefix(trashArea, namespace), "", "", func(object objectInfo) error {
+		ref, ok := store.parseKey(trashArea, object.Key)
+		if !ok {
+			return nil
+		}
+		return walkFunc(store.blobInfo(ref, object))
+	}, nil)
+}
+
+// EmptyTrash deletes the blobs of the namespace which were trashed before trashedBefore, and
+// returns their total size and their keys.
+func (store *blobStore) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (bytesEmptied int64, keys [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.emptyTrash(ctx, namespace, trashedBefore, nil)
+}
+
+// EmptyTrashWithProgress is like EmptyTrash, but it reports every deleted blob to deleted.
+func (store *blobStore) EmptyTrashWithProgress(ctx context.Context, namespace []byte, trashedBefore time.Time, deleted func(blobs, bytes int64)) (bytesEmptied int64, keys [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.emptyTrash(ctx, namespace, trashedBefore, deleted)
+}
+
+// emptyTrash empties the trash like EmptyTrash. deleted, when not nil, is called after
+// every deleted blob.
+func (store *blobStore) emptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time, deleted func(blobs, bytes int64)) (bytesEmptied int64, keys [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var expired []objectInfo
+	err = store.client.listObjects(ctx, store.namespacePrefix(trashArea, namespace), "", "", func(object objectInfo) error {
+		if object.LastModified.Before(trashedBefore) {
+			expired = append(expired, object)
+		}
+		return nil
+	}, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	for _, object := range expired {
+		if err := store.client.deleteObject(ctx, object.Key); err != nil {
+			return bytesEmptied, keys, err
+		}
+		bytesEmptied += object.Size
+		if ref, ok := store.parseKey(trashArea, object.Key); ok {
+			keys = append(keys, ref.Key)
+		}
+		if deleted != nil {
+			deleted(1, object.Size)
+		}
+	}
+	return bytesEmptied, keys, nil
+}
+
+// FreeSpace returns the configured capacity of the object storage.
+func (store *blobStore) FreeSpace(ctx context.Context) (int64, error) {
+	if store.config.Capacity <= 0 {
+		return math.MaxInt64, nil
+	}
+	return store.config.Capacity.Int64(), nil
+}
+
+// SpaceUsedForTrash returns the total size of the trashed blobs.
+func (store *blobStore) SpaceUsedForTrash(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = store.client.listObjects(ctx, store.config.Prefix+trashArea, "", "", func(object objectInfo) error {
+		total += object.Size
+		return nil
+	}, nil)
+	return total, err
+}
+
+// SpaceUsedForBlobs returns the total size of the blobs in all namespaces.
+func (store *blobStore) SpaceUsedForBlobs(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = store.walkObjects(ctx, store.config.Prefix+blobsArea, func(object objectInfo) error {
+		total += object.Size
+		return nil
+	})
+	return total, err
+}
+
+// SpaceUsedForBlobsInNamespace returns the total size of the blobs in the namespace.
+func (store *blobStore) SpaceUsedForBlobsInNamespace(ctx context.Context, namespace []byte) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = store.walkObjects(ctx, store.namespacePrefix(blobsArea, namespace), func(object objectInfo) error {
+		total += object.Size
+		return nil
+	})
+	return total, err
+}
+
+// ListNamespaces returns the namespaces which have blobs.
+func (store *blobStore) ListNamespaces(ctx context.Context) (ids [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	prefix := store.config.Prefix + blobsArea
+	seen := map[string]struct{}{}
+	addNamespace := func(encoded string) {
+		if _, ok := seen[encoded]; ok {
+			return
+		}
+		seen[encoded] = struct{}{}
+		if namespace, err := pathEncoding.DecodeString(encoded); err == nil {
+			ids = append(ids, namespace)
+		}
+	}
+
+	// the pending blobs are listed first, as they may be uploaded during the listing.
+	for _, object := range store.cache.pendingObjects(prefix) {
+		addNamespace(strings.SplitN(strings.TrimPrefix(object.Key, prefix), "/", 2)[0])
+	}
+	err = store.client.listObjects(ctx, prefix, "/", "", func(objectInfo) error { return nil }, func(commonPrefix string) error {
+		addNamespace(strings.TrimSuffix(strings.TrimPrefix(commonPrefix, prefix), "/"))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// WalkNamespace executes walkFunc for each blob in the namespace.
+func (store *blobStore) WalkNamespace(ctx context.Context, namespace []byte, walkFunc func(blobstore.BlobInfo) error) (err error) {
+	return store.WalkNamespaceFrom(ctx, namespace, "", walkFunc, nil)
+}
+
+// WalkNamespaceFrom is like WalkNamespace, but it walks the key prefixes in order, skipping the
+// ones up to and including startAfter, and calls prefixDone after all the blobs with a key prefix
+// have been walked.
+func (store *blobStore) WalkNamespaceFrom(ctx context.Context, namespace []byte, startAfter string, walkFunc func(blobstore.BlobInfo) error, prefixDone func(keyPrefix string) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyPrefixes, err := store.ListKeyPrefixes(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	for _, keyPrefix := range keyPrefixes {
+		if keyPrefix <= startAfter {
+			continue
+		}
+		if err := store.WalkNamespacePrefix(ctx, namespace, keyPrefix, walkFunc); err != nil {
+			return err
+		}
+		if prefixDone != nil {
+			if err := prefixDone(keyPrefix); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ListKeyPrefixes returns the sorted key prefixes in the namespace.
+func (store *blobStore) ListKeyPrefixes(ctx context.Context, namespace []byte) (_ []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	prefix := store.namespacePrefix(blobsArea, namespace)
+	seen := map[string]struct{}{}
+
+	// the pending blobs are listed first, as they may be uploaded during the listing.
+	for _, object := range store.cache.pendingObjects(prefix) {
+		seen[strings.SplitN(strings.TrimPrefix(object.Key, prefix), "/", 2)[0]] = struct{}{}
+	}
+	err = store.client.listObjects(ctx, prefix, "/", "", func(objectInfo) error { return nil }, func(commonPrefix string) error {
+		seen[strings.TrimSuffix(strings.TrimPrefix(commonPrefix, prefix), "/")] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keyPrefixes := make([]string, 0, len(seen))
+	for keyPrefix := range seen {
+		keyPrefixes = append(keyPrefixes, keyPrefix)
+	}
+	sort.Strings(keyPrefixes)
+	return keyPrefixes, nil
+}
+
+// WalkNamespacePrefix executes walkFunc for each blob with the given key prefix in the namespace.
+func (store *blobStore) WalkNamespacePrefix(ctx context.Context, namespace []byte, keyPrefix string, walkFunc func(blobstore.BlobInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return store.walkObjects(ctx, store.namespacePrefix(blobsArea, namespace)+keyPrefix+"/", func(object objectInfo) error {
+		ref, ok := store.parseKey(blobsArea, object.Key)
+		if !ok {
+			return nil
+		}
+		return walkFunc(store.blobInfo(ref, object))
+	})
+}
+
+// walkObjects calls fn for each blob with the key prefix, including the blobs which weren't
+// uploaded yet.
+func (store *blobStore) walkObjects(ctx context.Context, prefix string, fn func(objectInfo) error) error {
+	pending := store.cache.pendingObjects(prefix)
+	pendingKeys := make(map[string]struct{}, len(pending))
+	for _, object := range pending {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		pendingKeys[object.Key] = struct{}{}
+		if err := fn(object); err != nil {
+			return err
+		}
+	}
+
+	return store.client.listObjects(ctx, prefix, "", "", func(object objectInfo) error {
+		if _, ok := pendingKeys[object.Key]; ok {
+			return nil
+		}
+		return fn(object)
+	}, nil)
+}
+
+// CheckWritability tests writability of the bucket by creating and deleting an object.
+func (store *blobStore) CheckWritability(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	key := store.config.Prefix + writeTestKey
+	if err := store.client.putObject(ctx, key, bytes.NewReader(nil), 0); err != nil {
+		return err
+	}
+	return store.client.deleteObject(ctx, key)
+}
+
+// CreateVerificationFile creates an object to be used for storage directory verification.
+func (store *blobStore) CreateVerificationFile(ctx context.Context, id storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return store.client.putObject(ctx, store.config.Prefix+verificationKey, bytes.NewReader(id.Bytes()), int64(len(id.Bytes())))
+}
+
+// VerifyStorageDir verifies that the bucket belongs to the node by checking for the existence
+// and validity of the verification object.
+func (store *blobStore) VerifyStorageDir(ctx context.Context, id storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	body, err := store.client.getObject(ctx, store.config.Prefix+verificationKey, 0, -1)
+	if err != nil {
+		return err
+	}
+	content, err := io.ReadAll(body)
+	if err := errs.Combine(err, body.Close()); err != nil {
+		return Error.Wrap(err)
+	}
+
+	if !bytes.Equal(content, id.Bytes()) {
+		verifyID, err := storj.NodeIDFromBytes(content)
+		if err != nil {
+			return errs.New("content of file is not a valid node ID: %x", content)
+		}
+		return errs.New("node ID in file (%s) does not match running node's ID (%s)", verifyID, id.String())
+	}
+	return nil
+}
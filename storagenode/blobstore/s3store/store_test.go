@@ -0,0 +1,361 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package s3store_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/s3store"
+)
+
+func TestStore(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	server := newFakeS3("bucket")
+	defer server.Close()
+
+	config := s3store.Config{
+		Endpoint:      server.URL,
+		Bucket:        "bucket",
+		Region:        "us-east-1",
+		AccessKey:     "access",
+		SecretKey:     "secret",
+		Prefix:        "node",
+		PathStyle:     true,
+		CacheDir:      ctx.Dir("cache"),
+		CacheSize:     0,
+		UploadWorkers: 2,
+	}
+	store, err := s3store.New(zaptest.NewLogger(t), config)
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	namespace := testrand.Bytes(32)
+	data := testrand.Bytes(10 * memory.KiB)
+	refs := make([]blobstore.BlobRef, 5)
+	for i := range refs {
+		refs[i] = blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+
+		writer, err := store.Create(ctx, refs[i], -1)
+		require.NoError(t, err)
+		_, err = writer.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+	}
+
+	// the blobs are readable and listed before and after they're uploaded.
+	requireBlobs := func() {
+		for _, ref := range refs {
+			reader, err := store.Open(ctx, ref)
+			require.NoError(t, err)
+			read, err := io.ReadAll(reader)
+			require.NoError(t, err)
+			require.Equal(t, data, read)
+
+			buf := make([]byte, 100)
+			n, err := reader.ReadAt(buf, 1000)
+			require.NoError(t, err)
+			require.Equal(t, data[1000:1000+n], buf)
+
+			_, err = reader.Seek(2000, io.SeekStart)
+			require.NoError(t, err)
+			n, err = reader.Read(buf)
+			require.NoError(t, err)
+			require.Equal(t, data[2000:2000+n], buf[:n])
+			require.NoError(t, reader.Close())
+
+			info, err := store.Stat(ctx, ref)
+			require.NoError(t, err)
+			stat, err := info.Stat(ctx)
+			require.NoError(t, err)
+			require.EqualValues(t, len(data), stat.Size())
+		}
+
+		var walked int
+		require.NoError(t, store.WalkNamespace(ctx, namespace, func(info blobstore.BlobInfo) error {
+			walked++
+			return nil
+		}))
+		require.Equal(t, len(refs), walked)
+
+		used, err := store.SpaceUsedForBlobsInNamespace(ctx, namespace)
+		require.NoError(t, err)
+		require.EqualValues(t, len(refs)*len(data), used)
+
+		namespaces, err := store.ListNamespaces(ctx)
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{namespace}, namespaces)
+	}
+	requireBlobs()
+
+	require.Eventually(t, func() bool {
+		return server.count("node/blobs/") == len(refs)
+	}, 10*time.Second, 10*time.Millisecond)
+	requireBlobs()
+
+	// trashed blobs can be restored.
+	require.NoError(t, store.Trash(ctx, refs[0]))
+	_, err = store.Open(ctx, refs[0])
+	require.Error(t, err)
+	trashUsed, err := store.SpaceUsedForTrash(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, len(data), trashUsed)
+
+	restored, err := store.RestoreTrash(ctx, namespace)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{refs[0].Key}, restored)
+	_, err = store.Open(ctx, refs[0])
+	require.NoError(t, err)
+
+	// emptying the trash only deletes the blobs trashed before the given time.
+	require.NoError(t, store.Trash(ctx, refs[1]))
+	bytesEmptied, keys, err := store.EmptyTrash(ctx, namespace, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Zero(t, bytesEmptied)
+	require.Empty(t, keys)
+
+	bytesEmptied, keys, err = store.EmptyTrash(ctx, namespace, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.EqualValues(t, len(data), bytesEmptied)
+	require.Equal(t, [][]byte{refs[1].Key}, keys)
+
+	require.NoError(t, store.Delete(ctx, refs[2]))
+	_, err = store.Stat(ctx, refs[2])
+	require.Error(t, err)
+
+	require.NoError(t, store.DeleteNamespace(ctx, namespace))
+	require.Zero(t, server.count("node/blobs/"))
+
+	nodeID := testrand.NodeID()
+	require.NoError(t, store.CheckWritability(ctx))
+	require.NoError(t, store.CreateVerificationFile(ctx, nodeID))
+	require.NoError(t, store.VerifyStorageDir(ctx, nodeID))
+	require.Error(t, store.VerifyStorageDir(ctx, testrand.NodeID()))
+}
+
+func TestStorePendingUploads(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	server := newFakeS3("bucket")
+	defer server.Close()
+
+	config := s3store.Config{
+		Endpoint:      server.URL,
+		Bucket:        "bucket",
+		Region:        "us-east-1",
+		PathStyle:     true,
+		CacheDir:      ctx.Dir("cache"),
+		CacheSize:     memory.MiB,
+		UploadWorkers: 1,
+	}
+
+	server.setFailUploads(true)
+	store, err := s3store.New(zaptest.NewLogger(t), config)
+	require.NoError(t, err)
+
+	ref := blobstore.BlobRef{Namespace: testrand.Bytes(32), Key: testrand.Bytes(32)}
+	writer, err := store.Create(ctx, ref, -1)
+	require.NoError(t, err)
+	_, err = writer.Write(testrand.Bytes(memory.KiB))
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit(ctx))
+	require.NoError(t, store.Close())
+	require.Zero(t, server.count("blobs/"))
+
+	// the blob is uploaded after the store is opened again.
+	server.setFailUploads(false)
+	store, err = s3store.New(zaptest.NewLogger(t), config)
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	require.Eventually(t, func() bool {
+		return server.count("blobs/") == 1
+	}, 10*time.Second, 10*time.Millisecond)
+
+	_, err = store.Stat(ctx, ref)
+	require.NoError(t, err)
+}
+
+// fakeS3 implements the parts of the S3 API used by the store.
+type fakeS3 struct {
+	*httptest.Server
+	bucket string
+
+	mu          sync.Mutex
+	objects     map[string]fakeObject
+	failUploads bool
+}
+
+type fakeObject struct {
+	data     []byte
+	modified time.Time
+}
+
+// maxKeys is the page size of the listings, small enough to test the pagination.
+const maxKeys = 2
+
+func newFakeS3(bucket string) *fakeS3 {
+	fake := &fakeS3{bucket: bucket, objects: map[string]fakeObject{}}
+	fake.Server = httptest.NewServer(http.HandlerFunc(fake.serve))
+	return fake
+}
+
+func (fake *fakeS3) setFailUploads(fail bool) {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	fake.failUploads = fail
+}
+
+func (fake *fakeS3) count(prefix string) (count int) {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	for key := range fake.objects {
+		if strings.HasPrefix(key, prefix) {
+			count++
+		}
+	}
+	return count
+}
+
+func (fake *fakeS3) serve(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 ") {
+		http.Error(w, "missing signature", http.StatusForbidden)
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, "/"+fake.bucket+"/")
+
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodGet && key == "":
+		fake.list(w, r)
+	case r.Method == http.MethodPut && r.Header.Get("X-Amz-Copy-Source") != "":
+		source := strings.TrimPrefix(r.Header.Get("X-Amz-Copy-Source"), "/"+fake.bucket+"/")
+		object, ok := fake.objects[source]
+		if !ok {
+			http.Error(w, "<Error><Code>NoSuchKey</Code></Error>", http.StatusNotFound)
+			return
+		}
+		fake.objects[key] = fakeObject{data: object.data, modified: time.Now()}
+		_, _ = fmt.Fprint(w, "<CopyObjectResult></CopyObjectResult>")
+	case r.Method == http.MethodPut:
+		if fake.failUploads {
+			http.Error(w, "<Error><Code>SlowDown</Code></Error>", http.StatusServiceUnavailable)
+			return
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		fake.objects[key] = fakeObject{data: data, modified: time.Now()}
+	case r.Method == http.MethodDelete:
+		delete(fake.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		object, ok := fake.objects[key]
+		if !ok {
+			http.Error(w, "<Error><Code>NoSuchKey</Code></Error>", http.StatusNotFound)
+			return
+		}
+		data := object.data
+		if rng := r.Header.Get("Range"); rng != "" {
+			var start, end int
+			bounds := strings.Split(strings.TrimPrefix(rng, "bytes="), "-")
+			start, _ = strconv.Atoi(bounds[0])
+			end = len(data) - 1
+			if bounds[1] != "" {
+				end, _ = strconv.Atoi(bounds[1])
+			}
+			data = data[start : end+1]
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.Header().Set("Last-Modified", object.modified.UTC().Format(http.TimeFormat))
+		_, _ = w.Write(data)
+	default:
+		http.Error(w, "unsupported", http.StatusMethodNotAllowed)
+	}
+}
+
+func (fake *fakeS3) list(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	prefix, delimiter := query.Get("prefix"), query.Get("delimiter")
+	after := query.Get("start-after")
+	if token := query.Get("continuation-token"); token != "" {
+		after = token
+	}
+
+	var keys []string
+	for key := range fake.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	type content struct {
+		Key          string
+		Size         int
+		LastModified time.Time
+	}
+	type commonPrefix struct {
+		Prefix string
+	}
+	var result struct {
+		XMLName               xml.Name `xml:"ListBucketResult"`
+		IsTruncated           bool
+		NextContinuationToken string
+		Contents              []content
+		CommonPrefixes        []commonPrefix
+	}
+
+	for _, key := range keys {
+		if key <= after {
+			continue
+		}
+		if len(result.Contents)+len(result.CommonPrefixes) == maxKeys {
+			result.IsTruncated = true
+			break
+		}
+		if delimiter != "" {
+			if i := strings.Index(key[len(prefix):], delimiter); i >= 0 {
+				group := key[:len(prefix)+i+len(delimiter)]
+				result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix{Prefix: group})
+				// skip the rest of the group.
+				after = group + "\xff"
+				result.NextContinuationToken = after
+				continue
+			}
+		}
+		result.Contents = append(result.Contents, content{
+			Key:          key,
+			Size:         len(fake.objects[key].data),
+			LastModified: fake.objects[key].modified,
+		})
+		after = key
+		result.NextContinuationToken = after
+	}
+
+	_ = xml.NewEncoder(w).Encode(result)
+}
@@ -152,6 +152,22 @@ func (bad *BadBlobs) RestoreTrash(ctx context.Context, namespace []byte) ([][]by
 	return bad.blobs.RestoreTrash(ctx, namespace)
 }
 
+// RestoreTrashKeys restores the files with the given keys in the trash.
+func (bad *BadBlobs) RestoreTrashKeys(ctx context.Context, namespace []byte, keys [][]byte) ([][]byte, error) {
+	if err := bad.err.Err(); err != nil {
+		return nil, err
+	}
+	return bad.blobs.RestoreTrashKeys(ctx, namespace, keys)
+}
+
+// WalkTrash walks the files in the trash.
+func (bad *BadBlobs) WalkTrash(ctx context.Context, namespace []byte, walkFunc func(blobstore.BlobInfo) error) error {
+	if err := bad.err.Err(); err != nil {
+		return err
+	}
+	return bad.blobs.WalkTrash(ctx, namespace, walkFunc)
+}
+
 // EmptyTrash empties the trash.
 func (bad *BadBlobs) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (int64, [][]byte, error) {
 	if err := bad.err.Err(); err != nil {
@@ -212,6 +228,33 @@ func (bad *BadBlobs) WalkNamespace(ctx context.Context, namespace []byte, walkFu
 	return bad.blobs.WalkNamespace(ctx, namespace, walkFunc)
 }
 
+// WalkNamespaceFrom is like WalkNamespace, but it walks the key prefixes in order, skipping the
+// ones up to and including startAfter, and calls prefixDone after all the blobs with a key prefix
+// have been walked.
+func (bad *BadBlobs) WalkNamespaceFrom(ctx context.Context, namespace []byte, startAfter string, walkFunc func(blobstore.BlobInfo) error, prefixDone func(keyPrefix string) error) error {
+	if err := bad.err.Err(); err != nil {
+		return err
+	}
+	return bad.blobs.WalkNamespaceFrom(ctx, namespace, startAfter, walkFunc, prefixDone)
+}
+
+// ListKeyPrefixes returns the sorted key prefixes in the given namespace.
+func (bad *BadBlobs) ListKeyPrefixes(ctx context.Context, namespace []byte) ([]string, error) {
+	if err := bad.err.Err(); err != nil {
+		return nil, err
+	}
+	return bad.blobs.ListKeyPrefixes(ctx, namespace)
+}
+
+// WalkNamespacePrefix executes walkFunc for each locally stored blob with the given key prefix in
+// the given namespace.
+func (bad *BadBlobs) WalkNamespacePrefix(ctx context.Context, namespace []byte, keyPrefix string, walkFunc func(blobstore.BlobInfo) error) error {
+	if err := bad.err.Err(); err != nil {
+		return err
+	}
+	return bad.blobs.WalkNamespacePrefix(ctx, namespace, keyPrefix, walkFunc)
+}
+
 // ListNamespaces returns all namespaces that might be storing data.
 func (bad *BadBlobs) ListNamespaces(ctx context.Context) ([][]byte, error) {
 	if err := bad.err.Err(); err != nil {
@@ -0,0 +1,137 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testblobs
+
+import (
+	"context"
+	"io"
+	"sync/atomic"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/pieces"
+)
+
+// ensures that CorruptDB implements storagenode.DB.
+var _ storagenode.DB = (*CorruptDB)(nil)
+
+// CorruptDB implements storage node DB, which corrupts the read pieces.
+type CorruptDB struct {
+	storagenode.DB
+	blobs *CorruptBlobs
+	log   *zap.Logger
+}
+
+// NewCorruptDB creates a new storage node DB, which corrupts the read pieces.
+// Use SetCorrupt to enable the corruption.
+func NewCorruptDB(log *zap.Logger, db storagenode.DB) *CorruptDB {
+	return &CorruptDB{
+		DB:    db,
+		blobs: newCorruptBlobs(log, db.Pieces()),
+		log:   log,
+	}
+}
+
+// Pieces returns the blob store.
+func (corrupt *CorruptDB) Pieces() blobstore.Blobs {
+	return corrupt.blobs
+}
+
+// SetCorrupt sets whether the content of the read pieces is corrupted.
+func (corrupt *CorruptDB) SetCorrupt(enabled bool) {
+	corrupt.blobs.SetCorrupt(enabled)
+}
+
+// CorruptBlobs implements a blob store, which corrupts the read blobs. The piece
+// header is kept intact, so the pieces are served, but fail the hash verification.
+type CorruptBlobs struct {
+	blobstore.Blobs
+	log     *zap.Logger
+	enabled int32
+}
+
+// newCorruptBlobs creates a new corrupting blob store wrapping the provided blobs.
+func newCorruptBlobs(log *zap.Logger, blobs blobstore.Blobs) *CorruptBlobs {
+	return &CorruptBlobs{
+		Blobs: blobs,
+		log:   log,
+	}
+}
+
+// SetCorrupt sets whether the content of the read blobs is corrupted.
+func (corrupt *CorruptBlobs) SetCorrupt(enabled bool) {
+	var value int32
+	if enabled {
+		value = 1
+	}
+	atomic.StoreInt32(&corrupt.enabled, value)
+}
+
+// Open opens a reader with the specified namespace and key.
+func (corrupt *CorruptBlobs) Open(ctx context.Context, ref blobstore.BlobRef) (blobstore.BlobReader, error) {
+	reader, err := corrupt.Blobs.Open(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	return corrupt.wrap(reader), nil
+}
+
+// OpenWithStorageFormat opens a reader for the already-located blob, avoiding the potential
+// need to check multiple storage formats to find the blob.
+func (corrupt *CorruptBlobs) OpenWithStorageFormat(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (blobstore.BlobReader, error) {
+	reader, err := corrupt.Blobs.OpenWithStorageFormat(ctx, ref, formatVer)
+	if err != nil {
+		return nil, err
+	}
+	return corrupt.wrap(reader), nil
+}
+
+// wrap returns a corrupting reader, when the corruption is enabled.
+func (corrupt *CorruptBlobs) wrap(reader blobstore.BlobReader) blobstore.BlobReader {
+	if atomic.LoadInt32(&corrupt.enabled) == 0 {
+		return reader
+	}
+
+	var headerSize int64
+	if reader.StorageFormatVersion() >= filestore.FormatV1 {
+		headerSize = pieces.V1PieceHeaderReservedArea
+	}
+	return &corruptReader{BlobReader: reader, headerSize: headerSize}
+}
+
+// corruptReader flips the bits of the content of the blob.
+type corruptReader struct {
+	blobstore.BlobReader
+	headerSize int64
+}
+
+// Read reads the corrupted content.
+func (reader *corruptReader) Read(p []byte) (int, error) {
+	offset, err := reader.BlobReader.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+	n, err := reader.BlobReader.Read(p)
+	reader.corrupt(p[:n], offset)
+	return n, err
+}
+
+// ReadAt reads the corrupted content at the offset.
+func (reader *corruptReader) ReadAt(p []byte, offset int64) (int, error) {
+	n, err := reader.BlobReader.ReadAt(p, offset)
+	reader.corrupt(p[:n], offset)
+	return n, err
+}
+
+// corrupt flips the bits of data read at the offset, except of the header.
+func (reader *corruptReader) corrupt(data []byte, offset int64) {
+	for i := range data {
+		if offset+int64(i) >= reader.headerSize {
+			data[i] ^= 0xFF
+		}
+	}
+}
@@ -107,6 +107,22 @@ func (slow *SlowBlobs) RestoreTrash(ctx context.Context, namespace []byte) ([][]
 	return slow.blobs.RestoreTrash(ctx, namespace)
 }
 
+// RestoreTrashKeys restores the files with the given keys in the trash.
+func (slow *SlowBlobs) RestoreTrashKeys(ctx context.Context, namespace []byte, keys [][]byte) ([][]byte, error) {
+	if err := slow.sleep(ctx); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return slow.blobs.RestoreTrashKeys(ctx, namespace, keys)
+}
+
+// WalkTrash walks the files in the trash.
+func (slow *SlowBlobs) WalkTrash(ctx context.Context, namespace []byte, walkFunc func(blobstore.BlobInfo) error) error {
+	if err := slow.sleep(ctx); err != nil {
+		return errs.Wrap(err)
+	}
+	return slow.blobs.WalkTrash(ctx, namespace, walkFunc)
+}
+
 // EmptyTrash empties the trash.
 func (slow *SlowBlobs) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (int64, [][]byte, error) {
 	if err := slow.sleep(ctx); err != nil {
@@ -167,6 +183,33 @@ func (slow *SlowBlobs) WalkNamespace(ctx context.Context, namespace []byte, walk
 	return slow.blobs.WalkNamespace(ctx, namespace, walkFunc)
 }
 
+// WalkNamespaceFrom is like WalkNamespace, but it walks the key prefixes in order, skipping the
+// ones up to and including startAfter, and calls prefixDone after all the blobs with a key prefix
+// have been walked.
+func (slow *SlowBlobs) WalkNamespaceFrom(ctx context.Context, namespace []byte, startAfter string, walkFunc func(blobstore.BlobInfo) error, prefixDone func(keyPrefix string) error) error {
+	if err := slow.sleep(ctx); err != nil {
+		return errs.Wrap(err)
+	}
+	return slow.blobs.WalkNamespaceFrom(ctx, namespace, startAfter, walkFunc, prefixDone)
+}
+
+// ListKeyPrefixes returns the sorted key prefixes in the given namespace.
+func (slow *SlowBlobs) ListKeyPrefixes(ctx context.Context, namespace []byte) ([]string, error) {
+	if err := slow.sleep(ctx); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return slow.blobs.ListKeyPrefixes(ctx, namespace)
+}
+
+// WalkNamespacePrefix executes walkFunc for each locally stored blob with the given key prefix in
+// the given namespace.
+func (slow *SlowBlobs) WalkNamespacePrefix(ctx context.Context, namespace []byte, keyPrefix string, walkFunc func(blobstore.BlobInfo) error) error {
+	if err := slow.sleep(ctx); err != nil {
+		return errs.Wrap(err)
+	}
+	return slow.blobs.WalkNamespacePrefix(ctx, namespace, keyPrefix, walkFunc)
+}
+
 // ListNamespaces returns all namespaces that might be storing data.
 func (slow *SlowBlobs) ListNamespaces(ctx context.Context) ([][]byte, error) {
 	return slow.blobs.ListNamespaces(ctx)
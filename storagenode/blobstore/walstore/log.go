@@ -0,0 +1,305 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package walstore
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/storagenode/blobstore"
+)
+
+const (
+	segmentSuffix = ".wal"
+
+	// recordMagic starts every record in a log segment.
+	recordMagic = "SJWL"
+	// recordHeaderSize is the size of the record header: magic, body length and the checksum
+	// of the body.
+	recordHeaderSize = 4 + 4 + 4
+	// bodyHeaderSize is the size of the fixed part of the body: kind, namespace length, key
+	// length and mtime. The namespace, the key and the blob data follow it.
+	bodyHeaderSize = 1 + 2 + 2 + 8
+	// maxBodySize limits the records read during the recovery, so that a corrupted length
+	// doesn't make it allocate too much memory.
+	maxBodySize = 256 << 20
+)
+
+// recordKind is the kind of a record in the log.
+type recordKind byte
+
+const (
+	// kindPut records a new blob.
+	kindPut recordKind = 1
+	// kindDelete records that the blob was deleted or trashed.
+	kindDelete recordKind = 2
+	// kindDeleteNamespace records that the blobs of the namespace were deleted.
+	kindDeleteNamespace recordKind = 3
+)
+
+// castagnoli is the checksum table of the records.
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// record is a decoded record of the log.
+type record struct {
+	kind    recordKind
+	ref     blobstore.BlobRef
+	modTime time.Time
+	data    []byte
+}
+
+// encodeRecord returns the record as it's appended to the log.
+func encodeRecord(kind recordKind, ref blobstore.BlobRef, modTime time.Time, data []byte) []byte {
+	bodySize := bodyHeaderSize + len(ref.Namespace) + len(ref.Key) + len(data)
+	buf := make([]byte, recordHeaderSize+bodySize)
+	copy(buf, recordMagic)
+	binary.BigEndian.PutUint32(buf[4:], uint32(bodySize))
+
+	body := buf[recordHeaderSize:]
+	body[0] = byte(kind)
+	binary.BigEndian.PutUint16(body[1:], uint16(len(ref.Namespace)))
+	binary.BigEndian.PutUint16(body[3:], uint16(len(ref.Key)))
+	binary.BigEndian.PutUint64(body[5:], uint64(modTime.UnixNano()))
+	n := bodyHeaderSize
+	n += copy(body[n:], ref.Namespace)
+	n += copy(body[n:], ref.Key)
+	copy(body[n:], data)
+
+	binary.BigEndian.PutUint32(buf[8:], crc32.Checksum(body, castagnoli))
+	return buf
+}
+
+// dataOffset returns the offset of the blob data from the start of the record.
+func dataOffset(ref blobstore.BlobRef) int64 {
+	return int64(recordHeaderSize + bodyHeaderSize + len(ref.Namespace) + len(ref.Key))
+}
+
+// readRecord reads and verifies the record at the current position of the reader. It returns
+// io.EOF at the end of the log and io.ErrUnexpectedEOF, when the last record is incomplete.
+func readRecord(r io.Reader) (_ record, size int64, err error) {
+	var header [recordHeaderSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return record{}, 0, err
+	}
+	if string(header[:4]) != recordMagic {
+		return record{}, 0, Error.New("invalid record magic")
+	}
+	bodySize := binary.BigEndian.Uint32(header[4:])
+	if bodySize < bodyHeaderSize || bodySize > maxBodySize {
+		return record{}, 0, Error.New("invalid record length %d", bodySize)
+	}
+
+	body := make([]byte, bodySize)
+	if _, err := io.ReadFull(r, body); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return record{}, 0, err
+	}
+	if crc32.Checksum(body, castagnoli) != binary.BigEndian.Uint32(header[8:]) {
+		return record{}, 0, Error.New("record checksum mismatch")
+	}
+
+	rec, err := decodeBody(body)
+	return rec, int64(recordHeaderSize) + int64(bodySize), err
+}
+
+// decodeBody decodes the verified body of a record.
+func decodeBody(body []byte) (record, error) {
+	namespaceLen := int(binary.BigEndian.Uint16(body[1:]))
+	keyLen := int(binary.BigEndian.Uint16(body[3:]))
+	if bodyHeaderSize+namespaceLen+keyLen > len(body) {
+		return record{}, Error.New("invalid record key length")
+	}
+
+	rec := record{
+		kind:    recordKind(body[0]),
+		modTime: time.Unix(0, int64(binary.BigEndian.Uint64(body[5:]))),
+	}
+	n := bodyHeaderSize
+	rec.ref.Namespace = body[n : n+namespaceLen]
+	n += namespaceLen
+	rec.ref.Key = body[n : n+keyLen]
+	n += keyLen
+	rec.data = body[n:]
+
+	switch rec.kind {
+	case kindPut, kindDelete:
+		if !rec.ref.IsValid() {
+			return record{}, Error.New("invalid record blob ref")
+		}
+	case kindDeleteNamespace:
+		if len(rec.ref.Namespace) == 0 {
+			return record{}, Error.New("invalid record namespace")
+		}
+	default:
+		return record{}, Error.New("unknown record kind %d", rec.kind)
+	}
+	return rec, nil
+}
+
+// segment is a file of the log. The records are appended to the current segment, and the
+// sealed segments are removed after all their blobs are moved into the underlying store.
+type segment struct {
+	seq  uint64
+	path string
+	// file is only open while the segment is current.
+	file *os.File
+	size int64
+	// entries are the blobs put in the segment, in the order of the records.
+	entries []*entry
+}
+
+// segmentPath returns the path of the log segment.
+func (store *blobStore) segmentPath(seq uint64) string {
+	return filepath.Join(store.dir, strconv.FormatUint(seq, 10)+segmentSuffix)
+}
+
+// listSegments returns the sequence numbers of the log segments in order.
+func (store *blobStore) listSegments() ([]uint64, error) {
+	dirEntries, err := os.ReadDir(store.dir)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	var seqs []uint64
+	for _, dirEntry := range dirEntries {
+		name := dirEntry.Name()
+		if !strings.HasSuffix(name, segmentSuffix) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, segmentSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		seqs = append(seqs, seq)
+	}
+	sort.Slice(seqs, func(i, k int) bool { return seqs[i] < seqs[k] })
+	return seqs, nil
+}
+
+// createSegment creates a new empty segment for appending.
+func (store *blobStore) createSegment(seq uint64) (*segment, error) {
+	path := store.segmentPath(seq)
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &segment{seq: seq, path: path, file: file}, nil
+}
+
+// recover replays the log segments left by the previous run into the index. The records
+// after the first invalid one, e.g. the last record of a crash during a write, are never
+// acknowledged, so they are truncated. It returns the next sequence number.
+// store.mu must be held.
+func (store *blobStore) recover() (next uint64, err error) {
+	seqs, err := store.listSegments()
+	if err != nil {
+		return 0, err
+	}
+
+	var recovered, dropped int64
+	for _, seq := range seqs {
+		seg, records, fileSize, err := store.replaySegment(seq)
+		if err != nil {
+			return 0, err
+		}
+		if seg.size < fileSize {
+			dropped += fileSize - seg.size
+			store.log.Warn("truncating invalid records of write-ahead log segment",
+				zap.String("Path", seg.path),
+				zap.Int64("Valid", seg.size),
+				zap.Int64("Dropped", fileSize-seg.size))
+			if err := os.Truncate(seg.path, seg.size); err != nil {
+				return 0, Error.Wrap(err)
+			}
+		}
+		recovered += records
+		store.sealed = append(store.sealed, seg)
+		next = seq + 1
+	}
+
+	mon.IntVal("walstore_recovered_records").Observe(recovered)
+	mon.IntVal("walstore_recovery_dropped_bytes").Observe(dropped)
+	if len(seqs) > 0 {
+		store.log.Info("recovered write-ahead log",
+			zap.Int("Segments", len(seqs)),
+			zap.Int64("Records", recovered),
+			zap.Int64("Pending Bytes", store.pendingBytes))
+	}
+	return next, nil
+}
+
+// replaySegment applies the valid records of the segment to the index. The returned segment
+// size is the end of the last valid record, and fileSize is the size of the file.
+// store.mu must be held.
+func (store *blobStore) replaySegment(seq uint64) (seg *segment, records, fileSize int64, err error) {
+	seg = &segment{seq: seq, path: store.segmentPath(seq)}
+
+	file, err := os.Open(seg.path)
+	if err != nil {
+		return nil, 0, 0, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(file.Close())) }()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, 0, 0, Error.Wrap(err)
+	}
+
+	reader := bufio.NewReaderSize(file, 1<<20)
+	for {
+		rec, size, err := readRecord(reader)
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				store.log.Warn("invalid write-ahead log record",
+					zap.String("Path", seg.path),
+					zap.Int64("Offset", seg.size),
+					zap.Error(err))
+			}
+			return seg, records, info.Size(), nil
+		}
+		store.apply(seg, seg.size, rec)
+		seg.size += size
+		records++
+	}
+}
+
+// apply applies the record at the offset of the segment to the index.
+// store.mu must be held.
+func (store *blobStore) apply(seg *segment, offset int64, rec record) {
+	switch rec.kind {
+	case kindPut:
+		store.put(&entry{
+			// the ref is copied, so that the entry doesn't keep the record alive.
+			ref: blobstore.BlobRef{
+				Namespace: append([]byte(nil), rec.ref.Namespace...),
+				Key:       append([]byte(nil), rec.ref.Key...),
+			},
+			segment: seg,
+			offset:  offset + dataOffset(rec.ref),
+			size:    int64(len(rec.data)),
+			modTime: rec.modTime,
+		})
+	case kindDelete:
+		store.remove(keyOf(rec.ref))
+	case kindDeleteNamespace:
+		for key := range store.index {
+			if key.namespace == string(rec.ref.Namespace) {
+				store.remove(key)
+			}
+		}
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package walstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+)
+
+// place seals the current segment, moves the blobs of the sealed segments into the underlying
+// store, and removes the segments. It stops at the first failure, which is retried by the next
+// placement.
+func (store *blobStore) place(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.mu.Lock()
+	if store.current.size > 0 {
+		store.rotate = true
+	}
+	store.mu.Unlock()
+	store.sync()
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		store.mu.Lock()
+		if len(store.sealed) == 0 {
+			store.mu.Unlock()
+			return nil
+		}
+		seg := store.sealed[0]
+		entries := seg.entries
+		store.mu.Unlock()
+
+		for _, e := range entries {
+			if err := store.placeIfPending(ctx, e); err != nil {
+				return err
+			}
+		}
+
+		if err := os.Remove(seg.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return Error.Wrap(err)
+		}
+
+		store.mu.Lock()
+		for _, e := range seg.entries {
+			key := keyOf(e.ref)
+			if store.index[key] == e {
+				delete(store.index, key)
+			}
+		}
+		store.sealed = store.sealed[1:]
+		store.mu.Unlock()
+
+		mon.Counter("walstore_segments_placed").Inc(1)
+	}
+}
+
+// placeIfPending moves the blob into the underlying store, unless it was placed or deleted meanwhile.
+func (store *blobStore) placeIfPending(ctx context.Context, e *entry) error {
+	store.placeMu.Lock()
+	defer store.placeMu.Unlock()
+
+	store.mu.Lock()
+	pending := !e.placed && !e.deleted
+	store.mu.Unlock()
+	if !pending {
+		return nil
+	}
+	return store.placeEntry(ctx, e)
+}
+
+// placeEntry verifies the record of the blob, writes the blob to the underlying store and
+// checks it. store.placeMu must be held.
+func (store *blobStore) placeEntry(ctx context.Context, e *entry) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	file, err := os.Open(e.segment.path)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	start := e.offset - dataOffset(e.ref)
+	rec, _, err := readRecord(io.NewSectionReader(file, start, e.offset+e.size-start))
+	err = errs.Combine(err, file.Close())
+	if err != nil {
+		mon.Counter("walstore_corrupted_records").Inc(1)
+		return Error.New("reading the record of %x in %s at %d: %w", e.ref.Key, e.segment.path, start, err)
+	}
+	if rec.kind != kindPut || !bytes.Equal(rec.ref.Namespace, e.ref.Namespace) || !bytes.Equal(rec.ref.Key, e.ref.Key) || int64(len(rec.data)) != e.size {
+		mon.Counter("walstore_corrupted_records").Inc(1)
+		return Error.New("record of %x in %s at %d doesn't match the index", e.ref.Key, e.segment.path, start)
+	}
+
+	if err := store.writeBlob(ctx, store.blobs, e.ref, rec.data); err != nil {
+		return Error.Wrap(err)
+	}
+
+	info, err := store.blobs.Stat(ctx, e.ref)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	fileInfo, err := info.Stat(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if fileInfo.Size() != e.size {
+		store.log.Error("placed piece has unexpected size",
+			zap.Binary("Namespace", e.ref.Namespace),
+			zap.Binary("Key", e.ref.Key),
+			zap.Int64("Expected", e.size),
+			zap.Int64("Actual", fileInfo.Size()))
+		return Error.New("placed blob %x has size %d instead of %d", e.ref.Key, fileInfo.Size(), e.size)
+	}
+
+	store.mu.Lock()
+	if !e.placed && !e.deleted {
+		e.placed = true
+		store.pendingBytes -= e.size
+	}
+	store.mu.Unlock()
+
+	mon.Counter("walstore_placed").Inc(1)
+	mon.IntVal("walstore_placement_delay_seconds").Observe(int64(time.Since(e.modTime).Seconds()))
+	return nil
+}
@@ -0,0 +1,833 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package walstore implements a blob store which appends the new blobs to a write-ahead log
+// and moves them into the underlying store in the background.
+package walstore
+
+import (
+	"context"
+	"encoding/base32"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+)
+
+var (
+	// Error is the default walstore error class.
+	Error = errs.Class("walstore error")
+
+	mon = monkit.Package()
+
+	_ blobstore.Blobs = (*blobStore)(nil)
+)
+
+// pathEncoding is the encoding used by the filestore, so that the key prefixes of the blobs in
+// the log are the same as the key prefixes of the blob files.
+var pathEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// Config is the configuration of the write-ahead log of the pieces.
+type Config struct {
+	Enabled           bool          `help:"append the uploaded pieces to a write-ahead log, which is synced in batches, and move them into the storage directory in the background" default:"false"`
+	SyncInterval      time.Duration `help:"how long an upload waits to be synced together with the other uploads" default:"10ms"`
+	MaxBatchSize      memory.Size   `help:"size of the appended pieces, above which they are synced without waiting for the sync interval" default:"16MiB"`
+	SegmentSize       memory.Size   `help:"size of a write-ahead log file, after which a new one is started" default:"256MiB"`
+	MaxPending        memory.Size   `help:"size of the pieces in the write-ahead log, which aren't in the storage directory yet, above which the uploads are written to the storage directory directly" default:"4GiB"`
+	PlacementInterval time.Duration `help:"how often the pieces are moved from the write-ahead log into the storage directory" default:"10s"`
+}
+
+// refKey identifies a blob in the index.
+type refKey struct {
+	namespace string
+	key       string
+}
+
+func keyOf(ref blobstore.BlobRef) refKey {
+	return refKey{namespace: string(ref.Namespace), key: string(ref.Key)}
+}
+
+// entry is a blob put in the log.
+type entry struct {
+	ref     blobstore.BlobRef
+	segment *segment
+	// offset is the offset of the blob data in the segment.
+	offset  int64
+	size    int64
+	modTime time.Time
+
+	// placed is set after the blob is moved into the underlying store. The entry is kept until
+	// its segment is removed, so that deleting the blob is recorded in the log.
+	placed bool
+	// deleted is set when the blob was deleted or replaced before it was placed.
+	deleted bool
+}
+
+// batch is the records appended since the last sync of the log.
+type batch struct {
+	segments []*segment
+	applies  []func()
+	size     int64
+
+	done chan struct{}
+	err  error
+}
+
+// blobStore appends the new blobs of the underlying store to a write-ahead log.
+//
+// Committing a new blob appends it as a record to the current log segment, and waits until
+// the segment is synced. The records appended meanwhile are synced together, so a slow disk
+// syncs once per batch instead of once per blob. The placement periodically seals the current
+// segment, moves the blobs of the sealed segments into the underlying store, and removes the
+// segments. Deleting or trashing a blob, which is still in the log, appends a tombstone, so that
+// the blob isn't restored by the recovery, which replays the segments left by a crash.
+//
+// Reads, walks and space usage include both the blobs in the log and in the underlying store.
+// When the log can't be written or the blobs in the log exceed the maximum pending size, the new
+// blobs are written to the underlying store directly.
+type blobStore struct {
+	log    *zap.Logger
+	blobs  blobstore.Blobs
+	dir    string
+	config Config
+
+	// mu protects the index, the segments and the current batch.
+	mu           sync.Mutex
+	index        map[refKey]*entry
+	current      *segment
+	sealed       []*segment
+	batch        *batch
+	rotate       bool
+	pendingBytes int64
+	closed       bool
+
+	// syncMu serializes the syncs, so that the segments are sealed in order.
+	syncMu sync.Mutex
+	// placeMu serializes moving the blobs into the underlying store with deleting and trashing them.
+	placeMu sync.Mutex
+
+	kick      chan struct{}
+	placement *sync2.Cycle
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+// New creates a blob store which appends the new blobs of blobs to a write-ahead log in dir,
+// recovers the log left by the previous run, and starts the background syncing and placement.
+func New(log *zap.Logger, blobs blobstore.Blobs, dir string, config Config) (_ blobstore.Blobs, err error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	store := &blobStore{
+		log:    log,
+		blobs:  blobs,
+		dir:    dir,
+		config: config,
+		index:  map[refKey]*entry{},
+
+		kick:      make(chan struct{}, 1),
+		placement: sync2.NewCycle(config.PlacementInterval),
+	}
+
+	store.mu.Lock()
+	next, err := store.recover()
+	if err == nil {
+		store.current, err = store.createSegment(next)
+	}
+	store.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	var ctx context.Context
+	ctx, store.cancel = context.WithCancel(context.Background())
+	store.placement.SetDelayStart()
+	store.wg.Add(2)
+	go func() {
+		defer store.wg.Done()
+		store.runSync(ctx)
+	}()
+	go func() {
+		defer store.wg.Done()
+		_ = store.placement.Run(ctx, func(ctx context.Context) error {
+			if err := store.place(ctx); err != nil && !errs.Is(err, context.Canceled) {
+				store.log.Error("moving the pieces from the write-ahead log failed", zap.Error(err))
+			}
+			return nil
+		})
+	}()
+	return store, nil
+}
+
+// Close stops the placement, syncs the log and closes it and the underlying store. The blobs,
+// which weren't placed, are placed after the log is recovered by the next run.
+func (store *blobStore) Close() error {
+	store.cancel()
+	store.wg.Wait()
+	store.placement.Close()
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	store.closed = true
+	return errs.Combine(Error.Wrap(store.current.file.Close()), store.blobs.Close())
+}
+
+// put adds the entry to the index and to its segment. A blob with the same ref, which wasn't
+// placed yet, is replaced. store.mu must be held.
+func (store *blobStore) put(e *entry) {
+	key := keyOf(e.ref)
+	if old, ok := store.index[key]; ok && !old.placed && !old.deleted {
+		old.deleted = true
+		store.pendingBytes -= old.size
+	}
+	store.index[key] = e
+	e.segment.entries = append(e.segment.entries, e)
+	store.pendingBytes += e.size
+}
+
+// remove removes the blob from the index. store.mu must be held.
+func (store *blobStore) remove(key refKey) {
+	e, ok := store.index[key]
+	if !ok {
+		return
+	}
+	delete(store.index, key)
+	if !e.placed && !e.deleted {
+		store.pendingBytes -= e.size
+	}
+	e.deleted = true
+}
+
+// lookup returns the entry of the blob, which wasn't placed yet.
+func (store *blobStore) lookup(ref blobstore.BlobRef) (*entry, bool, error) {
+	if !ref.IsValid() {
+		return nil, false, blobstore.ErrInvalidBlobRef.New("")
+	}
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	e, ok := store.index[keyOf(ref)]
+	if !ok || e.placed || e.deleted {
+		return nil, false, nil
+	}
+	return e, true, nil
+}
+
+// append appends the record to the current segment. The record is applied to the index by
+// the sync of the returned batch.
+func (store *blobStore) append(rec []byte, apply func(seg *segment, offset int64)) (*batch, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	if store.closed {
+		return nil, Error.New("closed")
+	}
+
+	seg := store.current
+	offset := seg.size
+	if _, err := seg.file.WriteAt(rec, offset); err != nil {
+		// the partial record is overwritten by the next one, or truncated by the recovery.
+		store.rotate = true
+		store.kickSync()
+		return nil, Error.Wrap(err)
+	}
+	seg.size += int64(len(rec))
+
+	b := store.batch
+	if b == nil {
+		b = &batch{done: make(chan struct{})}
+		store.batch = b
+	}
+	if len(b.segments) == 0 || b.segments[len(b.segments)-1] != seg {
+		b.segments = append(b.segments, seg)
+	}
+	b.applies = append(b.applies, func() { apply(seg, offset) })
+	b.size += int64(len(rec))
+	if b.size >= store.config.MaxBatchSize.Int64() {
+		store.kickSync()
+	}
+	return b, nil
+}
+
+// appendAndWait appends the record and waits until it's synced.
+func (store *blobStore) appendAndWait(rec []byte, apply func(seg *segment, offset int64)) error {
+	b, err := store.append(rec, apply)
+	if err != nil {
+		return err
+	}
+	<-b.done
+	return b.err
+}
+
+// kickSync makes the syncing not wait for the sync interval. store.mu must be held.
+func (store *blobStore) kickSync() {
+	select {
+	case store.kick <- struct{}{}:
+	default:
+	}
+}
+
+// runSync syncs the appended records in batches until the context is canceled.
+func (store *blobStore) runSync(ctx context.Context) {
+	ticker := time.NewTicker(store.config.SyncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			store.sync()
+			return
+		case <-ticker.C:
+		case <-store.kick:
+		}
+		store.sync()
+	}
+}
+
+// sync syncs the current batch, applies its records to the index and notifies the waiting
+// writers. The current segment is sealed, when it's full or the rotation was requested.
+func (store *blobStore) sync() {
+	store.syncMu.Lock()
+	defer store.syncMu.Unlock()
+
+	store.mu.Lock()
+	b := store.batch
+	store.batch = nil
+
+	var sealed *segment
+	if store.current.size > 0 && (store.rotate || store.current.size >= store.config.SegmentSize.Int64()) {
+		next, err := store.createSegment(store.current.seq + 1)
+		if err != nil {
+			store.log.Error("failed to create write-ahead log segment", zap.Error(err))
+		} else {
+			sealed, store.current = store.current, next
+			store.rotate = false
+		}
+	}
+	store.mu.Unlock()
+
+	if b != nil {
+		for _, seg := range b.segments {
+			if err := seg.file.Sync(); err != nil {
+				b.err = errs.Combine(b.err, Error.Wrap(err))
+			}
+		}
+		mon.IntVal("walstore_sync_batch_records").Observe(int64(len(b.applies)))
+		mon.IntVal("walstore_sync_batch_bytes").Observe(b.size)
+	}
+	if sealed != nil {
+		if err := sealed.file.Close(); err != nil {
+			store.log.Warn("failed to close write-ahead log segment", zap.String("Path", sealed.path), zap.Error(err))
+		}
+		sealed.file = nil
+	}
+
+	store.mu.Lock()
+	if b != nil {
+		if b.err == nil {
+			for _, apply := range b.applies {
+				apply()
+			}
+		} else {
+			store.rotate = true
+		}
+	}
+	if sealed != nil {
+		store.sealed = append(store.sealed, sealed)
+	}
+	store.mu.Unlock()
+
+	if b != nil {
+		close(b.done)
+	}
+}
+
+// commit appends the blob to the log. When the log fails, the blob is written to the
+// underlying store instead.
+func (store *blobStore) commit(ctx context.Context, ref blobstore.BlobRef, data []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rec := record{kind: kindPut, ref: ref, modTime: time.Now(), data: data}
+	err = store.appendAndWait(encodeRecord(rec.kind, rec.ref, rec.modTime, rec.data), func(seg *segment, offset int64) {
+		store.apply(seg, offset, rec)
+	})
+	if err == nil {
+		return nil
+	}
+
+	mon.Counter("walstore_fallback").Inc(1)
+	store.log.Warn("failed to append the piece to the write-ahead log, writing it to the storage directory",
+		zap.Binary("Namespace", ref.Namespace),
+		zap.Binary("Key", ref.Key),
+		zap.Error(err))
+	return store.writeBlob(ctx, store.blobs, ref, data)
+}
+
+// writeBlob writes the blob to the blobs.
+func (store *blobStore) writeBlob(ctx context.Context, blobs blobstore.Blobs, ref blobstore.BlobRef, data []byte) error {
+	writer, err := blobs.Create(ctx, ref, int64(len(data)))
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(data); err != nil {
+		return errs.Combine(err, writer.Cancel(ctx))
+	}
+	return writer.Commit(ctx)
+}
+
+// forget appends a tombstone of the blob, when it's in the index, and removes it from the
+// index, so that the recovery doesn't restore it. store.placeMu must be held.
+func (store *blobStore) forget(ref blobstore.BlobRef) error {
+	if !ref.IsValid() {
+		return blobstore.ErrInvalidBlobRef.New("")
+	}
+	store.mu.Lock()
+	_, ok := store.index[keyOf(ref)]
+	store.mu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	rec := record{kind: kindDelete, ref: ref, modTime: time.Now()}
+	return store.appendAndWait(encodeRecord(rec.kind, rec.ref, rec.modTime, nil), func(seg *segment, offset int64) {
+		store.apply(seg, offset, rec)
+	})
+}
+
+// Create creates a new blob, which is appended to the log when it's committed. When there
+// are too many blobs in the log, it's created in the underlying store.
+func (store *blobStore) Create(ctx context.Context, ref blobstore.BlobRef, size int64) (_ blobstore.BlobWriter, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !ref.IsValid() {
+		return nil, blobstore.ErrInvalidBlobRef.New("")
+	}
+
+	store.mu.Lock()
+	pending := store.pendingBytes
+	store.mu.Unlock()
+	if pending >= store.config.MaxPending.Int64() {
+		mon.Counter("walstore_bypassed").Inc(1)
+		return store.blobs.Create(ctx, ref, size)
+	}
+
+	if size < 0 {
+		size = 0
+	}
+	return &blobWriter{store: store, ref: ref, buf: make([]byte, 0, size)}, nil
+}
+
+// Open opens a reader for the blob, which is either in the log or in the underlying store.
+func (store *blobStore) Open(ctx context.Context, ref blobstore.BlobRef) (_ blobstore.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	e, ok, err := store.lookup(ref)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return store.blobs.Open(ctx, ref)
+	}
+
+	file, err := os.Open(e.segment.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// the segment was removed after the blob was placed.
+			return store.blobs.Open(ctx, ref)
+		}
+		return nil, Error.Wrap(err)
+	}
+	return &blobReader{SectionReader: io.NewSectionReader(file, e.offset, e.size), file: file}, nil
+}
+
+// OpenWithStorageFormat opens a reader for the blob with the storage format. Only V1 blobs are in the log.
+func (store *blobStore) OpenWithStorageFormat(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (_ blobstore.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if formatVer != filestore.FormatV1 {
+		return store.blobs.OpenWithStorageFormat(ctx, ref, formatVer)
+	}
+	return store.Open(ctx, ref)
+}
+
+// Stat looks up the metadata of the blob, which is either in the log or in the underlying store.
+func (store *blobStore) Stat(ctx context.Context, ref blobstore.BlobRef) (_ blobstore.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	e, ok, err := store.lookup(ref)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return store.blobs.Stat(ctx, ref)
+	}
+	return &blobInfo{ref: ref, entry: e}, nil
+}
+
+// StatWithStorageFormat looks up the metadata of the blob with the storage format. Only V1 blobs are in the log.
+func (store *blobStore) StatWithStorageFormat(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (_ blobstore.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if formatVer != filestore.FormatV1 {
+		return store.blobs.StatWithStorageFormat(ctx, ref, formatVer)
+	}
+	return store.Stat(ctx, ref)
+}
+
+// Delete deletes the blob from the log and from the underlying store.
+func (store *blobStore) Delete(ctx context.Context, ref blobstore.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.placeMu.Lock()
+	defer store.placeMu.Unlock()
+
+	if err := store.forget(ref); err != nil {
+		return err
+	}
+	return store.blobs.Delete(ctx, ref)
+}
+
+// DeleteWithStorageFormat deletes the blob with the storage format. Only V1 blobs are in the log.
+func (store *blobStore) DeleteWithStorageFormat(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.placeMu.Lock()
+	defer store.placeMu.Unlock()
+
+	if formatVer == filestore.FormatV1 {
+		if err := store.forget(ref); err != nil {
+			return err
+		}
+	}
+	return store.blobs.DeleteWithStorageFormat(ctx, ref, formatVer)
+}
+
+// DeleteNamespace deletes the blobs of the namespace from the log and from the underlying store.
+func (store *blobStore) DeleteNamespace(ctx context.Context, ref []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.placeMu.Lock()
+	defer store.placeMu.Unlock()
+
+	store.mu.Lock()
+	var found bool
+	for key := range store.index {
+		if key.namespace == string(ref) {
+			found = true
+			break
+		}
+	}
+	store.mu.Unlock()
+
+	if found {
+		rec := record{kind: kindDeleteNamespace, ref: blobstore.BlobRef{Namespace: ref}, modTime: time.Now()}
+		err := store.appendAndWait(encodeRecord(rec.kind, rec.ref, rec.modTime, nil), func(seg *segment, offset int64) {
+			store.apply(seg, offset, rec)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return store.blobs.DeleteNamespace(ctx, ref)
+}
+
+// Trash moves the blob to the trash of the underlying store. A blob in the log is placed first.
+func (store *blobStore) Trash(ctx context.Context, ref blobstore.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.placeMu.Lock()
+	defer store.placeMu.Unlock()
+
+	e, ok, err := store.lookup(ref)
+	if err != nil {
+		return err
+	}
+	if ok {
+		if err := store.placeEntry(ctx, e); err != nil {
+			return err
+		}
+	}
+	if err := store.forget(ref); err != nil {
+		return err
+	}
+	return store.blobs.Trash(ctx, ref)
+}
+
+// RestoreTrash restores the trash of the underlying store.
+func (store *blobStore) RestoreTrash(ctx context.Context, namespace []byte) (_ [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.blobs.RestoreTrash(ctx, namespace)
+}
+
+// RestoreTrashKeys restores the blobs with the given keys from the trash of the underlying store.
+func (store *blobStore) RestoreTrashKeys(ctx context.Context, namespace []byte, keys [][]byte) (_ [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.blobs.RestoreTrashKeys(ctx, namespace, keys)
+}
+
+// WalkTrash walks the trash of the underlying store.
+func (store *blobStore) WalkTrash(ctx context.Context, namespace []byte, walkFunc func(blobstore.BlobInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.blobs.WalkTrash(ctx, namespace, walkFunc)
+}
+
+// EmptyTrash empties the trash of the underlying store.
+func (store *blobStore) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (_ int64, _ [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.blobs.EmptyTrash(ctx, namespace, trashedBefore)
+}
+
+// EmptyTrashWithProgress empties the trash of the underlying store and reports the deleted
+// blobs to deleted.
+func (store *blobStore) EmptyTrashWithProgress(ctx context.Context, namespace []byte, trashedBefore time.Time, deleted func(blobs, bytes int64)) (_ int64, _ [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return blobstore.EmptyTrash(ctx, store.blobs, namespace, trashedBefore, deleted)
+}
+
+// FreeSpace returns the free space of the underlying store.
+func (store *blobStore) FreeSpace(ctx context.Context) (int64, error) {
+	return store.blobs.FreeSpace(ctx)
+}
+
+// SpaceUsedForTrash returns the space used by the trash of the underlying store.
+func (store *blobStore) SpaceUsedForTrash(ctx context.Context) (int64, error) {
+	return store.blobs.SpaceUsedForTrash(ctx)
+}
+
+// SpaceUsedForBlobs returns the space used by the blobs in the log and in the underlying store.
+func (store *blobStore) SpaceUsedForBlobs(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	total, err = store.blobs.SpaceUsedForBlobs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	return total + store.pendingBytes, nil
+}
+
+// SpaceUsedForBlobsInNamespace returns the space used by the blobs in the log and in the
+// underlying store in the namespace.
+func (store *blobStore) SpaceUsedForBlobsInNamespace(ctx context.Context, namespace []byte) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	total, err = store.blobs.SpaceUsedForBlobsInNamespace(ctx, namespace)
+	if err != nil {
+		return 0, err
+	}
+	for _, e := range store.pending(namespace, "") {
+		total += e.size
+	}
+	return total, nil
+}
+
+// pending returns the entries of the blobs with the key prefix in the namespace, which weren't
+// placed yet, by their encoded keys. An empty key prefix matches all the blobs.
+func (store *blobStore) pending(namespace []byte, keyPrefix string) map[string]*entry {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	entries := map[string]*entry{}
+	for key, e := range store.index {
+		if e.placed || e.deleted || key.namespace != string(namespace) {
+			continue
+		}
+		encoded := encodeKey(e.ref.Key)
+		if strings.HasPrefix(encoded, keyPrefix) {
+			entries[encoded] = e
+		}
+	}
+	return entries
+}
+
+// ListNamespaces returns the namespaces of the blobs in the log and in the underlying store.
+func (store *blobStore) ListNamespaces(ctx context.Context) (ids [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ids, err = store.blobs.ListNamespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]struct{}{}
+	for _, id := range ids {
+		seen[string(id)] = struct{}{}
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	for key, e := range store.index {
+		if e.placed || e.deleted {
+			continue
+		}
+		if _, ok := seen[key.namespace]; !ok {
+			seen[key.namespace] = struct{}{}
+			ids = append(ids, []byte(key.namespace))
+		}
+	}
+	return ids, nil
+}
+
+// WalkNamespace executes walkFunc for each blob in the namespace.
+func (store *blobStore) WalkNamespace(ctx context.Context, namespace []byte, walkFunc func(blobstore.BlobInfo) error) (err error) {
+	return store.WalkNamespaceFrom(ctx, namespace, "", walkFunc, nil)
+}
+
+// WalkNamespaceFrom is like WalkNamespace, but it walks the key prefixes in order, skipping the
+// ones up to and including startAfter, and calls prefixDone after all the blobs with a key prefix
+// have been walked.
+func (store *blobStore) WalkNamespaceFrom(ctx context.Context, namespace []byte, startAfter string, walkFunc func(blobstore.BlobInfo) error, prefixDone func(keyPrefix string) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyPrefixes, err := store.ListKeyPrefixes(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	for _, keyPrefix := range keyPrefixes {
+		if keyPrefix <= startAfter {
+			continue
+		}
+		if err := store.WalkNamespacePrefix(ctx, namespace, keyPrefix, walkFunc); err != nil {
+			return err
+		}
+		if prefixDone != nil {
+			if err := prefixDone(keyPrefix); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ListKeyPrefixes returns the sorted key prefixes of the blobs in the log and in the underlying store.
+func (store *blobStore) ListKeyPrefixes(ctx context.Context, namespace []byte) (_ []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyPrefixes, err := store.blobs.ListKeyPrefixes(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]struct{}{}
+	for _, keyPrefix := range keyPrefixes {
+		seen[keyPrefix] = struct{}{}
+	}
+	for encoded := range store.pending(namespace, "") {
+		keyPrefix := encoded[:2]
+		if _, ok := seen[keyPrefix]; !ok {
+			seen[keyPrefix] = struct{}{}
+			keyPrefixes = append(keyPrefixes, keyPrefix)
+		}
+	}
+	sort.Strings(keyPrefixes)
+	return keyPrefixes, nil
+}
+
+// WalkNamespacePrefix executes walkFunc for each blob in the log and in the underlying store
+// with the key prefix in the namespace.
+func (store *blobStore) WalkNamespacePrefix(ctx context.Context, namespace []byte, keyPrefix string, walkFunc func(blobstore.BlobInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the entries are collected first, so that walkFunc can change the index.
+	pending := store.pending(namespace, keyPrefix)
+
+	err = store.blobs.WalkNamespacePrefix(ctx, namespace, keyPrefix, func(info blobstore.BlobInfo) error {
+		if info.StorageFormatVersion() == filestore.FormatV1 {
+			// the blob was placed after the entries were collected.
+			if _, ok := pending[encodeKey(info.BlobRef().Key)]; ok {
+				return nil
+			}
+		}
+		return walkFunc(info)
+	})
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(pending))
+	for key := range pending {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		e := pending[key]
+		if err := walkFunc(&blobInfo{ref: e.ref, entry: e}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckWritability checks the writability of the underlying store.
+func (store *blobStore) CheckWritability(ctx context.Context) error {
+	return store.blobs.CheckWritability(ctx)
+}
+
+// CreateVerificationFile creates the verification file in the underlying store.
+func (store *blobStore) CreateVerificationFile(ctx context.Context, id storj.NodeID) error {
+	return store.blobs.CreateVerificationFile(ctx, id)
+}
+
+// VerifyStorageDir verifies the underlying store.
+func (store *blobStore) VerifyStorageDir(ctx context.Context, id storj.NodeID) error {
+	return store.blobs.VerifyStorageDir(ctx, id)
+}
+
+// encodeKey returns the blob key as it's encoded in the file names of the filestore.
+func encodeKey(key []byte) string {
+	encoded := pathEncoding.EncodeToString(key)
+	if len(encoded) < 3 {
+		// ensure we always have enough characters to split [:2] and [2:]
+		encoded = "11" + encoded
+	}
+	return encoded
+}
+
+// blobInfo is the metadata of a blob in the log.
+type blobInfo struct {
+	ref   blobstore.BlobRef
+	entry *entry
+}
+
+// BlobRef returns the relevant BlobRef for the blob.
+func (info *blobInfo) BlobRef() blobstore.BlobRef { return info.ref }
+
+// StorageFormatVersion indicates the storage format version used to store the blob.
+func (info *blobInfo) StorageFormatVersion() blobstore.FormatVersion { return filestore.FormatV1 }
+
+// FullPath returns the path of the log segment which contains the blob.
+func (info *blobInfo) FullPath(ctx context.Context) (string, error) {
+	return info.entry.segment.path, nil
+}
+
+// Stat returns the size and the mtime of the blob.
+func (info *blobInfo) Stat(ctx context.Context) (os.FileInfo, error) {
+	return pendingFileInfo{name: encodeKey(info.ref.Key), size: info.entry.size, modTime: info.entry.modTime}, nil
+}
+
+// pendingFileInfo implements os.FileInfo for a blob in the log.
+type pendingFileInfo struct {
+	name    string
+	size    int64
+	modTime time.Time
+}
+
+func (info pendingFileInfo) Name() string       { return info.name }
+func (info pendingFileInfo) Size() int64        { return info.size }
+func (info pendingFileInfo) Mode() fs.FileMode  { return 0600 }
+func (info pendingFileInfo) ModTime() time.Time { return info.modTime }
+func (info pendingFileInfo) IsDir() bool        { return false }
+func (info pendingFileInfo) Sys() interface{}   { return nil }
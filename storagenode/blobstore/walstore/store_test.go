@@ -0,0 +1,215 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package walstore
+
+import (
+	"context"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+)
+
+var testConfig = Config{
+	Enabled:           true,
+	SyncInterval:      time.Millisecond,
+	MaxBatchSize:      memory.KiB,
+	SegmentSize:       4 * memory.KiB,
+	MaxPending:        memory.MiB,
+	PlacementInterval: time.Hour,
+}
+
+func writeBlob(ctx context.Context, t *testing.T, store blobstore.Blobs, ref blobstore.BlobRef, data []byte) {
+	writer, err := store.Create(ctx, ref, int64(len(data)))
+	require.NoError(t, err)
+	_, err = writer.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit(ctx))
+}
+
+func readBlob(ctx context.Context, t *testing.T, store blobstore.Blobs, ref blobstore.BlobRef) []byte {
+	reader, err := store.Open(ctx, ref)
+	require.NoError(t, err)
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	return data
+}
+
+func openStore(ctx *testcontext.Context, t *testing.T) (*blobStore, blobstore.Blobs) {
+	log := zaptest.NewLogger(t)
+	loose, err := filestore.NewAt(log, ctx.Dir("pieces"), filestore.DefaultConfig)
+	require.NoError(t, err)
+	blobs, err := New(log, loose, ctx.Dir("wal"), testConfig)
+	require.NoError(t, err)
+	return blobs.(*blobStore), loose
+}
+
+func TestStore(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store, loose := openStore(ctx, t)
+	defer ctx.Check(store.Close)
+
+	namespace := testrand.Bytes(32)
+	contents := map[string][]byte{}
+	var refs []blobstore.BlobRef
+	for i := 0; i < 20; i++ {
+		ref := blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+		data := testrand.BytesInt(512)
+		writeBlob(ctx, t, store, ref, data)
+		contents[string(ref.Key)] = data
+		refs = append(refs, ref)
+	}
+
+	// the header is written after the data, like the piece writer does.
+	header := blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	writer, err := store.Create(ctx, header, -1)
+	require.NoError(t, err)
+	_, err = writer.Seek(16, io.SeekStart)
+	require.NoError(t, err)
+	_, err = writer.Write([]byte("data"))
+	require.NoError(t, err)
+	_, err = writer.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+	_, err = writer.Write([]byte("header"))
+	require.NoError(t, err)
+	_, err = writer.Seek(0, io.SeekEnd)
+	require.NoError(t, err)
+	size, err := writer.Size()
+	require.NoError(t, err)
+	require.EqualValues(t, 20, size)
+	require.NoError(t, writer.Commit(ctx))
+	contents[string(header.Key)] = append(append([]byte("header"), make([]byte, 10)...), "data"...)
+	refs = append(refs, header)
+
+	// the blobs are only in the log.
+	for _, ref := range refs {
+		_, err := loose.Stat(ctx, ref)
+		require.ErrorIs(t, err, os.ErrNotExist)
+		require.Equal(t, contents[string(ref.Key)], readBlob(ctx, t, store, ref))
+	}
+
+	usedBefore, err := store.SpaceUsedForBlobsInNamespace(ctx, namespace)
+	require.NoError(t, err)
+	require.EqualValues(t, 20*512+20, usedBefore)
+
+	namespaces, err := store.ListNamespaces(ctx)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{namespace}, namespaces)
+
+	// deleting and trashing a blob in the log.
+	require.NoError(t, store.Delete(ctx, refs[0]))
+	_, err = store.Open(ctx, refs[0])
+	require.ErrorIs(t, err, os.ErrNotExist)
+	delete(contents, string(refs[0].Key))
+
+	require.NoError(t, store.Trash(ctx, refs[1]))
+	_, err = store.Stat(ctx, refs[1])
+	require.ErrorIs(t, err, os.ErrNotExist)
+	restored, err := store.RestoreTrash(ctx, namespace)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{refs[1].Key}, restored)
+
+	require.NoError(t, store.place(ctx))
+
+	// the blobs are moved into the underlying store and the segments are removed.
+	for key, data := range contents {
+		ref := blobstore.BlobRef{Namespace: namespace, Key: []byte(key)}
+		require.Equal(t, data, readBlob(ctx, t, loose, ref))
+		require.Equal(t, data, readBlob(ctx, t, store, ref))
+	}
+	seqs, err := store.listSegments()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{store.current.seq}, seqs)
+	require.Empty(t, store.index)
+	require.Zero(t, store.pendingBytes)
+
+	usedAfter, err := store.SpaceUsedForBlobsInNamespace(ctx, namespace)
+	require.NoError(t, err)
+	require.Equal(t, usedBefore-512, usedAfter)
+
+	walked := map[string]bool{}
+	require.NoError(t, store.WalkNamespace(ctx, namespace, func(info blobstore.BlobInfo) error {
+		require.False(t, walked[string(info.BlobRef().Key)])
+		walked[string(info.BlobRef().Key)] = true
+		return nil
+	}))
+	require.Len(t, walked, len(contents))
+}
+
+func TestRecovery(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store, _ := openStore(ctx, t)
+
+	namespace := testrand.Bytes(32)
+	kept := blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	deleted := blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	data := testrand.BytesInt(512)
+	writeBlob(ctx, t, store, kept, data)
+	writeBlob(ctx, t, store, deleted, testrand.BytesInt(512))
+	require.NoError(t, store.Delete(ctx, deleted))
+
+	current := store.current.path
+	require.NoError(t, store.Close())
+
+	// a crash while appending leaves a partial record at the end of the segment.
+	torn := encodeRecord(kindPut, blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}, time.Now(), testrand.BytesInt(100))
+	file, err := os.OpenFile(current, os.O_WRONLY|os.O_APPEND, 0600)
+	require.NoError(t, err)
+	_, err = file.Write(torn[:len(torn)/2])
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	info, err := os.Stat(current)
+	require.NoError(t, err)
+	validSize := info.Size() - int64(len(torn)/2)
+
+	store, loose := openStore(ctx, t)
+
+	info, err = os.Stat(current)
+	require.NoError(t, err)
+	require.Equal(t, validSize, info.Size())
+
+	// the deleted blob isn't resurrected by the recovery.
+	require.Equal(t, data, readBlob(ctx, t, store, kept))
+	_, err = store.Stat(ctx, deleted)
+	require.ErrorIs(t, err, os.ErrNotExist)
+	require.EqualValues(t, len(data), store.pendingBytes)
+
+	require.NoError(t, store.place(ctx))
+	require.Equal(t, data, readBlob(ctx, t, loose, kept))
+	_, err = loose.Stat(ctx, deleted)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	// the records after a corrupted one are dropped.
+	writeBlob(ctx, t, store, deleted, testrand.BytesInt(128))
+	current = store.current.path
+	require.NoError(t, store.Close())
+
+	file, err = os.OpenFile(current, os.O_RDWR, 0600)
+	require.NoError(t, err)
+	_, err = file.WriteAt([]byte("XXXX"), recordHeaderSize+bodyHeaderSize)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	store, _ = openStore(ctx, t)
+	defer ctx.Check(store.Close)
+	_, err = store.Stat(ctx, deleted)
+	require.ErrorIs(t, err, os.ErrNotExist)
+	info, err = os.Stat(current)
+	require.NoError(t, err)
+	require.Zero(t, info.Size())
+}
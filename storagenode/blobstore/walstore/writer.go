@@ -0,0 +1,115 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package walstore
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+)
+
+// blobWriter buffers a new blob in memory, and appends it to the log when it's committed.
+type blobWriter struct {
+	store  *blobStore
+	ref    blobstore.BlobRef
+	buf    []byte
+	pos    int64
+	closed bool
+}
+
+// Write writes the data at the current position, growing the blob as needed.
+func (blob *blobWriter) Write(p []byte) (int, error) {
+	if blob.closed {
+		return 0, Error.New("already closed")
+	}
+	end := blob.pos + int64(len(p))
+	if end > int64(len(blob.buf)) {
+		if end > int64(cap(blob.buf)) {
+			grown := make([]byte, len(blob.buf), end+end/4)
+			copy(grown, blob.buf)
+			blob.buf = grown
+		}
+		// the gap left by seeking past the end reads as zeros, like in a file.
+		blob.buf = blob.buf[:end]
+	}
+	copy(blob.buf[blob.pos:], p)
+	blob.pos = end
+	return len(p), nil
+}
+
+// Seek sets the position of the next write.
+func (blob *blobWriter) Seek(offset int64, whence int) (int64, error) {
+	var pos int64
+	switch whence {
+	case io.SeekStart:
+		pos = offset
+	case io.SeekCurrent:
+		pos = blob.pos + offset
+	case io.SeekEnd:
+		pos = int64(len(blob.buf)) + offset
+	default:
+		return 0, Error.New("invalid whence %d", whence)
+	}
+	if pos < 0 {
+		return 0, Error.New("negative position")
+	}
+	blob.pos = pos
+	return pos, nil
+}
+
+// Cancel discards the blob.
+func (blob *blobWriter) Cancel(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	blob.closed = true
+	blob.buf = nil
+	return nil
+}
+
+// Commit appends the blob to the log and waits until it's synced.
+func (blob *blobWriter) Commit(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if blob.closed {
+		return Error.New("already closed")
+	}
+	blob.closed = true
+
+	// like the filestore, the blob is truncated or extended to the current position.
+	data := blob.buf
+	if blob.pos <= int64(len(data)) {
+		data = data[:blob.pos]
+	} else {
+		data = append(data, make([]byte, blob.pos-int64(len(data)))...)
+	}
+	blob.buf = nil
+	return blob.store.commit(ctx, blob.ref, data)
+}
+
+// Size returns the current position, like the size of the files of the filestore.
+func (blob *blobWriter) Size() (int64, error) {
+	return blob.pos, nil
+}
+
+// StorageFormatVersion returns the storage format version of the blob.
+func (blob *blobWriter) StorageFormatVersion() blobstore.FormatVersion {
+	return filestore.FormatV1
+}
+
+// blobReader reads a blob from a log segment.
+type blobReader struct {
+	*io.SectionReader
+	file *os.File
+}
+
+// Close closes the segment file.
+func (reader *blobReader) Close() error { return reader.file.Close() }
+
+// StorageFormatVersion returns the storage format version of the blob.
+func (reader *blobReader) StorageFormatVersion() blobstore.FormatVersion { return filestore.FormatV1 }
+
+// Size returns the size of the blob.
+func (reader *blobReader) Size() (int64, error) { return reader.SectionReader.Size(), nil }
@@ -0,0 +1,125 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// Aggregate contains the totals of the node across all the trusted satellites.
+type Aggregate struct {
+	Satellites   int `json:"satellites"`
+	Vetted       int `json:"vetted"`
+	Suspended    int `json:"suspended"`
+	Disqualified int `json:"disqualified"`
+
+	DiskSpace DiskSpaceInfo `json:"diskSpace"`
+	Bandwidth BandwidthInfo `json:"bandwidth"`
+
+	Payout AggregatePayout `json:"payout"`
+	Audits AggregateAudits `json:"audits"`
+
+	// Status is the severity of the worst alert, or "ok" when there are none.
+	Status string `json:"status"`
+	Alerts int    `json:"alerts"`
+}
+
+// AggregatePayout contains the payouts from all the satellites, in cents.
+type AggregatePayout struct {
+	CurrentMonth             float64 `json:"currentMonth"`
+	CurrentMonthHeld         float64 `json:"currentMonthHeld"`
+	CurrentMonthExpectations int64   `json:"currentMonthExpectations"`
+	PreviousMonth            float64 `json:"previousMonth"`
+}
+
+// AggregateAudits contains the lowest scores and the audit counts across the satellites,
+// on which the node isn't disqualified.
+type AggregateAudits struct {
+	AuditScore      float64 `json:"auditScore"`
+	SuspensionScore float64 `json:"suspensionScore"`
+	OnlineScore     float64 `json:"onlineScore"`
+	SuccessCount    int64   `json:"successCount"`
+	TotalCount      int64   `json:"totalCount"`
+}
+
+// GetAggregate returns the totals of the node across all the trusted satellites.
+func (s *Service) GetAggregate(ctx context.Context) (_ *Aggregate, err error) {
+	defer mon.Task()(&ctx)(&err)
+	now := time.Now().UTC()
+
+	data := &Aggregate{
+		Audits: AggregateAudits{
+			AuditScore:      1,
+			SuspensionScore: 1,
+			OnlineScore:     1,
+		},
+	}
+
+	for _, satelliteID := range s.trust.GetSatellites(ctx) {
+		stats, err := s.reputationDB.Get(ctx, satelliteID)
+		if err != nil {
+			return nil, SNOServiceErr.Wrap(err)
+		}
+
+		data.Satellites++
+		if stats.VettedAt != nil {
+			data.Vetted++
+		}
+		if stats.SuspendedAt != nil || stats.OfflineSuspendedAt != nil {
+			data.Suspended++
+		}
+		if stats.DisqualifiedAt != nil {
+			data.Disqualified++
+			continue
+		}
+		if stats.UpdatedAt.IsZero() {
+			continue
+		}
+
+		data.Audits.AuditScore = math.Min(data.Audits.AuditScore, stats.Audit.Score)
+		data.Audits.SuspensionScore = math.Min(data.Audits.SuspensionScore, stats.Audit.UnknownScore)
+		data.Audits.OnlineScore = math.Min(data.Audits.OnlineScore, stats.OnlineScore)
+		data.Audits.SuccessCount += stats.Audit.SuccessCount
+		data.Audits.TotalCount += stats.Audit.TotalCount
+	}
+
+	data.DiskSpace, err = s.diskSpace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bandwidthUsage, err := s.bandwidthDB.MonthSummary(ctx, now)
+	if err != nil {
+		return nil, SNOServiceErr.Wrap(err)
+	}
+	data.Bandwidth = BandwidthInfo{
+		Used: bandwidthUsage,
+	}
+
+	payout, err := s.estimation.GetAllSatellitesEstimatedPayout(ctx, now)
+	if err != nil {
+		return nil, SNOServiceErr.Wrap(err)
+	}
+	data.Payout = AggregatePayout{
+		CurrentMonth:             payout.CurrentMonth.Payout,
+		CurrentMonthHeld:         payout.CurrentMonth.Held,
+		CurrentMonthExpectations: payout.CurrentMonthExpectations,
+		PreviousMonth:            payout.PreviousMonth.Payout,
+	}
+
+	alerts, err := s.GetAlerts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data.Alerts = len(alerts)
+	data.Status = "ok"
+	if len(alerts) > 0 {
+		// the alerts are sorted by the severity.
+		data.Status = string(alerts[0].Severity)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,190 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/reputation"
+)
+
+// AlertSeverity tells how urgent the alert is.
+type AlertSeverity string
+
+const (
+	// AlertWarning means that the node will get into trouble, unless the operator acts.
+	AlertWarning AlertSeverity = "warning"
+	// AlertCritical means that the node is already losing income.
+	AlertCritical AlertSeverity = "critical"
+)
+
+// AlertType is the kind of the problem of the node.
+type AlertType string
+
+const (
+	// AlertDisqualified is raised when the node is disqualified on a satellite.
+	AlertDisqualified AlertType = "disqualified"
+	// AlertSuspended is raised when the node is suspended for unknown audit errors.
+	AlertSuspended AlertType = "suspended"
+	// AlertOfflineSuspended is raised when the node is suspended for being offline.
+	AlertOfflineSuspended AlertType = "offline_suspended"
+	// AlertOfflineUnderReview is raised when the node is under review for being offline.
+	AlertOfflineUnderReview AlertType = "offline_under_review"
+	// AlertOnlineScoreDropping is raised when the online score is low or dropping.
+	AlertOnlineScoreDropping AlertType = "online_score_dropping"
+	// AlertAuditScoreLow is raised when the audit score approaches disqualification.
+	AlertAuditScoreLow AlertType = "audit_score_low"
+	// AlertSuspensionScoreLow is raised when the suspension score approaches suspension.
+	AlertSuspensionScoreLow AlertType = "suspension_score_low"
+	// AlertDiskNearlyFull is raised when most of the allocated space is used.
+	AlertDiskNearlyFull AlertType = "disk_nearly_full"
+	// AlertDiskFull is raised when the allocated space is used up.
+	AlertDiskFull AlertType = "disk_full"
+)
+
+const (
+	// onlineScoreWarning is the online score, below which the operator is warned.
+	onlineScoreWarning = 0.9
+	// onlineScoreDrop is the decrease of the online score during onlineScoreWindow, at which
+	// the operator is warned.
+	onlineScoreDrop = 0.02
+	// onlineScoreWindow is the period, in which the online score drop is measured.
+	onlineScoreWindow = 7 * 24 * time.Hour
+	// auditScoreWarning is the audit score, below which the operator is warned. The satellites
+	// disqualify the nodes at 0.96.
+	auditScoreWarning = 0.98
+	// suspensionScoreWarning is the suspension score, below which the operator is warned. The
+	// satellites suspend the nodes at 0.6.
+	suspensionScoreWarning = 0.7
+	// diskNearlyFull is the ratio of the used allocated space, at which the operator is warned.
+	diskNearlyFull = 0.9
+)
+
+// Alert is a problem of the node, which needs the attention of the operator.
+type Alert struct {
+	Type        AlertType     `json:"type"`
+	Severity    AlertSeverity `json:"severity"`
+	SatelliteID *storj.NodeID `json:"satelliteID,omitempty"`
+	Since       *time.Time    `json:"since,omitempty"`
+	Message     string        `json:"message"`
+}
+
+// GetAlerts returns the problems of the node on all the trusted satellites and of its disk,
+// the critical ones first.
+func (s *Service) GetAlerts(ctx context.Context) (alerts []Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+	now := time.Now().UTC()
+
+	for _, satelliteID := range s.trust.GetSatellites(ctx) {
+		stats, err := s.reputationDB.Get(ctx, satelliteID)
+		if err != nil {
+			return nil, SNOServiceErr.Wrap(err)
+		}
+
+		history, err := s.reputationDB.History(ctx, satelliteID, now.Add(-onlineScoreWindow), now)
+		if err != nil {
+			s.log.Warn("unable to get the score history", zap.Stringer("Satellite ID", satelliteID), zap.Error(SNOServiceErr.Wrap(err)))
+		}
+
+		alerts = append(alerts, reputationAlerts(*stats, history)...)
+	}
+
+	diskSpace, err := s.diskSpace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	alerts = append(alerts, diskSpaceAlerts(diskSpace)...)
+
+	return sortAlerts(alerts), nil
+}
+
+// reputationAlerts returns the alerts for the reputation of the node on a satellite.
+func reputationAlerts(stats reputation.Stats, history []reputation.DailyScores) (alerts []Alert) {
+	satelliteID := stats.SatelliteID
+	alert := func(typ AlertType, severity AlertSeverity, since *time.Time, format string, args ...interface{}) {
+		alerts = append(alerts, Alert{
+			Type:        typ,
+			Severity:    severity,
+			SatelliteID: &satelliteID,
+			Since:       since,
+			Message:     fmt.Sprintf(format, args...),
+		})
+	}
+
+	if stats.DisqualifiedAt != nil {
+		alert(AlertDisqualified, AlertCritical, stats.DisqualifiedAt, "the node is disqualified")
+		// the other alerts don't matter anymore.
+		return alerts
+	}
+	if stats.SuspendedAt != nil {
+		alert(AlertSuspended, AlertCritical, stats.SuspendedAt, "the node is suspended for failing audits with unknown errors, check the log for the audit errors")
+	}
+	if stats.OfflineSuspendedAt != nil {
+		alert(AlertOfflineSuspended, AlertCritical, stats.OfflineSuspendedAt, "the node is suspended for being offline, keep the node online to recover")
+	} else if stats.OfflineUnderReviewAt != nil {
+		alert(AlertOfflineUnderReview, AlertWarning, stats.OfflineUnderReviewAt, "the node is under review for being offline, keep the node online to avoid the suspension")
+	}
+
+	if stats.UpdatedAt.IsZero() {
+		// the scores weren't received from the satellite yet.
+		return alerts
+	}
+
+	if stats.OnlineScore < onlineScoreWarning {
+		alert(AlertOnlineScoreDropping, AlertWarning, nil, "the online score is %.2f%%, check the connectivity of the node", stats.OnlineScore*100)
+	} else if len(history) > 0 && history[0].OnlineScore-stats.OnlineScore >= onlineScoreDrop {
+		alert(AlertOnlineScoreDropping, AlertWarning, &history[0].Date, "the online score dropped from %.2f%% to %.2f%%, check the connectivity of the node", history[0].OnlineScore*100, stats.OnlineScore*100)
+	}
+	if stats.Audit.Score < auditScoreWarning {
+		alert(AlertAuditScoreLow, AlertWarning, nil, "the audit score is %.2f%%, the node is disqualified at 96%%: check the storage disk for lost or corrupted pieces", stats.Audit.Score*100)
+	}
+	if stats.SuspendedAt == nil && stats.Audit.UnknownScore < suspensionScoreWarning {
+		alert(AlertSuspensionScoreLow, AlertWarning, nil, "the suspension score is %.2f%%, the node is suspended at 60%%: check the log for the audit errors", stats.Audit.UnknownScore*100)
+	}
+
+	return alerts
+}
+
+// diskSpaceAlerts returns the alerts for the allocated disk space.
+func diskSpaceAlerts(diskSpace DiskSpaceInfo) []Alert {
+	if diskSpace.Available <= 0 {
+		return nil
+	}
+
+	used := diskSpace.Used + diskSpace.Trash
+	switch {
+	case used >= diskSpace.Available:
+		return []Alert{{
+			Type:     AlertDiskFull,
+			Severity: AlertCritical,
+			Message:  fmt.Sprintf("the node uses %s of the allocated %s and doesn't accept uploads, allocate more space", memory.Size(used), memory.Size(diskSpace.Available)),
+		}}
+	case float64(used) >= float64(diskSpace.Available)*diskNearlyFull:
+		return []Alert{{
+			Type:     AlertDiskNearlyFull,
+			Severity: AlertWarning,
+			Message:  fmt.Sprintf("the node uses %s of the allocated %s", memory.Size(used), memory.Size(diskSpace.Available)),
+		}}
+	}
+	return nil
+}
+
+// sortAlerts moves the critical alerts before the warnings, keeping their order otherwise.
+func sortAlerts(alerts []Alert) []Alert {
+	sorted := make([]Alert, 0, len(alerts))
+	for _, severity := range []AlertSeverity{AlertCritical, AlertWarning} {
+		for _, alert := range alerts {
+			if alert.Severity == severity {
+				sorted = append(sorted, alert)
+			}
+		}
+	}
+	return sorted
+}
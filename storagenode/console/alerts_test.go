@@ -0,0 +1,66 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/reputation"
+)
+
+func TestReputationAlerts(t *testing.T) {
+	now := time.Now().UTC()
+	healthy := reputation.Stats{
+		SatelliteID: testrand.NodeID(),
+		Audit:       reputation.Metric{Score: 1, UnknownScore: 1},
+		OnlineScore: 1,
+		UpdatedAt:   now,
+	}
+	types := func(alerts []Alert) (types []AlertType) {
+		for _, alert := range alerts {
+			types = append(types, alert.Type)
+		}
+		return types
+	}
+
+	require.Empty(t, reputationAlerts(healthy, nil))
+
+	stats := healthy
+	stats.DisqualifiedAt = &now
+	stats.Audit.Score = 0.5
+	require.Equal(t, []AlertType{AlertDisqualified}, types(reputationAlerts(stats, nil)))
+
+	stats = healthy
+	stats.OfflineUnderReviewAt = &now
+	stats.OnlineScore = 0.8
+	stats.Audit.Score = 0.97
+	stats.Audit.UnknownScore = 0.65
+	alerts := reputationAlerts(stats, nil)
+	require.Equal(t, []AlertType{AlertOfflineUnderReview, AlertOnlineScoreDropping, AlertAuditScoreLow, AlertSuspensionScoreLow}, types(alerts))
+	require.Equal(t, stats.SatelliteID, *alerts[0].SatelliteID)
+
+	// the online score is fine, but dropping.
+	stats = healthy
+	stats.OnlineScore = 0.95
+	history := []reputation.DailyScores{{Date: now.Add(-6 * 24 * time.Hour), OnlineScore: 0.99}}
+	alerts = reputationAlerts(stats, history)
+	require.Equal(t, []AlertType{AlertOnlineScoreDropping}, types(alerts))
+	require.Equal(t, history[0].Date, *alerts[0].Since)
+
+	// no scores from the satellite yet.
+	require.Empty(t, reputationAlerts(reputation.Stats{SatelliteID: testrand.NodeID()}, nil))
+}
+
+func TestDiskSpaceAlerts(t *testing.T) {
+	require.Empty(t, diskSpaceAlerts(DiskSpaceInfo{Used: 50, Trash: 10, Available: 100}))
+	require.Equal(t, AlertDiskNearlyFull, diskSpaceAlerts(DiskSpaceInfo{Used: 80, Trash: 10, Available: 100})[0].Type)
+	require.Equal(t, AlertDiskFull, diskSpaceAlerts(DiskSpaceInfo{Used: 100, Trash: 10, Available: 100})[0].Type)
+
+	sorted := sortAlerts([]Alert{{Type: AlertDiskNearlyFull, Severity: AlertWarning}, {Type: AlertSuspended, Severity: AlertCritical}})
+	require.Equal(t, AlertSuspended, sorted[0].Type)
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/storagenode/diagnostics"
+)
+
+// ErrDiagnosticsAPI - console diagnostics api error type.
+var ErrDiagnosticsAPI = errs.Class("consoleapi diagnostics")
+
+// Diagnostics is an api controller that serves the diagnostics bundle of the node.
+type Diagnostics struct {
+	log     *zap.Logger
+	service *diagnostics.Service
+}
+
+// NewDiagnostics is a constructor for the diagnostics controller.
+func NewDiagnostics(log *zap.Logger, service *diagnostics.Service) *Diagnostics {
+	return &Diagnostics{
+		log:     log,
+		service: service,
+	}
+}
+
+// Bundle serves the diagnostics bundle as a zip archive to download.
+func (controller *Diagnostics) Bundle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if controller.service == nil {
+		controller.serveJSONError(w, http.StatusNotFound, ErrDiagnosticsAPI.New("diagnostics are disabled"))
+		return
+	}
+
+	// the bundle is collected before writing the response, so the failures are
+	// reported with the error status.
+	var bundle bytes.Buffer
+	if err = controller.service.Write(ctx, &bundle); err != nil {
+		controller.serveJSONError(w, http.StatusInternalServerError, ErrDiagnosticsAPI.Wrap(err))
+		return
+	}
+
+	filename := fmt.Sprintf("storagenode-diagnostics-%s.zip", time.Now().UTC().Format("20060102-150405"))
+	w.Header().Set(contentType, "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.Header().Set("Content-Length", strconv.Itoa(bundle.Len()))
+
+	if _, err = bundle.WriteTo(w); err != nil {
+		controller.log.Debug("failed to write diagnostics bundle", zap.Error(ErrDiagnosticsAPI.Wrap(err)))
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (controller *Diagnostics) serveJSONError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set(contentType, applicationJSON)
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(ErrDiagnosticsAPI.Wrap(err)))
+		return
+	}
+}
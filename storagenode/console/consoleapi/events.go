@@ -0,0 +1,180 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/private/multinodeauth"
+	"storj.io/storj/storagenode/apikeys"
+	"storj.io/storj/storagenode/events"
+)
+
+// ErrEventsAPI - console events api error type.
+var ErrEventsAPI = errs.Class("consoleapi events")
+
+// applicationNDJSON is the content type of the event stream, a json document per line.
+const applicationNDJSON = "application/x-ndjson"
+
+// Events is an api controller that streams the transfer events of the node.
+type Events struct {
+	log     *zap.Logger
+	feed    *events.Feed
+	apiKeys *apikeys.Service
+}
+
+// NewEvents is a constructor for the events controller.
+func NewEvents(log *zap.Logger, feed *events.Feed, apiKeys *apikeys.Service) *Events {
+	return &Events{
+		log:     log,
+		feed:    feed,
+		apiKeys: apiKeys,
+	}
+}
+
+// Stream writes the recent events matching the query as a json document per line.
+// With follow=true the connection is kept open and the new events are written as they
+// happen. The request is authenticated with the api key of the node in the
+// Authorization header, e.g. "Authorization: Bearer <api key>".
+//
+// The events are filtered with the query parameters type (comma separated list of
+// upload, download, audit, repair, delete and gc), satellite and since (RFC 3339).
+func (controller *Events) Stream(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if err = controller.authenticate(r); err != nil {
+		controller.serveJSONError(w, http.StatusUnauthorized, ErrEventsAPI.Wrap(err))
+		return
+	}
+
+	if controller.feed == nil {
+		controller.serveJSONError(w, http.StatusNotFound, ErrEventsAPI.New("event feed is disabled"))
+		return
+	}
+
+	filter, follow, err := parseEventsQuery(r)
+	if err != nil {
+		controller.serveJSONError(w, http.StatusBadRequest, ErrEventsAPI.Wrap(err))
+		return
+	}
+
+	w.Header().Set(contentType, applicationNDJSON)
+	w.Header().Set("Cache-Control", "no-cache")
+
+	flusher, _ := w.(http.Flusher)
+	encoder := json.NewEncoder(w)
+
+	if !follow {
+		for _, event := range controller.feed.Recent(filter) {
+			if err = encoder.Encode(event); err != nil {
+				return
+			}
+		}
+		return
+	}
+
+	recent, stream, unsubscribe := controller.feed.Subscribe(filter)
+	defer unsubscribe()
+
+	for _, event := range recent {
+		if err = encoder.Encode(event); err != nil {
+			return
+		}
+	}
+	if flusher != nil {
+		flusher.Flush()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-stream:
+			if !ok {
+				return
+			}
+			if err = encoder.Encode(event); err != nil {
+				return
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+	}
+}
+
+// authenticate checks the api key of the request.
+func (controller *Events) authenticate(r *http.Request) error {
+	if controller.apiKeys == nil {
+		return errs.New("api keys are not available")
+	}
+
+	authorization := r.Header.Get("Authorization")
+	token := strings.TrimPrefix(authorization, "Bearer ")
+	if authorization == "" || token == authorization {
+		return errs.New("missing api key")
+	}
+
+	secret, err := multinodeauth.SecretFromBase64(token)
+	if err != nil {
+		return errs.New("invalid api key")
+	}
+	if err := controller.apiKeys.Check(r.Context(), secret); err != nil {
+		return errs.New("invalid api key")
+	}
+	return nil
+}
+
+// parseEventsQuery parses the filter of the events from the query of the request.
+func parseEventsQuery(r *http.Request) (filter events.Filter, follow bool, err error) {
+	query := r.URL.Query()
+
+	filter.Types, err = events.ParseTypes(query.Get("type"))
+	if err != nil {
+		return events.Filter{}, false, err
+	}
+
+	if satellite := query.Get("satellite"); satellite != "" {
+		filter.SatelliteID, err = storj.NodeIDFromString(satellite)
+		if err != nil {
+			return events.Filter{}, false, errs.New("invalid satellite id: %v", err)
+		}
+	}
+
+	if since := query.Get("since"); since != "" {
+		filter.Since, err = time.Parse(time.RFC3339Nano, since)
+		if err != nil {
+			return events.Filter{}, false, errs.New("invalid since: %v", err)
+		}
+	}
+
+	return filter, query.Get("follow") == "true", nil
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (controller *Events) serveJSONError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set(contentType, applicationJSON)
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(ErrEventsAPI.Wrap(err)))
+		return
+	}
+}
@@ -13,7 +13,10 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
 	"storj.io/storj/storagenode/console"
+	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/nodestats"
 )
 
 // ErrStorageNodeAPI - console storagenode api error type.
@@ -155,6 +158,27 @@ func (dashboard *StorageNode) EstimatedPayout(w http.ResponseWriter, r *http.Req
 	}
 }
 
+// ProjectedPayouts returns the projected payouts of the current month per satellite, including
+// the surge and the held amount.
+func (dashboard *StorageNode) ProjectedPayouts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	data, err := dashboard.service.GetProjectedPayouts(ctx, time.Now())
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusInternalServerError, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		dashboard.log.Error("failed to encode json response", zap.Error(ErrStorageNodeAPI.Wrap(err)))
+		return
+	}
+}
+
 // Pricing returns pricing model for specific satellite.
 func (dashboard *StorageNode) Pricing(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -187,6 +211,356 @@ func (dashboard *StorageNode) Pricing(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// UnsettledOrders returns the unsent orders, their estimated value and the settlement failures
+// per satellite.
+func (dashboard *StorageNode) UnsettledOrders(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	data, err := dashboard.service.GetUnsettledOrders(ctx)
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusInternalServerError, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		dashboard.log.Error("failed to encode json response", zap.Error(ErrStorageNodeAPI.Wrap(err)))
+		return
+	}
+}
+
+// Aggregate handles the totals of the node across all the satellites.
+func (dashboard *StorageNode) Aggregate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	data, err := dashboard.service.GetAggregate(ctx)
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusInternalServerError, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		dashboard.log.Error("failed to encode json response", zap.Error(ErrStorageNodeAPI.Wrap(err)))
+		return
+	}
+}
+
+// Alerts handles the problems of the node, which need the attention of the operator.
+func (dashboard *StorageNode) Alerts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	alerts, err := dashboard.service.GetAlerts(ctx)
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusInternalServerError, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+	if alerts == nil {
+		alerts = []console.Alert{}
+	}
+
+	if err := json.NewEncoder(w).Encode(alerts); err != nil {
+		dashboard.log.Error("failed to encode json response", zap.Error(ErrStorageNodeAPI.Wrap(err)))
+		return
+	}
+}
+
+// BandwidthLimits returns the rate limits of the piece transfers.
+func (dashboard *StorageNode) BandwidthLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	data, err := dashboard.service.GetBandwidthLimits(ctx)
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusInternalServerError, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		dashboard.log.Error("failed to encode json response", zap.Error(ErrStorageNodeAPI.Wrap(err)))
+		return
+	}
+}
+
+// SetBandwidthLimits changes the rate limits of the piece transfers.
+func (dashboard *StorageNode) SetBandwidthLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	var limits console.BandwidthLimits
+	if err = json.NewDecoder(r.Body).Decode(&limits); err != nil {
+		dashboard.serveJSONError(w, http.StatusBadRequest, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+	if limits.Global.Ingress < 0 || limits.Global.Egress < 0 {
+		dashboard.serveJSONError(w, http.StatusBadRequest, ErrStorageNodeAPI.New("limits can't be negative"))
+		return
+	}
+	for _, satellite := range limits.Satellites {
+		if satellite.Ingress < 0 || satellite.Egress < 0 {
+			dashboard.serveJSONError(w, http.StatusBadRequest, ErrStorageNodeAPI.New("limits can't be negative"))
+			return
+		}
+	}
+
+	if err = dashboard.service.SetBandwidthLimits(ctx, limits); err != nil {
+		dashboard.serveJSONError(w, http.StatusInternalServerError, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	dashboard.BandwidthLimits(w, r)
+}
+
+// UploadRejections returns the number of the rejected uploads per reason and per satellite.
+func (dashboard *StorageNode) UploadRejections(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	data, err := dashboard.service.GetUploadRejections(ctx)
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusInternalServerError, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		dashboard.log.Error("failed to encode json response", zap.Error(ErrStorageNodeAPI.Wrap(err)))
+		return
+	}
+}
+
+// Appeals returns the appeals of the node on the satellite, the newest first.
+func (dashboard *StorageNode) Appeals(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	satelliteID, err := storj.NodeIDFromString(mux.Vars(r)["id"])
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusBadRequest, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	data, err := dashboard.service.ListAppeals(ctx, satelliteID)
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusInternalServerError, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		dashboard.log.Error("failed to encode json response", zap.Error(ErrStorageNodeAPI.Wrap(err)))
+		return
+	}
+}
+
+// FileAppeal files an appeal of the suspension or the disqualification of the node on the satellite.
+func (dashboard *StorageNode) FileAppeal(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	satelliteID, err := storj.NodeIDFromString(mux.Vars(r)["id"])
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusBadRequest, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	var request struct {
+		Message string `json:"message"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		dashboard.serveJSONError(w, http.StatusBadRequest, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	data, err := dashboard.service.FileAppeal(ctx, satelliteID, request.Message)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if nodestats.ErrAppealRefused.Has(err) {
+			status = http.StatusBadRequest
+		}
+		dashboard.serveJSONError(w, status, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		dashboard.log.Error("failed to encode json response", zap.Error(ErrStorageNodeAPI.Wrap(err)))
+		return
+	}
+}
+
+// Maintenance returns the maintenance windows of the node on the satellite, which end in the
+// current month or later.
+func (dashboard *StorageNode) Maintenance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	satelliteID, err := storj.NodeIDFromString(mux.Vars(r)["id"])
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusBadRequest, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	data, err := dashboard.service.ListMaintenance(ctx, satelliteID)
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusInternalServerError, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		dashboard.log.Error("failed to encode json response", zap.Error(ErrStorageNodeAPI.Wrap(err)))
+		return
+	}
+}
+
+// ScheduleMaintenance schedules a maintenance window of the node on the satellite.
+func (dashboard *StorageNode) ScheduleMaintenance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	satelliteID, err := storj.NodeIDFromString(mux.Vars(r)["id"])
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusBadRequest, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	var request struct {
+		StartsAt time.Time `json:"startsAt"`
+		EndsAt   time.Time `json:"endsAt"`
+		Reason   string    `json:"reason"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		dashboard.serveJSONError(w, http.StatusBadRequest, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	data, err := dashboard.service.ScheduleMaintenance(ctx, satelliteID, request.StartsAt, request.EndsAt, request.Reason)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if nodestats.ErrMaintenanceRefused.Has(err) {
+			status = http.StatusBadRequest
+		}
+		dashboard.serveJSONError(w, status, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		dashboard.log.Error("failed to encode json response", zap.Error(ErrStorageNodeAPI.Wrap(err)))
+		return
+	}
+}
+
+// CancelMaintenance cancels the maintenance window of the node on the satellite, which hasn't
+// started yet.
+func (dashboard *StorageNode) CancelMaintenance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	satelliteID, err := storj.NodeIDFromString(mux.Vars(r)["id"])
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusBadRequest, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	windowID, err := uuid.FromString(mux.Vars(r)["windowId"])
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusBadRequest, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	err = dashboard.service.CancelMaintenance(ctx, satelliteID, windowID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if nodestats.ErrMaintenanceNotFound.Has(err) {
+			status = http.StatusNotFound
+		}
+		dashboard.serveJSONError(w, status, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+}
+
+// OperatorMessages returns the messages of the operators of the satellites, the newest first.
+func (dashboard *StorageNode) OperatorMessages(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	data, err := dashboard.service.GetOperatorMessages(ctx)
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusInternalServerError, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		dashboard.log.Error("failed to encode json response", zap.Error(ErrStorageNodeAPI.Wrap(err)))
+		return
+	}
+}
+
+// AcknowledgeOperatorMessage acknowledges the message of the operators of the satellite.
+func (dashboard *StorageNode) AcknowledgeOperatorMessage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	satelliteID, err := storj.NodeIDFromString(mux.Vars(r)["id"])
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusBadRequest, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	messageID, err := uuid.FromString(mux.Vars(r)["messageId"])
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusBadRequest, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	err = dashboard.service.AcknowledgeOperatorMessage(ctx, satelliteID, messageID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if contact.ErrMessageNotFound.Has(err) {
+			status = http.StatusNotFound
+		}
+		dashboard.serveJSONError(w, status, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (dashboard *StorageNode) serveJSONError(w http.ResponseWriter, status int, err error) {
 	w.WriteHeader(status)
@@ -19,8 +19,11 @@ import (
 
 	"storj.io/common/errs2"
 	"storj.io/storj/private/web"
+	"storj.io/storj/storagenode/apikeys"
 	"storj.io/storj/storagenode/console"
 	"storj.io/storj/storagenode/console/consoleapi"
+	"storj.io/storj/storagenode/diagnostics"
+	"storj.io/storj/storagenode/events"
 	"storj.io/storj/storagenode/notifications"
 	"storj.io/storj/storagenode/payouts"
 )
@@ -46,6 +49,9 @@ type Server struct {
 	service       *console.Service
 	notifications *notifications.Service
 	payout        *payouts.Service
+	events        *events.Feed
+	apiKeys       *apikeys.Service
+	diagnostics   *diagnostics.Service
 	listener      net.Listener
 	assets        fs.FS
 
@@ -53,7 +59,7 @@ type Server struct {
 }
 
 // NewServer creates new instance of storagenode console web server.
-func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Service, service *console.Service, payout *payouts.Service, listener net.Listener) *Server {
+func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Service, service *console.Service, payout *payouts.Service, events *events.Feed, apiKeys *apikeys.Service, diagnostics *diagnostics.Service, listener net.Listener) *Server {
 	server := Server{
 		log:           logger,
 		service:       service,
@@ -61,6 +67,9 @@ func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Se
 		assets:        assets,
 		notifications: notifications,
 		payout:        payout,
+		events:        events,
+		apiKeys:       apiKeys,
+		diagnostics:   diagnostics,
 	}
 
 	router := mux.NewRouter()
@@ -73,7 +82,24 @@ func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Se
 	storageNodeRouter.HandleFunc("/satellites", storageNodeController.Satellites).Methods(http.MethodGet)
 	storageNodeRouter.HandleFunc("/satellite/{id}", storageNodeController.Satellite).Methods(http.MethodGet)
 	storageNodeRouter.HandleFunc("/satellites/{id}/pricing", storageNodeController.Pricing).Methods(http.MethodGet)
+	storageNodeRouter.HandleFunc("/satellites/{id}/appeals", storageNodeController.Appeals).Methods(http.MethodGet)
+	storageNodeRouter.HandleFunc("/satellites/{id}/appeals", storageNodeController.FileAppeal).Methods(http.MethodPost)
+	storageNodeRouter.HandleFunc("/satellites/{id}/maintenance", storageNodeController.Maintenance).Methods(http.MethodGet)
+	storageNodeRouter.HandleFunc("/satellites/{id}/maintenance", storageNodeController.ScheduleMaintenance).Methods(http.MethodPost)
+	storageNodeRouter.HandleFunc("/satellites/{id}/maintenance/{windowId}", storageNodeController.CancelMaintenance).Methods(http.MethodDelete)
+	storageNodeRouter.HandleFunc("/satellites/{id}/messages/{messageId}/acknowledge", storageNodeController.AcknowledgeOperatorMessage).Methods(http.MethodPost)
+	storageNodeRouter.HandleFunc("/operator-messages", storageNodeController.OperatorMessages).Methods(http.MethodGet)
 	storageNodeRouter.HandleFunc("/estimated-payout", storageNodeController.EstimatedPayout).Methods(http.MethodGet)
+	storageNodeRouter.HandleFunc("/projected-payouts", storageNodeController.ProjectedPayouts).Methods(http.MethodGet)
+	storageNodeRouter.HandleFunc("/unsettled-orders", storageNodeController.UnsettledOrders).Methods(http.MethodGet)
+	storageNodeRouter.HandleFunc("/aggregate", storageNodeController.Aggregate).Methods(http.MethodGet)
+	storageNodeRouter.HandleFunc("/alerts", storageNodeController.Alerts).Methods(http.MethodGet)
+	storageNodeRouter.HandleFunc("/bandwidth-limits", storageNodeController.BandwidthLimits).Methods(http.MethodGet)
+	storageNodeRouter.HandleFunc("/bandwidth-limits", storageNodeController.SetBandwidthLimits).Methods(http.MethodPut)
+	storageNodeRouter.HandleFunc("/upload-rejections", storageNodeController.UploadRejections).Methods(http.MethodGet)
+
+	diagnosticsController := consoleapi.NewDiagnostics(server.log, server.diagnostics)
+	storageNodeRouter.HandleFunc("/diagnostics", diagnosticsController.Bundle).Methods(http.MethodGet)
 
 	notificationController := consoleapi.NewNotifications(server.log, server.notifications)
 	notificationRouter := router.PathPrefix("/api/notifications").Subrouter()
@@ -82,6 +108,9 @@ func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Se
 	notificationRouter.HandleFunc("/{id}/read", notificationController.ReadNotification).Methods(http.MethodPost)
 	notificationRouter.HandleFunc("/readall", notificationController.ReadAllNotifications).Methods(http.MethodPost)
 
+	eventsController := consoleapi.NewEvents(server.log, server.events, server.apiKeys)
+	router.HandleFunc("/api/events", eventsController.Stream).Methods(http.MethodGet)
+
 	payoutController := consoleapi.NewPayout(server.log, server.payout)
 	payoutRouter := router.PathPrefix("/api/heldamount").Subrouter()
 	payoutRouter.StrictSlash(true)
@@ -14,16 +14,24 @@ import (
 
 	"storj.io/common/memory"
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
 	"storj.io/private/version"
 	"storj.io/storj/private/date"
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/diskhealth"
+	"storj.io/storj/storagenode/nodestats"
 	"storj.io/storj/storagenode/operator"
+	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/payouts/estimatedpayouts"
 	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/pieces/lazyfilewalker"
+	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/piecestore/shaping"
 	"storj.io/storj/storagenode/pricing"
 	"storj.io/storj/storagenode/reputation"
+	"storj.io/storj/storagenode/retain"
 	"storj.io/storj/storagenode/satellites"
 	"storj.io/storj/storagenode/storageusage"
 	"storj.io/storj/storagenode/trust"
@@ -36,6 +44,9 @@ var (
 	mon = monkit.Package()
 )
 
+// reputationHistoryDays is the number of the days of the score history in the satellite data.
+const reputationHistoryDays = 90
+
 // Service is handling storage node operator related logic.
 //
 // architecture: Service
@@ -64,6 +75,14 @@ type Service struct {
 
 	quicStats      *contact.QUICStats
 	configuredPort string
+
+	retain     *retain.Service
+	diskHealth *diskhealth.Service
+	shaper     *shaping.Shaper
+	orders     *orders.Service
+	transports *contact.TransportStats
+	rejections *piecestore.UploadRejections
+	nodestats  *nodestats.Service
 }
 
 // NewService returns new instance of Service.
@@ -71,7 +90,7 @@ func NewService(log *zap.Logger, bandwidth bandwidth.DB, pieceStore *pieces.Stor
 	allocatedDiskSpace memory.Size, walletAddress string, versionInfo version.Info, trust *trust.Pool,
 	reputationDB reputation.DB, storageUsageDB storageusage.DB, pricingDB pricing.DB, satelliteDB satellites.DB,
 	pingStats *contact.PingStats, contact *contact.Service, estimation *estimatedpayouts.Service, usageCache *pieces.BlobsUsageCache,
-	walletFeatures operator.WalletFeatures, port string, quicStats *contact.QUICStats) (*Service, error) {
+	walletFeatures operator.WalletFeatures, port string, quicStats *contact.QUICStats, retain *retain.Service) (*Service, error) {
 	if log == nil {
 		return nil, errs.New("log can't be nil")
 	}
@@ -125,9 +144,176 @@ func NewService(log *zap.Logger, bandwidth bandwidth.DB, pieceStore *pieces.Stor
 		walletFeatures:     walletFeatures,
 		quicStats:          quicStats,
 		configuredPort:     port,
+		retain:             retain,
 	}, nil
 }
 
+// SetDiskHealth sets the disk health service, whose status is shown in the dashboard.
+func (s *Service) SetDiskHealth(diskHealth *diskhealth.Service) {
+	s.diskHealth = diskHealth
+}
+
+// SetShaper sets the shaper of the piece transfers, whose limits can be changed with the api.
+func (s *Service) SetShaper(shaper *shaping.Shaper) {
+	s.shaper = shaper
+}
+
+// SetOrders sets the orders service, whose unsettled orders are shown in the dashboard.
+func (s *Service) SetOrders(orders *orders.Service) {
+	s.orders = orders
+}
+
+// SetTransportStats sets the statistics of the transports, which are shown in the dashboard.
+func (s *Service) SetTransportStats(transports *contact.TransportStats) {
+	s.transports = transports
+}
+
+// SetUploadRejections sets the statistics of the rejected uploads, which are shown in the dashboard.
+func (s *Service) SetUploadRejections(rejections *piecestore.UploadRejections) {
+	s.rejections = rejections
+}
+
+// GetUploadRejections returns the number of the rejected uploads per reason and per satellite.
+func (s *Service) GetUploadRejections(ctx context.Context) (_ piecestore.UploadRejectionStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if s.rejections == nil {
+		return piecestore.UploadRejectionStats{}, SNOServiceErr.New("upload rejection statistics are not available")
+	}
+	return s.rejections.Stats(), nil
+}
+
+// SetNodeStats sets the node stats service, which files the appeals of the suspended or
+// disqualified node to the satellites.
+func (s *Service) SetNodeStats(nodestats *nodestats.Service) {
+	s.nodestats = nodestats
+}
+
+// FileAppeal files an appeal of the suspension or the disqualification of the node on the satellite.
+func (s *Service) FileAppeal(ctx context.Context, satelliteID storj.NodeID, message string) (_ nodestats.Appeal, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if s.nodestats == nil {
+		return nodestats.Appeal{}, SNOServiceErr.New("appeals are not available")
+	}
+	return s.nodestats.FileAppeal(ctx, satelliteID, message)
+}
+
+// ListAppeals returns the appeals of the node on the satellite, the newest first.
+func (s *Service) ListAppeals(ctx context.Context, satelliteID storj.NodeID) (_ []nodestats.Appeal, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if s.nodestats == nil {
+		return nil, SNOServiceErr.New("appeals are not available")
+	}
+	return s.nodestats.ListAppeals(ctx, satelliteID)
+}
+
+// ScheduleMaintenance schedules a maintenance window of the node on the satellite.
+func (s *Service) ScheduleMaintenance(ctx context.Context, satelliteID storj.NodeID, startsAt, endsAt time.Time, reason string) (_ nodestats.MaintenanceWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if s.nodestats == nil {
+		return nodestats.MaintenanceWindow{}, SNOServiceErr.New("maintenance windows are not available")
+	}
+	return s.nodestats.ScheduleMaintenance(ctx, satelliteID, startsAt, endsAt, reason)
+}
+
+// ListMaintenance returns the maintenance windows of the node on the satellite, which end in
+// the current month or later.
+func (s *Service) ListMaintenance(ctx context.Context, satelliteID storj.NodeID) (_ []nodestats.MaintenanceWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if s.nodestats == nil {
+		return nil, SNOServiceErr.New("maintenance windows are not available")
+	}
+	return s.nodestats.ListMaintenance(ctx, satelliteID)
+}
+
+// CancelMaintenance cancels the maintenance window of the node on the satellite, which hasn't
+// started yet.
+func (s *Service) CancelMaintenance(ctx context.Context, satelliteID storj.NodeID, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if s.nodestats == nil {
+		return SNOServiceErr.New("maintenance windows are not available")
+	}
+	return s.nodestats.CancelMaintenance(ctx, satelliteID, id)
+}
+
+// GetOperatorMessages returns the messages of the operators of the satellites, the newest first.
+func (s *Service) GetOperatorMessages(ctx context.Context) (_ []contact.OperatorMessage, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.contact.OperatorMessages(), nil
+}
+
+// AcknowledgeOperatorMessage acknowledges the message of the operators of the satellite.
+func (s *Service) AcknowledgeOperatorMessage(ctx context.Context, satelliteID storj.NodeID, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.contact.AcknowledgeMessage(ctx, satelliteID, id)
+}
+
+// BandwidthLimits holds the rate limits of the piece transfers.
+type BandwidthLimits struct {
+	Global     shaping.Limits            `json:"global"`
+	Satellites []shaping.SatelliteLimits `json:"satellites"`
+}
+
+// GetBandwidthLimits returns the rate limits of the piece transfers.
+func (s *Service) GetBandwidthLimits(ctx context.Context) (_ BandwidthLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if s.shaper == nil {
+		return BandwidthLimits{}, nil
+	}
+	var limits BandwidthLimits
+	limits.Global, limits.Satellites = s.shaper.Limits()
+	return limits, nil
+}
+
+// SetBandwidthLimits changes the rate limits of the piece transfers until the node is restarted.
+func (s *Service) SetBandwidthLimits(ctx context.Context, limits BandwidthLimits) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if s.shaper == nil {
+		return SNOServiceErr.New("bandwidth shaping is not available")
+	}
+	s.shaper.SetLimits(limits.Global, limits.Satellites)
+	return nil
+}
+
+// UnsettledOrders is the state of the unsent orders of a satellite, with the estimated value
+// of the orders in cents.
+type UnsettledOrders struct {
+	orders.SettlementStatus
+	EstimatedValue float64 `json:"estimatedValue"`
+}
+
+// GetUnsettledOrders returns the unsent orders and the settlement failures per satellite.
+func (s *Service) GetUnsettledOrders(ctx context.Context) (_ []UnsettledOrders, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if s.orders == nil {
+		return nil, nil
+	}
+
+	statuses, err := s.orders.SettlementStatuses(ctx)
+	if err != nil {
+		return nil, SNOServiceErr.Wrap(err)
+	}
+
+	unsettled := make([]UnsettledOrders, 0, len(statuses))
+	for _, status := range statuses {
+		priceModel, err := s.pricingDB.Get(ctx, status.SatelliteID)
+		if err != nil {
+			return nil, SNOServiceErr.Wrap(err)
+		}
+
+		// the prices are per TB.
+		value := float64(status.Egress)*float64(priceModel.EgressBandwidth) +
+			float64(status.Repair)*float64(priceModel.RepairBandwidth) +
+			float64(status.Audit)*float64(priceModel.AuditBandwidth)
+
+		unsettled = append(unsettled, UnsettledOrders{
+			SettlementStatus: status,
+			EstimatedValue:   estimatedpayouts.RoundFloat(value / math.Pow10(12)),
+		})
+	}
+
+	return unsettled, nil
+}
+
 // SatelliteInfo encapsulates satellite ID and disqualification.
 type SatelliteInfo struct {
 	ID                 storj.NodeID `json:"id"`
@@ -135,6 +321,35 @@ type SatelliteInfo struct {
 	Disqualified       *time.Time   `json:"disqualified"`
 	Suspended          *time.Time   `json:"suspended"`
 	CurrentStorageUsed int64        `json:"currentStorageUsed"`
+	// Quota is nil, when the allocated space of the satellite isn't limited.
+	Quota *SatelliteQuota `json:"quota"`
+}
+
+// SatelliteQuota is the allocated space limit of a satellite and the space used
+// by its pieces, which counts towards the limit. The uploads of the satellite
+// are rejected, when the limit is reached.
+type SatelliteQuota struct {
+	Allocated int64 `json:"allocated"`
+	Used      int64 `json:"used"`
+	Available int64 `json:"available"`
+}
+
+// satelliteQuota returns the quota of the satellite, or nil, when it isn't limited.
+func (s *Service) satelliteQuota(satelliteID storj.NodeID, used int64) *SatelliteQuota {
+	allocated, ok := s.trust.Quota(satelliteID)
+	if !ok {
+		return nil
+	}
+
+	quota := &SatelliteQuota{
+		Allocated: allocated.Int64(),
+		Used:      used,
+		Available: allocated.Int64() - used,
+	}
+	if quota.Available < 0 {
+		quota.Available = 0
+	}
+	return quota
 }
 
 // Dashboard encapsulates dashboard stale data.
@@ -159,6 +374,14 @@ type Dashboard struct {
 	ConfiguredPort   string    `json:"configuredPort"`
 	QUICStatus       string    `json:"quicStatus"`
 	LastQUICPingedAt time.Time `json:"lastQuicPingedAt"`
+
+	Transports []contact.TransportStatus `json:"transports"`
+	// PreferTCP is whether the outgoing connections use only tcp, because quic works worse.
+	PreferTCP bool `json:"preferTCP"`
+
+	Filewalkers []lazyfilewalker.Progress `json:"filewalkers"`
+	Retain      []retain.JobStatus        `json:"retain"`
+	DiskHealth  *diskhealth.Status        `json:"diskHealth"`
 }
 
 // GetDashboardData returns stale dashboard data.
@@ -178,6 +401,17 @@ func (s *Service) GetDashboardData(ctx context.Context) (_ *Dashboard, err error
 	data.QUICStatus = s.quicStats.Status()
 	data.LastQUICPingedAt = s.quicStats.WhenLastPinged()
 	data.ConfiguredPort = s.configuredPort
+	if s.transports != nil {
+		data.Transports = s.transports.Status()
+		data.PreferTCP = s.transports.PreferTCP()
+	}
+	data.Filewalkers = s.pieceStore.LazyFilewalkerProgress()
+	if s.retain != nil {
+		data.Retain = s.retain.Jobs()
+	}
+	if s.diskHealth != nil {
+		data.DiskHealth = s.diskHealth.Status()
+	}
 
 	stats, err := s.reputationDB.All(ctx)
 	if err != nil {
@@ -191,7 +425,7 @@ func (s *Service) GetDashboardData(ctx context.Context) (_ *Dashboard, err error
 				zap.Error(SNOServiceErr.Wrap(err)))
 			continue
 		}
-		_, currentStorageUsed, err := s.usageCache.SpaceUsedBySatellite(ctx, rep.SatelliteID)
+		piecesTotal, currentStorageUsed, err := s.usageCache.SpaceUsedBySatellite(ctx, rep.SatelliteID)
 		if err != nil {
 			s.log.Warn("unable to get Satellite Current Storage Used", zap.String("Satellite ID", rep.SatelliteID.String()),
 				zap.Error(SNOServiceErr.Wrap(err)))
@@ -205,26 +439,41 @@ func (s *Service) GetDashboardData(ctx context.Context) (_ *Dashboard, err error
 				Suspended:          rep.SuspendedAt,
 				URL:                url.Address,
 				CurrentStorageUsed: currentStorageUsed,
+				Quota:              s.satelliteQuota(rep.SatelliteID, piecesTotal),
 			},
 		)
 	}
 
-	pieceTotal, _, err := s.pieceStore.SpaceUsedForPieces(ctx)
+	data.DiskSpace, err = s.diskSpace(ctx)
 	if err != nil {
-		return nil, SNOServiceErr.Wrap(err)
+		return nil, err
 	}
 
-	trash, err := s.pieceStore.SpaceUsedForTrash(ctx)
+	bandwidthUsage, err := s.bandwidthDB.MonthSummary(ctx, time.Now())
 	if err != nil {
 		return nil, SNOServiceErr.Wrap(err)
 	}
 
-	bandwidthUsage, err := s.bandwidthDB.MonthSummary(ctx, time.Now())
+	data.Bandwidth = BandwidthInfo{
+		Used: bandwidthUsage,
+	}
+
+	return data, nil
+}
+
+// diskSpace returns the space used by the pieces and the trash.
+func (s *Service) diskSpace(ctx context.Context) (_ DiskSpaceInfo, err error) {
+	pieceTotal, _, err := s.pieceStore.SpaceUsedForPieces(ctx)
 	if err != nil {
-		return nil, SNOServiceErr.Wrap(err)
+		return DiskSpaceInfo{}, SNOServiceErr.Wrap(err)
 	}
 
-	data.DiskSpace = DiskSpaceInfo{
+	trash, err := s.pieceStore.SpaceUsedForTrash(ctx)
+	if err != nil {
+		return DiskSpaceInfo{}, SNOServiceErr.Wrap(err)
+	}
+
+	diskSpace := DiskSpaceInfo{
 		Used:      pieceTotal,
 		Available: s.allocatedDiskSpace.Int64(),
 		Trash:     trash,
@@ -232,14 +481,10 @@ func (s *Service) GetDashboardData(ctx context.Context) (_ *Dashboard, err error
 
 	overused := s.allocatedDiskSpace.Int64() - pieceTotal - trash
 	if overused < 0 {
-		data.DiskSpace.Overused = int64(math.Abs(float64(overused)))
-	}
-
-	data.Bandwidth = BandwidthInfo{
-		Used: bandwidthUsage,
+		diskSpace.Overused = int64(math.Abs(float64(overused)))
 	}
 
-	return data, nil
+	return diskSpace, nil
 }
 
 // PriceModel is a satellite prices for storagenode usage TB/H.
@@ -252,19 +497,22 @@ type PriceModel struct {
 
 // Satellite encapsulates satellite related data.
 type Satellite struct {
-	ID                 storj.NodeID            `json:"id"`
-	StorageDaily       []storageusage.Stamp    `json:"storageDaily"`
-	BandwidthDaily     []bandwidth.UsageRollup `json:"bandwidthDaily"`
-	StorageSummary     float64                 `json:"storageSummary"`
-	AverageUsageBytes  float64                 `json:"averageUsageBytes"`
-	BandwidthSummary   int64                   `json:"bandwidthSummary"`
-	EgressSummary      int64                   `json:"egressSummary"`
-	IngressSummary     int64                   `json:"ingressSummary"`
-	CurrentStorageUsed int64                   `json:"currentStorageUsed"`
-	Audits             Audits                  `json:"audits"`
-	AuditHistory       reputation.AuditHistory `json:"auditHistory"`
-	PriceModel         PriceModel              `json:"priceModel"`
-	NodeJoinedAt       time.Time               `json:"nodeJoinedAt"`
+	ID                 storj.NodeID             `json:"id"`
+	StorageDaily       []storageusage.Stamp     `json:"storageDaily"`
+	BandwidthDaily     []bandwidth.UsageRollup  `json:"bandwidthDaily"`
+	StorageSummary     float64                  `json:"storageSummary"`
+	AverageUsageBytes  float64                  `json:"averageUsageBytes"`
+	BandwidthSummary   int64                    `json:"bandwidthSummary"`
+	EgressSummary      int64                    `json:"egressSummary"`
+	IngressSummary     int64                    `json:"ingressSummary"`
+	CurrentStorageUsed int64                    `json:"currentStorageUsed"`
+	Quota              *SatelliteQuota          `json:"quota"`
+	Audits             Audits                   `json:"audits"`
+	AuditHistory       reputation.AuditHistory  `json:"auditHistory"`
+	ReputationHistory  []reputation.DailyScores `json:"reputationHistory"`
+	Containment        reputation.Containment   `json:"containment"`
+	PriceModel         PriceModel               `json:"priceModel"`
+	NodeJoinedAt       time.Time                `json:"nodeJoinedAt"`
 }
 
 // GetSatelliteData returns satellite related data.
@@ -302,7 +550,7 @@ func (s *Service) GetSatelliteData(ctx context.Context, satelliteID storj.NodeID
 		return nil, SNOServiceErr.Wrap(err)
 	}
 
-	_, currentStorageUsed, err := s.usageCache.SpaceUsedBySatellite(ctx, satelliteID)
+	piecesTotal, currentStorageUsed, err := s.usageCache.SpaceUsedBySatellite(ctx, satelliteID)
 	if err != nil {
 		return nil, SNOServiceErr.Wrap(err)
 	}
@@ -312,6 +560,12 @@ func (s *Service) GetSatelliteData(ctx context.Context, satelliteID storj.NodeID
 		return nil, SNOServiceErr.Wrap(err)
 	}
 
+	now := time.Now().UTC()
+	reputationHistory, err := s.reputationDB.History(ctx, satelliteID, now.AddDate(0, 0, -reputationHistoryDays), now)
+	if err != nil {
+		return nil, SNOServiceErr.Wrap(err)
+	}
+
 	pricingModel, err := s.pricingDB.Get(ctx, satelliteID)
 	if err != nil {
 		return nil, SNOServiceErr.Wrap(err)
@@ -338,6 +592,7 @@ func (s *Service) GetSatelliteData(ctx context.Context, satelliteID storj.NodeID
 		AverageUsageBytes:  averageUsageInBytes,
 		BandwidthSummary:   bandwidthSummary.Total(),
 		CurrentStorageUsed: currentStorageUsed,
+		Quota:              s.satelliteQuota(satelliteID, piecesTotal),
 		EgressSummary:      egressSummary.Total(),
 		IngressSummary:     ingressSummary.Total(),
 		Audits: Audits{
@@ -346,9 +601,11 @@ func (s *Service) GetSatelliteData(ctx context.Context, satelliteID storj.NodeID
 			OnlineScore:     rep.OnlineScore,
 			SatelliteName:   url.Address,
 		},
-		AuditHistory: reputation.GetAuditHistoryFromPB(rep.AuditHistory),
-		PriceModel:   satellitePricing,
-		NodeJoinedAt: rep.JoinedAt,
+		AuditHistory:      reputation.GetAuditHistoryFromPB(rep.AuditHistory),
+		ReputationHistory: reputationHistory,
+		Containment:       rep.Containment,
+		PriceModel:        satellitePricing,
+		NodeJoinedAt:      rep.JoinedAt,
 	}, nil
 }
 
@@ -461,6 +718,18 @@ func (s *Service) GetSatelliteEstimatedPayout(ctx context.Context, satelliteID s
 	return estimatedPayout, nil
 }
 
+// GetProjectedPayouts returns the projected payouts of the current month per satellite.
+func (s *Service) GetProjectedPayouts(ctx context.Context, now time.Time) (projections []estimatedpayouts.Projection, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projections, err = s.estimation.GetProjectedPayouts(ctx, now)
+	if err != nil {
+		return nil, SNOServiceErr.Wrap(err)
+	}
+
+	return projections, nil
+}
+
 // GetAllSatellitesEstimatedPayout returns estimated payouts for current and previous months for all satellites.
 func (s *Service) GetAllSatellitesEstimatedPayout(ctx context.Context, now time.Time) (estimatedPayout estimatedpayouts.EstimatedPayout, err error) {
 	estimatedPayout, err = s.estimation.GetAllSatellitesEstimatedPayout(ctx, now)
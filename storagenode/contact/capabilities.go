@@ -0,0 +1,66 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/pb"
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/capabilitiespb"
+)
+
+// SupportedHashAlgorithms returns the piece hash algorithms the node accepts.
+func SupportedHashAlgorithms() []int32 {
+	algorithms := make([]int32, 0, len(pb.PieceHashAlgorithm_name))
+	for algorithm := range pb.PieceHashAlgorithm_name {
+		algorithms = append(algorithms, algorithm)
+	}
+	sort.Slice(algorithms, func(i, k int) bool { return algorithms[i] < algorithms[k] })
+	return algorithms
+}
+
+// advertiseCapabilities advertises the capabilities of the node to the satellite, which
+// uses them for the node selection and the order limits.
+func (service *Service) advertiseCapabilities(ctx context.Context, conn *rpc.Conn, id storj.NodeID) {
+	var err error
+	defer mon.Task()(&ctx, id)(&err)
+
+	self := service.Local()
+	resp, err := capabilitiespb.NewDRPCNodeCapabilitiesClient(conn).Advertise(ctx, &capabilitiespb.AdvertiseRequest{
+		Capabilities: &self.Capabilities,
+	})
+	if err != nil {
+		// satellites, which don't support the capabilities yet, infer them.
+		if rpcstatus.Code(err) == rpcstatus.Unimplemented {
+			return
+		}
+		service.log.Warn("failed to advertise capabilities", zap.Stringer("Satellite ID", id), zap.Error(err))
+		return
+	}
+
+	windows := make([]MaintenanceWindow, 0, len(resp.MaintenanceWindows))
+	for _, window := range resp.MaintenanceWindows {
+		windows = append(windows, MaintenanceWindow{Start: window.Start, End: window.End})
+	}
+	service.setMaintenance(id, windows)
+
+	extensions := make([]SettlementExtension, 0, len(resp.SettlementExtensions))
+	for _, extension := range resp.SettlementExtensions {
+		token, err := uuid.FromBytes(extension.Token)
+		if err != nil {
+			service.log.Warn("invalid settlement extension token", zap.Stringer("Satellite ID", id), zap.Error(err))
+			continue
+		}
+		extensions = append(extensions, SettlementExtension{Token: token, Start: extension.Start, End: extension.End})
+	}
+	service.setSettlementExtensions(id, extensions, time.Duration(resp.MaxSettlementExtensionSeconds)*time.Second)
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+)
+
+// MaintenanceWindow is a planned downtime announced by a satellite. The satellite
+// doesn't count the offline audits within the window against the node.
+type MaintenanceWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Contains returns whether t is within the window.
+func (window MaintenanceWindow) Contains(t time.Time) bool {
+	return !t.Before(window.Start) && t.Before(window.End)
+}
+
+// MaintenanceWindows returns the maintenance windows last announced by the satellite.
+func (service *Service) MaintenanceWindows(id storj.NodeID) []MaintenanceWindow {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	return append([]MaintenanceWindow(nil), service.maintenance[id]...)
+}
+
+// InMaintenance returns whether the satellite announced a maintenance window containing t.
+func (service *Service) InMaintenance(id storj.NodeID, t time.Time) bool {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	for _, window := range service.maintenance[id] {
+		if window.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// setMaintenance replaces the maintenance windows announced by the satellite.
+func (service *Service) setMaintenance(id storj.NodeID, windows []MaintenanceWindow) {
+	service.mu.Lock()
+	previous := len(service.maintenance[id])
+	if len(windows) == 0 {
+		delete(service.maintenance, id)
+	} else {
+		service.maintenance[id] = windows
+	}
+	service.mu.Unlock()
+
+	if len(windows) > previous {
+		service.log.Info("satellite announced planned maintenance",
+			zap.Stringer("Satellite ID", id),
+			zap.Time("Start", windows[0].Start),
+			zap.Time("End", windows[0].End))
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/nodemessagepb"
+	"storj.io/storj/storagenode/notifications"
+)
+
+// ErrMessageNotFound is returned when the satellite doesn't know the message.
+var ErrMessageNotFound = errs.Class("operator message not found")
+
+// OperatorMessage is a message of the operators of a satellite to the operator of the node,
+// e.g. about a deprecation or a policy change.
+type OperatorMessage struct {
+	ID             uuid.UUID    `json:"id"`
+	SatelliteID    storj.NodeID `json:"satelliteId"`
+	Kind           string       `json:"kind"`
+	Title          string       `json:"title"`
+	Body           string       `json:"body"`
+	CreatedAt      time.Time    `json:"createdAt"`
+	ExpiresAt      *time.Time   `json:"expiresAt"`
+	AcknowledgedAt *time.Time   `json:"acknowledgedAt"`
+}
+
+// OperatorMessages returns the messages last fetched from the satellites, the newest first.
+func (service *Service) OperatorMessages() []OperatorMessage {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	messages := []OperatorMessage{}
+	for _, satelliteMessages := range service.messages {
+		messages = append(messages, satelliteMessages...)
+	}
+	sort.Slice(messages, func(i, k int) bool {
+		return messages[i].CreatedAt.After(messages[k].CreatedAt)
+	})
+	return messages
+}
+
+// AcknowledgeMessage acknowledges the message of the satellite.
+func (service *Service) AcknowledgeMessage(ctx context.Context, satelliteID storj.NodeID, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx, satelliteID)(&err)
+
+	conn, err := service.dialSatellite(ctx, satelliteID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	resp, err := nodemessagepb.NewDRPCNodeMessagesClient(conn).AcknowledgeMessage(ctx, &nodemessagepb.AcknowledgeMessageRequest{
+		Id: id.Bytes(),
+	})
+	if err != nil {
+		if rpcstatus.Code(err) == rpcstatus.NotFound {
+			return ErrMessageNotFound.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+
+	acknowledgedAt := resp.AcknowledgedAt
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	for i := range service.messages[satelliteID] {
+		message := &service.messages[satelliteID][i]
+		if message.ID == id {
+			message.AcknowledgedAt = &acknowledgedAt
+		}
+	}
+	return nil
+}
+
+// fetchMessages fetches the messages of the operators of the satellite, and notifies the
+// operator of the node about the new ones.
+func (service *Service) fetchMessages(ctx context.Context, conn *rpc.Conn, id storj.NodeID) {
+	var err error
+	defer mon.Task()(&ctx, id)(&err)
+
+	resp, err := nodemessagepb.NewDRPCNodeMessagesClient(conn).ListMessages(ctx, &nodemessagepb.ListMessagesRequest{})
+	if err != nil {
+		// satellites, which don't send the messages.
+		if rpcstatus.Code(err) == rpcstatus.Unimplemented {
+			return
+		}
+		service.log.Warn("failed to fetch operator messages", zap.Stringer("Satellite ID", id), zap.Error(err))
+		return
+	}
+
+	messages := make([]OperatorMessage, 0, len(resp.Messages))
+	for _, message := range resp.Messages {
+		messageID, err := uuid.FromBytes(message.Id)
+		if err != nil {
+			service.log.Warn("invalid operator message id", zap.Stringer("Satellite ID", id), zap.Error(err))
+			continue
+		}
+		messages = append(messages, OperatorMessage{
+			ID:             messageID,
+			SatelliteID:    id,
+			Kind:           message.Kind,
+			Title:          message.Title,
+			Body:           message.Body,
+			CreatedAt:      message.CreatedAt,
+			ExpiresAt:      message.ExpiresAt,
+			AcknowledgedAt: message.AcknowledgedAt,
+		})
+	}
+
+	for _, message := range service.setMessages(id, messages) {
+		service.notifyMessage(ctx, message)
+	}
+}
+
+// setMessages replaces the messages of the satellite and returns the unacknowledged ones,
+// which weren't known before.
+func (service *Service) setMessages(id storj.NodeID, messages []OperatorMessage) (added []OperatorMessage) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	known := make(map[uuid.UUID]struct{}, len(service.messages[id]))
+	for _, message := range service.messages[id] {
+		known[message.ID] = struct{}{}
+	}
+	for _, message := range messages {
+		if _, ok := known[message.ID]; !ok && message.AcknowledgedAt == nil {
+			added = append(added, message)
+		}
+	}
+
+	if len(messages) == 0 {
+		delete(service.messages, id)
+	} else {
+		service.messages[id] = messages
+	}
+	return added
+}
+
+// notifyMessage shows the new message in the notifications of the dashboard.
+func (service *Service) notifyMessage(ctx context.Context, message OperatorMessage) {
+	if service.notifications == nil {
+		return
+	}
+
+	_, err := service.notifications.Receive(ctx, notifications.NewNotification{
+		SenderID: message.SatelliteID,
+		Type:     notifications.TypeCustom,
+		Title:    message.Title,
+		Message:  message.Body,
+	})
+	if err != nil {
+		service.log.Error("Failed to receive notification", zap.Stringer("Satellite ID", message.SatelliteID), zap.Error(err))
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/peertls/extensions"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/private/revocationpb"
+)
+
+var errFetchRevocations = errs.Class("fetch revocations")
+
+// RevocationDB stores the identity revocations propagated from the satellites.
+type RevocationDB interface {
+	// Add stores the revocation, when it's signed by the CA and newer than the
+	// stored one.
+	Add(ctx context.Context, ca *x509.Certificate, revocation []byte) error
+}
+
+// RevocationChore fetches the identity revocations from the trusted satellites and
+// stores them in the revocation database of the node, so the connections of the
+// revoked peers are rejected.
+//
+// architecture: Chore
+type RevocationChore struct {
+	log     *zap.Logger
+	service *Service
+	db      RevocationDB
+
+	Loop *sync2.Cycle
+}
+
+// NewRevocationChore creates a new identity revocation chore.
+func NewRevocationChore(log *zap.Logger, interval time.Duration, service *Service, db RevocationDB) *RevocationChore {
+	return &RevocationChore{
+		log:     log,
+		service: service,
+		db:      db,
+
+		Loop: sync2.NewCycle(interval),
+	}
+}
+
+// Run fetches the identity revocations on a regular interval.
+func (chore *RevocationChore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.service.initialized.Wait(ctx) {
+		return ctx.Err()
+	}
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		chore.FetchRevocations(ctx)
+		return nil
+	})
+}
+
+// FetchRevocations fetches the identity revocations from every trusted satellite.
+func (chore *RevocationChore) FetchRevocations(ctx context.Context) {
+	defer mon.Task()(&ctx)(nil)
+
+	for _, satellite := range chore.service.trust.GetSatellites(ctx) {
+		added, err := chore.fetchRevocations(ctx, satellite)
+		if err != nil {
+			chore.log.Warn("failed to fetch identity revocations", zap.Stringer("Satellite ID", satellite), zap.Error(err))
+		}
+		if added > 0 {
+			chore.log.Info("identity revocations added", zap.Stringer("Satellite ID", satellite), zap.Int("count", added))
+		}
+	}
+}
+
+// fetchRevocations fetches the identity revocations from the satellite and returns
+// how many were added to the revocation database.
+func (chore *RevocationChore) fetchRevocations(ctx context.Context, satellite storj.NodeID) (added int, err error) {
+	defer mon.Task()(&ctx, satellite)(&err)
+
+	conn, err := chore.service.dialSatellite(ctx, satellite)
+	if err != nil {
+		return 0, errFetchRevocations.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	resp, err := revocationpb.NewDRPCIdentityRevocationsClient(conn).List(ctx, &revocationpb.ListRequest{})
+	if err != nil {
+		return 0, errFetchRevocations.Wrap(err)
+	}
+
+	var group errs.Group
+	for _, revocation := range resp.Revocations {
+		ca, err := x509.ParseCertificate(revocation.CaCertificate)
+		if err != nil {
+			group.Add(err)
+			continue
+		}
+
+		err = chore.db.Add(ctx, ca, revocation.Revocation)
+		switch {
+		case errors.Is(err, extensions.ErrRevocationTimestamp):
+			// the revocation is known already.
+		case err != nil:
+			group.Add(err)
+		default:
+			added++
+		}
+	}
+	mon.IntVal("identity_revocations_added").Observe(int64(added))
+
+	return added, errFetchRevocations.Wrap(group.Err())
+}
+
+// Close stops the identity revocation chore.
+func (chore *RevocationChore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -18,6 +18,8 @@ import (
 	"storj.io/common/rpc"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/private/capabilitiespb"
+	"storj.io/storj/storagenode/notifications"
 	"storj.io/storj/storagenode/trust"
 )
 
@@ -38,6 +40,10 @@ type Config struct {
 
 	// Chore config values
 	Interval time.Duration `help:"how frequently the node contact chore should run" releaseDefault:"1h" devDefault:"30s"`
+
+	RevocationsInterval time.Duration `help:"how frequently the identity revocations are fetched from the trusted satellites" releaseDefault:"5m" devDefault:"30s"`
+
+	Transport TransportConfig
 }
 
 // NodeInfo contains information necessary for introducing storagenode to satellite.
@@ -49,6 +55,12 @@ type NodeInfo struct {
 	Operator            pb.NodeOperator
 	NoiseKeyAttestation *pb.NoiseKeyAttestation
 	DebounceLimit       int
+	Capabilities        capabilitiespb.Capabilities
+}
+
+// SatelliteSpace returns the space used by the pieces of a satellite.
+type SatelliteSpace interface {
+	SpaceUsedBySatellite(ctx context.Context, satelliteID storj.NodeID) (piecesTotal int64, piecesContentSize int64, err error)
 }
 
 // Service is the contact service between storage nodes and satellites.
@@ -59,9 +71,20 @@ type Service struct {
 
 	mu   sync.Mutex
 	self NodeInfo
-
-	trust     *trust.Pool
-	quicStats *QUICStats
+	// unreachable contains the satellites, which couldn't ping the node back.
+	unreachable map[storj.NodeID]bool
+	// maintenance contains the planned maintenance windows announced by the satellites.
+	maintenance map[storj.NodeID][]MaintenanceWindow
+	// extensions contains the settlement extensions announced by the satellites.
+	extensions map[storj.NodeID]satelliteExtensions
+	// messages contains the messages of the operators of the satellites.
+	messages map[storj.NodeID][]OperatorMessage
+
+	trust         *trust.Pool
+	quicStats     *QUICStats
+	notifications *notifications.Service
+	// satelliteSpace is used to limit the advertised free space of the satellites with a quota.
+	satelliteSpace SatelliteSpace
 
 	initialized sync2.Fence
 }
@@ -75,9 +98,26 @@ func NewService(log *zap.Logger, dialer rpc.Dialer, self NodeInfo, trust *trust.
 		trust:     trust,
 		self:      self,
 		quicStats: quicStats,
+
+		unreachable: map[storj.NodeID]bool{},
+		maintenance: map[storj.NodeID][]MaintenanceWindow{},
+		extensions:  map[storj.NodeID]satelliteExtensions{},
+		messages:    map[storj.NodeID][]OperatorMessage{},
 	}
 }
 
+// SetNotifications sets the notification service, which is notified when the satellites
+// can't reach the node or send new messages.
+func (service *Service) SetNotifications(notifications *notifications.Service) {
+	service.notifications = notifications
+}
+
+// SetSatelliteSpace sets the source of the space used per satellite, so that
+// the satellites with a quota are advertised only the space left within it.
+func (service *Service) SetSatelliteSpace(space SatelliteSpace) {
+	service.satelliteSpace = space
+}
+
 // PingSatellites attempts to ping all satellites in trusted list until backoff reaches maxInterval.
 func (service *Service) PingSatellites(ctx context.Context, maxInterval time.Duration) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -104,7 +144,11 @@ func (service *Service) pingSatellite(ctx context.Context, satellite storj.NodeI
 		if err == nil {
 			return nil
 		}
-		service.log.Error("ping satellite failed ", zap.Stringer("Satellite ID", satellite), zap.Int("attempts", attempts), zap.Error(err))
+		if service.InMaintenance(satellite, time.Now()) {
+			service.log.Info("ping satellite failed during its planned maintenance", zap.Stringer("Satellite ID", satellite), zap.Int("attempts", attempts), zap.Error(err))
+		} else {
+			service.log.Error("ping satellite failed ", zap.Stringer("Satellite ID", satellite), zap.Int("attempts", attempts), zap.Error(err))
+		}
 
 		// Sleeps until interval times out, then continue. Returns if context is cancelled.
 		if !sync2.Sleep(ctx, interval) {
@@ -130,10 +174,11 @@ func (service *Service) pingSatelliteOnce(ctx context.Context, id storj.NodeID)
 	defer func() { err = errs.Combine(err, conn.Close()) }()
 
 	self := service.Local()
+	capacity := service.satelliteCapacity(ctx, id, self.Capacity)
 	resp, err := pb.NewDRPCNodeClient(conn).CheckIn(ctx, &pb.CheckInRequest{
 		Address:             self.Address,
 		Version:             &self.Version,
-		Capacity:            &self.Capacity,
+		Capacity:            &capacity,
 		Operator:            &self.Operator,
 		NoiseKeyAttestation: self.NoiseKeyAttestation,
 		DebounceLimit:       int32(self.DebounceLimit),
@@ -144,6 +189,7 @@ func (service *Service) pingSatelliteOnce(ctx context.Context, id storj.NodeID)
 	}
 	if resp != nil {
 		service.quicStats.SetStatus(resp.PingNodeSuccessQuic)
+		service.setReachable(ctx, id, resp.PingNodeSuccess, resp.PingErrorMessage)
 
 		if !resp.PingNodeSuccess {
 			return errPingSatellite.New("%s", resp.PingErrorMessage)
@@ -152,9 +198,67 @@ func (service *Service) pingSatelliteOnce(ctx context.Context, id storj.NodeID)
 	if resp.PingErrorMessage != "" {
 		service.log.Warn("Your node is still considered to be online but encountered an error.", zap.Stringer("Satellite ID", id), zap.String("Error", resp.GetPingErrorMessage()))
 	}
+
+	service.advertiseCapabilities(ctx, conn, id)
+	service.fetchMessages(ctx, conn, id)
 	return nil
 }
 
+// satelliteCapacity returns the capacity advertised to the satellite. The free
+// space is limited by the space left within the quota of the satellite.
+func (service *Service) satelliteCapacity(ctx context.Context, id storj.NodeID, capacity pb.NodeCapacity) pb.NodeCapacity {
+	quota, ok := service.trust.Quota(id)
+	if !ok || service.satelliteSpace == nil {
+		return capacity
+	}
+
+	used, _, err := service.satelliteSpace.SpaceUsedBySatellite(ctx, id)
+	if err != nil {
+		service.log.Warn("unable to get the space used by the satellite", zap.Stringer("Satellite ID", id), zap.Error(err))
+		return capacity
+	}
+
+	left := quota.Int64() - used
+	if left < 0 {
+		left = 0
+	}
+	if left < capacity.FreeDisk {
+		capacity.FreeDisk = left
+	}
+	return capacity
+}
+
+// setReachable records whether the satellite could ping the node back, and notifies when
+// the node became unreachable.
+func (service *Service) setReachable(ctx context.Context, id storj.NodeID, reachable bool, pingError string) {
+	service.mu.Lock()
+	wasUnreachable := service.unreachable[id]
+	if reachable {
+		delete(service.unreachable, id)
+	} else {
+		service.unreachable[id] = true
+	}
+	service.mu.Unlock()
+
+	if reachable || wasUnreachable || service.notifications == nil {
+		return
+	}
+	// the offline audits during the maintenance don't count against the node.
+	if service.InMaintenance(id, time.Now()) {
+		return
+	}
+
+	_, err := service.notifications.Receive(ctx, notifications.NewNotification{
+		SenderID: service.Local().ID,
+		Type:     notifications.TypeOffline,
+		Title:    "Your Node is offline",
+		Message:  "Satellite " + id.String() + " couldn't reach your StorageNode: " + pingError + ". Check the external address and the port forwarding of the node.",
+	})
+	if err != nil {
+		service.log.Error("Failed to receive notification", zap.Stringer("Satellite ID", id), zap.Error(err))
+	}
+}
+
 // RequestPingMeQUIC sends pings request to satellite for a pingBack via QUIC.
 func (service *Service) RequestPingMeQUIC(ctx context.Context) (stats *QUICStats, err error) {
 	defer mon.Task()(&ctx)(&err)
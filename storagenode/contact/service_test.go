@@ -0,0 +1,60 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/rpc"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/trust"
+)
+
+type fakeSatelliteSpace map[storj.NodeID]int64
+
+func (space fakeSatelliteSpace) SpaceUsedBySatellite(ctx context.Context, satelliteID storj.NodeID) (int64, int64, error) {
+	return space[satelliteID], space[satelliteID], nil
+}
+
+func TestSatelliteCapacity(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	limited, full, unlimited := testrand.NodeID(), testrand.NodeID(), testrand.NodeID()
+	pool, err := trust.NewPool(zaptest.NewLogger(t), nil, trust.Config{
+		CachePath: ctx.File("trust-cache.json"),
+		Quotas: trust.Quotas{
+			Limits: map[storj.NodeID]memory.Size{
+				limited: 3 * memory.GB,
+				full:    memory.GB,
+			},
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	service := NewService(zaptest.NewLogger(t), rpc.Dialer{}, NodeInfo{}, pool, nil)
+	capacity := pb.NodeCapacity{FreeDisk: 5 * memory.GB.Int64()}
+
+	// without the space usage the capacity isn't limited.
+	require.Equal(t, capacity, service.satelliteCapacity(ctx, limited, capacity))
+
+	service.SetSatelliteSpace(fakeSatelliteSpace{
+		limited: memory.GB.Int64(),
+		full:    2 * memory.GB.Int64(),
+	})
+	require.EqualValues(t, 2*memory.GB, service.satelliteCapacity(ctx, limited, capacity).FreeDisk)
+	require.EqualValues(t, 0, service.satelliteCapacity(ctx, full, capacity).FreeDisk)
+	require.Equal(t, capacity, service.satelliteCapacity(ctx, unlimited, capacity))
+
+	// the quota doesn't increase the free disk.
+	capacity.FreeDisk = memory.GB.Int64()
+	require.EqualValues(t, memory.GB, service.satelliteCapacity(ctx, limited, capacity).FreeDisk)
+}
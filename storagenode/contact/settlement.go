@@ -0,0 +1,89 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+)
+
+// SettlementExtension is an unplanned downtime announced by a satellite. The satellite
+// accepts the orders, which expired during the downtime, after their expiration when
+// their settlement is flagged with the token.
+type SettlementExtension struct {
+	Token uuid.UUID
+	Start time.Time
+	End   time.Time
+}
+
+// Overlap returns how long the downtime overlaps the period from start until end.
+func (extension SettlementExtension) Overlap(start, end time.Time) time.Duration {
+	if extension.Start.After(start) {
+		start = extension.Start
+	}
+	if extension.End.Before(end) {
+		end = extension.End
+	}
+	if !start.Before(end) {
+		return 0
+	}
+	return end.Sub(start)
+}
+
+// satelliteExtensions are the settlement extensions announced by a satellite.
+type satelliteExtensions struct {
+	extensions []SettlementExtension
+	maximum    time.Duration
+}
+
+// SettlementExtension returns how long after its expiration the satellite accepts an
+// order limit, which was valid from creation until expiration, and the token, which
+// flags the settlement. It's the total downtime of the satellite while the order limit
+// was valid, up to the maximum announced by the satellite.
+func (service *Service) SettlementExtension(id storj.NodeID, creation, expiration time.Time) (token uuid.UUID, extension time.Duration) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	satellite := service.extensions[id]
+	for _, downtime := range satellite.extensions {
+		if overlap := downtime.Overlap(creation, expiration); overlap > 0 {
+			extension += overlap
+			token = downtime.Token
+		}
+	}
+	if extension > satellite.maximum {
+		extension = satellite.maximum
+	}
+	if extension <= 0 {
+		return uuid.UUID{}, 0
+	}
+	return token, extension
+}
+
+// setSettlementExtensions replaces the settlement extensions announced by the satellite.
+func (service *Service) setSettlementExtensions(id storj.NodeID, extensions []SettlementExtension, maximum time.Duration) {
+	service.mu.Lock()
+	previous := len(service.extensions[id].extensions)
+	if len(extensions) == 0 {
+		delete(service.extensions, id)
+	} else {
+		service.extensions[id] = satelliteExtensions{
+			extensions: extensions,
+			maximum:    maximum,
+		}
+	}
+	service.mu.Unlock()
+
+	if len(extensions) > previous {
+		latest := extensions[len(extensions)-1]
+		service.log.Info("satellite announced downtime, orders expired during it will be settled late",
+			zap.Stringer("Satellite ID", id),
+			zap.Time("Start", latest.Start),
+			zap.Time("End", latest.End))
+	}
+}
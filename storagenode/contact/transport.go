@@ -0,0 +1,257 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc"
+	"storj.io/storj/private/server"
+)
+
+// recentWeight is the weight of the latest sample in the success rates and the latencies,
+// so they reflect the recent state of the network.
+const recentWeight = 0.01
+
+// TransportConfig defines when the node prefers tcp for its outgoing connections.
+type TransportConfig struct {
+	Fallback        bool    `help:"prefer tcp for the outgoing connections, when quic works worse than tcp on this network" default:"true"`
+	FallbackMargin  float64 `help:"how much lower the success rate of the quic transfers has to be than of the tcp transfers to prefer tcp" default:"0.2"`
+	FallbackMinimum int64   `help:"minimum number of the transfers over each transport before their success rates are compared" default:"100"`
+}
+
+// TransportStatus contains the statistics of a transport.
+type TransportStatus struct {
+	Transport string `json:"transport"`
+
+	Connections         int64 `json:"connections"`
+	FastOpenConnections int64 `json:"fastOpenConnections"`
+
+	Handshakes        int64         `json:"handshakes"`
+	HandshakeFailures int64         `json:"handshakeFailures"`
+	HandshakeLatency  time.Duration `json:"handshakeLatency"`
+
+	Transfers           int64   `json:"transfers"`
+	TransfersSucceeded  int64   `json:"transfersSucceeded"`
+	TransferSuccessRate float64 `json:"transferSuccessRate"`
+}
+
+// TransportStats collects the statistics of the incoming and the outgoing connections per
+// transport, and decides whether quic should be avoided on the network of the node.
+//
+// It implements server.ConnectionObserver.
+type TransportStats struct {
+	log    *zap.Logger
+	config TransportConfig
+	quic   *QUICStats
+
+	mu         sync.Mutex
+	transports map[string]*TransportStatus
+	preferTCP  bool
+}
+
+// NewTransportStats creates a new transport statistics collector.
+func NewTransportStats(log *zap.Logger, config TransportConfig, quic *QUICStats) *TransportStats {
+	return &TransportStats{
+		log:    log,
+		config: config,
+		quic:   quic,
+		transports: map[string]*TransportStatus{
+			server.TransportTCP:  {Transport: server.TransportTCP},
+			server.TransportQUIC: {Transport: server.TransportQUIC},
+		},
+	}
+}
+
+// Accepted records an accepted incoming connection.
+func (stats *TransportStats) Accepted(transport string, fastOpen bool) {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+
+	status := stats.transport(transport)
+	status.Connections++
+	if fastOpen {
+		status.FastOpenConnections++
+	}
+}
+
+// Handshake records the handshake of an incoming or an outgoing connection.
+func (stats *TransportStats) Handshake(transport string, duration time.Duration, err error) {
+	transportTag := monkit.NewSeriesTag("transport", transport)
+
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+
+	status := stats.transport(transport)
+	status.Handshakes++
+	if err != nil {
+		mon.Event("transport_handshake_failed", transportTag)
+		status.HandshakeFailures++
+		return
+	}
+	mon.DurationVal("transport_handshake_latency", transportTag).Observe(duration)
+
+	if status.Handshakes-status.HandshakeFailures == 1 {
+		status.HandshakeLatency = duration
+		return
+	}
+	status.HandshakeLatency = time.Duration(float64(status.HandshakeLatency)*(1-recentWeight) + float64(duration)*recentWeight)
+}
+
+// Transfer records the outcome of an upload or a download. The canceled transfers count as
+// unsuccessful, because the stalled connections of a mangled network end up canceled.
+func (stats *TransportStats) Transfer(transport string, success bool) {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+
+	status := stats.transport(transport)
+	status.Transfers++
+	outcome := 0.0
+	if success {
+		status.TransfersSucceeded++
+		outcome = 1
+	}
+
+	if status.Transfers == 1 {
+		status.TransferSuccessRate = outcome
+	} else {
+		status.TransferSuccessRate = status.TransferSuccessRate*(1-recentWeight) + outcome*recentWeight
+	}
+
+	stats.updatePreference()
+}
+
+// Status returns the statistics of the transports.
+func (stats *TransportStats) Status() []TransportStatus {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+
+	return []TransportStatus{
+		*stats.transports[server.TransportTCP],
+		*stats.transports[server.TransportQUIC],
+	}
+}
+
+// PreferTCP returns whether the outgoing connections should use only tcp, because quic
+// doesn't work well on the network of the node.
+func (stats *TransportStats) PreferTCP() bool {
+	if !stats.config.Fallback {
+		return false
+	}
+	if stats.quic != nil && stats.quic.Status() == NetworkStatusMisconfigured {
+		return true
+	}
+
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+	return stats.preferTCP
+}
+
+// updatePreference compares the success rates of the transfers over the transports.
+func (stats *TransportStats) updatePreference() {
+	tcp, quic := stats.transports[server.TransportTCP], stats.transports[server.TransportQUIC]
+	if tcp.Transfers < stats.config.FallbackMinimum || quic.Transfers < stats.config.FallbackMinimum {
+		return
+	}
+
+	preferTCP := tcp.TransferSuccessRate-quic.TransferSuccessRate >= stats.config.FallbackMargin
+	if preferTCP == stats.preferTCP {
+		return
+	}
+	stats.preferTCP = preferTCP
+
+	if preferTCP {
+		mon.Event("transport_prefer_tcp")
+		stats.log.Warn("quic transfers fail more often than tcp transfers, preferring tcp for the outgoing connections",
+			zap.Float64("TCP Success Rate", tcp.TransferSuccessRate),
+			zap.Float64("QUIC Success Rate", quic.TransferSuccessRate))
+	} else {
+		stats.log.Info("quic transfers recovered, using all transports for the outgoing connections")
+	}
+}
+
+// transport returns the statistics of the transport, stats.mu must be held.
+func (stats *TransportStats) transport(transport string) *TransportStatus {
+	status, ok := stats.transports[transport]
+	if !ok {
+		status = &TransportStatus{Transport: transport}
+		stats.transports[transport] = status
+	}
+	return status
+}
+
+// TransportOf returns the transport of the connection with the remote address.
+func TransportOf(addr net.Addr) string {
+	if _, ok := addr.(*net.UDPAddr); ok {
+		return server.TransportQUIC
+	}
+	return server.TransportTCP
+}
+
+// TransportConnector dials only tcp, when the statistics prefer tcp, and records the
+// handshakes of the outgoing connections.
+type TransportConnector struct {
+	connector rpc.Connector
+	stats     *TransportStats
+}
+
+// NewTransportConnector wraps the connector.
+func NewTransportConnector(connector rpc.Connector, stats *TransportStats) *TransportConnector {
+	return &TransportConnector{
+		connector: connector,
+		stats:     stats,
+	}
+}
+
+// DialContext creates an encrypted connection.
+func (connector *TransportConnector) DialContext(ctx context.Context, tlsConfig *tls.Config, address string) (_ rpc.ConnectorConn, err error) {
+	forceTCP := connector.stats.PreferTCP()
+	if forceTCP {
+		ctx = rpc.WithForcedKind(ctx, server.TransportTCP)
+	}
+
+	start := time.Now()
+	conn, err := connector.connector.DialContext(ctx, tlsConfig, address)
+	if err != nil {
+		// the transport is only known, when it was forced.
+		if forceTCP {
+			connector.stats.Handshake(server.TransportTCP, time.Since(start), err)
+		}
+		return nil, err
+	}
+
+	connector.stats.Handshake(TransportOf(conn.RemoteAddr()), time.Since(start), nil)
+	return conn, nil
+}
+
+// unencryptedConnector is implemented by the connectors, which support the unencrypted
+// connections, e.g. for noise.
+type unencryptedConnector interface {
+	DialContextUnencrypted(ctx context.Context, address string) (net.Conn, error)
+	DialContextUnencryptedUnprefixed(ctx context.Context, address string) (net.Conn, error)
+}
+
+// DialContextUnencrypted creates a raw connection with the wrapped connector.
+func (connector *TransportConnector) DialContextUnencrypted(ctx context.Context, address string) (net.Conn, error) {
+	if unencrypted, ok := connector.connector.(unencryptedConnector); ok {
+		return unencrypted.DialContextUnencrypted(ctx, address)
+	}
+	return nil, Error.New("unsupported connector: %T", connector.connector)
+}
+
+// DialContextUnencryptedUnprefixed creates a raw connection without the drpc header with
+// the wrapped connector.
+func (connector *TransportConnector) DialContextUnencryptedUnprefixed(ctx context.Context, address string) (net.Conn, error) {
+	if unencrypted, ok := connector.connector.(unencryptedConnector); ok {
+		return unencrypted.DialContextUnencryptedUnprefixed(ctx, address)
+	}
+	return nil, Error.New("unsupported connector: %T", connector.connector)
+}
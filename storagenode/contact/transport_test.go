@@ -0,0 +1,70 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact_test
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/private/server"
+	"storj.io/storj/storagenode/contact"
+)
+
+func TestTransportStats(t *testing.T) {
+	quic := contact.NewQUICStats(true)
+	stats := contact.NewTransportStats(zaptest.NewLogger(t), contact.TransportConfig{
+		Fallback:        true,
+		FallbackMargin:  0.2,
+		FallbackMinimum: 10,
+	}, quic)
+
+	stats.Accepted(server.TransportTCP, true)
+	stats.Accepted(server.TransportTCP, false)
+	stats.Accepted(server.TransportQUIC, false)
+	stats.Handshake(server.TransportTCP, 10*time.Millisecond, nil)
+	stats.Handshake(server.TransportTCP, 0, errors.New("bad certificate"))
+
+	status := stats.Status()
+	require.Len(t, status, 2)
+	tcp, quicStatus := status[0], status[1]
+	require.Equal(t, server.TransportTCP, tcp.Transport)
+	require.EqualValues(t, 2, tcp.Connections)
+	require.EqualValues(t, 1, tcp.FastOpenConnections)
+	require.EqualValues(t, 2, tcp.Handshakes)
+	require.EqualValues(t, 1, tcp.HandshakeFailures)
+	require.Equal(t, 10*time.Millisecond, tcp.HandshakeLatency)
+	require.EqualValues(t, 1, quicStatus.Connections)
+
+	// too few transfers to compare.
+	for i := 0; i < 5; i++ {
+		stats.Transfer(server.TransportTCP, true)
+		stats.Transfer(server.TransportQUIC, false)
+	}
+	require.False(t, stats.PreferTCP())
+
+	// quic transfers fail on this network.
+	for i := 0; i < 5; i++ {
+		stats.Transfer(server.TransportTCP, true)
+		stats.Transfer(server.TransportQUIC, false)
+	}
+	require.True(t, stats.PreferTCP())
+
+	// quic recovers.
+	for i := 0; i < 200; i++ {
+		stats.Transfer(server.TransportQUIC, true)
+	}
+	require.False(t, stats.PreferTCP())
+
+	// the satellite can't reach the node over quic.
+	quic.SetStatus(false)
+	require.True(t, stats.PreferTCP())
+
+	require.Equal(t, server.TransportQUIC, contact.TransportOf(&net.UDPAddr{}))
+	require.Equal(t, server.TransportTCP, contact.TransportOf(&net.TCPAddr{}))
+}
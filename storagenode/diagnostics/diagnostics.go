@@ -0,0 +1,281 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package diagnostics
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/spf13/pflag"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/private/version"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/diskhealth"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/reputation"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+var (
+	// Error is the error class for the diagnostics bundle.
+	Error = errs.Class("diagnostics")
+
+	mon = monkit.Package()
+)
+
+// Config defines parameters of the diagnostics bundle.
+type Config struct {
+	LogPath  string `help:"path to the log file included in the diagnostics bundle, the log output file when empty" default:""`
+	LogLines int    `help:"number of the most recent log lines included in the diagnostics bundle" default:"1000"`
+}
+
+// DB contains the databases, from which the diagnostics are collected.
+type DB interface {
+	Pieces() blobstore.Blobs
+	PieceSpaceUsedDB() pieces.PieceSpaceUsedDB
+	Reputation() reputation.DB
+	IntegrityCheck(ctx context.Context) ([]storagenodedb.IntegrityCheckResult, error)
+}
+
+// redactedPatterns are the parts of the setting names, whose values are secret or
+// personal, and are left out of the bundle.
+var redactedPatterns = []string{"password", "secret", "access-key", "token", "login", "email", "webhook-url"}
+
+// redacted replaces the values of the secret settings.
+const redacted = "[redacted]"
+
+// Service collects the diagnostics of the node into a zip archive, which the operator
+// can share when asking for support.
+type Service struct {
+	log     *zap.Logger
+	config  Config
+	nodeID  storj.NodeID
+	version version.Info
+	db      DB
+
+	mu         sync.Mutex
+	settings   map[string]string
+	diskHealth *diskhealth.Service
+}
+
+// NewService creates a new diagnostics service.
+func NewService(log *zap.Logger, config Config, nodeID storj.NodeID, versionInfo version.Info, db DB) *Service {
+	return &Service{
+		log:     log,
+		config:  config,
+		nodeID:  nodeID,
+		version: versionInfo,
+		db:      db,
+	}
+}
+
+// SetSettings sets the effective settings of the node included in the bundle.
+// The values of the secret settings are redacted.
+func (service *Service) SetSettings(settings map[string]string) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	service.settings = Redact(settings)
+}
+
+// SetLogPath sets the log file included in the bundle, unless it's configured explicitly.
+func (service *Service) SetLogPath(path string) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	if service.config.LogPath == "" {
+		service.config.LogPath = path
+	}
+}
+
+// SetDiskHealth makes the bundle include the last disk health status.
+func (service *Service) SetDiskHealth(diskHealth *diskhealth.Service) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	service.diskHealth = diskHealth
+}
+
+// Info describes the node and the time of the bundle.
+type Info struct {
+	NodeID      storj.NodeID `json:"nodeID"`
+	Version     string       `json:"version"`
+	CommitHash  string       `json:"commitHash"`
+	Release     bool         `json:"release"`
+	CollectedAt time.Time    `json:"collectedAt"`
+}
+
+// Disks contains the space usage of the node.
+type Disks struct {
+	PiecesTotal       int64                                  `json:"piecesTotal"`
+	PiecesContentSize int64                                  `json:"piecesContentSize"`
+	Trash             int64                                  `json:"trash"`
+	Free              int64                                  `json:"free"`
+	Satellites        map[storj.NodeID]pieces.SatelliteUsage `json:"satellites"`
+	Health            *diskhealth.Status                     `json:"health,omitempty"`
+}
+
+// Write writes the diagnostics bundle as a zip archive. The parts of the bundle, which
+// fail to be collected, are described in errors.txt instead of failing the bundle.
+func (service *Service) Write(ctx context.Context, w io.Writer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service.mu.Lock()
+	config := service.config
+	settings := service.settings
+	diskHealth := service.diskHealth
+	service.mu.Unlock()
+
+	archive := zip.NewWriter(w)
+	var failures []string
+
+	add := func(name string, collect func() ([]byte, error)) error {
+		data, err := collect()
+		if err != nil {
+			service.log.Warn("failed to collect diagnostics", zap.String("file", name), zap.Error(err))
+			failures = append(failures, fmt.Sprintf("%s: %v", name, err))
+			return nil
+		}
+		file, err := archive.Create(name)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		_, err = file.Write(data)
+		return Error.Wrap(err)
+	}
+
+	addJSON := func(name string, collect func() (interface{}, error)) error {
+		return add(name, func() ([]byte, error) {
+			value, err := collect()
+			if err != nil {
+				return nil, err
+			}
+			return json.MarshalIndent(value, "", "\t")
+		})
+	}
+
+	err = errs.Combine(
+		addJSON("info.json", func() (interface{}, error) {
+			return Info{
+				NodeID:      service.nodeID,
+				Version:     service.version.Version.String(),
+				CommitHash:  service.version.CommitHash,
+				Release:     service.version.Release,
+				CollectedAt: time.Now().UTC(),
+			}, nil
+		}),
+		add("config.txt", func() ([]byte, error) {
+			return formatSettings(settings), nil
+		}),
+		add("logs.txt", func() ([]byte, error) {
+			if config.LogPath == "" {
+				return nil, Error.New("the log is not written to a file")
+			}
+			return TailLines(config.LogPath, config.LogLines)
+		}),
+		addJSON("databases.json", func() (interface{}, error) {
+			return service.db.IntegrityCheck(ctx)
+		}),
+		addJSON("disks.json", func() (interface{}, error) {
+			return service.disks(ctx, diskHealth)
+		}),
+		addJSON("reputation.json", func() (interface{}, error) {
+			return service.db.Reputation().All(ctx)
+		}),
+	)
+	if err != nil {
+		return errs.Combine(err, archive.Close())
+	}
+
+	if len(failures) > 0 {
+		err = add("errors.txt", func() ([]byte, error) {
+			return []byte(strings.Join(failures, "\n") + "\n"), nil
+		})
+		if err != nil {
+			return errs.Combine(err, archive.Close())
+		}
+	}
+
+	return Error.Wrap(archive.Close())
+}
+
+// disks collects the space usage of the node.
+func (service *Service) disks(ctx context.Context, diskHealth *diskhealth.Service) (disks Disks, err error) {
+	spaceUsedDB := service.db.PieceSpaceUsedDB()
+
+	disks.PiecesTotal, disks.PiecesContentSize, err = spaceUsedDB.GetPieceTotals(ctx)
+	if err != nil {
+		return Disks{}, err
+	}
+	disks.Trash, err = spaceUsedDB.GetTrashTotal(ctx)
+	if err != nil {
+		return Disks{}, err
+	}
+	disks.Satellites, err = spaceUsedDB.GetPieceTotalsForAllSatellites(ctx)
+	if err != nil {
+		return Disks{}, err
+	}
+	disks.Free, err = service.db.Pieces().FreeSpace(ctx)
+	if err != nil {
+		return Disks{}, err
+	}
+	if diskHealth != nil {
+		disks.Health = diskHealth.Status()
+	}
+	return disks, nil
+}
+
+// Settings returns the values of the flags, which contain the effective settings of the node.
+func Settings(flags *pflag.FlagSet) map[string]string {
+	settings := map[string]string{}
+	flags.VisitAll(func(flag *pflag.Flag) {
+		settings[flag.Name] = flag.Value.String()
+	})
+	return settings
+}
+
+// Redact returns a copy of the settings with the values of the secret settings redacted.
+func Redact(settings map[string]string) map[string]string {
+	result := make(map[string]string, len(settings))
+	for name, value := range settings {
+		if value != "" && isSecret(name) {
+			value = redacted
+		}
+		result[name] = value
+	}
+	return result
+}
+
+func isSecret(name string) bool {
+	name = strings.ToLower(name)
+	for _, pattern := range redactedPatterns {
+		if strings.Contains(name, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// formatSettings formats the settings as the lines of the config file, sorted by the name.
+func formatSettings(settings map[string]string) []byte {
+	names := make([]string, 0, len(settings))
+	for name := range settings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&b, "%s: %q\n", name, settings[name])
+	}
+	return []byte(b.String())
+}
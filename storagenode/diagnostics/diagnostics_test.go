@@ -0,0 +1,154 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package diagnostics_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/private/version"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/diagnostics"
+	"storj.io/storj/storagenode/reputation"
+	"storj.io/storj/storagenode/storagenodedb"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestBundle(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		satelliteID := testrand.NodeID()
+		require.NoError(t, db.Reputation().Store(ctx, reputation.Stats{
+			SatelliteID: satelliteID,
+			OnlineScore: 0.95,
+		}))
+
+		var logs strings.Builder
+		for i := 0; i < 100; i++ {
+			fmt.Fprintf(&logs, "line %d\n", i)
+		}
+		logPath := ctx.File("node.log")
+		require.NoError(t, os.WriteFile(logPath, []byte(logs.String()), 0644))
+
+		nodeID := testrand.NodeID()
+		service := diagnostics.NewService(zaptest.NewLogger(t), diagnostics.Config{
+			LogPath:  logPath,
+			LogLines: 10,
+		}, nodeID, version.Info{}, db)
+		service.SetSettings(map[string]string{
+			"storage.path":                         "/mnt/storagenode",
+			"notifications.channels.smtp-password": "hunter2",
+			"s3.secret-key":                        "abc",
+		})
+
+		var bundle bytes.Buffer
+		require.NoError(t, service.Write(ctx, &bundle))
+
+		files := readZip(t, bundle.Bytes())
+		require.NotContains(t, files, "errors.txt")
+
+		var info diagnostics.Info
+		require.NoError(t, json.Unmarshal(files["info.json"], &info))
+		require.Equal(t, nodeID, info.NodeID)
+
+		config := string(files["config.txt"])
+		require.Contains(t, config, `storage.path: "/mnt/storagenode"`)
+		require.Contains(t, config, `s3.secret-key: "[redacted]"`)
+		require.NotContains(t, config, "hunter2")
+
+		expectedLogs := strings.Join(strings.SplitAfter(logs.String(), "\n")[90:], "")
+		require.Equal(t, expectedLogs, string(files["logs.txt"]))
+
+		var databases []storagenodedb.IntegrityCheckResult
+		require.NoError(t, json.Unmarshal(files["databases.json"], &databases))
+		require.NotEmpty(t, databases)
+		for _, result := range databases {
+			require.True(t, result.OK, result.Database)
+		}
+
+		var disks diagnostics.Disks
+		require.NoError(t, json.Unmarshal(files["disks.json"], &disks))
+
+		var stats []reputation.Stats
+		require.NoError(t, json.Unmarshal(files["reputation.json"], &stats))
+		require.Len(t, stats, 1)
+		require.Equal(t, satelliteID, stats[0].SatelliteID)
+	})
+}
+
+func TestBundleMissingLog(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		service := diagnostics.NewService(zaptest.NewLogger(t), diagnostics.Config{LogLines: 10}, testrand.NodeID(), version.Info{}, db)
+
+		var bundle bytes.Buffer
+		require.NoError(t, service.Write(ctx, &bundle))
+
+		files := readZip(t, bundle.Bytes())
+		require.NotContains(t, files, "logs.txt")
+		require.Contains(t, string(files["errors.txt"]), "logs.txt")
+		require.Contains(t, files, "databases.json")
+	})
+}
+
+func TestTailLines(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	write := func(name, data string) string {
+		path := ctx.File(name)
+		require.NoError(t, os.WriteFile(path, []byte(data), 0644))
+		return path
+	}
+
+	long := strings.Repeat("x", 100*1024)
+	for _, tt := range []struct {
+		data     string
+		lines    int
+		expected string
+	}{
+		{data: "", lines: 3, expected: ""},
+		{data: "a\nb\n", lines: 3, expected: "a\nb\n"},
+		{data: "a\nb\nc\nd\n", lines: 2, expected: "c\nd\n"},
+		{data: "a\nb\nc\nd", lines: 2, expected: "c\nd"},
+		{data: "a\n" + long + "\nb\n", lines: 2, expected: long + "\nb\n"},
+		{data: "a\nb\n", lines: 0, expected: ""},
+	} {
+		path := write("log", tt.data)
+		data, err := diagnostics.TailLines(path, tt.lines)
+		require.NoError(t, err)
+		require.Equal(t, tt.expected, string(data))
+	}
+}
+
+func TestLogPath(t *testing.T) {
+	require.Equal(t, "", diagnostics.LogPath("stderr"))
+	require.Equal(t, "", diagnostics.LogPath("stdout"))
+	require.Equal(t, "/var/log/node.log", diagnostics.LogPath("/var/log/node.log"))
+	require.Equal(t, `C:\Program Files\Storj\node.log`, diagnostics.LogPath(`winfile:///C:\Program Files\Storj\node.log`))
+}
+
+func readZip(t *testing.T, data []byte) map[string][]byte {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	files := map[string][]byte{}
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		require.NoError(t, err)
+		files[file.Name], err = io.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+	}
+	return files
+}
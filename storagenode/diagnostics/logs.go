@@ -0,0 +1,72 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package diagnostics
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// tailChunkSize is the size of the chunks, in which the log file is read from the end.
+const tailChunkSize = 64 * 1024
+
+// LogPath returns the path of the log file for the log output setting, or an empty
+// string when the log is not written to a file.
+func LogPath(output string) string {
+	switch output {
+	case "", "stdout", "stderr":
+		return ""
+	}
+	if path := strings.TrimPrefix(output, "winfile:///"); path != output {
+		return path
+	}
+	return strings.TrimPrefix(output, "file://")
+}
+
+// TailLines returns the last lines of the file.
+func TailLines(path string, lines int) (_ []byte, err error) {
+	if lines <= 0 {
+		return nil, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(file.Close())) }()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// read the chunks from the end, until there are more newlines than the lines. The
+	// newline terminating the last line is not counted.
+	end := info.Size()
+	var data []byte
+	for end > 0 && bytes.Count(bytes.TrimSuffix(data, []byte("\n")), []byte("\n")) < lines {
+		start := end - tailChunkSize
+		if start < 0 {
+			start = 0
+		}
+		chunk := make([]byte, end-start)
+		if _, err := file.ReadAt(chunk, start); err != nil && err != io.EOF {
+			return nil, Error.Wrap(err)
+		}
+		data = append(chunk, data...)
+		end = start
+	}
+
+	// drop the lines before the last ones, including the partial line at the start of the
+	// first chunk.
+	body := bytes.TrimSuffix(data, []byte("\n"))
+	for extra := bytes.Count(body, []byte("\n")) + 1 - lines; extra > 0; extra-- {
+		data = data[bytes.IndexByte(data, '\n')+1:]
+	}
+	return data, nil
+}
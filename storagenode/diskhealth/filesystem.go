@@ -0,0 +1,85 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package diskhealth
+
+import (
+	"bufio"
+	"bytes"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// FilesystemStatus contains the error counters of the filesystem of the storage directory.
+type FilesystemStatus struct {
+	Device     string `json:"device"`
+	MountPoint string `json:"mountPoint"`
+	Type       string `json:"type"`
+	// ErrorsSupported is whether the filesystem reports its errors.
+	ErrorsSupported bool  `json:"errorsSupported"`
+	Errors          int64 `json:"errors"`
+}
+
+// mount is a mounted filesystem.
+type mount struct {
+	device     string
+	mountPoint string
+	fsType     string
+}
+
+// findMount returns the mount containing path from the contents of /proc/self/mountinfo.
+func findMount(mountinfo []byte, path string) (found mount, ok bool) {
+	scanner := bufio.NewScanner(bytes.NewReader(mountinfo))
+	for scanner.Scan() {
+		// 36 35 98:0 /mnt1 /mnt2 rw,noatime master:1 - ext3 /dev/root rw,errors=continue
+		fields := strings.Fields(scanner.Text())
+		separator := -1
+		for i, field := range fields {
+			if field == "-" {
+				separator = i
+				break
+			}
+		}
+		if separator < 5 || len(fields) < separator+3 {
+			continue
+		}
+
+		mountPoint := unescapeMountinfo(fields[4])
+		if !containsPath(mountPoint, path) || len(mountPoint) < len(found.mountPoint) {
+			continue
+		}
+		found = mount{
+			device:     unescapeMountinfo(fields[separator+2]),
+			mountPoint: mountPoint,
+			fsType:     fields[separator+1],
+		}
+		ok = true
+	}
+	return found, ok
+}
+
+// containsPath returns whether path is dir or inside it.
+func containsPath(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// unescapeMountinfo replaces the octal escapes of the space characters and the backslash.
+func unescapeMountinfo(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+4 <= len(s) {
+			if v, err := strconv.ParseUint(s[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(v))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
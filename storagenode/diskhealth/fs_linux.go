@@ -0,0 +1,57 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package diskhealth
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// readFilesystem finds the filesystem of the path in /proc/self/mountinfo, and reads its
+// error counter from sysfs, when the filesystem has one.
+func readFilesystem(ctx context.Context, path string) (_ *FilesystemStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		path = resolved
+	}
+
+	mountinfo, err := os.ReadFile("/proc/self/mountinfo")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	found, ok := findMount(mountinfo, path)
+	if !ok {
+		return nil, Error.New("mount point of %q not found", path)
+	}
+
+	status := &FilesystemStatus{
+		Device:     found.device,
+		MountPoint: found.mountPoint,
+		Type:       found.fsType,
+	}
+
+	if found.fsType == "ext4" || found.fsType == "ext3" {
+		device := found.device
+		if resolved, err := filepath.EvalSymlinks(device); err == nil {
+			device = resolved
+		}
+		data, err := os.ReadFile(filepath.Join("/sys/fs/ext4", filepath.Base(device), "errors_count"))
+		if err == nil {
+			status.Errors, err = strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+			status.ErrorsSupported = true
+		}
+	}
+	return status, nil
+}
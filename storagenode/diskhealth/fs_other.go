@@ -0,0 +1,17 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+//go:build !linux
+// +build !linux
+
+package diskhealth
+
+import (
+	"context"
+)
+
+// readFilesystem isn't supported on this platform.
+func readFilesystem(ctx context.Context, path string) (_ *FilesystemStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return nil, Error.New("filesystem health is not supported on this platform")
+}
@@ -0,0 +1,210 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package diskhealth implements the monitoring of the SMART attributes and the filesystem
+// errors of the storage disk.
+package diskhealth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+)
+
+var (
+	// Error is the error class for the disk health checks.
+	Error = errs.Class("disk health")
+
+	mon = monkit.Package()
+)
+
+// Config defines parameters for the disk health monitoring.
+type Config struct {
+	Enabled  bool          `help:"periodically check the SMART attributes and the filesystem errors of the storage disk" default:"false"`
+	Interval time.Duration `help:"how frequently the health of the storage disk is checked" default:"1h0m0s"`
+	Smartctl string        `help:"path to the smartctl binary, empty disables the SMART checks" default:"smartctl"`
+	Device   string        `help:"device passed to smartctl, defaults to the device of the storage directory" default:""`
+
+	StopUploads             bool  `help:"stop accepting new uploads while the disk has failure indicators" default:"false"`
+	MaxReallocatedSectors   int64 `help:"the disk is failing when it has more reallocated sectors" default:"100"`
+	MaxPendingSectors       int64 `help:"the disk is failing when it has more pending or offline uncorrectable sectors" default:"10"`
+	MaxMediaErrors          int64 `help:"the disk is failing when it has more NVMe media errors" default:"10"`
+	MaxFilesystemErrors     int64 `help:"the disk is failing when its filesystem has more errors" default:"0"`
+	MaxNVMePercentageUsed   int64 `help:"the disk is failing when it has used more of its NVMe endurance, in percent" default:"100"`
+	IgnoreSmartOverallCheck bool  `help:"don't treat a failed SMART overall health self-assessment as a failure indicator" default:"false"`
+}
+
+// Status is the result of the last disk health check.
+type Status struct {
+	CheckedAt time.Time `json:"checkedAt"`
+
+	Smart      *SmartStatus `json:"smart"`
+	SmartError string       `json:"smartError,omitempty"`
+
+	Filesystem      *FilesystemStatus `json:"filesystem"`
+	FilesystemError string            `json:"filesystemError,omitempty"`
+
+	Failing        bool     `json:"failing"`
+	Reasons        []string `json:"reasons"`
+	UploadsStopped bool     `json:"uploadsStopped"`
+}
+
+// Service periodically checks the health of the storage disk, and reports whether new
+// uploads should be accepted.
+//
+// architecture: Chore
+type Service struct {
+	log    *zap.Logger
+	path   string
+	config Config
+
+	Loop *sync2.Cycle
+
+	mu     sync.Mutex
+	status *Status
+}
+
+// NewService creates a new disk health service for the storage directory.
+func NewService(log *zap.Logger, path string, config Config) *Service {
+	return &Service{
+		log:    log,
+		path:   path,
+		config: config,
+		Loop:   sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the disk health checks.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		service.Check(ctx)
+		return nil
+	})
+}
+
+// Close stops the disk health checks.
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// Check collects the SMART attributes and the filesystem errors, and evaluates them.
+func (service *Service) Check(ctx context.Context) {
+	defer mon.Task()(&ctx)(nil)
+
+	status := &Status{CheckedAt: time.Now()}
+
+	filesystem, err := readFilesystem(ctx, service.path)
+	if err != nil {
+		status.FilesystemError = err.Error()
+	}
+	status.Filesystem = filesystem
+
+	if service.config.Smartctl != "" {
+		device := service.config.Device
+		if device == "" && filesystem != nil {
+			device = filesystem.Device
+		}
+		if device == "" {
+			status.SmartError = "device of the storage directory is unknown"
+		} else {
+			status.Smart, err = readSmart(ctx, service.config.Smartctl, device)
+			if err != nil {
+				status.SmartError = err.Error()
+			}
+		}
+	}
+
+	status.Reasons = service.config.failureReasons(status.Smart, status.Filesystem)
+	status.Failing = len(status.Reasons) > 0
+	status.UploadsStopped = status.Failing && service.config.StopUploads
+
+	if status.SmartError != "" || status.FilesystemError != "" {
+		service.log.Debug("disk health is partially unknown",
+			zap.String("smart", status.SmartError),
+			zap.String("filesystem", status.FilesystemError))
+	}
+	if status.Smart != nil {
+		mon.IntVal("smart_reallocated_sectors").Observe(status.Smart.ReallocatedSectors)
+		mon.IntVal("smart_pending_sectors").Observe(status.Smart.PendingSectors)
+		mon.IntVal("smart_temperature").Observe(status.Smart.Temperature)
+	}
+	if status.Filesystem != nil && status.Filesystem.ErrorsSupported {
+		mon.IntVal("filesystem_errors").Observe(status.Filesystem.Errors)
+	}
+
+	service.mu.Lock()
+	previous := service.status
+	service.status = status
+	service.mu.Unlock()
+
+	wasFailing := previous != nil && previous.Failing
+	switch {
+	case status.Failing && !wasFailing:
+		service.log.Error("disk has failure indicators",
+			zap.Strings("reasons", status.Reasons),
+			zap.Bool("uploads stopped", status.UploadsStopped))
+	case !status.Failing && wasFailing:
+		service.log.Info("disk failure indicators cleared")
+	}
+}
+
+// Status returns the result of the last check, or nil when the disk hasn't been checked yet.
+func (service *Service) Status() *Status {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	if service.status == nil {
+		return nil
+	}
+	status := *service.status
+	return &status
+}
+
+// AcceptingUploads returns false, when new uploads should be refused because of the disk health.
+func (service *Service) AcceptingUploads() bool {
+	status := service.Status()
+	return status == nil || !status.UploadsStopped
+}
+
+// failureReasons returns the failure indicators, which cross the thresholds.
+func (config Config) failureReasons(smart *SmartStatus, filesystem *FilesystemStatus) (reasons []string) {
+	if smart != nil {
+		if !smart.Passed && !config.IgnoreSmartOverallCheck {
+			reasons = append(reasons, "SMART overall health self-assessment failed")
+		}
+		if smart.ReallocatedSectors > config.MaxReallocatedSectors {
+			reasons = append(reasons, fmt.Sprintf("%d reallocated sectors", smart.ReallocatedSectors))
+		}
+		if smart.PendingSectors > config.MaxPendingSectors {
+			reasons = append(reasons, fmt.Sprintf("%d pending sectors", smart.PendingSectors))
+		}
+		if smart.OfflineUncorrectable > config.MaxPendingSectors {
+			reasons = append(reasons, fmt.Sprintf("%d offline uncorrectable sectors", smart.OfflineUncorrectable))
+		}
+		if smart.MediaErrors > config.MaxMediaErrors {
+			reasons = append(reasons, fmt.Sprintf("%d media errors", smart.MediaErrors))
+		}
+		if smart.PercentageUsed > config.MaxNVMePercentageUsed {
+			reasons = append(reasons, fmt.Sprintf("%d%% of the endurance used", smart.PercentageUsed))
+		}
+		if smart.AvailableSpareLow {
+			reasons = append(reasons, "available spare below threshold")
+		}
+		if smart.CriticalWarningRaised {
+			reasons = append(reasons, "critical warning raised")
+		}
+	}
+	if filesystem != nil && filesystem.ErrorsSupported && filesystem.Errors > config.MaxFilesystemErrors {
+		reasons = append(reasons, fmt.Sprintf("%d filesystem errors", filesystem.Errors))
+	}
+	return reasons
+}
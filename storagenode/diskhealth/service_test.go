@@ -0,0 +1,154 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package diskhealth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+)
+
+const ataOutput = `{
+  "smartctl": {"exit_status": 0},
+  "model_name": "WDC WD80EFAX",
+  "smart_status": {"passed": true},
+  "ata_smart_attributes": {
+    "table": [
+      {"id": 5, "name": "Reallocated_Sector_Ct", "raw": {"value": 120}},
+      {"id": 9, "name": "Power_On_Hours", "raw": {"value": 20000}},
+      {"id": 197, "name": "Current_Pending_Sector", "raw": {"value": 2}},
+      {"id": 198, "name": "Offline_Uncorrectable", "raw": {"value": 0}}
+    ]
+  },
+  "temperature": {"current": 38},
+  "power_on_time": {"hours": 20000}
+}`
+
+const nvmeOutput = `{
+  "smartctl": {"exit_status": 8},
+  "model_name": "Samsung SSD 970",
+  "smart_status": {"passed": false},
+  "nvme_smart_health_information_log": {
+    "critical_warning": 4,
+    "available_spare": 100,
+    "available_spare_threshold": 10,
+    "percentage_used": 3,
+    "media_errors": 0
+  },
+  "temperature": {"current": 45}
+}`
+
+const failedOutput = `{
+  "smartctl": {
+    "exit_status": 2,
+    "messages": [{"string": "Smartctl open device: /dev/sdx failed: No such device", "severity": "error"}]
+  }
+}`
+
+func TestParseSmartctl(t *testing.T) {
+	ata, err := parseSmartctl("/dev/sda", []byte(ataOutput))
+	require.NoError(t, err)
+	require.Equal(t, &SmartStatus{
+		Device:             "/dev/sda",
+		Model:              "WDC WD80EFAX",
+		Passed:             true,
+		ReallocatedSectors: 120,
+		PendingSectors:     2,
+		Temperature:        38,
+		PowerOnHours:       20000,
+	}, ata)
+
+	nvme, err := parseSmartctl("/dev/nvme0", []byte(nvmeOutput))
+	require.NoError(t, err)
+	require.False(t, nvme.Passed)
+	require.True(t, nvme.CriticalWarningRaised)
+	require.False(t, nvme.AvailableSpareLow)
+	require.EqualValues(t, 3, nvme.PercentageUsed)
+
+	_, err = parseSmartctl("/dev/sdx", []byte(failedOutput))
+	require.ErrorContains(t, err, "No such device")
+
+	_, err = parseSmartctl("/dev/sdx", []byte("not json"))
+	require.Error(t, err)
+}
+
+func TestFailureReasons(t *testing.T) {
+	config := Config{
+		MaxReallocatedSectors: 100,
+		MaxPendingSectors:     10,
+		MaxMediaErrors:        10,
+		MaxNVMePercentageUsed: 100,
+	}
+
+	ata, err := parseSmartctl("/dev/sda", []byte(ataOutput))
+	require.NoError(t, err)
+	require.Equal(t, []string{"120 reallocated sectors"}, config.failureReasons(ata, nil))
+
+	nvme, err := parseSmartctl("/dev/nvme0", []byte(nvmeOutput))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"SMART overall health self-assessment failed",
+		"critical warning raised",
+	}, config.failureReasons(nvme, nil))
+
+	config.IgnoreSmartOverallCheck = true
+	require.Equal(t, []string{"critical warning raised"}, config.failureReasons(nvme, nil))
+
+	require.Empty(t, config.failureReasons(nil, &FilesystemStatus{Errors: 3}))
+	require.Equal(t, []string{"3 filesystem errors"}, config.failureReasons(nil, &FilesystemStatus{ErrorsSupported: true, Errors: 3}))
+	require.Empty(t, config.failureReasons(nil, &FilesystemStatus{ErrorsSupported: true}))
+}
+
+func TestFindMount(t *testing.T) {
+	mountinfo := []byte(`22 1 8:1 / / rw,relatime shared:1 - ext4 /dev/sda1 rw,errors=remount-ro
+25 22 0:22 / /proc rw,nosuid shared:12 - proc proc rw
+30 22 8:17 / /mnt/storj rw,relatime shared:2 - ext4 /dev/sdb1 rw
+31 22 8:33 / /mnt/storj\040node rw,relatime shared:3 - xfs /dev/sdc1 rw
+32 30 8:49 / /mnt/storj/extra rw,relatime shared:4 - ext4 /dev/mapper/extra rw
+`)
+
+	for _, tt := range []struct {
+		path  string
+		mount mount
+	}{
+		{"/home/storj", mount{device: "/dev/sda1", mountPoint: "/", fsType: "ext4"}},
+		{"/mnt/storj", mount{device: "/dev/sdb1", mountPoint: "/mnt/storj", fsType: "ext4"}},
+		{"/mnt/storj/storage", mount{device: "/dev/sdb1", mountPoint: "/mnt/storj", fsType: "ext4"}},
+		{"/mnt/storjx", mount{device: "/dev/sda1", mountPoint: "/", fsType: "ext4"}},
+		{"/mnt/storj node/storage", mount{device: "/dev/sdc1", mountPoint: "/mnt/storj node", fsType: "xfs"}},
+		{"/mnt/storj/extra/storage", mount{device: "/dev/mapper/extra", mountPoint: "/mnt/storj/extra", fsType: "ext4"}},
+	} {
+		found, ok := findMount(mountinfo, tt.path)
+		require.True(t, ok, tt.path)
+		require.Equal(t, tt.mount, found, tt.path)
+	}
+
+	_, ok := findMount([]byte("invalid\n"), "/")
+	require.False(t, ok)
+}
+
+func TestService(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	service := NewService(zaptest.NewLogger(t), ctx.Dir("storage"), Config{
+		Smartctl:    ctx.File("missing-smartctl"),
+		Device:      "/dev/sdx",
+		StopUploads: true,
+	})
+	defer ctx.Check(service.Close)
+
+	require.Nil(t, service.Status())
+	require.True(t, service.AcceptingUploads())
+
+	// a missing smartctl isn't a failure indicator.
+	service.Check(ctx)
+	status := service.Status()
+	require.NotNil(t, status)
+	require.Nil(t, status.Smart)
+	require.NotEmpty(t, status.SmartError)
+	require.True(t, service.AcceptingUploads())
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package diskhealth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os/exec"
+)
+
+// SMART attribute ids of the failure indicators.
+const (
+	attributeReallocatedSectors   = 5
+	attributePendingSectors       = 197
+	attributeOfflineUncorrectable = 198
+)
+
+// SmartStatus contains the SMART attributes of the disk, which indicate failures.
+type SmartStatus struct {
+	Device                string `json:"device"`
+	Model                 string `json:"model"`
+	Passed                bool   `json:"passed"`
+	ReallocatedSectors    int64  `json:"reallocatedSectors"`
+	PendingSectors        int64  `json:"pendingSectors"`
+	OfflineUncorrectable  int64  `json:"offlineUncorrectable"`
+	MediaErrors           int64  `json:"mediaErrors"`
+	Temperature           int64  `json:"temperature"`
+	PowerOnHours          int64  `json:"powerOnHours"`
+	PercentageUsed        int64  `json:"percentageUsed"`
+	AvailableSpareLow     bool   `json:"availableSpareLow"`
+	CriticalWarningRaised bool   `json:"criticalWarningRaised"`
+}
+
+// smartctlOutput is the part of the json output of smartctl, which is used.
+type smartctlOutput struct {
+	Smartctl struct {
+		ExitStatus int `json:"exit_status"`
+		Messages   []struct {
+			String   string `json:"string"`
+			Severity string `json:"severity"`
+		} `json:"messages"`
+	} `json:"smartctl"`
+	ModelName   string `json:"model_name"`
+	SmartStatus *struct {
+		Passed bool `json:"passed"`
+	} `json:"smart_status"`
+	AtaSmartAttributes struct {
+		Table []struct {
+			ID  int `json:"id"`
+			Raw struct {
+				Value int64 `json:"value"`
+			} `json:"raw"`
+		} `json:"table"`
+	} `json:"ata_smart_attributes"`
+	NvmeSmartHealthInformationLog *struct {
+		CriticalWarning int64 `json:"critical_warning"`
+		AvailableSpare  int64 `json:"available_spare"`
+		SpareThreshold  int64 `json:"available_spare_threshold"`
+		PercentageUsed  int64 `json:"percentage_used"`
+		MediaErrors     int64 `json:"media_errors"`
+	} `json:"nvme_smart_health_information_log"`
+	Temperature struct {
+		Current int64 `json:"current"`
+	} `json:"temperature"`
+	PowerOnTime struct {
+		Hours int64 `json:"hours"`
+	} `json:"power_on_time"`
+}
+
+// smartctlFatal are the bits of the smartctl exit status, which mean that the output
+// doesn't contain the device information.
+const smartctlFatal = 1 | 2
+
+// readSmart runs smartctl for the device, and parses its output.
+func readSmart(ctx context.Context, smartctl, device string) (_ *SmartStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	output, err := exec.CommandContext(ctx, smartctl, "--json", "--health", "--attributes", "--info", device).Output()
+	if err != nil {
+		// smartctl uses the exit status as a bit mask, and the output is still useful when
+		// only the bits of the disk problems are set.
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) || len(output) == 0 {
+			return nil, Error.New("running %s: %v", smartctl, err)
+		}
+	}
+	return parseSmartctl(device, output)
+}
+
+// parseSmartctl parses the json output of smartctl.
+func parseSmartctl(device string, data []byte) (*SmartStatus, error) {
+	var output smartctlOutput
+	if err := json.Unmarshal(data, &output); err != nil {
+		return nil, Error.New("invalid smartctl output: %v", err)
+	}
+	if output.Smartctl.ExitStatus&smartctlFatal != 0 {
+		for _, message := range output.Smartctl.Messages {
+			if message.Severity == "error" {
+				return nil, Error.New("smartctl: %s", message.String)
+			}
+		}
+		return nil, Error.New("smartctl failed with exit status %d", output.Smartctl.ExitStatus)
+	}
+	if output.SmartStatus == nil {
+		return nil, Error.New("smartctl: SMART is not supported by %s", device)
+	}
+
+	status := &SmartStatus{
+		Device:       device,
+		Model:        output.ModelName,
+		Passed:       output.SmartStatus.Passed,
+		Temperature:  output.Temperature.Current,
+		PowerOnHours: output.PowerOnTime.Hours,
+	}
+	for _, attribute := range output.AtaSmartAttributes.Table {
+		switch attribute.ID {
+		case attributeReallocatedSectors:
+			status.ReallocatedSectors = attribute.Raw.Value
+		case attributePendingSectors:
+			status.PendingSectors = attribute.Raw.Value
+		case attributeOfflineUncorrectable:
+			status.OfflineUncorrectable = attribute.Raw.Value
+		}
+	}
+	if nvme := output.NvmeSmartHealthInformationLog; nvme != nil {
+		status.MediaErrors = nvme.MediaErrors
+		status.PercentageUsed = nvme.PercentageUsed
+		status.AvailableSpareLow = nvme.AvailableSpare < nvme.SpareThreshold
+		status.CriticalWarningRaised = nvme.CriticalWarning != 0
+	}
+	return status, nil
+}
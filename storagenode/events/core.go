@@ -0,0 +1,141 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package events
+
+import (
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"storj.io/common/storj"
+)
+
+// messages maps the log messages to the types and the statuses of the events.
+var messages = map[string]struct {
+	typ    Type
+	status Status
+}{
+	"upload started":    {TypeUpload, StatusStarted},
+	"uploaded":          {TypeUpload, StatusSuccess},
+	"upload canceled":   {TypeUpload, StatusCanceled},
+	"upload failed":     {TypeUpload, StatusFailed},
+	"download started":  {TypeDownload, StatusStarted},
+	"downloaded":        {TypeDownload, StatusSuccess},
+	"download canceled": {TypeDownload, StatusCanceled},
+	"download failed":   {TypeDownload, StatusFailed},
+	"deleted":           {TypeDelete, StatusSuccess},
+
+	"Prepared to run a Retain request.":   {TypeGC, StatusStarted},
+	"Moved pieces to trash during retain": {TypeGC, StatusSuccess},
+}
+
+// WrapCore returns a zap option, which captures the events from the log into the feed.
+func (feed *Feed) WrapCore() zap.Option {
+	return zap.WrapCore(func(wrapped zapcore.Core) zapcore.Core {
+		if feed == nil {
+			return wrapped
+		}
+		return zapcore.NewTee(wrapped, &core{feed: feed})
+	})
+}
+
+// core is a zap core, which converts the log entries of the transfers into events.
+//
+// The entries are captured regardless of the level of the log, so the feed works
+// even when the info messages aren't logged.
+type core struct {
+	feed   *Feed
+	fields []zapcore.Field
+}
+
+// Enabled implements zapcore.Core.
+func (c *core) Enabled(level zapcore.Level) bool {
+	return level >= zapcore.InfoLevel
+}
+
+// With implements zapcore.Core.
+func (c *core) With(fields []zapcore.Field) zapcore.Core {
+	return &core{
+		feed:   c.feed,
+		fields: append(c.fields[:len(c.fields):len(c.fields)], fields...),
+	}
+}
+
+// Check implements zapcore.Core.
+func (c *core) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.Enabled(entry.Level) {
+		return checked
+	}
+	if _, ok := messages[entry.Message]; !ok {
+		return checked
+	}
+	return checked.AddCore(entry, c)
+}
+
+// Write implements zapcore.Core.
+func (c *core) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	kind, ok := messages[entry.Message]
+	if !ok {
+		return nil
+	}
+
+	encoder := zapcore.NewMapObjectEncoder()
+	for _, field := range c.fields {
+		field.AddTo(encoder)
+	}
+	for _, field := range fields {
+		field.AddTo(encoder)
+	}
+	values := encoder.Fields
+
+	event := Event{
+		Time:          entry.Time,
+		Type:          kind.typ,
+		Status:        kind.status,
+		Message:       entry.Message,
+		PieceID:       stringField(values, "Piece ID"),
+		Action:        stringField(values, "Action"),
+		Size:          intField(values, "Size"),
+		RemoteAddress: stringField(values, "Remote Address"),
+		Error:         stringField(values, "error"),
+		Deleted:       intField(values, "num deleted"),
+	}
+	if satelliteID, err := storj.NodeIDFromString(stringField(values, "Satellite ID")); err == nil {
+		event.SatelliteID = satelliteID
+	}
+
+	switch event.Action {
+	case "GET_AUDIT":
+		event.Type = TypeAudit
+	case "GET_REPAIR", "PUT_REPAIR":
+		event.Type = TypeRepair
+	}
+
+	c.feed.Publish(event)
+	return nil
+}
+
+// Sync implements zapcore.Core.
+func (c *core) Sync() error { return nil }
+
+func stringField(values map[string]interface{}, key string) string {
+	switch value := values[key].(type) {
+	case string:
+		return value
+	case error:
+		return value.Error()
+	default:
+		return ""
+	}
+}
+
+func intField(values map[string]interface{}, key string) int64 {
+	switch value := values[key].(type) {
+	case int64:
+		return value
+	case int:
+		return int64(value)
+	default:
+		return 0
+	}
+}
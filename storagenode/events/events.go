@@ -0,0 +1,117 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package events implements a feed of the structured events of the piece transfers,
+// which are captured from the log of the node.
+package events
+
+import (
+	"strings"
+	"time"
+
+	"storj.io/common/storj"
+)
+
+// Type is the type of an event.
+type Type string
+
+const (
+	// TypeUpload is an upload of a piece.
+	TypeUpload Type = "upload"
+	// TypeDownload is a download of a piece.
+	TypeDownload Type = "download"
+	// TypeAudit is a download of a piece for an audit.
+	TypeAudit Type = "audit"
+	// TypeRepair is a transfer of a piece for a repair.
+	TypeRepair Type = "repair"
+	// TypeDelete is a deletion of a piece.
+	TypeDelete Type = "delete"
+	// TypeGC is a garbage collection run.
+	TypeGC Type = "gc"
+)
+
+// Types are all the types of the events.
+var Types = []Type{TypeUpload, TypeDownload, TypeAudit, TypeRepair, TypeDelete, TypeGC}
+
+// Status is the outcome of an event.
+type Status string
+
+const (
+	// StatusStarted means the transfer has started.
+	StatusStarted Status = "started"
+	// StatusSuccess means the operation succeeded.
+	StatusSuccess Status = "success"
+	// StatusCanceled means the transfer was canceled.
+	StatusCanceled Status = "canceled"
+	// StatusFailed means the operation failed.
+	StatusFailed Status = "failed"
+)
+
+// Event is a structured event, which is captured from the log.
+type Event struct {
+	Time          time.Time    `json:"time"`
+	Type          Type         `json:"type"`
+	Status        Status       `json:"status"`
+	Message       string       `json:"message"`
+	SatelliteID   storj.NodeID `json:"satelliteID"`
+	PieceID       string       `json:"pieceID,omitempty"`
+	Action        string       `json:"action,omitempty"`
+	Size          int64        `json:"size,omitempty"`
+	RemoteAddress string       `json:"remoteAddress,omitempty"`
+	Error         string       `json:"error,omitempty"`
+	Deleted       int64        `json:"deleted,omitempty"`
+}
+
+// ParseTypes parses a comma separated list of event types.
+func ParseTypes(s string) (types []Type, err error) {
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		typ := Type(name)
+		if !typ.valid() {
+			return nil, Error.New("unknown event type %q", name)
+		}
+		types = append(types, typ)
+	}
+	return types, nil
+}
+
+func (typ Type) valid() bool {
+	for _, known := range Types {
+		if typ == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter selects the events.
+type Filter struct {
+	// Types selects the events of the types, all the types when empty.
+	Types []Type
+	// SatelliteID selects the events of the satellite, all the satellites when zero.
+	SatelliteID storj.NodeID
+	// Since selects the events after the time, all the events when zero.
+	Since time.Time
+}
+
+// Match returns whether the filter selects the event.
+func (filter Filter) Match(event Event) bool {
+	if !filter.SatelliteID.IsZero() && filter.SatelliteID != event.SatelliteID {
+		return false
+	}
+	if !filter.Since.IsZero() && !event.Time.After(filter.Since) {
+		return false
+	}
+	if len(filter.Types) == 0 {
+		return true
+	}
+	for _, typ := range filter.Types {
+		if typ == event.Type {
+			return true
+		}
+	}
+	return false
+}
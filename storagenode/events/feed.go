@@ -0,0 +1,141 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package events
+
+import (
+	"sync"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+)
+
+var (
+	// Error is the error class for the event feed.
+	Error = errs.Class("events")
+
+	mon = monkit.Package()
+)
+
+// subscriberBuffer is the number of the events, which are buffered for a slow subscriber
+// before the events are dropped.
+const subscriberBuffer = 1000
+
+// Config defines parameters for the event feed.
+type Config struct {
+	Enabled bool `help:"capture the transfer events from the log for the node api" default:"true"`
+	Buffer  int  `help:"number of the recent events kept in memory" default:"10000"`
+}
+
+// Feed keeps the recent events, and streams the new events to the subscribers.
+type Feed struct {
+	mu          sync.Mutex
+	events      []Event
+	next        int
+	full        bool
+	subscribers map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	filter Filter
+	events chan Event
+}
+
+// NewFeed creates a feed, which keeps the configured number of the recent events.
+// It returns nil, when the feed is disabled.
+func NewFeed(config Config) *Feed {
+	if !config.Enabled || config.Buffer <= 0 {
+		return nil
+	}
+	return &Feed{
+		events:      make([]Event, config.Buffer),
+		subscribers: map[*subscriber]struct{}{},
+	}
+}
+
+// Publish adds the event to the feed.
+func (feed *Feed) Publish(event Event) {
+	if feed == nil {
+		return
+	}
+
+	feed.mu.Lock()
+	defer feed.mu.Unlock()
+
+	feed.events[feed.next] = event
+	feed.next++
+	if feed.next == len(feed.events) {
+		feed.next = 0
+		feed.full = true
+	}
+
+	for sub := range feed.subscribers {
+		if !sub.filter.Match(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			mon.Counter("events_dropped").Inc(1)
+		}
+	}
+}
+
+// Recent returns the kept events, which match the filter, from the oldest to the newest.
+func (feed *Feed) Recent(filter Filter) []Event {
+	if feed == nil {
+		return nil
+	}
+
+	feed.mu.Lock()
+	defer feed.mu.Unlock()
+
+	return feed.recent(filter)
+}
+
+func (feed *Feed) recent(filter Filter) (events []Event) {
+	if feed.full {
+		events = appendMatching(events, filter, feed.events[feed.next:])
+	}
+	return appendMatching(events, filter, feed.events[:feed.next])
+}
+
+func appendMatching(events []Event, filter Filter, all []Event) []Event {
+	for _, event := range all {
+		if filter.Match(event) {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// Subscribe returns the kept events, which match the filter, and a channel of the new
+// matching events. When the subscriber doesn't keep up, the new events are dropped.
+// The channel is closed by calling unsubscribe.
+func (feed *Feed) Subscribe(filter Filter) (recent []Event, events <-chan Event, unsubscribe func()) {
+	if feed == nil {
+		closed := make(chan Event)
+		close(closed)
+		return nil, closed, func() {}
+	}
+
+	sub := &subscriber{
+		filter: filter,
+		events: make(chan Event, subscriberBuffer),
+	}
+
+	feed.mu.Lock()
+	defer feed.mu.Unlock()
+
+	feed.subscribers[sub] = struct{}{}
+
+	var once sync.Once
+	return feed.recent(filter), sub.events, func() {
+		once.Do(func() {
+			feed.mu.Lock()
+			defer feed.mu.Unlock()
+			delete(feed.subscribers, sub)
+			close(sub.events)
+		})
+	}
+}
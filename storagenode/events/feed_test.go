@@ -0,0 +1,100 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package events_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"storj.io/common/pb"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/events"
+)
+
+func TestFeed(t *testing.T) {
+	feed := events.NewFeed(events.Config{Enabled: true, Buffer: 3})
+
+	// the events are captured even when the info messages aren't logged.
+	log := zap.NewNop().WithOptions(zap.IncreaseLevel(zapcore.WarnLevel), feed.WrapCore()).Named("piecestore")
+
+	satelliteA, satelliteB := testrand.NodeID(), testrand.NodeID()
+	pieceID := testrand.PieceID()
+
+	recent, stream, unsubscribe := feed.Subscribe(events.Filter{Types: []events.Type{events.TypeAudit}})
+	require.Empty(t, recent)
+
+	log.Info("uploaded", zap.Stringer("Piece ID", pieceID), zap.Stringer("Satellite ID", satelliteA), zap.Stringer("Action", pb.PieceAction_PUT), zap.Int64("Size", 1024), zap.String("Remote Address", "1.2.3.4:5"))
+	log.Info("downloaded", zap.Stringer("Piece ID", pieceID), zap.Stringer("Satellite ID", satelliteB), zap.Stringer("Action", pb.PieceAction_GET_AUDIT), zap.Int64("Size", 256))
+	log.Info("unrelated message", zap.Stringer("Satellite ID", satelliteA))
+	log.With(zap.String("Process", "storagenode")).Error("download failed", zap.Stringer("Satellite ID", satelliteA), zap.Stringer("Action", pb.PieceAction_GET_REPAIR), zap.Error(errors.New("broken pipe")))
+
+	all := feed.Recent(events.Filter{})
+	require.Len(t, all, 3)
+
+	require.Equal(t, events.TypeUpload, all[0].Type)
+	require.Equal(t, events.StatusSuccess, all[0].Status)
+	require.Equal(t, satelliteA, all[0].SatelliteID)
+	require.Equal(t, pieceID.String(), all[0].PieceID)
+	require.Equal(t, "PUT", all[0].Action)
+	require.EqualValues(t, 1024, all[0].Size)
+	require.Equal(t, "1.2.3.4:5", all[0].RemoteAddress)
+
+	require.Equal(t, events.TypeAudit, all[1].Type)
+	require.Equal(t, events.TypeRepair, all[2].Type)
+	require.Equal(t, events.StatusFailed, all[2].Status)
+	require.Equal(t, "broken pipe", all[2].Error)
+
+	// filters
+	require.Len(t, feed.Recent(events.Filter{SatelliteID: satelliteA}), 2)
+	require.Len(t, feed.Recent(events.Filter{Types: []events.Type{events.TypeUpload, events.TypeRepair}}), 2)
+	require.Len(t, feed.Recent(events.Filter{Since: all[0].Time.Add(-time.Nanosecond)}), 3)
+	require.Empty(t, feed.Recent(events.Filter{Since: all[2].Time}))
+
+	// the subscriber gets the new matching events.
+	select {
+	case event := <-stream:
+		require.Equal(t, events.TypeAudit, event.Type)
+	default:
+		t.Fatal("expected an audit event")
+	}
+	unsubscribe()
+	_, ok := <-stream
+	require.False(t, ok)
+
+	// only the configured number of the events is kept.
+	log.Info("Moved pieces to trash during retain", zap.Int("num deleted", 5), zap.Stringer("Satellite ID", satelliteB))
+	all = feed.Recent(events.Filter{})
+	require.Len(t, all, 3)
+	require.Equal(t, events.TypeAudit, all[0].Type)
+	require.Equal(t, events.TypeGC, all[2].Type)
+	require.EqualValues(t, 5, all[2].Deleted)
+}
+
+func TestParseTypes(t *testing.T) {
+	types, err := events.ParseTypes("upload, audit,,gc")
+	require.NoError(t, err)
+	require.Equal(t, []events.Type{events.TypeUpload, events.TypeAudit, events.TypeGC}, types)
+
+	_, err = events.ParseTypes("upload,unknown")
+	require.Error(t, err)
+}
+
+func TestDisabledFeed(t *testing.T) {
+	feed := events.NewFeed(events.Config{Enabled: false, Buffer: 10})
+	require.Nil(t, feed)
+
+	log := zap.NewNop().WithOptions(feed.WrapCore())
+	log.Info("uploaded")
+
+	require.Empty(t, feed.Recent(events.Filter{}))
+	_, stream, unsubscribe := feed.Subscribe(events.Filter{})
+	defer unsubscribe()
+	_, ok := <-stream
+	require.False(t, ok)
+}
@@ -7,6 +7,9 @@ package monitor
 
 import (
 	"context"
+	"errors"
+	"os"
+	"sync/atomic"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -14,11 +17,14 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
+	"storj.io/common/errs2"
 	"storj.io/common/memory"
 	"storj.io/common/pb"
 	"storj.io/common/sync2"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/diskhealth"
+	"storj.io/storj/storagenode/notifications"
 	"storj.io/storj/storagenode/pieces"
 )
 
@@ -47,9 +53,11 @@ type Config struct {
 	VerifyDirReadableTimeout  time.Duration `help:"how long to wait for a storage directory readability verification to complete" releaseDefault:"1m" devDefault:"10s"`
 	VerifyDirWritableTimeout  time.Duration `help:"how long to wait for a storage directory writability verification to complete" releaseDefault:"1m" devDefault:"10s"`
 	VerifyDirWarnOnly         bool          `help:"if the storage directory verification check fails, log a warning instead of killing the node" default:"false"`
+	ReadOnlyOnWriteFailure    bool          `help:"if the storage directory isn't writable, switch to serving only downloads and audits instead of killing the node" default:"true"`
 	MinimumDiskSpace          memory.Size   `help:"how much disk space a node at minimum has to advertise" default:"500GB"`
 	MinimumBandwidth          memory.Size   `help:"how much bandwidth a node at minimum has to advertise (deprecated)" default:"0TB"`
 	NotifyLowDiskCooldown     time.Duration `help:"minimum length of time between capacity reports" default:"10m" hidden:"true"`
+	FreeDiskMargin            memory.Size   `help:"how much space is kept free on the disk of the storage directory. When other data fills the disk, the allocated space is reduced to keep it free" releaseDefault:"5GB" devDefault:"0B"`
 }
 
 // Service which monitors disk usage.
@@ -66,6 +74,26 @@ type Service struct {
 	VerifyDirReadableLoop *sync2.Cycle
 	VerifyDirWritableLoop *sync2.Cycle
 	Config                Config
+
+	diskHealth    *diskhealth.Service
+	notifications *notifications.Service
+
+	// readOnly is 1 while the storage directory isn't writable, and only downloads are served.
+	readOnly int32
+	// safeMode is 1 when the storage directory failed the preflight check, and only
+	// downloads are served until the node is restarted.
+	safeMode int32
+	// diskFull is 1 while the allocated space is used up.
+	diskFull int32
+	// shrunk is 1 while the allocated space is reduced, because other data filled the disk.
+	shrunk int32
+
+	// cutoverMarker is the file, which tells the node to stop accepting uploads during the
+	// cutover of a storage migration, when it contains backend.
+	cutoverMarker string
+	backend       string
+	// cutover is 1 during the cutover of a storage migration.
+	cutover int32
 }
 
 // NewService creates a new storage node monitoring service.
@@ -84,6 +112,46 @@ func NewService(log *zap.Logger, store *pieces.Store, contact *contact.Service,
 	}
 }
 
+// SetDiskHealth sets the disk health service, which can stop the uploads.
+func (service *Service) SetDiskHealth(diskHealth *diskhealth.Service) {
+	service.diskHealth = diskHealth
+}
+
+// SetNotifications sets the service which notifies the operator about the read-only mode.
+func (service *Service) SetNotifications(notifications *notifications.Service) {
+	service.notifications = notifications
+}
+
+// SetCutoverMarker sets the file, which tells the node to stop accepting uploads during the
+// cutover of a storage migration from its blob store, which is described by backend.
+func (service *Service) SetCutoverMarker(path, backend string) {
+	service.cutoverMarker = path
+	service.backend = backend
+}
+
+// ReadOnly returns whether the storage directory isn't writable or the node is in the
+// safe mode, and uploads are refused.
+func (service *Service) ReadOnly() bool {
+	return atomic.LoadInt32(&service.readOnly) == 1 || atomic.LoadInt32(&service.safeMode) == 1 || atomic.LoadInt32(&service.cutover) == 1
+}
+
+// EnterSafeMode stops accepting uploads until the node is restarted, because the storage
+// directory failed the preflight check.
+func (service *Service) EnterSafeMode(ctx context.Context, cause error) {
+	if !atomic.CompareAndSwapInt32(&service.safeMode, 0, 1) {
+		return
+	}
+	mon.Event("storage_dir_safe_mode")
+
+	service.notify(ctx, notifications.NewNotification{
+		SenderID: service.contact.Local().ID,
+		Type:     notifications.TypeCustom,
+		Title:    "Your Node started in safe mode",
+		Message:  "The storage directory failed the startup check, so your Node doesn't accept uploads: " + cause.Error() + ". Please fix the problem and restart your Node.",
+	})
+	service.NotifyLowDisk()
+}
+
 // Run runs monitor service.
 func (service *Service) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -132,6 +200,8 @@ func (service *Service) Run(ctx context.Context) (err error) {
 	group.Go(func() error {
 		timeout := service.Config.VerifyDirReadableTimeout
 		return service.VerifyDirReadableLoop.Run(ctx, func(ctx context.Context) error {
+			service.checkCutover(ctx)
+
 			err := service.store.VerifyStorageDirWithTimeout(ctx, service.contact.Local().ID, timeout)
 			if err != nil {
 				if errs.Is(err, context.DeadlineExceeded) {
@@ -154,6 +224,13 @@ func (service *Service) Run(ctx context.Context) (err error) {
 		timeout := service.Config.VerifyDirWritableTimeout
 		return service.VerifyDirWritableLoop.Run(ctx, func(ctx context.Context) error {
 			err := service.store.CheckWritabilityWithTimeout(ctx, timeout)
+			if err != nil && service.Config.ReadOnlyOnWriteFailure && !errs2.IsCanceled(err) {
+				service.enterReadOnly(ctx, err)
+				return nil
+			}
+			if err == nil {
+				service.leaveReadOnly(ctx)
+			}
 			if err != nil {
 				if errs.Is(err, context.DeadlineExceeded) {
 					if service.Config.VerifyDirWarnOnly {
@@ -197,6 +274,94 @@ func (service *Service) Run(ctx context.Context) (err error) {
 	return group.Wait()
 }
 
+// enterReadOnly switches to the read-only mode, and notifies the operator and the satellites.
+func (service *Service) enterReadOnly(ctx context.Context, cause error) {
+	if !atomic.CompareAndSwapInt32(&service.readOnly, 0, 1) {
+		return
+	}
+	mon.Event("storage_dir_read_only")
+	service.log.Error("storage directory is not writable, switching to read-only mode: only downloads and audits are served", zap.Error(cause))
+
+	service.notify(ctx, notifications.NewNotification{
+		SenderID: service.contact.Local().ID,
+		Type:     notifications.TypeCustom,
+		Title:    "Your Node's storage is read-only",
+		Message:  "The storage directory isn't writable, so your Node stopped accepting uploads. Please check the storage disk and its mount.",
+	})
+	// report the zero available space to the satellites.
+	service.NotifyLowDisk()
+}
+
+// leaveReadOnly switches back from the read-only mode.
+func (service *Service) leaveReadOnly(ctx context.Context) {
+	if !atomic.CompareAndSwapInt32(&service.readOnly, 1, 0) {
+		return
+	}
+	service.log.Info("storage directory is writable again, leaving read-only mode")
+
+	service.notify(ctx, notifications.NewNotification{
+		SenderID: service.contact.Local().ID,
+		Type:     notifications.TypeCustom,
+		Title:    "Your Node's storage is writable again",
+		Message:  "The storage directory is writable again, your Node accepts uploads.",
+	})
+	service.NotifyLowDisk()
+}
+
+// checkCutover switches to the read-only mode, while the cutover marker names the blob store
+// of the node. A marker of another blob store is left by a completed migration, after the
+// node was switched to the new blob store, so it's removed.
+func (service *Service) checkCutover(ctx context.Context) {
+	if service.cutoverMarker == "" {
+		return
+	}
+
+	marker, err := os.ReadFile(service.cutoverMarker)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		if atomic.CompareAndSwapInt32(&service.cutover, 1, 0) {
+			service.log.Info("storage migration cutover canceled, accepting uploads again")
+			service.NotifyLowDisk()
+		}
+		return
+	case err != nil:
+		service.log.Warn("unable to read the storage migration cutover marker", zap.Error(err))
+		return
+	}
+
+	if string(marker) != service.backend {
+		service.log.Info("removing the cutover marker of a completed storage migration", zap.String("Backend", string(marker)))
+		if err := os.Remove(service.cutoverMarker); err != nil {
+			service.log.Warn("unable to remove the storage migration cutover marker", zap.Error(err))
+		}
+		return
+	}
+
+	if !atomic.CompareAndSwapInt32(&service.cutover, 0, 1) {
+		return
+	}
+	mon.Event("storage_migration_cutover")
+	service.log.Warn("storage migration cutover started, switching to read-only mode: only downloads and audits are served until the node is restarted with the new storage")
+
+	service.notify(ctx, notifications.NewNotification{
+		SenderID: service.contact.Local().ID,
+		Type:     notifications.TypeCustom,
+		Title:    "Your Node's storage is being migrated",
+		Message:  "Your Node stopped accepting uploads until the storage migration is completed. Restart the node with the new storage configuration after the migration.",
+	})
+	// report the zero available space to the satellites.
+	service.NotifyLowDisk()
+}
+
+func (service *Service) notify(ctx context.Context, notification notifications.NewNotification) {
+	if service.notifications == nil {
+		return
+	}
+	if _, err := service.notifications.Receive(ctx, notification); err != nil {
+		service.log.Error("failed to notify the operator", zap.Error(err))
+	}
+}
+
 // NotifyLowDisk reports disk space to satellites if cooldown timer has expired.
 func (service *Service) NotifyLowDisk() {
 	service.cooldown.Trigger()
@@ -220,9 +385,81 @@ func (service *Service) updateNodeInformation(ctx context.Context) (err error) {
 		FreeDisk: freeSpace,
 	})
 
+	// no space is advertised also while the disk is read-only or failing, or while the
+	// allocated space is reduced, which are notified separately.
+	failing := service.ReadOnly() || (service.diskHealth != nil && !service.diskHealth.AcceptingUploads())
+	shrunk := atomic.LoadInt32(&service.shrunk) == 1
+	service.setDiskFull(ctx, freeSpace <= 0 && !failing && !shrunk)
+
 	return nil
 }
 
+// setDiskFull notifies the operator, when the allocated space got used up.
+func (service *Service) setDiskFull(ctx context.Context, full bool) {
+	if !full {
+		atomic.StoreInt32(&service.diskFull, 0)
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&service.diskFull, 0, 1) {
+		return
+	}
+	mon.Event("allocated_space_full")
+
+	service.notify(ctx, notifications.NewNotification{
+		SenderID: service.contact.Local().ID,
+		Type:     notifications.TypeDiskFull,
+		Title:    "Your Node's allocated space is full",
+		Message:  "Your Node used up its allocated disk space of " + memory.Size(service.allocatedDiskSpace).String() + " and stopped accepting uploads. Please allocate more space if the disk allows it.",
+	})
+}
+
+// effectiveAllocatedSpace returns the allocated space, reduced so the free disk margin isn't
+// used up, when other data filled the disk.
+func (service *Service) effectiveAllocatedSpace(usedSpace, diskFree int64) int64 {
+	allocated := service.allocatedDiskSpace
+	margin := service.Config.FreeDiskMargin.Int64()
+	if margin <= 0 {
+		return allocated
+	}
+	if limit := usedSpace + diskFree - margin; limit < allocated {
+		allocated = limit
+	}
+	if allocated < 0 {
+		allocated = 0
+	}
+	return allocated
+}
+
+// setShrunk notifies the operator, when the allocated space got reduced, because other data
+// filled the disk. The uploads are refused before they'd fail on the full disk.
+func (service *Service) setShrunk(ctx context.Context, allocatedSpace int64) {
+	if allocatedSpace >= service.allocatedDiskSpace {
+		if atomic.CompareAndSwapInt32(&service.shrunk, 1, 0) {
+			service.log.Info("free disk space recovered, the allocated space is restored",
+				zap.Int64("bytes", service.allocatedDiskSpace))
+			service.NotifyLowDisk()
+		}
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&service.shrunk, 0, 1) {
+		return
+	}
+	mon.Event("allocated_space_shrunk")
+	service.log.Warn("other data filled the disk, reducing the allocated space",
+		zap.Int64("Allocated", service.allocatedDiskSpace),
+		zap.Int64("Effective", allocatedSpace),
+		zap.Int64("Margin", service.Config.FreeDiskMargin.Int64()))
+
+	service.notify(ctx, notifications.NewNotification{
+		SenderID: service.contact.Local().ID,
+		Type:     notifications.TypeCustom,
+		Title:    "Your Node's disk is filling up",
+		Message:  "Other data filled the disk of your Node, so its allocated space was reduced from " + memory.Size(service.allocatedDiskSpace).String() + " to " + memory.Size(allocatedSpace).String() + " to keep " + service.Config.FreeDiskMargin.String() + " free. Please free up space on the disk or lower the allocated space.",
+	})
+	// report the reduced available space to the satellites.
+	service.NotifyLowDisk()
+}
+
 // AvailableSpace returns available disk space for upload.
 func (service *Service) AvailableSpace(ctx context.Context) (_ int64, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -232,17 +469,27 @@ func (service *Service) AvailableSpace(ctx context.Context) (_ int64, err error)
 		return 0, err
 	}
 
-	freeSpaceForStorj := service.allocatedDiskSpace - usedSpace
-
 	diskStatus, err := service.store.StorageStatus(ctx)
 	if err != nil {
 		return 0, Error.Wrap(err)
 	}
+
+	allocatedSpace := service.effectiveAllocatedSpace(usedSpace, diskStatus.DiskFree)
+	service.setShrunk(ctx, allocatedSpace)
+
+	freeSpaceForStorj := allocatedSpace - usedSpace
 	if diskStatus.DiskFree < freeSpaceForStorj {
 		freeSpaceForStorj = diskStatus.DiskFree
 	}
 
+	// no space is advertised while the disk is failing or read-only, so the satellites stop
+	// selecting the node for uploads.
+	if service.ReadOnly() || (service.diskHealth != nil && !service.diskHealth.AcceptingUploads()) {
+		freeSpaceForStorj = 0
+	}
+
 	mon.IntVal("allocated_space").Observe(service.allocatedDiskSpace)
+	mon.IntVal("effective_allocated_space").Observe(allocatedSpace)
 	mon.IntVal("used_space").Observe(usedSpace)
 	mon.IntVal("available_space").Observe(freeSpaceForStorj)
 
@@ -269,7 +516,7 @@ func (service *Service) DiskSpace(ctx context.Context) (_ DiskSpace, err error)
 
 	overused := int64(0)
 
-	available := service.allocatedDiskSpace - (usedForPieces + usedForTrash)
+	available := service.effectiveAllocatedSpace(usedForPieces+usedForTrash, storageStatus.DiskFree) - (usedForPieces + usedForTrash)
 	if available < 0 {
 		overused = -available
 	}
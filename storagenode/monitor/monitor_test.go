@@ -4,16 +4,23 @@
 package monitor_test
 
 import (
+	"path/filepath"
+	"syscall"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
 	"storj.io/common/memory"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/blobstore/testblobs"
 	"storj.io/storj/storagenode/internalpb"
+	"storj.io/storj/storagenode/notifications"
+	"storj.io/storj/storagenode/storagemigration"
 )
 
 func TestMonitor(t *testing.T) {
@@ -43,3 +50,95 @@ func TestMonitor(t *testing.T) {
 		assert.NotZero(t, nodeAssertions, "No storage node were verifed")
 	})
 }
+
+func TestReadOnlyMode(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNodeDB: func(index int, db storagenode.DB, log *zap.Logger) (storagenode.DB, error) {
+				return testblobs.NewBadDB(log.Named("baddb"), db), nil
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		node := planet.StorageNodes[0]
+		monitor := node.Storage2.Monitor
+		require.False(t, monitor.ReadOnly())
+
+		node.DB.(*testblobs.BadDB).SetCheckError(syscall.EROFS)
+		monitor.VerifyDirWritableLoop.TriggerWait()
+		require.True(t, monitor.ReadOnly())
+
+		available, err := monitor.AvailableSpace(ctx)
+		require.NoError(t, err)
+		require.Zero(t, available)
+
+		page, err := node.Notifications.Service.List(ctx, notifications.Cursor{Limit: 10, Page: 1})
+		require.NoError(t, err)
+		require.NotEmpty(t, page.Notifications)
+
+		node.DB.(*testblobs.BadDB).SetCheckError(nil)
+		monitor.VerifyDirWritableLoop.TriggerWait()
+		require.False(t, monitor.ReadOnly())
+
+		available, err = monitor.AvailableSpace(ctx)
+		require.NoError(t, err)
+		require.NotZero(t, available)
+	})
+}
+
+func TestStorageMigrationCutover(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		node := planet.StorageNodes[0]
+		monitor := node.Storage2.Monitor
+		storageDir := node.Config.Storage.Path
+
+		// the marker of another blob store is removed.
+		require.NoError(t, storagemigration.MarkCutover(storageDir, "dirs:/elsewhere"))
+		monitor.VerifyDirReadableLoop.TriggerWait()
+		require.False(t, monitor.ReadOnly())
+		require.NoFileExists(t, filepath.Join(storageDir, storagemigration.CutoverMarker))
+
+		require.NoError(t, storagemigration.MarkCutover(storageDir, storagemigration.Backend(node.Config.DatabaseConfig())))
+		monitor.VerifyDirReadableLoop.TriggerWait()
+		require.True(t, monitor.ReadOnly())
+
+		available, err := monitor.AvailableSpace(ctx)
+		require.NoError(t, err)
+		require.Zero(t, available)
+
+		// removing the marker cancels the cutover.
+		require.NoError(t, storagemigration.UnmarkCutover(storageDir))
+		monitor.VerifyDirReadableLoop.TriggerWait()
+		require.False(t, monitor.ReadOnly())
+	})
+}
+
+func TestFreeDiskMargin(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNode: func(index int, config *storagenode.Config) {
+				// more than the free space of any disk.
+				config.Storage2.Monitor.FreeDiskMargin = memory.PB
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		node := planet.StorageNodes[0]
+		monitor := node.Storage2.Monitor
+
+		available, err := monitor.AvailableSpace(ctx)
+		require.NoError(t, err)
+		require.LessOrEqual(t, available, int64(0))
+
+		page, err := node.Notifications.Service.List(ctx, notifications.Cursor{Limit: 10, Page: 1})
+		require.NoError(t, err)
+		require.Len(t, page.Notifications, 1)
+		require.Equal(t, "Your Node's disk is filling up", page.Notifications[0].Title)
+
+		diskSpace, err := monitor.DiskSpace(ctx)
+		require.NoError(t, err)
+		require.LessOrEqual(t, diskSpace.Available, int64(0))
+	})
+}
@@ -0,0 +1,236 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multinode
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/private/multinodepb"
+	"storj.io/storj/storagenode/apikeys"
+	"storj.io/storj/storagenode/operator"
+	"storj.io/storj/storagenode/piecestore/shaping"
+)
+
+// SettingsFile is the file in the storage directory, which keeps the settings changed by
+// the multinode dashboard.
+const SettingsFile = "multinode-settings.json"
+
+// ErrSettings is the error class of the remote settings.
+var ErrSettings = errs.Class("multinode settings")
+
+var _ multinodepb.DRPCSettingsServer = (*SettingsEndpoint)(nil)
+
+// SettingsConfig defines whether the multinode dashboard may change the settings of the node.
+type SettingsConfig struct {
+	AllowUpdates bool `help:"allow the multinode dashboard to change the allocated disk space, the bandwidth limits and the operator wallet" default:"false"`
+}
+
+// SettingsOverrides are the settings changed by the multinode dashboard, which override the
+// configuration of the node. Nil settings aren't overridden.
+type SettingsOverrides struct {
+	AllocatedDiskSpace *int64  `json:"allocatedDiskSpace,omitempty"`
+	Wallet             *string `json:"wallet,omitempty"`
+	Ingress            *int64  `json:"ingress,omitempty"`
+	Egress             *int64  `json:"egress,omitempty"`
+}
+
+// LoadSettingsOverrides reads the overrides from the file. A missing file has no overrides.
+func LoadSettingsOverrides(path string) (overrides SettingsOverrides, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return SettingsOverrides{}, nil
+		}
+		return SettingsOverrides{}, ErrSettings.Wrap(err)
+	}
+	return overrides, ErrSettings.Wrap(json.Unmarshal(data, &overrides))
+}
+
+// saveSettingsOverrides replaces the file with the overrides.
+func saveSettingsOverrides(path string, overrides SettingsOverrides) error {
+	data, err := json.MarshalIndent(overrides, "", "  ")
+	if err != nil {
+		return ErrSettings.Wrap(err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return ErrSettings.Wrap(err)
+	}
+	_, err = tmp.Write(data)
+	err = errs.Combine(err, tmp.Sync(), tmp.Close())
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		return ErrSettings.Wrap(errs.Combine(err, os.Remove(tmp.Name())))
+	}
+	return nil
+}
+
+// SettingsEndpoint implements multinode settings endpoint.
+//
+// The bandwidth limits are changed immediately, the allocated disk space and the wallet
+// when the node is restarted.
+//
+// architecture: Endpoint
+type SettingsEndpoint struct {
+	multinodepb.DRPCSettingsUnimplementedServer
+
+	log     *zap.Logger
+	config  SettingsConfig
+	apiKeys *apikeys.Service
+	shaper  *shaping.Shaper
+	path    string
+
+	// allocatedDiskSpace and wallet are the settings, with which the node is running.
+	allocatedDiskSpace memory.Size
+	wallet             string
+
+	mu sync.Mutex
+}
+
+// NewSettingsEndpoint creates new multinode settings endpoint. The overrides are saved
+// to path.
+func NewSettingsEndpoint(log *zap.Logger, config SettingsConfig, apiKeys *apikeys.Service, shaper *shaping.Shaper, path string, allocatedDiskSpace memory.Size, wallet string) *SettingsEndpoint {
+	return &SettingsEndpoint{
+		log:                log,
+		config:             config,
+		apiKeys:            apiKeys,
+		shaper:             shaper,
+		path:               path,
+		allocatedDiskSpace: allocatedDiskSpace,
+		wallet:             wallet,
+	}
+}
+
+// Settings returns the settings of the node.
+func (settings *SettingsEndpoint) Settings(ctx context.Context, req *multinodepb.SettingsRequest) (_ *multinodepb.SettingsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, settings.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	settings.mu.Lock()
+	defer settings.mu.Unlock()
+
+	overrides, err := LoadSettingsOverrides(settings.path)
+	if err != nil {
+		settings.log.Error("settings internal error", zap.Error(err))
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	current, restartRequired := settings.current(overrides)
+	return &multinodepb.SettingsResponse{
+		Settings:        current,
+		RestartRequired: restartRequired,
+		UpdatesAllowed:  settings.config.AllowUpdates,
+	}, nil
+}
+
+// UpdateSettings changes the settings of the node. The zero settings aren't changed.
+func (settings *SettingsEndpoint) UpdateSettings(ctx context.Context, req *multinodepb.UpdateSettingsRequest) (_ *multinodepb.UpdateSettingsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, settings.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+	if !settings.config.AllowUpdates {
+		return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "the node doesn't allow changing its settings, see --multinode.allow-updates")
+	}
+
+	update := req.GetSettings()
+	if update.GetAllocatedDiskSpace() < 0 {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "allocated disk space can't be negative")
+	}
+	if update.GetWallet() != "" {
+		if err := operator.ValidateWallet(update.GetWallet()); err != nil {
+			return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
+		}
+	}
+	limits := update.GetBandwidthLimits()
+	if limits.GetIngress() < 0 || limits.GetEgress() < 0 {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "bandwidth limits can't be negative")
+	}
+
+	settings.mu.Lock()
+	defer settings.mu.Unlock()
+
+	overrides, err := LoadSettingsOverrides(settings.path)
+	if err != nil {
+		settings.log.Error("update settings internal error", zap.Error(err))
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	if allocated := update.GetAllocatedDiskSpace(); allocated > 0 {
+		overrides.AllocatedDiskSpace = &allocated
+	}
+	if wallet := update.GetWallet(); wallet != "" {
+		overrides.Wallet = &wallet
+	}
+	if limits != nil {
+		ingress, egress := limits.GetIngress(), limits.GetEgress()
+		overrides.Ingress, overrides.Egress = &ingress, &egress
+	}
+
+	if err := saveSettingsOverrides(settings.path, overrides); err != nil {
+		settings.log.Error("update settings internal error", zap.Error(err))
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	if limits != nil {
+		_, satellites := settings.shaper.Limits()
+		settings.shaper.SetLimits(shaping.Limits{
+			Ingress: memory.Size(limits.GetIngress()),
+			Egress:  memory.Size(limits.GetEgress()),
+		}, satellites)
+	}
+
+	current, restartRequired := settings.current(overrides)
+	settings.log.Info("settings changed by the multinode dashboard",
+		zap.Int64("Allocated Disk Space", current.AllocatedDiskSpace),
+		zap.String("Wallet", current.Wallet),
+		zap.Int64("Ingress", current.BandwidthLimits.Ingress),
+		zap.Int64("Egress", current.BandwidthLimits.Egress),
+		zap.Bool("Restart Required", restartRequired))
+
+	return &multinodepb.UpdateSettingsResponse{
+		Settings:        current,
+		RestartRequired: restartRequired,
+	}, nil
+}
+
+// current returns the settings, which the node runs with after a restart, and whether
+// they differ from the running ones.
+func (settings *SettingsEndpoint) current(overrides SettingsOverrides) (_ *multinodepb.NodeSettings, restartRequired bool) {
+	allocated, wallet := settings.allocatedDiskSpace.Int64(), settings.wallet
+	if overrides.AllocatedDiskSpace != nil {
+		allocated = *overrides.AllocatedDiskSpace
+	}
+	if overrides.Wallet != nil {
+		wallet = *overrides.Wallet
+	}
+	global, _ := settings.shaper.Limits()
+
+	return &multinodepb.NodeSettings{
+		AllocatedDiskSpace: allocated,
+		Wallet:             wallet,
+		BandwidthLimits: &multinodepb.BandwidthLimits{
+			Ingress: global.Ingress.Int64(),
+			Egress:  global.Egress.Int64(),
+		},
+	}, allocated != settings.allocatedDiskSpace.Int64() || wallet != settings.wallet
+}
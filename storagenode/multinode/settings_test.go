@@ -0,0 +1,89 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multinode_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/multinodepb"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/apikeys"
+	"storj.io/storj/storagenode/multinode"
+	"storj.io/storj/storagenode/piecestore/shaping"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestSettingsEndpoint(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		service := apikeys.NewService(db.APIKeys())
+		key, err := service.Issue(ctx)
+		require.NoError(t, err)
+		header := &multinodepb.RequestHeader{ApiKey: key.Secret[:]}
+
+		shaper, err := shaping.NewShaper(shaping.Config{})
+		require.NoError(t, err)
+
+		path := ctx.File(multinode.SettingsFile)
+		wallet := "0x0000000000000000000000000000000000000001"
+		newWallet := "0x0000000000000000000000000000000000000002"
+
+		endpoint := multinode.NewSettingsEndpoint(log, multinode.SettingsConfig{}, service, shaper, path, memory.TB, wallet)
+
+		response, err := endpoint.Settings(ctx, &multinodepb.SettingsRequest{Header: header})
+		require.NoError(t, err)
+		require.EqualValues(t, memory.TB, response.Settings.AllocatedDiskSpace)
+		require.Equal(t, wallet, response.Settings.Wallet)
+		require.False(t, response.RestartRequired)
+		require.False(t, response.UpdatesAllowed)
+
+		_, err = endpoint.UpdateSettings(ctx, &multinodepb.UpdateSettingsRequest{
+			Header:   header,
+			Settings: &multinodepb.NodeSettings{Wallet: newWallet},
+		})
+		require.Equal(t, rpcstatus.PermissionDenied, rpcstatus.Code(err))
+
+		endpoint = multinode.NewSettingsEndpoint(log, multinode.SettingsConfig{AllowUpdates: true}, service, shaper, path, memory.TB, wallet)
+
+		_, err = endpoint.UpdateSettings(ctx, &multinodepb.UpdateSettingsRequest{
+			Header:   header,
+			Settings: &multinodepb.NodeSettings{Wallet: "invalid"},
+		})
+		require.Equal(t, rpcstatus.InvalidArgument, rpcstatus.Code(err))
+
+		updated, err := endpoint.UpdateSettings(ctx, &multinodepb.UpdateSettingsRequest{
+			Header: header,
+			Settings: &multinodepb.NodeSettings{
+				Wallet:          newWallet,
+				BandwidthLimits: &multinodepb.BandwidthLimits{Ingress: memory.MB.Int64()},
+			},
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, memory.TB, updated.Settings.AllocatedDiskSpace)
+		require.Equal(t, newWallet, updated.Settings.Wallet)
+		require.EqualValues(t, memory.MB, updated.Settings.BandwidthLimits.Ingress)
+		require.True(t, updated.RestartRequired)
+
+		// the bandwidth limits are changed immediately.
+		global, _ := shaper.Limits()
+		require.Equal(t, memory.MB, global.Ingress)
+
+		// the other settings are applied after a restart.
+		overrides, err := multinode.LoadSettingsOverrides(path)
+		require.NoError(t, err)
+		require.Nil(t, overrides.AllocatedDiskSpace)
+		require.Equal(t, newWallet, *overrides.Wallet)
+		require.EqualValues(t, memory.MB, *overrides.Ingress)
+		require.Zero(t, *overrides.Egress)
+
+		_, err = endpoint.Settings(ctx, &multinodepb.SettingsRequest{Header: &multinodepb.RequestHeader{ApiKey: make([]byte, 16)}})
+		require.Equal(t, rpcstatus.Unauthenticated, rpcstatus.Code(err))
+	})
+}
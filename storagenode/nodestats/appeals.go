@@ -0,0 +1,105 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodestats
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/appealpb"
+)
+
+// ErrAppealRefused is returned when the satellite refuses the appeal, e.g. because the node
+// is neither suspended nor disqualified or its previous appeal is still pending.
+var ErrAppealRefused = errs.Class("appeal refused")
+
+// Appeal is a request to reinstate the node, which is suspended or disqualified on the
+// satellite.
+type Appeal struct {
+	ID          uuid.UUID    `json:"id"`
+	SatelliteID storj.NodeID `json:"satelliteId"`
+	Kind        string       `json:"kind"`
+	Status      string       `json:"status"`
+	Message     string       `json:"message"`
+	ReviewNote  string       `json:"reviewNote"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	ReviewedAt  *time.Time   `json:"reviewedAt"`
+}
+
+// FileAppeal files an appeal of the suspension or the disqualification of the node on the
+// satellite.
+func (s *Service) FileAppeal(ctx context.Context, satelliteID storj.NodeID, message string) (_ Appeal, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	client, err := s.dial(ctx, satelliteID)
+	if err != nil {
+		return Appeal{}, NodeStatsServiceErr.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, client.Close()) }()
+
+	resp, err := client.appeals.FileAppeal(ctx, &appealpb.FileAppealRequest{Message: message})
+	if err != nil {
+		switch rpcstatus.Code(err) {
+		case rpcstatus.InvalidArgument, rpcstatus.FailedPrecondition:
+			return Appeal{}, ErrAppealRefused.Wrap(err)
+		}
+		return Appeal{}, NodeStatsServiceErr.Wrap(err)
+	}
+
+	appeal, err := fromAppealProto(resp.GetAppeal(), satelliteID)
+	return appeal, NodeStatsServiceErr.Wrap(err)
+}
+
+// ListAppeals returns the appeals of the node on the satellite, the newest first.
+func (s *Service) ListAppeals(ctx context.Context, satelliteID storj.NodeID) (_ []Appeal, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	client, err := s.dial(ctx, satelliteID)
+	if err != nil {
+		return nil, NodeStatsServiceErr.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, client.Close()) }()
+
+	resp, err := client.appeals.ListAppeals(ctx, &appealpb.ListAppealsRequest{})
+	if err != nil {
+		// the satellites, which don't accept the appeals.
+		if rpcstatus.Code(err) == rpcstatus.Unimplemented {
+			return nil, nil
+		}
+		return nil, NodeStatsServiceErr.Wrap(err)
+	}
+
+	appeals := make([]Appeal, 0, len(resp.GetAppeals()))
+	for _, pbAppeal := range resp.GetAppeals() {
+		appeal, err := fromAppealProto(pbAppeal, satelliteID)
+		if err != nil {
+			return nil, NodeStatsServiceErr.Wrap(err)
+		}
+		appeals = append(appeals, appeal)
+	}
+	return appeals, nil
+}
+
+// fromAppealProto converts the appeal received from the satellite.
+func fromAppealProto(appeal *appealpb.Appeal, satelliteID storj.NodeID) (Appeal, error) {
+	id, err := uuid.FromBytes(appeal.GetId())
+	if err != nil {
+		return Appeal{}, err
+	}
+	return Appeal{
+		ID:          id,
+		SatelliteID: satelliteID,
+		Kind:        appeal.GetKind(),
+		Status:      appeal.GetStatus(),
+		Message:     appeal.GetMessage(),
+		ReviewNote:  appeal.GetReviewNote(),
+		CreatedAt:   appeal.GetCreatedAt(),
+		ReviewedAt:  appeal.GetReviewedAt(),
+	}, nil
+}
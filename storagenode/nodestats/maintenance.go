@@ -0,0 +1,134 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodestats
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/maintenancepb"
+)
+
+var (
+	// ErrMaintenanceRefused is returned when the satellite refuses the maintenance window, e.g.
+	// because it overlaps another window or exceeds the monthly limit.
+	ErrMaintenanceRefused = errs.Class("maintenance refused")
+
+	// ErrMaintenanceNotFound is returned when the maintenance window doesn't exist or has
+	// already started.
+	ErrMaintenanceNotFound = errs.Class("maintenance not found")
+)
+
+// MaintenanceWindow is a planned downtime of the node, during which the satellite doesn't
+// penalize the node for being offline.
+type MaintenanceWindow struct {
+	ID            uuid.UUID    `json:"id"`
+	SatelliteID   storj.NodeID `json:"satelliteId"`
+	StartsAt      time.Time    `json:"startsAt"`
+	EndsAt        time.Time    `json:"endsAt"`
+	Reason        string       `json:"reason"`
+	OfflineAudits int64        `json:"offlineAudits"`
+	CreatedAt     time.Time    `json:"createdAt"`
+}
+
+// ScheduleMaintenance schedules a maintenance window of the node on the satellite.
+func (s *Service) ScheduleMaintenance(ctx context.Context, satelliteID storj.NodeID, startsAt, endsAt time.Time, reason string) (_ MaintenanceWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	client, err := s.dial(ctx, satelliteID)
+	if err != nil {
+		return MaintenanceWindow{}, NodeStatsServiceErr.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, client.Close()) }()
+
+	resp, err := client.maintenance.ScheduleMaintenance(ctx, &maintenancepb.ScheduleMaintenanceRequest{
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+		Reason:   reason,
+	})
+	if err != nil {
+		switch rpcstatus.Code(err) {
+		case rpcstatus.InvalidArgument, rpcstatus.Unimplemented:
+			return MaintenanceWindow{}, ErrMaintenanceRefused.Wrap(err)
+		}
+		return MaintenanceWindow{}, NodeStatsServiceErr.Wrap(err)
+	}
+
+	window, err := fromMaintenanceProto(resp.GetWindow(), satelliteID)
+	return window, NodeStatsServiceErr.Wrap(err)
+}
+
+// ListMaintenance returns the maintenance windows of the node on the satellite, which end
+// in the current month or later.
+func (s *Service) ListMaintenance(ctx context.Context, satelliteID storj.NodeID) (_ []MaintenanceWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	client, err := s.dial(ctx, satelliteID)
+	if err != nil {
+		return nil, NodeStatsServiceErr.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, client.Close()) }()
+
+	resp, err := client.maintenance.ListMaintenance(ctx, &maintenancepb.ListMaintenanceRequest{})
+	if err != nil {
+		// the satellites, which don't accept the maintenance windows.
+		if rpcstatus.Code(err) == rpcstatus.Unimplemented {
+			return nil, nil
+		}
+		return nil, NodeStatsServiceErr.Wrap(err)
+	}
+
+	windows := make([]MaintenanceWindow, 0, len(resp.GetWindows()))
+	for _, pbWindow := range resp.GetWindows() {
+		window, err := fromMaintenanceProto(pbWindow, satelliteID)
+		if err != nil {
+			return nil, NodeStatsServiceErr.Wrap(err)
+		}
+		windows = append(windows, window)
+	}
+	return windows, nil
+}
+
+// CancelMaintenance cancels the maintenance window of the node on the satellite, which
+// hasn't started yet.
+func (s *Service) CancelMaintenance(ctx context.Context, satelliteID storj.NodeID, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	client, err := s.dial(ctx, satelliteID)
+	if err != nil {
+		return NodeStatsServiceErr.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, client.Close()) }()
+
+	_, err = client.maintenance.CancelMaintenance(ctx, &maintenancepb.CancelMaintenanceRequest{Id: id.Bytes()})
+	if err != nil {
+		if rpcstatus.Code(err) == rpcstatus.NotFound {
+			return ErrMaintenanceNotFound.Wrap(err)
+		}
+		return NodeStatsServiceErr.Wrap(err)
+	}
+	return nil
+}
+
+// fromMaintenanceProto converts the maintenance window received from the satellite.
+func fromMaintenanceProto(window *maintenancepb.MaintenanceWindow, satelliteID storj.NodeID) (MaintenanceWindow, error) {
+	id, err := uuid.FromBytes(window.GetId())
+	if err != nil {
+		return MaintenanceWindow{}, err
+	}
+	return MaintenanceWindow{
+		ID:            id,
+		SatelliteID:   satelliteID,
+		StartsAt:      window.GetStartsAt(),
+		EndsAt:        window.GetEndsAt(),
+		Reason:        window.GetReason(),
+		OfflineAudits: window.GetOfflineAudits(),
+		CreatedAt:     window.GetCreatedAt(),
+	}, nil
+}
@@ -13,7 +13,11 @@ import (
 
 	"storj.io/common/pb"
 	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
+	"storj.io/storj/private/appealpb"
+	"storj.io/storj/private/containmentpb"
+	"storj.io/storj/private/maintenancepb"
 	"storj.io/storj/storagenode/pricing"
 	"storj.io/storj/storagenode/reputation"
 	"storj.io/storj/storagenode/storageusage"
@@ -33,6 +37,10 @@ var (
 type Client struct {
 	conn *rpc.Conn
 	pb.DRPCNodeStatsClient
+
+	containment containmentpb.DRPCNodeContainmentClient
+	appeals     appealpb.DRPCNodeAppealsClient
+	maintenance maintenancepb.DRPCNodeMaintenanceClient
 }
 
 // Close closes underlying client connection.
@@ -84,6 +92,12 @@ func (s *Service) GetReputationStats(ctx context.Context, satelliteID storj.Node
 	mon.FloatVal("suspension_score", satelliteIDSeriesTag).Observe(audit.GetUnknownReputationScore())
 	mon.FloatVal("online_score", satelliteIDSeriesTag).Observe(resp.GetOnlineScore())
 
+	containment, err := s.getContainment(ctx, client, satelliteID)
+	if err != nil {
+		return nil, NodeStatsServiceErr.Wrap(err)
+	}
+	mon.IntVal("contained_pieces", satelliteIDSeriesTag).Observe(containment.PendingCount)
+
 	return &reputation.Stats{
 		SatelliteID: satelliteID,
 		Audit: reputation.Metric{
@@ -103,11 +117,32 @@ func (s *Service) GetReputationStats(ctx context.Context, satelliteID storj.Node
 		OfflineUnderReviewAt: resp.GetOfflineUnderReview(),
 		VettedAt:             resp.GetVettedAt(),
 		AuditHistory:         resp.GetAuditHistory(),
+		Containment:          containment,
 		UpdatedAt:            time.Now(),
 		JoinedAt:             resp.JoinedAt,
 	}, nil
 }
 
+// getContainment retrieves the pieces of the node awaiting a reverification audit.
+func (s *Service) getContainment(ctx context.Context, client *Client, satelliteID storj.NodeID) (_ reputation.Containment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	resp, err := client.containment.GetContainment(ctx, &containmentpb.GetContainmentRequest{})
+	if err != nil {
+		// the satellites, which don't expose the containment yet.
+		if rpcstatus.Code(err) == rpcstatus.Unimplemented {
+			s.log.Debug("satellite doesn't expose the containment", zap.Stringer("Satellite ID", satelliteID))
+			return reputation.Containment{}, nil
+		}
+		return reputation.Containment{}, err
+	}
+
+	return reputation.Containment{
+		PendingCount:    resp.GetPendingCount(),
+		OldestPendingAt: resp.GetOldestPendingAt(),
+	}, nil
+}
+
 // GetDailyStorageUsage returns daily storage usage over a period of time for a particular satellite.
 func (s *Service) GetDailyStorageUsage(ctx context.Context, satelliteID storj.NodeID, from, to time.Time) (_ []storageusage.Stamp, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -167,6 +202,9 @@ func (s *Service) dial(ctx context.Context, satelliteID storj.NodeID) (_ *Client
 	return &Client{
 		conn:                conn,
 		DRPCNodeStatsClient: pb.NewDRPCNodeStatsClient(conn),
+		containment:         containmentpb.NewDRPCNodeContainmentClient(conn),
+		appeals:             appealpb.NewDRPCNodeAppealsClient(conn),
+		maintenance:         maintenancepb.NewDRPCNodeMaintenanceClient(conn),
 	}, nil
 }
 
@@ -0,0 +1,142 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package notifications
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+)
+
+// ErrChannel is the error class for sending the notifications to the channels.
+var ErrChannel = errs.Class("notification channel")
+
+// dispatchQueue is the number of the notifications waiting to be sent, before the new
+// ones are dropped.
+const dispatchQueue = 100
+
+// Config defines the channels, to which the critical notifications are sent.
+type Config struct {
+	SMTPServerAddress string        `help:"smtp server address to send the critical notifications by email, e.g. smtp.example.com:587" default:""`
+	SMTPLogin         string        `help:"login of the smtp server" default:""`
+	SMTPPassword      string        `help:"password of the smtp server" default:""`
+	EmailFrom         string        `help:"sender address of the notification emails" default:""`
+	EmailTo           string        `help:"comma separated recipients of the notification emails, the operator email when empty" default:""`
+	WebhookURL        string        `help:"url, to which the critical notifications are posted as json" default:""`
+	Desktop           bool          `help:"show the critical notifications on the desktop of the logged in users, only on windows" default:"false"`
+	Timeout           time.Duration `help:"timeout of sending a notification to a channel" default:"30s"`
+}
+
+// IsCritical returns whether the notifications of the type are sent to the channels.
+func IsCritical(typ Type) bool {
+	switch typ {
+	case TypeDisqualification, TypeSuspension, TypeOffline, TypeDiskFull:
+		return true
+	default:
+		return false
+	}
+}
+
+// channel sends the notifications outside of the node.
+type channel interface {
+	Name() string
+	Send(ctx context.Context, notification Notification) error
+}
+
+// Dispatcher sends the critical notifications to the configured channels.
+//
+// architecture: Service
+type Dispatcher struct {
+	log      *zap.Logger
+	timeout  time.Duration
+	channels []channel
+	queue    chan Notification
+}
+
+// NewDispatcher creates a dispatcher for the configured channels. The emails are sent to
+// the operator email, unless the recipients are configured.
+func NewDispatcher(log *zap.Logger, config Config, operatorEmail string) (*Dispatcher, error) {
+	dispatcher := &Dispatcher{
+		log:     log,
+		timeout: config.Timeout,
+		queue:   make(chan Notification, dispatchQueue),
+	}
+
+	if config.SMTPServerAddress != "" {
+		email, err := newEmailChannel(config, operatorEmail)
+		if err != nil {
+			return nil, err
+		}
+		dispatcher.channels = append(dispatcher.channels, email)
+	}
+	if config.WebhookURL != "" {
+		webhook, err := newWebhookChannel(config.WebhookURL)
+		if err != nil {
+			return nil, err
+		}
+		dispatcher.channels = append(dispatcher.channels, webhook)
+	}
+	if config.Desktop {
+		desktop, err := newDesktopChannel()
+		if err != nil {
+			return nil, err
+		}
+		dispatcher.channels = append(dispatcher.channels, desktop)
+	}
+
+	return dispatcher, nil
+}
+
+// Dispatch queues the notification for sending. When the queue is full, the notification
+// is only kept in the database.
+func (dispatcher *Dispatcher) Dispatch(notification Notification) {
+	if dispatcher == nil || len(dispatcher.channels) == 0 {
+		return
+	}
+
+	select {
+	case dispatcher.queue <- notification:
+	default:
+		mon.Event("notification_dispatch_dropped")
+		dispatcher.log.Warn("too many notifications, not sending", zap.String("Title", notification.Title))
+	}
+}
+
+// Run sends the queued notifications until the context is canceled.
+func (dispatcher *Dispatcher) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case notification := <-dispatcher.queue:
+			dispatcher.send(ctx, notification)
+		}
+	}
+}
+
+// send sends the notification to all the channels.
+func (dispatcher *Dispatcher) send(ctx context.Context, notification Notification) {
+	for _, channel := range dispatcher.channels {
+		sendCtx, cancel := ctx, func() {}
+		if dispatcher.timeout > 0 {
+			sendCtx, cancel = context.WithTimeout(ctx, dispatcher.timeout)
+		}
+		err := channel.Send(sendCtx, notification)
+		cancel()
+
+		if err != nil {
+			mon.Event("notification_send_failed")
+			dispatcher.log.Error("failed to send notification", zap.String("Channel", channel.Name()), zap.String("Title", notification.Title), zap.Error(err))
+		}
+	}
+}
+
+// Close stops the dispatcher.
+func (dispatcher *Dispatcher) Close() error {
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package notifications_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/notifications"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestDispatcher_Webhook(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		received := make(chan notifications.Notification, 10)
+		webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var notification notifications.Notification
+			if err := json.NewDecoder(r.Body).Decode(&notification); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			received <- notification
+		}))
+		defer webhook.Close()
+
+		dispatcher, err := notifications.NewDispatcher(zaptest.NewLogger(t), notifications.Config{
+			WebhookURL: webhook.URL,
+			Timeout:    time.Minute,
+		}, "")
+		require.NoError(t, err)
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		ctx.Go(func() error { return dispatcher.Run(runCtx) })
+
+		service := notifications.NewService(zaptest.NewLogger(t), db.Notifications())
+		service.SetDispatcher(dispatcher)
+
+		nodeID := testrand.NodeID()
+		_, err = service.Receive(ctx, notifications.NewNotification{
+			SenderID: nodeID,
+			Type:     notifications.TypeCustom,
+			Title:    "not critical",
+		})
+		require.NoError(t, err)
+
+		_, err = service.Receive(ctx, notifications.NewNotification{
+			SenderID: nodeID,
+			Type:     notifications.TypeDiskFull,
+			Title:    "disk full",
+			Message:  "allocate more space",
+		})
+		require.NoError(t, err)
+
+		select {
+		case notification := <-received:
+			require.Equal(t, nodeID, notification.SenderID)
+			require.Equal(t, notifications.TypeDiskFull, notification.Type)
+			require.Equal(t, "disk full", notification.Title)
+			require.Equal(t, "allocate more space", notification.Message)
+		case <-time.After(10 * time.Second):
+			t.Fatal("the critical notification wasn't sent")
+		}
+
+		// both notifications are still stored.
+		unread, err := service.UnreadAmount(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, unread)
+	})
+}
+
+func TestDispatcher_InvalidConfig(t *testing.T) {
+	_, err := notifications.NewDispatcher(zaptest.NewLogger(t), notifications.Config{
+		WebhookURL: "ftp://example.com",
+	}, "")
+	require.Error(t, err)
+
+	_, err = notifications.NewDispatcher(zaptest.NewLogger(t), notifications.Config{
+		SMTPServerAddress: "smtp.example.com",
+	}, "operator@example.com")
+	require.Error(t, err)
+
+	dispatcher, err := notifications.NewDispatcher(zaptest.NewLogger(t), notifications.Config{
+		SMTPServerAddress: "smtp.example.com:587",
+	}, "operator@example.com")
+	require.NoError(t, err)
+	require.NotNil(t, dispatcher)
+}
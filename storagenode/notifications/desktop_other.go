@@ -0,0 +1,11 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+//go:build !windows
+// +build !windows
+
+package notifications
+
+func newDesktopChannel() (channel, error) {
+	return nil, ErrChannel.New("desktop notifications are only supported on windows")
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package notifications
+
+import (
+	"context"
+	"unsafe"
+
+	"github.com/zeebo/errs"
+	"golang.org/x/sys/windows"
+)
+
+var (
+	wtsapi32           = windows.NewLazySystemDLL("wtsapi32.dll")
+	procWTSSendMessage = wtsapi32.NewProc("WTSSendMessageW")
+)
+
+const (
+	// mbIconWarning is MB_ICONWARNING of the message box.
+	mbIconWarning = 0x30
+	// desktopTimeout is how long the message box is shown, in seconds.
+	desktopTimeout = 0
+)
+
+// desktopChannel shows the notifications as message boxes in the active sessions, because
+// the node runs as a windows service without its own desktop.
+type desktopChannel struct{}
+
+func newDesktopChannel() (channel, error) {
+	if err := procWTSSendMessage.Find(); err != nil {
+		return nil, ErrChannel.New("desktop notifications are not supported: %v", err)
+	}
+	return desktopChannel{}, nil
+}
+
+// Name implements channel.
+func (desktopChannel) Name() string { return "desktop" }
+
+// Send implements channel.
+func (desktopChannel) Send(ctx context.Context, notification Notification) (err error) {
+	title, err := windows.UTF16FromString("Storage Node: " + notification.Title)
+	if err != nil {
+		return ErrChannel.Wrap(err)
+	}
+	message, err := windows.UTF16FromString(notification.Message)
+	if err != nil {
+		return ErrChannel.Wrap(err)
+	}
+
+	var sessions *windows.WTS_SESSION_INFO
+	var count uint32
+	if err := windows.WTSEnumerateSessions(0, 0, 1, &sessions, &count); err != nil {
+		return ErrChannel.Wrap(err)
+	}
+	defer windows.WTSFreeMemory(uintptr(unsafe.Pointer(sessions)))
+
+	var group errs.Group
+	for _, session := range unsafe.Slice(sessions, count) {
+		if session.State != windows.WTSActive {
+			continue
+		}
+
+		var response uint32
+		r, _, callErr := procWTSSendMessage.Call(
+			0, // WTS_CURRENT_SERVER_HANDLE
+			uintptr(session.SessionID),
+			uintptr(unsafe.Pointer(&title[0])), uintptr(len(title)*2),
+			uintptr(unsafe.Pointer(&message[0])), uintptr(len(message)*2),
+			mbIconWarning, desktopTimeout,
+			uintptr(unsafe.Pointer(&response)),
+			0, // don't wait for the response
+		)
+		if r == 0 {
+			group.Add(callErr)
+		}
+	}
+	return ErrChannel.Wrap(group.Err())
+}
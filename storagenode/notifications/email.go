@@ -0,0 +1,80 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package notifications
+
+import (
+	"context"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+
+	"storj.io/storj/private/post"
+)
+
+// emailChannel sends the notifications by email.
+type emailChannel struct {
+	sender *post.SMTPSender
+	to     []post.Address
+}
+
+func newEmailChannel(config Config, operatorEmail string) (*emailChannel, error) {
+	host, _, err := net.SplitHostPort(config.SMTPServerAddress)
+	if err != nil {
+		return nil, ErrChannel.New("smtp server address %q couldn't be parsed: %v", config.SMTPServerAddress, err)
+	}
+
+	recipients := config.EmailTo
+	if recipients == "" {
+		recipients = operatorEmail
+	}
+	to, err := mail.ParseAddressList(recipients)
+	if err != nil {
+		return nil, ErrChannel.New("email recipients %q couldn't be parsed: %v", recipients, err)
+	}
+
+	fromAddress := config.EmailFrom
+	if fromAddress == "" {
+		fromAddress = to[0].Address
+	}
+	from, err := mail.ParseAddress(fromAddress)
+	if err != nil {
+		return nil, ErrChannel.New("email sender %q couldn't be parsed: %v", fromAddress, err)
+	}
+
+	channel := &emailChannel{
+		sender: &post.SMTPSender{
+			ServerAddress: config.SMTPServerAddress,
+			From:          *from,
+		},
+	}
+	if config.SMTPLogin != "" {
+		channel.sender.Auth = smtp.PlainAuth("", config.SMTPLogin, config.SMTPPassword, host)
+	}
+	for _, address := range to {
+		channel.to = append(channel.to, *address)
+	}
+	return channel, nil
+}
+
+// Name implements channel.
+func (channel *emailChannel) Name() string { return "email" }
+
+// Send implements channel.
+func (channel *emailChannel) Send(ctx context.Context, notification Notification) error {
+	var body strings.Builder
+	body.WriteString(notification.Message)
+	body.WriteString("\n\nNode ID: ")
+	body.WriteString(notification.SenderID.String())
+	body.WriteString("\nTime: ")
+	body.WriteString(notification.CreatedAt.UTC().String())
+
+	return ErrChannel.Wrap(channel.sender.SendEmail(ctx, &post.Message{
+		From:      channel.sender.From,
+		To:        channel.to,
+		Subject:   notification.Title,
+		Date:      notification.CreatedAt,
+		PlainText: body.String(),
+	}))
+}
@@ -35,6 +35,10 @@ const (
 	TypeDisqualification Type = 2
 	// TypeSuspension is a notification type which describes node's suspension status.
 	TypeSuspension Type = 3
+	// TypeOffline is a notification type which describes node being offline or under review for it.
+	TypeOffline Type = 4
+	// TypeDiskFull is a notification type which describes node's allocated disk space being used up.
+	TypeDiskFull Type = 5
 )
 
 // NewNotification holds notification entity info which is being received from satellite or local client.
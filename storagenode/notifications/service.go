@@ -33,8 +33,9 @@ const (
 // Service is the notification service between storage nodes and satellites.
 // architecture: Service
 type Service struct {
-	log *zap.Logger
-	db  DB
+	log        *zap.Logger
+	db         DB
+	dispatcher *Dispatcher
 }
 
 // NewService creates a new notification service.
@@ -45,13 +46,23 @@ func NewService(log *zap.Logger, db DB) *Service {
 	}
 }
 
+// SetDispatcher sets the dispatcher, which sends the critical notifications to the configured channels.
+func (service *Service) SetDispatcher(dispatcher *Dispatcher) {
+	service.dispatcher = dispatcher
+}
+
 // Receive - receives notifications from satellite and Insert them into DB.
+// The critical notifications are also sent to the configured channels.
 func (service *Service) Receive(ctx context.Context, newNotification NewNotification) (Notification, error) {
 	notification, err := service.db.Insert(ctx, newNotification)
 	if err != nil {
 		return Notification{}, err
 	}
 
+	if IsCritical(notification.Type) {
+		service.dispatcher.Dispatch(notification)
+	}
+
 	return notification, nil
 }
 
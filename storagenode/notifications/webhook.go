@@ -0,0 +1,63 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/zeebo/errs"
+)
+
+// webhookChannel posts the notifications as json to an url.
+type webhookChannel struct {
+	url    string
+	client *http.Client
+}
+
+func newWebhookChannel(webhookURL string) (*webhookChannel, error) {
+	parsed, err := url.Parse(webhookURL)
+	if err != nil {
+		return nil, ErrChannel.New("webhook url %q couldn't be parsed: %v", webhookURL, err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nil, ErrChannel.New("webhook url %q must use http or https", webhookURL)
+	}
+
+	return &webhookChannel{
+		url:    webhookURL,
+		client: &http.Client{},
+	}, nil
+}
+
+// Name implements channel.
+func (channel *webhookChannel) Name() string { return "webhook" }
+
+// Send implements channel.
+func (channel *webhookChannel) Send(ctx context.Context, notification Notification) (err error) {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return ErrChannel.Wrap(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, channel.url, bytes.NewReader(body))
+	if err != nil {
+		return ErrChannel.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := channel.client.Do(req)
+	if err != nil {
+		return ErrChannel.Wrap(err)
+	}
+	defer func() { err = ErrChannel.Wrap(errs.Combine(err, resp.Body.Close())) }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return ErrChannel.New("webhook responded with %s", resp.Status)
+	}
+	return nil
+}
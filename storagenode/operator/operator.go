@@ -45,6 +45,16 @@ func isOperatorEmailValid(log *zap.Logger, email string) error {
 }
 
 func isOperatorWalletValid(log *zap.Logger, wallet string) error {
+	if err := ValidateWallet(wallet); err != nil {
+		return err
+	}
+
+	log.Info("Operator wallet", zap.String("Address", wallet))
+	return nil
+}
+
+// ValidateWallet checks whether the wallet is a valid ethereum address.
+func ValidateWallet(wallet string) error {
 	if wallet == "" {
 		return fmt.Errorf("operator wallet address isn't specified")
 	}
@@ -52,8 +62,6 @@ func isOperatorWalletValid(log *zap.Logger, wallet string) error {
 	if match := r.MatchString(wallet); !match {
 		return fmt.Errorf("operator wallet address isn't valid")
 	}
-
-	log.Info("Operator wallet", zap.String("Address", wallet))
 	return nil
 }
 
@@ -6,6 +6,7 @@ package orders
 import (
 	"context"
 	"math/rand"
+	"sort"
 	"sync"
 	"time"
 
@@ -18,6 +19,9 @@ import (
 	"storj.io/common/rpc"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/drpc/drpcmetadata"
+	"storj.io/storj/private/capabilitiespb"
 	"storj.io/storj/storagenode/orders/ordersfile"
 	"storj.io/storj/storagenode/trust"
 )
@@ -76,6 +80,15 @@ type DB interface {
 	CleanArchive(ctx context.Context, deleteBefore time.Time) (int, error)
 }
 
+// SettlementExtensions provides the settlement extensions announced by the satellites after
+// their downtime.
+type SettlementExtensions interface {
+	// SettlementExtension returns how long after its expiration the satellite accepts an
+	// order limit, which was valid from creation until expiration, and the token, which
+	// flags the settlement.
+	SettlementExtension(satelliteID storj.NodeID, creation, expiration time.Time) (token uuid.UUID, extension time.Duration)
+}
+
 // Config defines configuration for sending orders.
 type Config struct {
 	MaxSleep          time.Duration `help:"maximum duration to wait before trying to send orders" releaseDefault:"30s" devDefault:"1s"`
@@ -84,6 +97,9 @@ type Config struct {
 	SenderDialTimeout time.Duration `help:"timeout for dialing satellite during sending orders" default:"1m0s"`
 	CleanupInterval   time.Duration `help:"duration between archive cleanups" default:"5m0s"`
 	ArchiveTTL        time.Duration `help:"length of time to archive orders before deletion" default:"168h0m0s"` // 7 days
+	RetryInterval     time.Duration `help:"how frequently the failed settlements are checked for a retry" releaseDefault:"5m0s" devDefault:"10s"`
+	RetryBackoff      time.Duration `help:"delay before retrying a failed settlement, doubled after every consecutive failure" releaseDefault:"5m0s" devDefault:"10s"`
+	MaxRetryBackoff   time.Duration `help:"maximum delay before retrying a failed settlement" default:"4h0m0s"`
 	Path              string        `help:"path to store order limit files in" default:"$CONFDIR/orders"`
 }
 
@@ -98,11 +114,27 @@ type Service struct {
 	ordersStore *FileStore
 	orders      DB
 	trust       *trust.Pool
+	extensions  SettlementExtensions
+
+	// sendMu ensures that the windows are settled only by one sender at a time.
+	sendMu sync.Mutex
+
+	failuresMu sync.Mutex
+	failures   map[storj.NodeID]*settlementFailure
 
 	Sender  *sync2.Cycle
+	Retry   *sync2.Cycle
 	Cleanup *sync2.Cycle
 }
 
+// settlementFailure tracks the consecutive failed settlements with a satellite.
+type settlementFailure struct {
+	count       int
+	lastError   string
+	lastAttempt time.Time
+	nextAttempt time.Time
+}
+
 // NewService creates an order service.
 func NewService(log *zap.Logger, dialer rpc.Dialer, ordersStore *FileStore, orders DB, trust *trust.Pool, config Config) *Service {
 	return &Service{
@@ -112,8 +144,10 @@ func NewService(log *zap.Logger, dialer rpc.Dialer, ordersStore *FileStore, orde
 		orders:      orders,
 		config:      config,
 		trust:       trust,
+		failures:    make(map[storj.NodeID]*settlementFailure),
 
 		Sender:  sync2.NewCycle(config.SenderInterval),
+		Retry:   sync2.NewCycle(config.RetryInterval),
 		Cleanup: sync2.NewCycle(config.CleanupInterval),
 	}
 }
@@ -133,6 +167,15 @@ func (service *Service) Run(ctx context.Context) (err error) {
 
 		return nil
 	})
+	if service.config.RetryInterval > 0 {
+		service.Retry.Start(ctx, &group, func(ctx context.Context) error {
+			now := time.Now()
+			if service.retryDue(now) {
+				service.SendOrders(ctx, now)
+			}
+			return nil
+		})
+	}
 	service.Cleanup.Start(ctx, &group, func(ctx context.Context) error {
 		if err := service.sleep(ctx); err != nil {
 			return err
@@ -149,6 +192,12 @@ func (service *Service) Run(ctx context.Context) (err error) {
 	return group.Wait()
 }
 
+// SetSettlementExtensions enables settling the orders, which expired during a downtime of
+// the satellite, with the extensions announced by the satellite.
+func (service *Service) SetSettlementExtensions(extensions SettlementExtensions) {
+	service.extensions = extensions
+}
+
 // CleanArchive removes all archived orders that were archived before the deleteBefore time.
 func (service *Service) CleanArchive(ctx context.Context, deleteBefore time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -166,6 +215,15 @@ func (service *Service) CleanArchive(ctx context.Context, deleteBefore time.Time
 		return nil
 	}
 
+	expired, err := service.ordersStore.ArchiveExpired(ctx, time.Now(), service.extensions)
+	if err != nil {
+		service.log.Error("archiving expired unsent orders", zap.Error(err))
+	}
+	if expired > 0 {
+		mon.Meter("orders_unsent_expired_archived").Mark(expired)
+		service.log.Warn("archived unsent orders, which expired before they were settled", zap.Int("windows", expired))
+	}
+
 	service.log.Debug("cleanup finished", zap.Int("items deleted", deleted))
 	return nil
 }
@@ -173,6 +231,10 @@ func (service *Service) CleanArchive(ctx context.Context, deleteBefore time.Time
 // SendOrders sends the orders using now as the current time.
 func (service *Service) SendOrders(ctx context.Context, now time.Time) {
 	defer mon.Task()(&ctx)(nil)
+
+	service.sendMu.Lock()
+	defer service.sendMu.Unlock()
+
 	service.log.Debug("sending")
 
 	errorSatellites := make(map[storj.NodeID]struct{})
@@ -198,6 +260,9 @@ func (service *Service) SendOrders(ctx context.Context, now time.Time) {
 			if _, ok := errorSatellites[satelliteID]; ok {
 				continue
 			}
+			if service.backingOff(satelliteID, now) {
+				continue
+			}
 			attemptedSatellites++
 
 			group.Go(func() error {
@@ -208,9 +273,11 @@ func (service *Service) SendOrders(ctx context.Context, now time.Time) {
 					errorSatellitesMu.Lock()
 					errorSatellites[satelliteID] = struct{}{}
 					errorSatellitesMu.Unlock()
-					log.Error("failed to settle orders for satellite", zap.String("satellite ID", satelliteID.String()), zap.Error(err))
+					retryAt := service.settlementFailed(satelliteID, now, err)
+					log.Error("failed to settle orders for satellite", zap.String("satellite ID", satelliteID.String()), zap.Time("retry at", retryAt), zap.Error(err))
 					return nil
 				}
+				service.settlementSucceeded(satelliteID)
 
 				err = service.ordersStore.Archive(satelliteID, unsentInfo, time.Now().UTC(), status)
 				if err != nil {
@@ -249,6 +316,12 @@ func (service *Service) settleWindow(ctx context.Context, log *zap.Logger, satel
 	}
 	defer func() { err = errs.Combine(err, conn.Close()) }()
 
+	if token, ok := service.extensionToken(satelliteID, orders, time.Now()); ok {
+		log.Info("settling orders expired during satellite downtime", zap.Stringer("Token", token))
+		mon.Event("orders_settled_with_extension")
+		ctx = drpcmetadata.Add(ctx, capabilitiespb.SettlementExtensionKey, token.String())
+	}
+
 	stream, err := pb.NewDRPCOrdersClient(conn).SettlementWithWindow(ctx)
 	if err != nil {
 		return 0, OrderError.New("failed to start settlement: %w", err)
@@ -280,6 +353,155 @@ func (service *Service) settleWindow(ctx context.Context, log *zap.Logger, satel
 	return res.Status, nil
 }
 
+// extensionToken returns the token of the settlement extension, which flags the settlement
+// of the window, when it contains orders expired during a downtime of the satellite.
+func (service *Service) extensionToken(satelliteID storj.NodeID, orders []*ordersfile.Info, now time.Time) (uuid.UUID, bool) {
+	if service.extensions == nil {
+		return uuid.UUID{}, false
+	}
+	for _, order := range orders {
+		if !order.Limit.OrderExpiration.Before(now) {
+			continue
+		}
+		token, extension := service.extensions.SettlementExtension(satelliteID, order.Limit.OrderCreation, order.Limit.OrderExpiration)
+		if extension > 0 && order.Limit.OrderExpiration.Add(extension).After(now) {
+			return token, true
+		}
+	}
+	return uuid.UUID{}, false
+}
+
+// settlementFailed records a failed settlement with the satellite, and returns when the
+// settlement is retried.
+func (service *Service) settlementFailed(satelliteID storj.NodeID, now time.Time, err error) time.Time {
+	service.failuresMu.Lock()
+	defer service.failuresMu.Unlock()
+
+	failure, ok := service.failures[satelliteID]
+	if !ok {
+		failure = &settlementFailure{}
+		service.failures[satelliteID] = failure
+	}
+	failure.count++
+	failure.lastError = err.Error()
+	failure.lastAttempt = now
+	failure.nextAttempt = now.Add(service.retryBackoff(failure.count))
+
+	mon.Counter("orders_settlement_failures").Inc(1)
+	return failure.nextAttempt
+}
+
+// settlementSucceeded clears the failures of the satellite.
+func (service *Service) settlementSucceeded(satelliteID storj.NodeID) {
+	service.failuresMu.Lock()
+	defer service.failuresMu.Unlock()
+
+	delete(service.failures, satelliteID)
+}
+
+// backingOff returns whether the settlement with the satellite should wait for the retry.
+func (service *Service) backingOff(satelliteID storj.NodeID, now time.Time) bool {
+	service.failuresMu.Lock()
+	defer service.failuresMu.Unlock()
+
+	failure, ok := service.failures[satelliteID]
+	return ok && now.Before(failure.nextAttempt)
+}
+
+// retryDue returns whether there is a failed settlement, which should be retried.
+func (service *Service) retryDue(now time.Time) bool {
+	service.failuresMu.Lock()
+	defer service.failuresMu.Unlock()
+
+	for _, failure := range service.failures {
+		if !now.Before(failure.nextAttempt) {
+			return true
+		}
+	}
+	return false
+}
+
+// retryBackoff returns the delay before retrying after the number of consecutive failures.
+func (service *Service) retryBackoff(failures int) time.Duration {
+	backoff := service.config.RetryBackoff
+	for i := 1; i < failures && backoff < service.config.MaxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if service.config.MaxRetryBackoff > 0 && backoff > service.config.MaxRetryBackoff {
+		backoff = service.config.MaxRetryBackoff
+	}
+	return backoff
+}
+
+// SettlementStatus is the state of the unsent orders of a satellite.
+type SettlementStatus struct {
+	SatelliteID storj.NodeID `json:"satelliteID"`
+
+	UnsentWindows int       `json:"unsentWindows"`
+	UnsentOrders  int       `json:"unsentOrders"`
+	OldestWindow  time.Time `json:"oldestWindow"`
+	// Egress, Repair and Audit are the unsettled downloaded bytes, which are paid.
+	Egress int64 `json:"egress"`
+	Repair int64 `json:"repair"`
+	Audit  int64 `json:"audit"`
+
+	Failures    int       `json:"failures"`
+	LastError   string    `json:"lastError,omitempty"`
+	LastAttempt time.Time `json:"lastAttempt"`
+	NextRetry   time.Time `json:"nextRetry"`
+}
+
+// SettlementStatuses returns the states of the unsent orders of the satellites, which have
+// unsent orders or failed settlements.
+func (service *Service) SettlementStatuses(ctx context.Context) (_ []SettlementStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	summaries, err := service.ordersStore.SummarizeUnsent(ctx)
+	if err != nil && len(summaries) == 0 {
+		return nil, err
+	}
+	if err != nil {
+		service.log.Warn("some unsent orders couldn't be read", zap.Error(err))
+	}
+
+	statuses := make(map[storj.NodeID]*SettlementStatus)
+	statusOf := func(satelliteID storj.NodeID) *SettlementStatus {
+		if _, ok := statuses[satelliteID]; !ok {
+			statuses[satelliteID] = &SettlementStatus{SatelliteID: satelliteID}
+		}
+		return statuses[satelliteID]
+	}
+
+	for satelliteID, summary := range summaries {
+		status := statusOf(satelliteID)
+		status.UnsentWindows = summary.Windows
+		status.UnsentOrders = summary.Orders
+		status.OldestWindow = summary.OldestWindow
+		status.Egress = summary.Amounts[pb.PieceAction_GET]
+		status.Repair = summary.Amounts[pb.PieceAction_GET_REPAIR]
+		status.Audit = summary.Amounts[pb.PieceAction_GET_AUDIT]
+	}
+
+	service.failuresMu.Lock()
+	for satelliteID, failure := range service.failures {
+		status := statusOf(satelliteID)
+		status.Failures = failure.count
+		status.LastError = failure.lastError
+		status.LastAttempt = failure.lastAttempt
+		status.NextRetry = failure.nextAttempt
+	}
+	service.failuresMu.Unlock()
+
+	list := make([]SettlementStatus, 0, len(statuses))
+	for _, status := range statuses {
+		list = append(list, *status)
+	}
+	sort.Slice(list, func(i, k int) bool {
+		return list[i].SatelliteID.Less(list[k].SatelliteID)
+	})
+	return list, nil
+}
+
 // sleep for random interval in [0;maxSleep).
 // Returns an error if context was cancelled.
 func (service *Service) sleep(ctx context.Context) error {
@@ -298,6 +520,7 @@ func (service *Service) sleep(ctx context.Context) error {
 // Close stops the sending service.
 func (service *Service) Close() error {
 	service.Sender.Close()
+	service.Retry.Close()
 	service.Cleanup.Close()
 	return nil
 }
@@ -0,0 +1,65 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package orders
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/rpc"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+)
+
+func TestSettlementBackoff(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	store, err := NewFileStore(zaptest.NewLogger(t), ctx.Dir("orders"), time.Hour)
+	require.NoError(t, err)
+
+	service := NewService(zaptest.NewLogger(t), rpc.Dialer{}, store, nil, nil, Config{
+		RetryBackoff:    time.Minute,
+		MaxRetryBackoff: 5 * time.Minute,
+	})
+
+	require.Equal(t, time.Minute, service.retryBackoff(1))
+	require.Equal(t, 2*time.Minute, service.retryBackoff(2))
+	require.Equal(t, 4*time.Minute, service.retryBackoff(3))
+	require.Equal(t, 5*time.Minute, service.retryBackoff(4))
+	require.Equal(t, 5*time.Minute, service.retryBackoff(100))
+
+	satelliteID := testrand.NodeID()
+	now := time.Now()
+	require.False(t, service.backingOff(satelliteID, now))
+	require.False(t, service.retryDue(now))
+
+	retryAt := service.settlementFailed(satelliteID, now, errors.New("satellite unavailable"))
+	require.Equal(t, now.Add(time.Minute), retryAt)
+	retryAt = service.settlementFailed(satelliteID, now, errors.New("satellite unavailable"))
+	require.Equal(t, now.Add(2*time.Minute), retryAt)
+
+	require.True(t, service.backingOff(satelliteID, now.Add(time.Minute)))
+	require.False(t, service.retryDue(now.Add(time.Minute)))
+	require.False(t, service.backingOff(satelliteID, now.Add(2*time.Minute)))
+	require.True(t, service.retryDue(now.Add(2*time.Minute)))
+
+	statuses, err := service.SettlementStatuses(ctx)
+	require.NoError(t, err)
+	require.Len(t, statuses, 1)
+	require.Equal(t, satelliteID, statuses[0].SatelliteID)
+	require.Equal(t, 2, statuses[0].Failures)
+	require.Equal(t, "satellite unavailable", statuses[0].LastError)
+	require.Equal(t, retryAt, statuses[0].NextRetry)
+
+	service.settlementSucceeded(satelliteID)
+	require.False(t, service.backingOff(satelliteID, now))
+
+	statuses, err = service.SettlementStatuses(ctx)
+	require.NoError(t, err)
+	require.Empty(t, statuses)
+}
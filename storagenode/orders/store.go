@@ -286,6 +286,152 @@ func (store *FileStore) Archive(satelliteID storj.NodeID, unsentInfo UnsentInfo,
 	))
 }
 
+// ArchiveExpired moves the unsent windows, which contain only expired orders, to the
+// archive as rejected without sending them. The satellites reject expired orders, so the
+// windows would otherwise only stay in the unsent directory when the settlement keeps failing.
+// The windows, which the satellite still accepts with a settlement extension, are kept. The
+// extensions may be nil.
+func (store *FileStore) ArchiveExpired(ctx context.Context, now time.Time, extensions SettlementExtensions) (archived int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.unsentMu.Lock()
+	defer store.unsentMu.Unlock()
+	store.archiveMu.Lock()
+	defer store.archiveMu.Unlock()
+
+	var errList error
+	err = filepath.Walk(store.unsentDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			errList = errs.Combine(errList, OrderError.Wrap(err))
+			return nil //nolint: nilerr // errors are collected separately
+		}
+		if info.IsDir() {
+			return nil
+		}
+		fileInfo, err := ordersfile.GetUnsentInfo(info)
+		if err != nil {
+			errList = errs.Combine(errList, OrderError.Wrap(err))
+			return nil //nolint: nilerr // errors are collected separately
+		}
+		if store.hasActiveEnqueue(fileInfo.SatelliteID, fileInfo.CreatedAtHour) {
+			return nil
+		}
+
+		// the orders of a window are created within the hour, so they also expire within
+		// an hour of the first order.
+		first, err := readFirst(path, fileInfo.Version)
+		if err != nil {
+			errList = errs.Combine(errList, err)
+			return nil
+		}
+		if first != nil {
+			var extension time.Duration
+			if extensions != nil {
+				_, extension = extensions.SettlementExtension(fileInfo.SatelliteID, first.Limit.OrderCreation, first.Limit.OrderExpiration)
+			}
+			if !now.After(first.Limit.OrderExpiration.Add(time.Hour + extension)) {
+				return nil
+			}
+		}
+
+		err = ordersfile.MoveUnsent(store.unsentDir, store.archiveDir, fileInfo.SatelliteID, fileInfo.CreatedAtHour,
+			now, pb.SettlementWithWindowResponse_REJECTED, fileInfo.Version)
+		if err != nil {
+			errList = errs.Combine(errList, OrderError.Wrap(err))
+			return nil
+		}
+		archived++
+		return nil
+	})
+
+	return archived, errs.Combine(errList, err)
+}
+
+// readFirst reads the first order of an orders file. It returns nil, when the file is empty.
+func readFirst(path string, version ordersfile.Version) (_ *ordersfile.Info, err error) {
+	of, err := ordersfile.OpenReadable(path, version)
+	if err != nil {
+		return nil, OrderError.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, OrderError.Wrap(of.Close())) }()
+
+	info, err := of.ReadOne()
+	if errs.Is(err, io.EOF) {
+		return nil, nil
+	}
+	return info, OrderError.Wrap(err)
+}
+
+// UnsentSummary summarizes the unsent orders of a satellite.
+type UnsentSummary struct {
+	Windows      int
+	Orders       int
+	OldestWindow time.Time
+	// Amounts are the ordered bytes by the action.
+	Amounts map[pb.PieceAction]int64
+}
+
+// SummarizeUnsent returns the summaries of all the unsent orders by satellite, including the
+// windows, which can still get new orders.
+func (store *FileStore) SummarizeUnsent(ctx context.Context) (summaries map[storj.NodeID]*UnsentSummary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var errList error
+	summaries = make(map[storj.NodeID]*UnsentSummary)
+
+	err = filepath.Walk(store.unsentDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			errList = errs.Combine(errList, OrderError.Wrap(err))
+			return nil //nolint: nilerr // errors are collected separately
+		}
+		if info.IsDir() {
+			return nil
+		}
+		fileInfo, err := ordersfile.GetUnsentInfo(info)
+		if err != nil {
+			errList = errs.Combine(errList, OrderError.Wrap(err))
+			return nil //nolint: nilerr // errors are collected separately
+		}
+
+		summary, ok := summaries[fileInfo.SatelliteID]
+		if !ok {
+			summary = &UnsentSummary{Amounts: map[pb.PieceAction]int64{}}
+			summaries[fileInfo.SatelliteID] = summary
+		}
+		summary.Windows++
+		if summary.OldestWindow.IsZero() || fileInfo.CreatedAtHour.Before(summary.OldestWindow) {
+			summary.OldestWindow = fileInfo.CreatedAtHour
+		}
+
+		of, err := ordersfile.OpenReadable(path, fileInfo.Version)
+		if err != nil {
+			errList = errs.Combine(errList, OrderError.Wrap(err))
+			return nil
+		}
+		defer func() { errList = errs.Combine(errList, OrderError.Wrap(of.Close())) }()
+
+		for {
+			order, err := of.ReadOne()
+			if err != nil {
+				// the window may be appended to at the same time, so a corrupt entry at the
+				// end of the file is expected.
+				if errs.Is(err, io.EOF) || errs.Is(err, io.ErrUnexpectedEOF) {
+					return nil
+				}
+				if ordersfile.ErrEntryCorrupt.Has(err) {
+					continue
+				}
+				errList = errs.Combine(errList, OrderError.Wrap(err))
+				return nil
+			}
+			summary.Orders++
+			summary.Amounts[order.Limit.Action] += order.Order.Amount
+		}
+	})
+
+	return summaries, errs.Combine(errList, err)
+}
+
 // ListArchived returns orders that have been sent.
 func (store *FileStore) ListArchived() ([]*ArchivedInfo, error) {
 	store.archiveMu.Lock()
@@ -16,6 +16,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/common/uuid"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/orders/ordersfile"
@@ -579,3 +580,79 @@ func storeNewOrders(ordersStore *orders.FileStore, numSatellites, numOrdersPerSa
 	}
 	return originalInfos, nil
 }
+
+func TestOrdersStore_SummarizeUnsentAndArchiveExpired(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+	dirName := ctx.Dir("test-orders")
+	now := time.Now()
+
+	ordersStore, err := orders.NewFileStore(zaptest.NewLogger(t), dirName, 12*time.Hour)
+	require.NoError(t, err)
+
+	originalInfos, err := storeNewOrders(ordersStore, 2, 3, []time.Time{now.Add(-4 * time.Hour), now})
+	require.NoError(t, err)
+
+	expected := make(map[storj.NodeID]map[pb.PieceAction]int64)
+	for _, info := range originalInfos {
+		if expected[info.Limit.SatelliteId] == nil {
+			expected[info.Limit.SatelliteId] = make(map[pb.PieceAction]int64)
+		}
+		expected[info.Limit.SatelliteId][info.Limit.Action] += info.Order.Amount
+	}
+
+	// the summary includes the windows, which can still get new orders.
+	summaries, err := ordersStore.SummarizeUnsent(ctx)
+	require.NoError(t, err)
+	require.Len(t, summaries, 2)
+	for satelliteID, summary := range summaries {
+		require.Equal(t, 2, summary.Windows)
+		require.Equal(t, 6, summary.Orders)
+		require.True(t, summary.OldestWindow.Equal(now.Add(-4*time.Hour).Truncate(time.Hour)))
+		require.Equal(t, expected[satelliteID], summary.Amounts)
+	}
+
+	// the orders haven't expired yet.
+	archived, err := ordersStore.ArchiveExpired(ctx, now, nil)
+	require.NoError(t, err)
+	require.Zero(t, archived)
+
+	// the windows of a satellite, which was down, are kept during the settlement extension.
+	var extensions settlementExtension
+	for satelliteID := range summaries {
+		extensions = settlementExtension{satelliteID: satelliteID, extension: 4 * time.Hour}
+		break
+	}
+	archived, err = ordersStore.ArchiveExpired(ctx, now.Add(3*time.Hour), extensions)
+	require.NoError(t, err)
+	require.Equal(t, 2, archived)
+
+	// all the orders expire in an hour.
+	archived, err = ordersStore.ArchiveExpired(ctx, now.Add(3*time.Hour), nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, archived)
+
+	summaries, err = ordersStore.SummarizeUnsent(ctx)
+	require.NoError(t, err)
+	require.Empty(t, summaries)
+
+	archivedInfos, err := ordersStore.ListArchived()
+	require.NoError(t, err)
+	require.Len(t, archivedInfos, len(originalInfos))
+	for _, info := range archivedInfos {
+		require.Equal(t, orders.StatusRejected, info.Status)
+	}
+}
+
+// settlementExtension extends the settlement of all the orders of a satellite.
+type settlementExtension struct {
+	satelliteID storj.NodeID
+	extension   time.Duration
+}
+
+func (extension settlementExtension) SettlementExtension(satelliteID storj.NodeID, creation, expiration time.Time) (uuid.UUID, time.Duration) {
+	if satelliteID != extension.satelliteID {
+		return uuid.UUID{}, 0
+	}
+	return uuid.UUID{1}, extension.extension
+}
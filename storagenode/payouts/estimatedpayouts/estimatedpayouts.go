@@ -23,6 +23,10 @@ type PayoutMonthly struct {
 	EgressBandwidthPayout   float64 `json:"egressBandwidthPayout"`
 	EgressRepairAudit       int64   `json:"egressRepairAudit"`
 	EgressRepairAuditPayout float64 `json:"egressRepairAuditPayout"`
+	EgressRepair            int64   `json:"egressRepair"`
+	EgressRepairPayout      float64 `json:"egressRepairPayout"`
+	EgressAudit             int64   `json:"egressAudit"`
+	EgressAuditPayout       float64 `json:"egressAuditPayout"`
 	DiskSpace               float64 `json:"diskSpace"`
 	DiskSpacePayout         float64 `json:"diskSpacePayout"`
 	HeldRate                float64 `json:"heldRate"`
@@ -37,9 +41,16 @@ func (pm *PayoutMonthly) SetEgressBandwidthPayout(egressPrice int64) {
 }
 
 // SetEgressRepairAuditPayout counts audit and repair payouts for PayoutMonthly object.
-func (pm *PayoutMonthly) SetEgressRepairAuditPayout(auditRepairPrice int64) {
-	amount := float64(pm.EgressRepairAudit*auditRepairPrice) / math.Pow10(12)
-	pm.EgressRepairAuditPayout += RoundFloat(amount)
+// Repair and audit egress are paid by their own prices, EgressRepairAudit and
+// EgressRepairAuditPayout contain their sums.
+func (pm *PayoutMonthly) SetEgressRepairAuditPayout(repairPrice, auditPrice int64) {
+	repair := RoundFloat(float64(pm.EgressRepair*repairPrice) / math.Pow10(12))
+	audit := RoundFloat(float64(pm.EgressAudit*auditPrice) / math.Pow10(12))
+
+	pm.EgressRepairPayout += repair
+	pm.EgressAuditPayout += audit
+	pm.EgressRepairAudit = pm.EgressRepair + pm.EgressAudit
+	pm.EgressRepairAuditPayout += repair + audit
 }
 
 // SetDiskSpacePayout counts disk space payouts for PayoutMonthly object.
@@ -69,6 +80,10 @@ func (pm *PayoutMonthly) Add(monthly PayoutMonthly) {
 	pm.EgressBandwidth += monthly.EgressBandwidth
 	pm.EgressBandwidthPayout += monthly.EgressBandwidthPayout
 	pm.EgressRepairAudit += monthly.EgressRepairAudit
+	pm.EgressRepairPayout += monthly.EgressRepairPayout
+	pm.EgressRepair += monthly.EgressRepair
+	pm.EgressAuditPayout += monthly.EgressAuditPayout
+	pm.EgressAudit += monthly.EgressAudit
 	pm.Held += monthly.Held
 }
 
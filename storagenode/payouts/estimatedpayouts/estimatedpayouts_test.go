@@ -9,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"storj.io/common/testrand"
 	"storj.io/storj/storagenode/payouts/estimatedpayouts"
 )
 
@@ -314,3 +315,49 @@ func TestAddEstimationPayout(t *testing.T) {
 		require.Equal(t, test.basic, test.result)
 	}
 }
+
+func TestSetEgressRepairAuditPayout(t *testing.T) {
+	payout := estimatedpayouts.PayoutMonthly{
+		EgressRepair: 2e9,
+		EgressAudit:  1e9,
+	}
+	payout.SetEgressRepairAuditPayout(1000, 500)
+
+	require.Equal(t, 2.0, payout.EgressRepairPayout)
+	require.Equal(t, 0.5, payout.EgressAuditPayout)
+	require.EqualValues(t, 3e9, payout.EgressRepairAudit)
+	require.Equal(t, 2.5, payout.EgressRepairAuditPayout)
+
+	payout.SetHeldAmount()
+	payout.SetPayout()
+	require.Equal(t, 2.5, payout.Payout)
+}
+
+func TestProject(t *testing.T) {
+	satelliteID := testrand.NodeID()
+	now := time.Date(2021, 2, 15, 0, 0, 0, 0, time.UTC)
+	current := estimatedpayouts.PayoutMonthly{
+		DiskSpacePayout:         10,
+		EgressBandwidthPayout:   20,
+		EgressRepairAuditPayout: 30,
+	}
+
+	// joined before the month, half of the month has passed.
+	current.HeldRate = 25
+	projection := estimatedpayouts.Project(satelliteID, current, 150, now, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.Equal(t, satelliteID, projection.SatelliteID)
+	require.EqualValues(t, 150, projection.SurgePercent)
+	require.InDelta(t, 120, projection.Earned, 0.01)
+	require.InDelta(t, 180, projection.EarnedWithSurge, 0.01)
+	require.InDelta(t, 45, projection.Held, 0.01)
+	require.InDelta(t, 135, projection.Payout, 0.01)
+
+	// joined a week ago, a quarter of the time in the month has passed, without surge.
+	current.HeldRate = 75
+	projection = estimatedpayouts.Project(satelliteID, current, 0, now, time.Date(2021, 2, 8, 0, 0, 0, 0, time.UTC))
+	require.EqualValues(t, 100, projection.SurgePercent)
+	require.InDelta(t, 180, projection.Earned, 0.01)
+	require.InDelta(t, 180, projection.EarnedWithSurge, 0.01)
+	require.InDelta(t, 135, projection.Held, 0.01)
+	require.InDelta(t, 45, projection.Payout, 0.01)
+}
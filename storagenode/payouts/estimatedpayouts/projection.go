@@ -0,0 +1,70 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package estimatedpayouts
+
+import (
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/storj/private/date"
+)
+
+// noSurgePercent is the surge percent, which doesn't change the earnings.
+const noSurgePercent = 100
+
+// Projection contains the payout of the current month from a satellite, which is expected
+// when the current level of load remains the same until the end of the month.
+//
+// The amounts are in cents.
+type Projection struct {
+	SatelliteID  storj.NodeID  `json:"satelliteID"`
+	CurrentMonth PayoutMonthly `json:"currentMonth"`
+	// SurgePercent is the surge of the last paystub of the satellite, 100 means no surge.
+	SurgePercent int64   `json:"surgePercent"`
+	HeldRate     float64 `json:"heldRate"`
+
+	Earned          float64 `json:"earned"`
+	EarnedWithSurge float64 `json:"earnedWithSurge"`
+	Held            float64 `json:"held"`
+	Payout          float64 `json:"payout"`
+}
+
+// Project projects the usage of the month so far to the whole month, and applies the
+// surge and the held amount schedule.
+func Project(satelliteID storj.NodeID, current PayoutMonthly, surgePercent int64, now, joinedAt time.Time) Projection {
+	if surgePercent <= 0 {
+		surgePercent = noSurgePercent
+	}
+
+	earned := (current.DiskSpacePayout + current.EgressBandwidthPayout + current.EgressRepairAuditPayout) * monthFactor(now, joinedAt)
+	withSurge := earned * float64(surgePercent) / 100
+	held := withSurge * current.HeldRate / 100
+
+	return Projection{
+		SatelliteID:     satelliteID,
+		CurrentMonth:    current,
+		SurgePercent:    surgePercent,
+		HeldRate:        current.HeldRate,
+		Earned:          RoundFloat(earned),
+		EarnedWithSurge: RoundFloat(withSurge),
+		Held:            RoundFloat(held),
+		Payout:          RoundFloat(withSurge - held),
+	}
+}
+
+// monthFactor returns the ratio of the time the node is expected to be in the network in the
+// current month to the time it has been so far.
+func monthFactor(now, joinedAt time.Time) float64 {
+	start := date.UTCBeginOfMonth(now)
+	if joinedAt.After(start) {
+		start = joinedAt
+	}
+	endOfMonth := date.UTCEndOfMonth(now)
+
+	elapsed := now.Sub(start)
+	if elapsed <= 0 {
+		return 0
+	}
+	return endOfMonth.Sub(start).Minutes() / elapsed.Minutes()
+}
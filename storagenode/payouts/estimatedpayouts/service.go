@@ -37,6 +37,7 @@ type Service struct {
 	storageUsageDB storageusage.DB
 	pricingDB      pricing.DB
 	satelliteDB    satellites.DB
+	payoutsDB      payouts.DB
 	trust          *trust.Pool
 }
 
@@ -52,6 +53,11 @@ func NewService(bandwidthDB bandwidth.DB, reputationDB reputation.DB, storageUsa
 	}
 }
 
+// SetPayoutsDB sets the database of the paystubs, which is used for the surge of the projections.
+func (s *Service) SetPayoutsDB(payoutsDB payouts.DB) {
+	s.payoutsDB = payoutsDB
+}
+
 // GetSatelliteEstimatedPayout returns estimated payouts for current and previous months from specific satellite with current level of load.
 func (s *Service) GetSatelliteEstimatedPayout(ctx context.Context, satelliteID storj.NodeID, now time.Time) (payout EstimatedPayout, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -102,6 +108,67 @@ func (s *Service) GetAllSatellitesEstimatedPayout(ctx context.Context, now time.
 	return payout, nil
 }
 
+// GetProjectedPayouts returns the projected payouts of the current month from all the satellites,
+// on which the node isn't disqualified.
+func (s *Service) GetProjectedPayouts(ctx context.Context, now time.Time) (projections []Projection, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, satelliteID := range s.trust.GetSatellites(ctx) {
+		stats, err := s.reputationDB.Get(ctx, satelliteID)
+		if err != nil {
+			return nil, EstimationServiceErr.Wrap(err)
+		}
+		if stats.DisqualifiedAt != nil {
+			continue
+		}
+
+		priceModel, err := s.pricingDB.Get(ctx, satelliteID)
+		if err != nil {
+			return nil, EstimationServiceErr.Wrap(err)
+		}
+
+		current, err := s.estimationUsagePeriod(ctx, now.UTC(), stats.JoinedAt, priceModel)
+		if err != nil {
+			return nil, EstimationServiceErr.Wrap(err)
+		}
+
+		surgePercent, err := s.lastSurgePercent(ctx, satelliteID)
+		if err != nil {
+			return nil, EstimationServiceErr.Wrap(err)
+		}
+
+		projections = append(projections, Project(satelliteID, current, surgePercent, now, stats.JoinedAt))
+	}
+
+	return projections, nil
+}
+
+// lastSurgePercent returns the surge percent of the last paystub of the satellite.
+func (s *Service) lastSurgePercent(ctx context.Context, satelliteID storj.NodeID) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if s.payoutsDB == nil {
+		return noSurgePercent, nil
+	}
+
+	periods, err := s.payoutsDB.SatellitePeriods(ctx, satelliteID)
+	if err != nil || len(periods) == 0 {
+		return noSurgePercent, err
+	}
+
+	paystub, err := s.payoutsDB.GetPayStub(ctx, satelliteID, periods[len(periods)-1])
+	if err != nil {
+		if payouts.ErrNoPayStubForPeriod.Has(err) {
+			return noSurgePercent, nil
+		}
+		return 0, err
+	}
+	if paystub.SurgePercent <= 0 {
+		return noSurgePercent, nil
+	}
+	return paystub.SurgePercent, nil
+}
+
 // estimatedPayout returns estimated payouts data for current and previous months from specific satellite.
 func (s *Service) estimatedPayout(ctx context.Context, satelliteID storj.NodeID, now time.Time) (currentMonthPayout PayoutMonthly, previousMonthPayout PayoutMonthly, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -137,10 +204,11 @@ func (s *Service) estimationUsagePeriod(ctx context.Context, period time.Time, j
 
 	for i := 0; i < len(bandwidthDaily); i++ {
 		payout.EgressBandwidth += bandwidthDaily[i].Egress.Usage
-		payout.EgressRepairAudit += bandwidthDaily[i].Egress.Audit + bandwidthDaily[i].Egress.Repair
+		payout.EgressRepair += bandwidthDaily[i].Egress.Repair
+		payout.EgressAudit += bandwidthDaily[i].Egress.Audit
 	}
 	payout.SetEgressBandwidthPayout(priceModel.EgressBandwidth)
-	payout.SetEgressRepairAuditPayout(priceModel.AuditBandwidth)
+	payout.SetEgressRepairAuditPayout(priceModel.RepairBandwidth, priceModel.AuditBandwidth)
 
 	storageDaily, err := s.storageUsageDB.GetDaily(ctx, priceModel.SatelliteID, from, to)
 	if err != nil {
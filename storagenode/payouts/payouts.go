@@ -10,6 +10,7 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/common/storj"
+	"storj.io/storj/private/blockchain"
 )
 
 // DB works with payouts database.
@@ -139,6 +140,28 @@ type SatellitePayoutForPeriod struct {
 	Receipt        string  `json:"receipt"`
 	IsExitComplete bool    `json:"isExitComplete"`
 	Distributed    int64   `json:"distributed"`
+	// Transaction is the on-chain transaction of the payout, parsed from the receipt.
+	Transaction *PayoutTransaction `json:"transaction"`
+}
+
+// PayoutTransaction is the on-chain transaction of a payout, which the node operator
+// can verify in the block explorer of the chain.
+type PayoutTransaction struct {
+	blockchain.Transaction
+	URL string `json:"url"`
+}
+
+// ParsePayoutTransaction parses the payout transaction from the receipt. It returns nil,
+// when the receipt doesn't reference a transaction on a supported chain.
+func ParsePayoutTransaction(receipt string) *PayoutTransaction {
+	transaction, err := blockchain.ParseReceipt(receipt)
+	if err != nil {
+		return nil
+	}
+	return &PayoutTransaction{
+		Transaction: transaction,
+		URL:         transaction.ExplorerURL(),
+	}
 }
 
 // HeldAmountHistory contains held amount history for satellite.
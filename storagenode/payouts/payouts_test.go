@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/private/blockchain"
 )
 
 func TestParsePeriodRange(t *testing.T) {
@@ -40,3 +42,16 @@ func TestParsePeriodRange(t *testing.T) {
 		}
 	}
 }
+
+func TestParsePayoutTransaction(t *testing.T) {
+	const hash = "0xdea1082dbea119c822dfe804264f5b880d4208ef51e8c5a8995eff10a5094de8"
+
+	transaction := ParsePayoutTransaction("polygon:" + hash)
+	require.NotNil(t, transaction)
+	require.Equal(t, blockchain.ChainPolygon, transaction.Chain)
+	require.True(t, transaction.Layer2)
+	require.Equal(t, "https://polygonscan.com/tx/"+hash, transaction.URL)
+
+	require.Nil(t, ParsePayoutTransaction(""))
+	require.Nil(t, ParsePayoutTransaction("paid by wire transfer"))
+}
@@ -299,6 +299,7 @@ func (service *Service) AllSatellitesPayoutPeriod(ctx context.Context, period st
 		heldPercent := GetHeldRate(stats.JoinedAt, heldPeriod)
 		payoutForPeriod.Held = paystub.Held
 		payoutForPeriod.Receipt = receipt
+		payoutForPeriod.Transaction = ParsePayoutTransaction(receipt)
 		payoutForPeriod.Surge = surge
 		payoutForPeriod.AfterHeld = surge - paystub.Held
 		payoutForPeriod.Age = int64(date.MonthsCountSince(stats.JoinedAt))
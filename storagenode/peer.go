@@ -19,6 +19,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/common/identity"
+	"storj.io/common/memory"
 	"storj.io/common/pb"
 	"storj.io/common/peertls/extensions"
 	"storj.io/common/peertls/tlsopts"
@@ -26,6 +27,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/private/debug"
 	"storj.io/private/version"
+	"storj.io/storj/private/capabilitiespb"
 	"storj.io/storj/private/lifecycle"
 	"storj.io/storj/private/multinodepb"
 	"storj.io/storj/private/server"
@@ -34,10 +36,16 @@ import (
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/blobstore"
 	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/blobstore/packstore"
+	"storj.io/storj/storagenode/blobstore/s3store"
+	"storj.io/storj/storagenode/blobstore/walstore"
 	"storj.io/storj/storagenode/collector"
 	"storj.io/storj/storagenode/console"
 	"storj.io/storj/storagenode/console/consoleserver"
 	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/diagnostics"
+	"storj.io/storj/storagenode/diskhealth"
+	"storj.io/storj/storagenode/events"
 	"storj.io/storj/storagenode/gracefulexit"
 	"storj.io/storj/storagenode/healthcheck"
 	"storj.io/storj/storagenode/inspector"
@@ -53,6 +61,7 @@ import (
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/pieces/lazyfilewalker"
 	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/piecestore/shaping"
 	"storj.io/storj/storagenode/piecestore/usedserials"
 	"storj.io/storj/storagenode/piecetransfer"
 	"storj.io/storj/storagenode/preflight"
@@ -60,6 +69,8 @@ import (
 	"storj.io/storj/storagenode/reputation"
 	"storj.io/storj/storagenode/retain"
 	"storj.io/storj/storagenode/satellites"
+	"storj.io/storj/storagenode/scrubber"
+	"storj.io/storj/storagenode/storagemigration"
 	"storj.io/storj/storagenode/storagenodedb"
 	"storj.io/storj/storagenode/storageusage"
 	"storj.io/storj/storagenode/trust"
@@ -100,6 +111,8 @@ type DB interface {
 	APIKeys() apikeys.DB
 
 	Preflight(ctx context.Context) error
+	// IntegrityCheck checks the integrity of the databases without modifying them.
+	IntegrityCheck(ctx context.Context) ([]storagenodedb.IntegrityCheckResult, error)
 }
 
 // Config is all the configuration parameters for a Storage Node.
@@ -117,8 +130,14 @@ type Config struct {
 	Storage   piecestore.OldConfig
 	Storage2  piecestore.Config
 	Collector collector.Config
+	Scrubber  scrubber.Config
+
+	DiskHealth diskhealth.Config
 
 	Filestore filestore.Config
+	S3        s3store.Config
+	Packfiles packstore.Config
+	PieceWAL  walstore.Config
 
 	Pieces pieces.Config
 
@@ -128,6 +147,10 @@ type Config struct {
 
 	Console consoleserver.Config
 
+	Diagnostics diagnostics.Config
+
+	Events events.Config
+
 	Healthcheck healthcheck.Config
 
 	Version checker.Config
@@ -135,23 +158,68 @@ type Config struct {
 	Bandwidth bandwidth.Config
 
 	GracefulExit gracefulexit.Config
+
+	Notifications notifications.Config
+
+	Multinode multinode.SettingsConfig
 }
 
 // DatabaseConfig returns the storagenodedb.Config that should be used with this Config.
 func (config *Config) DatabaseConfig() storagenodedb.Config {
-	dbdir := config.Storage2.DatabaseDir
-	if dbdir == "" {
-		dbdir = config.Storage.Path
-	}
+	dbdir := config.databaseDir()
 	return storagenodedb.Config{
 		Storage:   config.Storage.Path,
 		Info:      filepath.Join(dbdir, "piecestore.db"),
 		Info2:     filepath.Join(dbdir, "info.db"),
 		Pieces:    config.Storage.Path,
 		Filestore: config.Filestore,
+		S3:        config.S3,
+		Packfiles: config.Packfiles,
+		PieceWAL:  config.PieceWAL,
+
+		AdditionalPieces: config.Storage.AdditionalPaths,
+	}
+}
+
+// applySettingsOverrides overrides the configuration with the settings changed by the multinode dashboard.
+func (config *Config) applySettingsOverrides(overrides multinode.SettingsOverrides) {
+	if overrides.AllocatedDiskSpace != nil {
+		config.Storage.AllocatedDiskSpace = memory.Size(*overrides.AllocatedDiskSpace)
+	}
+	if overrides.Wallet != nil {
+		config.Operator.Wallet = *overrides.Wallet
+	}
+	if overrides.Ingress != nil {
+		config.Storage2.Shaping.Ingress = memory.Size(*overrides.Ingress)
+	}
+	if overrides.Egress != nil {
+		config.Storage2.Shaping.Egress = memory.Size(*overrides.Egress)
 	}
 }
 
+// databaseDir returns the directory where the databases are stored.
+func (config *Config) databaseDir() string {
+	if config.Storage2.DatabaseDir != "" {
+		return config.Storage2.DatabaseDir
+	}
+	return config.Storage.Path
+}
+
+// UsedSpaceJournalPath returns the path of the journal of the space used cache.
+func (config *Config) UsedSpaceJournalPath() string {
+	return filepath.Join(config.databaseDir(), "used_space.journal")
+}
+
+// PieceIndexPath returns the path of the piece index.
+func (config *Config) PieceIndexPath() string {
+	return filepath.Join(config.databaseDir(), "piece_index.db")
+}
+
+// CreationTimeCachePath returns the path of the cache of the piece creation times.
+func (config *Config) CreationTimeCachePath() string {
+	return filepath.Join(config.databaseDir(), "piece_creation_times.db")
+}
+
 // Verify verifies whether configuration is consistent and acceptable.
 func (config *Config) Verify(log *zap.Logger) error {
 	err := config.Operator.Verify(log)
@@ -230,14 +298,18 @@ type Peer struct {
 
 	Preflight struct {
 		LocalTime *preflight.LocalTime
+		Storage   *preflight.Storage
 	}
 
 	Contact struct {
-		Service   *contact.Service
-		Chore     *contact.Chore
-		Endpoint  *contact.Endpoint
-		PingStats *contact.PingStats
-		QUICStats *contact.QUICStats
+		Service         *contact.Service
+		Chore           *contact.Chore
+		RevocationChore *contact.RevocationChore
+		Endpoint        *contact.Endpoint
+		PingStats       *contact.PingStats
+		QUICStats       *contact.QUICStats
+
+		TransportStats *contact.TransportStats
 	}
 
 	Estimation struct {
@@ -256,12 +328,15 @@ type Peer struct {
 		Endpoint       *piecestore.Endpoint
 		Inspector      *inspector.Endpoint
 		Monitor        *monitor.Service
+		DiskHealth     *diskhealth.Service
+		Shaper         *shaping.Shaper
 		Orders         *orders.Service
 		FileWalker     *pieces.FileWalker
 		LazyFileWalker *lazyfilewalker.Supervisor
 	}
 
 	Collector *collector.Service
+	Scrubber  *scrubber.Service
 
 	NodeStats struct {
 		Service *nodestats.Service
@@ -275,6 +350,12 @@ type Peer struct {
 		Endpoint *consoleserver.Server
 	}
 
+	// Diagnostics collects the diagnostics bundle for the operator.
+	Diagnostics *diagnostics.Service
+
+	// Events captures the transfer events from the log for the node api.
+	Events *events.Feed
+
 	PieceTransfer struct {
 		Service piecetransfer.Service
 	}
@@ -287,7 +368,8 @@ type Peer struct {
 	}
 
 	Notifications struct {
-		Service *notifications.Service
+		Service    *notifications.Service
+		Dispatcher *notifications.Dispatcher
 	}
 
 	Payout struct {
@@ -304,13 +386,18 @@ type Peer struct {
 		Bandwidth *multinode.BandwidthEndpoint
 		Node      *multinode.NodeEndpoint
 		Payout    *multinode.PayoutEndpoint
+		Settings  *multinode.SettingsEndpoint
 	}
 }
 
 // New creates a new Storage Node.
 func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB extensions.RevocationDB, config Config, versionInfo version.Info, atomicLogLevel *zap.AtomicLevel) (*Peer, error) {
+	feed := events.NewFeed(config.Events)
+	log = log.WithOptions(feed.WrapCore())
+
 	peer := &Peer{
 		Log:      log,
+		Events:   feed,
 		Identity: full,
 		DB:       db,
 
@@ -318,8 +405,30 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		Services: lifecycle.NewGroup(log.Named("services")),
 	}
 
+	settingsPath := filepath.Join(config.Storage.Path, multinode.SettingsFile)
+	{ // the settings changed by the multinode dashboard override the configuration.
+		overrides, err := multinode.LoadSettingsOverrides(settingsPath)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		config.applySettingsOverrides(overrides)
+	}
+
 	{ // setup notification service.
 		peer.Notifications.Service = notifications.NewService(peer.Log, peer.DB.Notifications())
+
+		dispatcher, err := notifications.NewDispatcher(peer.Log.Named("notifications:dispatcher"), config.Notifications, config.Operator.Email)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Notifications.Dispatcher = dispatcher
+		peer.Notifications.Service.SetDispatcher(dispatcher)
+
+		peer.Services.Add(lifecycle.Item{
+			Name:  "notifications:dispatcher",
+			Run:   peer.Notifications.Dispatcher.Run,
+			Close: peer.Notifications.Dispatcher.Close,
+		})
 	}
 
 	{ // setup debug
@@ -384,6 +493,11 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			return nil, errs.Combine(err, peer.Close())
 		}
 
+		peer.Contact.QUICStats = contact.NewQUICStats(peer.Server.IsQUICEnabled())
+		peer.Contact.TransportStats = contact.NewTransportStats(peer.Log.Named("contact:transport"), config.Contact.Transport, peer.Contact.QUICStats)
+		peer.Server.SetConnectionObserver(peer.Contact.TransportStats)
+		peer.Dialer.Connector = contact.NewTransportConnector(peer.Dialer.Connector, peer.Contact.TransportStats)
+
 		if config.Healthcheck.Enabled {
 			peer.Server.AddHTTPFallback(peer.Healthcheck.Endpoint.HandleHTTP)
 		}
@@ -441,10 +555,15 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			Version:             *pbVersion,
 			NoiseKeyAttestation: noiseKeyAttestation,
 			DebounceLimit:       peer.Server.DebounceLimit(),
+			Capabilities: capabilitiespb.Capabilities{
+				HashAlgorithms: contact.SupportedHashAlgorithms(),
+				TcpFastOpen:    config.Server.TCPFastOpen,
+				Noise:          noiseKeyAttestation != nil,
+			},
 		}
 		peer.Contact.PingStats = new(contact.PingStats)
-		peer.Contact.QUICStats = contact.NewQUICStats(peer.Server.IsQUICEnabled())
 		peer.Contact.Service = contact.NewService(peer.Log.Named("contact:service"), peer.Dialer, self, peer.Storage2.Trust, peer.Contact.QUICStats)
+		peer.Contact.Service.SetNotifications(peer.Notifications.Service)
 
 		peer.Contact.Chore = contact.NewChore(peer.Log.Named("contact:chore"), config.Contact.Interval, peer.Contact.Service)
 		peer.Services.Add(lifecycle.Item{
@@ -453,6 +572,17 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			Close: peer.Contact.Chore.Close,
 		})
 
+		if db, ok := revocationDB.(contact.RevocationDB); ok && config.Server.Config.Extensions.Revocation {
+			peer.Contact.RevocationChore = contact.NewRevocationChore(peer.Log.Named("contact:revocations"), config.Contact.RevocationsInterval, peer.Contact.Service, db)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "contact:revocations",
+				Run:   peer.Contact.RevocationChore.Run,
+				Close: peer.Contact.RevocationChore.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Contact Revocations", peer.Contact.RevocationChore.Loop))
+		}
+
 		peer.Contact.Endpoint = contact.NewEndpoint(peer.Log.Named("contact:endpoint"), peer.Storage2.Trust, peer.Contact.PingStats)
 		if err := pb.DRPCRegisterContact(peer.Server.DRPC(), peer.Contact.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -461,15 +591,36 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 
 	{ // setup storage
 		peer.Storage2.BlobsCache = pieces.NewBlobsUsageCache(peer.Log.Named("blobscache"), peer.DB.Pieces())
+
+		usedSpaceJournal, err := pieces.OpenUsedSpaceJournal(config.UsedSpaceJournalPath())
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Storage2.BlobsCache.SetJournal(usedSpaceJournal)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "piecestore:used-space-journal",
+			Close: usedSpaceJournal.Close,
+		})
 		peer.Storage2.FileWalker = pieces.NewFileWalker(peer.Log.Named("filewalker"), peer.Storage2.BlobsCache, peer.DB.V0PieceInfo())
+		walkCheckpoints, err := pieces.OpenWalkCheckpoints(filepath.Join(config.Storage.Path, pieces.WalkCheckpointsDir))
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Storage2.FileWalker.SetCheckpoints(walkCheckpoints)
+		peer.Storage2.FileWalker.SetConfig(config.Pieces.FileWalker)
 
-		if config.Pieces.EnableLazyFilewalker {
+		// the subprocesses of the lazy filewalker only know how to walk the storage directory,
+		// and can't open the pack file index, which is locked by the node.
+		if config.Pieces.EnableLazyFilewalker && !config.S3.Enabled() && !config.Packfiles.Enabled {
 			executable, err := os.Executable()
 			if err != nil {
 				return nil, errs.Combine(err, peer.Close())
 			}
 
-			peer.Storage2.LazyFileWalker = lazyfilewalker.NewSupervisor(peer.Log.Named("lazyfilewalker"), db.Config().LazyFilewalkerConfig(), executable)
+			lazyFilewalkerConfig := db.Config().LazyFilewalkerConfig()
+			lazyFilewalkerConfig.Concurrency = config.Pieces.FileWalker.Concurrency
+			lazyFilewalkerConfig.PiecesPerSecond = config.Pieces.FileWalker.PiecesPerSecond
+			peer.Storage2.LazyFileWalker = lazyfilewalker.NewSupervisor(peer.Log.Named("lazyfilewalker"), lazyFilewalkerConfig, executable)
 		}
 
 		peer.Storage2.Store = pieces.NewStore(peer.Log.Named("pieces"),
@@ -481,8 +632,48 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.DB.PieceSpaceUsedDB(),
 			config.Pieces,
 		)
+		peer.Contact.Service.SetSatelliteSpace(peer.Storage2.Store)
+
+		if config.Pieces.EnablePieceIndex {
+			pieceIndex, err := pieces.OpenPieceIndex(peer.Log.Named("pieceindex"), config.PieceIndexPath())
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			peer.Storage2.Store.SetIndex(pieceIndex)
+			peer.Storage2.FileWalker.SetIndex(pieceIndex)
+			peer.Services.Add(lifecycle.Item{
+				Name: "piecestore:piece-index",
+				Run: func(ctx context.Context) error {
+					if pieceIndex.Ready() {
+						return nil
+					}
+					peer.Log.Info("building the piece index")
+					if err := pieceIndex.Rebuild(ctx, peer.DB.Pieces()); err != nil {
+						peer.Log.Error("failed to build the piece index", zap.Error(err))
+						return nil
+					}
+					peer.Log.Info("piece index built")
+					return nil
+				},
+				Close: pieceIndex.Close,
+			})
+		}
+
+		if config.Pieces.CacheCreationTimes {
+			creationTimes, err := pieces.OpenCreationTimeCache(peer.Log.Named("creationtimes"), config.CreationTimeCachePath())
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			peer.Storage2.Store.SetCreationTimes(creationTimes)
+			peer.Storage2.FileWalker.SetCreationTimes(creationTimes)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "piecestore:creation-times",
+				Close: creationTimes.Close,
+			})
+		}
 
 		peer.Storage2.PieceDeleter = pieces.NewDeleter(log.Named("piecedeleter"), peer.Storage2.Store, config.Storage2.DeleteWorkers, config.Storage2.DeleteQueueSize)
+		peer.Storage2.PieceDeleter.SetPacer(filestore.NewPacer(config.Filestore.DeletePacing))
 		peer.Services.Add(lifecycle.Item{
 			Name:  "PieceDeleter",
 			Run:   peer.Storage2.PieceDeleter.Run,
@@ -508,6 +699,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Storage2.Store,
 			config.Storage2.CacheSyncInterval,
 			config.Storage2.PieceScanOnStartup,
+			config.Storage2.CacheReconcileInterval,
 		)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "piecestore:cache",
@@ -528,6 +720,11 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Contact.Chore.Trigger,
 			config.Storage2.Monitor,
 		)
+		peer.Storage2.Monitor.SetNotifications(peer.Notifications.Service)
+		peer.Storage2.Monitor.SetCutoverMarker(filepath.Join(config.Storage.Path, storagemigration.CutoverMarker), storagemigration.Backend(config.DatabaseConfig()))
+
+		peer.Preflight.Storage = preflight.NewStorage(peer.Log.Named("preflight:storage"), config.Preflight, config.Storage.Path, peer.Storage2.Store, peer.DB.PieceSpaceUsedDB(), peer.Identity.ID)
+		peer.Preflight.Storage.SetSafeMode(peer.Storage2.Monitor.EnterSafeMode)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "piecestore:monitor",
 			Run:   peer.Storage2.Monitor.Run,
@@ -536,11 +733,28 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Piecestore Monitor", peer.Storage2.Monitor.Loop))
 
+		if config.DiskHealth.Enabled {
+			peer.Storage2.DiskHealth = diskhealth.NewService(peer.Log.Named("diskhealth"), config.Storage.Path, config.DiskHealth)
+			peer.Storage2.Monitor.SetDiskHealth(peer.Storage2.DiskHealth)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "diskhealth",
+				Run:   peer.Storage2.DiskHealth.Run,
+				Close: peer.Storage2.DiskHealth.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Disk Health", peer.Storage2.DiskHealth.Loop))
+		}
+
 		peer.Storage2.RetainService = retain.NewService(
 			peer.Log.Named("retain"),
 			peer.Storage2.Store,
 			config.Retain,
 		)
+		retainRequests, err := retain.OpenRequestStore(filepath.Join(config.Storage.Path, retain.RequestsDir))
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Storage2.RetainService.SetRequestStore(retainRequests)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "retain",
 			Run:   peer.Storage2.RetainService.Run,
@@ -558,6 +772,11 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			return nil, errs.Combine(err, peer.Close())
 		}
 
+		peer.Storage2.Shaper, err = shaping.NewShaper(config.Storage2.Shaping)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
 		peer.Storage2.Endpoint, err = piecestore.NewEndpoint(
 			peer.Log.Named("piecestore"),
 			peer.Identity,
@@ -576,6 +795,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		peer.Storage2.Endpoint.SetShaper(peer.Storage2.Shaper)
+		peer.Storage2.Endpoint.SetTransportStats(peer.Contact.TransportStats)
 
 		if err := pb.DRPCRegisterPiecestore(peer.Server.DRPC(), peer.Storage2.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -603,6 +824,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Storage2.Trust,
 			config.Storage2.Orders,
 		)
+		peer.Storage2.Orders.SetSettlementExtensions(peer.Contact.Service)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "orders",
 			Run:   peer.Storage2.Orders.Run,
@@ -683,6 +905,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.DB.Satellites(),
 			peer.Storage2.Trust,
 		)
+		peer.Estimation.Service.SetPayoutsDB(peer.DB.Payout())
 	}
 
 	{ // setup storage node operator dashboard
@@ -707,10 +930,30 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			config.Operator.WalletFeatures,
 			port,
 			peer.Contact.QUICStats,
+			peer.Storage2.RetainService,
 		)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		if peer.Storage2.DiskHealth != nil {
+			peer.Console.Service.SetDiskHealth(peer.Storage2.DiskHealth)
+		}
+		peer.Console.Service.SetShaper(peer.Storage2.Shaper)
+		peer.Console.Service.SetOrders(peer.Storage2.Orders)
+		peer.Console.Service.SetTransportStats(peer.Contact.TransportStats)
+		peer.Console.Service.SetUploadRejections(peer.Storage2.Endpoint.UploadRejections())
+		peer.Console.Service.SetNodeStats(peer.NodeStats.Service)
+
+		peer.Diagnostics = diagnostics.NewService(
+			peer.Log.Named("diagnostics"),
+			config.Diagnostics,
+			peer.Identity.ID,
+			versionInfo,
+			peer.DB,
+		)
+		if peer.Storage2.DiskHealth != nil {
+			peer.Diagnostics.SetDiskHealth(peer.Storage2.DiskHealth)
+		}
 
 		peer.Console.Listener, err = net.Listen("tcp", config.Console.Address)
 		if err != nil {
@@ -732,6 +975,9 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Notifications.Service,
 			peer.Console.Service,
 			peer.Payout.Service,
+			peer.Events,
+			apikeys.NewService(peer.DB.APIKeys()),
+			peer.Diagnostics,
 			peer.Console.Listener,
 		)
 
@@ -828,6 +1074,21 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 	peer.Debug.Server.Panel.Add(
 		debug.Cycle("Collector", peer.Collector.Loop))
 
+	if config.Scrubber.Enabled {
+		records, err := scrubber.OpenRecords(filepath.Join(config.Storage.Path, scrubber.RecordsFile))
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Scrubber = scrubber.NewService(peer.Log.Named("scrubber"), peer.Storage2.Store, records, config.Scrubber)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "scrubber",
+			Run:   peer.Scrubber.Run,
+			Close: peer.Scrubber.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Scrubber", peer.Scrubber.Loop))
+	}
+
 	peer.Bandwidth = bandwidth.NewService(peer.Log.Named("bandwidth"), peer.DB.Bandwidth(), config.Bandwidth)
 	peer.Services.Add(lifecycle.Item{
 		Name:  "bandwidth",
@@ -872,6 +1133,16 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Payout.Service,
 		)
 
+		peer.Multinode.Settings = multinode.NewSettingsEndpoint(
+			peer.Log.Named("multinode:settings-endpoint"),
+			config.Multinode,
+			apiKeys,
+			peer.Storage2.Shaper,
+			settingsPath,
+			config.Storage.AllocatedDiskSpace,
+			config.Operator.Wallet,
+		)
+
 		if err = multinodepb.DRPCRegisterStorage(peer.Server.DRPC(), peer.Multinode.Storage); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
@@ -887,6 +1158,9 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		if err = multinodepb.DRPCRegisterPayouts(peer.Server.DRPC(), peer.Multinode.Payout); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		if err = multinodepb.DRPCRegisterSettings(peer.Server.DRPC(), peer.Multinode.Settings); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 	}
 
 	return peer, nil
@@ -907,6 +1181,11 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 		return err
 	}
 
+	if err := peer.Preflight.Storage.Check(ctx); err != nil {
+		peer.Log.Error("Failed preflight check.", zap.Error(err))
+		return err
+	}
+
 	group, ctx := errgroup.WithContext(ctx)
 
 	peer.Servers.Run(ctx, group)
@@ -25,6 +25,7 @@ type CacheService struct {
 	usageCache         *BlobsUsageCache
 	store              *Store
 	pieceScanOnStartup bool
+	reconcileInterval  time.Duration
 	Loop               *sync2.Cycle
 
 	// InitFence is released once the cache's Run method returns or when it has
@@ -34,12 +35,16 @@ type CacheService struct {
 
 // NewService creates a new cache service that updates the space usage cache on startup and syncs the cache values to
 // persistent storage on an interval.
-func NewService(log *zap.Logger, usageCache *BlobsUsageCache, pieces *Store, interval time.Duration, pieceScanOnStartup bool) *CacheService {
+//
+// When the usage cache has a journal, the cache is restored from persistent storage on startup and
+// the pieces are only walked when the last reconciliation is older than reconcileInterval.
+func NewService(log *zap.Logger, usageCache *BlobsUsageCache, pieces *Store, interval time.Duration, pieceScanOnStartup bool, reconcileInterval time.Duration) *CacheService {
 	return &CacheService{
 		log:                log,
 		usageCache:         usageCache,
 		store:              pieces,
 		pieceScanOnStartup: pieceScanOnStartup,
+		reconcileInterval:  reconcileInterval,
 		Loop:               sync2.NewCycle(interval),
 	}
 }
@@ -50,42 +55,49 @@ func (service *CacheService) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	defer service.InitFence.Release()
 
-	totalsAtStart := service.usageCache.copyCacheTotals()
+	if err = service.store.spaceUsedDB.Init(ctx); err != nil {
+		service.log.Error("error during init space usage db: ", zap.Error(err))
+		return err
+	}
+
+	if service.usageCache.journal != nil {
+		if err := service.Init(ctx); err != nil {
+			return err
+		}
+	}
 
 	// recalculate the cache once
 	if service.pieceScanOnStartup {
-		piecesTotal, piecesContentSize, totalsBySatellite, err := service.store.SpaceUsedTotalAndBySatellite(ctx)
+		due, reconciledAt, err := service.reconciliationDue()
 		if err != nil {
-			service.log.Error("error getting current used space: ", zap.Error(err))
+			service.log.Error("error getting last used space reconciliation: ", zap.Error(err))
 			return err
 		}
-		trashTotal, err := service.usageCache.Blobs.SpaceUsedForTrash(ctx)
-		if err != nil {
-			service.log.Error("error getting current used space for trash: ", zap.Error(err))
-			return err
+		if due {
+			if err := service.Reconcile(ctx); err != nil {
+				return err
+			}
+		} else {
+			service.log.Info("Startup piece scan omitted, used space cache was reconciled recently", zap.Time("Reconciled At", reconciledAt))
 		}
-		service.usageCache.Recalculate(
-			piecesTotal,
-			totalsAtStart.piecesTotal,
-			piecesContentSize,
-			totalsAtStart.piecesContentSize,
-			trashTotal,
-			totalsAtStart.trashTotal,
-			totalsBySatellite,
-			totalsAtStart.spaceUsedBySatellite,
-		)
 	} else {
 		service.log.Info("Startup piece scan omitted by configuration")
 	}
 
-	if err = service.store.spaceUsedDB.Init(ctx); err != nil {
-		service.log.Error("error during init space usage db: ", zap.Error(err))
-		return err
-	}
-
 	return service.Loop.Run(ctx, func(ctx context.Context) (err error) {
 		defer mon.Task()(&ctx)(&err)
 
+		if service.pieceScanOnStartup && service.usageCache.journal != nil {
+			due, _, err := service.reconciliationDue()
+			if err != nil {
+				service.log.Error("error getting last used space reconciliation: ", zap.Error(err))
+			} else if due {
+				if err := service.Reconcile(ctx); err != nil {
+					service.log.Error("error reconciling used space: ", zap.Error(err))
+				}
+			}
+		}
+
 		// on a loop sync the cache values to the db so that we have the them saved
 		// in the case that the storagenode restarts
 		if err := service.PersistCacheTotals(ctx); err != nil {
@@ -96,23 +108,96 @@ func (service *CacheService) Run(ctx context.Context) (err error) {
 	})
 }
 
+// reconciliationDue returns whether the space used cache should be reconciled
+// by walking all the pieces.
+func (service *CacheService) reconciliationDue() (due bool, reconciledAt time.Time, err error) {
+	journal := service.usageCache.journal
+	if journal == nil {
+		// without a journal the cache can't be trusted after a restart.
+		return true, time.Time{}, nil
+	}
+	reconciledAt, err = journal.ReconciledAt()
+	if err != nil {
+		return false, time.Time{}, err
+	}
+	if reconciledAt.IsZero() {
+		return true, reconciledAt, nil
+	}
+	return time.Since(reconciledAt) >= service.reconcileInterval, reconciledAt, nil
+}
+
+// Reconcile recalculates the space used cache by walking all the pieces. Changes
+// made while walking are estimated, see BlobsUsageCache.Recalculate.
+func (service *CacheService) Reconcile(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	totalsAtStart := service.usageCache.copyCacheTotals()
+
+	piecesTotal, piecesContentSize, totalsBySatellite, trashTotal, ok := service.store.lazySpaceUsedOnDisk(ctx)
+	if !ok {
+		piecesTotal, piecesContentSize, totalsBySatellite, err = service.store.SpaceUsedTotalAndBySatellite(ctx)
+		if err != nil {
+			service.log.Error("error getting current used space: ", zap.Error(err))
+			return err
+		}
+		trashTotal, err = service.usageCache.Blobs.SpaceUsedForTrash(ctx)
+		if err != nil {
+			service.log.Error("error getting current used space for trash: ", zap.Error(err))
+			return err
+		}
+	}
+	service.usageCache.Recalculate(
+		piecesTotal,
+		totalsAtStart.piecesTotal,
+		piecesContentSize,
+		totalsAtStart.piecesContentSize,
+		trashTotal,
+		totalsAtStart.trashTotal,
+		totalsBySatellite,
+		totalsAtStart.spaceUsedBySatellite,
+	)
+
+	journal := service.usageCache.journal
+	if journal == nil {
+		return nil
+	}
+
+	// the recalculated totals are not in the journal, so they need to be
+	// checkpointed before the reconciliation is recorded.
+	if err := service.PersistCacheTotals(ctx); err != nil {
+		service.log.Error("error persisting cache totals to the database: ", zap.Error(err))
+		return err
+	}
+	return journal.SetReconciledAt(time.Now())
+}
+
 // PersistCacheTotals saves the current totals of the space used cache to the database
 // so that if the storagenode restarts it can retrieve the latest space used
 // values without needing to recalculate since that could take a long time.
+// The journal of the cache is checkpointed first, since its changes are included in the
+// totals, so a crash while the totals are saved doesn't replay the changes twice.
 func (service *CacheService) PersistCacheTotals(ctx context.Context) error {
 	cache := service.usageCache
 	cache.mu.Lock()
 	defer cache.mu.Unlock()
+	if cache.journal != nil {
+		err := cache.journal.Checkpoint(UsedSpaceTotals{
+			PiecesTotal:       cache.piecesTotal,
+			PiecesContentSize: cache.piecesContentSize,
+			TrashTotal:        cache.trashTotal,
+			BySatellite:       cache.spaceUsedBySatellite,
+		})
+		if err != nil {
+			return err
+		}
+	}
 	if err := service.store.spaceUsedDB.UpdatePieceTotals(ctx, cache.piecesTotal, cache.piecesContentSize); err != nil {
 		return err
 	}
 	if err := service.store.spaceUsedDB.UpdatePieceTotalsForAllSatellites(ctx, cache.spaceUsedBySatellite); err != nil {
 		return err
 	}
-	if err := service.store.spaceUsedDB.UpdateTrashTotal(ctx, cache.trashTotal); err != nil {
-		return err
-	}
-	return nil
+	return service.store.spaceUsedDB.UpdateTrashTotal(ctx, cache.trashTotal)
 }
 
 // Init initializes the space used cache with the most recent values that were stored persistently.
@@ -135,7 +220,10 @@ func (service *CacheService) Init(ctx context.Context) (err error) {
 		return err
 	}
 
-	service.usageCache.init(piecesTotal, piecesContentSize, trashTotal, totalsBySatellite)
+	if err := service.usageCache.init(piecesTotal, piecesContentSize, trashTotal, totalsBySatellite); err != nil {
+		service.log.Error("CacheServiceInit error during replaying used space journal:", zap.Error(err))
+		return err
+	}
 	return nil
 }
 
@@ -165,6 +253,7 @@ type BlobsUsageCache struct {
 	piecesContentSize    int64
 	trashTotal           int64
 	spaceUsedBySatellite map[storj.NodeID]SatelliteUsage
+	journal              *UsedSpaceJournal
 }
 
 // NewBlobsUsageCache creates a new disk blob store with a space used cache.
@@ -188,13 +277,36 @@ func NewBlobsUsageCacheTest(log *zap.Logger, blob blobstore.Blobs, piecesTotal,
 	}
 }
 
-func (blobs *BlobsUsageCache) init(pieceTotal, contentSize, trashTotal int64, totalsBySatellite map[storj.NodeID]SatelliteUsage) {
+// SetJournal sets the journal which records every change of the cache totals
+// between checkpoints. It must be called before the cache is used.
+func (blobs *BlobsUsageCache) SetJournal(journal *UsedSpaceJournal) {
+	blobs.mu.Lock()
+	defer blobs.mu.Unlock()
+	blobs.journal = journal
+}
+
+// init sets the cache totals to the checkpointed values and applies the changes
+// from the journal made after the checkpoint. The checkpoint of the journal takes
+// precedence over the totals from the database, which may be older.
+func (blobs *BlobsUsageCache) init(pieceTotal, contentSize, trashTotal int64, totalsBySatellite map[storj.NodeID]SatelliteUsage) error {
 	blobs.mu.Lock()
 	defer blobs.mu.Unlock()
 	blobs.piecesTotal = pieceTotal
 	blobs.piecesContentSize = contentSize
 	blobs.trashTotal = trashTotal
 	blobs.spaceUsedBySatellite = totalsBySatellite
+
+	if blobs.journal == nil {
+		return nil
+	}
+	return blobs.journal.Replay(func(totals UsedSpaceTotals) {
+		blobs.piecesTotal = totals.PiecesTotal
+		blobs.piecesContentSize = totals.PiecesContentSize
+		blobs.trashTotal = totals.TrashTotal
+		blobs.spaceUsedBySatellite = totals.BySatellite
+	}, func(delta UsedSpaceDelta) {
+		blobs.apply(delta.SatelliteID, delta.PiecesTotal, delta.PiecesContentSize, delta.TrashTotal)
+	})
 }
 
 // SpaceUsedBySatellite returns the current total space used for a specific
@@ -258,6 +370,24 @@ func (blobs *BlobsUsageCache) Update(ctx context.Context, satelliteID storj.Node
 	blobs.mu.Lock()
 	defer blobs.mu.Unlock()
 
+	blobs.apply(satelliteID, piecesTotalDelta, piecesContentSizeDelta, trashDelta)
+
+	if blobs.journal != nil {
+		err := blobs.journal.Append(UsedSpaceDelta{
+			SatelliteID:       satelliteID,
+			PiecesTotal:       piecesTotalDelta,
+			PiecesContentSize: piecesContentSizeDelta,
+			TrashTotal:        trashDelta,
+		})
+		if err != nil {
+			mon.Event("used_space_journal_append_failed")
+			blobs.log.Error("failed to append to used space journal", zap.Error(err))
+		}
+	}
+}
+
+// apply adds the deltas to the cache totals. It must be called with the lock held.
+func (blobs *BlobsUsageCache) apply(satelliteID storj.NodeID, piecesTotalDelta, piecesContentSizeDelta, trashDelta int64) {
 	blobs.piecesTotal += piecesTotalDelta
 	blobs.piecesContentSize += piecesContentSizeDelta
 	blobs.trashTotal += trashDelta
@@ -274,7 +404,6 @@ func (blobs *BlobsUsageCache) Update(ctx context.Context, satelliteID storj.Node
 	blobs.ensurePositiveCacheValue(&newVals.Total, "satPiecesTotal")
 	blobs.ensurePositiveCacheValue(&newVals.ContentSize, "satPiecesContentSize")
 	blobs.spaceUsedBySatellite[satelliteID] = newVals
-
 }
 
 func (blobs *BlobsUsageCache) ensurePositiveCacheValue(value *int64, name string) {
@@ -335,6 +464,26 @@ func (blobs *BlobsUsageCache) RestoreTrash(ctx context.Context, namespace []byte
 		return nil, err
 	}
 
+	return keysRestored, blobs.updateRestored(ctx, satelliteID, namespace, keysRestored)
+}
+
+// RestoreTrashKeys restores the pieces with the given keys from the trash for the namespace and updates the cache.
+func (blobs *BlobsUsageCache) RestoreTrashKeys(ctx context.Context, namespace []byte, keys [][]byte) ([][]byte, error) {
+	satelliteID, err := storj.NodeIDFromBytes(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	keysRestored, err := blobs.Blobs.RestoreTrashKeys(ctx, namespace, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return keysRestored, blobs.updateRestored(ctx, satelliteID, namespace, keysRestored)
+}
+
+// updateRestored moves the size of the restored pieces from the trash to the pieces in the cache.
+func (blobs *BlobsUsageCache) updateRestored(ctx context.Context, satelliteID storj.NodeID, namespace []byte, keysRestored [][]byte) (err error) {
 	for _, key := range keysRestored {
 		pieceTotal, pieceContentSize, sizeErr := blobs.pieceSizes(ctx, blobstore.BlobRef{
 			Key:       key,
@@ -346,8 +495,7 @@ func (blobs *BlobsUsageCache) RestoreTrash(ctx context.Context, namespace []byte
 		}
 		blobs.Update(ctx, satelliteID, pieceTotal, pieceContentSize, -pieceTotal)
 	}
-
-	return keysRestored, err
+	return err
 }
 
 func (blobs *BlobsUsageCache) copyCacheTotals() BlobsUsageCache {
@@ -4,11 +4,14 @@
 package pieces_test
 
 import (
+	"context"
+	"os"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
 	"go.uber.org/zap/zaptest"
 	"golang.org/x/sync/errgroup"
 
@@ -118,6 +121,7 @@ func TestCacheInit(t *testing.T) {
 			pieces.NewStore(log, pieces.NewFileWalker(log, cache, nil), nil, cache, nil, nil, spaceUsedDB, pieces.DefaultConfig),
 			1*time.Hour,
 			true,
+			7*24*time.Hour,
 		)
 
 		// Confirm that when we call init before the cache has been persisted.
@@ -157,6 +161,7 @@ func TestCacheInit(t *testing.T) {
 			pieces.NewStore(log, pieces.NewFileWalker(log, cache, nil), nil, cache, nil, nil, spaceUsedDB, pieces.DefaultConfig),
 			1*time.Hour,
 			true,
+			7*24*time.Hour,
 		)
 		err = cacheService.PersistCacheTotals(ctx)
 		require.NoError(t, err)
@@ -168,6 +173,7 @@ func TestCacheInit(t *testing.T) {
 			pieces.NewStore(log, pieces.NewFileWalker(log, cache, nil), nil, cache, nil, nil, spaceUsedDB, pieces.DefaultConfig),
 			1*time.Hour,
 			true,
+			7*24*time.Hour,
 		)
 		// Confirm that when we call Init after the cache has been persisted
 		// that the cache gets initialized with the values from the database
@@ -232,6 +238,7 @@ func TestCacheServiceRun(t *testing.T) {
 			pieces.NewStore(log, pieces.NewFileWalker(log, cache, nil), nil, cache, nil, nil, spaceUsedDB, pieces.DefaultConfig),
 			1*time.Hour,
 			true,
+			7*24*time.Hour,
 		)
 
 		// Init the cache service, to read the values from the db (should all be 0)
@@ -312,6 +319,7 @@ func TestCacheServiceRun_LazyFilewalker(t *testing.T) {
 		lazyFwCfg.LowerIOPriority = false
 		lazyFw := lazyfilewalker.NewSupervisor(log, lazyFwCfg, "")
 		lazyFw.TestingSetUsedSpaceCmd(internalcmd.NewUsedSpaceLazyFilewalker(ctx, log.Named("used-space-filewalker.subprocess"), lazyFwCfg))
+		lazyFw.TestingSetReconcileCmd(internalcmd.NewReconcileLazyFilewalker(ctx, log.Named("reconcile-filewalker.subprocess"), lazyFwCfg))
 
 		// Now instantiate the cache
 		cache := pieces.NewBlobsUsageCache(log, store)
@@ -320,6 +328,7 @@ func TestCacheServiceRun_LazyFilewalker(t *testing.T) {
 			pieces.NewStore(log, nil, lazyFw, cache, nil, nil, spaceUsedDB, cfg),
 			1*time.Hour,
 			true,
+			7*24*time.Hour,
 		)
 
 		// Init the cache service, to read the values from the db (should all be 0)
@@ -407,6 +416,7 @@ func TestPersistCacheTotals(t *testing.T) {
 			pieces.NewStore(log, pieces.NewFileWalker(log, cache, nil), nil, cache, nil, nil, spaceUsedDB, pieces.DefaultConfig),
 			1*time.Hour,
 			true,
+			7*24*time.Hour,
 		)
 		err = cacheService.PersistCacheTotals(ctx)
 		require.NoError(t, err)
@@ -805,3 +815,163 @@ func TestConcurrency(t *testing.T) {
 		require.NoError(t, group.Wait())
 	})
 }
+
+func TestCacheInitWithJournal(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		spaceUsedDB := db.PieceSpaceUsedDB()
+		require.NoError(t, spaceUsedDB.Init(ctx))
+
+		log := zaptest.NewLogger(t)
+		journalPath := ctx.File("used_space.journal")
+
+		newService := func() (*pieces.BlobsUsageCache, *pieces.CacheService, *pieces.UsedSpaceJournal) {
+			journal, err := pieces.OpenUsedSpaceJournal(journalPath)
+			require.NoError(t, err)
+
+			cache := pieces.NewBlobsUsageCacheTest(log, nil, 0, 0, 0, map[storj.NodeID]pieces.SatelliteUsage{})
+			cache.SetJournal(journal)
+			service := pieces.NewService(log,
+				cache,
+				pieces.NewStore(log, pieces.NewFileWalker(log, cache, nil), nil, cache, nil, nil, spaceUsedDB, pieces.DefaultConfig),
+				1*time.Hour,
+				true,
+				7*24*time.Hour,
+			)
+			return cache, service, journal
+		}
+
+		cache, cacheService, journal := newService()
+		require.NoError(t, cacheService.Init(ctx))
+
+		cache.Update(ctx, storj.NodeID{1}, 100, 90, 0)
+		require.NoError(t, cacheService.PersistCacheTotals(ctx))
+
+		// changes after the checkpoint are only in the journal.
+		cache.Update(ctx, storj.NodeID{1}, 20, 18, 0)
+		cache.Update(ctx, storj.NodeID{2}, 50, 45, 0)
+		cache.Update(ctx, storj.NodeID{1}, -10, -9, 10)
+		require.NoError(t, journal.Close())
+
+		// restart with an empty cache.
+		cache, cacheService, journal = newService()
+		defer ctx.Check(journal.Close)
+		require.NoError(t, cacheService.Init(ctx))
+
+		piecesTotal, piecesContentSize, err := cache.SpaceUsedForPieces(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(160), piecesTotal)
+		require.Equal(t, int64(144), piecesContentSize)
+
+		sat1PiecesTotal, sat1PiecesContentSize, err := cache.SpaceUsedBySatellite(ctx, storj.NodeID{1})
+		require.NoError(t, err)
+		require.Equal(t, int64(110), sat1PiecesTotal)
+		require.Equal(t, int64(99), sat1PiecesContentSize)
+
+		sat2PiecesTotal, _, err := cache.SpaceUsedBySatellite(ctx, storj.NodeID{2})
+		require.NoError(t, err)
+		require.Equal(t, int64(50), sat2PiecesTotal)
+
+		trashTotal, err := cache.SpaceUsedForTrash(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(10), trashTotal)
+
+		// after a checkpoint the journal has only the checkpointed totals.
+		require.NoError(t, cacheService.PersistCacheTotals(ctx))
+		var checkpoint pieces.UsedSpaceTotals
+		entries := 0
+		require.NoError(t, journal.Replay(func(totals pieces.UsedSpaceTotals) { checkpoint = totals }, func(pieces.UsedSpaceDelta) { entries++ }))
+		require.Zero(t, entries)
+		require.Equal(t, int64(160), checkpoint.PiecesTotal)
+		require.Equal(t, int64(10), checkpoint.TrashTotal)
+
+		reconciledAt, err := journal.ReconciledAt()
+		require.NoError(t, err)
+		require.True(t, reconciledAt.IsZero())
+
+		now := time.Now().Truncate(time.Second)
+		require.NoError(t, journal.SetReconciledAt(now))
+		reconciledAt, err = journal.ReconciledAt()
+		require.NoError(t, err)
+		require.Equal(t, now, reconciledAt)
+	})
+}
+
+// crashingSpaceUsedDB fails to save the satellite totals after the piece totals were saved,
+// like a node, which crashes in the middle of persisting the cache.
+type crashingSpaceUsedDB struct {
+	pieces.PieceSpaceUsedDB
+}
+
+func (db crashingSpaceUsedDB) UpdatePieceTotalsForAllSatellites(ctx context.Context, newTotalsBySatellites map[storj.NodeID]pieces.SatelliteUsage) error {
+	return errs.New("crashed")
+}
+
+func TestCacheJournalCrashWhilePersisting(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		spaceUsedDB := db.PieceSpaceUsedDB()
+		require.NoError(t, spaceUsedDB.Init(ctx))
+
+		log := zaptest.NewLogger(t)
+		journalPath := ctx.File("used_space.journal")
+
+		newService := func(spaceUsedDB pieces.PieceSpaceUsedDB) (*pieces.BlobsUsageCache, *pieces.CacheService, *pieces.UsedSpaceJournal) {
+			journal, err := pieces.OpenUsedSpaceJournal(journalPath)
+			require.NoError(t, err)
+
+			cache := pieces.NewBlobsUsageCacheTest(log, nil, 0, 0, 0, map[storj.NodeID]pieces.SatelliteUsage{})
+			cache.SetJournal(journal)
+			service := pieces.NewService(log,
+				cache,
+				pieces.NewStore(log, pieces.NewFileWalker(log, cache, nil), nil, cache, nil, nil, spaceUsedDB, pieces.DefaultConfig),
+				1*time.Hour,
+				true,
+				7*24*time.Hour,
+			)
+			return cache, service, journal
+		}
+
+		requireTotals := func(cache *pieces.BlobsUsageCache, piecesTotal, satelliteTotal, trashTotal int64) {
+			total, _, err := cache.SpaceUsedForPieces(ctx)
+			require.NoError(t, err)
+			require.Equal(t, piecesTotal, total)
+			total, _, err = cache.SpaceUsedBySatellite(ctx, storj.NodeID{1})
+			require.NoError(t, err)
+			require.Equal(t, satelliteTotal, total)
+			total, err = cache.SpaceUsedForTrash(ctx)
+			require.NoError(t, err)
+			require.Equal(t, trashTotal, total)
+		}
+
+		cache, cacheService, journal := newService(crashingSpaceUsedDB{spaceUsedDB})
+		require.NoError(t, cacheService.Init(ctx))
+
+		cache.Update(ctx, storj.NodeID{1}, 100, 90, 0)
+		cache.Update(ctx, storj.NodeID{1}, -20, -18, 20)
+
+		// the piece totals are saved, but the node crashes before the rest is saved.
+		require.Error(t, cacheService.PersistCacheTotals(ctx))
+		piecesTotal, _, err := spaceUsedDB.GetPieceTotals(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(80), piecesTotal)
+
+		cache.Update(ctx, storj.NodeID{1}, 5, 4, 0)
+		require.NoError(t, journal.Close())
+
+		// a checkpoint interrupted before it replaced the journal is ignored.
+		require.NoError(t, os.WriteFile(journalPath+".tmp", []byte("USJ1 interrupted"), 0600))
+
+		// the deltas saved in the database are not replayed again.
+		cache, cacheService, journal = newService(spaceUsedDB)
+		require.NoError(t, cacheService.Init(ctx))
+		requireTotals(cache, 85, 85, 20)
+
+		require.NoError(t, cacheService.PersistCacheTotals(ctx))
+		cache.Update(ctx, storj.NodeID{1}, 10, 9, 0)
+		require.NoError(t, journal.Close())
+
+		cache, cacheService, journal = newService(spaceUsedDB)
+		defer ctx.Check(journal.Close)
+		require.NoError(t, cacheService.Init(ctx))
+		requireTotals(cache, 95, 95, 20)
+	})
+}
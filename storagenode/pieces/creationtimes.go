@@ -0,0 +1,129 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+)
+
+// ErrCreationTimes is the error class for the cache of the piece creation times.
+var ErrCreationTimes = errs.Class("piece creation times")
+
+// creationTimesBucket contains a bucket of creation times for every satellite.
+var creationTimesBucket = []byte("creation_times")
+
+// CreationTimeCache caches the creation times from the piece headers. Reading the creation
+// time requires opening the piece file and decoding its header, so garbage collection uses
+// the mtime of the piece file instead. With the cache, the header of a piece is read only by
+// the first walk, and the later walks can use the exact creation time.
+//
+// The entries are removed when the pieces are deleted or trashed. A missing entry is filled
+// in again from the piece header, so the cache doesn't need to be complete.
+//
+// architecture: Database
+type CreationTimeCache struct {
+	log *zap.Logger
+	db  *bbolt.DB
+}
+
+// OpenCreationTimeCache opens or creates the cache at path. It fails when the cache is used
+// by another process.
+func OpenCreationTimeCache(log *zap.Logger, path string) (*CreationTimeCache, error) {
+	db, err := bbolt.Open(path, 0600, &bbolt.Options{Timeout: indexOpenTimeout})
+	if err != nil {
+		return nil, ErrCreationTimes.Wrap(err)
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(creationTimesBucket)
+		return err
+	})
+	if err != nil {
+		return nil, ErrCreationTimes.Wrap(errs.Combine(err, db.Close()))
+	}
+
+	return &CreationTimeCache{
+		log: log,
+		db:  db,
+	}, nil
+}
+
+// Get returns the cached creation time of a piece.
+func (cache *CreationTimeCache) Get(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (creationTime time.Time, found bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = cache.db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(creationTimesBucket).Bucket(satellite.Bytes())
+		if bucket == nil {
+			return nil
+		}
+		value := bucket.Get(pieceID.Bytes())
+		if value == nil {
+			return nil
+		}
+		if len(value) != 8 {
+			return errs.New("invalid creation time entry of %d bytes", len(value))
+		}
+		found = true
+		creationTime = time.Unix(0, int64(binary.BigEndian.Uint64(value)))
+		return nil
+	})
+	return creationTime, found, ErrCreationTimes.Wrap(err)
+}
+
+// Add adds or replaces the creation time of a piece.
+func (cache *CreationTimeCache) Add(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, creationTime time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var value [8]byte
+	binary.BigEndian.PutUint64(value[:], uint64(creationTime.UnixNano()))
+
+	return ErrCreationTimes.Wrap(cache.db.Batch(func(tx *bbolt.Tx) error {
+		bucket, err := tx.Bucket(creationTimesBucket).CreateBucketIfNotExists(satellite.Bytes())
+		if err != nil {
+			return err
+		}
+		return bucket.Put(pieceID.Bytes(), value[:])
+	}))
+}
+
+// Remove removes the creation time of a piece.
+func (cache *CreationTimeCache) Remove(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return ErrCreationTimes.Wrap(cache.db.Batch(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(creationTimesBucket).Bucket(satellite.Bytes())
+		if bucket == nil {
+			return nil
+		}
+		return bucket.Delete(pieceID.Bytes())
+	}))
+}
+
+// RemoveSatellite removes the creation times of all the pieces of a satellite.
+func (cache *CreationTimeCache) RemoveSatellite(ctx context.Context, satellite storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return ErrCreationTimes.Wrap(cache.db.Update(func(tx *bbolt.Tx) error {
+		err := tx.Bucket(creationTimesBucket).DeleteBucket(satellite.Bytes())
+		if errors.Is(err, bbolt.ErrBucketNotFound) {
+			return nil
+		}
+		return err
+	}))
+}
+
+// Close closes the cache.
+func (cache *CreationTimeCache) Close() error {
+	return ErrCreationTimes.Wrap(cache.db.Close())
+}
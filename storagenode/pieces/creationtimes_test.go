@@ -0,0 +1,106 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/bloomfilter"
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestCreationTimeCache(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+
+		blobs, err := filestore.NewAt(log, db.Config().Pieces, filestore.DefaultConfig)
+		require.NoError(t, err)
+		defer ctx.Check(blobs.Close)
+
+		fw := pieces.NewFileWalker(log, blobs, nil)
+		store := pieces.NewStore(log, fw, nil, blobs, nil, db.PieceExpirationDB(), nil, pieces.DefaultConfig)
+
+		// the pieces were created two hours ago, but their files were written just now.
+		satelliteID := testrand.NodeID()
+		creationTime := time.Now().Add(-2 * time.Hour).Truncate(time.Microsecond)
+		var pieceIDs []storj.PieceID
+		for i := 0; i < 6; i++ {
+			pieceID := testrand.PieceID()
+			writer, err := store.Writer(ctx, satelliteID, pieceID, pb.PieceHashAlgorithm_SHA256)
+			require.NoError(t, err)
+			_, err = writer.Write(testrand.Bytes(memory.KiB))
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{CreationTime: creationTime}))
+			pieceIDs = append(pieceIDs, pieceID)
+		}
+
+		filter := bloomfilter.NewOptimal(100, 0.1)
+		for _, pieceID := range pieceIDs[:2] {
+			filter.Add(pieceID)
+		}
+		createdBefore := time.Now().Add(-time.Hour)
+
+		// with the mtimes, the pieces look too new to be trashed.
+		trash, _, _, err := fw.WalkSatellitePiecesToTrash(ctx, satelliteID, createdBefore, filter)
+		require.NoError(t, err)
+		require.Empty(t, trash)
+
+		cache, err := pieces.OpenCreationTimeCache(log, ctx.File("piece_creation_times.db"))
+		require.NoError(t, err)
+		defer ctx.Check(cache.Close)
+
+		store.SetCreationTimes(cache)
+		fw.SetCreationTimes(cache)
+		require.True(t, fw.UsesCreationTimes())
+
+		// the creation times are read from the headers and cached.
+		trash, piecesCount, piecesSkipped, err := fw.WalkSatellitePiecesToTrash(ctx, satelliteID, createdBefore, filter)
+		require.NoError(t, err)
+		require.EqualValues(t, 6, piecesCount)
+		require.Zero(t, piecesSkipped)
+		require.ElementsMatch(t, pieceIDs[2:], trash)
+
+		// the pieces kept by the filter aren't looked up.
+		for _, pieceID := range pieceIDs[2:] {
+			cached, found, err := cache.Get(ctx, satelliteID, pieceID)
+			require.NoError(t, err)
+			require.True(t, found)
+			require.True(t, creationTime.Equal(cached))
+		}
+
+		// the cached creation times are used by the later walks.
+		newer := creationTime.Add(90 * time.Minute)
+		require.NoError(t, cache.Add(ctx, satelliteID, pieceIDs[2], newer))
+
+		trash, _, _, err = fw.WalkSatellitePiecesToTrash(ctx, satelliteID, createdBefore, filter)
+		require.NoError(t, err)
+		require.ElementsMatch(t, pieceIDs[3:], trash)
+
+		// the creation times of the deleted and trashed pieces are removed.
+		require.NoError(t, store.Delete(ctx, satelliteID, pieceIDs[3]))
+		require.NoError(t, store.Trash(ctx, satelliteID, pieceIDs[4]))
+		for _, pieceID := range pieceIDs[3:5] {
+			_, found, err := cache.Get(ctx, satelliteID, pieceID)
+			require.NoError(t, err)
+			require.False(t, found)
+		}
+
+		require.NoError(t, store.DeleteSatelliteBlobs(ctx, satelliteID))
+		_, found, err := cache.Get(ctx, satelliteID, pieceIDs[5])
+		require.NoError(t, err)
+		require.False(t, found)
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/common/storj"
+	"storj.io/storj/storagenode/blobstore/filestore"
 )
 
 // DeleteRequest contains information to delete piece.
@@ -25,6 +26,9 @@ type DeleteRequest struct {
 // Deleter is a worker that processes requests to delete groups of pieceIDs.
 // Deletes are processed "best-effort" asynchronously, and any errors are
 // logged.
+//
+// The workers take the requests from the queue in batches, which are paced
+// by the pacer when it's set.
 type Deleter struct {
 	mu         sync.Mutex
 	ch         chan DeleteRequest
@@ -33,6 +37,7 @@ type Deleter struct {
 	log        *zap.Logger
 	stop       func()
 	store      *Store
+	pacer      *filestore.Pacer
 	closed     bool
 
 	// The test variables are only used when testing.
@@ -51,6 +56,11 @@ func NewDeleter(log *zap.Logger, store *Store, numWorkers int, queueSize int) *D
 	}
 }
 
+// SetPacer sets the pacer of the deletions. It must be called before Run.
+func (d *Deleter) SetPacer(pacer *filestore.Pacer) {
+	d.pacer = pacer
+}
+
 // Run starts the delete workers.
 func (d *Deleter) Run(ctx context.Context) error {
 	d.mu.Lock()
@@ -125,20 +135,44 @@ func (d *Deleter) checkDone(delta int) {
 }
 
 func (d *Deleter) work(ctx context.Context) error {
+	batch := make([]DeleteRequest, 0, d.pacer.BatchSize())
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
 		case r := <-d.ch:
-			mon.IntVal("piecedeleter-queue-time").Observe(int64(time.Since(r.QueueTime)))
-			mon.IntVal("piecedeleter-queue-size").Observe(int64(len(d.ch)))
-			d.deleteOrTrash(ctx, r.SatelliteID, r.PieceID)
-			// If we are in test mode, check if we are done processing deletes
-			if d.testMode {
-				d.checkDone(-1)
+			batch = d.fillBatch(append(batch[:0], r))
+			mon.IntVal("piecedeleter-batch-size").Observe(int64(len(batch)))
+			for _, r := range batch {
+				mon.IntVal("piecedeleter-queue-time").Observe(int64(time.Since(r.QueueTime)))
+				err := d.pacer.Do(ctx, func() error {
+					d.deleteOrTrash(ctx, r.SatelliteID, r.PieceID)
+					return nil
+				})
+				if err != nil {
+					return nil
+				}
+				// If we are in test mode, check if we are done processing deletes
+				if d.testMode {
+					d.checkDone(-1)
+				}
 			}
+			mon.IntVal("piecedeleter-queue-size").Observe(int64(len(d.ch)))
+		}
+	}
+}
+
+// fillBatch adds the queued requests to the batch without waiting, until the batch is full.
+func (d *Deleter) fillBatch(batch []DeleteRequest) []DeleteRequest {
+	for len(batch) < cap(batch) {
+		select {
+		case r := <-d.ch:
+			batch = append(batch, r)
+		default:
+			return batch
 		}
 	}
+	return batch
 }
 
 // Close stops all the workers and waits for them to finish.
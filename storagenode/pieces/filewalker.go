@@ -7,10 +7,13 @@ import (
 	"context"
 	"os"
 	"runtime"
+	"sync"
 	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
 
 	"storj.io/common/bloomfilter"
 	"storj.io/common/storj"
@@ -20,12 +23,32 @@ import (
 
 var errFileWalker = errs.Class("filewalker")
 
+const (
+	// checkpointInterval is the minimum time between saving two checkpoints of a walk.
+	checkpointInterval = time.Minute
+	// checkpointMaxAge is the age after which a used space checkpoint is ignored, since
+	// the already walked pieces could have changed too much.
+	checkpointMaxAge = 24 * time.Hour
+)
+
+// FileWalkerConfig configures the used space calculation of the filewalker.
+type FileWalkerConfig struct {
+	Concurrency     int `help:"number of key prefix directories walked in parallel when calculating used space, 1 is best for HDDs" default:"1"`
+	PiecesPerSecond int `help:"maximum number of pieces per second each used space walker processes, 0 means unlimited" default:"0"`
+}
+
 // FileWalker implements methods to walk over pieces in a storage directory.
 type FileWalker struct {
 	log *zap.Logger
 
 	blobs       blobstore.Blobs
 	v0PieceInfo V0PieceInfoDB
+	checkpoints *WalkCheckpoints
+	config      FileWalkerConfig
+	progress    *WalkProgress
+	index       *PieceIndex
+
+	creationTimes *CreationTimeCache
 }
 
 // NewFileWalker creates a new FileWalker.
@@ -37,6 +60,46 @@ func NewFileWalker(log *zap.Logger, blobs blobstore.Blobs, db V0PieceInfoDB) *Fi
 	}
 }
 
+// SetConfig sets how the used space is calculated.
+func (fw *FileWalker) SetConfig(config FileWalkerConfig) {
+	fw.config = config
+}
+
+// SetCheckpoints sets where the progress of used space and garbage collection walks is saved,
+// so that an interrupted walk can be resumed.
+func (fw *FileWalker) SetCheckpoints(checkpoints *WalkCheckpoints) {
+	fw.checkpoints = checkpoints
+}
+
+// SetIndex sets the piece index. When the index is ready, the used space and garbage
+// collection walks use it instead of walking the piece files.
+func (fw *FileWalker) SetIndex(index *PieceIndex) {
+	fw.index = index
+}
+
+// UsesIndex returns whether the walks use the piece index instead of the piece files.
+func (fw *FileWalker) UsesIndex() bool {
+	return fw != nil && fw.index != nil && fw.index.Ready()
+}
+
+// SetCreationTimes sets the cache of the piece creation times. When it's set, garbage collection
+// compares the creation times from the piece headers with the bloom filter creation instead of
+// the mtimes of the piece files.
+func (fw *FileWalker) SetCreationTimes(cache *CreationTimeCache) {
+	fw.creationTimes = cache
+}
+
+// UsesCreationTimes returns whether garbage collection uses the cached piece creation times.
+func (fw *FileWalker) UsesCreationTimes() bool {
+	return fw != nil && fw.creationTimes != nil
+}
+
+// SetProgress sets where the number of pieces processed by the used space and garbage
+// collection walks is counted.
+func (fw *FileWalker) SetProgress(progress *WalkProgress) {
+	fw.progress = progress
+}
+
 // WalkSatellitePieces executes walkFunc for each locally stored piece in the namespace of the
 // given satellite. If walkFunc returns a non-nil error, WalkSatellitePieces will stop iterating
 // and return the error immediately. The ctx parameter is intended specifically to allow canceling
@@ -44,9 +107,16 @@ func NewFileWalker(log *zap.Logger, blobs blobstore.Blobs, db V0PieceInfoDB) *Fi
 //
 // Note that this method includes all locally stored pieces, both V0 and higher.
 func (fw *FileWalker) WalkSatellitePieces(ctx context.Context, satellite storj.NodeID, fn func(StoredPieceAccess) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return fw.walkSatellitePiecesFrom(ctx, satellite, "", fn, nil)
+}
+
+// walkSatellitePiecesFrom is like WalkSatellitePieces, but it skips the V1 pieces with key
+// prefixes up to and including startAfter and calls prefixDone after every walked key prefix.
+func (fw *FileWalker) walkSatellitePiecesFrom(ctx context.Context, satellite storj.NodeID, startAfter string, fn func(StoredPieceAccess) error, prefixDone func(keyPrefix string) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	// iterate over all in V1 storage, skipping v0 pieces
-	err = fw.blobs.WalkNamespace(ctx, satellite.Bytes(), func(blobInfo blobstore.BlobInfo) error {
+	err = fw.blobs.WalkNamespaceFrom(ctx, satellite.Bytes(), startAfter, func(blobInfo blobstore.BlobInfo) error {
 		if blobInfo.StorageFormatVersion() < filestore.FormatV1 {
 			// skip v0 pieces, which are handled separately
 			return nil
@@ -59,7 +129,7 @@ func (fw *FileWalker) WalkSatellitePieces(ctx context.Context, satellite storj.N
 			return nil //nolint: nilerr // we ignore other files
 		}
 		return fn(pieceAccess)
-	})
+	}, prefixDone)
 
 	if err == nil && fw.v0PieceInfo != nil {
 		// iterate over all in V0 storage
@@ -69,9 +139,208 @@ func (fw *FileWalker) WalkSatellitePieces(ctx context.Context, satellite storj.N
 	return errFileWalker.Wrap(err)
 }
 
+// loadCheckpoint returns the checkpoint of an interrupted walk, which can be resumed.
+func (fw *FileWalker) loadCheckpoint(kind string, satelliteID storj.NodeID, createdBefore time.Time) (WalkCheckpoint, bool) {
+	if fw.checkpoints == nil {
+		return WalkCheckpoint{}, false
+	}
+	checkpoint, ok, err := fw.checkpoints.Get(kind, satelliteID)
+	if err != nil {
+		fw.log.Warn("failed to load filewalker checkpoint", zap.String("kind", kind), zap.Stringer("Satellite ID", satelliteID), zap.Error(err))
+		return WalkCheckpoint{}, false
+	}
+	if !ok || !checkpoint.CreatedBefore.Equal(createdBefore) {
+		return WalkCheckpoint{}, false
+	}
+	if kind == WalkUsedSpace && time.Since(checkpoint.UpdatedAt) > checkpointMaxAge {
+		return WalkCheckpoint{}, false
+	}
+	fw.log.Info("resuming filewalker from checkpoint", zap.String("kind", kind), zap.Stringer("Satellite ID", satelliteID), zap.String("prefix", checkpoint.LastPrefix))
+	return checkpoint, true
+}
+
+// checkpointer returns a prefixDone callback, which saves the checkpoint returned by
+// current at most once per checkpointInterval.
+func (fw *FileWalker) checkpointer(kind string, satelliteID storj.NodeID, current func(lastPrefix string) WalkCheckpoint) func(string) error {
+	if fw.checkpoints == nil {
+		return nil
+	}
+	lastSaved := time.Now()
+	return func(keyPrefix string) error {
+		if time.Since(lastSaved) < checkpointInterval {
+			return nil
+		}
+		checkpoint := current(keyPrefix)
+		checkpoint.UpdatedAt = time.Now()
+		if err := fw.checkpoints.Set(kind, satelliteID, checkpoint); err != nil {
+			// failing to save a checkpoint only means that the walk can't be resumed.
+			fw.log.Warn("failed to save filewalker checkpoint", zap.String("kind", kind), zap.Stringer("Satellite ID", satelliteID), zap.Error(err))
+		}
+		lastSaved = time.Now()
+		return nil
+	}
+}
+
+// deleteCheckpoint removes the checkpoint of a completed walk.
+func (fw *FileWalker) deleteCheckpoint(kind string, satelliteID storj.NodeID) {
+	if fw.checkpoints == nil {
+		return
+	}
+	if err := fw.checkpoints.Delete(kind, satelliteID); err != nil {
+		fw.log.Warn("failed to delete filewalker checkpoint", zap.String("kind", kind), zap.Stringer("Satellite ID", satelliteID), zap.Error(err))
+	}
+}
+
 // WalkAndComputeSpaceUsedBySatellite walks over all pieces for a given satellite, adds up and returns the total space used.
+// When checkpoints are set, an interrupted walk resumes from the last checkpoint.
+//
+// The key prefixes are walked by config.Concurrency workers in parallel.
 func (fw *FileWalker) WalkAndComputeSpaceUsedBySatellite(ctx context.Context, satelliteID storj.NodeID) (satPiecesTotal int64, satPiecesContentSize int64, err error) {
-	err = fw.WalkSatellitePieces(ctx, satelliteID, func(access StoredPieceAccess) error {
+	defer mon.Task()(&ctx)(&err)
+
+	if fw.UsesIndex() {
+		return fw.spaceUsedFromIndex(ctx, satelliteID)
+	}
+
+	var startAfter string
+	if checkpoint, ok := fw.loadCheckpoint(WalkUsedSpace, satelliteID, time.Time{}); ok {
+		startAfter = checkpoint.LastPrefix
+		satPiecesTotal = checkpoint.PiecesTotal
+		satPiecesContentSize = checkpoint.PiecesContentSize
+	}
+
+	keyPrefixes, err := fw.blobs.ListKeyPrefixes(ctx, satelliteID.Bytes())
+	if err != nil {
+		return 0, 0, errFileWalker.Wrap(err)
+	}
+	for len(keyPrefixes) > 0 && keyPrefixes[0] <= startAfter {
+		keyPrefixes = keyPrefixes[1:]
+	}
+
+	// the checkpoint only includes the prefixes which were walked without gaps,
+	// since the workers can complete them out of order.
+	type prefixUsage struct {
+		done        bool
+		total       int64
+		contentSize int64
+	}
+	var mu sync.Mutex
+	usages := make([]prefixUsage, len(keyPrefixes))
+	completed := 0
+	prefixDone := fw.checkpointer(WalkUsedSpace, satelliteID, func(lastPrefix string) WalkCheckpoint {
+		return WalkCheckpoint{
+			LastPrefix:        lastPrefix,
+			PiecesTotal:       satPiecesTotal,
+			PiecesContentSize: satPiecesContentSize,
+		}
+	})
+
+	concurrency := fw.config.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	jobs := make(chan int)
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.Go(func() error {
+		defer close(jobs)
+		for i := range keyPrefixes {
+			select {
+			case jobs <- i:
+			case <-groupCtx.Done():
+				return nil
+			}
+		}
+		return nil
+	})
+	for worker := 0; worker < concurrency; worker++ {
+		group.Go(func() error {
+			var limiter *rate.Limiter
+			if fw.config.PiecesPerSecond > 0 {
+				limiter = rate.NewLimiter(rate.Limit(fw.config.PiecesPerSecond), 1)
+			}
+
+			for i := range jobs {
+				var usage prefixUsage
+				err := fw.blobs.WalkNamespacePrefix(groupCtx, satelliteID.Bytes(), keyPrefixes[i], func(blobInfo blobstore.BlobInfo) error {
+					if limiter != nil {
+						if err := limiter.Wait(groupCtx); err != nil {
+							return err
+						}
+					}
+					pieceTotal, pieceContentSize, err := fw.blobSize(groupCtx, blobInfo)
+					if err != nil {
+						return err
+					}
+					fw.progress.Add(1, pieceContentSize)
+					usage.total += pieceTotal
+					usage.contentSize += pieceContentSize
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+
+				mu.Lock()
+				usage.done = true
+				usages[i] = usage
+				advanced := false
+				for completed < len(usages) && usages[completed].done {
+					satPiecesTotal += usages[completed].total
+					satPiecesContentSize += usages[completed].contentSize
+					completed++
+					advanced = true
+				}
+				if advanced && prefixDone != nil {
+					err = prefixDone(keyPrefixes[completed-1])
+				}
+				mu.Unlock()
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return satPiecesTotal, satPiecesContentSize, errFileWalker.Wrap(err)
+	}
+
+	v0Total, v0ContentSize, err := fw.spaceUsedByV0Pieces(ctx, satelliteID)
+	satPiecesTotal += v0Total
+	satPiecesContentSize += v0ContentSize
+	if err != nil {
+		return satPiecesTotal, satPiecesContentSize, errFileWalker.Wrap(err)
+	}
+
+	fw.deleteCheckpoint(WalkUsedSpace, satelliteID)
+	return satPiecesTotal, satPiecesContentSize, nil
+}
+
+// spaceUsedFromIndex returns the space used by the pieces of the satellite using the piece index.
+func (fw *FileWalker) spaceUsedFromIndex(ctx context.Context, satelliteID storj.NodeID) (satPiecesTotal int64, satPiecesContentSize int64, err error) {
+	err = fw.index.Walk(ctx, satelliteID, func(piece IndexedPiece) error {
+		fw.progress.Add(1, piece.ContentSize)
+		satPiecesTotal += piece.Total
+		satPiecesContentSize += piece.ContentSize
+		return nil
+	})
+	if err != nil {
+		return satPiecesTotal, satPiecesContentSize, errFileWalker.Wrap(err)
+	}
+
+	v0Total, v0ContentSize, err := fw.spaceUsedByV0Pieces(ctx, satelliteID)
+	return satPiecesTotal + v0Total, satPiecesContentSize + v0ContentSize, errFileWalker.Wrap(err)
+}
+
+// spaceUsedByV0Pieces returns the space used by the V0 pieces of the satellite.
+func (fw *FileWalker) spaceUsedByV0Pieces(ctx context.Context, satelliteID storj.NodeID) (satPiecesTotal int64, satPiecesContentSize int64, err error) {
+	if fw.v0PieceInfo == nil {
+		return 0, 0, nil
+	}
+
+	// iterate over all in V0 storage
+	err = fw.v0PieceInfo.WalkSatelliteV0Pieces(ctx, fw.blobs, satelliteID, func(access StoredPieceAccess) error {
 		pieceTotal, pieceContentSize, err := access.Size(ctx)
 		if err != nil {
 			if os.IsNotExist(err) {
@@ -79,12 +348,34 @@ func (fw *FileWalker) WalkAndComputeSpaceUsedBySatellite(ctx context.Context, sa
 			}
 			return err
 		}
+		fw.progress.Add(1, pieceContentSize)
 		satPiecesTotal += pieceTotal
 		satPiecesContentSize += pieceContentSize
 		return nil
 	})
+	return satPiecesTotal, satPiecesContentSize, err
+}
 
-	return satPiecesTotal, satPiecesContentSize, errFileWalker.Wrap(err)
+// blobSize returns the size of a V1 piece blob. V0 pieces and blobs which are not
+// pieces or were deleted while walking have zero size.
+func (fw *FileWalker) blobSize(ctx context.Context, blobInfo blobstore.BlobInfo) (pieceTotal, pieceContentSize int64, err error) {
+	if blobInfo.StorageFormatVersion() < filestore.FormatV1 {
+		// skip v0 pieces, which are handled separately
+		return 0, 0, nil
+	}
+	pieceAccess, err := newStoredPieceAccess(fw.blobs, blobInfo)
+	if err != nil {
+		// this is not a real piece blob, see walkSatellitePiecesFrom.
+		return 0, 0, nil //nolint: nilerr // we ignore other files
+	}
+	pieceTotal, pieceContentSize, err = pieceAccess.Size(ctx)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, 0, nil
+		}
+		return 0, 0, err
+	}
+	return pieceTotal, pieceContentSize, nil
 }
 
 // WalkSatellitePiecesToTrash returns a list of piece IDs that need to be trashed for the given satellite.
@@ -136,6 +427,12 @@ func (fw *FileWalker) WalkAndComputeSpaceUsedBySatellite(ctx context.Context, sa
 // nontrivial amount, mtimes on existing blobs should also be adjusted (by the same interval,
 // ideally, but just running "touch" on all blobs is sufficient to avoid incorrect deletion of
 // data).
+//
+// When the creation time cache is set, the exact CreationTime is used instead. The header of a
+// piece is read only when its creation time isn't cached yet.
+//
+// When checkpoints are set, an interrupted walk for the same createdBefore resumes from the last
+// checkpoint.
 func (fw *FileWalker) WalkSatellitePiecesToTrash(ctx context.Context, satelliteID storj.NodeID, createdBefore time.Time, filter *bloomfilter.Filter) (pieceIDs []storj.PieceID, piecesCount, piecesSkipped int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -143,8 +440,31 @@ func (fw *FileWalker) WalkSatellitePiecesToTrash(ctx context.Context, satelliteI
 		return
 	}
 
-	err = fw.WalkSatellitePieces(ctx, satelliteID, func(access StoredPieceAccess) error {
+	if fw.UsesIndex() {
+		return fw.piecesToTrashFromIndex(ctx, satelliteID, createdBefore, filter)
+	}
+
+	var startAfter string
+	if checkpoint, ok := fw.loadCheckpoint(WalkGC, satelliteID, createdBefore); ok {
+		startAfter = checkpoint.LastPrefix
+		pieceIDs = checkpoint.PieceIDs
+		piecesCount = checkpoint.PiecesCount
+		piecesSkipped = checkpoint.PiecesSkipped
+	}
+
+	prefixDone := fw.checkpointer(WalkGC, satelliteID, func(lastPrefix string) WalkCheckpoint {
+		return WalkCheckpoint{
+			LastPrefix:    lastPrefix,
+			CreatedBefore: createdBefore,
+			PieceIDs:      pieceIDs,
+			PiecesCount:   piecesCount,
+			PiecesSkipped: piecesSkipped,
+		}
+	})
+
+	err = fw.walkSatellitePiecesFrom(ctx, satelliteID, startAfter, func(access StoredPieceAccess) error {
 		piecesCount++
+		fw.progress.Add(1, 0)
 
 		// We call Gosched() when done because the GC process is expected to be long and we want to keep it at low priority,
 		// so other goroutines can continue serving requests.
@@ -156,6 +476,29 @@ func (fw *FileWalker) WalkSatellitePiecesToTrash(ctx context.Context, satelliteI
 			return nil
 		}
 
+		if fw.creationTimes != nil && access.StorageFormatVersion() >= filestore.FormatV1 {
+			cTime, ok, err := fw.cachedCreationTime(ctx, satelliteID, pieceID, func() (StoredPieceAccess, error) {
+				return access, nil
+			})
+			if err != nil {
+				if errs.IsFunc(err, os.IsNotExist) {
+					// piece was deleted while we were scanning.
+					return nil
+				}
+
+				piecesSkipped++
+				fw.log.Warn("failed to determine creation time of piece", zap.Error(err))
+				// but continue iterating.
+				return nil
+			}
+			if ok {
+				if cTime.Before(createdBefore) {
+					pieceIDs = append(pieceIDs, pieceID)
+				}
+				return ctx.Err()
+			}
+		}
+
 		// If the blob's mtime is at or after the createdBefore line, we can't safely delete it;
 		// it might not be trash. If it is, we can expect to get it next time.
 		//
@@ -185,8 +528,124 @@ func (fw *FileWalker) WalkSatellitePiecesToTrash(ctx context.Context, satelliteI
 		default:
 		}
 
+		return nil
+	}, prefixDone)
+	if err != nil {
+		return pieceIDs, piecesCount, piecesSkipped, errFileWalker.Wrap(err)
+	}
+
+	fw.deleteCheckpoint(WalkGC, satelliteID)
+	return pieceIDs, piecesCount, piecesSkipped, nil
+}
+
+// piecesToTrashFromIndex is like WalkSatellitePiecesToTrash, but it uses the piece index instead
+// of walking the piece files. The mtime in the index is the mtime of the blob after it was committed.
+func (fw *FileWalker) piecesToTrashFromIndex(ctx context.Context, satelliteID storj.NodeID, createdBefore time.Time, filter *bloomfilter.Filter) (pieceIDs []storj.PieceID, piecesCount, piecesSkipped int64, err error) {
+	err = fw.index.Walk(ctx, satelliteID, func(piece IndexedPiece) error {
+		piecesCount++
+		fw.progress.Add(1, 0)
+
+		if filter.Contains(piece.PieceID) {
+			return nil
+		}
+
+		if fw.creationTimes != nil {
+			cTime, ok, err := fw.cachedCreationTime(ctx, satelliteID, piece.PieceID, func() (StoredPieceAccess, error) {
+				blobInfo, err := fw.blobs.StatWithStorageFormat(ctx, blobstore.BlobRef{
+					Namespace: satelliteID.Bytes(),
+					Key:       piece.PieceID.Bytes(),
+				}, filestore.FormatV1)
+				if err != nil {
+					return nil, err
+				}
+				return newStoredPieceAccess(fw.blobs, blobInfo)
+			})
+			if err != nil {
+				if !errs.IsFunc(err, os.IsNotExist) {
+					piecesSkipped++
+					fw.log.Warn("failed to determine creation time of piece", zap.Error(err))
+				}
+				return nil
+			}
+			if ok {
+				if cTime.Before(createdBefore) {
+					pieceIDs = append(pieceIDs, piece.PieceID)
+				}
+				return nil
+			}
+		}
+
+		if piece.ModTime.Before(createdBefore) {
+			pieceIDs = append(pieceIDs, piece.PieceID)
+		}
 		return nil
 	})
+	if err != nil {
+		return pieceIDs, piecesCount, piecesSkipped, errFileWalker.Wrap(err)
+	}
+
+	if fw.v0PieceInfo == nil {
+		return pieceIDs, piecesCount, piecesSkipped, nil
+	}
+
+	// V0 pieces are not indexed.
+	err = fw.v0PieceInfo.WalkSatelliteV0Pieces(ctx, fw.blobs, satelliteID, func(access StoredPieceAccess) error {
+		piecesCount++
+		fw.progress.Add(1, 0)
 
+		pieceID := access.PieceID()
+		if filter.Contains(pieceID) {
+			return nil
+		}
+		mTime, err := access.ModTime(ctx)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			piecesSkipped++
+			fw.log.Warn("failed to determine mtime of blob", zap.Error(err))
+			return nil
+		}
+		if mTime.Before(createdBefore) {
+			pieceIDs = append(pieceIDs, pieceID)
+		}
+		return nil
+	})
 	return pieceIDs, piecesCount, piecesSkipped, errFileWalker.Wrap(err)
 }
+
+// cachedCreationTime returns the creation time of a V1 piece from the cache. When the
+// creation time isn't cached, it's read from the header of the piece opened with access and
+// added to the cache. ok is false, when the header has no creation time, so the mtime has
+// to be used instead.
+func (fw *FileWalker) cachedCreationTime(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, access func() (StoredPieceAccess, error)) (_ time.Time, ok bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cTime, found, err := fw.creationTimes.Get(ctx, satelliteID, pieceID)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	if found {
+		mon.Counter("creation_time_cache_hit").Inc(1)
+		return cTime, true, nil
+	}
+	mon.Counter("creation_time_cache_miss").Inc(1)
+
+	pieceAccess, err := access()
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	cTime, err = pieceAccess.CreationTime(ctx)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	if cTime.IsZero() {
+		return time.Time{}, false, nil
+	}
+
+	if err := fw.creationTimes.Add(ctx, satelliteID, pieceID, cTime); err != nil {
+		// the creation time is read again by the next walk.
+		fw.log.Warn("failed to cache the creation time of piece", zap.Error(err))
+	}
+	return cTime, true, nil
+}
@@ -0,0 +1,135 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/pieces"
+)
+
+func TestFileWalkerResumeFromCheckpoint(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	log := zaptest.NewLogger(t)
+
+	dir, err := filestore.NewDir(log, ctx.Dir("pieces"))
+	require.NoError(t, err)
+
+	blobs := filestore.New(log, dir, filestore.DefaultConfig)
+	defer ctx.Check(blobs.Close)
+
+	fw := pieces.NewFileWalker(log, blobs, nil)
+	store := pieces.NewStore(log, fw, nil, blobs, nil, nil, nil, pieces.DefaultConfig)
+
+	satelliteID := testrand.NodeID()
+	for i := 0; i < 50; i++ {
+		writer, err := store.Writer(ctx, satelliteID, testrand.PieceID(), pb.PieceHashAlgorithm_SHA256)
+		require.NoError(t, err)
+		_, err = writer.Write(testrand.BytesInt(1000 + i))
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{}))
+	}
+
+	fullTotal, fullContentSize, err := fw.WalkAndComputeSpaceUsedBySatellite(ctx, satelliteID)
+	require.NoError(t, err)
+
+	var prefixes []string
+	err = blobs.WalkNamespaceFrom(ctx, satelliteID.Bytes(), "", func(blobstore.BlobInfo) error { return nil }, func(prefix string) error {
+		prefixes = append(prefixes, prefix)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Greater(t, len(prefixes), 2)
+	require.IsIncreasing(t, prefixes)
+
+	checkpoints, err := pieces.OpenWalkCheckpoints(ctx.Dir("checkpoints"))
+	require.NoError(t, err)
+	fw.SetCheckpoints(checkpoints)
+
+	// resuming from the middle only walks the remaining prefixes.
+	lastPrefix := prefixes[len(prefixes)/2]
+	require.NoError(t, checkpoints.Set(pieces.WalkUsedSpace, satelliteID, pieces.WalkCheckpoint{
+		LastPrefix: lastPrefix,
+		UpdatedAt:  time.Now(),
+	}))
+	remainingTotal, remainingContentSize, err := fw.WalkAndComputeSpaceUsedBySatellite(ctx, satelliteID)
+	require.NoError(t, err)
+	require.Less(t, remainingTotal, fullTotal)
+	require.Less(t, remainingContentSize, fullContentSize)
+
+	// the checkpoint is removed after the walk completed.
+	_, ok, err := checkpoints.Get(pieces.WalkUsedSpace, satelliteID)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// totals from the checkpoint are included in the result.
+	require.NoError(t, checkpoints.Set(pieces.WalkUsedSpace, satelliteID, pieces.WalkCheckpoint{
+		LastPrefix:        lastPrefix,
+		UpdatedAt:         time.Now(),
+		PiecesTotal:       fullTotal - remainingTotal,
+		PiecesContentSize: fullContentSize - remainingContentSize,
+	}))
+	total, contentSize, err := fw.WalkAndComputeSpaceUsedBySatellite(ctx, satelliteID)
+	require.NoError(t, err)
+	require.Equal(t, fullTotal, total)
+	require.Equal(t, fullContentSize, contentSize)
+
+	// stale checkpoints are ignored.
+	require.NoError(t, checkpoints.Set(pieces.WalkUsedSpace, satelliteID, pieces.WalkCheckpoint{
+		LastPrefix:  prefixes[len(prefixes)-1],
+		UpdatedAt:   time.Now().Add(-48 * time.Hour),
+		PiecesTotal: 1,
+	}))
+	total, _, err = fw.WalkAndComputeSpaceUsedBySatellite(ctx, satelliteID)
+	require.NoError(t, err)
+	require.Equal(t, fullTotal, total)
+}
+
+func TestFileWalkerConcurrency(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	log := zaptest.NewLogger(t)
+
+	dir, err := filestore.NewDir(log, ctx.Dir("pieces"))
+	require.NoError(t, err)
+
+	blobs := filestore.New(log, dir, filestore.DefaultConfig)
+	defer ctx.Check(blobs.Close)
+
+	fw := pieces.NewFileWalker(log, blobs, nil)
+	store := pieces.NewStore(log, fw, nil, blobs, nil, nil, nil, pieces.DefaultConfig)
+
+	satelliteID := testrand.NodeID()
+	var expectedContentSize int64
+	for i := 0; i < 100; i++ {
+		writer, err := store.Writer(ctx, satelliteID, testrand.PieceID(), pb.PieceHashAlgorithm_SHA256)
+		require.NoError(t, err)
+		_, err = writer.Write(testrand.BytesInt(100 + i))
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{}))
+		expectedContentSize += int64(100 + i)
+	}
+
+	sequentialTotal, sequentialContentSize, err := fw.WalkAndComputeSpaceUsedBySatellite(ctx, satelliteID)
+	require.NoError(t, err)
+	require.Equal(t, expectedContentSize, sequentialContentSize)
+
+	fw.SetConfig(pieces.FileWalkerConfig{Concurrency: 8, PiecesPerSecond: 1000})
+	parallelTotal, parallelContentSize, err := fw.WalkAndComputeSpaceUsedBySatellite(ctx, satelliteID)
+	require.NoError(t, err)
+	require.Equal(t, sequentialTotal, parallelTotal)
+	require.Equal(t, sequentialContentSize, parallelContentSize)
+}
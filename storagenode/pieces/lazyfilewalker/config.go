@@ -5,6 +5,7 @@ package lazyfilewalker
 
 import (
 	"strconv"
+	"strings"
 
 	"storj.io/storj/storagenode/blobstore/filestore"
 )
@@ -19,24 +20,38 @@ type Config struct {
 	Pieces    string `help:"path to store pieces in"`
 	Filestore filestore.Config
 
+	AdditionalPieces []string `help:"additional directories where pieces are stored" default:""`
+
 	LowerIOPriority bool `help:"if true, the process will run with lower IO priority" default:"true"`
+
+	Concurrency     int `help:"number of key prefix directories walked in parallel when calculating used space" default:"1"`
+	PiecesPerSecond int `help:"maximum number of pieces per second each used space walker processes, 0 means unlimited" default:"0"`
 }
 
 // Args returns the flags to be passed lazyfilewalker process.
 func (config *Config) Args() []string {
 	// TODO: of course, we shouldn't hardcode this.
-	return []string{
+	args := []string{
 		"--storage", config.Storage,
 		"--info", config.Info,
 		"--info2", config.Info2,
 		"--pieces", config.Pieces,
 		"--driver", config.Driver,
 		"--filestore.write-buffer-size", config.Filestore.WriteBufferSize.String(),
+		"--filestore.delete-pacing.batch-size", strconv.Itoa(config.Filestore.DeletePacing.BatchSize),
+		"--filestore.delete-pacing.target-latency", config.Filestore.DeletePacing.TargetLatency.String(),
+		"--filestore.delete-pacing.max-pause", config.Filestore.DeletePacing.MaxPause.String(),
 		// set log output to stderr, so it doesn't interfere with the output of the command
 		"--log.output", "stderr",
 		// use the json formatter in the subprocess, so we could read lines and re-log them in the main process
 		// with all the fields intact.
 		"--log.encoding", "json",
 		"--lower-io-priority", strconv.FormatBool(config.LowerIOPriority),
+		"--concurrency", strconv.Itoa(config.Concurrency),
+		"--pieces-per-second", strconv.Itoa(config.PiecesPerSecond),
+	}
+	if len(config.AdditionalPieces) > 0 {
+		args = append(args, "--additional-pieces", strings.Join(config.AdditionalPieces, ","))
 	}
+	return args
 }
@@ -20,18 +20,21 @@ type process struct {
 	log        *zap.Logger
 	executable string
 	args       []string
+	progress   func(piecesProcessed, bytesProcessed int64)
 
 	cmd execwrapper.Command
 }
 
 // newProcess creates a new process.
 // The cmd argument can be used to replace the subprocess with a runner for testing, it can be nil.
-func newProcess(cmd execwrapper.Command, log *zap.Logger, executable string, args []string) *process {
+// The progress func is called with the progress reported by the subprocess, it can be nil.
+func newProcess(cmd execwrapper.Command, log *zap.Logger, executable string, args []string, progress func(piecesProcessed, bytesProcessed int64)) *process {
 	return &process{
 		cmd:        cmd,
 		log:        log,
 		executable: executable,
 		args:       args,
+		progress:   progress,
 	}
 }
 
@@ -47,7 +50,7 @@ func (p *process) run(ctx context.Context, req, resp interface{}) (err error) {
 	p.log.Info("starting subprocess")
 
 	var buf, outbuf bytes.Buffer
-	writer := &zapWrapper{p.log.Named("subprocess")}
+	writer := &zapWrapper{Log: p.log.Named("subprocess"), progress: p.progress}
 
 	// encode the struct and write it to the buffer
 	enc := json.NewEncoder(&buf)
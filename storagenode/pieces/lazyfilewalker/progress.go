@@ -0,0 +1,89 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lazyfilewalker
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+)
+
+// progressMessage is the message of the log entries which the subprocess uses to report its progress.
+const progressMessage = "lazyfilewalker progress"
+
+// ReportProgress logs the progress of a subprocess, in the format that is parsed by the
+// Supervisor in the main process.
+func ReportProgress(log *zap.Logger, piecesProcessed, bytesProcessed int64) {
+	log.Info(progressMessage, zap.Int64("piecesProcessed", piecesProcessed), zap.Int64("bytesProcessed", bytesProcessed))
+}
+
+// Progress is the progress of a running subprocess.
+type Progress struct {
+	Command     string       `json:"command"`
+	SatelliteID storj.NodeID `json:"satelliteID"`
+
+	PiecesProcessed int64 `json:"piecesProcessed"`
+	BytesProcessed  int64 `json:"bytesProcessed"`
+
+	StartedAt time.Time `json:"startedAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// progressTracker keeps the progress of the running subprocesses.
+type progressTracker struct {
+	mu      sync.Mutex
+	running map[*Progress]struct{}
+}
+
+// start starts tracking a subprocess. The returned update func records the reported progress,
+// and done must be called when the subprocess finished.
+func (tracker *progressTracker) start(command string, satelliteID storj.NodeID) (update func(piecesProcessed, bytesProcessed int64), done func()) {
+	now := time.Now()
+	progress := &Progress{
+		Command:     command,
+		SatelliteID: satelliteID,
+		StartedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	tracker.mu.Lock()
+	if tracker.running == nil {
+		tracker.running = map[*Progress]struct{}{}
+	}
+	tracker.running[progress] = struct{}{}
+	tracker.mu.Unlock()
+
+	update = func(piecesProcessed, bytesProcessed int64) {
+		tracker.mu.Lock()
+		defer tracker.mu.Unlock()
+		progress.PiecesProcessed = piecesProcessed
+		progress.BytesProcessed = bytesProcessed
+		progress.UpdatedAt = time.Now()
+	}
+	done = func() {
+		tracker.mu.Lock()
+		defer tracker.mu.Unlock()
+		delete(tracker.running, progress)
+	}
+	return update, done
+}
+
+// list returns the progress of the running subprocesses, oldest first.
+func (tracker *progressTracker) list() []Progress {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	list := make([]Progress, 0, len(tracker.running))
+	for progress := range tracker.running {
+		list = append(list, *progress)
+	}
+	sort.Slice(list, func(i, k int) bool {
+		return list[i].StartedAt.Before(list[k].StartedAt)
+	})
+	return list
+}
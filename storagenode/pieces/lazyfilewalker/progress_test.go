@@ -0,0 +1,50 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lazyfilewalker
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testrand"
+)
+
+func TestProgressReporting(t *testing.T) {
+	var tracker progressTracker
+	satelliteID := testrand.NodeID()
+
+	update, done := tracker.start(UsedSpaceFilewalkerCmdName, satelliteID)
+	writer := &zapWrapper{Log: zaptest.NewLogger(t), progress: update}
+
+	// the subprocess logs its progress as json to stderr.
+	var buf bytes.Buffer
+	encoder := zapcore.NewJSONEncoder(zapcore.EncoderConfig{
+		MessageKey:  "M",
+		LevelKey:    "L",
+		NameKey:     "N",
+		EncodeLevel: zapcore.LowercaseLevelEncoder,
+	})
+	subprocessLog := zap.New(zapcore.NewCore(encoder, zapcore.AddSync(&buf), zapcore.DebugLevel))
+	subprocessLog.Info("walking pieces")
+	ReportProgress(subprocessLog, 10, 1000)
+	ReportProgress(subprocessLog, 25, 2500)
+
+	_, err := writer.Write(buf.Bytes())
+	require.NoError(t, err)
+
+	running := tracker.list()
+	require.Len(t, running, 1)
+	require.Equal(t, UsedSpaceFilewalkerCmdName, running[0].Command)
+	require.Equal(t, satelliteID, running[0].SatelliteID)
+	require.EqualValues(t, 25, running[0].PiecesProcessed)
+	require.EqualValues(t, 2500, running[0].BytesProcessed)
+
+	done()
+	require.Empty(t, tracker.list())
+}
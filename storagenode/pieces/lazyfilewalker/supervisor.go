@@ -21,8 +21,20 @@ const (
 	UsedSpaceFilewalkerCmdName = "used-space-filewalker"
 	// GCFilewalkerCmdName is the name of the gc-filewalker subcommand.
 	GCFilewalkerCmdName = "gc-filewalker"
+	// TrashFilewalkerCmdName is the name of the trash-filewalker subcommand.
+	TrashFilewalkerCmdName = "trash-filewalker"
+	// ReconcileFilewalkerCmdName is the name of the reconcile-filewalker subcommand.
+	ReconcileFilewalkerCmdName = "reconcile-filewalker"
 )
 
+// CmdNames are the names of all the lazyfilewalker subcommands.
+var CmdNames = []string{
+	UsedSpaceFilewalkerCmdName,
+	GCFilewalkerCmdName,
+	TrashFilewalkerCmdName,
+	ReconcileFilewalkerCmdName,
+}
+
 var (
 	errLazyFilewalker = errs.Class("lazyfilewalker")
 
@@ -39,9 +51,15 @@ type Supervisor struct {
 	executable    string
 	gcArgs        []string
 	usedSpaceArgs []string
+	trashArgs     []string
+	reconcileArgs []string
+
+	progress progressTracker
 
 	testingGCCmd        execwrapper.Command
 	testingUsedSpaceCmd execwrapper.Command
+	testingTrashCmd     execwrapper.Command
+	testingReconcileCmd execwrapper.Command
 }
 
 // NewSupervisor creates a new lazy filewalker Supervisor.
@@ -50,10 +68,30 @@ func NewSupervisor(log *zap.Logger, config Config, executable string) *Superviso
 		log:           log,
 		gcArgs:        append([]string{GCFilewalkerCmdName}, config.Args()...),
 		usedSpaceArgs: append([]string{UsedSpaceFilewalkerCmdName}, config.Args()...),
+		trashArgs:     append([]string{TrashFilewalkerCmdName}, config.Args()...),
+		reconcileArgs: append([]string{ReconcileFilewalkerCmdName}, config.Args()...),
 		executable:    executable,
 	}
 }
 
+// Progress returns the progress of the running subprocesses.
+func (fw *Supervisor) Progress() []Progress {
+	return fw.progress.list()
+}
+
+// run runs a subprocess for the command and tracks its progress.
+func (fw *Supervisor) run(ctx context.Context, cmd execwrapper.Command, command string, args []string, satelliteID storj.NodeID, req, resp interface{}) error {
+	log := fw.log.Named(command)
+	if !satelliteID.IsZero() {
+		log = log.With(zap.String("satelliteID", satelliteID.String()))
+	}
+
+	update, done := fw.progress.start(command, satelliteID)
+	defer done()
+
+	return newProcess(cmd, log, fw.executable, args, update).run(ctx, req, resp)
+}
+
 // TestingSetGCCmd sets the command for the gc-filewalker subprocess.
 // The cmd acts as a replacement for the subprocess.
 func (fw *Supervisor) TestingSetGCCmd(cmd execwrapper.Command) {
@@ -66,6 +104,18 @@ func (fw *Supervisor) TestingSetUsedSpaceCmd(cmd execwrapper.Command) {
 	fw.testingUsedSpaceCmd = cmd
 }
 
+// TestingSetTrashCmd sets the command for the trash-filewalker subprocess.
+// The cmd acts as a replacement for the subprocess.
+func (fw *Supervisor) TestingSetTrashCmd(cmd execwrapper.Command) {
+	fw.testingTrashCmd = cmd
+}
+
+// TestingSetReconcileCmd sets the command for the reconcile-filewalker subprocess.
+// The cmd acts as a replacement for the subprocess.
+func (fw *Supervisor) TestingSetReconcileCmd(cmd execwrapper.Command) {
+	fw.testingReconcileCmd = cmd
+}
+
 // UsedSpaceRequest is the request struct for the used-space-filewalker process.
 type UsedSpaceRequest struct {
 	SatelliteID storj.NodeID `json:"satelliteID"`
@@ -91,6 +141,36 @@ type GCFilewalkerResponse struct {
 	PiecesCount        int64           `json:"piecesCount"`
 }
 
+// TrashRequest is the request struct for the trash-filewalker process.
+type TrashRequest struct {
+	SatelliteID   storj.NodeID `json:"satelliteID"`
+	TrashedBefore time.Time    `json:"trashedBefore"`
+}
+
+// TrashResponse is the response struct for the trash-filewalker process.
+type TrashResponse struct {
+	BytesDeleted    int64           `json:"bytesDeleted"`
+	DeletedPieceIDs []storj.PieceID `json:"deletedPieceIDs"`
+}
+
+// ReconcileRequest is the request struct for the reconcile-filewalker process.
+type ReconcileRequest struct {
+	SatelliteIDs []storj.NodeID `json:"satelliteIDs"`
+}
+
+// ReconcileResponse is the response struct for the reconcile-filewalker process.
+type ReconcileResponse struct {
+	Satellites []SatelliteSpaceUsed `json:"satellites"`
+	TrashTotal int64                `json:"trashTotal"`
+}
+
+// SatelliteSpaceUsed is the space used by the pieces of a satellite.
+type SatelliteSpaceUsed struct {
+	SatelliteID       storj.NodeID `json:"satelliteID"`
+	PiecesTotal       int64        `json:"piecesTotal"`
+	PiecesContentSize int64        `json:"piecesContentSize"`
+}
+
 // WalkAndComputeSpaceUsedBySatellite returns the total used space by satellite.
 func (fw *Supervisor) WalkAndComputeSpaceUsedBySatellite(ctx context.Context, satelliteID storj.NodeID) (piecesTotal int64, piecesContentSize int64, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -100,9 +180,7 @@ func (fw *Supervisor) WalkAndComputeSpaceUsedBySatellite(ctx context.Context, sa
 	}
 	var resp UsedSpaceResponse
 
-	log := fw.log.Named(UsedSpaceFilewalkerCmdName).With(zap.String("satelliteID", satelliteID.String()))
-
-	err = newProcess(fw.testingUsedSpaceCmd, log, fw.executable, fw.usedSpaceArgs).run(ctx, req, &resp)
+	err = fw.run(ctx, fw.testingUsedSpaceCmd, UsedSpaceFilewalkerCmdName, fw.usedSpaceArgs, satelliteID, req, &resp)
 	if err != nil {
 		return 0, 0, err
 	}
@@ -125,12 +203,46 @@ func (fw *Supervisor) WalkSatellitePiecesToTrash(ctx context.Context, satelliteI
 	}
 	var resp GCFilewalkerResponse
 
-	log := fw.log.Named(GCFilewalkerCmdName).With(zap.String("satelliteID", satelliteID.String()))
-
-	err = newProcess(fw.testingGCCmd, log, fw.executable, fw.gcArgs).run(ctx, req, &resp)
+	err = fw.run(ctx, fw.testingGCCmd, GCFilewalkerCmdName, fw.gcArgs, satelliteID, req, &resp)
 	if err != nil {
 		return nil, 0, 0, err
 	}
 
 	return resp.PieceIDs, resp.PiecesSkippedCount, resp.PiecesCount, nil
 }
+
+// EmptyTrash deletes the pieces of the satellite which were trashed before trashedBefore and
+// returns the size and the IDs of the deleted pieces.
+func (fw *Supervisor) EmptyTrash(ctx context.Context, satelliteID storj.NodeID, trashedBefore time.Time) (bytesDeleted int64, deletedPieceIDs []storj.PieceID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	req := TrashRequest{
+		SatelliteID:   satelliteID,
+		TrashedBefore: trashedBefore,
+	}
+	var resp TrashResponse
+
+	err = fw.run(ctx, fw.testingTrashCmd, TrashFilewalkerCmdName, fw.trashArgs, satelliteID, req, &resp)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return resp.BytesDeleted, resp.DeletedPieceIDs, nil
+}
+
+// WalkAndReconcileSpaceUsed returns the space used by the pieces of the satellites and by the trash.
+func (fw *Supervisor) WalkAndReconcileSpaceUsed(ctx context.Context, satelliteIDs []storj.NodeID) (satellites []SatelliteSpaceUsed, trashTotal int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	req := ReconcileRequest{
+		SatelliteIDs: satelliteIDs,
+	}
+	var resp ReconcileResponse
+
+	err = fw.run(ctx, fw.testingReconcileCmd, ReconcileFilewalkerCmdName, fw.reconcileArgs, storj.NodeID{}, req, &resp)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return resp.Satellites, resp.TrashTotal, nil
+}
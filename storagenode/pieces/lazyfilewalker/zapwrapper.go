@@ -17,6 +17,9 @@ import (
 
 type zapWrapper struct {
 	Log *zap.Logger
+
+	// progress is called with the progress reported by the subprocess, it can be nil.
+	progress func(piecesProcessed, bytesProcessed int64)
 }
 
 var _ io.Writer = (*zapWrapper)(nil)
@@ -79,6 +82,14 @@ func (w *zapWrapper) log(b []byte) error {
 	delete(logger.LogMap, "N")
 	delete(logger.LogMap, "T")
 
+	if logger.Message == progressMessage && w.progress != nil {
+		piecesProcessed, _ := logger.LogMap["piecesProcessed"].(float64)
+		bytesProcessed, _ := logger.LogMap["bytesProcessed"].(float64)
+		w.progress(int64(piecesProcessed), int64(bytesProcessed))
+		// progress reports are frequent, so they are only logged for debugging.
+		logger.Level = zapcore.DebugLevel
+	}
+
 	log := w.Log.Named(logger.Name)
 	if ce := log.Check(logger.Level, logger.Message); ce != nil {
 		if logger.Stack != "" {
@@ -0,0 +1,445 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+)
+
+// ErrPieceIndex is the error class for the piece index.
+var ErrPieceIndex = errs.Class("piece index")
+
+var (
+	// indexPiecesBucket contains a bucket of pieces for every satellite.
+	indexPiecesBucket = []byte("pieces")
+	// indexMetaBucket contains the state of the index.
+	indexMetaBucket = []byte("meta")
+	// indexBuiltKey is set when the index was completely built.
+	indexBuiltKey = []byte("built")
+)
+
+const (
+	// indexEntrySize is the size of an encoded index entry: total size, content size and mtime.
+	indexEntrySize = 3 * 8
+	// indexBatchSize is the number of pieces written in a single transaction while rebuilding.
+	indexBatchSize = 1000
+	// indexOpenTimeout is how long opening the index waits for another process to release it.
+	indexOpenTimeout = time.Second
+)
+
+// PieceIndex is an index of the metadata of the stored pieces. It's updated when pieces are
+// written, deleted, trashed and restored, so that garbage collection and the space used
+// calculation can use it instead of walking the piece files.
+//
+// The index is only used after Rebuild has completed once. Pieces written or deleted while
+// rebuilding can be missed, which is found and fixed by Check. V0 pieces are not indexed,
+// since their metadata is already in the database.
+//
+// architecture: Database
+type PieceIndex struct {
+	log *zap.Logger
+	db  *bbolt.DB
+}
+
+// IndexedPiece is the metadata of a piece in the index.
+type IndexedPiece struct {
+	PieceID     storj.PieceID
+	Total       int64
+	ContentSize int64
+	ModTime     time.Time
+}
+
+// PieceIndexCheck is the result of a consistency check of the index.
+type PieceIndexCheck struct {
+	// Missing are the pieces on disk which are not in the index.
+	Missing int64
+	// Stale are the pieces in the index which are not on disk anymore.
+	Stale int64
+	// Mismatched are the pieces whose size or mtime in the index differs from the disk.
+	Mismatched int64
+}
+
+// Consistent returns whether the index matches the pieces on disk.
+func (check PieceIndexCheck) Consistent() bool {
+	return check.Missing == 0 && check.Stale == 0 && check.Mismatched == 0
+}
+
+// OpenPieceIndex opens or creates the index at path. It fails when the index is used by
+// another process.
+func OpenPieceIndex(log *zap.Logger, path string) (*PieceIndex, error) {
+	db, err := bbolt.Open(path, 0600, &bbolt.Options{Timeout: indexOpenTimeout})
+	if err != nil {
+		return nil, ErrPieceIndex.Wrap(err)
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(indexPiecesBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists(indexMetaBucket)
+		return err
+	})
+	if err != nil {
+		return nil, ErrPieceIndex.Wrap(errs.Combine(err, db.Close()))
+	}
+
+	return &PieceIndex{
+		log: log,
+		db:  db,
+	}, nil
+}
+
+// Ready returns whether the index was completely built and can be used.
+func (index *PieceIndex) Ready() bool {
+	var ready bool
+	err := index.db.View(func(tx *bbolt.Tx) error {
+		ready = tx.Bucket(indexMetaBucket).Get(indexBuiltKey) != nil
+		return nil
+	})
+	if err != nil {
+		index.log.Error("failed to read the piece index state", zap.Error(err))
+		return false
+	}
+	return ready
+}
+
+// Invalidate marks the index as not built, so that it isn't used until the next Rebuild.
+func (index *PieceIndex) Invalidate() error {
+	return ErrPieceIndex.Wrap(index.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(indexMetaBucket).Delete(indexBuiltKey)
+	}))
+}
+
+// Add adds or replaces a piece in the index.
+func (index *PieceIndex) Add(ctx context.Context, satellite storj.NodeID, piece IndexedPiece) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return ErrPieceIndex.Wrap(index.db.Batch(func(tx *bbolt.Tx) error {
+		bucket, err := tx.Bucket(indexPiecesBucket).CreateBucketIfNotExists(satellite.Bytes())
+		if err != nil {
+			return err
+		}
+		return bucket.Put(piece.PieceID.Bytes(), encodeIndexedPiece(piece))
+	}))
+}
+
+// Remove removes a piece from the index.
+func (index *PieceIndex) Remove(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return ErrPieceIndex.Wrap(index.db.Batch(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(indexPiecesBucket).Bucket(satellite.Bytes())
+		if bucket == nil {
+			return nil
+		}
+		return bucket.Delete(pieceID.Bytes())
+	}))
+}
+
+// RemoveSatellite removes all pieces of a satellite from the index.
+func (index *PieceIndex) RemoveSatellite(ctx context.Context, satellite storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return ErrPieceIndex.Wrap(index.db.Update(func(tx *bbolt.Tx) error {
+		err := tx.Bucket(indexPiecesBucket).DeleteBucket(satellite.Bytes())
+		if errors.Is(err, bbolt.ErrBucketNotFound) {
+			return nil
+		}
+		return err
+	}))
+}
+
+// Walk calls fn for every indexed piece of the satellite, in the order of piece IDs.
+func (index *PieceIndex) Walk(ctx context.Context, satellite storj.NodeID, fn func(IndexedPiece) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return ErrPieceIndex.Wrap(index.db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(indexPiecesBucket).Bucket(satellite.Bytes())
+		if bucket == nil {
+			return nil
+		}
+		return bucket.ForEach(func(key, value []byte) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			piece, err := decodeIndexedPiece(key, value)
+			if err != nil {
+				return err
+			}
+			return fn(piece)
+		})
+	}))
+}
+
+// SpaceUsedBySatellite returns the total and the content size of the indexed pieces of the satellite.
+func (index *PieceIndex) SpaceUsedBySatellite(ctx context.Context, satellite storj.NodeID) (piecesTotal, piecesContentSize int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = index.Walk(ctx, satellite, func(piece IndexedPiece) error {
+		piecesTotal += piece.Total
+		piecesContentSize += piece.ContentSize
+		return nil
+	})
+	return piecesTotal, piecesContentSize, err
+}
+
+// Rebuild recreates the index by walking all the pieces in blobs.
+func (index *PieceIndex) Rebuild(ctx context.Context, blobs blobstore.Blobs) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = index.db.Update(func(tx *bbolt.Tx) error {
+		if err := tx.Bucket(indexMetaBucket).Delete(indexBuiltKey); err != nil {
+			return err
+		}
+		if err := tx.DeleteBucket(indexPiecesBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucket(indexPiecesBucket)
+		return err
+	})
+	if err != nil {
+		return ErrPieceIndex.Wrap(err)
+	}
+
+	namespaces, err := blobs.ListNamespaces(ctx)
+	if err != nil {
+		return ErrPieceIndex.Wrap(err)
+	}
+
+	for _, namespace := range namespaces {
+		satellite, err := storj.NodeIDFromBytes(namespace)
+		if err != nil {
+			// not a satellite namespace, e.g. the trash.
+			continue
+		}
+
+		var batch []IndexedPiece
+		flush := func() error {
+			err := index.db.Update(func(tx *bbolt.Tx) error {
+				bucket, err := tx.Bucket(indexPiecesBucket).CreateBucketIfNotExists(satellite.Bytes())
+				if err != nil {
+					return err
+				}
+				for _, piece := range batch {
+					if err := bucket.Put(piece.PieceID.Bytes(), encodeIndexedPiece(piece)); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			batch = batch[:0]
+			return err
+		}
+
+		err = blobs.WalkNamespace(ctx, namespace, func(blobInfo blobstore.BlobInfo) error {
+			piece, ok, err := indexedPieceFromBlob(ctx, blobInfo)
+			if err != nil || !ok {
+				return err
+			}
+			batch = append(batch, piece)
+			if len(batch) >= indexBatchSize {
+				return flush()
+			}
+			return nil
+		})
+		if err == nil {
+			err = flush()
+		}
+		if err != nil {
+			return ErrPieceIndex.Wrap(err)
+		}
+	}
+
+	return ErrPieceIndex.Wrap(index.db.Update(func(tx *bbolt.Tx) error {
+		var built [8]byte
+		binary.BigEndian.PutUint64(built[:], uint64(time.Now().Unix()))
+		return tx.Bucket(indexMetaBucket).Put(indexBuiltKey, built[:])
+	}))
+}
+
+// Check compares the index with the pieces in blobs. When repair is true, the differences
+// are fixed in the index.
+func (index *PieceIndex) Check(ctx context.Context, blobs blobstore.Blobs, repair bool) (check PieceIndexCheck, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	namespaces, err := blobs.ListNamespaces(ctx)
+	if err != nil {
+		return check, ErrPieceIndex.Wrap(err)
+	}
+
+	for _, namespace := range namespaces {
+		satellite, err := storj.NodeIDFromBytes(namespace)
+		if err != nil {
+			continue
+		}
+
+		// find the pieces on disk which are missing or different in the index.
+		err = blobs.WalkNamespace(ctx, namespace, func(blobInfo blobstore.BlobInfo) error {
+			piece, ok, err := indexedPieceFromBlob(ctx, blobInfo)
+			if err != nil || !ok {
+				return err
+			}
+
+			indexed, found, err := index.get(satellite, piece.PieceID)
+			if err != nil {
+				return err
+			}
+			switch {
+			case !found:
+				check.Missing++
+			case indexed.Total != piece.Total || indexed.ContentSize != piece.ContentSize || !indexed.ModTime.Equal(piece.ModTime):
+				check.Mismatched++
+			default:
+				return nil
+			}
+
+			if repair {
+				return index.Add(ctx, satellite, piece)
+			}
+			return nil
+		})
+		if err != nil {
+			return check, ErrPieceIndex.Wrap(err)
+		}
+	}
+
+	// find the pieces in the index which are not on disk anymore.
+	satellites, err := index.satellites()
+	if err != nil {
+		return check, err
+	}
+	for _, satellite := range satellites {
+		var stale []storj.PieceID
+		err = index.Walk(ctx, satellite, func(piece IndexedPiece) error {
+			_, err := blobs.StatWithStorageFormat(ctx, blobstore.BlobRef{
+				Namespace: satellite.Bytes(),
+				Key:       piece.PieceID.Bytes(),
+			}, filestore.FormatV1)
+			if err != nil {
+				if errs.IsFunc(err, os.IsNotExist) {
+					stale = append(stale, piece.PieceID)
+					return nil
+				}
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return check, err
+		}
+
+		check.Stale += int64(len(stale))
+		if repair {
+			for _, pieceID := range stale {
+				if err := index.Remove(ctx, satellite, pieceID); err != nil {
+					return check, err
+				}
+			}
+		}
+	}
+
+	return check, nil
+}
+
+// Close closes the index.
+func (index *PieceIndex) Close() error {
+	return ErrPieceIndex.Wrap(index.db.Close())
+}
+
+// get returns a piece from the index.
+func (index *PieceIndex) get(satellite storj.NodeID, pieceID storj.PieceID) (piece IndexedPiece, found bool, err error) {
+	err = index.db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(indexPiecesBucket).Bucket(satellite.Bytes())
+		if bucket == nil {
+			return nil
+		}
+		value := bucket.Get(pieceID.Bytes())
+		if value == nil {
+			return nil
+		}
+		found = true
+		piece, err = decodeIndexedPiece(pieceID.Bytes(), value)
+		return err
+	})
+	return piece, found, ErrPieceIndex.Wrap(err)
+}
+
+// satellites returns the satellites with indexed pieces.
+func (index *PieceIndex) satellites() (satellites []storj.NodeID, err error) {
+	err = index.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(indexPiecesBucket).ForEach(func(key, _ []byte) error {
+			satellite, err := storj.NodeIDFromBytes(key)
+			if err != nil {
+				return err
+			}
+			satellites = append(satellites, satellite)
+			return nil
+		})
+	})
+	return satellites, ErrPieceIndex.Wrap(err)
+}
+
+// indexedPieceFromBlob returns the index entry of a V1 piece blob. ok is false for V0
+// pieces, blobs which are not pieces and pieces deleted in the meantime.
+func indexedPieceFromBlob(ctx context.Context, blobInfo blobstore.BlobInfo) (piece IndexedPiece, ok bool, err error) {
+	if blobInfo.StorageFormatVersion() < filestore.FormatV1 {
+		return IndexedPiece{}, false, nil
+	}
+	pieceID, err := storj.PieceIDFromBytes(blobInfo.BlobRef().Key)
+	if err != nil {
+		// not a piece blob, see FileWalker.walkSatellitePiecesFrom.
+		return IndexedPiece{}, false, nil //nolint: nilerr // we ignore other files
+	}
+
+	stat, err := blobInfo.Stat(ctx)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return IndexedPiece{}, false, nil
+		}
+		return IndexedPiece{}, false, err
+	}
+
+	return IndexedPiece{
+		PieceID:     pieceID,
+		Total:       stat.Size(),
+		ContentSize: stat.Size() - V1PieceHeaderReservedArea,
+		ModTime:     stat.ModTime(),
+	}, true, nil
+}
+
+func encodeIndexedPiece(piece IndexedPiece) []byte {
+	value := make([]byte, indexEntrySize)
+	binary.BigEndian.PutUint64(value[0:], uint64(piece.Total))
+	binary.BigEndian.PutUint64(value[8:], uint64(piece.ContentSize))
+	binary.BigEndian.PutUint64(value[16:], uint64(piece.ModTime.UnixNano()))
+	return value
+}
+
+func decodeIndexedPiece(key, value []byte) (IndexedPiece, error) {
+	pieceID, err := storj.PieceIDFromBytes(key)
+	if err != nil {
+		return IndexedPiece{}, err
+	}
+	if len(value) != indexEntrySize {
+		return IndexedPiece{}, errs.New("invalid entry size %d", len(value))
+	}
+	return IndexedPiece{
+		PieceID:     pieceID,
+		Total:       int64(binary.BigEndian.Uint64(value[0:])),
+		ContentSize: int64(binary.BigEndian.Uint64(value[8:])),
+		ModTime:     time.Unix(0, int64(binary.BigEndian.Uint64(value[16:]))),
+	}, nil
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/bloomfilter"
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestPieceIndex(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+
+		blobs, err := filestore.NewAt(log, db.Config().Pieces, filestore.DefaultConfig)
+		require.NoError(t, err)
+		defer ctx.Check(blobs.Close)
+
+		fw := pieces.NewFileWalker(log, blobs, nil)
+		store := pieces.NewStore(log, fw, nil, blobs, nil, db.PieceExpirationDB(), nil, pieces.DefaultConfig)
+
+		satelliteID := testrand.NodeID()
+		writePiece := func() storj.PieceID {
+			pieceID := testrand.PieceID()
+			writer, err := store.Writer(ctx, satelliteID, pieceID, pb.PieceHashAlgorithm_SHA256)
+			require.NoError(t, err)
+			_, err = writer.Write(testrand.Bytes(memory.KiB))
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{}))
+			return pieceID
+		}
+
+		// pieces stored before the index existed are added by rebuilding it.
+		var pieceIDs []storj.PieceID
+		for i := 0; i < 10; i++ {
+			pieceIDs = append(pieceIDs, writePiece())
+		}
+
+		walkedTotal, walkedContentSize, err := fw.WalkAndComputeSpaceUsedBySatellite(ctx, satelliteID)
+		require.NoError(t, err)
+
+		index, err := pieces.OpenPieceIndex(log, ctx.File("piece_index.db"))
+		require.NoError(t, err)
+		defer ctx.Check(index.Close)
+
+		store.SetIndex(index)
+		fw.SetIndex(index)
+		require.False(t, fw.UsesIndex())
+
+		require.NoError(t, index.Rebuild(ctx, blobs))
+		require.True(t, fw.UsesIndex())
+
+		indexedTotal, indexedContentSize, err := fw.WalkAndComputeSpaceUsedBySatellite(ctx, satelliteID)
+		require.NoError(t, err)
+		require.Equal(t, walkedTotal, indexedTotal)
+		require.Equal(t, walkedContentSize, indexedContentSize)
+
+		// the index is updated on write, delete, trash and restore.
+		newPieceID := writePiece()
+		require.NoError(t, store.Delete(ctx, satelliteID, pieceIDs[0]))
+		require.NoError(t, store.Trash(ctx, satelliteID, pieceIDs[1]))
+
+		indexed := map[storj.PieceID]bool{}
+		require.NoError(t, index.Walk(ctx, satelliteID, func(piece pieces.IndexedPiece) error {
+			indexed[piece.PieceID] = true
+			return nil
+		}))
+		require.Len(t, indexed, 9)
+		require.True(t, indexed[newPieceID])
+		require.False(t, indexed[pieceIDs[0]])
+		require.False(t, indexed[pieceIDs[1]])
+
+		require.NoError(t, store.RestoreTrash(ctx, satelliteID))
+		total, _, err := index.SpaceUsedBySatellite(ctx, satelliteID)
+		require.NoError(t, err)
+		require.Equal(t, walkedTotal, total)
+
+		check, err := index.Check(ctx, blobs, false)
+		require.NoError(t, err)
+		require.True(t, check.Consistent(), "%+v", check)
+
+		// garbage collection uses the index.
+		filter := bloomfilter.NewOptimal(100, 0.1)
+		for _, pieceID := range pieceIDs[1:5] {
+			filter.Add(pieceID)
+		}
+		filter.Add(newPieceID)
+		trash, piecesCount, _, err := fw.WalkSatellitePiecesToTrash(ctx, satelliteID, time.Now().Add(time.Hour), filter)
+		require.NoError(t, err)
+		require.EqualValues(t, 10, piecesCount)
+		require.ElementsMatch(t, pieceIDs[5:], trash)
+
+		// the checker finds and repairs the pieces changed without updating the index.
+		require.NoError(t, blobs.Delete(ctx, blobstore.BlobRef{Namespace: satelliteID.Bytes(), Key: pieceIDs[2].Bytes()}))
+		require.NoError(t, index.Remove(ctx, satelliteID, pieceIDs[3]))
+
+		check, err = index.Check(ctx, blobs, true)
+		require.NoError(t, err)
+		require.Equal(t, pieces.PieceIndexCheck{Missing: 1, Stale: 1}, check)
+
+		check, err = index.Check(ctx, blobs, false)
+		require.NoError(t, err)
+		require.True(t, check.Consistent(), "%+v", check)
+
+		// an invalidated index isn't used.
+		require.NoError(t, index.Invalidate())
+		require.False(t, fw.UsesIndex())
+	})
+}
@@ -59,6 +59,9 @@ const (
 // BadFormatVersion is returned when a storage format cannot support the request function.
 var BadFormatVersion = errs.Class("Incompatible storage format version")
 
+// ErrInvalidPieceHeader is returned when the piece header was read, but can't be decoded.
+var ErrInvalidPieceHeader = errs.Class("invalid piece header")
+
 // Writer implements a piece writer that writes content to blob store and calculates a hash.
 type Writer struct {
 	log       *zap.Logger
@@ -69,6 +72,10 @@ type Writer struct {
 	blobs     blobstore.Blobs
 	satellite storj.NodeID
 	closed    bool
+
+	// index is updated after the piece was committed, when it's set by the Store.
+	index   *PieceIndex
+	pieceID storj.PieceID
 }
 
 // NewWriter creates a new writer for blobstore.BlobWriter.
@@ -124,6 +131,14 @@ func (w *Writer) Commit(ctx context.Context, pieceHeader *pb.PieceHeader) (err e
 
 	// point of no return: after this we definitely either commit or cancel
 	w.closed = true
+	if w.index != nil {
+		// this runs after the blob was committed, so the blob can be found.
+		defer func() {
+			if err == nil {
+				w.updateIndex(ctx)
+			}
+		}()
+	}
 	defer func() {
 		if err != nil {
 			err = Error.Wrap(errs.Combine(err, w.blob.Cancel(ctx)))
@@ -199,6 +214,30 @@ func (w *Writer) Commit(ctx context.Context, pieceHeader *pb.PieceHeader) (err e
 	return nil
 }
 
+// updateIndex adds the committed piece to the index. When that fails, the index is
+// invalidated, since it's not complete anymore.
+func (w *Writer) updateIndex(ctx context.Context) {
+	blobInfo, err := w.blobs.Stat(ctx, blobstore.BlobRef{
+		Namespace: w.satellite.Bytes(),
+		Key:       w.pieceID.Bytes(),
+	})
+	if err == nil {
+		var piece IndexedPiece
+		var ok bool
+		piece, ok, err = indexedPieceFromBlob(ctx, blobInfo)
+		if err == nil && ok {
+			err = w.index.Add(ctx, w.satellite, piece)
+		}
+	}
+	if err != nil {
+		w.log.Error("failed to add piece to the index, invalidating the index", zap.Error(err),
+			zap.Stringer("Piece ID", w.pieceID), zap.Stringer("Satellite ID", w.satellite))
+		if err := w.index.Invalidate(); err != nil {
+			w.log.Error("failed to invalidate the piece index", zap.Error(err))
+		}
+	}
+}
+
 // Cancel deletes any temporarily written data.
 func (w *Writer) Cancel(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -273,7 +312,7 @@ func (r *Reader) GetPieceHeader() (*pb.PieceHeader, error) {
 	r.pos += int64(n)
 	headerSize := binary.BigEndian.Uint16(framingBytes)
 	if headerSize > (V1PieceHeaderReservedArea - v1PieceHeaderFramingSize) {
-		return nil, Error.New("PieceHeader framing field claims impossible size of %d bytes", headerSize)
+		return nil, Error.Wrap(ErrInvalidPieceHeader.New("PieceHeader framing field claims impossible size of %d bytes", headerSize))
 	}
 
 	// Now we can read the actual serialized header.
@@ -287,7 +326,7 @@ func (r *Reader) GetPieceHeader() (*pb.PieceHeader, error) {
 	// Deserialize and return.
 	header := &pb.PieceHeader{}
 	if err := pb.Unmarshal(pieceHeaderBytes, header); err != nil {
-		return nil, Error.New("piece header: %w", err)
+		return nil, Error.Wrap(ErrInvalidPieceHeader.Wrap(err))
 	}
 	return header, nil
 }
@@ -70,6 +70,8 @@ type PieceExpirationDB interface {
 	Trash(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) error
 	// RestoreTrash marks all piece as not being in trash
 	RestoreTrash(ctx context.Context, satelliteID storj.NodeID) error
+	// Restore marks a piece as not being in trash
+	Restore(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) error
 }
 
 // V0PieceInfoDB stores meta information about pieces stored with storage format V0 (where
@@ -162,11 +164,18 @@ type Config struct {
 	// TODO(clement): default is set to false for now.
 	//  I will test and monitor on my node for some time before changing the default to true.
 	EnableLazyFilewalker bool `help:"run garbage collection and used-space calculation filewalkers as a separate subprocess with lower IO priority" releaseDefault:"false" devDefault:"true" testDefault:"false"`
+	EnablePieceIndex     bool `help:"maintain an index of the stored pieces, which is used by garbage collection and used-space calculation instead of walking the piece files" default:"false"`
+	CacheCreationTimes   bool `help:"garbage collection uses the creation times from the piece headers instead of the file modification times, caching them so every header is read only once" default:"false"`
+
+	FileWalker FileWalkerConfig
 }
 
 // DefaultConfig is the default value for the Config.
 var DefaultConfig = Config{
 	WritePreallocSize: 4 * memory.MiB,
+	FileWalker: FileWalkerConfig{
+		Concurrency: 1,
+	},
 }
 
 // Store implements storing pieces onto a blob storage implementation.
@@ -183,6 +192,8 @@ type Store struct {
 
 	Filewalker     *FileWalker
 	lazyFilewalker *lazyfilewalker.Supervisor
+	index          *PieceIndex
+	creationTimes  *CreationTimeCache
 }
 
 // StoreForTest is a wrapper around Store to be used only in test scenarios. It enables writing
@@ -205,6 +216,41 @@ func NewStore(log *zap.Logger, fw *FileWalker, lazyFilewalker *lazyfilewalker.Su
 	}
 }
 
+// SetIndex sets the piece index, which is updated when pieces are written and deleted.
+func (store *Store) SetIndex(index *PieceIndex) {
+	store.index = index
+}
+
+// SetCreationTimes sets the cache of the piece creation times, whose entries are removed
+// when pieces are deleted.
+func (store *Store) SetCreationTimes(cache *CreationTimeCache) {
+	store.creationTimes = cache
+}
+
+// forgetCreationTime removes a piece from the cache of the creation times, when the cache is set.
+func (store *Store) forgetCreationTime(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) {
+	if store.creationTimes == nil {
+		return
+	}
+	if err := store.creationTimes.Remove(ctx, satellite, pieceID); err != nil {
+		store.log.Warn("failed to remove the creation time of piece", zap.Error(err))
+	}
+}
+
+// updateIndex applies a change to the piece index, when the index is set. When the change
+// fails, the index is invalidated, since it doesn't match the pieces anymore.
+func (store *Store) updateIndex(ctx context.Context, update func(index *PieceIndex) error) {
+	if store.index == nil {
+		return
+	}
+	if err := update(store.index); err != nil {
+		store.log.Error("failed to update the piece index, invalidating the index", zap.Error(err))
+		if err := store.index.Invalidate(); err != nil {
+			store.log.Error("failed to invalidate the piece index", zap.Error(err))
+		}
+	}
+}
+
 // CreateVerificationFile creates a file to be used for storage directory verification.
 func (store *Store) CreateVerificationFile(ctx context.Context, id storj.NodeID) error {
 	return store.blobs.CreateVerificationFile(ctx, id)
@@ -247,7 +293,12 @@ func (store *Store) Writer(ctx context.Context, satellite storj.NodeID, pieceID
 	}
 
 	writer, err := NewWriter(store.log.Named("blob-writer"), blobWriter, store.blobs, satellite, hashAlgorithm)
-	return writer, Error.Wrap(err)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	writer.index = store.index
+	writer.pieceID = pieceID
+	return writer, nil
 }
 
 // WriterForFormatVersion allows opening a piece writer with a specified storage format version.
@@ -332,6 +383,11 @@ func (store *Store) Delete(ctx context.Context, satellite storj.NodeID, pieceID
 		return Error.Wrap(err)
 	}
 
+	store.updateIndex(ctx, func(index *PieceIndex) error {
+		return index.Remove(ctx, satellite, pieceID)
+	})
+	store.forgetCreationTime(ctx, satellite, pieceID)
+
 	// delete expired piece records
 	err = store.DeleteExpired(ctx, satellite, pieceID)
 	if err == nil {
@@ -362,7 +418,19 @@ func (store *Store) DeleteSatelliteBlobs(ctx context.Context, satellite storj.No
 	defer mon.Task()(&ctx)(&err)
 
 	err = store.blobs.DeleteNamespace(ctx, satellite.Bytes())
-	return Error.Wrap(err)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	store.updateIndex(ctx, func(index *PieceIndex) error {
+		return index.RemoveSatellite(ctx, satellite)
+	})
+	if store.creationTimes != nil {
+		if err := store.creationTimes.RemoveSatellite(ctx, satellite); err != nil {
+			store.log.Warn("failed to remove the creation times of satellite", zap.Error(err))
+		}
+	}
+	return nil
 }
 
 // Trash moves the specified piece to the blob trash. If necessary, it converts
@@ -393,28 +461,55 @@ func (store *Store) Trash(ctx context.Context, satellite storj.NodeID, pieceID s
 	}
 
 	err = store.expirationInfo.Trash(ctx, satellite, pieceID)
-	err = errs.Combine(err, store.blobs.Trash(ctx, blobstore.BlobRef{
+	trashErr := store.blobs.Trash(ctx, blobstore.BlobRef{
 		Namespace: satellite.Bytes(),
 		Key:       pieceID.Bytes(),
-	}))
+	})
+	if trashErr == nil {
+		store.updateIndex(ctx, func(index *PieceIndex) error {
+			return index.Remove(ctx, satellite, pieceID)
+		})
+		store.forgetCreationTime(ctx, satellite, pieceID)
+	}
 
-	return Error.Wrap(err)
+	return Error.Wrap(errs.Combine(err, trashErr))
 }
 
 // EmptyTrash deletes pieces in the trash that have been in there longer than trashExpiryInterval.
 func (store *Store) EmptyTrash(ctx context.Context, satelliteID storj.NodeID, trashedBefore time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	if store.config.EnableLazyFilewalker && store.lazyFilewalker != nil {
+		bytesDeleted, deletedPieceIDs, err := store.lazyFilewalker.EmptyTrash(ctx, satelliteID, trashedBefore)
+		if err == nil {
+			// the subprocess deletes the pieces directly, without updating the space used cache.
+			if cache, ok := store.blobs.(*BlobsUsageCache); ok {
+				cache.Update(ctx, satelliteID, 0, 0, -bytesDeleted)
+			}
+			return store.deleteTrashedExpirations(ctx, satelliteID, deletedPieceIDs)
+		}
+		store.log.Error("lazyfilewalker failed", zap.Error(err))
+	}
+
 	_, deletedIDs, err := store.blobs.EmptyTrash(ctx, satelliteID[:], trashedBefore)
 	if err != nil {
 		return Error.Wrap(err)
 	}
 
+	deletedPieceIDs := make([]storj.PieceID, 0, len(deletedIDs))
 	for _, deletedID := range deletedIDs {
 		pieceID, pieceIDErr := storj.PieceIDFromBytes(deletedID)
 		if pieceIDErr != nil {
 			return Error.Wrap(pieceIDErr)
 		}
+		deletedPieceIDs = append(deletedPieceIDs, pieceID)
+	}
+	return store.deleteTrashedExpirations(ctx, satelliteID, deletedPieceIDs)
+}
+
+// deleteTrashedExpirations deletes the expiration info of pieces deleted from the trash.
+func (store *Store) deleteTrashedExpirations(ctx context.Context, satelliteID storj.NodeID, pieceIDs []storj.PieceID) (err error) {
+	for _, pieceID := range pieceIDs {
 		_, deleteErr := store.expirationInfo.DeleteExpiration(ctx, satelliteID, pieceID)
 		err = errs.Combine(err, deleteErr)
 	}
@@ -425,13 +520,100 @@ func (store *Store) EmptyTrash(ctx context.Context, satelliteID storj.NodeID, tr
 func (store *Store) RestoreTrash(ctx context.Context, satelliteID storj.NodeID) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	_, err = store.blobs.RestoreTrash(ctx, satelliteID.Bytes())
+	restoredKeys, err := store.blobs.RestoreTrash(ctx, satelliteID.Bytes())
 	if err != nil {
 		return Error.Wrap(err)
 	}
+	store.indexRestored(ctx, satelliteID, restoredKeys)
+
 	return Error.Wrap(store.expirationInfo.RestoreTrash(ctx, satelliteID))
 }
 
+// RestoreTrashedPieces restores the given pieces from the trash, and returns the pieces
+// which were in the trash.
+func (store *Store) RestoreTrashedPieces(ctx context.Context, satelliteID storj.NodeID, pieceIDs []storj.PieceID) (restored []storj.PieceID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keys := make([][]byte, 0, len(pieceIDs))
+	for _, pieceID := range pieceIDs {
+		keys = append(keys, pieceID.Bytes())
+	}
+	restoredKeys, err := store.blobs.RestoreTrashKeys(ctx, satelliteID.Bytes(), keys)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	store.indexRestored(ctx, satelliteID, restoredKeys)
+
+	restored = make([]storj.PieceID, 0, len(restoredKeys))
+	for _, key := range restoredKeys {
+		pieceID, err := storj.PieceIDFromBytes(key)
+		if err != nil {
+			return restored, Error.Wrap(err)
+		}
+		if err := store.expirationInfo.Restore(ctx, satelliteID, pieceID); err != nil {
+			return restored, Error.Wrap(err)
+		}
+		restored = append(restored, pieceID)
+	}
+	return restored, nil
+}
+
+// indexRestored adds the pieces restored from the trash to the piece index.
+func (store *Store) indexRestored(ctx context.Context, satelliteID storj.NodeID, restoredKeys [][]byte) {
+	store.updateIndex(ctx, func(index *PieceIndex) error {
+		for _, key := range restoredKeys {
+			blobInfo, err := store.blobs.Stat(ctx, blobstore.BlobRef{
+				Namespace: satelliteID.Bytes(),
+				Key:       key,
+			})
+			if err != nil {
+				return err
+			}
+			piece, ok, err := indexedPieceFromBlob(ctx, blobInfo)
+			if err != nil {
+				return err
+			}
+			if ok {
+				if err := index.Add(ctx, satelliteID, piece); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// TrashedPiece is a piece in the trash.
+type TrashedPiece struct {
+	PieceID   storj.PieceID
+	Size      int64
+	TrashedAt time.Time
+}
+
+// WalkTrash executes walkFunc for each piece of the satellite in the trash.
+func (store *Store) WalkTrash(ctx context.Context, satelliteID storj.NodeID, walkFunc func(TrashedPiece) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(store.blobs.WalkTrash(ctx, satelliteID.Bytes(), func(blobInfo blobstore.BlobInfo) error {
+		pieceID, err := storj.PieceIDFromBytes(blobInfo.BlobRef().Key)
+		if err != nil {
+			return err
+		}
+		stat, err := blobInfo.Stat(ctx)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		return walkFunc(TrashedPiece{
+			PieceID:   pieceID,
+			Size:      stat.Size(),
+			TrashedAt: stat.ModTime(),
+		})
+	}))
+}
+
 // MigrateV0ToV1 will migrate a piece stored with storage format v0 to storage
 // format v1. If the piece is not stored as a v0 piece it will return an error.
 // The follow failures are possible:
@@ -543,7 +725,9 @@ func (store *Store) WalkSatellitePieces(ctx context.Context, satellite storj.Nod
 func (store *Store) SatellitePiecesToTrash(ctx context.Context, satelliteID storj.NodeID, createdBefore time.Time, filter *bloomfilter.Filter) (pieceIDs []storj.PieceID, piecesCount, piecesSkipped int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	if store.config.EnableLazyFilewalker && store.lazyFilewalker != nil {
+	// walking the piece index is cheap, so the lazy filewalker is only used without it. The
+	// subprocess can't use the creation time cache, so it isn't used with the cache either.
+	if store.config.EnableLazyFilewalker && store.lazyFilewalker != nil && !store.Filewalker.UsesIndex() && !store.Filewalker.UsesCreationTimes() {
 		pieceIDs, piecesCount, piecesSkipped, err = store.lazyFilewalker.WalkSatellitePiecesToTrash(ctx, satelliteID, createdBefore, filter)
 		if err == nil {
 			return pieceIDs, piecesCount, piecesSkipped, nil
@@ -641,6 +825,12 @@ func (store *Store) SpaceUsedForPiecesAndTrash(ctx context.Context) (int64, erro
 	return piecesTotal + trashTotal, nil
 }
 
+// StoringSatellites returns the satellites which have pieces stored on the node.
+func (store *Store) StoringSatellites(ctx context.Context) (_ []storj.NodeID, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.getAllStoringSatellites(ctx)
+}
+
 func (store *Store) getAllStoringSatellites(ctx context.Context) ([]storj.NodeID, error) {
 	namespaces, err := store.blobs.ListNamespaces(ctx)
 	if err != nil {
@@ -705,7 +895,7 @@ func (store *Store) SpaceUsedTotalAndBySatellite(ctx context.Context) (piecesTot
 		var satPiecesContentSize int64
 
 		failover := true
-		if store.config.EnableLazyFilewalker && store.lazyFilewalker != nil {
+		if store.config.EnableLazyFilewalker && store.lazyFilewalker != nil && !store.Filewalker.UsesIndex() {
 			satPiecesTotal, satPiecesContentSize, err = store.lazyFilewalker.WalkAndComputeSpaceUsedBySatellite(ctx, satelliteID)
 			if err != nil {
 				store.log.Error("failed to lazywalk space used by satellite", zap.Error(err), zap.Stringer("Satellite ID", satelliteID))
@@ -732,6 +922,45 @@ func (store *Store) SpaceUsedTotalAndBySatellite(ctx context.Context) (piecesTot
 	return piecesTotal, piecesContentSize, totalBySatellite, group.Err()
 }
 
+// lazySpaceUsedOnDisk walks all the pieces and the trash to calculate the space used by them.
+// The lazy filewalker is used when it's enabled, ok is false otherwise or when it failed.
+func (store *Store) lazySpaceUsedOnDisk(ctx context.Context) (piecesTotal, piecesContentSize int64, totalBySatellite map[storj.NodeID]SatelliteUsage, trashTotal int64, ok bool) {
+	if !store.config.EnableLazyFilewalker || store.lazyFilewalker == nil || store.Filewalker.UsesIndex() {
+		return 0, 0, nil, 0, false
+	}
+
+	satelliteIDs, err := store.getAllStoringSatellites(ctx)
+	if err != nil {
+		store.log.Error("failed to enumerate satellites", zap.Error(err))
+		return 0, 0, nil, 0, false
+	}
+
+	satellites, trashTotal, err := store.lazyFilewalker.WalkAndReconcileSpaceUsed(ctx, satelliteIDs)
+	if err != nil {
+		store.log.Error("failed to lazywalk space used", zap.Error(err))
+		return 0, 0, nil, 0, false
+	}
+
+	totalBySatellite = map[storj.NodeID]SatelliteUsage{}
+	for _, satellite := range satellites {
+		piecesTotal += satellite.PiecesTotal
+		piecesContentSize += satellite.PiecesContentSize
+		totalBySatellite[satellite.SatelliteID] = SatelliteUsage{
+			Total:       satellite.PiecesTotal,
+			ContentSize: satellite.PiecesContentSize,
+		}
+	}
+	return piecesTotal, piecesContentSize, totalBySatellite, trashTotal, true
+}
+
+// LazyFilewalkerProgress returns the progress of the running lazy filewalker subprocesses.
+func (store *Store) LazyFilewalkerProgress() []lazyfilewalker.Progress {
+	if store.lazyFilewalker == nil {
+		return nil
+	}
+	return store.lazyFilewalker.Progress()
+}
+
 // GetV0PieceInfo fetches the Info record from the V0 piece info database. Obviously,
 // of no use when a piece does not have filestore.FormatV0 storage.
 func (store *Store) GetV0PieceInfo(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (*Info, error) {
@@ -26,10 +26,12 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/storj/cmd/storagenode/internalcmd"
 	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/blobstore"
 	"storj.io/storj/storagenode/blobstore/filestore"
 	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/pieces/lazyfilewalker"
 	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
 	"storj.io/storj/storagenode/trust"
 )
@@ -521,6 +523,125 @@ func verifyPieceData(ctx context.Context, t testing.TB, store *pieces.StoreForTe
 	}
 }
 
+func TestEmptyTrash_LazyFilewalker(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		dbConfig := db.Config()
+
+		blobs, err := filestore.NewAt(log, dbConfig.Pieces, dbConfig.Filestore)
+		require.NoError(t, err)
+		defer ctx.Check(blobs.Close)
+
+		cfg := pieces.DefaultConfig
+		cfg.EnableLazyFilewalker = true
+		lazyFwCfg := dbConfig.LazyFilewalkerConfig()
+		lazyFwCfg.LowerIOPriority = false
+		lazyFw := lazyfilewalker.NewSupervisor(log, lazyFwCfg, "")
+		lazyFw.TestingSetTrashCmd(internalcmd.NewTrashLazyFilewalker(ctx, log.Named("trash-filewalker.subprocess"), lazyFwCfg))
+
+		cache := pieces.NewBlobsUsageCache(log, blobs)
+		store := pieces.NewStore(log, pieces.NewFileWalker(log, blobs, nil), lazyFw, cache, nil, db.PieceExpirationDB(), db.PieceSpaceUsedDB(), cfg)
+
+		satelliteID := testrand.NodeID()
+		pieceID := testrand.PieceID()
+		writer, err := store.Writer(ctx, satelliteID, pieceID, pb.PieceHashAlgorithm_SHA256)
+		require.NoError(t, err)
+		_, err = writer.Write(testrand.Bytes(memory.KiB))
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{}))
+		require.NoError(t, store.SetExpiration(ctx, satelliteID, pieceID, time.Now().Add(time.Hour)))
+
+		require.NoError(t, store.Trash(ctx, satelliteID, pieceID))
+		trashTotal, err := cache.SpaceUsedForTrash(ctx)
+		require.NoError(t, err)
+		require.Positive(t, trashTotal)
+
+		require.NoError(t, store.EmptyTrash(ctx, satelliteID, time.Now().Add(time.Hour)))
+
+		// the piece is deleted and the space used cache is updated.
+		trashTotal, err = cache.SpaceUsedForTrash(ctx)
+		require.NoError(t, err)
+		require.Zero(t, trashTotal)
+
+		require.NoError(t, store.RestoreTrash(ctx, satelliteID))
+		_, err = store.Reader(ctx, satelliteID, pieceID)
+		require.Error(t, err)
+
+		expired, err := store.GetExpired(ctx, time.Now().Add(2*time.Hour), 10)
+		require.NoError(t, err)
+		require.Empty(t, expired)
+
+		// no subprocesses are running after they completed.
+		require.Empty(t, store.LazyFilewalkerProgress())
+	})
+}
+
+func TestRestoreTrashedPieces(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		dbConfig := db.Config()
+
+		dir, err := filestore.NewDir(log, dbConfig.Pieces)
+		require.NoError(t, err)
+		blobs := filestore.New(log, dir, dbConfig.Filestore)
+		defer ctx.Check(blobs.Close)
+
+		cache := pieces.NewBlobsUsageCache(log, blobs)
+		store := pieces.NewStore(log, pieces.NewFileWalker(log, blobs, nil), nil, cache, nil, db.PieceExpirationDB(), db.PieceSpaceUsedDB(), pieces.DefaultConfig)
+
+		satelliteID := testrand.NodeID()
+		trashedAt := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+		var pieceIDs []storj.PieceID
+		for i := 0; i < 3; i++ {
+			pieceID := testrand.PieceID()
+			writer, err := store.Writer(ctx, satelliteID, pieceID, pb.PieceHashAlgorithm_SHA256)
+			require.NoError(t, err)
+			_, err = writer.Write(testrand.Bytes(memory.KiB))
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{}))
+			require.NoError(t, store.SetExpiration(ctx, satelliteID, pieceID, time.Now().Add(time.Hour)))
+
+			dir.ReplaceTrashnow(func() time.Time { return trashedAt.AddDate(0, 0, i) })
+			require.NoError(t, store.Trash(ctx, satelliteID, pieceID))
+			pieceIDs = append(pieceIDs, pieceID)
+		}
+
+		trashed := map[storj.PieceID]time.Time{}
+		require.NoError(t, store.WalkTrash(ctx, satelliteID, func(piece pieces.TrashedPiece) error {
+			require.Positive(t, piece.Size)
+			trashed[piece.PieceID] = piece.TrashedAt
+			return nil
+		}))
+		require.Len(t, trashed, len(pieceIDs))
+		for i, pieceID := range pieceIDs {
+			require.True(t, trashedAt.AddDate(0, 0, i).Equal(trashed[pieceID]))
+		}
+
+		// only the selected pieces are restored, and the ones not in the trash are skipped.
+		trashTotal, err := cache.SpaceUsedForTrash(ctx)
+		require.NoError(t, err)
+		restored, err := store.RestoreTrashedPieces(ctx, satelliteID, []storj.PieceID{pieceIDs[1], testrand.PieceID()})
+		require.NoError(t, err)
+		require.Equal(t, []storj.PieceID{pieceIDs[1]}, restored)
+
+		reader, err := store.Reader(ctx, satelliteID, pieceIDs[1])
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		_, err = store.Reader(ctx, satelliteID, pieceIDs[0])
+		require.Error(t, err)
+
+		trashTotalAfter, err := cache.SpaceUsedForTrash(ctx)
+		require.NoError(t, err)
+		require.Less(t, trashTotalAfter, trashTotal)
+
+		// the expiration of the restored piece is no longer in the trash.
+		expired, err := store.GetExpired(ctx, time.Now().Add(2*time.Hour), 10)
+		require.NoError(t, err)
+		require.Len(t, expired, 1)
+		require.Equal(t, pieceIDs[1], expired[0].PieceID)
+	})
+}
+
 func TestPieceVersionMigrate(t *testing.T) {
 	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
 		const pieceSize = 1024
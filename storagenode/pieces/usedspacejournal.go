@@ -0,0 +1,282 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+)
+
+// ErrUsedSpaceJournal is the error class for the used space journal.
+var ErrUsedSpaceJournal = errs.Class("used space journal")
+
+// journalEntrySize is the size of a single encoded journal entry:
+// satellite id, three deltas and a crc32 checksum.
+const journalEntrySize = len(storj.NodeID{}) + 3*8 + 4
+
+// journalCheckpointMagic starts the journals, which begin with a checkpoint.
+var journalCheckpointMagic = [4]byte{'U', 'S', 'J', '1'}
+
+// UsedSpaceJournal records changes to the space used cache between checkpoints,
+// so that the cache survives restarts without recalculating it by walking all
+// the pieces.
+//
+// A checkpoint atomically replaces the journal with one, which starts with the
+// checkpointed totals, so the journal alone restores the cache at any time: a
+// crash before the replacement replays the old journal, a crash after it
+// replays the checkpoint.
+//
+// Entries are appended without syncing to disk. A partially written entry at
+// the end of the journal, for example after a crash, is ignored on replay and
+// the difference is fixed by the next reconciliation.
+//
+// architecture: Database
+type UsedSpaceJournal struct {
+	path string
+
+	mu   sync.Mutex
+	file *os.File
+}
+
+// UsedSpaceDelta is a change of the space used by a satellite.
+type UsedSpaceDelta struct {
+	SatelliteID       storj.NodeID
+	PiecesTotal       int64
+	PiecesContentSize int64
+	TrashTotal        int64
+}
+
+// UsedSpaceTotals are the totals of the space used cache.
+type UsedSpaceTotals struct {
+	PiecesTotal       int64
+	PiecesContentSize int64
+	TrashTotal        int64
+	BySatellite       map[storj.NodeID]SatelliteUsage
+}
+
+// OpenUsedSpaceJournal opens or creates the journal at path.
+func OpenUsedSpaceJournal(path string) (*UsedSpaceJournal, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, ErrUsedSpaceJournal.Wrap(err)
+	}
+	return &UsedSpaceJournal{
+		path: path,
+		file: file,
+	}, nil
+}
+
+// Append adds a delta to the journal.
+func (journal *UsedSpaceJournal) Append(delta UsedSpaceDelta) error {
+	var buf [journalEntrySize]byte
+	n := copy(buf[:], delta.SatelliteID[:])
+	binary.LittleEndian.PutUint64(buf[n:], uint64(delta.PiecesTotal))
+	binary.LittleEndian.PutUint64(buf[n+8:], uint64(delta.PiecesContentSize))
+	binary.LittleEndian.PutUint64(buf[n+16:], uint64(delta.TrashTotal))
+	binary.LittleEndian.PutUint32(buf[n+24:], crc32.ChecksumIEEE(buf[:n+24]))
+
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+	_, err := journal.file.Write(buf[:])
+	return ErrUsedSpaceJournal.Wrap(err)
+}
+
+// Replay calls restore with the totals of the checkpoint, which the journal starts with, and
+// apply for every delta appended after the checkpoint, in the order they were appended.
+// restore isn't called, when the journal doesn't start with a checkpoint. It stops at the
+// first incomplete or corrupted entry.
+func (journal *UsedSpaceJournal) Replay(restore func(totals UsedSpaceTotals), apply func(delta UsedSpaceDelta)) (err error) {
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+
+	file, err := os.Open(journal.path)
+	if err != nil {
+		return ErrUsedSpaceJournal.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrUsedSpaceJournal.Wrap(file.Close())) }()
+
+	reader := bufio.NewReader(file)
+	if magic, err := reader.Peek(len(journalCheckpointMagic)); err == nil && bytes.Equal(magic, journalCheckpointMagic[:]) {
+		totals, ok, err := readJournalCheckpoint(reader)
+		if err != nil {
+			return ErrUsedSpaceJournal.Wrap(err)
+		}
+		if !ok {
+			return nil
+		}
+		restore(totals)
+	}
+
+	var buf [journalEntrySize]byte
+	for {
+		_, err := io.ReadFull(reader, buf[:])
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		}
+		if err != nil {
+			return ErrUsedSpaceJournal.Wrap(err)
+		}
+
+		n := len(storj.NodeID{})
+		if crc32.ChecksumIEEE(buf[:n+24]) != binary.LittleEndian.Uint32(buf[n+24:]) {
+			return nil
+		}
+
+		var delta UsedSpaceDelta
+		copy(delta.SatelliteID[:], buf[:n])
+		delta.PiecesTotal = int64(binary.LittleEndian.Uint64(buf[n:]))
+		delta.PiecesContentSize = int64(binary.LittleEndian.Uint64(buf[n+8:]))
+		delta.TrashTotal = int64(binary.LittleEndian.Uint64(buf[n+16:]))
+		apply(delta)
+	}
+}
+
+// Checkpoint replaces the journal with one, which starts with the totals. The totals must
+// include all the deltas appended so far. The new journal is synced to disk before it
+// replaces the old one.
+func (journal *UsedSpaceJournal) Checkpoint(totals UsedSpaceTotals) (err error) {
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+
+	tmpPath := journal.path + ".tmp"
+	file, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_RDWR|os.O_APPEND, 0600)
+	if err != nil {
+		return ErrUsedSpaceJournal.Wrap(err)
+	}
+
+	_, err = file.Write(encodeJournalCheckpoint(totals))
+	if err == nil {
+		err = file.Sync()
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, journal.path)
+	}
+	if err != nil {
+		return ErrUsedSpaceJournal.Wrap(errs.Combine(err, file.Close(), os.Remove(tmpPath)))
+	}
+
+	err = journal.file.Close()
+	journal.file = file
+	return ErrUsedSpaceJournal.Wrap(err)
+}
+
+// encodeJournalCheckpoint encodes the totals as the magic, the three totals, the number of the
+// satellites, the satellite ids with their two totals and a crc32 checksum.
+func encodeJournalCheckpoint(totals UsedSpaceTotals) []byte {
+	const satelliteSize = len(storj.NodeID{}) + 2*8
+	n := len(journalCheckpointMagic)
+
+	buf := make([]byte, n+3*8+4+len(totals.BySatellite)*satelliteSize+4)
+	copy(buf, journalCheckpointMagic[:])
+	binary.LittleEndian.PutUint64(buf[n:], uint64(totals.PiecesTotal))
+	binary.LittleEndian.PutUint64(buf[n+8:], uint64(totals.PiecesContentSize))
+	binary.LittleEndian.PutUint64(buf[n+16:], uint64(totals.TrashTotal))
+	binary.LittleEndian.PutUint32(buf[n+24:], uint32(len(totals.BySatellite)))
+	n += 3*8 + 4
+	for satelliteID, usage := range totals.BySatellite {
+		n += copy(buf[n:], satelliteID[:])
+		binary.LittleEndian.PutUint64(buf[n:], uint64(usage.Total))
+		binary.LittleEndian.PutUint64(buf[n+8:], uint64(usage.ContentSize))
+		n += 2 * 8
+	}
+	binary.LittleEndian.PutUint32(buf[n:], crc32.ChecksumIEEE(buf[:n]))
+	return buf
+}
+
+// readJournalCheckpoint reads the checkpoint encoded by encodeJournalCheckpoint. It returns
+// false, when the checkpoint is incomplete or corrupted.
+func readJournalCheckpoint(reader io.Reader) (_ UsedSpaceTotals, ok bool, err error) {
+	hash := crc32.NewIEEE()
+	tee := io.TeeReader(reader, hash)
+
+	header := make([]byte, len(journalCheckpointMagic)+3*8+4)
+	if _, err := io.ReadFull(tee, header); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return UsedSpaceTotals{}, false, nil
+		}
+		return UsedSpaceTotals{}, false, err
+	}
+	n := len(journalCheckpointMagic)
+	totals := UsedSpaceTotals{
+		PiecesTotal:       int64(binary.LittleEndian.Uint64(header[n:])),
+		PiecesContentSize: int64(binary.LittleEndian.Uint64(header[n+8:])),
+		TrashTotal:        int64(binary.LittleEndian.Uint64(header[n+16:])),
+		BySatellite:       map[storj.NodeID]SatelliteUsage{},
+	}
+	count := binary.LittleEndian.Uint32(header[n+24:])
+
+	var satellite [len(storj.NodeID{}) + 2*8]byte
+	for i := uint32(0); i < count; i++ {
+		if _, err := io.ReadFull(tee, satellite[:]); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return UsedSpaceTotals{}, false, nil
+			}
+			return UsedSpaceTotals{}, false, err
+		}
+		var satelliteID storj.NodeID
+		copy(satelliteID[:], satellite[:])
+		totals.BySatellite[satelliteID] = SatelliteUsage{
+			Total:       int64(binary.LittleEndian.Uint64(satellite[len(satelliteID):])),
+			ContentSize: int64(binary.LittleEndian.Uint64(satellite[len(satelliteID)+8:])),
+		}
+	}
+
+	sum := hash.Sum32()
+	var checksum [4]byte
+	if _, err := io.ReadFull(reader, checksum[:]); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return UsedSpaceTotals{}, false, nil
+		}
+		return UsedSpaceTotals{}, false, err
+	}
+	return totals, sum == binary.LittleEndian.Uint32(checksum[:]), nil
+}
+
+// ReconciledAt returns when the cache was last reconciled with the pieces on disk.
+// It returns the zero time when the cache was never reconciled.
+func (journal *UsedSpaceJournal) ReconciledAt() (time.Time, error) {
+	data, err := os.ReadFile(journal.path + ".reconciled")
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, ErrUsedSpaceJournal.Wrap(err)
+	}
+	unix, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		// a broken file only means that the next startup reconciles the cache.
+		return time.Time{}, nil
+	}
+	return time.Unix(unix, 0), nil
+}
+
+// SetReconciledAt records when the cache was last reconciled with the pieces on disk.
+func (journal *UsedSpaceJournal) SetReconciledAt(at time.Time) error {
+	path := journal.path + ".reconciled"
+	err := os.WriteFile(path+".tmp", []byte(strconv.FormatInt(at.Unix(), 10)), 0600)
+	if err != nil {
+		return ErrUsedSpaceJournal.Wrap(err)
+	}
+	return ErrUsedSpaceJournal.Wrap(os.Rename(path+".tmp", path))
+}
+
+// Close closes the journal.
+func (journal *UsedSpaceJournal) Close() error {
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+	return ErrUsedSpaceJournal.Wrap(journal.file.Close())
+}
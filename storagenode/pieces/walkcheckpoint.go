@@ -0,0 +1,104 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+)
+
+// ErrWalkCheckpoint is the error class for filewalker checkpoints.
+var ErrWalkCheckpoint = errs.Class("filewalker checkpoint")
+
+const (
+	// WalkCheckpointsDir is the name of the checkpoint directory in the storage directory.
+	WalkCheckpointsDir = "filewalker-checkpoints"
+
+	// WalkUsedSpace is the kind of the checkpoints of used space calculation walks.
+	WalkUsedSpace = "used-space"
+	// WalkGC is the kind of the checkpoints of garbage collection walks.
+	WalkGC = "gc"
+)
+
+// WalkCheckpoint is the progress of an interrupted walk over the pieces of a satellite.
+type WalkCheckpoint struct {
+	// LastPrefix is the last key prefix whose pieces were completely walked.
+	LastPrefix string `json:"lastPrefix"`
+	// CreatedBefore identifies the garbage collection request the walk belongs to.
+	CreatedBefore time.Time `json:"createdBefore,omitempty"`
+	// UpdatedAt is when the checkpoint was saved.
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// PiecesTotal and PiecesContentSize are the space used by the already walked pieces.
+	PiecesTotal       int64 `json:"piecesTotal,omitempty"`
+	PiecesContentSize int64 `json:"piecesContentSize,omitempty"`
+
+	// PieceIDs are the pieces to trash found so far.
+	PieceIDs      []storj.PieceID `json:"pieceIds,omitempty"`
+	PiecesCount   int64           `json:"piecesCount,omitempty"`
+	PiecesSkipped int64           `json:"piecesSkipped,omitempty"`
+}
+
+// WalkCheckpoints stores the checkpoints of walks, one per kind of walk and satellite,
+// as files in a directory.
+type WalkCheckpoints struct {
+	dir string
+}
+
+// OpenWalkCheckpoints creates the checkpoint directory, when it doesn't exist.
+func OpenWalkCheckpoints(dir string) (*WalkCheckpoints, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, ErrWalkCheckpoint.Wrap(err)
+	}
+	return &WalkCheckpoints{dir: dir}, nil
+}
+
+func (checkpoints *WalkCheckpoints) path(kind string, satellite storj.NodeID) string {
+	return filepath.Join(checkpoints.dir, kind+"-"+satellite.String()+".json")
+}
+
+// Get returns the checkpoint of the walk. ok is false when there is no checkpoint.
+func (checkpoints *WalkCheckpoints) Get(kind string, satellite storj.NodeID) (checkpoint WalkCheckpoint, ok bool, err error) {
+	data, err := os.ReadFile(checkpoints.path(kind, satellite))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return WalkCheckpoint{}, false, nil
+		}
+		return WalkCheckpoint{}, false, ErrWalkCheckpoint.Wrap(err)
+	}
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		// a broken checkpoint only means that the walk starts from the beginning.
+		return WalkCheckpoint{}, false, nil //nolint: nilerr // the checkpoint is ignored
+	}
+	return checkpoint, true, nil
+}
+
+// Set saves the checkpoint of the walk.
+func (checkpoints *WalkCheckpoints) Set(kind string, satellite storj.NodeID, checkpoint WalkCheckpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return ErrWalkCheckpoint.Wrap(err)
+	}
+	path := checkpoints.path(kind, satellite)
+	if err := os.WriteFile(path+".tmp", data, 0600); err != nil {
+		return ErrWalkCheckpoint.Wrap(err)
+	}
+	return ErrWalkCheckpoint.Wrap(os.Rename(path+".tmp", path))
+}
+
+// Delete removes the checkpoint of the walk, after the walk completed.
+func (checkpoints *WalkCheckpoints) Delete(kind string, satellite storj.NodeID) error {
+	err := os.Remove(checkpoints.path(kind, satellite))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return ErrWalkCheckpoint.Wrap(err)
+	}
+	return nil
+}
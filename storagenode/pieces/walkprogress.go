@@ -0,0 +1,32 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"sync/atomic"
+)
+
+// WalkProgress counts the pieces processed by a walk. It's safe for concurrent use.
+// A nil WalkProgress ignores all updates.
+type WalkProgress struct {
+	piecesProcessed int64
+	bytesProcessed  int64
+}
+
+// Add adds the processed pieces and their size to the progress.
+func (progress *WalkProgress) Add(pieces, bytes int64) {
+	if progress == nil {
+		return
+	}
+	atomic.AddInt64(&progress.piecesProcessed, pieces)
+	atomic.AddInt64(&progress.bytesProcessed, bytes)
+}
+
+// Get returns the number and the size of the pieces processed so far.
+func (progress *WalkProgress) Get() (piecesProcessed, bytesProcessed int64) {
+	if progress == nil {
+		return 0, 0
+	}
+	return atomic.LoadInt64(&progress.piecesProcessed), atomic.LoadInt64(&progress.bytesProcessed)
+}
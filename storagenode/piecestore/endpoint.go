@@ -5,12 +5,14 @@ package piecestore
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"os"
 	"sync"
 	"sync/atomic"
+	"syscall"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -32,10 +34,12 @@ import (
 	"storj.io/drpc"
 	"storj.io/drpc/drpcctx"
 	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/contact"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/orders/ordersfile"
 	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/piecestore/shaping"
 	"storj.io/storj/storagenode/piecestore/usedserials"
 	"storj.io/storj/storagenode/retain"
 	"storj.io/storj/storagenode/trust"
@@ -48,6 +52,7 @@ var (
 // OldConfig contains everything necessary for a server.
 type OldConfig struct {
 	Path                   string         `help:"path to store data in" default:"$CONFDIR/storage"`
+	AdditionalPaths        []string       `help:"additional directories to store pieces in, new pieces are stored in the directory with the most free space" default:""`
 	WhitelistedSatellites  storj.NodeURLs `help:"a comma-separated list of approved satellite node urls (unused)" devDefault:"" releaseDefault:""`
 	AllocatedDiskSpace     memory.Size    `user:"true" help:"total allocated disk space in bytes" default:"1TB"`
 	AllocatedBandwidth     memory.Size    `user:"true" help:"total allocated bandwidth in bytes (deprecated)" default:"0B"`
@@ -65,6 +70,7 @@ type Config struct {
 	OrderLimitGracePeriod   time.Duration `help:"how long after OrderLimit creation date are OrderLimits no longer accepted" default:"1h0m0s"`
 	CacheSyncInterval       time.Duration `help:"how often the space used cache is synced to persistent storage" releaseDefault:"1h0m0s" devDefault:"0h1m0s"`
 	PieceScanOnStartup      bool          `help:"if set to true, all pieces disk usage is recalculated on startup" default:"true"`
+	CacheReconcileInterval  time.Duration `help:"how often the persisted space used cache is reconciled by walking all the pieces" default:"168h0m0s"`
 	StreamOperationTimeout  time.Duration `help:"how long to spend waiting for a stream operation before canceling" default:"30m"`
 	RetainTimeBuffer        time.Duration `help:"allows for small differences in the satellite and storagenode clocks" default:"48h0m0s"`
 	ReportCapacityThreshold memory.Size   `help:"threshold below which to immediately notify satellite of capacity" default:"500MB" hidden:"true"`
@@ -78,6 +84,7 @@ type Config struct {
 
 	Monitor monitor.Config
 	Orders  orders.Config
+	Shaping shaping.Config
 }
 
 type pingStatsSource interface {
@@ -105,6 +112,9 @@ type Endpoint struct {
 	usage        bandwidth.DB
 	usedSerials  *usedserials.Table
 	pieceDeleter *pieces.Deleter
+	shaper       *shaping.Shaper
+	transports   *contact.TransportStats
+	rejections   *UploadRejections
 
 	liveRequests int32
 }
@@ -127,11 +137,27 @@ func NewEndpoint(log *zap.Logger, ident *identity.FullIdentity, trust *trust.Poo
 		usage:        usage,
 		usedSerials:  usedSerials,
 		pieceDeleter: pieceDeleter,
+		rejections:   NewUploadRejections(),
 
 		liveRequests: 0,
 	}, nil
 }
 
+// SetShaper sets the shaper, which limits the rate of the uploads and the downloads.
+func (endpoint *Endpoint) SetShaper(shaper *shaping.Shaper) {
+	endpoint.shaper = shaper
+}
+
+// SetTransportStats sets the statistics, which record the outcome of the transfers per transport.
+func (endpoint *Endpoint) SetTransportStats(transports *contact.TransportStats) {
+	endpoint.transports = transports
+}
+
+// UploadRejections returns the statistics of the rejected uploads.
+func (endpoint *Endpoint) UploadRejections() *UploadRejections {
+	return endpoint.rejections
+}
+
 var monLiveRequests = mon.TaskNamed("live-request")
 
 // Delete handles deleting a piece on piece store requested by uplink.
@@ -276,6 +302,7 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 			zap.Int32("live requests", liveRequests),
 			zap.Int("requestLimit", endpoint.config.MaxConcurrentRequests),
 		)
+		endpoint.rejections.Reject(storj.NodeID{}, RejectRateLimited)
 		errMsg := fmt.Sprintf("storage node overloaded, request limit: %d", endpoint.config.MaxConcurrentRequests)
 		return rpcstatus.Error(rpcstatus.Unavailable, errMsg)
 	}
@@ -303,13 +330,22 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 	hashAlgorithm := message.HashAlgorithm
 
 	if limit.Action != pb.PieceAction_PUT && limit.Action != pb.PieceAction_PUT_REPAIR {
+		endpoint.rejections.Reject(limit.SatelliteId, RejectOrderLimitInvalid)
 		return rpcstatus.Errorf(rpcstatus.InvalidArgument, "expected put or put repair action got %v", limit.Action)
 	}
 
 	if err := endpoint.verifyOrderLimit(ctx, limit); err != nil {
+		if reason, ok := orderLimitRejection(err); ok {
+			endpoint.rejections.Reject(limit.SatelliteId, reason)
+		}
 		return err
 	}
 
+	if endpoint.monitor.ReadOnly() {
+		endpoint.rejections.Reject(limit.SatelliteId, RejectReadOnly)
+		return rpcstatus.Error(rpcstatus.Unavailable, "storage node is in read-only mode, the storage directory is not writable")
+	}
+
 	availableSpace, err := endpoint.monitor.AvailableSpace(ctx)
 	if err != nil {
 		return rpcstatus.Wrap(rpcstatus.Internal, err)
@@ -322,9 +358,22 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 	}()
 
 	if availableSpace < limit.Limit {
+		endpoint.rejections.Reject(limit.SatelliteId, RejectDiskFull)
 		return rpcstatus.Errorf(rpcstatus.Aborted, "not enough available disk space, have: %v, need: %v", availableSpace, limit.Limit)
 	}
 
+	if quota, ok := endpoint.trust.Quota(limit.SatelliteId); ok {
+		usedBySatellite, _, err := endpoint.store.SpaceUsedBySatellite(ctx, limit.SatelliteId)
+		if err != nil {
+			return rpcstatus.Wrap(rpcstatus.Internal, err)
+		}
+		if quota.Int64()-usedBySatellite < limit.Limit {
+			mon.Event("upload_satellite_quota_exceeded")
+			endpoint.rejections.Reject(limit.SatelliteId, RejectDiskFull)
+			return rpcstatus.Errorf(rpcstatus.Aborted, "satellite quota exceeded, used: %v, quota: %v, need: %v", usedBySatellite, quota.Int64(), limit.Limit)
+		}
+	}
+
 	remoteAddrLogField := zap.String("Remote Address", getRemoteAddr(ctx))
 
 	var pieceWriter *pieces.Writer
@@ -352,6 +401,7 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 			mon.IntVal("upload_cancel_duration_ns").Observe(uploadDuration)
 			mon.FloatVal("upload_cancel_rate_bytes_per_sec").Observe(uploadRate)
 			endpoint.log.Info("upload canceled", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Stringer("Action", limit.Action), zap.Int64("Size", uploadSize), remoteAddrLogField)
+			endpoint.recordTransfer(ctx, false)
 		} else if err != nil {
 			mon.Counter("upload_failure_count").Inc(1)
 			mon.Meter("upload_failure_byte_meter").Mark64(uploadSize)
@@ -359,6 +409,7 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 			mon.IntVal("upload_failure_duration_ns").Observe(uploadDuration)
 			mon.FloatVal("upload_failure_rate_bytes_per_sec").Observe(uploadRate)
 			endpoint.log.Error("upload failed", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Stringer("Action", limit.Action), zap.Error(err), zap.Int64("Size", uploadSize), remoteAddrLogField)
+			endpoint.recordTransfer(ctx, false)
 		} else {
 			mon.Counter("upload_success_count").Inc(1)
 			mon.Meter("upload_success_byte_meter").Mark64(uploadSize)
@@ -366,6 +417,7 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 			mon.IntVal("upload_success_duration_ns").Observe(uploadDuration)
 			mon.FloatVal("upload_success_rate_bytes_per_sec").Observe(uploadRate)
 			endpoint.log.Info("uploaded", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Stringer("Action", limit.Action), zap.Int64("Size", uploadSize), remoteAddrLogField)
+			endpoint.recordTransfer(ctx, true)
 		}
 	}()
 
@@ -379,6 +431,10 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 
 	pieceWriter, err = endpoint.store.Writer(ctx, limit.SatelliteId, limit.PieceId, hashAlgorithm)
 	if err != nil {
+		if errors.Is(err, syscall.EROFS) {
+			// check the storage directory, which switches to the read-only mode.
+			endpoint.monitor.VerifyDirWritableLoop.Trigger()
+		}
 		return rpcstatus.Wrap(rpcstatus.Internal, err)
 	}
 	defer func() {
@@ -432,6 +488,9 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 			if availableSpace < 0 {
 				return true, rpcstatus.Error(rpcstatus.Internal, "out of space")
 			}
+			if err := endpoint.shaper.WaitIngress(ctx, limit.SatelliteId, len(message.Chunk.Data)); err != nil {
+				return true, rpcstatus.Wrap(rpcstatus.Canceled, err)
+			}
 			if _, err := pieceWriter.Write(message.Chunk.Data); err != nil {
 				return true, rpcstatus.Wrap(rpcstatus.Internal, err)
 			}
@@ -627,6 +686,7 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 			mon.IntVal("download_cancel_duration_ns", actionSeriesTag).Observe(downloadDuration)
 			mon.FloatVal("download_cancel_rate_bytes_per_sec", actionSeriesTag).Observe(downloadRate)
 			endpoint.log.Info("download canceled", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Stringer("Action", limit.Action), zap.Int64("Offset", chunk.Offset), zap.Int64("Size", downloadSize), zap.String("Remote Address", remoteAddr))
+			endpoint.recordTransfer(ctx, false)
 		} else if err != nil {
 			mon.Counter("download_failure_count", actionSeriesTag).Inc(1)
 			mon.Meter("download_failure_byte_meter", actionSeriesTag).Mark64(downloadSize)
@@ -634,6 +694,7 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 			mon.IntVal("download_failure_duration_ns", actionSeriesTag).Observe(downloadDuration)
 			mon.FloatVal("download_failure_rate_bytes_per_sec", actionSeriesTag).Observe(downloadRate)
 			endpoint.log.Error("download failed", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Stringer("Action", limit.Action), zap.Int64("Offset", chunk.Offset), zap.Int64("Size", downloadSize), zap.String("Remote Address", remoteAddr), zap.Error(err))
+			endpoint.recordTransfer(ctx, false)
 		} else {
 			mon.Counter("download_success_count", actionSeriesTag).Inc(1)
 			mon.Meter("download_success_byte_meter", actionSeriesTag).Mark64(downloadSize)
@@ -641,6 +702,7 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 			mon.IntVal("download_success_duration_ns", actionSeriesTag).Observe(downloadDuration)
 			mon.FloatVal("download_success_rate_bytes_per_sec", actionSeriesTag).Observe(downloadRate)
 			endpoint.log.Info("downloaded", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Stringer("Action", limit.Action), zap.Int64("Offset", chunk.Offset), zap.Int64("Size", downloadSize), zap.String("Remote Address", remoteAddr))
+			endpoint.recordTransfer(ctx, true)
 		}
 		mon.IntVal("download_orders_amount", actionSeriesTag).Observe(largestOrder.Amount)
 	}()
@@ -714,7 +776,7 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 				return nil // We don't need to return an error when client cancels.
 			}
 
-			done, err := endpoint.sendData(ctx, stream, pieceReader, currentOffset, chunkSize)
+			done, err := endpoint.sendData(ctx, stream, limit.SatelliteId, pieceReader, currentOffset, chunkSize)
 			if err != nil || done {
 				return err
 			}
@@ -787,7 +849,7 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 	return rpcstatus.Wrap(rpcstatus.Internal, errs.Combine(sendErr, recvErr))
 }
 
-func (endpoint *Endpoint) sendData(ctx context.Context, stream pb.DRPCPiecestore_DownloadStream, pieceReader *pieces.Reader, currentOffset int64, chunkSize int64) (result bool, err error) {
+func (endpoint *Endpoint) sendData(ctx context.Context, stream pb.DRPCPiecestore_DownloadStream, satelliteID storj.NodeID, pieceReader *pieces.Reader, currentOffset int64, chunkSize int64) (result bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 	chunkData := make([]byte, chunkSize)
 	_, err = pieceReader.Seek(currentOffset, io.SeekStart)
@@ -803,6 +865,10 @@ func (endpoint *Endpoint) sendData(ctx context.Context, stream pb.DRPCPiecestore
 		return true, rpcstatus.Wrap(rpcstatus.Internal, err)
 	}
 
+	if err := endpoint.shaper.WaitEgress(ctx, satelliteID, len(chunkData)); err != nil {
+		return true, rpcstatus.Wrap(rpcstatus.Canceled, err)
+	}
+
 	err = rpctimeout.Run(ctx, endpoint.config.StreamOperationTimeout, func(_ context.Context) (err error) {
 		return stream.Send(&pb.PieceDownloadResponse{
 			Chunk: &pb.PieceDownloadResponse_Chunk{
@@ -979,6 +1045,18 @@ func (estimate *speedEstimation) EnsureLimit(transferred memory.Size, congested
 }
 
 // getRemoteAddr returns the remote address from the request context.
+// recordTransfer records the outcome of the transfer for the transport of the request.
+func (endpoint *Endpoint) recordTransfer(ctx context.Context, success bool) {
+	if endpoint.transports == nil {
+		return
+	}
+	if transport, ok := drpcctx.Transport(ctx); ok {
+		if conn, ok := transport.(net.Conn); ok {
+			endpoint.transports.Transfer(contact.TransportOf(conn.RemoteAddr()), success)
+		}
+	}
+}
+
 func getRemoteAddr(ctx context.Context) string {
 	if transport, ok := drpcctx.Transport(ctx); ok {
 		if conn, ok := transport.(net.Conn); ok {
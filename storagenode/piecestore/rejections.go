@@ -0,0 +1,147 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/piecestore/usedserials"
+)
+
+// RejectionReason is the category of a rejected upload.
+type RejectionReason string
+
+const (
+	// RejectDiskFull is used when the node or the space allocated to the satellite has no room for the piece.
+	RejectDiskFull RejectionReason = "disk_full"
+	// RejectReadOnly is used when the storage directory is not writable.
+	RejectReadOnly RejectionReason = "read_only"
+	// RejectRateLimited is used when the node is handling too many requests.
+	RejectRateLimited RejectionReason = "rate_limited"
+	// RejectUntrustedSatellite is used when the order limit is issued by a satellite, which is not trusted.
+	RejectUntrustedSatellite RejectionReason = "untrusted_satellite"
+	// RejectOrderLimitInvalid is used when the order limit is expired, badly signed or malformed.
+	RejectOrderLimitInvalid RejectionReason = "order_limit_invalid"
+	// RejectDuplicatePiece is used when the order limit of the piece has been already used.
+	RejectDuplicatePiece RejectionReason = "duplicate_piece"
+)
+
+// RejectionReasons lists all the categories of the rejected uploads.
+var RejectionReasons = []RejectionReason{
+	RejectDiskFull,
+	RejectReadOnly,
+	RejectRateLimited,
+	RejectUntrustedSatellite,
+	RejectOrderLimitInvalid,
+	RejectDuplicatePiece,
+}
+
+// SatelliteUploadRejections contains the rejected uploads of a satellite.
+type SatelliteUploadRejections struct {
+	SatelliteID storj.NodeID              `json:"satelliteID"`
+	Rejections  map[RejectionReason]int64 `json:"rejections"`
+}
+
+// UploadRejectionStats contains the number of the rejected uploads per reason since the
+// node has started. The uploads rejected before their order limit is known are not
+// attributed to any satellite.
+type UploadRejectionStats struct {
+	Since      time.Time                   `json:"since"`
+	Rejections map[RejectionReason]int64   `json:"rejections"`
+	Satellites []SatelliteUploadRejections `json:"satellites"`
+}
+
+// UploadRejections counts the rejected uploads per reason and per satellite.
+type UploadRejections struct {
+	since time.Time
+
+	mu         sync.Mutex
+	total      map[RejectionReason]int64
+	satellites map[storj.NodeID]map[RejectionReason]int64
+}
+
+// NewUploadRejections creates new statistics of the rejected uploads.
+func NewUploadRejections() *UploadRejections {
+	return &UploadRejections{
+		since:      time.Now(),
+		total:      map[RejectionReason]int64{},
+		satellites: map[storj.NodeID]map[RejectionReason]int64{},
+	}
+}
+
+// Reject records a rejected upload. A zero satelliteID is used when the satellite is unknown.
+func (rejections *UploadRejections) Reject(satelliteID storj.NodeID, reason RejectionReason) {
+	mon.Counter("upload_rejected", monkit.NewSeriesTag("reason", string(reason))).Inc(1)
+
+	rejections.mu.Lock()
+	defer rejections.mu.Unlock()
+
+	rejections.total[reason]++
+	if satelliteID.IsZero() {
+		return
+	}
+	satellite, ok := rejections.satellites[satelliteID]
+	if !ok {
+		satellite = map[RejectionReason]int64{}
+		rejections.satellites[satelliteID] = satellite
+	}
+	satellite[reason]++
+}
+
+// Stats returns a copy of the statistics. All the reasons are included, so the
+// categories without rejections are reported as zero.
+func (rejections *UploadRejections) Stats() UploadRejectionStats {
+	rejections.mu.Lock()
+	defer rejections.mu.Unlock()
+
+	stats := UploadRejectionStats{
+		Since:      rejections.since,
+		Rejections: copyRejections(rejections.total),
+		Satellites: make([]SatelliteUploadRejections, 0, len(rejections.satellites)),
+	}
+	for satelliteID, satellite := range rejections.satellites {
+		stats.Satellites = append(stats.Satellites, SatelliteUploadRejections{
+			SatelliteID: satelliteID,
+			Rejections:  copyRejections(satellite),
+		})
+	}
+	sort.Slice(stats.Satellites, func(i, k int) bool {
+		return stats.Satellites[i].SatelliteID.Less(stats.Satellites[k].SatelliteID)
+	})
+	return stats
+}
+
+func copyRejections(counts map[RejectionReason]int64) map[RejectionReason]int64 {
+	copied := make(map[RejectionReason]int64, len(RejectionReasons))
+	for _, reason := range RejectionReasons {
+		copied[reason] = counts[reason]
+	}
+	return copied
+}
+
+// orderLimitRejection categorizes the failed verification of an upload order limit.
+// It returns false, when the upload wasn't rejected because of the order limit, e.g.
+// it was canceled.
+func orderLimitRejection(err error) (RejectionReason, bool) {
+	switch {
+	case usedserials.ErrSerialAlreadyExists.Has(err):
+		return RejectDuplicatePiece, true
+	case ErrVerifyUntrusted.Has(err):
+		return RejectUntrustedSatellite, true
+	}
+	switch rpcstatus.Code(err) {
+	case rpcstatus.Canceled:
+		return "", false
+	case rpcstatus.PermissionDenied:
+		return RejectUntrustedSatellite, true
+	default:
+		return RejectOrderLimitInvalid, true
+	}
+}
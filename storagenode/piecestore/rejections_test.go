@@ -0,0 +1,55 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/piecestore"
+)
+
+func TestUploadRejections(t *testing.T) {
+	rejections := piecestore.NewUploadRejections()
+
+	stats := rejections.Stats()
+	require.Len(t, stats.Rejections, len(piecestore.RejectionReasons))
+	require.Empty(t, stats.Satellites)
+	for _, count := range stats.Rejections {
+		require.Zero(t, count)
+	}
+
+	first, second := testrand.NodeID(), testrand.NodeID()
+	if second.Less(first) {
+		first, second = second, first
+	}
+
+	rejections.Reject(storj.NodeID{}, piecestore.RejectRateLimited)
+	rejections.Reject(first, piecestore.RejectDiskFull)
+	rejections.Reject(first, piecestore.RejectDiskFull)
+	rejections.Reject(second, piecestore.RejectDuplicatePiece)
+	rejections.Reject(second, piecestore.RejectUntrustedSatellite)
+
+	stats = rejections.Stats()
+	require.Equal(t, int64(1), stats.Rejections[piecestore.RejectRateLimited])
+	require.Equal(t, int64(2), stats.Rejections[piecestore.RejectDiskFull])
+	require.Equal(t, int64(1), stats.Rejections[piecestore.RejectDuplicatePiece])
+	require.Equal(t, int64(1), stats.Rejections[piecestore.RejectUntrustedSatellite])
+	require.Zero(t, stats.Rejections[piecestore.RejectOrderLimitInvalid])
+
+	require.Len(t, stats.Satellites, 2)
+	require.Equal(t, first, stats.Satellites[0].SatelliteID)
+	require.Equal(t, int64(2), stats.Satellites[0].Rejections[piecestore.RejectDiskFull])
+	require.Zero(t, stats.Satellites[0].Rejections[piecestore.RejectRateLimited])
+	require.Equal(t, second, stats.Satellites[1].SatelliteID)
+	require.Equal(t, int64(1), stats.Satellites[1].Rejections[piecestore.RejectDuplicatePiece])
+	require.Equal(t, int64(1), stats.Satellites[1].Rejections[piecestore.RejectUntrustedSatellite])
+
+	// the returned statistics are not changed by later rejections
+	rejections.Reject(first, piecestore.RejectDiskFull)
+	require.Equal(t, int64(2), stats.Rejections[piecestore.RejectDiskFull])
+}
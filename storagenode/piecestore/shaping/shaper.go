@@ -0,0 +1,188 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package shaping implements the rate limiting of the piece transfers.
+package shaping
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/zeebo/errs"
+	"golang.org/x/time/rate"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+)
+
+// Error is the error class for the bandwidth shaping.
+var Error = errs.Class("shaping")
+
+// Config defines the bandwidth limits.
+type Config struct {
+	Ingress    memory.Size `help:"maximum rate of the uploads to the node per second, 0 means unlimited" default:"0B"`
+	Egress     memory.Size `help:"maximum rate of the downloads from the node per second, 0 means unlimited" default:"0B"`
+	Satellites []string    `help:"per satellite maximum rates per second as <satellite id>:<ingress>:<egress>, e.g. 12EayRS2V1kEsWESU9QMRseFhdxYxKicsiFmxrsLZHeLUtdps3S:10MB:20MB" default:""`
+}
+
+// Limits are the maximum rates of the transfers in bytes per second. Zero means unlimited.
+type Limits struct {
+	Ingress memory.Size `json:"ingress"`
+	Egress  memory.Size `json:"egress"`
+}
+
+// SatelliteLimits are the limits of the transfers of a satellite.
+type SatelliteLimits struct {
+	SatelliteID storj.NodeID `json:"satelliteID"`
+	Limits
+}
+
+// Parse returns the global and the per satellite limits of the config.
+func (config Config) Parse() (global Limits, satellites []SatelliteLimits, err error) {
+	global = Limits{Ingress: config.Ingress, Egress: config.Egress}
+	for _, s := range config.Satellites {
+		if s == "" {
+			continue
+		}
+		parts := strings.Split(s, ":")
+		if len(parts) != 3 {
+			return Limits{}, nil, Error.New("invalid satellite limits %q, expected <satellite id>:<ingress>:<egress>", s)
+		}
+		var limits SatelliteLimits
+		limits.SatelliteID, err = storj.NodeIDFromString(parts[0])
+		if err != nil {
+			return Limits{}, nil, Error.New("invalid satellite id in %q: %v", s, err)
+		}
+		if err := limits.Ingress.Set(parts[1]); err != nil {
+			return Limits{}, nil, Error.New("invalid ingress in %q: %v", s, err)
+		}
+		if err := limits.Egress.Set(parts[2]); err != nil {
+			return Limits{}, nil, Error.New("invalid egress in %q: %v", s, err)
+		}
+		satellites = append(satellites, limits)
+	}
+	return global, satellites, nil
+}
+
+// Shaper limits the rate of the uploads and the downloads with token buckets, globally and
+// per satellite. The limits can be changed while the node is running.
+type Shaper struct {
+	mu         sync.RWMutex
+	global     limiters
+	satellites map[storj.NodeID]limiters
+}
+
+// limiters are the token buckets of the limits. A nil limiter is unlimited.
+type limiters struct {
+	limits  Limits
+	ingress *rate.Limiter
+	egress  *rate.Limiter
+}
+
+func newLimiters(limits Limits) limiters {
+	return limiters{
+		limits:  limits,
+		ingress: newLimiter(limits.Ingress),
+		egress:  newLimiter(limits.Egress),
+	}
+}
+
+// newLimiter creates a token bucket, which allows bursts of a second.
+func newLimiter(limit memory.Size) *rate.Limiter {
+	if limit <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(limit), limit.Int())
+}
+
+// NewShaper creates a shaper with the configured limits.
+func NewShaper(config Config) (*Shaper, error) {
+	global, satellites, err := config.Parse()
+	if err != nil {
+		return nil, err
+	}
+	shaper := &Shaper{}
+	shaper.SetLimits(global, satellites)
+	return shaper, nil
+}
+
+// SetLimits replaces the global and the per satellite limits.
+func (shaper *Shaper) SetLimits(global Limits, satellites []SatelliteLimits) {
+	perSatellite := make(map[storj.NodeID]limiters, len(satellites))
+	for _, limits := range satellites {
+		perSatellite[limits.SatelliteID] = newLimiters(limits.Limits)
+	}
+
+	shaper.mu.Lock()
+	defer shaper.mu.Unlock()
+	shaper.global = newLimiters(global)
+	shaper.satellites = perSatellite
+}
+
+// Limits returns the global and the per satellite limits.
+func (shaper *Shaper) Limits() (global Limits, satellites []SatelliteLimits) {
+	shaper.mu.RLock()
+	defer shaper.mu.RUnlock()
+
+	for satelliteID, limiters := range shaper.satellites {
+		satellites = append(satellites, SatelliteLimits{SatelliteID: satelliteID, Limits: limiters.limits})
+	}
+	sort.Slice(satellites, func(i, k int) bool {
+		return satellites[i].SatelliteID.Less(satellites[k].SatelliteID)
+	})
+	return shaper.global.limits, satellites
+}
+
+// WaitIngress waits until n bytes can be uploaded from the satellite.
+func (shaper *Shaper) WaitIngress(ctx context.Context, satelliteID storj.NodeID, n int) error {
+	if shaper == nil {
+		return nil
+	}
+	global, satellite := shaper.limiters(satelliteID)
+	return waitAll(ctx, n, global.ingress, satellite.ingress)
+}
+
+// WaitEgress waits until n bytes can be downloaded for the satellite.
+func (shaper *Shaper) WaitEgress(ctx context.Context, satelliteID storj.NodeID, n int) error {
+	if shaper == nil {
+		return nil
+	}
+	global, satellite := shaper.limiters(satelliteID)
+	return waitAll(ctx, n, global.egress, satellite.egress)
+}
+
+func (shaper *Shaper) limiters(satelliteID storj.NodeID) (global, satellite limiters) {
+	shaper.mu.RLock()
+	defer shaper.mu.RUnlock()
+	return shaper.global, shaper.satellites[satelliteID]
+}
+
+// waitAll waits until n bytes are allowed by all the limiters.
+func waitAll(ctx context.Context, n int, all ...*rate.Limiter) error {
+	for _, limiter := range all {
+		if limiter == nil {
+			continue
+		}
+		if err := wait(ctx, limiter, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// wait waits for n bytes in pieces of at most the burst of the limiter.
+func wait(ctx context.Context, limiter *rate.Limiter, n int) error {
+	for n > 0 {
+		size := n
+		if size > limiter.Burst() {
+			size = limiter.Burst()
+		}
+		if err := limiter.WaitN(ctx, size); err != nil {
+			return err
+		}
+		n -= size
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package shaping_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/piecestore/shaping"
+)
+
+func TestConfigParse(t *testing.T) {
+	satelliteID := testrand.NodeID()
+
+	global, satellites, err := shaping.Config{
+		Ingress:    10 * memory.MB,
+		Satellites: []string{satelliteID.String() + ":1MB:2MB"},
+	}.Parse()
+	require.NoError(t, err)
+	require.Equal(t, shaping.Limits{Ingress: 10 * memory.MB}, global)
+	require.Equal(t, []shaping.SatelliteLimits{{
+		SatelliteID: satelliteID,
+		Limits:      shaping.Limits{Ingress: memory.MB, Egress: 2 * memory.MB},
+	}}, satellites)
+
+	for _, invalid := range []string{
+		"1MB:2MB",
+		"invalid:1MB:2MB",
+		satelliteID.String() + ":1XB:2MB",
+		satelliteID.String() + ":1MB:2XB",
+	} {
+		_, _, err := shaping.Config{Satellites: []string{invalid}}.Parse()
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestShaper(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	limited, unlimited := testrand.NodeID(), testrand.NodeID()
+
+	shaper, err := shaping.NewShaper(shaping.Config{
+		Satellites: []string{limited.String() + ":0:1KiB"},
+	})
+	require.NoError(t, err)
+
+	// the transfers without limits don't wait.
+	start := time.Now()
+	require.NoError(t, shaper.WaitIngress(ctx, limited, memory.GiB.Int()))
+	require.NoError(t, shaper.WaitEgress(ctx, unlimited, memory.GiB.Int()))
+	require.Less(t, time.Since(start), time.Second)
+
+	// a burst of a second is allowed, after which the transfer is limited.
+	start = time.Now()
+	require.NoError(t, shaper.WaitEgress(ctx, limited, memory.KiB.Int()))
+	require.NoError(t, shaper.WaitEgress(ctx, limited, memory.KiB.Int()/4))
+	require.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+
+	// the limits can be changed.
+	shaper.SetLimits(shaping.Limits{Ingress: memory.MiB}, nil)
+	global, satellites := shaper.Limits()
+	require.Equal(t, shaping.Limits{Ingress: memory.MiB}, global)
+	require.Empty(t, satellites)
+
+	start = time.Now()
+	require.NoError(t, shaper.WaitEgress(ctx, limited, memory.GiB.Int()))
+	require.Less(t, time.Since(start), time.Second)
+
+	// the waits are canceled with the context.
+	shaper.SetLimits(shaping.Limits{Ingress: memory.B}, nil)
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	require.Error(t, shaper.WaitIngress(canceled, limited, memory.KiB.Int()))
+
+	// a nil shaper doesn't limit.
+	var disabled *shaping.Shaper
+	require.NoError(t, disabled.WaitIngress(ctx, limited, memory.GiB.Int()))
+}
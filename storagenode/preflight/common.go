@@ -4,7 +4,11 @@
 package preflight
 
 import (
+	"time"
+
 	"github.com/spacemonkeygo/monkit/v3"
+
+	"storj.io/common/memory"
 )
 
 var mon = monkit.Package()
@@ -13,4 +17,11 @@ var mon = monkit.Package()
 type Config struct {
 	LocalTimeCheck bool `help:"whether or not preflight check for local system clock is enabled on the satellite side. When disabling this feature, your storagenode may not setup correctly." default:"true"`
 	DatabaseCheck  bool `help:"whether or not preflight check for database is enabled." default:"true"`
+
+	StorageCheck    bool          `help:"whether or not preflight check for the storage directory is enabled." default:"true"`
+	StorageSafeMode bool          `help:"if the storage directory check fails, start serving only downloads and audits instead of refusing to start." default:"false"`
+	MinFreeInodes   int64         `help:"minimum number of free inodes on the filesystem of the storage directory, 0 disables the check." default:"100000"`
+	BenchmarkSize   memory.Size   `help:"size of the file written and read to measure the latency of the storage directory, 0 disables the benchmark." default:"4MiB"`
+	MaxWriteLatency time.Duration `help:"maximum time to write and sync the benchmark file to the storage directory." default:"10s"`
+	MaxReadLatency  time.Duration `help:"maximum time to read the benchmark file from the storage directory." default:"10s"`
 }
@@ -0,0 +1,26 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+//go:build !windows
+// +build !windows
+
+package preflight
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+// freeInodes returns the number of the free inodes on the filesystem of the path, or -1
+// when the filesystem allocates the inodes dynamically.
+func freeInodes(path string) (int64, error) {
+	var stat unix.Statfs_t
+	if err := unix.Statfs(path, &stat); err != nil {
+		return 0, err
+	}
+	// e.g. btrfs reports no inodes at all.
+	if stat.Files == 0 {
+		return -1, nil
+	}
+	// the Ffree type depends on the OS and unconvert gives a false-positive
+	return int64(stat.Ffree), nil //nolint: unconvert
+}
@@ -0,0 +1,9 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package preflight
+
+// freeInodes returns -1, because NTFS doesn't limit the number of the files.
+func freeInodes(path string) (int64, error) {
+	return -1, nil
+}
@@ -0,0 +1,193 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package preflight
+
+import (
+	"context"
+	"crypto/rand"
+	"io"
+	"os"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/pieces"
+)
+
+// ErrStorage is the error class for the failed storage directory checks.
+var ErrStorage = errs.Class("storage directory check failed")
+
+// Storage checks the storage directory before the node starts: whether it's the directory
+// of this node, whether the disk is mounted, whether there are free inodes, and whether
+// the disk is fast enough.
+type Storage struct {
+	log       *zap.Logger
+	config    Config
+	dir       string
+	store     *pieces.Store
+	spaceUsed pieces.PieceSpaceUsedDB
+	nodeID    storj.NodeID
+
+	safeMode func(ctx context.Context, cause error)
+}
+
+// NewStorage creates a new storage directory check.
+func NewStorage(log *zap.Logger, config Config, dir string, store *pieces.Store, spaceUsed pieces.PieceSpaceUsedDB, nodeID storj.NodeID) *Storage {
+	return &Storage{
+		log:       log,
+		config:    config,
+		dir:       dir,
+		store:     store,
+		spaceUsed: spaceUsed,
+		nodeID:    nodeID,
+	}
+}
+
+// SetSafeMode sets the function, which switches the node to serve only downloads, when
+// the check fails and StorageSafeMode is enabled.
+func (storage *Storage) SetSafeMode(safeMode func(ctx context.Context, cause error)) {
+	storage.safeMode = safeMode
+}
+
+// Check checks the storage directory. It returns an error, which describes how to fix the
+// problem, unless the safe mode is enabled.
+func (storage *Storage) Check(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !storage.config.StorageCheck {
+		storage.log.Debug("storage directory check is not enabled")
+		return nil
+	}
+
+	storage.log.Info("start checking the storage directory.", zap.String("Path", storage.dir))
+
+	err = storage.check(ctx)
+	if err == nil {
+		return nil
+	}
+
+	if storage.config.StorageSafeMode && storage.safeMode != nil {
+		storage.log.Error("storage directory check failed, starting in safe mode: only downloads and audits are served", zap.Error(err))
+		storage.safeMode(ctx, err)
+		return nil
+	}
+	return err
+}
+
+func (storage *Storage) check(ctx context.Context) (err error) {
+	if err := storage.store.VerifyStorageDir(ctx, storage.nodeID); err != nil {
+		return ErrStorage.New("%v: make sure the disk is mounted at %q and the storage directory belongs to this node identity", err, storage.dir)
+	}
+
+	if err := storage.checkMount(ctx); err != nil {
+		return err
+	}
+
+	if err := storage.checkInodes(); err != nil {
+		return err
+	}
+
+	return storage.benchmark(ctx)
+}
+
+// checkMount checks whether the node had stored pieces, but the storage directory has none,
+// which happens when the disk isn't mounted and the verification file was copied over.
+func (storage *Storage) checkMount(ctx context.Context) error {
+	piecesTotal, _, err := storage.spaceUsed.GetPieceTotals(ctx)
+	if err != nil {
+		return ErrStorage.Wrap(err)
+	}
+	if piecesTotal <= 0 {
+		return nil
+	}
+
+	satellites, err := storage.store.StoringSatellites(ctx)
+	if err != nil {
+		return ErrStorage.Wrap(err)
+	}
+	if len(satellites) == 0 {
+		return ErrStorage.New("the node stored %s of pieces, but %q contains none: make sure the disk is mounted", memory.Size(piecesTotal), storage.dir)
+	}
+	return nil
+}
+
+// checkInodes checks whether new pieces can be created on the filesystem.
+func (storage *Storage) checkInodes() error {
+	if storage.config.MinFreeInodes <= 0 {
+		return nil
+	}
+
+	free, err := freeInodes(storage.dir)
+	if err != nil {
+		return ErrStorage.Wrap(err)
+	}
+	// the filesystem doesn't report the inodes.
+	if free < 0 {
+		return nil
+	}
+
+	if free < storage.config.MinFreeInodes {
+		return ErrStorage.New("only %d free inodes left on the filesystem of %q, at least %d are required: free up files or recreate the filesystem with more inodes", free, storage.dir, storage.config.MinFreeInodes)
+	}
+	return nil
+}
+
+// benchmark writes and reads a file in the storage directory, and checks how long it takes.
+func (storage *Storage) benchmark(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if storage.config.BenchmarkSize <= 0 {
+		return nil
+	}
+
+	data := make([]byte, storage.config.BenchmarkSize.Int())
+	if _, err := rand.Read(data); err != nil {
+		return ErrStorage.Wrap(err)
+	}
+
+	file, err := os.CreateTemp(storage.dir, "preflight-*.tmp")
+	if err != nil {
+		return ErrStorage.New("unable to create a file in %q: %v", storage.dir, err)
+	}
+	path := file.Name()
+	defer func() {
+		err = errs.Combine(err, ErrStorage.Wrap(os.Remove(path)))
+	}()
+
+	start := time.Now()
+	_, err = file.Write(data)
+	if err == nil {
+		err = file.Sync()
+	}
+	err = errs.Combine(err, file.Close())
+	if err != nil {
+		return ErrStorage.New("unable to write a file in %q: %v", storage.dir, err)
+	}
+	writeLatency := time.Since(start)
+	mon.DurationVal("preflight_storage_write_latency").Observe(writeLatency)
+
+	start = time.Now()
+	file, err = os.Open(path)
+	if err != nil {
+		return ErrStorage.New("unable to read a file in %q: %v", storage.dir, err)
+	}
+	_, err = io.Copy(io.Discard, file)
+	err = errs.Combine(err, file.Close())
+	if err != nil {
+		return ErrStorage.New("unable to read a file in %q: %v", storage.dir, err)
+	}
+	readLatency := time.Since(start)
+	mon.DurationVal("preflight_storage_read_latency").Observe(readLatency)
+
+	storage.log.Info("storage directory latency", zap.Stringer("Size", storage.config.BenchmarkSize), zap.Duration("Write", writeLatency), zap.Duration("Read", readLatency))
+
+	if storage.config.MaxWriteLatency > 0 && writeLatency > storage.config.MaxWriteLatency {
+		return ErrStorage.New("writing %s to %q took %s, more than %s: the disk is too slow or failing, check its health and connection", storage.config.BenchmarkSize, storage.dir, writeLatency, storage.config.MaxWriteLatency)
+	}
+	if storage.config.MaxReadLatency > 0 && readLatency > storage.config.MaxReadLatency {
+		return ErrStorage.New("reading %s from %q took %s, more than %s: the disk is too slow or failing, check its health and connection", storage.config.BenchmarkSize, storage.dir, readLatency, storage.config.MaxReadLatency)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package preflight_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/preflight"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestStorage(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		dir := ctx.Dir("storage")
+		nodeID := testrand.NodeID()
+
+		blobs, err := filestore.NewAt(log, dir, filestore.DefaultConfig)
+		require.NoError(t, err)
+		defer ctx.Check(blobs.Close)
+		store := pieces.NewStore(log, pieces.NewFileWalker(log, blobs, nil), nil, blobs, nil, nil, db.PieceSpaceUsedDB(), pieces.DefaultConfig)
+
+		config := preflight.Config{
+			StorageCheck:  true,
+			MinFreeInodes: 1,
+			BenchmarkSize: memory.KiB,
+		}
+		check := func(config preflight.Config) error {
+			return preflight.NewStorage(log, config, dir, store, db.PieceSpaceUsedDB(), nodeID).Check(ctx)
+		}
+
+		// the verification file is missing.
+		err = check(config)
+		require.Error(t, err)
+		require.True(t, preflight.ErrStorage.Has(err))
+
+		require.NoError(t, store.CreateVerificationFile(ctx, nodeID))
+		require.NoError(t, check(config))
+
+		// not enough inodes, unless the filesystem doesn't report them.
+		err = check(preflight.Config{StorageCheck: true, MinFreeInodes: math.MaxInt64})
+		if err != nil {
+			require.True(t, preflight.ErrStorage.Has(err))
+		}
+
+		// the node stored pieces, but the directory is empty.
+		require.NoError(t, db.PieceSpaceUsedDB().Init(ctx))
+		require.NoError(t, db.PieceSpaceUsedDB().UpdatePieceTotals(ctx, 100, 100))
+		err = check(config)
+		require.Error(t, err)
+		require.True(t, preflight.ErrStorage.Has(err))
+
+		// the safe mode starts the node anyway.
+		config.StorageSafeMode = true
+		storage := preflight.NewStorage(log, config, dir, store, db.PieceSpaceUsedDB(), nodeID)
+		var cause error
+		storage.SetSafeMode(func(ctx context.Context, err error) { cause = err })
+		require.NoError(t, storage.Check(ctx))
+		require.True(t, preflight.ErrStorage.Has(cause))
+
+		// disabled check.
+		require.NoError(t, check(preflight.Config{StorageCheck: false}))
+	})
+}
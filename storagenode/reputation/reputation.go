@@ -21,6 +21,8 @@ type DB interface {
 	Get(ctx context.Context, satelliteID storj.NodeID) (*Stats, error)
 	// All retrieves all stats from DB
 	All(ctx context.Context) ([]Stats, error)
+	// History retrieves the daily scores of a satellite in the [from, to) interval
+	History(ctx context.Context, satelliteID storj.NodeID, from, to time.Time) ([]DailyScores, error)
 }
 
 // Stats consist of reputation metrics.
@@ -36,11 +38,29 @@ type Stats struct {
 	OfflineUnderReviewAt *time.Time
 	VettedAt             *time.Time
 	AuditHistory         *pb.AuditHistory
+	Containment          Containment
 
 	UpdatedAt time.Time
 	JoinedAt  time.Time
 }
 
+// Containment describes the pieces of the node awaiting a reverification audit,
+// after the node timed out on their audits.
+type Containment struct {
+	PendingCount    int64      `json:"pendingCount"`
+	OldestPendingAt *time.Time `json:"oldestPendingAt"`
+}
+
+// DailyScores are the scores of the node on a satellite at the last update of a day.
+type DailyScores struct {
+	Date              time.Time `json:"date"`
+	AuditScore        float64   `json:"auditScore"`
+	SuspensionScore   float64   `json:"suspensionScore"`
+	OnlineScore       float64   `json:"onlineScore"`
+	AuditSuccessCount int64     `json:"auditSuccessCount"`
+	AuditTotalCount   int64     `json:"auditTotalCount"`
+}
+
 // Metric encapsulates storagenode reputation metrics.
 type Metric struct {
 	TotalCount   int64 `json:"totalCount"`
@@ -130,6 +130,88 @@ func TestReputationDBGetAll(t *testing.T) {
 	})
 }
 
+func TestReputationDBHistory(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		reputationDB := db.Reputation()
+
+		satelliteID := testrand.NodeID()
+		day := time.Date(2023, 3, 10, 0, 0, 0, 0, time.UTC)
+
+		store := func(updatedAt time.Time, score float64) {
+			err := reputationDB.Store(ctx, reputation.Stats{
+				SatelliteID: satelliteID,
+				Audit:       reputation.Metric{Score: score, UnknownScore: 1, TotalCount: 3, SuccessCount: 2},
+				OnlineScore: 0.9,
+				UpdatedAt:   updatedAt,
+			})
+			require.NoError(t, err)
+		}
+
+		// the last scores of the day are kept.
+		store(day.Add(2*time.Hour), 0.5)
+		store(day.Add(6*time.Hour), 0.6)
+		store(day.AddDate(0, 0, 1).Add(time.Hour), 0.7)
+		store(day.AddDate(0, 0, 2).Add(time.Hour), 0.8)
+
+		// another satellite doesn't affect the history.
+		require.NoError(t, reputationDB.Store(ctx, reputation.Stats{SatelliteID: testrand.NodeID(), UpdatedAt: day}))
+
+		history, err := reputationDB.History(ctx, satelliteID, day, day.AddDate(0, 0, 2))
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+
+		require.True(t, history[0].Date.Equal(day))
+		require.Equal(t, reputation.DailyScores{
+			Date:              history[0].Date,
+			AuditScore:        0.6,
+			SuspensionScore:   1,
+			OnlineScore:       0.9,
+			AuditSuccessCount: 2,
+			AuditTotalCount:   3,
+		}, history[0])
+		require.True(t, history[1].Date.Equal(day.AddDate(0, 0, 1)))
+		require.Equal(t, 0.7, history[1].AuditScore)
+
+		history, err = reputationDB.History(ctx, testrand.NodeID(), day, day.AddDate(0, 0, 2))
+		require.NoError(t, err)
+		require.Empty(t, history)
+	})
+}
+
+func TestReputationDBContainment(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		timestamp := time.Now()
+		reputationDB := db.Reputation()
+
+		stats := reputation.Stats{
+			SatelliteID: testrand.NodeID(),
+			Containment: reputation.Containment{
+				PendingCount:    3,
+				OldestPendingAt: &timestamp,
+			},
+			UpdatedAt: timestamp,
+			JoinedAt:  timestamp,
+		}
+		require.NoError(t, reputationDB.Store(ctx, stats))
+
+		res, err := reputationDB.Get(ctx, stats.SatelliteID)
+		require.NoError(t, err)
+		require.EqualValues(t, 3, res.Containment.PendingCount)
+		require.NotNil(t, res.Containment.OldestPendingAt)
+		require.True(t, res.Containment.OldestPendingAt.Equal(timestamp))
+
+		// the node is released from the containment.
+		stats.Containment = reputation.Containment{}
+		require.NoError(t, reputationDB.Store(ctx, stats))
+
+		all, err := reputationDB.All(ctx)
+		require.NoError(t, err)
+		require.Len(t, all, 1)
+		require.Zero(t, all[0].Containment.PendingCount)
+		require.Nil(t, all[0].Containment.OldestPendingAt)
+	})
+}
+
 // compareReputationMetric compares two reputation metrics and asserts that they are equal.
 func compareReputationMetric(t *testing.T, a, b *reputation.Metric) {
 	require.Equal(t, a.SuccessCount, b.SuccessCount)
@@ -226,11 +308,12 @@ func TestServiceStore(t *testing.T) {
 			DisqualifiedAt:     &later,
 		}
 
+		// the new disqualification is notified.
 		err = reputationService.Store(ctx, statsNew, id)
 		require.NoError(t, err)
 		amount, err = notificationsDB.UnreadAmount(ctx)
 		require.NoError(t, err)
-		require.Equal(t, amount, 2)
+		require.Equal(t, amount, 3)
 
 		statsNew = reputation.Stats{
 			SatelliteID:        id,
@@ -242,7 +325,7 @@ func TestServiceStore(t *testing.T) {
 		require.NoError(t, err)
 		amount, err = notificationsDB.UnreadAmount(ctx)
 		require.NoError(t, err)
-		require.Equal(t, amount, 2)
+		require.Equal(t, amount, 3)
 
 		statsNew = reputation.Stats{
 			SatelliteID:        id,
@@ -254,7 +337,7 @@ func TestServiceStore(t *testing.T) {
 		require.NoError(t, err)
 		amount, err = notificationsDB.UnreadAmount(ctx)
 		require.NoError(t, err)
-		require.Equal(t, amount, 3)
+		require.Equal(t, amount, 4)
 
 		later = later.AddDate(0, 1, 0)
 
@@ -267,7 +350,7 @@ func TestServiceStore(t *testing.T) {
 		require.NoError(t, err)
 		amount, err = notificationsDB.UnreadAmount(ctx)
 		require.NoError(t, err)
-		require.Equal(t, amount, 4)
+		require.Equal(t, amount, 5)
 
 		statsNew = reputation.Stats{
 			SatelliteID:        id,
@@ -278,7 +361,7 @@ func TestServiceStore(t *testing.T) {
 		require.NoError(t, err)
 		amount, err = notificationsDB.UnreadAmount(ctx)
 		require.NoError(t, err)
-		require.Equal(t, amount, 4)
+		require.Equal(t, amount, 5)
 
 		id2 := testrand.NodeID()
 
@@ -291,6 +374,20 @@ func TestServiceStore(t *testing.T) {
 		require.NoError(t, err)
 		amount, err = notificationsDB.UnreadAmount(ctx)
 		require.NoError(t, err)
-		require.Equal(t, amount, 5)
+		require.Equal(t, amount, 6)
+
+		statsNew = reputation.Stats{
+			SatelliteID:          id2,
+			OfflineSuspendedAt:   &later,
+			OfflineUnderReviewAt: &later,
+			SuspendedAt:          &later,
+		}
+
+		// the audit suspension is notified, the offline review is superseded by the suspension.
+		err = reputationService.Store(ctx, statsNew, id2)
+		require.NoError(t, err)
+		amount, err = notificationsDB.UnreadAmount(ctx)
+		require.NoError(t, err)
+		require.Equal(t, amount, 7)
 	})
 }
@@ -34,7 +34,8 @@ func NewService(log *zap.Logger, db DB, nodeID storj.NodeID, notifications *noti
 	}
 }
 
-// Store stores reputation stats into db, and notify's in case of offline suspension.
+// Store stores reputation stats into db, and notifies in case of a new disqualification,
+// suspension or offline review.
 func (s *Service) Store(ctx context.Context, stats Stats, satelliteID storj.NodeID) error {
 	rep, err := s.db.Get(ctx, satelliteID)
 	if err != nil {
@@ -46,33 +47,49 @@ func (s *Service) Store(ctx context.Context, stats Stats, satelliteID storj.Node
 		return err
 	}
 
-	if stats.DisqualifiedAt == nil && isSuspended(stats, *rep) {
-		notification := newSuspensionNotification(satelliteID, s.nodeID, *stats.OfflineSuspendedAt)
-
+	for _, notification := range s.newNotifications(satelliteID, stats, *rep) {
 		_, err = s.notifications.Receive(ctx, notification)
 		if err != nil {
-			s.log.Sugar().Errorf("Failed to receive notification", err.Error())
+			s.log.Error("Failed to receive notification", zap.Stringer("Satellite ID", satelliteID), zap.Error(err))
 		}
 	}
 
 	return nil
 }
 
-// isSuspended returns if there's new downtime suspension.
-func isSuspended(new, old Stats) bool {
-	if new.OfflineSuspendedAt == nil {
-		return false
+// newNotifications returns the notifications for the changes of the node status on the satellite.
+func (s *Service) newNotifications(satelliteID storj.NodeID, new, old Stats) (list []notifications.NewNotification) {
+	if changed(new.DisqualifiedAt, old.DisqualifiedAt) {
+		// the other statuses don't matter anymore.
+		return []notifications.NewNotification{newDisqualificationNotification(satelliteID, s.nodeID, *new.DisqualifiedAt)}
+	}
+	if new.DisqualifiedAt != nil {
+		return nil
 	}
 
-	if old.OfflineSuspendedAt == nil {
-		return true
+	if changed(new.OfflineSuspendedAt, old.OfflineSuspendedAt) {
+		list = append(list, newSuspensionNotification(satelliteID, s.nodeID, *new.OfflineSuspendedAt))
+	} else if new.OfflineSuspendedAt == nil && changed(new.OfflineUnderReviewAt, old.OfflineUnderReviewAt) {
+		list = append(list, newOfflineReviewNotification(satelliteID, s.nodeID, *new.OfflineUnderReviewAt))
+	}
+	if changed(new.SuspendedAt, old.SuspendedAt) {
+		list = append(list, newAuditSuspensionNotification(satelliteID, s.nodeID, *new.SuspendedAt))
+	}
+
+	return list
+}
+
+// changed returns whether there's a new time set.
+func changed(new, old *time.Time) bool {
+	if new == nil {
+		return false
 	}
 
-	if !old.OfflineSuspendedAt.Equal(*new.OfflineSuspendedAt) {
+	if old == nil {
 		return true
 	}
 
-	return false
+	return !old.Equal(*new)
 }
 
 // newSuspensionNotification - returns offline suspension notification.
@@ -84,3 +101,33 @@ func newSuspensionNotification(satelliteID storj.NodeID, senderID storj.NodeID,
 		Message:  "This is a reminder that your StorageNode is suspended on Satellite " + satelliteID.String(),
 	}
 }
+
+// newAuditSuspensionNotification - returns unknown audit errors suspension notification.
+func newAuditSuspensionNotification(satelliteID storj.NodeID, senderID storj.NodeID, time time.Time) (_ notifications.NewNotification) {
+	return notifications.NewNotification{
+		SenderID: senderID,
+		Type:     notifications.TypeSuspension,
+		Title:    "Your Node is suspended since " + time.String(),
+		Message:  "Your StorageNode is suspended on Satellite " + satelliteID.String() + " for failing audits with unknown errors. Check the log for the audit errors.",
+	}
+}
+
+// newOfflineReviewNotification - returns offline review notification.
+func newOfflineReviewNotification(satelliteID storj.NodeID, senderID storj.NodeID, time time.Time) (_ notifications.NewNotification) {
+	return notifications.NewNotification{
+		SenderID: senderID,
+		Type:     notifications.TypeOffline,
+		Title:    "Your Node is under review for being offline since " + time.String(),
+		Message:  "Your StorageNode is under review on Satellite " + satelliteID.String() + " for being offline. Keep the node online to avoid the suspension.",
+	}
+}
+
+// newDisqualificationNotification - returns disqualification notification.
+func newDisqualificationNotification(satelliteID storj.NodeID, senderID storj.NodeID, time time.Time) (_ notifications.NewNotification) {
+	return notifications.NewNotification{
+		SenderID: senderID,
+		Type:     notifications.TypeDisqualification,
+		Title:    "Your Node is disqualified since " + time.String(),
+		Message:  "Your StorageNode is disqualified on Satellite " + satelliteID.String() + ".",
+	}
+}
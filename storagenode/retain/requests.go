@@ -0,0 +1,116 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package retain
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/bloomfilter"
+	"storj.io/common/storj"
+)
+
+// ErrRequestStore is the error class for the persisted retain requests.
+var ErrRequestStore = errs.Class("retain request store")
+
+const (
+	// RequestsDir is the name of the directory of the persisted retain requests in the storage directory.
+	RequestsDir = "retain-requests"
+
+	requestSuffix = ".bf"
+)
+
+// RequestStore persists the retain requests as files in a directory, so that the queued and
+// running requests are processed after a restart. The satellite and the creation time of a
+// request are in the file name, and the bloom filter is the file content.
+type RequestStore struct {
+	dir string
+}
+
+// OpenRequestStore creates the request directory, when it doesn't exist.
+func OpenRequestStore(dir string) (*RequestStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, ErrRequestStore.Wrap(err)
+	}
+	return &RequestStore{dir: dir}, nil
+}
+
+func (store *RequestStore) path(req Request) string {
+	return filepath.Join(store.dir, req.SatelliteID.String()+"-"+strconv.FormatInt(req.CreatedBefore.UnixNano(), 10)+requestSuffix)
+}
+
+// Add saves the request.
+func (store *RequestStore) Add(req Request) error {
+	path := store.path(req)
+	if err := os.WriteFile(path+".tmp", req.Filter.Bytes(), 0600); err != nil {
+		return ErrRequestStore.Wrap(err)
+	}
+	return ErrRequestStore.Wrap(os.Rename(path+".tmp", path))
+}
+
+// Remove removes the request, after it has been processed or replaced by a newer request.
+func (store *RequestStore) Remove(req Request) error {
+	err := os.Remove(store.path(req))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return ErrRequestStore.Wrap(err)
+	}
+	return nil
+}
+
+// Load returns the saved requests. The files which can't be parsed are removed.
+func (store *RequestStore) Load() (requests []Request, err error) {
+	entries, err := os.ReadDir(store.dir)
+	if err != nil {
+		return nil, ErrRequestStore.Wrap(err)
+	}
+
+	var group errs.Group
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasSuffix(name, requestSuffix) {
+			continue
+		}
+		req, err := store.load(name)
+		if err != nil {
+			group.Add(os.Remove(filepath.Join(store.dir, name)))
+			continue
+		}
+		requests = append(requests, req)
+	}
+	return requests, ErrRequestStore.Wrap(group.Err())
+}
+
+func (store *RequestStore) load(name string) (Request, error) {
+	satellite, createdBefore, ok := strings.Cut(strings.TrimSuffix(name, requestSuffix), "-")
+	if !ok {
+		return Request{}, errs.New("invalid request file name %q", name)
+	}
+	satelliteID, err := storj.NodeIDFromString(satellite)
+	if err != nil {
+		return Request{}, err
+	}
+	nanos, err := strconv.ParseInt(createdBefore, 10, 64)
+	if err != nil {
+		return Request{}, err
+	}
+	data, err := os.ReadFile(filepath.Join(store.dir, name))
+	if err != nil {
+		return Request{}, err
+	}
+	filter, err := bloomfilter.NewFromBytes(data)
+	if err != nil {
+		return Request{}, err
+	}
+	return Request{
+		SatelliteID:   satelliteID,
+		CreatedBefore: time.Unix(0, nanos),
+		Filter:        filter,
+	}, nil
+}
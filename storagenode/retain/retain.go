@@ -5,7 +5,9 @@ package retain
 
 import (
 	"context"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -14,6 +16,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/common/bloomfilter"
+	"storj.io/common/errs2"
 	"storj.io/common/storj"
 	"storj.io/storj/storagenode/pieces"
 )
@@ -90,10 +93,11 @@ type Service struct {
 	log    *zap.Logger
 	config Config
 
-	cond    sync.Cond
-	queued  map[storj.NodeID]Request
-	working map[storj.NodeID]struct{}
-	group   errgroup.Group
+	cond     sync.Cond
+	queued   map[storj.NodeID]Request
+	working  map[storj.NodeID]*job
+	group    errgroup.Group
+	requests *RequestStore
 
 	closedOnce sync.Once
 	closed     chan struct{}
@@ -110,13 +114,19 @@ func NewService(log *zap.Logger, store *pieces.Store, config Config) *Service {
 
 		cond:    *sync.NewCond(&sync.Mutex{}),
 		queued:  make(map[storj.NodeID]Request),
-		working: make(map[storj.NodeID]struct{}),
+		working: make(map[storj.NodeID]*job),
 		closed:  make(chan struct{}),
 
 		store: store,
 	}
 }
 
+// SetRequestStore sets where the queued and running requests are saved, so that they are
+// processed after a restart. It must be called before Run.
+func (s *Service) SetRequestStore(requests *RequestStore) {
+	s.requests = requests
+}
+
 // Queue adds a retain request to the queue.
 // It discards a request for a satellite that already has a queued request.
 // true is returned if the request is queued and false is returned if it is discarded.
@@ -130,12 +140,56 @@ func (s *Service) Queue(req Request) bool {
 	default:
 	}
 
+	if s.requests != nil {
+		if err := s.requests.Add(req); err != nil {
+			s.log.Warn("failed to save retain request", zap.Stringer("Satellite ID", req.SatelliteID), zap.Error(err))
+		}
+		if replaced, ok := s.queued[req.SatelliteID]; ok && !replaced.CreatedBefore.Equal(req.CreatedBefore) {
+			s.removeRequest(replaced)
+		}
+	}
+
 	s.queued[req.SatelliteID] = req
 	s.cond.Broadcast()
 
 	return true
 }
 
+// loadRequests queues the requests saved before a restart, requires mutex to be held.
+// Only the newest request of a satellite is queued, since it supersedes the older ones.
+func (s *Service) loadRequests() {
+	if s.requests == nil {
+		return
+	}
+	requests, err := s.requests.Load()
+	if err != nil {
+		s.log.Warn("failed to load retain requests", zap.Error(err))
+	}
+	for _, req := range requests {
+		queued, ok := s.queued[req.SatelliteID]
+		switch {
+		case !ok:
+			s.queued[req.SatelliteID] = req
+			s.log.Info("resuming retain request", zap.Stringer("Satellite ID", req.SatelliteID), zap.Time("Created Before", req.CreatedBefore))
+		case req.CreatedBefore.After(queued.CreatedBefore):
+			s.queued[req.SatelliteID] = req
+			s.removeRequest(queued)
+		case req.CreatedBefore.Before(queued.CreatedBefore):
+			s.removeRequest(req)
+		}
+	}
+}
+
+// removeRequest removes the saved request.
+func (s *Service) removeRequest(req Request) {
+	if s.requests == nil {
+		return
+	}
+	if err := s.requests.Remove(req); err != nil {
+		s.log.Warn("failed to remove retain request", zap.Stringer("Satellite ID", req.SatelliteID), zap.Error(err))
+	}
+}
+
 // Run listens for queued retain requests and processes them as they come in.
 func (s *Service) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -153,6 +207,8 @@ func (s *Service) Run(ctx context.Context) (err error) {
 	}
 	s.started = true
 
+	s.loadRequests()
+
 	// Ensure Run doesn't start after it's closed. Then we may leak some
 	// workers.
 	select {
@@ -202,7 +258,7 @@ func (s *Service) Run(ctx context.Context) (err error) {
 				}
 
 				// Grab next item from queue.
-				request, ok := s.next()
+				job, ok := s.next()
 				if !ok {
 					// Nothing in queue, go to sleep and wait for
 					// things shutting down or next item.
@@ -218,7 +274,7 @@ func (s *Service) Run(ctx context.Context) (err error) {
 				s.cond.Broadcast()
 
 				// Run retaining process.
-				err := s.retainPieces(ctx, request)
+				err := s.retainPieces(ctx, job)
 				if err != nil {
 					s.log.Error("retain pieces failed", zap.Error(err))
 				}
@@ -226,7 +282,7 @@ func (s *Service) Run(ctx context.Context) (err error) {
 				// Mark the request as finished. Relock to maintain that
 				// at the top of the for loop the lock is held.
 				s.cond.L.Lock()
-				s.finish(request)
+				s.finish(job, err)
 				s.cond.Broadcast()
 			}
 		})
@@ -247,7 +303,7 @@ func (s *Service) Run(ctx context.Context) (err error) {
 }
 
 // next returns next item from queue, requires mutex to be held.
-func (s *Service) next() (Request, bool) {
+func (s *Service) next() (*job, bool) {
 	for id, request := range s.queued {
 		// Check whether a worker is retaining this satellite,
 		// if, yes, then try to get something else from the queue.
@@ -256,15 +312,25 @@ func (s *Service) next() (Request, bool) {
 		}
 		delete(s.queued, id)
 		// Mark this satellite as being worked on.
-		s.working[request.SatelliteID] = struct{}{}
-		return request, true
+		job := &job{request: request, startedAt: time.Now(), piecesToTrash: -1}
+		s.working[request.SatelliteID] = job
+		return job, true
 	}
-	return Request{}, false
+	return nil, false
 }
 
 // finish marks the request as finished, requires mutex to be held.
-func (s *Service) finish(request Request) {
-	delete(s.working, request.SatelliteID)
+// The saved request is kept when the processing was interrupted, so it's resumed after a restart.
+func (s *Service) finish(job *job, err error) {
+	delete(s.working, job.request.SatelliteID)
+	if errs2.IsCanceled(err) {
+		return
+	}
+	if queued, ok := s.queued[job.request.SatelliteID]; ok && queued.CreatedBefore.Equal(job.request.CreatedBefore) {
+		// the same request was queued again while it was processed.
+		return
+	}
+	s.removeRequest(job.request)
 }
 
 // Close causes any pending Run to exit and waits for any retain requests to
@@ -291,17 +357,85 @@ func (s *Service) TestWaitUntilEmpty() {
 	}
 }
 
+// JobStatus is the status of a queued or running retain request.
+type JobStatus struct {
+	SatelliteID   storj.NodeID `json:"satelliteID"`
+	CreatedBefore time.Time    `json:"createdBefore"`
+	FilterSize    int64        `json:"filterSize"`
+	// State is "queued", "walking" while the pieces to trash are collected, or "trashing".
+	State         string    `json:"state"`
+	StartedAt     time.Time `json:"startedAt,omitempty"`
+	PiecesToTrash int64     `json:"piecesToTrash"`
+	PiecesTrashed int64     `json:"piecesTrashed"`
+}
+
+// Jobs returns the status of the queued and running retain requests.
+func (s *Service) Jobs() []JobStatus {
+	s.cond.L.Lock()
+	defer s.cond.L.Unlock()
+
+	jobs := make([]JobStatus, 0, len(s.queued)+len(s.working))
+	for _, job := range s.working {
+		jobs = append(jobs, job.status())
+	}
+	for _, req := range s.queued {
+		jobs = append(jobs, JobStatus{
+			SatelliteID:   req.SatelliteID,
+			CreatedBefore: req.CreatedBefore,
+			FilterSize:    req.Filter.Size(),
+			State:         "queued",
+		})
+	}
+	sort.Slice(jobs, func(i, k int) bool {
+		return jobs[i].SatelliteID.Less(jobs[k].SatelliteID) ||
+			(jobs[i].SatelliteID == jobs[k].SatelliteID && jobs[i].CreatedBefore.Before(jobs[k].CreatedBefore))
+	})
+	return jobs
+}
+
+// job is a retain request being processed.
+type job struct {
+	request   Request
+	startedAt time.Time
+
+	// piecesToTrash is -1 until the walk completes.
+	piecesToTrash int64
+	piecesTrashed int64
+}
+
+func (job *job) setPiecesToTrash(count int64) { atomic.StoreInt64(&job.piecesToTrash, count) }
+
+func (job *job) addTrashed(count int64) { atomic.AddInt64(&job.piecesTrashed, count) }
+
+func (job *job) status() JobStatus {
+	status := JobStatus{
+		SatelliteID:   job.request.SatelliteID,
+		CreatedBefore: job.request.CreatedBefore,
+		FilterSize:    job.request.Filter.Size(),
+		State:         "walking",
+		StartedAt:     job.startedAt,
+		PiecesTrashed: atomic.LoadInt64(&job.piecesTrashed),
+	}
+	if toTrash := atomic.LoadInt64(&job.piecesToTrash); toTrash >= 0 {
+		status.State = "trashing"
+		status.PiecesToTrash = toTrash
+	}
+	return status
+}
+
 // Status returns the retain status.
 func (s *Service) Status() Status {
 	return s.config.Status
 }
 
-func (s *Service) retainPieces(ctx context.Context, req Request) (err error) {
+func (s *Service) retainPieces(ctx context.Context, job *job) (err error) {
 	// if retain status is disabled, return immediately
 	if s.config.Status == Disabled {
 		return nil
 	}
 
+	req := job.request
+
 	defer mon.Task()(&ctx, req.SatelliteID, req.CreatedBefore)(&err)
 
 	numDeleted := 0
@@ -328,6 +462,7 @@ func (s *Service) retainPieces(ctx context.Context, req Request) (err error) {
 	}
 
 	piecesToDeleteCount := len(pieceIDs)
+	job.setPiecesToTrash(int64(piecesToDeleteCount))
 
 	for i := range pieceIDs {
 		pieceID := pieceIDs[i]
@@ -343,17 +478,21 @@ func (s *Service) retainPieces(ctx context.Context, req Request) (err error) {
 					zap.Stringer("Satellite ID", satelliteID),
 					zap.Stringer("Piece ID", pieceID),
 					zap.Error(err))
+				if errs2.IsCanceled(err) {
+					return err
+				}
 				return nil
 			}
 		}
 		numDeleted++
+		job.addTrashed(1)
 	}
 	mon.IntVal("garbage_collection_pieces_count").Observe(piecesCount)
 	mon.IntVal("garbage_collection_pieces_skipped").Observe(piecesSkipped)
 	mon.IntVal("garbage_collection_pieces_to_delete_count").Observe(int64(piecesToDeleteCount))
 	mon.IntVal("garbage_collection_pieces_deleted").Observe(int64(numDeleted))
 	mon.DurationVal("garbage_collection_loop_duration").Observe(time.Now().UTC().Sub(started))
-	s.log.Info("Moved pieces to trash during retain", zap.Int("num deleted", numDeleted), zap.String("Retain Status", s.config.Status.String()))
+	s.log.Info("Moved pieces to trash during retain", zap.Int("num deleted", numDeleted), zap.String("Retain Status", s.config.Status.String()), zap.Stringer("Satellite ID", satelliteID))
 
 	return nil
 }
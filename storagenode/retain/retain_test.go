@@ -342,6 +342,83 @@ func TestRetainPieces_lazyFilewalker(t *testing.T) {
 	})
 }
 
+func TestRetainRequestsResumed(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		blobs := db.Pieces()
+		fw := pieces.NewFileWalker(log, blobs, nil)
+		store := pieces.NewStore(log, fw, nil, blobs, nil, db.PieceExpirationDB(), db.PieceSpaceUsedDB(), pieces.DefaultConfig)
+
+		satelliteID := testrand.NodeID()
+		pieceIDs := generateTestIDs(10)
+		filter := bloomfilter.NewOptimal(int64(len(pieceIDs)), 0.000000001)
+		for i, pieceID := range pieceIDs {
+			w, err := store.Writer(ctx, satelliteID, pieceID, pb.PieceHashAlgorithm_SHA256)
+			require.NoError(t, err)
+			_, err = w.Write(testrand.Bytes(memory.KiB))
+			require.NoError(t, err)
+			require.NoError(t, w.Commit(ctx, &pb.PieceHeader{CreationTime: time.Now()}))
+			if i%2 == 0 {
+				filter.Add(pieceID)
+			}
+		}
+
+		requests, err := retain.OpenRequestStore(ctx.Dir("retain-requests"))
+		require.NoError(t, err)
+		config := retain.Config{
+			Status:      retain.Enabled,
+			Concurrency: 1,
+		}
+
+		// the request is queued, but the node stops before processing it.
+		stopped := retain.NewService(log, store, config)
+		stopped.SetRequestStore(requests)
+		req := retain.Request{
+			SatelliteID:   satelliteID,
+			CreatedBefore: time.Now().Add(time.Hour),
+			Filter:        filter,
+		}
+		require.True(t, stopped.Queue(req))
+		jobs := stopped.Jobs()
+		require.Len(t, jobs, 1)
+		require.Equal(t, satelliteID, jobs[0].SatelliteID)
+		require.Equal(t, "queued", jobs[0].State)
+		require.NoError(t, stopped.Close())
+
+		saved, err := requests.Load()
+		require.NoError(t, err)
+		require.Len(t, saved, 1)
+		require.True(t, req.CreatedBefore.Equal(saved[0].CreatedBefore))
+		require.Equal(t, filter.Bytes(), saved[0].Filter.Bytes())
+
+		// the saved request is processed after the restart, and removed afterwards.
+		restarted := retain.NewService(log, store, config)
+		restarted.SetRequestStore(requests)
+
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		var group errgroup.Group
+		group.Go(func() error {
+			return restarted.Run(runCtx)
+		})
+
+		require.Eventually(t, func() bool {
+			remaining, err := getAllPieceIDs(ctx, store, satelliteID)
+			return err == nil && len(remaining) == len(pieceIDs)/2
+		}, 10*time.Second, 10*time.Millisecond)
+		restarted.TestWaitUntilEmpty()
+		require.Empty(t, restarted.Jobs())
+
+		saved, err = requests.Load()
+		require.NoError(t, err)
+		require.Empty(t, saved)
+
+		cancel()
+		err = group.Wait()
+		require.True(t, errs2.IsCanceled(err))
+	})
+}
+
 func getAllPieceIDs(ctx context.Context, store *pieces.Store, satellite storj.NodeID) (pieceIDs []storj.PieceID, err error) {
 	err = store.WalkSatellitePieces(ctx, satellite, func(pieceAccess pieces.StoredPieceAccess) error {
 		pieceIDs = append(pieceIDs, pieceAccess.PieceID())
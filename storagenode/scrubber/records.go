@@ -0,0 +1,81 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scrubber
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"storj.io/common/storj"
+)
+
+// RecordsFile is the name of the file of the corrupt pieces in the storage directory.
+const RecordsFile = "corrupt-pieces.json"
+
+// maxRecords is the number of the most recent corrupt pieces which are kept.
+const maxRecords = 1000
+
+// CorruptPiece is a piece which failed the validation.
+type CorruptPiece struct {
+	SatelliteID storj.NodeID  `json:"satelliteID"`
+	PieceID     storj.PieceID `json:"pieceID"`
+	DetectedAt  time.Time     `json:"detectedAt"`
+	Reason      string        `json:"reason"`
+	Removed     bool          `json:"removed"`
+}
+
+// Records keeps the most recent corrupt pieces in a file.
+type Records struct {
+	path string
+
+	mu     sync.Mutex
+	pieces []CorruptPiece
+}
+
+// OpenRecords loads the corrupt pieces from the file, when it exists.
+func OpenRecords(path string) (*Records, error) {
+	records := &Records{path: path}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return records, nil
+		}
+		return nil, Error.Wrap(err)
+	}
+	if err := json.Unmarshal(data, &records.pieces); err != nil {
+		return nil, Error.New("invalid records file %q: %v", path, err)
+	}
+	return records, nil
+}
+
+// Add records the corrupt piece.
+func (records *Records) Add(piece CorruptPiece) error {
+	records.mu.Lock()
+	defer records.mu.Unlock()
+
+	records.pieces = append(records.pieces, piece)
+	if len(records.pieces) > maxRecords {
+		records.pieces = append([]CorruptPiece(nil), records.pieces[len(records.pieces)-maxRecords:]...)
+	}
+
+	data, err := json.Marshal(records.pieces)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if err := os.WriteFile(records.path+".tmp", data, 0600); err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(os.Rename(records.path+".tmp", records.path))
+}
+
+// List returns the recorded corrupt pieces, oldest first.
+func (records *Records) List() []CorruptPiece {
+	records.mu.Lock()
+	defer records.mu.Unlock()
+	return append([]CorruptPiece(nil), records.pieces...)
+}
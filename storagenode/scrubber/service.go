@@ -0,0 +1,266 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package scrubber implements the validation of the stored pieces on the storage node.
+package scrubber
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"os"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/storagenode/pieces"
+)
+
+var (
+	// Error is the error class for the scrubber.
+	Error = errs.Class("scrubber")
+
+	mon = monkit.Package()
+)
+
+// readChunkSize is the size of the reads of the validated pieces.
+const readChunkSize = 32 * memory.KiB
+
+// Config defines parameters for the piece scrubber.
+type Config struct {
+	Enabled        bool          `help:"periodically validate the hashes of a sample of the stored pieces" default:"false"`
+	Interval       time.Duration `help:"how frequently a sample of the stored pieces is validated" default:"24h0m0s"`
+	PiecesPerRun   int           `help:"number of pieces of each satellite validated in a run" default:"1000"`
+	BytesPerSecond memory.Size   `help:"maximum rate of reading the validated pieces, 0 means unlimited" default:"4MiB"`
+	RemoveCorrupt  bool          `help:"move the corrupt pieces to the trash" default:"true"`
+}
+
+// Service validates a random sample of the stored pieces against the hashes in their
+// headers, records the corrupt pieces and optionally trashes them, before the audits find them.
+//
+// architecture: Chore
+type Service struct {
+	log     *zap.Logger
+	store   *pieces.Store
+	records *Records
+	config  Config
+
+	Loop *sync2.Cycle
+}
+
+// NewService creates a new piece scrubber.
+func NewService(log *zap.Logger, store *pieces.Store, records *Records, config Config) *Service {
+	return &Service{
+		log:     log,
+		store:   store,
+		records: records,
+		config:  config,
+		Loop:    sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the scrubber.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// don't add to the load of the startup.
+	service.Loop.SetDelayStart()
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := service.Scrub(ctx); err != nil {
+			service.log.Error("scrubbing failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the scrubber.
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// Scrub validates a sample of the pieces of every satellite.
+func (service *Service) Scrub(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satellites, err := service.store.StoringSatellites(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var group errs.Group
+	for _, satelliteID := range satellites {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		group.Add(service.ScrubSatellite(ctx, satelliteID))
+	}
+	return group.Err()
+}
+
+// ScrubSatellite validates a sample of the pieces of the satellite.
+func (service *Service) ScrubSatellite(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	sample, err := service.sample(ctx, satelliteID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var limiter *rate.Limiter
+	if service.config.BytesPerSecond > 0 {
+		limiter = rate.NewLimiter(rate.Limit(service.config.BytesPerSecond), readChunkSize.Int())
+	}
+
+	var corrupt int
+	for _, pieceID := range sample {
+		reason, err := service.validate(ctx, satelliteID, pieceID, limiter)
+		if err != nil {
+			if errs.Is(err, context.Canceled) {
+				return err
+			}
+			service.log.Warn("failed to validate piece", zap.Stringer("Satellite ID", satelliteID), zap.Stringer("Piece ID", pieceID), zap.Error(err))
+			continue
+		}
+		if reason == "" {
+			continue
+		}
+		corrupt++
+		service.corrupt(ctx, satelliteID, pieceID, reason)
+	}
+
+	mon.IntVal("scrubbed_pieces").Observe(int64(len(sample)))
+	mon.IntVal("corrupt_pieces").Observe(int64(corrupt))
+	service.log.Info("scrubbed pieces",
+		zap.Stringer("Satellite ID", satelliteID),
+		zap.Int("validated", len(sample)),
+		zap.Int("corrupt", corrupt))
+	return nil
+}
+
+// sample selects PiecesPerRun pieces of the satellite uniformly at random.
+func (service *Service) sample(ctx context.Context, satelliteID storj.NodeID) (sample []storj.PieceID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var walked int
+	err = service.store.WalkSatellitePieces(ctx, satelliteID, func(access pieces.StoredPieceAccess) error {
+		walked++
+		if len(sample) < service.config.PiecesPerRun {
+			sample = append(sample, access.PieceID())
+		} else if i := rand.Intn(walked); i < len(sample) {
+			sample[i] = access.PieceID()
+		}
+		return nil
+	})
+	return sample, err
+}
+
+// validate checks the content of the piece against the hash in its header. It returns the
+// reason why the piece is corrupt, or an empty string when the piece is valid or doesn't exist
+// anymore. Only a hash mismatch or a header, which can't be decoded, means the piece is corrupt;
+// the failures to read the piece or its piece info are returned as errors.
+func (service *Service) validate(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, limiter *rate.Limiter) (reason string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reader, err := service.store.Reader(ctx, satelliteID, pieceID)
+	if err != nil {
+		if errs.Is(err, os.ErrNotExist) {
+			return "", nil
+		}
+		return "", err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	pieceHash, _, err := service.store.GetHashAndLimit(ctx, satelliteID, pieceID, reader)
+	if err != nil {
+		if pieces.ErrInvalidPieceHeader.Has(err) {
+			return err.Error(), nil
+		}
+		return "", err
+	}
+
+	var content io.Reader = reader
+	if limiter != nil {
+		content = &limitedReader{ctx: ctx, reader: reader, limiter: limiter}
+	}
+	return checkHash(ctx, content, pieceHash)
+}
+
+// checkHash checks the content against the piece hash. It returns the reason why the content
+// is corrupt, or an empty string when it's valid.
+func checkHash(ctx context.Context, content io.Reader, pieceHash pb.PieceHash) (reason string, err error) {
+	hash := pb.NewHashFromAlgorithm(pieceHash.HashAlgorithm)
+	if _, err := io.Copy(hash, content); err != nil {
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		return "", Error.New("read failed: %w", err)
+	}
+
+	if !bytes.Equal(hash.Sum(nil), pieceHash.Hash) {
+		return "hash mismatch", nil
+	}
+	return "", nil
+}
+
+// corrupt records the corrupt piece, and trashes it when RemoveCorrupt is set.
+func (service *Service) corrupt(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, reason string) {
+	removed := false
+	if service.config.RemoveCorrupt {
+		if err := service.store.Trash(ctx, satelliteID, pieceID); err != nil {
+			service.log.Error("failed to trash corrupt piece", zap.Stringer("Satellite ID", satelliteID), zap.Stringer("Piece ID", pieceID), zap.Error(err))
+		} else {
+			removed = true
+		}
+	}
+
+	service.log.Warn("corrupt piece",
+		zap.Stringer("Satellite ID", satelliteID),
+		zap.Stringer("Piece ID", pieceID),
+		zap.String("reason", reason),
+		zap.Bool("trashed", removed))
+
+	err := service.records.Add(CorruptPiece{
+		SatelliteID: satelliteID,
+		PieceID:     pieceID,
+		DetectedAt:  time.Now().UTC(),
+		Reason:      reason,
+		Removed:     removed,
+	})
+	if err != nil {
+		service.log.Error("failed to record corrupt piece", zap.Stringer("Piece ID", pieceID), zap.Error(err))
+	}
+}
+
+// CorruptPieces returns the recorded corrupt pieces.
+func (service *Service) CorruptPieces() []CorruptPiece {
+	return service.records.List()
+}
+
+// limitedReader limits the rate of reading with the limiter.
+type limitedReader struct {
+	ctx     context.Context
+	reader  io.Reader
+	limiter *rate.Limiter
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+	if len(p) > r.limiter.Burst() {
+		p = p[:r.limiter.Burst()]
+	}
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		if waitErr := r.limiter.WaitN(r.ctx, n); waitErr != nil {
+			return n, waitErr
+		}
+	}
+	return n, err
+}
@@ -0,0 +1,48 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scrubber
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+	"storj.io/common/testrand"
+)
+
+func TestCheckHash(t *testing.T) {
+	ctx := context.Background()
+	data := testrand.Bytes(1024)
+
+	hash := pb.NewHashFromAlgorithm(pb.PieceHashAlgorithm_SHA256)
+	_, err := hash.Write(data)
+	require.NoError(t, err)
+	pieceHash := pb.PieceHash{Hash: hash.Sum(nil), HashAlgorithm: pb.PieceHashAlgorithm_SHA256}
+
+	reason, err := checkHash(ctx, bytes.NewReader(data), pieceHash)
+	require.NoError(t, err)
+	require.Empty(t, reason)
+
+	reason, err = checkHash(ctx, bytes.NewReader(data[1:]), pieceHash)
+	require.NoError(t, err)
+	require.Equal(t, "hash mismatch", reason)
+
+	// a failed read doesn't mean the piece is corrupt.
+	failing := io.MultiReader(bytes.NewReader(data[:512]), &failingReader{err: errors.New("input/output error")})
+	reason, err = checkHash(ctx, failing, pieceHash)
+	require.Error(t, err)
+	require.Empty(t, reason)
+}
+
+// failingReader fails every read with the error.
+type failingReader struct {
+	err error
+}
+
+func (r *failingReader) Read(p []byte) (int, error) { return 0, r.err }
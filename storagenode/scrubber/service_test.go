@@ -0,0 +1,192 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scrubber_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/scrubber"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestScrubber(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		blobs, err := filestore.NewAt(log, ctx.Dir("pieces"), filestore.DefaultConfig)
+		require.NoError(t, err)
+		defer ctx.Check(blobs.Close)
+
+		store := pieces.NewStore(log, pieces.NewFileWalker(log, blobs, db.V0PieceInfo()), nil, blobs, db.V0PieceInfo(), db.PieceExpirationDB(), nil, pieces.DefaultConfig)
+
+		satelliteID := testrand.NodeID()
+		var pieceIDs []storj.PieceID
+		for i := 0; i < 5; i++ {
+			pieceID := testrand.PieceID()
+			w, err := store.Writer(ctx, satelliteID, pieceID, pb.PieceHashAlgorithm_SHA256)
+			require.NoError(t, err)
+			_, err = w.Write(testrand.Bytes(10 * memory.KiB))
+			require.NoError(t, err)
+			require.NoError(t, w.Commit(ctx, &pb.PieceHeader{
+				Hash:          w.Hash(),
+				HashAlgorithm: pb.PieceHashAlgorithm_SHA256,
+				CreationTime:  time.Now(),
+			}))
+			pieceIDs = append(pieceIDs, pieceID)
+		}
+
+		// flip a byte of the content of a piece.
+		info, err := store.Stat(ctx, satelliteID, pieceIDs[2])
+		require.NoError(t, err)
+		path, err := info.FullPath(ctx)
+		require.NoError(t, err)
+		file, err := os.OpenFile(path, os.O_RDWR, 0)
+		require.NoError(t, err)
+		data := make([]byte, 1)
+		_, err = file.ReadAt(data, pieces.V1PieceHeaderReservedArea+100)
+		require.NoError(t, err)
+		data[0] ^= 0xFF
+		_, err = file.WriteAt(data, pieces.V1PieceHeaderReservedArea+100)
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+
+		recordsPath := filepath.Join(ctx.Dir("scrubber"), scrubber.RecordsFile)
+		records, err := scrubber.OpenRecords(recordsPath)
+		require.NoError(t, err)
+
+		service := scrubber.NewService(log, store, records, scrubber.Config{
+			Interval:       time.Hour,
+			PiecesPerRun:   10,
+			BytesPerSecond: memory.MiB,
+			RemoveCorrupt:  true,
+		})
+		defer ctx.Check(service.Close)
+
+		require.NoError(t, service.Scrub(ctx))
+
+		corrupt := service.CorruptPieces()
+		require.Len(t, corrupt, 1)
+		require.Equal(t, satelliteID, corrupt[0].SatelliteID)
+		require.Equal(t, pieceIDs[2], corrupt[0].PieceID)
+		require.Equal(t, "hash mismatch", corrupt[0].Reason)
+		require.True(t, corrupt[0].Removed)
+
+		// the corrupt piece is in the trash.
+		_, err = store.Stat(ctx, satelliteID, pieceIDs[2])
+		require.Error(t, err)
+		for _, pieceID := range append(pieceIDs[:2:2], pieceIDs[3:]...) {
+			_, err = store.Stat(ctx, satelliteID, pieceID)
+			require.NoError(t, err)
+		}
+
+		// the records are kept after reopening.
+		reopened, err := scrubber.OpenRecords(recordsPath)
+		require.NoError(t, err)
+		require.Equal(t, corrupt, reopened.List())
+
+		// the valid pieces stay valid.
+		require.NoError(t, service.Scrub(ctx))
+		require.Len(t, service.CorruptPieces(), 1)
+	})
+}
+
+func TestScrubberHeaders(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		blobs, err := filestore.NewAt(log, ctx.Dir("pieces"), filestore.DefaultConfig)
+		require.NoError(t, err)
+		defer ctx.Check(blobs.Close)
+
+		v0PieceInfo := &failingPieceInfo{V0PieceInfoDB: db.V0PieceInfo()}
+		store := pieces.NewStore(log, pieces.NewFileWalker(log, blobs, v0PieceInfo), nil, blobs, v0PieceInfo, db.PieceExpirationDB(), nil, pieces.DefaultConfig)
+		satelliteID := testrand.NodeID()
+
+		// a v0 piece, whose piece info can't be read, can't be validated, but it isn't corrupt.
+		v0PieceID := testrand.PieceID()
+		w, err := pieces.StoreForTest{Store: store}.WriterForFormatVersion(ctx, satelliteID, v0PieceID, filestore.FormatV0, pb.PieceHashAlgorithm_SHA256)
+		require.NoError(t, err)
+		_, err = w.Write(testrand.Bytes(10 * memory.KiB))
+		require.NoError(t, err)
+		require.NoError(t, w.Commit(ctx, &pb.PieceHeader{}))
+		require.NoError(t, db.V0PieceInfo().(pieces.V0PieceInfoDBForTest).Add(ctx, &pieces.Info{
+			SatelliteID:     satelliteID,
+			PieceID:         v0PieceID,
+			PieceSize:       w.Size(),
+			PieceCreation:   time.Now(),
+			OrderLimit:      &pb.OrderLimit{},
+			UplinkPieceHash: &pb.PieceHash{Hash: w.Hash()},
+		}))
+
+		// a piece with a header, which can't be decoded, is corrupt.
+		pieceID := testrand.PieceID()
+		w, err = store.Writer(ctx, satelliteID, pieceID, pb.PieceHashAlgorithm_SHA256)
+		require.NoError(t, err)
+		_, err = w.Write(testrand.Bytes(10 * memory.KiB))
+		require.NoError(t, err)
+		require.NoError(t, w.Commit(ctx, &pb.PieceHeader{
+			Hash:          w.Hash(),
+			HashAlgorithm: pb.PieceHashAlgorithm_SHA256,
+			CreationTime:  time.Now(),
+		}))
+
+		info, err := store.Stat(ctx, satelliteID, pieceID)
+		require.NoError(t, err)
+		path, err := info.FullPath(ctx)
+		require.NoError(t, err)
+		file, err := os.OpenFile(path, os.O_RDWR, 0)
+		require.NoError(t, err)
+		_, err = file.WriteAt([]byte{0xFF, 0xFF}, 0)
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+
+		records, err := scrubber.OpenRecords(filepath.Join(ctx.Dir("scrubber"), scrubber.RecordsFile))
+		require.NoError(t, err)
+
+		service := scrubber.NewService(log, store, records, scrubber.Config{
+			Interval:      time.Hour,
+			PiecesPerRun:  10,
+			RemoveCorrupt: true,
+		})
+		defer ctx.Check(service.Close)
+
+		require.NoError(t, service.Scrub(ctx))
+		require.Equal(t, 1, v0PieceInfo.failed)
+
+		corrupt := service.CorruptPieces()
+		require.Len(t, corrupt, 1)
+		require.Equal(t, pieceID, corrupt[0].PieceID)
+		require.Contains(t, corrupt[0].Reason, "invalid piece header")
+		require.True(t, corrupt[0].Removed)
+
+		// the v0 piece is kept.
+		_, err = store.Stat(ctx, satelliteID, v0PieceID)
+		require.NoError(t, err)
+	})
+}
+
+// failingPieceInfo fails to get the piece info.
+type failingPieceInfo struct {
+	pieces.V0PieceInfoDB
+	failed int
+}
+
+func (db *failingPieceInfo) Get(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) (*pieces.Info, error) {
+	db.failed++
+	return nil, errs.New("database is locked")
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagemigration
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+// CutoverMarker is the name of the file in the storage directory, which tells the running
+// node to stop accepting uploads, so that the last migration pass copies all the pieces.
+// The marker contains the blob store of the node, to which it applies.
+const CutoverMarker = "storage-migration-cutover"
+
+// Backend describes the blob store of the pieces opened with the config.
+func Backend(config storagenodedb.Config) string {
+	var backend string
+	if config.S3.Enabled() {
+		backend = "s3:" + config.S3.Endpoint + "/" + config.S3.Bucket + "/" + config.S3.Prefix
+	} else {
+		backend = "dirs:" + strings.Join(append([]string{config.Pieces}, config.AdditionalPieces...), ",")
+		if config.Packfiles.Enabled {
+			backend += "+packfiles"
+		}
+	}
+	return backend
+}
+
+// MarkCutover creates the cutover marker for the blob store in the storage directory.
+func MarkCutover(storageDir, backend string) error {
+	return Error.Wrap(os.WriteFile(filepath.Join(storageDir, CutoverMarker), []byte(backend), 0644))
+}
+
+// UnmarkCutover removes the cutover marker from the storage directory.
+func UnmarkCutover(storageDir string) error {
+	err := os.Remove(filepath.Join(storageDir, CutoverMarker))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return Error.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package storagemigration implements copying the pieces between the blob stores, e.g. from
+// a storage directory to another disk, to pack files or to an object storage.
+package storagemigration
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/pieces"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for storage migration errors.
+	Error = errs.Class("storage migration")
+)
+
+const (
+	// checkpointKind is the kind of the checkpoints of the migration walks.
+	checkpointKind = "migrate"
+	// copyBurst is the largest chunk read at once, when the copying is rate limited.
+	copyBurst = 256 * memory.KiB
+)
+
+// Config defines how the pieces are copied.
+type Config struct {
+	Verify         bool        `help:"read every copied piece back from the target and compare its checksum" default:"true"`
+	BytesPerSecond memory.Size `help:"maximum rate of copying the pieces, 0 means unlimited" default:"0B"`
+	Restart        bool        `help:"ignore the checkpoints of an interrupted migration and start from the beginning" default:"false"`
+}
+
+// Stats are the results of a migration pass.
+type Stats struct {
+	Copied      int64
+	CopiedBytes int64
+	// Existing are the pieces, which were already copied by a previous pass.
+	Existing int64
+	// Skipped are the pieces with the V0 storage format, which can't be migrated, because
+	// the piece info database refers to them.
+	Skipped int64
+}
+
+// Migrator copies all the pieces from one blob store to another.
+//
+// The source may be used by the running node during the migration. The pieces uploaded
+// meanwhile are copied by the next pass, which skips the already copied pieces, and the
+// pieces deleted meanwhile are left in the target until garbage collection removes them.
+type Migrator struct {
+	log         *zap.Logger
+	from, to    blobstore.Blobs
+	checkpoints *pieces.WalkCheckpoints
+	config      Config
+	limiter     *rate.Limiter
+}
+
+// NewMigrator creates a migrator from one blob store to another. The progress is saved
+// to checkpoints, so that an interrupted migration can be resumed.
+func NewMigrator(log *zap.Logger, from, to blobstore.Blobs, checkpoints *pieces.WalkCheckpoints, config Config) *Migrator {
+	migrator := &Migrator{
+		log:         log,
+		from:        from,
+		to:          to,
+		checkpoints: checkpoints,
+		config:      config,
+	}
+	if config.BytesPerSecond > 0 {
+		migrator.limiter = rate.NewLimiter(rate.Limit(config.BytesPerSecond), copyBurst.Int())
+	}
+	return migrator
+}
+
+// Migrate copies the pieces, which aren't in the target yet. The trash isn't copied.
+func (migrator *Migrator) Migrate(ctx context.Context) (stats Stats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	namespaces, err := migrator.from.ListNamespaces(ctx)
+	if err != nil {
+		return stats, Error.Wrap(err)
+	}
+
+	for _, namespace := range namespaces {
+		satelliteID, err := storj.NodeIDFromBytes(namespace)
+		if err != nil {
+			migrator.log.Warn("skipping unknown namespace", zap.Binary("Namespace", namespace))
+			continue
+		}
+		if err := migrator.migrateSatellite(ctx, satelliteID, &stats); err != nil {
+			return stats, err
+		}
+	}
+	return stats, nil
+}
+
+// migrateSatellite copies the pieces of the satellite, resuming from the checkpoint.
+func (migrator *Migrator) migrateSatellite(ctx context.Context, satelliteID storj.NodeID, stats *Stats) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	startAfter := ""
+	if !migrator.config.Restart {
+		checkpoint, ok, err := migrator.checkpoints.Get(checkpointKind, satelliteID)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if ok {
+			startAfter = checkpoint.LastPrefix
+			migrator.log.Info("resuming from checkpoint", zap.Stringer("Satellite ID", satelliteID), zap.String("Prefix", startAfter))
+		}
+	}
+
+	err = migrator.from.WalkNamespaceFrom(ctx, satelliteID.Bytes(), startAfter, func(info blobstore.BlobInfo) error {
+		if info.StorageFormatVersion() < filestore.FormatV1 {
+			stats.Skipped++
+			return nil
+		}
+
+		size, existing, err := migrator.copyBlob(ctx, info)
+		if err != nil {
+			return err
+		}
+		if existing {
+			stats.Existing++
+			return nil
+		}
+		stats.Copied++
+		stats.CopiedBytes += size
+		return nil
+	}, func(keyPrefix string) error {
+		migrator.log.Debug("copied key prefix", zap.Stringer("Satellite ID", satelliteID), zap.String("Prefix", keyPrefix), zap.Int64("Copied", stats.Copied))
+		return migrator.checkpoints.Set(checkpointKind, satelliteID, pieces.WalkCheckpoint{
+			LastPrefix: keyPrefix,
+			UpdatedAt:  time.Now(),
+		})
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	// the next pass walks all the pieces again, to find the ones uploaded meanwhile.
+	return Error.Wrap(migrator.checkpoints.Delete(checkpointKind, satelliteID))
+}
+
+// copyBlob copies the blob to the target, unless it's already there, and verifies its
+// checksum. existing is true, when the blob was already copied.
+func (migrator *Migrator) copyBlob(ctx context.Context, info blobstore.BlobInfo) (size int64, existing bool, err error) {
+	ref := info.BlobRef()
+	stat, err := info.Stat(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if copied, err := migrator.to.Stat(ctx, ref); err == nil {
+		if copiedStat, err := copied.Stat(ctx); err == nil && copiedStat.Size() == stat.Size() {
+			return 0, true, nil
+		}
+		// an incomplete copy is replaced.
+		if err := migrator.to.Delete(ctx, ref); err != nil {
+			return 0, false, err
+		}
+	}
+
+	reader, err := migrator.from.OpenWithStorageFormat(ctx, ref, info.StorageFormatVersion())
+	if err != nil {
+		return 0, false, err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	writer, err := migrator.to.Create(ctx, ref, stat.Size())
+	if err != nil {
+		return 0, false, err
+	}
+
+	hash := sha256.New()
+	var source io.Reader = io.TeeReader(reader, hash)
+	if migrator.limiter != nil {
+		source = &limitedReader{ctx: ctx, reader: source, limiter: migrator.limiter}
+	}
+	size, err = io.Copy(writer, source)
+	if err != nil {
+		return 0, false, errs.Combine(err, writer.Cancel(ctx))
+	}
+	if err := writer.Commit(ctx); err != nil {
+		return 0, false, err
+	}
+
+	if migrator.config.Verify {
+		if err := migrator.verify(ctx, ref, hash.Sum(nil)); err != nil {
+			return 0, false, errs.Combine(err, migrator.to.Delete(ctx, ref))
+		}
+	}
+
+	// keep the modification time, which garbage collection compares with the bloom filter
+	// creation time. it's best effort, because not every blob store has files.
+	if copied, err := migrator.to.Stat(ctx, ref); err == nil {
+		if path, err := copied.FullPath(ctx); err == nil {
+			_ = os.Chtimes(path, stat.ModTime(), stat.ModTime())
+		}
+	}
+
+	return size, false, nil
+}
+
+// verify reads the copied blob and compares its checksum.
+func (migrator *Migrator) verify(ctx context.Context, ref blobstore.BlobRef, expected []byte) (err error) {
+	reader, err := migrator.to.Open(ctx, ref)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, reader); err != nil {
+		return err
+	}
+	if !bytes.Equal(hash.Sum(nil), expected) {
+		mon.Event("storage_migration_checksum_mismatch")
+		return Error.New("checksum mismatch of the copied piece %x in namespace %x", ref.Key, ref.Namespace)
+	}
+	return nil
+}
+
+// limitedReader limits the rate of reading with the limiter.
+type limitedReader struct {
+	ctx     context.Context
+	reader  io.Reader
+	limiter *rate.Limiter
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+	if len(p) > r.limiter.Burst() {
+		p = p[:r.limiter.Burst()]
+	}
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		if waitErr := r.limiter.WaitN(r.ctx, n); waitErr != nil {
+			return n, waitErr
+		}
+	}
+	return n, err
+}
@@ -0,0 +1,115 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagemigration_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagemigration"
+)
+
+func writeBlob(ctx context.Context, t *testing.T, store blobstore.Blobs, ref blobstore.BlobRef, data []byte) {
+	writer, err := store.Create(ctx, ref, int64(len(data)))
+	require.NoError(t, err)
+	_, err = writer.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit(ctx))
+}
+
+func readBlob(ctx context.Context, t *testing.T, store blobstore.Blobs, ref blobstore.BlobRef) []byte {
+	reader, err := store.Open(ctx, ref)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, reader.Close()) }()
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	return data
+}
+
+func TestMigrator(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+	log := zaptest.NewLogger(t)
+
+	from, err := filestore.NewAt(log, ctx.Dir("from"), filestore.DefaultConfig)
+	require.NoError(t, err)
+	defer ctx.Check(from.Close)
+	to, err := filestore.NewAt(log, ctx.Dir("to"), filestore.DefaultConfig)
+	require.NoError(t, err)
+	defer ctx.Check(to.Close)
+	checkpoints, err := pieces.OpenWalkCheckpoints(ctx.Dir("checkpoints"))
+	require.NoError(t, err)
+
+	namespace := testrand.NodeID().Bytes()
+	blobs := map[string][]byte{}
+	var refs []blobstore.BlobRef
+	for i := 0; i < 20; i++ {
+		ref := blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+		data := testrand.BytesInt(100 + i)
+		writeBlob(ctx, t, from, ref, data)
+		blobs[string(ref.Key)] = data
+		refs = append(refs, ref)
+	}
+
+	// an incomplete copy of an interrupted migration is replaced.
+	writeBlob(ctx, t, to, refs[0], blobs[string(refs[0].Key)][:10])
+
+	migrator := storagemigration.NewMigrator(log, from, to, checkpoints, storagemigration.Config{Verify: true})
+	stats, err := migrator.Migrate(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, len(refs), stats.Copied)
+	require.Zero(t, stats.Existing)
+	require.Zero(t, stats.Skipped)
+
+	for _, ref := range refs {
+		// the source is kept for the running node.
+		require.Equal(t, blobs[string(ref.Key)], readBlob(ctx, t, from, ref))
+		require.Equal(t, blobs[string(ref.Key)], readBlob(ctx, t, to, ref))
+	}
+
+	before, err := from.Stat(ctx, refs[1])
+	require.NoError(t, err)
+	beforeStat, err := before.Stat(ctx)
+	require.NoError(t, err)
+	after, err := to.Stat(ctx, refs[1])
+	require.NoError(t, err)
+	afterStat, err := after.Stat(ctx)
+	require.NoError(t, err)
+	require.True(t, beforeStat.ModTime().Equal(afterStat.ModTime()))
+
+	// the next pass only copies the pieces uploaded meanwhile.
+	uploaded := blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	writeBlob(ctx, t, from, uploaded, testrand.BytesInt(1000))
+
+	migrator = storagemigration.NewMigrator(log, from, to, checkpoints, storagemigration.Config{Verify: true, BytesPerSecond: 1 << 20})
+	stats, err = migrator.Migrate(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, stats.Copied)
+	require.EqualValues(t, 1000, stats.CopiedBytes)
+	require.EqualValues(t, len(refs), stats.Existing)
+}
+
+func TestCutoverMarker(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	dir := ctx.Dir("storage")
+	require.NoError(t, storagemigration.MarkCutover(dir, "dirs:/storage"))
+	require.FileExists(t, ctx.File("storage", storagemigration.CutoverMarker))
+
+	require.NoError(t, storagemigration.UnmarkCutover(dir))
+	require.NoFileExists(t, ctx.File("storage", storagemigration.CutoverMarker))
+
+	// removing a missing marker isn't an error.
+	require.NoError(t, storagemigration.UnmarkCutover(dir))
+}
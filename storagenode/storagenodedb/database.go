@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
@@ -27,6 +28,10 @@ import (
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/blobstore"
 	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/blobstore/multistore"
+	"storj.io/storj/storagenode/blobstore/packstore"
+	"storj.io/storj/storagenode/blobstore/s3store"
+	"storj.io/storj/storagenode/blobstore/walstore"
 	"storj.io/storj/storagenode/notifications"
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/payouts"
@@ -84,6 +89,12 @@ type Config struct {
 	Driver    string // if unset, uses sqlite3
 	Pieces    string
 	Filestore filestore.Config
+	S3        s3store.Config
+	Packfiles packstore.Config
+	PieceWAL  walstore.Config
+
+	// AdditionalPieces are the directories where pieces are stored in addition to Pieces.
+	AdditionalPieces []string
 
 	TestingDisableWAL bool
 }
@@ -100,6 +111,8 @@ func (config Config) LazyFilewalkerConfig() lazyfilewalker.Config {
 		Pieces:          config.Pieces,
 		Filestore:       config.Filestore,
 		LowerIOPriority: true,
+
+		AdditionalPieces: config.AdditionalPieces,
 	}
 }
 
@@ -130,15 +143,89 @@ type DB struct {
 	SQLDBs map[string]DBContainer
 }
 
+// OpenPieces opens the blob store of the pieces without the databases, e.g. to migrate the
+// pieces between the blob stores. The storage directories are created when create is set.
+func OpenPieces(log *zap.Logger, config Config, create bool) (blobstore.Blobs, error) {
+	return openPieces(log, config, create)
+}
+
+// openPieces opens the blob store of the pieces, which is the object storage when it's
+// configured, and the pieces directories otherwise.
+func openPieces(log *zap.Logger, config Config, create bool) (_ blobstore.Blobs, err error) {
+	if config.S3.Enabled() {
+		s3config := config.S3
+		if s3config.CacheDir == "" {
+			s3config.CacheDir = filepath.Join(config.Pieces, "s3-cache")
+		}
+		return s3store.New(log, s3config)
+	}
+
+	var pieces blobstore.Blobs
+	if len(config.AdditionalPieces) == 0 {
+		pieces, err = openPiecesDir(log, config.Pieces, config.Filestore, create)
+	} else {
+		pieces, err = openPiecesDirs(log, config, create)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Packfiles.Enabled {
+		packed, err := packstore.New(log.Named("packstore"), pieces, filepath.Join(config.Pieces, "packs"), config.Packfiles)
+		if err != nil {
+			return nil, errs.Combine(err, pieces.Close())
+		}
+		pieces = packed
+	}
+
+	if config.PieceWAL.Enabled {
+		logged, err := walstore.New(log.Named("walstore"), pieces, filepath.Join(config.Pieces, "wal"), config.PieceWAL)
+		if err != nil {
+			return nil, errs.Combine(err, pieces.Close())
+		}
+		pieces = logged
+	}
+	return pieces, nil
+}
+
+// openPiecesDirs opens the blob stores of all the pieces directories.
+func openPiecesDirs(log *zap.Logger, config Config, create bool) (blobstore.Blobs, error) {
+	var dirs []multistore.Dir
+	for _, path := range append([]string{config.Pieces}, config.AdditionalPieces...) {
+		blobs, err := openPiecesDir(log, path, config.Filestore, create)
+		if err != nil {
+			for _, dir := range dirs {
+				err = errs.Combine(err, dir.Blobs.Close())
+			}
+			return nil, err
+		}
+		dirs = append(dirs, multistore.Dir{Path: path, Blobs: blobs})
+	}
+	return multistore.New(log.Named("multistore"), dirs), nil
+}
+
+// openPiecesDir opens the blob store of a pieces directory.
+func openPiecesDir(log *zap.Logger, path string, config filestore.Config, create bool) (blobstore.Blobs, error) {
+	var piecesDir *filestore.Dir
+	var err error
+	if create {
+		piecesDir, err = filestore.NewDir(log, path)
+	} else {
+		piecesDir, err = filestore.OpenDir(log, path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return filestore.New(log, piecesDir, config), nil
+}
+
 // OpenNew creates a new master database for storage node.
 func OpenNew(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
-	piecesDir, err := filestore.NewDir(log, config.Pieces)
+	pieces, err := openPieces(log, config, true)
 	if err != nil {
 		return nil, err
 	}
 
-	pieces := filestore.New(log, piecesDir, config.Filestore)
-
 	deprecatedInfoDB := &deprecatedInfoDB{}
 	v0PieceInfoDB := &v0PieceInfoDB{}
 	bandwidthDB := &bandwidthDB{}
@@ -200,13 +287,11 @@ func OpenNew(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
 
 // OpenExisting opens an existing master database for storage node.
 func OpenExisting(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
-	piecesDir, err := filestore.OpenDir(log, config.Pieces)
+	pieces, err := openPieces(log, config, false)
 	if err != nil {
 		return nil, err
 	}
 
-	pieces := filestore.New(log, piecesDir, config.Filestore)
-
 	deprecatedInfoDB := &deprecatedInfoDB{}
 	v0PieceInfoDB := &v0PieceInfoDB{}
 	bandwidthDB := &bandwidthDB{}
@@ -478,9 +563,62 @@ func (db *DB) preflight(ctx context.Context, dbName string, dbContainer DBContai
 	return nil
 }
 
+// IntegrityCheckResult is the result of the integrity check of a database.
+type IntegrityCheckResult struct {
+	Database string   `json:"database"`
+	OK       bool     `json:"ok"`
+	Problems []string `json:"problems,omitempty"`
+}
+
+// IntegrityCheck runs the quick integrity check of sqlite on the databases, without modifying them.
+func (db *DB) IntegrityCheck(ctx context.Context) (_ []IntegrityCheckResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbNames := make([]string, 0, len(db.SQLDBs))
+	for dbName := range db.SQLDBs {
+		dbNames = append(dbNames, dbName)
+	}
+	sort.Strings(dbNames)
+
+	results := make([]IntegrityCheckResult, 0, len(dbNames))
+	for _, dbName := range dbNames {
+		problems, err := integrityCheck(ctx, db.SQLDBs[dbName].GetDB())
+		if err != nil {
+			return nil, ErrDatabase.New("database %q: integrity check failed: %w", dbName, err)
+		}
+		results = append(results, IntegrityCheckResult{
+			Database: dbName,
+			OK:       len(problems) == 0,
+			Problems: problems,
+		})
+	}
+	return results, nil
+}
+
+// integrityCheck returns the problems found by the quick check of sqlite.
+func integrityCheck(ctx context.Context, sqlDB tagsql.DB) (problems []string, err error) {
+	rows, err := sqlDB.QueryContext(ctx, "PRAGMA quick_check")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var message string
+		if err := rows.Scan(&message); err != nil {
+			return nil, err
+		}
+		// the check returns a single "ok" row, when no problems were found.
+		if message != "ok" {
+			problems = append(problems, message)
+		}
+	}
+	return problems, rows.Err()
+}
+
 // Close closes any resources.
 func (db *DB) Close() error {
-	return db.closeDatabases()
+	return errs.Combine(db.closeDatabases(), db.pieces.Close())
 }
 
 // closeDatabases closes all the SQLite database connections and removes them from the associated maps.
@@ -2061,6 +2199,32 @@ func (db *DB) Migration(ctx context.Context) *migrate.Migration {
 					return errs.Wrap(err)
 				}),
 			},
+			{
+				DB:          &db.reputationDB.DB,
+				Description: "Add reputation_history table to keep the daily scores",
+				Version:     55,
+				Action: migrate.SQL{
+					`CREATE TABLE reputation_history (
+						satellite_id BLOB NOT NULL,
+						date TIMESTAMP NOT NULL,
+						audit_score REAL NOT NULL,
+						suspension_score REAL NOT NULL,
+						online_score REAL NOT NULL,
+						audit_success_count INTEGER NOT NULL,
+						audit_total_count INTEGER NOT NULL,
+						PRIMARY KEY (satellite_id, date)
+					)`,
+				},
+			},
+			{
+				DB:          &db.reputationDB.DB,
+				Description: "Add contained_pieces and oldest_pending_reverify_at to reputation db",
+				Version:     56,
+				Action: migrate.SQL{
+					`ALTER TABLE reputation ADD COLUMN contained_pieces INTEGER NOT NULL DEFAULT 0`,
+					`ALTER TABLE reputation ADD COLUMN oldest_pending_reverify_at TIMESTAMP`,
+				},
+			},
 		},
 	}
 }
@@ -124,3 +124,17 @@ func (db *pieceExpirationDB) RestoreTrash(ctx context.Context, satelliteID storj
 	`, satelliteID)
 	return ErrPieceExpiration.Wrap(err)
 }
+
+// Restore marks a trashed piece expiration as not "trashed".
+func (db *pieceExpirationDB) Restore(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.ExecContext(ctx, `
+		UPDATE piece_expirations
+			SET trash = 0
+			WHERE satellite_id = ?
+				AND piece_id = ?
+				AND trash = 1
+	`, satelliteID, pieceID)
+	return ErrPieceExpiration.Wrap(err)
+}
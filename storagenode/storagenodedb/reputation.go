@@ -7,6 +7,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/zeebo/errs"
 
@@ -48,8 +49,10 @@ func (db *reputationDB) Store(ctx context.Context, stats reputation.Stats) (err
 			offline_under_review_at,
 			vetted_at,
 			updated_at,
-			joined_at
-		) VALUES(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)`
+			joined_at,
+			contained_pieces,
+			oldest_pending_reverify_at
+		) VALUES(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)`
 
 	// ensure we insert utc
 	if stats.DisqualifiedAt != nil {
@@ -68,6 +71,10 @@ func (db *reputationDB) Store(ctx context.Context, stats reputation.Stats) (err
 		utc := stats.OfflineUnderReviewAt.UTC()
 		stats.OfflineUnderReviewAt = &utc
 	}
+	if stats.Containment.OldestPendingAt != nil {
+		utc := stats.Containment.OldestPendingAt.UTC()
+		stats.Containment.OldestPendingAt = &utc
+	}
 
 	var auditHistoryBytes []byte
 	if stats.AuditHistory != nil {
@@ -96,11 +103,82 @@ func (db *reputationDB) Store(ctx context.Context, stats reputation.Stats) (err
 		stats.VettedAt,
 		stats.UpdatedAt.UTC(),
 		stats.JoinedAt.UTC(),
+		stats.Containment.PendingCount,
+		stats.Containment.OldestPendingAt,
+	)
+	if err != nil {
+		return ErrReputation.Wrap(err)
+	}
+
+	// the satellites return only the current scores, so the last scores of the day are kept
+	// to have the history of the scores.
+	_, err = db.ExecContext(ctx, `INSERT OR REPLACE INTO reputation_history (
+			satellite_id,
+			date,
+			audit_score,
+			suspension_score,
+			online_score,
+			audit_success_count,
+			audit_total_count
+		) VALUES(?,?,?,?,?,?,?)`,
+		stats.SatelliteID,
+		dayOf(stats.UpdatedAt),
+		stats.Audit.Score,
+		stats.Audit.UnknownScore,
+		stats.OnlineScore,
+		stats.Audit.SuccessCount,
+		stats.Audit.TotalCount,
 	)
 
 	return ErrReputation.Wrap(err)
 }
 
+// History retrieves the daily scores of a satellite in the [from, to) interval.
+func (db *reputationDB) History(ctx context.Context, satelliteID storj.NodeID, from, to time.Time) (_ []reputation.DailyScores, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.QueryContext(ctx, `SELECT date,
+			audit_score,
+			suspension_score,
+			online_score,
+			audit_success_count,
+			audit_total_count
+		FROM reputation_history
+		WHERE satellite_id = ? AND ? <= date AND date < ?
+		ORDER BY date`,
+		satelliteID, from.UTC(), to.UTC(),
+	)
+	if err != nil {
+		return nil, ErrReputation.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var history []reputation.DailyScores
+	for rows.Next() {
+		var scores reputation.DailyScores
+		err := rows.Scan(
+			&scores.Date,
+			&scores.AuditScore,
+			&scores.SuspensionScore,
+			&scores.OnlineScore,
+			&scores.AuditSuccessCount,
+			&scores.AuditTotalCount,
+		)
+		if err != nil {
+			return nil, ErrReputation.Wrap(err)
+		}
+		history = append(history, scores)
+	}
+
+	return history, ErrReputation.Wrap(rows.Err())
+}
+
+// dayOf returns the start of the day of the time in UTC.
+func dayOf(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
 // Get retrieves stats for specific satellite.
 func (db *reputationDB) Get(ctx context.Context, satelliteID storj.NodeID) (_ *reputation.Stats, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -126,7 +204,9 @@ func (db *reputationDB) Get(ctx context.Context, satelliteID storj.NodeID) (_ *r
 			offline_under_review_at,
 			vetted_at,
 			updated_at,
-			joined_at
+			joined_at,
+			contained_pieces,
+			oldest_pending_reverify_at
 		FROM reputation WHERE satellite_id = ?`,
 		satelliteID,
 	)
@@ -150,6 +230,8 @@ func (db *reputationDB) Get(ctx context.Context, satelliteID storj.NodeID) (_ *r
 		&stats.VettedAt,
 		&stats.UpdatedAt,
 		&stats.JoinedAt,
+		&stats.Containment.PendingCount,
+		&stats.Containment.OldestPendingAt,
 	)
 
 	if errors.Is(err, sql.ErrNoRows) {
@@ -187,7 +269,9 @@ func (db *reputationDB) All(ctx context.Context) (_ []reputation.Stats, err erro
 			offline_under_review_at,
 			vetted_at,
 			updated_at,
-			joined_at
+			joined_at,
+			contained_pieces,
+			oldest_pending_reverify_at
 		FROM reputation`
 
 	rows, err := db.QueryContext(ctx, query)
@@ -218,6 +302,8 @@ func (db *reputationDB) All(ctx context.Context) (_ []reputation.Stats, err erro
 			&stats.VettedAt,
 			&stats.UpdatedAt,
 			&stats.JoinedAt,
+			&stats.Containment.PendingCount,
+			&stats.Containment.OldestPendingAt,
 		)
 
 		if err != nil {
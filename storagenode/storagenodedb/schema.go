@@ -571,6 +571,11 @@ func Schema() map[string]*dbschema.Schema {
 							Type:       "REAL",
 							IsNullable: false,
 						},
+						{
+							Name:       "contained_pieces",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
 						{
 							Name:       "disqualified_at",
 							Type:       "TIMESTAMP",
@@ -591,6 +596,11 @@ func Schema() map[string]*dbschema.Schema {
 							Type:       "TIMESTAMP",
 							IsNullable: true,
 						},
+						{
+							Name:       "oldest_pending_reverify_at",
+							Type:       "TIMESTAMP",
+							IsNullable: true,
+						},
 						{
 							Name:       "online_score",
 							Type:       "REAL",
@@ -618,6 +628,47 @@ func Schema() map[string]*dbschema.Schema {
 						},
 					},
 				},
+				{
+					Name:       "reputation_history",
+					PrimaryKey: []string{"date", "satellite_id"},
+					Columns: []*dbschema.Column{
+						{
+							Name:       "audit_score",
+							Type:       "REAL",
+							IsNullable: false,
+						},
+						{
+							Name:       "audit_success_count",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						{
+							Name:       "audit_total_count",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
+						{
+							Name:       "date",
+							Type:       "TIMESTAMP",
+							IsNullable: false,
+						},
+						{
+							Name:       "online_score",
+							Type:       "REAL",
+							IsNullable: false,
+						},
+						{
+							Name:       "satellite_id",
+							Type:       "BLOB",
+							IsNullable: false,
+						},
+						{
+							Name:       "suspension_score",
+							Type:       "REAL",
+							IsNullable: false,
+						},
+					},
+				},
 			},
 		},
 		"satellites": {
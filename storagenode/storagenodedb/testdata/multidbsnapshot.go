@@ -69,6 +69,8 @@ var States = MultiDBStates{
 		&v52,
 		&v53,
 		&v54,
+		&v55,
+		&v56,
 	},
 }
 
@@ -0,0 +1,74 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testdata
+
+import "storj.io/storj/storagenode/storagenodedb"
+
+var v56 = MultiDBState{
+	Version: 56,
+	DBStates: DBStates{
+		storagenodedb.UsedSerialsDBName:  v55.DBStates[storagenodedb.UsedSerialsDBName],
+		storagenodedb.StorageUsageDBName: v55.DBStates[storagenodedb.StorageUsageDBName],
+		storagenodedb.ReputationDBName: &DBState{
+			SQL: `
+				-- table to store nodestats cache
+				CREATE TABLE reputation (
+					satellite_id BLOB NOT NULL,
+					audit_success_count INTEGER NOT NULL,
+					audit_total_count INTEGER NOT NULL,
+					audit_reputation_alpha REAL NOT NULL,
+					audit_reputation_beta REAL NOT NULL,
+					audit_reputation_score REAL NOT NULL,
+					audit_unknown_reputation_alpha REAL NOT NULL,
+					audit_unknown_reputation_beta REAL NOT NULL,
+					audit_unknown_reputation_score REAL NOT NULL,
+					online_score REAL NOT NULL,
+					audit_history BLOB,
+					disqualified_at TIMESTAMP,
+					updated_at TIMESTAMP NOT NULL,
+					suspended_at TIMESTAMP,
+					offline_suspended_at TIMESTAMP,
+					offline_under_review_at TIMESTAMP,
+					vetted_at TIMESTAMP,
+					joined_at TIMESTAMP NOT NULL,
+					contained_pieces INTEGER NOT NULL DEFAULT 0,
+					oldest_pending_reverify_at TIMESTAMP,
+					PRIMARY KEY (satellite_id)
+				);
+				INSERT INTO reputation (satellite_id,														 audit_success_count, audit_total_count, audit_reputation_alpha, audit_reputation_beta, audit_reputation_score, audit_unknown_reputation_alpha, audit_unknown_reputation_beta, audit_unknown_reputation_score, online_score, audit_history, disqualified_at,             updated_at,                  suspended_at, offline_suspended_at, offline_under_review_at, vetted_at,                   joined_at,                   contained_pieces, oldest_pending_reverify_at) VALUES
+									   (X'0ed28abb2813e184a1e98b0f6605c4911ea468c7e8433eb583e0fca7ceac3000', 1,                   1,                 1.0,					 1.0,					1.0,					1.0,							1.0,						   1.0,							   1.0,			 NULL,			'2019-07-19 20:00:00+00:00', '2019-08-23 20:00:00+00:00', NULL,			NULL,				  NULL,					   NULL,						'1970-01-01 00:00:00+00:00', 0,                NULL),
+									   (X'953fdf144a088a4116a1f6acfc8475c78278c018849db050d894a89572e56d00', 1,                   1,                 1.0,                    1.0,                   1.0,                    1.0,                            1.0,                           1.0,                            1.0,          NULL,          '2019-07-19 20:00:00+00:00', '2019-08-23 20:00:00+00:00', NULL,         NULL,                 NULL,                    '2019-06-25 20:00:00+00:00', '1970-01-01 00:00:00+00:00', 0,                NULL),
+									   (X'1a438a44e3cc9ab9faaacc1c034339f0ebec05f310f0ba270414dac753882f00', 1,                   1,                 1.0,                    1.0,                   1.0,                    1.0,                            1.0,                           1.0,                            1.0,          NULL,          NULL,                        '2019-08-23 20:00:00+00:00', NULL,         NULL,                 NULL,                    NULL,                        '1970-01-01 00:00:00+00:00', 0,                NULL);
+
+				CREATE TABLE reputation_history (
+					satellite_id BLOB NOT NULL,
+					date TIMESTAMP NOT NULL,
+					audit_score REAL NOT NULL,
+					suspension_score REAL NOT NULL,
+					online_score REAL NOT NULL,
+					audit_success_count INTEGER NOT NULL,
+					audit_total_count INTEGER NOT NULL,
+					PRIMARY KEY (satellite_id, date)
+				);
+				INSERT INTO reputation_history (satellite_id,                                                        date,                        audit_score, suspension_score, online_score, audit_success_count, audit_total_count) VALUES
+											   (X'0ed28abb2813e184a1e98b0f6605c4911ea468c7e8433eb583e0fca7ceac3000', '2019-08-23 00:00:00+00:00', 1.0,         1.0,              1.0,          1,                   1);
+			`,
+			NewData: `
+				INSERT INTO reputation (satellite_id,                                                        audit_success_count, audit_total_count, audit_reputation_alpha, audit_reputation_beta, audit_reputation_score, audit_unknown_reputation_alpha, audit_unknown_reputation_beta, audit_unknown_reputation_score, online_score, audit_history, disqualified_at, updated_at,                  suspended_at, offline_suspended_at, offline_under_review_at, vetted_at, joined_at,                   contained_pieces, oldest_pending_reverify_at) VALUES
+									   (X'2b3a5863a41f25408a8f5348839d7a1361dbd886d75786bb139a8ca0bdf41000', 1,                   1,                 1.0,                    1.0,                   1.0,                    1.0,                            1.0,                           1.0,                            1.0,          NULL,          NULL,            '2019-08-23 20:00:00+00:00', NULL,         NULL,                 NULL,                    NULL,      '1970-01-01 00:00:00+00:00', 3,                '2019-08-22 20:00:00+00:00');
+			`,
+		},
+		storagenodedb.PieceSpaceUsedDBName:  v55.DBStates[storagenodedb.PieceSpaceUsedDBName],
+		storagenodedb.PieceInfoDBName:       v55.DBStates[storagenodedb.PieceInfoDBName],
+		storagenodedb.PieceExpirationDBName: v55.DBStates[storagenodedb.PieceExpirationDBName],
+		storagenodedb.OrdersDBName:          v55.DBStates[storagenodedb.OrdersDBName],
+		storagenodedb.BandwidthDBName:       v55.DBStates[storagenodedb.BandwidthDBName],
+		storagenodedb.SatellitesDBName:      v55.DBStates[storagenodedb.SatellitesDBName],
+		storagenodedb.DeprecatedInfoDBName:  v55.DBStates[storagenodedb.DeprecatedInfoDBName],
+		storagenodedb.NotificationsDBName:   v55.DBStates[storagenodedb.NotificationsDBName],
+		storagenodedb.HeldAmountDBName:      v55.DBStates[storagenodedb.HeldAmountDBName],
+		storagenodedb.PricingDBName:         v55.DBStates[storagenodedb.PricingDBName],
+		storagenodedb.APIKeysDBName:         v55.DBStates[storagenodedb.APIKeysDBName],
+	},
+}
@@ -4,10 +4,14 @@
 package trust
 
 import (
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
 )
 
 // Config is the trust configuration.
@@ -16,6 +20,8 @@ type Config struct {
 	Exclusions      Exclusions    `help:"list of trust exclusions" devDefault:"" releaseDefault:""`
 	RefreshInterval time.Duration `help:"how often the trust pool should be refreshed" default:"6h"`
 	CachePath       string        `help:"file path where trust lists should be cached" default:"${CONFDIR}/trust-cache.json"`
+	WatchInterval   time.Duration `help:"how often the trust list files are checked for changes, which reload the trust pool; 0 to disable" default:"1m"`
+	Quotas          Quotas        `help:"list of per-satellite allocated space limits, e.g. <satellite-id>:2TB" default:""`
 }
 
 // Sources is a list of sources that implements pflag.Value.
@@ -93,3 +99,51 @@ func (exclusions *Exclusions) Set(value string) error {
 func (exclusions Exclusions) Type() string {
 	return "trust-exclusions"
 }
+
+// Quotas is a list of per-satellite allocated space limits that implements pflag.Value.
+type Quotas struct {
+	Limits map[storj.NodeID]memory.Size
+}
+
+// String returns the string representation of the config.
+func (quotas *Quotas) String() string {
+	s := make([]string, 0, len(quotas.Limits))
+	for id, limit := range quotas.Limits {
+		s = append(s, id.String()+":"+limit.String())
+	}
+	sort.Strings(s)
+	return strings.Join(s, ",")
+}
+
+// Set implements pflag.Value by parsing a comma separated list of <satellite-id>:<size> quotas.
+func (quotas *Quotas) Set(value string) error {
+	var entries []string
+	if value != "" {
+		entries = strings.Split(value, ",")
+	}
+
+	limits := make(map[storj.NodeID]memory.Size, len(entries))
+	for _, entry := range entries {
+		idString, sizeString, ok := strings.Cut(entry, ":")
+		if !ok {
+			return Error.New("invalid quota %q: expected <satellite-id>:<size>", entry)
+		}
+		id, err := storj.NodeIDFromString(idString)
+		if err != nil {
+			return Error.New("invalid quota %q: %w", entry, errs.Unwrap(err))
+		}
+		var limit memory.Size
+		if err := limit.Set(sizeString); err != nil {
+			return Error.New("invalid quota %q: %w", entry, err)
+		}
+		limits[id] = limit
+	}
+
+	quotas.Limits = limits
+	return nil
+}
+
+// Type returns the type of the pflag.Value.
+func (quotas Quotas) Type() string {
+	return "trust-quotas"
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"storj.io/common/memory"
 	"storj.io/common/testrand"
 	"storj.io/storj/storagenode/trust"
 )
@@ -61,3 +62,22 @@ func TestExclusionsConfig(t *testing.T) {
 		assert.Equal(t, exclusion2, exclusions.Rules[2].String())
 	}
 }
+
+func TestQuotasConfig(t *testing.T) {
+	id0, id1 := testrand.NodeID(), testrand.NodeID()
+
+	var quotas trust.Quotas
+	assert.Equal(t, "trust-quotas", quotas.Type())
+	assert.Equal(t, "", quotas.String())
+
+	// Assert that comma separated quotas can be set
+	require.NoError(t, quotas.Set(fmt.Sprintf("%s:2TB,%s:500GB", id0, id1)))
+	assert.Equal(t, memory.TB*2, quotas.Limits[id0])
+	assert.Equal(t, memory.GB*500, quotas.Limits[id1])
+
+	// Assert that a failure to set does not modify the current quotas
+	require.Error(t, quotas.Set(id0.String()))
+	require.Error(t, quotas.Set("foo:2TB"))
+	require.Error(t, quotas.Set(id0.String()+":2QB"))
+	assert.Len(t, quotas.Limits, 2)
+}
@@ -0,0 +1,20 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+//go:build !windows
+// +build !windows
+
+package trust
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// reloadSignal returns the channel, which receives SIGHUP.
+func reloadSignal() (_ <-chan os.Signal, stop func()) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	return signals, func() { signal.Stop(signals) }
+}
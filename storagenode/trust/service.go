@@ -6,6 +6,7 @@ package trust
 import (
 	"context"
 	"math/rand"
+	"os"
 	"sort"
 	"sync"
 	"time"
@@ -15,11 +16,11 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/identity"
+	"storj.io/common/memory"
 	"storj.io/common/rpc"
 	"storj.io/common/rpc/rpcpool"
 	"storj.io/common/signing"
 	"storj.io/common/storj"
-	"storj.io/common/sync2"
 	"storj.io/storj/storagenode/satellites"
 )
 
@@ -66,10 +67,16 @@ type Pool struct {
 	log             *zap.Logger
 	resolver        IdentityResolver
 	refreshInterval time.Duration
+	watchInterval   time.Duration
+	quotas          map[storj.NodeID]memory.Size
 
 	listMu sync.Mutex
 	list   *List
 
+	// fileSources are watched for changes, which reload the pool.
+	fileSources []*FileSource
+	modTimes    map[string]time.Time
+
 	satellitesDB satellites.DB
 
 	satellitesMu sync.RWMutex
@@ -97,36 +104,129 @@ func NewPool(log *zap.Logger, resolver IdentityResolver, config Config, satellit
 		return nil, err
 	}
 
-	return &Pool{
+	pool := &Pool{
 		log:             log,
 		resolver:        resolver,
 		refreshInterval: config.RefreshInterval,
+		watchInterval:   config.WatchInterval,
+		quotas:          config.Quotas.Limits,
 		list:            list,
+		modTimes:        make(map[string]time.Time),
 		satellitesDB:    satellitesDB,
 		satellites:      make(map[storj.NodeID]*satelliteInfoCache),
-	}, nil
+	}
+	for _, source := range config.Sources {
+		if fileSource, ok := source.(*FileSource); ok {
+			pool.fileSources = append(pool.fileSources, fileSource)
+		}
+	}
+	pool.filesChanged()
+
+	return pool, nil
 }
 
 // Run periodically refreshes the pool. The initial refresh is intended to
 // happen before run is call. Therefore Run does not refresh right away.
+//
+// The pool is also reloaded, when the trust list files change or, on unix,
+// when the process receives SIGHUP.
 func (pool *Pool) Run(ctx context.Context) error {
+	reload, stop := reloadSignal()
+	defer stop()
+
+	var watch <-chan time.Time
+	if pool.watchInterval > 0 && len(pool.fileSources) > 0 {
+		ticker := time.NewTicker(pool.watchInterval)
+		defer ticker.Stop()
+		watch = ticker.C
+	}
+
 	for {
 		refreshAfter := jitter(pool.refreshInterval)
 		pool.log.Info("Scheduling next refresh", zap.Duration("after", refreshAfter))
-		if !sync2.Sleep(ctx, refreshAfter) {
-			return ctx.Err()
+		timer := time.NewTimer(refreshAfter)
+
+		scheduled := false
+		for !scheduled {
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+				scheduled = true
+			case <-watch:
+				if pool.filesChanged() {
+					pool.log.Info("Trust list files changed, reloading")
+					pool.reload(ctx)
+				}
+			case <-reload:
+				pool.log.Info("Received reload signal, reloading")
+				pool.filesChanged()
+				pool.reload(ctx)
+			}
 		}
+
 		if err := pool.Refresh(ctx); err != nil {
 			pool.log.Error("Failed to refresh", zap.Error(err))
 			return err
 		}
+		if err := pool.storeAddresses(ctx); err != nil {
+			return err
+		}
+	}
+}
 
-		for _, trustedSatellite := range pool.satellites {
-			if err := pool.satellitesDB.SetAddress(ctx, trustedSatellite.url.ID, trustedSatellite.url.Address); err != nil {
-				return err
-			}
+// reload refreshes the pool on the request of the operator. Unlike the scheduled
+// refresh, the failures keep the current trusted satellites, so a mistake in an
+// edited trust list doesn't stop the node.
+func (pool *Pool) reload(ctx context.Context) {
+	mon.Event("trust_pool_reload")
+	if err := pool.Refresh(ctx); err != nil {
+		pool.log.Error("Failed to reload, keeping the current trusted satellites", zap.Error(err))
+		return
+	}
+	if err := pool.storeAddresses(ctx); err != nil {
+		pool.log.Error("Failed to store the satellite addresses", zap.Error(err))
+	}
+}
+
+// storeAddresses saves the addresses of the trusted satellites to the database.
+func (pool *Pool) storeAddresses(ctx context.Context) error {
+	pool.satellitesMu.RLock()
+	urls := make([]storj.NodeURL, 0, len(pool.satellites))
+	for _, trustedSatellite := range pool.satellites {
+		urls = append(urls, trustedSatellite.url)
+	}
+	pool.satellitesMu.RUnlock()
+
+	for _, url := range urls {
+		if err := pool.satellitesDB.SetAddress(ctx, url.ID, url.Address); err != nil {
+			return err
 		}
 	}
+	return nil
+}
+
+// filesChanged returns whether any of the trust list files was modified since the
+// last check.
+func (pool *Pool) filesChanged() (changed bool) {
+	for _, source := range pool.fileSources {
+		var modTime time.Time
+		if info, err := os.Stat(source.String()); err == nil {
+			modTime = info.ModTime()
+		}
+		if !modTime.Equal(pool.modTimes[source.String()]) {
+			pool.modTimes[source.String()] = modTime
+			changed = true
+		}
+	}
+	return changed
+}
+
+// Quota returns the allocated space limit of the satellite, if it's configured.
+func (pool *Pool) Quota(id storj.NodeID) (memory.Size, bool) {
+	quota, ok := pool.quotas[id]
+	return quota, ok
 }
 
 // VerifySatelliteID checks whether id corresponds to a trusted satellite.
@@ -164,6 +264,8 @@ func (pool *Pool) GetSignee(ctx context.Context, id storj.NodeID) (_ signing.Sig
 // GetSatellites returns a slice containing all trusted satellites.
 func (pool *Pool) GetSatellites(ctx context.Context) (satellites []storj.NodeID) {
 	defer mon.Task()(&ctx)(nil)
+	pool.satellitesMu.RLock()
+	defer pool.satellitesMu.RUnlock()
 	for sat := range pool.satellites {
 		satellites = append(satellites, sat)
 	}
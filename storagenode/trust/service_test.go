@@ -8,17 +8,22 @@ import (
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"os"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
 	"storj.io/common/identity"
+	"storj.io/common/memory"
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
 	"storj.io/storj/storagenode/trust"
 )
 
@@ -239,3 +244,67 @@ func (resolver *fakeIdentityResolver) ResolveIdentity(ctx context.Context, url s
 	}
 	return identity, nil
 }
+
+func TestPoolReloadsChangedFile(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		id0, id1 := testrand.NodeID(), testrand.NodeID()
+		listPath := ctx.File("trust-list.txt")
+		require.NoError(t, os.WriteFile(listPath, []byte(id0.String()+"@foo.test:7777\n"), 0644))
+
+		var sources trust.Sources
+		require.NoError(t, sources.Set(listPath))
+
+		pool, err := trust.NewPool(zaptest.NewLogger(t), newFakeIdentityResolver(), trust.Config{
+			Sources:         sources,
+			CachePath:       ctx.File("trust-cache.json"),
+			RefreshInterval: time.Hour,
+			WatchInterval:   time.Millisecond,
+		}, db.Satellites())
+		require.NoError(t, err)
+		require.NoError(t, pool.Refresh(ctx))
+		require.Equal(t, []storj.NodeID{id0}, pool.GetSatellites(ctx))
+
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		ctx.Go(func() error {
+			_ = pool.Run(runCtx)
+			return nil
+		})
+
+		// replace the trusted satellite without restarting the pool
+		require.NoError(t, os.WriteFile(listPath, []byte(id1.String()+"@bar.test:7777\n"), 0644))
+		later := time.Now().Add(time.Minute)
+		require.NoError(t, os.Chtimes(listPath, later, later))
+
+		require.Eventually(t, func() bool {
+			return pool.VerifySatelliteID(ctx, id1) == nil
+		}, 10*time.Second, 10*time.Millisecond)
+		require.Error(t, pool.VerifySatelliteID(ctx, id0))
+
+		address, err := db.Satellites().GetSatellitesUrls(ctx)
+		require.NoError(t, err)
+		require.Len(t, address, 1)
+		require.Equal(t, id1, address[0].ID)
+	})
+}
+
+func TestPoolQuota(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	id0, id1 := testrand.NodeID(), testrand.NodeID()
+	pool, err := trust.NewPool(zaptest.NewLogger(t), newFakeIdentityResolver(), trust.Config{
+		CachePath: ctx.File("trust-cache.json"),
+		Quotas: trust.Quotas{
+			Limits: map[storj.NodeID]memory.Size{id0: memory.TB},
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	quota, ok := pool.Quota(id0)
+	require.True(t, ok)
+	require.Equal(t, memory.TB, quota)
+
+	_, ok = pool.Quota(id1)
+	require.False(t, ok)
+}
@@ -0,0 +1,207 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package versioncontrol
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/private/version"
+)
+
+// CohortErr defines the rollout cohort error class.
+var CohortErr = errs.Class("rollout cohort")
+
+// Cohort selects the nodes of a staged rollout by their attributes. The nodes of a
+// cohort are rolled out to the suggested version in addition to the nodes selected
+// by the seed and cursor of the rollout, e.g. for canary releases.
+//
+// A node matches the cohort, when it matches every non-empty attribute list.
+type Cohort struct {
+	Name string `json:"name"`
+
+	// OS are the operating systems of the nodes, e.g. "linux".
+	OS []string `json:"os,omitempty"`
+	// Versions are the prefixes of the running versions of the nodes, e.g. "v1.76".
+	Versions []string `json:"versions,omitempty"`
+	// Wallets are the operator wallets of the nodes.
+	Wallets []string `json:"wallets,omitempty"`
+	// Tags are the tags of the nodes.
+	Tags []string `json:"tags,omitempty"`
+
+	// Percentage is the percentage of the matching nodes, which are selected with
+	// the node ID hash using the seed of the rollout.
+	Percentage int `json:"percentage"`
+}
+
+// NodeAttributes are the attributes of a node, which are used to select its cohort.
+type NodeAttributes struct {
+	ID      storj.NodeID
+	OS      string
+	Version string
+	Wallet  string
+	Tag     string
+}
+
+// Validate validates the cohort.
+func (cohort Cohort) Validate() error {
+	if cohort.Name == "" {
+		return CohortErr.New("name is missing")
+	}
+	if cohort.Percentage < 0 || cohort.Percentage > 100 {
+		return CohortErr.New("invalid percentage of %q: %d", cohort.Name, cohort.Percentage)
+	}
+	return nil
+}
+
+// Matches returns whether the node matches the attributes of the cohort.
+func (cohort Cohort) Matches(node NodeAttributes) bool {
+	matches := func(values []string, value string, match func(a, b string) bool) bool {
+		if len(values) == 0 {
+			return true
+		}
+		for _, v := range values {
+			if match(value, v) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return matches(cohort.OS, node.OS, strings.EqualFold) &&
+		matches(cohort.Versions, node.Version, strings.HasPrefix) &&
+		matches(cohort.Wallets, node.Wallet, strings.EqualFold) &&
+		matches(cohort.Tags, node.Tag, func(a, b string) bool { return a == b })
+}
+
+// Selects returns whether the cohort selects the node for the rollout with the seed.
+func (cohort Cohort) Selects(seed version.RolloutBytes, node NodeAttributes) bool {
+	if !cohort.Matches(node) {
+		return false
+	}
+	return version.ShouldUpdate(version.Rollout{
+		Seed:   seed,
+		Cursor: version.PercentageToCursor(cohort.Percentage),
+	}, node.ID)
+}
+
+// ValidateCohorts validates the cohorts of a process.
+func ValidateCohorts(cohorts []Cohort) error {
+	names := map[string]struct{}{}
+	for _, cohort := range cohorts {
+		if err := cohort.Validate(); err != nil {
+			return err
+		}
+		if _, ok := names[cohort.Name]; ok {
+			return CohortErr.New("duplicate name: %q", cohort.Name)
+		}
+		names[cohort.Name] = struct{}{}
+	}
+	return nil
+}
+
+// cohorts contains the rollout cohorts of the processes, which can be changed at
+// runtime. The changes are saved to the file, when it's configured.
+type cohorts struct {
+	path string
+
+	mu        sync.RWMutex
+	processes map[string][]Cohort
+}
+
+// loadCohorts loads the cohorts from the json file at path. An empty path or a
+// missing file means no cohorts.
+func loadCohorts(path string) (*cohorts, error) {
+	c := &cohorts{
+		path:      path,
+		processes: map[string][]Cohort{},
+	}
+	if path == "" {
+		return c, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return c, nil
+		}
+		return nil, CohortErr.Wrap(err)
+	}
+	if err := json.Unmarshal(data, &c.processes); err != nil {
+		return nil, CohortErr.Wrap(err)
+	}
+	for process, list := range c.processes {
+		if _, ok := processByName(version.Processes{}, process); !ok {
+			return nil, CohortErr.New("unknown process: %q", process)
+		}
+		if err := ValidateCohorts(list); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// Get returns the cohorts of the process.
+func (c *cohorts) Get(process string) []Cohort {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return append([]Cohort{}, c.processes[process]...)
+}
+
+// Set replaces the cohorts of the process.
+func (c *cohorts) Set(process string, list []Cohort) error {
+	if err := ValidateCohorts(list); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	previous, existed := c.processes[process]
+	if len(list) == 0 {
+		delete(c.processes, process)
+	} else {
+		c.processes[process] = list
+	}
+
+	if err := c.save(); err != nil {
+		if existed {
+			c.processes[process] = previous
+		} else {
+			delete(c.processes, process)
+		}
+		return err
+	}
+	return nil
+}
+
+// save writes the cohorts to the file. It must be called with mu held.
+func (c *cohorts) save() error {
+	if c.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(c.processes, "", "\t")
+	if err != nil {
+		return CohortErr.Wrap(err)
+	}
+	return CohortErr.Wrap(os.WriteFile(c.path, data, 0644))
+}
+
+// Select returns the first cohort of the process, which selects the node.
+func (c *cohorts) Select(process string, seed version.RolloutBytes, node NodeAttributes) (Cohort, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, cohort := range c.processes[process] {
+		if cohort.Selects(seed, node) {
+			return cohort, true
+		}
+	}
+	return Cohort{}, false
+}
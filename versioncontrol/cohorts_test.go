@@ -0,0 +1,138 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package versioncontrol_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/private/version"
+	"storj.io/storj/versioncontrol"
+)
+
+func TestCohort_Matches(t *testing.T) {
+	node := versioncontrol.NodeAttributes{
+		ID:      testrand.NodeID(),
+		OS:      "linux",
+		Version: "v1.76.2",
+		Wallet:  "0xABCD",
+		Tag:     "canary",
+	}
+
+	require.True(t, versioncontrol.Cohort{Name: "all"}.Matches(node))
+	require.True(t, versioncontrol.Cohort{Name: "os", OS: []string{"windows", "Linux"}}.Matches(node))
+	require.False(t, versioncontrol.Cohort{Name: "os", OS: []string{"windows"}}.Matches(node))
+	require.True(t, versioncontrol.Cohort{Name: "version", Versions: []string{"v1.76"}}.Matches(node))
+	require.False(t, versioncontrol.Cohort{Name: "version", Versions: []string{"v1.77"}}.Matches(node))
+	require.True(t, versioncontrol.Cohort{Name: "wallet", Wallets: []string{"0xabcd"}}.Matches(node))
+	require.False(t, versioncontrol.Cohort{Name: "tag", Tags: []string{"stable"}}.Matches(node))
+	require.False(t, versioncontrol.Cohort{Name: "all of", OS: []string{"linux"}, Tags: []string{"stable"}}.Matches(node))
+
+	var seed version.RolloutBytes
+	require.True(t, versioncontrol.Cohort{Name: "full", Tags: []string{"canary"}, Percentage: 100}.Selects(seed, node))
+	require.False(t, versioncontrol.Cohort{Name: "none", Tags: []string{"canary"}, Percentage: 0}.Selects(seed, node))
+	require.False(t, versioncontrol.Cohort{Name: "other", Tags: []string{"stable"}, Percentage: 100}.Selects(seed, node))
+}
+
+func TestValidateCohorts(t *testing.T) {
+	require.NoError(t, versioncontrol.ValidateCohorts(nil))
+	require.NoError(t, versioncontrol.ValidateCohorts([]versioncontrol.Cohort{{Name: "a"}, {Name: "b", Percentage: 100}}))
+	require.Error(t, versioncontrol.ValidateCohorts([]versioncontrol.Cohort{{Name: ""}}))
+	require.Error(t, versioncontrol.ValidateCohorts([]versioncontrol.Cohort{{Name: "a", Percentage: 101}}))
+	require.Error(t, versioncontrol.ValidateCohorts([]versioncontrol.Cohort{{Name: "a"}, {Name: "a"}}))
+}
+
+func TestPeerCohorts(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	cohortsFile := filepath.Join(ctx.Dir(), "cohorts.json")
+	const apiKey = "secret"
+
+	config := &versioncontrol.Config{
+		Address:       "127.0.0.1:0",
+		Versions:      versioncontrol.OldVersionConfig{Satellite: "v0.0.1", Storagenode: "v0.0.1", Uplink: "v0.0.1", Gateway: "v0.0.1", Identity: "v0.0.1"},
+		CohortsFile:   cohortsFile,
+		CohortsAPIKey: apiKey,
+	}
+	config.Binary.Storagenode = versioncontrol.ProcessConfig{
+		Minimum:   versioncontrol.VersionConfig{Version: "v1.0.0"},
+		Suggested: versioncontrol.VersionConfig{Version: "v1.1.0"},
+		Rollout: versioncontrol.RolloutConfig{
+			Seed:   "0000000000000000000000000000000000000000000000000000000000000001",
+			Cursor: 0,
+		},
+	}
+
+	peer, err := versioncontrol.New(zaptest.NewLogger(t), config)
+	require.NoError(t, err)
+	ctx.Go(func() error { return peer.Run(ctx) })
+	defer ctx.Check(peer.Close)
+
+	baseURL := "http://" + peer.Addr()
+	nodeID := testrand.NodeID()
+
+	rollout := func(tag string) versioncontrol.RolloutResponse {
+		query := url.Values{
+			"id":      {nodeID.String()},
+			"os":      {"linux"},
+			"version": {"v1.0.0"},
+			"tag":     {tag},
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/processes/storagenode/rollout?"+query.Encode(), nil)
+		require.NoError(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer func() { require.NoError(t, resp.Body.Close()) }()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var response versioncontrol.RolloutResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+		return response
+	}
+
+	setCohorts := func(ctx context.Context, key string, cohorts []versioncontrol.Cohort) int {
+		body, err := json.Marshal(cohorts)
+		require.NoError(t, err)
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, baseURL+"/processes/storagenode/cohorts", bytes.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", key)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return resp.StatusCode
+	}
+
+	// the cursor of the rollout is zero, so the node isn't selected without a cohort.
+	response := rollout("canary")
+	require.False(t, response.Selected)
+	require.Equal(t, "v1.1.0", response.Suggested.Version)
+
+	canary := []versioncontrol.Cohort{{Name: "canary", OS: []string{"linux"}, Tags: []string{"canary"}, Percentage: 100}}
+	require.Equal(t, http.StatusUnauthorized, setCohorts(ctx, "wrong", canary))
+	require.Equal(t, http.StatusBadRequest, setCohorts(ctx, apiKey, []versioncontrol.Cohort{{Name: "invalid", Percentage: -1}}))
+	require.Equal(t, http.StatusOK, setCohorts(ctx, apiKey, canary))
+
+	response = rollout("canary")
+	require.True(t, response.Selected)
+	require.Equal(t, "canary", response.Cohort)
+	require.False(t, rollout("stable").Selected)
+
+	// the cohorts are saved, so they are loaded after a restart.
+	data, err := os.ReadFile(cohortsFile)
+	require.NoError(t, err)
+	var saved map[string][]versioncontrol.Cohort
+	require.NoError(t, json.Unmarshal(data, &saved))
+	require.Equal(t, canary, saved["storagenode"])
+}
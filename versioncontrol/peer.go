@@ -5,6 +5,7 @@ package versioncontrol
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -20,6 +21,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/common/errs2"
+	"storj.io/common/storj"
 	"storj.io/private/version"
 )
 
@@ -39,6 +41,9 @@ type Config struct {
 	Versions OldVersionConfig
 
 	Binary ProcessesConfig
+
+	CohortsFile   string `user:"true" help:"path to the json file with the rollout cohorts of the processes, which is updated when the cohorts are changed" default:""`
+	CohortsAPIKey string `user:"true" help:"api key for changing the rollout cohorts at runtime, empty disables the changes" default:""`
 }
 
 // OldVersionConfig provides a list of allowed Versions per process.
@@ -98,6 +103,21 @@ type Peer struct {
 
 	// response contains the byte version of current allowed versions
 	response []byte
+
+	cohorts       *cohorts
+	cohortsAPIKey string
+
+	rollbacks rollbacks
+}
+
+// RolloutResponse is the rollout state of a process for a node.
+type RolloutResponse struct {
+	// Selected is true, when the node should update to the suggested version.
+	Selected bool `json:"selected"`
+	// Cohort is the name of the cohort, which selected the node. It's empty, when the
+	// node was selected by the seed and cursor of the rollout.
+	Cohort    string          `json:"cohort,omitempty"`
+	Suggested version.Version `json:"suggested"`
 }
 
 // New creates a new VersionControl Server.
@@ -107,7 +127,13 @@ func New(log *zap.Logger, config *Config) (peer *Peer, err error) {
 	}
 
 	peer = &Peer{
-		Log: log,
+		Log:           log,
+		cohortsAPIKey: config.CohortsAPIKey,
+	}
+
+	peer.cohorts, err = loadCohorts(config.CohortsFile)
+	if err != nil {
+		return nil, err
 	}
 
 	// Convert each Service's VersionConfig String to SemVer
@@ -178,6 +204,11 @@ func New(log *zap.Logger, config *Config) (peer *Peer, err error) {
 		router := mux.NewRouter()
 		router.HandleFunc("/", peer.versionHandle).Methods(http.MethodGet)
 		router.HandleFunc("/processes/{service}/{version}/url", peer.processURLHandle).Methods(http.MethodGet)
+		router.HandleFunc("/processes/{service}/rollout", peer.rolloutHandle).Methods(http.MethodGet)
+		router.HandleFunc("/processes/{service}/cohorts", peer.cohortsHandle).Methods(http.MethodGet)
+		router.HandleFunc("/processes/{service}/cohorts", peer.setCohortsHandle).Methods(http.MethodPut)
+		router.HandleFunc("/processes/{service}/rollbacks", peer.rollbacksHandle).Methods(http.MethodGet)
+		router.HandleFunc("/processes/{service}/rollbacks", peer.reportRollbackHandle).Methods(http.MethodPost)
 
 		peer.Server.Endpoint = http.Server{
 			Handler: router,
@@ -208,21 +239,8 @@ func (peer *Peer) processURLHandle(w http.ResponseWriter, r *http.Request) {
 	service := params["service"]
 	versionType := params["version"]
 
-	var process version.Process
-	switch service {
-	case "satellite":
-		process = peer.Versions.Processes.Satellite
-	case "storagenode":
-		process = peer.Versions.Processes.Storagenode
-	case "storagenode-updater":
-		process = peer.Versions.Processes.StoragenodeUpdater
-	case "uplink":
-		process = peer.Versions.Processes.Uplink
-	case "gateway":
-		process = peer.Versions.Processes.Gateway
-	case "identity":
-		process = peer.Versions.Processes.Identity
-	default:
+	process, ok := processByName(peer.Versions.Processes, service)
+	if !ok {
 		http.Error(w, "service does not exists", http.StatusNotFound)
 		return
 	}
@@ -267,6 +285,105 @@ func (peer *Peer) processURLHandle(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// rolloutHandle handles the rollout state request of a node. The node is described by
+// the query parameters id, os, version, wallet and tag.
+func (peer *Peer) rolloutHandle(w http.ResponseWriter, r *http.Request) {
+	service := mux.Vars(r)["service"]
+
+	process, ok := processByName(peer.Versions.Processes, service)
+	if !ok {
+		http.Error(w, "service does not exists", http.StatusNotFound)
+		return
+	}
+
+	query := r.URL.Query()
+	nodeID, err := storj.NodeIDFromString(query.Get("id"))
+	if err != nil {
+		http.Error(w, "invalid node id", http.StatusBadRequest)
+		return
+	}
+
+	node := NodeAttributes{
+		ID:      nodeID,
+		OS:      query.Get("os"),
+		Version: query.Get("version"),
+		Wallet:  query.Get("wallet"),
+		Tag:     query.Get("tag"),
+	}
+
+	response := RolloutResponse{
+		Suggested: process.Suggested,
+	}
+	if cohort, ok := peer.cohorts.Select(service, process.Rollout.Seed, node); ok {
+		response.Selected = true
+		response.Cohort = cohort.Name
+	} else {
+		response.Selected = version.ShouldUpdate(process.Rollout, nodeID)
+	}
+
+	peer.writeJSON(w, response)
+}
+
+// cohortsHandle handles the request of the rollout cohorts of a process.
+func (peer *Peer) cohortsHandle(w http.ResponseWriter, r *http.Request) {
+	service := mux.Vars(r)["service"]
+
+	if _, ok := processByName(peer.Versions.Processes, service); !ok {
+		http.Error(w, "service does not exists", http.StatusNotFound)
+		return
+	}
+
+	peer.writeJSON(w, peer.cohorts.Get(service))
+}
+
+// setCohortsHandle handles the request replacing the rollout cohorts of a process.
+func (peer *Peer) setCohortsHandle(w http.ResponseWriter, r *http.Request) {
+	service := mux.Vars(r)["service"]
+
+	if peer.cohortsAPIKey == "" {
+		http.Error(w, "changing the cohorts is disabled", http.StatusForbidden)
+		return
+	}
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte(peer.cohortsAPIKey)) != 1 {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if _, ok := processByName(peer.Versions.Processes, service); !ok {
+		http.Error(w, "service does not exists", http.StatusNotFound)
+		return
+	}
+
+	var cohorts []Cohort
+	if err := json.NewDecoder(r.Body).Decode(&cohorts); err != nil {
+		http.Error(w, "invalid cohorts: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := ValidateCohorts(cohorts); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := peer.cohorts.Set(service, cohorts); err != nil {
+		peer.Log.Error("Error changing the rollout cohorts.", zap.String("service", service), zap.Error(err))
+		http.Error(w, "failed to change the cohorts", http.StatusInternalServerError)
+		return
+	}
+
+	peer.Log.Info("Rollout cohorts changed.", zap.String("service", service), zap.Int("cohorts", len(cohorts)))
+	peer.writeJSON(w, peer.cohorts.Get(service))
+}
+
+// writeJSON writes the value as a json response.
+func (peer *Peer) writeJSON(w http.ResponseWriter, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		peer.Log.Error("Error writing response to client.", zap.Error(err))
+	}
+}
+
 // Run runs versioncontrol server until it's either closed or it errors.
 func (peer *Peer) Run(ctx context.Context) (err error) {
 	ctx, cancel := context.WithCancel(ctx)
@@ -339,6 +456,26 @@ func (rollout RolloutConfig) Validate() error {
 	return nil
 }
 
+// processByName returns the process with the service name.
+func processByName(processes version.Processes, service string) (version.Process, bool) {
+	switch service {
+	case "satellite":
+		return processes.Satellite, true
+	case "storagenode":
+		return processes.Storagenode, true
+	case "storagenode-updater":
+		return processes.StoragenodeUpdater, true
+	case "uplink":
+		return processes.Uplink, true
+	case "gateway":
+		return processes.Gateway, true
+	case "identity":
+		return processes.Identity, true
+	default:
+		return version.Process{}, false
+	}
+}
+
 func configToProcess(binary ProcessConfig) (version.Process, error) {
 	process := version.Process{
 		Minimum: version.Version{
@@ -0,0 +1,90 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package versioncontrol
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+
+	"storj.io/storj/private/version/checker"
+)
+
+// rollbacks counts the rollbacks of the updated processes reported by the nodes,
+// per the version, which failed the health checks.
+type rollbacks struct {
+	mu        sync.Mutex
+	processes map[string]map[string]int
+}
+
+// Add records the reported rollback of the process.
+func (r *rollbacks) Add(process string, report checker.RollbackReport) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.processes == nil {
+		r.processes = map[string]map[string]int{}
+	}
+	if r.processes[process] == nil {
+		r.processes[process] = map[string]int{}
+	}
+	r.processes[process][report.Failed]++
+}
+
+// Get returns the rollback counts of the process per the failed version.
+func (r *rollbacks) Get(process string) map[string]int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	counts := map[string]int{}
+	for failed, count := range r.processes[process] {
+		counts[failed] = count
+	}
+	return counts
+}
+
+// reportRollbackHandle handles the rollback report of a node.
+func (peer *Peer) reportRollbackHandle(w http.ResponseWriter, r *http.Request) {
+	service := mux.Vars(r)["service"]
+
+	if _, ok := processByName(peer.Versions.Processes, service); !ok {
+		http.Error(w, "service does not exists", http.StatusNotFound)
+		return
+	}
+
+	var report checker.RollbackReport
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 64*1024)).Decode(&report); err != nil {
+		http.Error(w, "invalid report: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if report.Failed == "" {
+		http.Error(w, "failed version is missing", http.StatusBadRequest)
+		return
+	}
+
+	peer.rollbacks.Add(service, report)
+	peer.Log.Warn("Update rolled back by a node.",
+		zap.String("service", service),
+		zap.Stringer("node", report.NodeID),
+		zap.String("failed", report.Failed),
+		zap.String("restored", report.Restored),
+		zap.String("reason", report.Reason))
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// rollbacksHandle handles the request of the rollback counts of a process.
+func (peer *Peer) rollbacksHandle(w http.ResponseWriter, r *http.Request) {
+	service := mux.Vars(r)["service"]
+
+	if _, ok := processByName(peer.Versions.Processes, service); !ok {
+		http.Error(w, "service does not exists", http.StatusNotFound)
+		return
+	}
+
+	peer.writeJSON(w, peer.rollbacks.Get(service))
+}
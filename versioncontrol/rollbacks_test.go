@@ -0,0 +1,61 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package versioncontrol_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/version/checker"
+	"storj.io/storj/versioncontrol"
+)
+
+func TestPeerRollbacks(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	config := &versioncontrol.Config{
+		Address:  "127.0.0.1:0",
+		Versions: versioncontrol.OldVersionConfig{Satellite: "v0.0.1", Storagenode: "v0.0.1", Uplink: "v0.0.1", Gateway: "v0.0.1", Identity: "v0.0.1"},
+	}
+
+	peer, err := versioncontrol.New(zaptest.NewLogger(t), config)
+	require.NoError(t, err)
+	ctx.Go(func() error { return peer.Run(ctx) })
+	defer ctx.Check(peer.Close)
+
+	baseURL := "http://" + peer.Addr()
+	client := checker.New(checker.ClientConfig{
+		ServerAddress:  baseURL,
+		RequestTimeout: time.Minute,
+	})
+
+	report := checker.RollbackReport{
+		NodeID:   testrand.NodeID(),
+		Failed:   "v1.1.0",
+		Restored: "v1.0.0",
+		Reason:   "dashboard is not responding",
+	}
+	require.NoError(t, client.ReportRollback(ctx, "storagenode", report))
+	require.NoError(t, client.ReportRollback(ctx, "storagenode", report))
+	require.Error(t, client.ReportRollback(ctx, "storagenode", checker.RollbackReport{NodeID: report.NodeID}))
+	require.Error(t, client.ReportRollback(ctx, "unknown", report))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/processes/storagenode/rollbacks", nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, resp.Body.Close()) }()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var counts map[string]int
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&counts))
+	require.Equal(t, map[string]int{"v1.1.0": 2}, counts)
+}
@@ -264,6 +264,10 @@ export enum PaymentsHistoryItemType {
     Coupon = 3,
     // DepositBonus is a 10% bonus for using Coinpayments transactions.
     DepositBonus = 4,
+    // Refund is a refund of a credit card charge.
+    Refund = 5,
+    // Chargeback is a chargeback, or its reversal, of a credit card charge.
+    Chargeback = 6,
 }
 
 /**
@@ -78,7 +78,10 @@ export class UINotification {
         case NotificationTypes.Disqualification:
             return DisqualificationIcon;
         case NotificationTypes.Suspension:
+        case NotificationTypes.Offline:
             return SuspendedIcon;
+        case NotificationTypes.DiskFull:
+            return FailIcon;
         default:
             return InfoIcon;
         }
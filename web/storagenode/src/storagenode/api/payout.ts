@@ -132,6 +132,7 @@ export class PayoutHttpApi implements PayoutApi {
                 payoutHistoryItem.isExitComplete,
                 payoutHistoryItem.heldPercent,
                 payoutHistoryItem.distributed,
+                payoutHistoryItem.transaction ? payoutHistoryItem.transaction.url : '',
             );
         });
     }
@@ -199,6 +200,10 @@ export class PayoutHttpApi implements PayoutApi {
                 data.currentMonth.heldRate,
                 data.currentMonth.payout,
                 data.currentMonth.held,
+                data.currentMonth.egressRepair,
+                data.currentMonth.egressRepairPayout,
+                data.currentMonth.egressAudit,
+                data.currentMonth.egressAuditPayout,
             ),
             new PreviousMonthEstimatedPayout(
                 data.previousMonth.egressBandwidth,
@@ -210,6 +215,10 @@ export class PayoutHttpApi implements PayoutApi {
                 data.previousMonth.heldRate,
                 data.previousMonth.payout,
                 data.previousMonth.held,
+                data.previousMonth.egressRepair,
+                data.previousMonth.egressRepairPayout,
+                data.previousMonth.egressAudit,
+                data.previousMonth.egressAuditPayout,
             ),
             data.currentMonthExpectations,
         );
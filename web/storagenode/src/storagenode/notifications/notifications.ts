@@ -47,6 +47,8 @@ export enum NotificationTypes {
     AuditCheckFailure = 1,
     Disqualification = 2,
     Suspension = 3,
+    Offline = 4,
+    DiskFull = 5,
 }
 
 /**
@@ -257,6 +257,10 @@ export class PreviousMonthEstimatedPayout {
         public heldRate: number = 0,
         public payout: number = 0,
         public held: number = 0,
+        public egressRepair: number = 0,
+        public egressRepairPayout: number = 0,
+        public egressAudit: number = 0,
+        public egressAuditPayout: number = 0,
     ) {}
 }
 
@@ -279,6 +283,7 @@ export class SatellitePayoutForPeriod {
         public isExitComplete: boolean = false,
         public heldPercent: number = 0,
         public distributed: number = 0,
+        public transactionUrl: string = '',
     ) {
         this.earned = this.convertToCents(this.earned);
         this.surge = this.convertToCents(this.surge);
@@ -296,6 +301,10 @@ export class SatellitePayoutForPeriod {
             }
             return hash;
         };
+        if (this.transactionUrl) {
+            return this.transactionUrl;
+        }
+
         if (!this.receipt) {
             return '';
         }